@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	apiTokenSecretLength = 40
+	// apiTokenLastUsedInterval is the interval of updating the last used time of an API token,
+	// so that we don't write the database for every request.
+	apiTokenLastUsedInterval = 1 * time.Minute
+)
+
+// NewAPIToken generates a scoped API token and returns the token and its hash.
+// Only the hash is stored, so the token can't be retrieved after creation.
+func NewAPIToken() (string, string, error) {
+	secret, err := common.RandomString(apiTokenSecretLength)
+	if err != nil {
+		return "", "", err
+	}
+	token := fmt.Sprintf("%s%s", api.APITokenPrefix, secret)
+	return token, HashAPIToken(token), nil
+}
+
+// HashAPIToken returns the hex-encoded SHA-256 hash of the API token.
+func HashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (in *APIAuthInterceptor) authenticateAPIToken(ctx context.Context, token string) (*store.APITokenMessage, error) {
+	tokenHash := HashAPIToken(token)
+	apiToken, err := in.store.GetAPIToken(ctx, &store.FindAPITokenMessage{TokenHash: &tokenHash})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find API token, error: %v", err)
+	}
+	if apiToken == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid API token")
+	}
+	now := time.Now()
+	if apiToken.RevokedTs != 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "API token has been revoked")
+	}
+	if apiToken.ExpireTs != 0 && apiToken.ExpireTs <= now.Unix() {
+		return nil, status.Errorf(codes.Unauthenticated, "API token expired")
+	}
+	if now.Unix()-apiToken.LastUsedTs >= int64(apiTokenLastUsedInterval.Seconds()) {
+		if err := in.store.UpdateAPITokenLastUsedTs(ctx, apiToken.UID, now.Unix()); err != nil {
+			slog.Warn("Failed to update the last used time of API token", slog.Int("token", apiToken.UID), log.BBError(err))
+		} else {
+			apiToken.LastUsedTs = now.Unix()
+		}
+	}
+	return apiToken, nil
+}
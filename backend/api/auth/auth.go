@@ -21,6 +21,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
 )
 
@@ -33,7 +34,8 @@ const (
 	AccessTokenAudienceFmt = "bb.user.access.%s"
 	// MFATempTokenAudienceFmt is the format of the MFA temp token audience.
 	MFATempTokenAudienceFmt = "bb.user.mfa-temp.%s"
-	apiTokenDuration        = 1 * time.Hour
+	// APITokenDuration is the expiration duration of the access token of the service accounts.
+	APITokenDuration = 1 * time.Hour
 	// DefaultTokenDuration is the default token expiration duration.
 	DefaultTokenDuration = 7 * 24 * time.Hour
 
@@ -81,16 +83,13 @@ func (in *APIAuthInterceptor) AuthenticationInterceptor(ctx context.Context, req
 		return nil, status.Errorf(codes.Unauthenticated, err.Error())
 	}
 
-	principalID, err := in.authenticate(ctx, accessTokenStr)
+	childCtx, err := in.authenticate(ctx, accessTokenStr)
 	if err != nil {
 		if IsAuthenticationAllowed(serverInfo.FullMethod) {
 			return handler(ctx, request)
 		}
 		return nil, err
 	}
-
-	// Stores principalID into context.
-	childCtx := context.WithValue(ctx, common.PrincipalIDContextKey, principalID)
 	return handler(childCtx, request)
 }
 
@@ -106,16 +105,13 @@ func (in *APIAuthInterceptor) AuthenticationStreamInterceptor(request any, ss gr
 		return status.Errorf(codes.Unauthenticated, err.Error())
 	}
 
-	principalID, err := in.authenticate(ctx, accessTokenStr)
+	childCtx, err := in.authenticate(ctx, accessTokenStr)
 	if err != nil {
 		if IsAuthenticationAllowed(serverInfo.FullMethod) {
 			return handler(request, ss)
 		}
 		return err
 	}
-
-	// Stores principalID into context.
-	childCtx := context.WithValue(ctx, common.PrincipalIDContextKey, principalID)
 	sss := overrideStream{ServerStream: ss, childCtx: childCtx}
 	return handler(request, sss)
 }
@@ -129,12 +125,42 @@ func (s overrideStream) Context() context.Context {
 	return s.childCtx
 }
 
-func (in *APIAuthInterceptor) authenticate(ctx context.Context, accessTokenStr string) (int, error) {
+// authenticate authenticates the access token and returns the child context storing the principal ID,
+// and the API token or the session ID authenticating the request.
+func (in *APIAuthInterceptor) authenticate(ctx context.Context, accessTokenStr string) (context.Context, error) {
 	if accessTokenStr == "" {
-		return 0, status.Errorf(codes.Unauthenticated, "access token not found")
+		return nil, status.Errorf(codes.Unauthenticated, "access token not found")
+	}
+	if strings.HasPrefix(accessTokenStr, api.APITokenPrefix) {
+		apiToken, err := in.authenticateAPIToken(ctx, accessTokenStr)
+		if err != nil {
+			return nil, err
+		}
+		if err := in.checkUser(ctx, apiToken.PrincipalUID); err != nil {
+			return nil, err
+		}
+		childCtx := context.WithValue(ctx, common.PrincipalIDContextKey, apiToken.PrincipalUID)
+		return context.WithValue(childCtx, common.APITokenContextKey, apiToken), nil
+	}
+	principalID, sessionID, err := in.authenticateAccessToken(accessTokenStr)
+	if err != nil {
+		return nil, err
+	}
+	if err := in.checkUser(ctx, principalID); err != nil {
+		return nil, err
+	}
+	session, err := in.authenticateSession(ctx, principalID, sessionID)
+	if err != nil {
+		return nil, err
 	}
+	childCtx := context.WithValue(ctx, common.PrincipalIDContextKey, principalID)
+	return context.WithValue(childCtx, common.SessionIDContextKey, session.UID), nil
+}
+
+// authenticateAccessToken returns the principal ID and the session ID in the access token.
+func (in *APIAuthInterceptor) authenticateAccessToken(accessTokenStr string) (int, string, error) {
 	if _, ok := in.stateCfg.ExpireCache.Get(accessTokenStr); ok {
-		return 0, status.Errorf(codes.Unauthenticated, "access token expired")
+		return 0, "", status.Errorf(codes.Unauthenticated, "access token expired")
 	}
 	claims := &claimsMessage{}
 	if _, err := jwt.ParseWithClaims(accessTokenStr, claims, func(t *jwt.Token) (any, error) {
@@ -149,12 +175,12 @@ func (in *APIAuthInterceptor) authenticate(ctx context.Context, accessTokenStr s
 		return nil, status.Errorf(codes.Unauthenticated, "unexpected access token kid=%v", t.Header["kid"])
 	}); err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
-			return 0, status.Errorf(codes.Unauthenticated, "access token expired")
+			return 0, "", status.Errorf(codes.Unauthenticated, "access token expired")
 		}
-		return 0, status.Errorf(codes.Unauthenticated, "failed to parse claim")
+		return 0, "", status.Errorf(codes.Unauthenticated, "failed to parse claim")
 	}
 	if !audienceContains(claims.Audience, fmt.Sprintf(AccessTokenAudienceFmt, in.mode)) {
-		return 0, status.Errorf(codes.Unauthenticated,
+		return 0, "", status.Errorf(codes.Unauthenticated,
 			"invalid access token, audience mismatch, got %q, expected %q. you may send request to the wrong environment",
 			claims.Audience,
 			fmt.Sprintf(AccessTokenAudienceFmt, in.mode),
@@ -163,20 +189,23 @@ func (in *APIAuthInterceptor) authenticate(ctx context.Context, accessTokenStr s
 
 	principalID, err := strconv.Atoi(claims.Subject)
 	if err != nil {
-		return 0, status.Errorf(codes.Unauthenticated, "malformed ID %q in the access token", claims.Subject)
+		return 0, "", status.Errorf(codes.Unauthenticated, "malformed ID %q in the access token", claims.Subject)
 	}
+	return principalID, claims.ID, nil
+}
+
+func (in *APIAuthInterceptor) checkUser(ctx context.Context, principalID int) error {
 	user, err := in.store.GetUserByID(ctx, principalID)
 	if err != nil {
-		return 0, status.Errorf(codes.Unauthenticated, "failed to find user ID %q in the access token", principalID)
+		return status.Errorf(codes.Unauthenticated, "failed to find user ID %q in the access token", principalID)
 	}
 	if user == nil {
-		return 0, status.Errorf(codes.Unauthenticated, "user ID %q not exists in the access token", principalID)
+		return status.Errorf(codes.Unauthenticated, "user ID %q not exists in the access token", principalID)
 	}
 	if user.MemberDeleted {
-		return 0, status.Errorf(codes.Unauthenticated, "user ID %q has been deactivated by administrators", principalID)
+		return status.Errorf(codes.Unauthenticated, "user ID %q has been deactivated by administrators", principalID)
 	}
-
-	return principalID, nil
+	return nil
 }
 
 // GetUserIDFromMFATempToken returns the user ID from the MFA temp token.
@@ -242,26 +271,26 @@ type claimsMessage struct {
 	jwt.RegisteredClaims
 }
 
-// GenerateAPIToken generates an API token.
-func GenerateAPIToken(userName string, userID int, mode common.ReleaseMode, secret string) (string, error) {
-	expirationTime := time.Now().Add(apiTokenDuration)
-	return generateToken(userName, userID, fmt.Sprintf(AccessTokenAudienceFmt, mode), expirationTime, []byte(secret))
+// GenerateAPIToken generates an API token of the session.
+func GenerateAPIToken(userName string, userID int, sessionID int, mode common.ReleaseMode, secret string) (string, error) {
+	expirationTime := time.Now().Add(APITokenDuration)
+	return generateToken(userName, userID, strconv.Itoa(sessionID), fmt.Sprintf(AccessTokenAudienceFmt, mode), expirationTime, []byte(secret))
 }
 
-// GenerateAccessToken generates an access token for web.
-func GenerateAccessToken(userName string, userID int, mode common.ReleaseMode, secret string, tokenDuration time.Duration) (string, error) {
+// GenerateAccessToken generates an access token of the session for web.
+func GenerateAccessToken(userName string, userID int, sessionID int, mode common.ReleaseMode, secret string, tokenDuration time.Duration) (string, error) {
 	expirationTime := time.Now().Add(tokenDuration)
-	return generateToken(userName, userID, fmt.Sprintf(AccessTokenAudienceFmt, mode), expirationTime, []byte(secret))
+	return generateToken(userName, userID, strconv.Itoa(sessionID), fmt.Sprintf(AccessTokenAudienceFmt, mode), expirationTime, []byte(secret))
 }
 
 // GenerateMFATempToken generates a temporary token for MFA.
 func GenerateMFATempToken(userName string, userID int, mode common.ReleaseMode, secret string, tokenDuration time.Duration) (string, error) {
 	expirationTime := time.Now().Add(tokenDuration)
-	return generateToken(userName, userID, fmt.Sprintf(MFATempTokenAudienceFmt, mode), expirationTime, []byte(secret))
+	return generateToken(userName, userID, "" /* sessionID */, fmt.Sprintf(MFATempTokenAudienceFmt, mode), expirationTime, []byte(secret))
 }
 
 // Pay attention to this function. It holds the main JWT token generation logic.
-func generateToken(userName string, userID int, aud string, expirationTime time.Time, secret []byte) (string, error) {
+func generateToken(userName string, userID int, sessionID string, aud string, expirationTime time.Time, secret []byte) (string, error) {
 	// Create the JWT claims, which includes the username and expiry time.
 	claims := &claimsMessage{
 		Name: userName,
@@ -272,6 +301,8 @@ func generateToken(userName string, userID int, aud string, expirationTime time.
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			Issuer:    issuer,
 			Subject:   strconv.Itoa(userID),
+			// The ID is the session ID, so that the access token can be invalidated by revoking the session.
+			ID: sessionID,
 		},
 	}
 
@@ -0,0 +1,420 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// Group is the SCIM group resource.
+type Group struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	DisplayName string       `json:"displayName"`
+	Members     []*MemberRef `json:"members,omitempty"`
+	Meta        *Meta        `json:"meta,omitempty"`
+}
+
+// MemberRef is the reference to a group member, or a group of the user.
+type MemberRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+func (s *Service) listGroups(c echo.Context) error {
+	ctx := c.Request().Context()
+	f, err := parseFilter(c.QueryParam("filter"), "displayName", "externalId")
+	if err != nil {
+		return handleError(c, err)
+	}
+	find := &store.FindSCIMGroupMessage{}
+	if f != nil && f.attribute == "displayname" {
+		find.DisplayName = &f.value
+	}
+	groups, err := s.store.ListSCIMGroups(ctx, find)
+	if err != nil {
+		return handleError(c, err)
+	}
+	// Azure AD lists the groups without the members to reduce the response size.
+	excludeMembers := strings.Contains(c.QueryParam("excludedAttributes"), "members")
+	var resources []any
+	for _, group := range groups {
+		if f != nil && f.attribute == "externalid" && group.Payload.ExternalId != f.value {
+			continue
+		}
+		resource, err := s.convertToSCIMGroup(ctx, group)
+		if err != nil {
+			return handleError(c, err)
+		}
+		if excludeMembers {
+			resource.Members = nil
+		}
+		resources = append(resources, resource)
+	}
+	response, err := getPage(c, resources)
+	if err != nil {
+		return handleError(c, err)
+	}
+	return writeJSON(c, http.StatusOK, response)
+}
+
+func (s *Service) getGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	group, err := s.findGroup(ctx, c.Param("id"))
+	if err != nil {
+		return handleError(c, err)
+	}
+	resource, err := s.convertToSCIMGroup(ctx, group)
+	if err != nil {
+		return handleError(c, err)
+	}
+	return writeJSON(c, http.StatusOK, resource)
+}
+
+func (s *Service) createGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	var request Group
+	if err := readJSON(c, &request); err != nil {
+		return handleError(c, err)
+	}
+	if request.DisplayName == "" {
+		return handleError(c, newBadRequestError("invalidValue", "displayName is required"))
+	}
+	existing, err := s.store.GetSCIMGroup(ctx, &store.FindSCIMGroupMessage{DisplayName: &request.DisplayName})
+	if err != nil {
+		return handleError(c, err)
+	}
+	if existing != nil {
+		return handleError(c, newConflictError("Group %q already exists", request.DisplayName))
+	}
+	memberIDs, err := s.getMemberIDs(ctx, request.Members)
+	if err != nil {
+		return handleError(c, err)
+	}
+
+	group, err := s.store.CreateSCIMGroup(ctx, &store.SCIMGroupMessage{
+		ResourceID:  uuid.NewString(),
+		DisplayName: request.DisplayName,
+		Payload: &storepb.SCIMGroupPayload{
+			ExternalId: request.ExternalID,
+		},
+	})
+	if err != nil {
+		return handleError(c, err)
+	}
+	group, err = s.updateGroupMembers(ctx, group, group.DisplayName, memberIDs)
+	if err != nil {
+		return handleError(c, err)
+	}
+	resource, err := s.convertToSCIMGroup(ctx, group)
+	if err != nil {
+		return handleError(c, err)
+	}
+	return writeJSON(c, http.StatusCreated, resource)
+}
+
+func (s *Service) replaceGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	group, err := s.findGroup(ctx, c.Param("id"))
+	if err != nil {
+		return handleError(c, err)
+	}
+	var request Group
+	if err := readJSON(c, &request); err != nil {
+		return handleError(c, err)
+	}
+	memberIDs, err := s.getMemberIDs(ctx, request.Members)
+	if err != nil {
+		return handleError(c, err)
+	}
+	if request.ExternalID != "" {
+		group.Payload.ExternalId = request.ExternalID
+	}
+	displayName := request.DisplayName
+	if displayName == "" {
+		displayName = group.DisplayName
+	}
+	group, err = s.updateGroupMembers(ctx, group, displayName, memberIDs)
+	if err != nil {
+		return handleError(c, err)
+	}
+	resource, err := s.convertToSCIMGroup(ctx, group)
+	if err != nil {
+		return handleError(c, err)
+	}
+	return writeJSON(c, http.StatusOK, resource)
+}
+
+func (s *Service) patchGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	group, err := s.findGroup(ctx, c.Param("id"))
+	if err != nil {
+		return handleError(c, err)
+	}
+	var request PatchRequest
+	if err := readJSON(c, &request); err != nil {
+		return handleError(c, err)
+	}
+	displayName, memberIDs, err := s.applyGroupPatch(ctx, group, request.Operations)
+	if err != nil {
+		return handleError(c, err)
+	}
+	group, err = s.updateGroupMembers(ctx, group, displayName, memberIDs)
+	if err != nil {
+		return handleError(c, err)
+	}
+	resource, err := s.convertToSCIMGroup(ctx, group)
+	if err != nil {
+		return handleError(c, err)
+	}
+	return writeJSON(c, http.StatusOK, resource)
+}
+
+// deleteGroup deletes the group, revoking the roles granted by the group.
+func (s *Service) deleteGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	group, err := s.findGroup(ctx, c.Param("id"))
+	if err != nil {
+		return handleError(c, err)
+	}
+	if _, err := s.updateGroupMembers(ctx, group, group.DisplayName, nil); err != nil {
+		return handleError(c, err)
+	}
+	if err := s.store.DeleteSCIMGroup(ctx, group.ResourceID); err != nil {
+		return handleError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+var memberValuePathRegexp = regexp.MustCompile(`(?i)^members\[value eq "([^"]*)"\]$`)
+
+// applyGroupPatch applies the PATCH operations to the group, and returns the desired display name and member IDs.
+func (s *Service) applyGroupPatch(ctx context.Context, group *store.SCIMGroupMessage, operations []*PatchOperation) (string, []int32, error) {
+	displayName := group.DisplayName
+	memberIDs := slices.Clone(group.Payload.MemberIds)
+	for _, operation := range operations {
+		op := strings.ToLower(operation.Op)
+		path := strings.ToLower(operation.Path)
+		switch {
+		case path == "":
+			if op != "add" && op != "replace" {
+				return "", nil, newBadRequestError("noTarget", "The path is required for the %q operation", operation.Op)
+			}
+			var value Group
+			if err := json.Unmarshal(operation.Value, &value); err != nil {
+				return "", nil, newBadRequestError("invalidValue", "Invalid value of the %q operation: %v", operation.Op, err)
+			}
+			if value.DisplayName != "" {
+				displayName = value.DisplayName
+			}
+			if value.ExternalID != "" {
+				group.Payload.ExternalId = value.ExternalID
+			}
+			if value.Members != nil {
+				ids, err := s.getMemberIDs(ctx, value.Members)
+				if err != nil {
+					return "", nil, err
+				}
+				if op == "replace" {
+					memberIDs = ids
+				} else {
+					memberIDs = addMembers(memberIDs, ids...)
+				}
+			}
+		case path == "displayname":
+			if err := unmarshalString(operation.Value, &displayName); err != nil {
+				return "", nil, err
+			}
+		case path == "externalid":
+			if err := unmarshalString(operation.Value, &group.Payload.ExternalId); err != nil {
+				return "", nil, err
+			}
+		case path == "members":
+			var members []*MemberRef
+			if op != "remove" || len(operation.Value) > 0 {
+				if err := json.Unmarshal(operation.Value, &members); err != nil {
+					return "", nil, newBadRequestError("invalidValue", "Invalid members: %v", err)
+				}
+			}
+			switch op {
+			case "add", "replace":
+				ids, err := s.getMemberIDs(ctx, members)
+				if err != nil {
+					return "", nil, err
+				}
+				if op == "replace" {
+					memberIDs = ids
+				} else {
+					memberIDs = addMembers(memberIDs, ids...)
+				}
+			case "remove":
+				if members == nil {
+					// Remove all members without the value.
+					memberIDs = nil
+				}
+				for _, member := range members {
+					if id, err := strconv.Atoi(member.Value); err == nil {
+						memberIDs = removeMembers(memberIDs, id)
+					}
+				}
+			}
+		case memberValuePathRegexp.MatchString(operation.Path):
+			if op != "remove" {
+				return "", nil, newBadRequestError("invalidPath", "Unsupported path %q for the %q operation", operation.Path, operation.Op)
+			}
+			if id, err := strconv.Atoi(memberValuePathRegexp.FindStringSubmatch(operation.Path)[1]); err == nil {
+				memberIDs = removeMembers(memberIDs, id)
+			}
+		default:
+			return "", nil, newBadRequestError("invalidPath", "Unsupported path %q", operation.Path)
+		}
+	}
+	if displayName == "" {
+		return "", nil, newBadRequestError("invalidValue", "displayName is required")
+	}
+	return displayName, memberIDs, nil
+}
+
+// updateGroupMembers updates the display name and the members of the group, and syncs the roles granted by the group mappings
+// to the members added, removed, or all members if the group is renamed.
+func (s *Service) updateGroupMembers(ctx context.Context, group *store.SCIMGroupMessage, displayName string, memberIDs []int32) (*store.SCIMGroupMessage, error) {
+	if displayName != group.DisplayName {
+		existing, err := s.store.GetSCIMGroup(ctx, &store.FindSCIMGroupMessage{DisplayName: &displayName})
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, newConflictError("Group %q already exists", displayName)
+		}
+	}
+	memberIDs = addMembers(nil, memberIDs...)
+
+	var affected []int32
+	for _, id := range group.Payload.MemberIds {
+		if displayName != group.DisplayName || !slices.Contains(memberIDs, id) {
+			affected = append(affected, id)
+		}
+	}
+	for _, id := range memberIDs {
+		if displayName != group.DisplayName || !slices.Contains(group.Payload.MemberIds, id) {
+			affected = addMembers(affected, id)
+		}
+	}
+
+	before := make(map[int32]*roleGrants)
+	setting, err := s.store.GetSCIMSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range affected {
+		grants, err := s.getRoleGrants(ctx, setting, int(id))
+		if err != nil {
+			return nil, err
+		}
+		before[id] = grants
+	}
+
+	group.Payload.MemberIds = memberIDs
+	updated, err := s.store.UpdateSCIMGroup(ctx, &store.UpdateSCIMGroupMessage{
+		ResourceID:  group.ResourceID,
+		DisplayName: &displayName,
+		Payload:     group.Payload,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range affected {
+		after, err := s.getRoleGrants(ctx, setting, int(id))
+		if err != nil {
+			return nil, err
+		}
+		if err := s.syncRoles(ctx, int(id), before[id], after); err != nil {
+			return nil, err
+		}
+	}
+	return updated, nil
+}
+
+// getMemberIDs returns the IDs of the members, which must be the end users.
+func (s *Service) getMemberIDs(ctx context.Context, members []*MemberRef) ([]int32, error) {
+	var ids []int32
+	for _, member := range members {
+		user, err := s.findUser(ctx, member.Value)
+		if err != nil {
+			if _, ok := err.(*scimError); ok {
+				return nil, newBadRequestError("invalidValue", "Member %q is not a user", member.Value)
+			}
+			return nil, err
+		}
+		ids = addMembers(ids, int32(user.ID))
+	}
+	return ids, nil
+}
+
+// findGroup finds the group by the SCIM id.
+func (s *Service) findGroup(ctx context.Context, id string) (*store.SCIMGroupMessage, error) {
+	group, err := s.store.GetSCIMGroup(ctx, &store.FindSCIMGroupMessage{ResourceID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, newNotFoundError("Group %q not found", id)
+	}
+	return group, nil
+}
+
+func (s *Service) convertToSCIMGroup(ctx context.Context, group *store.SCIMGroupMessage) (*Group, error) {
+	resource := &Group{
+		Schemas:     []string{schemaGroup},
+		ID:          group.ResourceID,
+		ExternalID:  group.Payload.ExternalId,
+		DisplayName: group.DisplayName,
+		Meta: &Meta{
+			ResourceType: "Group",
+			Location:     "Groups/" + group.ResourceID,
+		},
+	}
+	for _, id := range group.Payload.MemberIds {
+		user, err := s.store.GetUserByID(ctx, int(id))
+		if err != nil {
+			return nil, err
+		}
+		if user == nil || user.Type != api.EndUser {
+			continue
+		}
+		resource.Members = append(resource.Members, &MemberRef{
+			Value:   strconv.Itoa(user.ID),
+			Display: user.Email,
+		})
+	}
+	return resource, nil
+}
+
+// addMembers adds the IDs to the members without duplicates.
+func addMembers(memberIDs []int32, ids ...int32) []int32 {
+	for _, id := range ids {
+		if !slices.Contains(memberIDs, id) {
+			memberIDs = append(memberIDs, id)
+		}
+	}
+	return memberIDs
+}
+
+func removeMembers(memberIDs []int32, id int) []int32 {
+	return slices.DeleteFunc(slices.Clone(memberIDs), func(memberID int32) bool {
+		return int(memberID) == id
+	})
+}
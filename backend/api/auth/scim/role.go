@@ -0,0 +1,150 @@
+package scim
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// projectRole is a role in a project granted by the group mappings.
+type projectRole struct {
+	projectID string
+	role      api.Role
+}
+
+// roleGrants are the roles granted to a user by the group mappings of the user's groups.
+type roleGrants struct {
+	workspaceRoles []api.Role
+	projectRoles   []projectRole
+}
+
+// getRoleGrants returns the roles granted to the user by the group mappings of the user's groups.
+func (s *Service) getRoleGrants(ctx context.Context, setting *storepb.SCIMSetting, userID int) (*roleGrants, error) {
+	groups, err := s.store.ListSCIMGroups(ctx, &store.FindSCIMGroupMessage{MemberID: &userID})
+	if err != nil {
+		return nil, err
+	}
+	var displayNames []string
+	for _, group := range groups {
+		displayNames = append(displayNames, group.DisplayName)
+	}
+	return getGroupRoleGrants(setting, displayNames), nil
+}
+
+func getGroupRoleGrants(setting *storepb.SCIMSetting, displayNames []string) *roleGrants {
+	grants := &roleGrants{}
+	for _, mapping := range setting.GroupMappings {
+		if !slices.Contains(displayNames, mapping.Group) {
+			continue
+		}
+		if mapping.WorkspaceRole != "" {
+			// The workspace role is validated when updating the setting.
+			roleID, _ := common.GetRoleID(mapping.WorkspaceRole)
+			if role := api.Role(roleID); !slices.Contains(grants.workspaceRoles, role) {
+				grants.workspaceRoles = append(grants.workspaceRoles, role)
+			}
+		}
+		for _, r := range mapping.ProjectRoles {
+			projectID, _ := common.GetProjectID(r.Project)
+			roleID, _ := common.GetRoleID(r.Role)
+			if pr := (projectRole{projectID: projectID, role: api.Role(roleID)}); !slices.Contains(grants.projectRoles, pr) {
+				grants.projectRoles = append(grants.projectRoles, pr)
+			}
+		}
+	}
+	return grants
+}
+
+// syncRoles revokes the roles no longer granted to the user and grants the new roles.
+// The roles granted manually rather than by the group mappings are kept.
+func (s *Service) syncRoles(ctx context.Context, userID int, before, after *roleGrants) error {
+	user, err := s.store.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return nil
+	}
+
+	roles := mergeRoles(user.Roles, before.workspaceRoles, after.workspaceRoles)
+	if len(roles) == 0 {
+		roles = []api.Role{api.WorkspaceMember}
+	}
+	if !sameRoles(roles, user.Roles) {
+		if _, err := s.store.UpdateUser(ctx, userID, &store.UpdateUserMessage{Roles: &roles}, api.SystemBotID); err != nil {
+			return err
+		}
+		// Refresh the member of the project policies.
+		if user, err = s.store.GetUserByID(ctx, userID); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range before.projectRoles {
+		if !slices.Contains(after.projectRoles, r) {
+			if err := s.updateProjectMember(ctx, r, user, false /* grant */); err != nil {
+				return err
+			}
+		}
+	}
+	for _, r := range after.projectRoles {
+		if !slices.Contains(before.projectRoles, r) {
+			if err := s.updateProjectMember(ctx, r, user, true /* grant */); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// updateProjectMember adds the user to or removes the user from the unconditional binding of the project role.
+func (s *Service) updateProjectMember(ctx context.Context, r projectRole, user *store.UserMessage, grant bool) error {
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &r.projectID})
+	if err != nil {
+		return err
+	}
+	if project == nil || project.Deleted {
+		slog.Warn("Skip the project role of the SCIM group mapping", slog.String("project", r.projectID), slog.String("role", string(r.role)))
+		return nil
+	}
+	if err := s.store.UpdateProjectMemberRole(ctx, project.UID, r.role, user, grant, api.SystemBotID); err != nil {
+		slog.Error("Failed to update the project IAM policy for SCIM", slog.String("project", r.projectID), log.BBError(err))
+		return err
+	}
+	return nil
+}
+
+// mergeRoles removes the roles revoked and adds the roles granted to the current roles.
+func mergeRoles(current, before, after []api.Role) []api.Role {
+	var roles []api.Role
+	for _, role := range current {
+		if slices.Contains(before, role) && !slices.Contains(after, role) {
+			continue
+		}
+		roles = append(roles, role)
+	}
+	for _, role := range after {
+		if !slices.Contains(roles, role) {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+func sameRoles(a, b []api.Role) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, role := range a {
+		if !slices.Contains(b, role) {
+			return false
+		}
+	}
+	return true
+}
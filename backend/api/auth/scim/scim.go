@@ -0,0 +1,263 @@
+// Package scim is the SCIM 2.0 server provisioning the users and groups from the identity providers such as Okta and Azure AD.
+package scim
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	schemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	contentType = "application/scim+json"
+
+	// defaultCount is the default page size of the list responses.
+	defaultCount = 100
+)
+
+// Service is the SCIM 2.0 server.
+type Service struct {
+	store          *store.Store
+	licenseService enterprise.LicenseService
+}
+
+// NewService creates a SCIM service.
+func NewService(store *store.Store, licenseService enterprise.LicenseService) *Service {
+	return &Service{
+		store:          store,
+		licenseService: licenseService,
+	}
+}
+
+// RegisterRoutes registers the routes of the SCIM users and groups.
+func (s *Service) RegisterRoutes(g *echo.Group) {
+	g.Use(s.authenticate)
+
+	g.GET("/ServiceProviderConfig", getServiceProviderConfig)
+
+	g.GET("/Users", s.listUsers)
+	g.POST("/Users", s.createUser)
+	g.GET("/Users/:id", s.getUser)
+	g.PUT("/Users/:id", s.replaceUser)
+	g.PATCH("/Users/:id", s.patchUser)
+	g.DELETE("/Users/:id", s.deleteUser)
+
+	g.GET("/Groups", s.listGroups)
+	g.POST("/Groups", s.createGroup)
+	g.GET("/Groups/:id", s.getGroup)
+	g.PUT("/Groups/:id", s.replaceGroup)
+	g.PATCH("/Groups/:id", s.patchGroup)
+	g.DELETE("/Groups/:id", s.deleteGroup)
+}
+
+// authenticate verifies the bearer token of the SCIM setting.
+func (s *Service) authenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		setting, err := s.store.GetSCIMSetting(c.Request().Context())
+		if err != nil {
+			return writeError(c, http.StatusInternalServerError, "", fmt.Sprintf("Failed to get SCIM setting: %v", err))
+		}
+		if setting.Token == "" {
+			return writeError(c, http.StatusNotFound, "", "SCIM provisioning is not configured")
+		}
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureSSO); err != nil {
+			return writeError(c, http.StatusForbidden, "", err.Error())
+		}
+		token, ok := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(setting.Token)) != 1 {
+			return writeError(c, http.StatusUnauthorized, "", "Invalid SCIM token")
+		}
+		return next(c)
+	}
+}
+
+func getServiceProviderConfig(c echo.Context) error {
+	supported := func(supported bool) map[string]any {
+		return map[string]any{"supported": supported}
+	}
+	return writeJSON(c, http.StatusOK, map[string]any{
+		"schemas":        []string{schemaServiceProviderConfig},
+		"patch":          supported(true),
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": defaultCount},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]any{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "OAuth Bearer Token",
+				"description": "Authentication with the token of the SCIM setting.",
+			},
+		},
+	})
+}
+
+// Meta is the metadata of the SCIM resources.
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location,omitempty"`
+}
+
+// ListResponse is the response of listing the SCIM resources.
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+// PatchRequest is the SCIM PATCH request.
+type PatchRequest struct {
+	Schemas    []string          `json:"schemas"`
+	Operations []*PatchOperation `json:"Operations"`
+}
+
+// PatchOperation is the operation of the SCIM PATCH request.
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// scimError is the error of a SCIM request, rendered as the SCIM error response.
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *scimError) Error() string {
+	return e.detail
+}
+
+func newBadRequestError(scimType, format string, args ...any) *scimError {
+	return &scimError{status: http.StatusBadRequest, scimType: scimType, detail: fmt.Sprintf(format, args...)}
+}
+
+func newNotFoundError(format string, args ...any) *scimError {
+	return &scimError{status: http.StatusNotFound, detail: fmt.Sprintf(format, args...)}
+}
+
+func newConflictError(format string, args ...any) *scimError {
+	return &scimError{status: http.StatusConflict, scimType: "uniqueness", detail: fmt.Sprintf(format, args...)}
+}
+
+// handleError writes the SCIM error response of the error.
+func handleError(c echo.Context, err error) error {
+	if e, ok := err.(*scimError); ok {
+		return writeError(c, e.status, e.scimType, e.detail)
+	}
+	return writeError(c, http.StatusInternalServerError, "", err.Error())
+}
+
+func writeError(c echo.Context, status int, scimType, detail string) error {
+	body := map[string]any{
+		"schemas": []string{schemaError},
+		"status":  strconv.Itoa(status),
+		"detail":  detail,
+	}
+	if scimType != "" {
+		body["scimType"] = scimType
+	}
+	return writeJSON(c, status, body)
+}
+
+func writeJSON(c echo.Context, status int, body any) error {
+	bytes, err := json.Marshal(body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to marshal SCIM response").SetInternal(err)
+	}
+	return c.Blob(status, contentType, bytes)
+}
+
+func readJSON(c echo.Context, v any) error {
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return newBadRequestError("invalidSyntax", "Failed to read request body: %v", err)
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return newBadRequestError("invalidSyntax", "Malformed request body: %v", err)
+	}
+	return nil
+}
+
+var filterRegexp = regexp.MustCompile(`(?i)^\s*([a-z.]+)\s+eq\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+// filter is the parsed SCIM filter, only the "eq" operator on a single attribute is supported
+// because the identity providers only use it to look up the existing resources.
+type filter struct {
+	// attribute is the lower-case attribute name, e.g. "username".
+	attribute string
+	value     string
+}
+
+func parseFilter(s string, attributes ...string) (*filter, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	matches := filterRegexp.FindStringSubmatch(s)
+	if matches == nil {
+		return nil, newBadRequestError("invalidFilter", "Unsupported filter %q, only the \"eq\" operator is supported", s)
+	}
+	attribute := strings.ToLower(matches[1])
+	for _, a := range attributes {
+		if attribute == strings.ToLower(a) {
+			var value string
+			if err := json.Unmarshal([]byte(`"`+matches[2]+`"`), &value); err != nil {
+				return nil, newBadRequestError("invalidFilter", "Invalid filter value %q", matches[2])
+			}
+			return &filter{attribute: attribute, value: value}, nil
+		}
+	}
+	return nil, newBadRequestError("invalidFilter", "Unsupported filter attribute %q", matches[1])
+}
+
+// getPage returns the resources in the page of the 1-based start index and the count.
+func getPage(c echo.Context, resources []any) (*ListResponse, error) {
+	startIndex, count := 1, defaultCount
+	if v := c.QueryParam("startIndex"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, newBadRequestError("invalidValue", "Invalid startIndex %q", v)
+		}
+		// A value less than 1 is interpreted as 1.
+		startIndex = max(i, 1)
+	}
+	if v := c.QueryParam("count"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, newBadRequestError("invalidValue", "Invalid count %q", v)
+		}
+		count = min(max(i, 0), defaultCount)
+	}
+	page := []any{}
+	if startIndex <= len(resources) {
+		page = resources[startIndex-1 : min(startIndex-1+count, len(resources))]
+	}
+	return &ListResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}, nil
+}
@@ -0,0 +1,179 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestParseFilter(t *testing.T) {
+	a := require.New(t)
+
+	tests := []struct {
+		filter  string
+		want    *filter
+		wantErr bool
+	}{
+		{filter: "", want: nil},
+		{filter: `userName eq "Alice@Example.com"`, want: &filter{attribute: "username", value: "Alice@Example.com"}},
+		{filter: `emails.value EQ "bob@example.com"`, want: &filter{attribute: "emails.value", value: "bob@example.com"}},
+		{filter: `userName eq "a\"b"`, want: &filter{attribute: "username", value: `a"b`}},
+		{filter: `userName sw "alice"`, wantErr: true},
+		{filter: `displayName eq "alice"`, wantErr: true},
+		{filter: `userName eq "alice" and active eq true`, wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := parseFilter(test.filter, "userName", "emails.value")
+		if test.wantErr {
+			a.Error(err, test.filter)
+			continue
+		}
+		a.NoError(err, test.filter)
+		a.Equal(test.want, got, test.filter)
+	}
+}
+
+func TestGetPage(t *testing.T) {
+	a := require.New(t)
+	resources := []any{"a", "b", "c"}
+
+	tests := []struct {
+		query string
+		want  []any
+		start int
+	}{
+		{query: "", want: []any{"a", "b", "c"}, start: 1},
+		{query: "startIndex=2&count=1", want: []any{"b"}, start: 2},
+		{query: "startIndex=0&count=10", want: []any{"a", "b", "c"}, start: 1},
+		{query: "startIndex=4", want: []any{}, start: 4},
+		{query: "count=0", want: []any{}, start: 1},
+	}
+	for _, test := range tests {
+		c := echo.New().NewContext(httptest.NewRequest("GET", "/Users?"+test.query, nil), httptest.NewRecorder())
+		got, err := getPage(c, resources)
+		a.NoError(err, test.query)
+		a.Equal(test.want, got.Resources, test.query)
+		a.Equal(3, got.TotalResults, test.query)
+		a.Equal(test.start, got.StartIndex, test.query)
+		a.Equal(len(test.want), got.ItemsPerPage, test.query)
+	}
+
+	c := echo.New().NewContext(httptest.NewRequest("GET", "/Users?count=x", nil), httptest.NewRecorder())
+	_, err := getPage(c, resources)
+	a.Error(err)
+}
+
+func TestApplyUserPatch(t *testing.T) {
+	a := require.New(t)
+
+	user := &User{
+		UserName: "alice@example.com",
+		Name:     &Name{GivenName: "Alice", FamilyName: "Liddell"},
+	}
+	var request PatchRequest
+	a.NoError(json.Unmarshal([]byte(`{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "Replace", "path": "active", "value": "False"},
+			{"op": "replace", "path": "name.givenName", "value": "Alicia"},
+			{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "alicia@example.com"},
+			{"op": "remove", "path": "displayName"}
+		]
+	}`), &request))
+	a.NoError(applyUserPatch(user, request.Operations))
+	a.False(user.isActive())
+	a.Equal("Alicia Liddell", user.getTitle())
+	a.Equal("alice@example.com", user.getEmail())
+	a.Equal([]*Email{{Value: "alicia@example.com", Primary: true}}, user.Emails)
+
+	request = PatchRequest{}
+	a.NoError(json.Unmarshal([]byte(`{
+		"Operations": [
+			{"op": "replace", "value": {"userName": "Alicia@Example.com", "active": true}}
+		]
+	}`), &request))
+	a.NoError(applyUserPatch(user, request.Operations))
+	a.True(user.isActive())
+	a.Equal("alicia@example.com", user.getEmail())
+
+	request = PatchRequest{}
+	a.NoError(json.Unmarshal([]byte(`{"Operations": [{"op": "replace", "path": "active", "value": "yes"}]}`), &request))
+	a.Error(applyUserPatch(user, request.Operations))
+}
+
+func TestGetGroupRoleGrants(t *testing.T) {
+	a := require.New(t)
+
+	setting := &storepb.SCIMSetting{
+		GroupMappings: []*storepb.SCIMSetting_GroupMapping{
+			{
+				Group:         "dba",
+				WorkspaceRole: "roles/workspaceDBA",
+				ProjectRoles: []*storepb.SCIMSetting_GroupMapping_ProjectRole{
+					{Project: "projects/payment", Role: "roles/projectOwner"},
+				},
+			},
+			{
+				Group: "payment-dev",
+				ProjectRoles: []*storepb.SCIMSetting_GroupMapping_ProjectRole{
+					{Project: "projects/payment", Role: "roles/projectOwner"},
+					{Project: "projects/payment", Role: "roles/projectDeveloper"},
+				},
+			},
+		},
+	}
+
+	grants := getGroupRoleGrants(setting, []string{"dba", "payment-dev", "unmapped"})
+	a.Equal([]api.Role{api.WorkspaceDBA}, grants.workspaceRoles)
+	a.Equal([]projectRole{
+		{projectID: "payment", role: api.ProjectOwner},
+		{projectID: "payment", role: api.ProjectDeveloper},
+	}, grants.projectRoles)
+
+	grants = getGroupRoleGrants(setting, nil)
+	a.Empty(grants.workspaceRoles)
+	a.Empty(grants.projectRoles)
+}
+
+func TestMergeRoles(t *testing.T) {
+	a := require.New(t)
+
+	// The roles granted manually are kept.
+	a.Equal([]api.Role{api.WorkspaceMember, api.WorkspaceAdmin}, mergeRoles([]api.Role{api.WorkspaceMember, api.WorkspaceDBA}, []api.Role{api.WorkspaceDBA}, []api.Role{api.WorkspaceAdmin}))
+	a.Equal([]api.Role{api.WorkspaceDBA}, mergeRoles([]api.Role{api.WorkspaceDBA}, nil, []api.Role{api.WorkspaceDBA}))
+	a.Empty(mergeRoles([]api.Role{api.WorkspaceDBA}, []api.Role{api.WorkspaceDBA}, nil))
+
+	a.True(sameRoles([]api.Role{api.WorkspaceDBA, api.WorkspaceMember}, []api.Role{api.WorkspaceMember, api.WorkspaceDBA}))
+	a.False(sameRoles([]api.Role{api.WorkspaceDBA}, []api.Role{api.WorkspaceMember}))
+}
+
+func TestRemoveMembers(t *testing.T) {
+	a := require.New(t)
+
+	members := []int32{101, 102, 103}
+	a.Equal([]int32{101, 103}, removeMembers(members, 102))
+	a.Equal([]int32{101, 102, 103}, members)
+	a.Equal([]int32{101, 102, 103, 104}, addMembers(members, 101, 104))
+}
+
+func TestCheckLastWorkspaceOwner(t *testing.T) {
+	a := require.New(t)
+
+	owner := &store.UserMessage{ID: 101, Email: "owner@example.com", Role: api.WorkspaceAdmin}
+	another := &store.UserMessage{ID: 102, Email: "another@example.com", Role: api.WorkspaceAdmin}
+
+	a.NoError(checkLastWorkspaceOwner(owner, []*store.UserMessage{owner, another}))
+	err := checkLastWorkspaceOwner(owner, []*store.UserMessage{owner})
+	a.Error(err)
+	scimErr, ok := err.(*scimError)
+	a.True(ok)
+	a.Equal(http.StatusForbidden, scimErr.status)
+}
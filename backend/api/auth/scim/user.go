@@ -0,0 +1,518 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/bytebase/bytebase/backend/common"
+	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// User is the SCIM user resource, mapped to the Bytebase end user.
+type User struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	UserName    string       `json:"userName"`
+	Name        *Name        `json:"name,omitempty"`
+	DisplayName string       `json:"displayName,omitempty"`
+	Emails      []*Email     `json:"emails,omitempty"`
+	Active      *bool        `json:"active,omitempty"`
+	Groups      []*MemberRef `json:"groups,omitempty"`
+	Meta        *Meta        `json:"meta,omitempty"`
+}
+
+// Name is the name of the SCIM user.
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// Email is the email of the SCIM user.
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// getEmail returns the user name if it's an email, as the identity providers use the email as the user name by default,
+// otherwise the primary email of the user.
+func (u *User) getEmail() string {
+	email := u.UserName
+	if !strings.Contains(email, "@") {
+		for i, e := range u.Emails {
+			if i == 0 || e.Primary {
+				email = e.Value
+			}
+			if e.Primary {
+				break
+			}
+		}
+	}
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// getTitle returns the Bytebase user title from the display name or the name of the user.
+func (u *User) getTitle() string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name != nil {
+		if u.Name.Formatted != "" {
+			return u.Name.Formatted
+		}
+		if title := strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName); title != "" {
+			return title
+		}
+	}
+	email := u.getEmail()
+	if i := strings.Index(email, "@"); i > 0 {
+		return email[:i]
+	}
+	return email
+}
+
+func (u *User) isActive() bool {
+	return u.Active == nil || *u.Active
+}
+
+func (s *Service) listUsers(c echo.Context) error {
+	ctx := c.Request().Context()
+	f, err := parseFilter(c.QueryParam("filter"), "userName", "emails.value")
+	if err != nil {
+		return handleError(c, err)
+	}
+	find := &store.FindUserMessage{ShowDeleted: true}
+	if f != nil {
+		email := strings.ToLower(f.value)
+		find.Email = &email
+	}
+	users, err := s.store.ListUsers(ctx, find)
+	if err != nil {
+		return handleError(c, err)
+	}
+	var resources []any
+	for _, user := range users {
+		if user.Type != api.EndUser {
+			continue
+		}
+		resource, err := s.convertToSCIMUser(ctx, user)
+		if err != nil {
+			return handleError(c, err)
+		}
+		resources = append(resources, resource)
+	}
+	response, err := getPage(c, resources)
+	if err != nil {
+		return handleError(c, err)
+	}
+	return writeJSON(c, http.StatusOK, response)
+}
+
+func (s *Service) getUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	user, err := s.findUser(ctx, c.Param("id"))
+	if err != nil {
+		return handleError(c, err)
+	}
+	resource, err := s.convertToSCIMUser(ctx, user)
+	if err != nil {
+		return handleError(c, err)
+	}
+	return writeJSON(c, http.StatusOK, resource)
+}
+
+// createUser creates the user, or reactivates the deactivated user with the same email.
+func (s *Service) createUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	var request User
+	if err := readJSON(c, &request); err != nil {
+		return handleError(c, err)
+	}
+	email := request.getEmail()
+	if err := validateEmail(email); err != nil {
+		return handleError(c, err)
+	}
+
+	existing, err := s.store.GetUser(ctx, &store.FindUserMessage{Email: &email, ShowDeleted: true})
+	if err != nil {
+		return handleError(c, err)
+	}
+	var user *store.UserMessage
+	if existing != nil {
+		// The active users are looked up by the identity providers with the filter before creating,
+		// so only the deactivated end users are taken over.
+		if existing.Type != api.EndUser {
+			return handleError(c, newConflictError("Email %q is used by a %s", email, existing.Type))
+		}
+		if !existing.MemberDeleted {
+			return handleError(c, newConflictError("User %q already exists", email))
+		}
+		user, err = s.updateUser(ctx, existing, &request)
+		if err != nil {
+			return handleError(c, err)
+		}
+	} else {
+		if request.isActive() {
+			if err := s.userCountGuard(ctx); err != nil {
+				return handleError(c, err)
+			}
+		}
+		// The users provisioned by SCIM sign in with SSO, the random password is never used.
+		password, err := common.RandomString(20)
+		if err != nil {
+			return handleError(c, errors.Wrapf(err, "failed to generate random password"))
+		}
+		passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			return handleError(c, errors.Wrapf(err, "failed to generate password hash"))
+		}
+		user, err = s.store.CreateUser(ctx, &store.UserMessage{
+			Email:        email,
+			Name:         request.getTitle(),
+			Type:         api.EndUser,
+			PasswordHash: string(passwordHash),
+			Role:         api.WorkspaceMember,
+		}, api.SystemBotID)
+		if err != nil {
+			return handleError(c, err)
+		}
+		if !request.isActive() {
+			deleted := true
+			user, err = s.store.UpdateUser(ctx, user.ID, &store.UpdateUserMessage{Delete: &deleted}, api.SystemBotID)
+			if err != nil {
+				return handleError(c, err)
+			}
+		}
+	}
+
+	resource, err := s.convertToSCIMUser(ctx, user)
+	if err != nil {
+		return handleError(c, err)
+	}
+	return writeJSON(c, http.StatusCreated, resource)
+}
+
+func (s *Service) replaceUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	user, err := s.findUser(ctx, c.Param("id"))
+	if err != nil {
+		return handleError(c, err)
+	}
+	var request User
+	if err := readJSON(c, &request); err != nil {
+		return handleError(c, err)
+	}
+	user, err = s.updateUser(ctx, user, &request)
+	if err != nil {
+		return handleError(c, err)
+	}
+	resource, err := s.convertToSCIMUser(ctx, user)
+	if err != nil {
+		return handleError(c, err)
+	}
+	return writeJSON(c, http.StatusOK, resource)
+}
+
+func (s *Service) patchUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	user, err := s.findUser(ctx, c.Param("id"))
+	if err != nil {
+		return handleError(c, err)
+	}
+	var request PatchRequest
+	if err := readJSON(c, &request); err != nil {
+		return handleError(c, err)
+	}
+	desired, err := s.convertToSCIMUser(ctx, user)
+	if err != nil {
+		return handleError(c, err)
+	}
+	if err := applyUserPatch(desired, request.Operations); err != nil {
+		return handleError(c, err)
+	}
+	user, err = s.updateUser(ctx, user, desired)
+	if err != nil {
+		return handleError(c, err)
+	}
+	resource, err := s.convertToSCIMUser(ctx, user)
+	if err != nil {
+		return handleError(c, err)
+	}
+	return writeJSON(c, http.StatusOK, resource)
+}
+
+// deleteUser removes the user from the groups, revoking the roles granted by the groups, and deactivates the user.
+// The user is kept for the audit history, and is reactivated if it's provisioned again.
+func (s *Service) deleteUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	user, err := s.findUser(ctx, c.Param("id"))
+	if err != nil {
+		return handleError(c, err)
+	}
+	if err := s.checkDeactivation(ctx, user); err != nil {
+		return handleError(c, err)
+	}
+	groups, err := s.store.ListSCIMGroups(ctx, &store.FindSCIMGroupMessage{MemberID: &user.ID})
+	if err != nil {
+		return handleError(c, err)
+	}
+	for _, group := range groups {
+		if _, err := s.updateGroupMembers(ctx, group, group.DisplayName, removeMembers(group.Payload.MemberIds, user.ID)); err != nil {
+			return handleError(c, err)
+		}
+	}
+	if !user.MemberDeleted {
+		deleted := true
+		if _, err := s.store.UpdateUser(ctx, user.ID, &store.UpdateUserMessage{Delete: &deleted}, api.SystemBotID); err != nil {
+			return handleError(c, err)
+		}
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// updateUser updates the email, the title and the activation of the user to the desired SCIM user.
+func (s *Service) updateUser(ctx context.Context, user *store.UserMessage, desired *User) (*store.UserMessage, error) {
+	patch := &store.UpdateUserMessage{}
+	if email := desired.getEmail(); email != "" && email != user.Email {
+		if err := validateEmail(email); err != nil {
+			return nil, err
+		}
+		existing, err := s.store.GetUser(ctx, &store.FindUserMessage{Email: &email, ShowDeleted: true})
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, newConflictError("Email %q is used by another user", email)
+		}
+		patch.Email = &email
+	}
+	if title := desired.getTitle(); title != "" && title != user.Name {
+		patch.Name = &title
+	}
+	if active := desired.isActive(); active == user.MemberDeleted {
+		if active {
+			if err := s.userCountGuard(ctx); err != nil {
+				return nil, err
+			}
+		} else {
+			if err := s.checkDeactivation(ctx, user); err != nil {
+				return nil, err
+			}
+		}
+		deleted := !active
+		patch.Delete = &deleted
+	}
+	if patch.Email == nil && patch.Name == nil && patch.Delete == nil {
+		return user, nil
+	}
+	return s.store.UpdateUser(ctx, user.ID, patch, api.SystemBotID)
+}
+
+// findUser finds the end user by the SCIM id.
+func (s *Service) findUser(ctx context.Context, id string) (*store.UserMessage, error) {
+	userID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, newNotFoundError("User %q not found", id)
+	}
+	user, err := s.store.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || user.Type != api.EndUser {
+		return nil, newNotFoundError("User %q not found", id)
+	}
+	return user, nil
+}
+
+// checkDeactivation refuses to deactivate the last active workspace owner, otherwise nobody could manage the workspace.
+func (s *Service) checkDeactivation(ctx context.Context, user *store.UserMessage) error {
+	if user.MemberDeleted || (user.Role != api.WorkspaceAdmin && !slices.Contains(user.Roles, api.WorkspaceAdmin)) {
+		return nil
+	}
+	role := api.WorkspaceAdmin
+	principalType := api.EndUser
+	owners, err := s.store.ListUsers(ctx, &store.FindUserMessage{Role: &role, Type: &principalType})
+	if err != nil {
+		return err
+	}
+	return checkLastWorkspaceOwner(user, owners)
+}
+
+// checkLastWorkspaceOwner returns an error if the user is the only one of the active workspace owners.
+func checkLastWorkspaceOwner(user *store.UserMessage, owners []*store.UserMessage) error {
+	for _, owner := range owners {
+		if owner.ID != user.ID {
+			return nil
+		}
+	}
+	return &scimError{status: http.StatusForbidden, detail: fmt.Sprintf("User %q is the last workspace owner and cannot be deactivated", user.Email)}
+}
+
+func (s *Service) userCountGuard(ctx context.Context) error {
+	userLimit := s.licenseService.GetPlanLimitValue(ctx, enterprise.PlanLimitMaximumUser)
+	count, err := s.store.CountActiveUsers(ctx)
+	if err != nil {
+		return err
+	}
+	if int64(count) >= userLimit {
+		return &scimError{status: http.StatusForbidden, detail: fmt.Sprintf("Reached the maximum user count %d", userLimit)}
+	}
+	return nil
+}
+
+func (s *Service) convertToSCIMUser(ctx context.Context, user *store.UserMessage) (*User, error) {
+	groups, err := s.store.ListSCIMGroups(ctx, &store.FindSCIMGroupMessage{MemberID: &user.ID})
+	if err != nil {
+		return nil, err
+	}
+	active := !user.MemberDeleted
+	id := strconv.Itoa(user.ID)
+	resource := &User{
+		Schemas:     []string{schemaUser},
+		ID:          id,
+		UserName:    user.Email,
+		DisplayName: user.Name,
+		Emails:      []*Email{{Value: user.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta: &Meta{
+			ResourceType: "User",
+			Location:     "Users/" + id,
+		},
+	}
+	for _, group := range groups {
+		resource.Groups = append(resource.Groups, &MemberRef{
+			Value:   group.ResourceID,
+			Display: group.DisplayName,
+		})
+	}
+	return resource, nil
+}
+
+// applyUserPatch applies the PATCH operations to the user.
+// Only the attributes mapped to the Bytebase user are patched, others are ignored.
+func applyUserPatch(user *User, operations []*PatchOperation) error {
+	for _, operation := range operations {
+		op := strings.ToLower(operation.Op)
+		if op != "add" && op != "replace" {
+			continue
+		}
+		path := strings.ToLower(operation.Path)
+		if path == "" {
+			// The value is the object of the attributes to replace.
+			var value User
+			if err := json.Unmarshal(operation.Value, &value); err != nil {
+				return newBadRequestError("invalidValue", "Invalid value of the %q operation: %v", operation.Op, err)
+			}
+			if value.UserName != "" {
+				user.UserName = value.UserName
+			}
+			if value.DisplayName != "" {
+				user.DisplayName = value.DisplayName
+			}
+			if value.Name != nil {
+				user.Name = value.Name
+			}
+			if len(value.Emails) > 0 {
+				user.Emails = value.Emails
+			}
+			if value.Active != nil {
+				user.Active = value.Active
+			}
+			continue
+		}
+
+		switch {
+		case path == "active":
+			active, err := unmarshalBool(operation.Value)
+			if err != nil {
+				return err
+			}
+			user.Active = &active
+		case path == "username":
+			if err := unmarshalString(operation.Value, &user.UserName); err != nil {
+				return err
+			}
+		case path == "displayname":
+			if err := unmarshalString(operation.Value, &user.DisplayName); err != nil {
+				return err
+			}
+		case strings.HasPrefix(path, "name."):
+			// The display name takes precedence over the name.
+			user.DisplayName = ""
+			if user.Name == nil {
+				user.Name = &Name{}
+			}
+			var field *string
+			switch strings.TrimPrefix(path, "name.") {
+			case "formatted":
+				field = &user.Name.Formatted
+			case "givenname":
+				field = &user.Name.GivenName
+			case "familyname":
+				field = &user.Name.FamilyName
+			default:
+				continue
+			}
+			if err := unmarshalString(operation.Value, field); err != nil {
+				return err
+			}
+		case strings.HasPrefix(path, "emails"):
+			// The path is "emails" with the list of emails, or the value path of an email such as `emails[type eq "work"].value`.
+			var emails []*Email
+			if err := json.Unmarshal(operation.Value, &emails); err == nil {
+				user.Emails = emails
+				continue
+			}
+			var email string
+			if err := unmarshalString(operation.Value, &email); err != nil {
+				return err
+			}
+			user.Emails = []*Email{{Value: email, Primary: true}}
+		}
+	}
+	return nil
+}
+
+// unmarshalBool unmarshals the boolean value, Azure AD sends the boolean as the string "True" or "False".
+func unmarshalBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b, nil
+		}
+	}
+	return false, newBadRequestError("invalidValue", "Invalid boolean value %s", value)
+}
+
+func unmarshalString(value json.RawMessage, s *string) error {
+	if err := json.Unmarshal(value, s); err != nil {
+		return newBadRequestError("invalidValue", "Invalid string value %s", value)
+	}
+	return nil
+}
+
+func validateEmail(email string) error {
+	if email == "" {
+		return newBadRequestError("invalidValue", "userName or emails is required")
+	}
+	if i := strings.Index(email, "@"); i <= 0 || i == len(email)-1 || strings.ContainsAny(email, " \t") {
+		return newBadRequestError("invalidValue", "Invalid email %q", email)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// sessionLastActiveInterval is the interval of updating the last active time of a session,
+// so that we don't write the database for every request.
+const sessionLastActiveInterval = 1 * time.Minute
+
+func (in *APIAuthInterceptor) authenticateSession(ctx context.Context, principalID int, sessionIDStr string) (*store.UserSessionMessage, error) {
+	// The access tokens signed before the session management don't have the session ID.
+	if sessionIDStr == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "session not found in the access token, please sign in again")
+	}
+	sessionID, err := strconv.Atoi(sessionIDStr)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "malformed session ID %q in the access token", sessionIDStr)
+	}
+	session, err := in.store.GetUserSession(ctx, &store.FindUserSessionMessage{UID: &sessionID, PrincipalUID: &principalID, ShowRevoked: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find session, error: %v", err)
+	}
+	if session == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "session %d not found", sessionID)
+	}
+	if session.RevokedTs != 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "session has been revoked")
+	}
+	now := time.Now()
+	if session.ExpireTs <= now.Unix() {
+		return nil, status.Errorf(codes.Unauthenticated, "session expired")
+	}
+	idleTimeout, err := GetSessionIdleTimeout(ctx, in.store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get session idle timeout, error: %v", err)
+	}
+	if !IsSessionActive(session, idleTimeout, now) {
+		return nil, status.Errorf(codes.Unauthenticated, "session expired due to inactivity")
+	}
+	if now.Unix()-session.LastActiveTs >= int64(sessionLastActiveInterval.Seconds()) {
+		if err := in.store.UpdateUserSessionLastActiveTs(ctx, session.UID, now.Unix()); err != nil {
+			slog.Warn("Failed to update the last active time of session", slog.Int("session", session.UID), log.BBError(err))
+		} else {
+			// Copy the session since the cached one is shared by the requests.
+			updated := *session
+			updated.LastActiveTs = now.Unix()
+			session = &updated
+		}
+	}
+	return session, nil
+}
+
+// IsSessionActive returns true if the session is neither revoked, expired, nor idle for the idle timeout at now.
+// There is no idle timeout if idleTimeout is 0.
+func IsSessionActive(session *store.UserSessionMessage, idleTimeout time.Duration, now time.Time) bool {
+	if session.RevokedTs != 0 || session.ExpireTs <= now.Unix() {
+		return false
+	}
+	if idleTimeout > 0 && now.Sub(time.Unix(session.LastActiveTs, 0)) > idleTimeout {
+		return false
+	}
+	return true
+}
+
+// GetSessionIdleTimeout returns the session idle timeout in the workspace profile setting.
+// It reads the cached setting since it's called for every request.
+func GetSessionIdleTimeout(ctx context.Context, s *store.Store) (time.Duration, error) {
+	settingName := api.SettingWorkspaceProfile
+	setting, err := s.GetSettingV2(ctx, &store.FindSettingMessage{Name: &settingName})
+	if err != nil {
+		return 0, err
+	}
+	if setting == nil {
+		return 0, nil
+	}
+	payload := new(storepb.WorkspaceProfileSetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return 0, err
+	}
+	if payload.SessionIdleTimeout == nil || payload.SessionIdleTimeout.Seconds <= 0 {
+		return 0, nil
+	}
+	return payload.SessionIdleTimeout.AsDuration(), nil
+}
+
+// GetClientInfoFromContext returns the IP address and the user agent of the client sending the request.
+// The requests proxied by the gRPC gateway carry them in the metadata.
+func GetClientInfoFromContext(ctx context.Context) (string, string) {
+	var ipAddress, userAgent string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get("x-forwarded-for"); len(v) > 0 {
+			// The first address is the original client.
+			ipAddress = strings.TrimSpace(strings.Split(v[0], ",")[0])
+		}
+		for _, key := range []string{"grpcgateway-user-agent", "user-agent"} {
+			if v := md.Get(key); len(v) > 0 {
+				userAgent = v[0]
+				break
+			}
+		}
+	}
+	if ipAddress == "" {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			ipAddress = p.Addr.String()
+			if host, _, err := net.SplitHostPort(ipAddress); err == nil {
+				ipAddress = host
+			}
+		}
+	}
+	return ipAddress, userAgent
+}
@@ -0,0 +1,358 @@
+package gitops
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	runnerutils "github.com/bytebase/bytebase/backend/runner/utils"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// dryRunStageResolvedAtRollout is the stage of the targets which are resolved when creating the rollout, e.g. the database group.
+const dryRunStageResolvedAtRollout = "Resolved at rollout"
+
+// dryRunIssue is the issue which will be created after the pull request is merged.
+type dryRunIssue struct {
+	project string
+	title   string
+	// sdl is true for the issue of the SDL schema file, whose changes have the schema diffs.
+	sdl     bool
+	changes []*dryRunChange
+	// warnings are the messages of the files ignored by the rollout.
+	warnings []string
+}
+
+// dryRunChange is the change of a file on a target in the rollout plan.
+type dryRunChange struct {
+	stage   string
+	target  string
+	file    string
+	version string
+	// diff is the schema diff generated from the SDL file.
+	diff string
+}
+
+// commentDryRunPlan posts the rollout plan of the pull request files and the SQL review findings as the pull request comment.
+// Nothing is created in Bytebase, the issues are only created after the pull request is merged.
+func (s *Service) commentDryRunPlan(ctx context.Context, oauthContext *common.OauthContext, repoInfoList []*repoInfo, pullRequestID string, prFiles []*vcs.PullRequestFile, adviceMap map[string][]advisor.Advice) {
+	repo := repoInfoList[0]
+	issues, err := s.dryRunPullRequest(ctx, oauthContext, repoInfoList, prFiles)
+	if err != nil {
+		slog.Warn("Failed to generate the rollout plan for the pull request",
+			slog.String("repository_id", repo.repository.ExternalID),
+			slog.String("pull_request", pullRequestID),
+			log.BBError(err),
+		)
+		return
+	}
+	if err := vcs.Get(repo.vcs.Type, vcs.ProviderConfig{}).CreatePullRequestComment(
+		ctx,
+		oauthContext,
+		repo.vcs.InstanceURL,
+		repo.repository.ExternalID,
+		pullRequestID,
+		renderDryRunPlan(issues, adviceMap),
+	); err != nil {
+		slog.Warn("Failed to comment the rollout plan on the pull request",
+			slog.String("repository_id", repo.repository.ExternalID),
+			slog.String("pull_request", pullRequestID),
+			log.BBError(err),
+		)
+	}
+}
+
+// dryRunPullRequest generates the issues which will be created by the files of the pull request after the merge.
+// It follows the same file grouping as processPushEvent, but doesn't create any sheet, plan or issue.
+func (s *Service) dryRunPullRequest(ctx context.Context, oauthContext *common.OauthContext, repoInfoList []*repoInfo, prFiles []*vcs.PullRequestFile) ([]*dryRunIssue, error) {
+	environments, err := s.store.ListEnvironmentV2(ctx, &store.FindEnvironmentMessage{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list environments")
+	}
+
+	var distinctFileList []vcs.DistinctFileItem
+	for _, prFile := range prFiles {
+		if prFile.IsDeleted {
+			continue
+		}
+		distinctFileList = append(distinctFileList, vcs.DistinctFileItem{
+			Commit:   vcs.Commit{ID: prFile.LastCommitID},
+			FileName: prFile.Path,
+			ItemType: vcs.FileItemTypeAdded,
+			IsYAML:   strings.HasSuffix(prFile.Path, ".yml"),
+		})
+	}
+
+	var issues []*dryRunIssue
+	for _, fileInfoListInProject := range groupFileInfoByRepo(distinctFileList, repoInfoList) {
+		for _, fileInfoListInDB := range groupFileInfoByDatabase(fileInfoListInProject) {
+			fileInfoListSorted := sortFilesBySchemaVersion(fileInfoListInDB)
+			repoInfo := fileInfoListSorted[0].repoInfo
+
+			// The migration files are applied in one issue per database, or one issue per file for the tenant project.
+			issue := &dryRunIssue{project: repoInfo.project.Title}
+			migrateType := "Change data"
+			for _, fileInfo := range fileInfoListSorted {
+				if fileInfo.fType == fileTypeSchema {
+					if repoInfo.project.SchemaChangeType == api.ProjectSchemaChangeTypeSDL {
+						issues = append(issues, s.dryRunSDLFile(ctx, oauthContext, environments, fileInfo))
+					}
+					continue
+				}
+				changes, err := s.dryRunMigrationFile(ctx, oauthContext, environments, fileInfo)
+				if err != nil {
+					issue.warnings = append(issue.warnings, fmt.Sprintf("Ignored file %q, %v.", fileInfo.item.FileName, err))
+					continue
+				}
+				if repoInfo.project.TenantMode == api.TenantModeTenant {
+					fileMigrateType := "Change data"
+					if fileInfo.migrationInfo.Type == db.Migrate {
+						fileMigrateType = "Alter schema"
+					}
+					issues = append(issues, &dryRunIssue{
+						project: repoInfo.project.Title,
+						title:   fmt.Sprintf(batchIssueNameTemplate, fileMigrateType, strings.ReplaceAll(fileInfo.migrationInfo.Description, "_", " ")),
+						changes: changes,
+					})
+					continue
+				}
+				if fileInfo.migrationInfo.Type == db.Migrate {
+					migrateType = "Alter schema"
+				}
+				issue.changes = append(issue.changes, changes...)
+			}
+			if len(issue.changes) == 0 && len(issue.warnings) == 0 {
+				continue
+			}
+			databaseName := fileInfoListSorted[0].migrationInfo.Database
+			description := strings.ReplaceAll(fileInfoListSorted[0].migrationInfo.Description, "_", " ")
+			issue.title = fmt.Sprintf(issueNameTemplate, databaseName, migrateType, description)
+			issues = append(issues, issue)
+		}
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].project != issues[j].project {
+			return issues[i].project < issues[j].project
+		}
+		return issues[i].title < issues[j].title
+	})
+	return issues, nil
+}
+
+// dryRunMigrationFile returns the changes of the migration file on the targets, ordered by the stages.
+func (s *Service) dryRunMigrationFile(ctx context.Context, oauthContext *common.OauthContext, environments []*store.EnvironmentMessage, fileInfo fileInfo) ([]*dryRunChange, error) {
+	repoInfo := fileInfo.repoInfo
+	file := strings.TrimPrefix(fileInfo.item.FileName, repoInfo.repository.BaseDirectory+"/")
+	version := fmt.Sprintf("%s-%s", fileInfo.migrationInfo.Version.Version, fileInfo.migrationInfo.Type.GetVersionTypeSuffix())
+
+	if repoInfo.databaseGroup != "" {
+		return []*dryRunChange{
+			{
+				stage:   dryRunStageResolvedAtRollout,
+				target:  fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, repoInfo.project.ResourceID, common.DatabaseGroupNamePrefix, repoInfo.databaseGroup),
+				file:    file,
+				version: version,
+			},
+		}, nil
+	}
+
+	var databases []*store.DatabaseMessage
+	if repoInfo.project.TenantMode == api.TenantModeTenant {
+		if !fileInfo.item.IsYAML {
+			return []*dryRunChange{
+				{
+					stage:   dryRunStageResolvedAtRollout,
+					target:  fmt.Sprintf("%s%s/deploymentConfigs/default", common.ProjectNamePrefix, repoInfo.project.ResourceID),
+					file:    file,
+					version: version,
+				},
+			}, nil
+		}
+		content, err := readPullRequestFileContent(ctx, oauthContext, fileInfo)
+		if err != nil {
+			return nil, err
+		}
+		var migrationFile MigrationFileYAML
+		if err := yaml.Unmarshal([]byte(content), &migrationFile); err != nil {
+			return nil, errors.Wrap(err, "failed to parse file content as YAML")
+		}
+		for _, database := range migrationFile.Databases {
+			dbList, err := s.findProjectDatabases(ctx, repoInfo.project.UID, database.Name, "")
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to find project database %q", database.Name)
+			}
+			databases = append(databases, dbList...)
+		}
+	} else {
+		dbList, err := s.findProjectDatabases(ctx, repoInfo.project.UID, fileInfo.migrationInfo.Database, fileInfo.migrationInfo.Environment)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find project databases")
+		}
+		databases = dbList
+	}
+
+	var changes []*dryRunChange
+	for _, database := range sortDatabasesByEnvironment(environments, databases) {
+		changes = append(changes, &dryRunChange{
+			stage:   getEnvironmentTitle(environments, database.EffectiveEnvironmentID),
+			target:  common.FormatDatabase(database.InstanceID, database.DatabaseName),
+			file:    file,
+			version: version,
+		})
+	}
+	return changes, nil
+}
+
+// dryRunSDLFile returns the issue of the SDL schema file with the schema diffs on the target databases.
+func (s *Service) dryRunSDLFile(ctx context.Context, oauthContext *common.OauthContext, environments []*store.EnvironmentMessage, fileInfo fileInfo) *dryRunIssue {
+	repoInfo := fileInfo.repoInfo
+	file := strings.TrimPrefix(fileInfo.item.FileName, repoInfo.repository.BaseDirectory+"/")
+	issue := &dryRunIssue{
+		project: repoInfo.project.Title,
+		title:   fmt.Sprintf(sdlIssueNameTemplate, fileInfo.migrationInfo.Database, "Alter schema"),
+		sdl:     true,
+	}
+
+	if repoInfo.project.TenantMode == api.TenantModeTenant {
+		issue.changes = append(issue.changes, &dryRunChange{
+			stage:  dryRunStageResolvedAtRollout,
+			target: fmt.Sprintf("%s%s/deploymentConfigs/default", common.ProjectNamePrefix, repoInfo.project.ResourceID),
+			file:   file,
+		})
+		return issue
+	}
+
+	sdl, err := readPullRequestFileContent(ctx, oauthContext, fileInfo)
+	if err != nil {
+		issue.warnings = append(issue.warnings, fmt.Sprintf("Ignored file %q, %v.", fileInfo.item.FileName, err))
+		return issue
+	}
+	databases, err := s.findProjectDatabases(ctx, repoInfo.project.UID, fileInfo.migrationInfo.Database, fileInfo.migrationInfo.Environment)
+	if err != nil {
+		issue.warnings = append(issue.warnings, fmt.Sprintf("Ignored file %q, %v.", fileInfo.item.FileName, err))
+		return issue
+	}
+	for _, database := range sortDatabasesByEnvironment(environments, databases) {
+		target := common.FormatDatabase(database.InstanceID, database.DatabaseName)
+		diff, err := func() (string, error) {
+			instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+			if err != nil {
+				return "", err
+			}
+			if instance == nil {
+				return "", errors.Errorf("instance %q not found", database.InstanceID)
+			}
+			return runnerutils.ComputeDatabaseSchemaDiff(ctx, instance, database, s.dbFactory, sdl)
+		}()
+		if err != nil {
+			issue.warnings = append(issue.warnings, fmt.Sprintf("Failed to compute the schema diff on %s, %v.", target, err))
+		}
+		issue.changes = append(issue.changes, &dryRunChange{
+			stage:  getEnvironmentTitle(environments, database.EffectiveEnvironmentID),
+			target: target,
+			file:   file,
+			diff:   diff,
+		})
+	}
+	return issue
+}
+
+// readPullRequestFileContent reads the content of the file at the last commit of the pull request.
+func readPullRequestFileContent(ctx context.Context, oauthContext *common.OauthContext, fileInfo fileInfo) (string, error) {
+	content, err := vcs.Get(fileInfo.repoInfo.vcs.Type, vcs.ProviderConfig{}).ReadFileContent(
+		ctx,
+		oauthContext,
+		fileInfo.repoInfo.vcs.InstanceURL,
+		fileInfo.repoInfo.repository.ExternalID,
+		fileInfo.item.FileName,
+		vcs.RefInfo{
+			RefType: vcs.RefTypeCommit,
+			RefName: fileInfo.item.Commit.ID,
+		},
+	)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read file content")
+	}
+	return content, nil
+}
+
+// sortDatabasesByEnvironment sorts the databases by the order of their environments, which is the order of the rollout stages.
+func sortDatabasesByEnvironment(environments []*store.EnvironmentMessage, databases []*store.DatabaseMessage) []*store.DatabaseMessage {
+	environmentOrder := make(map[string]int32)
+	for _, environment := range environments {
+		environmentOrder[environment.ResourceID] = environment.Order
+	}
+	sorted := append([]*store.DatabaseMessage{}, databases...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return environmentOrder[sorted[i].EffectiveEnvironmentID] < environmentOrder[sorted[j].EffectiveEnvironmentID]
+	})
+	return sorted
+}
+
+func getEnvironmentTitle(environments []*store.EnvironmentMessage, environmentID string) string {
+	for _, environment := range environments {
+		if environment.ResourceID == environmentID {
+			return environment.Title
+		}
+	}
+	return environmentID
+}
+
+// renderDryRunPlan renders the issues and the SQL review findings as the markdown comment, which looks like the output of "terraform plan".
+func renderDryRunPlan(issues []*dryRunIssue, adviceMap map[string][]advisor.Advice) string {
+	var sb strings.Builder
+	_, _ = sb.WriteString("### Bytebase Plan\n\n")
+	if len(issues) == 0 {
+		_, _ = sb.WriteString("No change will be rolled out after merging this pull request.\n\n")
+	} else {
+		_, _ = fmt.Fprintf(&sb, "Merging this pull request will create %d issue(s). Nothing is rolled out before the merge.\n\n", len(issues))
+	}
+	for _, issue := range issues {
+		_, _ = fmt.Fprintf(&sb, "#### %s: %s\n\n", issue.project, issue.title)
+		if len(issue.changes) > 0 {
+			_, _ = sb.WriteString("| Stage | Target | File | Version |\n| --- | --- | --- | --- |\n")
+			for _, change := range issue.changes {
+				version := change.version
+				if version == "" {
+					version = "-"
+				}
+				_, _ = fmt.Fprintf(&sb, "| %s | %s | %s | %s |\n", change.stage, change.target, change.file, version)
+			}
+			_, _ = sb.WriteString("\n")
+		}
+		for _, change := range issue.changes {
+			if !issue.sdl || change.stage == dryRunStageResolvedAtRollout {
+				continue
+			}
+			diff := strings.TrimSpace(change.diff)
+			if diff == "" {
+				_, _ = fmt.Fprintf(&sb, "No schema change on %s.\n\n", change.target)
+				continue
+			}
+			_, _ = fmt.Fprintf(&sb, "<details>\n<summary>Schema diff on %s</summary>\n\n```sql\n%s\n```\n\n</details>\n\n", change.target, diff)
+		}
+		for _, warning := range issue.warnings {
+			_, _ = fmt.Fprintf(&sb, "> :warning: %s\n\n", warning)
+		}
+	}
+
+	review, errorCount, warningCount := convertSQLAdviceToMarkdown(adviceMap)
+	if errorCount+warningCount == 0 {
+		_, _ = sb.WriteString("### Bytebase SQL Review\n\nSQL review passed.\n")
+	} else {
+		_, _ = sb.WriteString(review)
+	}
+	return sb.String()
+}
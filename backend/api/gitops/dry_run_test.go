@@ -0,0 +1,56 @@
+package gitops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+func TestRenderDryRunPlan(t *testing.T) {
+	issues := []*dryRunIssue{
+		{
+			project: "Payment",
+			title:   "[ledger] Alter schema: add index",
+			changes: []*dryRunChange{
+				{stage: "Test", target: "instances/test/databases/ledger", file: "ledger##0002##ddl.sql", version: "0002-ddl"},
+				{stage: "Prod", target: "instances/prod/databases/ledger", file: "ledger##0002##ddl.sql", version: "0002-ddl"},
+			},
+			warnings: []string{`Ignored file "ledger##0003##ddl.sql", failed to find project databases.`},
+		},
+		{
+			project: "Payment",
+			title:   "[ledger] Alter schema",
+			sdl:     true,
+			changes: []*dryRunChange{
+				{stage: "Test", target: "instances/test/databases/ledger", file: ".ledger##LATEST.sql", diff: "CREATE INDEX idx ON t(a);\n"},
+				{stage: "Prod", target: "instances/prod/databases/ledger", file: ".ledger##LATEST.sql"},
+			},
+		},
+	}
+	adviceMap := map[string][]advisor.Advice{
+		"ledger##0002##ddl.sql": {
+			{Status: advisor.Warn, Code: advisor.StatementNoWhere, Title: "statement.where.require", Content: "WHERE clause is required", Line: 3},
+		},
+	}
+
+	want := "### Bytebase Plan\n\n" +
+		"Merging this pull request will create 2 issue(s). Nothing is rolled out before the merge.\n\n" +
+		"#### Payment: [ledger] Alter schema: add index\n\n" +
+		"| Stage | Target | File | Version |\n| --- | --- | --- | --- |\n" +
+		"| Test | instances/test/databases/ledger | ledger##0002##ddl.sql | 0002-ddl |\n" +
+		"| Prod | instances/prod/databases/ledger | ledger##0002##ddl.sql | 0002-ddl |\n\n" +
+		"> :warning: Ignored file \"ledger##0003##ddl.sql\", failed to find project databases.\n\n" +
+		"#### Payment: [ledger] Alter schema\n\n" +
+		"| Stage | Target | File | Version |\n| --- | --- | --- | --- |\n" +
+		"| Test | instances/test/databases/ledger | .ledger##LATEST.sql | - |\n" +
+		"| Prod | instances/prod/databases/ledger | .ledger##LATEST.sql | - |\n\n" +
+		"<details>\n<summary>Schema diff on instances/test/databases/ledger</summary>\n\n```sql\nCREATE INDEX idx ON t(a);\n```\n\n</details>\n\n" +
+		"No schema change on instances/prod/databases/ledger.\n\n" +
+		"### Bytebase SQL Review\n\n| Level | File | Line | Rule | Message |\n| --- | --- | --- | --- | --- |\n" +
+		"| WARN | ledger##0002##ddl.sql | 3 | [statement.where.require](https://www.bytebase.com/docs/reference/error-code/advisor#202) | WHERE clause is required |\n"
+	assert.Equal(t, want, renderDryRunPlan(issues, adviceMap))
+
+	assert.Equal(t, "### Bytebase Plan\n\nNo change will be rolled out after merging this pull request.\n\n### Bytebase SQL Review\n\nSQL review passed.\n", renderDryRunPlan(nil, nil))
+}
@@ -13,6 +13,9 @@ type migrationDetail struct {
 	// databaseID is the ID of a database.
 	// This should be unset when a project is in tenant mode. The ProjectID is derived from IssueCreate.
 	databaseID int
+	// databaseGroup is the resource ID of the database group in the project.
+	// This is set when the file is routed to a database group, and databaseID should be unset.
+	databaseGroup string
 	// sheetID is the ID of a sheet. Statement and sheet ID is mutually exclusive.
 	sheetID int
 	// schemaVersion is parsed from VCS file name.
@@ -40,6 +40,7 @@ import (
 	// Register azure plugin.
 	"github.com/bytebase/bytebase/backend/plugin/vcs/azure"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/bitbucket"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gerrit"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/github"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/gitlab"
 	"github.com/bytebase/bytebase/backend/store"
@@ -576,6 +577,114 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 		return c.String(http.StatusOK, strings.Join(createdMessages, "\n"))
 	})
 
+	g.POST("/gerrit/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Failed to read webhook request").SetInternal(err)
+		}
+		var event gerrit.Event
+		if err := json.Unmarshal(body, &event); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Malformed Gerrit event").SetInternal(err)
+		}
+		// This shouldn't happen as we only set up webhook to receive change-merged and patchset-created events, just in case.
+		if event.Type != gerrit.EventChangeMerged && event.Type != gerrit.EventPatchSetCreated {
+			return c.String(http.StatusOK, fmt.Sprintf("Ignore the Gerrit event %q", event.Type))
+		}
+
+		// The Gerrit webhooks plugin doesn't sign the payload, so we verify the secret token in the webhook URL.
+		token := c.QueryParam("token")
+		ref := "refs/heads/" + event.Change.Branch
+		filter := func(repo *store.RepositoryMessage) (bool, error) {
+			if token != repo.WebhookSecretToken {
+				return false, nil
+			}
+			if event.Type == gerrit.EventPatchSetCreated && !repo.EnableSQLReviewCI {
+				return false, nil
+			}
+			return isWebhookEventBranch(ref, repo.BranchFilter)
+		}
+		repositoryList, err := s.filterRepository(ctx, c.Param("id"), event.Change.Project, filter)
+		if err != nil {
+			return err
+		}
+		if len(repositoryList) == 0 {
+			slog.Debug("Empty handle repo list. Ignore this Gerrit event.", slog.String("type", event.Type))
+			return c.String(http.StatusOK, "No repository matched")
+		}
+		repo := repositoryList[0]
+
+		oauthContext := &common.OauthContext{
+			ClientID:     repo.vcs.ApplicationID,
+			ClientSecret: repo.vcs.Secret,
+			AccessToken:  repo.repository.AccessToken,
+		}
+		changeFiles, err := gerrit.ListRevisionFile(ctx, &http.Client{}, oauthContext, repo.vcs.InstanceURL, event.GetChangeID(), event.PatchSet.Revision)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to list files of Gerrit change %q", event.GetChangeID())).SetInternal(err)
+		}
+
+		if event.Type == gerrit.EventPatchSetCreated {
+			setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find workspace setting").SetInternal(err)
+			}
+			sqlFileName2Advice := s.sqlAdviceForSQLFiles(ctx, oauthContext, repositoryList, changeFiles, setting.ExternalUrl)
+			review := convertSQLAdviceToGerritReview(sqlFileName2Advice)
+			if err := gerrit.SetReview(ctx, &http.Client{}, oauthContext, repo.vcs.InstanceURL, event.GetChangeID(), event.PatchSet.Revision, review); err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to post SQL review to Gerrit change %q", event.GetChangeID())).SetInternal(err)
+			}
+			if repo.repository.Payload.GetEnableDryRun() {
+				s.commentDryRunPlan(ctx, oauthContext, repositoryList, event.GetChangeID(), changeFiles, sqlFileName2Advice)
+			}
+			return c.String(http.StatusOK, review.Message)
+		}
+
+		// The merged commit may differ from the patch set revision depending on the submit type, e.g. rebase or cherry-pick,
+		// so we read the files from the merged commit.
+		commit := vcs.Commit{
+			ID:          event.NewRev,
+			Title:       event.Change.Subject,
+			Message:     event.Change.CommitMessage,
+			CreatedTs:   event.EventCreatedOn,
+			URL:         event.Change.URL,
+			AuthorName:  event.PatchSet.Author.Name,
+			AuthorEmail: event.PatchSet.Author.Email,
+		}
+		if commit.ID == "" {
+			commit.ID = event.PatchSet.Revision
+		}
+		for _, file := range changeFiles {
+			if file.IsDeleted {
+				continue
+			}
+			commit.AddedList = append(commit.AddedList, file.Path)
+		}
+
+		createdMessages, err := s.processPushEvent(
+			ctx,
+			oauthContext,
+			repositoryList,
+			vcs.PushEvent{
+				VCSType: vcs.Gerrit,
+				Ref:     ref,
+				// The change contains exactly the files to process, so we skip the commits diff.
+				Before:             strings.Repeat("0", 40),
+				After:              commit.ID,
+				RepositoryID:       event.Change.Project,
+				RepositoryURL:      repo.repository.WebURL,
+				RepositoryFullPath: event.Change.Project,
+				AuthorName:         event.Submitter.Name,
+				CommitList:         []vcs.Commit{commit},
+			},
+		)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to process merged Gerrit change %q", event.GetChangeID())).SetInternal(err)
+		}
+		return c.String(http.StatusOK, strings.Join(createdMessages, "\n"))
+	})
+
 	// id is the webhookEndpointID in repository
 	// This endpoint is generated and injected into GitHub action & GitLab CI during the VCS setup.
 	g.POST("/sql-review/:id", func(c echo.Context) error {
@@ -584,9 +693,17 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 		if err != nil {
 			return echo.NewHTTPError(http.StatusBadRequest, "Failed to read SQL review request").SetInternal(err)
 		}
+
+		var request api.VCSSQLReviewRequest
+		if err := json.Unmarshal(body, &request); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Malformed SQL review request").SetInternal(err)
+		}
+		// Don't log the request body containing the check run token.
 		slog.Debug("SQL review request received for VCS project",
 			slog.String("webhook_endpoint_id", c.Param("id")),
-			slog.String("request", string(body)),
+			slog.String("repository_id", request.RepositoryID),
+			slog.String("pull_request", request.PullRequestID),
+			slog.String("head_sha", request.HeadSHA),
 		)
 
 		setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
@@ -594,11 +711,6 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find workspace setting").SetInternal(err)
 		}
 
-		var request api.VCSSQLReviewRequest
-		if err := json.Unmarshal(body, &request); err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, "Malformed SQL review request").SetInternal(err)
-		}
-
 		workspaceID, err := s.store.GetWorkspaceID(ctx)
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
@@ -717,10 +829,17 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 		switch repo.vcs.Type {
 		case vcs.GitHub:
 			response = convertSQLAdviceToGitHubActionResult(sqlFileName2Advice)
+			if repo.repository.EnableSQLReviewCheckRun {
+				response.Content = s.createSQLReviewCheckRun(ctx, repo, &request, sqlFileName2Advice, response.Content)
+			}
 		case vcs.GitLab:
 			response = convertSQLAdviceToGitLabCIResult(sqlFileName2Advice)
 		case vcs.AzureDevOps:
 			response = convertSQLAdviceToGitLabCIResult(sqlFileName2Advice)
+			reportSQLReviewToAzurePullRequest(ctx, oauthContext, repo, request.PullRequestID, sqlFileName2Advice)
+		}
+		if repo.repository.Payload.GetEnableDryRun() {
+			s.commentDryRunPlan(ctx, oauthContext, repositoryList, request.PullRequestID, prFiles, sqlFileName2Advice)
 		}
 
 		slog.Debug("SQL review finished",
@@ -950,6 +1069,18 @@ func (s *Service) sqlAdviceForFile(
 		}, nil
 	}
 
+	if fileInfo.repoInfo.databaseGroup != "" {
+		return []advisor.Advice{
+			{
+				Status:  advisor.Warn,
+				Code:    advisor.Unsupported,
+				Title:   "Database group is not supported",
+				Content: fmt.Sprintf("File is routed to database group %s in project %s.", fileInfo.repoInfo.databaseGroup, fileInfo.repoInfo.project.Title),
+				Line:    1,
+			},
+		}, nil
+	}
+
 	// TODO(ed): findProjectDatabases doesn't support the tenant mode.
 	// We can use https://github.com/bytebase/bytebase/blob/main/server/issue.go#L691 to find databases in tenant mode project.
 	databases, err := s.findProjectDatabases(ctx, fileInfo.repoInfo.project.UID, fileInfo.migrationInfo.Database, fileInfo.migrationInfo.Environment)
@@ -1064,6 +1195,63 @@ type repoInfo struct {
 	repository *store.RepositoryMessage
 	project    *store.ProjectMessage
 	vcs        *store.ExternalVersionControlMessage
+	// routes are the resolved routing rules of the repository, which are evaluated before the file path template.
+	routes []*repositoryRoute
+	// databaseGroup is the resource ID of the database group in the project receiving the changes.
+	// It's only set for the files routed to a database group by the routing rule.
+	databaseGroup string
+}
+
+// repositoryRoute is the routing rule of the repository with the compiled patterns and the project receiving the changes.
+type repositoryRoute struct {
+	rule           *storepb.RepositoryRoutingRule
+	project        *store.ProjectMessage
+	pathGlob       *regexp.Regexp
+	versionPattern *regexp.Regexp
+}
+
+// getRepositoryRoutes resolves the routing rules of the repository. The rules with the missing project or invalid patterns are skipped.
+func (s *Service) getRepositoryRoutes(ctx context.Context, repo *store.RepositoryMessage) []*repositoryRoute {
+	var routes []*repositoryRoute
+	for _, rule := range repo.Payload.GetRoutingRules() {
+		project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+			ResourceID:  &rule.Project,
+			ShowDeleted: false,
+		})
+		if err != nil || project == nil {
+			slog.Warn("skipping routing rule due to missing project",
+				slog.String("project_resource_id", rule.Project),
+				slog.String("repository_external_id", repo.ExternalID),
+				log.BBError(err),
+			)
+			continue
+		}
+		pathGlob, err := api.CompileRepositoryPathGlob(rule.PathGlob)
+		if err != nil {
+			slog.Warn("skipping routing rule due to invalid path glob",
+				slog.String("path_glob", rule.PathGlob),
+				slog.String("repository_external_id", repo.ExternalID),
+				log.BBError(err),
+			)
+			continue
+		}
+		versionPattern, err := regexp.Compile(rule.VersionPattern)
+		if err != nil {
+			slog.Warn("skipping routing rule due to invalid version pattern",
+				slog.String("version_pattern", rule.VersionPattern),
+				slog.String("repository_external_id", repo.ExternalID),
+				log.BBError(err),
+			)
+			continue
+		}
+		routes = append(routes, &repositoryRoute{
+			rule:           rule,
+			project:        project,
+			pathGlob:       pathGlob,
+			versionPattern: versionPattern,
+		})
+	}
+	return routes
 }
 
 func (s *Service) filterRepository(ctx context.Context, webhookEndpointID string, pushEventRepositoryID string, filter repositoryFilter) ([]*repoInfo, error) {
@@ -1137,6 +1325,7 @@ func (s *Service) filterRepository(ctx context.Context, webhookEndpointID string
 			repository: repo,
 			project:    project,
 			vcs:        externalVCS,
+			routes:     s.getRepositoryRoutes(ctx, repo),
 		})
 	}
 	return filteredRepos, nil
@@ -1318,13 +1507,13 @@ func groupFileInfoByDatabase(fileInfoList []fileInfo) map[string][]fileInfo {
 	return dbID2FileInfoList
 }
 
-// groupFileInfoByRepo groups information for distinct files in the push event by their corresponding store.RepositoryMessage.
-// In a GitLab/GitHub monorepo, a user could create multiple projects and configure different base directory in the repository.
-// Bytebase will create a different store.RepositoryMessage for each project. If the user decides to do a migration in multiple directories at once,
-// the push event will trigger changes in multiple projects. So we first group the files into store.RepositoryMessage, and create issue(s) in
-// each project.
+// groupFileInfoByRepo groups information for distinct files in the push event by their corresponding project.
+// In a GitLab/GitHub monorepo, a user could create multiple projects and configure different base directory in the repository,
+// or route the files to multiple projects by the routing rules of one store.RepositoryMessage.
+// If the user decides to do a migration in multiple directories at once, the push event will trigger changes in multiple projects.
+// So we first group the files into projects, and create issue(s) in each project.
 func groupFileInfoByRepo(distinctFileList []vcs.DistinctFileItem, repoInfoList []*repoInfo) map[int][]fileInfo {
-	repoID2FileItemList := make(map[int][]fileInfo)
+	projectID2FileItemList := make(map[int][]fileInfo)
 	for _, item := range distinctFileList {
 		slog.Debug("Processing file", slog.String("file", item.FileName), slog.String("commit", item.Commit.ID))
 		migrationInfo, fType, repoInfo, err := getFileInfo(item, repoInfoList)
@@ -1335,14 +1524,14 @@ func groupFileInfoByRepo(distinctFileList []vcs.DistinctFileItem, repoInfoList [
 			)
 			continue
 		}
-		repoID2FileItemList[repoInfo.repository.UID] = append(repoID2FileItemList[repoInfo.repository.UID], fileInfo{
+		projectID2FileItemList[repoInfo.project.UID] = append(projectID2FileItemList[repoInfo.project.UID], fileInfo{
 			item:          item,
 			migrationInfo: migrationInfo,
 			fType:         fType,
 			repoInfo:      repoInfo,
 		})
 	}
-	return repoID2FileItemList
+	return projectID2FileItemList
 }
 
 type fileType int
@@ -1357,8 +1546,17 @@ const (
 // repositories and returns the parsed migration information, file change type
 // and a single matched repository. It returns an error when none or multiple
 // repositories are matched.
+// The routing rules of the repositories are evaluated first, and the file
+// matched by a rule is routed to the project of the rule.
 func getFileInfo(fileItem vcs.DistinctFileItem, repoInfoList []*repoInfo) (*db.MigrationInfo, fileType, *repoInfo, error) {
-	var migrationInfo *db.MigrationInfo
+	migrationInfo, routedRepoInfo, err := getRoutedFileInfo(fileItem, repoInfoList)
+	if err != nil {
+		return nil, fileTypeUnknown, nil, err
+	}
+	if routedRepoInfo != nil {
+		return migrationInfo, fileTypeMigration, routedRepoInfo, nil
+	}
+
 	var fType fileType
 	var fileRepositoryList []*repoInfo
 	for _, repoInfo := range repoInfoList {
@@ -1433,6 +1631,50 @@ func getFileInfo(fileItem vcs.DistinctFileItem, repoInfoList []*repoInfo) (*db.M
 	}
 }
 
+// getRoutedFileInfo evaluates the routing rules of the repositories in order, and returns the parsed migration information
+// and the repository routed to the project of the first matched rule. It returns nil repoInfo if no rule matches the file.
+func getRoutedFileInfo(fileItem vcs.DistinctFileItem, repoInfoList []*repoInfo) (*db.MigrationInfo, *repoInfo, error) {
+	for _, info := range repoInfoList {
+		if len(info.routes) == 0 {
+			continue
+		}
+		relativePath := fileItem.FileName
+		if baseDirectory := info.repository.BaseDirectory; baseDirectory != "" {
+			if !strings.HasPrefix(fileItem.FileName, baseDirectory+"/") {
+				continue
+			}
+			relativePath = strings.TrimPrefix(fileItem.FileName, baseDirectory+"/")
+		}
+		for _, route := range info.routes {
+			if !route.pathGlob.MatchString(relativePath) {
+				continue
+			}
+			if fileItem.IsYAML {
+				return nil, nil, errors.Errorf("YAML file %q is not supported by the routing rule %q", fileItem.FileName, route.rule.PathGlob)
+			}
+			allowOmitDatabaseName := route.rule.DatabaseGroup != "" || route.project.TenantMode == api.TenantModeTenant
+			mi, err := db.ParseMigrationInfoByPattern(relativePath, route.versionPattern, allowOmitDatabaseName)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "version pattern %q of the routing rule %q", route.rule.VersionPattern, route.rule.PathGlob)
+			}
+			if mi == nil {
+				return nil, nil, errors.Errorf("file %q matches the routing rule %q but not its version pattern %q", fileItem.FileName, route.rule.PathGlob, route.rule.VersionPattern)
+			}
+			if route.rule.DatabaseGroup != "" {
+				// The files routed to the same database group are grouped into one issue.
+				mi.Database = route.rule.DatabaseGroup
+			}
+			return mi, &repoInfo{
+				repository:    info.repository,
+				project:       route.project,
+				vcs:           info.vcs,
+				databaseGroup: route.rule.DatabaseGroup,
+			}, nil
+		}
+	}
+	return nil, nil, nil
+}
+
 // processFilesInProject attempts to create new issue(s) according to the repository type.
 // 1. For a state based project, we create one issue per schema file, and one issue for all of the rest migration files (if any).
 // 2. For a migration based project, we create one issue for all of the migration files. All schema files are ignored.
@@ -1582,7 +1824,23 @@ func sortFilesBySchemaVersion(fileInfoList []fileInfo) []fileInfo {
 
 func (s *Service) createIssueFromMigrationDetailsV2(ctx context.Context, project *store.ProjectMessage, issueName, issueDescription string, pushEvent vcs.PushEvent, creatorID int, migrationDetailList []*migrationDetail) error {
 	var steps []*v1pb.Plan_Step
-	if len(migrationDetailList) == 1 && migrationDetailList[0].databaseID == 0 {
+	if migrationDetailList[0].databaseGroup != "" {
+		// The files routed to the database group are applied in one step, and the rollout expands the group into the databases.
+		step := &v1pb.Plan_Step{}
+		for _, migrationDetail := range migrationDetailList {
+			step.Specs = append(step.Specs, &v1pb.Plan_Spec{
+				Config: &v1pb.Plan_Spec_ChangeDatabaseConfig{
+					ChangeDatabaseConfig: &v1pb.Plan_ChangeDatabaseConfig{
+						Type:          getChangeType(migrationDetail.migrationType),
+						Target:        fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, project.ResourceID, common.DatabaseGroupNamePrefix, migrationDetail.databaseGroup),
+						Sheet:         fmt.Sprintf("projects/%s/sheets/%d", project.ResourceID, migrationDetail.sheetID),
+						SchemaVersion: migrationDetail.schemaVersion.Version,
+					},
+				},
+			})
+		}
+		steps = []*v1pb.Plan_Step{step}
+	} else if len(migrationDetailList) == 1 && migrationDetailList[0].databaseID == 0 {
 		migrationDetail := migrationDetailList[0]
 		changeType := getChangeType(migrationDetail.migrationType)
 		steps = []*v1pb.Plan_Step{
@@ -1978,6 +2236,35 @@ func (s *Service) prepareIssueFromFile(
 			PushEvent: utils.ConvertVcsPushEvent(&pushEvent),
 		},
 	}
+	if repoInfo.databaseGroup != "" {
+		// The databases of the group are resolved when creating the rollout.
+		if fileInfo.item.ItemType != vcs.FileItemTypeAdded {
+			activityCreate := getIgnoredFileActivityCreate(repoInfo.project.UID, pushEvent, fileInfo.item.FileName, errors.Errorf("Modified file routed to database group %q is not supported", repoInfo.databaseGroup))
+			return nil, []*store.ActivityMessage{activityCreate}
+		}
+		sheet, err := s.store.CreateSheet(ctx, &store.SheetMessage{
+			CreatorID:  api.SystemBotID,
+			ProjectUID: repoInfo.project.UID,
+			Title:      fileInfo.item.FileName,
+			Statement:  content,
+			Visibility: store.ProjectSheet,
+			Source:     store.SheetFromBytebaseArtifact,
+			Type:       store.SheetForSQL,
+			Payload:    sheetPayload,
+		})
+		if err != nil {
+			activityCreate := getIgnoredFileActivityCreate(repoInfo.project.UID, pushEvent, fileInfo.item.FileName, errors.Wrap(err, "Failed to create a sheet"))
+			return nil, []*store.ActivityMessage{activityCreate}
+		}
+		return []*migrationDetail{
+			{
+				migrationType: fileInfo.migrationInfo.Type,
+				databaseGroup: repoInfo.databaseGroup,
+				sheetID:       sheet.UID,
+				schemaVersion: model.Version{Version: fmt.Sprintf("%s-%s", fileInfo.migrationInfo.Version.Version, fileInfo.migrationInfo.Type.GetVersionTypeSuffix())},
+			},
+		}, nil
+	}
 	if repoInfo.project.TenantMode == api.TenantModeTenant {
 		// A non-YAML file means the whole file content is the SQL statement
 		if !fileInfo.item.IsYAML {
@@ -2259,6 +2546,78 @@ func patchTask(ctx context.Context, stores *store.Store, activityManager *activi
 	return nil
 }
 
+// reportSQLReviewToAzurePullRequest reports the SQL advice as the status and the comment of the Azure DevOps pull request.
+// The failures are only logged because the pipeline reports the result anyway.
+func reportSQLReviewToAzurePullRequest(ctx context.Context, oauthContext *common.OauthContext, repo *repoInfo, pullRequestID string, adviceMap map[string][]advisor.Advice) {
+	comment, errorCount, warningCount := convertSQLAdviceToMarkdown(adviceMap)
+	status := &azure.PullRequestStatus{
+		State:       azure.PullRequestStatusSucceeded,
+		Description: "SQL review passed",
+		Context: &azure.PullRequestStatusContext{
+			Genre: azure.SQLReviewStatusGenre,
+			Name:  azure.SQLReviewStatusName,
+		},
+	}
+	if errorCount > 0 {
+		status.State = azure.PullRequestStatusFailed
+		status.Description = fmt.Sprintf("SQL review found %d error(s) and %d warning(s)", errorCount, warningCount)
+	} else if warningCount > 0 {
+		status.Description = fmt.Sprintf("SQL review found %d warning(s)", warningCount)
+	}
+	if err := azure.CreatePullRequestStatus(ctx, oauthContext, repo.repository.ExternalID, pullRequestID, status); err != nil {
+		slog.Warn("Failed to create SQL review status for Azure DevOps pull request",
+			slog.String("repository_id", repo.repository.ExternalID),
+			slog.String("pull_request", pullRequestID),
+			log.BBError(err),
+		)
+	}
+	if errorCount+warningCount == 0 {
+		return
+	}
+	if err := azure.CreatePullRequestComment(ctx, oauthContext, repo.repository.ExternalID, pullRequestID, comment); err != nil {
+		slog.Warn("Failed to create SQL review comment for Azure DevOps pull request",
+			slog.String("repository_id", repo.repository.ExternalID),
+			slog.String("pull_request", pullRequestID),
+			log.BBError(err),
+		)
+	}
+}
+
+// convertSQLAdviceToMarkdown converts the SQL advice map to the markdown comment of the pull request, and returns the error and warning count.
+func convertSQLAdviceToMarkdown(adviceMap map[string][]advisor.Advice) (string, int, int) {
+	var sb strings.Builder
+	errorCount, warningCount := 0, 0
+	_, _ = sb.WriteString("### Bytebase SQL Review\n\n| Level | File | Line | Rule | Message |\n| --- | --- | --- | --- | --- |\n")
+	for _, filePath := range getSQLAdviceFileList(adviceMap) {
+		for _, advice := range adviceMap[filePath] {
+			if advice.Code == 0 || advice.Status == advisor.Success {
+				continue
+			}
+			line := advice.Line
+			if line <= 0 {
+				line = 1
+			}
+			level := "WARN"
+			if advice.Status == advisor.Error {
+				level = "ERROR"
+				errorCount++
+			} else {
+				warningCount++
+			}
+			_, _ = fmt.Fprintf(&sb, "| %s | %s | %d | [%s](%s#%d) | %s |\n",
+				level,
+				filePath,
+				line,
+				advice.Title,
+				sqlReviewDocs,
+				advice.Code,
+				strings.ReplaceAll(strings.ReplaceAll(advice.Content, "|", "\\|"), "\n", " "),
+			)
+		}
+	}
+	return sb.String(), errorCount, warningCount
+}
+
 // convertSQLAdviceToGitLabCIResult will convert SQL advice map to GitLab test output format.
 // GitLab test report: https://docs.gitlab.com/ee/ci/testing/unit_test_reports.html
 // junit XML format: https://llg.cubic.org/docs/junit/
@@ -2377,6 +2736,118 @@ func convertSQLAdviceToGitHubActionResult(adviceMap map[string][]advisor.Advice)
 	}
 }
 
+// createSQLReviewCheckRun reports the SQL advice as the GitHub check run, and returns the GitHub action messages.
+// The check run replaces the annotations of the action messages, and the action messages are kept if the check run fails to create.
+func (*Service) createSQLReviewCheckRun(ctx context.Context, repo *repoInfo, request *api.VCSSQLReviewRequest, adviceMap map[string][]advisor.Advice, messageList []string) []string {
+	if request.HeadSHA == "" || request.CheckRunToken == "" {
+		return append(messageList, fmt.Sprintf("::warning::The SQL review check run is enabled, please update the workflow %s by setting up the SQL review CI again.", github.SQLReviewActionFilePath))
+	}
+	checkRun, err := github.CreateCheckRun(ctx, &http.Client{}, repo.vcs.InstanceURL, repo.repository.ExternalID, request.CheckRunToken, convertSQLAdviceToGitHubCheckRun(adviceMap, request.HeadSHA))
+	if err != nil {
+		slog.Warn("Failed to create SQL review check run",
+			slog.String("repository_id", request.RepositoryID),
+			slog.String("pull_request", request.PullRequestID),
+			log.BBError(err),
+		)
+		return append(messageList, fmt.Sprintf("::warning::Failed to create the SQL review check run: %s", strings.ReplaceAll(err.Error(), "\n", "%0A")))
+	}
+	return []string{fmt.Sprintf("::notice::The SQL review results are reported in the check run %s", checkRun.HTMLURL)}
+}
+
+// convertSQLAdviceToGitHubCheckRun converts the SQL advice to the completed GitHub check run with the annotations on the SQL files.
+// The check run fails on the errors to block the merge if it's required by the branch protection rules, and the warnings don't block the merge.
+func convertSQLAdviceToGitHubCheckRun(adviceMap map[string][]advisor.Advice, headSHA string) *github.CheckRunCreate {
+	var annotations []*github.CheckRunAnnotation
+	errorCount, warningCount := 0, 0
+	for _, filePath := range getSQLAdviceFileList(adviceMap) {
+		for _, advice := range adviceMap[filePath] {
+			if advice.Code == 0 || advice.Status == advisor.Success {
+				continue
+			}
+			line := advice.Line
+			if line <= 0 {
+				line = 1
+			}
+			level := github.CheckRunAnnotationWarning
+			if advice.Status == advisor.Error {
+				level = github.CheckRunAnnotationFailure
+				errorCount++
+			} else {
+				warningCount++
+			}
+			annotations = append(annotations, &github.CheckRunAnnotation{
+				Path:            filePath,
+				StartLine:       line,
+				EndLine:         line,
+				AnnotationLevel: level,
+				Title:           fmt.Sprintf("%s (%d)", advice.Title, advice.Code),
+				Message:         fmt.Sprintf("%s\nDoc: %s#%d", advice.Content, sqlReviewDocs, advice.Code),
+			})
+		}
+	}
+
+	conclusion, title := github.CheckRunSuccess, "SQL review passed"
+	switch {
+	case errorCount > 0:
+		conclusion, title = github.CheckRunFailure, fmt.Sprintf("SQL review found %d error(s) and %d warning(s)", errorCount, warningCount)
+	case warningCount > 0:
+		conclusion, title = github.CheckRunNeutral, fmt.Sprintf("SQL review found %d warning(s)", warningCount)
+	}
+	return &github.CheckRunCreate{
+		Name:       github.SQLReviewCheckRunName,
+		HeadSHA:    headSHA,
+		Status:     "completed",
+		Conclusion: conclusion,
+		Output: &github.CheckRunOutput{
+			Title:       title,
+			Summary:     fmt.Sprintf("Bytebase reviewed %d file(s) in the pull request. See the [SQL review rules](%s) for details.", len(adviceMap), sqlReviewDocs),
+			Annotations: annotations,
+		},
+	}
+}
+
+// convertSQLAdviceToGerritReview converts the SQL advice map to the Gerrit review with the inline comments,
+// and votes -1 on the label if there are errors, +1 otherwise.
+func convertSQLAdviceToGerritReview(adviceMap map[string][]advisor.Advice) *gerrit.ReviewInput {
+	comments := make(map[string][]gerrit.CommentInput)
+	errorCount, warningCount := 0, 0
+	for _, filePath := range getSQLAdviceFileList(adviceMap) {
+		for _, advice := range adviceMap[filePath] {
+			if advice.Code == 0 || advice.Status == advisor.Success {
+				continue
+			}
+			line := advice.Line
+			if line <= 0 {
+				line = 1
+			}
+			if advice.Status == advisor.Error {
+				errorCount++
+			} else {
+				warningCount++
+			}
+			comments[filePath] = append(comments[filePath], gerrit.CommentInput{
+				Line:       line,
+				Message:    fmt.Sprintf("[%s] %s (%d): %s\nDoc: %s#%d", advice.Status, advice.Title, advice.Code, advice.Content, sqlReviewDocs, advice.Code),
+				Unresolved: advice.Status == advisor.Error,
+			})
+		}
+	}
+
+	vote, message := 1, "Bytebase SQL review passed"
+	switch {
+	case errorCount > 0:
+		vote, message = -1, fmt.Sprintf("Bytebase SQL review found %d error(s) and %d warning(s)", errorCount, warningCount)
+	case warningCount > 0:
+		message = fmt.Sprintf("Bytebase SQL review found %d warning(s)", warningCount)
+	}
+	return &gerrit.ReviewInput{
+		Message:  message,
+		Labels:   map[string]int{gerrit.SQLReviewLabel: vote},
+		Comments: comments,
+		Tag:      "autogenerated:bytebase",
+	}
+}
+
 func getSQLAdviceFileList(adviceMap map[string][]advisor.Advice) []string {
 	fileList := []string{}
 	fileToErrorCount := map[string]int{}
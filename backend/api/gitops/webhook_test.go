@@ -1,6 +1,7 @@
 package gitops
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -10,6 +11,8 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gerrit"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/github"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/store/model"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -187,6 +190,61 @@ func TestVCSSQLReview_ConvertSQLAdviceToGitHubActionResult(t *testing.T) {
 	assert.Equal(t, expect, res.Content)
 }
 
+func TestVCSSQLReview_ConvertSQLAdviceToGitHubCheckRun(t *testing.T) {
+	a := require.New(t)
+	res := convertSQLAdviceToGitHubCheckRun(mockSQLAdviceMap, "sha")
+	a.Equal(github.SQLReviewCheckRunName, res.Name)
+	a.Equal("sha", res.HeadSHA)
+	a.Equal(github.CheckRunFailure, res.Conclusion)
+	a.Equal("SQL review found 2 error(s) and 2 warning(s)", res.Output.Title)
+	a.Equal([]*github.CheckRunAnnotation{
+		{Path: "file1.sql", StartLine: 1, EndLine: 1, AnnotationLevel: github.CheckRunAnnotationWarning, Title: "column.no-null (402)", Message: "Column \"id\" in \"public\".\"book\" cannot have NULL value\nDoc: https://www.bytebase.com/docs/reference/error-code/advisor#402"},
+		{Path: "file1.sql", StartLine: 2, EndLine: 2, AnnotationLevel: github.CheckRunAnnotationFailure, Title: "naming.index.idx (303)", Message: "Index in table \"tech_book\" mismatches the naming convention, expect \"^$|^idx_tech_book_id_name$\" but found \"tech_book_id_name\"\nDoc: https://www.bytebase.com/docs/reference/error-code/advisor#303"},
+		{Path: "file2.sql", StartLine: 1, EndLine: 1, AnnotationLevel: github.CheckRunAnnotationWarning, Title: "naming.table (301)", Message: "\"techBook\" mismatches table naming convention, naming format should be \"^[a-z]+(_[a-z]+)*$\"\nDoc: https://www.bytebase.com/docs/reference/error-code/advisor#301"},
+		{Path: "file2.sql", StartLine: 4, EndLine: 4, AnnotationLevel: github.CheckRunAnnotationFailure, Title: "naming.index.uk (304)", Message: "Unique key in table \"tech_book\" mismatches the naming convention, expect \"^$|^uk_tech_book_id_name$\" but found \"tech_book_id_name\"\nDoc: https://www.bytebase.com/docs/reference/error-code/advisor#304"},
+	}, res.Output.Annotations)
+
+	res = convertSQLAdviceToGitHubCheckRun(map[string][]advisor.Advice{
+		"file1.sql": {{Status: advisor.Success, Code: advisor.Ok, Title: "OK"}},
+	}, "sha")
+	a.Equal(github.CheckRunSuccess, res.Conclusion)
+	a.Empty(res.Output.Annotations)
+}
+
+func TestVCSSQLReview_ConvertSQLAdviceToMarkdown(t *testing.T) {
+	expect := `### Bytebase SQL Review
+
+| Level | File | Line | Rule | Message |
+| --- | --- | --- | --- | --- |
+| WARN | file1.sql | 1 | [column.no-null](https://www.bytebase.com/docs/reference/error-code/advisor#402) | Column "id" in "public"."book" cannot have NULL value |
+| ERROR | file1.sql | 2 | [naming.index.idx](https://www.bytebase.com/docs/reference/error-code/advisor#303) | Index in table "tech_book" mismatches the naming convention, expect "^$\|^idx_tech_book_id_name$" but found "tech_book_id_name" |
+| WARN | file2.sql | 1 | [naming.table](https://www.bytebase.com/docs/reference/error-code/advisor#301) | "techBook" mismatches table naming convention, naming format should be "^[a-z]+(_[a-z]+)*$" |
+| ERROR | file2.sql | 4 | [naming.index.uk](https://www.bytebase.com/docs/reference/error-code/advisor#304) | Unique key in table "tech_book" mismatches the naming convention, expect "^$\|^uk_tech_book_id_name$" but found "tech_book_id_name" |
+`
+	res, errorCount, warningCount := convertSQLAdviceToMarkdown(mockSQLAdviceMap)
+	assert.Equal(t, expect, res)
+	assert.Equal(t, 2, errorCount)
+	assert.Equal(t, 2, warningCount)
+}
+
+func TestVCSSQLReview_ConvertSQLAdviceToGerritReview(t *testing.T) {
+	review := convertSQLAdviceToGerritReview(mockSQLAdviceMap)
+	assert.Equal(t, "Bytebase SQL review found 2 error(s) and 2 warning(s)", review.Message)
+	assert.Equal(t, map[string]int{gerrit.SQLReviewLabel: -1}, review.Labels)
+	require.Len(t, review.Comments["file1.sql"], 2)
+	require.Len(t, review.Comments["file2.sql"], 2)
+	assert.Equal(t, gerrit.CommentInput{
+		Line:       4,
+		Message:    "[ERROR] naming.index.uk (304): Unique key in table \"tech_book\" mismatches the naming convention, expect \"^$|^uk_tech_book_id_name$\" but found \"tech_book_id_name\"\nDoc: https://www.bytebase.com/docs/reference/error-code/advisor#304",
+		Unresolved: true,
+	}, review.Comments["file2.sql"][1])
+	assert.False(t, review.Comments["file1.sql"][0].Unresolved)
+
+	review = convertSQLAdviceToGerritReview(map[string][]advisor.Advice{})
+	assert.Equal(t, "Bytebase SQL review passed", review.Message)
+	assert.Equal(t, map[string]int{gerrit.SQLReviewLabel: 1}, review.Labels)
+}
+
 func TestGetFileInfo(t *testing.T) {
 	t.Run("a SQL format DDL", func(t *testing.T) {
 		mi, fileType, repoInfo, err := getFileInfo(
@@ -362,6 +420,96 @@ func TestGetFileInfo(t *testing.T) {
 		)
 		require.EqualError(t, err, "file change should be associated with exactly one project but found project-1, project-2")
 	})
+
+	t.Run("routing rules", func(t *testing.T) {
+		newRoute := func(pathGlob, versionPattern string, project *store.ProjectMessage, databaseGroup string) *repositoryRoute {
+			glob, err := api.CompileRepositoryPathGlob(pathGlob)
+			require.NoError(t, err)
+			return &repositoryRoute{
+				rule: &storepb.RepositoryRoutingRule{
+					PathGlob:       pathGlob,
+					Project:        project.ResourceID,
+					DatabaseGroup:  databaseGroup,
+					VersionPattern: versionPattern,
+				},
+				project:        project,
+				pathGlob:       glob,
+				versionPattern: regexp.MustCompile(versionPattern),
+			}
+		}
+		repoProject := &store.ProjectMessage{UID: 1, ResourceID: "platform"}
+		paymentProject := &store.ProjectMessage{UID: 2, ResourceID: "payment"}
+		orderProject := &store.ProjectMessage{UID: 3, ResourceID: "order"}
+		repoInfoList := []*repoInfo{
+			{
+				repository: &store.RepositoryMessage{
+					UID:              1,
+					BaseDirectory:    "bytebase",
+					FilePathTemplate: "{{DB_NAME}}##{{VERSION}}##{{TYPE}}.sql",
+				},
+				project: repoProject,
+				vcs:     &store.ExternalVersionControlMessage{},
+				routes: []*repositoryRoute{
+					newRoute("services/payment/**/*.sql", `(?P<DB_NAME>\w+)__(?P<VERSION>\d+)__(?P<TYPE>migrate|data)\.sql$`, paymentProject, ""),
+					newRoute("services/order/*.sql", `(?P<VERSION>\d+)__(?P<DESCRIPTION>\w+)\.sql$`, orderProject, "shards"),
+				},
+			},
+		}
+
+		mi, fileType, got, err := getFileInfo(
+			vcs.DistinctFileItem{
+				FileName: "bytebase/services/payment/prod/ledger__0002__data.sql",
+				ItemType: vcs.FileItemTypeAdded,
+			},
+			repoInfoList,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, fileTypeMigration, fileType)
+		assert.Equal(t, paymentProject, got.project)
+		assert.Equal(t, 1, got.repository.UID)
+		assert.Equal(t, "", got.databaseGroup)
+		assert.Equal(t, &db.MigrationInfo{
+			Version:     model.Version{Version: "0002"},
+			Namespace:   "ledger",
+			Database:    "ledger",
+			Source:      db.VCS,
+			Type:        db.Data,
+			Description: "Create ledger data change",
+		}, mi)
+
+		mi, _, got, err = getFileInfo(
+			vcs.DistinctFileItem{
+				FileName: "bytebase/services/order/0003__add_index.sql",
+				ItemType: vcs.FileItemTypeAdded,
+			},
+			repoInfoList,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, orderProject, got.project)
+		assert.Equal(t, "shards", got.databaseGroup)
+		assert.Equal(t, "shards", mi.Database)
+		assert.Equal(t, "0003", mi.Version.Version)
+
+		// The files not matched by any rule fall back to the file path template.
+		_, _, got, err = getFileInfo(
+			vcs.DistinctFileItem{
+				FileName: "bytebase/db##0001##migrate.sql",
+				ItemType: vcs.FileItemTypeAdded,
+			},
+			repoInfoList,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, repoProject, got.project)
+
+		_, _, _, err = getFileInfo(
+			vcs.DistinctFileItem{
+				FileName: "bytebase/services/payment/README.sql",
+				ItemType: vcs.FileItemTypeAdded,
+			},
+			repoInfoList,
+		)
+		require.Error(t, err)
+	})
 }
 
 func TestExtractDBTypeFromJDBCConnectionString(t *testing.T) {
@@ -0,0 +1,259 @@
+// Package slack is the package for the Bytebase Slack app APIs, answering the approval buttons and the slash commands.
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gosimple/slug"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/bytebase/bytebase/backend/api/v1"
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	slackplugin "github.com/bytebase/bytebase/backend/plugin/app/slack"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const commandUsage = "Usage: `/bytebase issue <id>` shows the status of the issue."
+
+// Service is the API endpoint for handling the Slack app requests.
+type Service struct {
+	store        *store.Store
+	iamManager   *iam.Manager
+	issueService *apiv1.IssueService
+}
+
+// NewService creates a Slack service.
+func NewService(store *store.Store, iamManager *iam.Manager, issueService *apiv1.IssueService) *Service {
+	return &Service{
+		store:        store,
+		iamManager:   iamManager,
+		issueService: issueService,
+	}
+}
+
+// interactionPayload is the payload of the Slack block actions.
+type interactionPayload struct {
+	Type string `json:"type"`
+	User struct {
+		ID string `json:"id"`
+	} `json:"user"`
+	ResponseURL string `json:"response_url"`
+	Actions     []struct {
+		ActionID string `json:"action_id"`
+		Value    string `json:"value"`
+	} `json:"actions"`
+}
+
+// RegisterWebhookRoutes registers the routes of the Slack app interactivity and slash command request URLs.
+func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
+	g.POST("/slack/interactions", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		setting, form, err := s.verifyRequest(c)
+		if err != nil {
+			return err
+		}
+		var payload interactionPayload
+		if err := json.Unmarshal([]byte(form.Get("payload")), &payload); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Malformed Slack interaction payload").SetInternal(err)
+		}
+		if payload.Type != "block_actions" {
+			return c.NoContent(http.StatusOK)
+		}
+
+		client := slackplugin.NewClient(setting.BotToken)
+		for _, action := range payload.Actions {
+			if action.ActionID != webhook.SlackActionApproveIssue && action.ActionID != webhook.SlackActionRejectIssue {
+				continue
+			}
+			message := s.handleApprovalAction(ctx, client, payload.User.ID, action.ActionID, action.Value)
+			if err := client.Respond(payload.ResponseURL, message); err != nil {
+				slog.Warn("Failed to respond to Slack interaction", log.BBError(err))
+			}
+		}
+		return c.NoContent(http.StatusOK)
+	})
+
+	g.POST("/slack/commands", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		setting, form, err := s.verifyRequest(c)
+		if err != nil {
+			return err
+		}
+		text := s.handleCommand(ctx, slackplugin.NewClient(setting.BotToken), form.Get("user_id"), form.Get("text"))
+		return c.JSON(http.StatusOK, &slackplugin.Message{
+			ResponseType: "ephemeral",
+			Text:         text,
+		})
+	})
+}
+
+// verifyRequest verifies the request is signed by the configured Slack app, and returns the Slack app setting and the request form.
+func (s *Service) verifyRequest(c echo.Context) (*storepb.SlackAppSetting, url.Values, error) {
+	setting, err := s.store.GetSlackAppSetting(c.Request().Context())
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get Slack app setting").SetInternal(err)
+	}
+	if setting.SigningSecret == "" {
+		return nil, nil, echo.NewHTTPError(http.StatusNotFound, "Slack app is not configured")
+	}
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest, "Failed to read Slack request").SetInternal(err)
+	}
+	if err := slackplugin.VerifyRequest(setting.SigningSecret, c.Request().Header, body, time.Now()); err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusUnauthorized, "Invalid Slack request").SetInternal(err)
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest, "Malformed Slack request").SetInternal(err)
+	}
+	return setting, form, nil
+}
+
+// handleApprovalAction approves or rejects the issue as the Bytebase user of the Slack user, and returns the message responded to Slack.
+func (s *Service) handleApprovalAction(ctx context.Context, client *slackplugin.Client, slackUserID, actionID, value string) *slackplugin.Message {
+	user, reply := s.getUser(ctx, client, slackUserID)
+	if user == nil {
+		return &slackplugin.Message{ResponseType: "ephemeral", Text: reply}
+	}
+	issueUID, err := strconv.Atoi(value)
+	if err != nil {
+		return &slackplugin.Message{ResponseType: "ephemeral", Text: fmt.Sprintf("Invalid issue %q.", value)}
+	}
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issueUID})
+	if err != nil {
+		slog.Error("Failed to get issue", slog.Int("issue", issueUID), log.BBError(err))
+		return &slackplugin.Message{ResponseType: "ephemeral", Text: fmt.Sprintf("Failed to get issue #%d.", issueUID)}
+	}
+	if issue == nil {
+		return &slackplugin.Message{ResponseType: "ephemeral", Text: fmt.Sprintf("Issue #%d not found.", issueUID)}
+	}
+
+	// The approval is made as the Bytebase user, so that the reviewer permission of the user is checked by the issue service.
+	userCtx := context.WithValue(ctx, common.PrincipalIDContextKey, user.ID)
+	issueName := fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, issue.Project.ResourceID, common.IssueNamePrefix, issue.UID)
+	verb := "approved"
+	if actionID == webhook.SlackActionApproveIssue {
+		_, err = s.issueService.ApproveIssue(userCtx, &v1pb.ApproveIssueRequest{Name: issueName, Comment: "Approved in Slack."})
+	} else {
+		verb = "rejected"
+		_, err = s.issueService.RejectIssue(userCtx, &v1pb.RejectIssueRequest{Name: issueName, Comment: "Rejected in Slack."})
+	}
+	if err != nil {
+		return &slackplugin.Message{ResponseType: "ephemeral", Text: fmt.Sprintf("Failed to update the approval of issue #%d: %s", issue.UID, status.Convert(err).Message())}
+	}
+	return &slackplugin.Message{ResponseType: "in_channel", Text: fmt.Sprintf("<@%s> %s issue #%d %q.", slackUserID, verb, issue.UID, issue.Title)}
+}
+
+// handleCommand handles the "/bytebase" slash command, and returns the text responded to the Slack user.
+func (s *Service) handleCommand(ctx context.Context, client *slackplugin.Client, slackUserID, text string) string {
+	issueUID, err := parseIssueCommand(text)
+	if err != nil {
+		return fmt.Sprintf("Failed to parse the command, %s.\n%s", err.Error(), commandUsage)
+	}
+	user, reply := s.getUser(ctx, client, slackUserID)
+	if user == nil {
+		return reply
+	}
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issueUID})
+	if err != nil {
+		slog.Error("Failed to get issue", slog.Int("issue", issueUID), log.BBError(err))
+		return fmt.Sprintf("Failed to get issue #%d.", issueUID)
+	}
+	notFound := fmt.Sprintf("Issue #%d not found.", issueUID)
+	if issue == nil {
+		return notFound
+	}
+	ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionIssuesGet, user, issue.Project.ResourceID)
+	if err != nil {
+		slog.Error("Failed to check permission", log.BBError(err))
+		return fmt.Sprintf("Failed to get issue #%d.", issueUID)
+	}
+	if !ok {
+		// The issues of the projects the user cannot access are reported as not found, not to leak their existence.
+		return notFound
+	}
+
+	lines := []string{
+		fmt.Sprintf("*#%d %s*", issue.UID, issue.Title),
+		fmt.Sprintf("*Project:* %s", issue.Project.Title),
+		fmt.Sprintf("*Status:* %s", issue.Status),
+		fmt.Sprintf("*Approval:* %s", getApprovalStatus(issue.Payload.GetApproval())),
+	}
+	generalSetting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		slog.Warn("Failed to get workspace general setting", log.BBError(err))
+	} else if generalSetting.ExternalUrl != "" {
+		lines = append(lines, fmt.Sprintf("%s/issue/%s-%d", generalSetting.ExternalUrl, slug.Make(issue.Title), issue.UID))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// getUser gets the active Bytebase user with the same email as the Slack user.
+// The message replied to the Slack user is returned if the user is not found.
+func (s *Service) getUser(ctx context.Context, client *slackplugin.Client, slackUserID string) (*store.UserMessage, string) {
+	email, err := client.GetUserEmail(slackUserID)
+	if err != nil {
+		slog.Warn("Failed to get Slack user email", log.BBError(err))
+		return nil, "Failed to get the email of your Slack account."
+	}
+	email = strings.ToLower(email)
+	user, err := s.store.GetUser(ctx, &store.FindUserMessage{Email: &email})
+	if err != nil {
+		slog.Error("Failed to get user", log.BBError(err))
+		return nil, "Failed to get your Bytebase user."
+	}
+	if user == nil || user.MemberDeleted {
+		return nil, fmt.Sprintf("No active Bytebase user has the email %s of your Slack account.", email)
+	}
+	return user, ""
+}
+
+// parseIssueCommand parses the "issue <id>" text of the slash command.
+func parseIssueCommand(text string) (int, error) {
+	fields := strings.Fields(text)
+	if len(fields) != 2 || fields[0] != "issue" {
+		return 0, errors.Errorf("unknown command %q", text)
+	}
+	issueUID, err := strconv.Atoi(strings.TrimPrefix(fields[1], "#"))
+	if err != nil || issueUID <= 0 {
+		return 0, errors.Errorf("invalid issue ID %q", fields[1])
+	}
+	return issueUID, nil
+}
+
+func getApprovalStatus(approval *storepb.IssuePayloadApproval) string {
+	if approval == nil || !approval.ApprovalFindingDone {
+		return "checking"
+	}
+	if approval.ApprovalFindingError != "" {
+		return "error"
+	}
+	if len(approval.ApprovalTemplates) != 1 {
+		return "not required"
+	}
+	if utils.FindRejectedStep(approval.ApprovalTemplates[0], approval.Approvers) != nil {
+		return "rejected"
+	}
+	if utils.FindNextPendingStep(approval.ApprovalTemplates[0], approval.Approvers) != nil {
+		return "pending"
+	}
+	return "approved"
+}
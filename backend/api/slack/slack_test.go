@@ -0,0 +1,34 @@
+package slack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIssueCommand(t *testing.T) {
+	a := require.New(t)
+
+	tests := []struct {
+		text    string
+		want    int
+		wantErr bool
+	}{
+		{text: "issue 123", want: 123},
+		{text: "  issue   #42 ", want: 42},
+		{text: "issue", wantErr: true},
+		{text: "issue abc", wantErr: true},
+		{text: "issue 0", wantErr: true},
+		{text: "issues 1", wantErr: true},
+		{text: "", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := parseIssueCommand(test.text)
+		if test.wantErr {
+			a.Error(err, test.text)
+			continue
+		}
+		a.NoError(err, test.text)
+		a.Equal(test.want, got, test.text)
+	}
+}
@@ -58,6 +58,9 @@ func (in *ACLInterceptor) ACLInterceptor(ctx context.Context, request any, serve
 	if user == nil {
 		return nil, status.Errorf(codes.Unauthenticated, "unauthenticated for method %q", serverInfo.FullMethod)
 	}
+	if err := in.checkAPITokenScope(ctx, serverInfo.FullMethod, request); err != nil {
+		return nil, err
+	}
 	if isOwnerOrDBA(user.Role) {
 		return handler(ctx, request)
 	}
@@ -90,6 +93,9 @@ func (in *ACLInterceptor) ACLStreamInterceptor(request any, ss grpc.ServerStream
 	if user == nil {
 		return status.Errorf(codes.Unauthenticated, "unauthenticated for method %q", serverInfo.FullMethod)
 	}
+	if err := in.checkAPITokenScope(ctx, serverInfo.FullMethod, request); err != nil {
+		return err
+	}
 	if isOwnerOrDBA(user.Role) {
 		return handler(request, ss)
 	}
@@ -112,7 +118,13 @@ func (s overrideStream) Context() context.Context {
 
 func (in *ACLInterceptor) aclInterceptorDo(ctx context.Context, fullMethod string, request any, user *store.UserMessage) error {
 	if isOwnerAndDBAMethod(fullMethod) {
-		return status.Errorf(codes.PermissionDenied, "only workspace owner and DBA can access method %q", fullMethod)
+		ok, err := in.hasCustomRolePermission(ctx, fullMethod, request, user)
+		if err != nil {
+			return status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		if !ok {
+			return status.Errorf(codes.PermissionDenied, "only workspace owner and DBA can access method %q", fullMethod)
+		}
 	}
 
 	if isProjectOwnerMethod(fullMethod) {
@@ -125,7 +137,14 @@ func (in *ACLInterceptor) aclInterceptorDo(ctx context.Context, fullMethod strin
 			if err != nil {
 				return status.Errorf(codes.PermissionDenied, err.Error())
 			}
-			if !projectRoles[api.ProjectOwner] {
+			if projectRoles[api.ProjectOwner] {
+				continue
+			}
+			ok, err := in.hasCustomRolePermission(ctx, fullMethod, request, user)
+			if err != nil {
+				return status.Errorf(codes.PermissionDenied, err.Error())
+			}
+			if !ok {
 				return status.Errorf(codes.PermissionDenied, "only the owner of project %q can access method %q", projectID, fullMethod)
 			}
 		}
@@ -154,6 +173,24 @@ func (in *ACLInterceptor) aclInterceptorDo(ctx context.Context, fullMethod strin
 	return nil
 }
 
+// hasCustomRolePermission returns whether the custom roles of the user grant the permission of the method,
+// in the workspace or in the projects the request accesses.
+func (in *ACLInterceptor) hasCustomRolePermission(ctx context.Context, fullMethod string, request any, user *store.UserMessage) (bool, error) {
+	p := methodPermissionMap[fullMethod]
+	if p == "" {
+		return false, nil
+	}
+	projectIDsGetter := in.getProjectIDsGetter(fullMethod)
+	if projectIDsGetter == nil {
+		return false, nil
+	}
+	projectIDs, err := projectIDsGetter(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	return in.iamManager.CheckCustomRolePermission(ctx, p, user, projectIDs...)
+}
+
 func (in *ACLInterceptor) getUser(ctx context.Context) (*store.UserMessage, error) {
 	principalPtr := ctx.Value(common.PrincipalIDContextKey)
 	if principalPtr == nil {
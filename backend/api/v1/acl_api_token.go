@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// checkAPITokenScope checks the scopes and the projects of the API token authenticating the request.
+// It's checked before the role-based checks, so the API tokens of the workspace owners and DBAs are restricted as well.
+func (in *ACLInterceptor) checkAPITokenScope(ctx context.Context, fullMethod string, request any) error {
+	apiToken, ok := ctx.Value(common.APITokenContextKey).(*store.APITokenMessage)
+	if !ok {
+		return nil
+	}
+	if !isAPITokenScopeAllowed(apiToken.Payload.Scopes, fullMethod) {
+		return status.Errorf(codes.PermissionDenied, "the scopes of the API token do not allow method %q", fullMethod)
+	}
+	if len(apiToken.Payload.Projects) == 0 {
+		return nil
+	}
+
+	projectIDs, err := in.getAPITokenProjectIDs(ctx, fullMethod, request)
+	if err != nil {
+		return status.Errorf(codes.PermissionDenied, err.Error())
+	}
+	if len(projectIDs) == 0 {
+		return status.Errorf(codes.PermissionDenied, "the API token is restricted to projects, but method %q does not access a specific project", fullMethod)
+	}
+	for _, projectID := range projectIDs {
+		if !slices.Contains(apiToken.Payload.Projects, projectID) {
+			return status.Errorf(codes.PermissionDenied, "the API token is not allowed to access project %q", projectID)
+		}
+	}
+	return nil
+}
+
+func isAPITokenScopeAllowed(scopes []storepb.APITokenPayload_Scope, fullMethod string) bool {
+	for _, scope := range scopes {
+		switch scope {
+		case storepb.APITokenPayload_ALL:
+			return true
+		case storepb.APITokenPayload_READ_ONLY:
+			if isReadOnlyMethod(fullMethod) {
+				return true
+			}
+		case storepb.APITokenPayload_SQL_CHECK:
+			if fullMethod == v1pb.SQLService_Check_FullMethodName {
+				return true
+			}
+		case storepb.APITokenPayload_ISSUE_CREATE:
+			switch fullMethod {
+			case
+				v1pb.SheetService_CreateSheet_FullMethodName,
+				v1pb.RolloutService_CreatePlan_FullMethodName,
+				v1pb.IssueService_CreateIssue_FullMethodName:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isReadOnlyMethod returns whether the method only reads the resources by its name.
+func isReadOnlyMethod(fullMethod string) bool {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range []string{"Get", "BatchGet", "List", "Search"} {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// getAPITokenProjectIDs returns the IDs of the projects the request accesses.
+// The project getters of the IAM check are used if available, otherwise the projects are
+// derived from the parent, name and database of the request.
+func (in *ACLInterceptor) getAPITokenProjectIDs(ctx context.Context, fullMethod string, request any) ([]string, error) {
+	if getter := in.getProjectIDsGetter(fullMethod); getter != nil {
+		projectIDs, err := getter(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		if len(projectIDs) > 0 {
+			return projectIDs, nil
+		}
+	}
+
+	var resources []string
+	if r, ok := request.(interface{ GetParent() string }); ok {
+		resources = append(resources, r.GetParent())
+	}
+	if r, ok := request.(interface{ GetName() string }); ok {
+		resources = append(resources, r.GetName())
+	}
+	if r, ok := request.(interface{ GetDatabase() string }); ok {
+		resources = append(resources, r.GetDatabase())
+	}
+	var projectIDs []string
+	for _, resource := range resources {
+		projectID, err := in.getResourceProjectID(ctx, resource)
+		if err != nil {
+			return nil, err
+		}
+		if projectID != "" {
+			projectIDs = append(projectIDs, projectID)
+		}
+	}
+	return uniq(projectIDs), nil
+}
+
+// getResourceProjectID returns the ID of the project of the project or database resource,
+// or empty if the resource belongs to no project.
+func (in *ACLInterceptor) getResourceProjectID(ctx context.Context, resource string) (string, error) {
+	parts := strings.Split(resource, "/")
+	switch {
+	case len(parts) >= 2 && parts[0]+"/" == common.ProjectNamePrefix:
+		return parts[1], nil
+	case len(parts) >= 4 && parts[0]+"/" == common.InstanceNamePrefix && parts[2]+"/" == common.DatabaseIDPrefix:
+		database, err := getDatabaseMessage(ctx, in.store, strings.Join(parts[:4], "/"))
+		if err != nil {
+			return "", err
+		}
+		if database == nil {
+			return "", errors.Errorf("database %q not found", resource)
+		}
+		return database.ProjectID, nil
+	}
+	return "", nil
+}
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestIsAPITokenScopeAllowed(t *testing.T) {
+	a := require.New(t)
+
+	tests := []struct {
+		scopes     []storepb.APITokenPayload_Scope
+		fullMethod string
+		want       bool
+	}{
+		{scopes: nil, fullMethod: v1pb.DatabaseService_GetDatabase_FullMethodName, want: false},
+		{scopes: []storepb.APITokenPayload_Scope{storepb.APITokenPayload_ALL}, fullMethod: v1pb.ProjectService_DeleteProject_FullMethodName, want: true},
+		{scopes: []storepb.APITokenPayload_Scope{storepb.APITokenPayload_READ_ONLY}, fullMethod: v1pb.DatabaseService_GetDatabase_FullMethodName, want: true},
+		{scopes: []storepb.APITokenPayload_Scope{storepb.APITokenPayload_READ_ONLY}, fullMethod: v1pb.IssueService_ListIssues_FullMethodName, want: true},
+		{scopes: []storepb.APITokenPayload_Scope{storepb.APITokenPayload_READ_ONLY}, fullMethod: v1pb.ProjectService_BatchGetIamPolicy_FullMethodName, want: true},
+		{scopes: []storepb.APITokenPayload_Scope{storepb.APITokenPayload_READ_ONLY}, fullMethod: v1pb.DatabaseService_UpdateDatabase_FullMethodName, want: false},
+		{scopes: []storepb.APITokenPayload_Scope{storepb.APITokenPayload_READ_ONLY}, fullMethod: v1pb.SQLService_Check_FullMethodName, want: false},
+		{scopes: []storepb.APITokenPayload_Scope{storepb.APITokenPayload_SQL_CHECK}, fullMethod: v1pb.SQLService_Check_FullMethodName, want: true},
+		{scopes: []storepb.APITokenPayload_Scope{storepb.APITokenPayload_SQL_CHECK}, fullMethod: v1pb.SQLService_Query_FullMethodName, want: false},
+		{scopes: []storepb.APITokenPayload_Scope{storepb.APITokenPayload_ISSUE_CREATE}, fullMethod: v1pb.IssueService_CreateIssue_FullMethodName, want: true},
+		{scopes: []storepb.APITokenPayload_Scope{storepb.APITokenPayload_ISSUE_CREATE}, fullMethod: v1pb.IssueService_ApproveIssue_FullMethodName, want: false},
+		{scopes: []storepb.APITokenPayload_Scope{storepb.APITokenPayload_ISSUE_CREATE, storepb.APITokenPayload_SQL_CHECK}, fullMethod: v1pb.SQLService_Check_FullMethodName, want: true},
+	}
+	for _, test := range tests {
+		a.Equal(test.want, isAPITokenScopeAllowed(test.scopes, test.fullMethod), "%v %s", test.scopes, test.fullMethod)
+	}
+}
+
+func TestGetAPITokenState(t *testing.T) {
+	a := require.New(t)
+
+	a.Equal(v1pb.APIToken_ACTIVE, getAPITokenState(&store.APITokenMessage{}, 100))
+	a.Equal(v1pb.APIToken_ACTIVE, getAPITokenState(&store.APITokenMessage{ExpireTs: 101}, 100))
+	a.Equal(v1pb.APIToken_EXPIRED, getAPITokenState(&store.APITokenMessage{ExpireTs: 100}, 100))
+	a.Equal(v1pb.APIToken_REVOKED, getAPITokenState(&store.APITokenMessage{ExpireTs: 100, RevokedTs: 50}, 100))
+}
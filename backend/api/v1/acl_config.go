@@ -6,27 +6,35 @@ import (
 )
 
 var ownerAndDBAMethods = map[string]bool{
-	v1pb.EnvironmentService_CreateEnvironment_FullMethodName:   true,
-	v1pb.EnvironmentService_UpdateEnvironment_FullMethodName:   true,
-	v1pb.EnvironmentService_DeleteEnvironment_FullMethodName:   true,
-	v1pb.EnvironmentService_UndeleteEnvironment_FullMethodName: true,
-	v1pb.EnvironmentService_UpdateBackupSetting_FullMethodName: true,
-	v1pb.InstanceService_CreateInstance_FullMethodName:         true,
-	v1pb.InstanceService_UpdateInstance_FullMethodName:         true,
-	v1pb.InstanceService_DeleteInstance_FullMethodName:         true,
-	v1pb.InstanceService_UndeleteInstance_FullMethodName:       true,
-	v1pb.InstanceService_AddDataSource_FullMethodName:          true,
-	v1pb.InstanceService_RemoveDataSource_FullMethodName:       true,
-	v1pb.InstanceService_UpdateDataSource_FullMethodName:       true,
-	v1pb.RiskService_CreateRisk_FullMethodName:                 true,
-	v1pb.RiskService_UpdateRisk_FullMethodName:                 true,
-	v1pb.RiskService_DeleteRisk_FullMethodName:                 true,
-	v1pb.SettingService_SetSetting_FullMethodName:              true,
-	v1pb.RoleService_CreateRole_FullMethodName:                 true,
-	v1pb.RoleService_UpdateRole_FullMethodName:                 true,
-	v1pb.RoleService_DeleteRole_FullMethodName:                 true,
-	v1pb.ActuatorService_UpdateActuatorInfo_FullMethodName:     true,
-	v1pb.ActuatorService_ListDebugLog_FullMethodName:           true,
+	v1pb.EnvironmentService_CreateEnvironment_FullMethodName:    true,
+	v1pb.EnvironmentService_UpdateEnvironment_FullMethodName:    true,
+	v1pb.EnvironmentService_DeleteEnvironment_FullMethodName:    true,
+	v1pb.EnvironmentService_UndeleteEnvironment_FullMethodName:  true,
+	v1pb.EnvironmentService_UpdateBackupSetting_FullMethodName:  true,
+	v1pb.InstanceService_CreateInstance_FullMethodName:          true,
+	v1pb.InstanceService_UpdateInstance_FullMethodName:          true,
+	v1pb.InstanceService_DeleteInstance_FullMethodName:          true,
+	v1pb.InstanceService_UndeleteInstance_FullMethodName:        true,
+	v1pb.InstanceService_AddDataSource_FullMethodName:           true,
+	v1pb.InstanceService_RemoveDataSource_FullMethodName:        true,
+	v1pb.InstanceService_UpdateDataSource_FullMethodName:        true,
+	v1pb.InstanceService_AdoptDiscoveredInstance_FullMethodName: true,
+	v1pb.DatabaseService_ProvisionTenantDatabase_FullMethodName: true,
+	v1pb.ProjectService_UpdateProjectQuota_FullMethodName:       true,
+	v1pb.RiskService_CreateRisk_FullMethodName:                  true,
+	v1pb.RiskService_UpdateRisk_FullMethodName:                  true,
+	v1pb.RiskService_DeleteRisk_FullMethodName:                  true,
+	v1pb.SettingService_SetSetting_FullMethodName:               true,
+	v1pb.SettingService_ExportWorkspaceConfig_FullMethodName:    true,
+	v1pb.SettingService_ApplyWorkspaceConfig_FullMethodName:     true,
+	v1pb.RoleService_CreateRole_FullMethodName:                  true,
+	v1pb.RoleService_UpdateRole_FullMethodName:                  true,
+	v1pb.RoleService_DeleteRole_FullMethodName:                  true,
+	v1pb.ActuatorService_UpdateActuatorInfo_FullMethodName:      true,
+	v1pb.ActuatorService_ListDebugLog_FullMethodName:            true,
+	v1pb.SQLService_ListExportAudits_FullMethodName:             true,
+	v1pb.SQLService_GetQueryHistoryStats_FullMethodName:         true,
+	v1pb.LoggingService_SearchAuditLogs_FullMethodName:          true,
 }
 
 var projectOwnerMethods = map[string]bool{
@@ -43,56 +51,72 @@ var transferDatabaseMethods = map[string]bool{
 }
 
 var methodPermissionMap = map[string]iam.Permission{
-	v1pb.InstanceService_ListInstances_FullMethodName:     iam.PermissionInstancesList,
-	v1pb.InstanceService_SearchInstances_FullMethodName:   "", // TODO(p0ny): implement me please.
-	v1pb.InstanceService_GetInstance_FullMethodName:       iam.PermissionInstancesGet,
-	v1pb.InstanceService_CreateInstance_FullMethodName:    iam.PermissionInstancesCreate,
-	v1pb.InstanceService_UpdateInstance_FullMethodName:    iam.PermissionInstancesUpdate,
-	v1pb.InstanceService_DeleteInstance_FullMethodName:    iam.PermissionInstancesDelete,
-	v1pb.InstanceService_UndeleteInstance_FullMethodName:  iam.PermissionInstancesUndelete,
-	v1pb.InstanceService_SyncInstance_FullMethodName:      iam.PermissionInstancesSync,
-	v1pb.InstanceService_BatchSyncInstance_FullMethodName: iam.PermissionInstancesSync,
-	v1pb.InstanceService_AddDataSource_FullMethodName:     iam.PermissionInstancesUpdate,
-	v1pb.InstanceService_RemoveDataSource_FullMethodName:  iam.PermissionInstancesUpdate,
-	v1pb.InstanceService_UpdateDataSource_FullMethodName:  iam.PermissionInstancesUpdate,
-	v1pb.InstanceService_SyncSlowQueries_FullMethodName:   iam.PermissionInstancesSync,
-
-	v1pb.DatabaseService_GetDatabase_FullMethodName:            iam.PermissionDatabasesGet,
-	v1pb.DatabaseService_ListDatabases_FullMethodName:          iam.PermissionDatabasesList,
-	v1pb.DatabaseService_UpdateDatabase_FullMethodName:         iam.PermissionDatabasesUpdate,
-	v1pb.DatabaseService_BatchUpdateDatabases_FullMethodName:   iam.PermissionDatabasesUpdate,
-	v1pb.DatabaseService_SyncDatabase_FullMethodName:           iam.PermissionDatabasesSync,
-	v1pb.DatabaseService_GetDatabaseMetadata_FullMethodName:    iam.PermissionDatabasesGetSchema,
-	v1pb.DatabaseService_UpdateDatabaseMetadata_FullMethodName: iam.PermissionDatabasesUpdate,
-	v1pb.DatabaseService_GetDatabaseSchema_FullMethodName:      iam.PermissionDatabasesGetSchema,
-	v1pb.DatabaseService_DiffSchema_FullMethodName:             "", // handled in the method.
-	v1pb.DatabaseService_GetBackupSetting_FullMethodName:       iam.PermissionDatabasesGetBackupSetting,
-	v1pb.DatabaseService_UpdateBackupSetting_FullMethodName:    iam.PermissionDatabasesUpdateBackupSetting,
-	v1pb.DatabaseService_CreateBackup_FullMethodName:           iam.PermissionBackupsCreate,
-	v1pb.DatabaseService_ListBackups_FullMethodName:            iam.PermissionBackupsList,
-	v1pb.DatabaseService_ListSlowQueries_FullMethodName:        iam.PermissionSlowQueriesList,
-	v1pb.DatabaseService_ListSecrets_FullMethodName:            iam.PermissionDatabaseSecretsList,
-	v1pb.DatabaseService_UpdateSecret_FullMethodName:           iam.PermissionDatabaseSecretsUpdate,
-	v1pb.DatabaseService_DeleteSecret_FullMethodName:           iam.PermissionDatabaseSecretsDelete,
-	v1pb.DatabaseService_AdviseIndex_FullMethodName:            iam.PermissionDatabasesAdviseIndex,
-	v1pb.DatabaseService_ListChangeHistories_FullMethodName:    iam.PermissionChangeHistoriesList,
-	v1pb.DatabaseService_GetChangeHistory_FullMethodName:       iam.PermissionChangeHistoriesGet,
-	v1pb.EnvironmentService_CreateEnvironment_FullMethodName:   iam.PermissionEnvironmentsCreate,
-	v1pb.EnvironmentService_UpdateEnvironment_FullMethodName:   iam.PermissionEnvironmentsUpdate,
-	v1pb.EnvironmentService_DeleteEnvironment_FullMethodName:   iam.PermissionEnvironmentsDelete,
-	v1pb.EnvironmentService_UndeleteEnvironment_FullMethodName: iam.PermissionEnvironmentsUndelete,
-	v1pb.EnvironmentService_GetEnvironment_FullMethodName:      iam.PermissionEnvironmentsGet,
-	v1pb.EnvironmentService_ListEnvironments_FullMethodName:    iam.PermissionEnvironmentsList,
-	v1pb.EnvironmentService_UpdateBackupSetting_FullMethodName: iam.PermissionEnvironmentsUpdate,
-	v1pb.IssueService_ListIssues_FullMethodName:                iam.PermissionIssuesList,
-	v1pb.IssueService_GetIssue_FullMethodName:                  iam.PermissionIssuesGet,
-	v1pb.IssueService_UpdateIssue_FullMethodName:               iam.PermissionIssuesUpdate,
-	v1pb.IssueService_BatchUpdateIssuesStatus_FullMethodName:   iam.PermissionIssuesUpdate,
-	v1pb.IssueService_CreateIssueComment_FullMethodName:        iam.PermissionIssueCommentsCreate,
-	v1pb.IssueService_UpdateIssueComment_FullMethodName:        iam.PermissionIssueCommentsUpdate,
-	v1pb.IssueService_ApproveIssue_FullMethodName:              "", // controlled by org policy.
-	v1pb.IssueService_RejectIssue_FullMethodName:               "", // controlled by org policy.
-	v1pb.IssueService_RequestIssue_FullMethodName:              "", // controlled by org policy.
+	v1pb.InstanceService_ListInstances_FullMethodName:           iam.PermissionInstancesList,
+	v1pb.InstanceService_SearchInstances_FullMethodName:         "", // TODO(p0ny): implement me please.
+	v1pb.InstanceService_GetInstance_FullMethodName:             iam.PermissionInstancesGet,
+	v1pb.InstanceService_CreateInstance_FullMethodName:          iam.PermissionInstancesCreate,
+	v1pb.InstanceService_UpdateInstance_FullMethodName:          iam.PermissionInstancesUpdate,
+	v1pb.InstanceService_DeleteInstance_FullMethodName:          iam.PermissionInstancesDelete,
+	v1pb.InstanceService_UndeleteInstance_FullMethodName:        iam.PermissionInstancesUndelete,
+	v1pb.InstanceService_SyncInstance_FullMethodName:            iam.PermissionInstancesSync,
+	v1pb.InstanceService_BatchSyncInstance_FullMethodName:       iam.PermissionInstancesSync,
+	v1pb.InstanceService_AddDataSource_FullMethodName:           iam.PermissionInstancesUpdate,
+	v1pb.InstanceService_RemoveDataSource_FullMethodName:        iam.PermissionInstancesUpdate,
+	v1pb.InstanceService_UpdateDataSource_FullMethodName:        iam.PermissionInstancesUpdate,
+	v1pb.InstanceService_SyncSlowQueries_FullMethodName:         iam.PermissionInstancesSync,
+	v1pb.InstanceService_ListDiscoveredInstances_FullMethodName: iam.PermissionInstancesList,
+	v1pb.InstanceService_AdoptDiscoveredInstance_FullMethodName: iam.PermissionInstancesCreate,
+
+	v1pb.DatabaseService_GetDatabase_FullMethodName:                 iam.PermissionDatabasesGet,
+	v1pb.DatabaseService_ListDatabases_FullMethodName:               iam.PermissionDatabasesList,
+	v1pb.DatabaseService_UpdateDatabase_FullMethodName:              iam.PermissionDatabasesUpdate,
+	v1pb.DatabaseService_BatchUpdateDatabases_FullMethodName:        iam.PermissionDatabasesUpdate,
+	v1pb.DatabaseService_SyncDatabase_FullMethodName:                iam.PermissionDatabasesSync,
+	v1pb.DatabaseService_GetDatabaseMetadata_FullMethodName:         iam.PermissionDatabasesGetSchema,
+	v1pb.DatabaseService_UpdateDatabaseMetadata_FullMethodName:      iam.PermissionDatabasesUpdate,
+	v1pb.DatabaseService_GetDatabaseSchema_FullMethodName:           iam.PermissionDatabasesGetSchema,
+	v1pb.DatabaseService_GetDatabaseComments_FullMethodName:         iam.PermissionDatabasesGetSchema,
+	v1pb.DatabaseService_UpdateDatabaseComments_FullMethodName:      iam.PermissionPlansCreate,
+	v1pb.DatabaseService_DiffSchema_FullMethodName:                  "", // handled in the method.
+	v1pb.DatabaseService_GetBackupSetting_FullMethodName:            iam.PermissionDatabasesGetBackupSetting,
+	v1pb.DatabaseService_UpdateBackupSetting_FullMethodName:         iam.PermissionDatabasesUpdateBackupSetting,
+	v1pb.DatabaseService_CreateBackup_FullMethodName:                iam.PermissionBackupsCreate,
+	v1pb.DatabaseService_ListBackups_FullMethodName:                 iam.PermissionBackupsList,
+	v1pb.DatabaseService_ListSlowQueries_FullMethodName:             iam.PermissionSlowQueriesList,
+	v1pb.DatabaseService_GetSlowQueryReport_FullMethodName:          iam.PermissionSlowQueriesList,
+	v1pb.DatabaseService_ListSecrets_FullMethodName:                 iam.PermissionDatabaseSecretsList,
+	v1pb.DatabaseService_UpdateSecret_FullMethodName:                iam.PermissionDatabaseSecretsUpdate,
+	v1pb.DatabaseService_DeleteSecret_FullMethodName:                iam.PermissionDatabaseSecretsDelete,
+	v1pb.DatabaseService_AdviseIndex_FullMethodName:                 iam.PermissionDatabasesAdviseIndex,
+	v1pb.DatabaseService_AdviseWorkloadIndexes_FullMethodName:       iam.PermissionDatabasesAdviseIndex,
+	v1pb.DatabaseService_ListChangeHistories_FullMethodName:         iam.PermissionChangeHistoriesList,
+	v1pb.DatabaseService_GetChangeHistory_FullMethodName:            iam.PermissionChangeHistoriesGet,
+	v1pb.DatabaseService_CheckChangeHistoryIntegrity_FullMethodName: iam.PermissionChangeHistoriesList,
+	v1pb.DatabaseService_ImportChangeHistories_FullMethodName:       iam.PermissionDatabasesUpdate,
+	v1pb.DatabaseService_ExportChangeHistories_FullMethodName:       iam.PermissionChangeHistoriesList,
+	v1pb.DatabaseService_CreateSandboxDatabase_FullMethodName:       iam.PermissionDatabasesQuery,
+	v1pb.DatabaseService_ListSandboxDatabases_FullMethodName:        iam.PermissionDatabasesGet,
+	v1pb.DatabaseService_ProvisionTenantDatabase_FullMethodName:     iam.PermissionDatabasesUpdate,
+	v1pb.EnvironmentService_CreateEnvironment_FullMethodName:        iam.PermissionEnvironmentsCreate,
+	v1pb.EnvironmentService_UpdateEnvironment_FullMethodName:        iam.PermissionEnvironmentsUpdate,
+	v1pb.EnvironmentService_DeleteEnvironment_FullMethodName:        iam.PermissionEnvironmentsDelete,
+	v1pb.EnvironmentService_UndeleteEnvironment_FullMethodName:      iam.PermissionEnvironmentsUndelete,
+	v1pb.EnvironmentService_GetEnvironment_FullMethodName:           iam.PermissionEnvironmentsGet,
+	v1pb.EnvironmentService_ListEnvironments_FullMethodName:         iam.PermissionEnvironmentsList,
+	v1pb.EnvironmentService_UpdateBackupSetting_FullMethodName:      iam.PermissionEnvironmentsUpdate,
+	v1pb.IssueService_ListIssues_FullMethodName:                     iam.PermissionIssuesList,
+	v1pb.IssueService_GetIssue_FullMethodName:                       iam.PermissionIssuesGet,
+	v1pb.IssueService_GetIssueDependencyGraph_FullMethodName:        iam.PermissionIssuesGet,
+	v1pb.IssueService_UpdateIssue_FullMethodName:                    iam.PermissionIssuesUpdate,
+	v1pb.IssueService_BatchUpdateIssuesStatus_FullMethodName:        iam.PermissionIssuesUpdate,
+	v1pb.IssueService_BatchUpdateIssues_FullMethodName:              iam.PermissionIssuesUpdate,
+	v1pb.IssueService_UnarchiveIssue_FullMethodName:                 iam.PermissionIssuesUpdate,
+	v1pb.IssueService_CreateIssueComment_FullMethodName:             iam.PermissionIssueCommentsCreate,
+	v1pb.IssueService_UpdateIssueComment_FullMethodName:             iam.PermissionIssueCommentsUpdate,
+	v1pb.IssueService_ApproveIssue_FullMethodName:                   "", // controlled by org policy.
+	v1pb.IssueService_BatchApproveIssues_FullMethodName:             "", // controlled by org policy.
+	v1pb.IssueService_RejectIssue_FullMethodName:                    "", // controlled by org policy.
+	v1pb.IssueService_RequestIssue_FullMethodName:                   "", // controlled by org policy.
 
 	v1pb.ProjectService_ListProjects_FullMethodName:                 iam.PermissionProjectsList,
 	v1pb.ProjectService_SearchProjects_FullMethodName:               "", // handled in the method.
@@ -106,10 +130,13 @@ var methodPermissionMap = map[string]iam.Permission{
 	v1pb.ProjectService_BatchGetIamPolicy_FullMethodName:            iam.PermissionProjectsGetIAMPolicy,
 	v1pb.ProjectService_GetDeploymentConfig_FullMethodName:          iam.PermissionProjectsGet,
 	v1pb.ProjectService_UpdateDeploymentConfig_FullMethodName:       iam.PermissionProjectsUpdate,
+	v1pb.ProjectService_EvaluateDeploymentExpression_FullMethodName: iam.PermissionProjectsGet,
 	v1pb.ProjectService_AddWebhook_FullMethodName:                   iam.PermissionProjectsUpdate,
 	v1pb.ProjectService_UpdateWebhook_FullMethodName:                iam.PermissionProjectsUpdate,
 	v1pb.ProjectService_RemoveWebhook_FullMethodName:                iam.PermissionProjectsUpdate,
 	v1pb.ProjectService_TestWebhook_FullMethodName:                  iam.PermissionProjectsUpdate,
+	v1pb.ProjectService_ListWebhookDeliveries_FullMethodName:        iam.PermissionProjectsGet,
+	v1pb.ProjectService_RedeliverWebhookDelivery_FullMethodName:     iam.PermissionProjectsUpdate,
 	v1pb.ProjectService_UpdateProjectGitOpsInfo_FullMethodName:      iam.PermissionProjectsUpdate,
 	v1pb.ProjectService_UnsetProjectGitOpsInfo_FullMethodName:       iam.PermissionProjectsUpdate,
 	v1pb.ProjectService_SetupProjectSQLReviewCI_FullMethodName:      iam.PermissionProjectsUpdate,
@@ -126,6 +153,20 @@ var methodPermissionMap = map[string]iam.Permission{
 	v1pb.ProjectService_DeleteSchemaGroup_FullMethodName:            iam.PermissionProjectsUpdate,
 	v1pb.ProjectService_GetProjectProtectionRules_FullMethodName:    iam.PermissionProjectsGet,
 	v1pb.ProjectService_UpdateProjectProtectionRules_FullMethodName: iam.PermissionProjectsUpdate,
+	v1pb.ProjectService_GetProjectIssueForms_FullMethodName:         iam.PermissionProjectsGet,
+	v1pb.ProjectService_UpdateProjectIssueForms_FullMethodName:      iam.PermissionProjectsUpdate,
+	v1pb.ProjectService_GetProjectQuota_FullMethodName:              iam.PermissionProjectsGet,
+	v1pb.ProjectService_UpdateProjectQuota_FullMethodName:           iam.PermissionSettingsSet,
+	v1pb.ProjectService_ListIssueTemplates_FullMethodName:           iam.PermissionProjectsGet,
+	v1pb.ProjectService_GetIssueTemplate_FullMethodName:             iam.PermissionProjectsGet,
+	v1pb.ProjectService_CreateIssueTemplate_FullMethodName:          iam.PermissionProjectsUpdate,
+	v1pb.ProjectService_UpdateIssueTemplate_FullMethodName:          iam.PermissionProjectsUpdate,
+	v1pb.ProjectService_DeleteIssueTemplate_FullMethodName:          iam.PermissionProjectsUpdate,
+	v1pb.ProjectService_ListIssueSchedules_FullMethodName:           iam.PermissionProjectsGet,
+	v1pb.ProjectService_GetIssueSchedule_FullMethodName:             iam.PermissionProjectsGet,
+	v1pb.ProjectService_CreateIssueSchedule_FullMethodName:          iam.PermissionProjectsUpdate,
+	v1pb.ProjectService_UpdateIssueSchedule_FullMethodName:          iam.PermissionProjectsUpdate,
+	v1pb.ProjectService_DeleteIssueSchedule_FullMethodName:          iam.PermissionProjectsUpdate,
 
 	v1pb.RiskService_ListRisks_FullMethodName:  iam.PermissionRisksList,
 	v1pb.RiskService_CreateRisk_FullMethodName: iam.PermissionRisksCreate,
@@ -165,21 +206,33 @@ var methodPermissionMap = map[string]iam.Permission{
 	v1pb.RolloutService_GetRollout_FullMethodName:                                          iam.PermissionRolloutsGet,
 	v1pb.RolloutService_CreateRollout_FullMethodName:                                       iam.PermissionRolloutsCreate,
 	v1pb.RolloutService_PreviewRollout_FullMethodName:                                      iam.PermissionRolloutsPreview,
+	v1pb.RolloutService_PreviewPlan_FullMethodName:                                         iam.PermissionRolloutsPreview,
 	v1pb.RolloutService_ListTaskRuns_FullMethodName:                                        iam.PermissionTaskRunsList,
 	v1pb.RolloutService_ListPlanCheckRuns_FullMethodName:                                   iam.PermissionPlanCheckRunsList,
 	v1pb.RolloutService_RunPlanChecks_FullMethodName:                                       iam.PermissionPlanCheckRunsRun,
 	v1pb.RolloutService_BatchRunTasks_FullMethodName:                                       iam.PermissionTasksRun,
 	v1pb.RolloutService_BatchSkipTasks_FullMethodName:                                      iam.PermissionTasksSkip,
+	v1pb.RolloutService_ApproveStage_FullMethodName:                                        iam.PermissionRolloutsGet,
 	v1pb.RolloutService_BatchCancelTaskRuns_FullMethodName:                                 iam.PermissionTaskRunsCancel,
+	v1pb.RolloutService_BatchCancelPendingTaskRuns_FullMethodName:                          iam.PermissionTaskRunsCancel,
+	v1pb.RolloutService_BatchRetryFailedTasks_FullMethodName:                               iam.PermissionTasksRun,
+	v1pb.RolloutService_CutoverTask_FullMethodName:                                         iam.PermissionTasksRun,
+	v1pb.RolloutService_ListTaskRunArtifacts_FullMethodName:                                iam.PermissionTaskRunsList,
+	v1pb.RolloutService_GetTaskRunArtifact_FullMethodName:                                  iam.PermissionTaskRunsList,
+	v1pb.RolloutService_ListTaskRunLogEntries_FullMethodName:                               iam.PermissionTaskRunsList,
 	v1pb.SettingService_ListSettings_FullMethodName:                                        iam.PermissionSettingsList,
 	v1pb.SettingService_GetSetting_FullMethodName:                                          iam.PermissionSettingsGet,
 	v1pb.SettingService_SetSetting_FullMethodName:                                          iam.PermissionSettingsSet,
+	v1pb.SettingService_ExportWorkspaceConfig_FullMethodName:                               iam.PermissionSettingsGet,
+	v1pb.SettingService_ApplyWorkspaceConfig_FullMethodName:                                iam.PermissionSettingsSet,
 
-	v1pb.OrgPolicyService_ListPolicies_FullMethodName: iam.PermissionPoliciesList,
-	v1pb.OrgPolicyService_GetPolicy_FullMethodName:    iam.PermissionPoliciesGet,
-	v1pb.OrgPolicyService_CreatePolicy_FullMethodName: iam.PermissionPoliciesCreate,
-	v1pb.OrgPolicyService_UpdatePolicy_FullMethodName: iam.PermissionPoliciesUpdate,
-	v1pb.OrgPolicyService_DeletePolicy_FullMethodName: iam.PermissionPoliciesDelete,
+	v1pb.OrgPolicyService_ListPolicies_FullMethodName:          iam.PermissionPoliciesList,
+	v1pb.OrgPolicyService_GetPolicy_FullMethodName:             iam.PermissionPoliciesGet,
+	v1pb.OrgPolicyService_CreatePolicy_FullMethodName:          iam.PermissionPoliciesCreate,
+	v1pb.OrgPolicyService_UpdatePolicy_FullMethodName:          iam.PermissionPoliciesUpdate,
+	v1pb.OrgPolicyService_DeletePolicy_FullMethodName:          iam.PermissionPoliciesDelete,
+	v1pb.OrgPolicyService_ExportSQLReviewPolicy_FullMethodName: iam.PermissionPoliciesGet,
+	v1pb.OrgPolicyService_ImportSQLReviewPolicy_FullMethodName: iam.PermissionPoliciesUpdate,
 
 	v1pb.BranchService_GetBranch_FullMethodName:    iam.PermissionBranchesGet,
 	v1pb.BranchService_ListBranches_FullMethodName: iam.PermissionBranchesList,
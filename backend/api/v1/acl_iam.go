@@ -23,8 +23,29 @@ func (in *ACLInterceptor) checkIAMPermission(ctx context.Context, fullMethod str
 	if !ok {
 		return errors.Errorf("method %q not found in method-permission map", fullMethod)
 	}
-	var projectIDsGetter func(context.Context, any) ([]string, error)
+	projectIDsGetter := in.getProjectIDsGetter(fullMethod)
+	if projectIDsGetter == nil {
+		return errors.Errorf("method %q not found in method-project map", fullMethod)
+	}
 
+	projectIDs, err := projectIDsGetter(ctx, req)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check permission, err %v", err)
+	}
+	ok, err = in.iamManager.CheckPermission(ctx, p, user, projectIDs...)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check permission for method %q, err: %v", fullMethod, err)
+	}
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "permission denied for method %q, user does not have permission %q", fullMethod, p)
+	}
+
+	return nil
+}
+
+// getProjectIDsGetter returns the getter of the IDs of the projects the request of the method accesses,
+// or nil if the method is unknown.
+func (in *ACLInterceptor) getProjectIDsGetter(fullMethod string) func(context.Context, any) ([]string, error) {
 	switch fullMethod {
 	// below are "workspace-level" permissions.
 	// we don't have to go down to the project level.
@@ -33,6 +54,7 @@ func (in *ACLInterceptor) checkIAMPermission(ctx context.Context, fullMethod str
 		v1pb.ProjectService_CreateProject_FullMethodName,
 		v1pb.ProjectService_DeleteProject_FullMethodName,
 		v1pb.ProjectService_UndeleteProject_FullMethodName,
+		v1pb.ProjectService_UpdateProjectQuota_FullMethodName,
 		v1pb.InstanceService_ListInstances_FullMethodName,
 		v1pb.InstanceService_GetInstance_FullMethodName,
 		v1pb.InstanceService_CreateInstance_FullMethodName,
@@ -45,6 +67,8 @@ func (in *ACLInterceptor) checkIAMPermission(ctx context.Context, fullMethod str
 		v1pb.InstanceService_RemoveDataSource_FullMethodName,
 		v1pb.InstanceService_UpdateDataSource_FullMethodName,
 		v1pb.InstanceService_SyncSlowQueries_FullMethodName,
+		v1pb.InstanceService_ListDiscoveredInstances_FullMethodName,
+		v1pb.InstanceService_AdoptDiscoveredInstance_FullMethodName,
 		v1pb.InstanceRoleService_GetInstanceRole_FullMethodName,
 		v1pb.InstanceRoleService_CreateInstanceRole_FullMethodName,
 		v1pb.InstanceRoleService_UpdateInstanceRole_FullMethodName,
@@ -60,11 +84,15 @@ func (in *ACLInterceptor) checkIAMPermission(ctx context.Context, fullMethod str
 		v1pb.SettingService_ListSettings_FullMethodName,
 		v1pb.SettingService_GetSetting_FullMethodName,
 		v1pb.SettingService_SetSetting_FullMethodName,
+		v1pb.SettingService_ExportWorkspaceConfig_FullMethodName,
+		v1pb.SettingService_ApplyWorkspaceConfig_FullMethodName,
 		v1pb.OrgPolicyService_ListPolicies_FullMethodName,
 		v1pb.OrgPolicyService_GetPolicy_FullMethodName,
 		v1pb.OrgPolicyService_CreatePolicy_FullMethodName,
 		v1pb.OrgPolicyService_UpdatePolicy_FullMethodName,
 		v1pb.OrgPolicyService_DeletePolicy_FullMethodName,
+		v1pb.OrgPolicyService_ExportSQLReviewPolicy_FullMethodName,
+		v1pb.OrgPolicyService_ImportSQLReviewPolicy_FullMethodName,
 		v1pb.IdentityProviderService_GetIdentityProvider_FullMethodName,
 		v1pb.IdentityProviderService_CreateIdentityProvider_FullMethodName,
 		v1pb.IdentityProviderService_UpdateIdentityProvider_FullMethodName,
@@ -88,7 +116,7 @@ func (in *ACLInterceptor) checkIAMPermission(ctx context.Context, fullMethod str
 		v1pb.RoleService_UpdateRole_FullMethodName,
 		v1pb.RoleService_DeleteRole_FullMethodName:
 
-		projectIDsGetter = func(context.Context, any) ([]string, error) {
+		return func(context.Context, any) ([]string, error) {
 			return nil, nil
 		}
 	case
@@ -100,6 +128,8 @@ func (in *ACLInterceptor) checkIAMPermission(ctx context.Context, fullMethod str
 		v1pb.DatabaseService_GetDatabaseMetadata_FullMethodName,
 		v1pb.DatabaseService_UpdateDatabaseMetadata_FullMethodName,
 		v1pb.DatabaseService_GetDatabaseSchema_FullMethodName,
+		v1pb.DatabaseService_GetDatabaseComments_FullMethodName,
+		v1pb.DatabaseService_UpdateDatabaseComments_FullMethodName,
 		v1pb.DatabaseService_GetBackupSetting_FullMethodName,
 		v1pb.DatabaseService_UpdateBackupSetting_FullMethodName,
 		v1pb.DatabaseService_CreateBackup_FullMethodName,
@@ -108,26 +138,37 @@ func (in *ACLInterceptor) checkIAMPermission(ctx context.Context, fullMethod str
 		v1pb.DatabaseService_UpdateSecret_FullMethodName,
 		v1pb.DatabaseService_DeleteSecret_FullMethodName,
 		v1pb.DatabaseService_AdviseIndex_FullMethodName,
+		v1pb.DatabaseService_AdviseWorkloadIndexes_FullMethodName,
 		v1pb.DatabaseService_ListChangeHistories_FullMethodName,
-		v1pb.DatabaseService_GetChangeHistory_FullMethodName:
-
-		projectIDsGetter = in.getProjectIDsForDatabaseService
+		v1pb.DatabaseService_GetChangeHistory_FullMethodName,
+		v1pb.DatabaseService_CheckChangeHistoryIntegrity_FullMethodName,
+		v1pb.DatabaseService_ImportChangeHistories_FullMethodName,
+		v1pb.DatabaseService_ExportChangeHistories_FullMethodName,
+		v1pb.DatabaseService_CreateSandboxDatabase_FullMethodName,
+		v1pb.DatabaseService_ListSandboxDatabases_FullMethodName,
+		v1pb.DatabaseService_ProvisionTenantDatabase_FullMethodName,
+		v1pb.DatabaseService_GetSlowQueryReport_FullMethodName:
+
+		return in.getProjectIDsForDatabaseService
 	case
 		v1pb.IssueService_GetIssue_FullMethodName,
 		v1pb.IssueService_CreateIssue_FullMethodName,
 		v1pb.IssueService_UpdateIssue_FullMethodName,
 		v1pb.IssueService_CreateIssueComment_FullMethodName,
 		v1pb.IssueService_UpdateIssueComment_FullMethodName,
-		v1pb.IssueService_BatchUpdateIssuesStatus_FullMethodName:
+		v1pb.IssueService_BatchUpdateIssuesStatus_FullMethodName,
+		v1pb.IssueService_BatchUpdateIssues_FullMethodName,
+		v1pb.IssueService_UnarchiveIssue_FullMethodName,
+		v1pb.IssueService_GetIssueDependencyGraph_FullMethodName:
 
-		projectIDsGetter = in.getProjectIDsForIssueService
+		return in.getProjectIDsForIssueService
 	case
 		v1pb.ChangelistService_CreateChangelist_FullMethodName,
 		v1pb.ChangelistService_UpdateChangelist_FullMethodName,
 		v1pb.ChangelistService_GetChangelist_FullMethodName,
 		v1pb.ChangelistService_DeleteChangelist_FullMethodName:
 
-		projectIDsGetter = in.getProjectIDsForChangelistService
+		return in.getProjectIDsForChangelistService
 	case
 		v1pb.BranchService_ListBranches_FullMethodName,
 		v1pb.BranchService_GetBranch_FullMethodName,
@@ -137,11 +178,12 @@ func (in *ACLInterceptor) checkIAMPermission(ctx context.Context, fullMethod str
 		v1pb.BranchService_MergeBranch_FullMethodName,
 		v1pb.BranchService_RebaseBranch_FullMethodName:
 
-		projectIDsGetter = in.getProjectIDsForBranchService
+		return in.getProjectIDsForBranchService
 	case
 		v1pb.RolloutService_GetRollout_FullMethodName,
 		v1pb.RolloutService_CreateRollout_FullMethodName,
 		v1pb.RolloutService_PreviewRollout_FullMethodName,
+		v1pb.RolloutService_PreviewPlan_FullMethodName,
 		v1pb.RolloutService_GetPlan_FullMethodName,
 		v1pb.RolloutService_CreatePlan_FullMethodName,
 		v1pb.RolloutService_UpdatePlan_FullMethodName,
@@ -150,9 +192,16 @@ func (in *ACLInterceptor) checkIAMPermission(ctx context.Context, fullMethod str
 		v1pb.RolloutService_RunPlanChecks_FullMethodName,
 		v1pb.RolloutService_BatchRunTasks_FullMethodName,
 		v1pb.RolloutService_BatchSkipTasks_FullMethodName,
-		v1pb.RolloutService_BatchCancelTaskRuns_FullMethodName:
-
-		projectIDsGetter = in.getProjectIDsForRolloutService
+		v1pb.RolloutService_ApproveStage_FullMethodName,
+		v1pb.RolloutService_BatchCancelTaskRuns_FullMethodName,
+		v1pb.RolloutService_BatchRetryFailedTasks_FullMethodName,
+		v1pb.RolloutService_BatchCancelPendingTaskRuns_FullMethodName,
+		v1pb.RolloutService_CutoverTask_FullMethodName,
+		v1pb.RolloutService_ListTaskRunArtifacts_FullMethodName,
+		v1pb.RolloutService_GetTaskRunArtifact_FullMethodName,
+		v1pb.RolloutService_ListTaskRunLogEntries_FullMethodName:
+
+		return in.getProjectIDsForRolloutService
 	case
 		v1pb.ProjectService_GetProject_FullMethodName,
 		v1pb.ProjectService_UpdateProject_FullMethodName,
@@ -161,10 +210,13 @@ func (in *ACLInterceptor) checkIAMPermission(ctx context.Context, fullMethod str
 		v1pb.ProjectService_BatchGetIamPolicy_FullMethodName,
 		v1pb.ProjectService_GetDeploymentConfig_FullMethodName,
 		v1pb.ProjectService_UpdateDeploymentConfig_FullMethodName,
+		v1pb.ProjectService_EvaluateDeploymentExpression_FullMethodName,
 		v1pb.ProjectService_AddWebhook_FullMethodName,
 		v1pb.ProjectService_UpdateWebhook_FullMethodName,
 		v1pb.ProjectService_RemoveWebhook_FullMethodName,
 		v1pb.ProjectService_TestWebhook_FullMethodName,
+		v1pb.ProjectService_ListWebhookDeliveries_FullMethodName,
+		v1pb.ProjectService_RedeliverWebhookDelivery_FullMethodName,
 		v1pb.ProjectService_UpdateProjectGitOpsInfo_FullMethodName,
 		v1pb.ProjectService_UnsetProjectGitOpsInfo_FullMethodName,
 		v1pb.ProjectService_GetProjectGitOpsInfo_FullMethodName,
@@ -180,23 +232,23 @@ func (in *ACLInterceptor) checkIAMPermission(ctx context.Context, fullMethod str
 		v1pb.ProjectService_UpdateSchemaGroup_FullMethodName,
 		v1pb.ProjectService_DeleteSchemaGroup_FullMethodName,
 		v1pb.ProjectService_GetProjectProtectionRules_FullMethodName,
-		v1pb.ProjectService_UpdateProjectProtectionRules_FullMethodName:
-
-		projectIDsGetter = in.getProjectIDsForProjectService
+		v1pb.ProjectService_UpdateProjectProtectionRules_FullMethodName,
+		v1pb.ProjectService_GetProjectIssueForms_FullMethodName,
+		v1pb.ProjectService_UpdateProjectIssueForms_FullMethodName,
+		v1pb.ProjectService_GetProjectQuota_FullMethodName,
+		v1pb.ProjectService_ListIssueTemplates_FullMethodName,
+		v1pb.ProjectService_GetIssueTemplate_FullMethodName,
+		v1pb.ProjectService_CreateIssueTemplate_FullMethodName,
+		v1pb.ProjectService_UpdateIssueTemplate_FullMethodName,
+		v1pb.ProjectService_DeleteIssueTemplate_FullMethodName,
+		v1pb.ProjectService_ListIssueSchedules_FullMethodName,
+		v1pb.ProjectService_GetIssueSchedule_FullMethodName,
+		v1pb.ProjectService_CreateIssueSchedule_FullMethodName,
+		v1pb.ProjectService_UpdateIssueSchedule_FullMethodName,
+		v1pb.ProjectService_DeleteIssueSchedule_FullMethodName:
+
+		return in.getProjectIDsForProjectService
 	}
-
-	projectIDs, err := projectIDsGetter(ctx, req)
-	if err != nil {
-		return status.Errorf(codes.Internal, "failed to check permission, err %v", err)
-	}
-	ok, err = in.iamManager.CheckPermission(ctx, p, user, projectIDs...)
-	if err != nil {
-		return status.Errorf(codes.Internal, "failed to check permission for method %q, err: %v", fullMethod, err)
-	}
-	if !ok {
-		return status.Errorf(codes.PermissionDenied, "permission denied for method %q, user does not have permission %q", fullMethod, p)
-	}
-
 	return nil
 }
 
@@ -222,6 +274,11 @@ func isSkippedMethod(fullMethod string) bool {
 		v1pb.AuthService_UndeleteUser_FullMethodName,
 		v1pb.AuthService_Login_FullMethodName,
 		v1pb.AuthService_Logout_FullMethodName,
+		v1pb.AuthService_ListAPITokens_FullMethodName,
+		v1pb.AuthService_CreateAPIToken_FullMethodName,
+		v1pb.AuthService_RevokeAPIToken_FullMethodName,
+		v1pb.AuthService_ListSessions_FullMethodName,
+		v1pb.AuthService_RevokeSession_FullMethodName,
 		v1pb.CelService_BatchParse_FullMethodName,
 		v1pb.CelService_BatchDeparse_FullMethodName,
 		v1pb.InboxService_GetInboxSummary_FullMethodName,
@@ -230,6 +287,7 @@ func isSkippedMethod(fullMethod string) bool {
 		v1pb.LoggingService_ListLogs_FullMethodName,
 		v1pb.LoggingService_GetLog_FullMethodName,
 		v1pb.LoggingService_ExportLogs_FullMethodName,
+		v1pb.LoggingService_SearchAuditLogs_FullMethodName,
 		v1pb.SQLService_Query_FullMethodName,
 		v1pb.SQLService_Export_FullMethodName,
 		v1pb.SQLService_AdminExecute_FullMethodName,
@@ -237,6 +295,19 @@ func isSkippedMethod(fullMethod string) bool {
 		v1pb.SQLService_Check_FullMethodName,
 		v1pb.SQLService_Pretty_FullMethodName,
 		v1pb.SQLService_StringifyMetadata_FullMethodName,
+		v1pb.SQLService_ListExportAudits_FullMethodName,
+		v1pb.SQLService_Explain_FullMethodName,
+		v1pb.SQLService_OpenQueryCursor_FullMethodName,
+		v1pb.SQLService_FetchQueryCursor_FullMethodName,
+		v1pb.SQLService_CloseQueryCursor_FullMethodName,
+		v1pb.SQLService_CreateScheduledQuery_FullMethodName,
+		v1pb.SQLService_ListScheduledQueries_FullMethodName,
+		v1pb.SQLService_UpdateScheduledQuery_FullMethodName,
+		v1pb.SQLService_DeleteScheduledQuery_FullMethodName,
+		v1pb.SQLService_ListScheduledQueryResults_FullMethodName,
+		v1pb.SQLService_QuerySharedSheet_FullMethodName,
+		v1pb.SQLService_SearchQueryHistories_FullMethodName,
+		v1pb.SQLService_GetQueryHistoryStats_FullMethodName,
 		v1pb.SubscriptionService_GetSubscription_FullMethodName,
 		v1pb.SubscriptionService_GetFeatureMatrix_FullMethodName,
 		v1pb.SubscriptionService_UpdateSubscription_FullMethodName,
@@ -251,11 +322,16 @@ func isSkippedMethod(fullMethod string) bool {
 		v1pb.SheetService_SearchSheets_FullMethodName,
 		v1pb.SheetService_UpdateSheet_FullMethodName,
 		v1pb.SheetService_UpdateSheetOrganizer_FullMethodName,
-		v1pb.SheetService_DeleteSheet_FullMethodName:
+		v1pb.SheetService_DeleteSheet_FullMethodName,
+		v1pb.SheetService_ShareSheet_FullMethodName,
+		v1pb.SheetService_UnshareSheet_FullMethodName,
+		v1pb.SheetService_GetSharedSheet_FullMethodName,
+		v1pb.SheetService_ImportData_FullMethodName:
 		return true
 	// skip checking for custom approval.
 	case
 		v1pb.IssueService_ApproveIssue_FullMethodName,
+		v1pb.IssueService_BatchApproveIssues_FullMethodName,
 		v1pb.IssueService_RejectIssue_FullMethodName,
 		v1pb.IssueService_RequestIssue_FullMethodName:
 		return true
@@ -342,7 +418,7 @@ func (*ACLInterceptor) getProjectIDsForChangelistService(_ context.Context, req
 }
 
 func (*ACLInterceptor) getProjectIDsForRolloutService(_ context.Context, req any) ([]string, error) {
-	var projects, rollouts, plans, tasks, stages []string
+	var projects, rollouts, plans, tasks, stages, taskRuns, artifacts []string
 	switch r := req.(type) {
 	case *v1pb.GetRolloutRequest:
 		rollouts = append(rollouts, r.GetName())
@@ -350,6 +426,8 @@ func (*ACLInterceptor) getProjectIDsForRolloutService(_ context.Context, req any
 		projects = append(projects, r.GetParent())
 	case *v1pb.PreviewRolloutRequest:
 		projects = append(projects, r.GetProject())
+	case *v1pb.PreviewPlanRequest:
+		projects = append(projects, r.GetProject())
 	case *v1pb.GetPlanRequest:
 		plans = append(plans, r.GetName())
 	case *v1pb.CreatePlanRequest:
@@ -366,8 +444,22 @@ func (*ACLInterceptor) getProjectIDsForRolloutService(_ context.Context, req any
 		stages = append(stages, r.GetParent())
 	case *v1pb.BatchSkipTasksRequest:
 		stages = append(stages, r.GetParent())
+	case *v1pb.ApproveStageRequest:
+		stages = append(stages, r.GetName())
 	case *v1pb.BatchCancelTaskRunsRequest:
 		tasks = append(tasks, r.GetParent())
+	case *v1pb.BatchRetryFailedTasksRequest:
+		projects = append(projects, r.GetParent())
+	case *v1pb.BatchCancelPendingTaskRunsRequest:
+		projects = append(projects, r.GetParent())
+	case *v1pb.CutoverTaskRequest:
+		tasks = append(tasks, r.GetName())
+	case *v1pb.ListTaskRunArtifactsRequest:
+		taskRuns = append(taskRuns, r.GetParent())
+	case *v1pb.ListTaskRunLogEntriesRequest:
+		taskRuns = append(taskRuns, r.GetParent())
+	case *v1pb.GetTaskRunArtifactRequest:
+		artifacts = append(artifacts, r.GetName())
 	}
 
 	var projectIDs []string
@@ -406,6 +498,20 @@ func (*ACLInterceptor) getProjectIDsForRolloutService(_ context.Context, req any
 		}
 		projectIDs = append(projectIDs, projectID)
 	}
+	for _, taskRun := range taskRuns {
+		projectID, _, _, _, _, err := common.GetProjectIDRolloutIDStageIDTaskIDTaskRunID(taskRun)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse task run %q", taskRun)
+		}
+		projectIDs = append(projectIDs, projectID)
+	}
+	for _, artifact := range artifacts {
+		projectID, _, _, err := common.GetProjectIDTaskRunIDArtifactID(artifact)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse artifact %q", artifact)
+		}
+		projectIDs = append(projectIDs, projectID)
+	}
 
 	return uniq(projectIDs), nil
 }
@@ -448,7 +554,7 @@ func (*ACLInterceptor) getProjectIDsForBranchService(_ context.Context, req any)
 }
 
 func (*ACLInterceptor) getProjectIDsForProjectService(_ context.Context, req any) ([]string, error) {
-	var projects, projectDeploymentConfigs, projectWebhooks, projectGitopsInfos, databaseGroups, schemaGroups, protectionRules []string
+	var projects, projectDeploymentConfigs, projectWebhooks, projectWebhookDeliveries, projectGitopsInfos, databaseGroups, schemaGroups, protectionRules, issueForms, quotas, issueTemplates, issueSchedules []string
 
 	switch r := req.(type) {
 	case *v1pb.GetProjectRequest:
@@ -465,6 +571,8 @@ func (*ACLInterceptor) getProjectIDsForProjectService(_ context.Context, req any
 		projectDeploymentConfigs = append(projectDeploymentConfigs, r.GetName())
 	case *v1pb.UpdateDeploymentConfigRequest:
 		projectDeploymentConfigs = append(projectDeploymentConfigs, r.GetConfig().GetName())
+	case *v1pb.EvaluateDeploymentExpressionRequest:
+		projects = append(projects, r.GetProject())
 	case *v1pb.AddWebhookRequest:
 		projects = append(projects, r.GetProject())
 	case *v1pb.UpdateWebhookRequest:
@@ -473,6 +581,10 @@ func (*ACLInterceptor) getProjectIDsForProjectService(_ context.Context, req any
 		projectWebhooks = append(projectWebhooks, r.GetWebhook().GetName())
 	case *v1pb.TestWebhookRequest:
 		projects = append(projects, r.GetProject())
+	case *v1pb.ListWebhookDeliveriesRequest:
+		projectWebhooks = append(projectWebhooks, r.GetParent())
+	case *v1pb.RedeliverWebhookDeliveryRequest:
+		projectWebhookDeliveries = append(projectWebhookDeliveries, r.GetName())
 	case *v1pb.UpdateProjectGitOpsInfoRequest:
 		projectGitopsInfos = append(projectGitopsInfos, r.GetProjectGitopsInfo().GetName())
 	case *v1pb.UnsetProjectGitOpsInfoRequest:
@@ -501,6 +613,32 @@ func (*ACLInterceptor) getProjectIDsForProjectService(_ context.Context, req any
 		protectionRules = append(protectionRules, r.GetName())
 	case *v1pb.UpdateProjectProtectionRulesRequest:
 		protectionRules = append(protectionRules, r.GetProtectionRules().GetName())
+	case *v1pb.GetProjectIssueFormsRequest:
+		issueForms = append(issueForms, r.GetName())
+	case *v1pb.UpdateProjectIssueFormsRequest:
+		issueForms = append(issueForms, r.GetIssueForms().GetName())
+	case *v1pb.GetProjectQuotaRequest:
+		quotas = append(quotas, r.GetName())
+	case *v1pb.ListIssueTemplatesRequest:
+		projects = append(projects, r.GetParent())
+	case *v1pb.GetIssueTemplateRequest:
+		issueTemplates = append(issueTemplates, r.GetName())
+	case *v1pb.CreateIssueTemplateRequest:
+		projects = append(projects, r.GetParent())
+	case *v1pb.UpdateIssueTemplateRequest:
+		issueTemplates = append(issueTemplates, r.GetIssueTemplate().GetName())
+	case *v1pb.DeleteIssueTemplateRequest:
+		issueTemplates = append(issueTemplates, r.GetName())
+	case *v1pb.ListIssueSchedulesRequest:
+		projects = append(projects, r.GetParent())
+	case *v1pb.GetIssueScheduleRequest:
+		issueSchedules = append(issueSchedules, r.GetName())
+	case *v1pb.CreateIssueScheduleRequest:
+		projects = append(projects, r.GetParent())
+	case *v1pb.UpdateIssueScheduleRequest:
+		issueSchedules = append(issueSchedules, r.GetIssueSchedule().GetName())
+	case *v1pb.DeleteIssueScheduleRequest:
+		issueSchedules = append(issueSchedules, r.GetName())
 	}
 
 	var projectIDs []string
@@ -526,6 +664,13 @@ func (*ACLInterceptor) getProjectIDsForProjectService(_ context.Context, req any
 		}
 		projectIDs = append(projectIDs, projectID)
 	}
+	for _, projectWebhookDelivery := range projectWebhookDeliveries {
+		projectID, _, _, err := common.GetProjectIDWebhookIDDeliveryID(projectWebhookDelivery)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %q", projectWebhookDelivery)
+		}
+		projectIDs = append(projectIDs, projectID)
+	}
 	for _, projectGitopsInfo := range projectGitopsInfos {
 		projectID, err := common.TrimSuffixAndGetProjectID(projectGitopsInfo, common.GitOpsInfoSuffix)
 		if err != nil {
@@ -554,6 +699,34 @@ func (*ACLInterceptor) getProjectIDsForProjectService(_ context.Context, req any
 		}
 		projectIDs = append(projectIDs, projectID)
 	}
+	for _, issueForm := range issueForms {
+		projectID, err := common.TrimSuffixAndGetProjectID(issueForm, common.IssueFormsSuffix)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %q", issueForm)
+		}
+		projectIDs = append(projectIDs, projectID)
+	}
+	for _, quota := range quotas {
+		projectID, err := common.TrimSuffixAndGetProjectID(quota, common.QuotaSuffix)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %q", quota)
+		}
+		projectIDs = append(projectIDs, projectID)
+	}
+	for _, issueTemplate := range issueTemplates {
+		projectID, _, err := common.GetProjectIDIssueTemplateID(issueTemplate)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %q", issueTemplate)
+		}
+		projectIDs = append(projectIDs, projectID)
+	}
+	for _, issueSchedule := range issueSchedules {
+		projectID, _, err := common.GetProjectIDIssueScheduleID(issueSchedule)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %q", issueSchedule)
+		}
+		projectIDs = append(projectIDs, projectID)
+	}
 	return uniq(projectIDs), nil
 }
 
@@ -573,6 +746,14 @@ func (*ACLInterceptor) getProjectIDsForIssueService(_ context.Context, req any)
 		issueNames = append(issueNames, r.GetParent())
 	case *v1pb.BatchUpdateIssuesStatusRequest:
 		issueNames = append(issueNames, r.GetIssues()...)
+	case *v1pb.BatchUpdateIssuesRequest:
+		for _, request := range r.GetRequests() {
+			issueNames = append(issueNames, request.GetIssue().GetName())
+		}
+	case *v1pb.UnarchiveIssueRequest:
+		issueNames = append(issueNames, r.GetName())
+	case *v1pb.GetIssueDependencyGraphRequest:
+		issueNames = append(issueNames, r.GetName())
 	}
 
 	var projectIDs []string
@@ -598,6 +779,8 @@ func (in *ACLInterceptor) getProjectIDsForDatabaseService(ctx context.Context, r
 		databaseNames = append(databaseNames, r.GetName())
 	case *v1pb.AdviseIndexRequest:
 		databaseNames = append(databaseNames, r.GetParent())
+	case *v1pb.AdviseWorkloadIndexesRequest:
+		databaseNames = append(databaseNames, r.GetParent())
 	case *v1pb.GetDatabaseMetadataRequest:
 		databaseName, err := common.TrimSuffix(r.GetName(), "/metadata")
 		if err != nil {
@@ -636,6 +819,18 @@ func (in *ACLInterceptor) getProjectIDsForDatabaseService(ctx context.Context, r
 			return nil, errors.Wrapf(err, "failed to get databaseName from %q", r.GetName())
 		}
 		databaseNames = append(databaseNames, databaseName)
+	case *v1pb.GetDatabaseCommentsRequest:
+		databaseName, err := common.TrimSuffix(r.GetName(), "/comments")
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get databaseName from %q", r.GetName())
+		}
+		databaseNames = append(databaseNames, databaseName)
+	case *v1pb.UpdateDatabaseCommentsRequest:
+		databaseName, err := common.TrimSuffix(r.GetDatabaseComments().GetName(), "/comments")
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get databaseName from %q", r.GetDatabaseComments().GetName())
+		}
+		databaseNames = append(databaseNames, databaseName)
 	case *v1pb.GetBackupSettingRequest:
 		databaseName, err := common.TrimSuffix(r.GetName(), "/backupSetting")
 		if err != nil {
@@ -668,6 +863,29 @@ func (in *ACLInterceptor) getProjectIDsForDatabaseService(ctx context.Context, r
 		databaseNames = append(databaseNames, common.FormatDatabase(instance, database))
 	case *v1pb.ListChangeHistoriesRequest:
 		databaseNames = append(databaseNames, r.GetParent())
+	case *v1pb.CheckChangeHistoryIntegrityRequest:
+		databaseNames = append(databaseNames, r.GetParent())
+	case *v1pb.ImportChangeHistoriesRequest:
+		databaseNames = append(databaseNames, r.GetParent())
+	case *v1pb.ExportChangeHistoriesRequest:
+		databaseNames = append(databaseNames, r.GetParent())
+	case *v1pb.CreateSandboxDatabaseRequest:
+		databaseNames = append(databaseNames, r.GetParent())
+	case *v1pb.ListSandboxDatabasesRequest:
+		databaseNames = append(databaseNames, r.GetParent())
+	case *v1pb.ProvisionTenantDatabaseRequest:
+		// The tenant database doesn't exist yet.
+		projectID, err := common.GetProjectID(r.GetProject())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get projectID from %q", r.GetProject())
+		}
+		projectIDs = append(projectIDs, projectID)
+	case *v1pb.GetSlowQueryReportRequest:
+		projectID, err := common.TrimSuffixAndGetProjectID(r.GetName(), common.SlowQueryReportSuffix)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get projectID from %q", r.GetName())
+		}
+		projectIDs = append(projectIDs, projectID)
 	case *v1pb.GetChangeHistoryRequest:
 		instance, database, _, err := common.GetInstanceDatabaseIDChangeHistory(r.GetName())
 		if err != nil {
@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestHasCustomRolePermission(t *testing.T) {
+	a := require.New(t)
+
+	iamManager, err := iam.NewManager(nil)
+	a.NoError(err)
+	in := &ACLInterceptor{iamManager: iamManager, profile: &config.Profile{}}
+	ctx := context.Background()
+	// The predefined workspace DBA role has the permission bb.settings.set, but it's not a custom role.
+	user := &store.UserMessage{ID: 101, Role: api.WorkspaceMember, Roles: []api.Role{api.WorkspaceDBA}}
+
+	ok, err := in.hasCustomRolePermission(ctx, "/bytebase.v1.UnknownService/UnknownMethod", nil, user)
+	a.NoError(err)
+	a.False(ok)
+
+	ok, err = in.hasCustomRolePermission(ctx, v1pb.SettingService_SetSetting_FullMethodName, &v1pb.SetSettingRequest{}, user)
+	a.NoError(err)
+	a.False(ok)
+
+	err = in.aclInterceptorDo(ctx, v1pb.SettingService_SetSetting_FullMethodName, &v1pb.SetSettingRequest{}, user)
+	a.Equal(codes.PermissionDenied, status.Code(err))
+
+	// The project quotas are set by the workspace owners and DBAs only, not by the project owners.
+	a.True(isOwnerAndDBAMethod(v1pb.ProjectService_UpdateProjectQuota_FullMethodName))
+	a.False(isProjectOwnerMethod(v1pb.ProjectService_UpdateProjectQuota_FullMethodName))
+	a.Equal(iam.PermissionSettingsSet, methodPermissionMap[v1pb.ProjectService_UpdateProjectQuota_FullMethodName])
+	err = in.aclInterceptorDo(ctx, v1pb.ProjectService_UpdateProjectQuota_FullMethodName, &v1pb.UpdateProjectQuotaRequest{
+		Quota: &v1pb.ProjectQuota{Name: "projects/p1/quota"},
+	}, user)
+	a.Equal(codes.PermissionDenied, status.Code(err))
+}
@@ -49,6 +49,13 @@ func (s *ActuatorService) UpdateActuatorInfo(ctx context.Context, request *v1pb.
 			}
 			log.LogLevel.Set(level)
 		}
+		if path == "component_log_levels" {
+			levels, err := log.ParseComponentLevels(request.Actuator.ComponentLogLevels)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			log.SetComponentLevels(levels)
+		}
 	}
 
 	return s.getServerInfo(ctx)
@@ -97,23 +104,40 @@ func (s *ActuatorService) getServerInfo(ctx context.Context) (*v1pb.ActuatorInfo
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
+	maintenance, err := s.store.GetMaintenanceSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find maintenance setting: %v", err)
+	}
+
 	serverInfo := v1pb.ActuatorInfo{
-		Version:          s.profile.Version,
-		GitCommit:        s.profile.GitCommit,
-		Readonly:         s.profile.Readonly,
-		Saas:             s.profile.SaaS,
-		DemoName:         s.profile.DemoName,
-		NeedAdminSetup:   count == 0,
-		ExternalUrl:      setting.ExternalUrl,
-		DisallowSignup:   setting.DisallowSignup,
-		Require_2Fa:      setting.Require_2Fa,
-		LastActiveTime:   timestamppb.New(time.Unix(s.profile.LastActiveTs, 0)),
-		WorkspaceId:      workspaceID,
-		GitopsWebhookUrl: setting.GitopsWebhookUrl,
-		Debug:            slog.Default().Enabled(ctx, slog.LevelDebug),
-		Lsp:              s.profile.Lsp,
-		PreUpdateBackup:  s.profile.PreUpdateBackup,
-		IamGuard:         s.profile.DevelopmentIAM,
+		Version:            s.profile.Version,
+		GitCommit:          s.profile.GitCommit,
+		Readonly:           s.profile.Readonly,
+		Saas:               s.profile.SaaS,
+		DemoName:           s.profile.DemoName,
+		NeedAdminSetup:     count == 0,
+		ExternalUrl:        setting.ExternalUrl,
+		DisallowSignup:     setting.DisallowSignup,
+		Require_2Fa:        setting.Require_2Fa,
+		LastActiveTime:     timestamppb.New(time.Unix(s.profile.LastActiveTs, 0)),
+		WorkspaceId:        workspaceID,
+		GitopsWebhookUrl:   setting.GitopsWebhookUrl,
+		Debug:              log.LogLevel.Level() <= slog.LevelDebug,
+		Lsp:                s.profile.Lsp,
+		PreUpdateBackup:    s.profile.PreUpdateBackup,
+		IamGuard:           s.profile.DevelopmentIAM,
+		ComponentLogLevels: log.FormatComponentLevels(log.GetComponentLevels()),
+		Maintenance: &v1pb.MaintenanceSetting{
+			Enabled: maintenance.Enabled,
+			Message: maintenance.Message,
+		},
+	}
+	if announcement := setting.Announcement; announcement != nil && announcement.Text != "" {
+		serverInfo.Announcement = &v1pb.Announcement{
+			Level: v1pb.Announcement_AlertLevel(announcement.Level),
+			Text:  announcement.Text,
+			Link:  announcement.Link,
+		}
 	}
 
 	return &serverInfo, nil
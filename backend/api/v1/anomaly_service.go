@@ -24,12 +24,13 @@ const (
 )
 
 var typesMap = map[string]api.AnomalyType{
-	"INSTANCE_CONNECTION":              api.AnomalyInstanceConnection,
-	"MIGRATION_SCHEMA":                 api.AnomalyInstanceMigrationSchema,
-	"DATABASE_BACKUP_POLICY_VIOLATION": api.AnomalyDatabaseBackupPolicyViolation,
-	"DATABASE_BACKUP_MISSING":          api.AnomalyDatabaseBackupMissing,
-	"DATABASE_CONNECTION":              api.AnomalyDatabaseConnection,
-	"DATABASE_SCHEMA_DRIFT":            api.AnomalyDatabaseSchemaDrift,
+	"INSTANCE_CONNECTION":               api.AnomalyInstanceConnection,
+	"MIGRATION_SCHEMA":                  api.AnomalyInstanceMigrationSchema,
+	"DATABASE_BACKUP_POLICY_VIOLATION":  api.AnomalyDatabaseBackupPolicyViolation,
+	"DATABASE_BACKUP_MISSING":           api.AnomalyDatabaseBackupMissing,
+	"DATABASE_CONNECTION":               api.AnomalyDatabaseConnection,
+	"DATABASE_SCHEMA_DRIFT":             api.AnomalyDatabaseSchemaDrift,
+	"DATABASE_CHANGE_HISTORY_INTEGRITY": api.AnomalyDatabaseChangeHistoryIntegrity,
 }
 
 // AnomalyService implements the anomaly service.
@@ -218,6 +219,17 @@ func (s *AnomalyService) convertToAnomaly(ctx context.Context, anomaly *store.An
 				ActualSchema:   detail.Actual,
 			},
 		}
+	case api.AnomalyDatabaseChangeHistoryIntegrity:
+		var detail api.AnomalyDatabaseChangeHistoryIntegrityPayload
+		if err := json.Unmarshal([]byte(anomaly.Payload), &detail); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal database change history integrity anomaly payload")
+		}
+		pbAnomaly.Type = v1pb.Anomaly_DATABASE_CHANGE_HISTORY_INTEGRITY
+		pbAnomaly.Detail = &v1pb.Anomaly_DatabaseChangeHistoryIntegrityDetail_{
+			DatabaseChangeHistoryIntegrityDetail: &v1pb.Anomaly_DatabaseChangeHistoryIntegrityDetail{
+				Violations: convertToChangeHistoryIntegrityViolations(pbAnomaly.Resource, detail.Violations),
+			},
+		}
 	}
 	pbAnomaly.Severity = getSeverityFromAnomalyType(pbAnomaly.Type)
 	return pbAnomaly, nil
@@ -229,7 +241,7 @@ func getSeverityFromAnomalyType(tp v1pb.Anomaly_AnomalyType) v1pb.Anomaly_Anomal
 		return v1pb.Anomaly_MEDIUM
 	case v1pb.Anomaly_DATABASE_BACKUP_MISSING:
 		return v1pb.Anomaly_HIGH
-	case v1pb.Anomaly_INSTANCE_CONNECTION, v1pb.Anomaly_MIGRATION_SCHEMA, v1pb.Anomaly_DATABASE_CONNECTION, v1pb.Anomaly_DATABASE_SCHEMA_DRIFT:
+	case v1pb.Anomaly_INSTANCE_CONNECTION, v1pb.Anomaly_MIGRATION_SCHEMA, v1pb.Anomaly_DATABASE_CONNECTION, v1pb.Anomaly_DATABASE_SCHEMA_DRIFT, v1pb.Anomaly_DATABASE_CHANGE_HISTORY_INTEGRITY:
 		return v1pb.Anomaly_CRITICAL
 	}
 	return v1pb.Anomaly_ANOMALY_SEVERITY_UNSPECIFIED
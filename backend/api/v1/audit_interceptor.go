@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/api/auth"
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/auditlog"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// unauditedMethods are the methods neither mutating the resources nor reading the data of the databases,
+// besides the read-only methods.
+var unauditedMethods = map[string]bool{
+	v1pb.ActuatorService_DeleteCache_FullMethodName:  true,
+	v1pb.CelService_BatchParse_FullMethodName:        true,
+	v1pb.CelService_BatchDeparse_FullMethodName:      true,
+	v1pb.SQLService_Pretty_FullMethodName:            true,
+	v1pb.SQLService_Check_FullMethodName:             true,
+	v1pb.SQLService_DifferPreview_FullMethodName:     true,
+	v1pb.SQLService_StringifyMetadata_FullMethodName: true,
+	// The rows of the cursors are audited by the OpenQueryCursor.
+	v1pb.SQLService_FetchQueryCursor_FullMethodName: true,
+	v1pb.SQLService_CloseQueryCursor_FullMethodName: true,
+}
+
+// AuditInterceptor is the v1 audit interceptor for gRPC server.
+// It records the audit logs of the mutating methods and the SQL editor queries and exports,
+// including the ones denied by the ACL.
+type AuditInterceptor struct {
+	store *store.Store
+}
+
+// NewAuditInterceptor returns a new v1 API audit interceptor.
+func NewAuditInterceptor(store *store.Store) *AuditInterceptor {
+	return &AuditInterceptor{
+		store: store,
+	}
+}
+
+// AuditInterceptor is the unary interceptor for gRPC API.
+func (in *AuditInterceptor) AuditInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if !isAuditedMethod(serverInfo.FullMethod) {
+		return handler(ctx, request)
+	}
+	startTime := time.Now()
+	response, err := handler(ctx, request)
+	in.createAuditLog(ctx, serverInfo.FullMethod, request, err, time.Since(startTime))
+	return response, err
+}
+
+// AuditStreamInterceptor is the stream interceptor for gRPC API.
+// An audit log is created for every request received, e.g. every statement of the AdminExecute.
+func (in *AuditInterceptor) AuditStreamInterceptor(request any, ss grpc.ServerStream, serverInfo *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !isAuditedMethod(serverInfo.FullMethod) {
+		return handler(request, ss)
+	}
+	return handler(request, &auditServerStream{ServerStream: ss, interceptor: in, fullMethod: serverInfo.FullMethod})
+}
+
+type auditServerStream struct {
+	grpc.ServerStream
+	interceptor *AuditInterceptor
+	fullMethod  string
+}
+
+func (s *auditServerStream) RecvMsg(m any) error {
+	startTime := time.Now()
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.interceptor.createAuditLog(s.Context(), s.fullMethod, m, nil, time.Since(startTime))
+	}
+	return err
+}
+
+func (in *AuditInterceptor) createAuditLog(ctx context.Context, fullMethod string, request any, err error, latency time.Duration) {
+	principalID, _ := ctx.Value(common.PrincipalIDContextKey).(int)
+	ipAddress, userAgent := auth.GetClientInfoFromContext(ctx)
+	st := status.Convert(err)
+	payload := &storepb.AuditLogPayload{
+		Request:   auditlog.GetRequestJSON(request),
+		Status:    st.Code().String(),
+		IpAddress: ipAddress,
+		UserAgent: userAgent,
+		Latency:   durationpb.New(latency),
+	}
+	if st.Code() != codes.OK {
+		payload.Error = st.Message()
+	}
+	// The audit log is created even if the request is canceled.
+	if err := in.store.CreateAuditLog(context.WithoutCancel(ctx), &store.AuditLogMessage{
+		CreatorUID: principalID,
+		Method:     fullMethod,
+		Resource:   auditlog.GetResource(request),
+		Payload:    payload,
+	}); err != nil {
+		slog.Error("Failed to create audit log", slog.String("method", fullMethod), log.BBError(err))
+	}
+}
+
+// isAuditedMethod returns whether the method mutates the resources, or queries or exports the data in the SQL editor.
+func isAuditedMethod(fullMethod string) bool {
+	return !isReadOnlyMethod(fullMethod) && !unauditedMethods[fullMethod]
+}
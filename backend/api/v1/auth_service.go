@@ -22,15 +22,16 @@ import (
 
 	"github.com/bytebase/bytebase/backend/api/auth"
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/activity"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	metricapi "github.com/bytebase/bytebase/backend/metric"
-	"github.com/bytebase/bytebase/backend/plugin/idp/ldap"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oauth2"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oidc"
 	"github.com/bytebase/bytebase/backend/plugin/metric"
+	"github.com/bytebase/bytebase/backend/runner/ldapsync"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -46,27 +47,29 @@ var (
 // AuthService implements the auth service.
 type AuthService struct {
 	v1pb.UnimplementedAuthServiceServer
-	store          *store.Store
-	secret         string
-	tokenDuration  time.Duration
-	licenseService enterprise.LicenseService
-	metricReporter *metricreport.Reporter
-	profile        *config.Profile
-	stateCfg       *state.State
-	postCreateUser func(ctx context.Context, user *store.UserMessage, firstEndUser bool) error
+	store           *store.Store
+	secret          string
+	tokenDuration   time.Duration
+	licenseService  enterprise.LicenseService
+	metricReporter  *metricreport.Reporter
+	profile         *config.Profile
+	stateCfg        *state.State
+	postCreateUser  func(ctx context.Context, user *store.UserMessage, firstEndUser bool) error
+	activityManager *activity.Manager
 }
 
 // NewAuthService creates a new AuthService.
-func NewAuthService(store *store.Store, secret string, tokenDuration time.Duration, licenseService enterprise.LicenseService, metricReporter *metricreport.Reporter, profile *config.Profile, stateCfg *state.State, postCreateUser func(ctx context.Context, user *store.UserMessage, firstEndUser bool) error) (*AuthService, error) {
+func NewAuthService(store *store.Store, secret string, tokenDuration time.Duration, licenseService enterprise.LicenseService, metricReporter *metricreport.Reporter, profile *config.Profile, stateCfg *state.State, postCreateUser func(ctx context.Context, user *store.UserMessage, firstEndUser bool) error, activityManager *activity.Manager) (*AuthService, error) {
 	return &AuthService{
-		store:          store,
-		secret:         secret,
-		tokenDuration:  tokenDuration,
-		licenseService: licenseService,
-		metricReporter: metricReporter,
-		profile:        profile,
-		stateCfg:       stateCfg,
-		postCreateUser: postCreateUser,
+		store:           store,
+		secret:          secret,
+		tokenDuration:   tokenDuration,
+		licenseService:  licenseService,
+		metricReporter:  metricReporter,
+		profile:         profile,
+		stateCfg:        stateCfg,
+		postCreateUser:  postCreateUser,
+		activityManager: activityManager,
 	}, nil
 }
 
@@ -249,7 +252,7 @@ func (s *AuthService) CreateUser(ctx context.Context, request *v1pb.CreateUserRe
 		Level:        api.ActivityInfo,
 		Payload:      string(bytes),
 	}
-	if _, err := s.store.CreateActivityV2(ctx, activityCreate); err != nil {
+	if _, err := s.activityManager.CreateActivity(ctx, activityCreate, &activity.Metadata{}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create activity, error: %v", err)
 	}
 	userResponse := convertToUser(user)
@@ -341,12 +344,18 @@ func (s *AuthService) UpdateUser(ctx context.Context, request *v1pb.UpdateUserRe
 			}
 			patch.Role = &userRole
 		case "roles":
+			if role != api.WorkspaceAdmin {
+				return nil, status.Errorf(codes.PermissionDenied, "only workspace owner can update user roles")
+			}
 			var roles []api.Role
 			for _, r := range request.User.Roles {
 				roleID, err := common.GetRoleID(r)
 				if err != nil {
 					return nil, status.Errorf(codes.InvalidArgument, err.Error())
 				}
+				if err := s.validateWorkspaceRole(ctx, api.Role(roleID)); err != nil {
+					return nil, err
+				}
 				roles = append(roles, api.Role(roleID))
 			}
 			patch.Roles = &roles
@@ -433,6 +442,16 @@ func (s *AuthService) UpdateUser(ctx context.Context, request *v1pb.UpdateUserRe
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update user, error: %v", err)
 	}
+	if passwordPatch != nil {
+		// Sign out the user from the other sessions once the password is changed.
+		revoke := &store.RevokeUserSessionMessage{PrincipalUID: &userID}
+		if sessionID, ok := ctx.Value(common.SessionIDContextKey).(int); ok && principalID == userID {
+			revoke.ExcludeUID = &sessionID
+		}
+		if _, err := s.store.RevokeUserSessions(ctx, revoke, time.Now().Unix()); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to revoke sessions, error: %v", err)
+		}
+	}
 
 	userResponse := convertToUser(user)
 	if request.User.UserType == v1pb.UserType_SERVICE_ACCOUNT && passwordPatch != nil {
@@ -649,13 +668,21 @@ func (s *AuthService) Login(ctx context.Context, request *v1pb.LoginRequest) (*v
 
 	var accessToken string
 	if loginUser.Type == api.EndUser {
-		token, err := auth.GenerateAccessToken(loginUser.Name, loginUser.ID, s.profile.Mode, s.secret, s.tokenDuration)
+		session, err := s.createSession(ctx, loginUser, s.tokenDuration)
+		if err != nil {
+			return nil, err
+		}
+		token, err := auth.GenerateAccessToken(loginUser.Name, loginUser.ID, session.UID, s.profile.Mode, s.secret, s.tokenDuration)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to generate API access token")
 		}
 		accessToken = token
 	} else if loginUser.Type == api.ServiceAccount {
-		token, err := auth.GenerateAPIToken(loginUser.Name, loginUser.ID, s.profile.Mode, s.secret)
+		session, err := s.createSession(ctx, loginUser, auth.APITokenDuration)
+		if err != nil {
+			return nil, err
+		}
+		token, err := auth.GenerateAPIToken(loginUser.Name, loginUser.ID, session.UID, s.profile.Mode, s.secret)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to generate API access token")
 		}
@@ -696,6 +723,11 @@ func (s *AuthService) Logout(ctx context.Context, _ *v1pb.LogoutRequest) (*empty
 		return nil, status.Errorf(codes.Unauthenticated, err.Error())
 	}
 	s.stateCfg.ExpireCache.Add(accessTokenStr, true)
+	if sessionID, ok := ctx.Value(common.SessionIDContextKey).(int); ok {
+		if _, err := s.store.RevokeUserSessions(ctx, &store.RevokeUserSessionMessage{UID: &sessionID}, time.Now().Unix()); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to revoke session, error: %v", err)
+		}
+	}
 
 	if err := grpc.SetHeader(ctx, metadata.New(map[string]string{
 		auth.GatewayMetadataAccessTokenKey: "",
@@ -746,6 +778,8 @@ func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.
 	}
 
 	var userInfo *storepb.IdentityProviderUserInfo
+	// ldapGroups are the LDAP groups of the user if the LDAP identity provider has group mappings.
+	var ldapGroups []string
 	if idp.Type == storepb.IdentityProviderType_OAUTH2 {
 		oauth2Context := request.IdpContext.GetOauth2Context()
 		if oauth2Context == nil {
@@ -798,19 +832,7 @@ func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.
 		}
 	} else if idp.Type == storepb.IdentityProviderType_LDAP {
 		idpConfig := idp.Config.GetLdapConfig()
-		ldapIDP, err := ldap.NewIdentityProvider(
-			ldap.IdentityProviderConfig{
-				Host:             idpConfig.Host,
-				Port:             int(idpConfig.Port),
-				SkipTLSVerify:    idpConfig.SkipTlsVerify,
-				BindDN:           idpConfig.BindDn,
-				BindPassword:     idpConfig.BindPassword,
-				BaseDN:           idpConfig.BaseDn,
-				UserFilter:       idpConfig.UserFilter,
-				SecurityProtocol: ldap.SecurityProtocol(idpConfig.SecurityProtocol),
-				FieldMapping:     idpConfig.FieldMapping,
-			},
-		)
+		ldapIDP, err := ldapsync.NewIdentityProvider(idpConfig)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to create new LDAP identity provider: %v", err)
 		}
@@ -819,6 +841,15 @@ func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get user info: %v", err)
 		}
+		if len(idpConfig.GroupMappings) > 0 {
+			ldapGroups, err = ldapIDP.GetUserGroups(userInfo.Identifier)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get LDAP groups: %v", err)
+			}
+			if !ldapsync.IsMappedGroupMember(idpConfig.GroupMappings, ldapGroups) {
+				return nil, status.Errorf(codes.PermissionDenied, "user %q is not a member of any mapped LDAP group", userInfo.Identifier)
+			}
+		}
 	} else {
 		return nil, status.Errorf(codes.InvalidArgument, "identity provider type %s not supported", idp.Type.String())
 	}
@@ -876,6 +907,24 @@ func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.
 		user = users[0]
 	}
 
+	if idp.Type == storepb.IdentityProviderType_LDAP {
+		if err := s.store.UpsertIdentityProviderUser(ctx, &store.IdentityProviderUserMessage{
+			IdentityProviderUID: idp.UID,
+			PrincipalUID:        user.ID,
+			Identifier:          userInfo.Identifier,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to record LDAP user: %v", err)
+		}
+		if mappings := idp.Config.GetLdapConfig().GroupMappings; len(mappings) > 0 && !user.MemberDeleted {
+			if err := ldapsync.SyncUserRoles(ctx, s.store, mappings, user, ldapGroups); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to sync roles with LDAP groups: %v", err)
+			}
+			if user, err = s.store.GetUserByID(ctx, user.ID); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+			}
+		}
+	}
+
 	return user, nil
 }
 
@@ -969,6 +1018,25 @@ func generateRecoveryCodes(n int) ([]string, error) {
 	return recoveryCodes, nil
 }
 
+// validateWorkspaceRole validates the role granted in the workspace, which is either a built-in workspace role or a custom role.
+func (s *AuthService) validateWorkspaceRole(ctx context.Context, role api.Role) error {
+	switch role {
+	case api.WorkspaceAdmin, api.WorkspaceDBA, api.WorkspaceMember:
+		return nil
+	}
+	if err := s.licenseService.IsFeatureEnabled(api.FeatureCustomRole); err != nil {
+		return status.Errorf(codes.PermissionDenied, err.Error())
+	}
+	customRole, err := s.store.GetRole(ctx, role.String())
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get role, error: %v", err)
+	}
+	if customRole == nil {
+		return status.Errorf(codes.InvalidArgument, "role %q is neither a workspace role nor a custom role", common.FormatRole(role.String()))
+	}
+	return nil
+}
+
 func (s *AuthService) userCountGuard(ctx context.Context) error {
 	userLimit := s.licenseService.GetPlanLimitValue(ctx, enterprise.PlanLimitMaximumUser)
 
@@ -0,0 +1,311 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/api/auth"
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/activity"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// ListAPITokens lists the API tokens of a user.
+func (s *AuthService) ListAPITokens(ctx context.Context, request *v1pb.ListAPITokensRequest) (*v1pb.ListAPITokensResponse, error) {
+	user, err := s.getAPITokenUser(ctx, request.Parent, false /* create */)
+	if err != nil {
+		return nil, err
+	}
+	apiTokens, err := s.store.ListAPITokens(ctx, &store.FindAPITokenMessage{PrincipalUID: &user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list API tokens, error: %v", err)
+	}
+
+	response := &v1pb.ListAPITokensResponse{}
+	now := time.Now().Unix()
+	for _, apiToken := range apiTokens {
+		if !request.ShowInactive && getAPITokenState(apiToken, now) != v1pb.APIToken_ACTIVE {
+			continue
+		}
+		v1APIToken, err := s.convertToAPIToken(ctx, apiToken)
+		if err != nil {
+			return nil, err
+		}
+		response.ApiTokens = append(response.ApiTokens, v1APIToken)
+	}
+	return response, nil
+}
+
+// CreateAPIToken creates an API token of a user.
+func (s *AuthService) CreateAPIToken(ctx context.Context, request *v1pb.CreateAPITokenRequest) (*v1pb.APIToken, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	// Prevent the API tokens restricted by scopes and projects from creating unrestricted ones.
+	if _, ok := ctx.Value(common.APITokenContextKey).(*store.APITokenMessage); ok {
+		return nil, status.Errorf(codes.PermissionDenied, "API tokens cannot be created with an API token")
+	}
+	user, err := s.getAPITokenUser(ctx, request.Parent, true /* create */)
+	if err != nil {
+		return nil, err
+	}
+	if request.ApiToken == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "api_token must be set")
+	}
+	create, err := s.convertToStoreAPIToken(ctx, request.ApiToken)
+	if err != nil {
+		return nil, err
+	}
+	create.PrincipalUID = user.ID
+	token, tokenHash, err := auth.NewAPIToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate API token, error: %v", err)
+	}
+	create.TokenHash = tokenHash
+
+	apiToken, err := s.store.CreateAPIToken(ctx, create, principalID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create API token, error: %v", err)
+	}
+	if err := s.createAPITokenActivity(ctx, api.ActivityMemberAPITokenCreate, principalID, user, apiToken); err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	v1APIToken, err := s.convertToAPIToken(ctx, apiToken)
+	if err != nil {
+		return nil, err
+	}
+	v1APIToken.Token = token
+	return v1APIToken, nil
+}
+
+// RevokeAPIToken revokes an API token of a user.
+func (s *AuthService) RevokeAPIToken(ctx context.Context, request *v1pb.RevokeAPITokenRequest) (*v1pb.APIToken, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	userID, apiTokenID, err := common.GetUserIDAPITokenID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	user, err := s.getAPITokenUser(ctx, common.FormatUserUID(userID), false /* create */)
+	if err != nil {
+		return nil, err
+	}
+	apiToken, err := s.store.GetAPIToken(ctx, &store.FindAPITokenMessage{UID: &apiTokenID, PrincipalUID: &user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get API token, error: %v", err)
+	}
+	if apiToken == nil {
+		return nil, status.Errorf(codes.NotFound, "API token %q not found", request.Name)
+	}
+	if apiToken.RevokedTs != 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "API token %q has been revoked", request.Name)
+	}
+
+	apiToken, err = s.store.RevokeAPIToken(ctx, apiToken.UID, time.Now().Unix())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke API token, error: %v", err)
+	}
+	if err := s.createAPITokenActivity(ctx, api.ActivityMemberAPITokenRevoke, principalID, user, apiToken); err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return s.convertToAPIToken(ctx, apiToken)
+}
+
+// getAPITokenUser returns the user owning the API tokens.
+// Users can manage their own API tokens. The workspace owners can manage the API tokens of all users,
+// but can only create the API tokens of the service accounts, to avoid impersonating the end users.
+func (s *AuthService) getAPITokenUser(ctx context.Context, parent string, create bool) (*store.UserMessage, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	role, ok := ctx.Value(common.RoleContextKey).(api.Role)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "role not found")
+	}
+	userID, err := common.GetUserID(parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	user, err := s.store.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user %d not found", userID)
+	}
+	if user.MemberDeleted {
+		return nil, status.Errorf(codes.NotFound, "user %q has been deleted", userID)
+	}
+
+	if principalID == userID {
+		return user, nil
+	}
+	if role != api.WorkspaceAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "only workspace owner or user itself can manage the API tokens of user %d", userID)
+	}
+	if create && user.Type != api.ServiceAccount {
+		return nil, status.Errorf(codes.PermissionDenied, "workspace owner can only create the API tokens of service accounts")
+	}
+	return user, nil
+}
+
+func (s *AuthService) convertToStoreAPIToken(ctx context.Context, apiToken *v1pb.APIToken) (*store.APITokenMessage, error) {
+	if apiToken.Title == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "title must be set")
+	}
+	if len(apiToken.Scopes) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "scopes must be set")
+	}
+	payload := &storepb.APITokenPayload{}
+	for _, scope := range apiToken.Scopes {
+		storeScope := convertToStoreAPITokenScope(scope)
+		if storeScope == storepb.APITokenPayload_SCOPE_UNSPECIFIED {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid scope %v", scope)
+		}
+		payload.Scopes = append(payload.Scopes, storeScope)
+	}
+	for _, name := range apiToken.Projects {
+		projectID, err := common.GetProjectID(name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get project, error: %v", err)
+		}
+		if project == nil || project.Deleted {
+			return nil, status.Errorf(codes.NotFound, "project %q not found", name)
+		}
+		payload.Projects = append(payload.Projects, projectID)
+	}
+
+	create := &store.APITokenMessage{
+		Title:   apiToken.Title,
+		Payload: payload,
+	}
+	if apiToken.ExpireTime != nil {
+		if err := apiToken.ExpireTime.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expire_time, error: %v", err)
+		}
+		if !apiToken.ExpireTime.AsTime().After(time.Now()) {
+			return nil, status.Errorf(codes.InvalidArgument, "expire_time must be in the future")
+		}
+		create.ExpireTs = apiToken.ExpireTime.AsTime().Unix()
+	}
+	return create, nil
+}
+
+func (s *AuthService) convertToAPIToken(ctx context.Context, apiToken *store.APITokenMessage) (*v1pb.APIToken, error) {
+	creator, err := s.store.GetUserByID(ctx, apiToken.CreatorUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get creator, error: %v", err)
+	}
+	if creator == nil {
+		return nil, status.Errorf(codes.NotFound, "creator %d not found", apiToken.CreatorUID)
+	}
+	v1APIToken := &v1pb.APIToken{
+		Name:       fmt.Sprintf("%s%d/%s%d", common.UserNamePrefix, apiToken.PrincipalUID, common.APITokenPrefix, apiToken.UID),
+		Title:      apiToken.Title,
+		State:      getAPITokenState(apiToken, time.Now().Unix()),
+		Creator:    common.FormatUserEmail(creator.Email),
+		CreateTime: timestamppb.New(time.Unix(apiToken.CreatedTs, 0)),
+	}
+	for _, scope := range apiToken.Payload.Scopes {
+		v1APIToken.Scopes = append(v1APIToken.Scopes, convertToAPITokenScope(scope))
+	}
+	for _, projectID := range apiToken.Payload.Projects {
+		v1APIToken.Projects = append(v1APIToken.Projects, common.FormatProject(projectID))
+	}
+	if apiToken.ExpireTs != 0 {
+		v1APIToken.ExpireTime = timestamppb.New(time.Unix(apiToken.ExpireTs, 0))
+	}
+	if apiToken.LastUsedTs != 0 {
+		v1APIToken.LastUsedTime = timestamppb.New(time.Unix(apiToken.LastUsedTs, 0))
+	}
+	if apiToken.RevokedTs != 0 {
+		v1APIToken.RevokeTime = timestamppb.New(time.Unix(apiToken.RevokedTs, 0))
+	}
+	return v1APIToken, nil
+}
+
+func getAPITokenState(apiToken *store.APITokenMessage, now int64) v1pb.APIToken_State {
+	if apiToken.RevokedTs != 0 {
+		return v1pb.APIToken_REVOKED
+	}
+	if apiToken.ExpireTs != 0 && apiToken.ExpireTs <= now {
+		return v1pb.APIToken_EXPIRED
+	}
+	return v1pb.APIToken_ACTIVE
+}
+
+func convertToStoreAPITokenScope(scope v1pb.APIToken_Scope) storepb.APITokenPayload_Scope {
+	switch scope {
+	case v1pb.APIToken_ALL:
+		return storepb.APITokenPayload_ALL
+	case v1pb.APIToken_READ_ONLY:
+		return storepb.APITokenPayload_READ_ONLY
+	case v1pb.APIToken_SQL_CHECK:
+		return storepb.APITokenPayload_SQL_CHECK
+	case v1pb.APIToken_ISSUE_CREATE:
+		return storepb.APITokenPayload_ISSUE_CREATE
+	}
+	return storepb.APITokenPayload_SCOPE_UNSPECIFIED
+}
+
+func convertToAPITokenScope(scope storepb.APITokenPayload_Scope) v1pb.APIToken_Scope {
+	switch scope {
+	case storepb.APITokenPayload_ALL:
+		return v1pb.APIToken_ALL
+	case storepb.APITokenPayload_READ_ONLY:
+		return v1pb.APIToken_READ_ONLY
+	case storepb.APITokenPayload_SQL_CHECK:
+		return v1pb.APIToken_SQL_CHECK
+	case storepb.APITokenPayload_ISSUE_CREATE:
+		return v1pb.APIToken_ISSUE_CREATE
+	}
+	return v1pb.APIToken_SCOPE_UNSPECIFIED
+}
+
+func (s *AuthService) createAPITokenActivity(ctx context.Context, activityType api.ActivityType, principalID int, user *store.UserMessage, apiToken *store.APITokenMessage) error {
+	payload := &api.ActivityMemberAPITokenPayload{
+		PrincipalID:    user.ID,
+		PrincipalName:  user.Name,
+		PrincipalEmail: user.Email,
+		APIToken:       fmt.Sprintf("%s%d/%s%d", common.UserNamePrefix, user.ID, common.APITokenPrefix, apiToken.UID),
+		Title:          apiToken.Title,
+		Projects:       apiToken.Payload.Projects,
+		ExpireTs:       apiToken.ExpireTs,
+	}
+	for _, scope := range apiToken.Payload.Scopes {
+		payload.Scopes = append(payload.Scopes, scope.String())
+	}
+	bytes, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal activity payload")
+	}
+	if _, err := s.activityManager.CreateActivity(ctx, &store.ActivityMessage{
+		CreatorUID:   principalID,
+		ContainerUID: user.ID,
+		Type:         activityType,
+		Level:        api.ActivityInfo,
+		Payload:      string(bytes),
+	}, &activity.Metadata{}); err != nil {
+		return errors.Wrapf(err, "failed to create activity")
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/api/auth"
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// ListSessions lists the active sessions of a user.
+func (s *AuthService) ListSessions(ctx context.Context, request *v1pb.ListSessionsRequest) (*v1pb.ListSessionsResponse, error) {
+	user, err := s.getSessionUser(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.store.ListUserSessions(ctx, &store.FindUserSessionMessage{PrincipalUID: &user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list sessions, error: %v", err)
+	}
+	idleTimeout, err := auth.GetSessionIdleTimeout(ctx, s.store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get session idle timeout, error: %v", err)
+	}
+
+	response := &v1pb.ListSessionsResponse{}
+	now := time.Now()
+	for _, session := range sessions {
+		if !auth.IsSessionActive(session, idleTimeout, now) {
+			continue
+		}
+		response.Sessions = append(response.Sessions, convertToSession(ctx, session))
+	}
+	return response, nil
+}
+
+// RevokeSession revokes a session of a user, which signs out the user from the session immediately.
+func (s *AuthService) RevokeSession(ctx context.Context, request *v1pb.RevokeSessionRequest) (*v1pb.Session, error) {
+	userID, sessionID, err := common.GetUserIDSessionID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	user, err := s.getSessionUser(ctx, common.FormatUserUID(userID))
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.store.GetUserSession(ctx, &store.FindUserSessionMessage{UID: &sessionID, PrincipalUID: &user.ID, ShowRevoked: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get session, error: %v", err)
+	}
+	if session == nil {
+		return nil, status.Errorf(codes.NotFound, "session %q not found", request.Name)
+	}
+	if session.RevokedTs != 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "session %q has been revoked", request.Name)
+	}
+
+	sessions, err := s.store.RevokeUserSessions(ctx, &store.RevokeUserSessionMessage{UID: &session.UID}, time.Now().Unix())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke session, error: %v", err)
+	}
+	if len(sessions) != 1 {
+		return nil, status.Errorf(codes.FailedPrecondition, "session %q has been revoked", request.Name)
+	}
+	return convertToSession(ctx, sessions[0]), nil
+}
+
+// createSession creates a session of the user signing in with the client info of the request.
+// The oldest sessions of the end users are revoked if the maximum session count is exceeded.
+func (s *AuthService) createSession(ctx context.Context, user *store.UserMessage, tokenDuration time.Duration) (*store.UserSessionMessage, error) {
+	ipAddress, userAgent := auth.GetClientInfoFromContext(ctx)
+	session, err := s.store.CreateUserSession(ctx, &store.UserSessionMessage{
+		PrincipalUID: user.ID,
+		IPAddress:    ipAddress,
+		UserAgent:    userAgent,
+		ExpireTs:     time.Now().Add(tokenDuration).Unix(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create session, error: %v", err)
+	}
+	if user.Type != api.EndUser {
+		return session, nil
+	}
+
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find workspace setting, error: %v", err)
+	}
+	if setting.MaximumSessionCount <= 0 {
+		return session, nil
+	}
+	sessions, err := s.store.ListUserSessions(ctx, &store.FindUserSessionMessage{PrincipalUID: &user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list sessions, error: %v", err)
+	}
+	idleTimeout := time.Duration(0)
+	if setting.SessionIdleTimeout != nil && setting.SessionIdleTimeout.Seconds > 0 {
+		idleTimeout = setting.SessionIdleTimeout.AsDuration()
+	}
+	for _, staleSession := range getExceededSessions(sessions, int(setting.MaximumSessionCount), idleTimeout, time.Now()) {
+		if _, err := s.store.RevokeUserSessions(ctx, &store.RevokeUserSessionMessage{UID: &staleSession.UID}, time.Now().Unix()); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to revoke session, error: %v", err)
+		}
+	}
+	return session, nil
+}
+
+// getExceededSessions returns the active sessions exceeding the maximum session count.
+// The sessions are in the reverse creation order, so the oldest ones exceed.
+func getExceededSessions(sessions []*store.UserSessionMessage, maximumSessionCount int, idleTimeout time.Duration, now time.Time) []*store.UserSessionMessage {
+	var exceeded []*store.UserSessionMessage
+	count := 0
+	for _, session := range sessions {
+		if !auth.IsSessionActive(session, idleTimeout, now) {
+			continue
+		}
+		count++
+		if count > maximumSessionCount {
+			exceeded = append(exceeded, session)
+		}
+	}
+	return exceeded
+}
+
+// getSessionUser returns the user owning the sessions.
+// Users can manage their own sessions. The workspace owners can manage the sessions of all users.
+func (s *AuthService) getSessionUser(ctx context.Context, parent string) (*store.UserMessage, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	role, ok := ctx.Value(common.RoleContextKey).(api.Role)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "role not found")
+	}
+	userID, err := common.GetUserID(parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if principalID != userID && role != api.WorkspaceAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "only workspace owner or user itself can manage the sessions of user %d", userID)
+	}
+	user, err := s.store.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user %d not found", userID)
+	}
+	return user, nil
+}
+
+func convertToSession(ctx context.Context, session *store.UserSessionMessage) *v1pb.Session {
+	v1Session := &v1pb.Session{
+		Name:           fmt.Sprintf("%s%d/%s%d", common.UserNamePrefix, session.PrincipalUID, common.SessionPrefix, session.UID),
+		IpAddress:      session.IPAddress,
+		UserAgent:      session.UserAgent,
+		CreateTime:     timestamppb.New(time.Unix(session.CreatedTs, 0)),
+		LastActiveTime: timestamppb.New(time.Unix(session.LastActiveTs, 0)),
+		ExpireTime:     timestamppb.New(time.Unix(session.ExpireTs, 0)),
+	}
+	if session.RevokedTs != 0 {
+		v1Session.RevokeTime = timestamppb.New(time.Unix(session.RevokedTs, 0))
+	}
+	if sessionID, ok := ctx.Value(common.SessionIDContextKey).(int); ok && sessionID == session.UID {
+		v1Session.Current = true
+	}
+	return v1Session
+}
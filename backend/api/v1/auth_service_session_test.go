@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestGetExceededSessions(t *testing.T) {
+	a := require.New(t)
+
+	now := time.Date(2023, 7, 4, 8, 0, 0, 0, time.UTC)
+	newSession := func(uid int, lastActive time.Duration) *store.UserSessionMessage {
+		return &store.UserSessionMessage{
+			UID:          uid,
+			ExpireTs:     now.Add(time.Hour).Unix(),
+			LastActiveTs: now.Add(-lastActive).Unix(),
+		}
+	}
+	// In the reverse creation order.
+	latest := newSession(4, 0)
+	revoked := newSession(3, 0)
+	revoked.RevokedTs = now.Unix()
+	idle := newSession(2, 2*time.Hour)
+	oldest := newSession(1, time.Minute)
+	sessions := []*store.UserSessionMessage{latest, revoked, idle, oldest}
+
+	// The revoked and idle sessions don't count.
+	a.Equal([]*store.UserSessionMessage{oldest}, getExceededSessions(sessions, 1, time.Hour, now))
+	a.Empty(getExceededSessions(sessions, 2, time.Hour, now))
+	// Without the idle timeout, the idle session counts.
+	a.Equal([]*store.UserSessionMessage{idle, oldest}, getExceededSessions(sessions, 1, 0, now))
+}
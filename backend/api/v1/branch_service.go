@@ -10,6 +10,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -363,11 +364,21 @@ func (s *BranchService) MergeBranch(ctx context.Context, request *v1pb.MergeBran
 
 	// Restrict merging only when the head branch is not updated.
 	// Maybe we can support auto-merging in the future.
-	mergedMetadata, err := tryMerge(headBranch.Base.Metadata, headBranch.Head.Metadata, baseBranch.Head.Metadata)
+	mergedMetadata, conflicts, err := threeWayMerge(headBranch.Base.Metadata, headBranch.Head.Metadata, baseBranch.Head.Metadata)
 	if err != nil {
 		slog.Info("cannot merge branches", log.BBError(err))
 		return nil, status.Errorf(codes.Aborted, "cannot merge branches without conflict, error: %v", err)
 	}
+	if len(conflicts) > 0 {
+		slog.Info("cannot merge branches", slog.Int("conflicts", len(conflicts)))
+		st, err := status.New(codes.Aborted, fmt.Sprintf("cannot merge branches without conflict, error: merge conflict: %s", conflicts[0].message)).WithDetails(
+			&v1pb.BranchMergeConflicts{Conflicts: convertToBranchMergeConflicts(conflicts)},
+		)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to attach merge conflicts, error: %v", err)
+		}
+		return nil, st.Err()
+	}
 	if mergedMetadata == nil {
 		return nil, status.Errorf(codes.Internal, "merged metadata should not be nil if there is no error while merging (%+v, %+v, %+v)", headBranch.Base.Metadata, headBranch.Head.Metadata, baseBranch.Head.Metadata)
 	}
@@ -459,7 +470,11 @@ func (s *BranchService) RebaseBranch(ctx context.Context, request *v1pb.RebaseBr
 		}
 		newHeadConfig = baseBranch.Head.GetDatabaseConfig()
 	} else {
-		newHeadMetadata, err = tryMerge(baseBranch.Base.Metadata, baseBranch.Head.Metadata, filteredNewBaseMetadata)
+		var conflicts []*mergeConflict
+		newHeadMetadata, conflicts, err = threeWayMerge(baseBranch.Base.Metadata, baseBranch.Head.Metadata, filteredNewBaseMetadata)
+		if err == nil && len(conflicts) > 0 {
+			err = errors.Errorf("merge conflict: %s", conflicts[0].message)
+		}
 		if err != nil {
 			slog.Info("cannot rebase branches", log.BBError(err))
 			conflictSchema, err := diff3.Merge(
@@ -478,7 +493,10 @@ func (s *BranchService) RebaseBranch(ctx context.Context, request *v1pb.RebaseBr
 				return nil, status.Errorf(codes.Internal, "failed to read conflict schema, %v", err)
 			}
 			conflictSchemaString := string(sb)
-			return &v1pb.RebaseBranchResponse{Result: &v1pb.RebaseBranchResponse_ConflictSchema{ConflictSchema: conflictSchemaString}}, nil
+			return &v1pb.RebaseBranchResponse{
+				Result:    &v1pb.RebaseBranchResponse_ConflictSchema{ConflictSchema: conflictSchemaString},
+				Conflicts: convertToBranchMergeConflicts(conflicts),
+			}, nil
 		}
 		if newHeadMetadata == nil {
 			return nil, status.Errorf(codes.Internal, "merged metadata should not be nil if there is no error while merging (%+v, %+v, %+v)", baseBranch.Base.Metadata, baseBranch.Head.Metadata, filteredNewBaseMetadata)
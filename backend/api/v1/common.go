@@ -15,6 +15,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
@@ -400,6 +401,28 @@ func getPageToken(limit int, offset int) (string, error) {
 	})
 }
 
+// getKeysetPageToken returns the page token listing the rows after the row with the created timestamp and the ID.
+func getKeysetPageToken(limit int, createdTs int64, id int) (string, error) {
+	return marshalPageToken(&storepb.PageToken{
+		Limit: int32(limit),
+		Cursor: &storepb.PageCursor{
+			CreatedTs: createdTs,
+			Id:        int64(id),
+		},
+	})
+}
+
+// getKeysetCursor returns the keyset cursor of the page token, or nil if the page token uses the offset.
+func getKeysetCursor(pageToken *storepb.PageToken) *store.KeysetCursor {
+	if pageToken.Cursor == nil {
+		return nil
+	}
+	return &store.KeysetCursor{
+		CreatedTs: pageToken.Cursor.CreatedTs,
+		ID:        int(pageToken.Cursor.Id),
+	}
+}
+
 func marshalPageToken(pageToken *storepb.PageToken) (string, error) {
 	b, err := proto.Marshal(pageToken)
 	if err != nil {
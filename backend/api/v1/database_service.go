@@ -27,8 +27,11 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/activity"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/maskingpolicy"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -40,6 +43,7 @@ import (
 	"github.com/bytebase/bytebase/backend/runner/schemasync"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/store/model"
+	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
@@ -64,23 +68,27 @@ const (
 // DatabaseService implements the database service.
 type DatabaseService struct {
 	v1pb.UnimplementedDatabaseServiceServer
-	store          *store.Store
-	backupRunner   *backuprun.Runner
-	schemaSyncer   *schemasync.Syncer
-	licenseService enterprise.LicenseService
-	profile        *config.Profile
-	iamManager     *iam.Manager
+	store           *store.Store
+	backupRunner    *backuprun.Runner
+	schemaSyncer    *schemasync.Syncer
+	licenseService  enterprise.LicenseService
+	profile         *config.Profile
+	iamManager      *iam.Manager
+	dbFactory       *dbfactory.DBFactory
+	activityManager *activity.Manager
 }
 
 // NewDatabaseService creates a new DatabaseService.
-func NewDatabaseService(store *store.Store, br *backuprun.Runner, schemaSyncer *schemasync.Syncer, licenseService enterprise.LicenseService, profile *config.Profile, iamManager *iam.Manager) *DatabaseService {
+func NewDatabaseService(store *store.Store, br *backuprun.Runner, schemaSyncer *schemasync.Syncer, licenseService enterprise.LicenseService, profile *config.Profile, iamManager *iam.Manager, dbFactory *dbfactory.DBFactory, activityManager *activity.Manager) *DatabaseService {
 	return &DatabaseService{
-		store:          store,
-		backupRunner:   br,
-		schemaSyncer:   schemaSyncer,
-		licenseService: licenseService,
-		profile:        profile,
-		iamManager:     iamManager,
+		store:           store,
+		backupRunner:    br,
+		schemaSyncer:    schemaSyncer,
+		licenseService:  licenseService,
+		profile:         profile,
+		iamManager:      iamManager,
+		dbFactory:       dbFactory,
+		activityManager: activityManager,
 	}
 }
 
@@ -218,7 +226,7 @@ func filterPolicyDatabases(userID int, policy *store.IAMPolicyMessage, databases
 			if member.ID != userID && member.Email != api.AllUsers {
 				continue
 			}
-			if binding.Role != api.ProjectQuerier && binding.Role != api.ProjectExporter {
+			if binding.Role != api.ProjectQuerier && binding.Role != api.ProjectExporter && binding.Role != api.ProjectDMLExecutor {
 				return databases
 			}
 			expressionDBs := getDatabasesFromExpression(binding.Condition.Expression)
@@ -282,6 +290,11 @@ func (s *DatabaseService) UpdateDatabase(ctx context.Context, request *v1pb.Upda
 		return nil, err
 	}
 
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+
 	var project *store.ProjectMessage
 	patch := &store.UpdateDatabaseMessage{
 		InstanceID:   instanceID,
@@ -313,8 +326,37 @@ func (s *DatabaseService) UpdateDatabase(ctx context.Context, request *v1pb.Upda
 			if labels == nil {
 				labels = map[string]string{}
 			}
-			patch.MetadataUpsert = &storepb.DatabaseMetadata{
-				Labels: labels,
+			if patch.MetadataUpsert == nil {
+				patch.MetadataUpsert = &storepb.DatabaseMetadata{}
+			}
+			patch.MetadataUpsert.Labels = labels
+		case "freeze":
+			freeze := request.Database.Freeze
+			if freeze == nil {
+				patch.RemoveFreeze = true
+				continue
+			}
+			if freeze.Reason == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "freeze reason is required")
+			}
+			if freeze.ExpireTime != nil && !freeze.ExpireTime.AsTime().After(time.Now()) {
+				return nil, status.Errorf(codes.InvalidArgument, "freeze expire time must be in the future")
+			}
+			user, err := s.store.GetUserByID(ctx, principalID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+			}
+			if user == nil {
+				return nil, status.Errorf(codes.NotFound, "user %d not found", principalID)
+			}
+			if patch.MetadataUpsert == nil {
+				patch.MetadataUpsert = &storepb.DatabaseMetadata{}
+			}
+			patch.MetadataUpsert.Freeze = &storepb.DatabaseFreeze{
+				Reason:     freeze.Reason,
+				ExpireTime: freeze.ExpireTime,
+				Creator:    common.FormatUserEmail(user.Email),
+				CreateTime: timestamppb.Now(),
 			}
 		case "environment":
 			if request.Database.Environment == "" {
@@ -343,10 +385,6 @@ func (s *DatabaseService) UpdateDatabase(ctx context.Context, request *v1pb.Upda
 		}
 	}
 
-	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
-	if !ok {
-		return nil, status.Errorf(codes.Internal, "principal ID not found")
-	}
 	updatedDatabase, err := s.store.UpdateDatabase(ctx, patch, principalID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
@@ -562,20 +600,9 @@ func (s *DatabaseService) GetDatabaseMetadata(ctx context.Context, request *v1pb
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get masking rule policy, error: %v", err)
 		}
-		// Convert the maskingPolicy to a map to reduce the time complexity of searching.
-		maskingPolicy, err := s.store.GetMaskingPolicyByDatabaseUID(ctx, database.UID)
+		maskingPolicyResolver, err := maskingpolicy.Load(ctx, s.store, project, database)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to find masking policy for database %q", databaseName)
-		}
-		maskingPolicyMap := make(map[maskingPolicyKey]*storepb.MaskData)
-		if maskingPolicy != nil {
-			for _, maskData := range maskingPolicy.MaskData {
-				maskingPolicyMap[maskingPolicyKey{
-					schema: maskData.Schema,
-					table:  maskData.Table,
-					column: maskData.Column,
-				}] = maskData
-			}
+			return nil, err
 		}
 
 		evaluator := newEmptyMaskingLevelEvaluator().withDataClassificationSetting(dataClassificationSetting).withMaskingRulePolicy(maskingRulePolicy)
@@ -588,7 +615,7 @@ func (s *DatabaseService) GetDatabaseMetadata(ctx context.Context, request *v1pb
 					continue
 				}
 				for _, column := range table.Columns {
-					maskingLevel, err := evaluator.evaluateMaskingLevelOfColumn(database, schema.Name, table.Name, column.Name, column.Classification, project.DataClassificationConfigID, maskingPolicyMap, nil /* Exceptions*/)
+					maskingLevel, err := evaluator.evaluateMaskingLevelOfColumn(database, schema.Name, table.Name, column.Name, column.Classification, project.DataClassificationConfigID, maskingPolicyResolver, nil /* Exceptions*/)
 					if err != nil {
 						return nil, status.Errorf(codes.Internal, "failed to evaluate masking level of column %q, error: %v", column.Name, err)
 					}
@@ -849,21 +876,52 @@ func (s *DatabaseService) ListBackups(ctx context.Context, request *v1pb.ListBac
 		return nil, err
 	}
 
+	if request.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "page size must be non-negative: %d", request.PageSize)
+	}
 	rowStatus := api.Normal
-	existedBackupList, err := s.store.ListBackupV2(ctx, &store.FindBackupMessage{
+	backupFind := &store.FindBackupMessage{
 		DatabaseUID: &database.UID,
 		RowStatus:   &rowStatus,
-	})
+	}
+	// All backups are returned if the page size is unspecified.
+	limit := int(request.PageSize)
+	if request.PageToken != "" {
+		var pageToken storepb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		backupFind.After = getKeysetCursor(&pageToken)
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	if limit > 0 {
+		limitPlusOne := limit + 1
+		backupFind.Limit = &limitPlusOne
+	}
+	existedBackupList, err := s.store.ListBackupV2(ctx, backupFind)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
+	nextPageToken := ""
+	if limit > 0 && len(existedBackupList) == limit+1 {
+		existedBackupList = existedBackupList[:limit]
+		last := existedBackupList[limit-1]
+		if nextPageToken, err = getKeysetPageToken(limit, last.CreatedTs, last.UID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+
 	var backupList []*v1pb.Backup
 	for _, existedBackup := range existedBackupList {
 		backupList = append(backupList, convertToBackup(existedBackup, instance.ResourceID, database.DatabaseName))
 	}
 	return &v1pb.ListBackupsResponse{
-		Backups: backupList,
+		Backups:       backupList,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -908,6 +966,14 @@ func (s *DatabaseService) CreateBackup(ctx context.Context, request *v1pb.Create
 		return nil, status.Errorf(codes.AlreadyExists, "backup %q in database %q already exists", backupName, databaseName)
 	}
 
+	remainingBytes, err := utils.GetRemainingBackupStorageBytes(ctx, s.store, database.ProjectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if remainingBytes == 0 {
+		return nil, status.Errorf(codes.ResourceExhausted, "the backup storage quota of project %q is exhausted", database.ProjectID)
+	}
+
 	creatorID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "principal ID not found")
@@ -1020,6 +1086,45 @@ func (s *DatabaseService) ListChangeHistories(ctx context.Context, request *v1pb
 	}, nil
 }
 
+// CheckChangeHistoryIntegrity checks the integrity of the change histories of a database.
+func (s *DatabaseService) CheckChangeHistoryIntegrity(ctx context.Context, request *v1pb.CheckChangeHistoryIntegrityRequest) (*v1pb.CheckChangeHistoryIntegrityResponse, error) {
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
+		ResourceID: &instanceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+	if err := s.checkDatabasePermission(ctx, database.ProjectID, api.ProjectPermissionManageGeneral); err != nil {
+		return nil, err
+	}
+
+	violations, err := utils.CheckChangeHistoryIntegrity(ctx, s.store, instance, database)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check change history integrity, error: %v", err)
+	}
+	return &v1pb.CheckChangeHistoryIntegrityResponse{
+		Violations: convertToChangeHistoryIntegrityViolations(common.FormatDatabase(instance.ResourceID, database.DatabaseName), violations),
+	}, nil
+}
+
 // GetChangeHistory gets a change history.
 func (s *DatabaseService) GetChangeHistory(ctx context.Context, request *v1pb.GetChangeHistoryRequest) (*v1pb.ChangeHistory, error) {
 	instanceID, databaseName, changeHistoryIDStr, err := common.GetInstanceDatabaseIDChangeHistory(request.Name)
@@ -1256,6 +1361,26 @@ func convertToChangeHistory(h *store.InstanceChangeHistoryMessage) (*v1pb.Change
 	return v1pbHistory, nil
 }
 
+func convertToChangeHistoryIntegrityViolations(databaseName string, violations []*api.ChangeHistoryIntegrityViolation) []*v1pb.ChangeHistoryIntegrityViolation {
+	var result []*v1pb.ChangeHistoryIntegrityViolation
+	for _, violation := range violations {
+		tp := v1pb.ChangeHistoryIntegrityViolation_TYPE_UNSPECIFIED
+		switch violation.Type {
+		case api.ChangeHistoryIntegrityChecksumMismatch:
+			tp = v1pb.ChangeHistoryIntegrityViolation_CHECKSUM_MISMATCH
+		case api.ChangeHistoryIntegrityOutOfOrderVersion:
+			tp = v1pb.ChangeHistoryIntegrityViolation_OUT_OF_ORDER_VERSION
+		}
+		result = append(result, &v1pb.ChangeHistoryIntegrityViolation{
+			ChangeHistory: fmt.Sprintf("%s/%s%s", databaseName, common.ChangeHistoryPrefix, violation.ChangeHistoryUID),
+			Version:       violation.Version,
+			Type:          tp,
+			Detail:        violation.Detail,
+		})
+	}
+	return result
+}
+
 func convertToChangedResources(r *storepb.ChangedResources) *v1pb.ChangedResources {
 	if r == nil {
 		return nil
@@ -1368,6 +1493,10 @@ func convertToChangeHistorySource(source db.MigrationSource) v1pb.ChangeHistory_
 		return v1pb.ChangeHistory_VCS
 	case db.LIBRARY:
 		return v1pb.ChangeHistory_LIBRARY
+	case db.FLYWAY:
+		return v1pb.ChangeHistory_FLYWAY
+	case db.LIQUIBASE:
+		return v1pb.ChangeHistory_LIQUIBASE
 	default:
 		return v1pb.ChangeHistory_SOURCE_UNSPECIFIED
 	}
@@ -1863,6 +1992,37 @@ func (s *DatabaseService) ListSlowQueries(ctx context.Context, request *v1pb.Lis
 	return result, nil
 }
 
+// GetSlowQueryReport gets the week over week slow query report of the project.
+func (s *DatabaseService) GetSlowQueryReport(ctx context.Context, request *v1pb.GetSlowQueryReportRequest) (*v1pb.SlowQueryReport, error) {
+	projectID, err := common.TrimSuffixAndGetProjectID(request.Name, common.SlowQueryReportSuffix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if request.RegressionFactor < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "regression factor must not be negative")
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if project == nil || project.Deleted {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+	}
+
+	factor := request.RegressionFactor
+	if factor == 0 {
+		factor = project.Setting.GetSlowQueryRegressionFactor()
+	}
+	if factor == 0 {
+		factor = utils.DefaultSlowQueryRegressionFactor
+	}
+	report, err := utils.GetProjectSlowQueryReport(ctx, s.store, projectID, time.Now(), factor)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get slow query report, error: %v", err)
+	}
+	return report, nil
+}
+
 func sortSlowQueryLogResponse(response *v1pb.ListSlowQueriesResponse, orderByKeys []orderByKey) (*v1pb.ListSlowQueriesResponse, error) {
 	if len(orderByKeys) == 0 {
 		orderByKeys = []orderByKey{
@@ -2047,9 +2207,22 @@ func (s *DatabaseService) convertToDatabase(ctx context.Context, database *store
 		SchemaVersion:        database.SchemaVersion.Version,
 		Labels:               database.Metadata.Labels,
 		InstanceResource:     convertToInstanceResource(instance),
+		Freeze:               convertToDatabaseFreeze(utils.GetDatabaseFreeze(database)),
 	}, nil
 }
 
+func convertToDatabaseFreeze(freeze *storepb.DatabaseFreeze) *v1pb.DatabaseFreeze {
+	if freeze == nil {
+		return nil
+	}
+	return &v1pb.DatabaseFreeze{
+		Reason:     freeze.Reason,
+		ExpireTime: freeze.ExpireTime,
+		Creator:    freeze.Creator,
+		CreateTime: freeze.CreateTime,
+	}
+}
+
 type metadataFilter struct {
 	schema string
 	table  string
@@ -2088,7 +2261,7 @@ func (s *DatabaseService) createTransferProjectActivity(ctx context.Context, new
 			},
 		)
 	}
-	if _, err := s.store.BatchCreateActivityV2(ctx, creates); err != nil {
+	if _, err := s.activityManager.BatchCreateAuditActivities(ctx, creates...); err != nil {
 		slog.Warn("failed to create activities for database project updates", log.BBError(err))
 	}
 	return nil
@@ -2324,6 +2497,72 @@ func (s *DatabaseService) AdviseIndex(ctx context.Context, request *v1pb.AdviseI
 	}
 }
 
+// AdviseWorkloadIndexes recommends the indexes for the slow queries of the database.
+func (s *DatabaseService) AdviseWorkloadIndexes(ctx context.Context, request *v1pb.AdviseWorkloadIndexesRequest) (*v1pb.AdviseWorkloadIndexesResponse, error) {
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance %s, error: %v", instanceID, err)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	if err := s.licenseService.IsFeatureEnabledForInstance(api.FeatureIndexAdvisor, instance); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	}
+	switch instance.Engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_POSTGRES:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "AdviseWorkloadIndexes is not implemented for engine: %v", instance.Engine)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database, error: %v", err)
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+
+	endTime := time.Now()
+	if request.EndTime != nil {
+		endTime = request.EndTime.AsTime()
+	}
+	startTime := endTime.AddDate(0, 0, -7)
+	if request.StartTime != nil {
+		startTime = request.StartTime.AsTime()
+	}
+	if !startTime.Before(endTime) {
+		return nil, status.Errorf(codes.InvalidArgument, "start time must be before end time")
+	}
+	logs, err := s.store.ListSlowQuery(ctx, &store.ListSlowQueryMessage{
+		InstanceUID:  &instance.UID,
+		DatabaseUID:  &database.UID,
+		StartLogDate: &startTime,
+		EndLogDate:   &endTime,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list slow queries, error: %v", err)
+	}
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database schema, error: %v", err)
+	}
+	if dbSchema == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "database schema %q not found", databaseName)
+	}
+
+	return &v1pb.AdviseWorkloadIndexesResponse{
+		Recommendations: utils.AdviseWorkloadIndexes(instance.Engine, database.DatabaseName, dbSchema.GetDatabaseMetadata(), logs),
+	}, nil
+}
+
 func (s *DatabaseService) mysqlAdviseIndex(ctx context.Context, request *v1pb.AdviseIndexRequest, instance *store.InstanceMessage, database *store.DatabaseMessage) (*v1pb.AdviseIndexResponse, error) {
 	openaiKeyName := api.SettingPluginOpenAIKey
 	key, err := s.store.GetSettingV2(ctx, &store.FindSettingMessage{Name: &openaiKeyName})
@@ -0,0 +1,226 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// GetDatabaseComments gets the comments of the tables and columns in the database.
+func (s *DatabaseService) GetDatabaseComments(ctx context.Context, request *v1pb.GetDatabaseCommentsRequest) (*v1pb.DatabaseComments, error) {
+	_, database, err := s.getCommentsDatabase(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkDatabasePermission(ctx, database.ProjectID, api.ProjectPermissionManageGeneral); err != nil {
+		return nil, err
+	}
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if dbSchema == nil {
+		return nil, status.Errorf(codes.NotFound, "database schema %q not found", database.DatabaseName)
+	}
+	return &v1pb.DatabaseComments{
+		Name:     fmt.Sprintf("%s%s/%s%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName, common.CommentsSuffix),
+		Comments: convertToSchemaObjectComments(dbSchema.GetMetadata()),
+	}, nil
+}
+
+// UpdateDatabaseComments generates the statement updating the comments and saves it to a sheet.
+func (s *DatabaseService) UpdateDatabaseComments(ctx context.Context, request *v1pb.UpdateDatabaseCommentsRequest) (*v1pb.UpdateDatabaseCommentsResponse, error) {
+	if request.DatabaseComments == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "database_comments must be set")
+	}
+	if len(request.DatabaseComments.Comments) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "comments must be set")
+	}
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	instance, database, err := s.getCommentsDatabase(ctx, request.DatabaseComments.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkDatabasePermission(ctx, database.ProjectID, api.ProjectPermissionManageGeneral); err != nil {
+		return nil, err
+	}
+	switch instance.Engine {
+	case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_POSTGRES:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "updating comments is not supported for engine %v", instance.Engine)
+	}
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if dbSchema == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "database schema %q not found", database.DatabaseName)
+	}
+
+	sourceMetadata := dbSchema.GetMetadata()
+	targetMetadata, ok := proto.Clone(sourceMetadata).(*storepb.DatabaseSchemaMetadata)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "failed to clone database metadata")
+	}
+	if err := applySchemaObjectComments(targetMetadata, request.DatabaseComments.Comments); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	statement, err := diffMetadataComments(instance.Engine, sourceMetadata, targetMetadata)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate the statement, error: %v", err)
+	}
+	if statement == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "the comments are not changed")
+	}
+	if request.ValidateOnly {
+		return &v1pb.UpdateDatabaseCommentsResponse{Statement: statement}, nil
+	}
+
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project %q, error: %v", database.ProjectID, err)
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", database.ProjectID)
+	}
+	sheet, err := s.store.CreateSheet(ctx, &store.SheetMessage{
+		CreatorID:   principalID,
+		ProjectUID:  project.UID,
+		DatabaseUID: &database.UID,
+		Title:       fmt.Sprintf("Update comments for database %q", database.DatabaseName),
+		Statement:   statement,
+		Visibility:  store.ProjectSheet,
+		Source:      store.SheetFromBytebaseArtifact,
+		Type:        store.SheetForSQL,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create sheet, error: %v", err)
+	}
+	return &v1pb.UpdateDatabaseCommentsResponse{
+		Statement: statement,
+		Sheet:     getResourceNameForSheet(project, sheet.UID),
+	}, nil
+}
+
+// getCommentsDatabase gets the instance and the database from the comments name.
+// Format: instances/{instance}/databases/{database}/comments.
+func (s *DatabaseService) getCommentsDatabase(ctx context.Context, name string) (*store.InstanceMessage, *store.DatabaseMessage, error) {
+	instanceID, databaseName, err := common.TrimSuffixAndGetInstanceDatabaseID(name, common.CommentsSuffix)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get instance %q, error: %v", instanceID, err)
+	}
+	if instance == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if database == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+	return instance, database, nil
+}
+
+func convertToSchemaObjectComments(metadata *storepb.DatabaseSchemaMetadata) []*v1pb.SchemaObjectComment {
+	var comments []*v1pb.SchemaObjectComment
+	for _, schema := range metadata.GetSchemas() {
+		for _, table := range schema.GetTables() {
+			comments = append(comments, &v1pb.SchemaObjectComment{
+				Schema:  schema.GetName(),
+				Table:   table.GetName(),
+				Comment: table.GetUserComment(),
+			})
+			for _, column := range table.GetColumns() {
+				comments = append(comments, &v1pb.SchemaObjectComment{
+					Schema:  schema.GetName(),
+					Table:   table.GetName(),
+					Column:  column.GetName(),
+					Comment: column.GetUserComment(),
+				})
+			}
+		}
+	}
+	return comments
+}
+
+// applySchemaObjectComments sets the user comments to the tables and columns in the metadata.
+// The classification is kept in the comment.
+func applySchemaObjectComments(metadata *storepb.DatabaseSchemaMetadata, comments []*v1pb.SchemaObjectComment) error {
+	for _, comment := range comments {
+		table := findTableMetadata(metadata, comment.Schema, comment.Table)
+		if table == nil {
+			return errors.Errorf("table %q not found in schema %q", comment.Table, comment.Schema)
+		}
+		if comment.Column == "" {
+			table.UserComment = comment.Comment
+			table.Comment = common.GetCommentFromClassificationAndUserComment(table.Classification, comment.Comment)
+			continue
+		}
+		var column *storepb.ColumnMetadata
+		for _, c := range table.Columns {
+			if c.Name == comment.Column {
+				column = c
+				break
+			}
+		}
+		if column == nil {
+			return errors.Errorf("column %q not found in table %q", comment.Column, comment.Table)
+		}
+		column.UserComment = comment.Comment
+		column.Comment = common.GetCommentFromClassificationAndUserComment(column.Classification, comment.Comment)
+	}
+	return nil
+}
+
+func findTableMetadata(metadata *storepb.DatabaseSchemaMetadata, schemaName, tableName string) *storepb.TableMetadata {
+	for _, schema := range metadata.GetSchemas() {
+		if schema.GetName() != schemaName {
+			continue
+		}
+		for _, table := range schema.GetTables() {
+			if table.GetName() == tableName {
+				return table
+			}
+		}
+	}
+	return nil
+}
+
+// diffMetadataComments generates the statement from the source metadata to the target metadata,
+// which only differ in the comments, e.g. COMMENT ON for PostgreSQL and ALTER TABLE ... COMMENT for MySQL.
+func diffMetadataComments(engine storepb.Engine, sourceMetadata, targetMetadata *storepb.DatabaseSchemaMetadata) (string, error) {
+	sourceMetadata, targetMetadata = trimDatabaseMetadata(sourceMetadata, targetMetadata)
+	sourceSchema, err := schema.GetDesignSchema(engine, "" /* baseline */, sourceMetadata)
+	if err != nil {
+		return "", err
+	}
+	targetSchema, err := schema.GetDesignSchema(engine, "" /* baseline */, targetMetadata)
+	if err != nil {
+		return "", err
+	}
+	return base.SchemaDiff(engine, sourceSchema, targetSchema, false /* ignoreCaseSensitive */)
+}
@@ -0,0 +1,196 @@
+package v1
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+var (
+	flywayVersionInvalidCharRegexp     = regexp.MustCompile(`[^0-9]+`)
+	flywayDescriptionInvalidCharRegexp = regexp.MustCompile(`[^A-Za-z0-9]+`)
+)
+
+// ExportChangeHistories exports the change histories in the format of Flyway, Liquibase or a single SQL script.
+func (s *DatabaseService) ExportChangeHistories(ctx context.Context, request *v1pb.ExportChangeHistoriesRequest) (*v1pb.ExportChangeHistoriesResponse, error) {
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
+		ResourceID: &instanceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+	if err := s.checkDatabasePermission(ctx, database.ProjectID, api.ProjectPermissionManageGeneral); err != nil {
+		return nil, err
+	}
+
+	changeHistories, err := s.store.ListInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+		InstanceID: &instance.UID,
+		DatabaseID: &database.UID,
+		ShowFull:   true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list change histories, error: %v", err)
+	}
+	changeHistories = getExportableChangeHistories(changeHistories)
+
+	switch request.Format {
+	case v1pb.ExportChangeHistoriesRequest_FLYWAY:
+		content, err := exportFlywayMigrations(changeHistories)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to export Flyway migrations, error: %v", err)
+		}
+		return &v1pb.ExportChangeHistoriesResponse{
+			Content:  content,
+			Filename: fmt.Sprintf("%s-flyway.zip", database.DatabaseName),
+		}, nil
+	case v1pb.ExportChangeHistoriesRequest_LIQUIBASE:
+		return &v1pb.ExportChangeHistoriesResponse{
+			Content:  []byte(exportLiquibaseChangelog(changeHistories)),
+			Filename: fmt.Sprintf("%s-changelog.sql", database.DatabaseName),
+		}, nil
+	case v1pb.ExportChangeHistoriesRequest_SQL:
+		return &v1pb.ExportChangeHistoriesResponse{
+			Content:  []byte(exportConsolidatedSQL(database.DatabaseName, changeHistories)),
+			Filename: fmt.Sprintf("%s-migrations.sql", database.DatabaseName),
+		}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported export format %v", request.Format)
+	}
+}
+
+// getExportableChangeHistories returns the applied change histories with statements in the applied order.
+// The histories imported from other migration tools are skipped because they don't have the statements.
+func getExportableChangeHistories(changeHistories []*store.InstanceChangeHistoryMessage) []*store.InstanceChangeHistoryMessage {
+	var result []*store.InstanceChangeHistoryMessage
+	// The change histories are listed in the descending order of the sequence.
+	for i := len(changeHistories) - 1; i >= 0; i-- {
+		h := changeHistories[i]
+		if h.Status != db.Done {
+			continue
+		}
+		if h.Source == db.FLYWAY || h.Source == db.LIQUIBASE {
+			continue
+		}
+		if strings.TrimSpace(h.Statement) == "" {
+			continue
+		}
+		result = append(result, h)
+	}
+	return result
+}
+
+// exportFlywayMigrations exports the change histories as a zip archive of the Flyway versioned migrations.
+// Flyway versions only contain digits separated by dots, so the other characters are replaced,
+// and the sequence is appended if the version is duplicated.
+func exportFlywayMigrations(changeHistories []*store.InstanceChangeHistoryMessage) ([]byte, error) {
+	var b bytes.Buffer
+	zipw := zip.NewWriter(&b)
+	defer zipw.Close()
+
+	seen := make(map[string]bool)
+	for _, h := range changeHistories {
+		version := strings.Trim(flywayVersionInvalidCharRegexp.ReplaceAllString(h.Version.Version, "."), ".")
+		if version == "" || seen[version] {
+			version = strings.Trim(fmt.Sprintf("%s.%d", version, h.Sequence), ".")
+		}
+		seen[version] = true
+		description := strings.Trim(flywayDescriptionInvalidCharRegexp.ReplaceAllString(h.Description, "_"), "_")
+		if len(description) > 64 {
+			description = strings.TrimRight(description[:64], "_")
+		}
+		if description == "" {
+			description = strings.ToLower(string(h.Type))
+		}
+
+		w, err := zipw.Create(fmt.Sprintf("V%s__%s.sql", version, description))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create migration file for version %q", h.Version.Version)
+		}
+		if _, err := w.Write([]byte(withTrailingNewline(h.Statement))); err != nil {
+			return nil, errors.Wrapf(err, "failed to write migration file for version %q", h.Version.Version)
+		}
+	}
+	if err := zipw.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close zip writer")
+	}
+	return b.Bytes(), nil
+}
+
+// exportLiquibaseChangelog exports the change histories as a changelog in the Liquibase formatted SQL.
+func exportLiquibaseChangelog(changeHistories []*store.InstanceChangeHistoryMessage) string {
+	var buf strings.Builder
+	_, _ = buf.WriteString("--liquibase formatted sql\n")
+	seen := make(map[string]bool)
+	for _, h := range changeHistories {
+		id := h.Version.Version
+		if id == "" || seen[id] {
+			id = fmt.Sprintf("%s-%d", id, h.Sequence)
+		}
+		seen[id] = true
+		author := "bytebase"
+		if h.Creator != nil && h.Creator.Email != "" {
+			author = h.Creator.Email
+		}
+		_, _ = fmt.Fprintf(&buf, "\n--changeset %s:%s\n", author, id)
+		if h.Description != "" {
+			_, _ = fmt.Fprintf(&buf, "--comment: %s\n", strings.ReplaceAll(h.Description, "\n", " "))
+		}
+		_, _ = buf.WriteString(withTrailingNewline(h.Statement))
+	}
+	return buf.String()
+}
+
+// exportConsolidatedSQL exports the change histories as a single SQL script with the version markers.
+func exportConsolidatedSQL(databaseName string, changeHistories []*store.InstanceChangeHistoryMessage) string {
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "-- Migration history of database %q exported from Bytebase.\n", databaseName)
+	for _, h := range changeHistories {
+		_, _ = fmt.Fprintf(&buf, "\n-- Version: %s\n", h.Version.Version)
+		_, _ = fmt.Fprintf(&buf, "-- Type: %s\n", h.Type)
+		if h.Description != "" {
+			_, _ = fmt.Fprintf(&buf, "-- Description: %s\n", strings.ReplaceAll(h.Description, "\n", " "))
+		}
+		_, _ = fmt.Fprintf(&buf, "-- Applied at: %s\n", time.Unix(h.CreatedTs, 0).UTC().Format(time.RFC3339))
+		_, _ = buf.WriteString(withTrailingNewline(h.Statement))
+	}
+	return buf.String()
+}
+
+func withTrailingNewline(statement string) string {
+	if strings.HasSuffix(statement, "\n") {
+		return statement
+	}
+	return statement + "\n"
+}
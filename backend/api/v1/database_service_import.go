@@ -0,0 +1,295 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	defaultFlywayHistoryTable    = "flyway_schema_history"
+	defaultLiquibaseHistoryTable = "DATABASECHANGELOG"
+)
+
+// historyTableRegexp matches the history table name with an optional schema, e.g. flyway.flyway_schema_history.
+var historyTableRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*(\.[A-Za-z_][A-Za-z0-9_$]*)?$`)
+
+// importedChange is a change read from the history table of the migration tool.
+type importedChange struct {
+	version     string
+	description string
+	statement   string
+	tp          db.MigrationType
+	status      db.MigrationStatus
+	appliedTs   int64
+}
+
+// ImportChangeHistories imports the change histories from the history table of Flyway or Liquibase.
+func (s *DatabaseService) ImportChangeHistories(ctx context.Context, request *v1pb.ImportChangeHistoriesRequest) (*v1pb.ImportChangeHistoriesResponse, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
+		ResourceID: &instanceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+	if err := s.checkDatabasePermission(ctx, database.ProjectID, api.ProjectPermissionManageGeneral); err != nil {
+		return nil, err
+	}
+	query, err := getImportHistoryQuery(instance.Engine, request.Tool, request.Table)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	user, err := s.store.GetUserByID(ctx, principalID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user %d not found", principalID)
+	}
+
+	changes, err := func() ([]*importedChange, error) {
+		driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+		if err != nil {
+			return nil, err
+		}
+		defer driver.Close(ctx)
+
+		rows, err := driver.GetDB().QueryContext(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		if request.Tool == v1pb.ImportChangeHistoriesRequest_FLYWAY {
+			return scanFlywayHistory(rows)
+		}
+		return scanLiquibaseHistory(rows)
+	}()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to read the history table, error: %v", err)
+	}
+
+	existingHistories, err := s.store.ListInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+		InstanceID: &instance.UID,
+		DatabaseID: &database.UID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list change histories, error: %v", err)
+	}
+	existingVersions := make(map[string]bool)
+	for _, history := range existingHistories {
+		existingVersions[history.Version.Version] = true
+	}
+
+	source := db.FLYWAY
+	if request.Tool == v1pb.ImportChangeHistoriesRequest_LIQUIBASE {
+		source = db.LIQUIBASE
+	}
+	var creates []*store.InstanceChangeHistoryMessage
+	for _, change := range changes {
+		if existingVersions[change.version] {
+			continue
+		}
+		existingVersions[change.version] = true
+		creates = append(creates, &store.InstanceChangeHistoryMessage{
+			CreatorID:      principalID,
+			CreatedTs:      change.appliedTs,
+			UpdaterID:      principalID,
+			UpdatedTs:      change.appliedTs,
+			InstanceUID:    &instance.UID,
+			DatabaseUID:    &database.UID,
+			ReleaseVersion: s.profile.Version,
+			Source:         source,
+			Type:           change.tp,
+			Status:         change.status,
+			Version:        model.Version{Version: change.version},
+			Description:    change.description,
+			Statement:      change.statement,
+			Payload: &storepb.InstanceChangeHistoryPayload{
+				StatementSha256: common.SHA256Hex(change.statement),
+			},
+
+			Creator:      user,
+			Updater:      user,
+			InstanceID:   instance.ResourceID,
+			DatabaseName: database.DatabaseName,
+		})
+	}
+	if !request.ValidateOnly && len(creates) > 0 {
+		uids, err := s.store.CreateInstanceChangeHistories(ctx, creates)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create change histories, error: %v", err)
+		}
+		for i, uid := range uids {
+			creates[i].UID = uid
+		}
+	}
+
+	response := &v1pb.ImportChangeHistoriesResponse{}
+	for _, create := range creates {
+		changeHistory, err := convertToChangeHistory(create)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert change history, error: %v", err)
+		}
+		response.ChangeHistories = append(response.ChangeHistories, changeHistory)
+	}
+	return response, nil
+}
+
+// getImportHistoryQuery returns the query reading the history table of the migration tool.
+// The applied time is converted to the unix timestamp in the query because the drivers scan the timestamps differently.
+func getImportHistoryQuery(engine storepb.Engine, tool v1pb.ImportChangeHistoriesRequest_Tool, table string) (string, error) {
+	if table != "" && !historyTableRegexp.MatchString(table) {
+		return "", errors.Errorf("invalid history table name %q", table)
+	}
+	var epochFormat string
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_MARIADB:
+		epochFormat = "CAST(UNIX_TIMESTAMP(%s) AS SIGNED)"
+	case storepb.Engine_POSTGRES:
+		epochFormat = "CAST(EXTRACT(EPOCH FROM %s) AS BIGINT)"
+	default:
+		return "", errors.Errorf("importing change histories is not supported for engine %v", engine)
+	}
+
+	switch tool {
+	case v1pb.ImportChangeHistoriesRequest_FLYWAY:
+		if table == "" {
+			table = defaultFlywayHistoryTable
+		}
+		return fmt.Sprintf("SELECT installed_rank, version, description, type, script, %s, success FROM %s ORDER BY installed_rank", fmt.Sprintf(epochFormat, "installed_on"), table), nil
+	case v1pb.ImportChangeHistoriesRequest_LIQUIBASE:
+		if table == "" {
+			table = defaultLiquibaseHistoryTable
+		}
+		return fmt.Sprintf("SELECT ID, AUTHOR, FILENAME, DESCRIPTION, EXECTYPE, %s FROM %s ORDER BY ORDEREXECUTED", fmt.Sprintf(epochFormat, "DATEEXECUTED"), table), nil
+	default:
+		return "", errors.Errorf("unsupported tool %v", tool)
+	}
+}
+
+func scanFlywayHistory(rows *sql.Rows) ([]*importedChange, error) {
+	var changes []*importedChange
+	for rows.Next() {
+		var installedRank int
+		var version, description sql.NullString
+		var tp, script string
+		var appliedTs int64
+		var success bool
+		if err := rows.Scan(&installedRank, &version, &description, &tp, &script, &appliedTs, &success); err != nil {
+			return nil, err
+		}
+		if change := convertFlywayHistory(installedRank, version.String, description.String, tp, script, appliedTs, success); change != nil {
+			changes = append(changes, change)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// convertFlywayHistory converts a row of the Flyway schema history table, returns nil if the row should be skipped.
+func convertFlywayHistory(installedRank int, version, description, tp, script string, appliedTs int64, success bool) *importedChange {
+	// The DELETE rows are the markers of the migrations removed by the repair command.
+	if tp == "DELETE" {
+		return nil
+	}
+	// The repeatable migrations have no version and are applied again once the checksum changes,
+	// so the installed rank is used to identify each run.
+	if version == "" {
+		version = fmt.Sprintf("R__%d", installedRank)
+	}
+	change := &importedChange{
+		version:     version,
+		description: description,
+		statement:   fmt.Sprintf("-- Imported from Flyway, script: %s", script),
+		tp:          db.Migrate,
+		status:      db.Done,
+		appliedTs:   appliedTs,
+	}
+	if strings.HasSuffix(tp, "BASELINE") {
+		change.tp = db.Baseline
+	}
+	if !success {
+		change.status = db.Failed
+	}
+	return change
+}
+
+func scanLiquibaseHistory(rows *sql.Rows) ([]*importedChange, error) {
+	var changes []*importedChange
+	for rows.Next() {
+		var id, author, filename, execType string
+		var description sql.NullString
+		var appliedTs int64
+		if err := rows.Scan(&id, &author, &filename, &description, &execType, &appliedTs); err != nil {
+			return nil, err
+		}
+		if change := convertLiquibaseHistory(id, author, filename, description.String, execType, appliedTs); change != nil {
+			changes = append(changes, change)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// convertLiquibaseHistory converts a row of the Liquibase DATABASECHANGELOG table, returns nil if the row should be skipped.
+func convertLiquibaseHistory(id, author, filename, description, execType string, appliedTs int64) *importedChange {
+	if execType == "SKIPPED" {
+		return nil
+	}
+	change := &importedChange{
+		version:     id,
+		description: description,
+		// Liquibase identifies the changeset by the file name, the id and the author.
+		statement: fmt.Sprintf("-- Imported from Liquibase, changeset: %s::%s::%s", filename, id, author),
+		tp:        db.Migrate,
+		status:    db.Done,
+		appliedTs: appliedTs,
+	}
+	if description == "" {
+		change.description = filename
+	}
+	if execType == "FAILED" {
+		change.status = db.Failed
+	}
+	return change
+}
@@ -0,0 +1,196 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/runner/sandbox"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	defaultSandboxTTL = 7 * 24 * time.Hour
+	maxSandboxTTL     = 30 * 24 * time.Hour
+)
+
+var sandboxDatabaseNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,63}$`)
+
+// CreateSandboxDatabase clones the database into a sandbox instance.
+func (s *DatabaseService) CreateSandboxDatabase(ctx context.Context, request *v1pb.CreateSandboxDatabaseRequest) (*v1pb.SandboxDatabase, error) {
+	if request.Sandbox == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "sandbox must be set")
+	}
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	sourceDatabase, err := getDatabaseMessage(ctx, s.store, request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, err.Error())
+	}
+	if err := s.checkDatabasePermission(ctx, sourceDatabase.ProjectID, api.ProjectPermissionManageGeneral); err != nil {
+		return nil, err
+	}
+	sourceInstance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &sourceDatabase.InstanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance %q, error: %v", sourceDatabase.InstanceID, err)
+	}
+	if sourceInstance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", sourceDatabase.InstanceID)
+	}
+	if !sandbox.SupportedEngines[sourceInstance.Engine] {
+		return nil, status.Errorf(codes.InvalidArgument, "sandbox database is not supported for engine %v", sourceInstance.Engine)
+	}
+
+	var mode storepb.SandboxDatabasePayload_Mode
+	switch request.Sandbox.Mode {
+	case v1pb.SandboxDatabase_SCHEMA_ONLY:
+		mode = storepb.SandboxDatabasePayload_SCHEMA_ONLY
+	case v1pb.SandboxDatabase_MASKED_DATA:
+		mode = storepb.SandboxDatabasePayload_MASKED_DATA
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported sandbox mode %v", request.Sandbox.Mode)
+	}
+	ttl := defaultSandboxTTL
+	if request.Sandbox.Ttl != nil {
+		ttl = request.Sandbox.Ttl.AsDuration()
+		if ttl <= 0 || ttl > maxSandboxTTL {
+			return nil, status.Errorf(codes.InvalidArgument, "sandbox ttl must be positive and at most %v", maxSandboxTTL)
+		}
+	}
+
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Sandbox.Database)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if !sandboxDatabaseNameRegex.MatchString(databaseName) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sandbox database name %q", databaseName)
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance %q, error: %v", instanceID, err)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	if !instance.Options.GetSandbox() {
+		return nil, status.Errorf(codes.InvalidArgument, "instance %q is not a sandbox instance", instanceID)
+	}
+	if instance.Engine != sourceInstance.Engine {
+		return nil, status.Errorf(codes.InvalidArgument, "sandbox instance %q must have the same engine as the source database", instanceID)
+	}
+	existedDatabase, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database %q, error: %v", databaseName, err)
+	}
+	if existedDatabase != nil && existedDatabase.SyncState == api.OK {
+		return nil, status.Errorf(codes.AlreadyExists, "database %q already exists", request.Sandbox.Database)
+	}
+	existedSandboxes, err := s.store.ListSandboxDatabases(ctx, &store.FindSandboxDatabaseMessage{
+		InstanceUID:  &instance.UID,
+		DatabaseName: &databaseName,
+		StatusList:   []store.SandboxDatabaseStatus{store.SandboxDatabaseStatusPending, store.SandboxDatabaseStatusReady, store.SandboxDatabaseStatusFailed},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list sandbox databases, error: %v", err)
+	}
+	if len(existedSandboxes) > 0 {
+		return nil, status.Errorf(codes.AlreadyExists, "sandbox database %q already exists", request.Sandbox.Database)
+	}
+
+	created, err := s.store.CreateSandboxDatabase(ctx, &store.SandboxDatabaseMessage{
+		SourceDatabaseUID: sourceDatabase.UID,
+		InstanceUID:       instance.UID,
+		DatabaseName:      databaseName,
+		ExpireTs:          time.Now().Add(ttl).Unix(),
+		Payload: &storepb.SandboxDatabasePayload{
+			Mode: mode,
+		},
+	}, principalID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create sandbox database, error: %v", err)
+	}
+	return s.convertToSandboxDatabase(ctx, sourceDatabase, created, instance)
+}
+
+// ListSandboxDatabases lists the sandbox databases cloned from the database.
+func (s *DatabaseService) ListSandboxDatabases(ctx context.Context, request *v1pb.ListSandboxDatabasesRequest) (*v1pb.ListSandboxDatabasesResponse, error) {
+	sourceDatabase, err := getDatabaseMessage(ctx, s.store, request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, err.Error())
+	}
+	if err := s.checkDatabasePermission(ctx, sourceDatabase.ProjectID, api.ProjectPermissionManageGeneral); err != nil {
+		return nil, err
+	}
+	sandboxes, err := s.store.ListSandboxDatabases(ctx, &store.FindSandboxDatabaseMessage{
+		SourceDatabaseUID: &sourceDatabase.UID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list sandbox databases, error: %v", err)
+	}
+	response := &v1pb.ListSandboxDatabasesResponse{}
+	for _, sandboxDatabase := range sandboxes {
+		instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &sandboxDatabase.InstanceUID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get instance %d, error: %v", sandboxDatabase.InstanceUID, err)
+		}
+		if instance == nil {
+			continue
+		}
+		converted, err := s.convertToSandboxDatabase(ctx, sourceDatabase, sandboxDatabase, instance)
+		if err != nil {
+			return nil, err
+		}
+		response.Sandboxes = append(response.Sandboxes, converted)
+	}
+	return response, nil
+}
+
+func (s *DatabaseService) convertToSandboxDatabase(ctx context.Context, sourceDatabase *store.DatabaseMessage, sandboxDatabase *store.SandboxDatabaseMessage, instance *store.InstanceMessage) (*v1pb.SandboxDatabase, error) {
+	creator, err := s.store.GetUserByID(ctx, sandboxDatabase.CreatorUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user %d, error: %v", sandboxDatabase.CreatorUID, err)
+	}
+	result := &v1pb.SandboxDatabase{
+		Name:       fmt.Sprintf("%s/%s%s", common.FormatDatabase(sourceDatabase.InstanceID, sourceDatabase.DatabaseName), common.SandboxPrefix, strconv.Itoa(sandboxDatabase.UID)),
+		Database:   common.FormatDatabase(instance.ResourceID, sandboxDatabase.DatabaseName),
+		ExpireTime: timestamppb.New(time.Unix(sandboxDatabase.ExpireTs, 0)),
+		Error:      sandboxDatabase.Payload.GetError(),
+		CreateTime: timestamppb.New(time.Unix(sandboxDatabase.CreatedTs, 0)),
+	}
+	if creator != nil {
+		result.Creator = common.FormatUserEmail(creator.Email)
+	}
+	switch sandboxDatabase.Payload.GetMode() {
+	case storepb.SandboxDatabasePayload_SCHEMA_ONLY:
+		result.Mode = v1pb.SandboxDatabase_SCHEMA_ONLY
+	case storepb.SandboxDatabasePayload_MASKED_DATA:
+		result.Mode = v1pb.SandboxDatabase_MASKED_DATA
+	}
+	switch sandboxDatabase.Status {
+	case store.SandboxDatabaseStatusPending:
+		result.State = v1pb.SandboxDatabase_PENDING
+	case store.SandboxDatabaseStatusReady:
+		result.State = v1pb.SandboxDatabase_READY
+	case store.SandboxDatabaseStatusFailed:
+		result.State = v1pb.SandboxDatabase_FAILED
+	case store.SandboxDatabaseStatusDropped:
+		result.State = v1pb.SandboxDatabase_DROPPED
+	}
+	return result, nil
+}
@@ -0,0 +1,343 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+var tenantDatabaseNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,63}$`)
+
+// tenantSupportedEngines are the engines supporting the tenant database provisioning.
+var tenantSupportedEngines = map[storepb.Engine]bool{
+	storepb.Engine_MYSQL:    true,
+	storepb.Engine_MARIADB:  true,
+	storepb.Engine_TIDB:     true,
+	storepb.Engine_POSTGRES: true,
+}
+
+// ProvisionTenantDatabase creates the tenant database from the baseline schema and the change history of the template database.
+func (s *DatabaseService) ProvisionTenantDatabase(ctx context.Context, request *v1pb.ProvisionTenantDatabaseRequest) (*v1pb.Database, error) {
+	if err := s.licenseService.IsFeatureEnabled(api.FeatureMultiTenancy); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	}
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	projectID, err := common.GetProjectID(request.Project)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project %q, error: %v", projectID, err)
+	}
+	if project == nil || project.Deleted {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+	}
+
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Database)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if !tenantDatabaseNameRegex.MatchString(databaseName) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tenant database name %q", databaseName)
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance %q, error: %v", instanceID, err)
+	}
+	if instance == nil || instance.Deleted {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	if !tenantSupportedEngines[instance.Engine] {
+		return nil, status.Errorf(codes.InvalidArgument, "tenant database provisioning is not supported for engine %v", instance.Engine)
+	}
+	existedDatabase, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database %q, error: %v", databaseName, err)
+	}
+	if existedDatabase != nil && existedDatabase.SyncState == api.OK {
+		return nil, status.Errorf(codes.AlreadyExists, "database %q already exists", request.Database)
+	}
+
+	environmentID := instance.EnvironmentID
+	if request.Environment != "" {
+		environmentID, err = common.GetEnvironmentID(request.Environment)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		environment, err := s.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &environmentID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get environment %q, error: %v", environmentID, err)
+		}
+		if environment == nil || environment.Deleted {
+			return nil, status.Errorf(codes.NotFound, "environment %q not found", environmentID)
+		}
+	}
+	labels := request.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	tenantDatabase := &store.DatabaseMessage{
+		ProjectID:              project.ResourceID,
+		InstanceID:             instance.ResourceID,
+		DatabaseName:           databaseName,
+		EffectiveEnvironmentID: environmentID,
+		Metadata:               &storepb.DatabaseMetadata{Labels: labels},
+	}
+	if request.Environment != "" {
+		tenantDatabase.EnvironmentID = environmentID
+	}
+
+	candidates, err := s.store.ListDatabases(ctx, &store.FindDatabaseMessage{ProjectID: &project.ResourceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list databases for project %q, error: %v", project.ResourceID, err)
+	}
+	if request.DatabaseGroup != "" {
+		groupProjectID, databaseGroupID, err := common.GetProjectIDDatabaseGroupID(request.DatabaseGroup)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if groupProjectID != project.ResourceID {
+			return nil, status.Errorf(codes.InvalidArgument, "database group %q is not in project %q", request.DatabaseGroup, project.ResourceID)
+		}
+		databaseGroup, err := s.store.GetDatabaseGroup(ctx, &store.FindDatabaseGroupMessage{ProjectUID: &project.UID, ResourceID: &databaseGroupID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get database group %q, error: %v", databaseGroupID, err)
+		}
+		if databaseGroup == nil {
+			return nil, status.Errorf(codes.NotFound, "database group %q not found", request.DatabaseGroup)
+		}
+		matches, _, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, s.store, databaseGroup, []*store.DatabaseMessage{tenantDatabase})
+		if err != nil {
+			return nil, err
+		}
+		if len(matches) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "tenant database %q with the labels doesn't match database group %q", request.Database, request.DatabaseGroup)
+		}
+		if candidates, _, err = utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, s.store, databaseGroup, candidates); err != nil {
+			return nil, err
+		}
+	}
+
+	var template *store.DatabaseMessage
+	if request.TemplateDatabase != "" {
+		template, err = getDatabaseMessage(ctx, s.store, request.TemplateDatabase)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		if template.ProjectID != project.ResourceID {
+			return nil, status.Errorf(codes.InvalidArgument, "template database %q is not in project %q", request.TemplateDatabase, project.ResourceID)
+		}
+	} else {
+		template, err = s.getLatestTenantDatabase(ctx, candidates, instance.Engine)
+		if err != nil {
+			return nil, err
+		}
+		if template == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "no %v database to provision the tenant database from in project %q", instance.Engine, project.ResourceID)
+		}
+	}
+	templateInstance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &template.InstanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance %q, error: %v", template.InstanceID, err)
+	}
+	if templateInstance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", template.InstanceID)
+	}
+	if templateInstance.Engine != instance.Engine {
+		return nil, status.Errorf(codes.InvalidArgument, "template database %q must have the same engine as instance %q", common.FormatDatabase(template.InstanceID, template.DatabaseName), instance.ResourceID)
+	}
+	histories, err := s.store.ListInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+		InstanceID: &templateInstance.UID,
+		DatabaseID: &template.UID,
+		ShowFull:   true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list change histories of template database %q, error: %v", template.DatabaseName, err)
+	}
+	baseline, migrations, err := getTenantMigrations(histories)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to provision from template database %q, error: %v", template.DatabaseName, err)
+	}
+
+	database, err := s.createTenantDatabase(ctx, instance, tenantDatabase)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create tenant database %q, error: %v", databaseName, err)
+	}
+	version, err := s.migrateTenantDatabase(ctx, instance, database, environmentID, principalID, baseline, migrations)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to migrate tenant database %q, error: %v", databaseName, err)
+	}
+
+	syncStatus := api.OK
+	database, err = s.store.UpdateDatabase(ctx, &store.UpdateDatabaseMessage{
+		InstanceID:    instance.ResourceID,
+		DatabaseName:  database.DatabaseName,
+		SyncState:     &syncStatus,
+		SchemaVersion: &version,
+	}, principalID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update tenant database %q, error: %v", databaseName, err)
+	}
+	if err := s.schemaSyncer.SyncDatabaseSchema(ctx, database, true /* force */); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sync tenant database %q, error: %v", databaseName, err)
+	}
+	return s.convertToDatabase(ctx, database)
+}
+
+// getLatestTenantDatabase returns the synced database of the engine with the latest schema version.
+func (s *DatabaseService) getLatestTenantDatabase(ctx context.Context, databases []*store.DatabaseMessage, engine storepb.Engine) (*store.DatabaseMessage, error) {
+	var latest *store.DatabaseMessage
+	for _, database := range databases {
+		if database.SyncState != api.OK {
+			continue
+		}
+		instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get instance %q, error: %v", database.InstanceID, err)
+		}
+		if instance == nil || instance.Engine != engine {
+			continue
+		}
+		if latest == nil || database.SchemaVersion.Version > latest.SchemaVersion.Version {
+			latest = database
+		}
+	}
+	return latest, nil
+}
+
+// getTenantMigrations returns the baseline and the migrations after the baseline to apply to the tenant database.
+// The baseline is the schema after the latest baseline, SDL migration or branch, which snapshots the whole schema.
+// The data changes are not applied because the data belongs to the template database.
+func getTenantMigrations(histories []*store.InstanceChangeHistoryMessage) (*store.InstanceChangeHistoryMessage, []*store.InstanceChangeHistoryMessage, error) {
+	var baseline *store.InstanceChangeHistoryMessage
+	var migrations []*store.InstanceChangeHistoryMessage
+	// The change histories are listed in the descending order.
+	for i := len(histories) - 1; i >= 0; i-- {
+		history := histories[i]
+		if history.Status != db.Done {
+			continue
+		}
+		switch history.Type {
+		case db.Baseline, db.MigrateSDL, db.Branch:
+			baseline, migrations = history, nil
+		case db.Migrate:
+			migrations = append(migrations, history)
+		case db.Data:
+			// Skip the data changes.
+		default:
+			return nil, nil, errors.Errorf("unsupported change history type %q of version %q", history.Type, history.Version.Version)
+		}
+	}
+	// The schema before the first migration is the baseline if the database has not been baselined.
+	if baseline == nil && len(migrations) > 0 && migrations[0].SchemaPrev != "" {
+		baseline = &store.InstanceChangeHistoryMessage{
+			Schema:      migrations[0].SchemaPrev,
+			Version:     common.DefaultMigrationVersion(),
+			Description: "Baseline",
+		}
+	}
+	return baseline, migrations, nil
+}
+
+func (s *DatabaseService) createTenantDatabase(ctx context.Context, instance *store.InstanceMessage, tenantDatabase *store.DatabaseMessage) (*store.DatabaseMessage, error) {
+	driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */, db.ConnectionContext{})
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+	if _, err := driver.Execute(ctx, getTenantCreateDatabaseStatement(instance.Engine, tenantDatabase.DatabaseName), db.ExecuteOptions{CreateDatabase: true}); err != nil {
+		return nil, err
+	}
+
+	// The sync status is NOT_FOUND until the migrations are applied.
+	return s.store.UpsertDatabase(ctx, &store.DatabaseMessage{
+		ProjectID:            tenantDatabase.ProjectID,
+		InstanceID:           tenantDatabase.InstanceID,
+		DatabaseName:         tenantDatabase.DatabaseName,
+		EnvironmentID:        tenantDatabase.EnvironmentID,
+		SyncState:            api.NotFound,
+		SuccessfulSyncTimeTs: time.Now().Unix(),
+		Metadata:             tenantDatabase.Metadata,
+	})
+}
+
+// migrateTenantDatabase applies the baseline and the migrations to the tenant database, and returns the latest schema version.
+func (s *DatabaseService) migrateTenantDatabase(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, environmentID string, principalID int, baseline *store.InstanceChangeHistoryMessage, migrations []*store.InstanceChangeHistoryMessage) (model.Version, error) {
+	driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return model.Version{}, err
+	}
+	defer driver.Close(ctx)
+
+	creator, err := s.store.GetUserByID(ctx, principalID)
+	if err != nil {
+		return model.Version{}, err
+	}
+	var version model.Version
+	apply := func(history *store.InstanceChangeHistoryMessage, statement string) error {
+		mi := &db.MigrationInfo{
+			InstanceID:     &instance.UID,
+			CreatorID:      principalID,
+			ReleaseVersion: s.profile.Version,
+			Version:        history.Version,
+			Namespace:      database.DatabaseName,
+			Database:       database.DatabaseName,
+			DatabaseID:     &database.UID,
+			Environment:    environmentID,
+			Source:         db.UI,
+			Type:           db.Migrate,
+			Description:    history.Description,
+		}
+		if mi.Version.Version == "" {
+			mi.Version = common.DefaultMigrationVersion()
+		}
+		if creator != nil {
+			mi.Creator = creator.Name
+		}
+		if _, _, err := utils.ExecuteMigrationDefault(ctx, ctx, s.store, nil /* stateCfg */, 0 /* taskRunUID */, driver, mi, statement, nil /* sheetID */, db.ExecuteOptions{}); err != nil {
+			return errors.Wrapf(err, "failed to apply version %q", history.Version.Version)
+		}
+		version = mi.Version
+		return nil
+	}
+	if baseline != nil && baseline.Schema != "" {
+		if err := apply(baseline, baseline.Schema); err != nil {
+			return model.Version{}, err
+		}
+	}
+	for _, migration := range migrations {
+		if err := apply(migration, migration.Statement); err != nil {
+			return model.Version{}, err
+		}
+	}
+	return version, nil
+}
+
+func getTenantCreateDatabaseStatement(engine storepb.Engine, databaseName string) string {
+	if engine == storepb.Engine_POSTGRES {
+		return fmt.Sprintf(`CREATE DATABASE "%s";`, databaseName)
+	}
+	return fmt.Sprintf("CREATE DATABASE `%s`;", databaseName)
+}
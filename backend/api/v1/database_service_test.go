@@ -1,11 +1,17 @@
 package v1
 
 import (
+	"archive/zip"
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
 func TestSimpleParseCron(t *testing.T) {
@@ -188,3 +194,188 @@ func TestGetDatabasesFromExpression(t *testing.T) {
 		require.Equal(t, tc.want, got)
 	}
 }
+
+func TestApplySchemaObjectComments(t *testing.T) {
+	a := require.New(t)
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name:           "users",
+						Classification: "1-1",
+						Comment:        "1-1-old",
+						UserComment:    "old",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id"},
+							{Name: "email", Classification: "2-1", Comment: "2-1"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err := applySchemaObjectComments(metadata, []*v1pb.SchemaObjectComment{
+		{Schema: "public", Table: "users", Comment: "The users."},
+		{Schema: "public", Table: "users", Column: "id", Comment: "The user ID."},
+		{Schema: "public", Table: "users", Column: "email", Comment: "The email."},
+	})
+	a.NoError(err)
+	table := metadata.Schemas[0].Tables[0]
+	a.Equal("1-1-The users.", table.Comment)
+	a.Equal("The users.", table.UserComment)
+	a.Equal("The user ID.", table.Columns[0].Comment)
+	a.Equal("2-1-The email.", table.Columns[1].Comment)
+	a.Equal("The email.", table.Columns[1].UserComment)
+
+	err = applySchemaObjectComments(metadata, []*v1pb.SchemaObjectComment{
+		{Schema: "public", Table: "users", Column: "name", Comment: "The name."},
+	})
+	a.Error(err)
+	err = applySchemaObjectComments(metadata, []*v1pb.SchemaObjectComment{
+		{Schema: "", Table: "users", Comment: "The users."},
+	})
+	a.Error(err)
+}
+
+func TestGetImportHistoryQuery(t *testing.T) {
+	a := require.New(t)
+
+	query, err := getImportHistoryQuery(storepb.Engine_MYSQL, v1pb.ImportChangeHistoriesRequest_FLYWAY, "")
+	a.NoError(err)
+	a.Equal("SELECT installed_rank, version, description, type, script, CAST(UNIX_TIMESTAMP(installed_on) AS SIGNED), success FROM flyway_schema_history ORDER BY installed_rank", query)
+
+	query, err = getImportHistoryQuery(storepb.Engine_POSTGRES, v1pb.ImportChangeHistoriesRequest_LIQUIBASE, "liquibase.databasechangelog")
+	a.NoError(err)
+	a.Equal("SELECT ID, AUTHOR, FILENAME, DESCRIPTION, EXECTYPE, CAST(EXTRACT(EPOCH FROM DATEEXECUTED) AS BIGINT) FROM liquibase.databasechangelog ORDER BY ORDEREXECUTED", query)
+
+	_, err = getImportHistoryQuery(storepb.Engine_MYSQL, v1pb.ImportChangeHistoriesRequest_FLYWAY, "t; DROP TABLE t")
+	a.Error(err)
+	_, err = getImportHistoryQuery(storepb.Engine_MONGODB, v1pb.ImportChangeHistoriesRequest_FLYWAY, "")
+	a.Error(err)
+}
+
+func TestConvertImportedHistory(t *testing.T) {
+	a := require.New(t)
+
+	change := convertFlywayHistory(1, "1", "<< Flyway Baseline >>", "BASELINE", "<< Flyway Baseline >>", 100, true)
+	a.Equal("1", change.version)
+	a.Equal(db.Baseline, change.tp)
+	a.Equal(db.Done, change.status)
+
+	change = convertFlywayHistory(2, "1.1", "add users", "SQL", "V1_1__add_users.sql", 200, false)
+	a.Equal("1.1", change.version)
+	a.Equal(db.Migrate, change.tp)
+	a.Equal(db.Failed, change.status)
+	a.Equal("-- Imported from Flyway, script: V1_1__add_users.sql", change.statement)
+
+	change = convertFlywayHistory(3, "", "views", "SQL", "R__views.sql", 300, true)
+	a.Equal("R__3", change.version)
+
+	a.Nil(convertFlywayHistory(4, "1.1", "add users", "DELETE", "V1_1__add_users.sql", 400, true))
+
+	change = convertLiquibaseHistory("create-users", "alice", "changelog.xml", "", "EXECUTED", 500)
+	a.Equal("create-users", change.version)
+	a.Equal("changelog.xml", change.description)
+	a.Equal(db.Done, change.status)
+	a.Equal(int64(500), change.appliedTs)
+
+	a.Nil(convertLiquibaseHistory("create-users", "alice", "changelog.xml", "", "SKIPPED", 500))
+}
+
+func TestExportChangeHistories(t *testing.T) {
+	a := require.New(t)
+	// The change histories are listed in the descending order of the sequence.
+	changeHistories := getExportableChangeHistories([]*store.InstanceChangeHistoryMessage{
+		{Sequence: 4, Status: db.Done, Type: db.Migrate, Version: model.Version{Version: "0002"}, Description: "Add index", Statement: "CREATE INDEX idx_t_a ON t(a);", CreatedTs: 200},
+		{Sequence: 3, Status: db.Failed, Type: db.Migrate, Version: model.Version{Version: "0002"}, Statement: "CREATE INDEX idx_t_a ON t(b);", CreatedTs: 150},
+		{Sequence: 2, Status: db.Done, Type: db.Migrate, Version: model.Version{Version: "0001"}, Description: "Create table t", Statement: "CREATE TABLE t(a INT);\n", CreatedTs: 100},
+		{Sequence: 1, Status: db.Done, Type: db.Baseline, Version: model.Version{Version: "0000"}, CreatedTs: 0},
+	})
+	a.Len(changeHistories, 2)
+
+	a.Equal(`--liquibase formatted sql
+
+--changeset bytebase:0001
+--comment: Create table t
+CREATE TABLE t(a INT);
+
+--changeset bytebase:0002
+--comment: Add index
+CREATE INDEX idx_t_a ON t(a);
+`, exportLiquibaseChangelog(changeHistories))
+
+	a.Equal(`-- Migration history of database "db" exported from Bytebase.
+
+-- Version: 0001
+-- Type: MIGRATE
+-- Description: Create table t
+-- Applied at: 1970-01-01T00:01:40Z
+CREATE TABLE t(a INT);
+
+-- Version: 0002
+-- Type: MIGRATE
+-- Description: Add index
+-- Applied at: 1970-01-01T00:03:20Z
+CREATE INDEX idx_t_a ON t(a);
+`, exportConsolidatedSQL("db", changeHistories))
+
+	content, err := exportFlywayMigrations(changeHistories)
+	a.NoError(err)
+	r, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	a.NoError(err)
+	var filenames []string
+	for _, f := range r.File {
+		filenames = append(filenames, f.Name)
+	}
+	a.Equal([]string{"V0001__Create_table_t.sql", "V0002__Add_index.sql"}, filenames)
+}
+
+func TestGetTenantMigrations(t *testing.T) {
+	a := require.New(t)
+
+	newHistory := func(version string, migrationType db.MigrationType, status db.MigrationStatus) *store.InstanceChangeHistoryMessage {
+		return &store.InstanceChangeHistoryMessage{
+			Version:    model.Version{Version: version},
+			Type:       migrationType,
+			Status:     status,
+			Statement:  "statement " + version,
+			Schema:     "schema " + version,
+			SchemaPrev: "schema before " + version,
+		}
+	}
+	// The change histories are listed in the descending order.
+	baseline, migrations, err := getTenantMigrations([]*store.InstanceChangeHistoryMessage{
+		newHistory("0005", db.Migrate, db.Done),
+		newHistory("0004", db.Data, db.Done),
+		newHistory("0003", db.Migrate, db.Failed),
+		newHistory("0002", db.Migrate, db.Done),
+		newHistory("0001", db.Baseline, db.Done),
+		newHistory("0000", db.Migrate, db.Done),
+	})
+	a.NoError(err)
+	a.Equal("0001", baseline.Version.Version)
+	a.Equal("schema 0001", baseline.Schema)
+	a.Len(migrations, 2)
+	a.Equal("0002", migrations[0].Version.Version)
+	a.Equal("0005", migrations[1].Version.Version)
+
+	// The schema before the first migration is the baseline if the database has not been baselined.
+	baseline, migrations, err = getTenantMigrations([]*store.InstanceChangeHistoryMessage{
+		newHistory("0002", db.Migrate, db.Done),
+		newHistory("0001", db.Migrate, db.Done),
+	})
+	a.NoError(err)
+	a.Equal("schema before 0001", baseline.Schema)
+	a.Len(migrations, 2)
+
+	baseline, migrations, err = getTenantMigrations(nil)
+	a.NoError(err)
+	a.Nil(baseline)
+	a.Empty(migrations)
+
+	_, _, err = getTenantMigrations([]*store.InstanceChangeHistoryMessage{newHistory("0001", "UNKNOWN", db.Done)})
+	a.Error(err)
+}
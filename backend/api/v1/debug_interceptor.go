@@ -7,8 +7,10 @@ import (
 	"runtime/debug"
 	"time"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -22,6 +24,9 @@ import (
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
+// RequestIDHeader is the header of the request ID correlating the logs of a request.
+const RequestIDHeader = "x-request-id"
+
 // DebugInterceptor is the v1 debug interceptor for gRPC server.
 type DebugInterceptor struct {
 	errorRecordRing *api.ErrorRecordRing
@@ -41,6 +46,7 @@ func NewDebugInterceptor(errorRecordRing *api.ErrorRecordRing, profile *config.P
 // DebugInterceptor is the unary interceptor for gRPC API.
 func (in *DebugInterceptor) DebugInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 	startTime := time.Now()
+	ctx = withRequestID(ctx)
 	resp, err := handler(ctx, request)
 	in.debugInterceptorDo(ctx, serverInfo.FullMethod, err, startTime)
 
@@ -59,13 +65,31 @@ func (in *DebugInterceptor) DebugInterceptor(ctx context.Context, request any, s
 // DebugStreamInterceptor is the unary interceptor for gRPC API.
 func (in *DebugInterceptor) DebugStreamInterceptor(request any, ss grpc.ServerStream, serverInfo *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 	startTime := time.Now()
-	err := handler(request, ss)
-	ctx := ss.Context()
+	ctx := withRequestID(ss.Context())
+	err := handler(request, overrideStream{ServerStream: ss, childCtx: ctx})
 	in.debugInterceptorDo(ctx, serverInfo.FullMethod, err, startTime)
 
 	return err
 }
 
+// withRequestID attaches the request ID to the logs emitted with the context, and returns it in the response header.
+// The request ID is taken from the x-request-id header of the request, or generated if absent.
+func withRequestID(ctx context.Context) context.Context {
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDHeader); len(values) > 0 && values[0] != "" {
+			requestID = values[0]
+		}
+	}
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID)); err != nil {
+		slog.Debug("failed to set the request ID header", log.BBError(err))
+	}
+	return log.ContextWithAttrs(ctx, slog.String("request_id", requestID))
+}
+
 func (in *DebugInterceptor) debugInterceptorDo(ctx context.Context, fullMethod string, err error, startTime time.Time) {
 	st := status.Convert(err)
 	var logLevel slog.Level
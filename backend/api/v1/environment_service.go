@@ -85,6 +85,7 @@ func (s *EnvironmentService) CreateEnvironment(ctx context.Context, request *v1p
 			Title:      request.Environment.Title,
 			Order:      request.Environment.Order,
 			Protected:  request.Environment.Tier == v1pb.EnvironmentTier_PROTECTED,
+			Class:      convertToStoreEnvironmentClass(request.Environment.EnvironmentClass),
 		},
 		principalID,
 	)
@@ -125,6 +126,9 @@ func (s *EnvironmentService) UpdateEnvironment(ctx context.Context, request *v1p
 		case "tier":
 			protected := request.Environment.Tier == v1pb.EnvironmentTier_PROTECTED
 			patch.Protected = &protected
+		case "environment_class":
+			class := convertToStoreEnvironmentClass(request.Environment.EnvironmentClass)
+			patch.Class = &class
 		}
 	}
 
@@ -249,11 +253,42 @@ func convertToEnvironment(environment *store.EnvironmentMessage) *v1pb.Environme
 		tier = v1pb.EnvironmentTier_PROTECTED
 	}
 	return &v1pb.Environment{
-		Name:  fmt.Sprintf("%s%s", common.EnvironmentNamePrefix, environment.ResourceID),
-		Uid:   fmt.Sprintf("%d", environment.UID),
-		State: convertDeletedToState(environment.Deleted),
-		Title: environment.Title,
-		Order: environment.Order,
-		Tier:  tier,
+		Name:             fmt.Sprintf("%s%s", common.EnvironmentNamePrefix, environment.ResourceID),
+		Uid:              fmt.Sprintf("%d", environment.UID),
+		State:            convertDeletedToState(environment.Deleted),
+		Title:            environment.Title,
+		Order:            environment.Order,
+		Tier:             tier,
+		EnvironmentClass: convertToV1EnvironmentClass(environment.Class),
+	}
+}
+
+func convertToStoreEnvironmentClass(class v1pb.EnvironmentClass) api.EnvironmentClass {
+	switch class {
+	case v1pb.EnvironmentClass_DEV:
+		return api.EnvironmentClassDev
+	case v1pb.EnvironmentClass_TEST:
+		return api.EnvironmentClassTest
+	case v1pb.EnvironmentClass_STAGING:
+		return api.EnvironmentClassStaging
+	case v1pb.EnvironmentClass_PROD:
+		return api.EnvironmentClassProd
+	default:
+		return api.EnvironmentClassUnset
+	}
+}
+
+func convertToV1EnvironmentClass(class api.EnvironmentClass) v1pb.EnvironmentClass {
+	switch class {
+	case api.EnvironmentClassDev:
+		return v1pb.EnvironmentClass_DEV
+	case api.EnvironmentClassTest:
+		return v1pb.EnvironmentClass_TEST
+	case api.EnvironmentClassStaging:
+		return v1pb.EnvironmentClass_STAGING
+	case api.EnvironmentClassProd:
+		return v1pb.EnvironmentClass_PROD
+	default:
+		return v1pb.EnvironmentClass_ENVIRONMENT_CLASS_UNSPECIFIED
 	}
 }
@@ -325,6 +325,8 @@ func convertToExternalVersionControl(externalVersionControl *store.ExternalVersi
 		tp = v1pb.ExternalVersionControl_BITBUCKET
 	case vcs.AzureDevOps:
 		tp = v1pb.ExternalVersionControl_AZURE_DEVOPS
+	case vcs.Gerrit:
+		tp = v1pb.ExternalVersionControl_GERRIT
 	}
 
 	return &v1pb.ExternalVersionControl{
@@ -380,6 +382,8 @@ func convertExternalVersionControlTypeToVCSType(tp v1pb.ExternalVersionControl_T
 		return vcs.Bitbucket, nil
 	case v1pb.ExternalVersionControl_AZURE_DEVOPS:
 		return vcs.AzureDevOps, nil
+	case v1pb.ExternalVersionControl_GERRIT:
+		return vcs.Gerrit, nil
 	}
 	return "", errors.Errorf("unknown external version control type: %v", tp)
 }
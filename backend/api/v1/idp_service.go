@@ -12,9 +12,11 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/idp/ldap"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oauth2"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oidc"
+	"github.com/bytebase/bytebase/backend/runner/ldapsync"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
@@ -292,19 +294,7 @@ func (s *IdentityProviderService) TestIdentityProvider(ctx context.Context, requ
 			request.IdentityProvider.Config.GetLdapConfig().BindPassword = storedIdentityProvider.Config.GetLdapConfig().BindPassword
 		}
 		identityProviderConfig := convertIdentityProviderConfigToStore(identityProvider.Config).GetLdapConfig()
-		ldapIdentityProvider, err := ldap.NewIdentityProvider(
-			ldap.IdentityProviderConfig{
-				Host:             identityProviderConfig.Host,
-				Port:             int(identityProviderConfig.Port),
-				SkipTLSVerify:    identityProviderConfig.SkipTlsVerify,
-				BindDN:           identityProviderConfig.BindDn,
-				BindPassword:     identityProviderConfig.BindPassword,
-				BaseDN:           identityProviderConfig.BaseDn,
-				UserFilter:       identityProviderConfig.UserFilter,
-				SecurityProtocol: ldap.SecurityProtocol(identityProviderConfig.SecurityProtocol),
-				FieldMapping:     identityProviderConfig.FieldMapping,
-			},
-		)
+		ldapIdentityProvider, err := ldapsync.NewIdentityProvider(identityProviderConfig)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to create new LDAP identity provider: %v", err)
 		}
@@ -406,15 +396,19 @@ func convertIdentityProviderConfigFromStore(identityProviderConfig *storepb.Iden
 		return &v1pb.IdentityProviderConfig{
 			Config: &v1pb.IdentityProviderConfig_LdapConfig{
 				LdapConfig: &v1pb.LDAPIdentityProviderConfig{
-					Host:             v.Host,
-					Port:             v.Port,
-					SkipTlsVerify:    v.SkipTlsVerify,
-					BindDn:           v.BindDn,
-					BindPassword:     "", // SECURITY: We do not expose the bind password
-					BaseDn:           v.BaseDn,
-					UserFilter:       v.UserFilter,
-					SecurityProtocol: v.SecurityProtocol,
-					FieldMapping:     &fieldMapping,
+					Host:                 v.Host,
+					Port:                 v.Port,
+					SkipTlsVerify:        v.SkipTlsVerify,
+					BindDn:               v.BindDn,
+					BindPassword:         "", // SECURITY: We do not expose the bind password
+					BaseDn:               v.BaseDn,
+					UserFilter:           v.UserFilter,
+					SecurityProtocol:     v.SecurityProtocol,
+					FieldMapping:         &fieldMapping,
+					GroupBaseDn:          v.GroupBaseDn,
+					GroupFilter:          v.GroupFilter,
+					GroupMemberAttribute: v.GroupMemberAttribute,
+					GroupMappings:        convertToV1LDAPGroupMappings(v.GroupMappings),
 				},
 			},
 		}
@@ -474,15 +468,19 @@ func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityP
 		return &storepb.IdentityProviderConfig{
 			Config: &storepb.IdentityProviderConfig_LdapConfig{
 				LdapConfig: &storepb.LDAPIdentityProviderConfig{
-					Host:             v.Host,
-					Port:             v.Port,
-					SkipTlsVerify:    v.SkipTlsVerify,
-					BindDn:           v.BindDn,
-					BindPassword:     v.BindPassword,
-					BaseDn:           v.BaseDn,
-					UserFilter:       v.UserFilter,
-					SecurityProtocol: v.SecurityProtocol,
-					FieldMapping:     &fieldMapping,
+					Host:                 v.Host,
+					Port:                 v.Port,
+					SkipTlsVerify:        v.SkipTlsVerify,
+					BindDn:               v.BindDn,
+					BindPassword:         v.BindPassword,
+					BaseDn:               v.BaseDn,
+					UserFilter:           v.UserFilter,
+					SecurityProtocol:     v.SecurityProtocol,
+					FieldMapping:         &fieldMapping,
+					GroupBaseDn:          v.GroupBaseDn,
+					GroupFilter:          v.GroupFilter,
+					GroupMemberAttribute: v.GroupMemberAttribute,
+					GroupMappings:        convertToStoreLDAPGroupMappings(v.GroupMappings),
 				},
 			},
 		}
@@ -490,6 +488,42 @@ func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityP
 	return nil
 }
 
+func convertToV1LDAPGroupMappings(mappings []*storepb.LDAPGroupMapping) []*v1pb.LDAPGroupMapping {
+	var v1Mappings []*v1pb.LDAPGroupMapping
+	for _, mapping := range mappings {
+		v1Mapping := &v1pb.LDAPGroupMapping{
+			Group:         mapping.Group,
+			WorkspaceRole: mapping.WorkspaceRole,
+		}
+		for _, projectRole := range mapping.ProjectRoles {
+			v1Mapping.ProjectRoles = append(v1Mapping.ProjectRoles, &v1pb.LDAPGroupMapping_ProjectRole{
+				Project: projectRole.Project,
+				Role:    projectRole.Role,
+			})
+		}
+		v1Mappings = append(v1Mappings, v1Mapping)
+	}
+	return v1Mappings
+}
+
+func convertToStoreLDAPGroupMappings(mappings []*v1pb.LDAPGroupMapping) []*storepb.LDAPGroupMapping {
+	var storeMappings []*storepb.LDAPGroupMapping
+	for _, mapping := range mappings {
+		storeMapping := &storepb.LDAPGroupMapping{
+			Group:         mapping.Group,
+			WorkspaceRole: mapping.WorkspaceRole,
+		}
+		for _, projectRole := range mapping.ProjectRoles {
+			storeMapping.ProjectRoles = append(storeMapping.ProjectRoles, &storepb.LDAPGroupMapping_ProjectRole{
+				Project: projectRole.Project,
+				Role:    projectRole.Role,
+			})
+		}
+		storeMappings = append(storeMappings, storeMapping)
+	}
+	return storeMappings
+}
+
 // validIdentityProviderConfig validates the identity provider's config is a valid JSON.
 func validIdentityProviderConfig(identityProviderType v1pb.IdentityProviderType, identityProviderConfig *v1pb.IdentityProviderConfig) error {
 	if identityProviderType == v1pb.IdentityProviderType_OAUTH2 {
@@ -504,8 +538,46 @@ func validIdentityProviderConfig(identityProviderType v1pb.IdentityProviderType,
 		if identityProviderConfig.GetLdapConfig() == nil {
 			return errors.Errorf("unexpected provider config value")
 		}
+		if err := validateLDAPGroupMappings(identityProviderConfig.GetLdapConfig().GroupMappings); err != nil {
+			return err
+		}
 	} else {
 		return errors.Errorf("unexpected provider type %s", identityProviderType)
 	}
 	return nil
 }
+
+// validateLDAPGroupMappings validates the group DNs and the roles of the LDAP group mappings.
+func validateLDAPGroupMappings(mappings []*v1pb.LDAPGroupMapping) error {
+	groups := make(map[string]bool)
+	for _, mapping := range mappings {
+		group, err := ldap.NormalizeDN(mapping.Group)
+		if err != nil {
+			return errors.Errorf("invalid group DN %q in the group mapping", mapping.Group)
+		}
+		if groups[group] {
+			return errors.Errorf("duplicate group mapping %q", mapping.Group)
+		}
+		groups[group] = true
+		if mapping.WorkspaceRole != "" {
+			role, err := common.GetRoleID(mapping.WorkspaceRole)
+			if err != nil {
+				return errors.Errorf("invalid workspace role %q of group %q", mapping.WorkspaceRole, mapping.Group)
+			}
+			switch api.Role(role) {
+			case api.WorkspaceAdmin, api.WorkspaceDBA, api.WorkspaceMember:
+			default:
+				return errors.Errorf("invalid workspace role %q of group %q", mapping.WorkspaceRole, mapping.Group)
+			}
+		}
+		for _, projectRole := range mapping.ProjectRoles {
+			if _, err := common.GetProjectID(projectRole.Project); err != nil {
+				return errors.Errorf("invalid project %q of group %q", projectRole.Project, mapping.Group)
+			}
+			if _, err := common.GetRoleID(projectRole.Role); err != nil {
+				return errors.Errorf("invalid project role %q of group %q", projectRole.Role, mapping.Group)
+			}
+		}
+	}
+	return nil
+}
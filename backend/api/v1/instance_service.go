@@ -15,6 +15,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/ghost"
 	"github.com/bytebase/bytebase/backend/component/secret"
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
@@ -291,6 +292,70 @@ func (s *InstanceService) UpdateInstance(ctx context.Context, request *v1pb.Upda
 			} else {
 				patch.OptionsUpsert.SyncInterval = request.Instance.Options.GetSyncInterval()
 			}
+		case "options.sandbox":
+			if patch.OptionsUpsert == nil {
+				patch.OptionsUpsert = &storepb.InstanceOptions{
+					Sandbox: request.Instance.Options.GetSandbox(),
+				}
+			} else {
+				patch.OptionsUpsert.Sandbox = request.Instance.Options.GetSandbox()
+			}
+		case "options.ghost_flags":
+			ghostFlags := request.Instance.Options.GetGhostFlags()
+			if _, err := ghost.GetUserFlags(ghostFlags); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid ghost flags %q, error %v", ghostFlags, err)
+			}
+			if patch.OptionsUpsert == nil {
+				patch.OptionsUpsert = &storepb.InstanceOptions{
+					GhostFlags: ghostFlags,
+				}
+			} else {
+				patch.OptionsUpsert.GhostFlags = ghostFlags
+			}
+		case "options.sync_database_include_patterns":
+			patterns := request.Instance.Options.GetSyncDatabaseIncludePatterns()
+			if err := schemasync.ValidateSyncDatabasePatterns(patterns); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			if patch.OptionsUpsert == nil {
+				patch.OptionsUpsert = &storepb.InstanceOptions{
+					SyncDatabaseIncludePatterns: patterns,
+				}
+			} else {
+				patch.OptionsUpsert.SyncDatabaseIncludePatterns = patterns
+			}
+		case "options.sync_database_exclude_patterns":
+			patterns := request.Instance.Options.GetSyncDatabaseExcludePatterns()
+			if err := schemasync.ValidateSyncDatabasePatterns(patterns); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			if patch.OptionsUpsert == nil {
+				patch.OptionsUpsert = &storepb.InstanceOptions{
+					SyncDatabaseExcludePatterns: patterns,
+				}
+			} else {
+				patch.OptionsUpsert.SyncDatabaseExcludePatterns = patterns
+			}
+		case "options.maximum_connections":
+			maximumConnections := request.Instance.Options.GetMaximumConnections()
+			if maximumConnections < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "maximum connections must not be negative")
+			}
+			if patch.OptionsUpsert == nil {
+				patch.OptionsUpsert = &storepb.InstanceOptions{
+					MaximumConnections: maximumConnections,
+				}
+			} else {
+				patch.OptionsUpsert.MaximumConnections = maximumConnections
+			}
+		case "options.labels":
+			if patch.OptionsUpsert == nil {
+				patch.OptionsUpsert = &storepb.InstanceOptions{
+					Labels: request.Instance.Options.GetLabels(),
+				}
+			} else {
+				patch.OptionsUpsert.Labels = request.Instance.Options.GetLabels()
+			}
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, `unsupported update_mask "%s"`, path)
 		}
@@ -972,6 +1037,18 @@ func (s *InstanceService) convertToInstanceMessage(instanceID string, instance *
 	if err != nil {
 		return nil, err
 	}
+	if _, err := ghost.GetUserFlags(instance.Options.GetGhostFlags()); err != nil {
+		return nil, errors.Wrapf(err, "invalid ghost flags %q", instance.Options.GetGhostFlags())
+	}
+	if err := schemasync.ValidateSyncDatabasePatterns(instance.Options.GetSyncDatabaseIncludePatterns()); err != nil {
+		return nil, err
+	}
+	if err := schemasync.ValidateSyncDatabasePatterns(instance.Options.GetSyncDatabaseExcludePatterns()); err != nil {
+		return nil, err
+	}
+	if instance.Options.GetMaximumConnections() < 0 {
+		return nil, errors.Errorf("maximum connections must not be negative")
+	}
 
 	return &store.InstanceMessage{
 		ResourceID:    instanceID,
@@ -1073,6 +1150,14 @@ func convertToInstanceOptions(options *storepb.InstanceOptions) *v1pb.InstanceOp
 	return &v1pb.InstanceOptions{
 		SchemaTenantMode: options.SchemaTenantMode,
 		SyncInterval:     options.SyncInterval,
+		Sandbox:          options.Sandbox,
+		GhostFlags:       options.GhostFlags,
+
+		SyncDatabaseIncludePatterns: options.SyncDatabaseIncludePatterns,
+		SyncDatabaseExcludePatterns: options.SyncDatabaseExcludePatterns,
+		MaximumConnections:          options.MaximumConnections,
+		Labels:                      options.Labels,
+		CloudResource:               options.CloudResource,
 	}
 }
 
@@ -1084,5 +1169,13 @@ func convertInstanceOptions(options *v1pb.InstanceOptions) *storepb.InstanceOpti
 	return &storepb.InstanceOptions{
 		SchemaTenantMode: options.SchemaTenantMode,
 		SyncInterval:     options.SyncInterval,
+		Sandbox:          options.Sandbox,
+		GhostFlags:       options.GhostFlags,
+
+		SyncDatabaseIncludePatterns: options.SyncDatabaseIncludePatterns,
+		SyncDatabaseExcludePatterns: options.SyncDatabaseExcludePatterns,
+		MaximumConnections:          options.MaximumConnections,
+		Labels:                      options.Labels,
+		CloudResource:               options.CloudResource,
 	}
 }
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/runner/instancediscovery"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// ListDiscoveredInstances lists the cloud instances found by the instance discovery which are not registered yet.
+func (s *InstanceService) ListDiscoveredInstances(ctx context.Context, _ *v1pb.ListDiscoveredInstancesRequest) (*v1pb.ListDiscoveredInstancesResponse, error) {
+	discovered, err := s.store.GetDiscoveredInstances(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get discovered instances: %v", err)
+	}
+	response := &v1pb.ListDiscoveredInstancesResponse{}
+	for _, d := range discovered.Instances {
+		response.DiscoveredInstances = append(response.DiscoveredInstances, convertToDiscoveredInstance(d))
+	}
+	return response, nil
+}
+
+// AdoptDiscoveredInstance registers the discovered cloud instance as an instance with the admin data source of its discovery source.
+func (s *InstanceService) AdoptDiscoveredInstance(ctx context.Context, request *v1pb.AdoptDiscoveredInstanceRequest) (*v1pb.Instance, error) {
+	if request.CloudResource == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cloud resource must be set")
+	}
+	discovered, err := s.store.GetDiscoveredInstances(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get discovered instances: %v", err)
+	}
+	var discoveredInstance *storepb.DiscoveredInstance
+	var remaining []*storepb.DiscoveredInstance
+	for _, d := range discovered.Instances {
+		if d.CloudResource == request.CloudResource {
+			discoveredInstance = d
+		} else {
+			remaining = append(remaining, d)
+		}
+	}
+	if discoveredInstance == nil {
+		return nil, status.Errorf(codes.NotFound, "discovered instance %q not found", request.CloudResource)
+	}
+	setting, err := s.store.GetInstanceDiscoverySetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance discovery setting: %v", err)
+	}
+	var source *storepb.InstanceDiscoverySetting_Source
+	for _, v := range setting.Sources {
+		if v.Id == discoveredInstance.Source {
+			source = v
+		}
+	}
+	if source == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "instance discovery source %q not found", discoveredInstance.Source)
+	}
+
+	instances, err := s.store.ListInstancesV2(ctx, &store.FindInstanceMessage{ShowDeleted: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list instances: %v", err)
+	}
+	instanceIDs := map[string]bool{}
+	for _, instance := range instances {
+		if instance.Options.GetCloudResource() == request.CloudResource {
+			return nil, status.Errorf(codes.AlreadyExists, "discovered instance %q is already registered as instance %q", request.CloudResource, instance.ResourceID)
+		}
+		instanceIDs[instance.ResourceID] = true
+	}
+	instanceID := request.InstanceId
+	if instanceID == "" {
+		instanceID = instancediscovery.GetInstanceID(discoveredInstance.Title, instanceIDs)
+	}
+
+	instance, err := s.CreateInstance(ctx, instancediscovery.NewCreateInstanceRequest(source, discoveredInstance, instanceID))
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := protojson.Marshal(&storepb.DiscoveredInstances{Instances: remaining})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal discovered instances: %v", err)
+	}
+	if _, err := s.store.UpsertSettingV2(ctx, &store.SetSettingMessage{
+		Name:  api.SettingDiscoveredInstances,
+		Value: string(bytes),
+	}, api.SystemBotID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update discovered instances: %v", err)
+	}
+	return instance, nil
+}
+
+func convertToDiscoveredInstance(discovered *storepb.DiscoveredInstance) *v1pb.DiscoveredInstance {
+	return &v1pb.DiscoveredInstance{
+		Source:        discovered.Source,
+		CloudResource: discovered.CloudResource,
+		Title:         discovered.Title,
+		Engine:        v1pb.Engine(discovered.Engine),
+		Host:          discovered.Host,
+		Port:          discovered.Port,
+		Labels:        discovered.Labels,
+		DiscoverTime:  discovered.DiscoverTime,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -151,12 +152,14 @@ func (s *IssueService) ListIssues(ctx context.Context, request *v1pb.ListIssuesR
 
 	limit := int(request.PageSize)
 	offset := 0
+	var after *store.KeysetCursor
 	if request.PageToken != "" {
 		var pageToken storepb.PageToken
 		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
 		}
 		offset = int(pageToken.Offset)
+		after = getKeysetCursor(&pageToken)
 	}
 	if limit == 0 {
 		limit = 10
@@ -166,16 +169,24 @@ func (s *IssueService) ListIssues(ctx context.Context, request *v1pb.ListIssuesR
 	}
 	limitPlusOne := limit + 1
 
+	normalRowStatus, archivedRowStatus := api.Normal, api.Archived
+	// The archived issues are hidden unless filtered by "archived = true".
 	issueFind := &store.FindIssueMessage{
 		ProjectIDs: projectIDs,
 		Limit:      &limitPlusOne,
-		Offset:     &offset,
+		RowStatus:  &normalRowStatus,
 	}
 	if requestProjectID != "-" {
 		issueFind.ProjectID = &requestProjectID
 	}
+	// The issues matching the query are ordered by the rank, so they are paginated by the offset.
 	if request.Query != "" {
 		issueFind.Query = &request.Query
+		issueFind.Offset = &offset
+	} else if after != nil {
+		issueFind.After = after
+	} else {
+		issueFind.Offset = &offset
 	}
 
 	filters, err := parseFilter(request.Filter)
@@ -290,6 +301,17 @@ func (s *IssueService) ListIssues(ctx context.Context, request *v1pb.ListIssuesR
 				return nil, status.Errorf(codes.InvalidArgument, `database "%q" not found`, spec.value)
 			}
 			issueFind.DatabaseUID = &database.UID
+		case "archived":
+			if spec.operator != comparatorTypeEqual {
+				return nil, status.Errorf(codes.InvalidArgument, `only support "=" operation for "archived" filter`)
+			}
+			archived, err := strconv.ParseBool(spec.value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, `invalid value %q for "archived" filter`, spec.value)
+			}
+			if archived {
+				issueFind.RowStatus = &archivedRowStatus
+			}
 		}
 	}
 
@@ -299,7 +321,13 @@ func (s *IssueService) ListIssues(ctx context.Context, request *v1pb.ListIssuesR
 	}
 
 	if len(issues) == limitPlusOne {
-		nextPageToken, err := getPageToken(limit, offset+limit)
+		var nextPageToken string
+		if request.Query != "" {
+			nextPageToken, err = getPageToken(limit, offset+limit)
+		} else {
+			last := issues[limit-1]
+			nextPageToken, err = getKeysetPageToken(limit, last.CreatedTime.Unix(), last.UID)
+		}
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
 		}
@@ -399,23 +427,62 @@ func (s *IssueService) createIssueDatabaseChange(ctx context.Context, request *v
 		return nil, status.Errorf(codes.NotFound, "project not found for id: %v", projectID)
 	}
 
-	if request.Issue.Plan == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "plan is required")
+	title, assignee, labels := request.Issue.Title, request.Issue.Assignee, request.Issue.Labels
+	var plan *store.PlanMessage
+	if request.Template != "" {
+		templateProjectID, templateID, err := common.GetProjectIDIssueTemplateID(request.Template)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		template := findIssueTemplate(project.Setting, templateID)
+		if templateProjectID != project.ResourceID || template == nil {
+			return nil, status.Errorf(codes.NotFound, "issue template %q not found", request.Template)
+		}
+		values, err := resolveIssueTemplateVariables(template, request.TemplateVariables, time.Now())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid template variables, error: %v", err)
+		}
+		if title == "" {
+			title = renderIssueTemplate(template.TitlePattern, values)
+		}
+		if assignee == "" {
+			assignee = template.Assignee
+		}
+		for _, label := range template.Labels {
+			if !slices.Contains(labels, label) {
+				labels = append(labels, label)
+			}
+		}
+		if request.Issue.Plan == "" {
+			plan, err = s.createIssueTemplatePlan(ctx, project, template, title, renderIssueTemplate(template.Statement, values), principalID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else if len(request.TemplateVariables) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "template variables are not allowed without template")
 	}
 
-	var planUID *int64
-	planID, err := common.GetPlanID(request.Issue.Plan)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
-	}
-	plan, err := s.store.GetPlan(ctx, &store.FindPlanMessage{UID: &planID})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get plan, error: %v", err)
-	}
 	if plan == nil {
-		return nil, status.Errorf(codes.NotFound, "plan not found for id: %d", planID)
+		if request.Issue.Plan == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "plan is required")
+		}
+		planID, err := common.GetPlanID(request.Issue.Plan)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		plan, err = s.store.GetPlan(ctx, &store.FindPlanMessage{UID: &planID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get plan, error: %v", err)
+		}
+		if plan == nil {
+			return nil, status.Errorf(codes.NotFound, "plan not found for id: %d", planID)
+		}
+	}
+	planUID := &plan.UID
+	if err := checkPlanDatabasesNotFrozen(ctx, s.store, plan); err != nil {
+		return nil, err
 	}
-	planUID = &plan.UID
 	var rolloutUID *int
 	if request.Issue.Rollout != "" {
 		_, rolloutID, err := common.GetProjectIDRolloutID(request.Issue.Rollout)
@@ -433,8 +500,8 @@ func (s *IssueService) createIssueDatabaseChange(ctx context.Context, request *v
 	}
 
 	var issueAssignee *store.UserMessage
-	if request.Issue.Assignee != "" {
-		assigneeEmail, err := common.GetUserEmail(request.Issue.Assignee)
+	if assignee != "" {
+		assigneeEmail, err := common.GetUserEmail(assignee)
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, err.Error())
 		}
@@ -448,18 +515,32 @@ func (s *IssueService) createIssueDatabaseChange(ctx context.Context, request *v
 		issueAssignee = assignee
 	}
 
+	blockingIssueUIDs, err := getBlockingIssueUIDs(ctx, s.store, 0, request.Issue.BlockedBy)
+	if err != nil {
+		return nil, err
+	}
+
 	issueCreateMessage := &store.IssueMessage{
-		Project:     project,
-		PlanUID:     planUID,
-		PipelineUID: rolloutUID,
-		Title:       request.Issue.Title,
-		Status:      api.IssueOpen,
-		Type:        api.IssueDatabaseGeneral,
-		Description: request.Issue.Description,
-		Assignee:    issueAssignee,
+		Project:           project,
+		PlanUID:           planUID,
+		PipelineUID:       rolloutUID,
+		Title:             title,
+		Status:            api.IssueOpen,
+		Type:              api.IssueDatabaseGeneral,
+		Description:       request.Issue.Description,
+		Assignee:          issueAssignee,
+		BlockingIssueUIDs: blockingIssueUIDs,
+	}
+
+	formData, err := validateIssueFormData(findIssueForm(project.Setting, api.IssueDatabaseGeneral), request.Issue.FormData)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid form data, error: %v", err)
 	}
 
 	issueCreateMessage.Payload = &storepb.IssuePayload{
+		FormData: formData,
+		Priority: convertIssuePriority(request.Issue.Priority),
+		Labels:   labels,
 		Approval: &storepb.IssuePayloadApproval{
 			ApprovalFindingDone: false,
 			ApprovalTemplates:   nil,
@@ -501,6 +582,70 @@ func (s *IssueService) createIssueDatabaseChange(ctx context.Context, request *v
 	return converted, nil
 }
 
+// checkPlanDatabasesNotFrozen returns a FailedPrecondition error if the plan changes any frozen database.
+// The databases targeted by the deployment configs are checked when the tasks run.
+func checkPlanDatabasesNotFrozen(ctx context.Context, s *store.Store, plan *store.PlanMessage) error {
+	for _, step := range plan.Config.GetSteps() {
+		for _, spec := range step.Specs {
+			config := spec.GetChangeDatabaseConfig()
+			if config == nil {
+				continue
+			}
+			var databases []*store.DatabaseMessage
+			if instanceID, databaseName, err := common.GetInstanceDatabaseID(config.Target); err == nil {
+				instance, err := s.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+				if err != nil {
+					return status.Errorf(codes.Internal, "failed to get instance %q, error: %v", instanceID, err)
+				}
+				if instance == nil {
+					continue
+				}
+				database, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+					InstanceID:          &instanceID,
+					DatabaseName:        &databaseName,
+					IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+				})
+				if err != nil {
+					return status.Errorf(codes.Internal, "failed to get database %q, error: %v", databaseName, err)
+				}
+				if database != nil {
+					databases = append(databases, database)
+				}
+			} else if projectID, databaseGroupID, err := common.GetProjectIDDatabaseGroupID(config.Target); err == nil {
+				project, err := s.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+				if err != nil {
+					return status.Errorf(codes.Internal, "failed to get project %q, error: %v", projectID, err)
+				}
+				if project == nil {
+					continue
+				}
+				databaseGroup, err := s.GetDatabaseGroup(ctx, &store.FindDatabaseGroupMessage{ProjectUID: &project.UID, ResourceID: &databaseGroupID})
+				if err != nil {
+					return status.Errorf(codes.Internal, "failed to get database group %q, error: %v", databaseGroupID, err)
+				}
+				if databaseGroup == nil {
+					continue
+				}
+				allDatabases, err := s.ListDatabases(ctx, &store.FindDatabaseMessage{ProjectID: &project.ResourceID})
+				if err != nil {
+					return status.Errorf(codes.Internal, "failed to list databases for project %q, error: %v", project.ResourceID, err)
+				}
+				matchedDatabases, _, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, s, databaseGroup, allDatabases)
+				if err != nil {
+					return status.Errorf(codes.Internal, "failed to get matched databases in database group %q, error: %v", databaseGroupID, err)
+				}
+				databases = append(databases, matchedDatabases...)
+			}
+			for _, database := range databases {
+				if freeze := utils.GetDatabaseFreeze(database); freeze != nil {
+					return status.Errorf(codes.FailedPrecondition, "database %q is frozen: %s", database.DatabaseName, freeze.Reason)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func (s *IssueService) createIssueGrantRequest(ctx context.Context, request *v1pb.CreateIssueRequest) (*v1pb.Issue, error) {
 	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
 	if !ok {
@@ -526,6 +671,17 @@ func (s *IssueService) createIssueGrantRequest(ctx context.Context, request *v1p
 	if request.Issue.GrantRequest.GetUser() == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "expect grant request user")
 	}
+	if request.Template != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "issue template is only supported for database change issues")
+	}
+	if len(request.Issue.BlockedBy) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "blocking issues are only supported for database change issues")
+	}
+	if request.Issue.GrantRequest.GetRole() == common.FormatRole(api.ProjectDMLExecutor.String()) {
+		if err := validateDMLExecutorGrantRequest(request.Issue.GrantRequest); err != nil {
+			return nil, err
+		}
+	}
 	// Validate CEL expression if it's not empty.
 	if expression := request.Issue.GrantRequest.GetCondition().GetExpression(); expression != "" {
 		e, err := cel.NewEnv(common.QueryExportPolicyCELAttributes...)
@@ -561,6 +717,11 @@ func (s *IssueService) createIssueGrantRequest(ctx context.Context, request *v1p
 		}
 	}
 
+	formData, err := validateIssueFormData(findIssueForm(project.Setting, api.IssueGrantRequest), request.Issue.FormData)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid form data, error: %v", err)
+	}
+
 	issueCreateMessage := &store.IssueMessage{
 		Project:     project,
 		PlanUID:     nil,
@@ -579,6 +740,8 @@ func (s *IssueService) createIssueGrantRequest(ctx context.Context, request *v1p
 
 	issueCreateMessage.Payload = &storepb.IssuePayload{
 		GrantRequest: convertedGrantRequest,
+		FormData:     formData,
+		Labels:       request.Issue.Labels,
 		Approval: &storepb.IssuePayloadApproval{
 			ApprovalFindingDone: false,
 			ApprovalTemplates:   nil,
@@ -703,14 +866,20 @@ func (s *IssueService) ApproveIssue(ctx context.Context, request *v1pb.ApproveIs
 	}
 	payload.Approval.Approvers = append(payload.Approval.Approvers, newApprovers...)
 
+	activities, err := s.newApprovalActivities(ctx, issue, principalID, request.Comment, storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_APPROVED, activityCreates)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build approval activities, error: %v", err)
+	}
 	issue, err = s.store.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
 		PayloadUpsert: &storepb.IssuePayload{
 			Approval: payload.Approval,
 		},
+		Activities: activities,
 	}, api.SystemBotID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update issue, error: %v", err)
 	}
+	s.activityManager.Notify()
 
 	// Grant the privilege if the issue is approved.
 	if approved && issue.Type == api.IssueGrantRequest {
@@ -737,42 +906,6 @@ func (s *IssueService) ApproveIssue(ctx context.Context, request *v1pb.ApproveIs
 		}
 	}
 
-	// It's ok to fail to create activity.
-	if err := func() error {
-		activityPayload, err := protojson.Marshal(&storepb.ActivityIssueCommentCreatePayload{
-			Event: &storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_{
-				ApprovalEvent: &storepb.ActivityIssueCommentCreatePayload_ApprovalEvent{
-					Status: storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_APPROVED,
-				},
-			},
-			IssueName: issue.Title,
-		})
-		if err != nil {
-			return err
-		}
-		create := &store.ActivityMessage{
-			CreatorUID:   principalID,
-			ContainerUID: issue.UID,
-			Type:         api.ActivityIssueCommentCreate,
-			Level:        api.ActivityInfo,
-			Comment:      request.Comment,
-			Payload:      string(activityPayload),
-		}
-		if _, err := s.activityManager.CreateActivity(ctx, create, &activity.Metadata{}); err != nil {
-			return err
-		}
-
-		for _, create := range activityCreates {
-			if _, err := s.activityManager.CreateActivity(ctx, create, &activity.Metadata{}); err != nil {
-				return err
-			}
-		}
-
-		return nil
-	}(); err != nil {
-		slog.Error("failed to create skipping steps activity after approving issue", log.BBError(err))
-	}
-
 	if err := func() error {
 		if len(payload.Approval.ApprovalTemplates) != 1 {
 			return nil
@@ -964,43 +1097,20 @@ func (s *IssueService) RejectIssue(ctx context.Context, request *v1pb.RejectIssu
 		PrincipalId: int32(principalID),
 	})
 
+	activities, err := s.newApprovalActivities(ctx, issue, principalID, request.Comment, storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_REJECTED, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build approval activities, error: %v", err)
+	}
 	issue, err = s.store.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
 		PayloadUpsert: &storepb.IssuePayload{
 			Approval: payload.Approval,
 		},
+		Activities: activities,
 	}, api.SystemBotID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update issue, error: %v", err)
 	}
-
-	// It's ok to fail to create activity.
-	if err := func() error {
-		activityPayload, err := protojson.Marshal(&storepb.ActivityIssueCommentCreatePayload{
-			Event: &storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_{
-				ApprovalEvent: &storepb.ActivityIssueCommentCreatePayload_ApprovalEvent{
-					Status: storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_REJECTED,
-				},
-			},
-			IssueName: issue.Title,
-		})
-		if err != nil {
-			return err
-		}
-		create := &store.ActivityMessage{
-			CreatorUID:   principalID,
-			ContainerUID: issue.UID,
-			Type:         api.ActivityIssueCommentCreate,
-			Level:        api.ActivityInfo,
-			Comment:      request.Comment,
-			Payload:      string(activityPayload),
-		}
-		if _, err := s.activityManager.CreateActivity(ctx, create, &activity.Metadata{}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		slog.Error("failed to create activity after rejecting issue", log.BBError(err))
-	}
+	s.activityManager.Notify()
 
 	issueV1, err := convertToIssue(ctx, s.store, issue)
 	if err != nil {
@@ -1063,56 +1173,56 @@ func (s *IssueService) RequestIssue(ctx context.Context, request *v1pb.RequestIs
 	}
 	payload.Approval.Approvers = append(payload.Approval.Approvers, newApprovers...)
 
+	activities, err := s.newApprovalActivities(ctx, issue, principalID, request.Comment, storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_PENDING, activityCreates)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build approval activities, error: %v", err)
+	}
 	issue, err = s.store.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
 		PayloadUpsert: &storepb.IssuePayload{
 			Approval: payload.Approval,
 		},
+		Activities: activities,
 	}, api.SystemBotID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update issue, error: %v", err)
 	}
+	s.activityManager.Notify()
 
-	// It's ok to fail to create activity.
-	if err := func() error {
-		activityPayload, err := protojson.Marshal(&storepb.ActivityIssueCommentCreatePayload{
-			Event: &storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_{
-				ApprovalEvent: &storepb.ActivityIssueCommentCreatePayload_ApprovalEvent{
-					Status: storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_PENDING,
-				},
+	issueV1, err := convertToIssue(ctx, s.store, issue)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert to issue, error: %v", err)
+	}
+	return issueV1, nil
+}
+
+// newApprovalActivities returns the approval comment activity and the activities of the incoming approval steps,
+// to create in the transaction updating the issue approval.
+func (s *IssueService) newApprovalActivities(ctx context.Context, issue *store.IssueMessage, principalID int, comment string, approvalStatus storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_Status, stepCreates []*store.ActivityMessage) ([]*store.PendingActivity, error) {
+	activityPayload, err := protojson.Marshal(&storepb.ActivityIssueCommentCreatePayload{
+		Event: &storepb.ActivityIssueCommentCreatePayload_ApprovalEvent_{
+			ApprovalEvent: &storepb.ActivityIssueCommentCreatePayload_ApprovalEvent{
+				Status: approvalStatus,
 			},
-			IssueName: issue.Title,
-		})
-		if err != nil {
-			return err
-		}
-		create := &store.ActivityMessage{
+		},
+		IssueName: issue.Title,
+	})
+	if err != nil {
+		return nil, err
+	}
+	activities := []*store.PendingActivity{
+		s.activityManager.NewPendingActivity(ctx, &store.ActivityMessage{
 			CreatorUID:   principalID,
 			ContainerUID: issue.UID,
 			Type:         api.ActivityIssueCommentCreate,
 			Level:        api.ActivityInfo,
-			Comment:      request.Comment,
+			Comment:      comment,
 			Payload:      string(activityPayload),
-		}
-		if _, err := s.activityManager.CreateActivity(ctx, create, &activity.Metadata{}); err != nil {
-			return err
-		}
-
-		for _, create := range activityCreates {
-			if _, err := s.activityManager.CreateActivity(ctx, create, &activity.Metadata{}); err != nil {
-				return err
-			}
-		}
-
-		return nil
-	}(); err != nil {
-		slog.Error("failed to create skipping steps activity after approving issue", log.BBError(err))
+		}, &activity.Metadata{}),
 	}
-
-	issueV1, err := convertToIssue(ctx, s.store, issue)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to convert to issue, error: %v", err)
+	for _, create := range stepCreates {
+		activities = append(activities, s.activityManager.NewPendingActivity(ctx, create, &activity.Metadata{}))
 	}
-	return issueV1, nil
+	return activities, nil
 }
 
 // UpdateIssue updates the issue.
@@ -1241,6 +1351,70 @@ func (s *IssueService) UpdateIssue(ctx context.Context, request *v1pb.UpdateIssu
 			}
 			patch.Subscribers = &subscribers
 
+		case "blocked_by":
+			if issue.Type != api.IssueDatabaseGeneral {
+				return nil, status.Errorf(codes.InvalidArgument, "blocking issues are only supported for database change issues")
+			}
+			blockingIssueUIDs, err := getBlockingIssueUIDs(ctx, s.store, issue.UID, request.Issue.BlockedBy)
+			if err != nil {
+				return nil, err
+			}
+			patch.BlockingIssueUIDs = &blockingIssueUIDs
+
+		case "priority":
+			priority := convertIssuePriority(request.Issue.Priority)
+			oldPriority := issue.Payload.GetPriority()
+			if oldPriority == storepb.IssuePayload_PRIORITY_UNSPECIFIED {
+				oldPriority = storepb.IssuePayload_NORMAL
+			}
+			if patch.PayloadUpsert == nil {
+				patch.PayloadUpsert = &storepb.IssuePayload{}
+			}
+			patch.PayloadUpsert.Priority = priority
+
+			payload := &api.ActivityIssueFieldUpdatePayload{
+				FieldID:   api.IssueFieldPriority,
+				OldValue:  oldPriority.String(),
+				NewValue:  priority.String(),
+				IssueName: issue.Title,
+			}
+			activityPayload, err := json.Marshal(payload)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to marshal activity payload, error: %v", err)
+			}
+			activityCreates = append(activityCreates, &store.ActivityMessage{
+				CreatorUID:   principalID,
+				ContainerUID: issue.UID,
+				Type:         api.ActivityIssueFieldUpdate,
+				Level:        api.ActivityInfo,
+				Payload:      string(activityPayload),
+			})
+
+		case "labels":
+			labels := request.Issue.Labels
+			if labels == nil {
+				labels = []string{}
+			}
+			patch.Labels = &labels
+
+			payload := &api.ActivityIssueFieldUpdatePayload{
+				FieldID:   api.IssueFieldLabels,
+				OldValue:  strings.Join(issue.Payload.GetLabels(), ","),
+				NewValue:  strings.Join(labels, ","),
+				IssueName: issue.Title,
+			}
+			activityPayload, err := json.Marshal(payload)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to marshal activity payload, error: %v", err)
+			}
+			activityCreates = append(activityCreates, &store.ActivityMessage{
+				CreatorUID:   principalID,
+				ContainerUID: issue.UID,
+				Type:         api.ActivityIssueFieldUpdate,
+				Level:        api.ActivityInfo,
+				Payload:      string(activityPayload),
+			})
+
 		case "assignee":
 			oldAssigneeID := ""
 			if issue.Assignee != nil {
@@ -1302,21 +1476,51 @@ func (s *IssueService) UpdateIssue(ctx context.Context, request *v1pb.UpdateIssu
 		}
 	}
 
+	for _, create := range activityCreates {
+		patch.Activities = append(patch.Activities, s.activityManager.NewPendingActivity(ctx, create, &activity.Metadata{Issue: issue}))
+	}
 	issue, err = s.store.UpdateIssueV2(ctx, issue.UID, patch, principalID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update issue, error: %v", err)
 	}
+	s.activityManager.Notify()
 
 	if updateMasks["approval_finding_done"] {
 		s.stateCfg.ApprovalFinding.Store(issue.UID, issue)
 	}
 
-	for _, create := range activityCreates {
-		if _, err := s.activityManager.CreateActivity(ctx, create, &activity.Metadata{Issue: issue}); err != nil {
-			slog.Warn("failed to create issue field update activity", "issue_id", issue.UID, log.BBError(err))
-		}
+	issueV1, err := convertToIssue(ctx, s.store, issue)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert to issue, error: %v", err)
+	}
+	return issueV1, nil
+}
+
+// UnarchiveIssue restores an archived issue to the issue list.
+func (s *IssueService) UnarchiveIssue(ctx context.Context, request *v1pb.UnarchiveIssueRequest) (*v1pb.Issue, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	issue, err := s.getIssueMessage(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !issue.Archived {
+		return nil, status.Errorf(codes.FailedPrecondition, "issue %q is not archived", request.Name)
+	}
+	ok, err = isUserAtLeastProjectDeveloper(ctx, s.store, issue.Project.ResourceID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check if the user can unarchive issue, error: %v", err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
+	issue, err = s.store.UnarchiveIssue(ctx, issue.UID, principalID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unarchive issue, error: %v", err)
+	}
 	issueV1, err := convertToIssue(ctx, s.store, issue)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to convert to issue, error: %v", err)
@@ -1362,46 +1566,34 @@ func (s *IssueService) BatchUpdateIssuesStatus(ctx context.Context, request *v1p
 		return nil, status.Errorf(codes.InvalidArgument, "failed to convert to issue status, err: %v", err)
 	}
 
-	if err := s.store.BatchUpdateIssueStatuses(ctx, issueIDs, newStatus, principalID); err != nil {
+	var activities []*store.PendingActivity
+	for _, issue := range issues {
+		payload, err := json.Marshal(api.ActivityIssueStatusUpdatePayload{
+			OldStatus: issue.Status,
+			NewStatus: newStatus,
+			IssueName: issue.Title,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal activity after changing the issue status: %v, err: %v", issue.Title, err)
+		}
+		activities = append(activities, s.activityManager.NewPendingActivity(ctx, &store.ActivityMessage{
+			CreatorUID:   principalID,
+			ContainerUID: issue.UID,
+			Type:         api.ActivityIssueStatusUpdate,
+			Level:        api.ActivityInfo,
+			Comment:      request.Reason,
+			Payload:      string(payload),
+		}, &activity.Metadata{
+			Issue: issue,
+		}))
+	}
+	if err := s.store.BatchUpdateIssueStatuses(ctx, issueIDs, newStatus, principalID, activities); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to batch update issues, err: %v", err)
 	}
+	s.activityManager.Notify()
 
-	if err := func() error {
-		var errs error
-		for _, issue := range issues {
-			updatedIssue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issue.UID})
-			if err != nil {
-				errs = multierr.Append(errs, errors.Wrapf(err, "failed to get issue %v", issue.UID))
-				continue
-			}
-
-			payload, err := json.Marshal(api.ActivityIssueStatusUpdatePayload{
-				OldStatus: issue.Status,
-				NewStatus: updatedIssue.Status,
-				IssueName: updatedIssue.Title,
-			})
-			if err != nil {
-				errs = multierr.Append(errs, errors.Wrapf(err, "failed to marshal activity after changing the issue status: %v", updatedIssue.Title))
-				continue
-			}
-			activityCreate := &store.ActivityMessage{
-				CreatorUID:   principalID,
-				ContainerUID: updatedIssue.UID,
-				Type:         api.ActivityIssueStatusUpdate,
-				Level:        api.ActivityInfo,
-				Comment:      request.Reason,
-				Payload:      string(payload),
-			}
-			if _, err := s.activityManager.CreateActivity(ctx, activityCreate, &activity.Metadata{
-				Issue: updatedIssue,
-			}); err != nil {
-				errs = multierr.Append(errs, errors.Wrapf(err, "failed to create activity after changing the issue status: %v", updatedIssue.Title))
-				continue
-			}
-		}
-		return errs
-	}(); err != nil {
-		slog.Error("failed to create activity after changing the issue status", log.BBError(err))
+	if err := createBatchOperationActivity(ctx, s.activityManager, principalID, "BatchUpdateIssuesStatus", request.Parent, request.Reason, request.Issues, nil); err != nil {
+		slog.Error("failed to create activity after changing the issues status", log.BBError(err))
 	}
 
 	return &v1pb.BatchUpdateIssuesStatusResponse{}, nil
@@ -1646,6 +1838,11 @@ func convertToIssue(ctx context.Context, s *store.Store, issue *store.IssueMessa
 		return nil, errors.Wrapf(err, "failed to get issue releasers")
 	}
 
+	blockedBy, blockedReasons, err := convertToIssueBlockedBy(ctx, s, issue)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get issue blocking issues")
+	}
+
 	issueV1 := &v1pb.Issue{
 		Name:                 fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, issue.Project.ResourceID, common.IssuePrefix, issue.UID),
 		Uid:                  fmt.Sprintf("%d", issue.UID),
@@ -1666,6 +1863,12 @@ func convertToIssue(ctx context.Context, s *store.Store, issue *store.IssueMessa
 		Rollout:              "",
 		GrantRequest:         convertedGrantRequest,
 		Releasers:            releasers,
+		FormData:             issuePayload.FormData,
+		Archived:             issue.Archived,
+		Priority:             convertToIssuePriority(issuePayload.Priority),
+		Labels:               issuePayload.Labels,
+		BlockedBy:            blockedBy,
+		BlockedReasons:       blockedReasons,
 	}
 
 	if issue.PlanUID != nil {
@@ -1797,6 +2000,29 @@ func convertToIssueStatus(status api.IssueStatus) v1pb.IssueStatus {
 	}
 }
 
+func convertToIssuePriority(priority storepb.IssuePayload_Priority) v1pb.Issue_Priority {
+	switch priority {
+	case storepb.IssuePayload_LOW:
+		return v1pb.Issue_LOW
+	case storepb.IssuePayload_HIGH:
+		return v1pb.Issue_HIGH
+	default:
+		return v1pb.Issue_NORMAL
+	}
+}
+
+// convertIssuePriority converts the priority to store, the unspecified priority is stored as NORMAL explicitly.
+func convertIssuePriority(priority v1pb.Issue_Priority) storepb.IssuePayload_Priority {
+	switch priority {
+	case v1pb.Issue_LOW:
+		return storepb.IssuePayload_LOW
+	case v1pb.Issue_HIGH:
+		return storepb.IssuePayload_HIGH
+	default:
+		return storepb.IssuePayload_NORMAL
+	}
+}
+
 func convertToApprovalTemplate(template *storepb.ApprovalTemplate) *v1pb.ApprovalTemplate {
 	return &v1pb.ApprovalTemplate{
 		Flow:        convertToApprovalFlow(template.Flow),
@@ -1883,6 +2109,30 @@ func convertToGrantRequest(ctx context.Context, s *store.Store, v *storepb.Grant
 	}, nil
 }
 
+// validateDMLExecutorGrantRequest validates the just-in-time privileged DML access request,
+// which must be limited to specific databases and expire.
+func validateDMLExecutorGrantRequest(grantRequest *v1pb.GrantRequest) error {
+	expression := grantRequest.GetCondition().GetExpression()
+	if expression == "" {
+		return status.Errorf(codes.InvalidArgument, "expect the databases in the grant request condition for role %q", grantRequest.Role)
+	}
+	factors, err := common.GetQueryExportFactors(expression)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to get query export factors, error: %v", err)
+	}
+	if len(factors.DatabaseNames) == 0 {
+		return status.Errorf(codes.InvalidArgument, "expect the databases in the grant request condition for role %q", grantRequest.Role)
+	}
+	expireTime, err := common.GetExpirationTime(expression)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to get expiration time, error: %v", err)
+	}
+	if expireTime == nil && grantRequest.GetExpiration().AsDuration() <= 0 {
+		return status.Errorf(codes.InvalidArgument, "expect the expiration in the grant request for role %q", grantRequest.Role)
+	}
+	return nil
+}
+
 func convertGrantRequest(ctx context.Context, s *store.Store, v *v1pb.GrantRequest) (*storepb.GrantRequest, error) {
 	if v == nil {
 		return nil, nil
@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/activity"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// BatchApproveIssues approves the issues in batch.
+// The issues failed to approve are returned in the failures, and the others are still approved.
+func (s *IssueService) BatchApproveIssues(ctx context.Context, request *v1pb.BatchApproveIssuesRequest) (*v1pb.BatchApproveIssuesResponse, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	if len(request.Issues) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "issues cannot be empty")
+	}
+
+	response := &v1pb.BatchApproveIssuesResponse{}
+	var approved []string
+	for _, issueName := range request.Issues {
+		issue, err := s.ApproveIssue(ctx, &v1pb.ApproveIssueRequest{
+			Name:    issueName,
+			Comment: request.Comment,
+		})
+		if err != nil {
+			response.Failures = append(response.Failures, convertToBatchOperationFailure(issueName, err))
+			continue
+		}
+		response.Issues = append(response.Issues, issue)
+		approved = append(approved, issueName)
+	}
+
+	if err := createBatchOperationActivity(ctx, s.activityManager, principalID, "BatchApproveIssues", request.Parent, request.Comment, approved, response.Failures); err != nil {
+		slog.Error("failed to create activity after approving issues", log.BBError(err))
+	}
+	return response, nil
+}
+
+// BatchUpdateIssues updates the issues in batch.
+// The issues failed to update are returned in the failures, and the others are still updated.
+func (s *IssueService) BatchUpdateIssues(ctx context.Context, request *v1pb.BatchUpdateIssuesRequest) (*v1pb.BatchUpdateIssuesResponse, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	if len(request.Requests) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "requests cannot be empty")
+	}
+	for _, req := range request.Requests {
+		if req.UpdateMask == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "update_mask must be set")
+		}
+		for _, path := range req.UpdateMask.Paths {
+			switch path {
+			case "assignee", "labels":
+			default:
+				return nil, status.Errorf(codes.InvalidArgument, "unsupported update_mask path %q in batch update", path)
+			}
+		}
+	}
+
+	response := &v1pb.BatchUpdateIssuesResponse{}
+	var updated []string
+	for _, req := range request.Requests {
+		issue, err := s.UpdateIssue(ctx, req)
+		if err != nil {
+			response.Failures = append(response.Failures, convertToBatchOperationFailure(req.GetIssue().GetName(), err))
+			continue
+		}
+		response.Issues = append(response.Issues, issue)
+		updated = append(updated, issue.Name)
+	}
+
+	if err := createBatchOperationActivity(ctx, s.activityManager, principalID, "BatchUpdateIssues", request.Parent, "", updated, response.Failures); err != nil {
+		slog.Error("failed to create activity after updating issues", log.BBError(err))
+	}
+	return response, nil
+}
+
+func convertToBatchOperationFailure(resource string, err error) *v1pb.BatchOperationFailure {
+	return &v1pb.BatchOperationFailure{
+		Resource: resource,
+		Error:    status.Convert(err).Message(),
+	}
+}
+
+// createBatchOperationActivity creates the single audit record summarizing the batch operation of the principal.
+func createBatchOperationActivity(ctx context.Context, activityManager *activity.Manager, principalID int, operation, parent, reason string, resources []string, failures []*v1pb.BatchOperationFailure) error {
+	payload := &api.ActivityMemberBatchOperationPayload{
+		Operation: operation,
+		Parent:    parent,
+		Resources: resources,
+	}
+	for _, failure := range failures {
+		payload.FailedResources = append(payload.FailedResources, failure.Resource)
+	}
+	bytes, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal activity payload")
+	}
+
+	level := api.ActivityInfo
+	if len(failures) > 0 {
+		level = api.ActivityWarn
+	}
+	comment := fmt.Sprintf("%s: %d succeeded, %d failed.", operation, len(resources), len(failures))
+	if reason != "" {
+		comment = fmt.Sprintf("%s %s", comment, reason)
+	}
+	if _, err := activityManager.CreateActivity(ctx, &store.ActivityMessage{
+		CreatorUID:   principalID,
+		ContainerUID: principalID,
+		Type:         api.ActivityMemberBatchOperation,
+		Level:        level,
+		Comment:      comment,
+		Payload:      string(bytes),
+	}, &activity.Metadata{}); err != nil {
+		return errors.Wrapf(err, "failed to create activity")
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// maxIssueDependencyGraphSize bounds the number of the issues in the dependency graph.
+const maxIssueDependencyGraphSize = 100
+
+// GetIssueDependencyGraph gets the graph of the issues connected with the issue by the dependencies.
+func (s *IssueService) GetIssueDependencyGraph(ctx context.Context, request *v1pb.GetIssueDependencyGraphRequest) (*v1pb.IssueDependencyGraph, error) {
+	issue, err := s.getIssueMessage(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	visited := map[int]bool{issue.UID: true}
+	queue := []*store.IssueMessage{issue}
+	graph := &v1pb.IssueDependencyGraph{}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		node := &v1pb.IssueDependencyGraph_Node{
+			Issue:  getIssueName(current),
+			Status: convertToIssueStatus(current.Status),
+		}
+		var neighbors []*store.IssueMessage
+		for _, blockingIssueUID := range current.BlockingIssueUIDs {
+			blockingIssue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &blockingIssueUID})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get issue %d, error: %v", blockingIssueUID, err)
+			}
+			if blockingIssue == nil {
+				continue
+			}
+			node.BlockedBy = append(node.BlockedBy, getIssueName(blockingIssue))
+			neighbors = append(neighbors, blockingIssue)
+		}
+		blockedIssues, err := s.store.ListIssueV2(ctx, &store.FindIssueMessage{BlockingIssueUID: &current.UID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list issues blocked by issue %d, error: %v", current.UID, err)
+		}
+		neighbors = append(neighbors, blockedIssues...)
+		graph.Nodes = append(graph.Nodes, node)
+
+		for _, neighbor := range neighbors {
+			if visited[neighbor.UID] || len(visited) >= maxIssueDependencyGraphSize {
+				continue
+			}
+			visited[neighbor.UID] = true
+			queue = append(queue, neighbor)
+		}
+	}
+	return graph, nil
+}
+
+// getBlockingIssueUIDs validates the blocking issues of the issue and returns their UIDs.
+// The issueUID is 0 for the issue to create, which cannot be in a dependency cycle.
+func getBlockingIssueUIDs(ctx context.Context, stores *store.Store, issueUID int, names []string) ([]int, error) {
+	var uids []int
+	for _, name := range names {
+		uid, err := common.GetIssueID(name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if uid == issueUID {
+			return nil, status.Errorf(codes.InvalidArgument, "issue cannot block itself")
+		}
+		if slices.Contains(uids, uid) {
+			continue
+		}
+		blockingIssue, err := stores.GetIssueV2(ctx, &store.FindIssueMessage{UID: &uid})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get issue %d, error: %v", uid, err)
+		}
+		if blockingIssue == nil {
+			return nil, status.Errorf(codes.NotFound, "blocking issue %q not found", name)
+		}
+		uids = append(uids, uid)
+	}
+	if issueUID != 0 {
+		cycle, err := isIssueBlockedBy(ctx, stores, uids, issueUID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check dependency cycle, error: %v", err)
+		}
+		if cycle {
+			return nil, status.Errorf(codes.FailedPrecondition, "blocking issues cannot depend on the issue itself")
+		}
+	}
+	return uids, nil
+}
+
+// isIssueBlockedBy returns whether any of the issues is blocked by the target issue directly or transitively.
+func isIssueBlockedBy(ctx context.Context, stores *store.Store, issueUIDs []int, targetUID int) (bool, error) {
+	visited := map[int]bool{}
+	queue := slices.Clone(issueUIDs)
+	for len(queue) > 0 {
+		uid := queue[0]
+		queue = queue[1:]
+		if uid == targetUID {
+			return true, nil
+		}
+		if visited[uid] {
+			continue
+		}
+		visited[uid] = true
+		issue, err := stores.GetIssueV2(ctx, &store.FindIssueMessage{UID: &uid})
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to get issue %d", uid)
+		}
+		if issue == nil {
+			continue
+		}
+		queue = append(queue, issue.BlockingIssueUIDs...)
+	}
+	return false, nil
+}
+
+// convertToIssueBlockedBy returns the blocking issue names of the issue and the reasons why its rollout is blocked.
+func convertToIssueBlockedBy(ctx context.Context, s *store.Store, issue *store.IssueMessage) ([]string, []string, error) {
+	var blockedBy []string
+	for _, blockingIssueUID := range issue.BlockingIssueUIDs {
+		blockingIssue, err := s.GetIssueV2(ctx, &store.FindIssueMessage{UID: &blockingIssueUID})
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get issue %d", blockingIssueUID)
+		}
+		if blockingIssue == nil {
+			continue
+		}
+		blockedBy = append(blockedBy, getIssueName(blockingIssue))
+	}
+	undone, err := utils.GetUndoneBlockingIssues(ctx, s, issue)
+	if err != nil {
+		return nil, nil, err
+	}
+	var reasons []string
+	for _, blockingIssue := range undone {
+		reasons = append(reasons, fmt.Sprintf("Blocked by issue %s, which is %s", getIssueName(blockingIssue), convertToIssueStatus(blockingIssue.Status)))
+	}
+	return blockedBy, reasons, nil
+}
+
+func getIssueName(issue *store.IssueMessage) string {
+	return fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, issue.Project.ResourceID, common.IssuePrefix, issue.UID)
+}
@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func convertToAPIIssueType(t v1pb.Issue_Type) (api.IssueType, error) {
+	switch t {
+	case v1pb.Issue_DATABASE_CHANGE:
+		return api.IssueDatabaseGeneral, nil
+	case v1pb.Issue_GRANT_REQUEST:
+		return api.IssueGrantRequest, nil
+	default:
+		return "", errors.Errorf("unsupported issue type %q", t)
+	}
+}
+
+// findIssueForm returns the issue form of the issue type in the project setting, nil if not found.
+func findIssueForm(setting *storepb.Project, issueType api.IssueType) *storepb.IssueForm {
+	if setting == nil {
+		return nil
+	}
+	for _, form := range setting.IssueForms {
+		if form.IssueType == string(issueType) {
+			return form
+		}
+	}
+	return nil
+}
+
+func validateIssueForm(form *storepb.IssueForm) error {
+	ids := make(map[string]bool)
+	for _, field := range form.Fields {
+		if field.Id == "" {
+			return errors.Errorf("field id must be set")
+		}
+		if ids[field.Id] {
+			return errors.Errorf("duplicate field id %q", field.Id)
+		}
+		ids[field.Id] = true
+		switch field.Type {
+		case storepb.IssueFormField_STRING:
+			if _, err := regexp.Compile(field.Pattern); err != nil {
+				return errors.Wrapf(err, "invalid pattern for field %q", field.Id)
+			}
+		case storepb.IssueFormField_NUMBER, storepb.IssueFormField_BOOLEAN:
+		case storepb.IssueFormField_SELECT:
+			if len(field.Options) == 0 {
+				return errors.Errorf("select field %q must have options", field.Id)
+			}
+		default:
+			return errors.Errorf("unsupported type %q for field %q", field.Type, field.Id)
+		}
+		if field.DefaultValue != "" {
+			if err := validateIssueFormValue(field, field.DefaultValue); err != nil {
+				return errors.Wrapf(err, "invalid default value for field %q", field.Id)
+			}
+		}
+	}
+	return nil
+}
+
+// validateIssueFormData validates the submitted form data against the issue form,
+// and returns the data with default values filled in.
+func validateIssueFormData(form *storepb.IssueForm, data map[string]string) (map[string]string, error) {
+	if form == nil {
+		if len(data) > 0 {
+			return nil, errors.Errorf("form data is not allowed because the project has no issue form for the issue type")
+		}
+		return nil, nil
+	}
+
+	fields := make(map[string]*storepb.IssueFormField)
+	for _, field := range form.Fields {
+		fields[field.Id] = field
+	}
+	for id := range data {
+		if _, ok := fields[id]; !ok {
+			return nil, errors.Errorf("unknown field %q", id)
+		}
+	}
+
+	result := make(map[string]string)
+	for _, field := range form.Fields {
+		value, ok := data[field.Id]
+		if !ok || value == "" {
+			value = field.DefaultValue
+		}
+		if value == "" {
+			if field.Required {
+				return nil, errors.Errorf("field %q is required", field.Id)
+			}
+			continue
+		}
+		if err := validateIssueFormValue(field, value); err != nil {
+			return nil, errors.Wrapf(err, "invalid value for field %q", field.Id)
+		}
+		result[field.Id] = value
+	}
+	return result, nil
+}
+
+func validateIssueFormValue(field *storepb.IssueFormField, value string) error {
+	switch field.Type {
+	case storepb.IssueFormField_STRING:
+		if field.Pattern == "" {
+			return nil
+		}
+		re, err := regexp.Compile("^(?:" + field.Pattern + ")$")
+		if err != nil {
+			return errors.Wrapf(err, "invalid pattern %q", field.Pattern)
+		}
+		if !re.MatchString(value) {
+			return errors.Errorf("value %q does not match pattern %q", value, field.Pattern)
+		}
+	case storepb.IssueFormField_NUMBER:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return errors.Errorf("value %q is not a number", value)
+		}
+	case storepb.IssueFormField_BOOLEAN:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.Errorf("value %q is not a boolean", value)
+		}
+	case storepb.IssueFormField_SELECT:
+		if !slices.Contains(field.Options, value) {
+			return errors.Errorf("value %q is not one of the options %v", value, field.Options)
+		}
+	default:
+		return errors.Errorf("unsupported type %q", field.Type)
+	}
+	return nil
+}
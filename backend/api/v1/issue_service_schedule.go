@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// findIssueSchedule returns the issue schedule of the id in the project setting, nil if not found.
+func findIssueSchedule(setting *storepb.Project, id string) *storepb.IssueSchedule {
+	if setting == nil {
+		return nil
+	}
+	for _, schedule := range setting.IssueSchedules {
+		if schedule.Id == id {
+			return schedule
+		}
+	}
+	return nil
+}
+
+// validateIssueSchedule validates the issue schedule against the issue templates of the project.
+func validateIssueSchedule(setting *storepb.Project, schedule *storepb.IssueSchedule) error {
+	if schedule.Title == "" {
+		return errors.Errorf("title must be set")
+	}
+	template := findIssueTemplate(setting, schedule.Template)
+	if template == nil {
+		return errors.Errorf("issue template %q not found", schedule.Template)
+	}
+	// The issues created by the schedule have no plan given, so the template must generate one.
+	if template.Statement == "" {
+		return errors.Errorf("issue template %q has no statement", schedule.Template)
+	}
+	if _, err := resolveIssueTemplateVariables(template, schedule.TemplateVariables, time.Now()); err != nil {
+		return errors.Wrapf(err, "invalid template variables")
+	}
+	return nil
+}
+
+func convertToIssueSchedule(ctx context.Context, s *store.Store, projectID string, schedule *storepb.IssueSchedule) (*v1pb.IssueSchedule, error) {
+	v1Schedule := &v1pb.IssueSchedule{
+		Name:              fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, projectID, common.IssueSchedulePrefix, schedule.Id),
+		Title:             schedule.Title,
+		Template:          fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, projectID, common.IssueTemplatePrefix, schedule.Template),
+		TemplateVariables: schedule.TemplateVariables,
+		Schedule:          schedule.Schedule,
+		Disabled:          schedule.Disabled,
+		LastError:         schedule.LastError,
+	}
+	creator, err := s.GetUserByID(ctx, int(schedule.CreatorId))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get creator, error: %v", err)
+	}
+	if creator != nil {
+		v1Schedule.Creator = common.FormatUserEmail(creator.Email)
+	}
+	if !schedule.Disabled && schedule.NextRunTs > 0 {
+		v1Schedule.NextRunTime = timestamppb.New(time.Unix(schedule.NextRunTs, 0))
+	}
+	if schedule.LastIssueId > 0 {
+		v1Schedule.LastIssue = fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, projectID, common.IssuePrefix, schedule.LastIssueId)
+	}
+	return v1Schedule, nil
+}
@@ -0,0 +1,243 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/type/expr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+var (
+	issueTemplateVariableNameRegexp      = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	issueTemplateVariableReferenceRegexp = regexp.MustCompile(`\{\{\s*([a-zA-Z_][a-zA-Z0-9_]*)\s*\}\}`)
+)
+
+// The built-in variables are resolved to the UTC date of the issue creation,
+// e.g. to name the partitions in the issues created by the issue schedules.
+const (
+	issueTemplateVariableRunDate        = "run_date"
+	issueTemplateVariableRunDateCompact = "run_date_compact"
+)
+
+// findIssueTemplate returns the issue template of the id in the project setting, nil if not found.
+func findIssueTemplate(setting *storepb.Project, id string) *storepb.IssueTemplate {
+	if setting == nil {
+		return nil
+	}
+	for _, template := range setting.IssueTemplates {
+		if template.Id == id {
+			return template
+		}
+	}
+	return nil
+}
+
+func validateIssueTemplate(template *storepb.IssueTemplate) error {
+	if template.TitlePattern == "" {
+		return errors.Errorf("title pattern must be set")
+	}
+	names := map[string]bool{
+		issueTemplateVariableRunDate:        true,
+		issueTemplateVariableRunDateCompact: true,
+	}
+	for _, variable := range template.Variables {
+		if !issueTemplateVariableNameRegexp.MatchString(variable.Name) {
+			return errors.Errorf("invalid variable name %q", variable.Name)
+		}
+		if variable.Name == issueTemplateVariableRunDate || variable.Name == issueTemplateVariableRunDateCompact {
+			return errors.Errorf("variable name %q is reserved for the built-in variable", variable.Name)
+		}
+		if names[variable.Name] {
+			return errors.Errorf("duplicate variable name %q", variable.Name)
+		}
+		names[variable.Name] = true
+	}
+	for _, pattern := range []string{template.TitlePattern, template.Statement} {
+		for _, match := range issueTemplateVariableReferenceRegexp.FindAllStringSubmatch(pattern, -1) {
+			if !names[match[1]] {
+				return errors.Errorf("undefined variable %q", match[1])
+			}
+		}
+	}
+	if template.Assignee != "" {
+		if _, err := common.GetUserEmail(template.Assignee); err != nil {
+			return errors.Wrapf(err, "invalid assignee %q", template.Assignee)
+		}
+	}
+	if template.Statement != "" && template.DatabaseExpression == "" {
+		return errors.Errorf("database expression must be set for the statement")
+	}
+	if template.DatabaseExpression != "" {
+		if _, err := common.ValidateGroupCELExpr(template.DatabaseExpression); err != nil {
+			return errors.Wrapf(err, "invalid database expression")
+		}
+	}
+	return nil
+}
+
+// resolveIssueTemplateVariables validates the submitted variable values against the issue template,
+// and returns the values with default values and built-in variables filled in.
+func resolveIssueTemplateVariables(template *storepb.IssueTemplate, values map[string]string, now time.Time) (map[string]string, error) {
+	variables := make(map[string]*storepb.IssueTemplateVariable)
+	for _, variable := range template.Variables {
+		variables[variable.Name] = variable
+	}
+	for name := range values {
+		if _, ok := variables[name]; !ok {
+			return nil, errors.Errorf("unknown variable %q", name)
+		}
+	}
+
+	resolved := map[string]string{
+		issueTemplateVariableRunDate:        now.UTC().Format("2006-01-02"),
+		issueTemplateVariableRunDateCompact: now.UTC().Format("20060102"),
+	}
+	for _, variable := range template.Variables {
+		value, ok := values[variable.Name]
+		if !ok || value == "" {
+			value = variable.DefaultValue
+		}
+		if value == "" && variable.Required {
+			return nil, errors.Errorf("variable %q is required", variable.Name)
+		}
+		resolved[variable.Name] = value
+	}
+	return resolved, nil
+}
+
+// renderIssueTemplate replaces the {{variable}} references in the pattern with the variable values.
+func renderIssueTemplate(pattern string, values map[string]string) string {
+	return issueTemplateVariableReferenceRegexp.ReplaceAllStringFunc(pattern, func(reference string) string {
+		name := issueTemplateVariableReferenceRegexp.FindStringSubmatch(reference)[1]
+		return values[name]
+	})
+}
+
+// createIssueTemplatePlan creates the plan changing the databases selected by the issue template with the statement.
+func (s *IssueService) createIssueTemplatePlan(ctx context.Context, project *store.ProjectMessage, template *storepb.IssueTemplate, title, statement string, creatorID int) (*store.PlanMessage, error) {
+	if template.DatabaseExpression == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "plan is required because issue template %q selects no databases", template.Id)
+	}
+	allDatabases, err := s.store.ListDatabases(ctx, &store.FindDatabaseMessage{ProjectID: &project.ResourceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list databases for project %q, error: %v", project.ResourceID, err)
+	}
+	databases, _, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, s.store, &store.DatabaseGroupMessage{
+		Expression: &expr.Expr{Expression: template.DatabaseExpression},
+	}, allDatabases)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get databases selected by issue template %q, error: %v", template.Id, err)
+	}
+	if len(databases) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "no database is selected by issue template %q", template.Id)
+	}
+
+	sheet, err := s.store.CreateSheet(ctx, &store.SheetMessage{
+		CreatorID:  creatorID,
+		ProjectUID: project.UID,
+		Title:      title,
+		Statement:  statement,
+		Visibility: store.ProjectSheet,
+		Source:     store.SheetFromBytebaseArtifact,
+		Type:       store.SheetForSQL,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create sheet, error: %v", err)
+	}
+	changeType := storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE
+	if template.ChangeType == storepb.IssueTemplate_DML {
+		changeType = storepb.PlanConfig_ChangeDatabaseConfig_DATA
+	}
+	step := &storepb.PlanConfig_Step{}
+	for _, database := range databases {
+		step.Specs = append(step.Specs, &storepb.PlanConfig_Spec{
+			Id: uuid.NewString(),
+			Config: &storepb.PlanConfig_Spec_ChangeDatabaseConfig{
+				ChangeDatabaseConfig: &storepb.PlanConfig_ChangeDatabaseConfig{
+					Target: common.FormatDatabase(database.InstanceID, database.DatabaseName),
+					Sheet:  getResourceNameForSheet(project, sheet.UID),
+					Type:   changeType,
+				},
+			},
+		})
+	}
+
+	plan, err := s.store.CreatePlan(ctx, &store.PlanMessage{
+		ProjectID: project.ResourceID,
+		Name:      title,
+		Config: &storepb.PlanConfig{
+			Steps: []*storepb.PlanConfig_Step{step},
+		},
+	}, creatorID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create plan, error: %v", err)
+	}
+	planCheckRuns, err := getPlanCheckRunsFromPlan(ctx, s.store, plan)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get plan check runs for plan, error: %v", err)
+	}
+	if err := s.store.CreatePlanCheckRuns(ctx, planCheckRuns...); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create plan check runs, error: %v", err)
+	}
+	// Tickle plan check scheduler.
+	s.stateCfg.TicklePlanCheck()
+	return plan, nil
+}
+
+func convertToIssueTemplate(projectID string, template *storepb.IssueTemplate) *v1pb.IssueTemplate {
+	v1Template := &v1pb.IssueTemplate{
+		Name:         fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, projectID, common.IssueTemplatePrefix, template.Id),
+		Title:        template.Title,
+		TitlePattern: template.TitlePattern,
+		Statement:    template.Statement,
+		Assignee:     template.Assignee,
+		Labels:       template.Labels,
+		ChangeType:   v1pb.IssueTemplate_ChangeType(template.ChangeType),
+	}
+	if template.DatabaseExpression != "" {
+		v1Template.DatabaseExpr = &expr.Expr{Expression: template.DatabaseExpression}
+	}
+	for _, variable := range template.Variables {
+		v1Template.Variables = append(v1Template.Variables, &v1pb.IssueTemplate_Variable{
+			Name:         variable.Name,
+			Description:  variable.Description,
+			Required:     variable.Required,
+			DefaultValue: variable.DefaultValue,
+		})
+	}
+	return v1Template
+}
+
+func convertToStoreIssueTemplate(id string, template *v1pb.IssueTemplate) *storepb.IssueTemplate {
+	storeTemplate := &storepb.IssueTemplate{
+		Id:                 id,
+		Title:              template.Title,
+		TitlePattern:       template.TitlePattern,
+		Statement:          template.Statement,
+		Assignee:           template.Assignee,
+		Labels:             template.Labels,
+		DatabaseExpression: template.DatabaseExpr.GetExpression(),
+		ChangeType:         storepb.IssueTemplate_ChangeType(template.ChangeType),
+	}
+	for _, variable := range template.Variables {
+		storeTemplate.Variables = append(storeTemplate.Variables, &storepb.IssueTemplateVariable{
+			Name:         variable.Name,
+			Description:  variable.Description,
+			Required:     variable.Required,
+			DefaultValue: variable.DefaultValue,
+		})
+	}
+	return storeTemplate
+}
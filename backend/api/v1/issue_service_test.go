@@ -2,6 +2,7 @@ package v1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -188,3 +189,91 @@ func TestCanUserApproveStep(t *testing.T) {
 		a.Equal(test.want, got)
 	}
 }
+
+func TestValidateIssueFormData(t *testing.T) {
+	form := &storepb.IssueForm{
+		IssueType: string(api.IssueDatabaseGeneral),
+		Fields: []*storepb.IssueFormField{
+			{Id: "ticket", Type: storepb.IssueFormField_STRING, Required: true, Pattern: `JIRA-\d+`},
+			{Id: "downtime", Type: storepb.IssueFormField_BOOLEAN, DefaultValue: "false"},
+			{Id: "rows", Type: storepb.IssueFormField_NUMBER},
+			{Id: "level", Type: storepb.IssueFormField_SELECT, Options: []string{"low", "high"}},
+		},
+	}
+
+	tests := []struct {
+		data    map[string]string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			data: map[string]string{"ticket": "JIRA-12", "rows": "1.5", "level": "high"},
+			want: map[string]string{"ticket": "JIRA-12", "downtime": "false", "rows": "1.5", "level": "high"},
+		},
+		{
+			data:    map[string]string{"downtime": "true"},
+			wantErr: true,
+		},
+		{
+			data:    map[string]string{"ticket": "JIRA-12x"},
+			wantErr: true,
+		},
+		{
+			data:    map[string]string{"ticket": "JIRA-12", "rows": "many"},
+			wantErr: true,
+		},
+		{
+			data:    map[string]string{"ticket": "JIRA-12", "level": "medium"},
+			wantErr: true,
+		},
+		{
+			data:    map[string]string{"ticket": "JIRA-12", "unknown": "1"},
+			wantErr: true,
+		},
+	}
+
+	a := require.New(t)
+	a.NoError(validateIssueForm(form))
+	for _, test := range tests {
+		got, err := validateIssueFormData(form, test.data)
+		if test.wantErr {
+			a.Error(err)
+			continue
+		}
+		a.NoError(err)
+		a.Equal(test.want, got)
+	}
+}
+
+func TestRenderIssueTemplate(t *testing.T) {
+	template := &storepb.IssueTemplate{
+		Id:           "backfill",
+		TitlePattern: "Backfill {{table}}",
+		Statement:    "UPDATE {{ table }} SET status = '{{status}}';",
+		Variables: []*storepb.IssueTemplateVariable{
+			{Name: "table", Required: true},
+			{Name: "status", DefaultValue: "active"},
+		},
+		DatabaseExpression: `resource.database_name.startsWith("shard_")`,
+	}
+
+	a := require.New(t)
+	a.NoError(validateIssueTemplate(template))
+
+	now := time.Date(2026, 10, 15, 8, 0, 0, 0, time.UTC)
+	values, err := resolveIssueTemplateVariables(template, map[string]string{"table": "users"}, now)
+	a.NoError(err)
+	a.Equal(map[string]string{"table": "users", "status": "active", "run_date": "2026-10-15", "run_date_compact": "20261015"}, values)
+	a.Equal("Backfill users", renderIssueTemplate(template.TitlePattern, values))
+	a.Equal("UPDATE users SET status = 'active';", renderIssueTemplate(template.Statement, values))
+
+	_, err = resolveIssueTemplateVariables(template, map[string]string{"status": "inactive"}, now)
+	a.Error(err)
+	_, err = resolveIssueTemplateVariables(template, map[string]string{"table": "users", "unknown": "1"}, now)
+	a.Error(err)
+
+	a.Error(validateIssueTemplate(&storepb.IssueTemplate{TitlePattern: "Backfill {{table}}"}))
+	a.Error(validateIssueTemplate(&storepb.IssueTemplate{TitlePattern: "Backfill", Statement: "DELETE FROM t;"}))
+	a.Error(validateIssueTemplate(&storepb.IssueTemplate{TitlePattern: "Backfill", Variables: []*storepb.IssueTemplateVariable{{Name: "run_date"}}}))
+	a.NoError(validateIssueTemplate(&storepb.IssueTemplate{TitlePattern: "Add partition p{{run_date_compact}}"}))
+}
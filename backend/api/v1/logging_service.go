@@ -38,10 +38,14 @@ var defaultResourceActionTypeMap = map[string][]api.ActivityType{
 		api.ActivityMemberRoleUpdate,
 		api.ActivityMemberActivate,
 		api.ActivityMemberDeactivate,
+		api.ActivityMemberBatchOperation,
+		api.ActivityMemberAPITokenCreate,
+		api.ActivityMemberAPITokenRevoke,
 	},
 	"instances": {
 		api.ActivitySQLEditorQuery,
 		api.ActivitySQLExport,
+		api.ActivitySQLQueryAudit,
 	},
 	"projects": {
 		api.ActivityProjectRepositoryPush,
@@ -58,6 +62,8 @@ var defaultResourceActionTypeMap = map[string][]api.ActivityType{
 		api.ActivityPipelineTaskStatementUpdate,
 		api.ActivityPipelineTaskEarliestAllowedTimeUpdate,
 		api.ActivityPipelineTaskPriorBackup,
+		api.ActivityPipelineStageApprove,
+		api.ActivityPipelineStageCanaryUpdate,
 	},
 	"issues": {
 		api.ActivityIssueCreate,
@@ -93,8 +99,12 @@ func (s *LoggingService) ListLogs(ctx context.Context, request *v1pb.ListLogsReq
 	offset := int(pageToken.Offset)
 
 	activityFind := &store.FindActivityMessage{
-		Limit:  &limitPlusOne,
-		Offset: &offset,
+		Limit: &limitPlusOne,
+	}
+	if after := getKeysetCursor(&pageToken); after != nil {
+		activityFind.After = after
+	} else {
+		activityFind.Offset = &offset
 	}
 
 	if err := setActivityFindFilterAndOrder(ctx, s.store, activityFind, request.Filter, request.OrderBy); err != nil {
@@ -109,10 +119,8 @@ func (s *LoggingService) ListLogs(ctx context.Context, request *v1pb.ListLogsReq
 	nextPageToken := ""
 	if len(activityList) == limitPlusOne {
 		activityList = activityList[:limit]
-		if nextPageToken, err = marshalPageToken(&storepb.PageToken{
-			Limit:  int32(limit),
-			Offset: int32(limit + offset),
-		}); err != nil {
+		last := activityList[limit-1]
+		if nextPageToken, err = getKeysetPageToken(limit, last.CreatedTs, last.UID); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to marshal next page token, error: %v", err)
 		}
 	}
@@ -364,7 +372,10 @@ func convertToLogEntity(ctx context.Context, db *store.Store, activity *store.Ac
 		api.ActivityMemberCreate,
 		api.ActivityMemberRoleUpdate,
 		api.ActivityMemberActivate,
-		api.ActivityMemberDeactivate:
+		api.ActivityMemberDeactivate,
+		api.ActivityMemberBatchOperation,
+		api.ActivityMemberAPITokenCreate,
+		api.ActivityMemberAPITokenRevoke:
 		user, err := db.GetUserByID(ctx, activity.ContainerUID)
 		if err != nil {
 			return nil, err
@@ -387,7 +398,9 @@ func convertToLogEntity(ctx context.Context, db *store.Store, activity *store.Ac
 		api.ActivityPipelineTaskFileCommit,
 		api.ActivityPipelineTaskStatementUpdate,
 		api.ActivityPipelineTaskEarliestAllowedTimeUpdate,
-		api.ActivityPipelineTaskPriorBackup:
+		api.ActivityPipelineTaskPriorBackup,
+		api.ActivityPipelineStageApprove,
+		api.ActivityPipelineStageCanaryUpdate:
 		resource = fmt.Sprintf("%s%d", common.PipelineNamePrefix, activity.ContainerUID)
 	case
 		api.ActivityProjectRepositoryPush,
@@ -407,7 +420,8 @@ func convertToLogEntity(ctx context.Context, db *store.Store, activity *store.Ac
 		resource = fmt.Sprintf("%s%s", common.ProjectNamePrefix, project.ResourceID)
 	case
 		api.ActivitySQLEditorQuery,
-		api.ActivitySQLExport:
+		api.ActivitySQLExport,
+		api.ActivitySQLQueryAudit:
 		instance, err := db.GetInstanceV2(ctx, &store.FindInstanceMessage{
 			UID: &activity.ContainerUID,
 		})
@@ -451,6 +465,12 @@ func convertToActivityType(action v1pb.LogEntity_Action) (api.ActivityType, erro
 		return api.ActivityMemberActivate, nil
 	case v1pb.LogEntity_ACTION_MEMBER_DEACTIVE:
 		return api.ActivityMemberDeactivate, nil
+	case v1pb.LogEntity_ACTION_MEMBER_BATCH_OPERATION:
+		return api.ActivityMemberBatchOperation, nil
+	case v1pb.LogEntity_ACTION_MEMBER_API_TOKEN_CREATE:
+		return api.ActivityMemberAPITokenCreate, nil
+	case v1pb.LogEntity_ACTION_MEMBER_API_TOKEN_REVOKE:
+		return api.ActivityMemberAPITokenRevoke, nil
 
 	case v1pb.LogEntity_ACTION_ISSUE_CREATE:
 		return api.ActivityIssueCreate, nil
@@ -477,6 +497,10 @@ func convertToActivityType(action v1pb.LogEntity_Action) (api.ActivityType, erro
 		return api.ActivityPipelineTaskEarliestAllowedTimeUpdate, nil
 	case v1pb.LogEntity_ACTION_PIPELINE_TASK_PRIOR_BACKUP:
 		return api.ActivityPipelineTaskPriorBackup, nil
+	case v1pb.LogEntity_ACTION_PIPELINE_STAGE_APPROVE:
+		return api.ActivityPipelineStageApprove, nil
+	case v1pb.LogEntity_ACTION_PIPELINE_STAGE_CANARY_UPDATE:
+		return api.ActivityPipelineStageCanaryUpdate, nil
 
 	case v1pb.LogEntity_ACTION_PROJECT_REPOSITORY_PUSH:
 		return api.ActivityProjectRepositoryPush, nil
@@ -493,6 +517,8 @@ func convertToActivityType(action v1pb.LogEntity_Action) (api.ActivityType, erro
 		return api.ActivitySQLEditorQuery, nil
 	case v1pb.LogEntity_ACTION_DATABASE_SQL_EXPORT:
 		return api.ActivitySQLExport, nil
+	case v1pb.LogEntity_ACTION_DATABASE_SQL_QUERY_AUDIT:
+		return api.ActivitySQLQueryAudit, nil
 	default:
 		return api.ActivityMemberCreate, status.Errorf(codes.InvalidArgument, "unsupported action type: %v", action)
 	}
@@ -508,6 +534,12 @@ func convertToActionType(activityType api.ActivityType) v1pb.LogEntity_Action {
 		return v1pb.LogEntity_ACTION_MEMBER_ACTIVATE
 	case api.ActivityMemberDeactivate:
 		return v1pb.LogEntity_ACTION_MEMBER_DEACTIVE
+	case api.ActivityMemberBatchOperation:
+		return v1pb.LogEntity_ACTION_MEMBER_BATCH_OPERATION
+	case api.ActivityMemberAPITokenCreate:
+		return v1pb.LogEntity_ACTION_MEMBER_API_TOKEN_CREATE
+	case api.ActivityMemberAPITokenRevoke:
+		return v1pb.LogEntity_ACTION_MEMBER_API_TOKEN_REVOKE
 
 	case api.ActivityIssueCreate:
 		return v1pb.LogEntity_ACTION_ISSUE_CREATE
@@ -534,6 +566,10 @@ func convertToActionType(activityType api.ActivityType) v1pb.LogEntity_Action {
 		return v1pb.LogEntity_ACTION_PIPELINE_TASK_EARLIEST_ALLOWED_TIME_UPDATE
 	case api.ActivityPipelineTaskPriorBackup:
 		return v1pb.LogEntity_ACTION_PIPELINE_TASK_PRIOR_BACKUP
+	case api.ActivityPipelineStageApprove:
+		return v1pb.LogEntity_ACTION_PIPELINE_STAGE_APPROVE
+	case api.ActivityPipelineStageCanaryUpdate:
+		return v1pb.LogEntity_ACTION_PIPELINE_STAGE_CANARY_UPDATE
 
 	case api.ActivityProjectRepositoryPush:
 		return v1pb.LogEntity_ACTION_PROJECT_REPOSITORY_PUSH
@@ -550,6 +586,8 @@ func convertToActionType(activityType api.ActivityType) v1pb.LogEntity_Action {
 		return v1pb.LogEntity_ACTION_DATABASE_SQL_EDITOR_QUERY
 	case api.ActivitySQLExport:
 		return v1pb.LogEntity_ACTION_DATABASE_SQL_EXPORT
+	case api.ActivitySQLQueryAudit:
+		return v1pb.LogEntity_ACTION_DATABASE_SQL_QUERY_AUDIT
 	default:
 		return v1pb.LogEntity_ACTION_UNSPECIFIED
 	}
@@ -0,0 +1,176 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// SearchAuditLogs searches the audit logs.
+func (s *LoggingService) SearchAuditLogs(ctx context.Context, request *v1pb.SearchAuditLogsRequest) (*v1pb.SearchAuditLogsResponse, error) {
+	var pageToken storepb.PageToken
+	if request.PageToken != "" {
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		if pageToken.Limit != request.PageSize {
+			return nil, status.Errorf(codes.InvalidArgument, "request page size does not match the page token")
+		}
+	} else {
+		pageToken.Limit = request.PageSize
+	}
+
+	limit := int(pageToken.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	limitPlusOne := limit + 1
+
+	find := &store.FindAuditLogMessage{
+		Limit: &limitPlusOne,
+		After: getKeysetCursor(&pageToken),
+	}
+	if err := s.setAuditLogFindFilterAndOrder(ctx, find, request.Filter, request.OrderBy); err != nil {
+		return nil, err
+	}
+
+	auditLogs, err := s.store.ListAuditLogs(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list audit logs: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(auditLogs) == limitPlusOne {
+		auditLogs = auditLogs[:limit]
+		last := auditLogs[limit-1]
+		if nextPageToken, err = getKeysetPageToken(limit, last.CreatedTs, int(last.UID)); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal next page token, error: %v", err)
+		}
+	}
+
+	resp := &v1pb.SearchAuditLogsResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, auditLog := range auditLogs {
+		v1AuditLog, err := s.convertToAuditLog(ctx, auditLog)
+		if err != nil {
+			return nil, err
+		}
+		resp.AuditLogs = append(resp.AuditLogs, v1AuditLog)
+	}
+	return resp, nil
+}
+
+func (s *LoggingService) setAuditLogFindFilterAndOrder(ctx context.Context, find *store.FindAuditLogMessage, filter, orderBy string) error {
+	if orderBy != "" {
+		orderByKeys, err := parseOrderBy(orderBy)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if len(orderByKeys) != 1 || orderByKeys[0].key != "create_time" {
+			return status.Errorf(codes.InvalidArgument, `invalid order_by, only support order by "create_time" for now`)
+		}
+		order := api.DESC
+		if orderByKeys[0].isAscend {
+			order = api.ASC
+		}
+		find.Order = &order
+	}
+
+	filters, err := parseFilter(filter)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	for _, spec := range filters {
+		switch spec.key {
+		case "user":
+			if spec.operator != comparatorTypeEqual {
+				return status.Errorf(codes.InvalidArgument, `only support "=" operation for "user" filter`)
+			}
+			email := strings.TrimPrefix(spec.value, common.UserNamePrefix)
+			if email == "" {
+				return status.Errorf(codes.InvalidArgument, "invalid empty user identifier")
+			}
+			user, err := s.store.GetUser(ctx, &store.FindUserMessage{
+				Email:       &email,
+				ShowDeleted: true,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, `failed to find user "%s" with error: %v`, email, err.Error())
+			}
+			if user == nil {
+				return status.Errorf(codes.NotFound, "user %q not found", spec.value)
+			}
+			find.CreatorUID = &user.ID
+		case "method", "resource", "status":
+			if spec.operator != comparatorTypeEqual {
+				return status.Errorf(codes.InvalidArgument, `only support "=" operation for "%s" filter`, spec.key)
+			}
+			value := spec.value
+			switch spec.key {
+			case "method":
+				find.Method = &value
+			case "resource":
+				find.Resource = &value
+			case "status":
+				find.Status = &value
+			}
+		case "create_time":
+			if spec.operator != comparatorTypeGreaterEqual && spec.operator != comparatorTypeLessEqual {
+				return status.Errorf(codes.InvalidArgument, `only support "<=" or ">=" operation for "create_time" filter`)
+			}
+			t, err := time.Parse(time.RFC3339, spec.value)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid create_time filter %q", spec.value)
+			}
+			ts := t.Unix()
+			if spec.operator == comparatorTypeGreaterEqual {
+				find.CreatedTsAfter = &ts
+			} else {
+				find.CreatedTsBefore = &ts
+			}
+		default:
+			return status.Errorf(codes.InvalidArgument, "invalid filter %s", spec.key)
+		}
+	}
+	return nil
+}
+
+func (s *LoggingService) convertToAuditLog(ctx context.Context, auditLog *store.AuditLogMessage) (*v1pb.AuditLog, error) {
+	v1AuditLog := &v1pb.AuditLog{
+		Name:       fmt.Sprintf("%s%d", common.AuditLogNamePrefix, auditLog.UID),
+		CreateTime: timestamppb.New(time.Unix(auditLog.CreatedTs, 0)),
+		Method:     auditLog.Method,
+		Resource:   auditLog.Resource,
+		Request:    auditLog.Payload.Request,
+		Status:     auditLog.Payload.Status,
+		Error:      auditLog.Payload.Error,
+		IpAddress:  auditLog.Payload.IpAddress,
+		UserAgent:  auditLog.Payload.UserAgent,
+		Latency:    auditLog.Payload.Latency,
+	}
+	if auditLog.CreatorUID != 0 {
+		user, err := s.store.GetUserByID(ctx, auditLog.CreatorUID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+		}
+		if user != nil {
+			v1AuditLog.User = common.FormatUserEmail(user.Email)
+		}
+	}
+	return v1AuditLog, nil
+}
@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// maintenanceRejectedMethods are the methods rejected in the maintenance mode by the interceptor, i.e. the SQL editor writes.
+// The rollouts are rejected in the rollout service, as they are also created by the GitOps webhooks and the issue schedules
+// without going through the interceptor. The read methods are still served.
+var maintenanceRejectedMethods = map[string]bool{
+	v1pb.SQLService_AdminExecute_FullMethodName: true,
+}
+
+// MaintenanceInterceptor is the v1 maintenance mode interceptor for gRPC server.
+type MaintenanceInterceptor struct {
+	store *store.Store
+}
+
+// NewMaintenanceInterceptor returns a new v1 API maintenance mode interceptor.
+func NewMaintenanceInterceptor(store *store.Store) *MaintenanceInterceptor {
+	return &MaintenanceInterceptor{
+		store: store,
+	}
+}
+
+// MaintenanceInterceptor is the unary interceptor for gRPC API.
+func (in *MaintenanceInterceptor) MaintenanceInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := in.maintenanceInterceptorDo(ctx, serverInfo.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, request)
+}
+
+// MaintenanceStreamInterceptor is the stream interceptor for gRPC API.
+func (in *MaintenanceInterceptor) MaintenanceStreamInterceptor(request any, ss grpc.ServerStream, serverInfo *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := in.maintenanceInterceptorDo(ss.Context(), serverInfo.FullMethod); err != nil {
+		return err
+	}
+	return handler(request, ss)
+}
+
+func (in *MaintenanceInterceptor) maintenanceInterceptorDo(ctx context.Context, fullMethod string) error {
+	if !maintenanceRejectedMethods[fullMethod] {
+		return nil
+	}
+	return checkMaintenance(ctx, in.store)
+}
+
+// checkMaintenance returns the Unavailable error if Bytebase is under maintenance.
+func checkMaintenance(ctx context.Context, stores *store.Store) error {
+	setting, err := stores.GetMaintenanceSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get maintenance setting with error: %v", err)
+	}
+	if !setting.Enabled {
+		return nil
+	}
+	if setting.Message != "" {
+		return status.Errorf(codes.Unavailable, "Bytebase is under maintenance: %s", setting.Message)
+	}
+	return status.Errorf(codes.Unavailable, "Bytebase is under maintenance, the rollouts and the SQL editor writes are rejected until the maintenance ends")
+}
@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/config"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	dbdriver "github.com/bytebase/bytebase/backend/plugin/db"
+	_ "github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/resources/postgres"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const maintenanceTestPgPort = 6012
+
+func TestMaintenanceRejectsDirectServiceCalls(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	pgBinDir, err := postgres.Install(path.Join(t.TempDir(), "resource"))
+	a.NoError(err)
+	stopInstance := postgres.SetupTestInstance(pgBinDir, t.TempDir(), maintenanceTestPgPort)
+	defer stopInstance()
+	db := store.NewDB(dbdriver.ConnectionConfig{
+		Username: postgres.TestPgUser,
+		Host:     common.GetPostgresSocketDir(),
+		Port:     fmt.Sprintf("%d", maintenanceTestPgPort),
+		Database: "hidb",
+	}, pgBinDir, false, common.ReleaseModeDev)
+	a.NoError(db.Open(ctx, true /* createDB */))
+	defer db.Close(ctx)
+	// The columns of the setting table in the metadata schema read and written by the store.
+	_, err = db.ExecContext(ctx, `
+		CREATE TABLE setting (
+			id SERIAL PRIMARY KEY,
+			creator_id INTEGER NOT NULL,
+			created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+			updater_id INTEGER NOT NULL,
+			updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+			name TEXT NOT NULL UNIQUE,
+			value TEXT NOT NULL,
+			description TEXT NOT NULL DEFAULT ''
+		)`)
+	a.NoError(err)
+	stores, err := store.New(db, &config.Profile{})
+	a.NoError(err)
+	value, err := protojson.Marshal(&storepb.MaintenanceSetting{Enabled: true, Message: "upgrading"})
+	a.NoError(err)
+	_, err = stores.UpsertSettingV2(ctx, &store.SetSettingMessage{Name: api.SettingMaintenance, Value: string(value)}, api.SystemBotID)
+	a.NoError(err)
+
+	// The rollouts created by the GitOps webhooks and the issue schedules call the service without the interceptor.
+	s := &RolloutService{store: stores}
+	ctx = context.WithValue(ctx, common.PrincipalIDContextKey, api.SystemBotID)
+	_, err = s.CreateRollout(ctx, &v1pb.CreateRolloutRequest{Parent: "projects/p1", Plan: "projects/p1/plans/1"})
+	a.Equal(codes.Unavailable, status.Code(err))
+	a.Contains(err.Error(), "upgrading")
+	_, err = s.BatchRunTasks(ctx, &v1pb.BatchRunTasksRequest{Parent: "projects/p1/rollouts/1/stages/1", Tasks: []string{"projects/p1/rollouts/1/stages/1/tasks/1"}})
+	a.Equal(codes.Unavailable, status.Code(err))
+	_, err = s.ApproveStage(ctx, &v1pb.ApproveStageRequest{Name: "projects/p1/rollouts/1/stages/1"})
+	a.Equal(codes.Unavailable, status.Code(err))
+	_, err = s.CutoverTask(ctx, &v1pb.CutoverTaskRequest{Name: "projects/p1/rollouts/1/stages/1/tasks/1"})
+	a.Equal(codes.Unavailable, status.Code(err))
+
+	// The SQL editor writes are rejected by the interceptor, and the reads are served.
+	in := NewMaintenanceInterceptor(stores)
+	a.Equal(codes.Unavailable, status.Code(in.maintenanceInterceptorDo(ctx, v1pb.SQLService_AdminExecute_FullMethodName)))
+	a.NoError(in.maintenanceInterceptorDo(ctx, v1pb.RolloutService_GetRollout_FullMethodName))
+
+	// The rollouts are served once the maintenance ends.
+	value, err = protojson.Marshal(&storepb.MaintenanceSetting{})
+	a.NoError(err)
+	_, err = stores.UpsertSettingV2(ctx, &store.SetSettingMessage{Name: api.SettingMaintenance, Value: string(value)}, api.SystemBotID)
+	a.NoError(err)
+	a.NoError(checkMaintenance(ctx, stores))
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/bytebase/bytebase/backend/component/maskingpolicy"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
@@ -16,6 +17,8 @@ type maskingLevelEvaluator struct {
 	dataClassificationIDMap map[string]*storepb.DataClassificationSetting_DataClassificationConfig
 	semanticTypesMap        map[string]*storepb.SemanticTypeSetting_SemanticType
 	maskingAlgorithms       map[string]*storepb.MaskingAlgorithmSetting_Algorithm
+	// maskingSalt is the per-workspace salt of the deterministic masking algorithms.
+	maskingSalt string
 }
 
 func newEmptyMaskingLevelEvaluator() *maskingLevelEvaluator {
@@ -70,25 +73,26 @@ func (m *maskingLevelEvaluator) withMaskingAlgorithmSetting(maskingAlgorithmSett
 	return m
 }
 
+func (m *maskingLevelEvaluator) withMaskingSalt(maskingSalt string) *maskingLevelEvaluator {
+	m.maskingSalt = maskingSalt
+	return m
+}
+
 func (m *maskingLevelEvaluator) getDataClassificationConfig(classificationID string) *storepb.DataClassificationSetting_DataClassificationConfig {
 	return m.dataClassificationIDMap[classificationID]
 }
 
 // nolint
-func (m *maskingLevelEvaluator) evaluateMaskingAlgorithmOfColumn(databaseMessage *store.DatabaseMessage, schemaName, tableName, columnName, columnSemanticTypeID, columnClassification string, databaseProjectDataClassificationID string, maskingPolicyMap map[maskingPolicyKey]*storepb.MaskData, filteredMaskingExceptions []*storepb.MaskingExceptionPolicy_MaskingException) (*storepb.MaskingAlgorithmSetting_Algorithm, storepb.MaskingLevel, error) {
-	maskingLevel, err := m.evaluateMaskingLevelOfColumn(databaseMessage, schemaName, tableName, columnName, columnClassification, databaseProjectDataClassificationID, maskingPolicyMap, filteredMaskingExceptions)
+func (m *maskingLevelEvaluator) evaluateMaskingAlgorithmOfColumn(databaseMessage *store.DatabaseMessage, schemaName, tableName, columnName, columnSemanticTypeID, columnClassification string, databaseProjectDataClassificationID string, maskingPolicyResolver *maskingpolicy.Resolver, filteredMaskingExceptions []*storepb.MaskingExceptionPolicy_MaskingException) (*storepb.MaskingAlgorithmSetting_Algorithm, storepb.MaskingLevel, error) {
+	maskingLevel, err := m.evaluateMaskingLevelOfColumn(databaseMessage, schemaName, tableName, columnName, columnClassification, databaseProjectDataClassificationID, maskingPolicyResolver, filteredMaskingExceptions)
 	if err != nil {
 		return nil, storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED, errors.Wrapf(err, "failed to evaluate masking level of column")
 	}
 	if maskingLevel == storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED || maskingLevel == storepb.MaskingLevel_NONE {
 		return nil, maskingLevel, nil
 	}
-	key := maskingPolicyKey{
-		schema: schemaName,
-		table:  tableName,
-		column: columnName,
-	}
-	if maskingData, ok := maskingPolicyMap[key]; ok {
+	maskingData := maskingPolicyResolver.Resolve(schemaName, tableName, columnName)
+	if maskingData != nil {
 		algorithmID := ""
 		switch maskingLevel {
 		case storepb.MaskingLevel_PARTIAL:
@@ -110,6 +114,10 @@ func (m *maskingLevelEvaluator) evaluateMaskingAlgorithmOfColumn(databaseMessage
 			// If we cannot find the algorithm, we just log the warning and treat it is as none masking.
 			return nil, storepb.MaskingLevel_NONE, nil
 		}
+		// The semantic type configured on the column takes precedence over the one of the masking policy.
+		if columnSemanticTypeID == "" {
+			columnSemanticTypeID = maskingData.SemanticTypeId
+		}
 	}
 
 	if columnSemanticTypeID == "" {
@@ -164,48 +172,17 @@ func (m *maskingLevelEvaluator) evaluateMaskingAlgorithmOfColumn(databaseMessage
 //
 // - databaseProjectDataClassificationID: the data classification id of the project the database belongs to.
 //
-// - maskingPolicyMap: the map of maskingPolicy for the database column belongs to.
+// - maskingPolicyResolver: the resolver of the masking policies for the database column belongs to.
 //
 // - filteredMaskingExceptions: the exceptions should apply for current principal.
-func (m *maskingLevelEvaluator) evaluateMaskingLevelOfColumn(databaseMessage *store.DatabaseMessage, schemaName, tableName, columnName, columnClassification string, databaseProjectDataClassificationID string, maskingPolicyMap map[maskingPolicyKey]*storepb.MaskData, filteredMaskingExceptions []*storepb.MaskingExceptionPolicy_MaskingException) (storepb.MaskingLevel, error) {
-	finalLevel := storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED
-
-	key := maskingPolicyKey{
-		schema: schemaName,
-		table:  tableName,
-		column: columnName,
-	}
-	maskingData, ok := maskingPolicyMap[key]
-	if (!ok) || (maskingData.MaskingLevel == storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED) {
-		dataClassificationConfig := m.getDataClassificationConfig(databaseProjectDataClassificationID)
-		// If the column has DEFAULT masking level in maskingPolicy or not set yet,
-		// we will eval the maskingRulePolicy to get the maskingLevel.
-		columnClassificationLevel := getClassificationLevelOfColumn(columnClassification, dataClassificationConfig)
-		for _, maskingRule := range m.maskingRules {
-			maskingRuleAttributes := map[string]any{
-				"environment_id":       databaseMessage.EffectiveEnvironmentID,
-				"project_id":           databaseMessage.ProjectID,
-				"instance_id":          databaseMessage.InstanceID,
-				"database_name":        databaseMessage.DatabaseName,
-				"schema_name":          schemaName,
-				"table_name":           tableName,
-				"column_name":          columnName,
-				"classification_level": columnClassificationLevel,
-			}
-			pass, err := evaluateMaskingRulePolicyCondition(maskingRule.Condition.Expression, maskingRuleAttributes)
-			if err != nil {
-				return storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED, errors.Wrapf(err, "failed to evaluate masking rule policy condition")
-			}
-			if pass {
-				finalLevel = maskingRule.MaskingLevel
-				break
-			}
-		}
-	} else {
-		finalLevel = maskingData.MaskingLevel
+func (m *maskingLevelEvaluator) evaluateMaskingLevelOfColumn(databaseMessage *store.DatabaseMessage, schemaName, tableName, columnName, columnClassification string, databaseProjectDataClassificationID string, maskingPolicyResolver *maskingpolicy.Resolver, filteredMaskingExceptions []*storepb.MaskingExceptionPolicy_MaskingException) (storepb.MaskingLevel, error) {
+	columnClassificationLevel := maskingpolicy.GetClassificationLevel(columnClassification, m.getDataClassificationConfig(databaseProjectDataClassificationID))
+	finalLevel, err := maskingpolicy.EvaluateLevel(databaseMessage, schemaName, tableName, columnName, columnClassificationLevel, maskingPolicyResolver, m.maskingRules)
+	if err != nil {
+		return storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED, err
 	}
 
-	if finalLevel == storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED || finalLevel == storepb.MaskingLevel_NONE {
+	if finalLevel == storepb.MaskingLevel_NONE {
 		return storepb.MaskingLevel_NONE, nil
 	}
 
@@ -240,19 +217,3 @@ func (m *maskingLevelEvaluator) evaluateMaskingLevelOfColumn(databaseMessage *st
 	}
 	return finalLevel, nil
 }
-
-func getClassificationLevelOfColumn(columnClassificationID string, classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig) string {
-	if columnClassificationID == "" || classificationConfig == nil {
-		return ""
-	}
-
-	classification, ok := classificationConfig.Classification[columnClassificationID]
-	if !ok {
-		return ""
-	}
-	if classification.LevelId == nil {
-		return ""
-	}
-
-	return *classification.LevelId
-}
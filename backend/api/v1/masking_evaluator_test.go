@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/genproto/googleapis/type/expr"
 
+	"github.com/bytebase/bytebase/backend/component/maskingpolicy"
 	"github.com/bytebase/bytebase/backend/store"
 
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -55,7 +56,7 @@ func TestEvalMaskingLevelOfColumn(t *testing.T) {
 		tableName                               string
 		columnName                              string
 		columnClassification                    string
-		maskingPolicyMap                        map[maskingPolicyKey]*storepb.MaskData
+		maskingPolicyResolver                   *maskingpolicy.Resolver
 		maskingRulePolicy                       *storepb.MaskingRulePolicy
 		filteredMaskingExceptions               []*storepb.MaskingExceptionPolicy_MaskingException
 		dataClassification                      *storepb.DataClassificationSetting
@@ -63,13 +64,13 @@ func TestEvalMaskingLevelOfColumn(t *testing.T) {
 		want storepb.MaskingLevel
 	}{
 		{
-			description:          "Follow The Global Masking Rule If Column Masking Policy Is Default",
-			databaseMessage:      defaultDatabaseMessage,
-			schemaName:           "hiring",
-			tableName:            "employees",
-			columnName:           "salary",
-			columnClassification: "1-1-1",
-			maskingPolicyMap:     map[maskingPolicyKey]*storepb.MaskData{},
+			description:           "Follow The Global Masking Rule If Column Masking Policy Is Default",
+			databaseMessage:       defaultDatabaseMessage,
+			schemaName:            "hiring",
+			tableName:             "employees",
+			columnName:            "salary",
+			columnClassification:  "1-1-1",
+			maskingPolicyResolver: maskingpolicy.NewResolver("bb", nil, nil),
 			maskingRulePolicy: &storepb.MaskingRulePolicy{
 				Rules: []*storepb.MaskingRulePolicy_MaskingRule{
 					{
@@ -86,13 +87,13 @@ func TestEvalMaskingLevelOfColumn(t *testing.T) {
 			want: storepb.MaskingLevel_FULL,
 		},
 		{
-			description:          "Follow The Global Masking Rule If Column Masking Policy Is Default And Respect The Exception",
-			databaseMessage:      defaultDatabaseMessage,
-			schemaName:           "hiring",
-			tableName:            "employees",
-			columnName:           "salary",
-			columnClassification: "1-1-1",
-			maskingPolicyMap:     map[maskingPolicyKey]*storepb.MaskData{},
+			description:           "Follow The Global Masking Rule If Column Masking Policy Is Default And Respect The Exception",
+			databaseMessage:       defaultDatabaseMessage,
+			schemaName:            "hiring",
+			tableName:             "employees",
+			columnName:            "salary",
+			columnClassification:  "1-1-1",
+			maskingPolicyResolver: maskingpolicy.NewResolver("bb", nil, nil),
 			maskingRulePolicy: &storepb.MaskingRulePolicy{
 				Rules: []*storepb.MaskingRulePolicy_MaskingRule{
 					{
@@ -118,13 +119,13 @@ func TestEvalMaskingLevelOfColumn(t *testing.T) {
 			want: storepb.MaskingLevel_PARTIAL,
 		},
 		{
-			description:          "Only Find The Lower Level in Exception",
-			databaseMessage:      defaultDatabaseMessage,
-			schemaName:           "hiring",
-			tableName:            "employees",
-			columnName:           "salary",
-			columnClassification: "1-1-1",
-			maskingPolicyMap:     map[maskingPolicyKey]*storepb.MaskData{},
+			description:           "Only Find The Lower Level in Exception",
+			databaseMessage:       defaultDatabaseMessage,
+			schemaName:            "hiring",
+			tableName:             "employees",
+			columnName:            "salary",
+			columnClassification:  "1-1-1",
+			maskingPolicyResolver: maskingpolicy.NewResolver("bb", nil, nil),
 			maskingRulePolicy: &storepb.MaskingRulePolicy{
 				Rules: []*storepb.MaskingRulePolicy_MaskingRule{
 					{
@@ -157,18 +158,16 @@ func TestEvalMaskingLevelOfColumn(t *testing.T) {
 			tableName:            "employees",
 			columnName:           "salary",
 			columnClassification: "1-1-1",
-			maskingPolicyMap: map[maskingPolicyKey]*storepb.MaskData{
-				{
-					schema: "hiring",
-					table:  "employees",
-					column: "salary",
-				}: {
-					Schema:       "hiring",
-					Table:        "employees",
-					Column:       "salary",
-					MaskingLevel: storepb.MaskingLevel_FULL,
+			maskingPolicyResolver: maskingpolicy.NewResolver("bb", nil, &storepb.MaskingPolicy{
+				MaskData: []*storepb.MaskData{
+					{
+						Schema:       "hiring",
+						Table:        "employees",
+						Column:       "salary",
+						MaskingLevel: storepb.MaskingLevel_FULL,
+					},
 				},
-			},
+			}),
 			maskingRulePolicy: &storepb.MaskingRulePolicy{},
 			filteredMaskingExceptions: []*storepb.MaskingExceptionPolicy_MaskingException{
 				{
@@ -186,13 +185,66 @@ func TestEvalMaskingLevelOfColumn(t *testing.T) {
 
 			want: storepb.MaskingLevel_PARTIAL,
 		},
+		{
+			description:          "Follow The Project Wildcard Masking Policy",
+			databaseMessage:      defaultDatabaseMessage,
+			schemaName:           "hiring",
+			tableName:            "employees",
+			columnName:           "work_email",
+			columnClassification: "1-1-1",
+			maskingPolicyResolver: maskingpolicy.NewResolver("bb", &storepb.MaskingPolicy{
+				MaskData: []*storepb.MaskData{
+					{
+						Column:       "*_email",
+						MaskingLevel: storepb.MaskingLevel_FULL,
+					},
+				},
+			}, nil),
+			maskingRulePolicy:                       &storepb.MaskingRulePolicy{},
+			filteredMaskingExceptions:               []*storepb.MaskingExceptionPolicy_MaskingException{},
+			dataClassification:                      defaultClassification,
+			databaseProjectDatabaseClassificationID: defaultProjectDatabaseDataClassificationID,
+
+			want: storepb.MaskingLevel_FULL,
+		},
+		{
+			description:          "Column Masking Policy Overrides The Project Wildcard Masking Policy",
+			databaseMessage:      defaultDatabaseMessage,
+			schemaName:           "hiring",
+			tableName:            "employees",
+			columnName:           "work_email",
+			columnClassification: "1-1-1",
+			maskingPolicyResolver: maskingpolicy.NewResolver("bb", &storepb.MaskingPolicy{
+				MaskData: []*storepb.MaskData{
+					{
+						Column:       "*_email",
+						MaskingLevel: storepb.MaskingLevel_FULL,
+					},
+				},
+			}, &storepb.MaskingPolicy{
+				MaskData: []*storepb.MaskData{
+					{
+						Schema:       "hiring",
+						Table:        "employees",
+						Column:       "work_email",
+						MaskingLevel: storepb.MaskingLevel_NONE,
+					},
+				},
+			}),
+			maskingRulePolicy:                       &storepb.MaskingRulePolicy{},
+			filteredMaskingExceptions:               []*storepb.MaskingExceptionPolicy_MaskingException{},
+			dataClassification:                      defaultClassification,
+			databaseProjectDatabaseClassificationID: defaultProjectDatabaseDataClassificationID,
+
+			want: storepb.MaskingLevel_NONE,
+		},
 	}
 
 	a := require.New(t)
 
 	for _, tc := range testCases {
 		m := newEmptyMaskingLevelEvaluator().withMaskingRulePolicy(tc.maskingRulePolicy).withDataClassificationSetting(tc.dataClassification)
-		result, err := m.evaluateMaskingLevelOfColumn(tc.databaseMessage, tc.schemaName, tc.tableName, tc.columnName, tc.columnClassification, tc.databaseProjectDatabaseClassificationID, tc.maskingPolicyMap, tc.filteredMaskingExceptions)
+		result, err := m.evaluateMaskingLevelOfColumn(tc.databaseMessage, tc.schemaName, tc.tableName, tc.columnName, tc.columnClassification, tc.databaseProjectDatabaseClassificationID, tc.maskingPolicyResolver, tc.filteredMaskingExceptions)
 		a.NoError(err, tc.description)
 		a.Equal(tc.want, result, tc.description)
 	}
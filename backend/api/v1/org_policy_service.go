@@ -2,9 +2,12 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
+	"reflect"
 	"strings"
+	"time"
 
 	"github.com/google/cel-go/cel"
 	"github.com/pkg/errors"
@@ -17,6 +20,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/maskingpolicy"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
@@ -156,7 +160,7 @@ func (s *OrgPolicyService) UpdatePolicy(ctx context.Context, request *v1pb.Updat
 		case "inherit_from_parent":
 			patch.InheritFromParent = &request.Policy.InheritFromParent
 		case "payload":
-			if err := validatePolicyPayload(policy.Type, request.Policy); err != nil {
+			if err := validatePolicyPayload(policy.Type, policy.ResourceType, request.Policy); err != nil {
 				return nil, status.Errorf(codes.InvalidArgument, "invalid policy: %v", err)
 			}
 			payloadStr, err := s.convertPolicyPayloadToString(request.Policy)
@@ -196,6 +200,191 @@ func (s *OrgPolicyService) DeletePolicy(ctx context.Context, request *v1pb.Delet
 	return &emptypb.Empty{}, nil
 }
 
+// ExportSQLReviewPolicy exports the SQL review policy as a versioned document.
+func (s *OrgPolicyService) ExportSQLReviewPolicy(ctx context.Context, request *v1pb.ExportSQLReviewPolicyRequest) (*v1pb.ExportSQLReviewPolicyResponse, error) {
+	policy, _, err := s.findPolicyMessage(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if policy.Type != api.PolicyTypeSQLReview {
+		return nil, status.Errorf(codes.InvalidArgument, "policy %q is not a SQL review policy", request.Name)
+	}
+	sqlReviewPolicy := new(storepb.SQLReviewPolicy)
+	if err := protojson.Unmarshal([]byte(policy.Payload), sqlReviewPolicy); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal sql review policy with error: %v", err)
+	}
+
+	format := advisor.SQLReviewDocumentFormatYAML
+	if request.Format == v1pb.ExportSQLReviewPolicyRequest_JSON {
+		format = advisor.SQLReviewDocumentFormatJSON
+	}
+	content, err := advisor.MarshalSQLReviewDocument(sqlReviewPolicy, format)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to export sql review policy with error: %v", err)
+	}
+	return &v1pb.ExportSQLReviewPolicyResponse{
+		Content: string(content),
+	}, nil
+}
+
+// ImportSQLReviewPolicy imports the SQL review policy document into an environment.
+func (s *OrgPolicyService) ImportSQLReviewPolicy(ctx context.Context, request *v1pb.ImportSQLReviewPolicyRequest) (*v1pb.ImportSQLReviewPolicyResponse, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	resourceType, resourceID, err := s.getPolicyResourceTypeAndID(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if resourceID == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "resource id for %s must be specific", resourceType)
+	}
+	if err := validatePolicyType(api.PolicyTypeSQLReview, resourceType); err != nil {
+		return nil, err
+	}
+
+	sqlReviewPolicy, err := advisor.UnmarshalSQLReviewDocument([]byte(request.Content))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sql review policy document: %v", err)
+	}
+	payloadBytes, err := protojson.Marshal(sqlReviewPolicy)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal sql review policy with error: %v", err)
+	}
+	newPolicy, err := convertToV1PBSQLReviewPolicy(string(payloadBytes))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	policy := &v1pb.Policy{
+		Name:              fmt.Sprintf("%s/%s%s", request.Parent, common.PolicyNamePrefix, strings.ToLower(v1pb.PolicyType_SQL_REVIEW.String())),
+		InheritFromParent: false,
+		Type:              v1pb.PolicyType_SQL_REVIEW,
+		Policy:            newPolicy,
+		Enforce:           true,
+		ResourceType:      v1pb.PolicyResourceType_ENVIRONMENT,
+		ResourceUid:       fmt.Sprintf("%d", *resourceID),
+	}
+	// Validate the policy the same way as creating or updating it.
+	payloadStr, err := s.convertPolicyPayloadToString(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	pType := api.PolicyTypeSQLReview
+	existing, err := s.store.GetPolicyV2(ctx, &store.FindPolicyMessage{
+		ResourceType: &resourceType,
+		ResourceUID:  resourceID,
+		Type:         &pType,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	var oldRules []*v1pb.SQLReviewRule
+	if existing != nil {
+		oldPolicy, err := convertToV1PBSQLReviewPolicy(existing.Payload)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		oldRules = oldPolicy.SqlReviewPolicy.Rules
+	}
+	diffs := diffSQLReviewRules(oldRules, newPolicy.SqlReviewPolicy.Rules)
+
+	if request.ValidateOnly {
+		return &v1pb.ImportSQLReviewPolicyResponse{
+			Policy: policy,
+			Diffs:  diffs,
+		}, nil
+	}
+
+	if existing == nil {
+		created, err := s.createPolicyMessage(ctx, principalID, request.Parent, policy)
+		if err != nil {
+			return nil, err
+		}
+		return &v1pb.ImportSQLReviewPolicyResponse{
+			Policy: created,
+			Diffs:  diffs,
+		}, nil
+	}
+	updated, err := s.store.UpdatePolicyV2(ctx, &store.UpdatePolicyMessage{
+		UpdaterID:    principalID,
+		ResourceType: existing.ResourceType,
+		Type:         existing.Type,
+		ResourceUID:  existing.ResourceUID,
+		Payload:      &payloadStr,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	response, err := convertToPolicy(request.Parent, updated)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return &v1pb.ImportSQLReviewPolicyResponse{
+		Policy: response,
+		Diffs:  diffs,
+	}, nil
+}
+
+// diffSQLReviewRules compares the rules by the rule type and engine.
+func diffSQLReviewRules(oldRules, newRules []*v1pb.SQLReviewRule) []*v1pb.SQLReviewRuleDiff {
+	ruleKey := func(rule *v1pb.SQLReviewRule) string {
+		return fmt.Sprintf("%s/%s", rule.Type, rule.Engine)
+	}
+	oldRuleMap := make(map[string]*v1pb.SQLReviewRule)
+	for _, rule := range oldRules {
+		oldRuleMap[ruleKey(rule)] = rule
+	}
+	newRuleMap := make(map[string]*v1pb.SQLReviewRule)
+	for _, rule := range newRules {
+		newRuleMap[ruleKey(rule)] = rule
+	}
+
+	var diffs []*v1pb.SQLReviewRuleDiff
+	for _, rule := range newRules {
+		oldRule, ok := oldRuleMap[ruleKey(rule)]
+		if !ok {
+			diffs = append(diffs, &v1pb.SQLReviewRuleDiff{
+				Action:  v1pb.SQLReviewRuleDiff_ADD,
+				NewRule: rule,
+			})
+			continue
+		}
+		if oldRule.Level != rule.Level || !equalSQLReviewRulePayload(oldRule.Payload, rule.Payload) || oldRule.Comment != rule.Comment {
+			diffs = append(diffs, &v1pb.SQLReviewRuleDiff{
+				Action:  v1pb.SQLReviewRuleDiff_UPDATE,
+				OldRule: oldRule,
+				NewRule: rule,
+			})
+		}
+	}
+	for _, rule := range oldRules {
+		if _, ok := newRuleMap[ruleKey(rule)]; !ok {
+			diffs = append(diffs, &v1pb.SQLReviewRuleDiff{
+				Action:  v1pb.SQLReviewRuleDiff_REMOVE,
+				OldRule: rule,
+			})
+		}
+	}
+	return diffs
+}
+
+// equalSQLReviewRulePayload compares the JSON payloads regardless of the key order and spacing.
+func equalSQLReviewRulePayload(a, b string) bool {
+	if a == b {
+		return true
+	}
+	var aValue, bValue any
+	if err := json.Unmarshal([]byte(a), &aValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &bValue); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
+
 // findPolicyMessage finds the policy and the parent name by the policy name.
 func (s *OrgPolicyService) findPolicyMessage(ctx context.Context, policyName string) (*store.PolicyMessage, string, error) {
 	tokens := strings.Split(policyName, common.PolicyNamePrefix)
@@ -279,6 +468,23 @@ func (s *OrgPolicyService) getPolicyResourceTypeAndID(ctx context.Context, reque
 		return api.PolicyResourceTypeEnvironment, &environment.UID, nil
 	}
 
+	if strings.HasPrefix(requestName, common.EnvironmentClassNamePrefix) && len(sections) == 2 {
+		// environment class policy request name should be environmentClasses/{dev|test|staging|prod}
+		classID, err := common.GetEnvironmentClassID(requestName)
+		if err != nil {
+			return api.PolicyResourceTypeUnknown, nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if classID == "-" {
+			return api.PolicyResourceTypeEnvironmentClass, nil, nil
+		}
+		classUID, ok := api.GetEnvironmentClassUID(api.EnvironmentClass(strings.ToUpper(classID)))
+		if !ok || classID != strings.ToLower(classID) {
+			return api.PolicyResourceTypeUnknown, nil, status.Errorf(codes.NotFound, "environment class %q not found", classID)
+		}
+
+		return api.PolicyResourceTypeEnvironmentClass, &classUID, nil
+	}
+
 	if strings.HasPrefix(requestName, common.InstanceNamePrefix) && len(sections) == 2 {
 		// instance policy request name should be instances/{instance id}
 		instanceID, err := common.GetInstanceID(requestName)
@@ -397,7 +603,7 @@ func (s *OrgPolicyService) createPolicyMessage(ctx context.Context, creatorID in
 		return nil, err
 	}
 
-	if err := validatePolicyPayload(policyType, policy); err != nil {
+	if err := validatePolicyPayload(policyType, resourceType, policy); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid policy: %v", err)
 	}
 
@@ -437,6 +643,12 @@ func (s *OrgPolicyService) getPolicyParentPath(ctx context.Context, policyMessag
 			return "", err
 		}
 		return fmt.Sprintf("%s%s", common.EnvironmentNamePrefix, env.ResourceID), nil
+	case api.PolicyResourceTypeEnvironmentClass:
+		class, ok := api.GetEnvironmentClassByUID(policyMessage.ResourceUID)
+		if !ok {
+			return "", status.Errorf(codes.NotFound, "environment class %d not found", policyMessage.ResourceUID)
+		}
+		return fmt.Sprintf("%s%s", common.EnvironmentClassNamePrefix, strings.ToLower(string(class))), nil
 	case api.PolicyResourceTypeProject:
 		proj, err := s.findActiveProject(ctx, &store.FindProjectMessage{
 			UID: &policyMessage.ResourceUID,
@@ -475,7 +687,7 @@ func validatePolicyType(policyType api.PolicyType, policyResourceType api.Policy
 	return status.Errorf(codes.InvalidArgument, "policy %v is not allowed in resource %v", policyType, policyResourceType)
 }
 
-func validatePolicyPayload(policyType api.PolicyType, policy *v1pb.Policy) error {
+func validatePolicyPayload(policyType api.PolicyType, resourceType api.PolicyResourceType, policy *v1pb.Policy) error {
 	switch policyType {
 	case api.PolicyTypeMasking:
 		maskingPolicy, ok := policy.Policy.(*v1pb.Policy_MaskingPolicy)
@@ -486,9 +698,24 @@ func validatePolicyPayload(policyType api.PolicyType, policy *v1pb.Policy) error
 			return status.Errorf(codes.InvalidArgument, "masking policy must be set")
 		}
 		for _, maskData := range maskingPolicy.MaskingPolicy.MaskData {
+			if resourceType == api.PolicyResourceTypeProject {
+				// The masking data of the project are the wildcard rules matching the columns of all databases in the project.
+				if maskData.Column == "" {
+					return status.Errorf(codes.InvalidArgument, "masking column must be set")
+				}
+				for _, pattern := range []string{maskData.Database, maskData.Schema, maskData.Table, maskData.Column} {
+					if err := maskingpolicy.ValidatePattern(pattern); err != nil {
+						return status.Errorf(codes.InvalidArgument, err.Error())
+					}
+				}
+				continue
+			}
 			if maskData.Column == "" || maskData.Table == "" {
 				return status.Errorf(codes.InvalidArgument, "masking column and table must be set")
 			}
+			if maskData.Database != "" {
+				return status.Errorf(codes.InvalidArgument, "masking database can only be set in the project masking policy")
+			}
 		}
 	case api.PolicyTypeMaskingRule:
 		maskingRulePolicy, ok := policy.Policy.(*v1pb.Policy_MaskingRulePolicy)
@@ -546,6 +773,17 @@ func (s *OrgPolicyService) convertPolicyPayloadToString(policy *v1pb.Policy) (st
 		}
 		return string(payloadBytes), nil
 	case v1pb.PolicyType_ROLLOUT_POLICY:
+		if err := validateRolloutWindow(policy.GetRolloutPolicy().GetWindow()); err != nil {
+			return "", status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if err := validateRolloutFreezes(policy.GetRolloutPolicy().GetFreezes()); err != nil {
+			return "", status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		for _, role := range policy.GetRolloutPolicy().GetApprovalGateRoles() {
+			if _, err := common.GetRoleID(role); err != nil {
+				return "", status.Errorf(codes.InvalidArgument, "invalid approval gate role %q, error: %v", role, err)
+			}
+		}
 		rolloutPolicy := convertToStorePBRolloutPolicy(policy.GetRolloutPolicy())
 		payloadBytes, err := protojson.Marshal(rolloutPolicy)
 		if err != nil {
@@ -646,6 +884,42 @@ func (s *OrgPolicyService) convertPolicyPayloadToString(policy *v1pb.Policy) (st
 			return "", status.Errorf(codes.InvalidArgument, err.Error())
 		}
 		return payload.String()
+	case v1pb.PolicyType_TASK_RUN_LOG_RETENTION:
+		retentionPolicy := convertToStorePBTaskRunLogRetentionPolicy(policy.GetTaskRunLogRetentionPolicy())
+		if retentionPolicy.RetentionDays < 0 {
+			return "", status.Errorf(codes.InvalidArgument, "retention days must not be negative")
+		}
+		if retentionPolicy.ArtifactRetentionDays < 0 {
+			return "", status.Errorf(codes.InvalidArgument, "artifact retention days must not be negative")
+		}
+		payloadBytes, err := protojson.Marshal(retentionPolicy)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal task run log retention policy")
+		}
+		return string(payloadBytes), nil
+	case v1pb.PolicyType_AFFECTED_ROWS_LIMIT:
+		limitPolicy := convertToStorePBAffectedRowsLimitPolicy(policy.GetAffectedRowsLimitPolicy())
+		if limitPolicy.MaxAffectedRows < 0 {
+			return "", status.Errorf(codes.InvalidArgument, "max affected rows must not be negative")
+		}
+		payloadBytes, err := protojson.Marshal(limitPolicy)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal affected rows limit policy")
+		}
+		return string(payloadBytes), nil
+	case v1pb.PolicyType_ROW_ACCESS:
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureAccessControl); err != nil {
+			return "", status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		rowAccessPolicy := convertToStorePBRowAccessPolicy(policy.GetRowAccessPolicy())
+		if err := validateRowAccessPolicy(rowAccessPolicy); err != nil {
+			return "", status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		payloadBytes, err := protojson.Marshal(rowAccessPolicy)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal row access policy")
+		}
+		return string(payloadBytes), nil
 	}
 
 	return "", status.Errorf(codes.InvalidArgument, "invalid policy %v", policy.Type)
@@ -658,6 +932,8 @@ func convertToPolicy(parentPath string, policyMessage *store.PolicyMessage) (*v1
 		resourceType = v1pb.PolicyResourceType_WORKSPACE
 	case api.PolicyResourceTypeEnvironment:
 		resourceType = v1pb.PolicyResourceType_ENVIRONMENT
+	case api.PolicyResourceTypeEnvironmentClass:
+		resourceType = v1pb.PolicyResourceType_ENVIRONMENT_CLASS
 	case api.PolicyResourceTypeProject:
 		resourceType = v1pb.PolicyResourceType_PROJECT
 	case api.PolicyResourceTypeDatabase:
@@ -762,6 +1038,27 @@ func convertToPolicy(parentPath string, policyMessage *store.PolicyMessage) (*v1
 			return nil, err
 		}
 		policy.Policy = payload
+	case api.PolicyTypeTaskRunLogRetention:
+		pType = v1pb.PolicyType_TASK_RUN_LOG_RETENTION
+		payload, err := convertToV1TaskRunLogRetentionPolicyPayload(policyMessage.Payload)
+		if err != nil {
+			return nil, err
+		}
+		policy.Policy = payload
+	case api.PolicyTypeAffectedRowsLimit:
+		pType = v1pb.PolicyType_AFFECTED_ROWS_LIMIT
+		payload, err := convertToV1AffectedRowsLimitPolicyPayload(policyMessage.Payload)
+		if err != nil {
+			return nil, err
+		}
+		policy.Policy = payload
+	case api.PolicyTypeRowAccess:
+		pType = v1pb.PolicyType_ROW_ACCESS
+		payload, err := convertToV1RowAccessPolicyPayload(policyMessage.Payload)
+		if err != nil {
+			return nil, err
+		}
+		policy.Policy = payload
 	}
 
 	policy.Type = pType
@@ -893,10 +1190,12 @@ func convertToV1PBMaskingPolicy(payloadStr string) (*v1pb.Policy_MaskingPolicy,
 	var maskDataList []*v1pb.MaskData
 	for _, data := range maskingPolicy.MaskData {
 		maskDataList = append(maskDataList, &v1pb.MaskData{
-			Schema:       data.Schema,
-			Table:        data.Table,
-			Column:       data.Column,
-			MaskingLevel: convertToV1PBMaskingLevel(data.MaskingLevel),
+			Schema:         data.Schema,
+			Table:          data.Table,
+			Column:         data.Column,
+			MaskingLevel:   convertToV1PBMaskingLevel(data.MaskingLevel),
+			Database:       data.Database,
+			SemanticTypeId: data.SemanticTypeId,
 		})
 	}
 
@@ -912,10 +1211,12 @@ func convertToStorePBMaskingPolicyPayload(policy *v1pb.MaskingPolicy) (*storepb.
 
 	for _, data := range policy.MaskData {
 		maskData = append(maskData, &storepb.MaskData{
-			Schema:       data.Schema,
-			Table:        data.Table,
-			Column:       data.Column,
-			MaskingLevel: convertToStorePBMaskingLevel(data.MaskingLevel),
+			Schema:         data.Schema,
+			Table:          data.Table,
+			Column:         data.Column,
+			MaskingLevel:   convertToStorePBMaskingLevel(data.MaskingLevel),
+			Database:       data.Database,
+			SemanticTypeId: data.SemanticTypeId,
 		})
 	}
 
@@ -1037,12 +1338,179 @@ func convertToV1RolloutPolicyPayload(payloadStr string) (*v1pb.Policy_RolloutPol
 }
 
 func convertToStorePBRolloutPolicy(policy *v1pb.RolloutPolicy) *storepb.RolloutPolicy {
-	return &storepb.RolloutPolicy{
-		Automatic:      policy.Automatic,
-		WorkspaceRoles: policy.WorkspaceRoles,
-		ProjectRoles:   policy.ProjectRoles,
-		IssueRoles:     policy.IssueRoles,
+	p := &storepb.RolloutPolicy{
+		Automatic:            policy.Automatic,
+		WorkspaceRoles:       policy.WorkspaceRoles,
+		ProjectRoles:         policy.ProjectRoles,
+		IssueRoles:           policy.IssueRoles,
+		ApprovalGateRoles:    policy.ApprovalGateRoles,
+		RequireChangeRequest: policy.RequireChangeRequest,
+	}
+	if window := policy.Window; window != nil {
+		p.Window = &storepb.RolloutWindow{
+			TimeZone:   window.TimeZone,
+			StartHour:  window.StartHour,
+			EndHour:    window.EndHour,
+			DaysOfWeek: window.DaysOfWeek,
+		}
+	}
+	for _, freeze := range policy.Freezes {
+		p.Freezes = append(p.Freezes, &storepb.RolloutFreeze{
+			Reason:    freeze.Reason,
+			StartTime: freeze.StartTime,
+			EndTime:   freeze.EndTime,
+		})
+	}
+	return p
+}
+
+func convertToV1PBRolloutPolicy(policy *storepb.RolloutPolicy) *v1pb.RolloutPolicy {
+	p := &v1pb.RolloutPolicy{
+		Automatic:            policy.Automatic,
+		WorkspaceRoles:       policy.WorkspaceRoles,
+		ProjectRoles:         policy.ProjectRoles,
+		IssueRoles:           policy.IssueRoles,
+		ApprovalGateRoles:    policy.ApprovalGateRoles,
+		RequireChangeRequest: policy.RequireChangeRequest,
+	}
+	if window := policy.Window; window != nil {
+		p.Window = &v1pb.RolloutWindow{
+			TimeZone:   window.TimeZone,
+			StartHour:  window.StartHour,
+			EndHour:    window.EndHour,
+			DaysOfWeek: window.DaysOfWeek,
+		}
+	}
+	for _, freeze := range policy.Freezes {
+		p.Freezes = append(p.Freezes, &v1pb.RolloutFreeze{
+			Reason:    freeze.Reason,
+			StartTime: freeze.StartTime,
+			EndTime:   freeze.EndTime,
+		})
+	}
+	return p
+}
+
+func validateRolloutWindow(window *v1pb.RolloutWindow) error {
+	if window == nil {
+		return nil
+	}
+	if window.StartHour < 0 || window.StartHour > 23 || window.EndHour < 0 || window.EndHour > 23 {
+		return errors.Errorf("the start and end hours of the rollout window must be in [0, 24)")
+	}
+	if window.StartHour == window.EndHour && len(window.DaysOfWeek) == 0 {
+		return errors.Errorf("the start and end hours of the rollout window cannot be the same unless the days of week are set")
 	}
+	if window.TimeZone != "" {
+		if _, err := time.LoadLocation(window.TimeZone); err != nil {
+			return errors.Wrapf(err, "invalid time zone %q", window.TimeZone)
+		}
+	}
+	for _, day := range window.DaysOfWeek {
+		if day < 0 || day > 6 {
+			return errors.Errorf("the days of week of the rollout window must be in [0, 6], got %d", day)
+		}
+	}
+	return nil
+}
+
+func validateRolloutFreezes(freezes []*v1pb.RolloutFreeze) error {
+	for _, freeze := range freezes {
+		if freeze.StartTime == nil || freeze.EndTime == nil {
+			return errors.Errorf("the start and end time of the rollout freeze are required")
+		}
+		if !freeze.EndTime.AsTime().After(freeze.StartTime.AsTime()) {
+			return errors.Errorf("the end time of the rollout freeze %q must be after the start time", freeze.Reason)
+		}
+	}
+	return nil
+}
+
+func convertToV1TaskRunLogRetentionPolicyPayload(payloadStr string) (*v1pb.Policy_TaskRunLogRetentionPolicy, error) {
+	p := &v1pb.TaskRunLogRetentionPolicy{}
+	if err := protojson.Unmarshal([]byte(payloadStr), p); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal task run log retention policy payload")
+	}
+	return &v1pb.Policy_TaskRunLogRetentionPolicy{
+		TaskRunLogRetentionPolicy: p,
+	}, nil
+}
+
+func convertToStorePBTaskRunLogRetentionPolicy(policy *v1pb.TaskRunLogRetentionPolicy) *storepb.TaskRunLogRetentionPolicy {
+	return &storepb.TaskRunLogRetentionPolicy{
+		RetentionDays:         policy.GetRetentionDays(),
+		ExportBeforeDelete:    policy.GetExportBeforeDelete(),
+		ArtifactRetentionDays: policy.GetArtifactRetentionDays(),
+	}
+}
+
+func convertToV1AffectedRowsLimitPolicyPayload(payloadStr string) (*v1pb.Policy_AffectedRowsLimitPolicy, error) {
+	p := &v1pb.AffectedRowsLimitPolicy{}
+	if err := protojson.Unmarshal([]byte(payloadStr), p); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal affected rows limit policy payload")
+	}
+	return &v1pb.Policy_AffectedRowsLimitPolicy{
+		AffectedRowsLimitPolicy: p,
+	}, nil
+}
+
+func convertToStorePBAffectedRowsLimitPolicy(policy *v1pb.AffectedRowsLimitPolicy) *storepb.AffectedRowsLimitPolicy {
+	return &storepb.AffectedRowsLimitPolicy{
+		MaxAffectedRows: policy.GetMaxAffectedRows(),
+	}
+}
+
+func convertToV1RowAccessPolicyPayload(payloadStr string) (*v1pb.Policy_RowAccessPolicy, error) {
+	p := &storepb.RowAccessPolicy{}
+	if err := protojson.Unmarshal([]byte(payloadStr), p); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal row access policy payload")
+	}
+	rowAccessPolicy := &v1pb.RowAccessPolicy{}
+	for _, rule := range p.Rules {
+		rowAccessPolicy.Rules = append(rowAccessPolicy.Rules, &v1pb.RowAccessRule{
+			Database: rule.Database,
+			Schema:   rule.Schema,
+			Table:    rule.Table,
+			Role:     rule.Role,
+			Filter:   rule.Filter,
+		})
+	}
+	return &v1pb.Policy_RowAccessPolicy{
+		RowAccessPolicy: rowAccessPolicy,
+	}, nil
+}
+
+func convertToStorePBRowAccessPolicy(policy *v1pb.RowAccessPolicy) *storepb.RowAccessPolicy {
+	rowAccessPolicy := &storepb.RowAccessPolicy{}
+	for _, rule := range policy.GetRules() {
+		rowAccessPolicy.Rules = append(rowAccessPolicy.Rules, &storepb.RowAccessRule{
+			Database: rule.Database,
+			Schema:   rule.Schema,
+			Table:    rule.Table,
+			Role:     rule.Role,
+			Filter:   strings.TrimSpace(rule.Filter),
+		})
+	}
+	return rowAccessPolicy
+}
+
+func validateRowAccessPolicy(policy *storepb.RowAccessPolicy) error {
+	for i, rule := range policy.Rules {
+		if rule.Table == "" {
+			return errors.Errorf("table is required in rule %d", i)
+		}
+		if !strings.HasPrefix(rule.Role, common.RolePrefix) {
+			return errors.Errorf("invalid role %q in rule %d, the role should be in the format of roles/{role}", rule.Role, i)
+		}
+		if rule.Filter == "" {
+			return errors.Errorf("filter is required in rule %d", i)
+		}
+		// The filter is injected into the queries, reject the multiple statements.
+		if strings.Contains(rule.Filter, ";") {
+			return errors.Errorf("invalid filter %q in rule %d, the filter should be a single predicate", rule.Filter, i)
+		}
+	}
+	return nil
 }
 
 func convertToV1PBSlowQueryPolicy(payloadStr string) (*v1pb.Policy_SlowQueryPolicy, error) {
@@ -1204,6 +1672,12 @@ func convertPolicyType(pType string) (api.PolicyType, error) {
 		return api.PolicyTypeDisableCopyData, nil
 	case v1pb.PolicyType_RESTRICT_ISSUE_CREATION_FOR_SQL_REVIEW.String():
 		return api.PolicyTypeRestrictIssueCreationForSQLReview, nil
+	case v1pb.PolicyType_TASK_RUN_LOG_RETENTION.String():
+		return api.PolicyTypeTaskRunLogRetention, nil
+	case v1pb.PolicyType_AFFECTED_ROWS_LIMIT.String():
+		return api.PolicyTypeAffectedRowsLimit, nil
+	case v1pb.PolicyType_ROW_ACCESS.String():
+		return api.PolicyTypeRowAccess, nil
 	}
 	return policyType, errors.Errorf("invalid policy type %v", pType)
 }
@@ -1246,6 +1720,13 @@ func validateSQLReviewPolicy(policy *v1pb.SQLReviewPolicy) error {
 			if _, err := advisor.UnmarshalNamingCaseRulePayload(rule.Payload); err != nil {
 				return err
 			}
+		case advisor.SchemaRuleCustomExpression:
+			if engine := convertEngine(rule.Engine); !advisor.CustomExpressionEngines[engine] {
+				return errors.Errorf("custom expression rule is not supported for %v, because the tables changed by its statements cannot be extracted", engine)
+			}
+			if _, err := advisor.UnmarshalCustomExpressionRulePayload(rule.Payload); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
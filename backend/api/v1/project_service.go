@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"net/url"
 	"path"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -16,7 +18,10 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gopkg.in/yaml.v3"
 
 	"github.com/bytebase/bytebase/backend/common"
@@ -29,6 +34,7 @@ import (
 	vcsplugin "github.com/bytebase/bytebase/backend/plugin/vcs"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/azure"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/bitbucket"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gerrit"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/github"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/gitlab"
 	webhookplugin "github.com/bytebase/bytebase/backend/plugin/webhook"
@@ -220,6 +226,32 @@ func (s *ProjectService) UpdateProject(ctx context.Context, request *v1pb.Update
 				return nil, status.Errorf(codes.InvalidArgument, "data classification %s not exists", request.Project.DataClassificationConfigId)
 			}
 			patch.DataClassificationConfigID = &request.Project.DataClassificationConfigId
+		case "slow_query_regression_factor":
+			if request.Project.SlowQueryRegressionFactor != 0 && request.Project.SlowQueryRegressionFactor <= 1 {
+				return nil, status.Errorf(codes.InvalidArgument, "slow query regression factor must be greater than 1")
+			}
+			setting := project.Setting
+			if setting == nil {
+				setting = &storepb.Project{}
+			}
+			setting.SlowQueryRegressionFactor = request.Project.SlowQueryRegressionFactor
+			patch.Setting = setting
+		case "query_performance_check":
+			check := request.Project.QueryPerformanceCheck
+			if check.GetCostIncreaseFactor() != 0 && check.GetCostIncreaseFactor() <= 1 {
+				return nil, status.Errorf(codes.InvalidArgument, "cost increase factor must be greater than 1")
+			}
+			for _, query := range check.GetBenchmarkQueries() {
+				if strings.TrimSpace(query.Statement) == "" {
+					return nil, status.Errorf(codes.InvalidArgument, "benchmark query %q has empty statement", query.Title)
+				}
+			}
+			setting := project.Setting
+			if setting == nil {
+				setting = &storepb.Project{}
+			}
+			setting.QueryPerformanceCheck = convertToStoreQueryPerformanceCheck(check)
+			patch.Setting = setting
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, `unsupport update_mask "%s"`, path)
 		}
@@ -520,6 +552,47 @@ func (s *ProjectService) UpdateProjectGitOpsInfo(ctx context.Context, request *v
 			patch.SheetPathTemplate = &request.ProjectGitopsInfo.SheetPathTemplate
 		case "enable_sql_review_ci":
 			patch.EnableSQLReviewCI = &request.ProjectGitopsInfo.EnableSqlReviewCi
+		case "enable_sql_review_check_run":
+			patch.EnableSQLReviewCheckRun = &request.ProjectGitopsInfo.EnableSqlReviewCheckRun
+		case "routing_rules":
+			routingRules, err := s.convertToStoreRoutingRules(ctx, request.ProjectGitopsInfo.RoutingRules)
+			if err != nil {
+				return nil, err
+			}
+			payload, err := getRepositoryPayloadPatch(repo, patch)
+			if err != nil {
+				return nil, err
+			}
+			payload.RoutingRules = routingRules
+		case "enable_dry_run":
+			payload, err := getRepositoryPayloadPatch(repo, patch)
+			if err != nil {
+				return nil, err
+			}
+			payload.EnableDryRun = request.ProjectGitopsInfo.EnableDryRun
+		}
+	}
+
+	if patch.Payload.GetEnableDryRun() {
+		enableSQLReviewCI := repo.EnableSQLReviewCI
+		if v := patch.EnableSQLReviewCI; v != nil {
+			enableSQLReviewCI = *v
+		}
+		if !enableSQLReviewCI {
+			return nil, status.Errorf(codes.InvalidArgument, "dry run requires the SQL review CI to be enabled")
+		}
+	}
+
+	if v := patch.EnableSQLReviewCheckRun; v != nil && *v {
+		vcs, err := s.store.GetExternalVersionControlV2(ctx, repo.VCSUID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to find vcs: %s", err.Error())
+		}
+		if vcs == nil {
+			return nil, status.Errorf(codes.NotFound, "vcs %d not found", repo.VCSUID)
+		}
+		if vcs.Type != vcsplugin.GitHub {
+			return nil, status.Errorf(codes.InvalidArgument, "SQL review check run is only supported by GitHub")
 		}
 	}
 
@@ -604,12 +677,14 @@ func (s *ProjectService) SetupProjectSQLReviewCI(ctx context.Context, request *v
 		return nil, status.Errorf(codes.NotFound, "vcs %d not found", repo.VCSUID)
 	}
 
-	pullRequest, err := s.setupVCSSQLReviewCI(ctx, repo, vcs)
-	if err != nil {
-		return nil, err
-	}
-	response := &v1pb.SetupSQLReviewCIResponse{
-		PullRequestUrl: pullRequest.URL,
+	response := &v1pb.SetupSQLReviewCIResponse{}
+	// Gerrit reviews the patch sets on the patchset-created webhook events, so there is no CI to set up.
+	if vcs.Type != vcsplugin.Gerrit {
+		pullRequest, err := s.setupVCSSQLReviewCI(ctx, repo, vcs)
+		if err != nil {
+			return nil, err
+		}
+		response.PullRequestUrl = pullRequest.URL
 	}
 
 	enableSQLReviewCi := true
@@ -795,6 +870,38 @@ func (s *ProjectService) UpdateDeploymentConfig(ctx context.Context, request *v1
 	return convertToDeploymentConfig(project.ResourceID, deploymentConfig), nil
 }
 
+// EvaluateDeploymentExpression evaluates the deployment expression against the databases in the project.
+func (s *ProjectService) EvaluateDeploymentExpression(ctx context.Context, request *v1pb.EvaluateDeploymentExpressionRequest) (*v1pb.EvaluateDeploymentExpressionResponse, error) {
+	if request.Expression == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "expression must be set")
+	}
+	project, err := s.getProjectMessage(ctx, request.Project)
+	if err != nil {
+		return nil, err
+	}
+	if project.Deleted {
+		return nil, status.Errorf(codes.NotFound, "project %q has been deleted", request.Project)
+	}
+
+	databases, err := s.store.ListDatabases(ctx, &store.FindDatabaseMessage{ProjectID: &project.ResourceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list databases, error: %v", err)
+	}
+	engines, err := utils.GetInstanceEngines(ctx, s.store, databases)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	matched, err := utils.GetDatabasesMatchingDeploymentExpression(request.Expression, databases, engines)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	response := &v1pb.EvaluateDeploymentExpressionResponse{}
+	for _, database := range matched {
+		response.Databases = append(response.Databases, common.FormatDatabase(database.InstanceID, database.DatabaseName))
+	}
+	return response, nil
+}
+
 // AddWebhook adds a webhook to a given project.
 func (s *ProjectService) AddWebhook(ctx context.Context, request *v1pb.AddWebhookRequest) (*v1pb.Project, error) {
 	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
@@ -897,6 +1004,31 @@ func (s *ProjectService) UpdateWebhook(ctx context.Context, request *v1pb.Update
 				return nil, status.Errorf(codes.InvalidArgument, "notification types should not be empty")
 			}
 			update.ActivityList = types
+		case "payload_version", "payload_fields", "signing_secret":
+			if update.Payload != nil {
+				continue
+			}
+			// Validate the payload with the other fields of the webhook.
+			patched := &v1pb.Webhook{
+				Type:           convertWebhookTypeString(webhook.Type),
+				PayloadVersion: convertToV1WebhookPayloadVersion(webhook.Payload.GetPayloadVersion()),
+				PayloadFields:  webhook.Payload.GetPayloadFields(),
+				SigningSecret:  webhook.Payload.GetSigningSecret(),
+			}
+			if slices.Contains(request.UpdateMask.Paths, "payload_version") {
+				patched.PayloadVersion = request.Webhook.PayloadVersion
+			}
+			if slices.Contains(request.UpdateMask.Paths, "payload_fields") {
+				patched.PayloadFields = request.Webhook.PayloadFields
+			}
+			if slices.Contains(request.UpdateMask.Paths, "signing_secret") {
+				patched.SigningSecret = request.Webhook.SigningSecret
+			}
+			payload, err := convertToStoreProjectWebhookPayload(patched)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			update.Payload = payload
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "invalid field %q", path)
 		}
@@ -1014,6 +1146,10 @@ func (s *ProjectService) TestWebhook(ctx context.Context, request *v1pb.TestWebh
 			CreatorEmail: api.SystemBotEmail,
 			CreatedTs:    time.Now().Unix(),
 			Project:      &webhookplugin.Project{Name: project.Title},
+
+			PayloadVersion: int(webhook.Payload.GetPayloadVersion()),
+			PayloadFields:  webhook.Payload.GetPayloadFields(),
+			SigningSecret:  webhook.Payload.GetSigningSecret(),
 		},
 	)
 	if err != nil {
@@ -1023,6 +1159,132 @@ func (s *ProjectService) TestWebhook(ctx context.Context, request *v1pb.TestWebh
 	return resp, nil
 }
 
+// ListWebhookDeliveries lists the deliveries of a webhook.
+func (s *ProjectService) ListWebhookDeliveries(ctx context.Context, request *v1pb.ListWebhookDeliveriesRequest) (*v1pb.ListWebhookDeliveriesResponse, error) {
+	projectID, webhookID, err := common.GetProjectIDWebhookID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	webhook, err := s.getProjectWebhook(ctx, projectID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if request.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "page size must be non-negative: %d", request.PageSize)
+	}
+
+	find := &store.FindWebhookDeliveryMessage{
+		WebhookID: &webhook.ID,
+	}
+	if request.DeadOnly {
+		dead := store.WebhookDeliveryDead
+		find.Status = &dead
+	}
+	limit := int(request.PageSize)
+	if request.PageToken != "" {
+		var pageToken storepb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		find.After = getKeysetCursor(&pageToken)
+	}
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	limitPlusOne := limit + 1
+	find.Limit = &limitPlusOne
+	deliveries, err := s.store.ListWebhookDeliveries(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	nextPageToken := ""
+	if len(deliveries) == limit+1 {
+		deliveries = deliveries[:limit]
+		last := deliveries[limit-1]
+		if nextPageToken, err = getKeysetPageToken(limit, last.CreatedTs, int(last.UID)); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	response := &v1pb.ListWebhookDeliveriesResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, convertToV1WebhookDelivery(request.Parent, delivery))
+	}
+	return response, nil
+}
+
+// RedeliverWebhookDelivery posts the dead delivery to the webhook again.
+func (s *ProjectService) RedeliverWebhookDelivery(ctx context.Context, request *v1pb.RedeliverWebhookDeliveryRequest) (*v1pb.WebhookDelivery, error) {
+	projectID, webhookID, deliveryID, err := common.GetProjectIDWebhookIDDeliveryID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	deliveryUID, err := strconv.ParseInt(deliveryID, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delivery id %q", deliveryID)
+	}
+	webhook, err := s.getProjectWebhook(ctx, projectID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	delivery, err := s.store.GetWebhookDelivery(ctx, &store.FindWebhookDeliveryMessage{
+		UID:       &deliveryUID,
+		WebhookID: &webhook.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if delivery == nil {
+		return nil, status.Errorf(codes.NotFound, "delivery %q not found", request.Name)
+	}
+	if delivery.Status != store.WebhookDeliveryDead {
+		return nil, status.Errorf(codes.FailedPrecondition, "only the dead delivery can be redelivered, the status of delivery %q is %s", request.Name, delivery.Status)
+	}
+
+	redelivery, err := s.activityManager.RedeliverWebhook(ctx, webhook, delivery)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to redeliver %q, error: %v", request.Name, err)
+	}
+	return convertToV1WebhookDelivery(fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, projectID, common.WebhookIDPrefix, webhookID), redelivery), nil
+}
+
+// getProjectWebhook gets the webhook of the undeleted project.
+func (s *ProjectService) getProjectWebhook(ctx context.Context, projectID, webhookID string) (*store.ProjectWebhookMessage, error) {
+	webhookIDInt, err := strconv.Atoi(webhookID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook id %q", webhookID)
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+	}
+	if project.Deleted {
+		return nil, status.Errorf(codes.NotFound, "project %q has been deleted", projectID)
+	}
+	webhook, err := s.store.GetProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
+		ProjectID: &project.UID,
+		ID:        &webhookIDInt,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if webhook == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook %q not found", webhookID)
+	}
+	return webhook, nil
+}
+
 func (s *ProjectService) findProjectRepository(ctx context.Context, projectName string) (*store.RepositoryMessage, error) {
 	project, err := s.getProjectMessage(ctx, projectName)
 	if err != nil {
@@ -2260,78 +2522,652 @@ func convertProtectionRules(project *store.ProjectMessage) *v1pb.ProtectionRules
 	return resp
 }
 
-func (s *ProjectService) convertStoreToAPIDatabaseGroupFull(ctx context.Context, databaseGroup *store.DatabaseGroupMessage, projectResourceID string) (*v1pb.DatabaseGroup, error) {
-	databases, err := s.store.ListDatabases(ctx, &store.FindDatabaseMessage{
-		ProjectID: &projectResourceID,
+// GetProjectIssueForms gets a project issue forms.
+func (s *ProjectService) GetProjectIssueForms(ctx context.Context, request *v1pb.GetProjectIssueFormsRequest) (*v1pb.IssueForms, error) {
+	projectName, err := common.TrimSuffix(request.Name, common.IssueFormsSuffix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	projectResourceID, err := common.GetProjectID(projectName)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectResourceID,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", projectResourceID)
+	}
+	if project.Deleted {
+		return nil, status.Errorf(codes.NotFound, "project %q has been deleted", projectResourceID)
+	}
+
+	resp := convertIssueForms(project)
+	return resp, nil
+}
 
-	matches, unmatches, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, databaseGroup, databases)
+// UpdateProjectIssueForms updates a project issue forms.
+func (s *ProjectService) UpdateProjectIssueForms(ctx context.Context, request *v1pb.UpdateProjectIssueFormsRequest) (*v1pb.IssueForms, error) {
+	if request.IssueForms == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "issue forms must be set")
+	}
+	projectName, err := common.TrimSuffix(request.IssueForms.Name, common.IssueFormsSuffix)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
-	ret := &v1pb.DatabaseGroup{
-		Name:                fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, projectResourceID, common.DatabaseGroupNamePrefix, databaseGroup.ResourceID),
-		DatabasePlaceholder: databaseGroup.Placeholder,
-		DatabaseExpr:        databaseGroup.Expression,
+	projectResourceID, err := common.GetProjectID(projectName)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
-	for _, database := range matches {
-		ret.MatchedDatabases = append(ret.MatchedDatabases, &v1pb.DatabaseGroup_Database{
-			Name: fmt.Sprintf("%s%s/%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName),
-		})
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectResourceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
 	}
-	for _, database := range unmatches {
-		ret.UnmatchedDatabases = append(ret.UnmatchedDatabases, &v1pb.DatabaseGroup_Database{
-			Name: fmt.Sprintf("%s%s/%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName),
-		})
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", projectResourceID)
+	}
+	if project.Deleted {
+		return nil, status.Errorf(codes.NotFound, "project %q has been deleted", projectResourceID)
 	}
-	return ret, nil
-}
 
-func convertStoreToAPIDatabaseGroupBasic(databaseGroup *store.DatabaseGroupMessage, projectResourceID string) *v1pb.DatabaseGroup {
-	return &v1pb.DatabaseGroup{
-		Name:                fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, projectResourceID, common.DatabaseGroupNamePrefix, databaseGroup.ResourceID),
-		DatabasePlaceholder: databaseGroup.Placeholder,
-		DatabaseExpr:        databaseGroup.Expression,
+	var forms []*storepb.IssueForm
+	issueTypes := make(map[api.IssueType]bool)
+	for _, form := range request.IssueForms.Forms {
+		issueType, err := convertToAPIIssueType(form.IssueType)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if issueTypes[issueType] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate issue form for issue type %q", form.IssueType)
+		}
+		issueTypes[issueType] = true
+		storeForm := &storepb.IssueForm{
+			IssueType: string(issueType),
+		}
+		for _, field := range form.Fields {
+			storeForm.Fields = append(storeForm.Fields, &storepb.IssueFormField{
+				Id:           field.Id,
+				Title:        field.Title,
+				Type:         storepb.IssueFormField_Type(field.Type),
+				Required:     field.Required,
+				DefaultValue: field.DefaultValue,
+				Pattern:      field.Pattern,
+				Options:      field.Options,
+			})
+		}
+		if err := validateIssueForm(storeForm); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid issue form for issue type %q, error: %v", form.IssueType, err)
+		}
+		forms = append(forms, storeForm)
 	}
-}
 
-func (s *ProjectService) convertStoreToAPISchemaGroupFull(ctx context.Context, schemaGroup *store.SchemaGroupMessage, databaseGroup *store.DatabaseGroupMessage, projectResourceID string) (*v1pb.SchemaGroup, error) {
-	matches, unmatches, err := s.getMatchesAndUnmatchedTables(ctx, schemaGroup, databaseGroup, projectResourceID)
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	setting := project.Setting
+	setting.IssueForms = forms
+	project, err = s.store.UpdateProjectV2(ctx, &store.UpdateProjectMessage{
+		UpdaterID:  principalID,
+		ResourceID: project.ResourceID,
+		Setting:    setting,
+	})
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.Internal, err.Error())
 	}
-	ret := &v1pb.SchemaGroup{
-		Name:             fmt.Sprintf("%s%s/%s%s/%s%s", common.ProjectNamePrefix, projectResourceID, common.DatabaseGroupNamePrefix, databaseGroup.ResourceID, common.SchemaGroupNamePrefix, schemaGroup.ResourceID),
-		TablePlaceholder: schemaGroup.Placeholder,
-		TableExpr:        schemaGroup.Expression,
-		MatchedTables:    matches,
-		UnmatchedTables:  unmatches,
+
+	resp := convertIssueForms(project)
+	return resp, nil
+}
+
+func convertIssueForms(project *store.ProjectMessage) *v1pb.IssueForms {
+	resp := &v1pb.IssueForms{
+		Name: fmt.Sprintf("%s%s%s", common.ProjectNamePrefix, project.ResourceID, common.IssueFormsSuffix),
 	}
-	return ret, nil
+	if project.Setting != nil {
+		for _, form := range project.Setting.IssueForms {
+			v1Form := &v1pb.IssueForm{
+				IssueType: convertToIssueType(api.IssueType(form.IssueType)),
+			}
+			for _, field := range form.Fields {
+				v1Form.Fields = append(v1Form.Fields, &v1pb.IssueFormField{
+					Id:           field.Id,
+					Title:        field.Title,
+					Type:         v1pb.IssueFormField_Type(field.Type),
+					Required:     field.Required,
+					DefaultValue: field.DefaultValue,
+					Pattern:      field.Pattern,
+					Options:      field.Options,
+				})
+			}
+			resp.Forms = append(resp.Forms, v1Form)
+		}
+	}
+	return resp
 }
 
-func (s *ProjectService) getMatchesAndUnmatchedTables(ctx context.Context, schemaGroup *store.SchemaGroupMessage, databaseGroup *store.DatabaseGroupMessage, projectResourceID string) ([]*v1pb.SchemaGroup_Table, []*v1pb.SchemaGroup_Table, error) {
-	allDatabases, err := s.store.ListDatabases(ctx, &store.FindDatabaseMessage{
-		ProjectID: &projectResourceID,
-	})
+// GetProjectQuota gets a project quota and its current usage.
+func (s *ProjectService) GetProjectQuota(ctx context.Context, request *v1pb.GetProjectQuotaRequest) (*v1pb.ProjectQuota, error) {
+	projectName, err := common.TrimSuffix(request.Name, common.QuotaSuffix)
 	if err != nil {
-		return nil, nil, status.Errorf(codes.Internal, err.Error())
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
-	matchesDatabases, _, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, databaseGroup, allDatabases)
+	project, err := s.getActiveProject(ctx, projectName)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
+	return s.convertToProjectQuota(ctx, project)
+}
 
-	prog, err := common.ValidateGroupCELExpr(schemaGroup.Expression.Expression)
+// UpdateProjectQuota updates a project quota.
+func (s *ProjectService) UpdateProjectQuota(ctx context.Context, request *v1pb.UpdateProjectQuotaRequest) (*v1pb.ProjectQuota, error) {
+	if request.Quota == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "quota must be set")
+	}
+	projectName, err := common.TrimSuffix(request.Quota.Name, common.QuotaSuffix)
 	if err != nil {
-		return nil, nil, err
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.getActiveProject(ctx, projectName)
+	if err != nil {
+		return nil, err
+	}
+	quota := request.Quota
+	if quota.MaxConcurrentRollouts < 0 || quota.MaxExportedRowsPerDay < 0 || quota.MaxBackupStorageBytes < 0 || quota.MaxQueryRuntime.AsDuration() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "quota limits must not be negative")
 	}
 
-	var matches []*v1pb.SchemaGroup_Table
-	var unmatches []*v1pb.SchemaGroup_Table
+	project.Setting.Quota = &storepb.ProjectQuota{
+		MaxConcurrentRollouts: quota.MaxConcurrentRollouts,
+		MaxExportedRowsPerDay: quota.MaxExportedRowsPerDay,
+		MaxQueryRuntime:       quota.MaxQueryRuntime,
+		MaxBackupStorageBytes: quota.MaxBackupStorageBytes,
+	}
+	if err := s.updateProjectSetting(ctx, project, project.Setting); err != nil {
+		return nil, err
+	}
+	return s.convertToProjectQuota(ctx, project)
+}
+
+func (s *ProjectService) convertToProjectQuota(ctx context.Context, project *store.ProjectMessage) (*v1pb.ProjectQuota, error) {
+	quota := project.Setting.GetQuota()
+	runningPipelines, err := s.store.ListProjectRunningPipelineUIDs(ctx, project.ResourceID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	exportedRows, err := s.store.GetProjectExportedRowCount(ctx, project.ResourceID, utils.StartOfUTCDay(time.Now()).Unix())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	backupBytes, err := s.store.GetProjectBackupStorageBytes(ctx, project.ResourceID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return &v1pb.ProjectQuota{
+		Name:                  fmt.Sprintf("%s%s%s", common.ProjectNamePrefix, project.ResourceID, common.QuotaSuffix),
+		MaxConcurrentRollouts: quota.GetMaxConcurrentRollouts(),
+		MaxExportedRowsPerDay: quota.GetMaxExportedRowsPerDay(),
+		MaxQueryRuntime:       quota.GetMaxQueryRuntime(),
+		MaxBackupStorageBytes: quota.GetMaxBackupStorageBytes(),
+		Usage: &v1pb.ProjectQuotaUsage{
+			ConcurrentRollouts: int32(len(runningPipelines)),
+			ExportedRowsToday:  exportedRows,
+			BackupStorageBytes: backupBytes,
+		},
+	}, nil
+}
+
+// ListIssueTemplates lists the issue templates of a project.
+func (s *ProjectService) ListIssueTemplates(ctx context.Context, request *v1pb.ListIssueTemplatesRequest) (*v1pb.ListIssueTemplatesResponse, error) {
+	project, err := s.getActiveProject(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1pb.ListIssueTemplatesResponse{}
+	for _, template := range project.Setting.GetIssueTemplates() {
+		resp.IssueTemplates = append(resp.IssueTemplates, convertToIssueTemplate(project.ResourceID, template))
+	}
+	return resp, nil
+}
+
+// GetIssueTemplate gets an issue template.
+func (s *ProjectService) GetIssueTemplate(ctx context.Context, request *v1pb.GetIssueTemplateRequest) (*v1pb.IssueTemplate, error) {
+	projectResourceID, templateID, err := common.GetProjectIDIssueTemplateID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.getActiveProject(ctx, common.FormatProject(projectResourceID))
+	if err != nil {
+		return nil, err
+	}
+	template := findIssueTemplate(project.Setting, templateID)
+	if template == nil {
+		return nil, status.Errorf(codes.NotFound, "issue template %q not found", request.Name)
+	}
+	return convertToIssueTemplate(project.ResourceID, template), nil
+}
+
+// CreateIssueTemplate creates an issue template.
+func (s *ProjectService) CreateIssueTemplate(ctx context.Context, request *v1pb.CreateIssueTemplateRequest) (*v1pb.IssueTemplate, error) {
+	if request.IssueTemplate == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "issue template must be set")
+	}
+	project, err := s.getActiveProject(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if !isValidResourceID(request.IssueTemplateId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid issue template id %q", request.IssueTemplateId)
+	}
+	if findIssueTemplate(project.Setting, request.IssueTemplateId) != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "issue template %q already exists", request.IssueTemplateId)
+	}
+	template := convertToStoreIssueTemplate(request.IssueTemplateId, request.IssueTemplate)
+	if err := validateIssueTemplate(template); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid issue template, error: %v", err)
+	}
+
+	setting := project.Setting
+	setting.IssueTemplates = append(setting.IssueTemplates, template)
+	if err := s.updateProjectSetting(ctx, project, setting); err != nil {
+		return nil, err
+	}
+	return convertToIssueTemplate(project.ResourceID, template), nil
+}
+
+// UpdateIssueTemplate updates an issue template.
+func (s *ProjectService) UpdateIssueTemplate(ctx context.Context, request *v1pb.UpdateIssueTemplateRequest) (*v1pb.IssueTemplate, error) {
+	if request.IssueTemplate == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "issue template must be set")
+	}
+	if request.UpdateMask == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "update_mask must be set")
+	}
+	projectResourceID, templateID, err := common.GetProjectIDIssueTemplateID(request.IssueTemplate.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.getActiveProject(ctx, common.FormatProject(projectResourceID))
+	if err != nil {
+		return nil, err
+	}
+	existed := findIssueTemplate(project.Setting, templateID)
+	if existed == nil {
+		return nil, status.Errorf(codes.NotFound, "issue template %q not found", request.IssueTemplate.Name)
+	}
+
+	update := convertToStoreIssueTemplate(templateID, request.IssueTemplate)
+	template, ok := proto.Clone(existed).(*storepb.IssueTemplate)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "failed to clone issue template %q", request.IssueTemplate.Name)
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "title":
+			template.Title = update.Title
+		case "title_pattern":
+			template.TitlePattern = update.TitlePattern
+		case "statement":
+			template.Statement = update.Statement
+		case "variables":
+			template.Variables = update.Variables
+		case "assignee":
+			template.Assignee = update.Assignee
+		case "labels":
+			template.Labels = update.Labels
+		case "database_expr":
+			template.DatabaseExpression = update.DatabaseExpression
+		case "change_type":
+			template.ChangeType = update.ChangeType
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported path: %q", path)
+		}
+	}
+	if err := validateIssueTemplate(template); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid issue template, error: %v", err)
+	}
+
+	setting := project.Setting
+	for i, t := range setting.IssueTemplates {
+		if t.Id == templateID {
+			setting.IssueTemplates[i] = template
+		}
+	}
+	if err := s.updateProjectSetting(ctx, project, setting); err != nil {
+		return nil, err
+	}
+	return convertToIssueTemplate(project.ResourceID, template), nil
+}
+
+// DeleteIssueTemplate deletes an issue template.
+func (s *ProjectService) DeleteIssueTemplate(ctx context.Context, request *v1pb.DeleteIssueTemplateRequest) (*emptypb.Empty, error) {
+	projectResourceID, templateID, err := common.GetProjectIDIssueTemplateID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.getActiveProject(ctx, common.FormatProject(projectResourceID))
+	if err != nil {
+		return nil, err
+	}
+	if findIssueTemplate(project.Setting, templateID) == nil {
+		return nil, status.Errorf(codes.NotFound, "issue template %q not found", request.Name)
+	}
+	for _, schedule := range project.Setting.IssueSchedules {
+		if schedule.Template == templateID {
+			return nil, status.Errorf(codes.FailedPrecondition, "issue template %q is used by issue schedule %q", request.Name, schedule.Id)
+		}
+	}
+
+	setting := project.Setting
+	setting.IssueTemplates = slices.DeleteFunc(setting.IssueTemplates, func(t *storepb.IssueTemplate) bool {
+		return t.Id == templateID
+	})
+	if err := s.updateProjectSetting(ctx, project, setting); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListIssueSchedules lists the issue schedules of a project.
+func (s *ProjectService) ListIssueSchedules(ctx context.Context, request *v1pb.ListIssueSchedulesRequest) (*v1pb.ListIssueSchedulesResponse, error) {
+	project, err := s.getActiveProject(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1pb.ListIssueSchedulesResponse{}
+	for _, schedule := range project.Setting.GetIssueSchedules() {
+		v1Schedule, err := convertToIssueSchedule(ctx, s.store, project.ResourceID, schedule)
+		if err != nil {
+			return nil, err
+		}
+		resp.IssueSchedules = append(resp.IssueSchedules, v1Schedule)
+	}
+	return resp, nil
+}
+
+// GetIssueSchedule gets an issue schedule.
+func (s *ProjectService) GetIssueSchedule(ctx context.Context, request *v1pb.GetIssueScheduleRequest) (*v1pb.IssueSchedule, error) {
+	projectResourceID, scheduleID, err := common.GetProjectIDIssueScheduleID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.getActiveProject(ctx, common.FormatProject(projectResourceID))
+	if err != nil {
+		return nil, err
+	}
+	schedule := findIssueSchedule(project.Setting, scheduleID)
+	if schedule == nil {
+		return nil, status.Errorf(codes.NotFound, "issue schedule %q not found", request.Name)
+	}
+	return convertToIssueSchedule(ctx, s.store, project.ResourceID, schedule)
+}
+
+// CreateIssueSchedule creates an issue schedule.
+func (s *ProjectService) CreateIssueSchedule(ctx context.Context, request *v1pb.CreateIssueScheduleRequest) (*v1pb.IssueSchedule, error) {
+	if request.IssueSchedule == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "issue schedule must be set")
+	}
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	project, err := s.getActiveProject(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if !isValidResourceID(request.IssueScheduleId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid issue schedule id %q", request.IssueScheduleId)
+	}
+	if findIssueSchedule(project.Setting, request.IssueScheduleId) != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "issue schedule %q already exists", request.IssueScheduleId)
+	}
+	templateID, err := getIssueScheduleTemplateID(project.ResourceID, request.IssueSchedule.Template)
+	if err != nil {
+		return nil, err
+	}
+	nextRunTs, err := getScheduledQueryNextRunTs(request.IssueSchedule.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	schedule := &storepb.IssueSchedule{
+		Id:                request.IssueScheduleId,
+		Title:             request.IssueSchedule.Title,
+		Template:          templateID,
+		TemplateVariables: request.IssueSchedule.TemplateVariables,
+		Schedule:          request.IssueSchedule.Schedule,
+		Disabled:          request.IssueSchedule.Disabled,
+		CreatorId:         int32(principalID),
+		NextRunTs:         nextRunTs,
+	}
+	if err := validateIssueSchedule(project.Setting, schedule); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid issue schedule, error: %v", err)
+	}
+
+	setting := project.Setting
+	setting.IssueSchedules = append(setting.IssueSchedules, schedule)
+	if err := s.updateProjectSetting(ctx, project, setting); err != nil {
+		return nil, err
+	}
+	return convertToIssueSchedule(ctx, s.store, project.ResourceID, schedule)
+}
+
+// UpdateIssueSchedule updates an issue schedule.
+func (s *ProjectService) UpdateIssueSchedule(ctx context.Context, request *v1pb.UpdateIssueScheduleRequest) (*v1pb.IssueSchedule, error) {
+	if request.IssueSchedule == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "issue schedule must be set")
+	}
+	if request.UpdateMask == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "update_mask must be set")
+	}
+	projectResourceID, scheduleID, err := common.GetProjectIDIssueScheduleID(request.IssueSchedule.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.getActiveProject(ctx, common.FormatProject(projectResourceID))
+	if err != nil {
+		return nil, err
+	}
+	existed := findIssueSchedule(project.Setting, scheduleID)
+	if existed == nil {
+		return nil, status.Errorf(codes.NotFound, "issue schedule %q not found", request.IssueSchedule.Name)
+	}
+
+	schedule, ok := proto.Clone(existed).(*storepb.IssueSchedule)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "failed to clone issue schedule %q", request.IssueSchedule.Name)
+	}
+	rescheduled := false
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "title":
+			schedule.Title = request.IssueSchedule.Title
+		case "template":
+			templateID, err := getIssueScheduleTemplateID(project.ResourceID, request.IssueSchedule.Template)
+			if err != nil {
+				return nil, err
+			}
+			schedule.Template = templateID
+		case "template_variables":
+			schedule.TemplateVariables = request.IssueSchedule.TemplateVariables
+		case "schedule":
+			schedule.Schedule = request.IssueSchedule.Schedule
+			rescheduled = true
+		case "disabled":
+			// Re-enabled schedules don't catch up the missed runs.
+			if schedule.Disabled && !request.IssueSchedule.Disabled {
+				rescheduled = true
+			}
+			schedule.Disabled = request.IssueSchedule.Disabled
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported path: %q", path)
+		}
+	}
+	if rescheduled {
+		nextRunTs, err := getScheduledQueryNextRunTs(schedule.Schedule)
+		if err != nil {
+			return nil, err
+		}
+		schedule.NextRunTs = nextRunTs
+	}
+	if err := validateIssueSchedule(project.Setting, schedule); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid issue schedule, error: %v", err)
+	}
+
+	setting := project.Setting
+	for i, sc := range setting.IssueSchedules {
+		if sc.Id == scheduleID {
+			setting.IssueSchedules[i] = schedule
+		}
+	}
+	if err := s.updateProjectSetting(ctx, project, setting); err != nil {
+		return nil, err
+	}
+	return convertToIssueSchedule(ctx, s.store, project.ResourceID, schedule)
+}
+
+// DeleteIssueSchedule deletes an issue schedule.
+func (s *ProjectService) DeleteIssueSchedule(ctx context.Context, request *v1pb.DeleteIssueScheduleRequest) (*emptypb.Empty, error) {
+	projectResourceID, scheduleID, err := common.GetProjectIDIssueScheduleID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.getActiveProject(ctx, common.FormatProject(projectResourceID))
+	if err != nil {
+		return nil, err
+	}
+	if findIssueSchedule(project.Setting, scheduleID) == nil {
+		return nil, status.Errorf(codes.NotFound, "issue schedule %q not found", request.Name)
+	}
+
+	setting := project.Setting
+	setting.IssueSchedules = slices.DeleteFunc(setting.IssueSchedules, func(sc *storepb.IssueSchedule) bool {
+		return sc.Id == scheduleID
+	})
+	if err := s.updateProjectSetting(ctx, project, setting); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getIssueScheduleTemplateID returns the issue template ID of the issue template name, which must be in the project.
+func getIssueScheduleTemplateID(projectID, name string) (string, error) {
+	templateProjectID, templateID, err := common.GetProjectIDIssueTemplateID(name)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid issue template %q, error: %v", name, err)
+	}
+	if templateProjectID != projectID {
+		return "", status.Errorf(codes.InvalidArgument, "issue template %q must be in project %q", name, projectID)
+	}
+	return templateID, nil
+}
+
+// getActiveProject returns the project of the name, and a NotFound error if it doesn't exist or has been deleted.
+func (s *ProjectService) getActiveProject(ctx context.Context, name string) (*store.ProjectMessage, error) {
+	project, err := s.getProjectMessage(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if project.Deleted {
+		return nil, status.Errorf(codes.NotFound, "project %q has been deleted", name)
+	}
+	if project.Setting == nil {
+		project.Setting = &storepb.Project{}
+	}
+	return project, nil
+}
+
+func (s *ProjectService) updateProjectSetting(ctx context.Context, project *store.ProjectMessage, setting *storepb.Project) error {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return status.Errorf(codes.Internal, "principal ID not found")
+	}
+	if _, err := s.store.UpdateProjectV2(ctx, &store.UpdateProjectMessage{
+		UpdaterID:  principalID,
+		ResourceID: project.ResourceID,
+		Setting:    setting,
+	}); err != nil {
+		return status.Errorf(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+func (s *ProjectService) convertStoreToAPIDatabaseGroupFull(ctx context.Context, databaseGroup *store.DatabaseGroupMessage, projectResourceID string) (*v1pb.DatabaseGroup, error) {
+	databases, err := s.store.ListDatabases(ctx, &store.FindDatabaseMessage{
+		ProjectID: &projectResourceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	matches, unmatches, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, s.store, databaseGroup, databases)
+	if err != nil {
+		return nil, err
+	}
+	ret := &v1pb.DatabaseGroup{
+		Name:                fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, projectResourceID, common.DatabaseGroupNamePrefix, databaseGroup.ResourceID),
+		DatabasePlaceholder: databaseGroup.Placeholder,
+		DatabaseExpr:        databaseGroup.Expression,
+	}
+	for _, database := range matches {
+		ret.MatchedDatabases = append(ret.MatchedDatabases, &v1pb.DatabaseGroup_Database{
+			Name: fmt.Sprintf("%s%s/%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName),
+		})
+	}
+	for _, database := range unmatches {
+		ret.UnmatchedDatabases = append(ret.UnmatchedDatabases, &v1pb.DatabaseGroup_Database{
+			Name: fmt.Sprintf("%s%s/%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName),
+		})
+	}
+	return ret, nil
+}
+
+func convertStoreToAPIDatabaseGroupBasic(databaseGroup *store.DatabaseGroupMessage, projectResourceID string) *v1pb.DatabaseGroup {
+	return &v1pb.DatabaseGroup{
+		Name:                fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, projectResourceID, common.DatabaseGroupNamePrefix, databaseGroup.ResourceID),
+		DatabasePlaceholder: databaseGroup.Placeholder,
+		DatabaseExpr:        databaseGroup.Expression,
+	}
+}
+
+func (s *ProjectService) convertStoreToAPISchemaGroupFull(ctx context.Context, schemaGroup *store.SchemaGroupMessage, databaseGroup *store.DatabaseGroupMessage, projectResourceID string) (*v1pb.SchemaGroup, error) {
+	matches, unmatches, err := s.getMatchesAndUnmatchedTables(ctx, schemaGroup, databaseGroup, projectResourceID)
+	if err != nil {
+		return nil, err
+	}
+	ret := &v1pb.SchemaGroup{
+		Name:             fmt.Sprintf("%s%s/%s%s/%s%s", common.ProjectNamePrefix, projectResourceID, common.DatabaseGroupNamePrefix, databaseGroup.ResourceID, common.SchemaGroupNamePrefix, schemaGroup.ResourceID),
+		TablePlaceholder: schemaGroup.Placeholder,
+		TableExpr:        schemaGroup.Expression,
+		MatchedTables:    matches,
+		UnmatchedTables:  unmatches,
+	}
+	return ret, nil
+}
+
+func (s *ProjectService) getMatchesAndUnmatchedTables(ctx context.Context, schemaGroup *store.SchemaGroupMessage, databaseGroup *store.DatabaseGroupMessage, projectResourceID string) ([]*v1pb.SchemaGroup_Table, []*v1pb.SchemaGroup_Table, error) {
+	allDatabases, err := s.store.ListDatabases(ctx, &store.FindDatabaseMessage{
+		ProjectID: &projectResourceID,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, err.Error())
+	}
+	matchesDatabases, _, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, s.store, databaseGroup, allDatabases)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prog, err := common.ValidateGroupCELExpr(schemaGroup.Expression.Expression)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var matches []*v1pb.SchemaGroup_Table
+	var unmatches []*v1pb.SchemaGroup_Table
 
 	for _, matchesDatabase := range matchesDatabases {
 		dbSchema, err := s.store.GetDBSchema(ctx, matchesDatabase.UID)
@@ -2394,14 +3230,81 @@ func convertToStoreProjectWebhookMessage(webhook *v1pb.Webhook) (*store.ProjectW
 	if err != nil {
 		return nil, err
 	}
+	payload, err := convertToStoreProjectWebhookPayload(webhook)
+	if err != nil {
+		return nil, err
+	}
 	return &store.ProjectWebhookMessage{
 		Type:         tp,
 		URL:          webhook.Url,
 		Title:        webhook.Title,
 		ActivityList: activityTypes,
+		Payload:      payload,
 	}, nil
 }
 
+func convertToStoreProjectWebhookPayload(webhook *v1pb.Webhook) (*storepb.ProjectWebhookPayload, error) {
+	var version storepb.ProjectWebhookPayload_PayloadVersion
+	switch webhook.PayloadVersion {
+	case v1pb.Webhook_PAYLOAD_VERSION_UNSPECIFIED:
+		version = storepb.ProjectWebhookPayload_PAYLOAD_VERSION_UNSPECIFIED
+	case v1pb.Webhook_PAYLOAD_VERSION_V1:
+		version = storepb.ProjectWebhookPayload_V1
+	default:
+		return nil, common.Errorf(common.Invalid, "unsupported payload version %v", webhook.PayloadVersion)
+	}
+	if version != storepb.ProjectWebhookPayload_PAYLOAD_VERSION_UNSPECIFIED && webhook.Type != v1pb.Webhook_TYPE_CUSTOM {
+		return nil, common.Errorf(common.Invalid, "payload version is only supported for the custom webhook")
+	}
+	if err := webhookplugin.ValidatePayloadFields(int(version), webhook.PayloadFields); err != nil {
+		return nil, common.Wrap(err, common.Invalid)
+	}
+	if webhook.SigningSecret != "" && webhook.Type != v1pb.Webhook_TYPE_CUSTOM {
+		return nil, common.Errorf(common.Invalid, "signing secret is only supported for the custom webhook")
+	}
+	return &storepb.ProjectWebhookPayload{
+		PayloadVersion: version,
+		PayloadFields:  webhook.PayloadFields,
+		SigningSecret:  webhook.SigningSecret,
+	}, nil
+}
+
+func convertToV1WebhookDelivery(webhookName string, delivery *store.WebhookDeliveryMessage) *v1pb.WebhookDelivery {
+	v1Delivery := &v1pb.WebhookDelivery{
+		Name:       fmt.Sprintf("%s/%s%d", webhookName, common.WebhookDeliveryPrefix, delivery.UID),
+		Attempt:    int32(delivery.Attempt),
+		Error:      delivery.Error,
+		CreateTime: timestamppb.New(time.Unix(delivery.CreatedTs, 0)),
+	}
+	if types := convertNotificationTypeStrings([]string{delivery.ActivityType}); len(types) > 0 {
+		v1Delivery.ActivityType = types[0]
+	}
+	webhookCtx := &webhookplugin.Context{}
+	if err := json.Unmarshal([]byte(delivery.WebhookContext), webhookCtx); err == nil {
+		v1Delivery.Title = webhookCtx.Title
+	}
+	switch delivery.Status {
+	case store.WebhookDeliverySucceeded:
+		v1Delivery.Status = v1pb.WebhookDelivery_SUCCEEDED
+	case store.WebhookDeliveryFailed:
+		v1Delivery.Status = v1pb.WebhookDelivery_FAILED
+	case store.WebhookDeliveryDead:
+		v1Delivery.Status = v1pb.WebhookDelivery_DEAD
+	case store.WebhookDeliveryRedelivered:
+		v1Delivery.Status = v1pb.WebhookDelivery_REDELIVERED
+	}
+	return v1Delivery
+}
+
+func convertToV1WebhookPayloadVersion(version storepb.ProjectWebhookPayload_PayloadVersion) v1pb.Webhook_PayloadVersion {
+	switch version {
+	case storepb.ProjectWebhookPayload_V1:
+		return v1pb.Webhook_PAYLOAD_VERSION_V1
+	default:
+		return v1pb.Webhook_PAYLOAD_VERSION_UNSPECIFIED
+	}
+}
+
 func convertToActivityTypeStrings(types []v1pb.Activity_Type) ([]string, error) {
 	var result []string
 	for _, tp := range types {
@@ -2454,6 +3357,16 @@ func convertToActivityTypeStrings(types []v1pb.Activity_Type) ([]string, error)
 			result = append(result, string(api.ActivityNotifyIssueApproved))
 		case v1pb.Activity_TYPE_NOTIFY_PIPELINE_ROLLOUT:
 			result = append(result, string(api.ActivityNotifyPipelineRollout))
+		case v1pb.Activity_TYPE_NOTIFY_SQL_QUERY_ANOMALY:
+			result = append(result, string(api.ActivityNotifySQLQueryAnomaly))
+		case v1pb.Activity_TYPE_NOTIFY_SQL_SCHEDULED_QUERY:
+			result = append(result, string(api.ActivityNotifySQLScheduledQuery))
+		case v1pb.Activity_TYPE_NOTIFY_ROLLOUT_FAILED:
+			result = append(result, string(api.ActivityNotifyRolloutFailed))
+		case v1pb.Activity_TYPE_NOTIFY_SCHEMA_DRIFT:
+			result = append(result, string(api.ActivityNotifySchemaDrift))
+		case v1pb.Activity_TYPE_NOTIFY_SLOW_QUERY_REGRESSION:
+			result = append(result, string(api.ActivityNotifySlowQueryRegression))
 		default:
 			return nil, common.Errorf(common.Invalid, "unsupported activity type: %v", tp)
 		}
@@ -2511,6 +3424,16 @@ func convertNotificationTypeStrings(types []string) []v1pb.Activity_Type {
 			result = append(result, v1pb.Activity_TYPE_NOTIFY_ISSUE_APPROVED)
 		case string(api.ActivityNotifyPipelineRollout):
 			result = append(result, v1pb.Activity_TYPE_NOTIFY_PIPELINE_ROLLOUT)
+		case string(api.ActivityNotifySQLQueryAnomaly):
+			result = append(result, v1pb.Activity_TYPE_NOTIFY_SQL_QUERY_ANOMALY)
+		case string(api.ActivityNotifySQLScheduledQuery):
+			result = append(result, v1pb.Activity_TYPE_NOTIFY_SQL_SCHEDULED_QUERY)
+		case string(api.ActivityNotifyRolloutFailed):
+			result = append(result, v1pb.Activity_TYPE_NOTIFY_ROLLOUT_FAILED)
+		case string(api.ActivityNotifySchemaDrift):
+			result = append(result, v1pb.Activity_TYPE_NOTIFY_SCHEMA_DRIFT)
+		case string(api.ActivityNotifySlowQueryRegression):
+			result = append(result, v1pb.Activity_TYPE_NOTIFY_SLOW_QUERY_REGRESSION)
 		default:
 			result = append(result, v1pb.Activity_TYPE_UNSPECIFIED)
 		}
@@ -2537,6 +3460,8 @@ func convertToAPIWebhookTypeString(tp v1pb.Webhook_Type) (string, error) {
 		return "bb.plugin.webhook.wecom", nil
 	case v1pb.Webhook_TYPE_CUSTOM:
 		return "bb.plugin.webhook.custom", nil
+	case v1pb.Webhook_TYPE_GOOGLE_CHAT:
+		return "bb.plugin.webhook.googlechat", nil
 	default:
 		return "", common.Errorf(common.Invalid, "webhook type %q is not supported", tp)
 	}
@@ -2558,6 +3483,8 @@ func convertWebhookTypeString(tp string) v1pb.Webhook_Type {
 		return v1pb.Webhook_TYPE_WECOM
 	case "bb.plugin.webhook.custom":
 		return v1pb.Webhook_TYPE_CUSTOM
+	case "bb.plugin.webhook.googlechat":
+		return v1pb.Webhook_TYPE_GOOGLE_CHAT
 	default:
 		return v1pb.Webhook_TYPE_UNSPECIFIED
 	}
@@ -2574,6 +3501,21 @@ func validateAndConvertToStoreDeploymentSchedule(deployment *v1pb.DeploymentConf
 		if d.Title == "" {
 			return nil, common.Errorf(common.Invalid, "Deployment name must not be empty")
 		}
+		if d.Spec == nil {
+			return nil, common.Errorf(common.Invalid, "deployment spec must not be empty")
+		}
+		if err := validateDeploymentCanary(d.Canary); err != nil {
+			return nil, common.Errorf(common.Invalid, "invalid canary of deployment %q: %v", d.Title, err)
+		}
+		if d.Spec.Expression != "" {
+			if _, err := utils.NewDeploymentProgram(d.Spec.Expression); err != nil {
+				return nil, common.Errorf(common.Invalid, err.Error())
+			}
+			continue
+		}
+		if d.Spec.LabelSelector == nil {
+			return nil, common.Errorf(common.Invalid, "deployment should have either label selector or expression")
+		}
 		hasEnv := false
 		for _, e := range d.Spec.LabelSelector.MatchExpressions {
 			if e == nil {
@@ -2605,6 +3547,32 @@ func validateAndConvertToStoreDeploymentSchedule(deployment *v1pb.DeploymentConf
 	return convertToStoreDeploymentConfig(deployment)
 }
 
+func validateDeploymentCanary(canary *v1pb.DeploymentCanary) error {
+	if canary == nil {
+		return nil
+	}
+	if len(canary.Databases) == 0 && (canary.Percentage <= 0 || canary.Percentage >= 100) {
+		return errors.Errorf("the percentage must be in (0, 100) if the databases are not set")
+	}
+	for _, database := range canary.Databases {
+		if _, _, err := common.GetInstanceDatabaseID(database); err != nil {
+			return errors.Wrapf(err, "invalid database %q", database)
+		}
+	}
+	if canary.BakeDuration.AsDuration() < 0 {
+		return errors.Errorf("the bake duration must not be negative")
+	}
+	if canary.MaxFailurePercentage < 0 || canary.MaxFailurePercentage > 100 {
+		return errors.Errorf("the max failure percentage must be in [0, 100]")
+	}
+	for _, statement := range canary.PostCheckStatements {
+		if strings.TrimSpace(statement) == "" {
+			return errors.Errorf("the post-check statement must not be empty")
+		}
+	}
+	return nil
+}
+
 func (s *ProjectService) getProjectMessage(ctx context.Context, name string) (*store.ProjectMessage, error) {
 	projectID, err := common.GetProjectID(name)
 	if err != nil {
@@ -2765,6 +3733,8 @@ func convertToProject(projectMessage *store.ProjectMessage) *v1pb.Project {
 			Title:             webhook.Title,
 			Url:               webhook.URL,
 			NotificationTypes: convertNotificationTypeStrings(webhook.ActivityList),
+			PayloadVersion:    convertToV1WebhookPayloadVersion(webhook.Payload.GetPayloadVersion()),
+			PayloadFields:     webhook.Payload.GetPayloadFields(),
 		})
 	}
 
@@ -2780,7 +3750,41 @@ func convertToProject(projectMessage *store.ProjectMessage) *v1pb.Project {
 		SchemaChange:               schemaChange,
 		Webhooks:                   projectWebhooks,
 		DataClassificationConfigId: projectMessage.DataClassificationConfigID,
+		SlowQueryRegressionFactor:  projectMessage.Setting.GetSlowQueryRegressionFactor(),
+		QueryPerformanceCheck:      convertToV1QueryPerformanceCheck(projectMessage.Setting.GetQueryPerformanceCheck()),
+	}
+}
+
+func convertToV1QueryPerformanceCheck(check *storepb.QueryPerformanceCheck) *v1pb.QueryPerformanceCheck {
+	if check == nil {
+		return nil
+	}
+	result := &v1pb.QueryPerformanceCheck{
+		CostIncreaseFactor: check.CostIncreaseFactor,
+	}
+	for _, query := range check.BenchmarkQueries {
+		result.BenchmarkQueries = append(result.BenchmarkQueries, &v1pb.BenchmarkQuery{
+			Title:     query.Title,
+			Statement: query.Statement,
+		})
+	}
+	return result
+}
+
+func convertToStoreQueryPerformanceCheck(check *v1pb.QueryPerformanceCheck) *storepb.QueryPerformanceCheck {
+	if check == nil {
+		return nil
+	}
+	result := &storepb.QueryPerformanceCheck{
+		CostIncreaseFactor: check.CostIncreaseFactor,
+	}
+	for _, query := range check.BenchmarkQueries {
+		result.BenchmarkQueries = append(result.BenchmarkQueries, &storepb.BenchmarkQuery{
+			Title:     query.Title,
+			Statement: query.Statement,
+		})
 	}
+	return result
 }
 
 func convertToProjectWorkflowType(workflow v1pb.Workflow) api.ProjectWorkflowType {
@@ -2892,10 +3896,20 @@ func convertToStoreSchedule(schedule *v1pb.Schedule) (*store.Schedule, error) {
 }
 
 func convertToDeployment(deployment *store.Deployment) *v1pb.ScheduleDeployment {
-	return &v1pb.ScheduleDeployment{
+	d := &v1pb.ScheduleDeployment{
 		Title: deployment.Name,
 		Spec:  convertToSpec(deployment.Spec),
 	}
+	if c := deployment.Canary; c != nil {
+		d.Canary = &v1pb.DeploymentCanary{
+			Percentage:           c.Percentage,
+			Databases:            c.Databases,
+			BakeDuration:         durationpb.New(time.Duration(c.BakeSeconds) * time.Second),
+			MaxFailurePercentage: c.MaxFailurePercentage,
+			PostCheckStatements:  c.PostCheckStatements,
+		}
+	}
+	return d
 }
 
 func convertToStoreDeployment(deployment *v1pb.ScheduleDeployment) (*store.Deployment, error) {
@@ -2904,15 +3918,26 @@ func convertToStoreDeployment(deployment *v1pb.ScheduleDeployment) (*store.Deplo
 		return nil, err
 	}
 
-	return &store.Deployment{
+	d := &store.Deployment{
 		Name: deployment.Title,
 		Spec: spec,
-	}, nil
+	}
+	if c := deployment.Canary; c != nil {
+		d.Canary = &store.DeploymentCanary{
+			Percentage:           c.Percentage,
+			Databases:            c.Databases,
+			BakeSeconds:          int64(c.BakeDuration.AsDuration().Seconds()),
+			MaxFailurePercentage: c.MaxFailurePercentage,
+			PostCheckStatements:  c.PostCheckStatements,
+		}
+	}
+	return d, nil
 }
 
 func convertToSpec(spec *store.DeploymentSpec) *v1pb.DeploymentSpec {
 	return &v1pb.DeploymentSpec{
 		LabelSelector: convertToLabelSelector(spec.Selector),
+		Expression:    spec.Expression,
 	}
 }
 
@@ -2922,11 +3947,15 @@ func convertToStoreSpec(spec *v1pb.DeploymentSpec) (*store.DeploymentSpec, error
 		return nil, err
 	}
 	return &store.DeploymentSpec{
-		Selector: selector,
+		Selector:   selector,
+		Expression: spec.Expression,
 	}, nil
 }
 
 func convertToLabelSelector(selector *store.LabelSelector) *v1pb.LabelSelector {
+	if selector == nil {
+		return nil
+	}
 	var exprs []*v1pb.LabelSelectorRequirement
 	for _, expr := range selector.MatchExpressions {
 		exprs = append(exprs, convertToLabelSelectorRequirement(expr))
@@ -2938,6 +3967,9 @@ func convertToLabelSelector(selector *store.LabelSelector) *v1pb.LabelSelector {
 }
 
 func convertToStoreLabelSelector(selector *v1pb.LabelSelector) (*store.LabelSelector, error) {
+	if selector == nil {
+		return nil, nil
+	}
 	var exprs []*store.LabelSelectorRequirement
 	for _, expr := range selector.MatchExpressions {
 		requirement, err := convertToStoreLabelSelectorRequirement(expr)
@@ -3078,22 +4110,113 @@ func isProjectMember(userID int, policy *store.IAMPolicyMessage) bool {
 	return false
 }
 
+// getRepositoryPayloadPatch returns the payload in the patch, which is cloned from the repository payload for the first time.
+func getRepositoryPayloadPatch(repo *store.RepositoryMessage, patch *store.PatchRepositoryMessage) (*storepb.RepositoryPayload, error) {
+	if patch.Payload != nil {
+		return patch.Payload, nil
+	}
+	payload, ok := proto.Clone(repo.Payload).(*storepb.RepositoryPayload)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "failed to clone repository payload")
+	}
+	patch.Payload = payload
+	return payload, nil
+}
+
 func convertToProjectGitOpsInfo(repository *store.RepositoryMessage) *v1pb.ProjectGitOpsInfo {
 	return &v1pb.ProjectGitOpsInfo{
-		Name:               fmt.Sprintf("%s%s/gitOpsInfo", common.ProjectNamePrefix, repository.ProjectResourceID),
-		VcsUid:             fmt.Sprintf("%d", repository.VCSUID),
-		Title:              repository.Title,
-		FullPath:           repository.FullPath,
-		WebUrl:             repository.WebURL,
-		BranchFilter:       repository.BranchFilter,
-		BaseDirectory:      repository.BaseDirectory,
-		FilePathTemplate:   repository.FilePathTemplate,
-		SchemaPathTemplate: repository.SchemaPathTemplate,
-		SheetPathTemplate:  repository.SheetPathTemplate,
-		EnableSqlReviewCi:  repository.EnableSQLReviewCI,
-		WebhookEndpointId:  repository.WebhookEndpointID,
-		ExternalId:         repository.ExternalID,
+		Name:                    fmt.Sprintf("%s%s/gitOpsInfo", common.ProjectNamePrefix, repository.ProjectResourceID),
+		VcsUid:                  fmt.Sprintf("%d", repository.VCSUID),
+		Title:                   repository.Title,
+		FullPath:                repository.FullPath,
+		WebUrl:                  repository.WebURL,
+		BranchFilter:            repository.BranchFilter,
+		BaseDirectory:           repository.BaseDirectory,
+		FilePathTemplate:        repository.FilePathTemplate,
+		SchemaPathTemplate:      repository.SchemaPathTemplate,
+		SheetPathTemplate:       repository.SheetPathTemplate,
+		EnableSqlReviewCi:       repository.EnableSQLReviewCI,
+		EnableSqlReviewCheckRun: repository.EnableSQLReviewCheckRun,
+		WebhookEndpointId:       repository.WebhookEndpointID,
+		ExternalId:              repository.ExternalID,
+		RoutingRules:            convertToRoutingRules(repository.Payload.GetRoutingRules()),
+		EnableDryRun:            repository.Payload.GetEnableDryRun(),
+	}
+}
+
+func convertToRoutingRules(routingRules []*storepb.RepositoryRoutingRule) []*v1pb.ProjectGitOpsInfo_RoutingRule {
+	var result []*v1pb.ProjectGitOpsInfo_RoutingRule
+	for _, rule := range routingRules {
+		r := &v1pb.ProjectGitOpsInfo_RoutingRule{
+			PathGlob:       rule.PathGlob,
+			Project:        fmt.Sprintf("%s%s", common.ProjectNamePrefix, rule.Project),
+			VersionPattern: rule.VersionPattern,
+		}
+		if rule.DatabaseGroup != "" {
+			r.DatabaseGroup = fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, rule.Project, common.DatabaseGroupNamePrefix, rule.DatabaseGroup)
+		}
+		result = append(result, r)
+	}
+	return result
+}
+
+// convertToStoreRoutingRules validates and converts the routing rules.
+// The caller must be able to update the projects receiving the changes, because the repository creates the issues in them.
+func (s *ProjectService) convertToStoreRoutingRules(ctx context.Context, routingRules []*v1pb.ProjectGitOpsInfo_RoutingRule) ([]*storepb.RepositoryRoutingRule, error) {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	var result []*storepb.RepositoryRoutingRule
+	for i, rule := range routingRules {
+		projectID, err := common.GetProjectID(rule.Project)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid project of routing rule %d: %v", i, err)
+		}
+		project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get project %q: %v", projectID, err)
+		}
+		if project == nil || project.Deleted {
+			return nil, status.Errorf(codes.NotFound, "project %q of routing rule %d not found", projectID, i)
+		}
+		ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionProjectsUpdate, user, project.ResourceID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permission for project %q: %v", project.ResourceID, err)
+		}
+		if !ok {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied to route changes to project %q", project.ResourceID)
+		}
+
+		storeRule := &storepb.RepositoryRoutingRule{
+			PathGlob:       rule.PathGlob,
+			Project:        project.ResourceID,
+			VersionPattern: rule.VersionPattern,
+		}
+		if rule.DatabaseGroup != "" {
+			groupProjectID, databaseGroupID, err := common.GetProjectIDDatabaseGroupID(rule.DatabaseGroup)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid database group of routing rule %d: %v", i, err)
+			}
+			if groupProjectID != project.ResourceID {
+				return nil, status.Errorf(codes.InvalidArgument, "database group %q of routing rule %d must belong to project %q", rule.DatabaseGroup, i, project.ResourceID)
+			}
+			databaseGroup, err := s.store.GetDatabaseGroup(ctx, &store.FindDatabaseGroupMessage{ProjectUID: &project.UID, ResourceID: &databaseGroupID})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get database group %q: %v", rule.DatabaseGroup, err)
+			}
+			if databaseGroup == nil {
+				return nil, status.Errorf(codes.NotFound, "database group %q of routing rule %d not found", rule.DatabaseGroup, i)
+			}
+			storeRule.DatabaseGroup = databaseGroup.ResourceID
+		}
+		omitDatabaseName := storeRule.DatabaseGroup != "" || project.TenantMode == api.TenantModeTenant
+		if err := api.ValidateRepositoryRoutingRule(rule.PathGlob, rule.VersionPattern, omitDatabaseName); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid routing rule %d: %v", i, err)
+		}
+		result = append(result, storeRule)
 	}
+	return result, nil
 }
 
 func isBranchNotFound(
@@ -3188,6 +4311,16 @@ func createVCSWebhook(ctx context.Context, vcsType vcsplugin.Type, webhookEndpoi
 		if err != nil {
 			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
 		}
+	case vcsplugin.Gerrit:
+		// The Gerrit webhooks plugin doesn't sign the payload, so the secret token is passed in the URL.
+		webhookPost := gerrit.WebhookCreate{
+			URL:    fmt.Sprintf("%s/hook/gerrit/%s?token=%s", gitopsWebhookURL, webhookEndpointID, url.QueryEscape(secretToken)),
+			Events: []string{gerrit.EventChangeMerged, gerrit.EventPatchSetCreated},
+		}
+		webhookCreatePayload, err = json.Marshal(webhookPost)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
+		}
 	}
 	webhookID, err := vcsplugin.Get(vcsType, vcsplugin.ProviderConfig{}).CreateWebhook(
 		ctx,
@@ -0,0 +1,174 @@
+package v1
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/metric"
+	metricplugin "github.com/bytebase/bytebase/backend/plugin/metric"
+	"github.com/bytebase/bytebase/backend/runner/metricreport"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// RateLimitLimitHeader is the header for the number of requests allowed per minute.
+	RateLimitLimitHeader = "x-ratelimit-limit"
+	// RateLimitRemainingHeader is the header for the number of requests remaining in the current window.
+	RateLimitRemainingHeader = "x-ratelimit-remaining"
+	// RateLimitRetryAfterHeader is the header for the seconds to wait before retrying a rejected request.
+	RateLimitRetryAfterHeader = "retry-after"
+)
+
+// rateLimitedMethods are the expensive methods guarded by the rate limit.
+var rateLimitedMethods = map[string]bool{
+	v1pb.SQLService_Query_FullMethodName:                  true,
+	v1pb.SQLService_Export_FullMethodName:                 true,
+	v1pb.SQLService_AdminExecute_FullMethodName:           true,
+	v1pb.DatabaseService_SyncDatabase_FullMethodName:      true,
+	v1pb.InstanceService_SyncInstance_FullMethodName:      true,
+	v1pb.InstanceService_BatchSyncInstance_FullMethodName: true,
+}
+
+// RateLimitInterceptor is the v1 rate limit interceptor for gRPC server.
+type RateLimitInterceptor struct {
+	store          *store.Store
+	metricReporter *metricreport.Reporter
+
+	mu sync.Mutex
+	// limiters is the map from principal email to its limiter.
+	limiters map[string]*principalLimiter
+}
+
+type principalLimiter struct {
+	requestsPerMinute int32
+	burst             int32
+	limiter           *rate.Limiter
+}
+
+// NewRateLimitInterceptor returns a new v1 API rate limit interceptor.
+func NewRateLimitInterceptor(store *store.Store, metricReporter *metricreport.Reporter) *RateLimitInterceptor {
+	return &RateLimitInterceptor{
+		store:          store,
+		metricReporter: metricReporter,
+		limiters:       make(map[string]*principalLimiter),
+	}
+}
+
+// RateLimitInterceptor is the unary interceptor for gRPC API.
+func (in *RateLimitInterceptor) RateLimitInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	headers, err := in.rateLimitInterceptorDo(ctx, serverInfo.FullMethod)
+	if headers != nil {
+		// Setting headers is best effort, the request is served even if it fails.
+		_ = grpc.SetHeader(ctx, headers)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, request)
+}
+
+// RateLimitStreamInterceptor is the stream interceptor for gRPC API.
+func (in *RateLimitInterceptor) RateLimitStreamInterceptor(request any, ss grpc.ServerStream, serverInfo *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	headers, err := in.rateLimitInterceptorDo(ss.Context(), serverInfo.FullMethod)
+	if headers != nil {
+		_ = ss.SetHeader(headers)
+	}
+	if err != nil {
+		return err
+	}
+	return handler(request, ss)
+}
+
+func (in *RateLimitInterceptor) rateLimitInterceptorDo(ctx context.Context, fullMethod string) (metadata.MD, error) {
+	if !rateLimitedMethods[fullMethod] {
+		return nil, nil
+	}
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok || user == nil {
+		return nil, nil
+	}
+	setting, err := in.store.GetRateLimitSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rate limit setting with error: %v", err)
+	}
+	quota := getRateLimitQuota(setting, user)
+	if quota.GetRequestsPerMinute() <= 0 {
+		return nil, nil
+	}
+
+	limiter := in.getLimiter(user.Email, quota)
+	now := time.Now()
+	reservation := limiter.ReserveN(now, 1)
+	headers := metadata.Pairs(RateLimitLimitHeader, strconv.Itoa(int(quota.RequestsPerMinute)))
+	if !reservation.OK() {
+		return headers, status.Errorf(codes.ResourceExhausted, "rate limit exceeded for method %q", fullMethod)
+	}
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		// Give the token back since the request is rejected rather than delayed.
+		reservation.CancelAt(now)
+		headers.Set(RateLimitRemainingHeader, "0")
+		headers.Set(RateLimitRetryAfterHeader, strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+		in.metricReporter.Report(ctx, &metricplugin.Metric{
+			Name:  metric.APIRateLimitedMetricName,
+			Value: 1,
+			Labels: map[string]any{
+				"method": fullMethod,
+			},
+		})
+		return headers, status.Errorf(codes.ResourceExhausted, "rate limit of %d requests per minute exceeded for method %q, retry after %v", quota.RequestsPerMinute, fullMethod, delay.Round(time.Second))
+	}
+	remaining := int(math.Floor(limiter.TokensAt(now)))
+	if remaining < 0 {
+		remaining = 0
+	}
+	headers.Set(RateLimitRemainingHeader, strconv.Itoa(remaining))
+	return headers, nil
+}
+
+// getLimiter returns the limiter of the principal, the limiter is rebuilt if the quota has changed.
+func (in *RateLimitInterceptor) getLimiter(email string, quota *storepb.RateLimitSetting_Quota) *rate.Limiter {
+	burst := quota.Burst
+	if burst <= 0 {
+		burst = quota.RequestsPerMinute
+	}
+
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	if l, ok := in.limiters[email]; ok && l.requestsPerMinute == quota.RequestsPerMinute && l.burst == burst {
+		return l.limiter
+	}
+	limiter := rate.NewLimiter(rate.Limit(float64(quota.RequestsPerMinute)/time.Minute.Seconds()), int(burst))
+	in.limiters[email] = &principalLimiter{
+		requestsPerMinute: quota.RequestsPerMinute,
+		burst:             burst,
+		limiter:           limiter,
+	}
+	return limiter
+}
+
+// getRateLimitQuota returns the quota of the user.
+// The per-principal override takes precedence over the service account and user quota.
+func getRateLimitQuota(setting *storepb.RateLimitSetting, user *store.UserMessage) *storepb.RateLimitSetting_Quota {
+	for _, override := range setting.GetOverrides() {
+		if override.Principal == user.Email {
+			return override.GetQuota()
+		}
+	}
+	if user.Type == api.ServiceAccount {
+		return setting.GetServiceAccountQuota()
+	}
+	return setting.GetUserQuota()
+}
@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetRateLimitQuota(t *testing.T) {
+	a := require.New(t)
+	setting := &storepb.RateLimitSetting{
+		UserQuota:           &storepb.RateLimitSetting_Quota{RequestsPerMinute: 10},
+		ServiceAccountQuota: &storepb.RateLimitSetting_Quota{RequestsPerMinute: 100},
+		Overrides: []*storepb.RateLimitSetting_Override{
+			{
+				Principal: "ci@service.bytebase.com",
+				Quota:     &storepb.RateLimitSetting_Quota{RequestsPerMinute: 1000},
+			},
+		},
+	}
+
+	tests := []struct {
+		user *store.UserMessage
+		want int32
+	}{
+		{
+			user: &store.UserMessage{Email: "alice@bytebase.com", Type: api.EndUser},
+			want: 10,
+		},
+		{
+			user: &store.UserMessage{Email: "bot@service.bytebase.com", Type: api.ServiceAccount},
+			want: 100,
+		},
+		{
+			user: &store.UserMessage{Email: "ci@service.bytebase.com", Type: api.ServiceAccount},
+			want: 1000,
+		},
+	}
+	for _, test := range tests {
+		got := getRateLimitQuota(setting, test.user)
+		a.Equal(test.want, got.GetRequestsPerMinute(), test.user.Email)
+	}
+
+	// Empty setting means unlimited.
+	a.Equal(int32(0), getRateLimitQuota(&storepb.RateLimitSetting{}, tests[0].user).GetRequestsPerMinute())
+}
+
+func TestRateLimitInterceptorGetLimiter(t *testing.T) {
+	a := require.New(t)
+	in := NewRateLimitInterceptor(nil, nil)
+
+	quota := &storepb.RateLimitSetting_Quota{RequestsPerMinute: 60}
+	limiter := in.getLimiter("alice@bytebase.com", quota)
+	a.Equal(60, limiter.Burst())
+	a.Same(limiter, in.getLimiter("alice@bytebase.com", quota))
+
+	// The limiter is rebuilt once the quota changes.
+	updated := in.getLimiter("alice@bytebase.com", &storepb.RateLimitSetting_Quota{RequestsPerMinute: 60, Burst: 5})
+	a.NotSame(limiter, updated)
+	a.Equal(5, updated.Burst())
+}
@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,6 +14,7 @@ import (
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
@@ -54,10 +56,15 @@ func (s *RoleService) CreateRole(ctx context.Context, request *v1pb.CreateRoleRe
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "principal ID not found")
 	}
+	permissions, err := s.convertToStoreRolePermissions(request.Role.Permissions)
+	if err != nil {
+		return nil, err
+	}
 	create := &store.RoleMessage{
 		ResourceID:  request.RoleId,
 		Name:        request.Role.Title,
 		Description: request.Role.Description,
+		Permissions: permissions,
 	}
 	roleMessage, err := s.store.CreateRole(ctx, create, principalID)
 	if err != nil {
@@ -99,6 +106,12 @@ func (s *RoleService) UpdateRole(ctx context.Context, request *v1pb.UpdateRoleRe
 			patch.Name = &request.Role.Title
 		case "description":
 			patch.Description = &request.Role.Description
+		case "permissions":
+			permissions, err := s.convertToStoreRolePermissions(request.Role.Permissions)
+			if err != nil {
+				return nil, err
+			}
+			patch.Permissions = permissions
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "invalid update mask path: %s", path)
 		}
@@ -131,6 +144,13 @@ func (s *RoleService) DeleteRole(ctx context.Context, request *v1pb.DeleteRoleRe
 	if has {
 		return nil, status.Errorf(codes.FailedPrecondition, "cannot delete because role %s is used in project %s", convertToRoleName(roleID), fmt.Sprintf("%s%s", common.ProjectNamePrefix, project))
 	}
+	has, email, err := s.store.GetUserUsingRole(ctx, api.Role(roleID))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check if the role is used: %v", err)
+	}
+	if has {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot delete because role %s is granted to user %s in the workspace", convertToRoleName(roleID), common.FormatUserEmail(email))
+	}
 	if err := s.store.DeleteRole(ctx, roleID); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete role: %v", err)
 	}
@@ -151,6 +171,9 @@ func convertToRole(iamManager *iam.Manager, role *store.RoleMessage) *v1pb.Role
 	for _, permission := range iamManager.GetPermissions(name) {
 		permissions = append(permissions, string(permission))
 	}
+	if role.Permissions != nil {
+		permissions = append(permissions, role.Permissions.Permissions...)
+	}
 	return &v1pb.Role{
 		Name:        name,
 		Title:       role.Name,
@@ -159,6 +182,21 @@ func convertToRole(iamManager *iam.Manager, role *store.RoleMessage) *v1pb.Role
 	}
 }
 
+// convertToStoreRolePermissions validates the permissions composing the custom role.
+func (s *RoleService) convertToStoreRolePermissions(permissions []string) (*storepb.RolePermissions, error) {
+	rolePermissions := &storepb.RolePermissions{}
+	for _, permission := range permissions {
+		if !s.iamManager.PermissionExist(iam.Permission(permission)) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid permission %q", permission)
+		}
+		if slices.Contains(rolePermissions.Permissions, permission) {
+			continue
+		}
+		rolePermissions.Permissions = append(rolePermissions.Permissions, permission)
+	}
+	return rolePermissions, nil
+}
+
 func convertToRoleName(role string) string {
 	return fmt.Sprintf("%s%s", common.RolePrefix, role)
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -16,8 +17,10 @@ import (
 	"google.golang.org/protobuf/testing/protocmp"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/i18n"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/activity"
+	"github.com/bytebase/bytebase/backend/component/artifact"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/ghost"
@@ -32,6 +35,14 @@ import (
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
+const (
+	// taskRunLogEntriesPageSize is the default and maximum number of task run log entries returned at a time.
+	taskRunLogEntriesPageSize = 1000
+	// taskRunLogEntriesMaxWait is the maximum duration to long-poll for new task run log entries.
+	taskRunLogEntriesMaxWait      = 30 * time.Second
+	taskRunLogEntriesPollInterval = time.Second
+)
+
 // RolloutService represents a service for managing rollout.
 type RolloutService struct {
 	v1pb.UnimplementedRolloutServiceServer
@@ -43,10 +54,11 @@ type RolloutService struct {
 	activityManager    *activity.Manager
 	profile            *config.Profile
 	iamManager         *iam.Manager
+	artifactManager    *artifact.Manager
 }
 
 // NewRolloutService returns a rollout service instance.
-func NewRolloutService(store *store.Store, licenseService enterprise.LicenseService, dbFactory *dbfactory.DBFactory, planCheckScheduler *plancheck.Scheduler, stateCfg *state.State, activityManager *activity.Manager, profile *config.Profile, iamManager *iam.Manager) *RolloutService {
+func NewRolloutService(store *store.Store, licenseService enterprise.LicenseService, dbFactory *dbfactory.DBFactory, planCheckScheduler *plancheck.Scheduler, stateCfg *state.State, activityManager *activity.Manager, profile *config.Profile, iamManager *iam.Manager, artifactManager *artifact.Manager) *RolloutService {
 	return &RolloutService{
 		store:              store,
 		licenseService:     licenseService,
@@ -56,6 +68,7 @@ func NewRolloutService(store *store.Store, licenseService enterprise.LicenseServ
 		activityManager:    activityManager,
 		profile:            profile,
 		iamManager:         iamManager,
+		artifactManager:    artifactManager,
 	}
 }
 
@@ -197,7 +210,7 @@ func (s *RolloutService) CreatePlan(ctx context.Context, request *v1pb.CreatePla
 	}
 
 	// Tickle plan check scheduler.
-	s.stateCfg.PlanCheckTickleChan <- 0
+	s.stateCfg.TicklePlanCheck()
 
 	return convertToPlan(plan), nil
 }
@@ -238,6 +251,122 @@ func (s *RolloutService) PreviewRollout(ctx context.Context, request *v1pb.Previ
 	return rolloutV1, nil
 }
 
+// PreviewPlan previews the rollout of the plan with the stage policies and the required approval.
+func (s *RolloutService) PreviewPlan(ctx context.Context, request *v1pb.PreviewPlanRequest) (*v1pb.PlanPreview, error) {
+	projectID, err := common.GetProjectID(request.Project)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project, error: %v", err)
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+	}
+	if request.Plan == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "plan must be set")
+	}
+
+	if err := validateSteps(request.Plan.Steps); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to validate plan steps, error: %v", err)
+	}
+	steps := convertPlanSteps(request.Plan.Steps)
+
+	pipelineCreate, err := GetPipelineCreate(ctx, s.store, s.licenseService, s.dbFactory, steps, project)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to get pipeline create, error: %v", err)
+	}
+	if len(pipelineCreate.Stages) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "plan has no stage created")
+	}
+	rollout, err := convertToRollout(ctx, s.store, project, pipelineCreate)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert to rollout, error: %v", err)
+	}
+	preview := &v1pb.PlanPreview{
+		Rollout: rollout,
+	}
+
+	seenEnvironments := map[int]bool{}
+	for _, stage := range pipelineCreate.Stages {
+		if seenEnvironments[stage.EnvironmentID] {
+			continue
+		}
+		seenEnvironments[stage.EnvironmentID] = true
+		stagePolicy, err := s.getStagePolicy(ctx, stage.EnvironmentID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get policies for environment %d, error: %v", stage.EnvironmentID, err)
+		}
+		preview.StagePolicies = append(preview.StagePolicies, stagePolicy)
+	}
+
+	risks, err := s.store.ListRisks(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list risks, error: %v", err)
+	}
+	riskSource := utils.GetPipelineRiskSource(pipelineCreate)
+	var riskLevel int32
+	if riskSource != store.RiskSourceUnknown {
+		// No plan check runs yet, the risk is evaluated with the unknown statement types and the unlimited affected rows.
+		riskLevel, err = utils.GetPipelineRiskLevel(ctx, s.store, project.ResourceID, pipelineCreate, riskSource, risks, nil /* planCheckRuns */)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get risk level, error: %v", err)
+		}
+	}
+	preview.RiskSource = convertToSource(riskSource)
+	preview.RiskLevel = riskLevel
+
+	if s.licenseService.IsFeatureEnabled(api.FeatureCustomApproval) == nil {
+		approvalSetting, err := s.store.GetWorkspaceApprovalSetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get workspace approval setting, error: %v", err)
+		}
+		approvalTemplate, err := utils.GetApprovalTemplate(approvalSetting, riskLevel, riskSource)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get approval template, error: %v", err)
+		}
+		if approvalTemplate != nil {
+			preview.ApprovalTemplate = convertToApprovalTemplate(approvalTemplate)
+		}
+	}
+	return preview, nil
+}
+
+func (s *RolloutService) getStagePolicy(ctx context.Context, environmentID int) (*v1pb.PlanPreview_StagePolicy, error) {
+	environment, err := s.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{UID: &environmentID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get environment")
+	}
+	if environment == nil {
+		return nil, errors.Errorf("environment %d not found", environmentID)
+	}
+	rolloutPolicy, err := s.store.GetRolloutPolicy(ctx, environmentID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get rollout policy")
+	}
+	affectedRowsLimitPolicy, err := s.store.GetAffectedRowsLimitPolicy(ctx, environmentID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get affected rows limit policy")
+	}
+	stagePolicy := &v1pb.PlanPreview_StagePolicy{
+		Environment:     fmt.Sprintf("%s%s", common.EnvironmentNamePrefix, environment.ResourceID),
+		RolloutPolicy:   convertToV1PBRolloutPolicy(rolloutPolicy),
+		MaxAffectedRows: affectedRowsLimitPolicy.MaxAffectedRows,
+	}
+	sqlReviewPolicy, err := s.store.GetSQLReviewPolicy(ctx, environmentID)
+	if err != nil {
+		if e, ok := err.(*common.Error); !ok || e.Code != common.NotFound {
+			return nil, errors.Wrapf(err, "failed to get SQL review policy")
+		}
+	} else {
+		stagePolicy.SqlReview = sqlReviewPolicy.Name
+	}
+	return stagePolicy, nil
+}
+
 // GetRollout gets a rollout.
 func (s *RolloutService) GetRollout(ctx context.Context, request *v1pb.GetRolloutRequest) (*v1pb.Rollout, error) {
 	projectID, rolloutID, err := common.GetProjectIDRolloutID(request.Name)
@@ -267,6 +396,9 @@ func (s *RolloutService) GetRollout(ctx context.Context, request *v1pb.GetRollou
 
 // CreateRollout creates a rollout from plan.
 func (s *RolloutService) CreateRollout(ctx context.Context, request *v1pb.CreateRolloutRequest) (*v1pb.Rollout, error) {
+	if err := checkMaintenance(ctx, s.store); err != nil {
+		return nil, err
+	}
 	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "principal ID not found")
@@ -392,7 +524,7 @@ func (s *RolloutService) RunPlanChecks(ctx context.Context, request *v1pb.RunPla
 	}
 
 	// Tickle plan check scheduler.
-	s.stateCfg.PlanCheckTickleChan <- 0
+	s.stateCfg.TicklePlanCheck()
 
 	return &v1pb.RunPlanChecksResponse{}, nil
 }
@@ -414,23 +546,56 @@ func (s *RolloutService) ListTaskRuns(ctx context.Context, request *v1pb.ListTas
 		return nil, status.Errorf(codes.NotFound, "project %v not found", projectID)
 	}
 
-	taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
+	if request.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "page size must be non-negative: %d", request.PageSize)
+	}
+	taskRunFind := &store.FindTaskRunMessage{
 		PipelineUID: &rolloutID,
 		StageUID:    maybeStageID,
 		TaskUID:     maybeTaskID,
-	})
+	}
+	// All task runs are returned if the page size is unspecified.
+	limit := int(request.PageSize)
+	if request.PageToken != "" {
+		var pageToken storepb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		taskRunFind.After = getKeysetCursor(&pageToken)
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	if limit > 0 {
+		limitPlusOne := limit + 1
+		taskRunFind.Limit = &limitPlusOne
+	}
+
+	taskRuns, err := s.store.ListTaskRunsV2(ctx, taskRunFind)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list task runs, error: %v", err)
 	}
 
+	nextPageToken := ""
+	if limit > 0 && len(taskRuns) == limit+1 {
+		taskRuns = taskRuns[:limit]
+		last := taskRuns[limit-1]
+		if nextPageToken, err = getKeysetPageToken(limit, last.CreatedTs, last.ID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
 	return &v1pb.ListTaskRunsResponse{
-		TaskRuns:      convertToTaskRuns(s.stateCfg, taskRuns),
-		NextPageToken: "",
+		TaskRuns:      convertToTaskRuns(s.stateCfg, taskRuns, i18n.GetLocale(ctx)),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
 // BatchRunTasks runs tasks in batch.
 func (s *RolloutService) BatchRunTasks(ctx context.Context, request *v1pb.BatchRunTasksRequest) (*v1pb.BatchRunTasksResponse, error) {
+	if err := checkMaintenance(ctx, s.store); err != nil {
+		return nil, err
+	}
 	if len(request.Tasks) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "The tasks in request cannot be empty")
 	}
@@ -536,12 +701,49 @@ func (s *RolloutService) BatchRunTasks(ctx context.Context, request *v1pb.BatchR
 		return nil, status.Errorf(codes.FailedPrecondition, "cannot run the tasks because the issue is not approved")
 	}
 
+	if canaryStage := utils.GetUnpromotedCanaryStage(stages, stageToRun.ID); canaryStage != nil && canaryStage.Payload.GetCanary().GetStatus() == storepb.StageCanary_HALTED {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot run the tasks because the canary stage %q is halted: %s", canaryStage.Name, canaryStage.Payload.GetCanary().GetHaltReason())
+	}
+
+	// Running the tasks outside the rollout window or during the rollout freezes is an emergency override.
+	if request.RunNow && !(user.Role == api.WorkspaceAdmin || user.Role == api.WorkspaceDBA) {
+		ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionTasksRunNow, user, project.ResourceID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permission, error: %v", err)
+		}
+		if !ok {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied, user does not have permission %q", iam.PermissionTasksRunNow)
+		}
+	}
+
 	var taskRunCreates []*store.TaskRunMessage
 	var tasksToRun []*store.TaskMessage
+	var runNowTaskIDs []int
 	for _, task := range stageToRunTasks {
 		if !taskIDsToRunMap[task.ID] {
 			continue
 		}
+		if request.RunNow {
+			runNowTaskIDs = append(runNowTaskIDs, task.ID)
+			if task.LatestTaskRunStatus == api.TaskRunPending {
+				// The task run is queued for the rollout window, it only needs to run now.
+				continue
+			}
+		}
+		freeze, err := utils.GetTaskDatabaseFreeze(ctx, s.store, task)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get the database freeze of task %q, error: %v", task.Name, err)
+		}
+		if freeze != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot run task %q because the database is frozen: %s", task.Name, freeze.Reason)
+		}
+		exceeded, err := utils.GetTaskAffectedRowsExceededResult(ctx, s.store, task)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check the affected rows of task %q, error: %v", task.Name, err)
+		}
+		if exceeded != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot run task %q: %s", task.Name, exceeded.Content)
+		}
 		tasksToRun = append(tasksToRun, task)
 		create := &store.TaskRunMessage{
 			TaskUID:   task.ID,
@@ -554,12 +756,19 @@ func (s *RolloutService) BatchRunTasks(ctx context.Context, request *v1pb.BatchR
 		return taskRunCreates[i].TaskUID < taskRunCreates[j].TaskUID
 	})
 
+	if len(runNowTaskIDs) > 0 {
+		if err := s.store.BatchRunTasksNow(ctx, runNowTaskIDs, user.ID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to run tasks now, error %v", err)
+		}
+	}
 	if err := s.store.CreatePendingTaskRuns(ctx, taskRunCreates...); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create pending task runs, error %v", err)
 	}
 
-	if err := s.activityManager.BatchCreateActivitiesForRunTasks(ctx, tasksToRun, issue, request.Reason, user.ID); err != nil {
-		slog.Error("failed to batch create activities for running tasks", log.BBError(err))
+	if len(tasksToRun) > 0 {
+		if err := s.activityManager.BatchCreateActivitiesForRunTasks(ctx, tasksToRun, issue, request.Reason, user.ID); err != nil {
+			slog.Error("failed to batch create activities for running tasks", log.BBError(err))
+		}
 	}
 
 	// Tickle task run scheduler.
@@ -568,6 +777,176 @@ func (s *RolloutService) BatchRunTasks(ctx context.Context, request *v1pb.BatchR
 	return &v1pb.BatchRunTasksResponse{}, nil
 }
 
+// CutoverTask cuts over the gh-ost migration of a postponed cutover task now.
+func (s *RolloutService) CutoverTask(ctx context.Context, request *v1pb.CutoverTaskRequest) (*v1pb.CutoverTaskResponse, error) {
+	if err := checkMaintenance(ctx, s.store); err != nil {
+		return nil, err
+	}
+	projectID, rolloutID, stageID, taskID, err := common.GetProjectIDRolloutIDStageIDTaskID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	task, err := s.store.GetTaskV2ByID(ctx, taskID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get task, error: %v", err)
+	}
+	if task == nil || task.PipelineID != rolloutID || task.StageID != stageID {
+		return nil, status.Errorf(codes.NotFound, "task %q not found", request.Name)
+	}
+	if task.Type != api.TaskDatabaseSchemaUpdateGhostCutover {
+		return nil, status.Errorf(codes.InvalidArgument, "task %q is not a gh-ost cutover task", request.Name)
+	}
+	if len(task.BlockedBy) != 1 {
+		return nil, status.Errorf(codes.Internal, "failed to find the gh-ost sync task for task %q", request.Name)
+	}
+	if _, ok := s.stateCfg.GhostTaskState.Load(task.BlockedBy[0]); !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "the gh-ost sync for task %q is not done", request.Name)
+	}
+
+	if _, err := s.BatchRunTasks(ctx, &v1pb.BatchRunTasksRequest{
+		Parent: fmt.Sprintf("%s%s/%s%d/%s%d", common.ProjectNamePrefix, projectID, common.RolloutPrefix, rolloutID, common.StagePrefix, stageID),
+		Tasks:  []string{request.Name},
+	}); err != nil {
+		return nil, err
+	}
+	return &v1pb.CutoverTaskResponse{}, nil
+}
+
+// ListTaskRunArtifacts lists the artifacts of a task run.
+func (s *RolloutService) ListTaskRunArtifacts(ctx context.Context, request *v1pb.ListTaskRunArtifactsRequest) (*v1pb.ListTaskRunArtifactsResponse, error) {
+	taskRun, err := s.getTaskRun(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	artifacts, err := s.store.ListTaskRunArtifacts(ctx, &store.FindTaskRunArtifactMessage{TaskRunUID: &taskRun.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list task run artifacts, error: %v", err)
+	}
+	response := &v1pb.ListTaskRunArtifactsResponse{}
+	for _, artifact := range artifacts {
+		response.Artifacts = append(response.Artifacts, convertToTaskRunArtifact(request.Parent, artifact))
+	}
+	return response, nil
+}
+
+// GetTaskRunArtifact gets the artifact of a task run with its content.
+func (s *RolloutService) GetTaskRunArtifact(ctx context.Context, request *v1pb.GetTaskRunArtifactRequest) (*v1pb.TaskRunArtifact, error) {
+	_, taskRunID, artifactID, err := common.GetProjectIDTaskRunIDArtifactID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	parent := request.Name[:strings.LastIndex(request.Name, "/"+common.TaskRunArtifactPrefix)]
+	taskRun, err := s.getTaskRun(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	artifact, err := s.store.GetTaskRunArtifact(ctx, &store.FindTaskRunArtifactMessage{ID: &artifactID, TaskRunUID: &taskRun.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get task run artifact, error: %v", err)
+	}
+	if artifact == nil || taskRun.ID != taskRunID {
+		return nil, status.Errorf(codes.NotFound, "artifact %q not found", request.Name)
+	}
+	content, err := s.artifactManager.Load(ctx, artifact)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load task run artifact, error: %v", err)
+	}
+	result := convertToTaskRunArtifact(parent, artifact)
+	result.Content = content
+	return result, nil
+}
+
+// ListTaskRunLogEntries lists the log entries of a task run incrementally.
+// It long-polls for the new entries if there is none yet and the task run is not finished.
+func (s *RolloutService) ListTaskRunLogEntries(ctx context.Context, request *v1pb.ListTaskRunLogEntriesRequest) (*v1pb.ListTaskRunLogEntriesResponse, error) {
+	taskRun, err := s.getTaskRun(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	pageToken := &storepb.PageToken{
+		Limit: request.PageSize,
+	}
+	if request.PageToken != "" {
+		if err := unmarshalPageToken(request.PageToken, pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		if pageToken.Limit != request.PageSize {
+			return nil, status.Errorf(codes.InvalidArgument, "request page size does not match the page token")
+		}
+	}
+	limit := int(pageToken.Limit)
+	if limit <= 0 {
+		limit = taskRunLogEntriesPageSize
+	}
+	limit = min(limit, taskRunLogEntriesPageSize)
+	afterID := pageToken.GetCursor().GetId()
+	deadline := time.Now().Add(min(request.Wait.AsDuration(), taskRunLogEntriesMaxWait))
+
+	for {
+		// Check the status before listing the logs, so that no log is written after the finished task run.
+		taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{UID: &taskRun.ID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get task run, error: %v", err)
+		}
+		if len(taskRuns) == 0 {
+			return nil, status.Errorf(codes.NotFound, "task run %q not found", request.Parent)
+		}
+		finished := slices.Contains([]api.TaskRunStatus{api.TaskRunDone, api.TaskRunFailed, api.TaskRunCanceled}, taskRuns[0].Status)
+
+		taskRunLogs, err := s.store.ListTaskRunLogs(ctx, &store.FindTaskRunLogMessage{
+			TaskRunUID: &taskRun.ID,
+			AfterID:    &afterID,
+			Limit:      &limit,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list task run logs, error: %v", err)
+		}
+		if len(taskRunLogs) > 0 || finished || !time.Now().Before(deadline) {
+			response := &v1pb.ListTaskRunLogEntriesResponse{
+				Finished: finished && len(taskRunLogs) < limit,
+			}
+			createdTs := pageToken.GetCursor().GetCreatedTs()
+			for _, taskRunLog := range taskRunLogs {
+				response.Entries = append(response.Entries, convertToTaskRunLogEntry(taskRunLog))
+				afterID, createdTs = taskRunLog.ID, taskRunLog.CreatedTs
+			}
+			nextPageToken, err := getKeysetPageToken(int(pageToken.Limit), createdTs, int(afterID))
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to marshal next page token, error: %v", err)
+			}
+			response.NextPageToken = nextPageToken
+			return response, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, status.Errorf(codes.Canceled, "context canceled")
+		case <-time.After(taskRunLogEntriesPollInterval):
+		}
+	}
+}
+
+// getTaskRun gets the task run by its resource name.
+func (s *RolloutService) getTaskRun(ctx context.Context, name string) (*store.TaskRunMessage, error) {
+	projectID, rolloutID, stageID, taskID, taskRunID, err := common.GetProjectIDRolloutIDStageIDTaskIDTaskRunID(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
+		UID:         &taskRunID,
+		PipelineUID: &rolloutID,
+		StageUID:    &stageID,
+		TaskUID:     &taskID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get task run, error: %v", err)
+	}
+	if len(taskRuns) == 0 || taskRuns[0].ProjectID != projectID {
+		return nil, status.Errorf(codes.NotFound, "task run %q not found", name)
+	}
+	return taskRuns[0], nil
+}
+
 // BatchSkipTasks skips tasks in batch.
 func (s *RolloutService) BatchSkipTasks(ctx context.Context, request *v1pb.BatchSkipTasksRequest) (*v1pb.BatchSkipTasksResponse, error) {
 	projectID, rolloutID, _, err := common.GetProjectIDRolloutIDMaybeStageID(request.Parent)
@@ -645,6 +1024,173 @@ func (s *RolloutService) BatchSkipTasks(ctx context.Context, request *v1pb.Batch
 
 // BatchCancelTaskRuns cancels a list of task runs.
 // TODO(p0ny): forbid cancel noncancellable task runs.
+// ApproveStage signs off the approval gate of the stage or its tasks with a role of the caller.
+func (s *RolloutService) ApproveStage(ctx context.Context, request *v1pb.ApproveStageRequest) (*v1pb.ApproveStageResponse, error) {
+	if err := checkMaintenance(ctx, s.store); err != nil {
+		return nil, err
+	}
+	projectID, rolloutID, maybeStageID, err := common.GetProjectIDRolloutIDMaybeStageID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if maybeStageID == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid stage name %q", request.Name)
+	}
+	stageID := *maybeStageID
+
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find project, error: %v", err)
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %v not found", projectID)
+	}
+
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &rolloutID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find issue, error: %v", err)
+	}
+	if issue == nil {
+		return nil, status.Errorf(codes.NotFound, "issue not found for rollout %v", rolloutID)
+	}
+
+	stages, err := s.store.ListStageV2(ctx, rolloutID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list stages, error: %v", err)
+	}
+	var stage *store.StageMessage
+	for i := range stages {
+		if stages[i].ID == stageID {
+			stage = stages[i]
+			break
+		}
+	}
+	if stage == nil {
+		return nil, status.Errorf(codes.NotFound, "stage %v not found in rollout %v", stageID, rolloutID)
+	}
+
+	policy, err := s.store.GetRolloutPolicy(ctx, stage.EnvironmentID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rollout policy, error: %v", err)
+	}
+	if !slices.Contains(policy.GetApprovalGateRoles(), request.Role) {
+		return nil, status.Errorf(codes.InvalidArgument, "role %q is not an approval gate role of stage %q", request.Role, stage.Name)
+	}
+
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	user, err := s.store.GetUserByID(ctx, principalID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find user, error: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user %v not found", principalID)
+	}
+	ok, err = userHasApprovalGateRole(ctx, s.store, user, project, request.Role)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check the roles of the user, error: %v", err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "Not allowed to approve as %q", request.Role)
+	}
+
+	var taskIDs []int
+	for _, task := range request.Tasks {
+		_, taskRolloutID, taskStageID, taskID, err := common.GetProjectIDRolloutIDStageIDTaskID(task)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if taskRolloutID != rolloutID || taskStageID != stageID {
+			return nil, status.Errorf(codes.InvalidArgument, "task %q is not in stage %q", task, request.Name)
+		}
+		if !slices.ContainsFunc(stage.TaskList, func(t *store.TaskMessage) bool { return t.ID == taskID }) {
+			return nil, status.Errorf(codes.NotFound, "task %q not found", task)
+		}
+		taskIDs = append(taskIDs, taskID)
+	}
+
+	var creates []*store.StageApprovalMessage
+	if len(taskIDs) == 0 {
+		creates = append(creates, &store.StageApprovalMessage{
+			StageID:   stage.ID,
+			Role:      request.Role,
+			Comment:   request.Comment,
+			CreatorID: principalID,
+		})
+	}
+	for _, taskID := range taskIDs {
+		taskID := taskID
+		creates = append(creates, &store.StageApprovalMessage{
+			StageID:   stage.ID,
+			TaskID:    &taskID,
+			Role:      request.Role,
+			Comment:   request.Comment,
+			CreatorID: principalID,
+		})
+	}
+	if err := s.store.CreateStageApprovals(ctx, creates...); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create stage approvals, error: %v", err)
+	}
+
+	if err := func() error {
+		payload, err := json.Marshal(api.ActivityPipelineStageApprovePayload{
+			StageID:   stage.ID,
+			Role:      request.Role,
+			TaskIDs:   taskIDs,
+			IssueName: issue.Title,
+			StageName: stage.Name,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal payload")
+		}
+		_, err = s.activityManager.CreateActivity(ctx, &store.ActivityMessage{
+			CreatorUID:   principalID,
+			ContainerUID: rolloutID,
+			Type:         api.ActivityPipelineStageApprove,
+			Payload:      string(payload),
+			Comment:      request.Comment,
+			Level:        api.ActivityInfo,
+		}, &activity.Metadata{
+			Issue: issue,
+		})
+		return errors.Wrapf(err, "failed to create activity")
+	}(); err != nil {
+		slog.Error("failed to create stage approve activity", log.BBError(err))
+	}
+
+	// Tickle the scheduler to run the task runs waiting for the approval gate.
+	s.stateCfg.TaskRunTickleChan <- 0
+
+	return &v1pb.ApproveStageResponse{}, nil
+}
+
+// userHasApprovalGateRole returns if the user has the workspace or project role of the approval gate.
+func userHasApprovalGateRole(ctx context.Context, s *store.Store, user *store.UserMessage, project *store.ProjectMessage, role string) (bool, error) {
+	apiRole := api.Role(strings.TrimPrefix(role, common.RolePrefix))
+	if user.Role == apiRole {
+		return true, nil
+	}
+	policy, err := s.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{UID: &project.UID})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get project %d policy", project.UID)
+	}
+	for _, binding := range policy.Bindings {
+		if binding.Role != apiRole {
+			continue
+		}
+		for _, member := range binding.Members {
+			if member.ID == user.ID {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
 func (s *RolloutService) BatchCancelTaskRuns(ctx context.Context, request *v1pb.BatchCancelTaskRunsRequest) (*v1pb.BatchCancelTaskRunsResponse, error) {
 	if len(request.TaskRuns) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "task runs cannot be empty")
@@ -811,6 +1357,17 @@ func (s *RolloutService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePla
 	if len(updated) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "no specs updated")
 	}
+	oldSpecDependencies := map[string][]string{}
+	for _, step := range oldSteps {
+		for _, spec := range step.Specs {
+			oldSpecDependencies[spec.Id] = spec.DependsOnSpecs
+		}
+	}
+	for _, spec := range updated {
+		if !slices.Equal(oldSpecDependencies[spec.Id], spec.DependsOnSpecs) {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot change the dependencies of spec %q", spec.Id)
+		}
+	}
 
 	updatedByID := make(map[string]*v1pb.Plan_Spec)
 	for _, spec := range updated {
@@ -889,10 +1446,12 @@ func (s *RolloutService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePla
 
 			// RollbackEnabled
 			if err := func() error {
-				if task.Type != api.TaskDatabaseDataUpdate {
+				if task.Type != api.TaskDatabaseDataUpdate && task.Type != api.TaskDatabaseSchemaUpdate {
 					return nil
 				}
-				payload := &api.TaskDatabaseDataUpdatePayload{}
+				payload := &struct {
+					RollbackEnabled bool `json:"rollbackEnabled"`
+				}{}
 				if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
 					return status.Errorf(codes.Internal, "failed to unmarshal task payload: %v", err)
 				}
@@ -982,12 +1541,11 @@ func (s *RolloutService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePla
 		}
 	}
 
+	if _, err := s.store.BatchUpdateTasks(ctx, taskPatchList); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update tasks: %v", err)
+	}
 	for _, taskPatch := range taskPatchList {
 		task := tasksMap[taskPatch.ID]
-		if _, err := s.store.UpdateTaskV2(ctx, taskPatch); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to update task %q: %v", task.Name, err)
-		}
-
 		taskPatched, err := s.store.GetTaskV2ByID(ctx, task.ID)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get updated task %q: %v", task.Name, err)
@@ -1148,6 +1706,13 @@ func diffSpecs(oldSteps []*v1pb.Plan_Step, newSteps []*v1pb.Plan_Step) ([]*v1pb.
 func validateSteps(steps []*v1pb.Plan_Step) error {
 	var databaseTarget, databaseGroupTarget, deploymentConfigTarget int
 	for _, step := range steps {
+		specDependencies := map[string][]string{}
+		for _, spec := range step.Specs {
+			specDependencies[spec.Id] = append(specDependencies[spec.Id], spec.DependsOnSpecs...)
+		}
+		if err := validateSpecDependencies(specDependencies); err != nil {
+			return err
+		}
 		for _, spec := range step.Specs {
 			if config := spec.GetChangeDatabaseConfig(); config != nil {
 				if _, _, err := common.GetInstanceDatabaseID(config.Target); err == nil {
@@ -1171,6 +1736,49 @@ func validateSteps(steps []*v1pb.Plan_Step) error {
 	return nil
 }
 
+// validateSpecDependencies validates the specs only depend on the specs in the same step and the dependencies are acyclic.
+// specDependencies maps the spec ID to the IDs of the specs it depends on.
+func validateSpecDependencies(specDependencies map[string][]string) error {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	states := map[string]int{}
+	var visit func(id string) error
+	visit = func(id string) error {
+		switch states[id] {
+		case visiting:
+			return errors.Errorf("spec %q depends on itself", id)
+		case visited:
+			return nil
+		}
+		states[id] = visiting
+		for _, dependency := range specDependencies[id] {
+			if _, ok := specDependencies[dependency]; !ok {
+				return errors.Errorf("spec %q depends on spec %q not in the same step", id, dependency)
+			}
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		states[id] = visited
+		return nil
+	}
+
+	var ids []string
+	for id := range specDependencies {
+		ids = append(ids, id)
+	}
+	// Visit in order so that the error is deterministic.
+	slices.Sort(ids)
+	for _, id := range ids {
+		if err := visit(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetPipelineCreate gets a pipeline create message from a plan.
 func GetPipelineCreate(ctx context.Context, s *store.Store, licenseService enterprise.LicenseService, dbFactory *dbfactory.DBFactory, steps []*storepb.PlanConfig_Step, project *store.ProjectMessage) (*store.PipelineMessage, error) {
 	pipelineCreate := &store.PipelineMessage{
@@ -1178,21 +1786,26 @@ func GetPipelineCreate(ctx context.Context, s *store.Store, licenseService enter
 	}
 
 	transformedSteps := steps
+	var stagePayloads []*storepb.StagePayload
 	if len(steps) == 1 && len(steps[0].Specs) == 1 {
 		spec := steps[0].Specs[0]
 		if config := spec.GetChangeDatabaseConfig(); config != nil {
 			if _, _, err := common.GetProjectIDDeploymentConfigID(config.Target); err == nil {
-				stepsFromDeploymentConfig, err := transformDeploymentConfigTargetToSteps(ctx, s, spec, config, project)
+				stepsFromDeploymentConfig, payloadsFromDeploymentConfig, err := transformDeploymentConfigTargetToSteps(ctx, s, spec, config, project)
 				if err != nil {
 					return nil, errors.Wrap(err, "failed to transform deploymentConfig target to steps")
 				}
 				transformedSteps = stepsFromDeploymentConfig
+				stagePayloads = payloadsFromDeploymentConfig
 			}
 		}
 	}
 
-	for _, step := range transformedSteps {
+	for i, step := range transformedSteps {
 		stageCreate := &store.StageMessage{}
+		if i < len(stagePayloads) && stagePayloads[i] != nil {
+			stageCreate.Payload = stagePayloads[i]
+		}
 
 		var stageEnvironmentID string
 		registerEnvironmentID := func(environmentID string) error {
@@ -1205,6 +1818,8 @@ func GetPipelineCreate(ctx context.Context, s *store.Store, licenseService enter
 			}
 			return nil
 		}
+		// specTaskIndexes maps the spec ID to the indexes of its tasks in the stage.
+		specTaskIndexes := map[string][]int{}
 		for _, spec := range step.Specs {
 			taskCreates, taskIndexDAGCreates, err := getTaskCreatesFromSpec(ctx, s, licenseService, dbFactory, spec, project, registerEnvironmentID)
 			if err != nil {
@@ -1216,9 +1831,25 @@ func GetPipelineCreate(ctx context.Context, s *store.Store, licenseService enter
 				taskIndexDAGCreates[i].FromIndex += offset
 				taskIndexDAGCreates[i].ToIndex += offset
 			}
+			for i := range taskCreates {
+				specTaskIndexes[spec.Id] = append(specTaskIndexes[spec.Id], offset+i)
+			}
 			stageCreate.TaskList = append(stageCreate.TaskList, taskCreates...)
 			stageCreate.TaskIndexDAGList = append(stageCreate.TaskIndexDAGList, taskIndexDAGCreates...)
 		}
+		// Every task of the spec is blocked by every task of the specs it depends on.
+		for _, spec := range step.Specs {
+			for _, dependency := range spec.DependsOnSpecs {
+				for _, fromIndex := range specTaskIndexes[dependency] {
+					for _, toIndex := range specTaskIndexes[spec.Id] {
+						stageCreate.TaskIndexDAGList = append(stageCreate.TaskIndexDAGList, store.TaskIndexDAG{
+							FromIndex: fromIndex,
+							ToIndex:   toIndex,
+						})
+					}
+				}
+			}
+		}
 
 		environment, err := s.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &stageEnvironmentID})
 		if err != nil {
@@ -1229,6 +1860,9 @@ func GetPipelineCreate(ctx context.Context, s *store.Store, licenseService enter
 		}
 		stageCreate.EnvironmentID = environment.UID
 		stageCreate.Name = fmt.Sprintf("%s Stage", environment.Title)
+		if stageCreate.Payload.GetCanary() != nil {
+			stageCreate.Name = fmt.Sprintf("%s Canary Stage", environment.Title)
+		}
 
 		pipelineCreate.Stages = append(pipelineCreate.Stages, stageCreate)
 	}
@@ -1250,6 +1884,7 @@ func (s *RolloutService) createPipeline(ctx context.Context, project *store.Proj
 			Name:          stage.Name,
 			EnvironmentID: stage.EnvironmentID,
 			PipelineID:    pipelineCreated.ID,
+			Payload:       stage.Payload,
 		})
 	}
 	createdStages, err := s.store.CreateStageV2(ctx, stageCreates, creatorID)
@@ -1260,10 +1895,19 @@ func (s *RolloutService) createPipeline(ctx context.Context, project *store.Proj
 		return nil, errors.Errorf("failed to create stages, expect to have created %d stages, got %d", len(stageCreates), len(createdStages))
 	}
 
+	// Create the tasks and the task DAGs of all stages in batch.
+	var taskCreateList []*store.TaskMessage
+	var taskIndexDAGList []store.TaskIndexDAG
 	for i, stageCreate := range pipelineCreate.Stages {
 		createdStage := createdStages[i]
 
-		var taskCreateList []*store.TaskMessage
+		offset := len(taskCreateList)
+		for _, indexDAG := range stageCreate.TaskIndexDAGList {
+			taskIndexDAGList = append(taskIndexDAGList, store.TaskIndexDAG{
+				FromIndex: indexDAG.FromIndex + offset,
+				ToIndex:   indexDAG.ToIndex + offset,
+			})
+		}
 		for _, taskCreate := range stageCreate.TaskList {
 			c := taskCreate
 			c.CreatorID = creatorID
@@ -1313,20 +1957,23 @@ func (s *RolloutService) createPipeline(ctx context.Context, project *store.Proj
 
 			taskCreateList = append(taskCreateList, c)
 		}
-		tasks, err := s.store.CreateTasksV2(ctx, taskCreateList...)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to create tasks for issue")
-		}
-
-		// TODO(p0ny): create task dags in batch.
-		for _, indexDAG := range stageCreate.TaskIndexDAGList {
-			if err := s.store.CreateTaskDAGV2(ctx, &store.TaskDAGMessage{
-				FromTaskID: tasks[indexDAG.FromIndex].ID,
-				ToTaskID:   tasks[indexDAG.ToIndex].ID,
-			}); err != nil {
-				return nil, errors.Wrap(err, "failed to create task DAG for issue")
-			}
-		}
+	}
+	tasks, err := s.store.CreateTasksV2(ctx, taskCreateList...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create tasks for issue")
+	}
+	if len(tasks) != len(taskCreateList) {
+		return nil, errors.Errorf("failed to create tasks, expect to have created %d tasks, got %d", len(taskCreateList), len(tasks))
+	}
+	var taskDAGCreates []*store.TaskDAGMessage
+	for _, indexDAG := range taskIndexDAGList {
+		taskDAGCreates = append(taskDAGCreates, &store.TaskDAGMessage{
+			FromTaskID: tasks[indexDAG.FromIndex].ID,
+			ToTaskID:   tasks[indexDAG.ToIndex].ID,
+		})
+	}
+	if err := s.store.CreateTaskDAGs(ctx, taskDAGCreates...); err != nil {
+		return nil, errors.Wrap(err, "failed to create task DAG for issue")
 	}
 
 	return pipelineCreated, nil
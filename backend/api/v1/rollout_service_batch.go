@@ -0,0 +1,288 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// batchStage is a stage with its tasks matched by the batch operation.
+type batchStage struct {
+	projectID  string
+	pipelineID int
+	stageID    int
+	tasks      []*store.TaskMessage
+}
+
+func (b *batchStage) name() string {
+	return fmt.Sprintf("%s%s/%s%d/%s%d", common.ProjectNamePrefix, b.projectID, common.RolloutPrefix, b.pipelineID, common.StagePrefix, b.stageID)
+}
+
+// BatchRetryFailedTasks runs the failed tasks matching the filter again.
+// The tasks are run by stage, and the tasks of the stages failed to run are returned in the failures.
+func (s *RolloutService) BatchRetryFailedTasks(ctx context.Context, request *v1pb.BatchRetryFailedTasksRequest) (*v1pb.BatchRetryFailedTasksResponse, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	find := &api.TaskFind{
+		LatestTaskRunStatusList: &[]api.TaskRunStatus{api.TaskRunFailed},
+	}
+	projectUID, err := s.getBatchProjectUID(ctx, request.Parent, iam.PermissionTasksRun)
+	if err != nil {
+		return nil, err
+	}
+	find.ProjectUID = projectUID
+	if err := checkMaintenance(ctx, s.store); err != nil {
+		return nil, err
+	}
+
+	filters, err := parseFilter(request.Filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	for _, spec := range filters {
+		if spec.operator != comparatorTypeEqual {
+			return nil, status.Errorf(codes.InvalidArgument, `only support "=" operation for %q filter`, spec.key)
+		}
+		switch spec.key {
+		case "environment":
+			environment, err := s.getBatchEnvironment(ctx, spec.value)
+			if err != nil {
+				return nil, err
+			}
+			find.EnvironmentID = &environment.UID
+		case "instance":
+			instanceID, err := common.GetInstanceID(spec.value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get instance, error: %v", err)
+			}
+			if instance == nil {
+				return nil, status.Errorf(codes.NotFound, "instance %q not found", spec.value)
+			}
+			find.InstanceID = &instance.UID
+		case "database":
+			instanceID, databaseName, err := common.GetInstanceDatabaseID(spec.value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+				InstanceID:   &instanceID,
+				DatabaseName: &databaseName,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get database, error: %v", err)
+			}
+			if database == nil {
+				return nil, status.Errorf(codes.NotFound, "database %q not found", spec.value)
+			}
+			find.DatabaseID = &database.UID
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported filter %q", spec.key)
+		}
+	}
+
+	tasks, err := s.store.ListTasks(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tasks, error: %v", err)
+	}
+	stages, err := s.groupOpenIssueTasksByStage(ctx, tasks)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to group tasks by stage, error: %v", err)
+	}
+
+	response := &v1pb.BatchRetryFailedTasksResponse{}
+	for _, stage := range stages {
+		var taskNames []string
+		for _, task := range stage.tasks {
+			taskNames = append(taskNames, fmt.Sprintf("%s/%s%d", stage.name(), common.TaskPrefix, task.ID))
+		}
+		if _, err := s.BatchRunTasks(ctx, &v1pb.BatchRunTasksRequest{
+			Parent: stage.name(),
+			Tasks:  taskNames,
+			Reason: request.Reason,
+		}); err != nil {
+			for _, taskName := range taskNames {
+				response.Failures = append(response.Failures, convertToBatchOperationFailure(taskName, err))
+			}
+			continue
+		}
+		response.Tasks = append(response.Tasks, taskNames...)
+	}
+
+	if err := createBatchOperationActivity(ctx, s.activityManager, principalID, "BatchRetryFailedTasks", request.Parent, request.Reason, response.Tasks, response.Failures); err != nil {
+		slog.Error("failed to create activity after retrying failed tasks", log.BBError(err))
+	}
+	return response, nil
+}
+
+// BatchCancelPendingTaskRuns cancels the pending task runs of the rollouts in the environment.
+// The task runs are canceled by stage, and the task runs of the stages failed to cancel are returned in the failures.
+func (s *RolloutService) BatchCancelPendingTaskRuns(ctx context.Context, request *v1pb.BatchCancelPendingTaskRunsRequest) (*v1pb.BatchCancelPendingTaskRunsResponse, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	projectUID, err := s.getBatchProjectUID(ctx, request.Parent, iam.PermissionTaskRunsCancel)
+	if err != nil {
+		return nil, err
+	}
+	environment, err := s.getBatchEnvironment(ctx, request.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingStatus := []api.TaskRunStatus{api.TaskRunPending}
+	tasks, err := s.store.ListTasks(ctx, &api.TaskFind{
+		ProjectUID:              projectUID,
+		EnvironmentID:           &environment.UID,
+		LatestTaskRunStatusList: &pendingStatus,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tasks, error: %v", err)
+	}
+	stages, err := s.groupOpenIssueTasksByStage(ctx, tasks)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to group tasks by stage, error: %v", err)
+	}
+
+	response := &v1pb.BatchCancelPendingTaskRunsResponse{}
+	for _, stage := range stages {
+		taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
+			StageUID: &stage.stageID,
+			Status:   &pendingStatus,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list task runs, error: %v", err)
+		}
+		var taskRunNames []string
+		for _, taskRun := range taskRuns {
+			taskRunNames = append(taskRunNames, fmt.Sprintf("%s/%s%d/%s%d", stage.name(), common.TaskPrefix, taskRun.TaskUID, common.TaskRunPrefix, taskRun.ID))
+		}
+		if len(taskRunNames) == 0 {
+			continue
+		}
+		if _, err := s.BatchCancelTaskRuns(ctx, &v1pb.BatchCancelTaskRunsRequest{
+			Parent:   fmt.Sprintf("%s/%s-", stage.name(), common.TaskPrefix),
+			TaskRuns: taskRunNames,
+			Reason:   request.Reason,
+		}); err != nil {
+			for _, taskRunName := range taskRunNames {
+				response.Failures = append(response.Failures, convertToBatchOperationFailure(taskRunName, err))
+			}
+			continue
+		}
+		response.TaskRuns = append(response.TaskRuns, taskRunNames...)
+	}
+
+	if err := createBatchOperationActivity(ctx, s.activityManager, principalID, "BatchCancelPendingTaskRuns", request.Parent, request.Reason, response.TaskRuns, response.Failures); err != nil {
+		slog.Error("failed to create activity after canceling pending task runs", log.BBError(err))
+	}
+	return response, nil
+}
+
+// getBatchProjectUID returns the UID of the project of the parent, nil for "projects/-".
+// The batch operations on "projects/-" match the tasks of all projects, so they require the permission p in the workspace,
+// as the ACL interceptor only checks the permission in the project of the parent.
+func (s *RolloutService) getBatchProjectUID(ctx context.Context, parent string, p iam.Permission) (*int, error) {
+	projectID, err := common.GetProjectID(parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if projectID == "-" {
+		if err := s.checkWorkspacePermission(ctx, p); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project, error: %v", err)
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+	}
+	return &project.UID, nil
+}
+
+// checkWorkspacePermission checks the user is the workspace owner or DBA, or has the permission p in the workspace.
+func (s *RolloutService) checkWorkspacePermission(ctx context.Context, p iam.Permission) error {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return status.Errorf(codes.Internal, "user not found")
+	}
+	if user.Role == api.WorkspaceAdmin || user.Role == api.WorkspaceDBA {
+		return nil
+	}
+	ok, err := s.iamManager.CheckPermission(ctx, p, user)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check permission, error: %v", err)
+	}
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "permission denied, user does not have permission %q in the workspace", p)
+	}
+	return nil
+}
+
+func (s *RolloutService) getBatchEnvironment(ctx context.Context, name string) (*store.EnvironmentMessage, error) {
+	environmentID, err := common.GetEnvironmentID(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	environment, err := s.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &environmentID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get environment, error: %v", err)
+	}
+	if environment == nil {
+		return nil, status.Errorf(codes.NotFound, "environment %q not found", name)
+	}
+	return environment, nil
+}
+
+// groupOpenIssueTasksByStage groups the tasks by stage in order, skipping the tasks whose issues are not open.
+func (s *RolloutService) groupOpenIssueTasksByStage(ctx context.Context, tasks []*store.TaskMessage) ([]*batchStage, error) {
+	issues := map[int]*store.IssueMessage{}
+	stageMap := map[int]*batchStage{}
+	var stages []*batchStage
+	for _, task := range tasks {
+		issue, ok := issues[task.PipelineID]
+		if !ok {
+			var err error
+			issue, err = s.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &task.PipelineID})
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get issue of pipeline %d", task.PipelineID)
+			}
+			issues[task.PipelineID] = issue
+		}
+		if issue == nil || issue.Status != api.IssueOpen {
+			continue
+		}
+		stage, ok := stageMap[task.StageID]
+		if !ok {
+			stage = &batchStage{
+				projectID:  issue.Project.ResourceID,
+				pipelineID: task.PipelineID,
+				stageID:    task.StageID,
+			}
+			stageMap[task.StageID] = stage
+			stages = append(stages, stage)
+		}
+		stage.tasks = append(stage.tasks, task)
+	}
+	return stages, nil
+}
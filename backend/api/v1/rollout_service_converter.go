@@ -7,11 +7,13 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"golang.org/x/text/language"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/i18n"
 	"github.com/bytebase/bytebase/backend/component/state"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
@@ -64,6 +66,7 @@ func convertToPlanSpec(spec *storepb.PlanConfig_Spec) *v1pb.Plan_Spec {
 	v1Spec := &v1pb.Plan_Spec{
 		EarliestAllowedTime: spec.EarliestAllowedTime,
 		Id:                  spec.Id,
+		DependsOnSpecs:      spec.DependsOnSpecs,
 	}
 
 	switch v := spec.Config.(type) {
@@ -73,6 +76,10 @@ func convertToPlanSpec(spec *storepb.PlanConfig_Spec) *v1pb.Plan_Spec {
 		v1Spec.Config = convertToPlanSpecChangeDatabaseConfig(v)
 	case *storepb.PlanConfig_Spec_RestoreDatabaseConfig:
 		v1Spec.Config = convertToPlanSpecRestoreDatabaseConfig(v)
+	case *storepb.PlanConfig_Spec_ProvisionDatabaseConfig:
+		v1Spec.Config = convertToPlanSpecProvisionDatabaseConfig(v)
+	case *storepb.PlanConfig_Spec_MigrateDataConfig:
+		v1Spec.Config = convertToPlanSpecMigrateDataConfig(v)
 	}
 
 	return v1Spec
@@ -130,10 +137,46 @@ func convertToPlanSpecChangeDatabaseConfig(config *storepb.PlanConfig_Spec_Chang
 			PreUpdateBackupDetail: &v1pb.Plan_ChangeDatabaseConfig_PreUpdateBackupDetail{
 				Database: c.PreUpdateBackupDetail.GetDatabase(),
 			},
+			BatchConfig:           convertToPlanSpecChangeDatabaseConfigBatchConfig(c.BatchConfig),
+			OnlineMigrationConfig: convertToPlanSpecChangeDatabaseConfigOnlineMigrationConfig(c.OnlineMigrationConfig),
+			Transactional:         c.Transactional,
+			Resumable:             c.Resumable,
+			PreChecks:             convertToPlanSpecChangeDatabaseConfigChecks(c.PreChecks),
+			PostChecks:            convertToPlanSpecChangeDatabaseConfigChecks(c.PostChecks),
 		},
 	}
 }
 
+func convertToPlanSpecChangeDatabaseConfigBatchConfig(c *storepb.PlanConfig_ChangeDatabaseConfig_BatchConfig) *v1pb.Plan_ChangeDatabaseConfig_BatchConfig {
+	if c == nil {
+		return nil
+	}
+	return &v1pb.Plan_ChangeDatabaseConfig_BatchConfig{
+		BatchSize:     c.BatchSize,
+		SleepInterval: c.SleepInterval,
+	}
+}
+
+func convertToPlanSpecChangeDatabaseConfigOnlineMigrationConfig(c *storepb.PlanConfig_ChangeDatabaseConfig_OnlineMigrationConfig) *v1pb.Plan_ChangeDatabaseConfig_OnlineMigrationConfig {
+	if c == nil {
+		return nil
+	}
+	return &v1pb.Plan_ChangeDatabaseConfig_OnlineMigrationConfig{
+		PgRepack: c.PgRepack,
+	}
+}
+
+func convertToPlanSpecChangeDatabaseConfigChecks(checks []*storepb.PlanConfig_ChangeDatabaseConfig_Check) []*v1pb.Plan_ChangeDatabaseConfig_Check {
+	var v1Checks []*v1pb.Plan_ChangeDatabaseConfig_Check
+	for _, check := range checks {
+		v1Checks = append(v1Checks, &v1pb.Plan_ChangeDatabaseConfig_Check{
+			Statement:     check.Statement,
+			ExpectedValue: check.ExpectedValue,
+		})
+	}
+	return v1Checks
+}
+
 func convertToPlanSpecChangeDatabaseConfigRollbackDetail(d *storepb.PlanConfig_ChangeDatabaseConfig_RollbackDetail) *v1pb.Plan_ChangeDatabaseConfig_RollbackDetail {
 	if d == nil {
 		return nil
@@ -187,6 +230,30 @@ func convertToPlanSpecRestoreDatabaseConfig(config *storepb.PlanConfig_Spec_Rest
 	return v1Config
 }
 
+func convertToPlanSpecProvisionDatabaseConfig(config *storepb.PlanConfig_Spec_ProvisionDatabaseConfig) *v1pb.Plan_Spec_ProvisionDatabaseConfig {
+	c := config.ProvisionDatabaseConfig
+	return &v1pb.Plan_Spec_ProvisionDatabaseConfig{
+		ProvisionDatabaseConfig: &v1pb.Plan_ProvisionDatabaseConfig{
+			Target: c.Target,
+			Source: c.Source,
+			Tables: c.Tables,
+		},
+	}
+}
+
+func convertToPlanSpecMigrateDataConfig(config *storepb.PlanConfig_Spec_MigrateDataConfig) *v1pb.Plan_Spec_MigrateDataConfig {
+	c := config.MigrateDataConfig
+	return &v1pb.Plan_Spec_MigrateDataConfig{
+		MigrateDataConfig: &v1pb.Plan_MigrateDataConfig{
+			Target:      c.Target,
+			Source:      c.Source,
+			Tables:      c.Tables,
+			ChunkSize:   c.ChunkSize,
+			ClearTarget: c.ClearTarget,
+		},
+	}
+}
+
 func convertPlanSteps(steps []*v1pb.Plan_Step) []*storepb.PlanConfig_Step {
 	storeSteps := make([]*storepb.PlanConfig_Step, len(steps))
 	for i := range steps {
@@ -213,6 +280,7 @@ func convertPlanSpec(spec *v1pb.Plan_Spec) *storepb.PlanConfig_Spec {
 	storeSpec := &storepb.PlanConfig_Spec{
 		EarliestAllowedTime: spec.EarliestAllowedTime,
 		Id:                  spec.Id,
+		DependsOnSpecs:      spec.DependsOnSpecs,
 	}
 
 	switch v := spec.Config.(type) {
@@ -222,6 +290,10 @@ func convertPlanSpec(spec *v1pb.Plan_Spec) *storepb.PlanConfig_Spec {
 		storeSpec.Config = convertPlanSpecChangeDatabaseConfig(v)
 	case *v1pb.Plan_Spec_RestoreDatabaseConfig:
 		storeSpec.Config = convertPlanSpecRestoreDatabaseConfig(v)
+	case *v1pb.Plan_Spec_ProvisionDatabaseConfig:
+		storeSpec.Config = convertPlanSpecProvisionDatabaseConfig(v)
+	case *v1pb.Plan_Spec_MigrateDataConfig:
+		storeSpec.Config = convertPlanSpecMigrateDataConfig(v)
 	}
 	return storeSpec
 }
@@ -265,10 +337,46 @@ func convertPlanSpecChangeDatabaseConfig(config *v1pb.Plan_Spec_ChangeDatabaseCo
 			RollbackEnabled:       c.RollbackEnabled,
 			GhostFlags:            c.GhostFlags,
 			PreUpdateBackupDetail: preUpdateBackupDetail,
+			BatchConfig:           convertPlanSpecChangeDatabaseConfigBatchConfig(c.BatchConfig),
+			OnlineMigrationConfig: convertPlanSpecChangeDatabaseConfigOnlineMigrationConfig(c.OnlineMigrationConfig),
+			Transactional:         c.Transactional,
+			Resumable:             c.Resumable,
+			PreChecks:             convertPlanSpecChangeDatabaseConfigChecks(c.PreChecks),
+			PostChecks:            convertPlanSpecChangeDatabaseConfigChecks(c.PostChecks),
 		},
 	}
 }
 
+func convertPlanSpecChangeDatabaseConfigBatchConfig(c *v1pb.Plan_ChangeDatabaseConfig_BatchConfig) *storepb.PlanConfig_ChangeDatabaseConfig_BatchConfig {
+	if c == nil {
+		return nil
+	}
+	return &storepb.PlanConfig_ChangeDatabaseConfig_BatchConfig{
+		BatchSize:     c.BatchSize,
+		SleepInterval: c.SleepInterval,
+	}
+}
+
+func convertPlanSpecChangeDatabaseConfigOnlineMigrationConfig(c *v1pb.Plan_ChangeDatabaseConfig_OnlineMigrationConfig) *storepb.PlanConfig_ChangeDatabaseConfig_OnlineMigrationConfig {
+	if c == nil {
+		return nil
+	}
+	return &storepb.PlanConfig_ChangeDatabaseConfig_OnlineMigrationConfig{
+		PgRepack: c.PgRepack,
+	}
+}
+
+func convertPlanSpecChangeDatabaseConfigChecks(checks []*v1pb.Plan_ChangeDatabaseConfig_Check) []*storepb.PlanConfig_ChangeDatabaseConfig_Check {
+	var storeChecks []*storepb.PlanConfig_ChangeDatabaseConfig_Check
+	for _, check := range checks {
+		storeChecks = append(storeChecks, &storepb.PlanConfig_ChangeDatabaseConfig_Check{
+			Statement:     check.Statement,
+			ExpectedValue: check.ExpectedValue,
+		})
+	}
+	return storeChecks
+}
+
 func convertPlanSpecRestoreDatabaseConfig(config *v1pb.Plan_Spec_RestoreDatabaseConfig) *storepb.PlanConfig_Spec_RestoreDatabaseConfig {
 	c := config.RestoreDatabaseConfig
 	storeConfig := &storepb.PlanConfig_Spec_RestoreDatabaseConfig{
@@ -294,6 +402,30 @@ func convertPlanSpecRestoreDatabaseConfig(config *v1pb.Plan_Spec_RestoreDatabase
 	return storeConfig
 }
 
+func convertPlanSpecProvisionDatabaseConfig(config *v1pb.Plan_Spec_ProvisionDatabaseConfig) *storepb.PlanConfig_Spec_ProvisionDatabaseConfig {
+	c := config.ProvisionDatabaseConfig
+	return &storepb.PlanConfig_Spec_ProvisionDatabaseConfig{
+		ProvisionDatabaseConfig: &storepb.PlanConfig_ProvisionDatabaseConfig{
+			Target: c.Target,
+			Source: c.Source,
+			Tables: c.Tables,
+		},
+	}
+}
+
+func convertPlanSpecMigrateDataConfig(config *v1pb.Plan_Spec_MigrateDataConfig) *storepb.PlanConfig_Spec_MigrateDataConfig {
+	c := config.MigrateDataConfig
+	return &storepb.PlanConfig_Spec_MigrateDataConfig{
+		MigrateDataConfig: &storepb.PlanConfig_MigrateDataConfig{
+			Target:      c.Target,
+			Source:      c.Source,
+			Tables:      c.Tables,
+			ChunkSize:   c.ChunkSize,
+			ClearTarget: c.ClearTarget,
+		},
+	}
+}
+
 // convertDatabaseLabels converts the map[string]string labels to []*api.DatabaseLabel JSON string.
 func convertDatabaseLabels(labelsMap map[string]string) (string, error) {
 	if len(labelsMap) == 0 {
@@ -338,7 +470,7 @@ func convertToPlanCheckRun(ctx context.Context, s *store.Store, projectID string
 		Status:     convertToPlanCheckRunStatus(run.Status),
 		Target:     "",
 		Sheet:      "",
-		Results:    convertToPlanCheckRunResults(run.Result.Results),
+		Results:    convertToPlanCheckRunResults(run.Result.Results, i18n.GetLocale(ctx)),
 		Error:      run.Result.Error,
 	}
 
@@ -372,10 +504,14 @@ func convertToPlanCheckRunType(t store.PlanCheckRunType) v1pb.PlanCheckRun_Type
 		return v1pb.PlanCheckRun_DATABASE_STATEMENT_COMPATIBILITY
 	case store.PlanCheckDatabaseStatementAdvise:
 		return v1pb.PlanCheckRun_DATABASE_STATEMENT_ADVISE
+	case store.PlanCheckDatabaseStatementBulkAdvise:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_BULK_ADVISE
 	case store.PlanCheckDatabaseStatementType:
 		return v1pb.PlanCheckRun_DATABASE_STATEMENT_TYPE
 	case store.PlanCheckDatabaseStatementSummaryReport:
 		return v1pb.PlanCheckRun_DATABASE_STATEMENT_SUMMARY_REPORT
+	case store.PlanCheckDatabaseStatementQueryPlan:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_QUERY_PLAN
 	case store.PlanCheckDatabaseConnect:
 		return v1pb.PlanCheckRun_DATABASE_CONNECT
 	case store.PlanCheckDatabaseGhostSync:
@@ -400,19 +536,19 @@ func convertToPlanCheckRunStatus(status store.PlanCheckRunStatus) v1pb.PlanCheck
 	return v1pb.PlanCheckRun_STATUS_UNSPECIFIED
 }
 
-func convertToPlanCheckRunResults(results []*storepb.PlanCheckRunResult_Result) []*v1pb.PlanCheckRun_Result {
+func convertToPlanCheckRunResults(results []*storepb.PlanCheckRunResult_Result, locale language.Tag) []*v1pb.PlanCheckRun_Result {
 	var resultsV1 []*v1pb.PlanCheckRun_Result
 	for _, result := range results {
-		resultsV1 = append(resultsV1, convertToPlanCheckRunResult(result))
+		resultsV1 = append(resultsV1, convertToPlanCheckRunResult(result, locale))
 	}
 	return resultsV1
 }
 
-func convertToPlanCheckRunResult(result *storepb.PlanCheckRunResult_Result) *v1pb.PlanCheckRun_Result {
+func convertToPlanCheckRunResult(result *storepb.PlanCheckRunResult_Result, locale language.Tag) *v1pb.PlanCheckRun_Result {
 	resultV1 := &v1pb.PlanCheckRun_Result{
 		Status:  convertToPlanCheckRunResultStatus(result.Status),
-		Title:   result.Title,
-		Content: result.Content,
+		Title:   i18n.Translate(locale, result.Title),
+		Content: i18n.Translate(locale, result.Content),
 		Code:    result.Code,
 		Report:  nil,
 	}
@@ -435,6 +571,17 @@ func convertToPlanCheckRunResult(result *storepb.PlanCheckRunResult_Result) *v1p
 				Code:   report.SqlReviewReport.Code,
 			},
 		}
+	case *storepb.PlanCheckRunResult_Result_QueryPlanReport_:
+		resultV1.Report = &v1pb.PlanCheckRun_Result_QueryPlanReport_{
+			QueryPlanReport: &v1pb.PlanCheckRun_Result_QueryPlanReport{
+				QueryTitle:         report.QueryPlanReport.QueryTitle,
+				CostBefore:         report.QueryPlanReport.CostBefore,
+				CostAfter:          report.QueryPlanReport.CostAfter,
+				NewSequentialScans: report.QueryPlanReport.NewSequentialScans,
+				PlanBefore:         report.QueryPlanReport.PlanBefore,
+				PlanAfter:          report.QueryPlanReport.PlanAfter,
+			},
+		}
 	}
 	return resultV1
 }
@@ -453,10 +600,10 @@ func convertToPlanCheckRunResultStatus(status storepb.PlanCheckRunResult_Result_
 	return v1pb.PlanCheckRun_Result_STATUS_UNSPECIFIED
 }
 
-func convertToTaskRuns(stateCfg *state.State, taskRuns []*store.TaskRunMessage) []*v1pb.TaskRun {
+func convertToTaskRuns(stateCfg *state.State, taskRuns []*store.TaskRunMessage, locale language.Tag) []*v1pb.TaskRun {
 	var taskRunsV1 []*v1pb.TaskRun
 	for _, taskRun := range taskRuns {
-		taskRunsV1 = append(taskRunsV1, convertToTaskRun(stateCfg, taskRun))
+		taskRunsV1 = append(taskRunsV1, convertToTaskRun(stateCfg, taskRun, locale))
 	}
 	return taskRunsV1
 }
@@ -480,7 +627,7 @@ func convertToTaskRunStatus(status api.TaskRunStatus) v1pb.TaskRun_Status {
 	}
 }
 
-func convertToTaskRun(stateCfg *state.State, taskRun *store.TaskRunMessage) *v1pb.TaskRun {
+func convertToTaskRun(stateCfg *state.State, taskRun *store.TaskRunMessage, locale language.Tag) *v1pb.TaskRun {
 	t := &v1pb.TaskRun{
 		Name:          fmt.Sprintf("%s%s/%s%d/%s%d/%s%d/%s%d", common.ProjectNamePrefix, taskRun.ProjectID, common.RolloutPrefix, taskRun.PipelineUID, common.StagePrefix, taskRun.StageUID, common.TaskPrefix, taskRun.TaskUID, common.TaskRunPrefix, taskRun.ID),
 		Uid:           fmt.Sprintf("%d", taskRun.ID),
@@ -491,9 +638,10 @@ func convertToTaskRun(stateCfg *state.State, taskRun *store.TaskRunMessage) *v1p
 		StartTime:     timestamppb.New(time.Unix(taskRun.StartedTs, 0)),
 		Title:         taskRun.Name,
 		Status:        convertToTaskRunStatus(taskRun.Status),
-		Detail:        taskRun.ResultProto.Detail,
+		Detail:        i18n.Translate(locale, taskRun.ResultProto.Detail),
 		ChangeHistory: taskRun.ResultProto.ChangeHistory,
 		SchemaVersion: taskRun.ResultProto.Version,
+		CheckResults:  convertToTaskRunCheckResults(taskRun.ResultProto.CheckResults),
 	}
 
 	if v, ok := stateCfg.TaskRunExecutionStatuses.Load(taskRun.ID); ok {
@@ -520,6 +668,76 @@ func convertToTaskRun(stateCfg *state.State, taskRun *store.TaskRunMessage) *v1p
 	return t
 }
 
+func convertToTaskRunCheckResults(results []*storepb.TaskRunResult_CheckResult) []*v1pb.TaskRun_CheckResult {
+	var v1Results []*v1pb.TaskRun_CheckResult
+	for _, result := range results {
+		v1Result := &v1pb.TaskRun_CheckResult{
+			Type:      v1pb.TaskRun_CheckResult_TYPE_UNSPECIFIED,
+			Statement: result.Statement,
+			Passed:    result.Passed,
+			Detail:    result.Detail,
+		}
+		switch result.Type {
+		case storepb.TaskRunResult_CheckResult_PRE_CHECK:
+			v1Result.Type = v1pb.TaskRun_CheckResult_PRE_CHECK
+		case storepb.TaskRunResult_CheckResult_POST_CHECK:
+			v1Result.Type = v1pb.TaskRun_CheckResult_POST_CHECK
+		default:
+		}
+		v1Results = append(v1Results, v1Result)
+	}
+	return v1Results
+}
+
+func convertToTaskRunArtifact(parent string, artifact *store.TaskRunArtifactMessage) *v1pb.TaskRunArtifact {
+	return &v1pb.TaskRunArtifact{
+		Name:        fmt.Sprintf("%s/%s%d", parent, common.TaskRunArtifactPrefix, artifact.ID),
+		Type:        convertToTaskRunArtifactType(artifact.Type),
+		Title:       artifact.Name,
+		ContentType: artifact.ContentType,
+		Size:        artifact.Size,
+		CreateTime:  timestamppb.New(time.Unix(artifact.CreatedTs, 0)),
+	}
+}
+
+func convertToTaskRunLogEntry(taskRunLog *store.TaskRunLogMessage) *v1pb.TaskRunLogEntry {
+	return &v1pb.TaskRunLogEntry{
+		Level:      convertToTaskRunLogEntryLevel(taskRunLog.Level),
+		CreateTime: timestamppb.New(time.Unix(taskRunLog.CreatedTs, 0)),
+		Content:    taskRunLog.Content,
+	}
+}
+
+func convertToTaskRunLogEntryLevel(level store.TaskRunLogLevel) v1pb.TaskRunLogEntry_Level {
+	switch level {
+	case store.TaskRunLogDebug:
+		return v1pb.TaskRunLogEntry_DEBUG
+	case store.TaskRunLogInfo:
+		return v1pb.TaskRunLogEntry_INFO
+	case store.TaskRunLogWarn:
+		return v1pb.TaskRunLogEntry_WARN
+	case store.TaskRunLogError:
+		return v1pb.TaskRunLogEntry_ERROR
+	default:
+		return v1pb.TaskRunLogEntry_LEVEL_UNSPECIFIED
+	}
+}
+
+func convertToTaskRunArtifactType(artifactType store.TaskRunArtifactType) v1pb.TaskRunArtifact_Type {
+	switch artifactType {
+	case store.TaskRunArtifactRollbackSQL:
+		return v1pb.TaskRunArtifact_ROLLBACK_SQL
+	case store.TaskRunArtifactGhostChangelog:
+		return v1pb.TaskRunArtifact_GHOST_CHANGELOG
+	case store.TaskRunArtifactVerificationOutput:
+		return v1pb.TaskRunArtifact_VERIFICATION_OUTPUT
+	case store.TaskRunArtifactDataCompareReport:
+		return v1pb.TaskRunArtifact_DATA_COMPARE_REPORT
+	default:
+		return v1pb.TaskRunArtifact_TYPE_UNSPECIFIED
+	}
+}
+
 func convertToRollout(ctx context.Context, s *store.Store, project *store.ProjectMessage, rollout *store.PipelineMessage) (*v1pb.Rollout, error) {
 	rolloutV1 := &v1pb.Rollout{
 		Name:   fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, project.ResourceID, common.RolloutPrefix, rollout.ID),
@@ -541,10 +759,12 @@ func convertToRollout(ctx context.Context, s *store.Store, project *store.Projec
 			return nil, errors.Errorf("environment %d not found", stage.EnvironmentID)
 		}
 		rolloutStage := &v1pb.Stage{
-			Name:        fmt.Sprintf("%s%s/%s%d/%s%d", common.ProjectNamePrefix, project.ResourceID, common.RolloutPrefix, rollout.ID, common.StagePrefix, stage.ID),
-			Uid:         fmt.Sprintf("%d", stage.ID),
-			Environment: fmt.Sprintf("%s%s", common.EnvironmentNamePrefix, environment.ResourceID),
-			Title:       stage.Name,
+			Name:          fmt.Sprintf("%s%s/%s%d/%s%d", common.ProjectNamePrefix, project.ResourceID, common.RolloutPrefix, rollout.ID, common.StagePrefix, stage.ID),
+			Uid:           fmt.Sprintf("%d", stage.ID),
+			Environment:   fmt.Sprintf("%s%s", common.EnvironmentNamePrefix, environment.ResourceID),
+			Title:         stage.Name,
+			Canary:        convertToStageCanary(stage.Payload.GetCanary()),
+			ChangeRequest: convertToStageChangeRequest(stage.Payload.GetChangeRequest()),
 		}
 		for _, task := range stage.TaskList {
 			rolloutTask, err := convertToTask(ctx, s, project, task)
@@ -567,9 +787,79 @@ func convertToRollout(ctx context.Context, s *store.Store, project *store.Projec
 		}
 	}
 
+	for i, rolloutStage := range rolloutV1.Stages {
+		approvalGate, err := convertToStageApprovalGate(ctx, s, rollout.Stages[i], taskIDToName)
+		if err != nil {
+			return nil, err
+		}
+		rolloutStage.ApprovalGate = approvalGate
+	}
+
 	return rolloutV1, nil
 }
 
+// convertToStageApprovalGate returns the approval gate of the stage, or nil if the rollout policy of the environment doesn't require one.
+func convertToStageApprovalGate(ctx context.Context, s *store.Store, stage *store.StageMessage, taskIDToName map[int]string) (*v1pb.StageApprovalGate, error) {
+	policy, err := s.GetRolloutPolicy(ctx, stage.EnvironmentID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get rollout policy for environment %d", stage.EnvironmentID)
+	}
+	if len(policy.GetApprovalGateRoles()) == 0 {
+		return nil, nil
+	}
+	approvals, err := s.ListStageApprovals(ctx, &store.FindStageApprovalMessage{StageID: &stage.ID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list approvals of stage %d", stage.ID)
+	}
+	approvalGate := &v1pb.StageApprovalGate{
+		Roles: policy.GetApprovalGateRoles(),
+	}
+	for _, approval := range approvals {
+		creator, err := s.GetUserByID(ctx, approval.CreatorID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %d", approval.CreatorID)
+		}
+		if creator == nil {
+			return nil, errors.Errorf("user %d not found", approval.CreatorID)
+		}
+		v1Approval := &v1pb.StageApprovalGate_Approval{
+			Role:       approval.Role,
+			Creator:    fmt.Sprintf("users/%s", creator.Email),
+			Comment:    approval.Comment,
+			CreateTime: timestamppb.New(time.Unix(approval.CreatedTs, 0)),
+		}
+		if approval.TaskID != nil {
+			v1Approval.Task = taskIDToName[*approval.TaskID]
+		}
+		approvalGate.Approvals = append(approvalGate.Approvals, v1Approval)
+	}
+	return approvalGate, nil
+}
+
+func convertToStageCanary(canary *storepb.StageCanary) *v1pb.StageCanary {
+	if canary == nil {
+		return nil
+	}
+	return &v1pb.StageCanary{
+		BakeDuration:         canary.BakeDuration,
+		MaxFailurePercentage: canary.MaxFailurePercentage,
+		PostCheckStatements:  canary.PostCheckStatements,
+		Status:               v1pb.StageCanary_Status(canary.Status),
+		HaltReason:           canary.HaltReason,
+	}
+}
+
+func convertToStageChangeRequest(changeRequest *storepb.StageChangeRequest) *v1pb.StageChangeRequest {
+	if changeRequest == nil {
+		return nil
+	}
+	return &v1pb.StageChangeRequest{
+		Number: changeRequest.Number,
+		Status: v1pb.StageChangeRequest_Status(changeRequest.Status),
+		Error:  changeRequest.Error,
+	}
+}
+
 func convertToTask(ctx context.Context, s *store.Store, project *store.ProjectMessage, task *store.TaskMessage) (*v1pb.Task, error) {
 	switch task.Type {
 	case api.TaskDatabaseCreate:
@@ -588,6 +878,10 @@ func convertToTask(ctx context.Context, s *store.Store, project *store.ProjectMe
 		return convertToTaskFromDatabaseRestoreRestore(ctx, s, project, task)
 	case api.TaskDatabaseRestorePITRCutover:
 		return convertToTaskFromDatabaseRestoreCutOver(ctx, s, project, task)
+	case api.TaskDatabaseDataProvision:
+		return convertToTaskFromDataProvision(ctx, s, project, task)
+	case api.TaskDatabaseDataMigrate:
+		return convertToTaskFromDataMigrate(ctx, s, project, task)
 	case api.TaskGeneral:
 		fallthrough
 	default:
@@ -694,6 +988,10 @@ func convertToTaskFromSchemaUpdate(ctx context.Context, s *store.Store, project
 	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
 		return nil, errors.Wrapf(err, "failed to unmarshal task payload")
 	}
+	var rollbackSheetName string
+	if payload.RollbackSheetID != 0 {
+		rollbackSheetName = getResourceNameForSheet(project, payload.RollbackSheetID)
+	}
 	database, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID, ShowDeleted: true})
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get database")
@@ -719,13 +1017,36 @@ func convertToTaskFromSchemaUpdate(ctx context.Context, s *store.Store, project
 		SkippedReason:  payload.SkippedReason,
 		BlockedByTasks: nil,
 		Target:         fmt.Sprintf("%s%s/%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName),
-		Payload: &v1pb.Task_DatabaseSchemaUpdate_{
-			DatabaseSchemaUpdate: &v1pb.Task_DatabaseSchemaUpdate{
-				Sheet:         sheet,
-				SchemaVersion: payload.SchemaVersion,
-			},
+		Payload:        nil,
+	}
+	v1pbTaskPayload := &v1pb.Task_DatabaseSchemaUpdate_{
+		DatabaseSchemaUpdate: &v1pb.Task_DatabaseSchemaUpdate{
+			Sheet:             sheet,
+			SchemaVersion:     payload.SchemaVersion,
+			RollbackEnabled:   payload.RollbackEnabled,
+			RollbackSqlStatus: convertToRollbackSQLStatus(payload.RollbackSQLStatus),
+			RollbackError:     payload.RollbackError,
+			RollbackSheet:     rollbackSheetName,
+			RollbackFromIssue: "",
+			RollbackFromTask:  "",
 		},
 	}
+	if payload.RollbackFromIssueID != 0 && payload.RollbackFromTaskID != 0 {
+		rollbackFromIssue, err := s.GetIssueV2(ctx, &store.FindIssueMessage{
+			UID: &payload.RollbackFromIssueID,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get rollback issue %q", payload.RollbackFromIssueID)
+		}
+		rollbackFromTask, err := s.GetTaskV2ByID(ctx, payload.RollbackFromTaskID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get rollback task %q", payload.RollbackFromTaskID)
+		}
+		v1pbTaskPayload.DatabaseSchemaUpdate.RollbackFromIssue = fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, project.ResourceID, common.IssuePrefix, rollbackFromIssue.UID)
+		v1pbTaskPayload.DatabaseSchemaUpdate.RollbackFromTask = fmt.Sprintf("%s%s/%s%d/%s%d/%s%d", common.ProjectNamePrefix, rollbackFromIssue.Project.ResourceID, common.RolloutPrefix, rollbackFromTask.PipelineID, common.StagePrefix, rollbackFromTask.StageID, common.TaskPrefix, rollbackFromTask.ID)
+	}
+
+	v1pbTask.Payload = v1pbTaskPayload
 	return v1pbTask, nil
 }
 
@@ -993,6 +1314,90 @@ func convertToTaskFromDatabaseRestoreCutOver(ctx context.Context, s *store.Store
 	return v1pbTask, nil
 }
 
+func convertToTaskFromDataProvision(ctx context.Context, s *store.Store, project *store.ProjectMessage, task *store.TaskMessage) (*v1pb.Task, error) {
+	if task.DatabaseID == nil {
+		return nil, errors.Errorf("database id is nil")
+	}
+	payload := &api.TaskDatabaseDataProvisionPayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal task payload")
+	}
+	database, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID, ShowDeleted: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database")
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found")
+	}
+	sourceDatabase, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: &payload.SourceDatabaseID, ShowDeleted: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get source database")
+	}
+	if sourceDatabase == nil {
+		return nil, errors.Errorf("source database not found")
+	}
+	v1pbTask := &v1pb.Task{
+		Name:           fmt.Sprintf("%s%s/%s%d/%s%d/%s%d", common.ProjectNamePrefix, project.ResourceID, common.RolloutPrefix, task.PipelineID, common.StagePrefix, task.StageID, common.TaskPrefix, task.ID),
+		Uid:            fmt.Sprintf("%d", task.ID),
+		Title:          task.Name,
+		SpecId:         payload.SpecID,
+		Type:           convertToTaskType(task.Type),
+		Status:         convertToTaskStatus(task.LatestTaskRunStatus, payload.Skipped),
+		SkippedReason:  payload.SkippedReason,
+		BlockedByTasks: nil,
+		Target:         fmt.Sprintf("%s%s/%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName),
+		Payload: &v1pb.Task_DatabaseDataProvision_{
+			DatabaseDataProvision: &v1pb.Task_DatabaseDataProvision{
+				Source: common.FormatDatabase(sourceDatabase.InstanceID, sourceDatabase.DatabaseName),
+				Tables: payload.Tables,
+			},
+		},
+	}
+	return v1pbTask, nil
+}
+
+func convertToTaskFromDataMigrate(ctx context.Context, s *store.Store, project *store.ProjectMessage, task *store.TaskMessage) (*v1pb.Task, error) {
+	if task.DatabaseID == nil {
+		return nil, errors.Errorf("database id is nil")
+	}
+	payload := &api.TaskDatabaseDataMigratePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal task payload")
+	}
+	database, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID, ShowDeleted: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database")
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found")
+	}
+	sourceDatabase, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: &payload.SourceDatabaseID, ShowDeleted: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get source database")
+	}
+	if sourceDatabase == nil {
+		return nil, errors.Errorf("source database not found")
+	}
+	v1pbTask := &v1pb.Task{
+		Name:           fmt.Sprintf("%s%s/%s%d/%s%d/%s%d", common.ProjectNamePrefix, project.ResourceID, common.RolloutPrefix, task.PipelineID, common.StagePrefix, task.StageID, common.TaskPrefix, task.ID),
+		Uid:            fmt.Sprintf("%d", task.ID),
+		Title:          task.Name,
+		SpecId:         payload.SpecID,
+		Type:           convertToTaskType(task.Type),
+		Status:         convertToTaskStatus(task.LatestTaskRunStatus, payload.Skipped),
+		SkippedReason:  payload.SkippedReason,
+		BlockedByTasks: nil,
+		Target:         fmt.Sprintf("%s%s/%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName),
+		Payload: &v1pb.Task_DatabaseDataMigrate_{
+			DatabaseDataMigrate: &v1pb.Task_DatabaseDataMigrate{
+				Source: common.FormatDatabase(sourceDatabase.InstanceID, sourceDatabase.DatabaseName),
+				Tables: payload.Tables,
+			},
+		},
+	}
+	return v1pbTask, nil
+}
+
 func convertToTaskStatus(latestTaskRunStatus api.TaskRunStatus, skipped bool) v1pb.Task_Status {
 	if skipped {
 		return v1pb.Task_SKIPPED
@@ -1039,6 +1444,10 @@ func convertToTaskType(taskType api.TaskType) v1pb.Task_Type {
 		return v1pb.Task_DATABASE_RESTORE_RESTORE
 	case api.TaskDatabaseRestorePITRCutover:
 		return v1pb.Task_DATABASE_RESTORE_CUTOVER
+	case api.TaskDatabaseDataProvision:
+		return v1pb.Task_DATABASE_DATA_PROVISION
+	case api.TaskDatabaseDataMigrate:
+		return v1pb.Task_DATABASE_DATA_MIGRATE
 	default:
 		return v1pb.Task_TYPE_UNSPECIFIED
 	}
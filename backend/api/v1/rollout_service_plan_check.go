@@ -164,7 +164,7 @@ func getPlanCheckRunsFromChangeDatabaseConfigDatabaseGroupTarget(ctx context.Con
 		return nil, errors.Wrapf(err, "failed to list databases for project %q", project.ResourceID)
 	}
 
-	matchedDatabases, _, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, databaseGroup, allDatabases)
+	matchedDatabases, _, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, s, databaseGroup, allDatabases)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get matched and unmatched databases in database group %q", databaseGroupID)
 	}
@@ -180,6 +180,13 @@ func getPlanCheckRunsFromChangeDatabaseConfigDatabaseGroupTarget(ctx context.Con
 		}
 		planCheckRuns = append(planCheckRuns, runs...)
 	}
+	bulkAdviseRun, err := getStatementBulkAdvisePlanCheckRun(ctx, s, plan, config, sheetUID, matchedDatabases, &databaseGroup.UID)
+	if err != nil {
+		return nil, err
+	}
+	if bulkAdviseRun != nil {
+		planCheckRuns = append(planCheckRuns, bulkAdviseRun)
+	}
 
 	return planCheckRuns, nil
 }
@@ -262,12 +269,17 @@ func getPlanCheckRunsFromChangeDatabaseConfigDeploymentConfigTarget(ctx context.
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to list databases")
 	}
-	matrix, err := utils.GetDatabaseMatrixFromDeploymentSchedule(deploySchedule, allDatabases)
+	engines, err := utils.GetInstanceEngines(ctx, s, allDatabases)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance engines")
+	}
+	matrix, err := utils.GetDatabaseMatrixFromDeploymentSchedule(deploySchedule, allDatabases, engines)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get database matrix from deployment schedule")
 	}
 
 	var planCheckRuns []*store.PlanCheckRunMessage
+	var targetDatabases []*store.DatabaseMessage
 	for _, databases := range matrix {
 		for _, database := range databases {
 			runs, err := getPlanCheckRunsFromChangeDatabaseConfigForDatabase(ctx, s, plan, config, sheetUID, database, nil)
@@ -275,12 +287,65 @@ func getPlanCheckRunsFromChangeDatabaseConfigDeploymentConfigTarget(ctx context.
 				return nil, errors.Wrapf(err, "failed to get plan check runs from spec with change database config for database %q", database.DatabaseName)
 			}
 			planCheckRuns = append(planCheckRuns, runs...)
+			targetDatabases = append(targetDatabases, database)
 		}
 	}
+	bulkAdviseRun, err := getStatementBulkAdvisePlanCheckRun(ctx, s, plan, config, sheetUID, targetDatabases, nil)
+	if err != nil {
+		return nil, err
+	}
+	if bulkAdviseRun != nil {
+		planCheckRuns = append(planCheckRuns, bulkAdviseRun)
+	}
 
 	return planCheckRuns, nil
 }
 
+// getStatementBulkAdvisePlanCheckRun returns the plan check run reviewing the statement against the schemas of all the target databases,
+// so that the findings such as the column already existing on some of the tenants are reported before the rollout is approved.
+// It returns nil if the change doesn't need the SQL review or there is only one target database.
+func getStatementBulkAdvisePlanCheckRun(ctx context.Context, s *store.Store, plan *store.PlanMessage, config *storepb.PlanConfig_ChangeDatabaseConfig, sheetUID int, databases []*store.DatabaseMessage, databaseGroupUID *int64) (*store.PlanCheckRunMessage, error) {
+	if config.Type == storepb.PlanConfig_ChangeDatabaseConfig_BASELINE || config.Type == storepb.PlanConfig_ChangeDatabaseConfig_BRANCH {
+		return nil, nil
+	}
+	if len(databases) < 2 {
+		return nil, nil
+	}
+
+	var targets []*storepb.PlanCheckRunConfig_DatabaseTarget
+	for _, database := range databases {
+		instance, err := s.GetInstanceV2(ctx, &store.FindInstanceMessage{
+			ResourceID: &database.InstanceID,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get instance %q", database.InstanceID)
+		}
+		if instance == nil {
+			return nil, errors.Errorf("instance %q not found", database.InstanceID)
+		}
+		targets = append(targets, &storepb.PlanCheckRunConfig_DatabaseTarget{
+			InstanceUid:  int32(instance.UID),
+			DatabaseName: database.DatabaseName,
+		})
+	}
+
+	return &store.PlanCheckRunMessage{
+		CreatorUID: api.SystemBotID,
+		UpdaterUID: api.SystemBotID,
+		PlanUID:    plan.UID,
+		Status:     store.PlanCheckRunStatusRunning,
+		Type:       store.PlanCheckDatabaseStatementBulkAdvise,
+		Config: &storepb.PlanCheckRunConfig{
+			SheetUid:           int32(sheetUID),
+			ChangeDatabaseType: convertToChangeDatabaseType(config.Type),
+			InstanceUid:        targets[0].InstanceUid,
+			DatabaseName:       targets[0].DatabaseName,
+			DatabaseGroupUid:   databaseGroupUID,
+			DatabaseTargets:    targets,
+		},
+	}, nil
+}
+
 func getPlanCheckRunsFromChangeDatabaseConfigForDatabase(ctx context.Context, s *store.Store, plan *store.PlanMessage, config *storepb.PlanConfig_ChangeDatabaseConfig, sheetUID int, database *store.DatabaseMessage, databaseGroupUID *int64) ([]*store.PlanCheckRunMessage, error) {
 	instance, err := s.GetInstanceV2(ctx, &store.FindInstanceMessage{
 		ResourceID: &database.InstanceID,
@@ -371,6 +436,31 @@ func getPlanCheckRunsFromChangeDatabaseConfigForDatabase(ctx context.Context, s
 			},
 		})
 	}
+	if databaseGroupUID == nil && config.Type == storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE && instance.Engine == storepb.Engine_POSTGRES {
+		project, err := s.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &plan.ProjectID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get project %q", plan.ProjectID)
+		}
+		if project == nil {
+			return nil, errors.Errorf("project %q not found", plan.ProjectID)
+		}
+		if len(project.Setting.GetQueryPerformanceCheck().GetBenchmarkQueries()) > 0 {
+			planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
+				CreatorUID: api.SystemBotID,
+				UpdaterUID: api.SystemBotID,
+				PlanUID:    plan.UID,
+				Status:     store.PlanCheckRunStatusRunning,
+				Type:       store.PlanCheckDatabaseStatementQueryPlan,
+				Config: &storepb.PlanCheckRunConfig{
+					SheetUid:           int32(sheetUID),
+					ChangeDatabaseType: convertToChangeDatabaseType(config.Type),
+					InstanceUid:        int32(instance.UID),
+					DatabaseName:       database.DatabaseName,
+					DatabaseGroupUid:   databaseGroupUID,
+				},
+			})
+		}
+	}
 
 	return planCheckRuns, nil
 }
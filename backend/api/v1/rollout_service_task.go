@@ -4,11 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"log/slog"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
@@ -17,18 +20,21 @@ import (
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/runner/taskrun"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
-func transformDeploymentConfigTargetToSteps(ctx context.Context, s *store.Store, spec *storepb.PlanConfig_Spec, c *storepb.PlanConfig_ChangeDatabaseConfig, project *store.ProjectMessage) ([]*storepb.PlanConfig_Step, error) {
+// transformDeploymentConfigTargetToSteps transforms the deployment config target to the steps of the deployments.
+// The payloads of the stages of the steps are returned as well, the canary databases of a deployment are rolled out in a canary stage before the rest.
+func transformDeploymentConfigTargetToSteps(ctx context.Context, s *store.Store, spec *storepb.PlanConfig_Spec, c *storepb.PlanConfig_ChangeDatabaseConfig, project *store.ProjectMessage) ([]*storepb.PlanConfig_Step, []*storepb.StagePayload, error) {
 	projectID, _, err := common.GetProjectIDDeploymentConfigID(c.Target)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get project and deployment id from target %q", c.Target)
+		return nil, nil, errors.Wrapf(err, "failed to get project and deployment id from target %q", c.Target)
 	}
 	if project.ResourceID != projectID {
-		return nil, errors.Errorf("project id %q in target %q does not match project id %q in plan config", projectID, c.Target, project.ResourceID)
+		return nil, nil, errors.Errorf("project id %q in target %q does not match project id %q in plan config", projectID, c.Target, project.ResourceID)
 	}
 
 	switch c.Type {
@@ -38,56 +44,105 @@ func transformDeploymentConfigTargetToSteps(ctx context.Context, s *store.Store,
 	case storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_SDL:
 	case storepb.PlanConfig_ChangeDatabaseConfig_DATA:
 	default:
-		return nil, errors.Errorf("unsupported change database config type: %v", c.Type)
+		return nil, nil, errors.Errorf("unsupported change database config type: %v", c.Type)
 	}
 
 	deploymentConfig, err := s.GetDeploymentConfigV2(ctx, project.UID)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get deployment config")
+		return nil, nil, errors.Wrapf(err, "failed to get deployment config")
 	}
 	apiDeploymentConfig, err := deploymentConfig.ToAPIDeploymentConfig()
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to convert deployment config to api deployment config")
+		return nil, nil, errors.Wrapf(err, "failed to convert deployment config to api deployment config")
 	}
 	deploySchedule, err := api.ValidateAndGetDeploymentSchedule(apiDeploymentConfig.Payload)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to validate and get deployment schedule")
+		return nil, nil, errors.Wrapf(err, "failed to validate and get deployment schedule")
 	}
 	allDatabases, err := s.ListDatabases(ctx, &store.FindDatabaseMessage{ProjectID: &project.ResourceID})
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to list databases")
+		return nil, nil, errors.Wrapf(err, "failed to list databases")
+	}
+	engines, err := utils.GetInstanceEngines(ctx, s, allDatabases)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get instance engines")
 	}
-	matrix, err := utils.GetDatabaseMatrixFromDeploymentSchedule(deploySchedule, allDatabases)
+	matrix, err := utils.GetDatabaseMatrixFromDeploymentSchedule(deploySchedule, allDatabases, engines)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get database matrix from deployment schedule")
+		return nil, nil, errors.Wrapf(err, "failed to get database matrix from deployment schedule")
 	}
 
 	var steps []*storepb.PlanConfig_Step
-	for _, databases := range matrix {
+	var stagePayloads []*storepb.StagePayload
+	for i, databases := range matrix {
 		if len(databases) == 0 {
 			continue
 		}
 
-		step := &storepb.PlanConfig_Step{}
-		for _, database := range databases {
-			step.Specs = append(step.Specs, &storepb.PlanConfig_Spec{
-				EarliestAllowedTime: spec.EarliestAllowedTime,
-				Id:                  spec.Id,
-				Config: &storepb.PlanConfig_Spec_ChangeDatabaseConfig{
-					ChangeDatabaseConfig: &storepb.PlanConfig_ChangeDatabaseConfig{
-						Type:            c.Type,
-						Target:          common.FormatDatabase(database.InstanceID, database.DatabaseName),
-						Sheet:           c.Sheet,
-						SchemaVersion:   getOrDefaultSchemaVersion(c.SchemaVersion),
-						RollbackEnabled: c.RollbackEnabled,
-						RollbackDetail:  c.RollbackDetail,
-					},
+		canary := deploySchedule.Deployments[i].Canary
+		canaryDatabases, restDatabases := splitCanaryDatabases(databases, canary)
+		if len(canaryDatabases) > 0 {
+			steps = append(steps, getStepFromDatabases(spec, c, canaryDatabases))
+			stagePayloads = append(stagePayloads, &storepb.StagePayload{
+				Canary: &storepb.StageCanary{
+					BakeDuration:         durationpb.New(time.Duration(canary.BakeSeconds) * time.Second),
+					MaxFailurePercentage: canary.MaxFailurePercentage,
+					PostCheckStatements:  canary.PostCheckStatements,
+					Status:               storepb.StageCanary_BAKING,
 				},
 			})
 		}
-		steps = append(steps, step)
+		steps = append(steps, getStepFromDatabases(spec, c, restDatabases))
+		stagePayloads = append(stagePayloads, nil)
 	}
-	return steps, nil
+	return steps, stagePayloads, nil
+}
+
+func getStepFromDatabases(spec *storepb.PlanConfig_Spec, c *storepb.PlanConfig_ChangeDatabaseConfig, databases []*store.DatabaseMessage) *storepb.PlanConfig_Step {
+	step := &storepb.PlanConfig_Step{}
+	for _, database := range databases {
+		step.Specs = append(step.Specs, &storepb.PlanConfig_Spec{
+			EarliestAllowedTime: spec.EarliestAllowedTime,
+			Id:                  spec.Id,
+			Config: &storepb.PlanConfig_Spec_ChangeDatabaseConfig{
+				ChangeDatabaseConfig: &storepb.PlanConfig_ChangeDatabaseConfig{
+					Type:            c.Type,
+					Target:          common.FormatDatabase(database.InstanceID, database.DatabaseName),
+					Sheet:           c.Sheet,
+					SchemaVersion:   getOrDefaultSchemaVersion(c.SchemaVersion),
+					RollbackEnabled: c.RollbackEnabled,
+					RollbackDetail:  c.RollbackDetail,
+				},
+			},
+		})
+	}
+	return step
+}
+
+// splitCanaryDatabases splits the databases of a deployment into the canary databases and the rest.
+// The canary databases are the named ones, or the first ones of the percentage.
+// There is no canary if the canary covers none or all of the databases.
+func splitCanaryDatabases(databases []*store.DatabaseMessage, canary *api.DeploymentCanary) ([]*store.DatabaseMessage, []*store.DatabaseMessage) {
+	if canary == nil {
+		return nil, databases
+	}
+	var canaryDatabases, restDatabases []*store.DatabaseMessage
+	if len(canary.Databases) > 0 {
+		for _, database := range databases {
+			if slices.Contains(canary.Databases, common.FormatDatabase(database.InstanceID, database.DatabaseName)) {
+				canaryDatabases = append(canaryDatabases, database)
+			} else {
+				restDatabases = append(restDatabases, database)
+			}
+		}
+	} else {
+		count := (len(databases)*int(canary.Percentage) + 99) / 100
+		canaryDatabases, restDatabases = databases[:count], databases[count:]
+	}
+	if len(canaryDatabases) == 0 || len(restDatabases) == 0 {
+		return nil, databases
+	}
+	return canaryDatabases, restDatabases
 }
 
 func getTaskCreatesFromSpec(ctx context.Context, s *store.Store, licenseService enterprise.LicenseService, dbFactory *dbfactory.DBFactory, spec *storepb.PlanConfig_Spec, project *store.ProjectMessage, registerEnvironmentID func(string) error) ([]*store.TaskMessage, []store.TaskIndexDAG, error) {
@@ -104,6 +159,10 @@ func getTaskCreatesFromSpec(ctx context.Context, s *store.Store, licenseService
 		return getTaskCreatesFromChangeDatabaseConfig(ctx, s, spec, config.ChangeDatabaseConfig, project, registerEnvironmentID)
 	case *storepb.PlanConfig_Spec_RestoreDatabaseConfig:
 		return getTaskCreatesFromRestoreDatabaseConfig(ctx, s, licenseService, dbFactory, spec, config.RestoreDatabaseConfig, project, registerEnvironmentID)
+	case *storepb.PlanConfig_Spec_ProvisionDatabaseConfig:
+		return getTaskCreatesFromProvisionDatabaseConfig(ctx, s, spec, config.ProvisionDatabaseConfig, project, registerEnvironmentID)
+	case *storepb.PlanConfig_Spec_MigrateDataConfig:
+		return getTaskCreatesFromMigrateDataConfig(ctx, s, spec, config.MigrateDataConfig, project, registerEnvironmentID)
 	}
 
 	return nil, nil, errors.Errorf("invalid spec config type %T", spec.Config)
@@ -338,10 +397,41 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "failed to get sheet id from sheet %q", c.Sheet)
 		}
+		if c.OnlineMigrationConfig != nil && instance.Engine != storepb.Engine_POSTGRES {
+			return nil, nil, errors.Errorf("online migration is only supported for PostgreSQL")
+		}
+		if err := validateTransactional(c, instance); err != nil {
+			return nil, nil, err
+		}
+		if err := validateResumable(c); err != nil {
+			return nil, nil, err
+		}
+		if err := validateMigrationChecks(c); err != nil {
+			return nil, nil, err
+		}
 		payload := api.TaskDatabaseSchemaUpdatePayload{
-			SpecID:        spec.Id,
-			SheetID:       sheetUID,
-			SchemaVersion: getOrDefaultSchemaVersion(c.SchemaVersion),
+			SpecID:                spec.Id,
+			SheetID:               sheetUID,
+			SchemaVersion:         getOrDefaultSchemaVersion(c.SchemaVersion),
+			OnlineMigrationConfig: convertToOnlineMigrationConfig(c.OnlineMigrationConfig),
+			Transactional:         c.Transactional,
+			Resumable:             c.Resumable,
+			PreChecks:             convertToMigrationChecks(c.PreChecks),
+			PostChecks:            convertToMigrationChecks(c.PostChecks),
+			RollbackEnabled:       c.RollbackEnabled,
+			RollbackSQLStatus:     api.RollbackSQLStatusPending,
+		}
+		if c.RollbackDetail != nil {
+			issueID, err := common.GetIssueID(c.RollbackDetail.RollbackFromIssue)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to get issue id from issue %q", c.RollbackDetail.RollbackFromIssue)
+			}
+			payload.RollbackFromIssueID = issueID
+			taskID, err := common.GetTaskID(c.RollbackDetail.RollbackFromTask)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to get task id from task %q", c.RollbackDetail.RollbackFromTask)
+			}
+			payload.RollbackFromTaskID = taskID
 		}
 		bytes, err := json.Marshal(payload)
 		if err != nil {
@@ -445,6 +535,18 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "failed to get sheet id from sheet %q", c.Sheet)
 		}
+		if c.BatchConfig != nil && c.BatchConfig.BatchSize <= 0 {
+			return nil, nil, errors.Errorf("batch size must be positive")
+		}
+		if err := validateTransactional(c, instance); err != nil {
+			return nil, nil, err
+		}
+		if err := validateResumable(c); err != nil {
+			return nil, nil, err
+		}
+		if err := validateMigrationChecks(c); err != nil {
+			return nil, nil, err
+		}
 		preUpdateBackupDetail := api.PreUpdateBackupDetail{}
 		if c.GetPreUpdateBackupDetail().GetDatabase() != "" {
 			preUpdateBackupDetail.Database = c.GetPreUpdateBackupDetail().GetDatabase()
@@ -456,6 +558,11 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 			RollbackEnabled:       c.RollbackEnabled,
 			RollbackSQLStatus:     api.RollbackSQLStatusPending,
 			PreUpdateBackupDetail: preUpdateBackupDetail,
+			BatchConfig:           convertToBatchDMLConfig(c.BatchConfig),
+			Transactional:         c.Transactional,
+			Resumable:             c.Resumable,
+			PreChecks:             convertToMigrationChecks(c.PreChecks),
+			PostChecks:            convertToMigrationChecks(c.PostChecks),
 		}
 		if c.RollbackDetail != nil {
 			issueID, err := common.GetIssueID(c.RollbackDetail.RollbackFromIssue)
@@ -520,7 +627,7 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseGroupTarget(ctx context.Conte
 		return nil, nil, errors.Wrapf(err, "failed to list databases for project %q", project.ResourceID)
 	}
 
-	matchedDatabases, _, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, databaseGroup, allDatabases)
+	matchedDatabases, _, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, s, databaseGroup, allDatabases)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "failed to get matched and unmatched databases in database group %q", databaseGroupID)
 	}
@@ -615,11 +722,30 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseGroupStatements(db *store.Dat
 		schemaGroupName := schemaGroupNames[idx]
 		switch c.Type {
 		case storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE:
+			if c.OnlineMigrationConfig != nil && instance.Engine != storepb.Engine_POSTGRES {
+				return nil, errors.Errorf("online migration is only supported for PostgreSQL")
+			}
+			if err := validateTransactional(c, instance); err != nil {
+				return nil, err
+			}
+			if err := validateResumable(c); err != nil {
+				return nil, err
+			}
+			if err := validateMigrationChecks(c); err != nil {
+				return nil, err
+			}
 			payload := api.TaskDatabaseSchemaUpdatePayload{
-				SpecID:          spec.Id,
-				SheetID:         0,
-				SchemaVersion:   getOrDefaultSchemaVersionWithSuffix(c.SchemaVersion, schemaVersionSuffix),
-				SchemaGroupName: schemaGroupName,
+				SpecID:                spec.Id,
+				SheetID:               0,
+				SchemaVersion:         getOrDefaultSchemaVersionWithSuffix(c.SchemaVersion, schemaVersionSuffix),
+				SchemaGroupName:       schemaGroupName,
+				OnlineMigrationConfig: convertToOnlineMigrationConfig(c.OnlineMigrationConfig),
+				Transactional:         c.Transactional,
+				Resumable:             c.Resumable,
+				PreChecks:             convertToMigrationChecks(c.PreChecks),
+				PostChecks:            convertToMigrationChecks(c.PostChecks),
+				RollbackEnabled:       c.RollbackEnabled,
+				RollbackSQLStatus:     api.RollbackSQLStatusPending,
 			}
 			bytes, err := json.Marshal(payload)
 			if err != nil {
@@ -639,6 +765,15 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseGroupStatements(db *store.Dat
 			creates = append(creates, taskCreate)
 
 		case storepb.PlanConfig_ChangeDatabaseConfig_DATA:
+			if err := validateTransactional(c, instance); err != nil {
+				return nil, err
+			}
+			if err := validateResumable(c); err != nil {
+				return nil, err
+			}
+			if err := validateMigrationChecks(c); err != nil {
+				return nil, err
+			}
 			payload := api.TaskDatabaseDataUpdatePayload{
 				SpecID:            spec.Id,
 				SheetID:           0,
@@ -646,6 +781,11 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseGroupStatements(db *store.Dat
 				RollbackEnabled:   c.RollbackEnabled,
 				RollbackSQLStatus: api.RollbackSQLStatusPending,
 				SchemaGroupName:   schemaGroupName,
+				BatchConfig:       convertToBatchDMLConfig(c.BatchConfig),
+				Transactional:     c.Transactional,
+				Resumable:         c.Resumable,
+				PreChecks:         convertToMigrationChecks(c.PreChecks),
+				PostChecks:        convertToMigrationChecks(c.PostChecks),
 			}
 
 			bytes, err := json.Marshal(payload)
@@ -884,6 +1024,155 @@ func getTaskCreatesFromRestoreDatabaseConfig(ctx context.Context, s *store.Store
 	return taskCreates, taskIndexDAGs, nil
 }
 
+func getTaskCreatesFromProvisionDatabaseConfig(ctx context.Context, s *store.Store, spec *storepb.PlanConfig_Spec, c *storepb.PlanConfig_ProvisionDatabaseConfig, project *store.ProjectMessage, registerEnvironmentID func(string) error) ([]*store.TaskMessage, []store.TaskIndexDAG, error) {
+	getDatabase := func(name string) (*store.InstanceMessage, *store.DatabaseMessage, error) {
+		instanceID, databaseName, err := common.GetInstanceDatabaseID(name)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get instance and database id from %q", name)
+		}
+		instance, err := s.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get instance %q", instanceID)
+		}
+		if instance == nil {
+			return nil, nil, errors.Errorf("instance %q not found", instanceID)
+		}
+		database, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+			InstanceID:          &instanceID,
+			DatabaseName:        &databaseName,
+			IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+		})
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get database %q", databaseName)
+		}
+		if database == nil {
+			return nil, nil, errors.Errorf("database %q not found", databaseName)
+		}
+		if database.ProjectID != project.ResourceID {
+			return nil, nil, errors.Errorf("database %q is not in project %q", databaseName, project.ResourceID)
+		}
+		return instance, database, nil
+	}
+
+	instance, database, err := getDatabase(c.Target)
+	if err != nil {
+		return nil, nil, err
+	}
+	sourceInstance, sourceDatabase, err := getDatabase(c.Source)
+	if err != nil {
+		return nil, nil, err
+	}
+	if sourceDatabase.UID == database.UID {
+		return nil, nil, errors.Errorf("the source and target database must be different")
+	}
+	if !taskrun.DataProvisionSupportedEngines[instance.Engine] || sourceInstance.Engine != instance.Engine {
+		return nil, nil, errors.Errorf("copying data from %s to %s is not supported", sourceInstance.Engine, instance.Engine)
+	}
+
+	if err := registerEnvironmentID(database.EffectiveEnvironmentID); err != nil {
+		return nil, nil, err
+	}
+
+	payload := api.TaskDatabaseDataProvisionPayload{
+		SpecID:           spec.Id,
+		SourceDatabaseID: sourceDatabase.UID,
+		Tables:           c.Tables,
+	}
+	bytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to marshal task database data provision payload")
+	}
+	taskCreate := &store.TaskMessage{
+		Name:              fmt.Sprintf("Copy masked data from %q to %q", sourceDatabase.DatabaseName, database.DatabaseName),
+		InstanceID:        instance.UID,
+		DatabaseID:        &database.UID,
+		Status:            api.TaskPendingApproval,
+		Type:              api.TaskDatabaseDataProvision,
+		EarliestAllowedTs: spec.EarliestAllowedTime.GetSeconds(),
+		Payload:           string(bytes),
+	}
+	return []*store.TaskMessage{taskCreate}, nil, nil
+}
+
+func getTaskCreatesFromMigrateDataConfig(ctx context.Context, s *store.Store, spec *storepb.PlanConfig_Spec, c *storepb.PlanConfig_MigrateDataConfig, project *store.ProjectMessage, registerEnvironmentID func(string) error) ([]*store.TaskMessage, []store.TaskIndexDAG, error) {
+	getDatabase := func(name string) (*store.InstanceMessage, *store.DatabaseMessage, error) {
+		instanceID, databaseName, err := common.GetInstanceDatabaseID(name)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get instance and database id from %q", name)
+		}
+		instance, err := s.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get instance %q", instanceID)
+		}
+		if instance == nil {
+			return nil, nil, errors.Errorf("instance %q not found", instanceID)
+		}
+		database, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+			InstanceID:          &instanceID,
+			DatabaseName:        &databaseName,
+			IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+		})
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get database %q", databaseName)
+		}
+		if database == nil {
+			return nil, nil, errors.Errorf("database %q not found", databaseName)
+		}
+		if database.ProjectID != project.ResourceID {
+			return nil, nil, errors.Errorf("database %q is not in project %q", databaseName, project.ResourceID)
+		}
+		return instance, database, nil
+	}
+
+	instance, database, err := getDatabase(c.Target)
+	if err != nil {
+		return nil, nil, err
+	}
+	sourceInstance, sourceDatabase, err := getDatabase(c.Source)
+	if err != nil {
+		return nil, nil, err
+	}
+	if sourceDatabase.UID == database.UID {
+		return nil, nil, errors.Errorf("the source and target database must be different")
+	}
+	if !taskrun.DataProvisionSupportedEngines[instance.Engine] || sourceInstance.Engine != instance.Engine {
+		return nil, nil, errors.Errorf("copying data from %s to %s is not supported", sourceInstance.Engine, instance.Engine)
+	}
+
+	if len(c.Tables) == 0 {
+		return nil, nil, errors.Errorf("the tables to copy must be set")
+	}
+	if c.ChunkSize < 0 || c.ChunkSize > taskrun.MaximumDataMigrateChunkSize {
+		return nil, nil, errors.Errorf("chunk size must be between 0 and %d", taskrun.MaximumDataMigrateChunkSize)
+	}
+
+	if err := registerEnvironmentID(database.EffectiveEnvironmentID); err != nil {
+		return nil, nil, err
+	}
+
+	payload := api.TaskDatabaseDataMigratePayload{
+		SpecID:           spec.Id,
+		SourceDatabaseID: sourceDatabase.UID,
+		Tables:           c.Tables,
+		ChunkSize:        int(c.ChunkSize),
+		ClearTarget:      c.ClearTarget,
+	}
+	bytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to marshal task database data migrate payload")
+	}
+	taskCreate := &store.TaskMessage{
+		Name:              fmt.Sprintf("Copy %d tables from %q to %q", len(c.Tables), sourceDatabase.DatabaseName, database.DatabaseName),
+		InstanceID:        instance.UID,
+		DatabaseID:        &database.UID,
+		Status:            api.TaskPendingApproval,
+		Type:              api.TaskDatabaseDataMigrate,
+		EarliestAllowedTs: spec.EarliestAllowedTime.GetSeconds(),
+		Payload:           string(bytes),
+	}
+	return []*store.TaskMessage{taskCreate}, nil, nil
+}
+
 // checkCharacterSetCollationOwner checks if the character set, collation and owner are legal according to the dbType.
 func checkCharacterSetCollationOwner(dbType storepb.Engine, characterSet, collation, owner string) error {
 	switch dbType {
@@ -1020,3 +1309,80 @@ func getOrDefaultSchemaVersionWithSuffix(v string, suffix string) string {
 	}
 	return common.DefaultMigrationVersion().Version + suffix
 }
+
+func convertToBatchDMLConfig(c *storepb.PlanConfig_ChangeDatabaseConfig_BatchConfig) *api.BatchDMLConfig {
+	if c == nil {
+		return nil
+	}
+	return &api.BatchDMLConfig{
+		BatchSize:       int(c.BatchSize),
+		SleepIntervalMs: c.SleepInterval.AsDuration().Milliseconds(),
+	}
+}
+
+func convertToOnlineMigrationConfig(c *storepb.PlanConfig_ChangeDatabaseConfig_OnlineMigrationConfig) *api.OnlineMigrationConfig {
+	if c == nil {
+		return nil
+	}
+	return &api.OnlineMigrationConfig{
+		PgRepack: c.PgRepack,
+	}
+}
+
+func convertToMigrationChecks(checks []*storepb.PlanConfig_ChangeDatabaseConfig_Check) []*api.MigrationCheck {
+	var migrationChecks []*api.MigrationCheck
+	for _, check := range checks {
+		migrationChecks = append(migrationChecks, &api.MigrationCheck{
+			Statement:     check.Statement,
+			ExpectedValue: check.ExpectedValue,
+		})
+	}
+	return migrationChecks
+}
+
+// validateTransactional validates the transactional migration of the change database config.
+func validateTransactional(c *storepb.PlanConfig_ChangeDatabaseConfig, instance *store.InstanceMessage) error {
+	if !c.Transactional {
+		return nil
+	}
+	if instance.Engine != storepb.Engine_POSTGRES {
+		return errors.Errorf("transactional migration is only supported for PostgreSQL")
+	}
+	if c.BatchConfig != nil || c.OnlineMigrationConfig != nil {
+		return errors.Errorf("transactional migration cannot be used with the batch DML or online migration")
+	}
+	return nil
+}
+
+// validateResumable validates the resumable migration of the change database config.
+func validateResumable(c *storepb.PlanConfig_ChangeDatabaseConfig) error {
+	if !c.Resumable {
+		return nil
+	}
+	if c.Transactional || c.BatchConfig != nil || c.OnlineMigrationConfig != nil {
+		return errors.Errorf("resumable migration cannot be used with the transactional, batch DML or online migration")
+	}
+	// The rollback is generated from a single transaction, which isn't the case for the statements executed one by one.
+	if c.RollbackEnabled {
+		return errors.Errorf("resumable migration cannot be used with the rollback")
+	}
+	return nil
+}
+
+// validateMigrationChecks validates the pre-checks and post-checks of the change database config.
+func validateMigrationChecks(c *storepb.PlanConfig_ChangeDatabaseConfig) error {
+	if len(c.PreChecks) == 0 && len(c.PostChecks) == 0 {
+		return nil
+	}
+	if c.BatchConfig != nil || c.OnlineMigrationConfig != nil {
+		return errors.Errorf("pre-checks and post-checks cannot be used with the batch DML or online migration")
+	}
+	for _, checks := range [][]*storepb.PlanConfig_ChangeDatabaseConfig_Check{c.PreChecks, c.PostChecks} {
+		for _, check := range checks {
+			if strings.TrimSpace(check.Statement) == "" {
+				return errors.Errorf("the statement of the check cannot be empty")
+			}
+		}
+	}
+	return nil
+}
@@ -1,13 +1,20 @@
 package v1
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
 func TestGetStatementsFromSchemaGroups(t *testing.T) {
@@ -136,3 +143,72 @@ ALTER TABLE singleton ADD COLUMN num INT;`,
 		a.Equal(tc.expectedSchemaGroupNames, schemaGroupNames, tc.name)
 	}
 }
+
+func TestValidateSpecDependencies(t *testing.T) {
+	a := require.New(t)
+
+	a.NoError(validateSpecDependencies(map[string][]string{"a": nil, "b": {"a"}, "c": {"a", "b"}}))
+	a.EqualError(validateSpecDependencies(map[string][]string{"a": {"d"}}), `spec "a" depends on spec "d" not in the same step`)
+	a.EqualError(validateSpecDependencies(map[string][]string{"a": {"b"}, "b": {"a"}}), `spec "a" depends on itself`)
+	a.EqualError(validateSpecDependencies(map[string][]string{"a": {"a"}}), `spec "a" depends on itself`)
+}
+
+func TestSplitCanaryDatabases(t *testing.T) {
+	a := require.New(t)
+	var databases []*store.DatabaseMessage
+	for _, name := range []string{"db1", "db2", "db3", "db4", "db5"} {
+		databases = append(databases, &store.DatabaseMessage{InstanceID: "prod", DatabaseName: name})
+	}
+
+	canary, rest := splitCanaryDatabases(databases, nil)
+	a.Nil(canary)
+	a.Equal(databases, rest)
+
+	// 30% of 5 databases rounds up to 2.
+	canary, rest = splitCanaryDatabases(databases, &api.DeploymentCanary{Percentage: 30})
+	a.Equal(databases[:2], canary)
+	a.Equal(databases[2:], rest)
+
+	canary, rest = splitCanaryDatabases(databases, &api.DeploymentCanary{Databases: []string{"instances/prod/databases/db4"}})
+	a.Equal([]*store.DatabaseMessage{databases[3]}, canary)
+	a.Len(rest, 4)
+
+	// No canary stage if the canary covers all or none of the databases.
+	canary, rest = splitCanaryDatabases(databases, &api.DeploymentCanary{Percentage: 100})
+	a.Nil(canary)
+	a.Equal(databases, rest)
+	canary, rest = splitCanaryDatabases(databases, &api.DeploymentCanary{Databases: []string{"instances/test/databases/db1"}})
+	a.Nil(canary)
+	a.Equal(databases, rest)
+}
+
+func TestBatchOperationsOnAllProjects(t *testing.T) {
+	a := require.New(t)
+	iamManager, err := iam.NewManager(nil)
+	a.NoError(err)
+	s := &RolloutService{iamManager: iamManager}
+	newContext := func(user *store.UserMessage) context.Context {
+		ctx := context.WithValue(context.Background(), common.PrincipalIDContextKey, user.ID)
+		return context.WithValue(ctx, common.UserContextKey, user)
+	}
+
+	// The workspace members can't operate on the tasks of all projects, whatever their roles in the projects.
+	member := &store.UserMessage{ID: 101, Role: api.WorkspaceMember, Roles: []api.Role{api.WorkspaceMember}}
+	_, err = s.BatchRetryFailedTasks(newContext(member), &v1pb.BatchRetryFailedTasksRequest{Parent: "projects/-"})
+	a.Equal(codes.PermissionDenied, status.Code(err))
+	_, err = s.BatchCancelPendingTaskRuns(newContext(member), &v1pb.BatchCancelPendingTaskRunsRequest{Parent: "projects/-", Environment: "environments/prod"})
+	a.Equal(codes.PermissionDenied, status.Code(err))
+
+	// The workspace owners and DBAs can.
+	for _, role := range []api.Role{api.WorkspaceAdmin, api.WorkspaceDBA} {
+		user := &store.UserMessage{ID: 102, Role: role, Roles: []api.Role{role}}
+		a.NoError(s.checkWorkspacePermission(newContext(user), iam.PermissionTasksRun))
+		a.NoError(s.checkWorkspacePermission(newContext(user), iam.PermissionTaskRunsCancel))
+		projectUID, err := s.getBatchProjectUID(newContext(user), "projects/-", iam.PermissionTasksRun)
+		a.NoError(err)
+		a.Nil(projectUID)
+	}
+
+	_, err = s.getBatchProjectUID(newContext(member), "invalid", iam.PermissionTasksRun)
+	a.Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"slices"
+	"strings"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -11,6 +12,7 @@ import (
 	"google.golang.org/protobuf/testing/protocmp"
 
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
 type diffAction string
@@ -21,8 +23,43 @@ const (
 	diffActionDrop   diffAction = "DROP"
 )
 
+// mergeObjectType is the type of the object in the merge conflict.
+type mergeObjectType string
+
+const (
+	mergeObjectTypeSchema     mergeObjectType = "SCHEMA"
+	mergeObjectTypeTable      mergeObjectType = "TABLE"
+	mergeObjectTypeColumn     mergeObjectType = "COLUMN"
+	mergeObjectTypeIndex      mergeObjectType = "INDEX"
+	mergeObjectTypeForeignKey mergeObjectType = "FOREIGN_KEY"
+)
+
+// mergeConflict is an object changed differently by both sides of the three-way merge.
+type mergeConflict struct {
+	schema string
+	// table is empty for the schema conflicts.
+	table      string
+	objectType mergeObjectType
+	name       string
+	message    string
+}
+
 // tryMerge merges other metadata to current metadata, always returns a non-nil metadata if no error occurs.
+// It returns an error if there is any conflict, use threeWayMerge to get the conflicts.
 func tryMerge(ancestor, head, base *storepb.DatabaseSchemaMetadata) (*storepb.DatabaseSchemaMetadata, error) {
+	merged, conflicts, err := threeWayMerge(ancestor, head, base)
+	if err != nil {
+		return nil, err
+	}
+	if len(conflicts) > 0 {
+		return nil, errors.Errorf("merge conflict: %s", conflicts[0].message)
+	}
+	return merged, nil
+}
+
+// threeWayMerge merges the changes from ancestor to head and the changes from ancestor to base.
+// The non-conflicting changes of both sides are merged, and the conflicting objects are kept as the base and returned as the conflicts.
+func threeWayMerge(ancestor, head, base *storepb.DatabaseSchemaMetadata) (*storepb.DatabaseSchemaMetadata, []*mergeConflict, error) {
 	ancestor, head, base = proto.Clone(ancestor).(*storepb.DatabaseSchemaMetadata), proto.Clone(head).(*storepb.DatabaseSchemaMetadata), proto.Clone(base).(*storepb.DatabaseSchemaMetadata)
 
 	if ancestor == nil {
@@ -31,23 +68,60 @@ func tryMerge(ancestor, head, base *storepb.DatabaseSchemaMetadata) (*storepb.Da
 
 	diffBetweenAncestorAndHead, err := diffMetadata(ancestor, head)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to diff between ancestor and head")
+		return nil, nil, errors.Wrap(err, "failed to diff between ancestor and head")
 	}
 
 	diffBetweenAncestorAndBase, err := diffMetadata(ancestor, base)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to diff between ancestor and base")
+		return nil, nil, errors.Wrap(err, "failed to diff between ancestor and base")
 	}
 
-	if conflict, msg := diffBetweenAncestorAndBase.tryMerge(diffBetweenAncestorAndHead); conflict {
-		return nil, errors.Errorf("merge conflict: %s", msg)
-	}
+	conflicts := diffBetweenAncestorAndBase.tryMerge(diffBetweenAncestorAndHead)
+	slices.SortFunc(conflicts, func(a, b *mergeConflict) int {
+		if a.schema != b.schema {
+			return strings.Compare(a.schema, b.schema)
+		}
+		if a.table != b.table {
+			return strings.Compare(a.table, b.table)
+		}
+		if a.objectType != b.objectType {
+			return strings.Compare(string(a.objectType), string(b.objectType))
+		}
+		return strings.Compare(a.name, b.name)
+	})
 
 	if err := diffBetweenAncestorAndBase.applyDiffTo(ancestor); err != nil {
-		return nil, errors.Wrap(err, "failed to apply diff to target")
+		return nil, nil, errors.Wrap(err, "failed to apply diff to target")
 	}
 
-	return ancestor, nil
+	return ancestor, conflicts, nil
+}
+
+func convertToBranchMergeConflicts(conflicts []*mergeConflict) []*v1pb.BranchMergeConflict {
+	var result []*v1pb.BranchMergeConflict
+	for _, conflict := range conflicts {
+		objectType := v1pb.BranchMergeConflict_OBJECT_TYPE_UNSPECIFIED
+		switch conflict.objectType {
+		case mergeObjectTypeSchema:
+			objectType = v1pb.BranchMergeConflict_SCHEMA
+		case mergeObjectTypeTable:
+			objectType = v1pb.BranchMergeConflict_TABLE
+		case mergeObjectTypeColumn:
+			objectType = v1pb.BranchMergeConflict_COLUMN
+		case mergeObjectTypeIndex:
+			objectType = v1pb.BranchMergeConflict_INDEX
+		case mergeObjectTypeForeignKey:
+			objectType = v1pb.BranchMergeConflict_FOREIGN_KEY
+		}
+		result = append(result, &v1pb.BranchMergeConflict{
+			ObjectType: objectType,
+			Schema:     conflict.schema,
+			Table:      conflict.table,
+			Name:       conflict.name,
+			Message:    conflict.message,
+		})
+	}
+	return result
 }
 
 type metadataDiffBaseNode struct {
@@ -58,24 +132,22 @@ type metadataDiffRootNode struct {
 	schemas map[string]*metadataDiffSchemaNode
 }
 
-// tryMerge merges other root node to current root node, stop and return error if conflict occurs.
-func (mr *metadataDiffRootNode) tryMerge(other *metadataDiffRootNode) (bool, string) {
+// tryMerge merges other root node to current root node, the conflicting objects are kept as the current root node.
+func (mr *metadataDiffRootNode) tryMerge(other *metadataDiffRootNode) []*mergeConflict {
+	var conflicts []*mergeConflict
 	for _, schema := range mr.schemas {
 		otherSchema, in := other.schemas[schema.name]
 		if !in {
 			continue
 		}
-		conflict, msg := schema.tryMerge(otherSchema)
-		if conflict {
-			return true, msg
-		}
+		conflicts = append(conflicts, schema.tryMerge(otherSchema)...)
 		delete(other.schemas, schema.name)
 	}
 	// Append other schema to current root node.
 	for _, otherSchema := range other.schemas {
 		mr.schemas[otherSchema.name] = otherSchema
 	}
-	return false, ""
+	return conflicts
 }
 
 func (mr *metadataDiffRootNode) applyDiffTo(target *storepb.DatabaseSchemaMetadata) error {
@@ -106,20 +178,20 @@ type metadataDiffSchemaNode struct {
 	// SchemaMetadata contains other object types, likes function, view etc. But we do not support them yet.
 }
 
-func (n *metadataDiffSchemaNode) tryMerge(other *metadataDiffSchemaNode) (bool, string) {
+func (n *metadataDiffSchemaNode) tryMerge(other *metadataDiffSchemaNode) []*mergeConflict {
 	if other == nil {
-		return true, "other node check conflict with schema node not be nil"
+		return []*mergeConflict{n.newConflict("other node check conflict with schema node not be nil")}
 	}
 
 	if n.name != other.name {
-		return true, fmt.Sprintf("non-expected schema node pair, one is %s, the other is %s", n.name, other.name)
+		return []*mergeConflict{n.newConflict(fmt.Sprintf("non-expected schema node pair, one is %s, the other is %s", n.name, other.name))}
 	}
 	if n.action != other.action {
-		return true, fmt.Sprintf("conflict schema action, one is %s, the other is %s", n.action, other.action)
+		return []*mergeConflict{n.newConflict(fmt.Sprintf("conflict schema action, one is %s, the other is %s", n.action, other.action))}
 	}
 
 	if n.action == diffActionDrop {
-		return false, ""
+		return nil
 	}
 
 	// if n.action == diffActionCreate {
@@ -132,15 +204,13 @@ func (n *metadataDiffSchemaNode) tryMerge(other *metadataDiffSchemaNode) (bool,
 	// 	// XXX: Expanding the schema attributes check if we support more attributes.
 	// }
 
+	var conflicts []*mergeConflict
 	for tableName, tableNode := range n.tables {
 		otherTableNode, in := other.tables[tableName]
 		if !in {
 			continue
 		}
-		conflict, msg := tableNode.tryMerge(otherTableNode)
-		if conflict {
-			return true, msg
-		}
+		conflicts = append(conflicts, tableNode.tryMerge(n.name, otherTableNode)...)
 		delete(other.tables, tableName)
 	}
 
@@ -148,7 +218,16 @@ func (n *metadataDiffSchemaNode) tryMerge(other *metadataDiffSchemaNode) (bool,
 		n.tables[remainingTable.name] = remainingTable
 	}
 
-	return false, ""
+	return conflicts
+}
+
+func (n *metadataDiffSchemaNode) newConflict(message string) *mergeConflict {
+	return &mergeConflict{
+		schema:     n.name,
+		objectType: mergeObjectTypeSchema,
+		name:       n.name,
+		message:    message,
+	}
 }
 
 func (n *metadataDiffSchemaNode) applyDiffTo(target *storepb.DatabaseSchemaMetadata) error {
@@ -221,22 +300,92 @@ type metadataDiffTableNode struct {
 	// TableMetaData contains other object types, likes trigger, index etc. But we do not support them yet.
 }
 
-func (n *metadataDiffTableNode) tryMerge(other *metadataDiffTableNode) (bool, string) {
+func (n *metadataDiffTableNode) tryMerge(schemaName string, other *metadataDiffTableNode) []*mergeConflict {
 	if other == nil {
-		return true, "other node check conflict with table node must not be nil"
+		return []*mergeConflict{n.newConflict(schemaName, mergeObjectTypeTable, n.name, "other node check conflict with table node must not be nil")}
 	}
 
 	if n.name != other.name {
-		return true, fmt.Sprintf("non-expected table node pair, one is %s, the other is %s", n.name, other.name)
+		return []*mergeConflict{n.newConflict(schemaName, mergeObjectTypeTable, n.name, fmt.Sprintf("non-expected table node pair, one is %s, the other is %s", n.name, other.name))}
 	}
 	if n.action != other.action {
-		return true, fmt.Sprintf("conflict table action, one is %s, the other is %s", n.action, other.action)
+		return []*mergeConflict{n.newConflict(schemaName, mergeObjectTypeTable, n.name, fmt.Sprintf("conflict table action, one is %s, the other is %s", n.action, other.action))}
 	}
 
 	if n.action == diffActionDrop {
-		return false, ""
+		return nil
+	}
+
+	var conflicts []*mergeConflict
+	// The conflicting object is kept as the current node, so the attributes merged before the conflict are restored.
+	head := proto.Clone(n.head).(*storepb.TableMetadata)
+	if conflict, msg := n.mergeAttributes(other); conflict {
+		n.head = head
+		conflicts = append(conflicts, n.newConflict(schemaName, mergeObjectTypeTable, n.name, msg))
 	}
 
+	for _, columnName := range n.columnNames {
+		columnNode := n.columnsMap[columnName]
+		otherColumnNode, in := other.columnsMap[columnName]
+		if !in {
+			continue
+		}
+		head := proto.Clone(columnNode.head).(*storepb.ColumnMetadata)
+		if conflict, msg := columnNode.tryMerge(otherColumnNode); conflict {
+			columnNode.head = head
+			conflicts = append(conflicts, n.newConflict(schemaName, mergeObjectTypeColumn, columnName, msg))
+		}
+		delete(other.columnsMap, columnName)
+	}
+
+	for foreignKeyName, foreignKeyNode := range n.foreignKeys {
+		otherForeignKeyNode, in := other.foreignKeys[foreignKeyName]
+		if !in {
+			continue
+		}
+		head := proto.Clone(foreignKeyNode.head).(*storepb.ForeignKeyMetadata)
+		if conflict, msg := foreignKeyNode.tryMerge(otherForeignKeyNode); conflict {
+			foreignKeyNode.head = head
+			conflicts = append(conflicts, n.newConflict(schemaName, mergeObjectTypeForeignKey, foreignKeyName, msg))
+		}
+		delete(other.foreignKeys, foreignKeyName)
+	}
+
+	for indexName, indexNode := range n.indexes {
+		otherIndexNode, in := other.indexes[indexName]
+		if !in {
+			continue
+		}
+		head := proto.Clone(indexNode.head).(*storepb.IndexMetadata)
+		if conflict, msg := indexNode.tryMerge(otherIndexNode); conflict {
+			indexNode.head = head
+			conflicts = append(conflicts, n.newConflict(schemaName, mergeObjectTypeIndex, indexName, msg))
+		}
+		delete(other.indexes, indexName)
+	}
+
+	for _, columnName := range other.columnNames {
+		// We had deleted the column node which appeared in both table nodes.
+		if columnNode, in := other.columnsMap[columnName]; in {
+			n.columnsMap[columnName] = columnNode
+			n.columnNames = append(n.columnNames, columnName)
+			continue
+		}
+	}
+
+	for _, remainingForeignKey := range other.foreignKeys {
+		n.foreignKeys[remainingForeignKey.name] = remainingForeignKey
+	}
+
+	for _, remainingIndex := range other.indexes {
+		n.indexes[remainingIndex.name] = remainingIndex
+	}
+
+	return conflicts
+}
+
+// mergeAttributes merges the table attributes of other table node to current table node.
+func (n *metadataDiffTableNode) mergeAttributes(other *metadataDiffTableNode) (bool, string) {
 	if n.action == diffActionCreate {
 		// If two actions are CREATE or UPDATE both, we need to check the table attributes is conflict.
 		// XXX: Expanding the table attributes check if we support more attributes.
@@ -309,61 +458,17 @@ func (n *metadataDiffTableNode) tryMerge(other *metadataDiffTableNode) (bool, st
 		}
 	}
 
-	for _, columnName := range n.columnNames {
-		columnNode := n.columnsMap[columnName]
-		otherColumnNode, in := other.columnsMap[columnName]
-		if !in {
-			continue
-		}
-		conflict, msg := columnNode.tryMerge(otherColumnNode)
-		if conflict {
-			return true, msg
-		}
-		delete(other.columnsMap, columnName)
-	}
-
-	for foreignKeyName, foreignKeyNode := range n.foreignKeys {
-		otherForeignKeyNode, in := other.foreignKeys[foreignKeyName]
-		if !in {
-			continue
-		}
-		conflict, msg := foreignKeyNode.tryMerge(otherForeignKeyNode)
-		if conflict {
-			return true, msg
-		}
-		delete(other.foreignKeys, foreignKeyName)
-	}
-
-	for indexName, indexNode := range n.indexes {
-		otherIndexNode, in := other.indexes[indexName]
-		if !in {
-			continue
-		}
-		conflict, msg := indexNode.tryMerge(otherIndexNode)
-		if conflict {
-			return true, msg
-		}
-		delete(other.indexes, indexName)
-	}
-
-	for _, columnName := range other.columnNames {
-		// We had deleted the column node which appeared in both table nodes.
-		if columnNode, in := other.columnsMap[columnName]; in {
-			n.columnsMap[columnName] = columnNode
-			n.columnNames = append(n.columnNames, columnName)
-			continue
-		}
-	}
-
-	for _, remainingForeignKey := range other.foreignKeys {
-		n.foreignKeys[remainingForeignKey.name] = remainingForeignKey
-	}
+	return false, ""
+}
 
-	for _, remainingIndex := range other.indexes {
-		n.indexes[remainingIndex.name] = remainingIndex
+func (n *metadataDiffTableNode) newConflict(schemaName string, objectType mergeObjectType, name, message string) *mergeConflict {
+	return &mergeConflict{
+		schema:     schemaName,
+		table:      n.name,
+		objectType: objectType,
+		name:       name,
+		message:    message,
 	}
-
-	return false, ""
 }
 
 func (n *metadataDiffTableNode) applyDiffTo(target *storepb.SchemaMetadata) error {
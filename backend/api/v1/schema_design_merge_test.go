@@ -8,6 +8,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 	"gopkg.in/yaml.v3"
 
@@ -77,3 +78,98 @@ func TestTryMerge(t *testing.T) {
 		a.NoError(err)
 	}
 }
+
+func TestThreeWayMerge(t *testing.T) {
+	a := require.New(t)
+
+	ancestor := &storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "t1",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "int", Position: 1},
+							{Name: "a", Type: "int", Position: 2},
+							{Name: "b", Type: "int", Position: 3},
+						},
+					},
+					{
+						Name: "t2",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "int", Position: 1},
+						},
+					},
+				},
+			},
+		},
+	}
+	head := proto.Clone(ancestor).(*storepb.DatabaseSchemaMetadata)
+	headT1 := head.Schemas[0].Tables[0]
+	headT1.Columns[1].Type = "bigint"
+	headT1.Columns[2].Comment = "comment from head"
+	headT1.Columns = append(headT1.Columns, &storepb.ColumnMetadata{Name: "c", Type: "text", Position: 4})
+	head.Schemas[0].Tables = head.Schemas[0].Tables[:1]
+
+	base := proto.Clone(ancestor).(*storepb.DatabaseSchemaMetadata)
+	baseT1 := base.Schemas[0].Tables[0]
+	baseT1.Columns[1].Type = "varchar(255)"
+	baseT1.Columns[2].Nullable = true
+	baseT1.Indexes = append(baseT1.Indexes, &storepb.IndexMetadata{Name: "idx_b", Expressions: []string{"b"}})
+	base.Schemas[0].Tables[1].Columns[0].Type = "bigint"
+
+	merged, conflicts, err := threeWayMerge(ancestor, head, base)
+	a.NoError(err)
+	a.Equal([]*mergeConflict{
+		{
+			schema:     "public",
+			table:      "t1",
+			objectType: mergeObjectTypeColumn,
+			name:       "a",
+			message:    "conflict column type, one is varchar(255), the other is bigint",
+		},
+		{
+			schema:     "public",
+			table:      "t2",
+			objectType: mergeObjectTypeTable,
+			name:       "t2",
+			message:    "conflict table action, one is UPDATE, the other is DROP",
+		},
+	}, conflicts)
+
+	// The non-conflicting changes of both sides are merged, and the conflicting objects are kept as the base.
+	want := &storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "t1",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "int", Position: 1},
+							{Name: "a", Type: "varchar(255)", Position: 2},
+							{Name: "b", Type: "int", Position: 3, Nullable: true, Comment: "comment from head"},
+							{Name: "c", Type: "text", Position: 4},
+						},
+						Indexes: []*storepb.IndexMetadata{
+							{Name: "idx_b", Expressions: []string{"b"}},
+						},
+					},
+					{
+						Name: "t2",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "bigint", Position: 1},
+						},
+					},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, merged, protocmp.Transform()); diff != "" {
+		a.Failf("Failed", "mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = tryMerge(ancestor, head, base)
+	a.EqualError(err, "merge conflict: conflict column type, one is varchar(255), the other is bigint")
+}
@@ -6,6 +6,7 @@ import (
 	"embed"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"regexp"
 	"strings"
 	"time"
@@ -23,6 +24,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/auditexport"
 	"github.com/bytebase/bytebase/backend/plugin/mail"
 	"github.com/bytebase/bytebase/backend/plugin/schema"
 	"github.com/bytebase/bytebase/backend/store"
@@ -37,6 +39,12 @@ type SettingService struct {
 	profile        *config.Profile
 	licenseService enterprise.LicenseService
 	stateCfg       *state.State
+
+	// The services used to export and apply the workspace configuration.
+	environmentService *EnvironmentService
+	instanceService    *InstanceService
+	projectService     *ProjectService
+	orgPolicyService   *OrgPolicyService
 }
 
 // NewSettingService creates a new setting service.
@@ -45,12 +53,20 @@ func NewSettingService(
 	profile *config.Profile,
 	licenseService enterprise.LicenseService,
 	stateCfg *state.State,
+	environmentService *EnvironmentService,
+	instanceService *InstanceService,
+	projectService *ProjectService,
+	orgPolicyService *OrgPolicyService,
 ) *SettingService {
 	return &SettingService{
-		store:          store,
-		profile:        profile,
-		licenseService: licenseService,
-		stateCfg:       stateCfg,
+		store:              store,
+		profile:            profile,
+		licenseService:     licenseService,
+		stateCfg:           stateCfg,
+		environmentService: environmentService,
+		instanceService:    instanceService,
+		projectService:     projectService,
+		orgPolicyService:   orgPolicyService,
 	}
 }
 
@@ -69,6 +85,19 @@ var whitelistSettings = []api.SettingName{
 	api.SettingDataClassification,
 	api.SettingSemanticTypes,
 	api.SettingMaskingAlgorithm,
+	api.SettingRateLimit,
+	api.SettingQueryAudit,
+	api.SettingQueryCursor,
+	api.SettingQueryHistory,
+	api.SettingArchive,
+	api.SettingJira,
+	api.SettingServiceNow,
+	api.SettingSlackApp,
+	api.SettingEventBus,
+	api.SettingSCIM,
+	api.SettingAuditLog,
+	api.SettingMaintenance,
+	api.SettingInstanceDiscovery,
 }
 
 var preservedMaskingAlgorithmIDMatcher = regexp.MustCompile("^[0]{8}-[0]{4}-[0]{4}-[0]{4}-[0]{9}[0-9a-fA-F]{3}$")
@@ -188,6 +217,13 @@ func (s *SettingService) SetSetting(ctx context.Context, request *v1pb.SetSettin
 		if payload.TokenDuration != nil && payload.TokenDuration.Seconds > 0 && payload.TokenDuration.AsDuration() < time.Hour {
 			return nil, status.Errorf(codes.InvalidArgument, "refresh token duration should be at least one hour")
 		}
+		if payload.MaximumSessionCount < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "maximum session count should not be negative")
+		}
+		// The last active time of the sessions is updated every minute, so a shorter idle timeout would sign out the active users.
+		if payload.SessionIdleTimeout != nil && payload.SessionIdleTimeout.Seconds > 0 && payload.SessionIdleTimeout.AsDuration() < 5*time.Minute {
+			return nil, status.Errorf(codes.InvalidArgument, "session idle timeout should be at least five minutes")
+		}
 		storeSettingValue = string(bytes)
 	case api.SettingWorkspaceApproval:
 		if err := s.licenseService.IsFeatureEnabled(api.FeatureCustomApproval); err != nil {
@@ -368,18 +404,13 @@ func (s *SettingService) SetSetting(ctx context.Context, request *v1pb.SetSettin
 			}
 		}
 		if len(removed) > 0 {
-			externalApprovalType := api.ExternalApprovalTypeRelay
-			approvals, err := s.store.ListExternalApprovalV2(
-				ctx,
-				&store.ListExternalApprovalMessage{
-					Type: &externalApprovalType,
-				},
-			)
+			// Both the relay and the jira external approvals are bound to the nodes.
+			approvals, err := s.store.ListExternalApprovalV2(ctx, &store.ListExternalApprovalMessage{})
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to list external approvals: %v", err)
 			}
 			for _, approval := range approvals {
-				payload := &api.ExternalApprovalPayloadRelay{}
+				payload := &api.ExternalApprovalPayloadJira{}
 				if err := json.Unmarshal([]byte(approval.Payload), payload); err != nil {
 					return nil, status.Errorf(codes.Internal, "failed to unmarshal external approval payload: %v", err)
 				}
@@ -467,6 +498,296 @@ func (s *SettingService) SetSetting(ctx context.Context, request *v1pb.SetSettin
 			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
 		}
 		storeSettingValue = string(bytes)
+	case api.SettingRateLimit:
+		if err := validateRateLimitSetting(request.Setting.Value.GetRateLimitSettingValue()); err != nil {
+			return nil, err
+		}
+		storeRateLimitSetting := new(storepb.RateLimitSetting)
+		if err := convertV1PbToStorePb(request.Setting.Value.GetRateLimitSettingValue(), storeRateLimitSetting); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", apiSettingName, err)
+		}
+		bytes, err := protojson.Marshal(storeRateLimitSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingQueryAudit:
+		if err := validateQueryAuditSetting(request.Setting.Value.GetQueryAuditSettingValue()); err != nil {
+			return nil, err
+		}
+		storeQueryAuditSetting := new(storepb.QueryAuditSetting)
+		if err := convertV1PbToStorePb(request.Setting.Value.GetQueryAuditSettingValue(), storeQueryAuditSetting); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", apiSettingName, err)
+		}
+		bytes, err := protojson.Marshal(storeQueryAuditSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingQueryCursor:
+		if err := validateQueryCursorSetting(request.Setting.Value.GetQueryCursorSettingValue()); err != nil {
+			return nil, err
+		}
+		storeQueryCursorSetting := new(storepb.QueryCursorSetting)
+		if err := convertV1PbToStorePb(request.Setting.Value.GetQueryCursorSettingValue(), storeQueryCursorSetting); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", apiSettingName, err)
+		}
+		bytes, err := protojson.Marshal(storeQueryCursorSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingQueryHistory:
+		if err := validateQueryHistorySetting(request.Setting.Value.GetQueryHistorySettingValue()); err != nil {
+			return nil, err
+		}
+		storeQueryHistorySetting := new(storepb.QueryHistorySetting)
+		if err := convertV1PbToStorePb(request.Setting.Value.GetQueryHistorySettingValue(), storeQueryHistorySetting); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", apiSettingName, err)
+		}
+		bytes, err := protojson.Marshal(storeQueryHistorySetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingArchive:
+		if err := validateArchiveSetting(request.Setting.Value.GetArchiveSettingValue()); err != nil {
+			return nil, err
+		}
+		storeArchiveSetting := new(storepb.ArchiveSetting)
+		if err := convertV1PbToStorePb(request.Setting.Value.GetArchiveSettingValue(), storeArchiveSetting); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", apiSettingName, err)
+		}
+		bytes, err := protojson.Marshal(storeArchiveSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingJira:
+		apiValue := request.Setting.Value.GetJiraSettingValue()
+		if apiValue == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "value cannot be nil when setting jira setting")
+		}
+		// We will fill the API token read from the store if it is not set.
+		if apiValue.ApiToken == nil {
+			oldValue, err := s.store.GetJiraSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get setting %q: %v", apiSettingName, err)
+			}
+			if oldValue.ApiToken == "" && apiValue.Url != "" {
+				return nil, status.Errorf(codes.InvalidArgument, "should set the api token for the first time")
+			}
+			apiValue.ApiToken = &oldValue.ApiToken
+		}
+		if err := validateJiraSetting(apiValue); err != nil {
+			return nil, err
+		}
+		storeJiraSetting := new(storepb.JiraSetting)
+		if err := convertV1PbToStorePb(apiValue, storeJiraSetting); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", apiSettingName, err)
+		}
+		bytes, err := protojson.Marshal(storeJiraSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingServiceNow:
+		apiValue := request.Setting.Value.GetServiceNowSettingValue()
+		if apiValue == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "value cannot be nil when setting servicenow setting")
+		}
+		// We will fill the password read from the store if it is not set.
+		if apiValue.Password == nil {
+			oldValue, err := s.store.GetServiceNowSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get setting %q: %v", apiSettingName, err)
+			}
+			if oldValue.Password == "" && apiValue.Url != "" {
+				return nil, status.Errorf(codes.InvalidArgument, "should set the password for the first time")
+			}
+			apiValue.Password = &oldValue.Password
+		}
+		if err := validateServiceNowSetting(apiValue); err != nil {
+			return nil, err
+		}
+		storeServiceNowSetting := new(storepb.ServiceNowSetting)
+		if err := convertV1PbToStorePb(apiValue, storeServiceNowSetting); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", apiSettingName, err)
+		}
+		bytes, err := protojson.Marshal(storeServiceNowSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingSlackApp:
+		apiValue := request.Setting.Value.GetSlackAppSettingValue()
+		if apiValue == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "value cannot be nil when setting slack app setting")
+		}
+		// We will fill the bot token and signing secret read from the store if they are not set.
+		if apiValue.BotToken == nil || apiValue.SigningSecret == nil {
+			oldValue, err := s.store.GetSlackAppSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get setting %q: %v", apiSettingName, err)
+			}
+			if apiValue.BotToken == nil {
+				apiValue.BotToken = &oldValue.BotToken
+			}
+			if apiValue.SigningSecret == nil {
+				apiValue.SigningSecret = &oldValue.SigningSecret
+			}
+		}
+		if err := validateSlackAppSetting(apiValue); err != nil {
+			return nil, err
+		}
+		storeSlackAppSetting := new(storepb.SlackAppSetting)
+		if err := convertV1PbToStorePb(apiValue, storeSlackAppSetting); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", apiSettingName, err)
+		}
+		bytes, err := protojson.Marshal(storeSlackAppSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingEventBus:
+		apiValue := request.Setting.Value.GetEventBusSettingValue()
+		if apiValue == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "value cannot be nil when setting event bus setting")
+		}
+		// We will fill the password read from the store if it is not set.
+		if apiValue.Password == nil {
+			oldValue, err := s.store.GetEventBusSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get setting %q: %v", apiSettingName, err)
+			}
+			apiValue.Password = &oldValue.Password
+		}
+		if err := validateEventBusSetting(apiValue); err != nil {
+			return nil, err
+		}
+		storeEventBusSetting := new(storepb.EventBusSetting)
+		if err := convertV1PbToStorePb(apiValue, storeEventBusSetting); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", apiSettingName, err)
+		}
+		bytes, err := protojson.Marshal(storeEventBusSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingSCIM:
+		apiValue := request.Setting.Value.GetScimSettingValue()
+		if apiValue == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "value cannot be nil when setting SCIM setting")
+		}
+		// We will fill the token read from the store if it is not set.
+		if apiValue.Token == nil {
+			oldValue, err := s.store.GetSCIMSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get setting %q: %v", apiSettingName, err)
+			}
+			apiValue.Token = &oldValue.Token
+		}
+		if apiValue.GetToken() != "" {
+			if err := s.licenseService.IsFeatureEnabled(api.FeatureSSO); err != nil {
+				return nil, status.Errorf(codes.PermissionDenied, err.Error())
+			}
+		}
+		if err := validateSCIMSetting(apiValue); err != nil {
+			return nil, err
+		}
+		storeSCIMSetting := new(storepb.SCIMSetting)
+		if err := convertV1PbToStorePb(apiValue, storeSCIMSetting); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", apiSettingName, err)
+		}
+		bytes, err := protojson.Marshal(storeSCIMSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingAuditLog:
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureAuditLog); err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		apiValue := request.Setting.Value.GetAuditLogSettingValue()
+		if apiValue == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "value cannot be nil when setting audit log setting")
+		}
+		// We will fill the S3 secret access key read from the store if it is not set.
+		if apiValue.S3 != nil && apiValue.S3.SecretAccessKey == nil {
+			oldValue, err := s.store.GetAuditLogSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get setting %q: %v", apiSettingName, err)
+			}
+			secretAccessKey := oldValue.GetS3().GetSecretAccessKey()
+			apiValue.S3.SecretAccessKey = &secretAccessKey
+		}
+		if err := validateAuditLogSetting(apiValue); err != nil {
+			return nil, err
+		}
+		storeAuditLogSetting := new(storepb.AuditLogSetting)
+		if err := convertV1PbToStorePb(apiValue, storeAuditLogSetting); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", apiSettingName, err)
+		}
+		bytes, err := protojson.Marshal(storeAuditLogSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingMaintenance:
+		apiValue := request.Setting.Value.GetMaintenanceSettingValue()
+		if apiValue == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "value cannot be nil when setting maintenance setting")
+		}
+		storeMaintenanceSetting := new(storepb.MaintenanceSetting)
+		if err := convertV1PbToStorePb(apiValue, storeMaintenanceSetting); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", apiSettingName, err)
+		}
+		bytes, err := protojson.Marshal(storeMaintenanceSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingInstanceDiscovery:
+		apiValue := request.Setting.Value.GetInstanceDiscoverySettingValue()
+		if apiValue == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "value cannot be nil when setting instance discovery setting")
+		}
+		oldValue, err := s.store.GetInstanceDiscoverySetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get setting %q: %v", apiSettingName, err)
+		}
+		// We will fill the secrets read from the store of the same source if they are not set.
+		oldSources := map[string]*storepb.InstanceDiscoverySetting_Source{}
+		for _, source := range oldValue.Sources {
+			oldSources[source.Id] = source
+		}
+		for _, source := range apiValue.Sources {
+			oldSource := oldSources[source.Id]
+			if source.SecretAccessKey == nil {
+				secretAccessKey := oldSource.GetSecretAccessKey()
+				source.SecretAccessKey = &secretAccessKey
+			}
+			if source.ServiceAccountKey == nil {
+				serviceAccountKey := oldSource.GetServiceAccountKey()
+				source.ServiceAccountKey = &serviceAccountKey
+			}
+			if source.Password == nil {
+				password := oldSource.GetPassword()
+				source.Password = &password
+			}
+		}
+		if err := s.validateInstanceDiscoverySetting(ctx, apiValue); err != nil {
+			return nil, err
+		}
+		storeInstanceDiscoverySetting := new(storepb.InstanceDiscoverySetting)
+		if err := convertV1PbToStorePb(apiValue, storeInstanceDiscoverySetting); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", apiSettingName, err)
+		}
+		bytes, err := protojson.Marshal(storeInstanceDiscoverySetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
 	default:
 		storeSettingValue = request.Setting.Value.GetStringValue()
 	}
@@ -693,6 +1014,175 @@ func (s *SettingService) convertToSettingMessage(ctx context.Context, setting *s
 				},
 			},
 		}, nil
+	case api.SettingRateLimit:
+		v1Value := new(v1pb.RateLimitSetting)
+		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_RateLimitSettingValue{
+					RateLimitSettingValue: v1Value,
+				},
+			},
+		}, nil
+	case api.SettingQueryAudit:
+		v1Value := new(v1pb.QueryAuditSetting)
+		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_QueryAuditSettingValue{
+					QueryAuditSettingValue: v1Value,
+				},
+			},
+		}, nil
+	case api.SettingQueryCursor:
+		v1Value := new(v1pb.QueryCursorSetting)
+		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_QueryCursorSettingValue{
+					QueryCursorSettingValue: v1Value,
+				},
+			},
+		}, nil
+	case api.SettingQueryHistory:
+		v1Value := new(v1pb.QueryHistorySetting)
+		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_QueryHistorySettingValue{
+					QueryHistorySettingValue: v1Value,
+				},
+			},
+		}, nil
+	case api.SettingArchive:
+		v1Value := new(v1pb.ArchiveSetting)
+		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_ArchiveSettingValue{
+					ArchiveSettingValue: v1Value,
+				},
+			},
+		}, nil
+	case api.SettingJira:
+		v1Value := new(v1pb.JiraSetting)
+		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return stripSensitiveData(&v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_JiraSettingValue{
+					JiraSettingValue: v1Value,
+				},
+			},
+		})
+	case api.SettingServiceNow:
+		v1Value := new(v1pb.ServiceNowSetting)
+		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return stripSensitiveData(&v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_ServiceNowSettingValue{
+					ServiceNowSettingValue: v1Value,
+				},
+			},
+		})
+	case api.SettingSlackApp:
+		v1Value := new(v1pb.SlackAppSetting)
+		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return stripSensitiveData(&v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_SlackAppSettingValue{
+					SlackAppSettingValue: v1Value,
+				},
+			},
+		})
+	case api.SettingEventBus:
+		v1Value := new(v1pb.EventBusSetting)
+		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return stripSensitiveData(&v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_EventBusSettingValue{
+					EventBusSettingValue: v1Value,
+				},
+			},
+		})
+	case api.SettingSCIM:
+		v1Value := new(v1pb.SCIMSetting)
+		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return stripSensitiveData(&v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_ScimSettingValue{
+					ScimSettingValue: v1Value,
+				},
+			},
+		})
+	case api.SettingAuditLog:
+		v1Value := new(v1pb.AuditLogSetting)
+		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return stripSensitiveData(&v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_AuditLogSettingValue{
+					AuditLogSettingValue: v1Value,
+				},
+			},
+		})
+	case api.SettingMaintenance:
+		v1Value := new(v1pb.MaintenanceSetting)
+		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_MaintenanceSettingValue{
+					MaintenanceSettingValue: v1Value,
+				},
+			},
+		}, nil
+	case api.SettingInstanceDiscovery:
+		v1Value := new(v1pb.InstanceDiscoverySetting)
+		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return stripSensitiveData(&v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_InstanceDiscoverySettingValue{
+					InstanceDiscoverySettingValue: v1Value,
+				},
+			},
+		})
 
 	default:
 		return &v1pb.Setting{
@@ -1000,6 +1490,7 @@ func convertToExternalApprovalSettingNode(o *storepb.ExternalApprovalSetting_Nod
 		Id:       o.Id,
 		Title:    o.Title,
 		Endpoint: o.Endpoint,
+		Mode:     v1pb.ExternalApprovalSetting_Node_Mode(o.Mode),
 	}
 }
 
@@ -1022,6 +1513,7 @@ func convertExternalApprovalSettingNode(o *v1pb.ExternalApprovalSetting_Node) *s
 		Id:       o.Id,
 		Title:    o.Title,
 		Endpoint: o.Endpoint,
+		Mode:     storepb.ExternalApprovalSetting_Node_Mode(o.Mode),
 	}
 }
 
@@ -1043,6 +1535,55 @@ func stripSensitiveData(setting *v1pb.Setting) (*v1pb.Setting, error) {
 		mailDeliveryValue.SmtpMailDeliverySettingValue.Cert = nil
 		mailDeliveryValue.SmtpMailDeliverySettingValue.Key = nil
 		setting.Value.Value = mailDeliveryValue
+	case api.SettingJira:
+		jiraValue, ok := setting.Value.Value.(*v1pb.Value_JiraSettingValue)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid setting value type: %T", setting.Value.Value)
+		}
+		jiraValue.JiraSettingValue.ApiToken = nil
+	case api.SettingServiceNow:
+		serviceNowValue, ok := setting.Value.Value.(*v1pb.Value_ServiceNowSettingValue)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid setting value type: %T", setting.Value.Value)
+		}
+		serviceNowValue.ServiceNowSettingValue.Password = nil
+	case api.SettingSlackApp:
+		slackAppValue, ok := setting.Value.Value.(*v1pb.Value_SlackAppSettingValue)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid setting value type: %T", setting.Value.Value)
+		}
+		slackAppValue.SlackAppSettingValue.BotToken = nil
+		slackAppValue.SlackAppSettingValue.SigningSecret = nil
+	case api.SettingEventBus:
+		eventBusValue, ok := setting.Value.Value.(*v1pb.Value_EventBusSettingValue)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid setting value type: %T", setting.Value.Value)
+		}
+		eventBusValue.EventBusSettingValue.Password = nil
+	case api.SettingSCIM:
+		scimValue, ok := setting.Value.Value.(*v1pb.Value_ScimSettingValue)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid setting value type: %T", setting.Value.Value)
+		}
+		scimValue.ScimSettingValue.Token = nil
+	case api.SettingAuditLog:
+		auditLogValue, ok := setting.Value.Value.(*v1pb.Value_AuditLogSettingValue)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid setting value type: %T", setting.Value.Value)
+		}
+		if auditLogValue.AuditLogSettingValue.S3 != nil {
+			auditLogValue.AuditLogSettingValue.S3.SecretAccessKey = nil
+		}
+	case api.SettingInstanceDiscovery:
+		instanceDiscoveryValue, ok := setting.Value.Value.(*v1pb.Value_InstanceDiscoverySettingValue)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid setting value type: %T", setting.Value.Value)
+		}
+		for _, source := range instanceDiscoveryValue.InstanceDiscoverySettingValue.Sources {
+			source.SecretAccessKey = nil
+			source.ServiceAccountKey = nil
+			source.Password = nil
+		}
 	default:
 	}
 	return setting, nil
@@ -1110,6 +1651,250 @@ func convertV1SchemaTemplateSetting(template *v1pb.SchemaTemplateSetting) *store
 	return v1Setting
 }
 
+func validateRateLimitSetting(setting *v1pb.RateLimitSetting) error {
+	validateQuota := func(quota *v1pb.RateLimitSetting_Quota) error {
+		if quota.GetRequestsPerMinute() < 0 || quota.GetBurst() < 0 {
+			return status.Errorf(codes.InvalidArgument, "requests per minute and burst must not be negative")
+		}
+		return nil
+	}
+	if err := validateQuota(setting.GetUserQuota()); err != nil {
+		return err
+	}
+	if err := validateQuota(setting.GetServiceAccountQuota()); err != nil {
+		return err
+	}
+	principalMap := make(map[string]struct{})
+	for _, override := range setting.GetOverrides() {
+		if override.Principal == "" {
+			return status.Errorf(codes.InvalidArgument, "rate limit override principal must not be empty")
+		}
+		if _, ok := principalMap[override.Principal]; ok {
+			return status.Errorf(codes.InvalidArgument, "duplicate rate limit override for principal %s", override.Principal)
+		}
+		principalMap[override.Principal] = struct{}{}
+		if err := validateQuota(override.GetQuota()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateQueryAuditSetting(setting *v1pb.QueryAuditSetting) error {
+	if setting.GetSampleRate() < 0 || setting.GetSampleRate() > 1 {
+		return status.Errorf(codes.InvalidArgument, "sample rate must be between 0 and 1")
+	}
+	if setting.GetEnabled() && (setting.GetAlertScore() < 1 || setting.GetAlertScore() > 100) {
+		return status.Errorf(codes.InvalidArgument, "alert score must be between 1 and 100")
+	}
+	if setting.GetClassifiedRowsThreshold() < 0 || setting.GetExportRowsThreshold() < 0 {
+		return status.Errorf(codes.InvalidArgument, "rows thresholds must not be negative")
+	}
+	for _, hour := range []int32{setting.GetWorkingHourStart(), setting.GetWorkingHourEnd()} {
+		if hour < 0 || hour > 23 {
+			return status.Errorf(codes.InvalidArgument, "working hours must be between 0 and 23")
+		}
+	}
+	if _, err := time.LoadLocation(setting.GetTimeZone()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid time zone %q", setting.GetTimeZone())
+	}
+	return nil
+}
+
+func validateQueryCursorSetting(setting *v1pb.QueryCursorSetting) error {
+	if setting.GetMaxTotalRows() < 0 || setting.GetMaxTotalBytes() < 0 {
+		return status.Errorf(codes.InvalidArgument, "max total rows and bytes must not be negative")
+	}
+	if setting.GetIdleTimeoutSeconds() < 0 {
+		return status.Errorf(codes.InvalidArgument, "idle timeout must not be negative")
+	}
+	return nil
+}
+
+func validateQueryHistorySetting(setting *v1pb.QueryHistorySetting) error {
+	if setting.GetRetentionDays() < 0 {
+		return status.Errorf(codes.InvalidArgument, "retention days must not be negative")
+	}
+	return nil
+}
+
+func validateArchiveSetting(setting *v1pb.ArchiveSetting) error {
+	if setting.GetIssueRetentionDays() < 0 || setting.GetDatabaseRetentionDays() < 0 {
+		return status.Errorf(codes.InvalidArgument, "retention days must not be negative")
+	}
+	return nil
+}
+
+// validateJiraSetting validates the Jira setting, the empty URL disables the Jira integration.
+func validateJiraSetting(setting *v1pb.JiraSetting) error {
+	if setting.Url == "" {
+		return nil
+	}
+	if _, err := url.ParseRequestURI(setting.Url); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid Jira URL %q", setting.Url)
+	}
+	if setting.Email == "" || setting.GetApiToken() == "" {
+		return status.Errorf(codes.InvalidArgument, "email and api token are required")
+	}
+	if setting.ProjectKey == "" || setting.IssueType == "" {
+		return status.Errorf(codes.InvalidArgument, "project key and issue type are required")
+	}
+	if len(setting.WebhookToken) < 16 {
+		return status.Errorf(codes.InvalidArgument, "webhook token must be at least 16 characters")
+	}
+	return nil
+}
+
+// validateServiceNowSetting validates the ServiceNow setting, the empty URL disables the ServiceNow integration.
+func validateServiceNowSetting(setting *v1pb.ServiceNowSetting) error {
+	if setting.Url == "" {
+		return nil
+	}
+	if _, err := url.ParseRequestURI(setting.Url); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid ServiceNow URL %q", setting.Url)
+	}
+	if setting.Username == "" || setting.GetPassword() == "" {
+		return status.Errorf(codes.InvalidArgument, "username and password are required")
+	}
+	if setting.WebhookToken != "" && len(setting.WebhookToken) < 16 {
+		return status.Errorf(codes.InvalidArgument, "webhook token must be at least 16 characters")
+	}
+	return nil
+}
+
+// validateSlackAppSetting validates the Slack app setting, the empty signing secret disables the Slack app.
+func validateSlackAppSetting(setting *v1pb.SlackAppSetting) error {
+	if setting.GetSigningSecret() == "" {
+		return nil
+	}
+	if !strings.HasPrefix(setting.GetBotToken(), "xoxb-") {
+		return status.Errorf(codes.InvalidArgument, "bot token must be a Slack bot user OAuth token starting with \"xoxb-\"")
+	}
+	return nil
+}
+
+// validateEventBusSetting validates the event bus setting, the unspecified type disables the event bus.
+func validateEventBusSetting(setting *v1pb.EventBusSetting) error {
+	if setting.Type == v1pb.EventBusSetting_TYPE_UNSPECIFIED {
+		return nil
+	}
+	if strings.TrimSpace(setting.Url) == "" {
+		return status.Errorf(codes.InvalidArgument, "url is required")
+	}
+	if setting.Type == v1pb.EventBusSetting_KAFKA && setting.Topic == "" {
+		return status.Errorf(codes.InvalidArgument, "topic is required for Kafka")
+	}
+	if strings.ContainsAny(setting.Topic, "*> \t") {
+		return status.Errorf(codes.InvalidArgument, "invalid topic %q", setting.Topic)
+	}
+	if setting.Username == "" && setting.GetPassword() != "" {
+		return status.Errorf(codes.InvalidArgument, "username is required with the password")
+	}
+	return nil
+}
+
+// validateSCIMSetting validates the group mappings of the SCIM setting.
+func validateSCIMSetting(setting *v1pb.SCIMSetting) error {
+	groups := make(map[string]bool)
+	for _, mapping := range setting.GroupMappings {
+		if mapping.Group == "" {
+			return status.Errorf(codes.InvalidArgument, "group is required in the group mapping")
+		}
+		if groups[mapping.Group] {
+			return status.Errorf(codes.InvalidArgument, "duplicate group mapping %q", mapping.Group)
+		}
+		groups[mapping.Group] = true
+		if mapping.WorkspaceRole != "" {
+			role, err := common.GetRoleID(mapping.WorkspaceRole)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid workspace role %q of group %q", mapping.WorkspaceRole, mapping.Group)
+			}
+			switch api.Role(role) {
+			case api.WorkspaceAdmin, api.WorkspaceDBA, api.WorkspaceMember:
+			default:
+				return status.Errorf(codes.InvalidArgument, "invalid workspace role %q of group %q", mapping.WorkspaceRole, mapping.Group)
+			}
+		}
+		for _, projectRole := range mapping.ProjectRoles {
+			if _, err := common.GetProjectID(projectRole.Project); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid project %q of group %q", projectRole.Project, mapping.Group)
+			}
+			if _, err := common.GetRoleID(projectRole.Role); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid project role %q of group %q", projectRole.Role, mapping.Group)
+			}
+		}
+	}
+	return nil
+}
+
+// validateAuditLogSetting validates the retention and the export destination of the audit log setting.
+func validateAuditLogSetting(setting *v1pb.AuditLogSetting) error {
+	if setting.Retention != nil && setting.Retention.AsDuration() != 0 && setting.Retention.AsDuration() < 24*time.Hour {
+		return status.Errorf(codes.InvalidArgument, "audit log retention should be at least one day")
+	}
+	switch setting.ExportType {
+	case v1pb.AuditLogSetting_EXPORT_TYPE_UNSPECIFIED:
+	case v1pb.AuditLogSetting_S3:
+		if setting.S3 == nil || setting.S3.Region == "" || setting.S3.Bucket == "" {
+			return status.Errorf(codes.InvalidArgument, "S3 region and bucket are required")
+		}
+		if setting.S3.AccessKeyId == "" || setting.S3.GetSecretAccessKey() == "" {
+			return status.Errorf(codes.InvalidArgument, "S3 access key ID and secret access key are required")
+		}
+	case v1pb.AuditLogSetting_SYSLOG:
+		if setting.Syslog == nil {
+			return status.Errorf(codes.InvalidArgument, "syslog address is required")
+		}
+		if _, _, err := auditexport.ParseSyslogAddress(setting.Syslog.Address); err != nil {
+			return status.Errorf(codes.InvalidArgument, err.Error())
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid audit log export type %v", setting.ExportType)
+	}
+	return nil
+}
+
+// validateInstanceDiscoverySetting validates the cloud accounts and the environments of the instance discovery sources.
+func (s *SettingService) validateInstanceDiscoverySetting(ctx context.Context, setting *v1pb.InstanceDiscoverySetting) error {
+	sourceIDs := map[string]bool{}
+	for _, source := range setting.Sources {
+		if !isValidResourceID(source.Id) {
+			return status.Errorf(codes.InvalidArgument, "invalid instance discovery source id %q", source.Id)
+		}
+		if sourceIDs[source.Id] {
+			return status.Errorf(codes.InvalidArgument, "duplicate instance discovery source id %q", source.Id)
+		}
+		sourceIDs[source.Id] = true
+		switch source.Provider {
+		case v1pb.InstanceDiscoverySetting_Source_AWS:
+			if len(source.Regions) == 0 {
+				return status.Errorf(codes.InvalidArgument, "regions are required for the AWS source %q", source.Id)
+			}
+			if source.AccessKeyId != "" && source.GetSecretAccessKey() == "" {
+				return status.Errorf(codes.InvalidArgument, "secret access key is required for the AWS source %q", source.Id)
+			}
+		case v1pb.InstanceDiscoverySetting_Source_GCP:
+			if source.Project == "" {
+				return status.Errorf(codes.InvalidArgument, "project is required for the GCP source %q", source.Id)
+			}
+		default:
+			return status.Errorf(codes.InvalidArgument, "invalid provider %v of the instance discovery source %q", source.Provider, source.Id)
+		}
+		environmentID, err := common.GetEnvironmentID(source.Environment)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid environment of the instance discovery source %q: %v", source.Id, err)
+		}
+		environment, err := s.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &environmentID})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get environment %q: %v", environmentID, err)
+		}
+		if environment == nil || environment.Deleted {
+			return status.Errorf(codes.InvalidArgument, "environment %q not found", environmentID)
+		}
+	}
+	return nil
+}
+
 func validateMaskingAlgorithm(algorithm *v1pb.MaskingAlgorithmSetting_Algorithm) error {
 	if !isValidUUID(algorithm.Id) {
 		return status.Errorf(codes.InvalidArgument, "invalid masking algorithm id format: %s", algorithm.Id)
@@ -1153,6 +1938,24 @@ func validateMaskingAlgorithm(algorithm *v1pb.MaskingAlgorithmSetting_Algorithm)
 					return status.Errorf(codes.InvalidArgument, "the slice range cannot overlap: [%d,%d) and [%d,%d)", pre.Start, pre.End, slice.Start, slice.End)
 				}
 			}
+		case *v1pb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_:
+			if m.FormatPreservingMask.Key == "" {
+				return status.Errorf(codes.InvalidArgument, "the key for format-preserving mask is required")
+			}
+			if m.FormatPreservingMask.KeepSuffixDigits < 0 {
+				return status.Errorf(codes.InvalidArgument, "the kept suffix digits must not be negative")
+			}
+		case *v1pb.MaskingAlgorithmSetting_Algorithm_DateShiftMask_:
+			if m.DateShiftMask.MaxDays <= 0 {
+				return status.Errorf(codes.InvalidArgument, "the max days for date shift mask must be positive")
+			}
+		case *v1pb.MaskingAlgorithmSetting_Algorithm_RegexMask_:
+			if m.RegexMask.Pattern == "" {
+				return status.Errorf(codes.InvalidArgument, "the pattern for regex mask is required")
+			}
+			if _, err := regexp.Compile(m.RegexMask.Pattern); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid pattern for regex mask %q: %v", m.RegexMask.Pattern, err)
+			}
 		default:
 			return status.Errorf(codes.InvalidArgument, "mismatch masking algorithm category and mask type: %T, %s", algorithm.Mask, algorithm.Category)
 		}
@@ -1162,6 +1965,7 @@ func validateMaskingAlgorithm(algorithm *v1pb.MaskingAlgorithmSetting_Algorithm)
 		}
 		switch algorithm.Mask.(type) {
 		case *v1pb.MaskingAlgorithmSetting_Algorithm_Md5Mask:
+		case *v1pb.MaskingAlgorithmSetting_Algorithm_DeterministicHashMask_:
 		default:
 			return status.Errorf(codes.InvalidArgument, "mismatch masking algorithm category and mask type: %T, %s", algorithm.Mask, algorithm.Category)
 		}
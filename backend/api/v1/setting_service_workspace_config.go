@@ -0,0 +1,443 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"gopkg.in/yaml.v3"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// workspaceConfigPolicyParents are the parents of the policies in the workspace configuration.
+// Database policies are not exported because databases are not part of the configuration.
+var workspaceConfigPolicyParents = []string{
+	"",
+	common.EnvironmentNamePrefix + "-",
+	common.ProjectNamePrefix + "-",
+	common.InstanceNamePrefix + "-",
+}
+
+// ExportWorkspaceConfig exports the workspace configuration as a declarative bundle.
+func (s *SettingService) ExportWorkspaceConfig(ctx context.Context, request *v1pb.ExportWorkspaceConfigRequest) (*v1pb.ExportWorkspaceConfigResponse, error) {
+	config := &v1pb.WorkspaceConfig{}
+
+	environments, err := s.environmentService.ListEnvironments(ctx, &v1pb.ListEnvironmentsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, environment := range environments.Environments {
+		config.Environments = append(config.Environments, normalizeWorkspaceConfigEnvironment(environment))
+	}
+	instances, err := s.instanceService.ListInstances(ctx, &v1pb.ListInstancesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances.Instances {
+		config.Instances = append(config.Instances, normalizeWorkspaceConfigInstance(instance))
+	}
+	projects, err := s.projectService.ListProjects(ctx, &v1pb.ListProjectsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, project := range projects.Projects {
+		if project.Name == common.FormatProject(api.DefaultProjectID) {
+			continue
+		}
+		config.Projects = append(config.Projects, normalizeWorkspaceConfigProject(project))
+	}
+	for _, parent := range workspaceConfigPolicyParents {
+		policies, err := s.orgPolicyService.ListPolicies(ctx, &v1pb.ListPoliciesRequest{Parent: parent})
+		if err != nil {
+			return nil, err
+		}
+		for _, policy := range policies.Policies {
+			config.Policies = append(config.Policies, normalizeWorkspaceConfigPolicy(policy))
+		}
+	}
+
+	content, err := marshalWorkspaceConfig(config, request.Format)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to export workspace config with error: %v", err)
+	}
+	return &v1pb.ExportWorkspaceConfigResponse{
+		Content: string(content),
+	}, nil
+}
+
+// ApplyWorkspaceConfig creates the missing resources and updates the changed fields in the workspace configuration bundle.
+func (s *SettingService) ApplyWorkspaceConfig(ctx context.Context, request *v1pb.ApplyWorkspaceConfigRequest) (*v1pb.ApplyWorkspaceConfigResponse, error) {
+	config, err := unmarshalWorkspaceConfig([]byte(request.Content))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid workspace config: %v", err)
+	}
+
+	applier := &workspaceConfigApplier{
+		s:            s,
+		validateOnly: request.ValidateOnly,
+		created:      make(map[string]bool),
+	}
+	// Apply the resources before the ones depending on them.
+	for _, environment := range config.Environments {
+		if err := applier.applyEnvironment(ctx, environment); err != nil {
+			return nil, err
+		}
+	}
+	for _, instance := range config.Instances {
+		if err := applier.applyInstance(ctx, instance); err != nil {
+			return nil, err
+		}
+	}
+	for _, project := range config.Projects {
+		if err := applier.applyProject(ctx, project); err != nil {
+			return nil, err
+		}
+	}
+	for _, policy := range config.Policies {
+		if err := applier.applyPolicy(ctx, policy); err != nil {
+			return nil, err
+		}
+	}
+	return &v1pb.ApplyWorkspaceConfigResponse{
+		Changes: applier.changes,
+	}, nil
+}
+
+type workspaceConfigApplier struct {
+	s            *SettingService
+	validateOnly bool
+	// created is the set of resources created, or to be created if validateOnly is true.
+	created map[string]bool
+	changes []*v1pb.WorkspaceConfigChange
+}
+
+func (a *workspaceConfigApplier) recordCreate(resource string) {
+	a.created[resource] = true
+	a.changes = append(a.changes, &v1pb.WorkspaceConfigChange{
+		Resource: resource,
+		Action:   v1pb.WorkspaceConfigChange_CREATE,
+	})
+}
+
+func (a *workspaceConfigApplier) recordUpdate(resource string, updateMask []string) {
+	a.changes = append(a.changes, &v1pb.WorkspaceConfigChange{
+		Resource:   resource,
+		Action:     v1pb.WorkspaceConfigChange_UPDATE,
+		UpdateMask: updateMask,
+	})
+}
+
+func (a *workspaceConfigApplier) applyEnvironment(ctx context.Context, environment *v1pb.Environment) error {
+	environmentID, err := common.GetEnvironmentID(environment.Name)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	existing, err := a.s.environmentService.GetEnvironment(ctx, &v1pb.GetEnvironmentRequest{Name: environment.Name})
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			return err
+		}
+		a.recordCreate(environment.Name)
+		if a.validateOnly {
+			return nil
+		}
+		_, err := a.s.environmentService.CreateEnvironment(ctx, &v1pb.CreateEnvironmentRequest{
+			Environment:   environment,
+			EnvironmentId: environmentID,
+		})
+		return err
+	}
+	if existing.State == v1pb.State_DELETED {
+		return status.Errorf(codes.FailedPrecondition, "environment %q has been deleted", environment.Name)
+	}
+
+	updateMask := diffWorkspaceConfigFields(existing, environment, "", []protoreflect.Name{"title", "order", "tier"})
+	if len(updateMask) == 0 {
+		return nil
+	}
+	a.recordUpdate(environment.Name, updateMask)
+	if a.validateOnly {
+		return nil
+	}
+	_, err = a.s.environmentService.UpdateEnvironment(ctx, &v1pb.UpdateEnvironmentRequest{
+		Environment: environment,
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: updateMask},
+	})
+	return err
+}
+
+func (a *workspaceConfigApplier) applyInstance(ctx context.Context, instance *v1pb.Instance) error {
+	instanceID, err := common.GetInstanceID(instance.Name)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	existing, err := a.s.instanceService.GetInstance(ctx, &v1pb.GetInstanceRequest{Name: instance.Name})
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			return err
+		}
+		a.recordCreate(instance.Name)
+		if a.validateOnly {
+			return nil
+		}
+		_, err := a.s.instanceService.CreateInstance(ctx, &v1pb.CreateInstanceRequest{
+			Instance:   instance,
+			InstanceId: instanceID,
+		})
+		return err
+	}
+	if existing.State == v1pb.State_DELETED {
+		return status.Errorf(codes.FailedPrecondition, "instance %q has been deleted", instance.Name)
+	}
+	if existing.Engine != instance.Engine {
+		return status.Errorf(codes.InvalidArgument, "cannot change the engine of instance %q from %s to %s", instance.Name, existing.Engine, instance.Engine)
+	}
+	if existing.Environment != instance.Environment {
+		return status.Errorf(codes.InvalidArgument, "cannot change the environment of instance %q from %s to %s", instance.Name, existing.Environment, instance.Environment)
+	}
+
+	// Data sources are not updated because the bundle has no secrets.
+	updateMask := diffWorkspaceConfigFields(existing, instance, "", []protoreflect.Name{"title", "external_link", "activation"})
+	existingOptions, options := existing.Options, instance.Options
+	if existingOptions == nil {
+		existingOptions = &v1pb.InstanceOptions{}
+	}
+	if options == nil {
+		options = &v1pb.InstanceOptions{}
+	}
+	updateMask = append(updateMask, diffWorkspaceConfigFields(existingOptions, options, "options.", []protoreflect.Name{
+		"schema_tenant_mode",
+		"sync_interval",
+		"sandbox",
+		"ghost_flags",
+		"sync_database_include_patterns",
+		"sync_database_exclude_patterns",
+		"maximum_connections",
+	})...)
+	if len(updateMask) == 0 {
+		return nil
+	}
+	a.recordUpdate(instance.Name, updateMask)
+	if a.validateOnly {
+		return nil
+	}
+	_, err = a.s.instanceService.UpdateInstance(ctx, &v1pb.UpdateInstanceRequest{
+		Instance:   instance,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: updateMask},
+	})
+	return err
+}
+
+func (a *workspaceConfigApplier) applyProject(ctx context.Context, project *v1pb.Project) error {
+	projectID, err := common.GetProjectID(project.Name)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if projectID == api.DefaultProjectID {
+		return status.Errorf(codes.InvalidArgument, "default project cannot be configured")
+	}
+	existing, err := a.s.projectService.GetProject(ctx, &v1pb.GetProjectRequest{Name: project.Name})
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			return err
+		}
+		a.recordCreate(project.Name)
+		if a.validateOnly {
+			return nil
+		}
+		_, err := a.s.projectService.CreateProject(ctx, &v1pb.CreateProjectRequest{
+			Project:   project,
+			ProjectId: projectID,
+		})
+		return err
+	}
+	if existing.State == v1pb.State_DELETED {
+		return status.Errorf(codes.FailedPrecondition, "project %q has been deleted", project.Name)
+	}
+
+	fields := []protoreflect.Name{"title", "key", "workflow", "tenant_mode", "schema_change"}
+	// The data classification config is workspace specific, keep the current one if unset.
+	if project.DataClassificationConfigId != "" {
+		fields = append(fields, "data_classification_config_id")
+	}
+	updateMask := diffWorkspaceConfigFields(existing, project, "", fields)
+	if len(updateMask) == 0 {
+		return nil
+	}
+	a.recordUpdate(project.Name, updateMask)
+	if a.validateOnly {
+		return nil
+	}
+	_, err = a.s.projectService.UpdateProject(ctx, &v1pb.UpdateProjectRequest{
+		Project:    project,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: updateMask},
+	})
+	return err
+}
+
+func (a *workspaceConfigApplier) applyPolicy(ctx context.Context, policy *v1pb.Policy) error {
+	tokens := strings.Split(policy.Name, common.PolicyNamePrefix)
+	if len(tokens) != 2 {
+		return status.Errorf(codes.InvalidArgument, "invalid policy name %q", policy.Name)
+	}
+	parent := strings.TrimSuffix(tokens[0], "/")
+
+	var existing *v1pb.Policy
+	// The parent to be created doesn't exist in validate only mode.
+	if !a.validateOnly || !a.created[parent] {
+		p, err := a.s.orgPolicyService.GetPolicy(ctx, &v1pb.GetPolicyRequest{Name: policy.Name})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		existing = p
+	}
+	if existing == nil {
+		a.recordCreate(policy.Name)
+		if a.validateOnly {
+			return nil
+		}
+		_, err := a.s.orgPolicyService.CreatePolicy(ctx, &v1pb.CreatePolicyRequest{
+			Parent: parent,
+			Policy: policy,
+		})
+		return err
+	}
+
+	updateMask := diffWorkspaceConfigFields(existing, policy, "", []protoreflect.Name{"inherit_from_parent", "enforce"})
+	if !proto.Equal(getPolicyPayload(existing), getPolicyPayload(policy)) {
+		updateMask = append(updateMask, "payload")
+	}
+	if len(updateMask) == 0 {
+		return nil
+	}
+	a.recordUpdate(policy.Name, updateMask)
+	if a.validateOnly {
+		return nil
+	}
+	_, err := a.s.orgPolicyService.UpdatePolicy(ctx, &v1pb.UpdatePolicyRequest{
+		Policy:     policy,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: updateMask},
+	})
+	return err
+}
+
+// diffWorkspaceConfigFields returns the update mask paths of the fields differing between the current and the desired message.
+func diffWorkspaceConfigFields(current, desired proto.Message, prefix string, fields []protoreflect.Name) []string {
+	var paths []string
+	currentMessage, desiredMessage := current.ProtoReflect(), desired.ProtoReflect()
+	for _, field := range fields {
+		fd := desiredMessage.Descriptor().Fields().ByName(field)
+		if !currentMessage.Get(fd).Equal(desiredMessage.Get(fd)) {
+			paths = append(paths, prefix+string(field))
+		}
+	}
+	return paths
+}
+
+// getPolicyPayload returns the policy with the payload only.
+func getPolicyPayload(policy *v1pb.Policy) *v1pb.Policy {
+	payload := proto.Clone(policy).(*v1pb.Policy)
+	payload.Name = ""
+	payload.Uid = ""
+	payload.InheritFromParent = false
+	payload.Enforce = false
+	payload.ResourceType = v1pb.PolicyResourceType_RESOURCE_TYPE_UNSPECIFIED
+	payload.ResourceUid = ""
+	return payload
+}
+
+func normalizeWorkspaceConfigEnvironment(environment *v1pb.Environment) *v1pb.Environment {
+	return &v1pb.Environment{
+		Name:  environment.Name,
+		Title: environment.Title,
+		Order: environment.Order,
+		Tier:  environment.Tier,
+	}
+}
+
+func normalizeWorkspaceConfigInstance(instance *v1pb.Instance) *v1pb.Instance {
+	normalized := proto.Clone(instance).(*v1pb.Instance)
+	normalized.Uid = ""
+	normalized.State = v1pb.State_STATE_UNSPECIFIED
+	normalized.EngineVersion = ""
+	for _, ds := range normalized.DataSources {
+		ds.Password = ""
+		ds.SslCa = ""
+		ds.SslCert = ""
+		ds.SslKey = ""
+		ds.SshPassword = ""
+		ds.SshPrivateKey = ""
+	}
+	return normalized
+}
+
+func normalizeWorkspaceConfigProject(project *v1pb.Project) *v1pb.Project {
+	normalized := proto.Clone(project).(*v1pb.Project)
+	normalized.Uid = ""
+	normalized.State = v1pb.State_STATE_UNSPECIFIED
+	normalized.Webhooks = nil
+	return normalized
+}
+
+func normalizeWorkspaceConfigPolicy(policy *v1pb.Policy) *v1pb.Policy {
+	normalized := proto.Clone(policy).(*v1pb.Policy)
+	normalized.Uid = ""
+	normalized.ResourceType = v1pb.PolicyResourceType_RESOURCE_TYPE_UNSPECIFIED
+	normalized.ResourceUid = ""
+	return normalized
+}
+
+// marshalWorkspaceConfig encodes the workspace config in YAML or JSON.
+func marshalWorkspaceConfig(config *v1pb.WorkspaceConfig, format v1pb.ExportWorkspaceConfigRequest_Format) ([]byte, error) {
+	content, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	if format == v1pb.ExportWorkspaceConfigRequest_JSON {
+		return content, nil
+	}
+
+	// Convert through the YAML node to keep the field order of the JSON.
+	var node yaml.Node
+	if err := yaml.Unmarshal(content, &node); err != nil {
+		return nil, errors.Wrapf(err, "failed to convert workspace config to YAML")
+	}
+	resetYAMLNodeStyle(&node)
+	return yaml.Marshal(&node)
+}
+
+// resetYAMLNodeStyle resets the flow style decoded from JSON to the block style.
+func resetYAMLNodeStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLNodeStyle(child)
+	}
+}
+
+// unmarshalWorkspaceConfig decodes the YAML or JSON workspace config.
+func unmarshalWorkspaceConfig(content []byte) (*v1pb.WorkspaceConfig, error) {
+	// JSON is a subset of YAML, so the YAML decoder handles both formats.
+	var document any
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse workspace config")
+	}
+	bytes, err := json.Marshal(document)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse workspace config")
+	}
+	config := &v1pb.WorkspaceConfig{}
+	if err := protojson.Unmarshal(bytes, config); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse workspace config")
+	}
+	return config, nil
+}
@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestWorkspaceConfigRoundTrip(t *testing.T) {
+	a := require.New(t)
+	config := &v1pb.WorkspaceConfig{
+		Environments: []*v1pb.Environment{
+			{Name: "environments/prod", Title: "Prod", Order: 1, Tier: v1pb.EnvironmentTier_PROTECTED},
+		},
+		Instances: []*v1pb.Instance{
+			normalizeWorkspaceConfigInstance(&v1pb.Instance{
+				Name:          "instances/mysql",
+				Uid:           "101",
+				Title:         "MySQL",
+				Engine:        v1pb.Engine_MYSQL,
+				EngineVersion: "8.0.33",
+				Environment:   "environments/prod",
+				DataSources: []*v1pb.DataSource{
+					{Id: "admin", Type: v1pb.DataSourceType_ADMIN, Username: "root", Password: "secret", SslKey: "key", SshPrivateKey: "key", Host: "127.0.0.1", Port: "3306"},
+				},
+				Options: &v1pb.InstanceOptions{SyncInterval: durationpb.New(3600e9)},
+			}),
+		},
+		Projects: []*v1pb.Project{
+			{Name: "projects/payment", Title: "Payment", Key: "PAY", Workflow: v1pb.Workflow_VCS},
+		},
+		Policies: []*v1pb.Policy{
+			{
+				Name:    "environments/prod/policies/sql_review",
+				Type:    v1pb.PolicyType_SQL_REVIEW,
+				Enforce: true,
+				Policy: &v1pb.Policy_SqlReviewPolicy{SqlReviewPolicy: &v1pb.SQLReviewPolicy{
+					Name:  "Prod",
+					Rules: []*v1pb.SQLReviewRule{{Type: "statement.where.require", Level: v1pb.SQLReviewRuleLevel_ERROR, Engine: v1pb.Engine_MYSQL}},
+				}},
+			},
+		},
+	}
+
+	instance := config.Instances[0]
+	a.Empty(instance.Uid)
+	a.Empty(instance.EngineVersion)
+	a.Empty(instance.DataSources[0].Password)
+	a.Empty(instance.DataSources[0].SslKey)
+	a.Empty(instance.DataSources[0].SshPrivateKey)
+
+	for _, format := range []v1pb.ExportWorkspaceConfigRequest_Format{v1pb.ExportWorkspaceConfigRequest_YAML, v1pb.ExportWorkspaceConfigRequest_JSON} {
+		content, err := marshalWorkspaceConfig(config, format)
+		a.NoError(err)
+		a.NotContains(string(content), "secret")
+		got, err := unmarshalWorkspaceConfig(content)
+		a.NoError(err)
+		a.True(proto.Equal(config, got), "format %s", format)
+	}
+
+	_, err := unmarshalWorkspaceConfig([]byte("environments: [{unknown: 1}]"))
+	a.Error(err)
+}
+
+func TestDiffWorkspaceConfigFields(t *testing.T) {
+	a := require.New(t)
+
+	current := &v1pb.Environment{Name: "environments/prod", Uid: "101", Title: "Prod", Order: 1, Tier: v1pb.EnvironmentTier_UNPROTECTED}
+	desired := &v1pb.Environment{Name: "environments/prod", Title: "Production", Order: 1, Tier: v1pb.EnvironmentTier_PROTECTED}
+	a.Equal([]string{"title", "tier"}, diffWorkspaceConfigFields(current, desired, "", []protoreflect.Name{"title", "order", "tier"}))
+	a.Empty(diffWorkspaceConfigFields(desired, desired, "", []protoreflect.Name{"title", "order", "tier"}))
+
+	currentOptions := &v1pb.InstanceOptions{GhostFlags: map[string]string{"chunk-size": "1000"}, SyncInterval: durationpb.New(3600e9)}
+	desiredOptions := &v1pb.InstanceOptions{GhostFlags: map[string]string{"chunk-size": "2000"}, SyncInterval: durationpb.New(3600e9)}
+	a.Equal([]string{"options.ghost_flags"}, diffWorkspaceConfigFields(currentOptions, desiredOptions, "options.", []protoreflect.Name{"sync_interval", "ghost_flags"}))
+
+	currentPolicy := &v1pb.Policy{
+		Name:        "environments/prod/policies/disable_copy_data",
+		Uid:         "1",
+		ResourceUid: "101",
+		Type:        v1pb.PolicyType_DISABLE_COPY_DATA,
+		Policy:      &v1pb.Policy_DisableCopyDataPolicy{DisableCopyDataPolicy: &v1pb.DisableCopyDataPolicy{Active: true}},
+	}
+	desiredPolicy := normalizeWorkspaceConfigPolicy(currentPolicy)
+	a.True(proto.Equal(getPolicyPayload(currentPolicy), getPolicyPayload(desiredPolicy)))
+	desiredPolicy.GetDisableCopyDataPolicy().Active = false
+	a.False(proto.Equal(getPolicyPayload(currentPolicy), getPolicyPayload(desiredPolicy)))
+}
@@ -9,11 +9,13 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/sheetparam"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
@@ -369,6 +371,17 @@ func (s *SheetService) UpdateSheet(ctx context.Context, request *v1pb.UpdateShee
 			}
 			stringVisibility := string(visibility)
 			sheetPatch.Visibility = &stringVisibility
+		case "parameters":
+			parameters := convertToStoreSheetParameters(request.Sheet.Parameters)
+			if err := sheetparam.ValidateDefinitions(parameters); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			payload := &storepb.SheetPayload{}
+			if sheet.Payload != nil {
+				payload = proto.Clone(sheet.Payload).(*storepb.SheetPayload)
+			}
+			payload.Parameters = parameters
+			sheetPatch.Payload = payload
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid update mask path %q", path))
 		}
@@ -638,8 +651,12 @@ func (s *SheetService) convertToAPISheetMessage(ctx context.Context, sheet *stor
 	}
 	var v1SheetPayload *v1pb.SheetPayload
 	var v1PushEvent *v1pb.PushEvent
+	var v1Parameters []*v1pb.SheetParameter
+	shared := false
 	if sheet.Payload != nil {
 		payload := sheet.Payload
+		v1Parameters = convertToV1SheetParameters(payload.Parameters)
+		shared = payload.ShareToken != ""
 		if payload.VcsPayload != nil && payload.VcsPayload.PushEvent != nil {
 			v1PushEvent = convertToPushEvent(payload.VcsPayload.PushEvent)
 		}
@@ -666,6 +683,8 @@ func (s *SheetService) convertToAPISheetMessage(ctx context.Context, sheet *stor
 		Starred:     sheet.Starred,
 		PushEvent:   v1PushEvent,
 		Payload:     v1SheetPayload,
+		Parameters:  v1Parameters,
+		Shared:      shared,
 	}, nil
 }
 
@@ -711,10 +730,48 @@ func convertToStoreSheetMessage(projectUID int, databaseUID *int, creatorID int,
 			BaselineDatabaseConfig: convertV1DatabaseConfig(sheet.Payload.BaselineDatabaseConfig),
 		}
 	}
+	if len(sheet.Parameters) > 0 {
+		parameters := convertToStoreSheetParameters(sheet.Parameters)
+		if err := sheetparam.ValidateDefinitions(parameters); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if sheetMessage.Payload == nil {
+			sheetMessage.Payload = &storepb.SheetPayload{}
+		}
+		sheetMessage.Payload.Parameters = parameters
+	}
 
 	return sheetMessage, nil
 }
 
+func convertToStoreSheetParameters(parameters []*v1pb.SheetParameter) []*storepb.SheetParameter {
+	var result []*storepb.SheetParameter
+	for _, parameter := range parameters {
+		result = append(result, &storepb.SheetParameter{
+			Name:         parameter.Name,
+			Type:         storepb.SheetParameter_Type(parameter.Type),
+			DefaultValue: parameter.DefaultValue,
+			Required:     parameter.Required,
+			Description:  parameter.Description,
+		})
+	}
+	return result
+}
+
+func convertToV1SheetParameters(parameters []*storepb.SheetParameter) []*v1pb.SheetParameter {
+	var result []*v1pb.SheetParameter
+	for _, parameter := range parameters {
+		result = append(result, &v1pb.SheetParameter{
+			Name:         parameter.Name,
+			Type:         v1pb.SheetParameter_Type(parameter.Type),
+			DefaultValue: parameter.DefaultValue,
+			Required:     parameter.Required,
+			Description:  parameter.Description,
+		})
+	}
+	return result
+}
+
 func convertToStoreSheetVisibility(visibility v1pb.Sheet_Visibility) (store.SheetVisibility, error) {
 	switch visibility {
 	case v1pb.Sheet_VISIBILITY_UNSPECIFIED:
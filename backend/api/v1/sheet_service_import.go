@@ -0,0 +1,178 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/dataimport"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// importDataPreviewStatementCount is the number of statements previewed in the response.
+const importDataPreviewStatementCount = 3
+
+// ImportData generates the batched INSERT statements importing the data into the table, and creates the artifact sheet of them.
+// The client creates the plan and the issue of the sheet, so that the import goes through the same approval flow as the other data changes.
+func (s *SheetService) ImportData(ctx context.Context, request *v1pb.ImportDataRequest) (*v1pb.ImportDataResponse, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	role, ok := ctx.Value(common.RoleContextKey).(api.Role)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "role not found")
+	}
+
+	projectResourceID, err := common.GetProjectID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectResourceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project with resource id %q, err: %v", projectResourceID, err)
+	}
+	if project == nil || project.Deleted {
+		return nil, status.Errorf(codes.NotFound, "project with resource id %q not found", projectResourceID)
+	}
+	if !isOwnerOrDBA(role) {
+		policy, err := s.store.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{UID: &project.UID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get project policy: %v", err)
+		}
+		if !isProjectOwnerOrDeveloper(principalID, policy) {
+			return nil, status.Errorf(codes.PermissionDenied, "only the owners and developers of project %q can import data", projectResourceID)
+		}
+	}
+
+	instanceResourceID, databaseName, err := common.GetInstanceDatabaseID(request.Database)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
+		ResourceID: &instanceResourceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance with resource id %q, err: %v", instanceResourceID, err)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance with resource id %q not found", instanceResourceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		ProjectID:           &projectResourceID,
+		InstanceID:          &instanceResourceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database with name %q, err: %v", databaseName, err)
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database with name %q not found in project %q instance %q", databaseName, projectResourceID, instanceResourceID)
+	}
+	if err := s.checkImportDataColumns(ctx, database, request); err != nil {
+		return nil, err
+	}
+
+	var delimiter rune
+	switch request.Format {
+	case v1pb.ImportDataRequest_CSV:
+		delimiter = ','
+	case v1pb.ImportDataRequest_TSV:
+		delimiter = '\t'
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported format %s", request.Format)
+	}
+	records, err := dataimport.Parse(request.Content, delimiter, request.SkipHeader)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if len(records) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no rows to import")
+	}
+	options := &dataimport.Options{
+		Engine:      instance.Engine,
+		Schema:      request.Schema,
+		Table:       request.Table,
+		EmptyAsNull: request.EmptyAsNull,
+		BatchSize:   int(request.BatchSize),
+	}
+	for _, mapping := range request.ColumnMappings {
+		options.ColumnMappings = append(options.ColumnMappings, &dataimport.ColumnMapping{
+			Column:     mapping.Column,
+			FieldIndex: int(mapping.FieldIndex),
+		})
+	}
+	result, err := dataimport.Generate(records, options)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	preview := result.Statements
+	if len(preview) > importDataPreviewStatementCount {
+		preview = preview[:importDataPreviewStatementCount]
+	}
+	response := &v1pb.ImportDataResponse{
+		RowCount:         int64(result.RowCount),
+		StatementCount:   int64(len(result.Statements)),
+		StatementPreview: strings.Join(preview, "\n"),
+	}
+	if request.ValidateOnly {
+		return response, nil
+	}
+
+	title := request.Title
+	if title == "" {
+		title = fmt.Sprintf("Import data into %s", request.Table)
+	}
+	sheet, err := s.store.CreateSheet(ctx, &store.SheetMessage{
+		ProjectUID:  project.UID,
+		DatabaseUID: &database.UID,
+		CreatorID:   principalID,
+		Title:       title,
+		Statement:   strings.Join(result.Statements, "\n"),
+		Visibility:  store.ProjectSheet,
+		Source:      store.SheetFromBytebaseArtifact,
+		Type:        store.SheetForSQL,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create sheet: %v", err)
+	}
+	if response.Sheet, err = s.convertToAPISheetMessage(ctx, sheet); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// checkImportDataColumns checks the target table and the mapped columns exist in the synced schema of the database.
+func (s *SheetService) checkImportDataColumns(ctx context.Context, database *store.DatabaseMessage, request *v1pb.ImportDataRequest) error {
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get database schema: %v", err)
+	}
+	if dbSchema == nil {
+		return status.Errorf(codes.FailedPrecondition, "schema of database %q is not synced", database.DatabaseName)
+	}
+	schema := dbSchema.GetDatabaseMetadata().GetSchema(request.Schema)
+	if schema == nil {
+		return status.Errorf(codes.NotFound, "schema %q not found in database %q", request.Schema, database.DatabaseName)
+	}
+	table := schema.GetTable(request.Table)
+	if table == nil {
+		return status.Errorf(codes.NotFound, "table %q not found in database %q", request.Table, database.DatabaseName)
+	}
+	for _, mapping := range request.ColumnMappings {
+		if table.GetColumn(mapping.Column) == nil {
+			return status.Errorf(codes.NotFound, "column %q not found in table %q", mapping.Column, request.Table)
+		}
+	}
+	return nil
+}
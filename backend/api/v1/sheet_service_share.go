@@ -0,0 +1,186 @@
+package v1
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const sheetShareTokenLength = 32
+
+// ShareSheet creates the share link of the sheet, or returns the existing one unless regenerating.
+// The share link lets the project members read and run the sheet, but not edit it.
+func (s *SheetService) ShareSheet(ctx context.Context, request *v1pb.ShareSheetRequest) (*v1pb.SheetShareLink, error) {
+	project, sheet, err := s.getWritableSheet(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &storepb.SheetPayload{}
+	if sheet.Payload != nil {
+		payload = proto.Clone(sheet.Payload).(*storepb.SheetPayload)
+	}
+	if payload.ShareToken == "" || request.Regenerate {
+		token, err := common.RandomString(sheetShareTokenLength)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate share token: %v", err)
+		}
+		payload.ShareToken = token
+		principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+		if !ok {
+			return nil, status.Errorf(codes.Internal, "principal ID not found")
+		}
+		if _, err := s.store.PatchSheet(ctx, &store.PatchSheetMessage{
+			UID:       sheet.UID,
+			UpdaterID: principalID,
+			Payload:   payload,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update sheet: %v", err)
+		}
+	}
+
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace general setting: %v", err)
+	}
+	return &v1pb.SheetShareLink{
+		Name:  fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, project.ResourceID, common.SheetIDPrefix, sheet.UID),
+		Token: payload.ShareToken,
+		Link:  fmt.Sprintf("%s/sql-editor/sheet/%d?token=%s", setting.ExternalUrl, sheet.UID, payload.ShareToken),
+	}, nil
+}
+
+// UnshareSheet revokes the share link of the sheet.
+func (s *SheetService) UnshareSheet(ctx context.Context, request *v1pb.UnshareSheetRequest) (*emptypb.Empty, error) {
+	_, sheet, err := s.getWritableSheet(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if sheet.Payload == nil || sheet.Payload.ShareToken == "" {
+		return &emptypb.Empty{}, nil
+	}
+
+	payload := proto.Clone(sheet.Payload).(*storepb.SheetPayload)
+	payload.ShareToken = ""
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	if _, err := s.store.PatchSheet(ctx, &store.PatchSheetMessage{
+		UID:       sheet.UID,
+		UpdaterID: principalID,
+		Payload:   payload,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update sheet: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetSharedSheet returns the shared sheet to the project members with the share token.
+func (s *SheetService) GetSharedSheet(ctx context.Context, request *v1pb.GetSharedSheetRequest) (*v1pb.Sheet, error) {
+	sheet, err := getSharedSheet(ctx, s.store, request.Name, request.Token)
+	if err != nil {
+		return nil, err
+	}
+	return s.convertToAPISheetMessage(ctx, sheet)
+}
+
+// getWritableSheet gets the sheet in the project, which the caller can write.
+func (s *SheetService) getWritableSheet(ctx context.Context, name string) (*store.ProjectMessage, *store.SheetMessage, error) {
+	projectResourceID, sheetUID, err := common.GetProjectResourceIDSheetUID(name)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectResourceID,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get project with resource id %q, err: %v", projectResourceID, err)
+	}
+	if project == nil || project.Deleted {
+		return nil, nil, status.Errorf(codes.NotFound, "project with resource id %q not found", projectResourceID)
+	}
+	sheet, err := s.findSheet(ctx, &store.FindSheetMessage{
+		UID:        &sheetUID,
+		ProjectUID: &project.UID,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	canAccess, err := s.canWriteSheet(ctx, sheet)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to check access with error: %v", err)
+	}
+	if !canAccess {
+		return nil, nil, status.Errorf(codes.PermissionDenied, "cannot write sheet %s", sheet.Title)
+	}
+	return project, sheet, nil
+}
+
+// getSharedSheet gets the full sheet shared with the token, which the caller can read as a member of the sheet project.
+func getSharedSheet(ctx context.Context, stores *store.Store, name, token string) (*store.SheetMessage, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	projectResourceID, sheetUID, err := common.GetProjectResourceIDSheetUID(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token must be set")
+	}
+	project, err := stores.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectResourceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project with resource id %q, err: %v", projectResourceID, err)
+	}
+	if project == nil || project.Deleted {
+		return nil, status.Errorf(codes.NotFound, "project with resource id %q not found", projectResourceID)
+	}
+	sheet, err := stores.GetSheet(ctx, &store.FindSheetMessage{
+		UID:        &sheetUID,
+		ProjectUID: &project.UID,
+		LoadFull:   true,
+	}, principalID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get sheet: %v", err)
+	}
+	// Don't tell the sheet exists without the right token.
+	if sheet == nil || sheet.Payload == nil || sheet.Payload.ShareToken == "" ||
+		subtle.ConstantTimeCompare([]byte(sheet.Payload.ShareToken), []byte(token)) != 1 {
+		return nil, status.Errorf(codes.NotFound, "shared sheet %q not found", name)
+	}
+
+	role, ok := ctx.Value(common.RoleContextKey).(api.Role)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "role not found")
+	}
+	if role == api.WorkspaceAdmin || role == api.WorkspaceDBA || sheet.CreatorID == principalID {
+		return sheet, nil
+	}
+	policy, err := stores.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{UID: &project.UID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project policy: %v", err)
+	}
+	for _, binding := range policy.Bindings {
+		for _, member := range binding.Members {
+			if member.ID == principalID {
+				return sheet, nil
+			}
+		}
+	}
+	return nil, status.Errorf(codes.PermissionDenied, "only the members of project %q can access the shared sheet", projectResourceID)
+}
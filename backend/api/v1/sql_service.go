@@ -21,16 +21,21 @@ import (
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/xuri/excelize/v2"
+	"golang.org/x/text/language"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/i18n"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/common/tracing"
 	"github.com/bytebase/bytebase/backend/component/activity"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
-	"github.com/bytebase/bytebase/backend/component/masker"
+	"github.com/bytebase/bytebase/backend/component/maskingpolicy"
+	"github.com/bytebase/bytebase/backend/component/queryaudit"
+	"github.com/bytebase/bytebase/backend/component/querycursor"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
@@ -53,6 +58,8 @@ const (
 	maximumSQLResultSize = 10 * 1024 * 1024
 	// defaultTimeout is the default timeout for query and admin execution.
 	defaultTimeout = 10 * time.Minute
+	// sqlReviewTimeBudget bounds the SQL review latency of the SQL check.
+	sqlReviewTimeBudget = 30 * time.Second
 )
 
 // SQLService is the service for SQL.
@@ -62,6 +69,8 @@ type SQLService struct {
 	schemaSyncer    *schemasync.Syncer
 	dbFactory       *dbfactory.DBFactory
 	activityManager *activity.Manager
+	auditor         *queryaudit.Auditor
+	cursorManager   *querycursor.Manager
 	licenseService  enterprise.LicenseService
 }
 
@@ -71,6 +80,8 @@ func NewSQLService(
 	schemaSyncer *schemasync.Syncer,
 	dbFactory *dbfactory.DBFactory,
 	activityManager *activity.Manager,
+	auditor *queryaudit.Auditor,
+	cursorManager *querycursor.Manager,
 	licenseService enterprise.LicenseService,
 ) *SQLService {
 	return &SQLService{
@@ -78,16 +89,12 @@ func NewSQLService(
 		schemaSyncer:    schemaSyncer,
 		dbFactory:       dbFactory,
 		activityManager: activityManager,
+		auditor:         auditor,
+		cursorManager:   cursorManager,
 		licenseService:  licenseService,
 	}
 }
 
-type maskingPolicyKey struct {
-	schema string
-	table  string
-	column string
-}
-
 // Pretty returns pretty format SDL.
 func (*SQLService) Pretty(_ context.Context, request *v1pb.PrettyRequest) (*v1pb.PrettyResponse, error) {
 	engine := convertEngine(request.Engine)
@@ -137,7 +144,7 @@ func (s *SQLService) AdminExecute(server v1pb.SQLService_AdminExecuteServer) err
 			return status.Errorf(codes.Internal, "failed to receive request: %v", err)
 		}
 
-		instance, database, activity, err := s.preAdminExecute(ctx, request)
+		user, instance, database, activity, err := s.preAdminExecute(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -164,6 +171,18 @@ func (s *SQLService) AdminExecute(server v1pb.SQLService_AdminExecuteServer) err
 		if err := s.postAdminExecute(ctx, activity, durationNs, queryErr); err != nil {
 			slog.Error("failed to post admin execute activity", log.BBError(err))
 		}
+		if queryErr == nil {
+			s.auditExecution(ctx, &queryaudit.Execution{
+				ActivityID:   activity.UID,
+				User:         user,
+				Instance:     instance,
+				Database:     database,
+				DatabaseName: request.ConnectionDatabase,
+				Statement:    request.Statement,
+				Results:      result,
+				Time:         time.Now(),
+			})
+		}
 
 		response := &v1pb.AdminExecuteResponse{}
 		if queryErr != nil {
@@ -236,7 +255,9 @@ func (*SQLService) doAdminExecute(ctx context.Context, driver db.Driver, conn *s
 	}
 	ctx, cancelCtx := context.WithTimeout(ctx, timeout)
 	defer cancelCtx()
-	result, err := driver.RunStatement(ctx, conn, request.Statement)
+	queryCtx, span := db.StartSpan(ctx, driver, "RunStatement", request.Statement)
+	result, err := driver.RunStatement(queryCtx, conn, request.Statement)
+	tracing.End(span, err)
 	select {
 	case <-ctx.Done():
 		// canceled or timed out
@@ -247,13 +268,22 @@ func (*SQLService) doAdminExecute(ctx context.Context, driver db.Driver, conn *s
 	return result, time.Now().UnixNano() - start, err
 }
 
-func (s *SQLService) preAdminExecute(ctx context.Context, request *v1pb.AdminExecuteRequest) (*store.InstanceMessage, *store.DatabaseMessage, *store.ActivityMessage, error) {
-	user, _, instance, database, err := s.prepareRelatedMessage(ctx, request.Name, request.ConnectionDatabase)
+func (s *SQLService) preAdminExecute(ctx context.Context, request *v1pb.AdminExecuteRequest) (*store.UserMessage, *store.InstanceMessage, *store.DatabaseMessage, *store.ActivityMessage, error) {
+	user, environment, instance, database, err := s.prepareRelatedMessage(ctx, request.Name, request.ConnectionDatabase)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
+	}
+	if s.licenseService.IsFeatureEnabled(api.FeatureAccessControl) == nil {
+		if err := s.checkAdminExecuteRights(ctx, user, environment, instance, database, request.Statement); err != nil {
+			return nil, nil, nil, nil, err
+		}
 	}
 	databaseID := 0
 	if database != nil {
+		// The admin execution may change the database, so it's blocked on the frozen databases.
+		if freeze := utils.GetDatabaseFreeze(database); freeze != nil {
+			return nil, nil, nil, nil, status.Errorf(codes.FailedPrecondition, "database %q is frozen: %s", database.DatabaseName, freeze.Reason)
+		}
 		databaseID = database.UID
 	}
 	activity, err := s.createQueryActivity(ctx, user, api.ActivityInfo, instance.UID, api.ActivitySQLEditorQueryPayload{
@@ -264,10 +294,89 @@ func (s *SQLService) preAdminExecute(ctx context.Context, request *v1pb.AdminExe
 		DatabaseName:           request.ConnectionDatabase,
 	})
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 
-	return instance, database, activity, nil
+	return user, instance, database, activity, nil
+}
+
+// checkAdminExecuteRights checks if the user can execute the statement in admin mode.
+// Besides the workspace owner and DBA, the users granted the privileged DML access just in time can execute DML
+// on the databases covered by the grants.
+func (s *SQLService) checkAdminExecuteRights(ctx context.Context, user *store.UserMessage, environment *store.EnvironmentMessage, instance *store.InstanceMessage, database *store.DatabaseMessage, statement string) error {
+	if user.Role == api.WorkspaceAdmin || user.Role == api.WorkspaceDBA {
+		return nil
+	}
+	if database == nil {
+		return status.Errorf(codes.PermissionDenied, "only workspace owner and DBA can execute statements on the instance")
+	}
+	databaseNames, err := getAdminExecuteDMLDatabases(instance.Engine, database.DatabaseName, statement)
+	if err != nil {
+		return status.Errorf(codes.PermissionDenied, "only workspace owner and DBA can execute the statement, error: %v", err)
+	}
+	for _, databaseName := range databaseNames {
+		target, targetEnvironment := database, environment
+		if databaseName != database.DatabaseName {
+			target, err = s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &databaseName})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to get database %q, error: %v", databaseName, err)
+			}
+			if target == nil {
+				return status.Errorf(codes.PermissionDenied, "permission denied to execute statements on database %q", databaseName)
+			}
+			targetEnvironment, err = s.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &target.EffectiveEnvironmentID})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to get environment %q, error: %v", target.EffectiveEnvironmentID, err)
+			}
+			if targetEnvironment == nil {
+				return status.Errorf(codes.NotFound, "environment %q not found", target.EffectiveEnvironmentID)
+			}
+		}
+		ok, err := s.hasDMLExecutorGrant(ctx, user, targetEnvironment, instance, target, statement)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return status.Errorf(codes.PermissionDenied, "permission denied to execute statements on database %q, request the privileged DML access first", target.DatabaseName)
+		}
+	}
+	return nil
+}
+
+// hasDMLExecutorGrant returns true if the user is granted the privileged DML access on the database.
+func (s *SQLService) hasDMLExecutorGrant(ctx context.Context, user *store.UserMessage, environment *store.EnvironmentMessage, instance *store.InstanceMessage, database *store.DatabaseMessage, statement string) (bool, error) {
+	projectPolicy, err := s.store.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{ProjectID: &database.ProjectID})
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to get project policy, error: %v", err)
+	}
+	attributes := map[string]any{
+		"request.time":              time.Now(),
+		"resource.environment_name": fmt.Sprintf("%s%s", common.EnvironmentNamePrefix, environment.ResourceID),
+		"resource.database":         common.FormatDatabase(instance.ResourceID, database.DatabaseName),
+		"resource.schema":           "",
+		"resource.table":            "",
+		"request.statement":         encodeToBase64String(statement),
+		"request.row_limit":         0,
+	}
+	for _, binding := range projectPolicy.Bindings {
+		if binding.Role != api.ProjectDMLExecutor {
+			continue
+		}
+		for _, member := range binding.Members {
+			if member.ID != user.ID && member.Email != api.AllUsers {
+				continue
+			}
+			ok, err := evaluateQueryExportPolicyCondition(binding.Condition.GetExpression(), attributes)
+			if err != nil {
+				slog.Error("failed to evaluate condition", log.BBError(err), slog.String("condition", binding.Condition.GetExpression()))
+				break
+			}
+			if ok {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
 }
 
 // Export exports the SQL query result.
@@ -284,6 +393,15 @@ func (s *SQLService) Export(ctx context.Context, request *v1pb.ExportRequest) (*
 	if err != nil {
 		return nil, err
 	}
+	statement, err := s.injectRowFilters(ctx, user, instance, request.ConnectionDatabase, request.Statement)
+	if err != nil {
+		return nil, err
+	}
+	exportRequest := proto.Clone(request).(*v1pb.ExportRequest)
+	exportRequest.Statement = statement
+	if err := s.applyExportRowQuota(ctx, database, exportRequest); err != nil {
+		return nil, err
+	}
 
 	databaseID := 0
 	if database != nil {
@@ -301,7 +419,8 @@ func (s *SQLService) Export(ctx context.Context, request *v1pb.ExportRequest) (*
 		return nil, err
 	}
 
-	bytes, durationNs, exportErr := s.doExport(ctx, request, instance, database, sensitiveSchemaInfo)
+	watermark := s.getExportWatermark(ctx, user, request.Format)
+	bytes, results, durationNs, exportErr := s.doExport(ctx, exportRequest, instance, database, sensitiveSchemaInfo, watermark)
 
 	if err := s.postExport(ctx, activity, durationNs, exportErr); err != nil {
 		return nil, err
@@ -310,6 +429,18 @@ func (s *SQLService) Export(ctx context.Context, request *v1pb.ExportRequest) (*
 	if exportErr != nil {
 		return nil, exportErr
 	}
+	s.auditExecution(ctx, &queryaudit.Execution{
+		ActivityID:   activity.UID,
+		Export:       true,
+		User:         user,
+		Instance:     instance,
+		Database:     database,
+		DatabaseName: request.ConnectionDatabase,
+		Statement:    request.Statement,
+		Results:      results,
+		Time:         time.Now(),
+	})
+	s.recordExportAudit(ctx, user, instance, database, request, results, watermark)
 
 	content, err := doEncrypt(bytes, request)
 	if err != nil {
@@ -396,7 +527,7 @@ func doEncrypt(data []byte, request *v1pb.ExportRequest) ([]byte, error) {
 	return b.Bytes(), nil
 }
 
-func (s *SQLService) doExport(ctx context.Context, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, sensitiveSchemaInfo *base.SensitiveSchemaInfo) ([]byte, int64, error) {
+func (s *SQLService) doExport(ctx context.Context, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, sensitiveSchemaInfo *base.SensitiveSchemaInfo, watermark string) ([]byte, []*v1pb.QueryResult, int64, error) {
 	// Don't anonymize data for exporting data using admin mode.
 	if request.Admin {
 		sensitiveSchemaInfo = nil
@@ -404,7 +535,7 @@ func (s *SQLService) doExport(ctx context.Context, request *v1pb.ExportRequest,
 
 	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, "" /* dataSourceID */)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, 0, err
 	}
 	defer driver.Close(ctx)
 
@@ -413,57 +544,67 @@ func (s *SQLService) doExport(ctx context.Context, request *v1pb.ExportRequest,
 	if sqlDB != nil {
 		conn, err = sqlDB.Conn(ctx)
 		if err != nil {
-			return nil, 0, err
+			return nil, nil, 0, err
 		}
 		defer conn.Close()
 	}
 
 	start := time.Now().UnixNano()
-	result, err := driver.QueryConn(ctx, conn, request.Statement, &db.QueryContext{
+	queryCtx, span := db.StartSpan(ctx, driver, "QueryConn", request.Statement)
+	result, err := driver.QueryConn(queryCtx, conn, request.Statement, &db.QueryContext{
 		Limit:               int(request.Limit),
 		ReadOnly:            true,
 		CurrentDatabase:     request.ConnectionDatabase,
 		SensitiveSchemaInfo: sensitiveSchemaInfo,
 		EnableSensitive:     s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil,
 	})
+	tracing.End(span, err)
 	durationNs := time.Now().UnixNano() - start
 	if err != nil {
-		return nil, durationNs, err
+		return nil, nil, durationNs, err
 	}
 	if len(result) != 1 {
-		return nil, durationNs, errors.Errorf("expecting 1 result, but got %d", len(result))
+		return nil, nil, durationNs, errors.Errorf("expecting 1 result, but got %d", len(result))
 	}
 
 	var content []byte
 	switch request.Format {
 	case v1pb.ExportFormat_CSV:
-		if content, err = exportCSV(result[0]); err != nil {
-			return nil, durationNs, err
+		if content, err = exportCSV(withWatermark(result[0], watermark)); err != nil {
+			return nil, nil, durationNs, err
 		}
 	case v1pb.ExportFormat_JSON:
 		if content, err = exportJSON(result[0]); err != nil {
-			return nil, durationNs, err
+			return nil, nil, durationNs, err
 		}
 	case v1pb.ExportFormat_SQL:
 		resourceList, err := s.extractResourceList(ctx, instance.Engine, request.ConnectionDatabase, request.Statement, instance)
 		if err != nil {
-			return nil, 0, status.Errorf(codes.InvalidArgument, "failed to extract resource list: %v", err)
+			return nil, nil, 0, status.Errorf(codes.InvalidArgument, "failed to extract resource list: %v", err)
 		}
 		statementPrefix, err := getSQLStatementPrefix(instance.Engine, resourceList, result[0].ColumnNames)
 		if err != nil {
-			return nil, 0, err
+			return nil, nil, 0, err
 		}
 		if content, err = exportSQL(instance.Engine, statementPrefix, result[0]); err != nil {
-			return nil, durationNs, err
+			return nil, nil, durationNs, err
 		}
 	case v1pb.ExportFormat_XLSX:
-		if content, err = exportXLSX(result[0]); err != nil {
-			return nil, durationNs, err
+		if content, err = exportXLSX(withWatermark(result[0], watermark)); err != nil {
+			return nil, nil, durationNs, err
+		}
+	case v1pb.ExportFormat_PARQUET:
+		if content, err = exportParquet(result[0]); err != nil {
+			return nil, nil, durationNs, err
+		}
+	case v1pb.ExportFormat_JSONL:
+		if content, err = exportJSONL(result[0]); err != nil {
+			return nil, nil, durationNs, err
 		}
 	default:
-		return nil, durationNs, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", request.Format.String())
+		return nil, nil, durationNs, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", request.Format.String())
 	}
-	return content, durationNs, nil
+	return content, result, durationNs, nil
 }
 
 func (*SQLService) StringifyMetadata(_ context.Context, request *v1pb.StringifyMetadataRequest) (*v1pb.StringifyMetadataResponse, error) {
@@ -878,7 +1019,7 @@ func (s *SQLService) createExportActivity(ctx context.Context, user *store.UserM
 		Payload: string(activityBytes),
 	}
 
-	activity, err := s.store.CreateActivityV2(ctx, activityCreate)
+	activity, err := s.activityManager.CreateActivity(ctx, activityCreate, &activity.Metadata{})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to create activity: %v", err)
 	}
@@ -955,6 +1096,15 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 	if err != nil {
 		return nil, err
 	}
+	statement, err := s.injectRowFilters(ctx, user, instance, request.ConnectionDatabase, request.Statement)
+	if err != nil {
+		return nil, err
+	}
+	queryRequest := proto.Clone(request).(*v1pb.QueryRequest)
+	queryRequest.Statement = statement
+	if err := s.applyQueryRuntimeQuota(ctx, database, queryRequest); err != nil {
+		return nil, err
+	}
 
 	// Create query activity.
 	level := api.ActivityInfo
@@ -983,17 +1133,28 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 	var queryErr error
 	var durationNs int64
 	if adviceStatus != advisor.Error {
-		results, durationNs, queryErr = s.doQuery(ctx, request, instance, database, sensitiveSchemaInfo)
+		results, durationNs, queryErr = s.doQuery(ctx, queryRequest, instance, database, sensitiveSchemaInfo)
 	}
 
 	err = s.postQuery(ctx, activity, durationNs, queryErr)
 	if err != nil {
 		return nil, err
 	}
+	s.recordQueryHistory(ctx, user, instance, database, request, results, durationNs, queryErr)
 
 	if queryErr != nil {
 		return nil, queryErr
 	}
+	s.auditExecution(ctx, &queryaudit.Execution{
+		ActivityID:   activity.UID,
+		User:         user,
+		Instance:     instance,
+		Database:     database,
+		DatabaseName: request.ConnectionDatabase,
+		Statement:    request.Statement,
+		Results:      results,
+		Time:         time.Now(),
+	})
 
 	// AllowExport is a validate only check.
 	_, _, _, _, _, _, err = s.preCheck(ctx, request.Name, request.ConnectionDatabase, request.Statement, request.Limit, false /* isAdmin */, true /* isExport */)
@@ -1017,6 +1178,13 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 	return response, nil
 }
 
+// auditExecution scores the execution for suspicious patterns, the failure doesn't fail the execution.
+func (s *SQLService) auditExecution(ctx context.Context, execution *queryaudit.Execution) {
+	if err := s.auditor.Audit(ctx, execution); err != nil {
+		slog.Error("failed to audit SQL execution", slog.Int("activity_id", execution.ActivityID), log.BBError(err))
+	}
+}
+
 // postQuery does the following:
 //  1. Check index hit Explain statements
 //  2. Update SQL query activity
@@ -1083,13 +1251,15 @@ func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, in
 	defer cancelCtx()
 
 	start := time.Now().UnixNano()
-	results, err := driver.QueryConn(ctx, conn, request.Statement, &db.QueryContext{
+	queryCtx, span := db.StartSpan(ctx, driver, "QueryConn", request.Statement)
+	results, err := driver.QueryConn(queryCtx, conn, request.Statement, &db.QueryContext{
 		Limit:               int(request.Limit),
 		ReadOnly:            true,
 		CurrentDatabase:     request.ConnectionDatabase,
 		SensitiveSchemaInfo: sensitiveSchemaInfo,
 		EnableSensitive:     s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil,
 	})
+	tracing.End(span, err)
 	select {
 	case <-ctx.Done():
 		// canceled or timed out
@@ -1278,7 +1448,7 @@ func (s *SQLService) createQueryActivity(ctx context.Context, user *store.UserMe
 		Payload: string(activityBytes),
 	}
 
-	activity, err := s.store.CreateActivityV2(ctx, activityCreate)
+	activity, err := s.activityManager.CreateActivity(ctx, activityCreate, &activity.Metadata{})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to create activity: %v", err)
 	}
@@ -1323,6 +1493,11 @@ func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store
 		return nil, errors.Wrapf(err, "failed to find semantic types setting")
 	}
 
+	maskingSalt, err := s.store.GetMaskingSalt(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find masking salt")
+	}
+
 	// Multiple databases may belong to the same project, to reduce the protojson unmarshal cost,
 	// we store the projectResourceID - maskingExceptionPolicy in a map.
 	maskingExceptionPolicyMap := make(map[string]*storepb.MaskingExceptionPolicy)
@@ -1331,7 +1506,8 @@ func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store
 		withMaskingRulePolicy(maskingRulePolicy).
 		withDataClassificationSetting(classificationSetting).
 		withMaskingAlgorithmSetting(algorithmSetting).
-		withSemanticTypeSetting(semanticTypesSetting)
+		withSemanticTypeSetting(semanticTypesSetting).
+		withMaskingSalt(maskingSalt)
 
 	for _, name := range databaseList {
 		databaseName := name
@@ -1406,22 +1582,10 @@ func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store
 			}
 		}
 
-		// Convert the maskingPolicy to a map to reduce the time complexity of searching.
-		maskingPolicy, err := s.store.GetMaskingPolicyByDatabaseUID(ctx, database.UID)
+		maskingPolicyResolver, err := maskingpolicy.Load(ctx, s.store, project, database)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to find masking policy for database %q", databaseName)
-		}
-		maskingPolicyMap := make(map[maskingPolicyKey]*storepb.MaskData)
-		if maskingPolicy != nil {
-			for _, maskData := range maskingPolicy.MaskData {
-				maskingPolicyMap[maskingPolicyKey{
-					schema: maskData.Schema,
-					table:  maskData.Table,
-					column: maskData.Column,
-				}] = maskData
-			}
+			return nil, err
 		}
-		slog.Debug("found masking policy for database", slog.String("database", databaseName), slog.Any("masking policy", maskingPolicy))
 
 		dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
 		if err != nil {
@@ -1478,7 +1642,7 @@ func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store
 						}
 
 						slog.Debug("processing sensitive schema info", slog.String("schema", schema.Name), slog.String("table", table.Name))
-						maskingAlgorithm, maskingLevel, err := m.evaluateMaskingAlgorithmOfColumn(database, schema.Name, table.Name, column.Name, columnSemanticTypeID, column.Classification, project.DataClassificationConfigID, maskingPolicyMap, maskingExceptionContainsCurrentPrincipal)
+						maskingAlgorithm, maskingLevel, err := m.evaluateMaskingAlgorithmOfColumn(database, schema.Name, table.Name, column.Name, columnSemanticTypeID, column.Classification, project.DataClassificationConfigID, maskingPolicyResolver, maskingExceptionContainsCurrentPrincipal)
 						if err != nil {
 							return nil, errors.Wrapf(err, "failed to evaluate masking level of database %q, schema %q, table %q, column %q", databaseName, schema.Name, table.Name, column.Name)
 						}
@@ -1486,7 +1650,7 @@ func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store
 						if sensitive {
 							isEmpty = false
 						}
-						masker := getMaskerByMaskingAlgorithmAndLevel(maskingAlgorithm, maskingLevel)
+						masker := maskingpolicy.NewMasker(maskingAlgorithm, maskingLevel, m.maskingSalt)
 						tableSchema.ColumnList = append(tableSchema.ColumnList, base.ColumnInfo{
 							Name:              column.Name,
 							MaskingAttributes: base.NewMaskingAttributes(masker),
@@ -1547,7 +1711,7 @@ func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store
 					}
 
 					slog.Debug("processing sensitive schema info", slog.String("database", database.DatabaseName), slog.String("schema", schema.Name), slog.String("table", table.Name), slog.String("column", column.Name))
-					maskingAlgorithm, maskingLevel, err := m.evaluateMaskingAlgorithmOfColumn(database, schema.Name, table.Name, column.Name, columnSemanticTypeID, column.Classification, project.DataClassificationConfigID, maskingPolicyMap, maskingExceptionContainsCurrentPrincipal)
+					maskingAlgorithm, maskingLevel, err := m.evaluateMaskingAlgorithmOfColumn(database, schema.Name, table.Name, column.Name, columnSemanticTypeID, column.Classification, project.DataClassificationConfigID, maskingPolicyResolver, maskingExceptionContainsCurrentPrincipal)
 					if err != nil {
 						return nil, errors.Wrapf(err, "failed to evaluate masking level of database %q, schema %q, table %q, column %q", databaseName, schema.Name, table.Name, column.Name)
 					}
@@ -1555,7 +1719,7 @@ func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store
 					if sensitive {
 						isEmpty = false
 					}
-					masker := getMaskerByMaskingAlgorithmAndLevel(maskingAlgorithm, maskingLevel)
+					masker := maskingpolicy.NewMasker(maskingAlgorithm, maskingLevel, m.maskingSalt)
 					tableSchema.ColumnList = append(tableSchema.ColumnList, base.ColumnInfo{
 						Name:              column.Name,
 						MaskingAttributes: base.NewMaskingAttributes(masker),
@@ -1583,41 +1747,6 @@ func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store
 	return result, nil
 }
 
-func getMaskerByMaskingAlgorithmAndLevel(algorithm *storepb.MaskingAlgorithmSetting_Algorithm, level storepb.MaskingLevel) masker.Masker {
-	if algorithm == nil {
-		switch level {
-		case storepb.MaskingLevel_FULL:
-			return masker.NewDefaultFullMasker()
-		case storepb.MaskingLevel_PARTIAL:
-			return masker.NewDefaultRangeMasker()
-		default:
-			return masker.NewNoneMasker()
-		}
-	}
-
-	switch m := algorithm.Mask.(type) {
-	case *storepb.MaskingAlgorithmSetting_Algorithm_FullMask_:
-		return masker.NewFullMasker(m.FullMask.Substitution)
-	case *storepb.MaskingAlgorithmSetting_Algorithm_RangeMask_:
-		return masker.NewRangeMasker(convertRangeMaskSlices(m.RangeMask.Slices))
-	case *storepb.MaskingAlgorithmSetting_Algorithm_Md5Mask:
-		return masker.NewMD5Masker(m.Md5Mask.Salt)
-	}
-	return masker.NewNoneMasker()
-}
-
-func convertRangeMaskSlices(slices []*storepb.MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) []*masker.MaskRangeSlice {
-	var result []*masker.MaskRangeSlice
-	for _, slice := range slices {
-		result = append(result, &masker.MaskRangeSlice{
-			Start:        slice.Start,
-			End:          slice.End,
-			Substitution: slice.Substitution,
-		})
-	}
-	return result
-}
-
 func isExcludeDatabase(dbType storepb.Engine, database string) bool {
 	switch dbType {
 	case storepb.Engine_MYSQL, storepb.Engine_MARIADB:
@@ -1718,20 +1847,21 @@ func (s *SQLService) sqlReviewCheck(ctx context.Context, statement string, envir
 		return advisor.Error, nil, status.Errorf(codes.Internal, "Failed to check SQL review policy: %v", err)
 	}
 
-	return adviceLevel, convertAdviceList(adviceList), nil
+	return adviceLevel, convertAdviceList(adviceList, i18n.GetLocale(ctx)), nil
 }
 
-func convertAdviceList(list []advisor.Advice) []*v1pb.Advice {
+func convertAdviceList(list []advisor.Advice, locale language.Tag) []*v1pb.Advice {
 	var result []*v1pb.Advice
 	for _, advice := range list {
 		result = append(result, &v1pb.Advice{
 			Status:  convertAdviceStatus(advice.Status),
 			Code:    int32(advice.Code),
 			Title:   advice.Title,
-			Content: advice.Content,
+			Content: i18n.Translate(locale, advice.Content),
 			Line:    int32(advice.Line),
 			Column:  int32(advice.Column),
 			Detail:  advice.Details,
+			Fix:     advice.Fix,
 		})
 	}
 	return result
@@ -1771,6 +1901,7 @@ func (s *SQLService) sqlCheck(
 		return advisor.Error, nil, err
 	}
 
+	stats := &advisor.SQLReviewCheckStats{}
 	res, err := advisor.SQLReviewCheck(statement, policy.RuleList, advisor.SQLReviewCheckContext{
 		Charset:         dbCharacterSet,
 		Collation:       dbCollation,
@@ -1780,10 +1911,13 @@ func (s *SQLService) sqlCheck(
 		Context:         ctx,
 		CurrentSchema:   currentSchema,
 		CurrentDatabase: currentDatabase,
+		TimeBudget:      sqlReviewTimeBudget,
+		Stats:           stats,
 	})
 	if err != nil {
 		return advisor.Error, nil, err
 	}
+	advisor.ObserveSQLReviewCheck("sql_check", dbType, stats)
 
 	adviceLevel := advisor.Success
 	for _, advice := range res {
@@ -2447,37 +2581,6 @@ func evaluateMaskingExceptionPolicyCondition(expression string, attributes map[s
 	return boolVar, nil
 }
 
-func evaluateMaskingRulePolicyCondition(expression string, attributes map[string]any) (bool, error) {
-	if expression == "" {
-		return true, nil
-	}
-	maskingRulePolicyEnv, err := cel.NewEnv(common.MaskingRulePolicyCELAttributes...)
-	if err != nil {
-		return false, errors.Wrapf(err, "failed to create CEL environment for masking rule policy")
-	}
-	ast, issues := maskingRulePolicyEnv.Compile(expression)
-	if issues != nil && issues.Err() != nil {
-		return false, errors.Wrapf(issues.Err(), "failed to get the ast of CEL program for masking rule")
-	}
-	prg, err := maskingRulePolicyEnv.Program(ast)
-	if err != nil {
-		return false, errors.Wrapf(err, "failed to create CEL program for masking rule")
-	}
-	out, _, err := prg.Eval(attributes)
-	if err != nil {
-		return false, errors.Wrapf(err, "failed to eval CEL program for masking rule")
-	}
-	val, err := out.ConvertToNative(reflect.TypeOf(false))
-	if err != nil {
-		return false, errors.Wrap(err, "expect bool result for masking rule")
-	}
-	boolVar, ok := val.(bool)
-	if !ok {
-		return false, errors.Wrap(err, "expect bool result for masking rule")
-	}
-	return boolVar, nil
-}
-
 func evaluateQueryExportPolicyCondition(expression string, attributes map[string]any) (bool, error) {
 	if expression == "" {
 		return true, nil
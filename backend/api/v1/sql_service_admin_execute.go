@@ -0,0 +1,142 @@
+package v1
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	mysql "github.com/bytebase/mysql-parser"
+	pgquery "github.com/pganalyze/pg_query_go/v4"
+	tidbast "github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	mysqlparser "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+	tidbparser "github.com/bytebase/bytebase/backend/plugin/parser/tidb"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// getAdminExecuteDMLDatabases returns the databases touched by the statement executed with the privileged DML access.
+// It returns an error if the statement is not DML, e.g. DDL, USE or SET statements, or the engine is not supported.
+// The connection database is always the first one.
+func getAdminExecuteDMLDatabases(engine storepb.Engine, databaseName, statement string) ([]string, error) {
+	if err := validateAdminExecuteDML(engine, statement); err != nil {
+		return nil, err
+	}
+	schema := ""
+	if engine == storepb.Engine_POSTGRES {
+		schema = "public"
+	}
+	resources, err := base.ExtractResourceList(engine, databaseName, schema, statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to extract the resources of the statement")
+	}
+	databases := []string{databaseName}
+	for _, resource := range resources {
+		if resource.Database != "" && !slices.Contains(databases, resource.Database) {
+			databases = append(databases, resource.Database)
+		}
+	}
+	return databases, nil
+}
+
+func validateAdminExecuteDML(engine storepb.Engine, statement string) error {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
+		list, err := mysqlparser.ParseMySQL(statement)
+		if err != nil {
+			return err
+		}
+		for _, result := range list {
+			if !isMySQLDML(result.Tree) {
+				return errors.Errorf("only DML is allowed, but got %q", strings.TrimSpace(result.Tokens.GetAllText()))
+			}
+		}
+		return nil
+	case storepb.Engine_TIDB:
+		nodes, err := tidbparser.ParseTiDB(statement, "", "")
+		if err != nil {
+			return err
+		}
+		for _, node := range nodes {
+			if !isTiDBDML(node) {
+				return errors.Errorf("only DML is allowed, but got %q", node.Text())
+			}
+		}
+		return nil
+	case storepb.Engine_POSTGRES:
+		result, err := pgquery.Parse(statement)
+		if err != nil {
+			return err
+		}
+		for _, stmt := range result.Stmts {
+			if !isPostgreSQLDML(stmt.Stmt) {
+				return errors.Errorf("only DML is allowed, but got %q", statement[stmt.StmtLocation:stmt.StmtLocation+stmt.StmtLen])
+			}
+		}
+		return nil
+	default:
+		return errors.Errorf("the privileged DML access is not supported for %s", engine)
+	}
+}
+
+// isMySQLDML returns true if the statement is SELECT, INSERT, UPDATE, DELETE or REPLACE, and does not write to files or variables.
+func isMySQLDML(tree antlr.Tree) bool {
+	var simpleStatement *mysql.SimpleStatementContext
+	for _, child := range tree.GetChildren() {
+		query, ok := child.(*mysql.QueryContext)
+		if !ok {
+			continue
+		}
+		if simpleStatement, ok = query.SimpleStatement().(*mysql.SimpleStatementContext); !ok {
+			return false
+		}
+	}
+	if simpleStatement == nil {
+		// Empty statements.
+		return true
+	}
+	switch {
+	case simpleStatement.SelectStatement() != nil:
+		return !hasMySQLIntoClause(simpleStatement.SelectStatement())
+	case simpleStatement.InsertStatement() != nil,
+		simpleStatement.UpdateStatement() != nil,
+		simpleStatement.DeleteStatement() != nil,
+		simpleStatement.ReplaceStatement() != nil:
+		return true
+	}
+	return false
+}
+
+func hasMySQLIntoClause(tree antlr.Tree) bool {
+	if _, ok := tree.(*mysql.IntoClauseContext); ok {
+		return true
+	}
+	for _, child := range tree.GetChildren() {
+		if hasMySQLIntoClause(child) {
+			return true
+		}
+	}
+	return false
+}
+
+func isTiDBDML(node tidbast.StmtNode) bool {
+	switch node := node.(type) {
+	case *tidbast.SelectStmt:
+		return node.SelectIntoOpt == nil
+	case *tidbast.SetOprStmt, *tidbast.InsertStmt, *tidbast.UpdateStmt, *tidbast.DeleteStmt:
+		return true
+	}
+	return false
+}
+
+func isPostgreSQLDML(node *pgquery.Node) bool {
+	switch node := node.GetNode().(type) {
+	case *pgquery.Node_SelectStmt:
+		// SELECT INTO creates a table.
+		return node.SelectStmt.GetIntoClause() == nil
+	case *pgquery.Node_InsertStmt, *pgquery.Node_UpdateStmt, *pgquery.Node_DeleteStmt:
+		return true
+	}
+	return false
+}
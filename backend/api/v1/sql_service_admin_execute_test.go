@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetAdminExecuteDMLDatabases(t *testing.T) {
+	tests := []struct {
+		engine    storepb.Engine
+		statement string
+		want      []string
+		wantErr   bool
+	}{
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "UPDATE t SET a = 1 WHERE id = 1; DELETE FROM t WHERE id = 2; INSERT INTO t SELECT * FROM t2;",
+			want:      []string{"db"},
+		},
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "DELETE FROM other_db.t WHERE id = 1",
+			want:      []string{"db", "other_db"},
+		},
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "UPDATE t JOIN other_db.t2 ON t.id = t2.id SET t.a = t2.a",
+			want:      []string{"db", "other_db"},
+		},
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "DROP TABLE t",
+			wantErr:   true,
+		},
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "DELETE FROM t WHERE id = 1; TRUNCATE TABLE t",
+			wantErr:   true,
+		},
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "USE other_db; DELETE FROM t",
+			wantErr:   true,
+		},
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "SELECT * FROM t INTO OUTFILE '/tmp/t.csv'",
+			wantErr:   true,
+		},
+		{
+			engine:    storepb.Engine_TIDB,
+			statement: "DELETE FROM other_db.t WHERE id = 1",
+			want:      []string{"db", "other_db"},
+		},
+		{
+			engine:    storepb.Engine_TIDB,
+			statement: "ALTER TABLE t ADD COLUMN c INT",
+			wantErr:   true,
+		},
+		{
+			engine:    storepb.Engine_POSTGRES,
+			statement: "UPDATE t SET a = 1; DELETE FROM s.t WHERE id = 2;",
+			want:      []string{"db"},
+		},
+		{
+			engine:    storepb.Engine_POSTGRES,
+			statement: "TRUNCATE t",
+			wantErr:   true,
+		},
+		{
+			engine:    storepb.Engine_POSTGRES,
+			statement: "SELECT * INTO t2 FROM t",
+			wantErr:   true,
+		},
+		{
+			engine:    storepb.Engine_SNOWFLAKE,
+			statement: "DELETE FROM t",
+			wantErr:   true,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := getAdminExecuteDMLDatabases(test.engine, "db", test.statement)
+		if test.wantErr {
+			a.Error(err, test.statement)
+			continue
+		}
+		a.NoError(err, test.statement)
+		a.Equal(test.want, got, test.statement)
+	}
+}
@@ -0,0 +1,241 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/masker"
+	"github.com/bytebase/bytebase/backend/component/querycursor"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	defaultQueryCursorPageSize = 1000
+	maximumQueryCursorPageSize = 10000
+)
+
+// OpenQueryCursor executes a SELECT statement in a server-side cursor and returns the first page of the rows.
+// The cursor is only supported for the engines masking the results with the query span.
+func (s *SQLService) OpenQueryCursor(ctx context.Context, request *v1pb.OpenQueryCursorRequest) (*v1pb.QueryCursorPage, error) {
+	pageSize, err := getQueryCursorPageSize(request.PageSize, defaultQueryCursorPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	user, environment, instance, maybeDatabase, err := s.prepareRelatedMessage(ctx, request.Name, request.ConnectionDatabase)
+	if err != nil {
+		return nil, err
+	}
+	switch instance.Engine {
+	case storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_RISINGWAVE:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "query cursor is not supported for %s", instance.Engine)
+	}
+
+	statement := request.Statement
+	// In Redshift datashare, Rewrite query used for parser.
+	if maybeDatabase != nil && maybeDatabase.DataShare {
+		statement = strings.ReplaceAll(statement, fmt.Sprintf("%s.", maybeDatabase.DatabaseName), "")
+	}
+	if err := validateQueryRequest(instance, request.ConnectionDatabase, statement); err != nil {
+		return nil, err
+	}
+	spans, err := base.GetQuerySpan(ctx, instance.Engine, statement, request.ConnectionDatabase, s.buildGetDatabaseMetadataFunc(instance))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get query span")
+	}
+	if len(spans) != 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "query cursor requires a single statement, but got %d", len(spans))
+	}
+
+	if s.licenseService.IsFeatureEnabled(api.FeatureAccessControl) == nil {
+		if err := s.accessCheck(ctx, instance, environment, user, request.Statement, spans, 0 /* limit */, false /* isAdmin */, false /* isExport */); err != nil {
+			return nil, err
+		}
+	}
+	rowFilteredStatement, err := s.injectRowFilters(ctx, user, instance, request.ConnectionDatabase, request.Statement)
+	if err != nil {
+		return nil, err
+	}
+
+	adviceStatus, _, err := s.sqlReviewCheck(ctx, statement, environment, instance, maybeDatabase)
+	if err != nil {
+		return nil, err
+	}
+	if adviceStatus == advisor.Error {
+		return nil, status.Errorf(codes.FailedPrecondition, "the statement violates the SQL review policy, run the query to see the advices")
+	}
+
+	var maskers []masker.Masker
+	if s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil {
+		m, err := s.newMaskingLevelEvaluator(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create masking level evaluator: %v", err)
+		}
+		if maskers, err = s.getMaskersForQuerySpan(ctx, m, instance, spans[0], storepb.MaskingExceptionPolicy_MaskingException_QUERY); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get maskers for query span: %v", err)
+		}
+	}
+
+	level := api.ActivityInfo
+	if adviceStatus == advisor.Warn {
+		level = api.ActivityWarn
+	}
+	databaseID := 0
+	if maybeDatabase != nil {
+		databaseID = maybeDatabase.UID
+	}
+	activity, err := s.createQueryActivity(ctx, user, level, instance.UID, api.ActivitySQLEditorQueryPayload{
+		Statement:              request.Statement,
+		InstanceID:             instance.UID,
+		DeprecatedInstanceName: instance.Title,
+		DatabaseID:             databaseID,
+		DatabaseName:           request.ConnectionDatabase,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The cursor keeps the query running until it's closed, so the maximum query runtime bounds the lifetime of the cursor.
+	maxRuntime, err := s.getMaxQueryRuntime(ctx, maybeDatabase)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now().UnixNano()
+	cursorID, openErr := s.openQueryCursor(ctx, user, instance, maybeDatabase, request.DataSourceId, rowFilteredStatement, maskers, maxRuntime.AsDuration())
+	if err := s.postQuery(ctx, activity, time.Now().UnixNano()-start, openErr); err != nil {
+		return nil, err
+	}
+	if openErr != nil {
+		if errors.Is(openErr, querycursor.ErrTooManyCursors) {
+			return nil, status.Errorf(codes.ResourceExhausted, openErr.Error())
+		}
+		return nil, openErr
+	}
+
+	return s.fetchQueryCursor(user, cursorID, pageSize)
+}
+
+func (s *SQLService) openQueryCursor(ctx context.Context, user *store.UserMessage, instance *store.InstanceMessage, database *store.DatabaseMessage, dataSourceID, statement string, maskers []masker.Masker, maxRuntime time.Duration) (string, error) {
+	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, dataSourceID)
+	if err != nil {
+		return "", err
+	}
+	sqlDB := driver.GetDB()
+	if sqlDB == nil {
+		driver.Close(ctx)
+		return "", errors.Errorf("query cursor is not supported for %s", instance.Engine)
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		driver.Close(ctx)
+		return "", err
+	}
+
+	cursorID, err := s.cursorManager.Open(ctx, &querycursor.OpenMessage{
+		UserID:     user.ID,
+		Driver:     driver,
+		Conn:       conn,
+		Statement:  statement,
+		Maskers:    maskers,
+		MaxRuntime: maxRuntime,
+	})
+	if err != nil {
+		conn.Close()
+		driver.Close(ctx)
+		return "", err
+	}
+	return cursorID, nil
+}
+
+// FetchQueryCursor fetches the next page of the rows through the query cursor.
+// Zero page size fetches no row and only keeps the cursor alive.
+func (s *SQLService) FetchQueryCursor(ctx context.Context, request *v1pb.FetchQueryCursorRequest) (*v1pb.QueryCursorPage, error) {
+	pageSize, err := getQueryCursorPageSize(request.PageSize, 0)
+	if err != nil {
+		return nil, err
+	}
+	cursorID, err := common.GetQueryCursorID(request.Cursor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not found")
+	}
+	return s.fetchQueryCursor(user, cursorID, pageSize)
+}
+
+func (s *SQLService) fetchQueryCursor(user *store.UserMessage, cursorID string, pageSize int) (*v1pb.QueryCursorPage, error) {
+	page, err := s.cursorManager.Fetch(user.ID, cursorID, pageSize, maximumSQLResultSize)
+	if err != nil {
+		if errors.Is(err, querycursor.ErrCursorNotFound) {
+			return nil, status.Errorf(codes.NotFound, "query cursor %q not found", cursorID)
+		}
+		if errors.Is(err, querycursor.ErrCursorTimeout) {
+			return nil, status.Errorf(codes.DeadlineExceeded, "query cursor %q exceeds the maximum query runtime", cursorID)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to fetch query cursor: %v", err)
+	}
+	sanitizeResults([]*v1pb.QueryResult{page.Result})
+	return &v1pb.QueryCursorPage{
+		Cursor:    fmt.Sprintf("%s%s", common.QueryCursorNamePrefix, page.ID),
+		Result:    page.Result,
+		Done:      page.Done,
+		TotalRows: page.TotalRows,
+		Truncated: page.Truncated,
+	}, nil
+}
+
+// CloseQueryCursor closes the query cursor and releases its database connection.
+func (s *SQLService) CloseQueryCursor(ctx context.Context, request *v1pb.CloseQueryCursorRequest) (*emptypb.Empty, error) {
+	cursorID, err := common.GetQueryCursorID(request.Cursor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not found")
+	}
+	if err := s.cursorManager.Close(user.ID, cursorID); err != nil {
+		if errors.Is(err, querycursor.ErrCursorNotFound) {
+			return nil, status.Errorf(codes.NotFound, "query cursor %q not found", cursorID)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to close query cursor: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getQueryCursorPageSize returns the page size of the request, or the default page size if unset.
+func getQueryCursorPageSize(pageSize int32, defaultPageSize int) (int, error) {
+	if pageSize < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "page size must not be negative")
+	}
+	if pageSize > maximumQueryCursorPageSize {
+		return 0, status.Errorf(codes.InvalidArgument, "page size must not exceed %d", maximumQueryCursorPageSize)
+	}
+	if pageSize == 0 {
+		return defaultPageSize, nil
+	}
+	return int(pageSize), nil
+}
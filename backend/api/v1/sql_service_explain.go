@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/component/queryplan"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// Explain explains the statement with the engine-appropriate EXPLAIN and returns the normalized plan tree.
+// The statement is not executed, so no query activity is created.
+func (s *SQLService) Explain(ctx context.Context, request *v1pb.ExplainRequest) (*v1pb.ExplainResponse, error) {
+	user, instance, database, _, _, _, err := s.preCheck(ctx, request.Name, request.ConnectionDatabase, request.Statement, 0 /* limit */, false /* isAdmin */, false /* isExport */)
+	if err != nil {
+		return nil, err
+	}
+	if !queryplan.IsSupported(instance.Engine) {
+		return nil, status.Errorf(codes.InvalidArgument, "explain is not supported for %s", instance.Engine)
+	}
+	// Explain the statement as queried, with the row filters applied.
+	statement, err := s.injectRowFilters(ctx, user, instance, request.ConnectionDatabase, request.Statement)
+	if err != nil {
+		return nil, err
+	}
+	explainStatement, err := queryplan.GetExplainStatement(instance.Engine, statement)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, request.DataSourceId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database driver: %v", err)
+	}
+	defer driver.Close(ctx)
+	sqlDB := driver.GetDB()
+	if sqlDB == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "explain is not supported for %s", instance.Engine)
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database connection: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+	raw, err := runExplain(ctx, conn, explainStatement)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to explain statement: %v", err)
+	}
+	plan, err := queryplan.Normalize(instance.Engine, raw)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to normalize plan: %v", err)
+	}
+	return &v1pb.ExplainResponse{
+		Plan: plan,
+		Raw:  raw,
+	}, nil
+}
+
+// runExplain runs the EXPLAIN statement and returns the lines of the first column.
+func runExplain(ctx context.Context, conn *sql.Conn, statement string) (string, error) {
+	rows, err := conn.QueryContext(ctx, statement)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return "", err
+	}
+	if len(columns) == 0 {
+		return "", errors.Errorf("no column in the explain result")
+	}
+	var lines []string
+	for rows.Next() {
+		values := make([]any, len(columns))
+		line := new(sql.NullString)
+		values[0] = line
+		for i := 1; i < len(columns); i++ {
+			values[i] = new(sql.RawBytes)
+		}
+		if err := rows.Scan(values...); err != nil {
+			return "", err
+		}
+		lines = append(lines, line.String)
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+	return strings.Join(lines, "\n"), nil
+}
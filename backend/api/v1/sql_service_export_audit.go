@@ -0,0 +1,275 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/watermark"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// ListExportAudits lists the audit records of the exports.
+func (s *SQLService) ListExportAudits(ctx context.Context, request *v1pb.ListExportAuditsRequest) (*v1pb.ListExportAuditsResponse, error) {
+	var pageToken storepb.PageToken
+	if request.PageToken != "" {
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		if pageToken.Limit != request.PageSize {
+			return nil, status.Errorf(codes.InvalidArgument, "request page size does not match the page token")
+		}
+	} else {
+		pageToken.Limit = request.PageSize
+	}
+
+	limit := int(pageToken.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	limitPlusOne := limit + 1
+	offset := int(pageToken.Offset)
+
+	find := &store.FindExportAuditMessage{
+		Limit:  &limitPlusOne,
+		Offset: &offset,
+	}
+	if err := s.setExportAuditFindFilter(ctx, find, request.Filter); err != nil {
+		return nil, err
+	}
+
+	exportAudits, err := s.store.ListExportAudits(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list export audits: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(exportAudits) == limitPlusOne {
+		exportAudits = exportAudits[:limit]
+		if nextPageToken, err = marshalPageToken(&storepb.PageToken{
+			Limit:  int32(limit),
+			Offset: int32(limit + offset),
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal next page token, error: %v", err)
+		}
+	}
+
+	resp := &v1pb.ListExportAuditsResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, exportAudit := range exportAudits {
+		v1ExportAudit, err := s.convertToExportAudit(ctx, exportAudit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert export audit, error: %v", err)
+		}
+		resp.ExportAudits = append(resp.ExportAudits, v1ExportAudit)
+	}
+	return resp, nil
+}
+
+func (s *SQLService) setExportAuditFindFilter(ctx context.Context, find *store.FindExportAuditMessage, filter string) error {
+	filters, err := parseFilter(filter)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	for _, spec := range filters {
+		switch spec.key {
+		case "creator":
+			if spec.operator != comparatorTypeEqual {
+				return status.Errorf(codes.InvalidArgument, `only support "=" operation for "creator" filter`)
+			}
+			creatorEmail := strings.TrimPrefix(spec.value, common.UserNamePrefix)
+			if creatorEmail == "" {
+				return status.Errorf(codes.InvalidArgument, "invalid empty creator identifier")
+			}
+			user, err := s.store.GetUser(ctx, &store.FindUserMessage{
+				Email:       &creatorEmail,
+				ShowDeleted: true,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, `failed to find user "%s" with error: %v`, creatorEmail, err.Error())
+			}
+			if user == nil {
+				return status.Errorf(codes.NotFound, "user %q not found", spec.value)
+			}
+			find.CreatorUID = &user.ID
+		case "instance":
+			if spec.operator != comparatorTypeEqual {
+				return status.Errorf(codes.InvalidArgument, `only support "=" operation for "instance" filter`)
+			}
+			instanceID, err := common.GetInstanceID(spec.value)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
+				ResourceID:  &instanceID,
+				ShowDeleted: true,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, err.Error())
+			}
+			if instance == nil {
+				return status.Errorf(codes.NotFound, "instance %q not found", spec.value)
+			}
+			find.InstanceUID = &instance.UID
+		case "database":
+			if spec.operator != comparatorTypeEqual {
+				return status.Errorf(codes.InvalidArgument, `only support "=" operation for "database" filter`)
+			}
+			instanceID, databaseName, err := common.GetInstanceDatabaseID(spec.value)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+				InstanceID:   &instanceID,
+				DatabaseName: &databaseName,
+				ShowDeleted:  true,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, err.Error())
+			}
+			if database == nil {
+				return status.Errorf(codes.NotFound, "database %q not found", spec.value)
+			}
+			find.DatabaseUID = &database.UID
+		case "create_time":
+			if spec.operator != comparatorTypeGreaterEqual && spec.operator != comparatorTypeLessEqual {
+				return status.Errorf(codes.InvalidArgument, `only support "<=" or ">=" operation for "create_time" filter`)
+			}
+			t, err := time.Parse(time.RFC3339, spec.value)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid create_time filter %q", spec.value)
+			}
+			ts := t.Unix()
+			if spec.operator == comparatorTypeGreaterEqual {
+				find.CreatedTsAfter = &ts
+			} else {
+				find.CreatedTsBefore = &ts
+			}
+		default:
+			return status.Errorf(codes.InvalidArgument, "invalid filter %s", spec.key)
+		}
+	}
+	return nil
+}
+
+func (s *SQLService) convertToExportAudit(ctx context.Context, exportAudit *store.ExportAuditMessage) (*v1pb.ExportAudit, error) {
+	creator, err := s.store.GetUserByID(ctx, exportAudit.CreatorUID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get creator %d", exportAudit.CreatorUID)
+	}
+	if creator == nil {
+		return nil, errors.Errorf("creator %d not found", exportAudit.CreatorUID)
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
+		UID:         &exportAudit.InstanceUID,
+		ShowDeleted: true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance %d", exportAudit.InstanceUID)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance %d not found", exportAudit.InstanceUID)
+	}
+	databaseName := ""
+	if exportAudit.DatabaseUID != nil {
+		database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+			UID:         exportAudit.DatabaseUID,
+			ShowDeleted: true,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get database %d", *exportAudit.DatabaseUID)
+		}
+		if database != nil {
+			databaseName = fmt.Sprintf("%s%s/%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName)
+		}
+	}
+
+	return &v1pb.ExportAudit{
+		Name:          fmt.Sprintf("%s%d", common.ExportAuditNamePrefix, exportAudit.UID),
+		Creator:       fmt.Sprintf("%s%s", common.UserNamePrefix, creator.Email),
+		CreateTime:    timestamppb.New(time.Unix(exportAudit.CreatedTs, 0)),
+		Instance:      fmt.Sprintf("%s%s", common.InstanceNamePrefix, instance.ResourceID),
+		Database:      databaseName,
+		Statement:     exportAudit.Statement,
+		Format:        v1pb.ExportFormat(v1pb.ExportFormat_value[exportAudit.Format]),
+		Columns:       exportAudit.Columns,
+		RowCount:      exportAudit.RowCount,
+		MaskedColumns: exportAudit.MaskedColumns,
+		Admin:         exportAudit.Admin,
+		Watermark:     exportAudit.Watermark,
+	}, nil
+}
+
+// getExportWatermark returns the watermark of the export if the watermark is enabled and supported by the format.
+// The watermark is the requester and the export time.
+func (s *SQLService) getExportWatermark(ctx context.Context, user *store.UserMessage, format v1pb.ExportFormat) string {
+	if format != v1pb.ExportFormat_CSV && format != v1pb.ExportFormat_XLSX {
+		return ""
+	}
+	setting, err := s.store.GetQueryAuditSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get query audit setting", log.BBError(err))
+		return ""
+	}
+	if !setting.ExportWatermark {
+		return ""
+	}
+	return fmt.Sprintf("%s %s", user.Email, time.Now().UTC().Format(time.RFC3339))
+}
+
+// withWatermark returns a copy of the result with the invisible watermark appended to the first column name.
+func withWatermark(result *v1pb.QueryResult, text string) *v1pb.QueryResult {
+	if text == "" || len(result.ColumnNames) == 0 {
+		return result
+	}
+	watermarked, ok := proto.Clone(result).(*v1pb.QueryResult)
+	if !ok {
+		return result
+	}
+	watermarked.ColumnNames[0] += watermark.Encode(text)
+	return watermarked
+}
+
+// recordExportAudit records the export in the export audits, the failure doesn't fail the export.
+func (s *SQLService) recordExportAudit(ctx context.Context, user *store.UserMessage, instance *store.InstanceMessage, database *store.DatabaseMessage, request *v1pb.ExportRequest, results []*v1pb.QueryResult, watermarkText string) {
+	exportAudit := &store.ExportAuditMessage{
+		CreatorUID:  user.ID,
+		InstanceUID: instance.UID,
+		Statement:   request.Statement,
+		Format:      request.Format.String(),
+		Admin:       request.Admin,
+		Watermark:   watermarkText,
+	}
+	if database != nil {
+		exportAudit.DatabaseUID = &database.UID
+	}
+	for _, result := range results {
+		exportAudit.Columns = append(exportAudit.Columns, result.ColumnNames...)
+		exportAudit.RowCount += int64(len(result.Rows))
+		for i, masked := range result.Masked {
+			if masked && i < len(result.ColumnNames) {
+				exportAudit.MaskedColumns = append(exportAudit.MaskedColumns, result.ColumnNames[i])
+			}
+		}
+	}
+	if _, err := s.store.CreateExportAudit(ctx, exportAudit); err != nil {
+		slog.Error("failed to record export audit", slog.Int("instance_id", instance.UID), log.BBError(err))
+	}
+}
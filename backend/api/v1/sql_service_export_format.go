@@ -0,0 +1,441 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/compress"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// parquetRowGroupSize is the number of rows written to the Parquet file in a batch.
+const parquetRowGroupSize = 10000
+
+// exportColumnType is the type of the column in the typed export formats.
+type exportColumnType int
+
+const (
+	exportColumnTypeString exportColumnType = iota
+	exportColumnTypeInt64
+	exportColumnTypeUint64
+	exportColumnTypeFloat64
+	exportColumnTypeBool
+	exportColumnTypeBinary
+	exportColumnTypeDate
+	exportColumnTypeTimestamp
+)
+
+var exportTimestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999-07",
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+}
+
+// getExportColumnType maps the database type name of the driver to the column type.
+// DECIMAL and NUMERIC are exported as strings to keep the precision.
+func getExportColumnType(typeName string) exportColumnType {
+	tp := strings.ToUpper(strings.TrimSpace(typeName))
+	// ClickHouse wraps the type as Nullable(T) or LowCardinality(T).
+	for _, wrapper := range []string{"NULLABLE(", "LOWCARDINALITY("} {
+		if strings.HasPrefix(tp, wrapper) && strings.HasSuffix(tp, ")") {
+			tp = strings.TrimSuffix(strings.TrimPrefix(tp, wrapper), ")")
+		}
+	}
+	if i := strings.Index(tp, "("); i >= 0 {
+		tp = tp[:i]
+	}
+	unsigned := strings.Contains(tp, "UNSIGNED")
+	tp = strings.TrimSpace(strings.ReplaceAll(tp, "UNSIGNED", ""))
+
+	switch tp {
+	case "TINYINT", "SMALLINT", "MEDIUMINT", "INT", "INTEGER", "INT2", "INT4", "SMALLSERIAL", "SERIAL",
+		"INT8", "INT16", "INT32", "UINT8", "UINT16", "UINT32":
+		return exportColumnTypeInt64
+	case "BIGINT", "BIGSERIAL", "INT64":
+		if unsigned {
+			return exportColumnTypeUint64
+		}
+		return exportColumnTypeInt64
+	case "UINT64":
+		return exportColumnTypeUint64
+	case "FLOAT", "REAL", "DOUBLE", "DOUBLE PRECISION", "FLOAT4", "FLOAT8", "FLOAT32", "FLOAT64", "BINARY_FLOAT", "BINARY_DOUBLE":
+		return exportColumnTypeFloat64
+	case "BOOL", "BOOLEAN":
+		return exportColumnTypeBool
+	case "BLOB", "TINYBLOB", "MEDIUMBLOB", "LONGBLOB", "BYTEA", "BINARY", "VARBINARY", "RAW", "LONG RAW", "IMAGE":
+		return exportColumnTypeBinary
+	case "DATE", "DATE32":
+		return exportColumnTypeDate
+	case "TIMESTAMP", "TIMESTAMPTZ", "DATETIME", "DATETIME2", "DATETIME64", "SMALLDATETIME", "DATETIMEOFFSET":
+		return exportColumnTypeTimestamp
+	default:
+		return exportColumnTypeString
+	}
+}
+
+// getExportColumnTypes returns the column types of the result.
+// The masked columns and the columns with any value not fitting in the type are exported as strings.
+func getExportColumnTypes(result *v1pb.QueryResult) []exportColumnType {
+	types := make([]exportColumnType, len(result.ColumnNames))
+	for i := range result.ColumnNames {
+		if i < len(result.ColumnTypeNames) {
+			types[i] = getExportColumnType(result.ColumnTypeNames[i])
+		}
+		if i < len(result.Masked) && result.Masked[i] {
+			types[i] = exportColumnTypeString
+		}
+	}
+	for _, row := range result.Rows {
+		for i, value := range row.Values {
+			if i >= len(types) || types[i] == exportColumnTypeString {
+				continue
+			}
+			if _, err := convertExportValue(value, types[i]); err != nil {
+				types[i] = exportColumnTypeString
+			}
+		}
+	}
+	return types
+}
+
+// getExportColumnNames returns the column names with the duplicates suffixed, since the names are the keys in the typed export formats.
+func getExportColumnNames(result *v1pb.QueryResult) []string {
+	names := make([]string, len(result.ColumnNames))
+	seen := make(map[string]bool)
+	for i, name := range result.ColumnNames {
+		uniqueName := name
+		for suffix := 1; seen[uniqueName]; suffix++ {
+			uniqueName = name + "_" + strconv.Itoa(suffix)
+		}
+		seen[uniqueName] = true
+		names[i] = uniqueName
+	}
+	return names
+}
+
+// convertExportValue converts the value to the Go value of the column type, which is nil for NULL.
+func convertExportValue(value *v1pb.RowValue, tp exportColumnType) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if v, ok := value.Kind.(*v1pb.RowValue_ValueValue); ok {
+		return convertExportStructValue(v.ValueValue, tp)
+	}
+	if _, ok := value.Kind.(*v1pb.RowValue_NullValue); ok {
+		return nil, nil
+	}
+
+	switch tp {
+	case exportColumnTypeInt64:
+		switch v := value.Kind.(type) {
+		case *v1pb.RowValue_Int32Value:
+			return int64(v.Int32Value), nil
+		case *v1pb.RowValue_Int64Value:
+			return v.Int64Value, nil
+		case *v1pb.RowValue_Uint32Value:
+			return int64(v.Uint32Value), nil
+		case *v1pb.RowValue_StringValue:
+			return strconv.ParseInt(strings.TrimSpace(v.StringValue), 10, 64)
+		}
+	case exportColumnTypeUint64:
+		switch v := value.Kind.(type) {
+		case *v1pb.RowValue_Uint32Value:
+			return uint64(v.Uint32Value), nil
+		case *v1pb.RowValue_Uint64Value:
+			return v.Uint64Value, nil
+		case *v1pb.RowValue_StringValue:
+			return strconv.ParseUint(strings.TrimSpace(v.StringValue), 10, 64)
+		}
+	case exportColumnTypeFloat64:
+		switch v := value.Kind.(type) {
+		case *v1pb.RowValue_FloatValue:
+			return float64(v.FloatValue), nil
+		case *v1pb.RowValue_DoubleValue:
+			return v.DoubleValue, nil
+		case *v1pb.RowValue_Int32Value:
+			return float64(v.Int32Value), nil
+		case *v1pb.RowValue_Int64Value:
+			return float64(v.Int64Value), nil
+		case *v1pb.RowValue_StringValue:
+			return strconv.ParseFloat(strings.TrimSpace(v.StringValue), 64)
+		}
+	case exportColumnTypeBool:
+		switch v := value.Kind.(type) {
+		case *v1pb.RowValue_BoolValue:
+			return v.BoolValue, nil
+		case *v1pb.RowValue_StringValue:
+			switch strings.ToLower(strings.TrimSpace(v.StringValue)) {
+			case "t", "true", "1":
+				return true, nil
+			case "f", "false", "0":
+				return false, nil
+			}
+			return nil, errors.Errorf("%q is not a boolean", v.StringValue)
+		}
+	case exportColumnTypeBinary:
+		switch v := value.Kind.(type) {
+		case *v1pb.RowValue_BytesValue:
+			return v.BytesValue, nil
+		case *v1pb.RowValue_StringValue:
+			return []byte(v.StringValue), nil
+		}
+	case exportColumnTypeDate, exportColumnTypeTimestamp:
+		if v, ok := value.Kind.(*v1pb.RowValue_StringValue); ok {
+			return parseExportTime(v.StringValue, tp)
+		}
+	default:
+		return convertValueToStringInJSON(value), nil
+	}
+	return nil, errors.Errorf("unexpected value %v for the column type", value)
+}
+
+func convertExportStructValue(value *structpb.Value, tp exportColumnType) (any, error) {
+	switch v := value.Kind.(type) {
+	case *structpb.Value_NullValue:
+		return nil, nil
+	case *structpb.Value_NumberValue:
+		switch tp {
+		case exportColumnTypeInt64:
+			if v.NumberValue != math.Trunc(v.NumberValue) {
+				return nil, errors.Errorf("%v is not an integer", v.NumberValue)
+			}
+			return int64(v.NumberValue), nil
+		case exportColumnTypeUint64:
+			if v.NumberValue < 0 || v.NumberValue != math.Trunc(v.NumberValue) {
+				return nil, errors.Errorf("%v is not an unsigned integer", v.NumberValue)
+			}
+			return uint64(v.NumberValue), nil
+		case exportColumnTypeFloat64:
+			return v.NumberValue, nil
+		case exportColumnTypeString:
+			return strconv.FormatFloat(v.NumberValue, 'f', -1, 64), nil
+		}
+	case *structpb.Value_BoolValue:
+		switch tp {
+		case exportColumnTypeBool:
+			return v.BoolValue, nil
+		case exportColumnTypeString:
+			return strconv.FormatBool(v.BoolValue), nil
+		}
+	case *structpb.Value_StringValue:
+		return convertExportValue(&v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: v.StringValue}}, tp)
+	default:
+		if tp == exportColumnTypeString {
+			b, err := value.MarshalJSON()
+			if err != nil {
+				return nil, err
+			}
+			return string(b), nil
+		}
+	}
+	return nil, errors.Errorf("unexpected value %v for the column type", value)
+}
+
+func parseExportTime(s string, tp exportColumnType) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if tp == exportColumnTypeDate {
+		if t, err := time.Parse("2006-01-02", s); err == nil {
+			return t, nil
+		}
+	}
+	// The drivers scanning the dates as time.Time format them as timestamps.
+	for _, layout := range exportTimestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.Errorf("%q is not a timestamp", s)
+}
+
+// exportJSONL exports the result in JSON Lines, one JSON object per row with the keys in the column order.
+func exportJSONL(result *v1pb.QueryResult) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeJSONL(&buf, result); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeJSONL(w io.Writer, result *v1pb.QueryResult) error {
+	names := getExportColumnNames(result)
+	types := getExportColumnTypes(result)
+	keys := make([][]byte, len(names))
+	for i, name := range names {
+		key, err := json.Marshal(name)
+		if err != nil {
+			return err
+		}
+		keys[i] = key
+	}
+
+	var line bytes.Buffer
+	for _, row := range result.Rows {
+		line.Reset()
+		line.WriteByte('{')
+		for i, value := range row.Values {
+			if i >= len(keys) {
+				break
+			}
+			if i > 0 {
+				line.WriteByte(',')
+			}
+			line.Write(keys[i])
+			line.WriteByte(':')
+			v, err := convertExportValue(value, types[i])
+			if err != nil {
+				return err
+			}
+			b, err := json.Marshal(convertExportValueToJSON(v, types[i]))
+			if err != nil {
+				return err
+			}
+			line.Write(b)
+		}
+		line.WriteString("}\n")
+		if _, err := w.Write(line.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convertExportValueToJSON converts the values without JSON types, i.e. binary, time and non-finite numbers, to strings.
+func convertExportValueToJSON(v any, tp exportColumnType) any {
+	switch v := v.(type) {
+	case []byte:
+		return base64.StdEncoding.EncodeToString(v)
+	case time.Time:
+		if tp == exportColumnTypeDate {
+			return v.Format("2006-01-02")
+		}
+		return v.Format(time.RFC3339Nano)
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		}
+	}
+	return v
+}
+
+// exportParquet exports the result in Parquet compressed with Snappy.
+func exportParquet(result *v1pb.QueryResult) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeParquet(&buf, result); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeParquet(w io.Writer, result *v1pb.QueryResult) error {
+	names := getExportColumnNames(result)
+	types := getExportColumnTypes(result)
+	var fields []arrow.Field
+	for i, name := range names {
+		fields = append(fields, arrow.Field{Name: name, Type: getArrowType(types[i]), Nullable: true})
+	}
+	schema := arrow.NewSchema(fields, nil)
+
+	writer, err := pqarrow.NewFileWriter(schema, w, parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy)), pqarrow.DefaultWriterProps())
+	if err != nil {
+		return errors.Wrapf(err, "failed to create parquet writer")
+	}
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+
+	flush := func() error {
+		record := builder.NewRecord()
+		defer record.Release()
+		if record.NumRows() == 0 {
+			return nil
+		}
+		return writer.Write(record)
+	}
+	for i, row := range result.Rows {
+		for j := range names {
+			var value *v1pb.RowValue
+			if j < len(row.Values) {
+				value = row.Values[j]
+			}
+			v, err := convertExportValue(value, types[j])
+			if err != nil {
+				return err
+			}
+			appendArrowValue(builder.Field(j), v, types[j])
+		}
+		if (i+1)%parquetRowGroupSize == 0 {
+			if err := flush(); err != nil {
+				return errors.Wrapf(err, "failed to write parquet rows")
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return errors.Wrapf(err, "failed to write parquet rows")
+	}
+	if err := writer.Close(); err != nil {
+		return errors.Wrapf(err, "failed to close parquet writer")
+	}
+	return nil
+}
+
+func getArrowType(tp exportColumnType) arrow.DataType {
+	switch tp {
+	case exportColumnTypeInt64:
+		return arrow.PrimitiveTypes.Int64
+	case exportColumnTypeUint64:
+		return arrow.PrimitiveTypes.Uint64
+	case exportColumnTypeFloat64:
+		return arrow.PrimitiveTypes.Float64
+	case exportColumnTypeBool:
+		return arrow.FixedWidthTypes.Boolean
+	case exportColumnTypeBinary:
+		return arrow.BinaryTypes.Binary
+	case exportColumnTypeDate:
+		return arrow.FixedWidthTypes.Date32
+	case exportColumnTypeTimestamp:
+		return &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}
+	default:
+		return arrow.BinaryTypes.String
+	}
+}
+
+func appendArrowValue(builder array.Builder, v any, tp exportColumnType) {
+	if v == nil {
+		builder.AppendNull()
+		return
+	}
+	switch tp {
+	case exportColumnTypeInt64:
+		builder.(*array.Int64Builder).Append(v.(int64))
+	case exportColumnTypeUint64:
+		builder.(*array.Uint64Builder).Append(v.(uint64))
+	case exportColumnTypeFloat64:
+		builder.(*array.Float64Builder).Append(v.(float64))
+	case exportColumnTypeBool:
+		builder.(*array.BooleanBuilder).Append(v.(bool))
+	case exportColumnTypeBinary:
+		builder.(*array.BinaryBuilder).Append(v.([]byte))
+	case exportColumnTypeDate:
+		builder.(*array.Date32Builder).Append(arrow.Date32FromTime(v.(time.Time)))
+	case exportColumnTypeTimestamp:
+		builder.(*array.TimestampBuilder).Append(arrow.Timestamp(v.(time.Time).UnixMicro()))
+	default:
+		builder.(*array.StringBuilder).Append(v.(string))
+	}
+}
@@ -0,0 +1,342 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const defaultQueryHistoryTopTableCount = 10
+
+// SearchQueryHistories searches the query histories of the SQL Editor.
+// The workspace owners and DBAs can search the query histories of all users, and the others can only search their own.
+func (s *SQLService) SearchQueryHistories(ctx context.Context, request *v1pb.SearchQueryHistoriesRequest) (*v1pb.SearchQueryHistoriesResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pageToken storepb.PageToken
+	if request.PageToken != "" {
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		if pageToken.Limit != request.PageSize {
+			return nil, status.Errorf(codes.InvalidArgument, "request page size does not match the page token")
+		}
+	} else {
+		pageToken.Limit = request.PageSize
+	}
+
+	limit := int(pageToken.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	limitPlusOne := limit + 1
+	offset := int(pageToken.Offset)
+
+	find := &store.FindQueryHistoryMessage{
+		Limit:  &limitPlusOne,
+		Offset: &offset,
+	}
+	if err := s.setQueryHistoryFindFilter(ctx, find, request.Filter, true /* allowResourceFilter */); err != nil {
+		return nil, err
+	}
+	if !isOwnerOrDBA(user.Role) {
+		if find.CreatorUID != nil && *find.CreatorUID != user.ID {
+			return nil, status.Errorf(codes.PermissionDenied, "only the workspace owners and DBAs can search the query histories of other users")
+		}
+		find.CreatorUID = &user.ID
+	}
+
+	histories, err := s.store.ListQueryHistories(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list query histories: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(histories) == limitPlusOne {
+		histories = histories[:limit]
+		if nextPageToken, err = marshalPageToken(&storepb.PageToken{
+			Limit:  int32(limit),
+			Offset: int32(limit + offset),
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal next page token, error: %v", err)
+		}
+	}
+
+	resp := &v1pb.SearchQueryHistoriesResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, history := range histories {
+		v1History, err := s.convertToQueryHistory(ctx, history)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert query history, error: %v", err)
+		}
+		resp.QueryHistories = append(resp.QueryHistories, v1History)
+	}
+	return resp, nil
+}
+
+// GetQueryHistoryStats returns the per-user statistics of the query histories of the SQL Editor.
+func (s *SQLService) GetQueryHistoryStats(ctx context.Context, request *v1pb.GetQueryHistoryStatsRequest) (*v1pb.QueryHistoryStats, error) {
+	topTableCount := int(request.TopTableCount)
+	if topTableCount < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "top table count must not be negative")
+	}
+	if topTableCount == 0 {
+		topTableCount = defaultQueryHistoryTopTableCount
+	}
+	if topTableCount > 100 {
+		topTableCount = 100
+	}
+
+	find := &store.FindQueryHistoryMessage{}
+	if err := s.setQueryHistoryFindFilter(ctx, find, request.Filter, false /* allowResourceFilter */); err != nil {
+		return nil, err
+	}
+	stats, err := s.store.GetQueryHistoryUserStats(ctx, find, topTableCount)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get query history statistics: %v", err)
+	}
+
+	resp := &v1pb.QueryHistoryStats{}
+	for _, stat := range stats {
+		creator, err := s.store.GetUserByID(ctx, stat.CreatorUID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user %d: %v", stat.CreatorUID, err)
+		}
+		if creator == nil {
+			continue
+		}
+		userStats := &v1pb.QueryHistoryStats_UserStats{
+			User:        fmt.Sprintf("%s%s", common.UserNamePrefix, creator.Email),
+			QueryCount:  stat.QueryCount,
+			ErrorCount:  stat.ErrorCount,
+			RowCount:    stat.RowCount,
+			P95Duration: durationpb.New(time.Duration(stat.P95DurationNs)),
+		}
+		for _, table := range stat.TopTables {
+			userStats.TopTables = append(userStats.TopTables, &v1pb.QueryHistoryStats_TableCount{
+				Table: table.Table,
+				Count: table.Count,
+			})
+		}
+		resp.UserStats = append(resp.UserStats, userStats)
+	}
+	return resp, nil
+}
+
+func (s *SQLService) setQueryHistoryFindFilter(ctx context.Context, find *store.FindQueryHistoryMessage, filter string, allowResourceFilter bool) error {
+	filters, err := parseFilter(filter)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	for _, spec := range filters {
+		switch spec.key {
+		case "creator":
+			if spec.operator != comparatorTypeEqual {
+				return status.Errorf(codes.InvalidArgument, `only support "=" operation for "creator" filter`)
+			}
+			creatorEmail := strings.TrimPrefix(spec.value, common.UserNamePrefix)
+			if creatorEmail == "" {
+				return status.Errorf(codes.InvalidArgument, "invalid empty creator identifier")
+			}
+			user, err := s.store.GetUser(ctx, &store.FindUserMessage{
+				Email:       &creatorEmail,
+				ShowDeleted: true,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, `failed to find user "%s" with error: %v`, creatorEmail, err.Error())
+			}
+			if user == nil {
+				return status.Errorf(codes.NotFound, "user %q not found", spec.value)
+			}
+			find.CreatorUID = &user.ID
+		case "create_time":
+			if spec.operator != comparatorTypeGreaterEqual && spec.operator != comparatorTypeLessEqual {
+				return status.Errorf(codes.InvalidArgument, `only support "<=" or ">=" operation for "create_time" filter`)
+			}
+			t, err := time.Parse(time.RFC3339, spec.value)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid create_time filter %q", spec.value)
+			}
+			ts := t.Unix()
+			if spec.operator == comparatorTypeGreaterEqual {
+				find.CreatedTsAfter = &ts
+			} else {
+				find.CreatedTsBefore = &ts
+			}
+		case "instance":
+			if !allowResourceFilter {
+				return status.Errorf(codes.InvalidArgument, "invalid filter %s", spec.key)
+			}
+			if spec.operator != comparatorTypeEqual {
+				return status.Errorf(codes.InvalidArgument, `only support "=" operation for "instance" filter`)
+			}
+			instanceID, err := common.GetInstanceID(spec.value)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
+				ResourceID:  &instanceID,
+				ShowDeleted: true,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, err.Error())
+			}
+			if instance == nil {
+				return status.Errorf(codes.NotFound, "instance %q not found", spec.value)
+			}
+			find.InstanceUID = &instance.UID
+		case "database":
+			if !allowResourceFilter {
+				return status.Errorf(codes.InvalidArgument, "invalid filter %s", spec.key)
+			}
+			if spec.operator != comparatorTypeEqual {
+				return status.Errorf(codes.InvalidArgument, `only support "=" operation for "database" filter`)
+			}
+			instanceID, databaseName, err := common.GetInstanceDatabaseID(spec.value)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+				InstanceID:   &instanceID,
+				DatabaseName: &databaseName,
+				ShowDeleted:  true,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, err.Error())
+			}
+			if database == nil {
+				return status.Errorf(codes.NotFound, "database %q not found", spec.value)
+			}
+			find.DatabaseUID = &database.UID
+		case "statement":
+			if !allowResourceFilter {
+				return status.Errorf(codes.InvalidArgument, "invalid filter %s", spec.key)
+			}
+			if spec.operator != comparatorTypeEqual {
+				return status.Errorf(codes.InvalidArgument, `only support "=" operation for "statement" filter`)
+			}
+			if spec.value != "" {
+				text := spec.value
+				find.StatementContains = &text
+			}
+		default:
+			return status.Errorf(codes.InvalidArgument, "invalid filter %s", spec.key)
+		}
+	}
+	return nil
+}
+
+func (s *SQLService) convertToQueryHistory(ctx context.Context, history *store.QueryHistoryMessage) (*v1pb.QueryHistory, error) {
+	creator, err := s.store.GetUserByID(ctx, history.CreatorUID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get creator %d", history.CreatorUID)
+	}
+	if creator == nil {
+		return nil, errors.Errorf("creator %d not found", history.CreatorUID)
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
+		UID:         &history.InstanceUID,
+		ShowDeleted: true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance %d", history.InstanceUID)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance %d not found", history.InstanceUID)
+	}
+	databaseName := ""
+	if history.DatabaseUID != nil {
+		database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+			UID:         history.DatabaseUID,
+			ShowDeleted: true,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get database %d", *history.DatabaseUID)
+		}
+		if database != nil {
+			databaseName = fmt.Sprintf("%s%s/%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName)
+		}
+	}
+
+	return &v1pb.QueryHistory{
+		Name:       fmt.Sprintf("%s%d", common.QueryHistoryNamePrefix, history.UID),
+		Creator:    fmt.Sprintf("%s%s", common.UserNamePrefix, creator.Email),
+		CreateTime: timestamppb.New(time.Unix(history.CreatedTs, 0)),
+		Instance:   fmt.Sprintf("%s%s", common.InstanceNamePrefix, instance.ResourceID),
+		Database:   databaseName,
+		Statement:  history.Statement,
+		Duration:   durationpb.New(time.Duration(history.DurationNs)),
+		RowCount:   history.RowCount,
+		Error:      history.Error,
+		Tables:     history.Tables,
+	}, nil
+}
+
+// recordQueryHistory records the query in the query histories unless disabled, the failure doesn't fail the query.
+func (s *SQLService) recordQueryHistory(ctx context.Context, user *store.UserMessage, instance *store.InstanceMessage, database *store.DatabaseMessage, request *v1pb.QueryRequest, results []*v1pb.QueryResult, durationNs int64, queryErr error) {
+	setting, err := s.store.GetQueryHistorySetting(ctx)
+	if err != nil {
+		slog.Error("failed to get query history setting", log.BBError(err))
+		return
+	}
+	if setting.Disabled {
+		return
+	}
+
+	history := &store.QueryHistoryMessage{
+		CreatorUID:  user.ID,
+		InstanceUID: instance.UID,
+		Statement:   request.Statement,
+		DurationNs:  durationNs,
+	}
+	if database != nil {
+		history.DatabaseUID = &database.UID
+	}
+	if queryErr != nil {
+		history.Error = queryErr.Error()
+	}
+	for _, result := range results {
+		history.RowCount += int64(len(result.Rows))
+		if result.Error != "" && history.Error == "" {
+			history.Error = result.Error
+		}
+	}
+	// The tables are only for the statistics, so the statements failed to parse are recorded without them.
+	if resources, err := base.ExtractResourceList(instance.Engine, request.ConnectionDatabase, "", request.Statement); err == nil {
+		seen := make(map[string]bool)
+		for _, resource := range resources {
+			table := resource.Pretty()
+			if table == "" || seen[table] {
+				continue
+			}
+			seen[table] = true
+			history.Tables = append(history.Tables, table)
+		}
+	}
+	if _, err := s.store.CreateQueryHistory(ctx, history); err != nil {
+		slog.Error("failed to record query history", slog.Int("instance_id", instance.UID), log.BBError(err))
+	}
+}
@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// applyExportRowQuota caps the export limit to the rows the database project is allowed to export for the rest of the day.
+func (s *SQLService) applyExportRowQuota(ctx context.Context, database *store.DatabaseMessage, request *v1pb.ExportRequest) error {
+	if database == nil {
+		return nil
+	}
+	remaining, err := utils.GetRemainingExportRows(ctx, s.store, database.ProjectID, time.Now())
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get remaining export rows, error: %v", err)
+	}
+	if remaining < 0 {
+		return nil
+	}
+	if remaining == 0 {
+		return status.Errorf(codes.ResourceExhausted, "the daily exported rows quota of project %q is exhausted", database.ProjectID)
+	}
+	request.Limit = capExportLimit(request.Limit, remaining)
+	return nil
+}
+
+// capExportLimit caps the export limit to the remaining rows, the zero limit means unlimited.
+func capExportLimit(limit int32, remaining int64) int32 {
+	remaining = min(remaining, math.MaxInt32)
+	if limit <= 0 || int64(limit) > remaining {
+		return int32(remaining)
+	}
+	return limit
+}
+
+// applyQueryRuntimeQuota caps the query timeout to the maximum query runtime of the database project.
+func (s *SQLService) applyQueryRuntimeQuota(ctx context.Context, database *store.DatabaseMessage, request *v1pb.QueryRequest) error {
+	maxRuntime, err := s.getMaxQueryRuntime(ctx, database)
+	if err != nil {
+		return err
+	}
+	request.Timeout = capQueryTimeout(request.Timeout, maxRuntime)
+	return nil
+}
+
+// getMaxQueryRuntime returns the maximum query runtime of the database project, nil if unlimited.
+func (s *SQLService) getMaxQueryRuntime(ctx context.Context, database *store.DatabaseMessage) (*durationpb.Duration, error) {
+	if database == nil {
+		return nil, nil
+	}
+	quota, err := utils.GetProjectQuota(ctx, s.store, database.ProjectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project quota, error: %v", err)
+	}
+	return quota.GetMaxQueryRuntime(), nil
+}
+
+// capQueryTimeout caps the query timeout to the maximum runtime, the nil or zero maximum runtime means unlimited.
+func capQueryTimeout(timeout, maxRuntime *durationpb.Duration) *durationpb.Duration {
+	if maxRuntime.AsDuration() <= 0 {
+		return timeout
+	}
+	current := defaultTimeout
+	if timeout != nil {
+		current = timeout.AsDuration()
+	}
+	if current > maxRuntime.AsDuration() {
+		return maxRuntime
+	}
+	return timeout
+}
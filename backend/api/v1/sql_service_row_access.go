@@ -0,0 +1,174 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// injectRowFilters rewrites the statement with the row filters of the row access policies applying to the user.
+// The statement is returned as is if no row access rule applies to the user.
+func (s *SQLService) injectRowFilters(ctx context.Context, user *store.UserMessage, instance *store.InstanceMessage, connectionDatabase, statement string) (string, error) {
+	resolver, err := newRowFilterResolver(ctx, s.store, user, instance)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get row access policies: %v", err)
+	}
+	if len(resolver.rules) == 0 {
+		return statement, nil
+	}
+
+	switch instance.Engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_POSTGRES:
+	default:
+		// Fail closed, the rows cannot be filtered for the engine.
+		rules, err := resolver.getDatabaseRules(connectionDatabase)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to get row access rules: %v", err)
+		}
+		if len(rules) > 0 {
+			return "", status.Errorf(codes.PermissionDenied, "row access policy is not supported for %s", instance.Engine)
+		}
+		return statement, nil
+	}
+
+	rewritten, err := base.InjectRowFilters(instance.Engine, statement, connectionDatabase, resolver.getRowFilter)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "failed to apply row access policy: %v", err)
+	}
+	if resolver.err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get row access rules: %v", resolver.err)
+	}
+	return rewritten, nil
+}
+
+// rowFilterResolver resolves the row filters of the tables in an instance for a user.
+type rowFilterResolver struct {
+	ctx      context.Context
+	store    *store.Store
+	instance *store.InstanceMessage
+	// rules are the row access rules applying to the user by the project UID.
+	rules map[int][]*storepb.RowAccessRule
+	// databaseRules caches the row access rules applying to the user by the database name.
+	databaseRules map[string][]*storepb.RowAccessRule
+	// err is the first error met in getRowFilter.
+	err error
+}
+
+func newRowFilterResolver(ctx context.Context, stores *store.Store, user *store.UserMessage, instance *store.InstanceMessage) (*rowFilterResolver, error) {
+	pType := api.PolicyTypeRowAccess
+	policies, err := stores.ListPoliciesV2(ctx, &store.FindPolicyMessage{
+		Type: &pType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &rowFilterResolver{
+		ctx:           ctx,
+		store:         stores,
+		instance:      instance,
+		rules:         make(map[int][]*storepb.RowAccessRule),
+		databaseRules: make(map[string][]*storepb.RowAccessRule),
+	}
+	for _, policy := range policies {
+		if policy.ResourceType != api.PolicyResourceTypeProject {
+			continue
+		}
+		payload := &storepb.RowAccessPolicy{}
+		if err := protojson.Unmarshal([]byte(policy.Payload), payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal row access policy of project %d", policy.ResourceUID)
+		}
+		if len(payload.Rules) == 0 {
+			continue
+		}
+		projectUID := policy.ResourceUID
+		iamPolicy, err := stores.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{UID: &projectUID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get iam policy of project %d", projectUID)
+		}
+		roles := make(map[string]bool)
+		for _, binding := range iamPolicy.Bindings {
+			for _, member := range binding.Members {
+				if member.ID == user.ID {
+					roles[common.FormatRole(binding.Role.String())] = true
+					break
+				}
+			}
+		}
+		for _, rule := range payload.Rules {
+			if roles[rule.Role] {
+				r.rules[projectUID] = append(r.rules[projectUID], rule)
+			}
+		}
+	}
+	return r, nil
+}
+
+// getDatabaseRules returns the row access rules applying to the user in the database.
+func (r *rowFilterResolver) getDatabaseRules(databaseName string) ([]*storepb.RowAccessRule, error) {
+	if databaseName == "" {
+		return nil, nil
+	}
+	if rules, ok := r.databaseRules[databaseName]; ok {
+		return rules, nil
+	}
+	database, err := r.store.GetDatabaseV2(r.ctx, &store.FindDatabaseMessage{
+		InstanceID:   &r.instance.ResourceID,
+		DatabaseName: &databaseName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", databaseName)
+	}
+	var rules []*storepb.RowAccessRule
+	if database != nil {
+		project, err := r.store.GetProjectV2(r.ctx, &store.FindProjectMessage{
+			ResourceID: &database.ProjectID,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get project %q", database.ProjectID)
+		}
+		if project != nil {
+			for _, rule := range r.rules[project.UID] {
+				if rule.Database == "" || strings.EqualFold(rule.Database, databaseName) {
+					rules = append(rules, rule)
+				}
+			}
+		}
+	}
+	r.databaseRules[databaseName] = rules
+	return rules, nil
+}
+
+// getRowFilter returns the predicate of the rows of the table visible to the user.
+// The filters of the rules on the same table are combined with OR, as each role grants access to its rows.
+func (r *rowFilterResolver) getRowFilter(databaseName, schemaName, tableName string) string {
+	rules, err := r.getDatabaseRules(databaseName)
+	if err != nil {
+		if r.err == nil {
+			r.err = err
+		}
+		return ""
+	}
+	var filters []string
+	for _, rule := range rules {
+		if rule.Schema != "" && !strings.EqualFold(rule.Schema, schemaName) {
+			continue
+		}
+		if !strings.EqualFold(rule.Table, tableName) {
+			continue
+		}
+		filters = append(filters, fmt.Sprintf("(%s)", rule.Filter))
+	}
+	return strings.Join(filters, " OR ")
+}
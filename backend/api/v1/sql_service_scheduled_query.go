@@ -0,0 +1,427 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/runner/scheduledquery"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// CreateScheduledQuery creates a scheduled query run on behalf of the caller.
+func (s *SQLService) CreateScheduledQuery(ctx context.Context, request *v1pb.CreateScheduledQueryRequest) (*v1pb.ScheduledQuery, error) {
+	scheduledQuery := request.ScheduledQuery
+	if scheduledQuery == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "scheduled query must be set")
+	}
+	if scheduledQuery.Title == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "title must be set")
+	}
+	nextRunTs, err := getScheduledQueryNextRunTs(scheduledQuery.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := convertToScheduledQueryPayload(scheduledQuery)
+	if err != nil {
+		return nil, err
+	}
+	user, database, err := s.checkScheduledQuery(ctx, scheduledQuery.Database, scheduledQuery.Statement, payload.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.store.CreateScheduledQuery(ctx, &store.ScheduledQueryMessage{
+		DatabaseUID: database.UID,
+		Title:       scheduledQuery.Title,
+		Statement:   scheduledQuery.Statement,
+		Schedule:    scheduledQuery.Schedule,
+		NextRunTs:   nextRunTs,
+		Payload:     payload,
+	}, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create scheduled query: %v", err)
+	}
+	return s.convertToScheduledQuery(ctx, created)
+}
+
+// ListScheduledQueries lists the scheduled queries of the caller, or all the scheduled queries for the workspace owners and DBAs.
+func (s *SQLService) ListScheduledQueries(ctx context.Context, request *v1pb.ListScheduledQueriesRequest) (*v1pb.ListScheduledQueriesResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not found")
+	}
+	limit, offset, err := getScheduledQueryPage(request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	limitPlusOne := limit + 1
+
+	find := &store.FindScheduledQueryMessage{
+		Limit:  &limitPlusOne,
+		Offset: &offset,
+	}
+	if user.Role != api.WorkspaceAdmin && user.Role != api.WorkspaceDBA {
+		find.CreatorUID = &user.ID
+	}
+	scheduledQueries, err := s.store.ListScheduledQueries(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list scheduled queries: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(scheduledQueries) == limitPlusOne {
+		scheduledQueries = scheduledQueries[:limit]
+		if nextPageToken, err = marshalPageToken(&storepb.PageToken{
+			Limit:  int32(limit),
+			Offset: int32(limit + offset),
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal next page token, error: %v", err)
+		}
+	}
+
+	resp := &v1pb.ListScheduledQueriesResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, scheduledQuery := range scheduledQueries {
+		v1ScheduledQuery, err := s.convertToScheduledQuery(ctx, scheduledQuery)
+		if err != nil {
+			return nil, err
+		}
+		resp.ScheduledQueries = append(resp.ScheduledQueries, v1ScheduledQuery)
+	}
+	return resp, nil
+}
+
+// UpdateScheduledQuery updates the scheduled query.
+func (s *SQLService) UpdateScheduledQuery(ctx context.Context, request *v1pb.UpdateScheduledQueryRequest) (*v1pb.ScheduledQuery, error) {
+	if request.ScheduledQuery == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "scheduled query must be set")
+	}
+	if request.UpdateMask == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "update_mask must be set")
+	}
+	scheduledQuery, err := s.getScheduledQueryByName(ctx, request.ScheduledQuery.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	patch := &store.UpdateScheduledQueryMessage{UID: scheduledQuery.UID}
+	// The updated scheduled query in v1 for validating the statement against the database.
+	updated, err := s.convertToScheduledQuery(ctx, scheduledQuery)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "title":
+			if request.ScheduledQuery.Title == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "title must be set")
+			}
+			patch.Title = &request.ScheduledQuery.Title
+		case "database":
+			updated.Database = request.ScheduledQuery.Database
+		case "statement":
+			patch.Statement = &request.ScheduledQuery.Statement
+			updated.Statement = request.ScheduledQuery.Statement
+		case "schedule":
+			nextRunTs, err := getScheduledQueryNextRunTs(request.ScheduledQuery.Schedule)
+			if err != nil {
+				return nil, err
+			}
+			patch.Schedule = &request.ScheduledQuery.Schedule
+			patch.NextRunTs = &nextRunTs
+		case "data_source_id":
+			updated.DataSourceId = request.ScheduledQuery.DataSourceId
+		case "limit":
+			updated.Limit = request.ScheduledQuery.Limit
+		case "retention_days":
+			updated.RetentionDays = request.ScheduledQuery.RetentionDays
+		case "webhook_delivery":
+			updated.WebhookDelivery = request.ScheduledQuery.WebhookDelivery
+		case "email_recipients":
+			updated.EmailRecipients = request.ScheduledQuery.EmailRecipients
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update_mask %q", path)
+		}
+	}
+	payload, err := convertToScheduledQueryPayload(updated)
+	if err != nil {
+		return nil, err
+	}
+	patch.Payload = payload
+	// Check the query rights of the caller again, as the caller may not be the creator.
+	_, database, err := s.checkScheduledQuery(ctx, updated.Database, updated.Statement, payload.Limit)
+	if err != nil {
+		return nil, err
+	}
+	if database.UID != scheduledQuery.DatabaseUID {
+		patch.DatabaseUID = &database.UID
+	}
+
+	scheduledQuery, err = s.store.UpdateScheduledQuery(ctx, patch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update scheduled query: %v", err)
+	}
+	return s.convertToScheduledQuery(ctx, scheduledQuery)
+}
+
+// DeleteScheduledQuery deletes the scheduled query with its results.
+func (s *SQLService) DeleteScheduledQuery(ctx context.Context, request *v1pb.DeleteScheduledQueryRequest) (*emptypb.Empty, error) {
+	scheduledQuery, err := s.getScheduledQueryByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.DeleteScheduledQuery(ctx, scheduledQuery.UID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete scheduled query: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListScheduledQueryResults lists the result snapshots of the scheduled query.
+func (s *SQLService) ListScheduledQueryResults(ctx context.Context, request *v1pb.ListScheduledQueryResultsRequest) (*v1pb.ListScheduledQueryResultsResponse, error) {
+	scheduledQuery, err := s.getScheduledQueryByName(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset, err := getScheduledQueryPage(request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	limitPlusOne := limit + 1
+
+	results, err := s.store.ListScheduledQueryResults(ctx, &store.FindScheduledQueryResultMessage{
+		ScheduledQueryUID: &scheduledQuery.UID,
+		Limit:             &limitPlusOne,
+		Offset:            &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list scheduled query results: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(results) == limitPlusOne {
+		results = results[:limit]
+		if nextPageToken, err = marshalPageToken(&storepb.PageToken{
+			Limit:  int32(limit),
+			Offset: int32(limit + offset),
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal next page token, error: %v", err)
+		}
+	}
+
+	resp := &v1pb.ListScheduledQueryResultsResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, result := range results {
+		v1Result := &v1pb.ScheduledQueryResult{
+			Name:       fmt.Sprintf("%s%d/%s%d", common.ScheduledQueryNamePrefix, scheduledQuery.UID, common.ScheduledQueryResultPrefix, result.UID),
+			CreateTime: timestamppb.New(time.Unix(result.CreatedTs, 0)),
+			Status:     v1pb.ScheduledQueryResult_DONE,
+			RowCount:   result.RowCount,
+			Content:    result.Content,
+			Error:      result.Error,
+		}
+		if result.Status == store.ScheduledQueryResultStatusFailed {
+			v1Result.Status = v1pb.ScheduledQueryResult_FAILED
+		}
+		resp.Results = append(resp.Results, v1Result)
+	}
+	return resp, nil
+}
+
+// ExecuteScheduledQuery runs the scheduled query as a query of its creator, and returns the first result in CSV and its row count.
+// The query goes through the same access check, SQL review, row filters and masking as the SQL Editor queries of the creator.
+func (s *SQLService) ExecuteScheduledQuery(ctx context.Context, scheduledQuery *store.ScheduledQueryMessage) (string, int64, error) {
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: &scheduledQuery.DatabaseUID})
+	if err != nil {
+		return "", 0, errors.Wrapf(err, "failed to get database %d", scheduledQuery.DatabaseUID)
+	}
+	if database == nil {
+		return "", 0, errors.Errorf("database %d not found", scheduledQuery.DatabaseUID)
+	}
+
+	ctx = context.WithValue(ctx, common.PrincipalIDContextKey, scheduledQuery.CreatorUID)
+	response, err := s.Query(ctx, &v1pb.QueryRequest{
+		Name:               fmt.Sprintf("%s%s", common.InstanceNamePrefix, database.InstanceID),
+		ConnectionDatabase: database.DatabaseName,
+		Statement:          scheduledQuery.Statement,
+		Limit:              scheduledQuery.Payload.Limit,
+		DataSourceId:       scheduledQuery.Payload.DataSourceId,
+	})
+	if err != nil {
+		return "", 0, err
+	}
+	if len(response.Results) == 0 {
+		return "", 0, errors.Errorf("no result")
+	}
+	result := response.Results[0]
+	if result.Error != "" {
+		return "", 0, errors.New(result.Error)
+	}
+	content, err := exportCSV(result)
+	if err != nil {
+		return "", 0, errors.Wrapf(err, "failed to convert result to CSV")
+	}
+	return string(content), int64(len(result.Rows)), nil
+}
+
+// checkScheduledQuery checks the caller can query the statement against the database.
+func (s *SQLService) checkScheduledQuery(ctx context.Context, databaseName, statement string, limit int32) (*store.UserMessage, *store.DatabaseMessage, error) {
+	instanceID, databaseID, err := common.GetInstanceDatabaseID(databaseName)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	user, _, database, adviceStatus, _, _, err := s.preCheck(ctx, fmt.Sprintf("%s%s", common.InstanceNamePrefix, instanceID), databaseID, statement, limit, false /* isAdmin */, false /* isExport */)
+	if err != nil {
+		return nil, nil, err
+	}
+	if database == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+	if adviceStatus == advisor.Error {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "the statement violates the SQL review policy, run the query to see the advices")
+	}
+	return user, database, nil
+}
+
+// getScheduledQueryByName gets the scheduled query managed by the caller, which is either the creator, or a workspace owner or DBA.
+func (s *SQLService) getScheduledQueryByName(ctx context.Context, name string) (*store.ScheduledQueryMessage, error) {
+	uid, err := common.GetUIDFromName(name, common.ScheduledQueryNamePrefix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not found")
+	}
+	scheduledQuery, err := s.store.GetScheduledQuery(ctx, uid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get scheduled query: %v", err)
+	}
+	if scheduledQuery == nil {
+		return nil, status.Errorf(codes.NotFound, "scheduled query %q not found", name)
+	}
+	if scheduledQuery.CreatorUID != user.ID && user.Role != api.WorkspaceAdmin && user.Role != api.WorkspaceDBA {
+		return nil, status.Errorf(codes.PermissionDenied, "only the creator, workspace owner and DBA can manage the scheduled query")
+	}
+	return scheduledQuery, nil
+}
+
+func getScheduledQueryNextRunTs(expression string) (int64, error) {
+	schedule, err := scheduledquery.ParseSchedule(expression)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid schedule: %v", err)
+	}
+	next := schedule.Next(time.Now())
+	if next.IsZero() {
+		return 0, status.Errorf(codes.InvalidArgument, "schedule %q never runs", expression)
+	}
+	return next.Unix(), nil
+}
+
+func getScheduledQueryPage(pageSize int32, token string) (int, int, error) {
+	var pageToken storepb.PageToken
+	if token != "" {
+		if err := unmarshalPageToken(token, &pageToken); err != nil {
+			return 0, 0, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		if pageToken.Limit != pageSize {
+			return 0, 0, status.Errorf(codes.InvalidArgument, "request page size does not match the page token")
+		}
+	} else {
+		pageToken.Limit = pageSize
+	}
+
+	limit := int(pageToken.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	return limit, int(pageToken.Offset), nil
+}
+
+func convertToScheduledQueryPayload(scheduledQuery *v1pb.ScheduledQuery) (*storepb.ScheduledQueryPayload, error) {
+	if scheduledQuery.Limit < 0 || scheduledQuery.Limit > scheduledquery.MaximumLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 0 and %d", scheduledquery.MaximumLimit)
+	}
+	if scheduledQuery.RetentionDays < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "retention days must not be negative")
+	}
+	for _, recipient := range scheduledQuery.EmailRecipients {
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid email recipient %q", recipient)
+		}
+	}
+	payload := &storepb.ScheduledQueryPayload{
+		DataSourceId:    scheduledQuery.DataSourceId,
+		Limit:           scheduledQuery.Limit,
+		RetentionDays:   scheduledQuery.RetentionDays,
+		WebhookDelivery: scheduledQuery.WebhookDelivery,
+		EmailRecipients: scheduledQuery.EmailRecipients,
+	}
+	if payload.Limit == 0 {
+		payload.Limit = scheduledquery.DefaultLimit
+	}
+	if payload.RetentionDays == 0 {
+		payload.RetentionDays = scheduledquery.DefaultRetentionDays
+	}
+	return payload, nil
+}
+
+func (s *SQLService) convertToScheduledQuery(ctx context.Context, scheduledQuery *store.ScheduledQueryMessage) (*v1pb.ScheduledQuery, error) {
+	creator, err := s.store.GetUserByID(ctx, scheduledQuery.CreatorUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get creator %d: %v", scheduledQuery.CreatorUID, err)
+	}
+	if creator == nil {
+		return nil, status.Errorf(codes.NotFound, "creator %d not found", scheduledQuery.CreatorUID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		UID:         &scheduledQuery.DatabaseUID,
+		ShowDeleted: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database %d: %v", scheduledQuery.DatabaseUID, err)
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %d not found", scheduledQuery.DatabaseUID)
+	}
+
+	return &v1pb.ScheduledQuery{
+		Name:            fmt.Sprintf("%s%d", common.ScheduledQueryNamePrefix, scheduledQuery.UID),
+		Title:           scheduledQuery.Title,
+		Database:        fmt.Sprintf("%s%s/%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName),
+		Statement:       scheduledQuery.Statement,
+		Schedule:        scheduledQuery.Schedule,
+		DataSourceId:    scheduledQuery.Payload.DataSourceId,
+		Limit:           scheduledQuery.Payload.Limit,
+		RetentionDays:   scheduledQuery.Payload.RetentionDays,
+		WebhookDelivery: scheduledQuery.Payload.WebhookDelivery,
+		EmailRecipients: scheduledQuery.Payload.EmailRecipients,
+		Creator:         fmt.Sprintf("%s%s", common.UserNamePrefix, creator.Email),
+		CreateTime:      timestamppb.New(time.Unix(scheduledQuery.CreatedTs, 0)),
+		UpdateTime:      timestamppb.New(time.Unix(scheduledQuery.UpdatedTs, 0)),
+		NextRunTime:     timestamppb.New(time.Unix(scheduledQuery.NextRunTs, 0)),
+	}, nil
+}
@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/sheetparam"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// QuerySharedSheet renders the parameters of the shared sheet with the values of the caller, and runs it as a query of the caller.
+func (s *SQLService) QuerySharedSheet(ctx context.Context, request *v1pb.QuerySharedSheetRequest) (*v1pb.QueryResponse, error) {
+	sheet, err := getSharedSheet(ctx, s.store, request.Sheet, request.Token)
+	if err != nil {
+		return nil, err
+	}
+	if sheet.DatabaseUID == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "shared sheet %q has no database", request.Sheet)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: sheet.DatabaseUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database: %v", err)
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %d not found", *sheet.DatabaseUID)
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance: %v", err)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", database.InstanceID)
+	}
+
+	statement, err := sheetparam.Render(instance.Engine, sheet.Statement, sheet.Payload.Parameters, request.Parameters)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return s.Query(ctx, &v1pb.QueryRequest{
+		Name:               fmt.Sprintf("%s%s", common.InstanceNamePrefix, instance.ResourceID),
+		ConnectionDatabase: database.DatabaseName,
+		Statement:          statement,
+		Limit:              request.Limit,
+		DataSourceId:       request.DataSourceId,
+	})
+}
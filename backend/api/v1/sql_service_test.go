@@ -1,10 +1,19 @@
 package v1
 
 import (
+	"bytes"
+	"context"
+	"math"
 	"testing"
+	"time"
 
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
 
+	"github.com/bytebase/bytebase/backend/component/watermark"
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
@@ -259,3 +268,191 @@ func TestGetExcelColumnName(t *testing.T) {
 		a.Equal(test.want, got)
 	}
 }
+
+func TestWithWatermark(t *testing.T) {
+	a := assert.New(t)
+
+	result := &v1pb.QueryResult{
+		ColumnNames: []string{"id", "email"},
+	}
+	a.Equal(result, withWatermark(result, ""))
+
+	watermarked := withWatermark(result, "alice@example.com 2023-11-01T08:00:00Z")
+	a.Equal([]string{"id", "email"}, result.ColumnNames)
+	a.Equal("email", watermarked.ColumnNames[1])
+	text, err := watermark.Decode(watermarked.ColumnNames[0])
+	a.NoError(err)
+	a.Equal("alice@example.com 2023-11-01T08:00:00Z", text)
+}
+
+func TestRowFilterResolverGetRowFilter(t *testing.T) {
+	a := assert.New(t)
+
+	r := &rowFilterResolver{
+		databaseRules: map[string][]*storepb.RowAccessRule{
+			"db": {
+				{Schema: "public", Table: "orders", Role: "roles/QUERIER", Filter: "region = 'EU'"},
+				{Table: "Orders", Role: "roles/analyst", Filter: "amount < 100"},
+				{Table: "users", Role: "roles/QUERIER", Filter: "active"},
+			},
+			"other": nil,
+		},
+	}
+	a.Equal("(region = 'EU') OR (amount < 100)", r.getRowFilter("db", "public", "orders"))
+	a.Equal("(amount < 100)", r.getRowFilter("db", "sales", "orders"))
+	a.Equal("", r.getRowFilter("other", "public", "orders"))
+	a.NoError(r.err)
+}
+
+func TestGetQueryCursorPageSize(t *testing.T) {
+	tests := []struct {
+		pageSize        int32
+		defaultPageSize int
+		want            int
+		wantErr         bool
+	}{
+		{pageSize: 0, defaultPageSize: defaultQueryCursorPageSize, want: defaultQueryCursorPageSize},
+		{pageSize: 0, defaultPageSize: 0, want: 0},
+		{pageSize: 200, defaultPageSize: defaultQueryCursorPageSize, want: 200},
+		{pageSize: maximumQueryCursorPageSize + 1, wantErr: true},
+		{pageSize: -1, wantErr: true},
+	}
+
+	a := assert.New(t)
+	for _, test := range tests {
+		got, err := getQueryCursorPageSize(test.pageSize, test.defaultPageSize)
+		if test.wantErr {
+			a.Error(err)
+			continue
+		}
+		a.NoError(err)
+		a.Equal(test.want, got)
+	}
+}
+
+func TestGetExportColumnType(t *testing.T) {
+	tests := map[string]exportColumnType{
+		"INT":                      exportColumnTypeInt64,
+		"UNSIGNED BIGINT":          exportColumnTypeUint64,
+		"Nullable(Int32)":          exportColumnTypeInt64,
+		"FLOAT8":                   exportColumnTypeFloat64,
+		"BOOL":                     exportColumnTypeBool,
+		"BYTEA":                    exportColumnTypeBinary,
+		"DATE":                     exportColumnTypeDate,
+		"DateTime64(3)":            exportColumnTypeTimestamp,
+		"TIMESTAMPTZ":              exportColumnTypeTimestamp,
+		"DECIMAL":                  exportColumnTypeString,
+		"LowCardinality(String)":   exportColumnTypeString,
+		"CHARACTER VARYING":        exportColumnTypeString,
+		"UNSIGNED INT":             exportColumnTypeInt64,
+		"Nullable(DateTime64(6))":  exportColumnTypeTimestamp,
+		"LowCardinality(Nullable)": exportColumnTypeString,
+	}
+
+	a := assert.New(t)
+	for typeName, want := range tests {
+		a.Equal(want, getExportColumnType(typeName), typeName)
+	}
+}
+
+func getExportFormatTestResult() *v1pb.QueryResult {
+	return &v1pb.QueryResult{
+		ColumnNames:     []string{"id", "name", "score", "active", "created_at", "id", "email"},
+		ColumnTypeNames: []string{"INT", "VARCHAR", "FLOAT8", "BOOL", "TIMESTAMP", "INT", "VARCHAR"},
+		Masked:          []bool{false, false, false, false, false, false, true},
+		Rows: []*v1pb.QueryRow{
+			{
+				Values: []*v1pb.RowValue{
+					{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "alice"}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "9.5"}},
+					{Kind: &v1pb.RowValue_BoolValue{BoolValue: true}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "2024-01-02 03:04:05"}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "10"}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}},
+				},
+			},
+			{
+				Values: []*v1pb.RowValue{
+					{Kind: &v1pb.RowValue_Int64Value{Int64Value: 2}},
+					{Kind: &v1pb.RowValue_NullValue{}},
+					{Kind: &v1pb.RowValue_NullValue{}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "f"}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "0000-00-00 00:00:00"}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "20"}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}},
+				},
+			},
+		},
+	}
+}
+
+func TestExportJSONL(t *testing.T) {
+	a := assert.New(t)
+	got, err := exportJSONL(getExportFormatTestResult())
+	a.NoError(err)
+	want := `{"id":1,"name":"alice","score":9.5,"active":true,"created_at":"2024-01-02 03:04:05","id_1":10,"email":"******"}
+{"id":2,"name":null,"score":null,"active":false,"created_at":"0000-00-00 00:00:00","id_1":20,"email":"******"}
+`
+	a.Equal(want, string(got))
+}
+
+func TestExportParquet(t *testing.T) {
+	a := assert.New(t)
+	got, err := exportParquet(getExportFormatTestResult())
+	a.NoError(err)
+
+	table, err := pqarrow.ReadTable(context.Background(), bytes.NewReader(got), nil, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	a.NoError(err)
+	defer table.Release()
+	a.Equal(int64(2), table.NumRows())
+
+	var fields []string
+	var types []arrow.Type
+	for _, field := range table.Schema().Fields() {
+		fields = append(fields, field.Name)
+		types = append(types, field.Type.ID())
+	}
+	a.Equal([]string{"id", "name", "score", "active", "created_at", "id_1", "email"}, fields)
+	// The timestamps fall back to strings for the zero date.
+	a.Equal([]arrow.Type{arrow.INT64, arrow.STRING, arrow.FLOAT64, arrow.BOOL, arrow.STRING, arrow.INT64, arrow.STRING}, types)
+}
+
+func TestCapExportLimit(t *testing.T) {
+	tests := []struct {
+		limit     int32
+		remaining int64
+		want      int32
+	}{
+		{limit: 0, remaining: 100, want: 100},
+		{limit: 50, remaining: 100, want: 50},
+		{limit: 500, remaining: 100, want: 100},
+		{limit: 0, remaining: math.MaxInt64, want: math.MaxInt32},
+	}
+
+	a := assert.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, capExportLimit(test.limit, test.remaining))
+	}
+}
+
+func TestCapQueryTimeout(t *testing.T) {
+	tests := []struct {
+		timeout    *durationpb.Duration
+		maxRuntime *durationpb.Duration
+		want       time.Duration
+	}{
+		{timeout: nil, maxRuntime: nil, want: 0},
+		{timeout: durationpb.New(time.Hour), maxRuntime: nil, want: time.Hour},
+		{timeout: nil, maxRuntime: durationpb.New(time.Minute), want: time.Minute},
+		{timeout: nil, maxRuntime: durationpb.New(time.Hour), want: 0},
+		{timeout: durationpb.New(time.Second), maxRuntime: durationpb.New(time.Minute), want: time.Second},
+		{timeout: durationpb.New(time.Hour), maxRuntime: durationpb.New(time.Minute), want: time.Minute},
+	}
+
+	a := assert.New(t)
+	for _, test := range tests {
+		// The zero duration stands for the nil timeout, i.e. the default timeout.
+		a.Equal(test.want, capQueryTimeout(test.timeout, test.maxRuntime).AsDuration())
+	}
+}
@@ -14,7 +14,10 @@ import (
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/tracing"
 	"github.com/bytebase/bytebase/backend/component/masker"
+	"github.com/bytebase/bytebase/backend/component/maskingpolicy"
+	"github.com/bytebase/bytebase/backend/component/queryaudit"
 
 	"github.com/pkg/errors"
 
@@ -55,6 +58,15 @@ func (s *SQLService) ExportV2(ctx context.Context, request *v1pb.ExportRequest)
 			return nil, err
 		}
 	}
+	rowFilteredStatement, err := s.injectRowFilters(ctx, user, instance, request.ConnectionDatabase, request.Statement)
+	if err != nil {
+		return nil, err
+	}
+	exportRequest := proto.Clone(request).(*v1pb.ExportRequest)
+	exportRequest.Statement = rowFilteredStatement
+	if err := s.applyExportRowQuota(ctx, maybeDatabase, exportRequest); err != nil {
+		return nil, err
+	}
 
 	// Run SQL review.
 	if _, _, err = s.sqlReviewCheck(ctx, statement, environment, instance, maybeDatabase); err != nil {
@@ -76,7 +88,8 @@ func (s *SQLService) ExportV2(ctx context.Context, request *v1pb.ExportRequest)
 		return nil, err
 	}
 
-	bytes, durationNs, exportErr := s.doExportV2(ctx, request, instance, maybeDatabase, spans)
+	watermark := s.getExportWatermark(ctx, user, request.Format)
+	bytes, results, durationNs, exportErr := s.doExportV2(ctx, exportRequest, instance, maybeDatabase, spans, watermark)
 
 	if err := s.postExport(ctx, activity, durationNs, exportErr); err != nil {
 		return nil, err
@@ -85,6 +98,18 @@ func (s *SQLService) ExportV2(ctx context.Context, request *v1pb.ExportRequest)
 	if exportErr != nil {
 		return nil, exportErr
 	}
+	s.auditExecution(ctx, &queryaudit.Execution{
+		ActivityID:   activity.UID,
+		Export:       true,
+		User:         user,
+		Instance:     instance,
+		Database:     maybeDatabase,
+		DatabaseName: request.ConnectionDatabase,
+		Statement:    request.Statement,
+		Results:      results,
+		Time:         time.Now(),
+	})
+	s.recordExportAudit(ctx, user, instance, maybeDatabase, request, results, watermark)
 
 	content, err := doEncrypt(bytes, request)
 	if err != nil {
@@ -125,6 +150,15 @@ func (s *SQLService) QueryV2(ctx context.Context, request *v1pb.QueryRequest) (*
 			return nil, err
 		}
 	}
+	rowFilteredStatement, err := s.injectRowFilters(ctx, user, instance, request.ConnectionDatabase, request.Statement)
+	if err != nil {
+		return nil, err
+	}
+	queryRequest := proto.Clone(request).(*v1pb.QueryRequest)
+	queryRequest.Statement = rowFilteredStatement
+	if err := s.applyQueryRuntimeQuota(ctx, maybeDatabase, queryRequest); err != nil {
+		return nil, err
+	}
 
 	// Run SQL review.
 	adviceStatus, advices, err := s.sqlReviewCheck(ctx, statement, environment, instance, maybeDatabase)
@@ -159,7 +193,7 @@ func (s *SQLService) QueryV2(ctx context.Context, request *v1pb.QueryRequest) (*
 	var queryErr error
 	var durationNs int64
 	if adviceStatus != advisor.Error {
-		results, durationNs, queryErr = s.doQueryV2(ctx, request, instance, maybeDatabase)
+		results, durationNs, queryErr = s.doQueryV2(ctx, queryRequest, instance, maybeDatabase)
 		if queryErr == nil && s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil {
 			if err := s.maskResults(ctx, spans, results, instance, storepb.MaskingExceptionPolicy_MaskingException_QUERY); err != nil {
 				return nil, err
@@ -172,9 +206,20 @@ func (s *SQLService) QueryV2(ctx context.Context, request *v1pb.QueryRequest) (*
 	if err != nil {
 		return nil, err
 	}
+	s.recordQueryHistory(ctx, user, instance, maybeDatabase, request, results, durationNs, queryErr)
 	if queryErr != nil {
 		return nil, queryErr
 	}
+	s.auditExecution(ctx, &queryaudit.Execution{
+		ActivityID:   activity.UID,
+		User:         user,
+		Instance:     instance,
+		Database:     maybeDatabase,
+		DatabaseName: request.ConnectionDatabase,
+		Statement:    request.Statement,
+		Results:      results,
+		Time:         time.Now(),
+	})
 
 	allowExport := true
 	// AllowExport is a validate only check.
@@ -201,10 +246,10 @@ func (s *SQLService) QueryV2(ctx context.Context, request *v1pb.QueryRequest) (*
 }
 
 // doExportV2 is the copy of doExport, which use query span to improve performance.
-func (s *SQLService) doExportV2(ctx context.Context, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, spans []*base.QuerySpan) ([]byte, int64, error) {
+func (s *SQLService) doExportV2(ctx context.Context, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, spans []*base.QuerySpan, watermark string) ([]byte, []*v1pb.QueryResult, int64, error) {
 	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, "" /* dataSourceID */)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, 0, err
 	}
 	defer driver.Close(ctx)
 
@@ -213,63 +258,73 @@ func (s *SQLService) doExportV2(ctx context.Context, request *v1pb.ExportRequest
 	if sqlDB != nil {
 		conn, err = sqlDB.Conn(ctx)
 		if err != nil {
-			return nil, 0, err
+			return nil, nil, 0, err
 		}
 		defer conn.Close()
 	}
 
 	start := time.Now().UnixNano()
-	result, err := driver.QueryConn(ctx, conn, request.Statement, &db.QueryContext{
+	queryCtx, span := db.StartSpan(ctx, driver, "QueryConn", request.Statement)
+	result, err := driver.QueryConn(queryCtx, conn, request.Statement, &db.QueryContext{
 		Limit:               int(request.Limit),
 		ReadOnly:            true,
 		CurrentDatabase:     request.ConnectionDatabase,
 		SensitiveSchemaInfo: nil,
 		EnableSensitive:     s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil,
 	})
+	tracing.End(span, err)
 	durationNs := time.Now().UnixNano() - start
 	if err != nil {
-		return nil, durationNs, err
+		return nil, nil, durationNs, err
 	}
 	if len(result) != 1 {
-		return nil, durationNs, errors.Errorf("expecting 1 result, but got %d", len(result))
+		return nil, nil, durationNs, errors.Errorf("expecting 1 result, but got %d", len(result))
 	}
 
 	if s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil {
 		if err := s.maskResults(ctx, spans, result, instance, storepb.MaskingExceptionPolicy_MaskingException_EXPORT); err != nil {
-			return nil, durationNs, err
+			return nil, nil, durationNs, err
 		}
 	}
 
 	var content []byte
 	switch request.Format {
 	case v1pb.ExportFormat_CSV:
-		if content, err = exportCSV(result[0]); err != nil {
-			return nil, durationNs, err
+		if content, err = exportCSV(withWatermark(result[0], watermark)); err != nil {
+			return nil, nil, durationNs, err
 		}
 	case v1pb.ExportFormat_JSON:
 		if content, err = exportJSON(result[0]); err != nil {
-			return nil, durationNs, err
+			return nil, nil, durationNs, err
 		}
 	case v1pb.ExportFormat_SQL:
 		resourceList, err := s.extractResourceList(ctx, instance.Engine, request.ConnectionDatabase, request.Statement, instance)
 		if err != nil {
-			return nil, 0, status.Errorf(codes.InvalidArgument, "failed to extract resource list: %v", err)
+			return nil, nil, 0, status.Errorf(codes.InvalidArgument, "failed to extract resource list: %v", err)
 		}
 		statementPrefix, err := getSQLStatementPrefix(instance.Engine, resourceList, result[0].ColumnNames)
 		if err != nil {
-			return nil, 0, err
+			return nil, nil, 0, err
 		}
 		if content, err = exportSQL(instance.Engine, statementPrefix, result[0]); err != nil {
-			return nil, durationNs, err
+			return nil, nil, durationNs, err
 		}
 	case v1pb.ExportFormat_XLSX:
-		if content, err = exportXLSX(result[0]); err != nil {
-			return nil, durationNs, err
+		if content, err = exportXLSX(withWatermark(result[0], watermark)); err != nil {
+			return nil, nil, durationNs, err
+		}
+	case v1pb.ExportFormat_PARQUET:
+		if content, err = exportParquet(result[0]); err != nil {
+			return nil, nil, durationNs, err
+		}
+	case v1pb.ExportFormat_JSONL:
+		if content, err = exportJSONL(result[0]); err != nil {
+			return nil, nil, durationNs, err
 		}
 	default:
-		return nil, durationNs, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", request.Format.String())
+		return nil, nil, durationNs, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", request.Format.String())
 	}
-	return content, durationNs, nil
+	return content, result, durationNs, nil
 }
 
 // doQueryV2 is the copy of doQuery, which use query span to improve performance.
@@ -298,13 +353,15 @@ func (s *SQLService) doQueryV2(ctx context.Context, request *v1pb.QueryRequest,
 	defer cancelCtx()
 
 	start := time.Now().UnixNano()
-	results, err := driver.QueryConn(ctx, conn, request.Statement, &db.QueryContext{
+	queryCtx, span := db.StartSpan(ctx, driver, "QueryConn", request.Statement)
+	results, err := driver.QueryConn(queryCtx, conn, request.Statement, &db.QueryContext{
 		Limit:               int(request.Limit),
 		ReadOnly:            true,
 		CurrentDatabase:     request.ConnectionDatabase,
 		SensitiveSchemaInfo: nil,
 		EnableSensitive:     s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil,
 	})
+	tracing.End(span, err)
 	select {
 	case <-ctx.Done():
 		// canceled or timed out
@@ -402,19 +459,9 @@ func (s *SQLService) getMaskersForQuerySpan(ctx context.Context, m *maskingLevel
 			semanticTypeID = config.SemanticTypeId
 		}
 
-		maskingPolicy, err := s.store.GetMaskingPolicyByDatabaseUID(ctx, database.UID)
+		maskingPolicyResolver, err := maskingpolicy.Load(ctx, s.store, project, database)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to get masking policy for database: %q", database.DatabaseName)
-		}
-		maskingPolicyMap := make(map[maskingPolicyKey]*storepb.MaskData)
-		if maskingPolicy != nil {
-			for _, maskData := range maskingPolicy.MaskData {
-				maskingPolicyMap[maskingPolicyKey{
-					schema: maskData.Schema,
-					table:  maskData.Table,
-					column: maskData.Column,
-				}] = maskData
-			}
+			return nil, err
 		}
 
 		var maskingExceptionPolicy *storepb.MaskingExceptionPolicy
@@ -443,11 +490,11 @@ func (s *SQLService) getMaskersForQuerySpan(ctx context.Context, m *maskingLevel
 			}
 		}
 
-		maskingAlgorithm, maskingLevel, err := m.evaluateMaskingAlgorithmOfColumn(database, sourceColumn.Schema, sourceColumn.Table, sourceColumn.Column, semanticTypeID, meta.Classification, project.DataClassificationConfigID, maskingPolicyMap, maskingExceptionContainsCurrentPrincipal)
+		maskingAlgorithm, maskingLevel, err := m.evaluateMaskingAlgorithmOfColumn(database, sourceColumn.Schema, sourceColumn.Table, sourceColumn.Column, semanticTypeID, meta.Classification, project.DataClassificationConfigID, maskingPolicyResolver, maskingExceptionContainsCurrentPrincipal)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to evaluate masking level of database %q, schema %q, table %q, column %q", sourceColumn.Database, sourceColumn.Schema, sourceColumn.Table, sourceColumn.Column)
 		}
-		masker := getMaskerByMaskingAlgorithmAndLevel(maskingAlgorithm, maskingLevel)
+		masker := maskingpolicy.NewMasker(maskingAlgorithm, maskingLevel, m.maskingSalt)
 		maskers = append(maskers, masker)
 	}
 	return maskers, nil
@@ -605,43 +652,57 @@ func (s *SQLService) accessCheck(
 
 // maskResult masks the result in-place based on the dynamic masking policy, query-span, instance and action.
 func (s *SQLService) maskResults(ctx context.Context, spans []*base.QuerySpan, results []*v1pb.QueryResult, instance *store.InstanceMessage, action storepb.MaskingExceptionPolicy_MaskingException_Action) error {
+	m, err := s.newMaskingLevelEvaluator(ctx)
+	if err != nil {
+		return err
+	}
+
+	// We expect the len(spans) == len(results), but to avoid NPE, we use the min(len(spans), len(results)) here.
+	loopBoundary := min(len(spans), len(results))
+	for i := 0; i < loopBoundary; i++ {
+		maskers, err := s.getMaskersForQuerySpan(ctx, m, instance, spans[i], action)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get maskers for query span")
+		}
+		mask(maskers, results[i])
+	}
+
+	return nil
+}
+
+// newMaskingLevelEvaluator creates the masking level evaluator with the workspace masking settings and policies.
+func (s *SQLService) newMaskingLevelEvaluator(ctx context.Context) (*maskingLevelEvaluator, error) {
 	classificationSetting, err := s.store.GetDataClassificationSetting(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "failed to find classification setting")
+		return nil, errors.Wrapf(err, "failed to find classification setting")
 	}
 
 	maskingRulePolicy, err := s.store.GetMaskingRulePolicy(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "failed to find masking rule policy")
+		return nil, errors.Wrapf(err, "failed to find masking rule policy")
 	}
 
 	algorithmSetting, err := s.store.GetMaskingAlgorithmSetting(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "failed to find masking algorithm setting")
+		return nil, errors.Wrapf(err, "failed to find masking algorithm setting")
 	}
 
 	semanticTypesSetting, err := s.store.GetSemanticTypesSetting(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "failed to find semantic types setting")
+		return nil, errors.Wrapf(err, "failed to find semantic types setting")
 	}
 
-	m := newEmptyMaskingLevelEvaluator().
+	maskingSalt, err := s.store.GetMaskingSalt(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find masking salt")
+	}
+
+	return newEmptyMaskingLevelEvaluator().
 		withMaskingRulePolicy(maskingRulePolicy).
 		withDataClassificationSetting(classificationSetting).
 		withMaskingAlgorithmSetting(algorithmSetting).
-		withSemanticTypeSetting(semanticTypesSetting)
-
-	// We expect the len(spans) == len(results), but to avoid NPE, we use the min(len(spans), len(results)) here.
-	loopBoundary := min(len(spans), len(results))
-	for i := 0; i < loopBoundary; i++ {
-		maskers, err := s.getMaskersForQuerySpan(ctx, m, instance, spans[i], action)
-		if err != nil {
-			return errors.Wrapf(err, "failed to get maskers for query span")
-		}
-		mask(maskers, results[i])
-	}
-
-	return nil
+		withSemanticTypeSetting(semanticTypesSetting).
+		withMaskingSalt(maskingSalt), nil
 }
 
 func mask(maskers []masker.Masker, result *v1pb.QueryResult) {
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/bytebase/bytebase/backend/component/metadatabackup"
+	dbdriver "github.com/bytebase/bytebase/backend/plugin/db"
+	bbs3 "github.com/bytebase/bytebase/backend/plugin/storage/s3"
+	"github.com/bytebase/bytebase/backend/resources/postgres"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func init() {
+	adminCmd.AddCommand(adminBackupCmd, adminListBackupsCmd, adminRestoreCmd)
+	rootCmd.AddCommand(adminCmd)
+}
+
+var adminCmd = &cobra.Command{
+	Use:   "admin",
+	Short: "Administer the Bytebase metadata database",
+	PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+		// The usage is not helpful for the errors from the metadata database and the storage backend.
+		cmd.SilenceUsage = true
+	},
+}
+
+var adminBackupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Back up the metadata database to the --backup-bucket, or the data directory if the bucket is not provided",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runWithMetadataBackupManager(cmd.Context(), func(ctx context.Context, m *metadatabackup.Manager) error {
+			name, err := m.Backup(ctx)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Backed up the metadata database to %s\n", name)
+			return nil
+		})
+	},
+}
+
+var adminListBackupsCmd = &cobra.Command{
+	Use:   "list-backups",
+	Short: "List the metadata database backups from the oldest to the latest",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runWithMetadataBackupManager(cmd.Context(), func(ctx context.Context, m *metadatabackup.Manager) error {
+			names, err := m.List(ctx)
+			if err != nil {
+				return err
+			}
+			for _, name := range names {
+				fmt.Println(name)
+			}
+			return nil
+		})
+	},
+}
+
+var adminRestoreCmd = &cobra.Command{
+	Use:   "restore <backup>",
+	Short: "Restore the metadata database from the backup. All the Bytebase servers using the metadata database must be stopped",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runWithMetadataBackupManager(cmd.Context(), func(ctx context.Context, m *metadatabackup.Manager) error {
+			if err := m.Restore(ctx, args[0]); err != nil {
+				return err
+			}
+			fmt.Printf("Restored the metadata database from %s\n", args[0])
+			return nil
+		})
+	},
+}
+
+// runWithMetadataBackupManager connects to the metadata database with the same flags as the server, and runs f with the metadata backup manager.
+// The embedded metadata database is started for the duration of f, so the server using it must be stopped.
+func runWithMetadataBackupManager(ctx context.Context, f func(context.Context, *metadatabackup.Manager) error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := checkDataDir(); err != nil {
+		return err
+	}
+	if err := checkCloudBackupFlags(); err != nil {
+		return errors.Wrap(err, "invalid flags for cloud backup")
+	}
+	profile := activeProfile(flags.dataDir)
+
+	pgBinDir, err := postgres.Install(profile.ResourceDir)
+	if err != nil {
+		return errors.Wrap(err, "cannot install postgres")
+	}
+	var connCfg dbdriver.ConnectionConfig
+	if profile.UseEmbedDB() {
+		if err := checkPort(profile.DatastorePort); err != nil {
+			return errors.Wrapf(err, "database port %d is not available, the server using the embedded metadata database must be stopped", profile.DatastorePort)
+		}
+		stopper, err := postgres.StartMetadataInstance(profile.DataDir, profile.ResourceDir, pgBinDir, profile.PgUser, profile.DemoName, profile.DatastorePort, profile.Mode)
+		if err != nil {
+			return errors.Wrap(err, "cannot start the embedded metadata database")
+		}
+		defer stopper()
+		connCfg = store.GetEmbeddedConnectionConfig(profile.DatastorePort, profile.PgUser)
+	} else {
+		connCfg, err = store.GetConnectionConfig(profile.PgURL)
+		if err != nil {
+			return errors.Wrap(err, "invalid --pg")
+		}
+	}
+
+	var s3Client *bbs3.Client
+	if profile.BackupBucket != "" {
+		credentials, err := bbs3.GetCredentialsFromFile(ctx, profile.BackupCredentialFile)
+		if err != nil {
+			return errors.Wrap(err, "failed to get credentials from file")
+		}
+		s3Client, err = bbs3.NewClient(ctx, profile.BackupRegion, profile.BackupBucket, credentials)
+		if err != nil {
+			return errors.Wrap(err, "failed to create AWS S3 client")
+		}
+	}
+
+	return f(ctx, metadatabackup.NewManager(&profile, pgBinDir, connCfg, s3Client))
+}
@@ -21,27 +21,35 @@ func getBaseProfile(dataDir string) config.Profile {
 	}
 
 	return config.Profile{
-		ExternalURL:          flags.externalURL,
-		GrpcPort:             flags.port + 1, // Using flags.port + 1 as our gRPC server port.
-		DatastorePort:        flags.port + 2, // Using flags.port + 2 as our datastore port.
-		SampleDatabasePort:   sampleDatabasePort,
-		Readonly:             flags.readonly,
-		SaaS:                 flags.saas,
-		Debug:                flags.debug,
-		DataDir:              dataDir,
-		ResourceDir:          common.GetResourceDir(dataDir),
-		DemoName:             flags.demoName,
-		Version:              version,
-		GitCommit:            gitcommit,
-		PgURL:                flags.pgURL,
-		BackupStorageBackend: backupStorageBackend,
-		BackupRegion:         flags.backupRegion,
-		BackupBucket:         flags.backupBucket,
-		BackupCredentialFile: flags.backupCredential,
-		LastActiveTs:         time.Now().Unix(),
-		Lsp:                  flags.lsp,
-		PreUpdateBackup:      flags.preUpdateBackup,
-		DevelopmentIAM:       flags.developmentIAM,
-		ExecuteDetail:        flags.executeDetail,
+		ExternalURL:             flags.externalURL,
+		GrpcPort:                flags.port + 1, // Using flags.port + 1 as our gRPC server port.
+		DatastorePort:           flags.port + 2, // Using flags.port + 2 as our datastore port.
+		SampleDatabasePort:      sampleDatabasePort,
+		Readonly:                flags.readonly,
+		SaaS:                    flags.saas,
+		Debug:                   flags.debug,
+		DataDir:                 dataDir,
+		ResourceDir:             common.GetResourceDir(dataDir),
+		DemoName:                flags.demoName,
+		Version:                 version,
+		GitCommit:               gitcommit,
+		PgURL:                   flags.pgURL,
+		PgReplicaURL:            flags.pgReplicaURL,
+		PgReplicaMaxLag:         flags.pgReplicaMaxLag,
+		BackupStorageBackend:    backupStorageBackend,
+		BackupRegion:            flags.backupRegion,
+		BackupBucket:            flags.backupBucket,
+		BackupCredentialFile:    flags.backupCredential,
+		MetadataBackupInterval:  flags.metadataBackupInterval,
+		MetadataBackupRetention: flags.metadataBackupRetention,
+		LastActiveTs:            time.Now().Unix(),
+		Lsp:                     flags.lsp,
+		PreUpdateBackup:         flags.preUpdateBackup,
+		DevelopmentIAM:          flags.developmentIAM,
+		ExecuteDetail:           flags.executeDetail,
+		CacheSizes:              flags.cacheSizes,
+		TraceEndpoint:           flags.traceEndpoint,
+		TraceInsecure:           flags.traceInsecure,
+		TraceSampleRatio:        flags.traceSampleRatio,
 	}
 }
@@ -31,6 +31,8 @@ import (
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/snowflake"
 	// Register mssql advisor.
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/mssql"
+	// Register custom expression advisor.
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/custom"
 
 	// Register postgres parser driver.
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/sql/engine/pg"
@@ -12,4 +12,8 @@ const (
 	LoopbackContextKey
 	// UserContextKey is the key name used to store user message in the context.
 	UserContextKey
+	// APITokenContextKey is the key name used to store the API token authenticating the request in the context.
+	APITokenContextKey
+	// SessionIDContextKey is the key name used to store the ID of the session authenticating the request in the context.
+	SessionIDContextKey
 )
@@ -2,6 +2,8 @@ package common
 
 import (
 	"encoding/base64"
+	"fmt"
+	"time"
 
 	"github.com/google/cel-go/cel"
 	"github.com/pkg/errors"
@@ -28,6 +30,9 @@ var RiskFactors = []cel.EnvOption{
 	cel.Variable("affected_rows", cel.IntType),
 	cel.Variable("expiration_days", cel.IntType),
 	cel.Variable("export_rows", cel.IntType),
+
+	// map factors
+	cel.Variable("database_labels", cel.MapType(cel.StringType, cel.StringType)),
 }
 
 // ApprovalFactors are the variables when finding the approval template.
@@ -73,6 +78,18 @@ var MaskingExceptionPolicyCELAttributes = []cel.EnvOption{
 	cel.ParserExpressionSizeLimit(celLimit),
 }
 
+// DeploymentCELAttributes are the variables when matching the databases of a deployment.
+var DeploymentCELAttributes = []cel.EnvOption{
+	// use environment.resource_id
+	cel.Variable("resource.environment_id", cel.StringType),
+	// use instance.resource_id
+	cel.Variable("resource.instance_id", cel.StringType),
+	cel.Variable("resource.database_name", cel.StringType),
+	cel.Variable("resource.engine", cel.StringType),
+	cel.Variable("resource.labels", cel.MapType(cel.StringType, cel.StringType)),
+	cel.ParserExpressionSizeLimit(celLimit),
+}
+
 var ProjectMemberCELAttributes = []cel.EnvOption{
 	cel.Variable("resource.environment_name", cel.StringType),
 	cel.Variable("resource.database", cel.StringType),
@@ -267,3 +284,60 @@ func findField(callExpr *exprproto.Expr_Call, factors *QueryExportFactors) {
 		findField(callExpr, factors)
 	}
 }
+
+// GetExpirationTime returns the expiration time of the `request.time < timestamp("...")` factor in the query and export expression.
+// It returns nil if the expression doesn't expire.
+func GetExpirationTime(expression string) (*time.Time, error) {
+	if expression == "" {
+		return nil, nil
+	}
+	e, err := cel.NewEnv(QueryExportPolicyCELAttributes...)
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := e.Compile(expression)
+	if issues != nil {
+		return nil, errors.Errorf("found issue %v", issues)
+	}
+	parsedExpr, err := cel.AstToParsedExpr(ast)
+	if err != nil {
+		return nil, err
+	}
+	return findExpirationTime(parsedExpr.Expr.GetCallExpr())
+}
+
+func findExpirationTime(callExpr *exprproto.Expr_Call) (*time.Time, error) {
+	if callExpr == nil {
+		return nil, nil
+	}
+	if len(callExpr.Args) == 2 && callExpr.Function == "_<_" && callExpr.Args[0].GetIdentExpr().GetName() == "request.time" {
+		timestampExpr := callExpr.Args[1].GetCallExpr()
+		if timestampExpr.GetFunction() != "timestamp" || len(timestampExpr.GetArgs()) != 1 {
+			return nil, nil
+		}
+		t, err := time.Parse(time.RFC3339, timestampExpr.Args[0].GetConstExpr().GetStringValue())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse expiration time")
+		}
+		return &t, nil
+	}
+	for _, arg := range callExpr.Args {
+		t, err := findExpirationTime(arg.GetCallExpr())
+		if err != nil {
+			return nil, err
+		}
+		if t != nil {
+			return t, nil
+		}
+	}
+	return nil, nil
+}
+
+// AppendExpiration appends the `request.time < timestamp("...")` factor to the query and export expression.
+func AppendExpiration(expression string, expireTime time.Time) string {
+	expiration := fmt.Sprintf(`request.time < timestamp(%q)`, expireTime.UTC().Format(time.RFC3339))
+	if expression == "" {
+		return expiration
+	}
+	return fmt.Sprintf("(%s) && %s", expression, expiration)
+}
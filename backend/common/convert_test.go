@@ -2,6 +2,7 @@ package common
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -50,3 +51,34 @@ func TestGetQueryExportFactors(t *testing.T) {
 		a.Equal(tt.want, *factors)
 	}
 }
+
+func TestGetExpirationTime(t *testing.T) {
+	a := assert.New(t)
+	expireTime := time.Date(2023, 7, 4, 6, 9, 3, 384000000, time.UTC)
+	tests := []struct {
+		expression string
+		want       *time.Time
+	}{
+		{
+			expression: "request.time < timestamp(\"2023-07-04T06:09:03.384Z\") && request.row_limit == 1000 && (resource.database == \"instances/postgres-sample/databases/employee\")",
+			want:       &expireTime,
+		},
+		{
+			expression: "(resource.database in [\"instances/postgres-sample/databases/employee\"]) && request.time < timestamp(\"2023-07-04T06:09:03.384Z\")",
+			want:       &expireTime,
+		},
+		{
+			expression: "resource.database == \"instances/postgres-sample/databases/employee\"",
+			want:       nil,
+		},
+		{
+			expression: AppendExpiration("resource.database == \"instances/postgres-sample/databases/employee\"", expireTime.Truncate(time.Second)),
+			want:       func() *time.Time { t := expireTime.Truncate(time.Second); return &t }(),
+		},
+	}
+	for _, tt := range tests {
+		got, err := GetExpirationTime(tt.expression)
+		a.NoError(err)
+		a.Equal(tt.want, got)
+	}
+}
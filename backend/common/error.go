@@ -36,7 +36,10 @@ const (
 	MigrationFailed  Code = 206
 
 	// 301 task error.
-	TaskTimingNotAllowed Code = 301
+	TaskTimingNotAllowed     Code = 301
+	TaskAffectedRowsExceeded Code = 302
+	TaskTransientError       Code = 303
+	TaskQueryPlanRegressed   Code = 304
 
 	// 401 task sql type error.
 	TaskTypeNotDML         Code = 401
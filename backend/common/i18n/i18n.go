@@ -0,0 +1,170 @@
+// Package i18n provides the message catalog to localize the advisor and task messages returned by the API.
+package i18n
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+)
+
+//go:embed locales/*.json
+var localesFS embed.FS
+
+var (
+	// supportedLocales are the supported locales, the first one is the fallback.
+	supportedLocales = []language.Tag{
+		language.English,
+		language.SimplifiedChinese,
+		language.Japanese,
+		language.Spanish,
+	}
+	localeFiles = map[language.Tag]string{
+		language.SimplifiedChinese: "locales/zh-CN.json",
+		language.Japanese:          "locales/ja-JP.json",
+		language.Spanish:           "locales/es-ES.json",
+	}
+	matcher = language.NewMatcher(supportedLocales)
+
+	// acceptLanguageKeys are the metadata keys of the Accept-Language header for gRPC and the gRPC gateway.
+	acceptLanguageKeys = []string{"accept-language", "grpcgateway-accept-language"}
+	// verbRegexp matches the formatting verbs in the English messages.
+	verbRegexp = regexp.MustCompile(`%[sdqv]`)
+
+	loadOnce sync.Once
+	catalogs map[language.Tag][]*entry
+)
+
+// entry is a message in the catalog.
+// The message is the English format string, e.g. "Table `%s` does not exist",
+// and the translation refers to the formatted arguments by the explicit index, e.g. "表 `%[1]s` 不存在".
+type entry struct {
+	pattern     *regexp.Regexp
+	translation string
+	// weight is the length of the literal text, the more specific messages are matched first.
+	weight int
+}
+
+// GetLocale returns the locale of the request from the Accept-Language header, English is returned if not specified.
+func GetLocale(ctx context.Context) language.Tag {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return language.English
+	}
+	for _, key := range acceptLanguageKeys {
+		for _, value := range md.Get(key) {
+			if locale, ok := matchLocale(value); ok {
+				return locale
+			}
+		}
+	}
+	return language.English
+}
+
+func matchLocale(acceptLanguage string) (language.Tag, bool) {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return language.English, false
+	}
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return language.English, false
+	}
+	return supportedLocales[index], true
+}
+
+// Translate returns the message in the locale.
+// The message is returned as is if the locale is English or the message is not in the catalog.
+func Translate(locale language.Tag, message string) string {
+	if locale == language.English || message == "" {
+		return message
+	}
+	loadOnce.Do(loadCatalogs)
+	for _, e := range catalogs[locale] {
+		matches := e.pattern.FindStringSubmatch(message)
+		if matches == nil {
+			continue
+		}
+		var args []any
+		for _, match := range matches[1:] {
+			args = append(args, match)
+		}
+		return fmt.Sprintf(e.translation, args...)
+	}
+	return message
+}
+
+func loadCatalogs() {
+	catalogs = make(map[language.Tag][]*entry)
+	for locale, file := range localeFiles {
+		entries, err := loadCatalog(file)
+		if err != nil {
+			// The catalogs are embedded, so it only fails on broken files.
+			slog.Error("failed to load i18n catalog", slog.String("file", file), log.BBError(err))
+			continue
+		}
+		catalogs[locale] = entries
+	}
+}
+
+func loadCatalog(file string) ([]*entry, error) {
+	content, err := localesFS.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	messages := make(map[string]string)
+	if err := json.Unmarshal(content, &messages); err != nil {
+		return nil, err
+	}
+	var entries []*entry
+	for message, translation := range messages {
+		pattern, weight, err := compilePattern(message)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, &entry{
+			pattern:     pattern,
+			translation: translation,
+			weight:      weight,
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].weight != entries[j].weight {
+			return entries[i].weight > entries[j].weight
+		}
+		return entries[i].pattern.String() < entries[j].pattern.String()
+	})
+	return entries, nil
+}
+
+// compilePattern compiles the English format string to the pattern capturing the formatted arguments.
+func compilePattern(message string) (*regexp.Regexp, int, error) {
+	var buf strings.Builder
+	buf.WriteString(`(?s)^`)
+	weight := 0
+	literals := verbRegexp.Split(message, -1)
+	for i, literal := range literals {
+		if i > 0 {
+			buf.WriteString(`(.*?)`)
+		}
+		literal = strings.ReplaceAll(literal, "%%", "%")
+		weight += len(literal)
+		buf.WriteString(regexp.QuoteMeta(literal))
+	}
+	buf.WriteString(`$`)
+	pattern, err := regexp.Compile(buf.String())
+	if err != nil {
+		return nil, 0, err
+	}
+	return pattern, weight, nil
+}
@@ -0,0 +1,117 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestGetLocale(t *testing.T) {
+	a := require.New(t)
+	tests := []struct {
+		md   metadata.MD
+		want language.Tag
+	}{
+		{
+			md:   nil,
+			want: language.English,
+		},
+		{
+			md:   metadata.Pairs("accept-language", "zh-CN,zh;q=0.9,en;q=0.8"),
+			want: language.SimplifiedChinese,
+		},
+		{
+			md:   metadata.Pairs("grpcgateway-accept-language", "ja"),
+			want: language.Japanese,
+		},
+		{
+			md:   metadata.Pairs("accept-language", "es-MX"),
+			want: language.Spanish,
+		},
+		{
+			md:   metadata.Pairs("accept-language", "invalid;;"),
+			want: language.English,
+		},
+	}
+	for _, test := range tests {
+		ctx := context.Background()
+		if test.md != nil {
+			ctx = metadata.NewIncomingContext(ctx, test.md)
+		}
+		a.Equal(test.want, GetLocale(ctx), test.md)
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	a := require.New(t)
+	tests := []struct {
+		locale  language.Tag
+		message string
+		want    string
+	}{
+		{
+			locale:  language.SimplifiedChinese,
+			message: "\"DELETE FROM t\" requires WHERE clause",
+			want:    "\"DELETE FROM t\" 需要 WHERE 子句",
+		},
+		{
+			locale:  language.Japanese,
+			message: "Table `t1` does not exist",
+			want:    "テーブル `t1` は存在しません",
+		},
+		{
+			locale:  language.SimplifiedChinese,
+			message: "Established baseline version 0001 for database \"db\".",
+			want:    "已为数据库 \"db\" 建立基线版本 0001。",
+		},
+		{
+			locale:  language.Spanish,
+			message: "\"INSERT INTO t SELECT * FROM s\" inserts 2000 rows. The count exceeds 1000.",
+			want:    "\"INSERT INTO t SELECT * FROM s\" inserta 2000 filas. La cantidad supera 1000.",
+		},
+		{
+			// Not in the catalog.
+			locale:  language.SimplifiedChinese,
+			message: "unknown message",
+			want:    "unknown message",
+		},
+		{
+			locale:  language.English,
+			message: "Table `t1` does not exist",
+			want:    "Table `t1` does not exist",
+		},
+	}
+	for _, test := range tests {
+		a.Equal(test.want, Translate(test.locale, test.message), test.message)
+	}
+}
+
+func TestCatalogs(t *testing.T) {
+	a := require.New(t)
+	english := make(map[string]bool)
+	for _, file := range localeFiles {
+		entries, err := loadCatalog(file)
+		a.NoError(err, file)
+		for _, e := range entries {
+			// The translation must consume exactly the captured arguments.
+			var args []any
+			for i := 0; i < e.pattern.NumSubexp(); i++ {
+				args = append(args, fmt.Sprintf("arg%d", i))
+			}
+			got := fmt.Sprintf(e.translation, args...)
+			a.False(strings.Contains(got, "%!"), "%s: %s", file, e.translation)
+			english[e.pattern.String()] = true
+		}
+	}
+	// All locales translate the same messages.
+	for _, file := range localeFiles {
+		entries, err := loadCatalog(file)
+		a.NoError(err)
+		a.Len(entries, len(english), file)
+	}
+}
@@ -0,0 +1,175 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// modulePrefix is the prefix of the function names of the backend packages, the rest of the package path is the component.
+const modulePrefix = "github.com/bytebase/bytebase/backend/"
+
+type contextAttrsKey struct{}
+
+var componentLevels = struct {
+	sync.RWMutex
+	// levels is the log severity level by the component, e.g. "runner/taskrun" or "store".
+	levels map[string]slog.Level
+}{levels: map[string]slog.Level{}}
+
+// ContextWithAttrs returns the context with the attributes attached to every log line emitted with the context,
+// e.g. the request ID of an API request or the task run ID of a task run.
+func ContextWithAttrs(ctx context.Context, attrs ...slog.Attr) context.Context {
+	parent, _ := ctx.Value(contextAttrsKey{}).([]slog.Attr)
+	merged := make([]slog.Attr, 0, len(parent)+len(attrs))
+	merged = append(merged, parent...)
+	merged = append(merged, attrs...)
+	return context.WithValue(ctx, contextAttrsKey{}, merged)
+}
+
+// GetComponentLevels returns the log severity levels by the component.
+func GetComponentLevels() map[string]slog.Level {
+	componentLevels.RLock()
+	defer componentLevels.RUnlock()
+	levels := make(map[string]slog.Level, len(componentLevels.levels))
+	for component, level := range componentLevels.levels {
+		levels[component] = level
+	}
+	return levels
+}
+
+// SetComponentLevels replaces the log severity levels by the component.
+// A component covers its sub-components, e.g. "runner" covers "runner/taskrun". The other components use the LogLevel.
+func SetComponentLevels(levels map[string]slog.Level) {
+	componentLevels.Lock()
+	defer componentLevels.Unlock()
+	componentLevels.levels = make(map[string]slog.Level, len(levels))
+	for component, level := range levels {
+		componentLevels.levels[strings.Trim(component, "/")] = level
+	}
+}
+
+// ParseComponentLevels parses the log severity levels by the component, e.g. {"store": "debug", "runner/taskrun": "warn"}.
+func ParseComponentLevels(levels map[string]string) (map[string]slog.Level, error) {
+	result := make(map[string]slog.Level, len(levels))
+	for component, value := range levels {
+		if strings.Trim(component, "/") == "" {
+			return nil, errors.Errorf("empty log component")
+		}
+		var level slog.Level
+		if err := level.UnmarshalText([]byte(value)); err != nil {
+			return nil, errors.Wrapf(err, "invalid log level %q of component %q", value, component)
+		}
+		result[component] = level
+	}
+	return result, nil
+}
+
+// FormatComponentLevels formats the log severity levels by the component, the reverse of ParseComponentLevels.
+func FormatComponentLevels(levels map[string]slog.Level) map[string]string {
+	result := make(map[string]string, len(levels))
+	for component, level := range levels {
+		result[component] = strings.ToLower(level.String())
+	}
+	return result
+}
+
+// getComponentLevel returns the level of the longest component matching the package path.
+func getComponentLevel(component string) slog.Level {
+	componentLevels.RLock()
+	defer componentLevels.RUnlock()
+	for c := component; c != ""; {
+		if level, ok := componentLevels.levels[c]; ok {
+			return level
+		}
+		idx := strings.LastIndexByte(c, '/')
+		if idx == -1 {
+			break
+		}
+		c = c[:idx]
+	}
+	return LogLevel.Level()
+}
+
+// getMinLevel returns the minimal level of the LogLevel and the component levels.
+func getMinLevel() slog.Level {
+	minLevel := LogLevel.Level()
+	componentLevels.RLock()
+	defer componentLevels.RUnlock()
+	for _, level := range componentLevels.levels {
+		if level < minLevel {
+			minLevel = level
+		}
+	}
+	return minLevel
+}
+
+// getComponent returns the component of the package emitting the log, e.g. "runner/taskrun".
+func getComponent(pc uintptr) string {
+	if pc == 0 {
+		return ""
+	}
+	frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+	name, ok := strings.CutPrefix(frame.Function, modulePrefix)
+	if !ok {
+		return ""
+	}
+	// The function name is the package path followed by the function, e.g. "runner/taskrun.(*SchedulerV2).runOnce".
+	slash := strings.LastIndexByte(name, '/')
+	if dot := strings.IndexByte(name[slash+1:], '.'); dot != -1 {
+		return name[:slash+1+dot]
+	}
+	return name
+}
+
+// Handler is the log handler filtering the logs by the level of their components,
+// and attaching the attributes of the context to the logs.
+type Handler struct {
+	handler slog.Handler
+}
+
+// NewHandler returns the handler wrapping the text or JSON handler.
+// The wrapped handler should be created with the MinLevel so that the Handler filters the logs by the component levels.
+func NewHandler(handler slog.Handler) *Handler {
+	return &Handler{handler: handler}
+}
+
+// MinLevel is the leveler of the minimal level of the LogLevel and the component levels.
+var MinLevel slog.Leveler = minLeveler{}
+
+type minLeveler struct{}
+
+func (minLeveler) Level() slog.Level {
+	return getMinLevel()
+}
+
+// Enabled implements slog.Handler.
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+func (h *Handler) Handle(ctx context.Context, record slog.Record) error {
+	if record.Level < getComponentLevel(getComponent(record.PC)) {
+		return nil
+	}
+	if attrs, ok := ctx.Value(contextAttrsKey{}).([]slog.Attr); ok && len(attrs) > 0 {
+		record = record.Clone()
+		record.AddAttrs(attrs...)
+	}
+	return h.handler.Handle(ctx, record)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &Handler{handler: h.handler.WithAttrs(attrs)}
+}
+
+// WithGroup implements slog.Handler.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return &Handler{handler: h.handler.WithGroup(name)}
+}
@@ -0,0 +1,62 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	a := require.New(t)
+	defer SetComponentLevels(nil)
+
+	var buf bytes.Buffer
+	logger := slog.New(NewHandler(slog.NewJSONHandler(&buf, &slog.HandlerOptions{AddSource: true, Level: MinLevel})))
+	ctx := ContextWithAttrs(context.Background(), slog.String("request_id", "r1"))
+	ctx = ContextWithAttrs(ctx, slog.Int("task_run_id", 2))
+
+	// The debug logs are filtered by the default level.
+	logger.DebugContext(ctx, "hidden")
+	a.Empty(buf.String())
+
+	// The logs of this package are emitted by the "common/log" component.
+	SetComponentLevels(map[string]slog.Level{"common": slog.LevelDebug})
+	logger.DebugContext(ctx, "shown")
+	var line map[string]any
+	a.NoError(json.Unmarshal(buf.Bytes(), &line))
+	a.Equal("shown", line["msg"])
+	a.Equal("r1", line["request_id"])
+	a.Equal(float64(2), line["task_run_id"])
+
+	// The longest component wins.
+	buf.Reset()
+	SetComponentLevels(map[string]slog.Level{"common": slog.LevelDebug, "common/log": slog.LevelError})
+	logger.WarnContext(ctx, "hidden")
+	a.Empty(buf.String())
+
+	// The other components are not affected.
+	buf.Reset()
+	SetComponentLevels(map[string]slog.Level{"store": slog.LevelError})
+	logger.InfoContext(context.Background(), "shown")
+	a.True(strings.Contains(buf.String(), `"msg":"shown"`))
+	a.False(strings.Contains(buf.String(), "request_id"))
+}
+
+func TestParseComponentLevels(t *testing.T) {
+	a := require.New(t)
+
+	levels, err := ParseComponentLevels(map[string]string{"store": "debug", "runner/taskrun": "WARN"})
+	a.NoError(err)
+	a.Equal(map[string]slog.Level{"store": slog.LevelDebug, "runner/taskrun": slog.LevelWarn}, levels)
+	a.Equal(map[string]string{"store": "debug", "runner/taskrun": "warn"}, FormatComponentLevels(levels))
+
+	_, err = ParseComponentLevels(map[string]string{"store": "verbose"})
+	a.Error(err)
+	_, err = ParseComponentLevels(map[string]string{"/": "info"})
+	a.Error(err)
+}
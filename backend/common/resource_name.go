@@ -13,6 +13,7 @@ import (
 const (
 	ProjectNamePrefix            = "projects/"
 	EnvironmentNamePrefix        = "environments/"
+	EnvironmentClassNamePrefix   = "environmentClasses/"
 	InstanceNamePrefix           = "instances/"
 	PolicyNamePrefix             = "policies/"
 	DatabaseIDPrefix             = "databases/"
@@ -28,6 +29,7 @@ const (
 	StagePrefix                  = "stages/"
 	TaskPrefix                   = "tasks/"
 	TaskRunPrefix                = "taskRuns/"
+	TaskRunArtifactPrefix        = "artifacts/"
 	PlanPrefix                   = "plans/"
 	PlanCheckRunPrefix           = "planCheckRuns/"
 	RolePrefix                   = "roles/"
@@ -42,16 +44,32 @@ const (
 	IssueNamePrefix              = "issues/"
 	PipelineNamePrefix           = "pipelines/"
 	LogNamePrefix                = "logs/"
+	AuditLogNamePrefix           = "auditLogs/"
 	InboxNamePrefix              = "inbox/"
 	BranchPrefix                 = "branches/"
 	DeploymentConfigPrefix       = "deploymentConfigs/"
 	ChangelistsPrefix            = "changelists/"
+	SandboxPrefix                = "sandboxes/"
+	ExportAuditNamePrefix        = "exportAudits/"
+	QueryCursorNamePrefix        = "queryCursors/"
+	ScheduledQueryNamePrefix     = "scheduledQueries/"
+	ScheduledQueryResultPrefix   = "results/"
+	QueryHistoryNamePrefix       = "queryHistories/"
+	IssueTemplatePrefix          = "issueTemplates/"
+	IssueSchedulePrefix          = "issueSchedules/"
+	WebhookDeliveryPrefix        = "deliveries/"
+	APITokenPrefix               = "apiTokens/"
+	SessionPrefix                = "sessions/"
 
 	BackupSettingSuffix   = "/backupSetting"
 	SchemaSuffix          = "/schema"
 	MetadataSuffix        = "/metadata"
+	CommentsSuffix        = "/comments"
 	GitOpsInfoSuffix      = "/gitOpsInfo"
 	ProtectionRulesSuffix = "/protectionRules"
+	IssueFormsSuffix      = "/issueForms"
+	QuotaSuffix           = "/quota"
+	SlowQueryReportSuffix = "/slowQueryReport"
 )
 
 // GetProjectID returns the project ID from a resource name.
@@ -102,6 +120,33 @@ func GetProjectIDWebhookID(name string) (string, string, error) {
 	return tokens[0], tokens[1], nil
 }
 
+// GetProjectIDWebhookIDDeliveryID returns the project ID, webhook ID and delivery ID from a resource name.
+func GetProjectIDWebhookIDDeliveryID(name string) (string, string, string, error) {
+	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, WebhookIDPrefix, WebhookDeliveryPrefix)
+	if err != nil {
+		return "", "", "", err
+	}
+	return tokens[0], tokens[1], tokens[2], nil
+}
+
+// GetProjectIDIssueTemplateID returns the project ID and issue template ID from a resource name.
+func GetProjectIDIssueTemplateID(name string) (string, string, error) {
+	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, IssueTemplatePrefix)
+	if err != nil {
+		return "", "", err
+	}
+	return tokens[0], tokens[1], nil
+}
+
+// GetProjectIDIssueScheduleID returns the project ID and issue schedule ID from a resource name.
+func GetProjectIDIssueScheduleID(name string) (string, string, error) {
+	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, IssueSchedulePrefix)
+	if err != nil {
+		return "", "", err
+	}
+	return tokens[0], tokens[1], nil
+}
+
 func GetProjectIDDeploymentConfigID(name string) (string, string, error) {
 	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, DeploymentConfigPrefix)
 	if err != nil {
@@ -158,6 +203,15 @@ func GetEnvironmentID(name string) (string, error) {
 	return tokens[0], nil
 }
 
+// GetEnvironmentClassID returns the environment class ID from a resource name.
+func GetEnvironmentClassID(name string) (string, error) {
+	tokens, err := GetNameParentTokens(name, EnvironmentClassNamePrefix)
+	if err != nil {
+		return "", err
+	}
+	return tokens[0], nil
+}
+
 // GetInstanceID returns the instance ID from a resource name.
 func GetInstanceID(name string) (string, error) {
 	// the instance request should be instances/{instance-id}
@@ -168,6 +222,15 @@ func GetInstanceID(name string) (string, error) {
 	return tokens[0], nil
 }
 
+// GetQueryCursorID returns the query cursor ID from a resource name.
+func GetQueryCursorID(name string) (string, error) {
+	tokens, err := GetNameParentTokens(name, QueryCursorNamePrefix)
+	if err != nil {
+		return "", err
+	}
+	return tokens[0], nil
+}
+
 // GetInstanceRoleID returns the instance ID and instance role name from a resource name.
 func GetInstanceRoleID(name string) (string, string, error) {
 	// the instance request should be instances/{instance-id}/roles/{role-name}
@@ -222,6 +285,40 @@ func GetUserID(name string) (int, error) {
 	return GetUIDFromName(name, UserNamePrefix)
 }
 
+// GetUserIDAPITokenID returns the user ID and API token ID from a resource name.
+func GetUserIDAPITokenID(name string) (int, int, error) {
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, APITokenPrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	userID, err := strconv.Atoi(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid user ID %q", tokens[0])
+	}
+	apiTokenID, err := strconv.Atoi(tokens[1])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid API token ID %q", tokens[1])
+	}
+	return userID, apiTokenID, nil
+}
+
+// GetUserIDSessionID returns the user ID and session ID from a resource name.
+func GetUserIDSessionID(name string) (int, int, error) {
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, SessionPrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	userID, err := strconv.Atoi(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid user ID %q", tokens[0])
+	}
+	sessionID, err := strconv.Atoi(tokens[1])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid session ID %q", tokens[1])
+	}
+	return userID, sessionID, nil
+}
+
 // GetUserEmail returns the user email from a resource name.
 func GetUserEmail(name string) (string, error) {
 	tokens, err := GetNameParentTokens(name, UserNamePrefix)
@@ -463,6 +560,23 @@ func GetProjectIDRolloutIDStageIDTaskIDTaskRunID(name string) (string, int, int,
 	return tokens[0], rolloutID, stageID, taskID, taskRunID, nil
 }
 
+// GetProjectIDTaskRunIDArtifactID returns the project ID, task run ID and artifact ID from a task run artifact resource name.
+func GetProjectIDTaskRunIDArtifactID(name string) (string, int, int64, error) {
+	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, RolloutPrefix, StagePrefix, TaskPrefix, TaskRunPrefix, TaskRunArtifactPrefix)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	taskRunID, err := strconv.Atoi(tokens[4])
+	if err != nil {
+		return "", 0, 0, errors.Errorf("invalid task run ID %q", tokens[4])
+	}
+	artifactID, err := strconv.ParseInt(tokens[5], 10, 64)
+	if err != nil {
+		return "", 0, 0, errors.Errorf("invalid artifact ID %q", tokens[5])
+	}
+	return tokens[0], taskRunID, artifactID, nil
+}
+
 // GetRoleID returns the role ID from a resource name.
 func GetRoleID(name string) (string, error) {
 	tokens, err := GetNameParentTokens(name, RolePrefix)
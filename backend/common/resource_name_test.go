@@ -66,3 +66,14 @@ func TestGetRiskID(t *testing.T) {
 		a.Equal(test.want, got)
 	}
 }
+
+func TestGetProjectIDTaskRunIDArtifactID(t *testing.T) {
+	projectID, taskRunID, artifactID, err := GetProjectIDTaskRunIDArtifactID("projects/p1/rollouts/1/stages/2/tasks/3/taskRuns/4/artifacts/105")
+	require.NoError(t, err)
+	require.Equal(t, "p1", projectID)
+	require.Equal(t, 4, taskRunID)
+	require.Equal(t, int64(105), artifactID)
+
+	_, _, _, err = GetProjectIDTaskRunIDArtifactID("projects/p1/rollouts/1/stages/2/tasks/3/taskRuns/4")
+	require.Error(t, err)
+}
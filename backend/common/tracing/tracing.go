@@ -0,0 +1,117 @@
+// Package tracing is the OpenTelemetry tracing of the API requests, the task runs, the store queries and the database driver operations.
+package tracing
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName  = "github.com/bytebase/bytebase"
+	serviceName = "bytebase"
+)
+
+var (
+	// stringLiteralRegexp matches the single or double quoted string literals, including the escaped quotes.
+	stringLiteralRegexp = regexp.MustCompile(`'(?:[^'\\]|\\.|'')*'|"(?:[^"\\]|\\.)*"`)
+	// numberLiteralRegexp matches the number literals which are not a part of the identifiers.
+	numberLiteralRegexp = regexp.MustCompile(`\b\d+(?:\.\d+)?\b`)
+	whitespaceRegexp    = regexp.MustCompile(`\s+`)
+)
+
+// Config is the configuration of the tracing.
+type Config struct {
+	// Endpoint is the OTLP gRPC endpoint of the trace collector, e.g. "localhost:4317". The tracing is disabled if it's empty.
+	Endpoint string
+	// Insecure disables the TLS to the endpoint.
+	Insecure bool
+	// SampleRatio is the ratio of the root spans sampled. The child spans follow the sampling of their parents.
+	SampleRatio float64
+	// Version is the version of the service.
+	Version string
+}
+
+// Setup sets the global tracer provider exporting the spans to the OTLP endpoint, and the W3C trace context propagator.
+// The returned shutdown function flushes the spans not exported yet.
+func Setup(ctx context.Context, config Config) (func(context.Context) error, error) {
+	if config.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(config.Endpoint)}
+	if config.Insecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, options...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create the trace exporter to %s", config.Endpoint)
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(config.Version),
+	))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create the trace resource")
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return provider.Shutdown, nil
+}
+
+// Start starts a root span, or a child span if the context has a span already.
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// StartChild starts a child span of the span in the context.
+// No span is started if the context has no span being recorded, so that the background jobs don't produce the orphan spans.
+func StartChild(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	parent := trace.SpanFromContext(ctx)
+	if !parent.IsRecording() {
+		return ctx, parent
+	}
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// End ends the span with the error status if err is not nil.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// StatementDigest returns the attribute of the statement digest.
+// The statements are never recorded in the spans since they may contain the sensitive data.
+func StatementDigest(statement string) attribute.KeyValue {
+	return attribute.String("db.statement.digest", GetStatementDigest(statement))
+}
+
+// GetStatementDigest returns the digest of the statement, which is the same for the statements only differing in the literals and the whitespaces.
+func GetStatementDigest(statement string) string {
+	normalized := stringLiteralRegexp.ReplaceAllString(statement, "?")
+	normalized = numberLiteralRegexp.ReplaceAllString(normalized, "?")
+	normalized = whitespaceRegexp.ReplaceAllString(strings.TrimSpace(normalized), " ")
+	sum := sha256.Sum256([]byte(strings.ToLower(normalized)))
+	return hex.EncodeToString(sum[:8])
+}
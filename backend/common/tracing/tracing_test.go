@@ -0,0 +1,30 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestGetStatementDigest(t *testing.T) {
+	a := require.New(t)
+
+	digest := GetStatementDigest("SELECT * FROM t WHERE id = 1 AND name = 'alice'")
+	a.Len(digest, 16)
+	a.Equal(digest, GetStatementDigest("select *\n  from t where id = 42 and name = 'it''s bob'"))
+	a.Equal(digest, GetStatementDigest(`SELECT * FROM t WHERE id = 3.14 AND name = "carol"`))
+	a.NotEqual(digest, GetStatementDigest("SELECT * FROM t2 WHERE id = 1 AND name = 'alice'"))
+	// The numbers in the identifiers are kept.
+	a.NotEqual(GetStatementDigest("SELECT c1 FROM t"), GetStatementDigest("SELECT c2 FROM t"))
+}
+
+func TestStartChild(t *testing.T) {
+	a := require.New(t)
+
+	// No orphan span is started without the parent span.
+	ctx, span := StartChild(context.Background(), "child")
+	a.False(span.IsRecording())
+	a.False(trace.SpanContextFromContext(ctx).IsValid())
+}
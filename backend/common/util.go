@@ -2,7 +2,9 @@ package common
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"os"
@@ -123,6 +125,12 @@ func GetBinlogRelativeDir(binlogDir string) string {
 	return filepath.Join("backup", "instance", instanceID)
 }
 
+// SHA256Hex returns the hex-encoded SHA-256 checksum of the string.
+func SHA256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
 // TruncateString truncates the string to have a maximum length of `limit` characters.
 func TruncateString(str string, limit int) (string, bool) {
 	chars := 0
@@ -0,0 +1,203 @@
+package activity
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/eventbus"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// eventPublisher caches the publisher of the event bus setting, which is recreated once the setting changes.
+type eventPublisher struct {
+	mu        sync.Mutex
+	setting   *storepb.EventBusSetting
+	publisher eventbus.Publisher
+}
+
+func (p *eventPublisher) get(setting *storepb.EventBusSetting) (eventbus.Publisher, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.publisher != nil && proto.Equal(p.setting, setting) {
+		return p.publisher, nil
+	}
+	p.closeLocked()
+	publisher, err := eventbus.NewPublisher(setting)
+	if err != nil {
+		return nil, err
+	}
+	p.setting, p.publisher = setting, publisher
+	return publisher, nil
+}
+
+func (p *eventPublisher) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closeLocked()
+}
+
+func (p *eventPublisher) closeLocked() {
+	if p.publisher == nil {
+		return
+	}
+	if err := p.publisher.Close(); err != nil {
+		slog.Warn("Failed to close the event bus publisher", log.BBError(err))
+	}
+	p.setting, p.publisher = nil, nil
+}
+
+// isEventPublished returns whether the events of the activity type are published by the event bus setting.
+func isEventPublished(setting *storepb.EventBusSetting, activityType string) bool {
+	if setting.Type == storepb.EventBusSetting_TYPE_UNSPECIFIED {
+		return false
+	}
+	return len(setting.EventTypes) == 0 || slices.Contains(setting.EventTypes, activityType)
+}
+
+// shouldPublishEvent returns whether the events of the activity type should be enqueued for the event bus.
+func (m *Manager) shouldPublishEvent(ctx context.Context, activityType api.ActivityType) bool {
+	setting, err := m.store.GetEventBusSetting(ctx)
+	if err != nil {
+		// The event is enqueued anyway, and the dispatcher checks the setting again before publishing.
+		slog.Warn("Failed to get event bus setting", log.BBError(err))
+		return true
+	}
+	return isEventPublished(setting, string(activityType))
+}
+
+// publishEvents publishes the events enabled by the event bus setting.
+func (m *Manager) publishEvents(ctx context.Context, events ...*v1pb.Event) error {
+	setting, err := m.store.GetEventBusSetting(ctx)
+	if err != nil {
+		return err
+	}
+	var messages []*eventbus.Message
+	for _, event := range events {
+		if !isEventPublished(setting, event.Type) {
+			continue
+		}
+		value, err := proto.Marshal(event)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal event %s", event.Id)
+		}
+		messages = append(messages, &eventbus.Message{
+			Key:   event.Project,
+			Type:  event.Type,
+			Value: value,
+		})
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	publisher, err := m.eventPublisher.get(setting)
+	if err != nil {
+		return err
+	}
+	return publisher.Publish(ctx, messages...)
+}
+
+// convertToEvent converts the webhook context of the outbox event to the event published to the event bus.
+func (m *Manager) convertToEvent(ctx context.Context, outboxEvent *store.OutboxEventMessage, webhookCtx *webhook.Context) (*v1pb.Event, error) {
+	event := &v1pb.Event{
+		Id:          outboxEvent.DedupKey,
+		Type:        webhookCtx.ActivityType,
+		CreateTime:  timestamppb.New(time.Unix(outboxEvent.CreatedTs, 0)),
+		Level:       convertToEventLevel(string(webhookCtx.Level)),
+		Title:       webhookCtx.Title,
+		Description: webhookCtx.Description,
+		Link:        webhookCtx.Link,
+	}
+	if webhookCtx.CreatorEmail != "" {
+		event.Creator = common.FormatUserEmail(webhookCtx.CreatorEmail)
+	}
+	if projectUID := int(outboxEvent.Payload.ProjectId); projectUID != 0 {
+		project, err := m.store.GetProjectV2(ctx, &store.FindProjectMessage{UID: &projectUID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get project %d", projectUID)
+		}
+		if project != nil {
+			event.Project = common.FormatProject(project.ResourceID)
+		}
+	}
+	if issue := webhookCtx.Issue; issue != nil && event.Project != "" {
+		event.Issue = &v1pb.Event_Issue{
+			Name:   fmt.Sprintf("%s/%s%d", event.Project, common.IssueNamePrefix, issue.ID),
+			Title:  issue.Name,
+			Status: issue.Status,
+			Type:   issue.Type,
+		}
+	}
+	if taskResult := webhookCtx.TaskResult; taskResult != nil {
+		event.TaskRun = &v1pb.Event_TaskRun{
+			Task:   taskResult.Name,
+			Status: taskResult.Status,
+			Detail: taskResult.Detail,
+		}
+	}
+	return event, nil
+}
+
+// convertToAuditEvents converts the audit activities of the outbox event to the events published to the event bus.
+func (m *Manager) convertToAuditEvents(ctx context.Context, activities []*storepb.OutboxEventPayload_AuditActivity) ([]*v1pb.Event, error) {
+	var events []*v1pb.Event
+	for _, activity := range activities {
+		event := &v1pb.Event{
+			Id:          fmt.Sprintf("activities/%d", activity.ActivityId),
+			Type:        activity.Type,
+			CreateTime:  timestamppb.New(time.Unix(activity.CreatedTs, 0)),
+			Level:       convertToEventLevel(activity.Level),
+			Description: activity.Comment,
+			Payload:     activity.Payload,
+		}
+		creator, err := m.store.GetUserByID(ctx, int(activity.CreatorId))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %d", activity.CreatorId)
+		}
+		if creator != nil {
+			event.Creator = common.FormatUserEmail(creator.Email)
+		}
+		// The container of the project activities is the project.
+		if strings.HasPrefix(activity.Type, "bb.project.") {
+			projectUID := int(activity.ContainerId)
+			project, err := m.store.GetProjectV2(ctx, &store.FindProjectMessage{UID: &projectUID})
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get project %d", projectUID)
+			}
+			if project != nil {
+				event.Project = common.FormatProject(project.ResourceID)
+			}
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func convertToEventLevel(level string) v1pb.Event_Level {
+	switch level {
+	case string(webhook.WebhookInfo):
+		return v1pb.Event_INFO
+	case string(webhook.WebhookSuccess):
+		return v1pb.Event_SUCCESS
+	case string(webhook.WebhookWarn):
+		return v1pb.Event_WARNING
+	case string(webhook.WebhookError):
+		return v1pb.Event_ERROR
+	default:
+		return v1pb.Event_LEVEL_UNSPECIFIED
+	}
+}
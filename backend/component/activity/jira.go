@@ -0,0 +1,82 @@
+package activity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gosimple/slug"
+	"github.com/pkg/errors"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	jiraplugin "github.com/bytebase/bytebase/backend/plugin/app/jira"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// syncJiraIssue creates the linked Jira ticket of the created issue, and transitions the ticket to the status mirrored with the issue status.
+// It does nothing if the Jira integration is not configured.
+func (m *Manager) syncJiraIssue(ctx context.Context, issue *store.IssueMessage, activityType api.ActivityType) error {
+	if activityType != api.ActivityIssueCreate && activityType != api.ActivityIssueStatusUpdate {
+		return nil
+	}
+	setting, err := m.store.GetJiraSetting(ctx)
+	if err != nil {
+		return err
+	}
+	if setting.Url == "" {
+		return nil
+	}
+	client := jiraplugin.NewClient(setting.Url, setting.Email, setting.ApiToken)
+
+	key := issue.Payload.GetJiraIssueKey()
+	if key == "" {
+		if activityType != api.ActivityIssueCreate {
+			return nil
+		}
+		generalSetting, err := m.store.GetWorkspaceGeneralSetting(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get workspace general setting")
+		}
+		description := issue.Description
+		if generalSetting.ExternalUrl != "" {
+			description = fmt.Sprintf("%s\n\nBytebase issue: %s/issue/%s-%d", description, generalSetting.ExternalUrl, slug.Make(issue.Title), issue.UID)
+		}
+		key, err = client.CreateIssue(&jiraplugin.CreateIssuePayload{
+			ProjectKey:  setting.ProjectKey,
+			IssueType:   setting.IssueType,
+			Summary:     issue.Title,
+			Description: description,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := m.store.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
+			PayloadUpsert: &storepb.IssuePayload{
+				JiraIssueKey: key,
+			},
+		}, api.SystemBotID); err != nil {
+			return errors.Wrapf(err, "failed to link issue %d with Jira issue %s", issue.UID, key)
+		}
+	}
+
+	// The issue is delivered with its latest status, so the ticket always ends in the latest status.
+	target := GetJiraStatus(setting, issue.Status)
+	if target == "" {
+		return nil
+	}
+	return client.TransitionIssue(key, target)
+}
+
+// GetJiraStatus returns the Jira status mirrored with the issue status, empty if not configured.
+func GetJiraStatus(setting *storepb.JiraSetting, status api.IssueStatus) string {
+	switch status {
+	case api.IssueOpen:
+		return setting.OpenStatus
+	case api.IssueDone:
+		return setting.DoneStatus
+	case api.IssueCanceled:
+		return setting.CanceledStatus
+	default:
+		return ""
+	}
+}
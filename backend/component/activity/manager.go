@@ -9,15 +9,14 @@ import (
 	"sort"
 	"strconv"
 	"strings"
-	"time"
 
+	"github.com/google/uuid"
 	"github.com/gosimple/slug"
 	"github.com/nyaruka/phonenumbers"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 
-	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/webhook"
@@ -30,6 +29,10 @@ import (
 // Manager is the activity manager.
 type Manager struct {
 	store *store.Store
+	// notifyC wakes up the outbox dispatcher on the new events.
+	notifyC chan struct{}
+	// eventPublisher publishes the events to the event bus.
+	eventPublisher eventPublisher
 }
 
 // Metadata is the activity metadata.
@@ -40,17 +43,33 @@ type Metadata struct {
 // NewManager creates an activity manager.
 func NewManager(store *store.Store) *Manager {
 	return &Manager{
-		store: store,
+		store:   store,
+		notifyC: make(chan struct{}, 1),
 	}
 }
 
-// BatchCreateActivitiesForCreateIssue creates activities for running tasks.
+// BatchCreateActivitiesForRunTasks creates activities for running tasks.
 func (m *Manager) BatchCreateActivitiesForRunTasks(ctx context.Context, tasks []*store.TaskMessage, issue *store.IssueMessage, comment string, updaterUID int) error {
+	return m.batchCreateTaskRunStatusUpdateActivities(ctx, tasks, issue, api.TaskRunPending, fmt.Sprintf("Issue task runs start - %s", issue.Title), comment, updaterUID)
+}
+
+// BatchCreateActivitiesForSkipTasks creates activities for skipping tasks.
+func (m *Manager) BatchCreateActivitiesForSkipTasks(ctx context.Context, tasks []*store.TaskMessage, issue *store.IssueMessage, comment string, updaterID int) error {
+	return m.batchCreateTaskRunStatusUpdateActivities(ctx, tasks, issue, api.TaskRunSkipped, fmt.Sprintf("Issue tasks skipped - %s", issue.Title), comment, updaterID)
+}
+
+// BatchCreateActivitiesForCancelTaskRuns creates activities for cancelling task runs.
+func (m *Manager) BatchCreateActivitiesForCancelTaskRuns(ctx context.Context, tasks []*store.TaskMessage, issue *store.IssueMessage, comment string, updaterUID int) error {
+	return m.batchCreateTaskRunStatusUpdateActivities(ctx, tasks, issue, api.TaskRunCanceled, fmt.Sprintf("Issue task runs start - %s", issue.Title), comment, updaterUID)
+}
+
+// batchCreateTaskRunStatusUpdateActivities creates the task run status update activities and enqueues one webhook post for all of them.
+func (m *Manager) batchCreateTaskRunStatusUpdateActivities(ctx context.Context, tasks []*store.TaskMessage, issue *store.IssueMessage, newStatus api.TaskRunStatus, title string, comment string, updaterUID int) error {
 	var creates []*store.ActivityMessage
 	for _, task := range tasks {
 		payload, err := json.Marshal(api.ActivityPipelineTaskRunStatusUpdatePayload{
 			TaskID:    task.ID,
-			NewStatus: api.TaskRunPending,
+			NewStatus: newStatus,
 			IssueName: issue.Title,
 			TaskName:  task.Name,
 		})
@@ -68,15 +87,9 @@ func (m *Manager) BatchCreateActivitiesForRunTasks(ctx context.Context, tasks []
 		}
 		creates = append(creates, activityCreate)
 	}
-
-	activityList, err := m.store.BatchCreateActivityV2(ctx, creates)
-	if err != nil {
-		return err
-	}
-	if len(activityList) == 0 {
+	if len(creates) == 0 {
 		return errors.Errorf("failed to create any activity")
 	}
-	anyActivity := activityList[0]
 
 	activityType := api.ActivityPipelineTaskRunStatusUpdate
 	webhookList, err := m.store.FindProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
@@ -86,8 +99,10 @@ func (m *Manager) BatchCreateActivitiesForRunTasks(ctx context.Context, tasks []
 	if err != nil {
 		return errors.Wrapf(err, "failed to find project webhook after changing the issue status: %v", issue.Title)
 	}
-
-	if len(webhookList) == 0 {
+	if len(webhookList) == 0 && !m.shouldPublishEvent(ctx, activityType) {
+		if _, err := m.store.BatchCreateActivityV2(ctx, creates); err != nil {
+			return err
+		}
 		return nil
 	}
 
@@ -96,16 +111,16 @@ func (m *Manager) BatchCreateActivitiesForRunTasks(ctx context.Context, tasks []
 		return errors.Wrapf(err, "failed to get workspace setting")
 	}
 
-	user, err := m.store.GetUserByID(ctx, anyActivity.CreatorUID)
+	user, err := m.store.GetUserByID(ctx, updaterUID)
 	if err != nil {
-		return errors.Wrapf(err, "failed to get principal %d", anyActivity.CreatorUID)
+		return errors.Wrapf(err, "failed to get principal %d", updaterUID)
 	}
 
 	// Send one webhook post for all activities.
 	webhookCtx := webhook.Context{
 		Level:        webhook.WebhookInfo,
 		ActivityType: string(activityType),
-		Title:        fmt.Sprintf("Issue task runs start - %s", issue.Title),
+		Title:        title,
 		Issue: &webhook.Issue{
 			ID:          issue.UID,
 			Name:        issue.Title,
@@ -117,265 +132,184 @@ func (m *Manager) BatchCreateActivitiesForRunTasks(ctx context.Context, tasks []
 			ID:   issue.Project.UID,
 			Name: issue.Project.Title,
 		},
-		Description:  anyActivity.Comment,
+		Description:  comment,
 		Link:         fmt.Sprintf("%s/issue/%s-%d", setting.ExternalUrl, slug.Make(issue.Title), issue.UID),
-		CreatorID:    anyActivity.CreatorUID,
+		CreatorID:    updaterUID,
 		CreatorName:  user.Name,
 		CreatorEmail: user.Email,
 	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go postWebhookList(ctx, &webhookCtx, webhookList)
+	webhookCtxJSON, err := json.Marshal(webhookCtx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal webhook context")
+	}
+	if _, err := m.store.BatchCreateActivityWithOutboxEvent(ctx, creates, func(activities ...*store.ActivityMessage) (*store.OutboxEventMessage, error) {
+		return &store.OutboxEventMessage{
+			DedupKey: fmt.Sprintf("activities/%d", activities[0].UID),
+			Payload: &storepb.OutboxEventPayload{
+				ProjectId:    int32(issue.Project.UID),
+				ActivityType: string(activityType),
+				Event:        &storepb.OutboxEventPayload_WebhookContext{WebhookContext: string(webhookCtxJSON)},
+			},
+		}, nil
+	}); err != nil {
+		return err
+	}
+	m.notify()
 
 	return nil
 }
 
-// BatchCreateActivitiesForSkipTasks creates activities for skipping tasks.
-func (m *Manager) BatchCreateActivitiesForSkipTasks(ctx context.Context, tasks []*store.TaskMessage, issue *store.IssueMessage, comment string, updaterID int) error {
-	var creates []*store.ActivityMessage
-	for _, task := range tasks {
-		payload, err := json.Marshal(api.ActivityPipelineTaskRunStatusUpdatePayload{
-			TaskID:    task.ID,
-			NewStatus: api.TaskRunSkipped,
-			IssueName: issue.Title,
-			TaskName:  task.Name,
-		})
+// CreateActivity creates an activity.
+// The inbox and the webhooks of the issue activities are delivered by the outbox dispatcher, the outbox event is created in the same transaction as the activity.
+func (m *Manager) CreateActivity(ctx context.Context, create *store.ActivityMessage, meta *Metadata) (*store.ActivityMessage, error) {
+	if meta.Issue == nil {
+		activities, err := m.BatchCreateAuditActivities(ctx, create)
 		if err != nil {
-			return errors.Wrapf(err, "failed to marshal activity after changing the task status: %v", task.Name)
-		}
-
-		activityCreate := &store.ActivityMessage{
-			CreatorUID:   updaterID,
-			ContainerUID: task.PipelineID,
-			Type:         api.ActivityPipelineTaskRunStatusUpdate,
-			Level:        api.ActivityInfo,
-			Comment:      comment,
-			Payload:      string(payload),
+			return nil, err
 		}
-		creates = append(creates, activityCreate)
+		return activities[0], nil
 	}
 
-	activityList, err := m.store.BatchCreateActivityV2(ctx, creates)
+	activity, err := m.store.CreateActivityWithOutboxEvent(ctx, create, buildIssueActivityEvent(meta.Issue))
 	if err != nil {
-		return err
-	}
-	if len(activityList) == 0 {
-		return errors.Errorf("failed to create any activity")
+		return nil, err
 	}
-	anyActivity := activityList[0]
+	m.notify()
 
-	activityType := api.ActivityPipelineTaskRunStatusUpdate
-	webhookList, err := m.store.FindProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
-		ProjectID:    &issue.Project.UID,
-		ActivityType: &activityType,
-	})
-	if err != nil {
-		return errors.Wrapf(err, "failed to find project webhook after changing the issue status: %v", issue.Title)
-	}
-	if len(webhookList) == 0 {
-		return nil
-	}
+	return activity, nil
+}
 
-	setting, err := m.store.GetWorkspaceGeneralSetting(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "failed to get workspace setting")
+// NewPendingActivity returns the activity with the outbox event of its side effects, to create in the same transaction
+// as the change causing it, e.g. store.UpdateIssueMessage.Activities. Call Notify after the change is committed.
+func (m *Manager) NewPendingActivity(ctx context.Context, create *store.ActivityMessage, meta *Metadata) *store.PendingActivity {
+	pending := &store.PendingActivity{Create: create}
+	if meta.Issue != nil {
+		pending.BuildEvent = buildIssueActivityEvent(meta.Issue)
+	} else if m.shouldPublishEvent(ctx, create.Type) {
+		pending.BuildEvent = func(activity *store.ActivityMessage) (*store.OutboxEventMessage, error) {
+			return buildAuditActivitiesEvent(activity), nil
+		}
 	}
+	return pending
+}
 
-	user, err := m.store.GetUserByID(ctx, anyActivity.CreatorUID)
-	if err != nil {
-		return errors.Wrapf(err, "failed to get principal %d", anyActivity.CreatorUID)
-	}
+// Notify wakes up the outbox dispatcher to deliver the outbox events of the pending activities.
+func (m *Manager) Notify() {
+	m.notify()
+}
 
-	// Send one webhook post for all activities.
-	webhookCtx := webhook.Context{
-		Level:        webhook.WebhookInfo,
-		ActivityType: string(activityType),
-		Title:        fmt.Sprintf("Issue tasks skipped - %s", issue.Title),
-		Issue: &webhook.Issue{
-			ID:          issue.UID,
-			Name:        issue.Title,
-			Status:      string(issue.Status),
-			Type:        string(issue.Type),
-			Description: issue.Description,
-		},
-		Project: &webhook.Project{
-			ID:   issue.Project.UID,
-			Name: issue.Project.Title,
-		},
-		Description:  anyActivity.Comment,
-		Link:         fmt.Sprintf("%s/issue/%s-%d", setting.ExternalUrl, slug.Make(issue.Title), issue.UID),
-		CreatorID:    anyActivity.CreatorUID,
-		CreatorName:  user.Name,
-		CreatorEmail: user.Email,
+// buildIssueActivityEvent returns the function building the outbox event delivering the inbox and the webhooks of the issue activity.
+func buildIssueActivityEvent(issue *store.IssueMessage) func(*store.ActivityMessage) (*store.OutboxEventMessage, error) {
+	return func(activity *store.ActivityMessage) (*store.OutboxEventMessage, error) {
+		// The notification activities are not stored and have no ID.
+		dedupKey := fmt.Sprintf("activities/%d", activity.UID)
+		if activity.UID == 0 {
+			dedupKey = fmt.Sprintf("notifications/%s", uuid.NewString())
+		}
+		return &store.OutboxEventMessage{
+			DedupKey: dedupKey,
+			Payload: &storepb.OutboxEventPayload{
+				ProjectId:    int32(issue.Project.UID),
+				ActivityType: string(activity.Type),
+				Event: &storepb.OutboxEventPayload_IssueActivity_{
+					IssueActivity: &storepb.OutboxEventPayload_IssueActivity{
+						IssueId:    int32(issue.UID),
+						ActivityId: int32(activity.UID),
+						CreatorId:  int32(activity.CreatorUID),
+						Level:      string(activity.Level),
+						Comment:    activity.Comment,
+						Payload:    activity.Payload,
+					},
+				},
+			},
+		}, nil
 	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go postWebhookList(ctx, &webhookCtx, webhookList)
-
-	return nil
 }
 
-// BatchCreateActivitiesForCancelTaskRuns creates activities for cancelling task runs.
-func (m *Manager) BatchCreateActivitiesForCancelTaskRuns(ctx context.Context, tasks []*store.TaskMessage, issue *store.IssueMessage, comment string, updaterUID int) error {
-	var creates []*store.ActivityMessage
-	for _, task := range tasks {
-		payload, err := json.Marshal(api.ActivityPipelineTaskRunStatusUpdatePayload{
-			TaskID:    task.ID,
-			NewStatus: api.TaskRunCanceled,
-			IssueName: issue.Title,
-			TaskName:  task.Name,
-		})
-		if err != nil {
-			return errors.Wrapf(err, "failed to marshal activity after changing the task status: %v", task.Name)
+// BatchCreateAuditActivities creates the activities not bound to an issue.
+// The activities published to the event bus are delivered by the outbox dispatcher.
+func (m *Manager) BatchCreateAuditActivities(ctx context.Context, creates ...*store.ActivityMessage) ([]*store.ActivityMessage, error) {
+	var published bool
+	for _, create := range creates {
+		if m.shouldPublishEvent(ctx, create.Type) {
+			published = true
+			break
 		}
-
-		activityCreate := &store.ActivityMessage{
-			CreatorUID:   updaterUID,
-			ContainerUID: task.PipelineID,
-			Type:         api.ActivityPipelineTaskRunStatusUpdate,
-			Level:        api.ActivityInfo,
-			Comment:      comment,
-			Payload:      string(payload),
-		}
-		creates = append(creates, activityCreate)
 	}
-
-	activityList, err := m.store.BatchCreateActivityV2(ctx, creates)
-	if err != nil {
-		return err
-	}
-	if len(activityList) == 0 {
-		return errors.Errorf("failed to create any activity")
+	if !published {
+		return m.store.BatchCreateActivityV2(ctx, creates)
 	}
-	anyActivity := activityList[0]
 
-	activityType := api.ActivityPipelineTaskRunStatusUpdate
-	webhookList, err := m.store.FindProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
-		ProjectID:    &issue.Project.UID,
-		ActivityType: &activityType,
+	activities, err := m.store.BatchCreateActivityWithOutboxEvent(ctx, creates, func(activities ...*store.ActivityMessage) (*store.OutboxEventMessage, error) {
+		return buildAuditActivitiesEvent(activities...), nil
 	})
 	if err != nil {
-		return errors.Wrapf(err, "failed to find project webhook after changing the issue status: %v", issue.Title)
-	}
-
-	if len(webhookList) == 0 {
-		return nil
-	}
-
-	setting, err := m.store.GetWorkspaceGeneralSetting(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "failed to get workspace setting")
+		return nil, err
 	}
+	m.notify()
+	return activities, nil
+}
 
-	user, err := m.store.GetUserByID(ctx, anyActivity.CreatorUID)
-	if err != nil {
-		return errors.Wrapf(err, "failed to get principal %d", anyActivity.CreatorUID)
+// buildAuditActivitiesEvent builds the outbox event publishing the activities not bound to an issue to the event bus.
+func buildAuditActivitiesEvent(activities ...*store.ActivityMessage) *store.OutboxEventMessage {
+	auditActivities := &storepb.OutboxEventPayload_AuditActivities{}
+	for _, activity := range activities {
+		auditActivities.Activities = append(auditActivities.Activities, &storepb.OutboxEventPayload_AuditActivity{
+			ActivityId:  int32(activity.UID),
+			CreatorId:   int32(activity.CreatorUID),
+			ContainerId: int32(activity.ContainerUID),
+			Type:        string(activity.Type),
+			Level:       string(activity.Level),
+			Comment:     activity.Comment,
+			Payload:     activity.Payload,
+			CreatedTs:   activity.CreatedTs,
+		})
 	}
-
-	// Send one webhook post for all activities.
-	webhookCtx := webhook.Context{
-		Level:        webhook.WebhookInfo,
-		ActivityType: string(activityType),
-		Title:        fmt.Sprintf("Issue task runs start - %s", issue.Title),
-		Issue: &webhook.Issue{
-			ID:          issue.UID,
-			Name:        issue.Title,
-			Status:      string(issue.Status),
-			Type:        string(issue.Type),
-			Description: issue.Description,
+	return &store.OutboxEventMessage{
+		DedupKey: fmt.Sprintf("activities/%d", activities[0].UID),
+		Payload: &storepb.OutboxEventPayload{
+			ActivityType: string(activities[0].Type),
+			Event:        &storepb.OutboxEventPayload_AuditActivities_{AuditActivities: auditActivities},
 		},
-		Project: &webhook.Project{
-			ID:   issue.Project.UID,
-			Name: issue.Project.Title,
-		},
-		Description:  anyActivity.Comment,
-		Link:         fmt.Sprintf("%s/issue/%s-%d", setting.ExternalUrl, slug.Make(issue.Title), issue.UID),
-		CreatorID:    anyActivity.CreatorUID,
-		CreatorName:  user.Name,
-		CreatorEmail: user.Email,
 	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go postWebhookList(ctx, &webhookCtx, webhookList)
-
-	return nil
 }
 
-// CreateActivity creates an activity.
-func (m *Manager) CreateActivity(ctx context.Context, create *store.ActivityMessage, meta *Metadata) (*store.ActivityMessage, error) {
-	activity, err := m.store.CreateActivityV2(ctx, create)
-	if err != nil {
-		return nil, err
-	}
-
-	if meta.Issue == nil {
-		return activity, nil
-	}
-	postInbox, err := shouldPostInbox(activity, create.Type)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to post webhook event after changing the issue task status: %s", meta.Issue.Title)
-	}
-	if postInbox {
-		if err := m.postInboxIssueActivity(ctx, meta.Issue, activity.UID); err != nil {
-			return nil, err
-		}
-	}
-
+// PostProjectWebhooks posts the webhook event to the project webhooks subscribing the activity type.
+// It's used by the activities not bound to an issue.
+func (m *Manager) PostProjectWebhooks(ctx context.Context, projectUID int, activityType api.ActivityType, webhookCtx *webhook.Context) error {
 	webhookList, err := m.store.FindProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
-		ProjectID:    &meta.Issue.Project.UID,
-		ActivityType: &create.Type,
+		ProjectID:    &projectUID,
+		ActivityType: &activityType,
 	})
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to find project webhook after changing the issue status: %v", meta.Issue.Title)
-	}
-	if len(webhookList) == 0 {
-		return activity, nil
+		return errors.Wrapf(err, "failed to find project webhook for activity type %s", activityType)
 	}
-
-	updater, err := m.store.GetUserByID(ctx, create.CreatorUID)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to find updater for posting webhook event after changing the issue status: %v", meta.Issue.Title)
-	}
-	if updater == nil {
-		return nil, errors.Errorf("updater user not found for ID %v", create.CreatorUID)
+	if len(webhookList) == 0 && !m.shouldPublishEvent(ctx, activityType) {
+		return nil
 	}
-
-	webhookCtx, err := m.getWebhookContext(ctx, activity, meta, updater)
+	webhookCtxJSON, err := json.Marshal(webhookCtx)
 	if err != nil {
-		slog.Warn("Failed to get webhook context",
-			slog.String("issue_name", meta.Issue.Title),
-			log.BBError(err))
-		return activity, nil
-	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go postWebhookList(ctx, webhookCtx, webhookList)
-
-	return activity, nil
-}
-
-func postWebhookList(ctx context.Context, webhookCtx *webhook.Context, webhookList []*store.ProjectWebhookMessage) {
-	for _, hook := range webhookList {
-		webhookCtx := *webhookCtx
-		webhookCtx.URL = hook.URL
-		webhookCtx.CreatedTs = time.Now().Unix()
-		go func(webhookCtx *webhook.Context, hook *store.ProjectWebhookMessage) {
-			if err := common.Retry(ctx, func() error {
-				return webhook.Post(hook.Type, *webhookCtx)
-			}); err != nil {
-				// The external webhook endpoint might be invalid which is out of our code control, so we just emit a warning
-				slog.Warn("Failed to post webhook event on activity",
-					slog.String("webhook type", hook.Type),
-					slog.String("webhook name", hook.Title),
-					slog.String("activity type", webhookCtx.ActivityType),
-					slog.String("title", webhookCtx.Title),
-					log.BBError(err))
-				return
-			}
-		}(&webhookCtx, hook)
+		return errors.Wrapf(err, "failed to marshal webhook context")
+	}
+	if err := m.store.CreateOutboxEvent(ctx, &store.OutboxEventMessage{
+		DedupKey: fmt.Sprintf("notifications/%s", uuid.NewString()),
+		Payload: &storepb.OutboxEventPayload{
+			ProjectId:    int32(projectUID),
+			ActivityType: string(activityType),
+			Event:        &storepb.OutboxEventPayload_WebhookContext{WebhookContext: string(webhookCtxJSON)},
+		},
+	}); err != nil {
+		return err
 	}
+	m.notify()
+	return nil
 }
 
 func (m *Manager) getWebhookContext(ctx context.Context, activity *store.ActivityMessage, meta *Metadata, updater *store.UserMessage) (*webhook.Context, error) {
 	var webhookCtx webhook.Context
 	var webhookTaskResult *webhook.TaskResult
 	var mentions []string
+	var approvalActions bool
 
 	setting, err := m.store.GetWorkspaceGeneralSetting(ctx)
 	if err != nil {
@@ -482,6 +416,12 @@ func (m *Manager) getWebhookContext(ctx context.Context, activity *store.Activit
 		case api.IssueFieldName:
 			title = fmt.Sprintf("Changed issue name - %s", meta.Issue.Title)
 			titleZh = fmt.Sprintf("工单标题变更 - %s", meta.Issue.Title)
+		case api.IssueFieldPriority:
+			title = fmt.Sprintf("Changed issue priority from %s to %s - %s", update.OldValue, update.NewValue, meta.Issue.Title)
+			titleZh = fmt.Sprintf("工单优先级从 %s 变更为 %s - %s", update.OldValue, update.NewValue, meta.Issue.Title)
+		case api.IssueFieldLabels:
+			title = fmt.Sprintf("Changed issue labels - %s", meta.Issue.Title)
+			titleZh = fmt.Sprintf("工单标签变更 - %s", meta.Issue.Title)
 		default:
 			title = fmt.Sprintf("Updated issue - %s", meta.Issue.Title)
 			titleZh = fmt.Sprintf("工单信息变更 - %s", meta.Issue.Title)
@@ -501,7 +441,33 @@ func (m *Manager) getWebhookContext(ctx context.Context, activity *store.Activit
 			titleZh = fmt.Sprintf("阶段结束 - %s", payload.StageName)
 		}
 
-	case api.ActivityPipelineTaskRunStatusUpdate:
+	case api.ActivityPipelineStageApprove:
+		payload := &api.ActivityPipelineStageApprovePayload{}
+		if err := json.Unmarshal([]byte(activity.Payload), payload); err != nil {
+			slog.Warn(
+				"failed to post webhook event after stage approval, failed to unmarshal payload",
+				slog.String("issue_name", meta.Issue.Title),
+				log.BBError(err))
+			return nil, err
+		}
+		link += fmt.Sprintf("?stage=%d", payload.StageID)
+		title = fmt.Sprintf("Stage approved as %s - %s", payload.Role, payload.StageName)
+		titleZh = fmt.Sprintf("阶段已由 %s 批准 - %s", payload.Role, payload.StageName)
+
+	case api.ActivityPipelineStageCanaryUpdate:
+		payload := &api.ActivityPipelineStageCanaryUpdatePayload{}
+		if err := json.Unmarshal([]byte(activity.Payload), payload); err != nil {
+			slog.Warn(
+				"failed to post webhook event after canary update, failed to unmarshal payload",
+				slog.String("issue_name", meta.Issue.Title),
+				log.BBError(err))
+			return nil, err
+		}
+		link += fmt.Sprintf("?stage=%d", payload.StageID)
+		title = fmt.Sprintf("Canary %s - %s", strings.ToLower(payload.Status), payload.StageName)
+		titleZh = fmt.Sprintf("金丝雀阶段 %s - %s", strings.ToLower(payload.Status), payload.StageName)
+
+	case api.ActivityPipelineTaskRunStatusUpdate, api.ActivityNotifyRolloutFailed:
 		payload := &api.ActivityPipelineTaskRunStatusUpdatePayload{}
 		if err := json.Unmarshal([]byte(activity.Payload), payload); err != nil {
 			slog.Warn("Failed to post webhook event after changing the issue task run status, failed to unmarshal payload",
@@ -718,6 +684,14 @@ func (m *Manager) getWebhookContext(ctx context.Context, activity *store.Activit
 		default:
 			return nil, errors.Errorf("invalid node payload type")
 		}
+		// The external approval nodes are approved in the external systems rather than by the users.
+		if _, ok := node.Payload.(*storepb.ApprovalNode_ExternalNodeId); !ok {
+			slackAppSetting, err := m.store.GetSlackAppSetting(ctx)
+			if err != nil {
+				return nil, err
+			}
+			approvalActions = slackAppSetting.SigningSecret != ""
+		}
 
 		users, err := usersGetter(ctx)
 		if err != nil {
@@ -763,6 +737,7 @@ func (m *Manager) getWebhookContext(ctx context.Context, activity *store.Activit
 		CreatorName:         updater.Name,
 		CreatorEmail:        updater.Email,
 		MentionUsersByPhone: mentions,
+		ApprovalActions:     approvalActions,
 	}
 	return &webhookCtx, nil
 }
@@ -829,6 +804,8 @@ func shouldPostInbox(activity *store.ActivityMessage, createType api.ActivityTyp
 		return false, nil
 	case api.ActivityNotifyPipelineRollout:
 		return false, nil
+	case api.ActivityNotifyRolloutFailed:
+		return false, nil
 	}
 	return false, nil
 }
@@ -0,0 +1,338 @@
+package activity
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// outboxDispatchInterval is the interval polling the outbox events, the dispatcher is also woken up on the new events of this replica.
+	outboxDispatchInterval = 5 * time.Second
+	// outboxClaimLimit is the max events claimed at a time.
+	outboxClaimLimit = 100
+	// outboxLease is the lease of the claimed events, they are delivered again after it if the dispatcher crashes.
+	outboxLease = 5 * time.Minute
+	// outboxMaxAttempts is the max delivery attempts, the events are marked as failed beyond it.
+	outboxMaxAttempts = 10
+	// outboxMaxBackoff is the max backoff between the delivery attempts.
+	outboxMaxBackoff = 1 * time.Hour
+	// outboxRetention is the retention of the delivered events and the webhook deliveries except the dead ones.
+	outboxRetention = 7 * 24 * time.Hour
+	// outboxPurgeInterval is the interval purging the delivered events.
+	outboxPurgeInterval = 1 * time.Hour
+)
+
+// Run runs the outbox dispatcher delivering the inbox and the webhooks of the activities at least once.
+// The webhooks delivered are recorded in the event, so only the failed ones are posted again on retries.
+func (m *Manager) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(outboxDispatchInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	defer m.eventPublisher.close()
+	slog.Debug("Activity outbox dispatcher started", slog.Duration("interval", outboxDispatchInterval))
+	var purgedAt time.Time
+	for {
+		select {
+		case <-ticker.C:
+		case <-m.notifyC:
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					err, ok := r.(error)
+					if !ok {
+						err = errors.Errorf("%v", r)
+					}
+					slog.Error("Activity outbox dispatcher PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+				}
+			}()
+			m.dispatch(ctx)
+			if time.Since(purgedAt) >= outboxPurgeInterval {
+				purgedAt = time.Now()
+				if _, err := m.store.DeleteDoneOutboxEventsBefore(ctx, time.Now().Add(-outboxRetention).Unix()); err != nil {
+					slog.Error("Failed to purge the delivered outbox events", log.BBError(err))
+				}
+				if _, err := m.store.DeleteWebhookDeliveriesBefore(ctx, time.Now().Add(-outboxRetention).Unix()); err != nil {
+					slog.Error("Failed to purge the webhook deliveries", log.BBError(err))
+				}
+			}
+		}()
+	}
+}
+
+// notify wakes up the dispatcher without blocking.
+func (m *Manager) notify() {
+	select {
+	case m.notifyC <- struct{}{}:
+	default:
+	}
+}
+
+func (m *Manager) dispatch(ctx context.Context) {
+	for {
+		events, err := m.store.ClaimOutboxEvents(ctx, outboxClaimLimit, outboxLease)
+		if err != nil {
+			slog.Error("Failed to claim outbox events", log.BBError(err))
+			return
+		}
+		for _, event := range events {
+			if ctx.Err() != nil {
+				return
+			}
+			m.deliver(ctx, event)
+		}
+		if len(events) < outboxClaimLimit {
+			return
+		}
+	}
+}
+
+// deliver delivers the event and records the result, the event is retried with backoff if any side effect fails.
+func (m *Manager) deliver(ctx context.Context, event *store.OutboxEventMessage) {
+	deliverErr := m.deliverImpl(ctx, event)
+	patch := &store.UpdateOutboxEventMessage{
+		UID:     event.UID,
+		Payload: event.Payload,
+	}
+	status := store.OutboxEventDone
+	if deliverErr != nil {
+		attempts := event.Attempts + 1
+		lastError := deliverErr.Error()
+		status = store.OutboxEventPending
+		if attempts >= outboxMaxAttempts {
+			status = store.OutboxEventFailed
+			slog.Warn("Failed to deliver outbox event after all the attempts",
+				slog.String("dedup_key", event.DedupKey),
+				slog.Int("attempts", attempts),
+				log.BBError(deliverErr))
+		}
+		backoff := time.Duration(math.Min(float64(outboxMaxBackoff), math.Pow(2, float64(attempts))*float64(10*time.Second)))
+		nextAttemptTs := time.Now().Add(backoff).Unix()
+		patch.Attempts = &attempts
+		patch.LastError = &lastError
+		patch.NextAttemptTs = &nextAttemptTs
+	}
+	patch.Status = &status
+	if err := m.store.UpdateOutboxEvent(ctx, patch); err != nil {
+		// The event will be delivered again after the lease, the delivered side effects are skipped.
+		slog.Error("Failed to update outbox event", slog.String("dedup_key", event.DedupKey), log.BBError(err))
+	}
+}
+
+func (m *Manager) deliverImpl(ctx context.Context, outboxEvent *store.OutboxEventMessage) error {
+	payload := outboxEvent.Payload
+	attempt := outboxEvent.Attempts + 1
+	var webhookCtx *webhook.Context
+	switch event := payload.Event.(type) {
+	case *storepb.OutboxEventPayload_IssueActivity_:
+		activity := event.IssueActivity
+		issueUID := int(activity.IssueId)
+		issue, err := m.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issueUID})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get issue %d", issueUID)
+		}
+		if issue == nil {
+			slog.Warn("Skip the outbox event of the deleted issue", slog.Int("issue_id", issueUID))
+			return nil
+		}
+		activityMessage := &store.ActivityMessage{
+			UID:          int(activity.ActivityId),
+			CreatorUID:   int(activity.CreatorId),
+			ContainerUID: issue.UID,
+			Type:         api.ActivityType(payload.ActivityType),
+			Level:        api.ActivityLevel(activity.Level),
+			Comment:      activity.Comment,
+			Payload:      activity.Payload,
+		}
+
+		if !payload.InboxPosted {
+			postInbox, err := shouldPostInbox(activityMessage, activityMessage.Type)
+			if err != nil {
+				slog.Warn("Failed to decide whether to post the inbox of the issue activity",
+					slog.String("issue_name", issue.Title),
+					log.BBError(err))
+			}
+			if postInbox {
+				if err := m.postInboxIssueActivity(ctx, issue, activityMessage.UID); err != nil {
+					return err
+				}
+			}
+			payload.InboxPosted = true
+		}
+
+		if !payload.JiraSynced {
+			if err := m.syncJiraIssue(ctx, issue, activityMessage.Type); err != nil {
+				return errors.Wrapf(err, "failed to sync Jira issue of issue %d", issue.UID)
+			}
+			payload.JiraSynced = true
+		}
+
+		updater, err := m.store.GetUserByID(ctx, activityMessage.CreatorUID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to find updater for posting webhook event after changing the issue status: %v", issue.Title)
+		}
+		if updater == nil {
+			slog.Warn("Skip the outbox event of the deleted updater", slog.Int("updater_id", activityMessage.CreatorUID))
+			return nil
+		}
+		webhookCtx, err = m.getWebhookContext(ctx, activityMessage, &Metadata{Issue: issue}, updater)
+		if err != nil {
+			slog.Warn("Failed to get webhook context",
+				slog.String("issue_name", issue.Title),
+				log.BBError(err))
+			return nil
+		}
+	case *storepb.OutboxEventPayload_WebhookContext:
+		webhookCtx = &webhook.Context{}
+		if err := json.Unmarshal([]byte(event.WebhookContext), webhookCtx); err != nil {
+			slog.Warn("Skip the outbox event of the invalid webhook context", log.BBError(err))
+			return nil
+		}
+	case *storepb.OutboxEventPayload_AuditActivities_:
+		events, err := m.convertToAuditEvents(ctx, event.AuditActivities.Activities)
+		if err != nil {
+			return err
+		}
+		if err := m.publishEvents(ctx, events...); err != nil {
+			return errors.Wrapf(err, "failed to publish events")
+		}
+		payload.EventPublished = true
+		return nil
+	default:
+		return nil
+	}
+
+	if !payload.EventPublished {
+		event, err := m.convertToEvent(ctx, outboxEvent, webhookCtx)
+		if err != nil {
+			return err
+		}
+		if err := m.publishEvents(ctx, event); err != nil {
+			return errors.Wrapf(err, "failed to publish event")
+		}
+		payload.EventPublished = true
+	}
+
+	projectUID := int(payload.ProjectId)
+	activityType := api.ActivityType(payload.ActivityType)
+	webhookList, err := m.store.FindProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
+		ProjectID:    &projectUID,
+		ActivityType: &activityType,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to find project webhook for activity type %s", activityType)
+	}
+	var pendingList []*store.ProjectWebhookMessage
+	for _, hook := range webhookList {
+		if !slices.Contains(payload.DeliveredWebhookIds, int32(hook.ID)) {
+			pendingList = append(pendingList, hook)
+		}
+	}
+	if len(pendingList) == 0 {
+		return nil
+	}
+	deliveries := postWebhookList(webhookCtx, pendingList)
+	var failed int
+	for _, delivery := range deliveries {
+		delivery.Attempt = attempt
+		if delivery.Status == store.WebhookDeliverySucceeded {
+			payload.DeliveredWebhookIds = append(payload.DeliveredWebhookIds, int32(delivery.WebhookID))
+			continue
+		}
+		failed++
+		if attempt >= outboxMaxAttempts {
+			// The deliveries failed after all the attempts are kept in the dead-letter list for the manual redelivery.
+			delivery.Status = store.WebhookDeliveryDead
+		}
+	}
+	if _, err := m.store.CreateWebhookDeliveries(ctx, deliveries...); err != nil {
+		// The delivery history is best-effort, it must not cause the delivered webhooks to be posted again.
+		slog.Error("Failed to record webhook deliveries", log.BBError(err))
+	}
+	if failed > 0 {
+		return errors.Errorf("failed to post %d of %d webhooks", failed, len(pendingList))
+	}
+	return nil
+}
+
+// postWebhookList posts the webhook event to the webhooks concurrently, and returns the deliveries of the webhooks.
+func postWebhookList(webhookCtx *webhook.Context, webhookList []*store.ProjectWebhookMessage) []*store.WebhookDeliveryMessage {
+	// The webhook context recorded in the deliveries is the one before being customized for each webhook,
+	// so that it can be redelivered with the latest config of the webhook.
+	webhookContext, err := json.Marshal(webhookCtx)
+	if err != nil {
+		slog.Warn("Failed to marshal webhook context", log.BBError(err))
+	}
+	var wg sync.WaitGroup
+	deliveries := make([]*store.WebhookDeliveryMessage, len(webhookList))
+	for i, hook := range webhookList {
+		webhookCtx := *webhookCtx
+		webhookCtx.URL = hook.URL
+		webhookCtx.CreatedTs = time.Now().Unix()
+		webhookCtx.PayloadVersion = int(hook.Payload.GetPayloadVersion())
+		webhookCtx.PayloadFields = hook.Payload.GetPayloadFields()
+		webhookCtx.SigningSecret = hook.Payload.GetSigningSecret()
+		deliveries[i] = &store.WebhookDeliveryMessage{
+			WebhookID:      hook.ID,
+			ActivityType:   webhookCtx.ActivityType,
+			Status:         store.WebhookDeliverySucceeded,
+			WebhookContext: string(webhookContext),
+		}
+		wg.Add(1)
+		go func(webhookCtx *webhook.Context, hook *store.ProjectWebhookMessage, delivery *store.WebhookDeliveryMessage) {
+			defer wg.Done()
+			if err := webhook.Post(hook.Type, *webhookCtx); err != nil {
+				// The external webhook endpoint might be invalid which is out of our code control, so we just emit a warning
+				slog.Warn("Failed to post webhook event on activity",
+					slog.String("webhook type", hook.Type),
+					slog.String("webhook name", hook.Title),
+					slog.String("activity type", webhookCtx.ActivityType),
+					slog.String("title", webhookCtx.Title),
+					log.BBError(err))
+				delivery.Status = store.WebhookDeliveryFailed
+				delivery.Error = err.Error()
+			}
+		}(&webhookCtx, hook, deliveries[i])
+	}
+	wg.Wait()
+	return deliveries
+}
+
+// RedeliverWebhook posts the webhook context of the dead delivery to the webhook with its latest config.
+// The redelivery is recorded as a new delivery, and the dead delivery is marked as redelivered if it succeeds.
+func (m *Manager) RedeliverWebhook(ctx context.Context, hook *store.ProjectWebhookMessage, dead *store.WebhookDeliveryMessage) (*store.WebhookDeliveryMessage, error) {
+	webhookCtx := &webhook.Context{}
+	if err := json.Unmarshal([]byte(dead.WebhookContext), webhookCtx); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal the webhook context of delivery %d", dead.UID)
+	}
+	delivery := postWebhookList(webhookCtx, []*store.ProjectWebhookMessage{hook})[0]
+	created, err := m.store.CreateWebhookDeliveries(ctx, delivery)
+	if err != nil {
+		return nil, err
+	}
+	if len(created) != 1 {
+		return nil, errors.Errorf("webhook %d has been deleted", hook.ID)
+	}
+	delivery = created[0]
+	if delivery.Status == store.WebhookDeliverySucceeded {
+		if err := m.store.UpdateWebhookDeliveryStatus(ctx, dead.UID, store.WebhookDeliveryRedelivered); err != nil {
+			return nil, err
+		}
+	}
+	return delivery, nil
+}
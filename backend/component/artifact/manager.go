@@ -0,0 +1,132 @@
+// Package artifact saves the files generated by the task runs in the storage backend.
+package artifact
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/component/config"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/storage/s3"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// pathPrefix is the storage path prefix of the task run artifacts.
+	pathPrefix = "task-run-artifacts"
+	// maxArtifactSize is the maximum size of an artifact, the artifacts are read into memory when retrieved.
+	maxArtifactSize = 64 * 1024 * 1024
+)
+
+// Manager is the manager saving and loading the task run artifacts.
+type Manager struct {
+	store    *store.Store
+	s3Client *s3.Client
+	profile  *config.Profile
+}
+
+// NewManager creates a new artifact manager.
+func NewManager(store *store.Store, s3Client *s3.Client, profile *config.Profile) *Manager {
+	return &Manager{
+		store:    store,
+		s3Client: s3Client,
+		profile:  profile,
+	}
+}
+
+// Attach saves the content in the storage backend and attaches it to the task run as an artifact.
+func (m *Manager) Attach(ctx context.Context, taskRunUID int, artifactType store.TaskRunArtifactType, name, contentType string, content []byte) (*store.TaskRunArtifactMessage, error) {
+	if len(content) > maxArtifactSize {
+		return nil, errors.Errorf("artifact %q is %d bytes, exceeding the limit of %d bytes", name, len(content), maxArtifactSize)
+	}
+	// The timestamp keeps the artifacts with the same name of a task run from overwriting each other.
+	path := fmt.Sprintf("%s/%d/%d-%s", pathPrefix, taskRunUID, time.Now().UnixNano(), filepath.Base(name))
+	storageBackend := m.profile.BackupStorageBackend
+	switch storageBackend {
+	case api.BackupStorageBackendLocal:
+		absPath := filepath.Join(m.profile.DataDir, path)
+		if err := os.MkdirAll(filepath.Dir(absPath), os.ModePerm); err != nil {
+			return nil, errors.Wrapf(err, "failed to create the directory of artifact %q", absPath)
+		}
+		if err := os.WriteFile(absPath, content, 0600); err != nil {
+			return nil, errors.Wrapf(err, "failed to write artifact %q", absPath)
+		}
+	case api.BackupStorageBackendS3:
+		if m.s3Client == nil {
+			return nil, errors.Errorf("object storage is not configured to save artifacts")
+		}
+		if _, err := m.s3Client.UploadObject(ctx, path, bytes.NewReader(content)); err != nil {
+			return nil, errors.Wrapf(err, "failed to upload artifact %q", path)
+		}
+	default:
+		return nil, errors.Errorf("saving artifacts to %s is not supported", storageBackend)
+	}
+
+	return m.store.CreateTaskRunArtifact(ctx, &store.TaskRunArtifactMessage{
+		TaskRunUID:     taskRunUID,
+		Type:           artifactType,
+		Name:           name,
+		ContentType:    contentType,
+		Size:           int64(len(content)),
+		StorageBackend: storageBackend,
+		Path:           path,
+	})
+}
+
+// Load loads the content of the artifact from the storage backend.
+func (m *Manager) Load(ctx context.Context, artifact *store.TaskRunArtifactMessage) ([]byte, error) {
+	switch artifact.StorageBackend {
+	case api.BackupStorageBackendLocal:
+		absPath := filepath.Join(m.profile.DataDir, artifact.Path)
+		content, err := os.ReadFile(absPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read artifact %q", absPath)
+		}
+		return content, nil
+	case api.BackupStorageBackendS3:
+		if m.s3Client == nil {
+			return nil, errors.Errorf("object storage is not configured to load artifacts")
+		}
+		buf := manager.NewWriteAtBuffer(make([]byte, 0, artifact.Size))
+		if _, err := m.s3Client.DownloadObject(ctx, artifact.Path, buf); err != nil {
+			return nil, errors.Wrapf(err, "failed to download artifact %q", artifact.Path)
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, errors.Errorf("loading artifacts from %s is not supported", artifact.StorageBackend)
+	}
+}
+
+// Delete deletes the artifacts and their content in the storage backend.
+func (m *Manager) Delete(ctx context.Context, artifacts []*store.TaskRunArtifactMessage) error {
+	var uids []int64
+	var s3Paths []string
+	for _, artifact := range artifacts {
+		switch artifact.StorageBackend {
+		case api.BackupStorageBackendLocal:
+			absPath := filepath.Join(m.profile.DataDir, artifact.Path)
+			if err := os.Remove(absPath); err != nil && !os.IsNotExist(err) {
+				return errors.Wrapf(err, "failed to delete artifact %q", absPath)
+			}
+		case api.BackupStorageBackendS3:
+			s3Paths = append(s3Paths, artifact.Path)
+		}
+		uids = append(uids, artifact.ID)
+	}
+	if len(s3Paths) > 0 {
+		if m.s3Client == nil {
+			return errors.Errorf("object storage is not configured to delete artifacts")
+		}
+		if _, err := m.s3Client.DeleteObjects(ctx, s3Paths...); err != nil {
+			return errors.Wrapf(err, "failed to delete artifacts in the object storage")
+		}
+	}
+	return m.store.DeleteTaskRunArtifacts(ctx, &store.DeleteTaskRunArtifactMessage{UIDs: uids})
+}
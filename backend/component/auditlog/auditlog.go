@@ -0,0 +1,154 @@
+// Package auditlog converts the API calls to the audit logs, and the audit logs to the records exported for SIEM ingestion.
+package auditlog
+
+import (
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// SchemaVersion is the version of the record JSON schema.
+	// The fields are only added to the same schema version, never renamed or removed.
+	SchemaVersion = "1"
+
+	// maxRequestLength is the max length of the request JSON in the audit log.
+	maxRequestLength = 16 * 1024
+	redactedValue    = "******"
+	truncatedSuffix  = "...(truncated)"
+)
+
+// sensitiveFieldNames are the substrings of the names of the request fields redacted from the audit logs.
+var sensitiveFieldNames = []string{"password", "secret", "token", "credential", "private_key", "ssl_key", "service_key", "access_key"}
+
+// nonSensitiveFieldNames are the names of the request fields kept even though they contain the sensitive substrings.
+var nonSensitiveFieldNames = map[string]bool{"page_token": true, "next_page_token": true}
+
+// Record is the audit log exported in the stable JSON schema for SIEM ingestion.
+type Record struct {
+	SchemaVersion string `json:"schemaVersion"`
+	ID            int64  `json:"id"`
+	// Time is in RFC 3339 format.
+	Time string `json:"time"`
+	// User is "users/{email}", or empty if the request is not authenticated.
+	User      string `json:"user"`
+	Method    string `json:"method"`
+	Resource  string `json:"resource"`
+	Request   string `json:"request"`
+	Status    string `json:"status"`
+	Error     string `json:"error"`
+	IPAddress string `json:"ipAddress"`
+	UserAgent string `json:"userAgent"`
+	LatencyMs int64  `json:"latencyMs"`
+}
+
+// NewRecord returns the record of the audit log created by the user with the email, which is empty if the request is not authenticated.
+func NewRecord(auditLog *store.AuditLogMessage, email string) *Record {
+	record := &Record{
+		SchemaVersion: SchemaVersion,
+		ID:            auditLog.UID,
+		Time:          time.Unix(auditLog.CreatedTs, 0).UTC().Format(time.RFC3339),
+		Method:        auditLog.Method,
+		Resource:      auditLog.Resource,
+		Request:       auditLog.Payload.Request,
+		Status:        auditLog.Payload.Status,
+		Error:         auditLog.Payload.Error,
+		IPAddress:     auditLog.Payload.IpAddress,
+		UserAgent:     auditLog.Payload.UserAgent,
+		LatencyMs:     auditLog.Payload.Latency.AsDuration().Milliseconds(),
+	}
+	if email != "" {
+		record.User = common.FormatUserEmail(email)
+	}
+	return record
+}
+
+// GetRequestJSON returns the JSON of the request with the sensitive fields redacted, which is truncated if too large.
+func GetRequestJSON(request any) string {
+	message, ok := request.(proto.Message)
+	if !ok {
+		return ""
+	}
+	message = proto.Clone(message)
+	redact(message.ProtoReflect())
+	bytes, err := protojson.Marshal(message)
+	if err != nil {
+		return ""
+	}
+	if len(bytes) > maxRequestLength {
+		return string(bytes[:maxRequestLength]) + truncatedSuffix
+	}
+	return string(bytes)
+}
+
+// redact redacts the sensitive string fields of the message recursively.
+func redact(message protoreflect.Message) {
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.IsMap():
+		case field.IsList():
+			if field.Kind() == protoreflect.MessageKind {
+				list := value.List()
+				for i := 0; i < list.Len(); i++ {
+					redact(list.Get(i).Message())
+				}
+			}
+		case field.Kind() == protoreflect.MessageKind:
+			redact(value.Message())
+		case field.Kind() == protoreflect.StringKind:
+			if isSensitiveField(string(field.Name())) && value.String() != "" {
+				message.Set(field, protoreflect.ValueOfString(redactedValue))
+			}
+		}
+		return true
+	})
+}
+
+func isSensitiveField(name string) bool {
+	if nonSensitiveFieldNames[name] {
+		return false
+	}
+	for _, sensitive := range sensitiveFieldNames {
+		if strings.Contains(name, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetResource returns the resource the request accesses, which is the first set of the name, parent and database fields,
+// or the name of the first message field, e.g. the project of the UpdateProjectRequest.
+func GetResource(request any) string {
+	message, ok := request.(proto.Message)
+	if !ok {
+		return ""
+	}
+	reflectMessage := message.ProtoReflect()
+	fields := reflectMessage.Descriptor().Fields()
+	for _, name := range []protoreflect.Name{"name", "parent", "database"} {
+		if field := fields.ByName(name); field != nil && field.Kind() == protoreflect.StringKind && !field.IsList() {
+			if v := reflectMessage.Get(field).String(); v != "" {
+				return v
+			}
+		}
+	}
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() || !reflectMessage.Has(field) {
+			continue
+		}
+		nested := reflectMessage.Get(field).Message()
+		if nameField := nested.Descriptor().Fields().ByName("name"); nameField != nil && nameField.Kind() == protoreflect.StringKind && !nameField.IsList() {
+			if v := nested.Get(nameField).String(); v != "" {
+				return v
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,59 @@
+package auditlog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestGetRequestJSON(t *testing.T) {
+	a := require.New(t)
+
+	request := &v1pb.LoginRequest{Email: "alice@example.com", Password: "secret"}
+	got := GetRequestJSON(request)
+	a.Contains(got, "alice@example.com")
+	a.Contains(got, redactedValue)
+	a.NotContains(got, `"secret"`)
+	// The request itself is not redacted.
+	a.Equal("secret", request.Password)
+
+	a.Equal(`{"parent":"projects/p1","pageToken":"abc"}`, strings.ReplaceAll(GetRequestJSON(&v1pb.ListDatabasesRequest{Parent: "projects/p1", PageToken: "abc"}), " ", ""))
+
+	got = GetRequestJSON(&v1pb.UpdateProjectRequest{Project: &v1pb.Project{Name: "projects/p1", Title: strings.Repeat("a", maxRequestLength)}})
+	a.Len(got, maxRequestLength+len(truncatedSuffix))
+	a.True(strings.HasSuffix(got, truncatedSuffix))
+
+	a.Equal("", GetRequestJSON(nil))
+}
+
+func TestGetResource(t *testing.T) {
+	tests := []struct {
+		request any
+		want    string
+	}{
+		{
+			request: &v1pb.DeleteProjectRequest{Name: "projects/p1"},
+			want:    "projects/p1",
+		},
+		{
+			request: &v1pb.CreateDatabaseGroupRequest{Parent: "projects/p1"},
+			want:    "projects/p1",
+		},
+		{
+			request: &v1pb.UpdateProjectRequest{Project: &v1pb.Project{Name: "projects/p1"}},
+			want:    "projects/p1",
+		},
+		{
+			request: &v1pb.LoginRequest{Email: "alice@example.com"},
+			want:    "",
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, GetResource(test.request))
+	}
+}
@@ -51,6 +51,10 @@ type Profile struct {
 	BackupRegion         string
 	BackupBucket         string
 	BackupCredentialFile string
+	// MetadataBackupInterval is the interval backing up the metadata database to the backup storage backend. 0 disables the backup.
+	MetadataBackupInterval time.Duration
+	// MetadataBackupRetention is the number of the latest metadata backups kept. 0 keeps all the backups.
+	MetadataBackupRetention int
 
 	// Version is the bytebase's server version
 	Version string
@@ -58,11 +62,22 @@ type Profile struct {
 	GitCommit string
 	// PgURL is the optional external PostgreSQL instance connection url
 	PgURL string
+	// PgReplicaURL is the optional connection url of the read-only replica of PgURL serving the read-heavy queries.
+	PgReplicaURL string
+	// PgReplicaMaxLag is the max replication lag to read from the replica.
+	PgReplicaMaxLag time.Duration
 	// MetricConnectionKey is the connection key for metric.
 	MetricConnectionKey string
 	// EnableMetric will enable the metric collector.
 	EnableMetric bool
 
+	// TraceEndpoint is the OTLP gRPC endpoint exporting the traces to, e.g. "localhost:4317". Empty disables the tracing.
+	TraceEndpoint string
+	// TraceInsecure disables the TLS to the TraceEndpoint.
+	TraceInsecure bool
+	// TraceSampleRatio is the ratio of the sampled traces.
+	TraceSampleRatio float64
+
 	// Test only flag to skip generating onboarding data.
 	TestOnlySkipOnboardingData bool
 
@@ -73,6 +88,11 @@ type Profile struct {
 	PreUpdateBackup bool
 	DevelopmentIAM  bool
 	ExecuteDetail   bool
+
+	// CacheSizes overrides the max entries of the metadata caches by the namespace.
+	CacheSizes map[string]int
+	// CacheTTLs overrides the TTL of the metadata caches by the namespace, zero never expires the entries.
+	CacheTTLs map[string]time.Duration
 }
 
 // UseEmbedDB returns whether to use embedDB.
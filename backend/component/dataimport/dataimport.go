@@ -0,0 +1,191 @@
+// Package dataimport parses the delimited data and generates the batched INSERT statements importing it into a table.
+package dataimport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// DefaultBatchSize is the default number of rows in an INSERT statement.
+	DefaultBatchSize = 500
+	// MaximumBatchSize is the maximum number of rows in an INSERT statement.
+	MaximumBatchSize = 10000
+	// mssqlMaximumBatchSize is the limit of the row value expressions in an INSERT statement of SQL Server.
+	mssqlMaximumBatchSize = 1000
+)
+
+// ColumnMapping maps a field of the records to a column of the target table.
+type ColumnMapping struct {
+	Column     string
+	FieldIndex int
+}
+
+// Options is the options generating the statements.
+type Options struct {
+	Engine         storepb.Engine
+	Schema         string
+	Table          string
+	ColumnMappings []*ColumnMapping
+	// EmptyAsNull imports the empty fields as NULL instead of empty strings.
+	EmptyAsNull bool
+	BatchSize   int
+}
+
+// Result is the generated statements.
+type Result struct {
+	Statements []string
+	RowCount   int
+}
+
+// Parse parses the records delimited by the delimiter, skipping the first record as the header if skipHeader.
+func Parse(content []byte, delimiter rune, skipHeader bool) ([][]string, error) {
+	// Excel writes the UTF-8 BOM in front of CSV files.
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.Comma = delimiter
+	reader.FieldsPerRecord = -1
+	if delimiter == '\t' {
+		// TSV doesn't quote the fields.
+		reader.LazyQuotes = true
+	}
+
+	var records [][]string
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse record %d", line)
+		}
+		if line == 1 && skipHeader {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// Generate generates the batched INSERT statements of the records.
+func Generate(records [][]string, options *Options) (*Result, error) {
+	if options.Table == "" {
+		return nil, errors.Errorf("table must be set")
+	}
+	if len(options.ColumnMappings) == 0 {
+		return nil, errors.Errorf("column mappings must be set")
+	}
+	columns := make(map[string]bool)
+	for _, mapping := range options.ColumnMappings {
+		if mapping.Column == "" {
+			return nil, errors.Errorf("column of the mapping must be set")
+		}
+		if columns[mapping.Column] {
+			return nil, errors.Errorf("duplicate column %q in the mappings", mapping.Column)
+		}
+		columns[mapping.Column] = true
+		if mapping.FieldIndex < 0 {
+			return nil, errors.Errorf("invalid field index %d of column %q", mapping.FieldIndex, mapping.Column)
+		}
+	}
+	batchSize := options.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	if batchSize > MaximumBatchSize {
+		return nil, errors.Errorf("batch size must not exceed %d", MaximumBatchSize)
+	}
+	if options.Engine == storepb.Engine_MSSQL && batchSize > mssqlMaximumBatchSize {
+		batchSize = mssqlMaximumBatchSize
+	}
+
+	quote, err := getIdentifierQuote(options.Engine)
+	if err != nil {
+		return nil, err
+	}
+	table := quote(options.Table)
+	if options.Schema != "" {
+		table = quote(options.Schema) + "." + table
+	}
+	var columnList []string
+	for _, mapping := range options.ColumnMappings {
+		columnList = append(columnList, quote(mapping.Column))
+	}
+	target := table + " (" + strings.Join(columnList, ", ") + ")"
+
+	result := &Result{}
+	var rows []string
+	for i, record := range records {
+		var values []string
+		for _, mapping := range options.ColumnMappings {
+			if mapping.FieldIndex >= len(record) {
+				return nil, errors.Errorf("record %d has %d fields, but column %q maps to field %d", i+1, len(record), mapping.Column, mapping.FieldIndex)
+			}
+			field := record[mapping.FieldIndex]
+			if field == "" && options.EmptyAsNull {
+				values = append(values, "NULL")
+				continue
+			}
+			values = append(values, quoteString(options.Engine, field))
+		}
+		rows = append(rows, "("+strings.Join(values, ", ")+")")
+		if len(rows) == batchSize {
+			result.Statements = append(result.Statements, getInsertStatement(options.Engine, target, rows))
+			rows = nil
+		}
+	}
+	if len(rows) > 0 {
+		result.Statements = append(result.Statements, getInsertStatement(options.Engine, target, rows))
+	}
+	result.RowCount = len(records)
+	return result, nil
+}
+
+func getInsertStatement(engine storepb.Engine, target string, rows []string) string {
+	switch engine {
+	case storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE, storepb.Engine_DM:
+		// Oracle doesn't support multiple rows in the VALUES clause.
+		var buf strings.Builder
+		buf.WriteString("INSERT ALL\n")
+		for _, row := range rows {
+			buf.WriteString("  INTO " + target + " VALUES " + row + "\n")
+		}
+		buf.WriteString("SELECT 1 FROM DUAL;")
+		return buf.String()
+	default:
+		return "INSERT INTO " + target + " VALUES\n  " + strings.Join(rows, ",\n  ") + ";"
+	}
+}
+
+func getIdentifierQuote(engine storepb.Engine) (func(string) string, error) {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE, storepb.Engine_CLICKHOUSE:
+		return func(s string) string {
+			return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+		}, nil
+	case storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_RISINGWAVE, storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE, storepb.Engine_DM, storepb.Engine_SQLITE, storepb.Engine_SNOWFLAKE, storepb.Engine_MSSQL:
+		return func(s string) string {
+			return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+		}, nil
+	default:
+		return nil, errors.Errorf("importing data is not supported for engine %s", engine)
+	}
+}
+
+// quoteString quotes the string literal, escaping the backslashes as well for the engines treating them as escape characters.
+func quoteString(engine storepb.Engine, value string) string {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE, storepb.Engine_CLICKHOUSE:
+		value = strings.ReplaceAll(value, `\`, `\\`)
+	case storepb.Engine_MSSQL:
+		// The N prefix keeps the Unicode characters for the NCHAR and NVARCHAR columns.
+		return "N'" + strings.ReplaceAll(value, "'", "''") + "'"
+	}
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
@@ -0,0 +1,79 @@
+package dataimport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestParse(t *testing.T) {
+	a := require.New(t)
+
+	records, err := Parse([]byte("\xef\xbb\xbfid,name\n1,\"o'brien, jr\"\n2,\n"), ',', true)
+	a.NoError(err)
+	a.Equal([][]string{{"1", "o'brien, jr"}, {"2", ""}}, records)
+
+	records, err = Parse([]byte("1\ta\"b\n2\tc"), '\t', false)
+	a.NoError(err)
+	a.Equal([][]string{{"1", `a"b`}, {"2", "c"}}, records)
+
+	_, err = Parse([]byte("1,\"unterminated\n"), ',', false)
+	a.Error(err)
+}
+
+func TestGenerate(t *testing.T) {
+	records := [][]string{{"1", `o'brien\`, ""}, {"2", "bob", "x"}, {"3", "carol", ""}}
+	mappings := []*ColumnMapping{{Column: "id", FieldIndex: 0}, {Column: "name", FieldIndex: 1}, {Column: "note", FieldIndex: 2}}
+
+	tests := []struct {
+		options *Options
+		want    []string
+		wantErr bool
+	}{
+		{
+			options: &Options{Engine: storepb.Engine_POSTGRES, Schema: "public", Table: "users", ColumnMappings: mappings, EmptyAsNull: true, BatchSize: 2},
+			want: []string{
+				"INSERT INTO \"public\".\"users\" (\"id\", \"name\", \"note\") VALUES\n  ('1', 'o''brien\\', NULL),\n  ('2', 'bob', 'x');",
+				"INSERT INTO \"public\".\"users\" (\"id\", \"name\", \"note\") VALUES\n  ('3', 'carol', NULL);",
+			},
+		},
+		{
+			options: &Options{Engine: storepb.Engine_MYSQL, Table: "users", ColumnMappings: mappings[:2]},
+			want: []string{
+				"INSERT INTO `users` (`id`, `name`) VALUES\n  ('1', 'o''brien\\\\'),\n  ('2', 'bob'),\n  ('3', 'carol');",
+			},
+		},
+		{
+			options: &Options{Engine: storepb.Engine_ORACLE, Schema: "HR", Table: "USERS", ColumnMappings: mappings[:1]},
+			want: []string{
+				"INSERT ALL\n  INTO \"HR\".\"USERS\" (\"id\") VALUES ('1')\n  INTO \"HR\".\"USERS\" (\"id\") VALUES ('2')\n  INTO \"HR\".\"USERS\" (\"id\") VALUES ('3')\nSELECT 1 FROM DUAL;",
+			},
+		},
+		{
+			options: &Options{Engine: storepb.Engine_POSTGRES, Table: "users", ColumnMappings: []*ColumnMapping{{Column: "id", FieldIndex: 3}}},
+			wantErr: true,
+		},
+		{
+			options: &Options{Engine: storepb.Engine_POSTGRES, Table: "users", ColumnMappings: []*ColumnMapping{{Column: "id"}, {Column: "id", FieldIndex: 1}}},
+			wantErr: true,
+		},
+		{
+			options: &Options{Engine: storepb.Engine_MONGODB, Table: "users", ColumnMappings: mappings},
+			wantErr: true,
+		},
+	}
+
+	a := require.New(t)
+	for i, test := range tests {
+		result, err := Generate(records, test.options)
+		if test.wantErr {
+			a.Error(err, i)
+			continue
+		}
+		a.NoError(err, i)
+		a.Equal(test.want, result.Statements, i)
+		a.Equal(3, result.RowCount, i)
+	}
+}
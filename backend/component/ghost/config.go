@@ -63,6 +63,7 @@ type UserFlags struct {
 	aliyunRDS                     *bool
 	azure                         *bool
 	gcp                           *bool
+	postponeCutover               *bool
 }
 
 var knownKeys = map[string]bool{
@@ -81,6 +82,9 @@ var knownKeys = map[string]bool{
 	"aliyun-rds":                       true,
 	"azure":                            true,
 	"gcp":                              true,
+	// postpone-cut-over doesn't have a gh-ost cli flag counterpart.
+	// The cutover task isn't rolled out automatically and waits for an explicit cutover.
+	"postpone-cut-over": true,
 }
 
 func GetUserFlags(flags map[string]string) (*UserFlags, error) {
@@ -199,9 +203,41 @@ func GetUserFlags(flags map[string]string) (*UserFlags, error) {
 		}
 		f.gcp = &gcp
 	}
+	if v, ok := flags["postpone-cut-over"]; ok {
+		postponeCutover, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert postpone-cut-over %q to bool", v)
+		}
+		f.postponeCutover = &postponeCutover
+	}
 	return f, nil
 }
 
+// IsCutoverPostponed returns whether the cutover is postponed until it's triggered explicitly.
+func IsCutoverPostponed(flags map[string]string) bool {
+	userFlags, err := GetUserFlags(flags)
+	if err != nil {
+		return false
+	}
+	return userFlags.postponeCutover != nil && *userFlags.postponeCutover
+}
+
+// MergeFlags merges the gh-ost flags of the instance and the task.
+// The flags of the task take precedence over the flags of the instance.
+func MergeFlags(instanceFlags, taskFlags map[string]string) map[string]string {
+	if len(instanceFlags) == 0 {
+		return taskFlags
+	}
+	flags := make(map[string]string)
+	for k, v := range instanceFlags {
+		flags[k] = v
+	}
+	for k, v := range taskFlags {
+		flags[k] = v
+	}
+	return flags
+}
+
 func getSocketFilename(taskID int, taskCreatedTs int64, databaseID int, databaseName, tableName string) string {
 	return fmt.Sprintf("/tmp/gh-ost.%v.%v.%v.%v.%v.sock", taskID, taskCreatedTs, databaseID, databaseName, tableName)
 }
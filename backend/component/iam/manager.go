@@ -4,6 +4,7 @@ import (
 	"context"
 	_ "embed"
 	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
@@ -23,12 +24,22 @@ type acl struct {
 	} `yaml:"roles"`
 }
 
+// policyStore is the store the manager looks up the custom roles and the project IAM policies from.
+type policyStore interface {
+	GetRole(ctx context.Context, resourceID string) (*store.RoleMessage, error)
+	GetProjectPolicy(ctx context.Context, find *store.GetProjectPolicyMessage) (*store.IAMPolicyMessage, error)
+}
+
 type Manager struct {
 	roles map[string][]Permission
-	store *store.Store
+	store policyStore
 }
 
 func NewManager(store *store.Store) (*Manager, error) {
+	return newManager(store)
+}
+
+func newManager(store policyStore) (*Manager, error) {
 	predefinedACL := new(acl)
 	if err := yaml.Unmarshal(aclYaml, predefinedACL); err != nil {
 		return nil, errors.Wrapf(err, "failed to unmarshal predefined acl")
@@ -50,32 +61,102 @@ func NewManager(store *store.Store) (*Manager, error) {
 // Check if the user has the permission p
 // or has the permission p in every project.
 func (m *Manager) CheckPermission(ctx context.Context, p Permission, user *store.UserMessage, projectIDs ...string) (bool, error) {
+	return m.checkPermission(ctx, p, user, false /* customRoleOnly */, projectIDs)
+}
+
+// CheckCustomRolePermission checks if the custom roles of the user grant the permission p
+// in the workspace or in every project.
+func (m *Manager) CheckCustomRolePermission(ctx context.Context, p Permission, user *store.UserMessage, projectIDs ...string) (bool, error) {
+	return m.checkPermission(ctx, p, user, true /* customRoleOnly */, projectIDs)
+}
+
+func (m *Manager) checkPermission(ctx context.Context, p Permission, user *store.UserMessage, customRoleOnly bool, projectIDs []string) (bool, error) {
 	workspaceRoles := m.getWorkspaceRoles(user)
 	projectRoles, err := m.getProjectRoles(ctx, user, projectIDs)
 	if err != nil {
 		return false, errors.Wrapf(err, "failed to get project roles")
 	}
+	if customRoleOnly {
+		workspaceRoles = m.getCustomRoles(workspaceRoles)
+		for i, roles := range projectRoles {
+			projectRoles[i] = m.getCustomRoles(roles)
+		}
+	}
 
-	return m.hasPermission(p, workspaceRoles, projectRoles), nil
+	rolePermissions, err := m.getRolePermissions(ctx, workspaceRoles, projectRoles)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get role permissions")
+	}
+	return hasPermission(p, rolePermissions, workspaceRoles, projectRoles), nil
 }
 
-// GetPermissions returns all permissions for the given role.
+// GetPermissions returns all permissions for the given predefined role.
 func (m *Manager) GetPermissions(role string) []Permission {
 	return m.roles[role]
 }
 
-func (m *Manager) hasPermission(p Permission, workspaceRoles []string, projectRoles [][]string) bool {
-	return m.hasPermissionOnWorkspace(p, workspaceRoles) ||
-		m.hasPermissionOnEveryProject(p, projectRoles)
+// PermissionExist returns whether the permission is granted by any predefined role,
+// which are the permissions custom roles can be composed from.
+func (m *Manager) PermissionExist(p Permission) bool {
+	for _, permissions := range m.roles {
+		for _, permission := range permissions {
+			if permission == p {
+				return true
+			}
+		}
+	}
+	return false
 }
 
-func (m *Manager) hasPermissionOnWorkspace(p Permission, workspaceRoles []string) bool {
-	for _, role := range workspaceRoles {
-		permissions, ok := m.roles[role]
-		if !ok {
+// getCustomRoles returns the roles which are not predefined.
+func (m *Manager) getCustomRoles(roles []string) []string {
+	var customRoles []string
+	for _, role := range roles {
+		if _, ok := m.roles[role]; !ok {
+			customRoles = append(customRoles, role)
+		}
+	}
+	return customRoles
+}
+
+// getRolePermissions returns the permissions of the roles, looking up the custom roles in the store.
+func (m *Manager) getRolePermissions(ctx context.Context, workspaceRoles []string, projectRoles [][]string) (map[string][]Permission, error) {
+	rolePermissions := make(map[string][]Permission)
+	roles := append([]string{}, workspaceRoles...)
+	for _, r := range projectRoles {
+		roles = append(roles, r...)
+	}
+	for _, role := range roles {
+		if _, ok := rolePermissions[role]; ok {
 			continue
 		}
-		for _, permission := range permissions {
+		if permissions, ok := m.roles[role]; ok {
+			rolePermissions[role] = permissions
+			continue
+		}
+		customRole, err := m.store.GetRole(ctx, strings.TrimPrefix(role, common.RolePrefix))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get role %q", role)
+		}
+		var permissions []Permission
+		if customRole != nil {
+			for _, permission := range customRole.Permissions.GetPermissions() {
+				permissions = append(permissions, Permission(permission))
+			}
+		}
+		rolePermissions[role] = permissions
+	}
+	return rolePermissions, nil
+}
+
+func hasPermission(p Permission, rolePermissions map[string][]Permission, workspaceRoles []string, projectRoles [][]string) bool {
+	return hasPermissionOnWorkspace(p, rolePermissions, workspaceRoles) ||
+		hasPermissionOnEveryProject(p, rolePermissions, projectRoles)
+}
+
+func hasPermissionOnWorkspace(p Permission, rolePermissions map[string][]Permission, workspaceRoles []string) bool {
+	for _, role := range workspaceRoles {
+		for _, permission := range rolePermissions[role] {
 			if permission == p {
 				return true
 			}
@@ -84,18 +165,14 @@ func (m *Manager) hasPermissionOnWorkspace(p Permission, workspaceRoles []string
 	return false
 }
 
-func (m *Manager) hasPermissionOnEveryProject(p Permission, projectRoles [][]string) bool {
+func hasPermissionOnEveryProject(p Permission, rolePermissions map[string][]Permission, projectRoles [][]string) bool {
 	if len(projectRoles) == 0 {
 		return false
 	}
 	for _, projectRole := range projectRoles {
 		has := false
 		for _, role := range projectRole {
-			permissions, ok := m.roles[role]
-			if !ok {
-				continue
-			}
-			for _, permission := range permissions {
+			for _, permission := range rolePermissions[role] {
 				if permission == p {
 					has = true
 					break
@@ -0,0 +1,141 @@
+package iam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+type fakePolicyStore struct {
+	roles    map[string]*store.RoleMessage
+	policies map[string]*store.IAMPolicyMessage
+}
+
+func (s *fakePolicyStore) GetRole(_ context.Context, resourceID string) (*store.RoleMessage, error) {
+	return s.roles[resourceID], nil
+}
+
+func (s *fakePolicyStore) GetProjectPolicy(_ context.Context, find *store.GetProjectPolicyMessage) (*store.IAMPolicyMessage, error) {
+	if find.ProjectID == nil {
+		return nil, errors.Errorf("project ID is required")
+	}
+	policy, ok := s.policies[*find.ProjectID]
+	if !ok {
+		return &store.IAMPolicyMessage{}, nil
+	}
+	return policy, nil
+}
+
+func TestCheckPermission(t *testing.T) {
+	a := require.New(t)
+
+	member := &store.UserMessage{ID: 101, Roles: []api.Role{api.WorkspaceMember}}
+	auditor := &store.UserMessage{ID: 102, Roles: []api.Role{api.WorkspaceMember, "auditor"}}
+	fakeStore := &fakePolicyStore{
+		roles: map[string]*store.RoleMessage{
+			"auditor": {
+				ResourceID: "auditor",
+				Permissions: &storepb.RolePermissions{Permissions: []string{
+					string(PermissionSettingsGet),
+					string(PermissionInstancesList),
+					string(PermissionDatabasesList),
+				}},
+			},
+			"querier": {
+				ResourceID:  "querier",
+				Permissions: &storepb.RolePermissions{Permissions: []string{string(PermissionDatabasesQuery)}},
+			},
+		},
+		policies: map[string]*store.IAMPolicyMessage{
+			"p1": {Bindings: []*store.PolicyBinding{
+				{Role: "querier", Members: []*store.UserMessage{member}},
+			}},
+			"p2": {Bindings: []*store.PolicyBinding{
+				{Role: api.ProjectViewer, Members: []*store.UserMessage{member}},
+			}},
+			"p3": {Bindings: []*store.PolicyBinding{
+				{Role: "deleted", Members: []*store.UserMessage{member}},
+			}},
+		},
+	}
+	m, err := newManager(fakeStore)
+	a.NoError(err)
+
+	tests := []struct {
+		name           string
+		permission     Permission
+		user           *store.UserMessage
+		projectIDs     []string
+		customRoleOnly bool
+		want           bool
+	}{
+		// The custom role bound at the workspace grants each of its permissions.
+		{name: "workspace custom role first permission", permission: PermissionSettingsGet, user: auditor, want: true},
+		{name: "workspace custom role second permission", permission: PermissionInstancesList, user: auditor, want: true},
+		{name: "workspace custom role third permission", permission: PermissionDatabasesList, user: auditor, want: true},
+		{name: "workspace custom role other permission", permission: PermissionSettingsSet, user: auditor, want: false},
+		{name: "workspace custom role in project", permission: PermissionDatabasesList, user: auditor, projectIDs: []string{"p1"}, want: true},
+		// The custom role bound at the project grants the permission in that project only.
+		{name: "project custom role", permission: PermissionDatabasesQuery, user: member, projectIDs: []string{"p1"}, want: true},
+		{name: "project custom role in other project", permission: PermissionDatabasesQuery, user: member, projectIDs: []string{"p2"}, want: false},
+		{name: "project custom role not in every project", permission: PermissionDatabasesQuery, user: member, projectIDs: []string{"p1", "p2"}, want: false},
+		{name: "project custom role at workspace", permission: PermissionDatabasesQuery, user: member, want: false},
+		// The deleted or unknown role grants nothing.
+		{name: "deleted project role", permission: PermissionDatabasesQuery, user: member, projectIDs: []string{"p3"}, want: false},
+		{name: "unknown workspace role", permission: PermissionSettingsGet, user: &store.UserMessage{ID: 103, Roles: []api.Role{"unknown"}}, want: false},
+		// The predefined roles are skipped by the custom role check.
+		{name: "predefined role", permission: PermissionDatabasesList, user: member, projectIDs: []string{"p2"}, want: true},
+		{name: "predefined role custom only", permission: PermissionDatabasesList, user: member, projectIDs: []string{"p2"}, customRoleOnly: true, want: false},
+		{name: "project custom role custom only", permission: PermissionDatabasesQuery, user: member, projectIDs: []string{"p1"}, customRoleOnly: true, want: true},
+		{name: "workspace custom role custom only", permission: PermissionSettingsGet, user: auditor, customRoleOnly: true, want: true},
+		{name: "workspace predefined role custom only", permission: PermissionSettingsList, user: auditor, customRoleOnly: true, want: false},
+	}
+	for _, test := range tests {
+		var got bool
+		if test.customRoleOnly {
+			got, err = m.CheckCustomRolePermission(context.Background(), test.permission, test.user, test.projectIDs...)
+		} else {
+			got, err = m.CheckPermission(context.Background(), test.permission, test.user, test.projectIDs...)
+		}
+		a.NoError(err, test.name)
+		a.Equal(test.want, got, test.name)
+	}
+}
+
+func TestGetRolePermissions(t *testing.T) {
+	a := require.New(t)
+
+	m, err := newManager(&fakePolicyStore{
+		roles: map[string]*store.RoleMessage{
+			"custom": {
+				ResourceID:  "custom",
+				Permissions: &storepb.RolePermissions{Permissions: []string{string(PermissionIssuesList), string(PermissionIssuesGet)}},
+			},
+		},
+	})
+	a.NoError(err)
+
+	rolePermissions, err := m.getRolePermissions(context.Background(), []string{"roles/custom", "roles/workspaceMember"}, [][]string{{"roles/deleted"}})
+	a.NoError(err)
+	a.Equal([]Permission{PermissionIssuesList, PermissionIssuesGet}, rolePermissions["roles/custom"])
+	a.Equal(m.roles["roles/workspaceMember"], rolePermissions["roles/workspaceMember"])
+	a.Empty(rolePermissions["roles/deleted"])
+}
+
+func TestManagerPermissionExist(t *testing.T) {
+	a := require.New(t)
+
+	m, err := NewManager(nil)
+	a.NoError(err)
+
+	a.True(m.PermissionExist(PermissionDatabasesQuery))
+	a.True(m.PermissionExist(PermissionSettingsSet))
+	a.False(m.PermissionExist(Permission("bb.databases.drop")))
+	a.False(m.PermissionExist(Permission("")))
+}
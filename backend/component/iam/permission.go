@@ -117,6 +117,7 @@ const (
 	PermissionPlanCheckRunsList Permission = "bb.planCheckRuns.list"
 	PermissionPlanCheckRunsRun  Permission = "bb.planCheckRuns.run"
 	PermissionTasksRun          Permission = "bb.tasks.run"
+	PermissionTasksRunNow       Permission = "bb.tasks.runNow"
 	PermissionTasksSkip         Permission = "bb.tasks.skip"
 	PermissionTaskRunsCancel    Permission = "bb.taskRuns.cancel"
 
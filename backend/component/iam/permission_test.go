@@ -126,6 +126,7 @@ func permissionExist(p Permission) bool {
 		PermissionTaskRunsCancel,
 		PermissionTaskRunsList,
 		PermissionTasksRun,
+		PermissionTasksRunNow,
 		PermissionTasksSkip:
 		return true
 	default:
@@ -0,0 +1,101 @@
+// Package leader elects the leader among the server replicas sharing the metadata database.
+// The leader runs the singleton runners, which would otherwise do their work once per replica.
+package leader
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// leaderLockKey is the key of the advisory lock held by the leader.
+	leaderLockKey int64 = 0x6279746562617365 // "bytebase"
+	// electionInterval is the interval for the followers to campaign, and for the leader to check its lock.
+	// A leader which lost its connection keeps its runners running for up to the interval.
+	electionInterval = 5 * time.Second
+)
+
+// Runner is the singleton runner run by the leader only.
+type Runner interface {
+	Run(ctx context.Context, wg *sync.WaitGroup)
+}
+
+// Elector campaigns for the leadership, and runs the registered runners while the replica is the leader.
+type Elector struct {
+	store   *store.Store
+	runners []Runner
+}
+
+// NewElector creates a new elector.
+func NewElector(store *store.Store) *Elector {
+	return &Elector{
+		store: store,
+	}
+}
+
+// Register registers a singleton runner.
+func (e *Elector) Register(runner Runner) {
+	e.runners = append(e.runners, runner)
+}
+
+// Run campaigns for the leadership periodically, and runs the registered runners until the leadership is lost.
+func (e *Elector) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(electionInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug("Leader elector started", slog.Duration("interval", electionInterval))
+	for {
+		e.campaign(ctx, ticker)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+// campaign tries to acquire the leader lock, and leads until the lock is lost or the context is canceled.
+func (e *Elector) campaign(ctx context.Context, ticker *time.Ticker) {
+	lock, err := e.store.TryAdvisoryLock(ctx, leaderLockKey)
+	if err != nil {
+		slog.Error("Failed to acquire the leader lock", log.BBError(err))
+		return
+	}
+	if lock == nil {
+		return
+	}
+	defer func() {
+		if err := lock.Release(); err != nil {
+			slog.Error("Failed to release the leader lock", log.BBError(err))
+		}
+	}()
+
+	slog.Info("The replica becomes the leader", slog.Int("runners", len(e.runners)))
+	leaderCtx, cancel := context.WithCancel(ctx)
+	var runnerWg sync.WaitGroup
+	for _, runner := range e.runners {
+		runnerWg.Add(1)
+		go runner.Run(leaderCtx, &runnerWg)
+	}
+	defer func() {
+		cancel()
+		runnerWg.Wait()
+	}()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := lock.Check(ctx); err != nil {
+				slog.Warn("The replica lost the leadership", log.BBError(err))
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,326 @@
+package masker
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// defaultFullMaskSubstitution is the substitution of the data which cannot be masked by the algorithm.
+const defaultFullMaskSubstitution = "******"
+
+// feistelRounds is the number of the Feistel rounds of the format-preserving encryption, same as FF1.
+const feistelRounds = 10
+
+// dateLayouts are the layouts of the date values that can be shifted, in the order of trying.
+var dateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// FormatPreservingMasker is the masker that encrypts the digits of the data with the key,
+// the length and the other characters are kept, so the masked credit card and phone numbers are still well-formed.
+type FormatPreservingMasker struct {
+	key              string
+	keepSuffixDigits int32
+}
+
+// NewFormatPreservingMasker returns a new FormatPreservingMasker.
+func NewFormatPreservingMasker(key string, keepSuffixDigits int32) *FormatPreservingMasker {
+	return &FormatPreservingMasker{
+		key:              key,
+		keepSuffixDigits: keepSuffixDigits,
+	}
+}
+
+// Mask implements Masker.Mask.
+func (m *FormatPreservingMasker) Mask(data *MaskData) *v1pb.RowValue {
+	return stringMask(m, data, m.encrypt)
+}
+
+// Equal implements Masker.Equal.
+func (m *FormatPreservingMasker) Equal(other Masker) bool {
+	if otherMasker, ok := other.(*FormatPreservingMasker); ok {
+		return m.key == otherMasker.key && m.keepSuffixDigits == otherMasker.keepSuffixDigits
+	}
+	return false
+}
+
+func (m *FormatPreservingMasker) encrypt(s string) string {
+	runes := []rune(s)
+	var positions []int
+	for i, r := range runes {
+		if r >= '0' && r <= '9' {
+			positions = append(positions, i)
+		}
+	}
+	keep := min(max(int(m.keepSuffixDigits), 0), len(positions))
+	positions = positions[:len(positions)-keep]
+
+	digits := make([]byte, 0, len(positions))
+	for _, position := range positions {
+		digits = append(digits, byte(runes[position]-'0'))
+	}
+	for i, digit := range feistelEncrypt([]byte(m.key), digits) {
+		runes[positions[i]] = rune('0' + digit)
+	}
+	return string(runes)
+}
+
+// feistelEncrypt encrypts the decimal digits with a balanced Feistel network in the way of FF1,
+// the round function is the HMAC-SHA256 of the key.
+func feistelEncrypt(key []byte, digits []byte) []byte {
+	n := len(digits)
+	if n == 0 {
+		return nil
+	}
+	if n == 1 {
+		c := new(big.Int).Add(big.NewInt(int64(digits[0])), feistelRound(key, n, 0, nil))
+		return []byte{byte(c.Mod(c, big.NewInt(10)).Int64())}
+	}
+
+	u, v := n/2, n-n/2
+	a, b := digits[:u], digits[u:]
+	for i := 0; i < feistelRounds; i++ {
+		length := u
+		if i%2 == 1 {
+			length = v
+		}
+		modulus := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(length)), nil)
+		c := new(big.Int).Add(digitsToInt(a), feistelRound(key, n, i, b))
+		c.Mod(c, modulus)
+		a, b = b, intToDigits(c, length)
+	}
+	return append(append([]byte{}, a...), b...)
+}
+
+func feistelRound(key []byte, n, round int, digits []byte) *big.Int {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte{byte(n), byte(round)})
+	_, _ = h.Write(digits)
+	return new(big.Int).SetBytes(h.Sum(nil))
+}
+
+func digitsToInt(digits []byte) *big.Int {
+	result := new(big.Int)
+	ten := big.NewInt(10)
+	for _, digit := range digits {
+		result.Mul(result, ten)
+		result.Add(result, big.NewInt(int64(digit)))
+	}
+	return result
+}
+
+func intToDigits(value *big.Int, length int) []byte {
+	digits := make([]byte, length)
+	v := new(big.Int).Set(value)
+	ten := big.NewInt(10)
+	remainder := new(big.Int)
+	for i := length - 1; i >= 0; i-- {
+		v.DivMod(v, ten, remainder)
+		digits[i] = byte(remainder.Int64())
+	}
+	return digits
+}
+
+// DeterministicHashMasker is the masker that masks the data with their HMAC-SHA256 hash of the per-workspace salt,
+// the same data is always masked to the same hash, so the joins on the masked columns still work.
+type DeterministicHashMasker struct {
+	salt string
+}
+
+// NewDeterministicHashMasker returns a new DeterministicHashMasker.
+func NewDeterministicHashMasker(salt string) *DeterministicHashMasker {
+	return &DeterministicHashMasker{
+		salt: salt,
+	}
+}
+
+// Mask implements Masker.Mask.
+func (m *DeterministicHashMasker) Mask(data *MaskData) *v1pb.RowValue {
+	return stringMask(m, data, func(s string) string {
+		h := hmac.New(sha256.New, []byte(m.salt))
+		_, _ = h.Write([]byte(s))
+		return fmt.Sprintf("%x", h.Sum(nil))
+	})
+}
+
+// Equal implements Masker.Equal.
+func (m *DeterministicHashMasker) Equal(other Masker) bool {
+	if otherMasker, ok := other.(*DeterministicHashMasker); ok {
+		return m.salt == otherMasker.salt
+	}
+	return false
+}
+
+// DateShiftMasker is the masker that shifts the dates by [-maxDays, maxDays] days.
+// The number of days is derived from the data and the per-workspace salt, so the same date is always shifted to the same date.
+type DateShiftMasker struct {
+	salt    string
+	maxDays int32
+}
+
+// NewDateShiftMasker returns a new DateShiftMasker.
+func NewDateShiftMasker(salt string, maxDays int32) *DateShiftMasker {
+	return &DateShiftMasker{
+		salt:    salt,
+		maxDays: maxDays,
+	}
+}
+
+// Mask implements Masker.Mask.
+func (m *DateShiftMasker) Mask(data *MaskData) *v1pb.RowValue {
+	return stringMask(m, data, m.shift)
+}
+
+// Equal implements Masker.Equal.
+func (m *DateShiftMasker) Equal(other Masker) bool {
+	if otherMasker, ok := other.(*DateShiftMasker); ok {
+		return m.salt == otherMasker.salt && m.maxDays == otherMasker.maxDays
+	}
+	return false
+}
+
+func (m *DateShiftMasker) shift(s string) string {
+	for _, layout := range dateLayouts {
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			continue
+		}
+		if m.maxDays <= 0 {
+			return s
+		}
+		h := hmac.New(sha256.New, []byte(m.salt))
+		_, _ = h.Write([]byte(s))
+		window := uint64(m.maxDays)*2 + 1
+		days := int(binary.BigEndian.Uint64(h.Sum(nil))%window) - int(m.maxDays)
+		return t.AddDate(0, 0, days).Format(layout)
+	}
+	// The data is not a date, mask it fully to avoid leaking.
+	return defaultFullMaskSubstitution
+}
+
+// RegexMasker is the masker that replaces the parts of the data matching the pattern with the substitution.
+type RegexMasker struct {
+	pattern      *regexp.Regexp
+	substitution string
+}
+
+// NewRegexMasker returns a new RegexMasker.
+func NewRegexMasker(pattern, substitution string) (*RegexMasker, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return &RegexMasker{
+		pattern:      re,
+		substitution: substitution,
+	}, nil
+}
+
+// Mask implements Masker.Mask.
+func (m *RegexMasker) Mask(data *MaskData) *v1pb.RowValue {
+	return stringMask(m, data, func(s string) string {
+		return m.pattern.ReplaceAllString(s, m.substitution)
+	})
+}
+
+// Equal implements Masker.Equal.
+func (m *RegexMasker) Equal(other Masker) bool {
+	if otherMasker, ok := other.(*RegexMasker); ok {
+		return m.pattern.String() == otherMasker.pattern.String() && m.substitution == otherMasker.substitution
+	}
+	return false
+}
+
+// stringMask masks the string representation of the data with f, the masked data is always a string.
+// The NULL values are kept, and the bool values are masked fully because their string representation is too short to mask.
+func stringMask(m Masker, data *MaskData, f func(string) string) *v1pb.RowValue {
+	stringValue := func(s string) *v1pb.RowValue {
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_StringValue{
+				StringValue: f(s),
+			},
+		}
+	}
+	nullValue := &v1pb.RowValue{
+		Kind: &v1pb.RowValue_NullValue{
+			NullValue: structpb.NullValue_NULL_VALUE,
+		},
+	}
+	fullMaskValue := &v1pb.RowValue{
+		Kind: &v1pb.RowValue_StringValue{
+			StringValue: defaultFullMaskSubstitution,
+		},
+	}
+
+	if data.Data != nil {
+		switch raw := data.Data.(type) {
+		case *sql.NullBool:
+			if raw.Valid {
+				return fullMaskValue
+			}
+		case *sql.NullString:
+			if raw.Valid {
+				return stringValue(raw.String)
+			}
+		case *sql.NullInt32:
+			if raw.Valid {
+				return stringValue(strconv.FormatInt(int64(raw.Int32), 10))
+			}
+		case *sql.NullInt64:
+			if raw.Valid {
+				return stringValue(strconv.FormatInt(raw.Int64, 10))
+			}
+		case *sql.NullFloat64:
+			if raw.Valid {
+				return stringValue(strconv.FormatFloat(raw.Float64, 'f', -1, 64))
+			}
+		}
+		return nullValue
+	}
+
+	switch kind := data.DataV2.Kind.(type) {
+	case *v1pb.RowValue_NullValue:
+		//nolint
+		return proto.Clone(data.DataV2).(*v1pb.RowValue)
+	case *v1pb.RowValue_BoolValue:
+		return fullMaskValue
+	case *v1pb.RowValue_BytesValue:
+		return stringValue(string(kind.BytesValue))
+	case *v1pb.RowValue_DoubleValue:
+		return stringValue(strconv.FormatFloat(kind.DoubleValue, 'f', -1, 64))
+	case *v1pb.RowValue_FloatValue:
+		return stringValue(strconv.FormatFloat(float64(kind.FloatValue), 'f', -1, 64))
+	case *v1pb.RowValue_Int32Value:
+		return stringValue(strconv.FormatInt(int64(kind.Int32Value), 10))
+	case *v1pb.RowValue_Int64Value:
+		return stringValue(strconv.FormatInt(kind.Int64Value, 10))
+	case *v1pb.RowValue_StringValue:
+		return stringValue(kind.StringValue)
+	case *v1pb.RowValue_Uint32Value:
+		return stringValue(strconv.FormatUint(uint64(kind.Uint32Value), 10))
+	case *v1pb.RowValue_Uint64Value:
+		return stringValue(strconv.FormatUint(kind.Uint64Value, 10))
+	case *v1pb.RowValue_ValueValue:
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_ValueValue{
+				ValueValue: maskProtoValue(m, kind.ValueValue),
+			},
+		}
+	}
+	return fullMaskValue
+}
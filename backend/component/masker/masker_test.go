@@ -3,6 +3,7 @@ package masker
 import (
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -151,3 +152,79 @@ func TestRangeMask(t *testing.T) {
 		a.Equal(tc.want, got, "description: %s", tc.description)
 	}
 }
+
+func TestFormatPreservingMask(t *testing.T) {
+	a := require.New(t)
+	m := NewFormatPreservingMasker("key", 4)
+
+	masked := m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "4111-1111-1111-1234"}}}).GetStringValue()
+	a.Regexp(`^\d{4}-\d{4}-\d{4}-1234$`, masked)
+	a.NotEqual("4111-1111-1111-1234", masked)
+	// The masking is deterministic.
+	a.Equal(masked, m.Mask(&MaskData{Data: &sql.NullString{String: "4111-1111-1111-1234", Valid: true}}).GetStringValue())
+	// Different keys encrypt the digits differently.
+	a.NotEqual(masked, NewFormatPreservingMasker("another key", 4).Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "4111-1111-1111-1234"}}}).GetStringValue())
+
+	phone := NewFormatPreservingMasker("key", 0).Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "+1 (555) 010-9999"}}}).GetStringValue()
+	a.Regexp(`^\+\d \(\d{3}\) \d{3}-\d{4}$`, phone)
+
+	// The encryption is a permutation of the digits of the same length.
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		masked := NewFormatPreservingMasker("key", 0).Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: int32(i)}}}).GetStringValue()
+		if i < 10 {
+			a.Len(masked, 1)
+		} else {
+			a.Len(masked, 2)
+		}
+		a.False(seen[masked], "duplicate masked value %q", masked)
+		seen[masked] = true
+	}
+}
+
+func TestDeterministicHashMask(t *testing.T) {
+	a := require.New(t)
+	m := NewDeterministicHashMasker("salt")
+
+	masked := m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: 42}}})
+	a.Len(masked.GetStringValue(), 64)
+	// The same value in different types is masked to the same hash, so the joins still work.
+	a.Equal(masked.GetStringValue(), m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "42"}}}).GetStringValue())
+	a.Equal(masked.GetStringValue(), m.Mask(&MaskData{Data: &sql.NullInt64{Int64: 42, Valid: true}}).GetStringValue())
+	a.NotEqual(masked.GetStringValue(), NewDeterministicHashMasker("another salt").Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: 42}}}).GetStringValue())
+	a.IsType(&v1pb.RowValue_NullValue{}, m.Mask(&MaskData{Data: &sql.NullString{}}).Kind)
+}
+
+func TestDateShiftMask(t *testing.T) {
+	a := require.New(t)
+	m := NewDateShiftMasker("salt", 30)
+
+	for _, tc := range []struct {
+		input  string
+		layout string
+	}{
+		{input: "2023-05-17", layout: "2006-01-02"},
+		{input: "2023-05-17 08:30:00", layout: "2006-01-02 15:04:05"},
+		{input: "2023-05-17T08:30:00Z", layout: time.RFC3339},
+	} {
+		masked := m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: tc.input}}}).GetStringValue()
+		original, err := time.Parse(tc.layout, tc.input)
+		a.NoError(err)
+		shifted, err := time.Parse(tc.layout, masked)
+		a.NoError(err, "masked value %q", masked)
+		a.LessOrEqual(shifted.Sub(original).Abs(), 30*24*time.Hour)
+		a.Equal(masked, m.Mask(&MaskData{Data: &sql.NullString{String: tc.input, Valid: true}}).GetStringValue())
+	}
+	a.Equal("******", m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "not a date"}}}).GetStringValue())
+}
+
+func TestRegexMask(t *testing.T) {
+	a := require.New(t)
+	m, err := NewRegexMasker(`^([^@]{2})[^@]*`, "${1}***")
+	a.NoError(err)
+	a.Equal("jo***@example.com", m.Mask(&MaskData{DataV2: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "john.doe@example.com"}}}).GetStringValue())
+	a.Equal("jo***@example.com", m.Mask(&MaskData{Data: &sql.NullString{String: "john.doe@example.com", Valid: true}}).GetStringValue())
+
+	_, err = NewRegexMasker(`(`, "")
+	a.Error(err)
+}
@@ -0,0 +1,97 @@
+package maskingpolicy
+
+import (
+	"reflect"
+
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// EvaluateLevel evaluates the masking level of the column before applying the masking exceptions.
+// The masking level in the masking policies resolved by the resolver takes precedence,
+// otherwise the first global masking rule matching the column decides the level.
+// It returns NONE if the column is not masked.
+func EvaluateLevel(database *store.DatabaseMessage, schemaName, tableName, columnName, classificationLevel string, resolver *Resolver, maskingRules []*storepb.MaskingRulePolicy_MaskingRule) (storepb.MaskingLevel, error) {
+	level := storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED
+	maskData := resolver.Resolve(schemaName, tableName, columnName)
+	if maskData != nil && maskData.MaskingLevel != storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED {
+		level = maskData.MaskingLevel
+	} else {
+		// If the column has DEFAULT masking level in the masking policies or not set yet,
+		// we will eval the masking rules to get the masking level.
+		for _, maskingRule := range maskingRules {
+			pass, err := evaluateMaskingRuleCondition(maskingRule.Condition.Expression, map[string]any{
+				"environment_id":       database.EffectiveEnvironmentID,
+				"project_id":           database.ProjectID,
+				"instance_id":          database.InstanceID,
+				"database_name":        database.DatabaseName,
+				"schema_name":          schemaName,
+				"table_name":           tableName,
+				"column_name":          columnName,
+				"classification_level": classificationLevel,
+			})
+			if err != nil {
+				return storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED, errors.Wrapf(err, "failed to evaluate masking rule policy condition")
+			}
+			if pass {
+				level = maskingRule.MaskingLevel
+				break
+			}
+		}
+	}
+	if level == storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED {
+		return storepb.MaskingLevel_NONE, nil
+	}
+	return level, nil
+}
+
+// GetClassificationLevel returns the level of the column classification in the data classification config of the project,
+// or empty if the column is not classified.
+func GetClassificationLevel(columnClassificationID string, classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig) string {
+	if columnClassificationID == "" || classificationConfig == nil {
+		return ""
+	}
+	classification, ok := classificationConfig.Classification[columnClassificationID]
+	if !ok {
+		return ""
+	}
+	if classification.LevelId == nil {
+		return ""
+	}
+	return *classification.LevelId
+}
+
+func evaluateMaskingRuleCondition(expression string, attributes map[string]any) (bool, error) {
+	if expression == "" {
+		return true, nil
+	}
+	maskingRulePolicyEnv, err := cel.NewEnv(common.MaskingRulePolicyCELAttributes...)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to create CEL environment for masking rule policy")
+	}
+	ast, issues := maskingRulePolicyEnv.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return false, errors.Wrapf(issues.Err(), "failed to get the ast of CEL program for masking rule")
+	}
+	prg, err := maskingRulePolicyEnv.Program(ast)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to create CEL program for masking rule")
+	}
+	out, _, err := prg.Eval(attributes)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to eval CEL program for masking rule")
+	}
+	val, err := out.ConvertToNative(reflect.TypeOf(false))
+	if err != nil {
+		return false, errors.Wrap(err, "expect bool result for masking rule")
+	}
+	boolVar, ok := val.(bool)
+	if !ok {
+		return false, errors.Wrap(err, "expect bool result for masking rule")
+	}
+	return boolVar, nil
+}
@@ -0,0 +1,73 @@
+package maskingpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/expr"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestEvaluateLevel(t *testing.T) {
+	a := require.New(t)
+
+	database := &store.DatabaseMessage{
+		EffectiveEnvironmentID: "prod",
+		ProjectID:              "hr",
+		InstanceID:             "mysql",
+		DatabaseName:           "employee",
+	}
+	resolver := NewResolver("employee", nil, &storepb.MaskingPolicy{MaskData: []*storepb.MaskData{
+		{Table: "salary", Column: "amount", MaskingLevel: storepb.MaskingLevel_FULL},
+		{Table: "salary", Column: "bonus", MaskingLevel: storepb.MaskingLevel_NONE},
+		{Table: "salary", Column: "grade", MaskingLevel: storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED},
+	}})
+	maskingRules := []*storepb.MaskingRulePolicy_MaskingRule{
+		{
+			Condition:    &expr.Expr{Expression: `classification_level in ["S2"]`},
+			MaskingLevel: storepb.MaskingLevel_PARTIAL,
+		},
+		{
+			Condition:    &expr.Expr{Expression: `environment_id == "prod" && column_name == "grade"`},
+			MaskingLevel: storepb.MaskingLevel_FULL,
+		},
+	}
+
+	tests := []struct {
+		column              string
+		classificationLevel string
+		want                storepb.MaskingLevel
+	}{
+		// The masking policy takes precedence over the masking rules.
+		{column: "amount", classificationLevel: "S2", want: storepb.MaskingLevel_FULL},
+		{column: "bonus", classificationLevel: "S2", want: storepb.MaskingLevel_NONE},
+		// The default masking level in the masking policy falls back to the masking rules.
+		{column: "grade", want: storepb.MaskingLevel_FULL},
+		{column: "level", classificationLevel: "S2", want: storepb.MaskingLevel_PARTIAL},
+		{column: "level", classificationLevel: "S1", want: storepb.MaskingLevel_NONE},
+	}
+	for _, test := range tests {
+		got, err := EvaluateLevel(database, "", "salary", test.column, test.classificationLevel, resolver, maskingRules)
+		a.NoError(err)
+		a.Equal(test.want, got, test.column)
+	}
+}
+
+func TestGetClassificationLevel(t *testing.T) {
+	a := require.New(t)
+
+	levelID := "S2"
+	config := &storepb.DataClassificationSetting_DataClassificationConfig{
+		Classification: map[string]*storepb.DataClassificationSetting_DataClassificationConfig_DataClassification{
+			"1-1": {Id: "1-1", LevelId: &levelID},
+			"1-2": {Id: "1-2"},
+		},
+	}
+	a.Equal("S2", GetClassificationLevel("1-1", config))
+	a.Equal("", GetClassificationLevel("1-2", config))
+	a.Equal("", GetClassificationLevel("1-3", config))
+	a.Equal("", GetClassificationLevel("", config))
+	a.Equal("", GetClassificationLevel("1-1", nil))
+}
@@ -0,0 +1,59 @@
+package maskingpolicy
+
+import (
+	"log/slog"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/masker"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// NewMasker returns the masker of the masking algorithm, or the default masker of the masking level if the algorithm is nil.
+// The maskingSalt is the per-workspace salt of the deterministic masking algorithms.
+func NewMasker(algorithm *storepb.MaskingAlgorithmSetting_Algorithm, level storepb.MaskingLevel, maskingSalt string) masker.Masker {
+	if algorithm == nil {
+		switch level {
+		case storepb.MaskingLevel_FULL:
+			return masker.NewDefaultFullMasker()
+		case storepb.MaskingLevel_PARTIAL:
+			return masker.NewDefaultRangeMasker()
+		default:
+			return masker.NewNoneMasker()
+		}
+	}
+
+	switch m := algorithm.Mask.(type) {
+	case *storepb.MaskingAlgorithmSetting_Algorithm_FullMask_:
+		return masker.NewFullMasker(m.FullMask.Substitution)
+	case *storepb.MaskingAlgorithmSetting_Algorithm_RangeMask_:
+		return masker.NewRangeMasker(convertRangeMaskSlices(m.RangeMask.Slices))
+	case *storepb.MaskingAlgorithmSetting_Algorithm_Md5Mask:
+		return masker.NewMD5Masker(m.Md5Mask.Salt)
+	case *storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_:
+		return masker.NewFormatPreservingMasker(m.FormatPreservingMask.Key, m.FormatPreservingMask.KeepSuffixDigits)
+	case *storepb.MaskingAlgorithmSetting_Algorithm_DeterministicHashMask_:
+		return masker.NewDeterministicHashMasker(maskingSalt)
+	case *storepb.MaskingAlgorithmSetting_Algorithm_DateShiftMask_:
+		return masker.NewDateShiftMasker(maskingSalt, m.DateShiftMask.MaxDays)
+	case *storepb.MaskingAlgorithmSetting_Algorithm_RegexMask_:
+		regexMasker, err := masker.NewRegexMasker(m.RegexMask.Pattern, m.RegexMask.Substitution)
+		if err != nil {
+			slog.Warn("invalid regex masking algorithm pattern", slog.String("algorithm", algorithm.Id), log.BBError(err))
+			return masker.NewDefaultFullMasker()
+		}
+		return regexMasker
+	}
+	return masker.NewNoneMasker()
+}
+
+func convertRangeMaskSlices(slices []*storepb.MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) []*masker.MaskRangeSlice {
+	var result []*masker.MaskRangeSlice
+	for _, slice := range slices {
+		result = append(result, &masker.MaskRangeSlice{
+			Start:        slice.Start,
+			End:          slice.End,
+			Substitution: slice.Substitution,
+		})
+	}
+	return result
+}
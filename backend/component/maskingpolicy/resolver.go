@@ -0,0 +1,98 @@
+// Package maskingpolicy resolves the masking of the columns from the masking policies of the projects and databases.
+package maskingpolicy
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+type columnKey struct {
+	schema string
+	table  string
+	column string
+}
+
+// Resolver resolves the mask data of the columns in a database.
+// The mask data of a column in the masking policy of the database overrides the wildcard rules of the project,
+// and the first matched wildcard rule wins if the column matches several rules.
+type Resolver struct {
+	overrides map[columnKey]*storepb.MaskData
+	rules     []*storepb.MaskData
+}
+
+// NewResolver creates a resolver of the database from the masking policies of its project and itself.
+// The wildcard rules of the project not matching the database are dropped.
+func NewResolver(databaseName string, projectPolicy, databasePolicy *storepb.MaskingPolicy) *Resolver {
+	r := &Resolver{
+		overrides: make(map[columnKey]*storepb.MaskData),
+	}
+	for _, maskData := range projectPolicy.GetMaskData() {
+		if Match(maskData.Database, databaseName) {
+			r.rules = append(r.rules, maskData)
+		}
+	}
+	for _, maskData := range databasePolicy.GetMaskData() {
+		r.overrides[columnKey{
+			schema: maskData.Schema,
+			table:  maskData.Table,
+			column: maskData.Column,
+		}] = maskData
+	}
+	return r
+}
+
+// Load loads the masking policies of the database and its project, and creates the resolver of the database.
+func Load(ctx context.Context, stores *store.Store, project *store.ProjectMessage, database *store.DatabaseMessage) (*Resolver, error) {
+	projectPolicy, err := stores.GetMaskingPolicyByProjectUID(ctx, project.UID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find masking policy for project %q", project.ResourceID)
+	}
+	databasePolicy, err := stores.GetMaskingPolicyByDatabaseUID(ctx, database.UID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find masking policy for database %q", database.DatabaseName)
+	}
+	return NewResolver(database.DatabaseName, projectPolicy, databasePolicy), nil
+}
+
+// Resolve returns the mask data of the column, or nil if neither the database nor the project masks the column.
+func (r *Resolver) Resolve(schemaName, tableName, columnName string) *storepb.MaskData {
+	if r == nil {
+		return nil
+	}
+	if maskData, ok := r.overrides[columnKey{
+		schema: schemaName,
+		table:  tableName,
+		column: columnName,
+	}]; ok {
+		return maskData
+	}
+	for _, rule := range r.rules {
+		if Match(rule.Schema, schemaName) && Match(rule.Table, tableName) && Match(rule.Column, columnName) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// Match reports whether the name matches the case-insensitive wildcard pattern, the empty pattern matches everything.
+func Match(pattern, name string) bool {
+	if pattern == "" {
+		return true
+	}
+	matched, err := path.Match(strings.ToLower(pattern), strings.ToLower(name))
+	return err == nil && matched
+}
+
+// ValidatePattern validates the wildcard pattern.
+func ValidatePattern(pattern string) error {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return errors.Errorf("invalid pattern %q", pattern)
+	}
+	return nil
+}
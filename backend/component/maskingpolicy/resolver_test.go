@@ -0,0 +1,64 @@
+package maskingpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{pattern: "", name: "anything", want: true},
+		{pattern: "*_email", name: "work_email", want: true},
+		{pattern: "*_email", name: "WORK_EMAIL", want: true},
+		{pattern: "*_email", name: "email", want: false},
+		{pattern: "phone?", name: "phone1", want: true},
+		{pattern: "phone?", name: "phone12", want: false},
+		{pattern: "user", name: "users", want: false},
+		{pattern: "[", name: "[", want: false},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, Match(test.pattern, test.name), "%q %q", test.pattern, test.name)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	a := require.New(t)
+	projectPolicy := &storepb.MaskingPolicy{
+		MaskData: []*storepb.MaskData{
+			{Database: "hr_*", Column: "salary", MaskingLevel: storepb.MaskingLevel_FULL},
+			{Column: "*_email", MaskingLevel: storepb.MaskingLevel_PARTIAL, SemanticTypeId: "email"},
+			{Table: "users", Column: "*", MaskingLevel: storepb.MaskingLevel_FULL},
+		},
+	}
+	databasePolicy := &storepb.MaskingPolicy{
+		MaskData: []*storepb.MaskData{
+			{Schema: "public", Table: "employees", Column: "work_email", MaskingLevel: storepb.MaskingLevel_NONE},
+		},
+	}
+
+	r := NewResolver("hr_prod", projectPolicy, databasePolicy)
+	a.Equal(storepb.MaskingLevel_FULL, r.Resolve("public", "employees", "salary").MaskingLevel)
+	a.Equal("email", r.Resolve("public", "employees", "home_email").SemanticTypeId)
+	// The explicit column override wins over the wildcard rules.
+	a.Equal(storepb.MaskingLevel_NONE, r.Resolve("public", "employees", "work_email").MaskingLevel)
+	// The first matched rule wins.
+	a.Equal(storepb.MaskingLevel_PARTIAL, r.Resolve("public", "users", "login_email").MaskingLevel)
+	a.Equal(storepb.MaskingLevel_FULL, r.Resolve("public", "users", "name").MaskingLevel)
+	a.Nil(r.Resolve("public", "employees", "name"))
+
+	// The rules of other databases are dropped.
+	r = NewResolver("sales", projectPolicy, nil)
+	a.Nil(r.Resolve("public", "employees", "salary"))
+
+	var nilResolver *Resolver
+	a.Nil(nilResolver.Resolve("public", "employees", "salary"))
+}
@@ -0,0 +1,362 @@
+// Package metadatabackup backs up the Bytebase metadata database to the storage backend and restores it.
+package metadatabackup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/config"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	dbdriver "github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/storage/s3"
+)
+
+const (
+	// pathPrefix is the storage path prefix of the metadata backups.
+	pathPrefix = "metadata-backup"
+	// namePrefix and nameSuffix wrap the backup time in the backup names, so that the names are sorted by the backup time.
+	namePrefix = "bytebase-metadata-"
+	nameSuffix = ".sql.gz"
+	// nameTimeLayout is the layout of the backup time in the backup names.
+	nameTimeLayout = "20060102T150405Z"
+)
+
+// Manager is the manager backing up and restoring the metadata database.
+type Manager struct {
+	profile  *config.Profile
+	pgBinDir string
+	connCfg  dbdriver.ConnectionConfig
+	s3Client *s3.Client
+}
+
+// NewManager creates a new metadata backup manager.
+// The backups are stored in the S3 bucket if s3Client is not nil, otherwise in the data directory.
+func NewManager(profile *config.Profile, pgBinDir string, connCfg dbdriver.ConnectionConfig, s3Client *s3.Client) *Manager {
+	return &Manager{
+		profile:  profile,
+		pgBinDir: pgBinDir,
+		connCfg:  connCfg,
+		s3Client: s3Client,
+	}
+}
+
+// Run backs up the metadata database every MetadataBackupInterval and purges the backups beyond the MetadataBackupRetention.
+func (m *Manager) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	interval := m.profile.MetadataBackupInterval
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	slog.Debug("Metadata backup runner started", slog.Duration("interval", interval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Metadata backup runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				m.runOnce(ctx, interval)
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (m *Manager) runOnce(ctx context.Context, interval time.Duration) {
+	names, err := m.List(ctx)
+	if err != nil {
+		slog.Error("Failed to list the metadata backups", log.BBError(err))
+		return
+	}
+	// The replicas sharing the metadata database and the storage backend take turns to back up.
+	if len(names) > 0 {
+		if latest, ok := parseBackupTime(names[len(names)-1]); ok && time.Since(latest) < interval/2 {
+			return
+		}
+	}
+	name, err := m.Backup(ctx)
+	if err != nil {
+		slog.Error("Failed to back up the metadata database", log.BBError(err))
+		return
+	}
+	slog.Info("Backed up the metadata database", slog.String("backup", name))
+	if retention := m.profile.MetadataBackupRetention; retention > 0 {
+		if err := m.Purge(ctx, retention); err != nil {
+			slog.Error("Failed to purge the expired metadata backups", log.BBError(err))
+		}
+	}
+}
+
+// Backup dumps the metadata database to the storage backend and returns the backup name.
+func (m *Manager) Backup(ctx context.Context) (string, error) {
+	name := getBackupName(time.Now())
+	// The dump is written to a temporary file first, so that a failed dump never leaves a partial backup in the storage backend.
+	tmpFile, err := os.CreateTemp(m.profile.DataDir, name+".*.tmp")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create the temporary backup file")
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+
+	gzipWriter := gzip.NewWriter(tmpFile)
+	if err := m.dump(ctx, gzipWriter); err != nil {
+		return "", err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return "", errors.Wrap(err, "failed to compress the metadata dump")
+	}
+	if _, err := tmpFile.Seek(0, io.SeekStart); err != nil {
+		return "", errors.Wrap(err, "failed to rewind the temporary backup file")
+	}
+
+	path := getBackupPath(name)
+	switch m.getStorageBackend() {
+	case api.BackupStorageBackendLocal:
+		absPath := filepath.Join(m.profile.DataDir, path)
+		if err := os.MkdirAll(filepath.Dir(absPath), os.ModePerm); err != nil {
+			return "", errors.Wrapf(err, "failed to create the directory of backup %q", absPath)
+		}
+		if err := tmpFile.Close(); err != nil {
+			return "", errors.Wrap(err, "failed to close the temporary backup file")
+		}
+		if err := os.Rename(tmpFile.Name(), absPath); err != nil {
+			return "", errors.Wrapf(err, "failed to move the backup to %q", absPath)
+		}
+	case api.BackupStorageBackendS3:
+		if _, err := m.s3Client.UploadObject(ctx, path, tmpFile); err != nil {
+			return "", errors.Wrapf(err, "failed to upload backup %q to S3", path)
+		}
+	}
+	return name, nil
+}
+
+// List lists the backup names from the oldest to the latest.
+func (m *Manager) List(ctx context.Context) ([]string, error) {
+	var names []string
+	switch m.getStorageBackend() {
+	case api.BackupStorageBackendLocal:
+		entries, err := os.ReadDir(filepath.Join(m.profile.DataDir, pathPrefix))
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, nil
+			}
+			return nil, errors.Wrap(err, "failed to list the local metadata backups")
+		}
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+	case api.BackupStorageBackendS3:
+		objects, err := m.s3Client.ListObjects(ctx, pathPrefix+"/")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list the metadata backups in S3")
+		}
+		for _, object := range objects {
+			if object.Key != nil {
+				names = append(names, strings.TrimPrefix(*object.Key, pathPrefix+"/"))
+			}
+		}
+	}
+	return filterBackupNames(names), nil
+}
+
+// Restore restores the metadata database from the backup.
+// The restore replaces all the data in the metadata database, the Bytebase servers using the database must be stopped.
+func (m *Manager) Restore(ctx context.Context, name string) error {
+	if _, ok := parseBackupTime(name); !ok {
+		return errors.Errorf("invalid metadata backup name %q", name)
+	}
+	path := getBackupPath(name)
+	var reader io.Reader
+	switch m.getStorageBackend() {
+	case api.BackupStorageBackendLocal:
+		file, err := os.Open(filepath.Join(m.profile.DataDir, path))
+		if err != nil {
+			return errors.Wrapf(err, "failed to open backup %q", name)
+		}
+		defer file.Close()
+		reader = file
+	case api.BackupStorageBackendS3:
+		tmpFile, err := os.CreateTemp(m.profile.DataDir, name+".*.tmp")
+		if err != nil {
+			return errors.Wrap(err, "failed to create the temporary backup file")
+		}
+		defer os.Remove(tmpFile.Name())
+		defer tmpFile.Close()
+		if _, err := m.s3Client.DownloadObject(ctx, path, tmpFile); err != nil {
+			return errors.Wrapf(err, "failed to download backup %q from S3", path)
+		}
+		reader = tmpFile
+	}
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decompress backup %q", name)
+	}
+	defer gzipReader.Close()
+	return m.restore(ctx, gzipReader)
+}
+
+// Purge deletes the backups except the latest retention ones.
+func (m *Manager) Purge(ctx context.Context, retention int) error {
+	names, err := m.List(ctx)
+	if err != nil {
+		return err
+	}
+	if len(names) <= retention {
+		return nil
+	}
+	expired := names[:len(names)-retention]
+	switch m.getStorageBackend() {
+	case api.BackupStorageBackendLocal:
+		for _, name := range expired {
+			if err := os.Remove(filepath.Join(m.profile.DataDir, getBackupPath(name))); err != nil {
+				return errors.Wrapf(err, "failed to delete backup %q", name)
+			}
+		}
+	case api.BackupStorageBackendS3:
+		var paths []string
+		for _, name := range expired {
+			paths = append(paths, getBackupPath(name))
+		}
+		if _, err := m.s3Client.DeleteObjects(ctx, paths...); err != nil {
+			return errors.Wrap(err, "failed to delete the expired metadata backups in S3")
+		}
+	}
+	slog.Debug("Purged the expired metadata backups", slog.Int("count", len(expired)))
+	return nil
+}
+
+func (m *Manager) getStorageBackend() api.BackupStorageBackend {
+	if m.s3Client != nil {
+		return api.BackupStorageBackendS3
+	}
+	return api.BackupStorageBackendLocal
+}
+
+// dump dumps the metadata database with pg_dump in the plain SQL format.
+func (m *Manager) dump(ctx context.Context, out io.Writer) error {
+	args := append(m.getConnectionArgs(),
+		// The objects are owned by the user restoring the backup.
+		"--no-owner",
+		"--no-privileges",
+	)
+	cmd := exec.CommandContext(ctx, filepath.Join(m.pgBinDir, "pg_dump"), args...)
+	cmd.Env = m.getEnv()
+	cmd.Stdout = out
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "failed to dump the metadata database: %s", stderr.String())
+	}
+	return nil
+}
+
+// restore replaces the public schema with the dump in a single transaction, so that the database is untouched if the restore fails.
+func (m *Manager) restore(ctx context.Context, in io.Reader) error {
+	args := append(m.getConnectionArgs(),
+		"--single-transaction",
+		"--quiet",
+		"--set=ON_ERROR_STOP=1",
+		// The tables created after the backup are dropped as well.
+		"--command=DROP SCHEMA IF EXISTS public CASCADE; CREATE SCHEMA public;",
+		"--file=-",
+	)
+	cmd := exec.CommandContext(ctx, filepath.Join(m.pgBinDir, "psql"), args...)
+	cmd.Env = m.getEnv()
+	cmd.Stdin = in
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "failed to restore the metadata database: %s", stderr.String())
+	}
+	return nil
+}
+
+func (m *Manager) getConnectionArgs() []string {
+	args := []string{
+		fmt.Sprintf("--host=%s", m.connCfg.Host),
+		fmt.Sprintf("--port=%s", m.connCfg.Port),
+		fmt.Sprintf("--username=%s", m.connCfg.Username),
+		fmt.Sprintf("--dbname=%s", m.connCfg.Database),
+	}
+	if m.connCfg.Password == "" {
+		args = append(args, "--no-password")
+	}
+	return args
+}
+
+func (m *Manager) getEnv() []string {
+	// PostgreSQL doesn't support passing the password and the TLS files in the arguments, we pass them in the environment variables.
+	env := os.Environ()
+	if m.connCfg.Password != "" {
+		env = append(env, fmt.Sprintf("PGPASSWORD=%s", m.connCfg.Password))
+	}
+	if m.connCfg.TLSConfig.SslCA != "" {
+		env = append(env, fmt.Sprintf("PGSSLROOTCERT=%s", m.connCfg.TLSConfig.SslCA))
+	}
+	if m.connCfg.TLSConfig.SslCert != "" {
+		env = append(env, fmt.Sprintf("PGSSLCERT=%s", m.connCfg.TLSConfig.SslCert))
+	}
+	if m.connCfg.TLSConfig.SslKey != "" {
+		env = append(env, fmt.Sprintf("PGSSLKEY=%s", m.connCfg.TLSConfig.SslKey))
+	}
+	return env
+}
+
+func getBackupName(t time.Time) string {
+	return namePrefix + t.UTC().Format(nameTimeLayout) + nameSuffix
+}
+
+func getBackupPath(name string) string {
+	return fmt.Sprintf("%s/%s", pathPrefix, name)
+}
+
+func parseBackupTime(name string) (time.Time, bool) {
+	s, ok := strings.CutPrefix(name, namePrefix)
+	if !ok {
+		return time.Time{}, false
+	}
+	s, ok = strings.CutSuffix(s, nameSuffix)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(nameTimeLayout, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// filterBackupNames filters out the files which are not the backups, and sorts the backups from the oldest to the latest.
+func filterBackupNames(names []string) []string {
+	var result []string
+	for _, name := range names {
+		if _, ok := parseBackupTime(name); ok {
+			result = append(result, name)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
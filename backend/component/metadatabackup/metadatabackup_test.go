@@ -0,0 +1,44 @@
+package metadatabackup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupName(t *testing.T) {
+	a := require.New(t)
+
+	backupTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	name := getBackupName(backupTime.In(time.FixedZone("UTC+8", 8*3600)))
+	a.Equal("bytebase-metadata-20240102T030405Z.sql.gz", name)
+	got, ok := parseBackupTime(name)
+	a.True(ok)
+	a.True(backupTime.Equal(got))
+
+	for _, name := range []string{
+		"bytebase-metadata-20240102T030405Z.sql",
+		"bytebase-metadata-2024.sql.gz",
+		"bytebase-metadata-20240102T030405Z.sql.gz.123.tmp",
+	} {
+		_, ok := parseBackupTime(name)
+		a.False(ok, name)
+	}
+}
+
+func TestFilterBackupNames(t *testing.T) {
+	a := require.New(t)
+
+	names := filterBackupNames([]string{
+		"bytebase-metadata-20240102T030405Z.sql.gz",
+		"README",
+		"bytebase-metadata-20231231T235959Z.sql.gz",
+		"bytebase-metadata-20240101T000000Z.sql.gz",
+	})
+	a.Equal([]string{
+		"bytebase-metadata-20231231T235959Z.sql.gz",
+		"bytebase-metadata-20240101T000000Z.sql.gz",
+		"bytebase-metadata-20240102T030405Z.sql.gz",
+	}, names)
+}
@@ -0,0 +1,191 @@
+// Package pgonline plans the PostgreSQL schema migration into steps avoiding long blocking locks.
+package pgonline
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	pgquery "github.com/pganalyze/pg_query_go/v4"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	pgparser "github.com/bytebase/bytebase/backend/plugin/parser/pg"
+)
+
+var (
+	// createIndexRegexp matches the leading "CREATE [UNIQUE] INDEX" of the statement.
+	createIndexRegexp = regexp.MustCompile(`(?is)^(\s*CREATE\s+(?:UNIQUE\s+)?INDEX)\b`)
+	// dropIndexRegexp matches the leading "DROP INDEX" of the statement.
+	dropIndexRegexp = regexp.MustCompile(`(?is)^(\s*DROP\s+INDEX)\b`)
+)
+
+// StepType is the type of the online migration step.
+type StepType int
+
+const (
+	// StepTypeSQL executes the statement.
+	StepTypeSQL StepType = iota
+	// StepTypeRepack rebuilds the table with pg_repack.
+	StepTypeRepack
+)
+
+// Step is a step of the online migration.
+type Step struct {
+	Type StepType
+	// Statement is the statement to execute for the SQL step.
+	Statement string
+	// Schema, Table and Tablespace are the table to rebuild for the repack step.
+	Schema     string
+	Table      string
+	Tablespace string
+	// Description is the human readable description of the step.
+	Description string
+}
+
+// Plan rewrites the statements to the concurrent-safe equivalents and returns the steps to execute in order.
+// The statements are rewritten as follows:
+//   - CREATE INDEX and DROP INDEX are executed CONCURRENTLY.
+//   - Adding FOREIGN KEY and CHECK constraints is split into adding the NOT VALID constraint and validating it.
+//   - If pgRepack is true, the table rewrites by ALTER TABLE SET TABLESPACE, VACUUM FULL and CLUSTER are done by pg_repack.
+//
+// Other statements are executed as is.
+func Plan(statement string, pgRepack bool) ([]*Step, error) {
+	list, err := pgparser.SplitSQL(statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to split statement")
+	}
+	var steps []*Step
+	for _, sql := range base.FilterEmptySQL(list) {
+		text := strings.TrimRight(strings.TrimSpace(sql.Text), ";")
+		if text == "" {
+			continue
+		}
+		result, err := pgquery.Parse(text)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse statement %q", text)
+		}
+		if len(result.Stmts) != 1 {
+			steps = append(steps, newSQLStep(text))
+			continue
+		}
+		steps = append(steps, planStatement(text, result.Stmts[0].Stmt, pgRepack)...)
+	}
+	return steps, nil
+}
+
+func planStatement(text string, node *pgquery.Node, pgRepack bool) []*Step {
+	switch n := node.Node.(type) {
+	case *pgquery.Node_IndexStmt:
+		if !n.IndexStmt.Concurrent {
+			if rewritten := createIndexRegexp.ReplaceAllString(text, "${1} CONCURRENTLY"); rewritten != text {
+				return []*Step{newSQLStep(rewritten)}
+			}
+		}
+	case *pgquery.Node_DropStmt:
+		// DROP INDEX CONCURRENTLY does not support dropping multiple indexes or CASCADE.
+		stmt := n.DropStmt
+		if stmt.RemoveType == pgquery.ObjectType_OBJECT_INDEX && !stmt.Concurrent && len(stmt.Objects) == 1 && stmt.Behavior != pgquery.DropBehavior_DROP_CASCADE {
+			if rewritten := dropIndexRegexp.ReplaceAllString(text, "${1} CONCURRENTLY"); rewritten != text {
+				return []*Step{newSQLStep(rewritten)}
+			}
+		}
+	case *pgquery.Node_AlterTableStmt:
+		if steps := planAlterTable(text, n.AlterTableStmt, pgRepack); steps != nil {
+			return steps
+		}
+	case *pgquery.Node_VacuumStmt:
+		if pgRepack && isVacuumFull(n.VacuumStmt) && len(n.VacuumStmt.Rels) > 0 {
+			var steps []*Step
+			for _, rel := range n.VacuumStmt.Rels {
+				relation := rel.GetVacuumRelation().GetRelation()
+				if relation == nil {
+					return []*Step{newSQLStep(text)}
+				}
+				steps = append(steps, newRepackStep(relation, ""))
+			}
+			return steps
+		}
+	case *pgquery.Node_ClusterStmt:
+		if pgRepack && n.ClusterStmt.Relation != nil {
+			var steps []*Step
+			if n.ClusterStmt.Indexname != "" {
+				// pg_repack orders the rows by the clustered index of the table.
+				steps = append(steps, newSQLStep(fmt.Sprintf(`ALTER TABLE %s CLUSTER ON "%s"`, quoteRelation(n.ClusterStmt.Relation), n.ClusterStmt.Indexname)))
+			}
+			return append(steps, newRepackStep(n.ClusterStmt.Relation, ""))
+		}
+	}
+	return []*Step{newSQLStep(text)}
+}
+
+func planAlterTable(text string, stmt *pgquery.AlterTableStmt, pgRepack bool) []*Step {
+	if len(stmt.Cmds) != 1 || stmt.Relation == nil {
+		return nil
+	}
+	cmd := stmt.Cmds[0].GetAlterTableCmd()
+	if cmd == nil {
+		return nil
+	}
+	switch cmd.Subtype {
+	case pgquery.AlterTableType_AT_AddConstraint:
+		constraint := cmd.GetDef().GetConstraint()
+		if constraint == nil || constraint.SkipValidation || constraint.Conname == "" {
+			return nil
+		}
+		if constraint.Contype != pgquery.ConstrType_CONSTR_FOREIGN && constraint.Contype != pgquery.ConstrType_CONSTR_CHECK {
+			return nil
+		}
+		// Adding the NOT VALID constraint only takes a brief lock, and validating it does not block the writes.
+		return []*Step{
+			newSQLStep(text + " NOT VALID"),
+			newSQLStep(fmt.Sprintf(`ALTER TABLE %s VALIDATE CONSTRAINT "%s"`, quoteRelation(stmt.Relation), constraint.Conname)),
+		}
+	case pgquery.AlterTableType_AT_SetTableSpace:
+		if pgRepack {
+			return []*Step{newRepackStep(stmt.Relation, cmd.Name)}
+		}
+	}
+	return nil
+}
+
+func isVacuumFull(stmt *pgquery.VacuumStmt) bool {
+	if !stmt.IsVacuumcmd {
+		return false
+	}
+	for _, option := range stmt.Options {
+		if defElem := option.GetDefElem(); defElem != nil && strings.EqualFold(defElem.Defname, "full") {
+			return true
+		}
+	}
+	return false
+}
+
+func newSQLStep(statement string) *Step {
+	return &Step{
+		Type:        StepTypeSQL,
+		Statement:   statement + ";",
+		Description: statement,
+	}
+}
+
+func newRepackStep(relation *pgquery.RangeVar, tablespace string) *Step {
+	description := fmt.Sprintf("pg_repack %s", quoteRelation(relation))
+	if tablespace != "" {
+		description = fmt.Sprintf(`%s to tablespace "%s"`, description, tablespace)
+	}
+	return &Step{
+		Type:        StepTypeRepack,
+		Schema:      relation.Schemaname,
+		Table:       relation.Relname,
+		Tablespace:  tablespace,
+		Description: description,
+	}
+}
+
+func quoteRelation(relation *pgquery.RangeVar) string {
+	if relation.Schemaname == "" {
+		return fmt.Sprintf(`"%s"`, relation.Relname)
+	}
+	return fmt.Sprintf(`"%s"."%s"`, relation.Schemaname, relation.Relname)
+}
@@ -0,0 +1,73 @@
+package pgonline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlan(t *testing.T) {
+	a := require.New(t)
+	tests := []struct {
+		statement string
+		pgRepack  bool
+		want      []*Step
+	}{
+		{
+			statement: "CREATE UNIQUE INDEX idx_a ON t(a);\nCREATE INDEX CONCURRENTLY idx_b ON t(b);",
+			want: []*Step{
+				{Type: StepTypeSQL, Statement: "CREATE UNIQUE INDEX CONCURRENTLY idx_a ON t(a);", Description: "CREATE UNIQUE INDEX CONCURRENTLY idx_a ON t(a)"},
+				{Type: StepTypeSQL, Statement: "CREATE INDEX CONCURRENTLY idx_b ON t(b);", Description: "CREATE INDEX CONCURRENTLY idx_b ON t(b)"},
+			},
+		},
+		{
+			statement: "DROP INDEX idx_a; DROP INDEX idx_b, idx_c; DROP INDEX idx_d CASCADE;",
+			want: []*Step{
+				{Type: StepTypeSQL, Statement: "DROP INDEX CONCURRENTLY idx_a;", Description: "DROP INDEX CONCURRENTLY idx_a"},
+				{Type: StepTypeSQL, Statement: "DROP INDEX idx_b, idx_c;", Description: "DROP INDEX idx_b, idx_c"},
+				{Type: StepTypeSQL, Statement: "DROP INDEX idx_d CASCADE;", Description: "DROP INDEX idx_d CASCADE"},
+			},
+		},
+		{
+			statement: "ALTER TABLE public.orders ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users(id);",
+			want: []*Step{
+				{Type: StepTypeSQL, Statement: "ALTER TABLE public.orders ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users(id) NOT VALID;", Description: "ALTER TABLE public.orders ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users(id) NOT VALID"},
+				{Type: StepTypeSQL, Statement: `ALTER TABLE "public"."orders" VALIDATE CONSTRAINT "fk_user";`, Description: `ALTER TABLE "public"."orders" VALIDATE CONSTRAINT "fk_user"`},
+			},
+		},
+		{
+			// The unnamed and already NOT VALID constraints are kept as is.
+			statement: "ALTER TABLE t ADD CHECK (a > 0); ALTER TABLE t ADD CONSTRAINT ck CHECK (a > 0) NOT VALID;",
+			want: []*Step{
+				{Type: StepTypeSQL, Statement: "ALTER TABLE t ADD CHECK (a > 0);", Description: "ALTER TABLE t ADD CHECK (a > 0)"},
+				{Type: StepTypeSQL, Statement: "ALTER TABLE t ADD CONSTRAINT ck CHECK (a > 0) NOT VALID;", Description: "ALTER TABLE t ADD CONSTRAINT ck CHECK (a > 0) NOT VALID"},
+			},
+		},
+		{
+			// The table rewrites are executed as is without pg_repack.
+			statement: "VACUUM FULL t; ALTER TABLE t SET TABLESPACE ts;",
+			want: []*Step{
+				{Type: StepTypeSQL, Statement: "VACUUM FULL t;", Description: "VACUUM FULL t"},
+				{Type: StepTypeSQL, Statement: "ALTER TABLE t SET TABLESPACE ts;", Description: "ALTER TABLE t SET TABLESPACE ts"},
+			},
+		},
+		{
+			statement: "VACUUM FULL t1, s.t2; ALTER TABLE t SET TABLESPACE ts; CLUSTER t USING idx; VACUUM t;",
+			pgRepack:  true,
+			want: []*Step{
+				{Type: StepTypeRepack, Table: "t1", Description: `pg_repack "t1"`},
+				{Type: StepTypeRepack, Schema: "s", Table: "t2", Description: `pg_repack "s"."t2"`},
+				{Type: StepTypeRepack, Table: "t", Tablespace: "ts", Description: `pg_repack "t" to tablespace "ts"`},
+				{Type: StepTypeSQL, Statement: `ALTER TABLE "t" CLUSTER ON "idx";`, Description: `ALTER TABLE "t" CLUSTER ON "idx"`},
+				{Type: StepTypeRepack, Table: "t", Description: `pg_repack "t"`},
+				{Type: StepTypeSQL, Statement: "VACUUM t;", Description: "VACUUM t"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		steps, err := Plan(test.statement, test.pgRepack)
+		a.NoError(err)
+		a.Equal(test.want, steps, test.statement)
+	}
+}
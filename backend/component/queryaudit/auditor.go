@@ -0,0 +1,207 @@
+// Package queryaudit scores the SQL editor and admin executions for suspicious patterns.
+package queryaudit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/component/activity"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// massClassifiedSelectScore is the score of selecting too many rows with classified columns at once.
+	massClassifiedSelectScore = 50
+	// largeExportScore is the score of exporting too many rows at once.
+	largeExportScore = 40
+	// unusualHoursScore is the score of executing outside of the working hours.
+	unusualHoursScore = 30
+	maxScore          = 100
+)
+
+// Auditor is the auditor scoring the SQL executions.
+type Auditor struct {
+	store           *store.Store
+	activityManager *activity.Manager
+}
+
+// NewAuditor creates a new auditor.
+func NewAuditor(store *store.Store, activityManager *activity.Manager) *Auditor {
+	return &Auditor{
+		store:           store,
+		activityManager: activityManager,
+	}
+}
+
+// Execution is a SQL execution to be scored.
+type Execution struct {
+	// ActivityID is the ID of the query or export activity.
+	ActivityID int
+	Export     bool
+	User       *store.UserMessage
+	Instance   *store.InstanceMessage
+	// Database is nil if the execution is not bound to a database.
+	Database     *store.DatabaseMessage
+	DatabaseName string
+	Statement    string
+	Results      []*v1pb.QueryResult
+	Time         time.Time
+}
+
+// Result is the anomaly score of a SQL execution.
+type Result struct {
+	Score   int32
+	Reasons []string
+}
+
+// Audit scores the execution and records it in the audit log if it's sampled or reaches the alert score.
+// The alert is also sent to the project webhooks subscribing the anomaly notification.
+func (a *Auditor) Audit(ctx context.Context, execution *Execution) error {
+	setting, err := a.store.GetQueryAuditSetting(ctx)
+	if err != nil {
+		return err
+	}
+	if !setting.Enabled {
+		return nil
+	}
+
+	result := Score(setting, execution)
+	alert := setting.AlertScore > 0 && result.Score >= setting.AlertScore
+	if !alert && rand.Float64() >= setting.SampleRate {
+		return nil
+	}
+
+	level := api.ActivityInfo
+	if alert {
+		level = api.ActivityWarn
+	}
+	databaseID := 0
+	if execution.Database != nil {
+		databaseID = execution.Database.UID
+	}
+	payload, err := json.Marshal(api.ActivitySQLQueryAuditPayload{
+		ActivityID:   execution.ActivityID,
+		Statement:    execution.Statement,
+		InstanceID:   execution.Instance.UID,
+		DatabaseID:   databaseID,
+		DatabaseName: execution.DatabaseName,
+		Score:        result.Score,
+		Reasons:      result.Reasons,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal query audit payload")
+	}
+	if _, err := a.activityManager.CreateActivity(ctx, &store.ActivityMessage{
+		CreatorUID:   execution.User.ID,
+		ContainerUID: execution.Instance.UID,
+		Type:         api.ActivitySQLQueryAudit,
+		Level:        level,
+		Comment:      fmt.Sprintf("Scored %d for the execution in database %q of instance %d.", result.Score, execution.DatabaseName, execution.Instance.UID),
+		Payload:      string(payload),
+	}, &activity.Metadata{}); err != nil {
+		return errors.Wrapf(err, "failed to create query audit activity")
+	}
+
+	if !alert || execution.Database == nil {
+		return nil
+	}
+	return a.postAlert(ctx, execution, result)
+}
+
+func (a *Auditor) postAlert(ctx context.Context, execution *Execution, result *Result) error {
+	project, err := a.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &execution.Database.ProjectID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project %q", execution.Database.ProjectID)
+	}
+	if project == nil {
+		return errors.Errorf("project %q not found", execution.Database.ProjectID)
+	}
+	generalSetting, err := a.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get workspace general setting")
+	}
+
+	return a.activityManager.PostProjectWebhooks(ctx, project.UID, api.ActivityNotifySQLQueryAnomaly, &webhook.Context{
+		Level:        webhook.WebhookWarn,
+		ActivityType: string(api.ActivityNotifySQLQueryAnomaly),
+		Title:        fmt.Sprintf("Suspicious SQL execution on database %s - score %d", execution.DatabaseName, result.Score),
+		TitleZh:      fmt.Sprintf("数据库 %s 上的可疑 SQL 执行 - 评分 %d", execution.DatabaseName, result.Score),
+		Description:  strings.Join(result.Reasons, "\n"),
+		Link:         fmt.Sprintf("%s/sql-editor", generalSetting.ExternalUrl),
+		CreatorID:    execution.User.ID,
+		CreatorName:  execution.User.Name,
+		CreatorEmail: execution.User.Email,
+		Project: &webhook.Project{
+			ID:   project.UID,
+			Name: project.Title,
+		},
+	})
+}
+
+// Score returns the anomaly score of the execution between 0 and 100.
+func Score(setting *storepb.QueryAuditSetting, execution *Execution) *Result {
+	result := &Result{}
+
+	var rows, classifiedRows int64
+	for _, r := range execution.Results {
+		rows += int64(len(r.Rows))
+		for _, sensitive := range r.Sensitive {
+			if sensitive {
+				classifiedRows += int64(len(r.Rows))
+				break
+			}
+		}
+	}
+
+	if setting.ClassifiedRowsThreshold > 0 && classifiedRows >= setting.ClassifiedRowsThreshold {
+		result.Score += massClassifiedSelectScore
+		result.Reasons = append(result.Reasons, fmt.Sprintf("Selected %d rows with classified columns, exceeding the threshold %d", classifiedRows, setting.ClassifiedRowsThreshold))
+	}
+	if execution.Export && setting.ExportRowsThreshold > 0 && rows >= setting.ExportRowsThreshold {
+		result.Score += largeExportScore
+		result.Reasons = append(result.Reasons, fmt.Sprintf("Exported %d rows, exceeding the threshold %d", rows, setting.ExportRowsThreshold))
+	}
+	if hour, ok := unusualHour(setting, execution.Time); ok {
+		result.Score += unusualHoursScore
+		result.Reasons = append(result.Reasons, fmt.Sprintf("Executed at %02d:00 outside of the working hours %02d:00-%02d:00", hour, setting.WorkingHourStart, setting.WorkingHourEnd))
+	}
+
+	if result.Score > maxScore {
+		result.Score = maxScore
+	}
+	return result
+}
+
+// unusualHour returns the hour of t in the setting time zone and whether it's outside of the working hours.
+func unusualHour(setting *storepb.QueryAuditSetting, t time.Time) (int, bool) {
+	if setting.WorkingHourStart == setting.WorkingHourEnd {
+		return 0, false
+	}
+	location := time.UTC
+	if setting.TimeZone != "" {
+		// The time zone is validated when updating the setting.
+		if l, err := time.LoadLocation(setting.TimeZone); err == nil {
+			location = l
+		}
+	}
+	hour := int32(t.In(location).Hour())
+	start, end := setting.WorkingHourStart, setting.WorkingHourEnd
+	var working bool
+	if start < end {
+		working = start <= hour && hour < end
+	} else {
+		// The working hours span midnight, e.g. 22:00-06:00.
+		working = hour >= start || hour < end
+	}
+	return int(hour), !working
+}
@@ -0,0 +1,101 @@
+package queryaudit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func newResult(rows int, sensitive ...bool) *v1pb.QueryResult {
+	result := &v1pb.QueryResult{Sensitive: sensitive}
+	for i := 0; i < rows; i++ {
+		result.Rows = append(result.Rows, &v1pb.QueryRow{})
+	}
+	return result
+}
+
+func TestScore(t *testing.T) {
+	a := require.New(t)
+	setting := &storepb.QueryAuditSetting{
+		Enabled:                 true,
+		AlertScore:              50,
+		ClassifiedRowsThreshold: 100,
+		ExportRowsThreshold:     1000,
+		WorkingHourStart:        9,
+		WorkingHourEnd:          18,
+		TimeZone:                "Asia/Shanghai",
+	}
+	// 10:00 in Asia/Shanghai.
+	workingTime := time.Date(2024, 1, 2, 2, 0, 0, 0, time.UTC)
+	// 23:00 in Asia/Shanghai.
+	lateTime := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		execution   *Execution
+		want        int32
+		reasons     int
+	}{
+		{
+			description: "normal query",
+			execution: &Execution{
+				Results: []*v1pb.QueryResult{newResult(10, false, true)},
+				Time:    workingTime,
+			},
+			want: 0,
+		},
+		{
+			description: "mass select of classified columns",
+			execution: &Execution{
+				Results: []*v1pb.QueryResult{newResult(60, false, true), newResult(60, false, true), newResult(500, false)},
+				Time:    workingTime,
+			},
+			want:    massClassifiedSelectScore,
+			reasons: 1,
+		},
+		{
+			description: "large export at unusual hours",
+			execution: &Execution{
+				Export:  true,
+				Results: []*v1pb.QueryResult{newResult(1000, false)},
+				Time:    lateTime,
+			},
+			want:    largeExportScore + unusualHoursScore,
+			reasons: 2,
+		},
+		{
+			description: "score is capped",
+			execution: &Execution{
+				Export:  true,
+				Results: []*v1pb.QueryResult{newResult(1000, true)},
+				Time:    lateTime,
+			},
+			want:    maxScore,
+			reasons: 3,
+		},
+	}
+	for _, test := range tests {
+		got := Score(setting, test.execution)
+		a.Equal(test.want, got.Score, test.description)
+		a.Len(got.Reasons, test.reasons, test.description)
+	}
+}
+
+func TestUnusualHour(t *testing.T) {
+	a := require.New(t)
+
+	// The check is disabled if the working hours are equal.
+	_, unusual := unusualHour(&storepb.QueryAuditSetting{}, time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC))
+	a.False(unusual)
+
+	// The working hours span midnight.
+	setting := &storepb.QueryAuditSetting{WorkingHourStart: 22, WorkingHourEnd: 6}
+	for hour, want := range map[int]bool{23: false, 2: false, 6: true, 12: true, 22: false} {
+		_, unusual := unusualHour(setting, time.Date(2024, 1, 2, hour, 0, 0, 0, time.UTC))
+		a.Equal(want, unusual, hour)
+	}
+}
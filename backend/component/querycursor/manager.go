@@ -0,0 +1,378 @@
+// Package querycursor keeps the server-side cursors of the SQL Editor queries so that the large results can be fetched page by page.
+package querycursor
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/masker"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	reapInterval = 30 * time.Second
+	// maxCursorsPerUser bounds the database connections held by a user.
+	maxCursorsPerUser = 5
+
+	defaultMaxTotalRows  = 1000000
+	defaultMaxTotalBytes = 1024 * 1024 * 1024
+	defaultIdleTimeout   = 5 * time.Minute
+)
+
+var (
+	// ErrCursorNotFound is returned if the cursor doesn't exist, is closed or is owned by another user.
+	ErrCursorNotFound = errors.New("query cursor not found")
+	// ErrTooManyCursors is returned if the user opens more cursors than allowed.
+	ErrTooManyCursors = errors.Errorf("cannot open more than %d query cursors", maxCursorsPerUser)
+	// ErrCursorTimeout is returned if the cursor is closed because it exceeds its max runtime.
+	ErrCursorTimeout = errors.New("query cursor exceeds the max runtime")
+)
+
+// OpenMessage is the message for opening a cursor.
+type OpenMessage struct {
+	UserID int
+	// Driver and Conn are owned by the cursor once opened, and are closed with the cursor.
+	Driver    db.Driver
+	Conn      *sql.Conn
+	Statement string
+	// Maskers are the maskers of the columns, the columns without maskers are not masked.
+	Maskers []masker.Masker
+	// MaxRuntime bounds the lifetime of the cursor, zero means unlimited.
+	MaxRuntime time.Duration
+}
+
+// Page is a page of rows fetched through a cursor.
+type Page struct {
+	ID     string
+	Result *v1pb.QueryResult
+	// Done is true if the rows are exhausted and the cursor is closed.
+	Done      bool
+	TotalRows int64
+	// Truncated is true if the cursor is closed because the max total rows or bytes is reached.
+	Truncated bool
+}
+
+// Manager is the manager of the query cursors.
+type Manager struct {
+	store *store.Store
+
+	sync.Mutex
+	cursors map[string]*cursor
+}
+
+type cursor struct {
+	id     string
+	userID int
+	driver db.Driver
+	conn   *sql.Conn
+	tx     *sql.Tx
+	rows   *sql.Rows
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	columnNames     []string
+	columnTypeNames []string
+	maskers         []masker.Masker
+	sensitive       []bool
+
+	maxTotalRows  int64
+	maxTotalBytes int64
+	idleTimeout   time.Duration
+
+	// mu serializes the fetches of the cursor, the fields below are protected by mu.
+	mu         sync.Mutex
+	totalRows  int64
+	totalBytes int64
+	lastAccess time.Time
+	closed     bool
+}
+
+// NewManager creates a new query cursor manager.
+func NewManager(store *store.Store) *Manager {
+	return &Manager{
+		store:   store,
+		cursors: make(map[string]*cursor),
+	}
+}
+
+// Run reaps the idle cursors, and closes all the cursors on shutdown.
+func (m *Manager) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(reapInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug("Query cursor manager started", slog.Duration("interval", reapInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Query cursor manager PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				m.reapIdleCursors()
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			m.Lock()
+			cursors := m.cursors
+			m.cursors = make(map[string]*cursor)
+			m.Unlock()
+			for _, c := range cursors {
+				c.mu.Lock()
+				c.close()
+				c.mu.Unlock()
+			}
+			return
+		}
+	}
+}
+
+func (m *Manager) reapIdleCursors() {
+	now := time.Now()
+	var idleCursors []*cursor
+	m.Lock()
+	for id, c := range m.cursors {
+		// The cursor being fetched is not idle.
+		if !c.mu.TryLock() {
+			continue
+		}
+		if now.Sub(c.lastAccess) > c.idleTimeout || c.ctx.Err() != nil {
+			delete(m.cursors, id)
+			idleCursors = append(idleCursors, c)
+		}
+		c.mu.Unlock()
+	}
+	m.Unlock()
+
+	for _, c := range idleCursors {
+		slog.Debug("Close idle query cursor", slog.String("cursor", c.id), slog.Int("user", c.userID))
+		c.mu.Lock()
+		c.close()
+		c.mu.Unlock()
+	}
+}
+
+// Open executes the statement and keeps the rows in a new cursor.
+// The statement is executed in a read-only transaction, which lives as long as the cursor.
+func (m *Manager) Open(ctx context.Context, open *OpenMessage) (string, error) {
+	setting, err := m.store.GetQueryCursorSetting(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	m.Lock()
+	count := 0
+	for _, c := range m.cursors {
+		if c.userID == open.UserID {
+			count++
+		}
+	}
+	m.Unlock()
+	if count >= maxCursorsPerUser {
+		return "", ErrTooManyCursors
+	}
+
+	// The cursor outlives the request, so the rows are not bound to the request context.
+	cursorCtx, cancel := context.WithCancel(context.Background())
+	if open.MaxRuntime > 0 {
+		cancel()
+		cursorCtx, cancel = context.WithTimeout(context.Background(), open.MaxRuntime)
+	}
+	tx, err := open.Conn.BeginTx(cursorCtx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		cancel()
+		return "", err
+	}
+	statement := strings.TrimRight(open.Statement, " \n\t;")
+	rows, err := tx.QueryContext(cursorCtx, statement)
+	if err != nil {
+		_ = tx.Rollback()
+		cancel()
+		return "", util.FormatErrorWithQuery(err, statement)
+	}
+
+	c := &cursor{
+		id:            uuid.NewString(),
+		userID:        open.UserID,
+		driver:        open.Driver,
+		conn:          open.Conn,
+		tx:            tx,
+		rows:          rows,
+		ctx:           cursorCtx,
+		cancel:        cancel,
+		maxTotalRows:  setting.MaxTotalRows,
+		maxTotalBytes: setting.MaxTotalBytes,
+		idleTimeout:   time.Duration(setting.IdleTimeoutSeconds) * time.Second,
+		lastAccess:    time.Now(),
+	}
+	if err := c.init(open.Maskers); err != nil {
+		c.close()
+		return "", err
+	}
+
+	m.Lock()
+	m.cursors[c.id] = c
+	m.Unlock()
+	return c.id, nil
+}
+
+func (c *cursor) init(maskers []masker.Masker) error {
+	if c.maxTotalRows == 0 {
+		c.maxTotalRows = defaultMaxTotalRows
+	}
+	if c.maxTotalBytes == 0 {
+		c.maxTotalBytes = defaultMaxTotalBytes
+	}
+	if c.idleTimeout == 0 {
+		c.idleTimeout = defaultIdleTimeout
+	}
+
+	columnNames, err := c.rows.Columns()
+	if err != nil {
+		return err
+	}
+	columnTypes, err := c.rows.ColumnTypes()
+	if err != nil {
+		return err
+	}
+	c.columnNames = columnNames
+	noneMasker := masker.NewNoneMasker()
+	for i, v := range columnTypes {
+		// DatabaseTypeName returns the database system name of the column type.
+		// refer: https://pkg.go.dev/database/sql#ColumnType.DatabaseTypeName
+		c.columnTypeNames = append(c.columnTypeNames, strings.ToUpper(v.DatabaseTypeName()))
+		var fieldMasker masker.Masker = noneMasker
+		if i < len(maskers) && maskers[i] != nil {
+			fieldMasker = maskers[i]
+		}
+		c.maskers = append(c.maskers, fieldMasker)
+		c.sensitive = append(c.sensitive, !fieldMasker.Equal(noneMasker))
+	}
+	return nil
+}
+
+// Fetch fetches at most pageSize rows and maxPageBytes bytes through the cursor of the user.
+// Zero pageSize fetches no row and only keeps the cursor alive.
+// The cursor is closed once the rows are exhausted, the max total rows or bytes is reached, or the fetch fails.
+func (m *Manager) Fetch(userID int, id string, pageSize int, maxPageBytes int) (*Page, error) {
+	m.Lock()
+	c, ok := m.cursors[id]
+	m.Unlock()
+	if !ok || c.userID != userID {
+		return nil, ErrCursorNotFound
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil, ErrCursorNotFound
+	}
+	c.lastAccess = time.Now()
+
+	page := &Page{
+		ID: id,
+		Result: &v1pb.QueryResult{
+			ColumnNames:     c.columnNames,
+			ColumnTypeNames: c.columnTypeNames,
+			Masked:          c.sensitive,
+			Sensitive:       c.sensitive,
+		},
+	}
+	var pageBytes int
+	start := time.Now()
+	for len(page.Result.Rows) < pageSize {
+		if c.totalRows >= c.maxTotalRows || c.totalBytes >= c.maxTotalBytes {
+			page.Truncated = true
+			break
+		}
+		if pageBytes >= maxPageBytes {
+			break
+		}
+		if !c.rows.Next() {
+			if err := c.rows.Err(); err != nil {
+				m.remove(c)
+				if errors.Is(c.ctx.Err(), context.DeadlineExceeded) {
+					return nil, ErrCursorTimeout
+				}
+				return nil, err
+			}
+			page.Done = true
+			break
+		}
+		row, err := util.ReadRow(c.rows, c.columnTypeNames, c.maskers)
+		if err != nil {
+			m.remove(c)
+			return nil, err
+		}
+		size := proto.Size(row)
+		page.Result.Rows = append(page.Result.Rows, row)
+		pageBytes += size
+		c.totalRows++
+		c.totalBytes += int64(size)
+	}
+	page.TotalRows = c.totalRows
+	page.Result.Latency = durationpb.New(time.Since(start))
+	if page.Done || page.Truncated {
+		m.remove(c)
+	}
+	return page, nil
+}
+
+// Close closes the cursor of the user.
+func (m *Manager) Close(userID int, id string) error {
+	m.Lock()
+	c, ok := m.cursors[id]
+	if !ok || c.userID != userID {
+		m.Unlock()
+		return ErrCursorNotFound
+	}
+	delete(m.cursors, id)
+	m.Unlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.close()
+	return nil
+}
+
+// remove removes and closes the cursor, the caller must hold the lock of the cursor.
+func (m *Manager) remove(c *cursor) {
+	m.Lock()
+	delete(m.cursors, c.id)
+	m.Unlock()
+	c.close()
+}
+
+// close closes the cursor, the caller must hold the lock of the cursor.
+func (c *cursor) close() {
+	if c.closed {
+		return
+	}
+	c.closed = true
+	if err := c.rows.Close(); err != nil {
+		slog.Debug("failed to close query cursor rows", slog.String("cursor", c.id), log.BBError(err))
+	}
+	_ = c.tx.Rollback()
+	c.cancel()
+	if err := c.conn.Close(); err != nil {
+		slog.Debug("failed to close query cursor connection", slog.String("cursor", c.id), log.BBError(err))
+	}
+	c.driver.Close(context.Background())
+}
@@ -0,0 +1,361 @@
+// Package queryplan builds the EXPLAIN statements and normalizes their output into plan trees across the engines.
+package queryplan
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// IsSupported returns true if the plan of the engine can be explained and normalized.
+func IsSupported(engine storepb.Engine) bool {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_POSTGRES, storepb.Engine_CLICKHOUSE:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetExplainStatement returns the EXPLAIN statement producing the plan of the single statement.
+func GetExplainStatement(engine storepb.Engine, statement string) (string, error) {
+	if !IsSupported(engine) {
+		return "", errors.Errorf("explain is not supported for %s", engine)
+	}
+	list, err := base.SplitMultiSQL(engine, statement)
+	if err != nil {
+		return "", err
+	}
+	list = base.FilterEmptySQL(list)
+	if len(list) != 1 {
+		return "", errors.Errorf("expecting a single statement to explain, but got %d", len(list))
+	}
+	stmt := strings.TrimRight(strings.TrimSpace(list[0].Text), ";")
+	if strings.HasPrefix(strings.ToUpper(stmt), "EXPLAIN") {
+		return "", errors.Errorf("the statement to explain must not be an EXPLAIN statement")
+	}
+
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB:
+		return fmt.Sprintf("EXPLAIN FORMAT=JSON %s", stmt), nil
+	case storepb.Engine_POSTGRES:
+		return fmt.Sprintf("EXPLAIN (FORMAT JSON) %s", stmt), nil
+	default:
+		return fmt.Sprintf("EXPLAIN PIPELINE %s", stmt), nil
+	}
+}
+
+// Normalize normalizes the raw output of the EXPLAIN statement into a plan tree.
+// The raw output is the JSON document for MySQL and PostgreSQL, and the lines of the pipeline for ClickHouse.
+func Normalize(engine storepb.Engine, raw string) (*v1pb.PlanNode, error) {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB:
+		return normalizeMySQL(raw)
+	case storepb.Engine_POSTGRES:
+		return normalizePostgres(raw)
+	case storepb.Engine_CLICKHOUSE:
+		return normalizeClickHouse(raw), nil
+	default:
+		return nil, errors.Errorf("explain is not supported for %s", engine)
+	}
+}
+
+func normalizeMySQL(raw string) (*v1pb.PlanNode, error) {
+	var plan map[string]any
+	if err := json.Unmarshal([]byte(raw), &plan); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal plan")
+	}
+	block, ok := plan["query_block"].(map[string]any)
+	if !ok {
+		return nil, errors.Errorf("query_block not found in plan")
+	}
+	return mysqlQueryBlock(block), nil
+}
+
+// mysqlWrappers are the operations wrapping the rest of the query block, by the JSON key.
+var mysqlWrappers = []struct {
+	key       string
+	operation string
+}{
+	{key: "windowing", operation: "Window"},
+	{key: "ordering_operation", operation: "Sort"},
+	{key: "grouping_operation", operation: "Group"},
+	{key: "duplicates_removal", operation: "Distinct"},
+}
+
+// mysqlSubqueries are the JSON keys of the subquery lists.
+var mysqlSubqueries = []string{
+	"select_list_subqueries",
+	"attached_subqueries",
+	"having_subqueries",
+	"order_by_subqueries",
+	"group_by_subqueries",
+	"optimized_away_subqueries",
+}
+
+func mysqlQueryBlock(block map[string]any) *v1pb.PlanNode {
+	node := &v1pb.PlanNode{
+		Operation:  "Query Block",
+		Properties: map[string]string{},
+	}
+	if v, ok := block["select_id"]; ok {
+		node.Properties["select_id"] = stringify(v)
+	}
+	if v, ok := block["message"]; ok {
+		node.Properties["message"] = stringify(v)
+	}
+	if costInfo, ok := block["cost_info"].(map[string]any); ok {
+		node.Cost = toFloat(costInfo["query_cost"])
+	}
+	node.Children = mysqlNodes(block)
+	return node
+}
+
+// mysqlNodes returns the nodes of the operations in the object of the plan.
+func mysqlNodes(object map[string]any) []*v1pb.PlanNode {
+	var nodes []*v1pb.PlanNode
+	for _, wrapper := range mysqlWrappers {
+		if child, ok := object[wrapper.key].(map[string]any); ok {
+			node := &v1pb.PlanNode{
+				Operation:  wrapper.operation,
+				Properties: scalarProperties(child),
+				Children:   mysqlNodes(child),
+			}
+			nodes = append(nodes, node)
+		}
+	}
+	if items, ok := object["nested_loop"].([]any); ok {
+		node := &v1pb.PlanNode{Operation: "Nested Loop"}
+		for _, item := range items {
+			if child, ok := item.(map[string]any); ok {
+				node.Children = append(node.Children, mysqlNodes(child)...)
+			}
+		}
+		nodes = append(nodes, node)
+	}
+	if table, ok := object["table"].(map[string]any); ok {
+		nodes = append(nodes, mysqlTable(table))
+	}
+	if union, ok := object["union_result"].(map[string]any); ok {
+		node := &v1pb.PlanNode{
+			Operation:  "Union",
+			Relation:   stringify(union["table_name"]),
+			Properties: scalarProperties(union),
+		}
+		if specs, ok := union["query_specifications"].([]any); ok {
+			for _, spec := range specs {
+				if child, ok := spec.(map[string]any); ok {
+					node.Children = append(node.Children, mysqlNodes(child)...)
+				}
+			}
+		}
+		nodes = append(nodes, node)
+	}
+	if block, ok := object["query_block"].(map[string]any); ok {
+		nodes = append(nodes, mysqlQueryBlock(block))
+	}
+	for _, key := range mysqlSubqueries {
+		items, ok := object[key].([]any)
+		if !ok {
+			continue
+		}
+		for _, item := range items {
+			if child, ok := item.(map[string]any); ok {
+				nodes = append(nodes, mysqlNodes(child)...)
+			}
+		}
+	}
+	return nodes
+}
+
+// mysqlAccessTypes are the operations of the table access types.
+var mysqlAccessTypes = map[string]string{
+	"ALL":         "Full Table Scan",
+	"index":       "Full Index Scan",
+	"range":       "Index Range Scan",
+	"ref":         "Index Lookup",
+	"eq_ref":      "Unique Index Lookup",
+	"const":       "Constant Lookup",
+	"system":      "Constant Lookup",
+	"fulltext":    "Fulltext Index Lookup",
+	"ref_or_null": "Index Lookup",
+	"index_merge": "Index Merge",
+}
+
+func mysqlTable(table map[string]any) *v1pb.PlanNode {
+	accessType := stringify(table["access_type"])
+	operation, ok := mysqlAccessTypes[accessType]
+	if !ok {
+		operation = "Table Access"
+	}
+	node := &v1pb.PlanNode{
+		Operation:  operation,
+		Relation:   stringify(table["table_name"]),
+		Index:      stringify(table["key"]),
+		Condition:  stringify(table["attached_condition"]),
+		Rows:       toFloat(table["rows_examined_per_scan"]),
+		Properties: scalarProperties(table),
+	}
+	if costInfo, ok := table["cost_info"].(map[string]any); ok {
+		node.Cost = toFloat(costInfo["prefix_cost"])
+	}
+	if materialized, ok := table["materialized_from_subquery"].(map[string]any); ok {
+		node.Children = mysqlNodes(materialized)
+	}
+	// The subqueries attached to the table.
+	node.Children = append(node.Children, mysqlNodes(table)...)
+	return node
+}
+
+func normalizePostgres(raw string) (*v1pb.PlanNode, error) {
+	var plans []struct {
+		Plan map[string]any `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(raw), &plans); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal plan")
+	}
+	if len(plans) == 0 || plans[0].Plan == nil {
+		return nil, errors.Errorf("plan not found")
+	}
+	return postgresNode(plans[0].Plan), nil
+}
+
+// postgresConditions are the keys of the conditions, in the order of preference.
+var postgresConditions = []string{"Index Cond", "Hash Cond", "Merge Cond", "Join Filter", "Recheck Cond", "Filter"}
+
+func postgresNode(plan map[string]any) *v1pb.PlanNode {
+	node := &v1pb.PlanNode{
+		Operation:  stringify(plan["Node Type"]),
+		Relation:   stringify(plan["Relation Name"]),
+		Index:      stringify(plan["Index Name"]),
+		Cost:       toFloat(plan["Total Cost"]),
+		Rows:       toFloat(plan["Plan Rows"]),
+		Properties: scalarProperties(plan),
+	}
+	if schema := stringify(plan["Schema"]); schema != "" && node.Relation != "" {
+		node.Relation = fmt.Sprintf("%s.%s", schema, node.Relation)
+	}
+	for _, key := range postgresConditions {
+		if condition := stringify(plan[key]); condition != "" {
+			node.Condition = condition
+			break
+		}
+	}
+	if children, ok := plan["Plans"].([]any); ok {
+		for _, child := range children {
+			if childPlan, ok := child.(map[string]any); ok {
+				node.Children = append(node.Children, postgresNode(childPlan))
+			}
+		}
+	}
+	return node
+}
+
+// normalizeClickHouse builds the plan tree from the indented lines of EXPLAIN PIPELINE, e.g.
+//
+//	(Expression)
+//	ExpressionTransform
+//	  (ReadFromMergeTree)
+//	  MergeTreeThread
+//
+// The steps are parenthesized, and the processors of a step follow the step with the same indentation.
+func normalizeClickHouse(raw string) *v1pb.PlanNode {
+	root := &v1pb.PlanNode{Operation: "Pipeline"}
+	type entry struct {
+		indent int
+		step   bool
+		node   *v1pb.PlanNode
+	}
+	var stack []entry
+	for _, line := range strings.Split(raw, "\n") {
+		text := strings.TrimSpace(line)
+		if text == "" {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		step := strings.HasPrefix(text, "(") && strings.HasSuffix(text, ")")
+		node := &v1pb.PlanNode{Operation: text}
+		if step {
+			node.Operation = strings.TrimSuffix(strings.TrimPrefix(text, "("), ")")
+			node.Properties = map[string]string{"type": "step"}
+		} else {
+			node.Properties = map[string]string{"type": "processor"}
+		}
+		// The processors are the children of the step with the same indentation.
+		for len(stack) > 0 {
+			top := stack[len(stack)-1]
+			if top.indent < indent || (top.indent == indent && top.step && !step) {
+				break
+			}
+			stack = stack[:len(stack)-1]
+		}
+		parent := root
+		if len(stack) > 0 {
+			parent = stack[len(stack)-1].node
+		}
+		parent.Children = append(parent.Children, node)
+		stack = append(stack, entry{indent: indent, step: step, node: node})
+	}
+	return root
+}
+
+// scalarProperties returns the properties of the scalar and scalar list values in the object.
+func scalarProperties(object map[string]any) map[string]string {
+	properties := map[string]string{}
+	for key, value := range object {
+		switch v := value.(type) {
+		case string, float64, bool:
+			properties[key] = stringify(v)
+		case []any:
+			var values []string
+			for _, item := range v {
+				switch item.(type) {
+				case string, float64, bool:
+					values = append(values, stringify(item))
+				}
+			}
+			if len(values) == len(v) && len(values) > 0 {
+				properties[key] = strings.Join(values, ", ")
+			}
+		}
+	}
+	return properties
+}
+
+func stringify(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// toFloat converts the number, or the number in string as reported by MySQL, to float.
+func toFloat(value any) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0
+		}
+		return f
+	default:
+		return 0
+	}
+}
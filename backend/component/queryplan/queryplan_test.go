@@ -0,0 +1,146 @@
+package queryplan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+
+	// Register the statement splitters.
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/pg"
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/standard"
+)
+
+func TestGetExplainStatement(t *testing.T) {
+	tests := []struct {
+		engine    storepb.Engine
+		statement string
+		want      string
+		wantErr   bool
+	}{
+		{engine: storepb.Engine_MYSQL, statement: "SELECT * FROM t;", want: "EXPLAIN FORMAT=JSON SELECT * FROM t"},
+		{engine: storepb.Engine_POSTGRES, statement: "SELECT * FROM t", want: "EXPLAIN (FORMAT JSON) SELECT * FROM t"},
+		{engine: storepb.Engine_CLICKHOUSE, statement: "SELECT * FROM t", want: "EXPLAIN PIPELINE SELECT * FROM t"},
+		{engine: storepb.Engine_MYSQL, statement: "SELECT 1; SELECT 2;", wantErr: true},
+		{engine: storepb.Engine_POSTGRES, statement: "EXPLAIN SELECT 1", wantErr: true},
+		{engine: storepb.Engine_ORACLE, statement: "SELECT 1 FROM dual", wantErr: true},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := GetExplainStatement(test.engine, test.statement)
+		if test.wantErr {
+			a.Error(err, test.statement)
+			continue
+		}
+		a.NoError(err)
+		a.Equal(test.want, got)
+	}
+}
+
+func TestNormalizeMySQL(t *testing.T) {
+	raw := `{
+  "query_block": {
+    "select_id": 1,
+    "cost_info": {"query_cost": "12.50"},
+    "ordering_operation": {
+      "using_filesort": true,
+      "nested_loop": [
+        {"table": {"table_name": "o", "access_type": "ALL", "rows_examined_per_scan": 100, "attached_condition": "(o.amount > 10)", "cost_info": {"prefix_cost": "10.25"}}},
+        {"table": {"table_name": "u", "access_type": "eq_ref", "key": "PRIMARY", "possible_keys": ["PRIMARY"], "rows_examined_per_scan": 1}}
+      ]
+    }
+  }
+}`
+	a := require.New(t)
+	plan, err := Normalize(storepb.Engine_MYSQL, raw)
+	a.NoError(err)
+	a.Equal("Query Block", plan.Operation)
+	a.Equal(12.5, plan.Cost)
+	a.Len(plan.Children, 1)
+	sort := plan.Children[0]
+	a.Equal("Sort", sort.Operation)
+	a.Equal("true", sort.Properties["using_filesort"])
+	a.Len(sort.Children, 1)
+	loop := sort.Children[0]
+	a.Equal("Nested Loop", loop.Operation)
+	a.Len(loop.Children, 2)
+	a.Equal("Full Table Scan", loop.Children[0].Operation)
+	a.Equal("o", loop.Children[0].Relation)
+	a.Equal("(o.amount > 10)", loop.Children[0].Condition)
+	a.Equal(float64(100), loop.Children[0].Rows)
+	a.Equal(10.25, loop.Children[0].Cost)
+	a.Equal("Unique Index Lookup", loop.Children[1].Operation)
+	a.Equal("PRIMARY", loop.Children[1].Index)
+	a.Equal("PRIMARY", loop.Children[1].Properties["possible_keys"])
+}
+
+func TestNormalizePostgres(t *testing.T) {
+	raw := `[
+  {
+    "Plan": {
+      "Node Type": "Hash Join",
+      "Join Type": "Inner",
+      "Total Cost": 35.5,
+      "Plan Rows": 120,
+      "Hash Cond": "(o.user_id = u.id)",
+      "Plans": [
+        {"Node Type": "Seq Scan", "Relation Name": "orders", "Alias": "o", "Total Cost": 20.1, "Plan Rows": 1000, "Filter": "(amount > 10)"},
+        {"Node Type": "Hash", "Plans": [
+          {"Node Type": "Index Scan", "Relation Name": "users", "Schema": "public", "Index Name": "users_pkey", "Index Cond": "(id > 5)"}
+        ]}
+      ]
+    }
+  }
+]`
+	a := require.New(t)
+	plan, err := Normalize(storepb.Engine_POSTGRES, raw)
+	a.NoError(err)
+	a.Equal("Hash Join", plan.Operation)
+	a.Equal("(o.user_id = u.id)", plan.Condition)
+	a.Equal("Inner", plan.Properties["Join Type"])
+	a.Equal(35.5, plan.Cost)
+	a.Equal(float64(120), plan.Rows)
+	a.Len(plan.Children, 2)
+	a.Equal("orders", plan.Children[0].Relation)
+	a.Equal("(amount > 10)", plan.Children[0].Condition)
+	a.Len(plan.Children[1].Children, 1)
+	index := plan.Children[1].Children[0]
+	a.Equal("public.users", index.Relation)
+	a.Equal("users_pkey", index.Index)
+	a.Equal("(id > 5)", index.Condition)
+}
+
+func TestNormalizeClickHouse(t *testing.T) {
+	raw := `(Expression)
+ExpressionTransform
+  (Filter)
+  FilterTransform
+    (ReadFromMergeTree)
+    MergeTreeThread
+`
+	a := require.New(t)
+	plan, err := Normalize(storepb.Engine_CLICKHOUSE, raw)
+	a.NoError(err)
+	a.Equal("Pipeline", plan.Operation)
+	a.Len(plan.Children, 1)
+	expression := plan.Children[0]
+	a.Equal("Expression", expression.Operation)
+	a.Equal("step", expression.Properties["type"])
+	a.Len(expression.Children, 1)
+	transform := expression.Children[0]
+	a.Equal("ExpressionTransform", transform.Operation)
+	a.Equal("processor", transform.Properties["type"])
+	a.Len(transform.Children, 1)
+	filter := transform.Children[0]
+	a.Equal("Filter", filter.Operation)
+	a.Len(filter.Children, 1)
+	a.Equal("FilterTransform", filter.Children[0].Operation)
+	a.Len(filter.Children[0].Children, 1)
+	read := filter.Children[0].Children[0]
+	a.Equal("ReadFromMergeTree", read.Operation)
+	a.Len(read.Children, 1)
+	a.Equal("MergeTreeThread", read.Children[0].Operation)
+}
@@ -0,0 +1,147 @@
+// Package sheetparam validates the named parameters of the sheets and renders them into the statements.
+package sheetparam
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	namePattern        = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	placeholderPattern = regexp.MustCompile(`\{\{\s*([a-zA-Z_][a-zA-Z0-9_]*)\s*\}\}`)
+
+	timestampLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02T15:04:05"}
+)
+
+// ValidateDefinitions validates the names, types and default values of the parameter definitions.
+func ValidateDefinitions(parameters []*storepb.SheetParameter) error {
+	names := make(map[string]bool)
+	for _, parameter := range parameters {
+		if !namePattern.MatchString(parameter.Name) {
+			return errors.Errorf("invalid parameter name %q", parameter.Name)
+		}
+		if names[parameter.Name] {
+			return errors.Errorf("duplicate parameter %q", parameter.Name)
+		}
+		names[parameter.Name] = true
+		if parameter.Type == storepb.SheetParameter_TYPE_UNSPECIFIED {
+			return errors.Errorf("type of parameter %q must be set", parameter.Name)
+		}
+		if !parameter.Required {
+			if _, err := literal(storepb.Engine_ENGINE_UNSPECIFIED, parameter.Type, parameter.DefaultValue); err != nil {
+				return errors.Wrapf(err, "invalid default value of parameter %q", parameter.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// ExtractNames returns the distinct names of the placeholders in the statement in the order of appearance.
+func ExtractNames(statement string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, match := range placeholderPattern.FindAllStringSubmatch(statement, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
+
+// Render replaces the placeholders in the statement with the literals of the values.
+// The default value is used for the parameter not required and not given.
+func Render(engine storepb.Engine, statement string, parameters []*storepb.SheetParameter, values map[string]string) (string, error) {
+	definitions := make(map[string]*storepb.SheetParameter)
+	for _, parameter := range parameters {
+		definitions[parameter.Name] = parameter
+	}
+	for name := range values {
+		if _, ok := definitions[name]; !ok {
+			return "", errors.Errorf("unknown parameter %q", name)
+		}
+	}
+
+	literals := make(map[string]string)
+	for _, name := range ExtractNames(statement) {
+		parameter, ok := definitions[name]
+		if !ok {
+			return "", errors.Errorf("parameter %q is not defined", name)
+		}
+		value, ok := values[name]
+		if !ok {
+			if parameter.Required {
+				return "", errors.Errorf("value of parameter %q is required", name)
+			}
+			value = parameter.DefaultValue
+		}
+		l, err := literal(engine, parameter.Type, value)
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid value of parameter %q", name)
+		}
+		literals[name] = l
+	}
+	return placeholderPattern.ReplaceAllStringFunc(statement, func(placeholder string) string {
+		return literals[placeholderPattern.FindStringSubmatch(placeholder)[1]]
+	}), nil
+}
+
+// literal returns the SQL literal of the value of the type.
+func literal(engine storepb.Engine, tp storepb.SheetParameter_Type, value string) (string, error) {
+	switch tp {
+	case storepb.SheetParameter_STRING:
+		return quote(engine, value), nil
+	case storepb.SheetParameter_INTEGER:
+		v, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return "", errors.Errorf("%q is not an integer", value)
+		}
+		return strconv.FormatInt(v, 10), nil
+	case storepb.SheetParameter_NUMBER:
+		v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return "", errors.Errorf("%q is not a number", value)
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case storepb.SheetParameter_BOOLEAN:
+		v, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return "", errors.Errorf("%q is not a boolean", value)
+		}
+		if v {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	case storepb.SheetParameter_DATE:
+		t, err := time.Parse("2006-01-02", strings.TrimSpace(value))
+		if err != nil {
+			return "", errors.Errorf("%q is not a date in the format of YYYY-MM-DD", value)
+		}
+		return quote(engine, t.Format("2006-01-02")), nil
+	case storepb.SheetParameter_TIMESTAMP:
+		for _, layout := range timestampLayouts {
+			if t, err := time.Parse(layout, strings.TrimSpace(value)); err == nil {
+				return quote(engine, t.Format("2006-01-02 15:04:05")), nil
+			}
+		}
+		return "", errors.Errorf("%q is not a timestamp", value)
+	default:
+		return "", errors.Errorf("unsupported parameter type %s", tp)
+	}
+}
+
+// quote quotes the string literal, escaping the backslashes as well for the engines treating them as escape characters.
+func quote(engine storepb.Engine, value string) string {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE, storepb.Engine_CLICKHOUSE:
+		value = strings.ReplaceAll(value, `\`, `\\`)
+	}
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(value, "'", "''"))
+}
@@ -0,0 +1,114 @@
+package sheetparam
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestValidateDefinitions(t *testing.T) {
+	tests := []struct {
+		parameters []*storepb.SheetParameter
+		wantErr    bool
+	}{
+		{
+			parameters: []*storepb.SheetParameter{
+				{Name: "start_date", Type: storepb.SheetParameter_DATE, Required: true},
+				{Name: "limit", Type: storepb.SheetParameter_INTEGER, DefaultValue: "10"},
+			},
+		},
+		{
+			parameters: []*storepb.SheetParameter{{Name: "1st", Type: storepb.SheetParameter_STRING}},
+			wantErr:    true,
+		},
+		{
+			parameters: []*storepb.SheetParameter{
+				{Name: "a", Type: storepb.SheetParameter_STRING},
+				{Name: "a", Type: storepb.SheetParameter_STRING},
+			},
+			wantErr: true,
+		},
+		{
+			parameters: []*storepb.SheetParameter{{Name: "a"}},
+			wantErr:    true,
+		},
+		{
+			parameters: []*storepb.SheetParameter{{Name: "a", Type: storepb.SheetParameter_INTEGER, DefaultValue: "x"}},
+			wantErr:    true,
+		},
+	}
+
+	a := require.New(t)
+	for i, test := range tests {
+		err := ValidateDefinitions(test.parameters)
+		if test.wantErr {
+			a.Error(err, i)
+		} else {
+			a.NoError(err, i)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	parameters := []*storepb.SheetParameter{
+		{Name: "start_date", Type: storepb.SheetParameter_DATE, Required: true},
+		{Name: "name", Type: storepb.SheetParameter_STRING, DefaultValue: "bob"},
+		{Name: "min", Type: storepb.SheetParameter_NUMBER, DefaultValue: "0"},
+		{Name: "active", Type: storepb.SheetParameter_BOOLEAN, DefaultValue: "true"},
+	}
+	statement := "SELECT * FROM t WHERE d >= {{start_date}} AND name = {{ name }} AND v > {{min}} AND active = {{active}} AND d2 >= {{start_date}}"
+
+	tests := []struct {
+		engine  storepb.Engine
+		values  map[string]string
+		want    string
+		wantErr bool
+	}{
+		{
+			engine: storepb.Engine_POSTGRES,
+			values: map[string]string{"start_date": "2024-01-01"},
+			want:   "SELECT * FROM t WHERE d >= '2024-01-01' AND name = 'bob' AND v > 0 AND active = TRUE AND d2 >= '2024-01-01'",
+		},
+		{
+			engine: storepb.Engine_POSTGRES,
+			values: map[string]string{"start_date": "2024-01-01", "name": `o'brien\`, "min": "1.5", "active": "false"},
+			want:   `SELECT * FROM t WHERE d >= '2024-01-01' AND name = 'o''brien\' AND v > 1.5 AND active = FALSE AND d2 >= '2024-01-01'`,
+		},
+		{
+			engine: storepb.Engine_MYSQL,
+			values: map[string]string{"start_date": "2024-01-01", "name": `o'brien\`},
+			want:   `SELECT * FROM t WHERE d >= '2024-01-01' AND name = 'o''brien\\' AND v > 0 AND active = TRUE AND d2 >= '2024-01-01'`,
+		},
+		{
+			engine:  storepb.Engine_POSTGRES,
+			values:  map[string]string{},
+			wantErr: true,
+		},
+		{
+			engine:  storepb.Engine_POSTGRES,
+			values:  map[string]string{"start_date": "2024-01-01; DROP TABLE t"},
+			wantErr: true,
+		},
+		{
+			engine:  storepb.Engine_POSTGRES,
+			values:  map[string]string{"start_date": "2024-01-01", "unknown": "x"},
+			wantErr: true,
+		},
+	}
+
+	a := require.New(t)
+	for i, test := range tests {
+		got, err := Render(test.engine, statement, parameters, test.values)
+		if test.wantErr {
+			a.Error(err, i)
+			continue
+		}
+		a.NoError(err, i)
+		a.Equal(test.want, got, i)
+	}
+
+	_, err := Render(storepb.Engine_POSTGRES, "SELECT {{undefined}}", parameters, nil)
+	a.Error(err)
+}
@@ -7,14 +7,23 @@ import (
 
 	lru "github.com/hashicorp/golang-lru/v2"
 
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 
 	"github.com/pkg/errors"
 )
 
-// InstanceMaximumConnectionNumber is the maximum number of connections outstanding per instance.
+// InstanceMaximumConnectionNumber is the default maximum number of connections outstanding per instance.
 const InstanceMaximumConnectionNumber = 10
 
+// GetInstanceMaximumConnectionNumber returns the maximum number of connections outstanding on the instance with the options.
+func GetInstanceMaximumConnectionNumber(options *storepb.InstanceOptions) int {
+	if n := options.GetMaximumConnections(); n > 0 {
+		return int(n)
+	}
+	return InstanceMaximumConnectionNumber
+}
+
 // State is the state for all in-memory states within the server.
 type State struct {
 	// InstanceDatabaseSyncChan is the channel for synchronizing schemas for instances.
@@ -91,6 +100,15 @@ func New() (*State, error) {
 	}, nil
 }
 
+// TicklePlanCheck tickles the plan check scheduler without blocking.
+// The plan check scheduler runs on the leader replica only, so the tickles on the other replicas are dropped once the channel is full.
+func (s *State) TicklePlanCheck() {
+	select {
+	case s.PlanCheckTickleChan <- 0:
+	default:
+	}
+}
+
 // InstanceSlowQuerySyncMessage is the message for synchronizing slow query logs for instances.
 type InstanceSlowQuerySyncMessage struct {
 	InstanceID string
@@ -0,0 +1,69 @@
+// Package watermark encodes the texts into invisible watermarks made of zero-width characters.
+package watermark
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// zeroBit and oneBit are the zero-width characters encoding the bits of the text.
+	zeroBit = '\u200b'
+	oneBit  = '\u200c'
+	// boundary is the zero-width character enclosing the encoded text, so that the watermark can be found in the surrounding text.
+	boundary = '\u2060'
+)
+
+// Encode encodes the text into an invisible watermark.
+func Encode(text string) string {
+	var b strings.Builder
+	b.WriteRune(boundary)
+	for i := 0; i < len(text); i++ {
+		for bit := 7; bit >= 0; bit-- {
+			if text[i]&(1<<bit) != 0 {
+				b.WriteRune(oneBit)
+			} else {
+				b.WriteRune(zeroBit)
+			}
+		}
+	}
+	b.WriteRune(boundary)
+	return b.String()
+}
+
+// Decode decodes the text of the first watermark found in s.
+func Decode(s string) (string, error) {
+	start := strings.IndexRune(s, boundary)
+	if start < 0 {
+		return "", errors.Errorf("watermark not found")
+	}
+	s = s[start+len(string(boundary)):]
+	end := strings.IndexRune(s, boundary)
+	if end < 0 {
+		return "", errors.Errorf("watermark is not terminated")
+	}
+
+	var text []byte
+	var c byte
+	n := 0
+	for _, r := range s[:end] {
+		switch r {
+		case zeroBit:
+			c <<= 1
+		case oneBit:
+			c = c<<1 | 1
+		default:
+			return "", errors.Errorf("invalid character %q in watermark", r)
+		}
+		n++
+		if n%8 == 0 {
+			text = append(text, c)
+			c = 0
+		}
+	}
+	if n%8 != 0 {
+		return "", errors.Errorf("watermark has %d bits, not a multiple of 8", n)
+	}
+	return string(text), nil
+}
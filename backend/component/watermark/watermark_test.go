@@ -0,0 +1,33 @@
+package watermark
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	a := require.New(t)
+
+	tests := []string{
+		"",
+		"alice@example.com 2023-11-01T08:00:00Z",
+		"用户@example.com",
+	}
+	for _, text := range tests {
+		encoded := Encode(text)
+		for _, r := range encoded {
+			a.Contains([]rune{zeroBit, oneBit, boundary}, r)
+		}
+		got, err := Decode("id" + encoded + "name")
+		a.NoError(err)
+		a.Equal(text, got)
+	}
+
+	_, err := Decode("id")
+	a.Error(err)
+	_, err = Decode("id\u2060\u200b\u2060")
+	a.Error(err)
+	_, err = Decode("id\u2060x\u2060")
+	a.Error(err)
+}
@@ -17,6 +17,21 @@ const (
 	// ActivityPipelineRollout is the type for notifying releasers to rollout.
 	// Will not be stored. Only used for notification.
 	ActivityNotifyPipelineRollout ActivityType = "bb.notify.pipeline.rollout"
+	// ActivityNotifySQLQueryAnomaly is the type for notifying the suspicious SQL execution.
+	// Will not be stored. Only used for notification.
+	ActivityNotifySQLQueryAnomaly ActivityType = "bb.notify.sql.query.anomaly"
+	// ActivityNotifySQLScheduledQuery is the type for delivering the results of the scheduled queries.
+	// Will not be stored. Only used for notification.
+	ActivityNotifySQLScheduledQuery ActivityType = "bb.notify.sql.scheduled-query"
+	// ActivityNotifyRolloutFailed is the type for notifying the failed task runs of the rollout.
+	// Will not be stored. Only used for notification.
+	ActivityNotifyRolloutFailed ActivityType = "bb.notify.pipeline.rollout-failed"
+	// ActivityNotifySchemaDrift is the type for notifying the schema drift detected on the database.
+	// Will not be stored. Only used for notification.
+	ActivityNotifySchemaDrift ActivityType = "bb.notify.database.schema-drift"
+	// ActivityNotifySlowQueryRegression is the type for notifying the week over week slow query regression of the project.
+	// Will not be stored. Only used for notification.
+	ActivityNotifySlowQueryRegression ActivityType = "bb.notify.slow-query.regression"
 
 	// Issue related.
 
@@ -45,6 +60,10 @@ const (
 	ActivityPipelineTaskEarliestAllowedTimeUpdate ActivityType = "bb.pipeline.task.general.earliest-allowed-time.update"
 	// ActivityPipelineTaskStatementUpdate is the type for updating pipeline task SQL statement.
 	ActivityPipelineTaskPriorBackup ActivityType = "bb.pipeline.task.prior-backup"
+	// ActivityPipelineStageApprove is the type for signing off the approval gate of the stage or its tasks.
+	ActivityPipelineStageApprove ActivityType = "bb.pipeline.stage.approve"
+	// ActivityPipelineStageCanaryUpdate is the type for promoting or halting the canary stage.
+	ActivityPipelineStageCanaryUpdate ActivityType = "bb.pipeline.stage.canary.update"
 
 	// Member related.
 
@@ -56,6 +75,12 @@ const (
 	ActivityMemberActivate ActivityType = "bb.member.activate"
 	// ActivityMemberDeactivate is the type for deactivating members.
 	ActivityMemberDeactivate ActivityType = "bb.member.deactivate"
+	// ActivityMemberBatchOperation is the type for the batch operations performed by members.
+	ActivityMemberBatchOperation ActivityType = "bb.member.batch-operation"
+	// ActivityMemberAPITokenCreate is the type for creating API tokens of members.
+	ActivityMemberAPITokenCreate ActivityType = "bb.member.api-token.create"
+	// ActivityMemberAPITokenRevoke is the type for revoking API tokens of members.
+	ActivityMemberAPITokenRevoke ActivityType = "bb.member.api-token.revoke"
 
 	// Project related.
 
@@ -77,6 +102,8 @@ const (
 
 	// ActivitySQLExport is the type for exporting SQL.
 	ActivitySQLExport ActivityType = "bb.sql.export"
+	// ActivitySQLQueryAudit is the type for the anomaly scoring of SQL executions.
+	ActivitySQLQueryAudit ActivityType = "bb.sql.query.audit"
 
 	// Database related.
 
@@ -225,6 +252,30 @@ type ActivityPipelineTaskPriorBackupPayload struct {
 	TaskName  string `json:"taskName"`
 }
 
+// ActivityPipelineStageApprovePayload is the API message payloads for signing off the stage approval gate.
+type ActivityPipelineStageApprovePayload struct {
+	StageID int    `json:"stageId"`
+	Role    string `json:"role"`
+	// TaskIDs is empty if the whole stage is signed off.
+	TaskIDs []int `json:"taskIds"`
+
+	// Used by inbox to display info without paying the join cost
+	IssueName string `json:"issueName"`
+	StageName string `json:"stageName"`
+}
+
+// ActivityPipelineStageCanaryUpdatePayload is the API message payloads for promoting or halting the canary stage.
+type ActivityPipelineStageCanaryUpdatePayload struct {
+	StageID int    `json:"stageId"`
+	Status  string `json:"status"`
+	// Reason is the reason of halting the canary.
+	Reason string `json:"reason"`
+
+	// Used by inbox to display info without paying the join cost
+	IssueName string `json:"issueName"`
+	StageName string `json:"stageName"`
+}
+
 // SchemaMetadata is the database schema metadata.
 type SchemaMetadata struct {
 	Schema string `json:"schema,omitempty"`
@@ -257,6 +308,31 @@ type ActivityMemberActivateDeactivatePayload struct {
 	Role           Role   `json:"role"`
 }
 
+// ActivityMemberBatchOperationPayload is the API message payloads for the batch operations performed by members.
+type ActivityMemberBatchOperationPayload struct {
+	// Operation is the name of the batch method, e.g. BatchApproveIssues.
+	Operation string `json:"operation"`
+	Parent    string `json:"parent"`
+	// Resources are the resources succeeded.
+	Resources []string `json:"resources"`
+	// FailedResources are the resources failed.
+	FailedResources []string `json:"failedResources"`
+}
+
+// ActivityMemberAPITokenPayload is the API message payloads for creating or revoking API tokens of members.
+type ActivityMemberAPITokenPayload struct {
+	PrincipalID    int    `json:"principalId"`
+	PrincipalName  string `json:"principalName"`
+	PrincipalEmail string `json:"principalEmail"`
+	// APIToken is the resource name of the API token.
+	APIToken string   `json:"apiToken"`
+	Title    string   `json:"title"`
+	Scopes   []string `json:"scopes"`
+	Projects []string `json:"projects"`
+	// ExpireTs is 0 if the API token never expires.
+	ExpireTs int64 `json:"expireTs"`
+}
+
 // ActivityProjectRepositoryPushPayload is the API message payloads for pushing repositories.
 type ActivityProjectRepositoryPushPayload struct {
 	VCSPushEvent vcs.PushEvent `json:"pushEvent"`
@@ -298,6 +374,18 @@ type ActivitySQLExportPayload struct {
 	Error        string `json:"error"`
 }
 
+// ActivitySQLQueryAuditPayload is the API message payloads for the anomaly scoring of SQL executions.
+type ActivitySQLQueryAuditPayload struct {
+	// ActivityID is the ID of the query or export activity being scored.
+	ActivityID   int      `json:"activityId"`
+	Statement    string   `json:"statement"`
+	InstanceID   int      `json:"instanceId"`
+	DatabaseID   int      `json:"databaseId"`
+	DatabaseName string   `json:"databaseName"`
+	Score        int32    `json:"score"`
+	Reasons      []string `json:"reasons"`
+}
+
 type ActivityNotifyPipelineRolloutPayload struct {
 	RolloutPolicy *storepb.RolloutPolicy
 	// Used to display info without paying the join cost
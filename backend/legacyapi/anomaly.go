@@ -16,6 +16,8 @@ const (
 	AnomalyDatabaseConnection AnomalyType = "bb.anomaly.database.connection"
 	// AnomalyDatabaseSchemaDrift is the anomaly type for database schema drifts.
 	AnomalyDatabaseSchemaDrift AnomalyType = "bb.anomaly.database.schema.drift"
+	// AnomalyDatabaseChangeHistoryIntegrity is the anomaly type for tampered or out-of-order change histories.
+	AnomalyDatabaseChangeHistoryIntegrity AnomalyType = "bb.anomaly.database.change-history.integrity"
 )
 
 // AnomalyInstanceConnectionPayload is the API message for instance connection payloads.
@@ -53,3 +55,26 @@ type AnomalyDatabaseSchemaDriftPayload struct {
 	// The actual schema dumped from the database
 	Actual string `json:"actual,omitempty"`
 }
+
+// ChangeHistoryIntegrityViolationType is the type of change history integrity violations.
+type ChangeHistoryIntegrityViolationType string
+
+const (
+	// ChangeHistoryIntegrityChecksumMismatch means the statement doesn't match the checksum recorded when the change was applied.
+	ChangeHistoryIntegrityChecksumMismatch ChangeHistoryIntegrityViolationType = "CHECKSUM_MISMATCH"
+	// ChangeHistoryIntegrityOutOfOrderVersion means the version is not greater than the version of a change applied before it.
+	ChangeHistoryIntegrityOutOfOrderVersion ChangeHistoryIntegrityViolationType = "OUT_OF_ORDER_VERSION"
+)
+
+// ChangeHistoryIntegrityViolation is the API message for a change history failing the integrity check.
+type ChangeHistoryIntegrityViolation struct {
+	ChangeHistoryUID string                              `json:"changeHistoryUid,omitempty"`
+	Version          string                              `json:"version,omitempty"`
+	Type             ChangeHistoryIntegrityViolationType `json:"type,omitempty"`
+	Detail           string                              `json:"detail,omitempty"`
+}
+
+// AnomalyDatabaseChangeHistoryIntegrityPayload is the API message for database change history integrity payloads.
+type AnomalyDatabaseChangeHistoryIntegrityPayload struct {
+	Violations []*ChangeHistoryIntegrityViolation `json:"violations,omitempty"`
+}
@@ -62,4 +62,7 @@ type BackupPayload struct {
 	// It is recorded within the same transaction as the dump so that the binlog position is consistent with the dump.
 	// Please refer to https://github.com/bytebase/bytebase/blob/main/docs/design/pitr-mysql.md#full-backup for details.
 	BinlogInfo BinlogInfo `json:"binlogInfo"`
+
+	// Size is the size of the backup file in bytes.
+	Size int64 `json:"size,omitempty"`
 }
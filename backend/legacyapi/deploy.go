@@ -23,13 +23,31 @@ type DeploymentSchedule struct {
 
 // Deployment is the API message for deployment.
 type Deployment struct {
-	Name string          `json:"name"`
-	Spec *DeploymentSpec `json:"spec"`
+	Name   string            `json:"name"`
+	Spec   *DeploymentSpec   `json:"spec"`
+	Canary *DeploymentCanary `json:"canary,omitempty"`
+}
+
+// DeploymentCanary is the API message for the canary of a deployment.
+// The canary databases are rolled out in a stage before the rest of the deployment.
+type DeploymentCanary struct {
+	// Percentage is the percentage of the databases of the deployment to roll out first, it's ignored if the databases are set.
+	Percentage int32 `json:"percentage,omitempty"`
+	// Databases are the databases to roll out first, in the format of instances/{instance}/databases/{database}.
+	Databases []string `json:"databases,omitempty"`
+	// BakeSeconds is the bake period after all the canary tasks finish, before the rest of the deployment is promoted.
+	BakeSeconds int64 `json:"bakeSeconds,omitempty"`
+	// MaxFailurePercentage is the max percentage of the failed or canceled canary tasks to promote the rest of the deployment.
+	MaxFailurePercentage int32 `json:"maxFailurePercentage,omitempty"`
+	// PostCheckStatements are the queries run on the canary databases after the bake period, which must return no rows.
+	PostCheckStatements []string `json:"postCheckStatements,omitempty"`
 }
 
 // DeploymentSpec is the API message for deployment specification.
 type DeploymentSpec struct {
 	Selector *LabelSelector `json:"selector"`
+	// Expression is the CEL expression matching the databases, it takes precedence over the selector.
+	Expression string `json:"expression,omitempty"`
 }
 
 // LabelSelector is the API message for label selector.
@@ -99,6 +117,9 @@ func ValidateAndGetDeploymentSchedule(payload string) (*DeploymentSchedule, erro
 		if d.Name == "" {
 			return nil, common.Errorf(common.Invalid, "Deployment name must not be empty")
 		}
+		if d.Spec.Expression != "" {
+			continue
+		}
 		hasEnv := false
 		for _, e := range d.Spec.Selector.MatchExpressions {
 			switch e.Operator {
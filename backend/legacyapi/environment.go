@@ -22,3 +22,44 @@ func IsValidEnvironmentName(environmentName string) error {
 
 	return nil
 }
+
+// EnvironmentClass is the class of the environments sharing the policy defaults, e.g. all the production environments.
+type EnvironmentClass string
+
+const (
+	// EnvironmentClassUnset means the environment doesn't inherit the policies of any class.
+	EnvironmentClassUnset EnvironmentClass = ""
+	// EnvironmentClassDev is the class of the development environments.
+	EnvironmentClassDev EnvironmentClass = "DEV"
+	// EnvironmentClassTest is the class of the test environments.
+	EnvironmentClassTest EnvironmentClass = "TEST"
+	// EnvironmentClassStaging is the class of the staging environments.
+	EnvironmentClassStaging EnvironmentClass = "STAGING"
+	// EnvironmentClassProd is the class of the production environments.
+	EnvironmentClassProd EnvironmentClass = "PROD"
+)
+
+// environmentClassUIDs are the resource IDs of the environment class policies.
+var environmentClassUIDs = map[EnvironmentClass]int{
+	EnvironmentClassDev:     1,
+	EnvironmentClassTest:    2,
+	EnvironmentClassStaging: 3,
+	EnvironmentClassProd:    4,
+}
+
+// GetEnvironmentClassUID returns the resource ID of the policies of the environment class.
+// It returns false if the class is unset or unknown.
+func GetEnvironmentClassUID(class EnvironmentClass) (int, bool) {
+	uid, ok := environmentClassUIDs[class]
+	return uid, ok
+}
+
+// GetEnvironmentClassByUID returns the environment class by the resource ID of its policies.
+func GetEnvironmentClassByUID(uid int) (EnvironmentClass, bool) {
+	for class, classUID := range environmentClassUIDs {
+		if classUID == uid {
+			return class, true
+		}
+	}
+	return EnvironmentClassUnset, false
+}
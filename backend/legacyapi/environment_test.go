@@ -0,0 +1,26 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEnvironmentClassUID(t *testing.T) {
+	a := require.New(t)
+
+	for _, class := range []EnvironmentClass{EnvironmentClassDev, EnvironmentClassTest, EnvironmentClassStaging, EnvironmentClassProd} {
+		uid, ok := GetEnvironmentClassUID(class)
+		a.True(ok)
+		got, ok := GetEnvironmentClassByUID(uid)
+		a.True(ok)
+		a.Equal(class, got)
+	}
+
+	_, ok := GetEnvironmentClassUID(EnvironmentClassUnset)
+	a.False(ok)
+	_, ok = GetEnvironmentClassUID("QA")
+	a.False(ok)
+	_, ok = GetEnvironmentClassByUID(0)
+	a.False(ok)
+}
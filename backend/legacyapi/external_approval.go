@@ -8,12 +8,22 @@ const (
 	ExternalApprovalTypeFeishu ExternalApprovalType = "bb.plugin.app.feishu"
 	// ExternalApprovalTypeRelay is the ExternalApproval from relay.
 	ExternalApprovalTypeRelay ExternalApprovalType = "bb.plugin.app.relay"
+	// ExternalApprovalTypeJira is the ExternalApproval decided by the status of the linked Jira ticket.
+	ExternalApprovalTypeJira ExternalApprovalType = "bb.plugin.app.jira"
 )
 
 // ExternalApprovalPayloadRelay is the payload for relay type ExternalApproval.
 type ExternalApprovalPayloadRelay struct {
 	ExternalApprovalNodeID string `json:"externalApprovalNodeId"`
 	ID                     string `json:"id"`
+	// CallbackToken identifies the external approval in the callback URL of the CALLBACK mode node.
+	// The approvals with a callback token are not polled.
+	CallbackToken string `json:"callbackToken,omitempty"`
+}
+
+// ExternalApprovalPayloadJira is the payload for jira type ExternalApproval.
+type ExternalApprovalPayloadJira struct {
+	ExternalApprovalNodeID string `json:"externalApprovalNodeId"`
 }
 
 // ExternalApprovalEventActionType is the type of the action which the user took.
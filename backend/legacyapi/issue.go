@@ -42,4 +42,8 @@ const (
 	IssueFieldSubscriberList IssueFieldID = "6"
 	// IssueFieldSQL is the field ID for SQL.
 	IssueFieldSQL IssueFieldID = "7"
+	// IssueFieldPriority is the field ID for priority.
+	IssueFieldPriority IssueFieldID = "8"
+	// IssueFieldLabels is the field ID for labels.
+	IssueFieldLabels IssueFieldID = "9"
 )
@@ -16,16 +16,17 @@ const (
 type Role string
 
 const (
-	WorkspaceAdmin   Role = "workspaceAdmin"
-	WorkspaceDBA     Role = "workspaceDBA"
-	WorkspaceMember  Role = "workspaceMember"
-	ProjectOwner     Role = "projectOwner"
-	ProjectDeveloper Role = "projectDeveloper"
-	ProjectQuerier   Role = "projectQuerier"
-	ProjectExporter  Role = "projectExporter"
-	ProjectReleaser  Role = "projectReleaser"
-	ProjectViewer    Role = "projectViewer"
-	UnknownRole      Role = "UNKNOWN"
+	WorkspaceAdmin     Role = "workspaceAdmin"
+	WorkspaceDBA       Role = "workspaceDBA"
+	WorkspaceMember    Role = "workspaceMember"
+	ProjectOwner       Role = "projectOwner"
+	ProjectDeveloper   Role = "projectDeveloper"
+	ProjectQuerier     Role = "projectQuerier"
+	ProjectExporter    Role = "projectExporter"
+	ProjectReleaser    Role = "projectReleaser"
+	ProjectViewer      Role = "projectViewer"
+	ProjectDMLExecutor Role = "projectDMLExecutor"
+	UnknownRole        Role = "UNKNOWN"
 )
 
 func (r Role) String() string {
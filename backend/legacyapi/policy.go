@@ -50,6 +50,12 @@ const (
 	PolicyTypeMaskingRule PolicyType = "bb.policy.masking-rule"
 	// PolicyTypeRestrictIssueCreationForSQLReview is the policy type for restricting issue creation for SQL review.
 	PolicyTypeRestrictIssueCreationForSQLReview PolicyType = "bb.policy.restrict-issue-creation-for-sql-review"
+	// PolicyTypeTaskRunLogRetention is the task run log retention policy type.
+	PolicyTypeTaskRunLogRetention PolicyType = "bb.policy.task-run-log-retention"
+	// PolicyTypeAffectedRowsLimit is the affected rows limit policy type.
+	PolicyTypeAffectedRowsLimit PolicyType = "bb.policy.affected-rows-limit"
+	// PolicyTypeRowAccess is the row access policy type.
+	PolicyTypeRowAccess PolicyType = "bb.policy.row-access"
 
 	// PipelineApprovalValueManualNever means the pipeline will automatically be approved without user intervention.
 	PipelineApprovalValueManualNever PipelineApprovalValue = "MANUAL_APPROVAL_NEVER"
@@ -85,22 +91,28 @@ const (
 	PolicyResourceTypeInstance PolicyResourceType = "INSTANCE"
 	// PolicyResourceTypeDatabase is the resource type for databases.
 	PolicyResourceTypeDatabase PolicyResourceType = "DATABASE"
+	// PolicyResourceTypeEnvironmentClass is the resource type for environment classes.
+	// The environments of the class inherit its policies unless they have their own.
+	PolicyResourceTypeEnvironmentClass PolicyResourceType = "ENVIRONMENT_CLASS"
 )
 
 var (
 	// AllowedResourceTypes includes allowed resource types for each policy type.
 	AllowedResourceTypes = map[PolicyType][]PolicyResourceType{
 		PolicyTypeWorkspaceIAM:                      {PolicyResourceTypeWorkspace},
-		PolicyTypeRollout:                           {PolicyResourceTypeEnvironment},
-		PolicyTypeBackupPlan:                        {PolicyResourceTypeEnvironment},
-		PolicyTypeSQLReview:                         {PolicyResourceTypeEnvironment},
+		PolicyTypeRollout:                           {PolicyResourceTypeEnvironment, PolicyResourceTypeEnvironmentClass},
+		PolicyTypeBackupPlan:                        {PolicyResourceTypeEnvironment, PolicyResourceTypeEnvironmentClass},
+		PolicyTypeSQLReview:                         {PolicyResourceTypeEnvironment, PolicyResourceTypeEnvironmentClass},
 		PolicyTypeEnvironmentTier:                   {PolicyResourceTypeEnvironment},
-		PolicyTypeMasking:                           {PolicyResourceTypeDatabase},
+		PolicyTypeMasking:                           {PolicyResourceTypeProject, PolicyResourceTypeDatabase},
 		PolicyTypeSlowQuery:                         {PolicyResourceTypeInstance},
 		PolicyTypeDisableCopyData:                   {PolicyResourceTypeEnvironment},
 		PolicyTypeMaskingRule:                       {PolicyResourceTypeWorkspace},
 		PolicyTypeMaskingException:                  {PolicyResourceTypeProject},
 		PolicyTypeRestrictIssueCreationForSQLReview: {PolicyResourceTypeWorkspace},
+		PolicyTypeTaskRunLogRetention:               {PolicyResourceTypeEnvironment},
+		PolicyTypeAffectedRowsLimit:                 {PolicyResourceTypeEnvironment},
+		PolicyTypeRowAccess:                         {PolicyResourceTypeProject},
 	}
 )
 
@@ -31,6 +31,8 @@ const (
 
 	// ServiceAccountAccessKeyPrefix is the prefix for service account access key.
 	ServiceAccountAccessKeyPrefix = "bbs_"
+	// APITokenPrefix is the prefix for API token.
+	APITokenPrefix = "bbt_"
 )
 
 // PrincipalAuthProvider is the type of an authentication provider.
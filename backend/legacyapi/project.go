@@ -1,6 +1,9 @@
 package api
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/pkg/errors"
 
 	"github.com/bytebase/bytebase/backend/common"
@@ -162,3 +165,56 @@ func ValidateRepositorySchemaPathTemplate(schemaPathTemplate string, tenantMode
 	}
 	return nil
 }
+
+// CompileRepositoryPathGlob compiles the path glob of the repository routing rule to the regular expression matching the whole path.
+// "**" matches any characters including "/", "*" matches any characters except "/", and "?" matches any character except "/".
+func CompileRepositoryPathGlob(pathGlob string) (*regexp.Regexp, error) {
+	if pathGlob == "" {
+		return nil, errors.Errorf("empty path glob")
+	}
+	var sb strings.Builder
+	_, _ = sb.WriteString("^")
+	for i := 0; i < len(pathGlob); i++ {
+		switch c := pathGlob[i]; c {
+		case '*':
+			if i+1 < len(pathGlob) && pathGlob[i+1] == '*' {
+				i++
+				// "**/" also matches the empty directory, e.g. "a/**/b.sql" matches "a/b.sql".
+				if i+1 < len(pathGlob) && pathGlob[i+1] == '/' {
+					i++
+					_, _ = sb.WriteString("(?:.*/)?")
+				} else {
+					_, _ = sb.WriteString(".*")
+				}
+			} else {
+				_, _ = sb.WriteString("[^/]*")
+			}
+		case '?':
+			_, _ = sb.WriteString("[^/]")
+		default:
+			_, _ = sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	_, _ = sb.WriteString("$")
+	return regexp.Compile(sb.String())
+}
+
+// ValidateRepositoryRoutingRule validates the path glob and the version pattern of the repository routing rule.
+// The version pattern must contain the VERSION named group, and the DB_NAME named group unless omitDatabaseName is set,
+// i.e. the changes are applied to a database group or the databases of a tenant project.
+func ValidateRepositoryRoutingRule(pathGlob, versionPattern string, omitDatabaseName bool) error {
+	if _, err := CompileRepositoryPathGlob(pathGlob); err != nil {
+		return errors.Wrapf(err, "invalid path glob %q", pathGlob)
+	}
+	pattern, err := regexp.Compile(versionPattern)
+	if err != nil {
+		return errors.Wrapf(err, "invalid version pattern %q", versionPattern)
+	}
+	if pattern.SubexpIndex("VERSION") < 0 {
+		return errors.Errorf("missing the VERSION named group in version pattern %q", versionPattern)
+	}
+	if !omitDatabaseName && pattern.SubexpIndex("DB_NAME") < 0 {
+		return errors.Errorf("missing the DB_NAME named group in version pattern %q", versionPattern)
+	}
+	return nil
+}
@@ -150,3 +150,35 @@ func TestValidateRepositorySchemaPathTemplate(t *testing.T) {
 		}
 	}
 }
+
+func TestCompileRepositoryPathGlob(t *testing.T) {
+	tests := []struct {
+		pathGlob string
+		path     string
+		match    bool
+	}{
+		{"services/payment/**/*.sql", "services/payment/migrations/001__init.sql", true},
+		{"services/payment/**/*.sql", "services/payment/001__init.sql", true},
+		{"services/payment/**/*.sql", "services/order/001__init.sql", false},
+		{"services/*/001.sql", "services/payment/001.sql", true},
+		{"services/*/001.sql", "services/payment/v1/001.sql", false},
+		{"db?.sql", "db1.sql", true},
+		{"db?.sql", "db12.sql", false},
+		{"a.b/*.sql", "aXb/1.sql", false},
+		{"**", "any/path.sql", true},
+	}
+	for _, test := range tests {
+		pattern, err := CompileRepositoryPathGlob(test.pathGlob)
+		require.NoError(t, err)
+		require.Equal(t, test.match, pattern.MatchString(test.path), "glob %q, path %q", test.pathGlob, test.path)
+	}
+}
+
+func TestValidateRepositoryRoutingRule(t *testing.T) {
+	require.NoError(t, ValidateRepositoryRoutingRule("payment/*.sql", `(?P<DB_NAME>\w+)__(?P<VERSION>\d+)\.sql$`, false))
+	require.NoError(t, ValidateRepositoryRoutingRule("payment/*.sql", `(?P<VERSION>\d+)\.sql$`, true))
+	require.Error(t, ValidateRepositoryRoutingRule("payment/*.sql", `(?P<VERSION>\d+)\.sql$`, false))
+	require.Error(t, ValidateRepositoryRoutingRule("payment/*.sql", `(?P<DB_NAME>\w+)\.sql$`, true))
+	require.Error(t, ValidateRepositoryRoutingRule("", `(?P<VERSION>\d+)`, true))
+	require.Error(t, ValidateRepositoryRoutingRule("payment/*.sql", `(?P<VERSION>\d+`, true))
+}
@@ -44,6 +44,38 @@ const (
 	SettingSemanticTypes SettingName = "bb.workspace.semantic-types"
 	// SettingMaskingAlgorithms is the setting name for masking algorithms.
 	SettingMaskingAlgorithm SettingName = "bb.workspace.masking-algorithm"
+	// SettingMaskingSalt is the setting name for the per-workspace salt of the deterministic masking algorithms.
+	SettingMaskingSalt SettingName = "bb.workspace.masking-salt"
+	// SettingRateLimit is the setting name for API rate limits.
+	SettingRateLimit SettingName = "bb.workspace.rate-limit"
+	// SettingQueryAudit is the setting name for the query audit sampling and anomaly scoring.
+	SettingQueryAudit SettingName = "bb.workspace.query-audit"
+	// SettingQueryCursor is the setting name for the limits of the query cursors.
+	SettingQueryCursor SettingName = "bb.workspace.query-cursor"
+	// SettingQueryHistory is the setting name for the recording and retention of the SQL Editor query history.
+	SettingQueryHistory SettingName = "bb.workspace.query-history"
+	// SettingArchive is the setting name for archiving the stale issues and databases.
+	SettingArchive SettingName = "bb.workspace.archive"
+	// SettingJira is the setting name for the Jira integration syncing the issue lifecycle.
+	SettingJira SettingName = "bb.workspace.jira"
+	// SettingServiceNow is the setting name for the ServiceNow change management integration.
+	SettingServiceNow SettingName = "bb.workspace.servicenow"
+	// SettingSlackApp is the setting name for the Slack app answering the approvals and slash commands.
+	SettingSlackApp SettingName = "bb.app.slack"
+	// SettingEventBus is the setting name for publishing the workspace events to Kafka or NATS.
+	SettingEventBus SettingName = "bb.workspace.event-bus"
+	// SettingSCIM is the setting name for the SCIM provisioning of the users and groups from the identity provider.
+	SettingSCIM SettingName = "bb.workspace.scim"
+	// SettingAuditLog is the setting name for the retention and the export of the audit logs.
+	SettingAuditLog SettingName = "bb.workspace.audit-log"
+	// SettingAuditLogExportCursor is the setting name for the ID of the last exported audit log.
+	SettingAuditLogExportCursor SettingName = "bb.workspace.audit-log-export-cursor"
+	// SettingMaintenance is the setting name for the maintenance mode.
+	SettingMaintenance SettingName = "bb.workspace.maintenance"
+	// SettingInstanceDiscovery is the setting name for the cloud accounts the instances are discovered from.
+	SettingInstanceDiscovery SettingName = "bb.workspace.instance-discovery"
+	// SettingDiscoveredInstances is the setting name for the discovered instances which are not registered yet.
+	SettingDiscoveredInstances SettingName = "bb.workspace.discovered-instances"
 )
 
 // IMType is the type of IM.
@@ -50,6 +50,10 @@ const (
 	TaskDatabaseRestorePITRRestore TaskType = "bb.task.database.restore.pitr.restore"
 	// TaskDatabaseRestorePITRCutover is the task type for swapping the pitr and original database.
 	TaskDatabaseRestorePITRCutover TaskType = "bb.task.database.restore.pitr.cutover"
+	// TaskDatabaseDataProvision is the task type for copying the masked data of another database.
+	TaskDatabaseDataProvision TaskType = "bb.task.database.data.provision"
+	// TaskDatabaseDataMigrate is the task type for copying the tables of another database in chunks.
+	TaskDatabaseDataMigrate TaskType = "bb.task.database.data.migrate"
 )
 
 // These payload types are only used when marshalling to the json format for saving into the database.
@@ -131,6 +135,52 @@ type TaskDatabaseSchemaUpdatePayload struct {
 	SheetID         int    `json:"sheetId,omitempty"`
 	SchemaVersion   string `json:"schemaVersion,omitempty"`
 	SchemaGroupName string `json:"schemaGroupName,omitempty"`
+
+	// OnlineMigrationConfig is set if the PostgreSQL DDL statements are rewritten to the concurrent-safe equivalents.
+	OnlineMigrationConfig *OnlineMigrationConfig `json:"onlineMigrationConfig,omitempty"`
+	// OnlineMigrationCheckpoint is the number of the completed steps, it's used to resume the task after failure.
+	OnlineMigrationCheckpoint *OnlineMigrationCheckpoint `json:"onlineMigrationCheckpoint,omitempty"`
+	// Transactional is set if the whole migration runs in a single transaction.
+	Transactional bool `json:"transactional,omitempty"`
+	// Resumable is set if the statements are executed one by one and a retry skips the applied ones.
+	Resumable bool `json:"resumable,omitempty"`
+	// PreChecks are the checks run before applying the migration.
+	PreChecks []*MigrationCheck `json:"preChecks,omitempty"`
+	// PostChecks are the checks run after applying the migration.
+	PostChecks []*MigrationCheck `json:"postChecks,omitempty"`
+
+	// Build the RollbackSheetID if RollbackEnabled.
+	RollbackEnabled bool `json:"rollbackEnabled,omitempty"`
+	// RollbackSQLStatus is the status of the rollback generation.
+	RollbackSQLStatus RollbackSQLStatus `json:"rollbackSqlStatus,omitempty"`
+	RollbackError     string            `json:"rollbackError,omitempty"`
+	// RollbackSheetID is the generated rollback DDL statement for the DDL task.
+	RollbackSheetID int `json:"rollbackSheetId,omitempty"`
+	// RollbackFromIssueID is the issue ID containing the original task from which the rollback DDL statement is generated for this task.
+	RollbackFromIssueID int `json:"rollbackFromIssueId,omitempty"`
+	// RollbackFromTaskID is the task ID from which the rollback DDL statement is generated for this task.
+	RollbackFromTaskID int `json:"rollbackFromTaskId,omitempty"`
+	// MigrationID is the ID of the migration history record, the rollback DDL statement is generated from its schema snapshots.
+	MigrationID string `json:"migrationId,omitempty"`
+}
+
+// OnlineMigrationConfig is the config of the PostgreSQL online migration.
+type OnlineMigrationConfig struct {
+	PgRepack bool `json:"pgRepack,omitempty"`
+}
+
+// OnlineMigrationCheckpoint is the progress of the online migration steps.
+type OnlineMigrationCheckpoint struct {
+	// StepIndex is the index of the next step to run.
+	StepIndex int `json:"stepIndex"`
+}
+
+// MigrationCheck is a query run against the database before or after applying the migration.
+type MigrationCheck struct {
+	Statement string `json:"statement,omitempty"`
+	// ExpectedValue is the expected first column of the single row returned by the query.
+	// If it's nil, the query must return zero rows.
+	ExpectedValue *string `json:"expectedValue,omitempty"`
 }
 
 // TaskDatabaseSchemaUpdateSDLPayload is the task payload for database schema update (SDL).
@@ -222,6 +272,36 @@ type TaskDatabaseDataUpdatePayload struct {
 	PreUpdateBackupDetail PreUpdateBackupDetail `json:"preUpdateBackupDetail,omitempty"`
 
 	SchemaGroupName string `json:"schemaGroupName,omitempty"`
+
+	// BatchConfig is set if the UPDATE and DELETE statements are executed in primary key range chunks.
+	BatchConfig *BatchDMLConfig `json:"batchConfig,omitempty"`
+	// BatchCheckpoint is the position after the last committed chunk, it's used to resume the task after failure.
+	BatchCheckpoint *BatchDMLCheckpoint `json:"batchCheckpoint,omitempty"`
+	// Transactional is set if the whole migration runs in a single transaction.
+	Transactional bool `json:"transactional,omitempty"`
+	// Resumable is set if the statements are executed one by one and a retry skips the applied ones.
+	Resumable bool `json:"resumable,omitempty"`
+	// PreChecks are the checks run before applying the migration.
+	PreChecks []*MigrationCheck `json:"preChecks,omitempty"`
+	// PostChecks are the checks run after applying the migration.
+	PostChecks []*MigrationCheck `json:"postChecks,omitempty"`
+}
+
+// BatchDMLConfig is the config of executing the DML statements in chunks.
+type BatchDMLConfig struct {
+	BatchSize       int   `json:"batchSize,omitempty"`
+	SleepIntervalMs int64 `json:"sleepIntervalMs,omitempty"`
+}
+
+// BatchDMLCheckpoint is the progress of the committed chunks.
+type BatchDMLCheckpoint struct {
+	// StatementIndex is the index of the statement in progress.
+	StatementIndex int `json:"statementIndex"`
+	// NextKey is the lower bound of the primary key of the next chunk of the statement in progress.
+	// It's nil if no chunk of the statement has been committed.
+	NextKey *int64 `json:"nextKey,omitempty"`
+	// RowsCompleted is the number of rows changed by the committed chunks.
+	RowsCompleted int64 `json:"rowsCompleted,omitempty"`
 }
 
 type PreUpdateBackupDetail struct {
@@ -240,6 +320,49 @@ type TaskDatabaseBackupPayload struct {
 	BackupID int `json:"backupId,omitempty"`
 }
 
+// TaskDatabaseDataProvisionPayload is the task payload for copying the masked data of another database.
+type TaskDatabaseDataProvisionPayload struct {
+	// Common fields
+	Skipped       bool   `json:"skipped,omitempty"`
+	SkippedReason string `json:"skippedReason,omitempty"`
+	SpecID        string `json:"specId,omitempty"`
+
+	// SourceDatabaseID is the ID of the database to copy the data from.
+	SourceDatabaseID int `json:"sourceDatabaseId,omitempty"`
+	// Tables are the tables to copy in the format of {schema}.{table} or {table}, all tables are copied if empty.
+	Tables []string `json:"tables,omitempty"`
+}
+
+// TaskDatabaseDataMigratePayload is the task payload for copying the tables of another database in chunks.
+type TaskDatabaseDataMigratePayload struct {
+	// Common fields
+	Skipped       bool   `json:"skipped,omitempty"`
+	SkippedReason string `json:"skippedReason,omitempty"`
+	SpecID        string `json:"specId,omitempty"`
+
+	// SourceDatabaseID is the ID of the database to copy the data from.
+	SourceDatabaseID int `json:"sourceDatabaseId,omitempty"`
+	// Tables are the tables to copy in the format of {schema}.{table} or {table}.
+	Tables []string `json:"tables,omitempty"`
+	// ChunkSize is the number of rows copied in a transaction.
+	ChunkSize int `json:"chunkSize,omitempty"`
+	// ClearTarget deletes the existing rows of the target tables before copying.
+	ClearTarget bool `json:"clearTarget,omitempty"`
+	// Checkpoint is the position after the last committed chunk, it's used to resume the task after failure.
+	Checkpoint *DataMigrateCheckpoint `json:"checkpoint,omitempty"`
+}
+
+// DataMigrateCheckpoint is the progress of the committed chunks.
+type DataMigrateCheckpoint struct {
+	// TableIndex is the index of the table in progress.
+	TableIndex int `json:"tableIndex"`
+	// LastKey is the SQL literals of the primary key of the last copied row of the table in progress.
+	// It's nil if no chunk of the table has been committed.
+	LastKey []string `json:"lastKey,omitempty"`
+	// RowsCompleted is the number of rows copied by the committed chunks.
+	RowsCompleted int64 `json:"rowsCompleted,omitempty"`
+}
+
 // Progress is a generalized struct which can track the progress of a task.
 type Progress struct {
 	// TotalUnit is the total unit count of the task
@@ -261,9 +384,12 @@ type TaskFind struct {
 	IDs *[]int
 
 	// Related fields
-	PipelineID *int
-	StageID    *int
-	DatabaseID *int
+	PipelineID    *int
+	StageID       *int
+	DatabaseID    *int
+	InstanceID    *int
+	ProjectUID    *int
+	EnvironmentID *int
 
 	// Domain specific fields
 	StatusList *[]TaskStatus
@@ -1,5 +1,9 @@
 package api
 
+import (
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
 // TaskRunStatus is the status of a task run.
 type TaskRunStatus string
 
@@ -28,4 +32,6 @@ type TaskRunResultPayload struct {
 	MigrationID   string `json:"migrationId,omitempty"`
 	ChangeHistory string `json:"changeHistory,omitempty"`
 	Version       string `json:"version,omitempty"`
+	// CheckResults are the results of the pre-checks and post-checks of the migration.
+	CheckResults []*storepb.TaskRunResult_CheckResult `json:"checkResults,omitempty"`
 }
@@ -30,4 +30,9 @@ type VCSSQLReviewRequest struct {
 	// In GitHub, the URL should be "https://github.com". Docs: https://docs.github.com/en/actions/learn-github-actions/environment-variables
 	// In GitLab, the URL should be the base URL of the GitLab instance like "https://gitlab.bytebase.com". Docs: https://docs.gitlab.com/ee/ci/variables/predefined_variables.html
 	WebURL string `json:"webURL"`
+	// HeadSHA is the head commit of the pull request, which the SQL review check run is created for.
+	HeadSHA string `json:"headSHA"`
+	// CheckRunToken is the GITHUB_TOKEN of the GitHub action to create the SQL review check run.
+	// GitHub only allows the GitHub Apps to create the check runs, so the OAuth token of the repository cannot be used.
+	CheckRunToken string `json:"checkRunToken"`
 }
@@ -44,6 +44,8 @@ const (
 	PrincipalCreateMetricName metric.Name = "bb.principal.create"
 	// APIRequestMetricName is the metric name for api request.
 	APIRequestMetricName metric.Name = "bb.api.request"
+	// APIRateLimitedMetricName is the metric name for api request rejected by the rate limit.
+	APIRateLimitedMetricName metric.Name = "bb.api.rate-limited"
 	// InstanceCreateMetricName is the metric name for instance creation event.
 	InstanceCreateMetricName metric.Name = "bb.instance.create"
 )
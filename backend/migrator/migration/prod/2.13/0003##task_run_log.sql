@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS task_run_log (
+    id BIGSERIAL PRIMARY KEY,
+    task_run_id INTEGER NOT NULL REFERENCES task_run (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    -- payload saves the gzip compressed log content.
+    payload BYTEA NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_run_log_task_run_id ON task_run_log(task_run_id);
+
+CREATE INDEX IF NOT EXISTS idx_task_run_log_created_ts ON task_run_log(created_ts);
+
+ALTER SEQUENCE task_run_log_id_seq RESTART WITH 101;
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS sandbox_database (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    source_database_id INTEGER NOT NULL REFERENCES db (id),
+    -- instance_id is the sandbox instance.
+    instance_id INTEGER NOT NULL REFERENCES instance (id),
+    name TEXT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'READY', 'FAILED', 'DROPPED')),
+    expire_ts BIGINT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX IF NOT EXISTS idx_sandbox_database_source_database_id ON sandbox_database(source_database_id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sandbox_database_unique_instance_id_name ON sandbox_database(instance_id, name) WHERE status <> 'DROPPED';
+
+ALTER SEQUENCE sandbox_database_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_sandbox_database_updated_ts
+BEFORE
+UPDATE
+    ON sandbox_database FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
@@ -0,0 +1 @@
+ALTER TABLE project_webhook ADD COLUMN IF NOT EXISTS payload JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,2 @@
+ALTER TABLE instance_change_history DROP CONSTRAINT IF EXISTS instance_change_history_source_check;
+ALTER TABLE instance_change_history ADD CONSTRAINT instance_change_history_source_check CHECK (source IN ('UI', 'VCS', 'LIBRARY', 'FLYWAY', 'LIQUIBASE'));
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS task_run_artifact (
+    id BIGSERIAL PRIMARY KEY,
+    task_run_id INTEGER NOT NULL REFERENCES task_run (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    type TEXT NOT NULL,
+    name TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size BIGINT NOT NULL,
+    -- storage_backend and path locate the content of the artifact in the storage.
+    storage_backend TEXT NOT NULL,
+    path TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_run_artifact_task_run_id ON task_run_artifact(task_run_id);
+
+CREATE INDEX IF NOT EXISTS idx_task_run_artifact_created_ts ON task_run_artifact(created_ts);
+
+ALTER SEQUENCE task_run_artifact_id_seq RESTART WITH 101;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS export_audit (
+    id BIGSERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    instance_id INTEGER NOT NULL REFERENCES instance (id),
+    database_id INTEGER NULL REFERENCES db (id),
+    statement TEXT NOT NULL,
+    format TEXT NOT NULL,
+    -- columns and masked_columns are the names of the exported columns and the masked ones among them.
+    columns JSONB NOT NULL DEFAULT '[]',
+    masked_columns JSONB NOT NULL DEFAULT '[]',
+    row_count BIGINT NOT NULL,
+    admin BOOLEAN NOT NULL DEFAULT FALSE,
+    watermark TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_export_audit_creator_id ON export_audit(creator_id);
+
+CREATE INDEX IF NOT EXISTS idx_export_audit_instance_id_database_id ON export_audit(instance_id, database_id);
+
+CREATE INDEX IF NOT EXISTS idx_export_audit_created_ts ON export_audit(created_ts);
+
+ALTER SEQUENCE export_audit_id_seq RESTART WITH 101;
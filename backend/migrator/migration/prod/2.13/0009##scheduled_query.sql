@@ -0,0 +1,44 @@
+CREATE TABLE IF NOT EXISTS scheduled_query (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    database_id INTEGER NOT NULL REFERENCES db (id),
+    title TEXT NOT NULL,
+    statement TEXT NOT NULL,
+    -- schedule is the cron expression in UTC.
+    schedule TEXT NOT NULL,
+    next_run_ts BIGINT NOT NULL,
+    -- Stored as ScheduledQueryPayload (proto/store/scheduled_query.proto)
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_query_creator_id ON scheduled_query(creator_id);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_query_next_run_ts ON scheduled_query(next_run_ts);
+
+ALTER SEQUENCE scheduled_query_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_scheduled_query_updated_ts
+BEFORE
+UPDATE
+    ON scheduled_query FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
+
+CREATE TABLE IF NOT EXISTS scheduled_query_result (
+    id BIGSERIAL PRIMARY KEY,
+    scheduled_query_id INTEGER NOT NULL REFERENCES scheduled_query (id) ON DELETE CASCADE,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    status TEXT NOT NULL CHECK (status IN ('DONE', 'FAILED')),
+    row_count BIGINT NOT NULL DEFAULT 0,
+    -- content is the result in CSV.
+    content TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    expire_ts BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_query_result_scheduled_query_id ON scheduled_query_result(scheduled_query_id);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_query_result_expire_ts ON scheduled_query_result(expire_ts);
+
+ALTER SEQUENCE scheduled_query_result_id_seq RESTART WITH 101;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS query_history (
+    id BIGSERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    instance_id INTEGER NOT NULL REFERENCES instance (id),
+    -- database_id is NULL if the query is against the instance.
+    database_id INTEGER NULL REFERENCES db (id),
+    statement TEXT NOT NULL,
+    duration_ns BIGINT NOT NULL DEFAULT 0,
+    row_count BIGINT NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    -- tables is the JSON array of the tables queried.
+    tables JSONB NOT NULL DEFAULT '[]'
+);
+
+CREATE INDEX IF NOT EXISTS idx_query_history_creator_id_created_ts ON query_history(creator_id, created_ts);
+
+CREATE INDEX IF NOT EXISTS idx_query_history_database_id ON query_history(database_id);
+
+CREATE INDEX IF NOT EXISTS idx_query_history_created_ts ON query_history(created_ts);
+
+ALTER SEQUENCE query_history_id_seq RESTART WITH 101;
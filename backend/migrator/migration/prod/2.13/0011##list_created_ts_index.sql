@@ -0,0 +1,5 @@
+CREATE INDEX IF NOT EXISTS idx_pipeline_created_ts ON pipeline(created_ts);
+
+CREATE INDEX IF NOT EXISTS idx_task_run_created_ts ON task_run(created_ts);
+
+CREATE INDEX IF NOT EXISTS idx_backup_created_ts ON backup(created_ts);
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS outbox (
+    id BIGSERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    -- dedup_key deduplicates the events enqueued more than once.
+    dedup_key TEXT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'DONE', 'FAILED')) DEFAULT 'PENDING',
+    -- Stored as OutboxEventPayload (proto/store/outbox.proto)
+    payload JSONB NOT NULL DEFAULT '{}',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    last_error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_outbox_unique_dedup_key ON outbox(dedup_key);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_status_next_attempt_ts ON outbox(status, next_attempt_ts);
+
+ALTER SEQUENCE outbox_id_seq RESTART WITH 101;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS stage_approval (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    stage_id INTEGER NOT NULL REFERENCES stage (id),
+    -- task_id is the task signed off, NULL if the whole stage is signed off.
+    task_id INTEGER REFERENCES task (id),
+    -- role is the approval gate role signed off as, e.g. roles/workspaceDBA.
+    role TEXT NOT NULL,
+    comment TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_stage_approval_stage_id ON stage_approval(stage_id);
+
+ALTER SEQUENCE stage_approval_id_seq RESTART WITH 101;
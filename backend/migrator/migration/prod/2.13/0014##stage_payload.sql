@@ -0,0 +1 @@
+ALTER TABLE stage ADD COLUMN IF NOT EXISTS payload JSONB NOT NULL DEFAULT '{}';
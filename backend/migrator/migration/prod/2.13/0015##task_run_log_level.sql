@@ -0,0 +1 @@
+ALTER TABLE task_run_log ADD COLUMN IF NOT EXISTS level TEXT NOT NULL DEFAULT 'INFO' CHECK (level IN ('DEBUG', 'INFO', 'WARN', 'ERROR'));
@@ -0,0 +1,2 @@
+ALTER TABLE task_run ADD COLUMN IF NOT EXISTS replica_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE task_run ADD COLUMN IF NOT EXISTS heartbeat_ts BIGINT NOT NULL DEFAULT 0;
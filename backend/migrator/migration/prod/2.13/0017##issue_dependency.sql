@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS issue_dependency (
+    issue_id INTEGER NOT NULL REFERENCES issue (id),
+    blocking_issue_id INTEGER NOT NULL REFERENCES issue (id),
+    PRIMARY KEY (issue_id, blocking_issue_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_issue_dependency_blocking_issue_id ON issue_dependency(blocking_issue_id);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS webhook_delivery (
+    id BIGSERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    webhook_id INTEGER NOT NULL REFERENCES project_webhook (id) ON DELETE CASCADE,
+    activity_type TEXT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('SUCCEEDED', 'FAILED', 'DEAD', 'REDELIVERED')),
+    attempt INTEGER NOT NULL,
+    webhook_context JSONB NOT NULL DEFAULT '{}',
+    error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_webhook_id_created_ts ON webhook_delivery(webhook_id, created_ts);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_status_created_ts ON webhook_delivery(status, created_ts);
+
+ALTER SEQUENCE webhook_delivery_id_seq RESTART WITH 101;
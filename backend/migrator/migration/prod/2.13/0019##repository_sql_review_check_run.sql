@@ -0,0 +1 @@
+ALTER TABLE repository ADD COLUMN IF NOT EXISTS enable_sql_review_check_run BOOLEAN NOT NULL DEFAULT false;
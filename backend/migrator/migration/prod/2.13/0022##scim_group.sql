@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS scim_group (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    resource_id TEXT NOT NULL,
+    display_name TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_scim_group_unique_resource_id ON scim_group(resource_id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_scim_group_unique_display_name ON scim_group(display_name);
+
+ALTER SEQUENCE scim_group_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_scim_group_updated_ts
+BEFORE
+UPDATE
+    ON scim_group FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
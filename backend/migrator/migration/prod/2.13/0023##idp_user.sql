@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS idp_user (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    idp_id INTEGER NOT NULL REFERENCES idp (id),
+    principal_id INTEGER NOT NULL REFERENCES principal (id),
+    identifier TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_idp_user_unique_idp_id_principal_id ON idp_user(idp_id, principal_id);
+
+ALTER SEQUENCE idp_user_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_idp_user_updated_ts
+BEFORE
+UPDATE
+    ON idp_user FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
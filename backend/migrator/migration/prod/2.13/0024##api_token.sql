@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS api_token (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    principal_id INTEGER NOT NULL REFERENCES principal (id),
+    title TEXT NOT NULL DEFAULT '',
+    token_hash TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}',
+    expire_ts BIGINT NOT NULL DEFAULT 0,
+    last_used_ts BIGINT NOT NULL DEFAULT 0,
+    revoked_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_token_unique_token_hash ON api_token(token_hash);
+
+CREATE INDEX IF NOT EXISTS idx_api_token_principal_id ON api_token(principal_id);
+
+ALTER SEQUENCE api_token_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_api_token_updated_ts
+BEFORE
+UPDATE
+    ON api_token FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS user_session (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    principal_id INTEGER NOT NULL REFERENCES principal (id),
+    ip_address TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    expire_ts BIGINT NOT NULL,
+    last_active_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    revoked_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_session_principal_id ON user_session(principal_id);
+
+ALTER SEQUENCE user_session_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_user_session_updated_ts
+BEFORE
+UPDATE
+    ON user_session FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
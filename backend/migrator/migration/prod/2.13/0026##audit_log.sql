@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    -- creator_id is NULL if the request is not authenticated, e.g. the failed login.
+    creator_id INTEGER REFERENCES principal (id),
+    method TEXT NOT NULL,
+    resource TEXT NOT NULL DEFAULT '',
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_created_ts ON audit_log(created_ts);
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_creator_id ON audit_log(creator_id);
+
+ALTER SEQUENCE audit_log_id_seq RESTART WITH 101;
+
+CREATE OR REPLACE FUNCTION trigger_audit_log_append_only()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_append_only
+BEFORE
+UPDATE
+    ON audit_log FOR EACH ROW
+EXECUTE FUNCTION trigger_audit_log_append_only();
@@ -0,0 +1,4 @@
+ALTER TYPE resource_type ADD VALUE IF NOT EXISTS 'ENVIRONMENT_CLASS';
+
+-- class is the environment class inheriting the policies, e.g. PROD. Empty means the environment doesn't inherit the policies.
+ALTER TABLE environment ADD COLUMN IF NOT EXISTS class TEXT NOT NULL DEFAULT '';
@@ -173,6 +173,134 @@ UPDATE
     ON member FOR EACH ROW
 EXECUTE FUNCTION trigger_update_updated_ts();
 
+-- scim_group table stores the groups provisioned by the identity provider through SCIM.
+CREATE TABLE scim_group (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    -- resource_id is the SCIM id of the group.
+    resource_id TEXT NOT NULL,
+    display_name TEXT NOT NULL,
+    -- payload stores the external ID and the members of the group.
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX idx_scim_group_unique_resource_id ON scim_group(resource_id);
+
+CREATE UNIQUE INDEX idx_scim_group_unique_display_name ON scim_group(display_name);
+
+ALTER SEQUENCE scim_group_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_scim_group_updated_ts
+BEFORE
+UPDATE
+    ON scim_group FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
+
+-- idp_user table stores the users signed in through the identity providers.
+CREATE TABLE idp_user (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    idp_id INTEGER NOT NULL REFERENCES idp (id),
+    principal_id INTEGER NOT NULL REFERENCES principal (id),
+    -- identifier is the unique identifier of the user in the identity provider.
+    identifier TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_idp_user_unique_idp_id_principal_id ON idp_user(idp_id, principal_id);
+
+ALTER SEQUENCE idp_user_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_idp_user_updated_ts
+BEFORE
+UPDATE
+    ON idp_user FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
+
+-- api_token table stores the API tokens of the users.
+-- Only the SHA-256 hash of the token is stored. expire_ts, last_used_ts and revoked_ts are 0 if not set.
+CREATE TABLE api_token (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    principal_id INTEGER NOT NULL REFERENCES principal (id),
+    title TEXT NOT NULL DEFAULT '',
+    token_hash TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}',
+    expire_ts BIGINT NOT NULL DEFAULT 0,
+    last_used_ts BIGINT NOT NULL DEFAULT 0,
+    revoked_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE UNIQUE INDEX idx_api_token_unique_token_hash ON api_token(token_hash);
+
+CREATE INDEX idx_api_token_principal_id ON api_token(principal_id);
+
+ALTER SEQUENCE api_token_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_api_token_updated_ts
+BEFORE
+UPDATE
+    ON api_token FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
+
+-- user_session table stores the sign-in sessions of the users.
+-- The ID of the session is the ID claim of the access token. revoked_ts is 0 if not revoked.
+CREATE TABLE user_session (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    principal_id INTEGER NOT NULL REFERENCES principal (id),
+    ip_address TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    expire_ts BIGINT NOT NULL,
+    last_active_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    revoked_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_user_session_principal_id ON user_session(principal_id);
+
+ALTER SEQUENCE user_session_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_user_session_updated_ts
+BEFORE
+UPDATE
+    ON user_session FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
+
+-- audit_log table stores the append-only audit logs of the mutating API calls and the SQL editor queries and exports.
+-- The rows are only deleted by the retention policy.
+CREATE TABLE audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    -- creator_id is NULL if the request is not authenticated, e.g. the failed login.
+    creator_id INTEGER REFERENCES principal (id),
+    method TEXT NOT NULL,
+    resource TEXT NOT NULL DEFAULT '',
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_audit_log_created_ts ON audit_log(created_ts);
+
+CREATE INDEX idx_audit_log_creator_id ON audit_log(creator_id);
+
+ALTER SEQUENCE audit_log_id_seq RESTART WITH 101;
+
+CREATE OR REPLACE FUNCTION trigger_audit_log_append_only()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_append_only
+BEFORE
+UPDATE
+    ON audit_log FOR EACH ROW
+EXECUTE FUNCTION trigger_audit_log_append_only();
+
 -- Environment
 CREATE TABLE environment (
     id SERIAL PRIMARY KEY,
@@ -183,7 +311,9 @@ CREATE TABLE environment (
     updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
     name TEXT NOT NULL,
     "order" INTEGER NOT NULL CHECK ("order" >= 0),
-    resource_id TEXT NOT NULL
+    resource_id TEXT NOT NULL,
+    -- class is the environment class inheriting the policies, e.g. PROD. Empty means the environment doesn't inherit the policies.
+    class TEXT NOT NULL DEFAULT ''
 );
 
 CREATE UNIQUE INDEX idx_environment_unique_name ON environment(name);
@@ -201,7 +331,7 @@ EXECUTE FUNCTION trigger_update_updated_ts();
 -- Policy
 -- policy stores the policies for each environment.
 -- Policies are associated with environments. Since we may have policies not associated with environment later, we name the table policy.
-CREATE TYPE resource_type AS ENUM ('WORKSPACE', 'ENVIRONMENT', 'PROJECT', 'INSTANCE', 'DATABASE');
+CREATE TYPE resource_type AS ENUM ('WORKSPACE', 'ENVIRONMENT', 'PROJECT', 'INSTANCE', 'DATABASE', 'ENVIRONMENT_CLASS');
 
 CREATE TABLE policy (
     id SERIAL PRIMARY KEY,
@@ -319,7 +449,8 @@ CREATE TABLE project_webhook (
     type TEXT NOT NULL CHECK (type LIKE 'bb.plugin.webhook.%'),
     name TEXT NOT NULL,
     url TEXT NOT NULL,
-    activity_list TEXT ARRAY NOT NULL
+    activity_list TEXT ARRAY NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'
 );
 
 CREATE INDEX idx_project_webhook_project_id ON project_webhook(project_id);
@@ -334,6 +465,26 @@ UPDATE
     ON project_webhook FOR EACH ROW
 EXECUTE FUNCTION trigger_update_updated_ts();
 
+-- webhook_delivery table stores the delivery history of the project webhooks.
+-- The deliveries failed after all the attempts are DEAD, which can be redelivered manually.
+CREATE TABLE webhook_delivery (
+    id BIGSERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    webhook_id INTEGER NOT NULL REFERENCES project_webhook (id) ON DELETE CASCADE,
+    activity_type TEXT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('SUCCEEDED', 'FAILED', 'DEAD', 'REDELIVERED')),
+    attempt INTEGER NOT NULL,
+    -- webhook_context is the JSON of the webhook context posted, which is posted again on redelivery.
+    webhook_context JSONB NOT NULL DEFAULT '{}',
+    error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_webhook_delivery_webhook_id_created_ts ON webhook_delivery(webhook_id, created_ts);
+
+CREATE INDEX idx_webhook_delivery_status_created_ts ON webhook_delivery(status, created_ts);
+
+ALTER SEQUENCE webhook_delivery_id_seq RESTART WITH 101;
+
 -- Instance
 CREATE TABLE instance (
     id SERIAL PRIMARY KEY,
@@ -505,6 +656,8 @@ CREATE INDEX idx_backup_database_id ON backup(database_id);
 
 CREATE UNIQUE INDEX idx_backup_unique_database_id_name ON backup(database_id, name);
 
+CREATE INDEX idx_backup_created_ts ON backup(created_ts);
+
 ALTER SEQUENCE backup_id_seq RESTART WITH 101;
 
 CREATE TRIGGER update_backup_updated_ts
@@ -544,6 +697,33 @@ UPDATE
     ON backup_setting FOR EACH ROW
 EXECUTE FUNCTION trigger_update_updated_ts();
 
+-- sandbox_database stores the sandbox databases cloned from the databases and dropped after they expire.
+CREATE TABLE sandbox_database (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    source_database_id INTEGER NOT NULL REFERENCES db (id),
+    -- instance_id is the sandbox instance.
+    instance_id INTEGER NOT NULL REFERENCES instance (id),
+    name TEXT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'READY', 'FAILED', 'DROPPED')),
+    expire_ts BIGINT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_sandbox_database_source_database_id ON sandbox_database(source_database_id);
+
+CREATE UNIQUE INDEX idx_sandbox_database_unique_instance_id_name ON sandbox_database(instance_id, name) WHERE status <> 'DROPPED';
+
+ALTER SEQUENCE sandbox_database_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_sandbox_database_updated_ts
+BEFORE
+UPDATE
+    ON sandbox_database FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
+
 -----------------------
 -- Pipeline related BEGIN
 -- pipeline table
@@ -558,6 +738,8 @@ CREATE TABLE pipeline (
     name TEXT NOT NULL
 );
 
+CREATE INDEX idx_pipeline_created_ts ON pipeline(created_ts);
+
 ALTER SEQUENCE pipeline_id_seq RESTART WITH 101;
 
 CREATE TRIGGER update_pipeline_updated_ts
@@ -576,7 +758,9 @@ CREATE TABLE stage (
     updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
     pipeline_id INTEGER NOT NULL REFERENCES pipeline (id),
     environment_id INTEGER NOT NULL REFERENCES environment (id),
-    name TEXT NOT NULL
+    name TEXT NOT NULL,
+    -- Stored as StagePayload (proto/store/stage.proto)
+    payload JSONB NOT NULL DEFAULT '{}'
 );
 
 CREATE INDEX idx_stage_pipeline_id ON stage(pipeline_id);
@@ -660,13 +844,19 @@ CREATE TABLE task_run (
     started_ts BIGINT NOT NULL DEFAULT 0,
     code INTEGER NOT NULL DEFAULT 0,
     -- result saves the task run result in json format
-    result  JSONB NOT NULL DEFAULT '{}'
+    result  JSONB NOT NULL DEFAULT '{}',
+    -- replica_id is the ID of the server replica executing the task run.
+    replica_id TEXT NOT NULL DEFAULT '',
+    -- heartbeat_ts is the last time the replica reported the task run is still executing.
+    heartbeat_ts BIGINT NOT NULL DEFAULT 0
 );
 
 CREATE INDEX idx_task_run_task_id ON task_run(task_id);
 
 CREATE UNIQUE INDEX uk_task_run_task_id_attempt ON task_run (task_id, attempt);
 
+CREATE INDEX idx_task_run_created_ts ON task_run(created_ts);
+
 ALTER SEQUENCE task_run_id_seq RESTART WITH 101;
 
 CREATE TRIGGER update_task_run_updated_ts
@@ -675,6 +865,40 @@ UPDATE
     ON task_run FOR EACH ROW
 EXECUTE FUNCTION trigger_update_updated_ts();
 
+CREATE TABLE task_run_log (
+    id BIGSERIAL PRIMARY KEY,
+    task_run_id INTEGER NOT NULL REFERENCES task_run (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    level TEXT NOT NULL DEFAULT 'INFO' CHECK (level IN ('DEBUG', 'INFO', 'WARN', 'ERROR')),
+    -- payload saves the gzip compressed log content.
+    payload BYTEA NOT NULL
+);
+
+CREATE INDEX idx_task_run_log_task_run_id ON task_run_log(task_run_id);
+
+CREATE INDEX idx_task_run_log_created_ts ON task_run_log(created_ts);
+
+ALTER SEQUENCE task_run_log_id_seq RESTART WITH 101;
+
+CREATE TABLE task_run_artifact (
+    id BIGSERIAL PRIMARY KEY,
+    task_run_id INTEGER NOT NULL REFERENCES task_run (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    type TEXT NOT NULL,
+    name TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size BIGINT NOT NULL,
+    -- storage_backend and path locate the content of the artifact in the storage.
+    storage_backend TEXT NOT NULL,
+    path TEXT NOT NULL
+);
+
+CREATE INDEX idx_task_run_artifact_task_run_id ON task_run_artifact(task_run_id);
+
+CREATE INDEX idx_task_run_artifact_created_ts ON task_run_artifact(created_ts);
+
+ALTER SEQUENCE task_run_artifact_id_seq RESTART WITH 101;
+
 -- Pipeline related END
 -----------------------
 -- Plan related BEGIN
@@ -784,6 +1008,16 @@ CREATE TABLE issue_subscriber (
 
 CREATE INDEX idx_issue_subscriber_subscriber_id ON issue_subscriber(subscriber_id);
 
+-- stores the issue dependencies, the issue cannot be rolled out until its blocking issues are done.
+-- The blocking issue may be in another project.
+CREATE TABLE issue_dependency (
+    issue_id INTEGER NOT NULL REFERENCES issue (id),
+    blocking_issue_id INTEGER NOT NULL REFERENCES issue (id),
+    PRIMARY KEY (issue_id, blocking_issue_id)
+);
+
+CREATE INDEX idx_issue_dependency_blocking_issue_id ON issue_dependency(blocking_issue_id);
+
 -- instance change history records the changes an instance and its databases.
 CREATE TABLE instance_change_history (
     id BIGSERIAL PRIMARY KEY,
@@ -804,8 +1038,8 @@ CREATE TABLE instance_change_history (
     -- Used to detect out of order migration together with 'namespace' and 'version' column.
     sequence BIGINT NOT NULL CONSTRAINT instance_change_history_sequence_check CHECK (sequence >= 0),
     -- We call it source because maybe we could load history from other migration tool.
-    -- Currently allowed values are UI, VCS, LIBRARY.
-    source TEXT NOT NULL CONSTRAINT instance_change_history_source_check CHECK (source IN ('UI', 'VCS', 'LIBRARY')),
+    -- Currently allowed values are UI, VCS, LIBRARY, FLYWAY, LIQUIBASE.
+    source TEXT NOT NULL CONSTRAINT instance_change_history_source_check CHECK (source IN ('UI', 'VCS', 'LIBRARY', 'FLYWAY', 'LIQUIBASE')),
     -- Currently allowed values are BASELINE, MIGRATE, MIGRATE_SDL, BRANCH, DATA.
     type TEXT NOT NULL CONSTRAINT instance_change_history_type_check CHECK (type IN ('BASELINE', 'MIGRATE', 'MIGRATE_SDL', 'BRANCH', 'DATA')),
     -- Currently allowed values are PENDING, DONE, FAILED.
@@ -867,6 +1101,140 @@ UPDATE
     ON activity FOR EACH ROW
 EXECUTE FUNCTION trigger_update_updated_ts();
 
+-- export_audit table stores the audit records of the exports of query results.
+CREATE TABLE export_audit (
+    id BIGSERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    instance_id INTEGER NOT NULL REFERENCES instance (id),
+    database_id INTEGER NULL REFERENCES db (id),
+    statement TEXT NOT NULL,
+    format TEXT NOT NULL,
+    -- columns and masked_columns are the names of the exported columns and the masked ones among them.
+    columns JSONB NOT NULL DEFAULT '[]',
+    masked_columns JSONB NOT NULL DEFAULT '[]',
+    row_count BIGINT NOT NULL,
+    admin BOOLEAN NOT NULL DEFAULT FALSE,
+    watermark TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_export_audit_creator_id ON export_audit(creator_id);
+
+CREATE INDEX idx_export_audit_instance_id_database_id ON export_audit(instance_id, database_id);
+
+CREATE INDEX idx_export_audit_created_ts ON export_audit(created_ts);
+
+ALTER SEQUENCE export_audit_id_seq RESTART WITH 101;
+
+-- scheduled_query table stores the queries run by the server on a schedule.
+CREATE TABLE scheduled_query (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    database_id INTEGER NOT NULL REFERENCES db (id),
+    title TEXT NOT NULL,
+    statement TEXT NOT NULL,
+    -- schedule is the cron expression in UTC.
+    schedule TEXT NOT NULL,
+    next_run_ts BIGINT NOT NULL,
+    -- Stored as ScheduledQueryPayload (proto/store/scheduled_query.proto)
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_scheduled_query_creator_id ON scheduled_query(creator_id);
+
+CREATE INDEX idx_scheduled_query_next_run_ts ON scheduled_query(next_run_ts);
+
+ALTER SEQUENCE scheduled_query_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_scheduled_query_updated_ts
+BEFORE
+UPDATE
+    ON scheduled_query FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
+
+-- scheduled_query_result table stores the result snapshots of the scheduled queries.
+CREATE TABLE scheduled_query_result (
+    id BIGSERIAL PRIMARY KEY,
+    scheduled_query_id INTEGER NOT NULL REFERENCES scheduled_query (id) ON DELETE CASCADE,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    status TEXT NOT NULL CHECK (status IN ('DONE', 'FAILED')),
+    row_count BIGINT NOT NULL DEFAULT 0,
+    -- content is the result in CSV.
+    content TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    expire_ts BIGINT NOT NULL
+);
+
+CREATE INDEX idx_scheduled_query_result_scheduled_query_id ON scheduled_query_result(scheduled_query_id);
+
+CREATE INDEX idx_scheduled_query_result_expire_ts ON scheduled_query_result(expire_ts);
+
+ALTER SEQUENCE scheduled_query_result_id_seq RESTART WITH 101;
+
+-- query_history table stores the history of the queries in the SQL Editor.
+CREATE TABLE query_history (
+    id BIGSERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    instance_id INTEGER NOT NULL REFERENCES instance (id),
+    -- database_id is NULL if the query is against the instance.
+    database_id INTEGER NULL REFERENCES db (id),
+    statement TEXT NOT NULL,
+    duration_ns BIGINT NOT NULL DEFAULT 0,
+    row_count BIGINT NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    -- tables is the JSON array of the tables queried.
+    tables JSONB NOT NULL DEFAULT '[]'
+);
+
+CREATE INDEX idx_query_history_creator_id_created_ts ON query_history(creator_id, created_ts);
+
+CREATE INDEX idx_query_history_database_id ON query_history(database_id);
+
+CREATE INDEX idx_query_history_created_ts ON query_history(created_ts);
+
+ALTER SEQUENCE query_history_id_seq RESTART WITH 101;
+
+-- outbox table stores the side effects of the activities such as the webhooks, which are delivered at least once by the dispatcher.
+CREATE TABLE outbox (
+    id BIGSERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    -- dedup_key deduplicates the events enqueued more than once.
+    dedup_key TEXT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'DONE', 'FAILED')) DEFAULT 'PENDING',
+    -- Stored as OutboxEventPayload (proto/store/outbox.proto)
+    payload JSONB NOT NULL DEFAULT '{}',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    last_error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX idx_outbox_unique_dedup_key ON outbox(dedup_key);
+
+CREATE INDEX idx_outbox_status_next_attempt_ts ON outbox(status, next_attempt_ts);
+
+ALTER SEQUENCE outbox_id_seq RESTART WITH 101;
+
+-- stage_approval table stores the sign-offs of the approval gates of the stages and the tasks.
+CREATE TABLE stage_approval (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    stage_id INTEGER NOT NULL REFERENCES stage (id),
+    -- task_id is the task signed off, NULL if the whole stage is signed off.
+    task_id INTEGER REFERENCES task (id),
+    -- role is the approval gate role signed off as, e.g. roles/workspaceDBA.
+    role TEXT NOT NULL,
+    comment TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_stage_approval_stage_id ON stage_approval(stage_id);
+
+ALTER SEQUENCE stage_approval_id_seq RESTART WITH 101;
+
 -- inbox table stores the inbox entry for the corresponding activity.
 -- Unlike other tables, it doesn't have row_status/creator_id/created_ts/updater_id/updated_ts.
 -- We design in this way because:
@@ -916,7 +1284,7 @@ CREATE TABLE vcs (
     updater_id INTEGER NOT NULL REFERENCES principal (id),
     updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
     name TEXT NOT NULL,
-    type TEXT NOT NULL CHECK (type IN ('GITLAB', 'GITHUB', 'BITBUCKET', 'AZURE_DEVOPS')),
+    type TEXT NOT NULL CHECK (type IN ('GITLAB', 'GITHUB', 'BITBUCKET', 'AZURE_DEVOPS', 'GERRIT')),
     instance_url TEXT NOT NULL CHECK ((instance_url LIKE 'http://%' OR instance_url LIKE 'https://%') AND instance_url = rtrim(instance_url, '/')),
     api_url TEXT NOT NULL CHECK ((api_url LIKE 'http://%' OR api_url LIKE 'https://%') AND api_url = rtrim(api_url, '/')),
     application_id TEXT NOT NULL,
@@ -961,6 +1329,8 @@ CREATE TABLE repository (
     file_path_template TEXT NOT NULL DEFAULT '',
     -- If enable the SQL review CI in VCS repository.
     enable_sql_review_ci BOOLEAN NOT NULL DEFAULT false,
+    -- If report the SQL review results as the GitHub check runs.
+    enable_sql_review_check_run BOOLEAN NOT NULL DEFAULT false,
     -- If enabled, create an issue on commits.
     enable_cd BOOLEAN NOT NULL DEFAULT false,
     -- The file path template for storing the latest schema auto-generated by Bytebase after migration.
@@ -983,7 +1353,9 @@ CREATE TABLE repository (
     -- access_token, expires_ts, refresh_token belongs to the user linking the project to the VCS repository.
     access_token TEXT NOT NULL,
     expires_ts BIGINT NOT NULL,
-    refresh_token TEXT NOT NULL
+    refresh_token TEXT NOT NULL,
+    -- Stored as RepositoryPayload (proto/store/repository.proto)
+    payload JSONB NOT NULL DEFAULT '{}'
 );
 
 CREATE UNIQUE INDEX idx_repository_unique_project_id ON repository(project_id);
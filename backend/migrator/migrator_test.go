@@ -230,5 +230,5 @@ func TestMigrationCompatibility(t *testing.T) {
 func TestGetCutoffVersion(t *testing.T) {
 	releaseVersion, err := getProdCutoffVersion()
 	require.NoError(t, err)
-	require.Equal(t, semver.MustParse("2.13.2"), releaseVersion)
+	require.Equal(t, semver.MustParse("2.13.27"), releaseVersion)
 }
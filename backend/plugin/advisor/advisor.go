@@ -13,6 +13,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/plugin/advisor/catalog"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
@@ -63,6 +64,9 @@ const (
 	// Fake is a fake advisor type for testing.
 	Fake Type = "bb.plugin.advisor.fake"
 
+	// CustomExpression is an advisor type for user-provided CEL expressions, shared by all engines.
+	CustomExpression Type = "bb.plugin.advisor.custom.expression"
+
 	// MySQL Advisor.
 
 	// MySQLSyntax is an advisor type for MySQL syntax.
@@ -493,6 +497,8 @@ type Advice struct {
 	Line    int    `json:"line"`
 	Column  int    `json:"column"`
 	Details string `json:"details,omitempty"`
+	// Fix is the rewritten statement which resolves the advice, empty if the rule cannot fix it automatically.
+	Fix string `json:"fix,omitempty"`
 }
 
 // SyntaxMode is the type of syntax mode.
@@ -522,6 +528,47 @@ type Context struct {
 	CurrentDatabase string
 	// CurrentSchema is the current schema. Special for Oracle.
 	CurrentSchema string
+
+	// cache is shared by the syntax check and the rules within one SQL review run.
+	cache *checkCache
+}
+
+// checkCache caches the statement splits shared by the syntax check and the rules within one SQL review run.
+// The built-in rules check the AST parsed once by the syntax check, so only the rules splitting the statement, e.g. the custom expression rules, read the cache.
+type checkCache struct {
+	mu     sync.Mutex
+	splits map[splitCacheKey][]base.SingleSQL
+}
+
+type splitCacheKey struct {
+	engine    storepb.Engine
+	statement string
+}
+
+func newCheckCache() *checkCache {
+	return &checkCache{
+		splits: make(map[splitCacheKey][]base.SingleSQL),
+	}
+}
+
+// SplitMultiSQL splits the statement, the result is cached within the SQL review run.
+// The returned list is shared by the rules, so it must not be modified.
+func (ctx Context) SplitMultiSQL(engine storepb.Engine, statement string) ([]base.SingleSQL, error) {
+	if ctx.cache == nil {
+		return base.SplitMultiSQL(engine, statement)
+	}
+	ctx.cache.mu.Lock()
+	defer ctx.cache.mu.Unlock()
+	key := splitCacheKey{engine: engine, statement: statement}
+	if list, ok := ctx.cache.splits[key]; ok {
+		return list, nil
+	}
+	list, err := base.SplitMultiSQL(engine, statement)
+	if err != nil {
+		return nil, err
+	}
+	ctx.cache.splits[key] = list
+	return list, nil
 }
 
 // Advisor is the interface for advisor.
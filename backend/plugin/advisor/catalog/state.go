@@ -6,6 +6,7 @@ package catalog
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -400,6 +401,16 @@ func (table *TableState) CountIndex() int {
 	return len(table.indexSet)
 }
 
+// ColumnNames returns the sorted column names of the table.
+func (table *TableState) ColumnNames() []string {
+	var names []string
+	for _, column := range table.columnSet {
+		names = append(names, column.name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // Index return the index map of table.
 func (table *TableState) Index(_ *TableIndexFind) *IndexStateMap {
 	return &table.indexSet
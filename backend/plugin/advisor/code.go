@@ -9,6 +9,8 @@ const (
 	Internal    Code = 1
 	NotFound    Code = 2
 	Unsupported Code = 3
+	// TimeBudgetExceeded means some rules are skipped because the SQL review exceeds the time budget.
+	TimeBudgetExceeded Code = 4
 
 	// 101 ~ 199 compatibility error code.
 	CompatibilityDropDatabase  Code = 101
@@ -137,6 +139,9 @@ const (
 
 	// 1301 ~ 1399 comment error code.
 	CommentTooLong Code = 1301
+
+	// 1401 ~ 1499 custom rule error code.
+	CustomExpressionViolation Code = 1401
 )
 
 // Int returns the int type of code.
@@ -0,0 +1,185 @@
+// Package custom implements the engine-agnostic SQL review rules defined by users.
+package custom
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/catalog"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	// Register the changed table extraction of PostgreSQL.
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/pg"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*ExpressionAdvisor)(nil)
+)
+
+func init() {
+	for engine := range advisor.CustomExpressionEngines {
+		advisor.Register(engine, advisor.CustomExpression, &ExpressionAdvisor{engine: engine})
+	}
+}
+
+// ExpressionAdvisor is the advisor evaluating the user-provided CEL expressions against each statement.
+type ExpressionAdvisor struct {
+	engine storepb.Engine
+}
+
+type compiledExpression struct {
+	rule    *advisor.CustomExpressionRule
+	program cel.Program
+}
+
+// Check evaluates the custom expressions against each statement.
+func (a *ExpressionAdvisor) Check(ctx advisor.Context, statement string) ([]advisor.Advice, error) {
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := advisor.UnmarshalCustomExpressionRulePayload(ctx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+	var expressions []*compiledExpression
+	for _, rule := range payload.Expressions {
+		program, err := advisor.CompileCustomExpression(rule.Expression)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to compile custom expression %q", rule.Title)
+		}
+		expressions = append(expressions, &compiledExpression{rule: rule, program: program})
+	}
+
+	list, err := ctx.SplitMultiSQL(a.engine, statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to split statement")
+	}
+
+	var adviceList []advisor.Advice
+	for _, singleSQL := range list {
+		if singleSQL.Empty {
+			continue
+		}
+		tables, err := a.getTables(ctx, singleSQL.Text)
+		if err != nil {
+			return nil, err
+		}
+		args := map[string]any{
+			"engine":         a.engine.String(),
+			"database":       ctx.CurrentDatabase,
+			"schema":         ctx.CurrentSchema,
+			"statement":      singleSQL.Text,
+			"statement_type": getStatementType(singleSQL.Text),
+			"tables":         tables,
+		}
+		for _, expression := range expressions {
+			res, err := evalExpression(expression.program, args)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to evaluate custom expression %q", expression.rule.Title)
+			}
+			val, err := res.ConvertToNative(reflect.TypeOf(false))
+			if err != nil {
+				return nil, errors.Wrapf(err, "expect bool result for custom expression %q", expression.rule.Title)
+			}
+			if violated, ok := val.(bool); !ok || !violated {
+				continue
+			}
+			content := expression.rule.Message
+			if content == "" {
+				content = "The statement violates the custom expression rule"
+			}
+			adviceList = append(adviceList, advisor.Advice{
+				Status:  level,
+				Code:    advisor.CustomExpressionViolation,
+				Title:   expression.rule.Title,
+				Content: content,
+				Line:    singleSQL.FirstStatementLine + 1,
+			})
+		}
+	}
+
+	if len(adviceList) == 0 {
+		adviceList = append(adviceList, advisor.Advice{
+			Status:  advisor.Success,
+			Code:    advisor.Ok,
+			Title:   "OK",
+			Content: "",
+		})
+	}
+	return adviceList, nil
+}
+
+func (a *ExpressionAdvisor) getTables(ctx advisor.Context, statement string) ([]map[string]any, error) {
+	tables := []map[string]any{}
+	resources, err := base.ExtractChangedResources(a.engine, ctx.CurrentDatabase, ctx.CurrentSchema, statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to extract changed tables")
+	}
+	for _, resource := range resources {
+		var table *catalog.TableState
+		if ctx.Catalog != nil {
+			table = ctx.Catalog.Origin.FindTable(&catalog.TableFind{
+				SchemaName: resource.Schema,
+				TableName:  resource.Table,
+			})
+		}
+		columns := []string{}
+		if table != nil {
+			columns = append(columns, table.ColumnNames()...)
+		}
+		tables = append(tables, map[string]any{
+			"database": resource.Database,
+			"schema":   resource.Schema,
+			"table":    resource.Table,
+			"exists":   table != nil,
+			"columns":  columns,
+		})
+	}
+	return tables, nil
+}
+
+// evalExpression evaluates the expression with the cost limit and the timeout.
+func evalExpression(program cel.Program, args map[string]any) (ref.Val, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), advisor.CustomExpressionEvalTimeout)
+	defer cancel()
+	res, _, err := program.ContextEval(ctx, args)
+	return res, err
+}
+
+// getStatementType returns the leading keyword of the statement in upper case, skipping the leading comments.
+func getStatementType(statement string) string {
+	s := statement
+	for {
+		s = strings.TrimLeftFunc(s, unicode.IsSpace)
+		switch {
+		case strings.HasPrefix(s, "--"), strings.HasPrefix(s, "#"):
+			i := strings.IndexByte(s, '\n')
+			if i < 0 {
+				return ""
+			}
+			s = s[i+1:]
+		case strings.HasPrefix(s, "/*"):
+			i := strings.Index(s, "*/")
+			if i < 0 {
+				return ""
+			}
+			s = s[i+2:]
+		default:
+			end := strings.IndexFunc(s, func(r rune) bool {
+				return !unicode.IsLetter(r)
+			})
+			if end < 0 {
+				end = len(s)
+			}
+			return strings.ToUpper(s[:end])
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package custom
+
+import (
+	"testing"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestCustomRules(t *testing.T) {
+	customRules := []advisor.SQLReviewRuleType{
+		// advisor.SchemaRuleCustomExpression evaluates the custom expressions.
+		advisor.SchemaRuleCustomExpression,
+	}
+
+	for _, rule := range customRules {
+		advisor.RunSQLReviewRuleTest(t, rule, storepb.Engine_MYSQL, false /* record */)
+		advisor.RunSQLReviewRuleTest(t, rule, storepb.Engine_POSTGRES, false /* record */)
+	}
+}
@@ -0,0 +1,63 @@
+package advisor
+
+import (
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// CustomExpressionEngines are the engines supporting the custom expression rules.
+// The rules are limited to the engines supporting extracting the changed tables, so that the tables variable is reliable.
+var CustomExpressionEngines = map[storepb.Engine]bool{
+	storepb.Engine_MYSQL:            true,
+	storepb.Engine_MARIADB:          true,
+	storepb.Engine_OCEANBASE:        true,
+	storepb.Engine_POSTGRES:         true,
+	storepb.Engine_ORACLE:           true,
+	storepb.Engine_OCEANBASE_ORACLE: true,
+}
+
+// CustomExpressionCELAttributes are the variables available to the custom expression rules.
+//
+// statement is the statement text, statement_type is its leading keyword in upper case, e.g. "ALTER".
+// tables are the tables changed by the statement. Each table is a map with the following keys:
+// database, schema, table, exists (whether the table exists before the change) and columns (the column names before the change).
+var CustomExpressionCELAttributes = []cel.EnvOption{
+	cel.Variable("engine", cel.StringType),
+	cel.Variable("database", cel.StringType),
+	cel.Variable("schema", cel.StringType),
+	cel.Variable("statement", cel.StringType),
+	cel.Variable("statement_type", cel.StringType),
+	cel.Variable("tables", cel.ListType(cel.MapType(cel.StringType, cel.DynType))),
+	cel.ParserExpressionSizeLimit(customExpressionSizeLimit),
+}
+
+const (
+	customExpressionSizeLimit = 64 * 1024
+	// customExpressionCostLimit limits the evaluation cost of each expression against a statement.
+	customExpressionCostLimit = 1000000
+	// CustomExpressionEvalTimeout is the timeout of evaluating each expression against a statement.
+	CustomExpressionEvalTimeout = time.Second
+)
+
+// CompileCustomExpression compiles the custom expression, which must evaluate to a boolean.
+func CompileCustomExpression(expression string) (cel.Program, error) {
+	if expression == "" {
+		return nil, errors.Errorf("expression cannot be empty")
+	}
+	e, err := cel.NewEnv(CustomExpressionCELAttributes...)
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := e.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, errors.Errorf("expression must evaluate to a boolean, got %v", ast.OutputType())
+	}
+	return e.Program(ast, cel.CostLimit(customExpressionCostLimit), cel.InterruptCheckFrequency(100))
+}
@@ -0,0 +1,49 @@
+package advisor
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	sqlReviewDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "bb",
+		Subsystem: "sql_review",
+		Name:      "duration_seconds",
+		Help:      "The time of the SQL reviews by the source of plan check or SQL check and the engine.",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 120},
+	}, []string{"source", "engine"})
+	sqlReviewRuleDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "bb",
+		Subsystem: "sql_review",
+		Name:      "rule_duration_seconds",
+		Help:      "The time of the SQL review rules by the engine and the rule type.",
+		Buckets:   []float64{0.001, 0.01, 0.05, 0.1, 0.5, 1, 3, 10, 30},
+	}, []string{"engine", "rule"})
+	sqlReviewSkippedRules = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bb",
+		Subsystem: "sql_review",
+		Name:      "skipped_rules_total",
+		Help:      "The number of the SQL review rules skipped because the time budget is exceeded, by the source of plan check or SQL check and the engine.",
+	}, []string{"source", "engine"})
+)
+
+func init() {
+	prometheus.MustRegister(sqlReviewDuration, sqlReviewRuleDuration, sqlReviewSkippedRules)
+}
+
+// ObserveSQLReviewCheck exports the statistics of a SQL review run as metrics.
+// The source is where the SQL review runs, e.g. plan_check or sql_check.
+func ObserveSQLReviewCheck(source string, engine storepb.Engine, stats *SQLReviewCheckStats) {
+	if stats == nil {
+		return
+	}
+	sqlReviewDuration.WithLabelValues(source, engine.String()).Observe(stats.Duration.Seconds())
+	for _, rule := range stats.Rules {
+		sqlReviewRuleDuration.WithLabelValues(engine.String(), string(rule.Type)).Observe(rule.Duration.Seconds())
+	}
+	if len(stats.SkippedRules) > 0 {
+		sqlReviewSkippedRules.WithLabelValues(source, engine.String()).Add(float64(len(stats.SkippedRules)))
+	}
+}
@@ -152,6 +152,14 @@ func (checker *columnAutoIncrementMustUnsignedChecker) checkFieldDefinition(tabl
 			Title:   checker.title,
 			Content: fmt.Sprintf("Auto-increment column `%s`.`%s` is not UNSIGNED type", tableName, columnName),
 			Line:    checker.baseLine + ctx.GetStart().GetLine(),
+			Fix: getStatementFix(ctx, func(rewriter *antlr.TokenStreamRewriter) {
+				dataType := ctx.DataType()
+				if dataType.FieldOptions() != nil {
+					rewriter.ReplaceDefault(dataType.FieldOptions().GetStart().GetTokenIndex(), dataType.FieldOptions().GetStop().GetTokenIndex(), "UNSIGNED")
+					return
+				}
+				rewriter.InsertAfterDefault(dataType.GetStop().GetTokenIndex(), " UNSIGNED")
+			}),
 		})
 	}
 }
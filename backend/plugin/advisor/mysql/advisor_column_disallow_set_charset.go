@@ -90,7 +90,8 @@ func (checker *columnDisallowSetCharsetChecker) EnterCreateTable(ctx *mysql.Crea
 		if tableElement.ColumnDefinition().FieldDefinition().DataType() == nil {
 			continue
 		}
-		charset := checker.getCharSet(tableElement.ColumnDefinition().FieldDefinition().DataType())
+		dataType := tableElement.ColumnDefinition().FieldDefinition().DataType()
+		charset := checker.getCharSet(dataType)
 		if !checker.checkCharset(charset) {
 			checker.adviceList = append(checker.adviceList, advisor.Advice{
 				Status:  checker.level,
@@ -98,6 +99,7 @@ func (checker *columnDisallowSetCharsetChecker) EnterCreateTable(ctx *mysql.Crea
 				Title:   checker.title,
 				Content: fmt.Sprintf("Disallow set column charset but \"%s\" does", checker.text),
 				Line:    checker.baseLine + ctx.GetStart().GetLine(),
+				Fix:     checker.removeCharset(dataType),
 			})
 		}
 	}
@@ -124,7 +126,7 @@ func (checker *columnDisallowSetCharsetChecker) EnterAlterTable(ctx *mysql.Alter
 			continue
 		}
 
-		var charsetList []string
+		var dataTypeList []mysql.IDataTypeContext
 		switch {
 		// add column.
 		case item.ADD_SYMBOL() != nil:
@@ -134,8 +136,7 @@ func (checker *columnDisallowSetCharsetChecker) EnterAlterTable(ctx *mysql.Alter
 					continue
 				}
 
-				charsetName := checker.getCharSet(item.FieldDefinition().DataType())
-				charsetList = append(charsetList, charsetName)
+				dataTypeList = append(dataTypeList, item.FieldDefinition().DataType())
 			case item.OPEN_PAR_SYMBOL() != nil && item.TableElementList() != nil:
 				for _, tableElement := range item.TableElementList().AllTableElement() {
 					if tableElement.ColumnDefinition() == nil {
@@ -148,36 +149,47 @@ func (checker *columnDisallowSetCharsetChecker) EnterAlterTable(ctx *mysql.Alter
 						continue
 					}
 
-					charsetName := checker.getCharSet(tableElement.ColumnDefinition().FieldDefinition().DataType())
-					charsetList = append(charsetList, charsetName)
+					dataTypeList = append(dataTypeList, tableElement.ColumnDefinition().FieldDefinition().DataType())
 				}
 			}
 		// change column.
 		case item.CHANGE_SYMBOL() != nil && item.ColumnInternalRef() != nil && item.Identifier() != nil && item.FieldDefinition() != nil:
-			charsetName := checker.getCharSet(item.FieldDefinition().DataType())
-			charsetList = append(charsetList, charsetName)
+			if item.FieldDefinition().DataType() == nil {
+				continue
+			}
+			dataTypeList = append(dataTypeList, item.FieldDefinition().DataType())
 		// modify column.
 		case item.MODIFY_SYMBOL() != nil && item.ColumnInternalRef() != nil && item.FieldDefinition() != nil:
-			charsetName := checker.getCharSet(item.FieldDefinition().DataType())
-			charsetList = append(charsetList, charsetName)
+			if item.FieldDefinition().DataType() == nil {
+				continue
+			}
+			dataTypeList = append(dataTypeList, item.FieldDefinition().DataType())
 		default:
 			continue
 		}
 
-		for _, charsetName := range charsetList {
-			if !checker.checkCharset(charsetName) {
+		for _, dataType := range dataTypeList {
+			if !checker.checkCharset(checker.getCharSet(dataType)) {
 				checker.adviceList = append(checker.adviceList, advisor.Advice{
 					Status:  checker.level,
 					Code:    advisor.SetColumnCharset,
 					Title:   checker.title,
 					Content: fmt.Sprintf("Disallow set column charset but \"%s\" does", checker.text),
 					Line:    checker.baseLine + ctx.GetStart().GetLine(),
+					Fix:     checker.removeCharset(dataType),
 				})
 			}
 		}
 	}
 }
 
+// removeCharset returns the statement without the charset clause of the data type.
+func (*columnDisallowSetCharsetChecker) removeCharset(ctx mysql.IDataTypeContext) string {
+	return getStatementFix(ctx, func(rewriter *antlr.TokenStreamRewriter) {
+		deleteRuleContext(rewriter, ctx.CharsetWithOptBinary())
+	})
+}
+
 func (*columnDisallowSetCharsetChecker) getCharSet(ctx mysql.IDataTypeContext) string {
 	if ctx.CharsetWithOptBinary() == nil {
 		return ""
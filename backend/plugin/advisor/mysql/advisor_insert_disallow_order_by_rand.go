@@ -110,7 +110,31 @@ func (checker *insertDisallowOrderByRandChecker) EnterQueryExpression(ctx *mysql
 				Title:   checker.title,
 				Content: fmt.Sprintf("\"%s\" uses ORDER BY RAND in the INSERT statement", checker.text),
 				Line:    checker.baseLine + ctx.GetStart().GetLine(),
+				Fix:     checker.fixOrderByRand(ctx.OrderClause(), expr),
 			})
 		}
 	}
 }
+
+// fixOrderByRand removes the RAND() order expression, and the whole ORDER BY clause if nothing else is left.
+func (*insertDisallowOrderByRandChecker) fixOrderByRand(orderClause mysql.IOrderClauseContext, expr mysql.IOrderExpressionContext) string {
+	return getStatementFix(expr, func(rewriter *antlr.TokenStreamRewriter) {
+		exprList := orderClause.OrderList().AllOrderExpression()
+		if len(exprList) == 1 {
+			deleteRuleContext(rewriter, orderClause)
+			return
+		}
+		for i, item := range exprList {
+			if item != expr {
+				continue
+			}
+			if i == 0 {
+				// Delete the expression and the comma after it.
+				rewriter.DeleteDefault(item.GetStart().GetTokenIndex(), exprList[i+1].GetStart().GetTokenIndex()-1)
+			} else {
+				// Delete the comma before the expression and the expression.
+				rewriter.DeleteDefault(exprList[i-1].GetStop().GetTokenIndex()+1, item.GetStop().GetTokenIndex())
+			}
+		}
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/antlr4-go/antlr/v4"
 	mysql "github.com/bytebase/mysql-parser"
@@ -155,10 +156,46 @@ func (checker *insertRowLimitChecker) handleNoInsertQueryExpression(ctx mysql.II
 			Title:   checker.title,
 			Content: fmt.Sprintf("\"%s\" inserts %d rows. The count exceeds %d.", checker.text, len(allValues), checker.maxRow),
 			Line:    checker.line,
+			Fix:     checker.splitInsert(ctx, ctx.InsertFromConstructor().InsertValues().ValueList()),
 		})
 	}
 }
 
+// splitInsert splits the INSERT statement into several statements, each inserts at most maxRow rows.
+func (checker *insertRowLimitChecker) splitInsert(ctx mysql.IInsertStatementContext, valueList mysql.IValueListContext) string {
+	if checker.maxRow <= 0 {
+		return ""
+	}
+	tokens := ctx.GetParser().GetTokenStream()
+	head := tokens.GetTextFromInterval(antlr.NewInterval(ctx.GetStart().GetTokenIndex(), valueList.GetStart().GetTokenIndex()-1))
+	tail := tokens.GetTextFromInterval(antlr.NewInterval(valueList.GetStop().GetTokenIndex()+1, ctx.GetStop().GetTokenIndex()))
+
+	var rowList []string
+	rowStart := -1
+	for _, child := range valueList.GetChildren() {
+		terminal, ok := child.(antlr.TerminalNode)
+		if !ok {
+			continue
+		}
+		switch terminal.GetSymbol().GetTokenType() {
+		case mysql.MySQLParserOPEN_PAR_SYMBOL:
+			rowStart = terminal.GetSymbol().GetTokenIndex()
+		case mysql.MySQLParserCLOSE_PAR_SYMBOL:
+			rowList = append(rowList, tokens.GetTextFromInterval(antlr.NewInterval(rowStart, terminal.GetSymbol().GetTokenIndex())))
+		}
+	}
+
+	var statementList []string
+	for i := 0; i < len(rowList); i += checker.maxRow {
+		end := i + checker.maxRow
+		if end > len(rowList) {
+			end = len(rowList)
+		}
+		statementList = append(statementList, head+strings.Join(rowList[i:end], ", ")+tail+";")
+	}
+	return strings.Join(statementList, "\n")
+}
+
 func getInsertRows(res []any) (int64, error) {
 	// the res struct is []any{columnName, columnTable, rowDataList}
 	if len(res) != 3 {
@@ -79,14 +79,14 @@ func (checker *disallowLimitChecker) EnterQuery(ctx *mysql.QueryContext) {
 // EnterDeleteStatement is called when production deleteStatement is entered.
 func (checker *disallowLimitChecker) EnterDeleteStatement(ctx *mysql.DeleteStatementContext) {
 	if ctx.SimpleLimitClause() != nil && ctx.SimpleLimitClause().LIMIT_SYMBOL() != nil {
-		checker.handleLimitClause(advisor.DeleteUseLimit, ctx.GetStart().GetLine())
+		checker.handleLimitClause(advisor.DeleteUseLimit, ctx.GetStart().GetLine(), ctx.SimpleLimitClause())
 	}
 }
 
 // EnterUpdateStatement is called when production updateStatement is entered.
 func (checker *disallowLimitChecker) EnterUpdateStatement(ctx *mysql.UpdateStatementContext) {
 	if ctx.SimpleLimitClause() != nil && ctx.SimpleLimitClause().LIMIT_SYMBOL() != nil {
-		checker.handleLimitClause(advisor.UpdateUseLimit, ctx.GetStart().GetLine())
+		checker.handleLimitClause(advisor.UpdateUseLimit, ctx.GetStart().GetLine(), ctx.SimpleLimitClause())
 	}
 }
 
@@ -106,16 +106,19 @@ func (checker *disallowLimitChecker) EnterQueryExpression(ctx *mysql.QueryExpres
 		return
 	}
 	if ctx.LimitClause() != nil && ctx.LimitClause().LIMIT_SYMBOL() != nil {
-		checker.handleLimitClause(advisor.InsertUseLimit, ctx.GetStart().GetLine())
+		checker.handleLimitClause(advisor.InsertUseLimit, ctx.GetStart().GetLine(), ctx.LimitClause())
 	}
 }
 
-func (checker *disallowLimitChecker) handleLimitClause(code advisor.Code, lineNumber int) {
+func (checker *disallowLimitChecker) handleLimitClause(code advisor.Code, lineNumber int, limitClause parserRuleContext) {
 	checker.adviceList = append(checker.adviceList, advisor.Advice{
 		Status:  checker.level,
 		Code:    code,
 		Title:   checker.title,
 		Content: fmt.Sprintf("LIMIT clause is forbidden in INSERT, UPDATE and DELETE statement, but \"%s\" uses", checker.text),
 		Line:    checker.line + lineNumber,
+		Fix: getStatementFix(limitClause, func(rewriter *antlr.TokenStreamRewriter) {
+			deleteRuleContext(rewriter, limitClause)
+		}),
 	})
 }
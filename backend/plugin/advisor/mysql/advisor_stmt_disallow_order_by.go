@@ -79,23 +79,26 @@ func (checker *disallowOrderByChecker) EnterQuery(ctx *mysql.QueryContext) {
 // EnterDeleteStatement is called when production deleteStatement is entered.
 func (checker *disallowOrderByChecker) EnterDeleteStatement(ctx *mysql.DeleteStatementContext) {
 	if ctx.OrderClause() != nil && ctx.OrderClause().ORDER_SYMBOL() != nil {
-		checker.handleOrderByClause(advisor.DeleteUseOrderBy, ctx.GetStart().GetLine())
+		checker.handleOrderByClause(advisor.DeleteUseOrderBy, ctx.GetStart().GetLine(), ctx.OrderClause())
 	}
 }
 
 // EnterUpdateStatement is called when production updateStatement is entered.
 func (checker *disallowOrderByChecker) EnterUpdateStatement(ctx *mysql.UpdateStatementContext) {
 	if ctx.OrderClause() != nil && ctx.OrderClause().ORDER_SYMBOL() != nil {
-		checker.handleOrderByClause(advisor.UpdateUseOrderBy, ctx.GetStart().GetLine())
+		checker.handleOrderByClause(advisor.UpdateUseOrderBy, ctx.GetStart().GetLine(), ctx.OrderClause())
 	}
 }
 
-func (checker *disallowOrderByChecker) handleOrderByClause(code advisor.Code, lineNumber int) {
+func (checker *disallowOrderByChecker) handleOrderByClause(code advisor.Code, lineNumber int, orderClause parserRuleContext) {
 	checker.adviceList = append(checker.adviceList, advisor.Advice{
 		Status:  checker.level,
 		Code:    code,
 		Title:   checker.title,
 		Content: fmt.Sprintf("ORDER BY clause is forbidden in DELETE and UPDATE statements, but \"%s\" uses", checker.text),
 		Line:    checker.line + lineNumber,
+		Fix: getStatementFix(orderClause, func(rewriter *antlr.TokenStreamRewriter) {
+			deleteRuleContext(rewriter, orderClause)
+		}),
 	})
 }
@@ -86,7 +86,7 @@ func (c *useInnoDBChecker) EnterCreateTable(ctx *mysql.CreateTableContext) {
 			if strings.ToLower(engine) != innoDB {
 				content := "CREATE " + ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx)
 				line := tableOption.GetStart().GetLine()
-				c.addAdvice(content, line)
+				c.addAdvice(content, line, replaceWithInnoDB(tableOption.EngineRef()))
 				break
 			}
 		}
@@ -101,6 +101,7 @@ func (c *useInnoDBChecker) EnterAlterTable(ctx *mysql.AlterTableContext) {
 		return
 	}
 	code := advisor.Ok
+	var engineRefList []parserRuleContext
 	for _, option := range ctx.AlterTableActions().AlterCommandList().AlterList().AllCreateTableOptionsSpaceSeparated() {
 		for _, op := range option.AllCreateTableOption() {
 			switch {
@@ -111,7 +112,7 @@ func (c *useInnoDBChecker) EnterAlterTable(ctx *mysql.AlterTableContext) {
 				engine := op.EngineRef().GetText()
 				if strings.ToLower(engine) != innoDB {
 					code = advisor.NotInnoDBEngine
-					break
+					engineRefList = append(engineRefList, op.EngineRef())
 				}
 			default:
 			}
@@ -121,7 +122,7 @@ func (c *useInnoDBChecker) EnterAlterTable(ctx *mysql.AlterTableContext) {
 	if code != advisor.Ok {
 		content := "ALTER " + ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx)
 		line := ctx.GetStart().GetLine()
-		c.addAdvice(content, line)
+		c.addAdvice(content, line, replaceWithInnoDB(engineRefList...))
 	}
 }
 
@@ -143,21 +144,23 @@ func (c *useInnoDBChecker) EnterSetStatement(ctx *mysql.SetStatementContext) {
 	if strings.ToLower(name) != defaultStorageEngin {
 		return
 	}
+	fix := ""
 	if optionValueNoOptionType.SetExprOrDefault() != nil {
 		engine := optionValueNoOptionType.SetExprOrDefault().GetText()
 		if strings.ToLower(engine) != innoDB {
 			code = advisor.NotInnoDBEngine
+			fix = replaceWithInnoDB(optionValueNoOptionType.SetExprOrDefault())
 		}
 	}
 
 	if code != advisor.Ok {
 		content := ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx)
 		line := ctx.GetStart().GetLine()
-		c.addAdvice(content, line)
+		c.addAdvice(content, line, fix)
 	}
 }
 
-func (c *useInnoDBChecker) addAdvice(content string, lineNumber int) {
+func (c *useInnoDBChecker) addAdvice(content string, lineNumber int, fix string) {
 	lineNumber += c.baseLine
 	c.adviceList = append(c.adviceList, advisor.Advice{
 		Status:  c.level,
@@ -165,5 +168,18 @@ func (c *useInnoDBChecker) addAdvice(content string, lineNumber int) {
 		Title:   c.title,
 		Content: fmt.Sprintf("\"%s;\" doesn't use InnoDB engine", content),
 		Line:    lineNumber,
+		Fix:     fix,
+	})
+}
+
+// replaceWithInnoDB returns the statement with all the given engine references replaced by InnoDB.
+func replaceWithInnoDB(engineList ...parserRuleContext) string {
+	if len(engineList) == 0 {
+		return ""
+	}
+	return getStatementFix(engineList[0], func(rewriter *antlr.TokenStreamRewriter) {
+		for _, engine := range engineList {
+			rewriter.ReplaceDefault(engine.GetStart().GetTokenIndex(), engine.GetStop().GetTokenIndex(), "InnoDB")
+		}
 	})
 }
@@ -5,6 +5,10 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+
+	mysql "github.com/bytebase/mysql-parser"
 )
 
 type columnSet map[string]bool
@@ -83,3 +87,42 @@ type tableData struct {
 	onUpdateCurrentTimeCount int
 	line                     int
 }
+
+// parserRuleContext is the rule context generated by the MySQL parser.
+type parserRuleContext interface {
+	antlr.ParserRuleContext
+	GetParser() antlr.Parser
+}
+
+// getStatementFix returns the text of the statement containing ctx after applying the edit.
+// It is used to fill the Fix field of the advice.
+func getStatementFix(ctx parserRuleContext, edit func(rewriter *antlr.TokenStreamRewriter)) string {
+	var query antlr.ParserRuleContext = ctx
+	for {
+		if _, ok := query.(*mysql.QueryContext); ok {
+			break
+		}
+		parent, ok := query.GetParent().(antlr.ParserRuleContext)
+		if !ok {
+			break
+		}
+		query = parent
+	}
+
+	rewriter := antlr.NewTokenStreamRewriter(ctx.GetParser().GetTokenStream())
+	edit(rewriter)
+	text := rewriter.GetText(antlr.DefaultProgramName, antlr.NewInterval(query.GetStart().GetTokenIndex(), query.GetStop().GetTokenIndex()))
+	return strings.TrimSpace(text)
+}
+
+// deleteRuleContext deletes the tokens of ctx together with the whitespace before it.
+func deleteRuleContext(rewriter *antlr.TokenStreamRewriter, ctx antlr.ParserRuleContext) {
+	start := ctx.GetStart().GetTokenIndex()
+	if start > 0 {
+		previous := rewriter.GetTokenStream().Get(start - 1)
+		if previous.GetChannel() != antlr.TokenDefaultChannel && strings.TrimSpace(previous.GetText()) == "" {
+			start--
+		}
+	}
+	rewriter.DeleteDefault(start, ctx.GetStop().GetTokenIndex())
+}
@@ -3,6 +3,8 @@ package pg
 // Framework code is generated by the generator.
 
 import (
+	"regexp"
+
 	"github.com/pkg/errors"
 
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
@@ -15,6 +17,9 @@ var (
 	_ ast.Visitor     = (*indexCreateConcurrentlyChecker)(nil)
 )
 
+// createIndexRegexp matches the leading "CREATE [UNIQUE] INDEX" of the statement.
+var createIndexRegexp = regexp.MustCompile(`(?is)^(\s*CREATE\s+(?:UNIQUE\s+)?INDEX)\b`)
+
 func init() {
 	advisor.Register(storepb.Engine_POSTGRES, advisor.PostgreSQLCreateIndexConcurrently, &IndexCreateConcurrentlyAdvisor{})
 }
@@ -70,6 +75,7 @@ func (checker *indexCreateConcurrentlyChecker) Visit(in ast.Node) ast.Visitor {
 				Title:   checker.title,
 				Content: "Creating indexes will block writes on the table, unless use CONCURRENTLY",
 				Line:    in.LastLine(),
+				Fix:     createIndexRegexp.ReplaceAllString(node.Text(), "${1} CONCURRENTLY"),
 			})
 		}
 	}
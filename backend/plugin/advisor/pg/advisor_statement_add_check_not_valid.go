@@ -3,6 +3,9 @@ package pg
 // Framework code is generated by the generator.
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/pkg/errors"
 
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
@@ -41,6 +44,7 @@ func (*StatementAddCheckNotValidAdvisor) Check(ctx advisor.Context, _ string) ([
 
 	for _, stmt := range stmtList {
 		checker.line = stmt.LastLine()
+		checker.text = stmt.Text()
 		ast.Walk(checker, stmt)
 	}
 
@@ -60,10 +64,16 @@ type statementAddCheckNotValidChecker struct {
 	level      advisor.Status
 	title      string
 	line       int
+	text       string
+	// singleItem is true if the current ALTER TABLE statement has only one alter item.
+	singleItem bool
 }
 
 // Visit implements ast.Visitor interface.
 func (checker *statementAddCheckNotValidChecker) Visit(in ast.Node) ast.Visitor {
+	if node, ok := in.(*ast.AlterTableStmt); ok {
+		checker.singleItem = len(node.AlterItemList) == 1
+	}
 	if node, ok := in.(*ast.AddConstraintStmt); ok {
 		if node.Constraint.Type == ast.ConstraintTypeCheck && !node.Constraint.SkipValidation {
 			checker.adviceList = append(checker.adviceList, advisor.Advice{
@@ -72,9 +82,20 @@ func (checker *statementAddCheckNotValidChecker) Visit(in ast.Node) ast.Visitor
 				Title:   checker.title,
 				Content: "Adding check constraints with validation will block reads and writes. You can add check constraints not valid and then validate separately",
 				Line:    checker.line,
+				Fix:     checker.fix(node),
 			})
 		}
 	}
 
 	return checker
 }
+
+// fix adds the check constraint as NOT VALID and validates it in a separate statement.
+// Only ALTER TABLE statements with a single alter item and a named constraint can be fixed,
+// because the unnamed constraint cannot be validated without knowing the name generated by PostgreSQL.
+func (checker *statementAddCheckNotValidChecker) fix(node *ast.AddConstraintStmt) string {
+	if !checker.singleItem || node.Constraint.Name == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s NOT VALID;\nALTER TABLE %s VALIDATE CONSTRAINT %q;", strings.TrimSuffix(strings.TrimSpace(checker.text), ";"), normalizeTableName(node.Table, ""), node.Constraint.Name)
+}
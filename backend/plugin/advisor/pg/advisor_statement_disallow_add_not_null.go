@@ -73,8 +73,16 @@ func (checker *statementDisallowAddNotNullChecker) Visit(in ast.Node) ast.Visito
 			Title:   checker.title,
 			Content: fmt.Sprintf("Setting NOT NULL will block reads and writes. You can use CHECK (%q IS NOT NULL) instead", node.ColumnName),
 			Line:    checker.line,
+			Fix:     addNotNullCheck(node),
 		})
 	}
 
 	return checker
 }
+
+// addNotNullCheck returns the statements adding a NOT VALID check constraint and validating it separately.
+func addNotNullCheck(node *ast.SetNotNullStmt) string {
+	tableName := normalizeTableName(node.Table, "")
+	constraintName := fmt.Sprintf("%s_%s_not_null", node.Table.Name, node.ColumnName)
+	return fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %q CHECK (%q IS NOT NULL) NOT VALID;\nALTER TABLE %s VALIDATE CONSTRAINT %q;", tableName, constraintName, node.ColumnName, tableName, constraintName)
+}
@@ -10,6 +10,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -172,6 +173,9 @@ const (
 	// SchemaRuleCommentLength limit comment length.
 	SchemaRuleCommentLength SQLReviewRuleType = "system.comment.length"
 
+	// SchemaRuleCustomExpression evaluates the user-provided CEL expressions against each statement.
+	SchemaRuleCustomExpression SQLReviewRuleType = "custom.expression"
+
 	// TableNameTemplateToken is the token for table name.
 	TableNameTemplateToken = "{{table}}"
 	// ColumnListTemplateToken is the token for column name list.
@@ -185,6 +189,9 @@ const (
 	// ReferencedColumnNameTemplateToken is the token for referenced column name.
 	ReferencedColumnNameTemplateToken = "{{referenced_column}}"
 
+	// slowRuleThreshold is the duration above which a rule is logged as slow.
+	slowRuleThreshold = 3 * time.Second
+
 	// defaultNameLengthLimit is the default length limit for naming rules.
 	// PostgreSQL has it's own naming length limit, will auto slice the name to make sure its length <= 63
 	// https://www.postgresql.org/docs/current/limits.html.
@@ -243,6 +250,19 @@ type NumberTypeRulePayload struct {
 	Number int `json:"number"`
 }
 
+// CustomExpressionRulePayload is the payload for custom expression rule.
+type CustomExpressionRulePayload struct {
+	Expressions []*CustomExpressionRule `json:"expressions"`
+}
+
+// CustomExpressionRule is a CEL expression evaluated against each statement.
+// The statement violates the rule if the expression evaluates to true.
+type CustomExpressionRule struct {
+	Title      string `json:"title"`
+	Expression string `json:"expression"`
+	Message    string `json:"message"`
+}
+
 // NamingCaseRulePayload is the payload for naming case rule.
 type NamingCaseRulePayload struct {
 	// Upper is true means the case should be upper case, otherwise lower case.
@@ -386,6 +406,26 @@ func UnmarshalNamingCaseRulePayload(payload string) (*NamingCaseRulePayload, err
 	return &ncr, nil
 }
 
+// UnmarshalCustomExpressionRulePayload will unmarshal payload to CustomExpressionRulePayload and compile the expressions.
+func UnmarshalCustomExpressionRulePayload(payload string) (*CustomExpressionRulePayload, error) {
+	var cer CustomExpressionRulePayload
+	if err := json.Unmarshal([]byte(payload), &cer); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal custom expression rule payload %q", payload)
+	}
+	if len(cer.Expressions) == 0 {
+		return nil, errors.Errorf("custom expression rule payload must have at least one expression")
+	}
+	for i, expression := range cer.Expressions {
+		if expression.Title == "" {
+			return nil, errors.Errorf("title of custom expression #%d is required", i+1)
+		}
+		if _, err := CompileCustomExpression(expression.Expression); err != nil {
+			return nil, errors.Wrapf(err, "invalid custom expression %q", expression.Title)
+		}
+	}
+	return &cer, nil
+}
+
 // SQLReviewCheckContext is the context for SQL review check.
 type SQLReviewCheckContext struct {
 	Charset   string
@@ -399,12 +439,34 @@ type SQLReviewCheckContext struct {
 	CurrentDatabase string
 	// Oracle specific fields
 	CurrentSchema string
+
+	// TimeBudget bounds the duration of the SQL review, zero means no limit.
+	// The rules not started within the budget are skipped, and the advices of the checked rules are returned.
+	TimeBudget time.Duration
+	// Stats collects the execution statistics of the SQL review if set.
+	Stats *SQLReviewCheckStats
+}
+
+// SQLReviewCheckStats is the execution statistics of a SQL review run.
+type SQLReviewCheckStats struct {
+	// Duration is the total duration, including the syntax check and the catalog walk-through.
+	Duration time.Duration
+	// SkippedRules are the rules skipped because the time budget is exceeded.
+	SkippedRules []SQLReviewRuleType
+	Rules        []*SQLReviewRuleStat
 }
 
-func syntaxCheck(statement string, checkContext SQLReviewCheckContext) (any, []Advice) {
+// SQLReviewRuleStat is the execution statistics of a SQL review rule.
+type SQLReviewRuleStat struct {
+	Type        SQLReviewRuleType
+	Duration    time.Duration
+	AdviceCount int
+}
+
+func syntaxCheck(statement string, checkContext SQLReviewCheckContext, cache *checkCache) (any, []Advice) {
 	switch checkContext.DbType {
 	case storepb.Engine_TIDB:
-		return tidbSyntaxCheck(statement)
+		return tidbSyntaxCheck(statement, cache)
 	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
 		return mysqlSyntaxCheck(statement)
 	case storepb.Engine_POSTGRES:
@@ -609,8 +671,8 @@ func mysqlSyntaxCheck(statement string) (any, []Advice) {
 	return res, nil
 }
 
-func tidbSyntaxCheck(statement string) (any, []Advice) {
-	singleSQLs, err := base.SplitMultiSQL(storepb.Engine_TIDB, statement)
+func tidbSyntaxCheck(statement string, cache *checkCache) (any, []Advice) {
+	singleSQLs, err := Context{cache: cache}.SplitMultiSQL(storepb.Engine_TIDB, statement)
 	if err != nil {
 		return nil, []Advice{
 			{
@@ -666,7 +728,22 @@ func tidbSyntaxCheck(statement string) (any, []Advice) {
 
 // SQLReviewCheck checks the statements with sql review rules.
 func SQLReviewCheck(statements string, ruleList []*storepb.SQLReviewRule, checkContext SQLReviewCheckContext) ([]Advice, error) {
-	ast, result := syntaxCheck(statements, checkContext)
+	startTime := time.Now()
+	if checkContext.Stats != nil {
+		defer func() {
+			checkContext.Stats.Duration = time.Since(startTime)
+		}()
+	}
+	if checkContext.TimeBudget > 0 && checkContext.Context != nil {
+		// Advisors querying the database, e.g. the DML dry run, are canceled after the budget.
+		ctx, cancel := context.WithTimeout(checkContext.Context, checkContext.TimeBudget)
+		defer cancel()
+		checkContext.Context = ctx
+	}
+
+	// The cache is shared by the syntax check and the rules in this run, so that the statements are only split once.
+	cache := newCheckCache()
+	ast, result := syntaxCheck(statements, checkContext, cache)
 	if ast == nil || len(ruleList) == 0 {
 		return result, nil
 	}
@@ -679,6 +756,7 @@ func SQLReviewCheck(statements string, ruleList []*storepb.SQLReviewRule, checkC
 		}
 	}
 
+	var skippedRules []SQLReviewRuleType
 	for _, rule := range ruleList {
 		if rule.Engine != storepb.Engine_ENGINE_UNSPECIFIED && rule.Engine != checkContext.DbType {
 			continue
@@ -686,6 +764,10 @@ func SQLReviewCheck(statements string, ruleList []*storepb.SQLReviewRule, checkC
 		if rule.Level == storepb.SQLReviewRuleLevel_DISABLED {
 			continue
 		}
+		if checkContext.TimeBudget > 0 && time.Since(startTime) > checkContext.TimeBudget {
+			skippedRules = append(skippedRules, SQLReviewRuleType(rule.Type))
+			continue
+		}
 
 		advisorType, err := getAdvisorTypeByRule(SQLReviewRuleType(rule.Type), checkContext.DbType)
 		if err != nil {
@@ -695,6 +777,7 @@ func SQLReviewCheck(statements string, ruleList []*storepb.SQLReviewRule, checkC
 			continue
 		}
 
+		ruleStartTime := time.Now()
 		adviceList, err := Check(
 			checkContext.DbType,
 			advisorType,
@@ -708,16 +791,44 @@ func SQLReviewCheck(statements string, ruleList []*storepb.SQLReviewRule, checkC
 				Context:         checkContext.Context,
 				CurrentSchema:   checkContext.CurrentSchema,
 				CurrentDatabase: checkContext.CurrentDatabase,
+				cache:           cache,
 			},
 			statements,
 		)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to check statement")
 		}
+		duration := time.Since(ruleStartTime)
+		if duration > slowRuleThreshold {
+			slog.Warn("slow SQL review rule", slog.String("rule type", rule.Type), slog.String("engine", checkContext.DbType.String()), slog.Duration("duration", duration))
+		}
+		if checkContext.Stats != nil {
+			checkContext.Stats.Rules = append(checkContext.Stats.Rules, &SQLReviewRuleStat{
+				Type:        SQLReviewRuleType(rule.Type),
+				Duration:    duration,
+				AdviceCount: len(adviceList),
+			})
+		}
 
 		result = append(result, adviceList...)
 	}
 
+	if len(skippedRules) > 0 {
+		if checkContext.Stats != nil {
+			checkContext.Stats.SkippedRules = skippedRules
+		}
+		var ruleTypes []string
+		for _, ruleType := range skippedRules {
+			ruleTypes = append(ruleTypes, string(ruleType))
+		}
+		result = append(result, Advice{
+			Status:  Warn,
+			Code:    TimeBudgetExceeded,
+			Title:   "SQL review time budget exceeded",
+			Content: fmt.Sprintf("SQL review exceeded the time budget %v, %d rule(s) are skipped: %s", checkContext.TimeBudget, len(skippedRules), strings.Join(ruleTypes, ", ")),
+		})
+	}
+
 	// There may be multiple syntax errors, return one only.
 	if len(result) > 0 && result[0].Title == SyntaxErrorTitle {
 		return result[:1], nil
@@ -946,6 +1057,9 @@ func convertWalkThroughErrorToAdvice(checkContext SQLReviewCheckContext, err err
 }
 
 func getViewDefinition(checkContext SQLReviewCheckContext, viewList []string) (string, error) {
+	if checkContext.Driver == nil {
+		return "", errors.New("no database connection to get the view definition")
+	}
 	var buf bytes.Buffer
 	sql := fmt.Sprintf(`
 		WITH view_list(view_name) AS (
@@ -1428,6 +1542,10 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 		if engine == storepb.Engine_POSTGRES {
 			return PostgreSQLCommentConvention, nil
 		}
+	case SchemaRuleCustomExpression:
+		if CustomExpressionEngines[engine] {
+			return CustomExpression, nil
+		}
 	}
 	return Fake, errors.Errorf("unknown SQL review rule type %v for %v", ruleType, engine)
 }
@@ -0,0 +1,138 @@
+package advisor
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// SQLReviewDocumentVersion is the current version of the SQL review policy document.
+// Bump it whenever the document format changes in an incompatible way.
+const SQLReviewDocumentVersion = "v1"
+
+// SQLReviewDocumentFormat is the encoding format of the SQL review policy document.
+type SQLReviewDocumentFormat string
+
+const (
+	// SQLReviewDocumentFormatYAML is the YAML document format.
+	SQLReviewDocumentFormatYAML SQLReviewDocumentFormat = "YAML"
+	// SQLReviewDocumentFormatJSON is the JSON document format.
+	SQLReviewDocumentFormatJSON SQLReviewDocumentFormat = "JSON"
+)
+
+// SQLReviewDocument is the portable document of a SQL review policy, used to promote policies across workspaces and environments.
+type SQLReviewDocument struct {
+	Version string                   `yaml:"version" json:"version"`
+	Name    string                   `yaml:"name" json:"name"`
+	Rules   []*SQLReviewDocumentRule `yaml:"rules" json:"rules"`
+}
+
+// SQLReviewDocumentRule is the SQL review rule in the document.
+type SQLReviewDocumentRule struct {
+	Type    SQLReviewRuleType `yaml:"type" json:"type"`
+	Engine  string            `yaml:"engine" json:"engine"`
+	Level   string            `yaml:"level" json:"level"`
+	Comment string            `yaml:"comment,omitempty" json:"comment,omitempty"`
+	Payload map[string]any    `yaml:"payload,omitempty" json:"payload,omitempty"`
+}
+
+// MarshalSQLReviewDocument encodes the SQL review policy as a versioned document.
+func MarshalSQLReviewDocument(policy *storepb.SQLReviewPolicy, format SQLReviewDocumentFormat) ([]byte, error) {
+	document := &SQLReviewDocument{
+		Version: SQLReviewDocumentVersion,
+		Name:    policy.Name,
+	}
+	for _, rule := range policy.RuleList {
+		documentRule := &SQLReviewDocumentRule{
+			Type:    SQLReviewRuleType(rule.Type),
+			Engine:  rule.Engine.String(),
+			Level:   rule.Level.String(),
+			Comment: rule.Comment,
+		}
+		if rule.Payload != "" {
+			if err := json.Unmarshal([]byte(rule.Payload), &documentRule.Payload); err != nil {
+				return nil, errors.Wrapf(err, "failed to unmarshal payload of rule %q", rule.Type)
+			}
+		}
+		document.Rules = append(document.Rules, documentRule)
+	}
+
+	switch format {
+	case SQLReviewDocumentFormatJSON:
+		return json.MarshalIndent(document, "", "  ")
+	case SQLReviewDocumentFormatYAML:
+		return yaml.Marshal(document)
+	default:
+		return nil, errors.Errorf("unsupported document format %q", format)
+	}
+}
+
+// UnmarshalSQLReviewDocument decodes and validates a YAML or JSON SQL review policy document.
+func UnmarshalSQLReviewDocument(content []byte) (*storepb.SQLReviewPolicy, error) {
+	// JSON is a subset of YAML, so the YAML decoder handles both formats.
+	document := &SQLReviewDocument{}
+	if err := yaml.Unmarshal(content, document); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse SQL review document")
+	}
+	if document.Version != SQLReviewDocumentVersion {
+		return nil, errors.Errorf("unsupported SQL review document version %q, expect %q", document.Version, SQLReviewDocumentVersion)
+	}
+	if document.Name == "" {
+		return nil, errors.Errorf("SQL review document name is required")
+	}
+
+	policy := &storepb.SQLReviewPolicy{
+		Name: document.Name,
+	}
+	seen := make(map[string]bool)
+	for i, rule := range document.Rules {
+		if !isKnownSQLReviewRuleType(rule.Type) {
+			return nil, errors.Errorf("rule #%d has unknown type %q", i+1, rule.Type)
+		}
+		engineValue, ok := storepb.Engine_value[rule.Engine]
+		if !ok {
+			return nil, errors.Errorf("rule #%d %q has invalid engine %q", i+1, rule.Type, rule.Engine)
+		}
+		engine := storepb.Engine(engineValue)
+		levelValue, ok := storepb.SQLReviewRuleLevel_value[rule.Level]
+		if !ok || storepb.SQLReviewRuleLevel(levelValue) == storepb.SQLReviewRuleLevel_LEVEL_UNSPECIFIED {
+			return nil, errors.Errorf("rule #%d %q has invalid level %q", i+1, rule.Type, rule.Level)
+		}
+		key := string(rule.Type) + "/" + rule.Engine
+		if seen[key] {
+			return nil, errors.Errorf("rule #%d %q is duplicated for engine %q", i+1, rule.Type, rule.Engine)
+		}
+		seen[key] = true
+
+		payload := ""
+		if len(rule.Payload) > 0 {
+			bytes, err := json.Marshal(rule.Payload)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to marshal payload of rule #%d %q", i+1, rule.Type)
+			}
+			payload = string(bytes)
+		}
+		policy.RuleList = append(policy.RuleList, &storepb.SQLReviewRule{
+			Type:    string(rule.Type),
+			Level:   storepb.SQLReviewRuleLevel(levelValue),
+			Payload: payload,
+			Engine:  engine,
+			Comment: rule.Comment,
+		})
+	}
+	return policy, nil
+}
+
+// isKnownSQLReviewRuleType returns true if the rule type is supported by any engine.
+// Rules unsupported by their own engine are kept as is because the SQL review skips them.
+func isKnownSQLReviewRuleType(ruleType SQLReviewRuleType) bool {
+	for _, engine := range storepb.Engine_value {
+		if _, err := getAdvisorTypeByRule(ruleType, storepb.Engine(engine)); err == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package advisor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestSQLReviewDocument(t *testing.T) {
+	a := require.New(t)
+	policy := &storepb.SQLReviewPolicy{
+		Name: "prod",
+		RuleList: []*storepb.SQLReviewRule{
+			{
+				Type:    string(SchemaRuleStatementRequireWhere),
+				Level:   storepb.SQLReviewRuleLevel_ERROR,
+				Engine:  storepb.Engine_MYSQL,
+				Comment: "require where",
+			},
+			{
+				Type:    string(SchemaRuleTableNaming),
+				Level:   storepb.SQLReviewRuleLevel_WARNING,
+				Engine:  storepb.Engine_POSTGRES,
+				Payload: `{"format":"^[a-z]+$","maxLength":64}`,
+			},
+		},
+	}
+
+	for _, format := range []SQLReviewDocumentFormat{SQLReviewDocumentFormatYAML, SQLReviewDocumentFormatJSON} {
+		content, err := MarshalSQLReviewDocument(policy, format)
+		a.NoError(err)
+		got, err := UnmarshalSQLReviewDocument(content)
+		a.NoError(err)
+		a.Equal(policy.Name, got.Name)
+		a.Len(got.RuleList, len(policy.RuleList))
+		for i, rule := range got.RuleList {
+			a.Equal(policy.RuleList[i].Type, rule.Type)
+			a.Equal(policy.RuleList[i].Level, rule.Level)
+			a.Equal(policy.RuleList[i].Engine, rule.Engine)
+			a.Equal(policy.RuleList[i].Comment, rule.Comment)
+			a.Equal(policy.RuleList[i].Payload, rule.Payload)
+		}
+	}
+
+	invalidDocuments := []string{
+		"version: v0\nname: prod\n",
+		"version: v1\n",
+		"version: v1\nname: prod\nrules:\n  - type: unknown.rule\n    engine: MYSQL\n    level: ERROR\n",
+		"version: v1\nname: prod\nrules:\n  - type: statement.where.require\n    engine: MYSQL\n    level: FATAL\n",
+		"version: v1\nname: prod\nrules:\n  - type: statement.where.require\n    engine: MYSQL\n    level: ERROR\n  - type: statement.where.require\n    engine: MYSQL\n    level: WARNING\n",
+	}
+	for _, document := range invalidDocuments {
+		_, err := UnmarshalSQLReviewDocument([]byte(document))
+		a.Error(err, document)
+	}
+}
@@ -0,0 +1,128 @@
+package advisor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor/catalog"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestSQLReviewCheckTimeBudget(t *testing.T) {
+	a := require.New(t)
+	finder := catalog.NewFinder(MockMySQLDatabase, &catalog.FinderContext{CheckIntegrity: true, EngineType: storepb.Engine_MYSQL})
+	ruleList := []*storepb.SQLReviewRule{
+		{
+			Type:  string(SchemaRuleStatementRequireWhere),
+			Level: storepb.SQLReviewRuleLevel_WARNING,
+		},
+		{
+			Type:  string(SchemaRuleStatementNoSelectAll),
+			Level: storepb.SQLReviewRuleLevel_WARNING,
+		},
+	}
+	stats := &SQLReviewCheckStats{}
+	adviceList, err := SQLReviewCheck("DELETE FROM t;", ruleList, SQLReviewCheckContext{
+		DbType:     storepb.Engine_MYSQL,
+		Catalog:    &testCatalog{finder: finder},
+		Context:    context.Background(),
+		TimeBudget: 1,
+		Stats:      stats,
+	})
+	a.NoError(err)
+	a.Len(adviceList, 1)
+	a.Equal(TimeBudgetExceeded, adviceList[0].Code)
+	a.Equal(Warn, adviceList[0].Status)
+	a.Equal([]SQLReviewRuleType{SchemaRuleStatementRequireWhere, SchemaRuleStatementNoSelectAll}, stats.SkippedRules)
+	a.Empty(stats.Rules)
+	a.Positive(stats.Duration)
+}
+
+// fakeWhereAdvisor reports one advice for any statement.
+type fakeWhereAdvisor struct{}
+
+func (fakeWhereAdvisor) Check(Context, string) ([]Advice, error) {
+	return []Advice{{Status: Warn, Title: "fake"}}, nil
+}
+
+var registerFakeWhereAdvisor sync.Once
+
+func TestSQLReviewCheckStats(t *testing.T) {
+	a := require.New(t)
+	// The MySQL advisors are registered by the mysql package, which is not imported by the tests here.
+	registerFakeWhereAdvisor.Do(func() {
+		Register(storepb.Engine_MYSQL, MySQLWhereRequirement, fakeWhereAdvisor{})
+	})
+	finder := catalog.NewFinder(MockMySQLDatabase, &catalog.FinderContext{CheckIntegrity: true, EngineType: storepb.Engine_MYSQL})
+	ruleList := []*storepb.SQLReviewRule{
+		{
+			Type:  string(SchemaRuleStatementRequireWhere),
+			Level: storepb.SQLReviewRuleLevel_WARNING,
+		},
+		{
+			Type:  string(SchemaRuleStatementNoSelectAll),
+			Level: storepb.SQLReviewRuleLevel_DISABLED,
+		},
+	}
+	stats := &SQLReviewCheckStats{}
+	adviceList, err := SQLReviewCheck("DELETE FROM t;", ruleList, SQLReviewCheckContext{
+		DbType:  storepb.Engine_MYSQL,
+		Catalog: &testCatalog{finder: finder},
+		Context: context.Background(),
+		Stats:   stats,
+	})
+	a.NoError(err)
+	a.Len(adviceList, 1)
+	// The disabled rule is not checked.
+	a.Len(stats.Rules, 1)
+	a.Equal(SchemaRuleStatementRequireWhere, stats.Rules[0].Type)
+	a.Equal(1, stats.Rules[0].AdviceCount)
+	a.Empty(stats.SkippedRules)
+	a.GreaterOrEqual(stats.Duration, stats.Rules[0].Duration)
+}
+
+func TestTiDBSyntaxCheckSplitCache(t *testing.T) {
+	a := require.New(t)
+	cache := newCheckCache()
+	_, adviceList := tidbSyntaxCheck("SELECT 1; SELECT 2;", cache)
+	a.Empty(adviceList)
+	// The splits of the syntax check are reused by the rules.
+	list, ok := cache.splits[splitCacheKey{engine: storepb.Engine_TIDB, statement: "SELECT 1; SELECT 2;"}]
+	a.True(ok)
+	a.Len(list, 2)
+}
+
+func TestContextSplitMultiSQL(t *testing.T) {
+	a := require.New(t)
+	ctx := Context{cache: newCheckCache()}
+	list, err := ctx.SplitMultiSQL(storepb.Engine_MYSQL, "SELECT 1; SELECT 2;")
+	a.NoError(err)
+	a.Len(list, 2)
+	a.Len(ctx.cache.splits, 1)
+
+	cached, err := ctx.SplitMultiSQL(storepb.Engine_MYSQL, "SELECT 1; SELECT 2;")
+	a.NoError(err)
+	a.Equal(list, cached)
+	a.Len(ctx.cache.splits, 1)
+}
+
+func TestCompileCustomExpressionCostLimit(t *testing.T) {
+	a := require.New(t)
+	list := "[" + strings.Repeat("1,", 99) + "1]"
+	program, err := CompileCustomExpression(fmt.Sprintf("%s.all(a, %s.all(b, %s.all(c, statement != '')))", list, list, list))
+	a.NoError(err)
+	_, _, err = program.Eval(map[string]any{
+		"engine":         "MYSQL",
+		"database":       "db",
+		"schema":         "",
+		"statement":      "SELECT 1",
+		"statement_type": "SELECT",
+		"tables":         []map[string]any{},
+	})
+	a.ErrorContains(err, "cost limit exceeded")
+}
@@ -454,6 +454,21 @@ func SetDefaultSQLReviewRulePayload(ruleTp SQLReviewRuleType, dbType storepb.Eng
 		payload, err = json.Marshal(NamingCaseRulePayload{
 			Upper: true,
 		})
+	case SchemaRuleCustomExpression:
+		payload, err = json.Marshal(CustomExpressionRulePayload{
+			Expressions: []*CustomExpressionRule{
+				{
+					Title:      "Disallow TRUNCATE",
+					Expression: `statement_type == "TRUNCATE"`,
+					Message:    "TRUNCATE is not allowed",
+				},
+				{
+					Title:      "Disallow altering tables with the id column",
+					Expression: `statement_type == "ALTER" && tables.exists(t, t.exists && "id" in t.columns)`,
+					Message:    "Tables with the id column cannot be altered",
+				},
+			},
+		})
 	default:
 		return "", errors.Errorf("unknown SQL review type for default payload: %s", ruleTp)
 	}
@@ -0,0 +1,151 @@
+// Package jira is the client calling the Jira REST API for the issue lifecycle sync.
+package jira
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Client is the client for Jira.
+type Client struct {
+	client *http.Client
+	// url is the base URL of the Jira site.
+	url      string
+	email    string
+	apiToken string
+}
+
+// NewClient returns a client authenticating with the email and the API token of the Jira account.
+func NewClient(url, email, apiToken string) *Client {
+	return &Client{
+		client: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+		url:      strings.TrimSuffix(url, "/"),
+		email:    email,
+		apiToken: apiToken,
+	}
+}
+
+// CreateIssuePayload is the message to create the Jira issue.
+type CreateIssuePayload struct {
+	ProjectKey  string
+	IssueType   string
+	Summary     string
+	Description string
+}
+
+// Issue is the Jira issue.
+type Issue struct {
+	Key    string `json:"key"`
+	Fields struct {
+		Status struct {
+			Name string `json:"name"`
+		} `json:"status"`
+	} `json:"fields"`
+}
+
+// Transition is the transition of the Jira issue workflow.
+type Transition struct {
+	ID string `json:"id"`
+	To struct {
+		Name string `json:"name"`
+	} `json:"to"`
+}
+
+// CreateIssue creates the Jira issue and returns its key.
+func (c *Client) CreateIssue(payload *CreateIssuePayload) (string, error) {
+	body := map[string]any{
+		"fields": map[string]any{
+			"project":     map[string]string{"key": payload.ProjectKey},
+			"issuetype":   map[string]string{"name": payload.IssueType},
+			"summary":     payload.Summary,
+			"description": payload.Description,
+		},
+	}
+	issue := &Issue{}
+	if err := c.do(http.MethodPost, "/rest/api/2/issue", body, issue); err != nil {
+		return "", errors.Wrapf(err, "failed to create Jira issue")
+	}
+	return issue.Key, nil
+}
+
+// GetIssue gets the Jira issue with its status.
+func (c *Client) GetIssue(key string) (*Issue, error) {
+	issue := &Issue{}
+	if err := c.do(http.MethodGet, fmt.Sprintf("/rest/api/2/issue/%s?fields=status", key), nil, issue); err != nil {
+		return nil, errors.Wrapf(err, "failed to get Jira issue %s", key)
+	}
+	return issue, nil
+}
+
+// TransitionIssue moves the Jira issue to the status by the first transition to it.
+// It does nothing if the issue is already in the status, so that the status mirrored back from Jira is not transitioned again.
+func (c *Client) TransitionIssue(key string, statusName string) error {
+	issue, err := c.GetIssue(key)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(issue.Fields.Status.Name, statusName) {
+		return nil
+	}
+	var transitions struct {
+		Transitions []*Transition `json:"transitions"`
+	}
+	if err := c.do(http.MethodGet, fmt.Sprintf("/rest/api/2/issue/%s/transitions", key), nil, &transitions); err != nil {
+		return errors.Wrapf(err, "failed to list transitions of Jira issue %s", key)
+	}
+	for _, transition := range transitions.Transitions {
+		if !strings.EqualFold(transition.To.Name, statusName) {
+			continue
+		}
+		body := map[string]any{
+			"transition": map[string]string{"id": transition.ID},
+		}
+		if err := c.do(http.MethodPost, fmt.Sprintf("/rest/api/2/issue/%s/transitions", key), body, nil); err != nil {
+			return errors.Wrapf(err, "failed to transition Jira issue %s to %q", key, statusName)
+		}
+		return nil
+	}
+	return errors.Errorf("no transition of Jira issue %s to %q", key, statusName)
+}
+
+func (c *Client) do(method, path string, in any, out any) error {
+	var reader io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, c.url+path, reader)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.email, c.apiToken)
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("unexpected status %v: %s", resp.Status, string(b))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
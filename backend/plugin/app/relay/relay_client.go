@@ -35,6 +35,9 @@ type CreatePayload struct {
 	Project     string    `json:"project"`
 	Statement   string    `json:"statement"`
 	CreateTime  time.Time `json:"createTime"`
+	// CallbackURL is set for the CALLBACK mode node. The external service posts the decision
+	// {"status": "APPROVED" | "REJECTED"} to the URL instead of being polled.
+	CallbackURL string `json:"callbackUrl,omitempty"`
 }
 
 // Status is the status of the external approval.
@@ -0,0 +1,149 @@
+// Package servicenow is the client calling the ServiceNow Table API for the change requests.
+package servicenow
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// State is the state of the change request, which is the value of the "state" field rather than its display value.
+type State string
+
+const (
+	// StateImplement means that the change request is approved and scheduled to be implemented.
+	StateImplement State = "-1"
+	// StateReview means that the change request is implemented and in review.
+	StateReview State = "0"
+	// StateClosed means that the change request is closed.
+	StateClosed State = "3"
+	// StateCanceled means that the change request is canceled.
+	StateCanceled State = "4"
+)
+
+// Client is the client for ServiceNow.
+type Client struct {
+	client *http.Client
+	// url is the base URL of the ServiceNow instance.
+	url      string
+	username string
+	password string
+}
+
+// NewClient returns a client authenticating with the username and the password.
+func NewClient(url, username, password string) *Client {
+	return &Client{
+		client: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		url:      strings.TrimSuffix(url, "/"),
+		username: username,
+		password: password,
+	}
+}
+
+// CreateChangeRequestPayload is the message to create the change request.
+type CreateChangeRequestPayload struct {
+	ShortDescription string `json:"short_description"`
+	Description      string `json:"description"`
+	AssignmentGroup  string `json:"assignment_group,omitempty"`
+}
+
+// ChangeRequest is the change request.
+type ChangeRequest struct {
+	SysID  string `json:"sys_id"`
+	Number string `json:"number"`
+	State  State  `json:"state"`
+}
+
+// CreateChangeRequest creates the normal change request.
+func (c *Client) CreateChangeRequest(payload *CreateChangeRequestPayload) (*ChangeRequest, error) {
+	changeRequest := &ChangeRequest{}
+	if err := c.do(http.MethodPost, "/api/now/table/change_request?sysparm_fields=sys_id,number,state", payload, changeRequest); err != nil {
+		return nil, errors.Wrapf(err, "failed to create change request")
+	}
+	return changeRequest, nil
+}
+
+// GetChangeRequest gets the change request with its state.
+func (c *Client) GetChangeRequest(sysID string) (*ChangeRequest, error) {
+	changeRequest := &ChangeRequest{}
+	if err := c.do(http.MethodGet, fmt.Sprintf("/api/now/table/change_request/%s?sysparm_fields=sys_id,number,state", sysID), nil, changeRequest); err != nil {
+		return nil, errors.Wrapf(err, "failed to get change request %s", sysID)
+	}
+	return changeRequest, nil
+}
+
+// CloseChangeRequest moves the change request to review and then closes it with the close code and notes.
+// The close code is "successful" or "unsuccessful".
+func (c *Client) CloseChangeRequest(sysID string, closeCode string, closeNotes string) error {
+	if err := c.patch(sysID, map[string]string{"state": string(StateReview)}); err != nil {
+		return errors.Wrapf(err, "failed to review change request %s", sysID)
+	}
+	if err := c.patch(sysID, map[string]string{
+		"state":       string(StateClosed),
+		"close_code":  closeCode,
+		"close_notes": closeNotes,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to close change request %s", sysID)
+	}
+	return nil
+}
+
+// CancelChangeRequest cancels the change request.
+func (c *Client) CancelChangeRequest(sysID string, reason string) error {
+	if err := c.patch(sysID, map[string]string{
+		"state":      string(StateCanceled),
+		"work_notes": reason,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to cancel change request %s", sysID)
+	}
+	return nil
+}
+
+func (c *Client) patch(sysID string, fields map[string]string) error {
+	return c.do(http.MethodPatch, fmt.Sprintf("/api/now/table/change_request/%s?sysparm_fields=sys_id", sysID), fields, nil)
+}
+
+func (c *Client) do(method, path string, in any, out any) error {
+	var reader io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, c.url+path, reader)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("unexpected status %v: %s", resp.Status, string(b))
+	}
+	if out == nil {
+		return nil
+	}
+	// The Table API wraps the record in the "result" field.
+	result := struct {
+		Result any `json:"result"`
+	}{Result: out}
+	return json.NewDecoder(resp.Body).Decode(&result)
+}
@@ -0,0 +1,128 @@
+// Package slack is the client of the Bytebase Slack app answering the approvals and slash commands.
+package slack
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// requestMaxAge is the max age of the Slack requests, the older ones are rejected to prevent the replay attacks.
+	requestMaxAge = 5 * time.Minute
+	usersInfoURL  = "https://slack.com/api/users.info"
+)
+
+// VerifyRequest verifies the signature of the request posted by Slack with the signing secret of the Slack app.
+// See https://api.slack.com/authentication/verifying-requests-from-slack.
+func VerifyRequest(signingSecret string, header http.Header, body []byte, now time.Time) error {
+	timestamp := header.Get("X-Slack-Request-Timestamp")
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid request timestamp %q", timestamp)
+	}
+	if age := now.Sub(time.Unix(ts, 0)); age > requestMaxAge || age < -requestMaxAge {
+		return errors.Errorf("request timestamp %q is expired", timestamp)
+	}
+	mac := hmac.New(sha256.New, []byte(signingSecret))
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(header.Get("X-Slack-Signature"))) != 1 {
+		return errors.New("invalid request signature")
+	}
+	return nil
+}
+
+// Client is the client for Slack.
+type Client struct {
+	client   *http.Client
+	botToken string
+}
+
+// NewClient returns a client authenticating with the bot user OAuth token of the Slack app.
+func NewClient(botToken string) *Client {
+	return &Client{
+		client: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+		botToken: botToken,
+	}
+}
+
+// GetUserEmail gets the email of the Slack user, which requires the "users:read.email" scope.
+func (c *Client) GetUserEmail(userID string) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, usersInfoURL+"?"+url.Values{"user": {userID}}.Encode(), nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to construct Slack request")
+	}
+	req.Header.Set("Authorization", "Bearer "+c.botToken)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get Slack user %s", userID)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read Slack response")
+	}
+	var result struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+		User  struct {
+			Profile struct {
+				Email string `json:"email"`
+			} `json:"profile"`
+		} `json:"user"`
+	}
+	if err := json.Unmarshal(b, &result); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal Slack response %.100s", string(b))
+	}
+	if !result.OK {
+		return "", errors.Errorf("failed to get Slack user %s: %s", userID, result.Error)
+	}
+	if result.User.Profile.Email == "" {
+		return "", errors.Errorf("the email of Slack user %s is not visible to the Slack app", userID)
+	}
+	return result.User.Profile.Email, nil
+}
+
+// Message is the message responded to the Slack interactions and slash commands.
+type Message struct {
+	// ResponseType is "ephemeral" for the message visible to the user only, or "in_channel".
+	ResponseType    string `json:"response_type,omitempty"`
+	Text            string `json:"text"`
+	ReplaceOriginal bool   `json:"replace_original"`
+}
+
+// Respond posts the message to the response URL of the Slack interaction.
+func (c *Client) Respond(responseURL string, message *Message) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal Slack message")
+	}
+	u, err := url.Parse(responseURL)
+	if err != nil || u.Scheme != "https" || u.Hostname() != "hooks.slack.com" {
+		return errors.Errorf("invalid Slack response URL %q", responseURL)
+	}
+	resp, err := c.client.Post(responseURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to post Slack response")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return errors.Errorf("failed to post Slack response, status code: %d, response body: %.100s", resp.StatusCode, string(b))
+	}
+	return nil
+}
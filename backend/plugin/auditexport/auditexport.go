@@ -0,0 +1,185 @@
+// Package auditexport is the exporter of the audit logs to S3 or syslog.
+package auditexport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/storage/s3"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	timeout = 10 * time.Second
+	// syslogPriority is the priority of the syslog messages, i.e. the local0 facility and the informational severity.
+	syslogPriority = 16*8 + 6
+	syslogAppName  = "bytebase"
+	syslogMsgID    = "audit"
+)
+
+// Message is an audit log exported.
+type Message struct {
+	ID   int64
+	Time time.Time
+	// Value is the audit log in the JSON schema.
+	Value []byte
+}
+
+// Exporter is the exporter of the audit logs.
+type Exporter interface {
+	// Export exports the messages in the ID order, which are all delivered if no error is returned.
+	Export(ctx context.Context, messages ...*Message) error
+	Close() error
+}
+
+// NewExporter creates the exporter of the audit log setting.
+func NewExporter(ctx context.Context, setting *storepb.AuditLogSetting) (Exporter, error) {
+	switch setting.ExportType {
+	case storepb.AuditLogSetting_S3:
+		return newS3Exporter(ctx, setting.S3)
+	case storepb.AuditLogSetting_SYSLOG:
+		return newSyslogExporter(setting.Syslog)
+	default:
+		return nil, errors.Errorf("unsupported audit log export type %v", setting.ExportType)
+	}
+}
+
+type s3Exporter struct {
+	client *s3.Client
+	prefix string
+}
+
+func newS3Exporter(ctx context.Context, setting *storepb.AuditLogSetting_S3Export) (*s3Exporter, error) {
+	if setting == nil {
+		return nil, errors.Errorf("S3 export setting is required")
+	}
+	client, err := s3.NewClient(ctx, setting.Region, setting.Bucket, aws.Credentials{
+		AccessKeyID:     setting.AccessKeyId,
+		SecretAccessKey: setting.SecretAccessKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &s3Exporter{client: client, prefix: setting.Prefix}, nil
+}
+
+// Export uploads the messages as a JSON Lines object.
+func (e *s3Exporter) Export(ctx context.Context, messages ...*Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	for _, message := range messages {
+		buf.Write(message.Value)
+		buf.WriteByte('\n')
+	}
+	key := getS3ObjectKey(e.prefix, messages[0], messages[len(messages)-1])
+	if _, err := e.client.UploadObject(ctx, key, &buf); err != nil {
+		return errors.Wrapf(err, "failed to upload audit logs to %s", key)
+	}
+	return nil
+}
+
+func (*s3Exporter) Close() error {
+	return nil
+}
+
+// getS3ObjectKey returns the object key partitioned by the date of the first message, e.g. "{prefix}/2023/07/04/{first ID}-{last ID}.jsonl".
+// The IDs are zero-padded so that the keys are listed in the ID order.
+func getS3ObjectKey(prefix string, first, last *Message) string {
+	return path.Join(prefix, first.Time.UTC().Format("2006/01/02"), fmt.Sprintf("%020d-%020d.jsonl", first.ID, last.ID))
+}
+
+type syslogExporter struct {
+	network  string
+	address  string
+	hostname string
+	conn     net.Conn
+}
+
+func newSyslogExporter(setting *storepb.AuditLogSetting_SyslogExport) (*syslogExporter, error) {
+	if setting == nil {
+		return nil, errors.Errorf("syslog export setting is required")
+	}
+	network, address, err := ParseSyslogAddress(setting.Address)
+	if err != nil {
+		return nil, err
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	return &syslogExporter{network: network, address: address, hostname: hostname}, nil
+}
+
+// ParseSyslogAddress parses the syslog address in the "udp://host:port" or "tcp://host:port" format.
+func ParseSyslogAddress(address string) (string, string, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "invalid syslog address %q", address)
+	}
+	if u.Scheme != "udp" && u.Scheme != "tcp" {
+		return "", "", errors.Errorf("invalid syslog address %q, the scheme must be udp or tcp", address)
+	}
+	if u.Host == "" {
+		return "", "", errors.Errorf("invalid syslog address %q, the host is required", address)
+	}
+	return u.Scheme, u.Host, nil
+}
+
+// Export sends a syslog message per audit log.
+// The connection is redialed on the next export if the export fails.
+func (e *syslogExporter) Export(ctx context.Context, messages ...*Message) error {
+	if e.conn == nil {
+		dialer := &net.Dialer{Timeout: timeout}
+		conn, err := dialer.DialContext(ctx, e.network, e.address)
+		if err != nil {
+			return errors.Wrapf(err, "failed to dial syslog server %s", e.address)
+		}
+		e.conn = conn
+	}
+	for _, message := range messages {
+		frame := formatSyslogMessage(e.hostname, message)
+		// The TCP transport uses the octet counting framing of RFC 6587.
+		if e.network == "tcp" {
+			frame = append([]byte(fmt.Sprintf("%d ", len(frame))), frame...)
+		}
+		if err := e.conn.SetWriteDeadline(time.Now().Add(timeout)); err != nil {
+			return e.fail(err)
+		}
+		if _, err := e.conn.Write(frame); err != nil {
+			return e.fail(err)
+		}
+	}
+	return nil
+}
+
+func (e *syslogExporter) fail(err error) error {
+	_ = e.conn.Close()
+	e.conn = nil
+	return errors.Wrapf(err, "failed to send audit logs to syslog server %s", e.address)
+}
+
+func (e *syslogExporter) Close() error {
+	if e.conn == nil {
+		return nil
+	}
+	err := e.conn.Close()
+	e.conn = nil
+	return err
+}
+
+// formatSyslogMessage formats the message in RFC 5424, e.g. "<134>1 2023-07-04T08:00:00Z host bytebase - audit - {...}".
+func formatSyslogMessage(hostname string, message *Message) []byte {
+	header := fmt.Sprintf("<%d>1 %s %s %s - %s - ", syslogPriority, message.Time.UTC().Format(time.RFC3339), hostname, syslogAppName, syslogMsgID)
+	return append([]byte(header), message.Value...)
+}
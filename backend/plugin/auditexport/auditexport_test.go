@@ -0,0 +1,45 @@
+package auditexport
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSyslogAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		network string
+		host    string
+		wantErr bool
+	}{
+		{address: "udp://syslog.example.com:514", network: "udp", host: "syslog.example.com:514"},
+		{address: "tcp://10.0.0.1:6514", network: "tcp", host: "10.0.0.1:6514"},
+		{address: "syslog.example.com:514", wantErr: true},
+		{address: "http://syslog.example.com:514", wantErr: true},
+		{address: "udp://", wantErr: true},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		network, host, err := ParseSyslogAddress(test.address)
+		if test.wantErr {
+			a.Error(err, test.address)
+			continue
+		}
+		a.NoError(err)
+		a.Equal(test.network, network)
+		a.Equal(test.host, host)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	a := require.New(t)
+
+	first := &Message{ID: 101, Time: time.Date(2023, 7, 4, 8, 0, 0, 0, time.UTC), Value: []byte(`{"id":101}`)}
+	last := &Message{ID: 200, Time: time.Date(2023, 7, 5, 8, 0, 0, 0, time.UTC), Value: []byte(`{"id":200}`)}
+	a.Equal("audit/2023/07/04/00000000000000000101-00000000000000000200.jsonl", getS3ObjectKey("audit", first, last))
+	a.Equal("2023/07/04/00000000000000000101-00000000000000000200.jsonl", getS3ObjectKey("", first, last))
+	a.Equal(`<134>1 2023-07-04T08:00:00Z host bytebase - audit - {"id":101}`, string(formatSyslogMessage("host", first)))
+}
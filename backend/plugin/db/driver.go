@@ -13,8 +13,11 @@ import (
 	"time"
 	"unicode"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/bytebase/bytebase/backend/common/tracing"
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	"github.com/bytebase/bytebase/backend/store/model"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -71,6 +74,9 @@ type DriverConfig struct {
 	// NOTE, introducing db specific fields is the last resort.
 	// MySQL specific
 	BinlogDir string
+	// Postgres specific
+	// PgxTracer traces the queries on the connections, e.g. the metadata database counts the statement cache hits.
+	PgxTracer pgx.QueryTracer
 }
 
 type driverFunc func(DriverConfig) Driver
@@ -85,6 +91,10 @@ const (
 	VCS MigrationSource = "VCS"
 	// LIBRARY is the migration source type for LIBRARY.
 	LIBRARY MigrationSource = "LIBRARY"
+	// FLYWAY is the migration source type for the history imported from Flyway.
+	FLYWAY MigrationSource = "FLYWAY"
+	// LIQUIBASE is the migration source type for the history imported from Liquibase.
+	LIQUIBASE MigrationSource = "LIQUIBASE"
 )
 
 // MigrationType is the type of a migration.
@@ -160,6 +170,15 @@ type MigrationInfo struct {
 	Payload *storepb.InstanceChangeHistoryPayload
 }
 
+// migrationInfoPlaceholderList is the placeholders of the file path template, which are also the named groups of the pattern.
+var migrationInfoPlaceholderList = []string{
+	"ENV_ID",
+	"VERSION",
+	"DB_NAME",
+	"TYPE",
+	"DESCRIPTION",
+}
+
 // placeholderRegexp is the regexp for placeholder.
 // Refer to https://stackoverflow.com/a/6222235/19075342, but we support "." for now.
 const placeholderRegexp = `[^\\/?%*:|"<>]+`
@@ -169,14 +188,6 @@ const placeholderRegexp = `[^\\/?%*:|"<>]+`
 // Both filePath and filePathTemplate are the full file path (including the base directory) of the repository.
 // It returns (nil, nil) if it doesn't look like a migration file path.
 func ParseMigrationInfo(filePath, filePathTemplate string, allowOmitDatabaseName bool) (*MigrationInfo, error) {
-	placeholderList := []string{
-		"ENV_ID",
-		"VERSION",
-		"DB_NAME",
-		"TYPE",
-		"DESCRIPTION",
-	}
-
 	// Escape "." characters to match literals instead of using it as a wildcard.
 	filePathRegex := strings.ReplaceAll(filePathTemplate, `.`, `\.`)
 
@@ -192,15 +203,26 @@ func ParseMigrationInfo(filePath, filePathTemplate string, allowOmitDatabaseName
 	// After the previous for-loop, filePathRegex will not include any "/*/" anymore, so we can safely replace all ** to .*.
 	filePathRegex = strings.ReplaceAll(filePathRegex, `**`, `.*`)
 
-	for _, placeholder := range placeholderList {
+	for _, placeholder := range migrationInfoPlaceholderList {
 		filePathRegex = strings.ReplaceAll(filePathRegex, fmt.Sprintf("{{%s}}", placeholder), fmt.Sprintf(`(?P<%s>%s)`, placeholder, placeholderRegexp))
 	}
 	myRegex, err := regexp.Compile(filePathRegex)
 	if err != nil {
 		return nil, errors.Errorf("invalid file path template: %q", filePathTemplate)
 	}
-	if !myRegex.MatchString(filePath) {
-		// File path does not match file path template.
+	mi, err := ParseMigrationInfoByPattern(filePath, myRegex, allowOmitDatabaseName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "configured file path template %q", filePathTemplate)
+	}
+	return mi, nil
+}
+
+// ParseMigrationInfoByPattern matches filePath against the pattern, and derives MigrationInfo from the named groups
+// VERSION, DB_NAME, TYPE, ENV_ID and DESCRIPTION, which are the same as the placeholders of the file path template.
+// It returns (nil, nil) if the file path doesn't match the pattern.
+func ParseMigrationInfoByPattern(filePath string, pattern *regexp.Regexp, allowOmitDatabaseName bool) (*MigrationInfo, error) {
+	if !pattern.MatchString(filePath) {
+		// File path does not match the pattern.
 		return nil, nil
 	}
 
@@ -208,9 +230,9 @@ func ParseMigrationInfo(filePath, filePathTemplate string, allowOmitDatabaseName
 		Source: VCS,
 		Type:   Migrate,
 	}
-	matchList := myRegex.FindStringSubmatch(filePath)
-	for _, placeholder := range placeholderList {
-		index := myRegex.SubexpIndex(placeholder)
+	matchList := pattern.FindStringSubmatch(filePath)
+	for _, placeholder := range migrationInfoPlaceholderList {
+		index := pattern.SubexpIndex(placeholder)
 		if index >= 0 {
 			switch placeholder {
 			case "ENV_ID":
@@ -240,10 +262,10 @@ func ParseMigrationInfo(filePath, filePathTemplate string, allowOmitDatabaseName
 	}
 
 	if mi.Version.Version == "" {
-		return nil, errors.Errorf("file path %q does not contain {{VERSION}}, configured file path template %q", filePath, filePathTemplate)
+		return nil, errors.Errorf("file path %q does not contain {{VERSION}}", filePath)
 	}
 	if mi.Namespace == "" && !allowOmitDatabaseName {
-		return nil, errors.Errorf("file path %q does not contain {{DB_NAME}}, configured file path template %q", filePath, filePathTemplate)
+		return nil, errors.Errorf("file path %q does not contain {{DB_NAME}}", filePath)
 	}
 
 	if mi.Description == "" {
@@ -474,7 +496,9 @@ func Open(ctx context.Context, dbType storepb.Engine, driverConfig DriverConfig,
 		return nil, errors.Errorf("db: unknown driver %v", dbType)
 	}
 
+	ctx, span := tracing.StartChild(ctx, "db.Open", attribute.String("db.system", dbType.String()))
 	driver, err := f(driverConfig).Open(ctx, dbType, connectionConfig)
+	tracing.End(span, err)
 	if err != nil {
 		return nil, err
 	}
@@ -492,6 +516,9 @@ type ExecuteOptions struct {
 	// For both cases, we will use one transaction to wrap the statements.
 	ChunkedSubmission     bool
 	UpdateExecutionStatus func(*v1pb.TaskRun_ExecutionDetail)
+	// Transactional is the flag to run all statements in a single transaction with a savepoint before each statement.
+	// The statements that cannot run in a transaction are refused. Only supported for PostgreSQL.
+	Transactional bool
 }
 
 // ErrorWithPosition is the error with the position information.
@@ -504,3 +531,7 @@ type ErrorWithPosition struct {
 func (e *ErrorWithPosition) Error() string {
 	return e.Err.Error()
 }
+
+func (e *ErrorWithPosition) Unwrap() error {
+	return e.Err
+}
@@ -1,9 +1,12 @@
 package db
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -251,6 +254,29 @@ func TestParseMigrationInfo(t *testing.T) {
 	}
 }
 
+func TestParseMigrationInfoByPattern(t *testing.T) {
+	pattern := regexp.MustCompile(`(?P<DB_NAME>\w+)/(?P<ENV_ID>\w+)/(?P<VERSION>\d+)__(?P<TYPE>migrate|data)\.sql$`)
+
+	mi, err := ParseMigrationInfoByPattern("payment/ledger/prod/0002__data.sql", pattern, false)
+	require.NoError(t, err)
+	require.Equal(t, &MigrationInfo{
+		Version:     model.Version{Version: "0002"},
+		Namespace:   "ledger",
+		Database:    "ledger",
+		Environment: "prod",
+		Source:      VCS,
+		Type:        Data,
+		Description: "Create ledger data change",
+	}, mi)
+
+	mi, err = ParseMigrationInfoByPattern("payment/README.md", pattern, false)
+	require.NoError(t, err)
+	require.Nil(t, mi)
+
+	_, err = ParseMigrationInfoByPattern("0002.sql", regexp.MustCompile(`(?P<VERSION>\d+)\.sql$`), false)
+	require.ErrorContains(t, err, "does not contain {{DB_NAME}}")
+}
+
 func TestParseSchemaFileInfo(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -320,3 +346,9 @@ func TestParseSchemaFileInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorWithPositionUnwrap(t *testing.T) {
+	a := require.New(t)
+	var err error = &ErrorWithPosition{Err: errors.Wrap(context.Canceled, "failed to execute context in a transaction")}
+	a.ErrorIs(err, context.Canceled)
+}
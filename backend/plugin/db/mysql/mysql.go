@@ -183,6 +183,7 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 
 	if opts.BeginFunc != nil {
 		if err := opts.BeginFunc(ctx, conn); err != nil {
+			driver.killQueryIfCanceled(ctx, connectionID)
 			return 0, err
 		}
 	}
@@ -251,13 +252,7 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 
 		sqlResult, err := tx.ExecContext(ctx, chunkText)
 		if err != nil {
-			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
-				slog.Info("cancel connection", slog.String("connectionID", connectionID))
-				if err := driver.StopConnectionByID(connectionID); err != nil {
-					slog.Error("failed to cancel connection", slog.String("connectionID", connectionID), log.BBError(err))
-				}
-			}
-
+			driver.killQueryIfCanceled(ctx, connectionID)
 			return 0, &db.ErrorWithPosition{
 				Err: errors.Wrapf(err, "failed to execute context in a transaction"),
 				Start: &storepb.TaskRunResult_Position{
@@ -280,6 +275,7 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 	}
 
 	if err := tx.Commit(); err != nil {
+		driver.killQueryIfCanceled(ctx, connectionID)
 		return 0, errors.Wrapf(err, "failed to commit execute transaction")
 	}
 
@@ -330,6 +326,22 @@ func (driver *Driver) StopConnectionByID(id string) error {
 	return err
 }
 
+// killQueryIfCanceled kills the statement running in the connection if the context is canceled.
+// The driver only closes the client connection on cancellation, and the server keeps running the statement and holding its locks,
+// so we kill it from another connection.
+func (driver *Driver) killQueryIfCanceled(ctx context.Context, connectionID string) {
+	if ctx.Err() == nil {
+		return
+	}
+	slog.Info("cancel connection", slog.String("connectionID", connectionID))
+	cancelCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	// We cannot use placeholder parameter because TiDB doesn't accept it.
+	if _, err := driver.db.ExecContext(cancelCtx, fmt.Sprintf("KILL QUERY %s", connectionID)); err != nil {
+		slog.Error("failed to cancel connection", slog.String("connectionID", connectionID), log.BBError(err))
+	}
+}
+
 func getConnectionID(ctx context.Context, conn *sql.Conn) (string, error) {
 	var id string
 	if err := conn.QueryRowContext(ctx, `SELECT CONNECTION_ID();`).Scan(&id); err != nil {
@@ -1,9 +1,20 @@
 package mysql
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
 )
 
 func TestParseVersion(t *testing.T) {
@@ -42,3 +53,120 @@ func TestParseVersion(t *testing.T) {
 		a.Equal(tc.wantRest, rest)
 	}
 }
+
+func TestExecuteKillQueryOnCancel(t *testing.T) {
+	a := require.New(t)
+	connector := &fakeConnector{executing: make(chan struct{})}
+	driver := &Driver{db: sql.OpenDB(connector)}
+	defer driver.db.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-connector.executing
+		cancel()
+	}()
+	_, err := driver.Execute(ctx, "SELECT SLEEP(100);", db.ExecuteOptions{})
+	a.Error(err)
+	a.Equal([]string{"KILL QUERY 1"}, connector.getKills())
+
+	// The statements failed for other reasons are not killed.
+	_, err = driver.Execute(context.Background(), "SELECT 1 FROM unknown;", db.ExecuteOptions{})
+	a.Error(err)
+	a.Equal([]string{"KILL QUERY 1"}, connector.getKills())
+}
+
+// fakeConnector is a database/sql connector that blocks SLEEP statements until the context is canceled,
+// and fails them with the error the MySQL driver returns for the canceled statements.
+type fakeConnector struct {
+	executing chan struct{}
+
+	mu     sync.Mutex
+	nextID int
+	kills  []string
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nextID++
+	return &fakeConn{connector: c, id: c.nextID}, nil
+}
+
+func (c *fakeConnector) Driver() driver.Driver {
+	return nil
+}
+
+func (c *fakeConnector) getKills() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.kills)
+}
+
+type fakeConn struct {
+	connector *fakeConnector
+	id        int
+}
+
+func (*fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (*fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c, nil
+}
+
+func (*fakeConn) Commit() error {
+	return nil
+}
+
+func (*fakeConn) Rollback() error {
+	return nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	if query != "SELECT CONNECTION_ID();" {
+		return nil, errors.Errorf("unexpected query %q", query)
+	}
+	return &fakeRows{values: []driver.Value{int64(c.id)}}, nil
+}
+
+func (c *fakeConn) ExecContext(ctx context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	switch {
+	case strings.HasPrefix(query, "KILL QUERY"):
+		c.connector.mu.Lock()
+		defer c.connector.mu.Unlock()
+		c.connector.kills = append(c.connector.kills, query)
+		return driver.RowsAffected(0), nil
+	case strings.HasPrefix(query, "SELECT SLEEP"):
+		close(c.connector.executing)
+		<-ctx.Done()
+		return nil, mysql.ErrInvalidConn
+	default:
+		return nil, errors.Errorf("unexpected statement %q", query)
+	}
+}
+
+type fakeRows struct {
+	values []driver.Value
+}
+
+func (*fakeRows) Columns() []string {
+	return []string{"CONNECTION_ID()"}
+}
+
+func (*fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.values == nil {
+		return io.EOF
+	}
+	copy(dest, r.values)
+	r.values = nil
+	return nil
+}
@@ -44,8 +44,9 @@ func init() {
 
 // Driver is the Postgres driver.
 type Driver struct {
-	dbBinDir string
-	config   db.ConnectionConfig
+	dbBinDir  string
+	pgxTracer pgx.QueryTracer
+	config    db.ConnectionConfig
 
 	db        *sql.DB
 	sshClient *ssh.Client
@@ -58,7 +59,8 @@ type Driver struct {
 
 func newDriver(config db.DriverConfig) db.Driver {
 	return &Driver{
-		dbBinDir: config.DbBinDir,
+		dbBinDir:  config.DbBinDir,
+		pgxTracer: config.PgxTracer,
 	}
 }
 
@@ -132,6 +134,9 @@ func (driver *Driver) Open(ctx context.Context, _ storepb.Engine, config db.Conn
 		driver.databaseName = databaseName
 	}
 	driver.config = config
+	if driver.pgxTracer != nil {
+		connConfig.Tracer = driver.pgxTracer
+	}
 
 	driver.connectionString = stdlib.RegisterConnConfig(connConfig)
 	db, err := sql.Open(driverName, driver.connectionString)
@@ -291,6 +296,9 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 	if len(singleSQLs) == 0 {
 		return 0, nil
 	}
+	if opts.Transactional {
+		return driver.executeInTransaction(ctx, singleSQLs, owner, opts)
+	}
 
 	var remainingSQLs []base.SingleSQL
 	var nonTransactionStmts []string
@@ -304,15 +312,7 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 		}
 
 		if isSuperuserStatement(singleSQL.Text) {
-			// CREATE EVENT TRIGGER statement only supports EXECUTE PROCEDURE in version 10 and before, while newer version supports both EXECUTE { FUNCTION | PROCEDURE }.
-			// Since we use pg_dump version 14, the dump uses a new style even for an old version of PostgreSQL.
-			// We should convert EXECUTE FUNCTION to EXECUTE PROCEDURE to make the restoration work on old versions.
-			// https://www.postgresql.org/docs/14/sql-createeventtrigger.html
-			if strings.Contains(strings.ToUpper(singleSQL.Text), "CREATE EVENT TRIGGER") {
-				singleSQL.Text = strings.ReplaceAll(singleSQL.Text, "EXECUTE FUNCTION", "EXECUTE PROCEDURE")
-			}
-			// Use superuser privilege to run privileged statements.
-			singleSQL.Text = fmt.Sprintf("SET LOCAL ROLE NONE;%sSET LOCAL ROLE '%s';", singleSQL.Text, owner)
+			singleSQL.Text = getSuperuserStatement(singleSQL.Text, owner)
 		}
 		remainingSQLs = append(remainingSQLs, singleSQL)
 	}
@@ -344,6 +344,10 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 		if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL ROLE '%s'", owner)); err != nil {
 			return 0, err
 		}
+		pid, err := getBackendPID(ctx, tx)
+		if err != nil {
+			return 0, err
+		}
 
 		for _, chunk := range chunks {
 			if len(chunk) == 0 {
@@ -373,6 +377,7 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 
 			sqlResult, err := tx.ExecContext(ctx, chunkText)
 			if err != nil {
+				driver.cancelBackendIfCanceled(ctx, pid)
 				return 0, &db.ErrorWithPosition{
 					Err: errors.Wrapf(err, "failed to execute context in a transaction"),
 					Start: &storepb.TaskRunResult_Position{
@@ -400,14 +405,174 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 	}
 
 	// Run non-transaction statements at the end.
-	for _, stmt := range nonTransactionStmts {
-		if _, err := driver.db.ExecContext(ctx, stmt); err != nil {
+	if len(nonTransactionStmts) > 0 {
+		conn, err := driver.db.Conn(ctx)
+		if err != nil {
+			return 0, err
+		}
+		defer conn.Close()
+		pid, err := getBackendPID(ctx, conn)
+		if err != nil {
 			return 0, err
 		}
+		for _, stmt := range nonTransactionStmts {
+			if _, err := conn.ExecContext(ctx, stmt); err != nil {
+				driver.cancelBackendIfCanceled(ctx, pid)
+				return 0, err
+			}
+		}
 	}
 	return totalRowsAffected, nil
 }
 
+// executeInTransaction executes all statements in a single transaction with a savepoint before each statement.
+// The statements that cannot run in a transaction are refused before executing any statement.
+// On failure, the failed statement is rolled back to its savepoint so that the error is reported with the position of the statement,
+// and then the whole transaction is rolled back.
+func (driver *Driver) executeInTransaction(ctx context.Context, singleSQLs []base.SingleSQL, owner string, opts db.ExecuteOptions) (int64, error) {
+	var stmts []base.SingleSQL
+	for _, singleSQL := range singleSQLs {
+		if isIgnoredStatement(singleSQL.Text) {
+			continue
+		}
+		if isNonTransactionStatement(singleSQL.Text) || isTransactionControlStatement(singleSQL.Text) {
+			statement, _ := common.TruncateString(strings.TrimSpace(singleSQL.Text), 256)
+			return 0, &db.ErrorWithPosition{
+				Err:   errors.Errorf("statement %q cannot run in the transactional migration", statement),
+				Start: getStatementStartPosition(singleSQL),
+				End:   getStatementEndPosition(singleSQL),
+			}
+		}
+		if isSuperuserStatement(singleSQL.Text) {
+			singleSQL.Text = getSuperuserStatement(singleSQL.Text, owner)
+		}
+		stmts = append(stmts, singleSQL)
+	}
+	if len(stmts) == 0 {
+		return 0, nil
+	}
+
+	tx, err := driver.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	// Set the current transaction role to the database owner so that the owner of created objects will be the same as the database owner.
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL ROLE '%s'", owner)); err != nil {
+		return 0, err
+	}
+	pid, err := getBackendPID(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	totalRowsAffected := int64(0)
+	for i, stmt := range stmts {
+		if opts.UpdateExecutionStatus != nil {
+			opts.UpdateExecutionStatus(&v1pb.TaskRun_ExecutionDetail{
+				CommandsTotal:     int32(len(stmts)),
+				CommandsCompleted: int32(i),
+				CommandStartPosition: &v1pb.TaskRun_ExecutionDetail_Position{
+					Line:   int32(stmt.FirstStatementLine),
+					Column: int32(stmt.FirstStatementColumn),
+				},
+				CommandEndPosition: &v1pb.TaskRun_ExecutionDetail_Position{
+					Line:   int32(stmt.LastLine),
+					Column: int32(stmt.LastColumn),
+				},
+			})
+		}
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT bb_statement"); err != nil {
+			return 0, errors.Wrapf(err, "failed to create savepoint")
+		}
+		sqlResult, err := tx.ExecContext(ctx, stmt.Text)
+		if err != nil {
+			driver.cancelBackendIfCanceled(ctx, pid)
+			if _, rollbackErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT bb_statement"); rollbackErr != nil {
+				slog.Warn("failed to roll back to savepoint", log.BBError(rollbackErr))
+			}
+			return 0, &db.ErrorWithPosition{
+				Err:   errors.Wrapf(err, "failed to execute statement %d of %d, the migration is rolled back", i+1, len(stmts)),
+				Start: getStatementStartPosition(stmt),
+				End:   getStatementEndPosition(stmt),
+			}
+		}
+		if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT bb_statement"); err != nil {
+			return 0, errors.Wrapf(err, "failed to release savepoint")
+		}
+		rowsAffected, err := sqlResult.RowsAffected()
+		if err != nil {
+			// Since we cannot differentiate DDL and DML yet, we have to ignore the error.
+			slog.Debug("rowsAffected returns error", log.BBError(err))
+		}
+		totalRowsAffected += rowsAffected
+	}
+
+	if opts.EndTransactionFunc != nil {
+		if err := opts.EndTransactionFunc(tx); err != nil {
+			return 0, errors.Wrapf(err, "failed to execute beforeCommitTx, the migration is rolled back")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return totalRowsAffected, nil
+}
+
+// getBackendPID returns the process ID of the backend serving the connection or the transaction.
+func getBackendPID(ctx context.Context, q interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}) (int, error) {
+	var pid int
+	if err := q.QueryRowContext(ctx, "SELECT pg_backend_pid()").Scan(&pid); err != nil {
+		return 0, errors.Wrapf(err, "failed to get backend pid")
+	}
+	return pid, nil
+}
+
+// cancelBackendIfCanceled cancels the statement running in the backend if the context is canceled.
+// Closing the client connection on cancellation doesn't stop the statement until the backend writes to the connection,
+// so the statement would keep running and holding its locks otherwise.
+func (driver *Driver) cancelBackendIfCanceled(ctx context.Context, pid int) {
+	if ctx.Err() == nil {
+		return
+	}
+	slog.Info("cancel backend", slog.Int("pid", pid))
+	cancelCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := driver.db.ExecContext(cancelCtx, "SELECT pg_cancel_backend($1)", pid); err != nil {
+		slog.Error("failed to cancel backend", slog.Int("pid", pid), log.BBError(err))
+	}
+}
+
+func getStatementStartPosition(singleSQL base.SingleSQL) *storepb.TaskRunResult_Position {
+	return &storepb.TaskRunResult_Position{
+		Line:   int32(singleSQL.FirstStatementLine),
+		Column: int32(singleSQL.FirstStatementColumn),
+	}
+}
+
+func getStatementEndPosition(singleSQL base.SingleSQL) *storepb.TaskRunResult_Position {
+	return &storepb.TaskRunResult_Position{
+		Line:   int32(singleSQL.LastLine),
+		Column: int32(singleSQL.LastColumn),
+	}
+}
+
+// getSuperuserStatement returns the statement running with the superuser privilege in the transaction.
+func getSuperuserStatement(stmt string, owner string) string {
+	// CREATE EVENT TRIGGER statement only supports EXECUTE PROCEDURE in version 10 and before, while newer version supports both EXECUTE { FUNCTION | PROCEDURE }.
+	// Since we use pg_dump version 14, the dump uses a new style even for an old version of PostgreSQL.
+	// We should convert EXECUTE FUNCTION to EXECUTE PROCEDURE to make the restoration work on old versions.
+	// https://www.postgresql.org/docs/14/sql-createeventtrigger.html
+	if strings.Contains(strings.ToUpper(stmt), "CREATE EVENT TRIGGER") {
+		stmt = strings.ReplaceAll(stmt, "EXECUTE FUNCTION", "EXECUTE PROCEDURE")
+	}
+	// Use superuser privilege to run privileged statements.
+	return fmt.Sprintf("SET LOCAL ROLE NONE;%sSET LOCAL ROLE '%s';", stmt, owner)
+}
+
 func (driver *Driver) createDatabaseExecute(ctx context.Context, statement string) error {
 	databaseName, err := getDatabaseInCreateDatabaseStatement(statement)
 	if err != nil {
@@ -460,6 +625,13 @@ var (
 	vacuumReg = regexp.MustCompile(`(?i)VACUUM`)
 )
 
+// transactionControlReg matches the statements controlling the transaction, which break the transactional migration.
+var transactionControlReg = regexp.MustCompile(`(?i)^\s*(BEGIN|START\s+TRANSACTION|COMMIT|END|ROLLBACK|ABORT)\b`)
+
+func isTransactionControlStatement(stmt string) bool {
+	return transactionControlReg.MatchString(stmt)
+}
+
 func isNonTransactionStatement(stmt string) bool {
 	if len(createIndexReg.FindString(stmt)) > 0 {
 		return true
@@ -49,3 +49,24 @@ func TestGetDatabaseInCreateDatabaseStatement(t *testing.T) {
 		require.Equal(t, test.want, got)
 	}
 }
+
+func TestIsTransactionControlStatement(t *testing.T) {
+	tests := []struct {
+		stmt string
+		want bool
+	}{
+		{"BEGIN;", true},
+		{"\n  commit;", true},
+		{"START TRANSACTION ISOLATION LEVEL SERIALIZABLE;", true},
+		{"ROLLBACK;", true},
+		{"END;", true},
+		{"CREATE TABLE begin_log (id INT);", false},
+		{"DO $$ BEGIN RAISE NOTICE 'hello'; END $$;", false},
+		{"UPDATE t SET committed = true;", false},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, isTransactionControlStatement(test.stmt), test.stmt)
+	}
+}
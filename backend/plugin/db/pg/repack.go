@@ -0,0 +1,124 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+)
+
+// RepackOptions is the options to rebuild a table online with pg_repack.
+type RepackOptions struct {
+	Schema string
+	Table  string
+	// Tablespace is the tablespace to move the table to, the table stays in its tablespace if empty.
+	Tablespace string
+}
+
+// Repack rebuilds the table with pg_repack, which only holds an ACCESS EXCLUSIVE lock briefly at the end.
+// The pg_repack extension must be installed in the database.
+func (driver *Driver) Repack(ctx context.Context, opts RepackOptions) error {
+	if driver.databaseName == "" {
+		return errors.Errorf("database must be specified to run pg_repack")
+	}
+	pgRepackPath, err := driver.getPgRepackPath()
+	if err != nil {
+		return err
+	}
+
+	var args []string
+	args = append(args, fmt.Sprintf("--username=%s", driver.config.Username))
+	if driver.config.Password == "" {
+		args = append(args, "--no-password")
+	}
+	if driver.sshClient == nil {
+		args = append(args, fmt.Sprintf("--host=%s", driver.config.Host))
+		args = append(args, fmt.Sprintf("--port=%s", driver.config.Port))
+	} else {
+		localPort := <-util.PortFIFO
+		defer func() {
+			util.PortFIFO <- localPort
+		}()
+		args = append(args, fmt.Sprintf("--host=%s", "localhost"))
+		args = append(args, fmt.Sprintf("--port=%d", localPort))
+		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", localPort))
+		if err != nil {
+			return err
+		}
+		defer listener.Close()
+		databaseAddress := fmt.Sprintf("%s:%s", driver.config.Host, driver.config.Port)
+		go util.ProxyConnection(driver.sshClient, listener, databaseAddress)
+	}
+	args = append(args, fmt.Sprintf("--dbname=%s", driver.databaseName))
+	table := fmt.Sprintf(`"%s"`, opts.Table)
+	if opts.Schema != "" {
+		table = fmt.Sprintf(`"%s".%s`, opts.Schema, table)
+	}
+	args = append(args, fmt.Sprintf("--table=%s", table))
+	if opts.Tablespace != "" {
+		args = append(args, fmt.Sprintf("--tablespace=%s", opts.Tablespace))
+		// Move the indexes along with the table.
+		args = append(args, "--moveidx")
+	}
+
+	var errs error
+	for _, sslCA := range splitSslCA(driver.config.TLSConfig.SslCA) {
+		if err := driver.execPgRepack(ctx, pgRepackPath, args, sslCA); err != nil {
+			errs = multierr.Append(errs, err)
+			slog.Warn("Failed to exec pg_repack", log.BBError(err))
+			continue
+		}
+		return nil
+	}
+	return errors.Errorf("failed to exec pg_repack on table %s, err: %v", table, errs)
+}
+
+// getPgRepackPath returns the pg_repack binary, the bundled binary takes precedence over the one in PATH.
+func (driver *Driver) getPgRepackPath() (string, error) {
+	bundled := filepath.Join(driver.dbBinDir, "pg_repack")
+	if _, err := os.Stat(bundled); err == nil {
+		return bundled, nil
+	}
+	path, err := exec.LookPath("pg_repack")
+	if err != nil {
+		return "", errors.Wrapf(err, "pg_repack binary not found")
+	}
+	return path, nil
+}
+
+func (driver *Driver) execPgRepack(ctx context.Context, pgRepackPath string, args []string, sslCA string) error {
+	cmd := exec.CommandContext(ctx, pgRepackPath, args...)
+	if driver.config.Password != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("PGPASSWORD=%s", driver.config.Password))
+	}
+	if driver.config.TLSConfig.SslCert != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("PGSSLCERT=%s", driver.config.TLSConfig.SslCert))
+	}
+	if sslCA != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("PGSSLROOTCERT=%s", sslCA))
+	}
+	if driver.config.TLSConfig.SslKey != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("PGSSLKEY=%s", driver.config.TLSConfig.SslKey))
+	}
+	cmd.Env = append(cmd.Env, "OPENSSL_CONF=/etc/ssl/")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		msg := strings.TrimSpace(string(output))
+		// We may store the error message in meta store, due to the performance concern, we only store the first 1024 bytes.
+		if len(msg) > 1024 {
+			msg = msg[:1024]
+		}
+		return errors.Wrapf(err, "error message: %s", msg)
+	}
+	return nil
+}
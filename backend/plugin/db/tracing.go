@@ -0,0 +1,23 @@
+package db
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/bytebase/bytebase/backend/common/tracing"
+)
+
+// StartSpan starts the span of the driver operation such as "Execute" as a child of the span in the context.
+// The statement is recorded as its digest if it's not empty.
+func StartSpan(ctx context.Context, driver Driver, operation string, statement string) (context.Context, trace.Span) {
+	attributes := []attribute.KeyValue{
+		attribute.String("db.system", driver.GetType().String()),
+		attribute.String("db.operation", operation),
+	}
+	if statement != "" {
+		attributes = append(attributes, tracing.StatementDigest(statement))
+	}
+	return tracing.StartChild(ctx, "db."+operation, attributes...)
+}
@@ -273,44 +273,52 @@ func readRows(rows *sql.Rows, columnTypeNames []string, fieldMasker []masker.Mas
 		return data, nil
 	}
 	for rows.Next() {
-		// wantBytesValue want to convert StringValue to BytesValue when columnTypeName is BIT or VARBIT
-		wantBytesValue := make([]bool, len(columnTypeNames))
-		scanArgs := make([]any, len(columnTypeNames))
-		for i, v := range columnTypeNames {
-			// TODO(steven need help): Consult a common list of data types from database driver documentation. e.g. MySQL,PostgreSQL.
-			switch v {
-			case "VARCHAR", "TEXT", "UUID", "TIMESTAMP":
-				scanArgs[i] = new(sql.NullString)
-			case "BOOL":
-				scanArgs[i] = new(sql.NullBool)
-			case "INT", "INTEGER":
-				scanArgs[i] = new(sql.NullInt64)
-			case "FLOAT":
-				scanArgs[i] = new(sql.NullFloat64)
-			case "BIT", "VARBIT":
-				wantBytesValue[i] = true
-				scanArgs[i] = new(sql.NullString)
-			default:
-				scanArgs[i] = new(sql.NullString)
-			}
-		}
-
-		if err := rows.Scan(scanArgs...); err != nil {
+		rowData, err := ReadRow(rows, columnTypeNames, fieldMasker)
+		if err != nil {
 			return nil, err
 		}
+		data = append(data, rowData)
+	}
 
-		var rowData v1pb.QueryRow
-		for i := range columnTypeNames {
-			rowData.Values = append(rowData.Values, fieldMasker[i].Mask(&masker.MaskData{
-				Data:      scanArgs[i],
-				WantBytes: wantBytesValue[i],
-			}))
+	return data, nil
+}
+
+// ReadRow scans the current row of the rows and masks the values with the field maskers.
+func ReadRow(rows *sql.Rows, columnTypeNames []string, fieldMasker []masker.Masker) (*v1pb.QueryRow, error) {
+	// wantBytesValue want to convert StringValue to BytesValue when columnTypeName is BIT or VARBIT
+	wantBytesValue := make([]bool, len(columnTypeNames))
+	scanArgs := make([]any, len(columnTypeNames))
+	for i, v := range columnTypeNames {
+		// TODO(steven need help): Consult a common list of data types from database driver documentation. e.g. MySQL,PostgreSQL.
+		switch v {
+		case "VARCHAR", "TEXT", "UUID", "TIMESTAMP":
+			scanArgs[i] = new(sql.NullString)
+		case "BOOL":
+			scanArgs[i] = new(sql.NullBool)
+		case "INT", "INTEGER":
+			scanArgs[i] = new(sql.NullInt64)
+		case "FLOAT":
+			scanArgs[i] = new(sql.NullFloat64)
+		case "BIT", "VARBIT":
+			wantBytesValue[i] = true
+			scanArgs[i] = new(sql.NullString)
+		default:
+			scanArgs[i] = new(sql.NullString)
 		}
+	}
 
-		data = append(data, &rowData)
+	if err := rows.Scan(scanArgs...); err != nil {
+		return nil, err
 	}
 
-	return data, nil
+	var rowData v1pb.QueryRow
+	for i := range columnTypeNames {
+		rowData.Values = append(rowData.Values, fieldMasker[i].Mask(&masker.MaskData{
+			Data:      scanArgs[i],
+			WantBytes: wantBytesValue[i],
+		}))
+	}
+	return &rowData, nil
 }
 
 func getStatementWithResultLimit(stmt string, limit int) string {
@@ -0,0 +1,168 @@
+// Package eventbus is the publisher of the workspace events to Kafka or NATS.
+package eventbus
+
+import (
+	"context"
+	"crypto/tls"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl/plain"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// ContentType is the content type of the events encoded as the bytebase.v1.Event protobuf message.
+	ContentType = "application/x-protobuf"
+
+	contentTypeHeader = "content-type"
+	timeout           = 10 * time.Second
+)
+
+// Message is the message published to the event bus.
+type Message struct {
+	// Key is the Kafka message key ordering the events of the same key, e.g. the project.
+	Key string
+	// Type is the event type, which is appended to the NATS subject prefix.
+	Type  string
+	Value []byte
+}
+
+// Publisher is the publisher of the event bus.
+type Publisher interface {
+	// Publish publishes the messages, which are acknowledged by the event bus if no error is returned.
+	Publish(ctx context.Context, messages ...*Message) error
+	Close() error
+}
+
+// NewPublisher creates the publisher of the event bus setting.
+func NewPublisher(setting *storepb.EventBusSetting) (Publisher, error) {
+	switch setting.Type {
+	case storepb.EventBusSetting_KAFKA:
+		return newKafkaPublisher(setting), nil
+	case storepb.EventBusSetting_NATS:
+		return newNATSPublisher(setting)
+	default:
+		return nil, errors.Errorf("unsupported event bus type %v", setting.Type)
+	}
+}
+
+type kafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+func newKafkaPublisher(setting *storepb.EventBusSetting) *kafkaPublisher {
+	transport := &kafka.Transport{
+		ClientID:    "bytebase",
+		DialTimeout: timeout,
+	}
+	if setting.Tls {
+		transport.TLS = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	if setting.Username != "" {
+		transport.SASL = plain.Mechanism{
+			Username: setting.Username,
+			Password: setting.Password,
+		}
+	}
+	var brokers []string
+	for _, broker := range strings.Split(setting.Url, ",") {
+		if broker = strings.TrimSpace(broker); broker != "" {
+			brokers = append(brokers, broker)
+		}
+	}
+	return &kafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Topic:        setting.Topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			BatchTimeout: 10 * time.Millisecond,
+			WriteTimeout: timeout,
+			Transport:    transport,
+		},
+	}
+}
+
+func (p *kafkaPublisher) Publish(ctx context.Context, messages ...*Message) error {
+	var kafkaMessages []kafka.Message
+	for _, message := range messages {
+		kafkaMessages = append(kafkaMessages, kafka.Message{
+			Key:     []byte(message.Key),
+			Value:   message.Value,
+			Headers: []kafka.Header{{Key: contentTypeHeader, Value: []byte(ContentType)}},
+		})
+	}
+	if err := p.writer.WriteMessages(ctx, kafkaMessages...); err != nil {
+		return errors.Wrapf(err, "failed to write Kafka messages to topic %s", p.writer.Topic)
+	}
+	return nil
+}
+
+func (p *kafkaPublisher) Close() error {
+	return p.writer.Close()
+}
+
+type natsPublisher struct {
+	conn          *nats.Conn
+	subjectPrefix string
+}
+
+func newNATSPublisher(setting *storepb.EventBusSetting) (*natsPublisher, error) {
+	options := []nats.Option{
+		nats.Name("bytebase"),
+		nats.Timeout(timeout),
+	}
+	if setting.Username != "" {
+		options = append(options, nats.UserInfo(setting.Username, setting.Password))
+	}
+	conn, err := nats.Connect(setting.Url, options...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to NATS %s", setting.Url)
+	}
+	return &natsPublisher{
+		conn:          conn,
+		subjectPrefix: setting.Topic,
+	}, nil
+}
+
+func (p *natsPublisher) Publish(_ context.Context, messages ...*Message) error {
+	for _, message := range messages {
+		msg := nats.NewMsg(GetNATSSubject(p.subjectPrefix, message.Type))
+		msg.Header.Set(contentTypeHeader, ContentType)
+		msg.Data = message.Value
+		if err := p.conn.PublishMsg(msg); err != nil {
+			return errors.Wrapf(err, "failed to publish NATS message to %s", msg.Subject)
+		}
+	}
+	// The messages are buffered by the connection, they are published once the server answers the flush.
+	if err := p.conn.FlushTimeout(timeout); err != nil {
+		return errors.Wrapf(err, "failed to flush NATS messages")
+	}
+	return nil
+}
+
+func (p *natsPublisher) Close() error {
+	p.conn.Close()
+	return nil
+}
+
+// GetNATSSubject returns the NATS subject of the event type, e.g. "bytebase.events.bb.issue.create".
+func GetNATSSubject(prefix, eventType string) string {
+	// The NATS subject tokens are separated by the dots, and the wildcards and whitespaces are not allowed.
+	eventType = strings.Map(func(r rune) rune {
+		switch r {
+		case '*', '>', ' ', '\t', '\r', '\n':
+			return '_'
+		}
+		return r
+	}, eventType)
+	if prefix == "" {
+		return eventType
+	}
+	return prefix + "." + eventType
+}
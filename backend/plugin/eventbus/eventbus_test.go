@@ -0,0 +1,22 @@
+package eventbus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetNATSSubject(t *testing.T) {
+	tests := []struct {
+		prefix    string
+		eventType string
+		want      string
+	}{
+		{prefix: "bytebase.events", eventType: "bb.issue.create", want: "bytebase.events.bb.issue.create"},
+		{prefix: "", eventType: "bb.notify.database.schema-drift", want: "bb.notify.database.schema-drift"},
+		{prefix: "events", eventType: "bb.*.> x", want: "events.bb._.__x"},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, GetNATSSubject(test.prefix, test.eventType))
+	}
+}
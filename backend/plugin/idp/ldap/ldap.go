@@ -56,8 +56,30 @@ type IdentityProviderConfig struct {
 	// FieldMapping is the mapping of the user attributes returned by the LDAP
 	// server.
 	FieldMapping *storepb.FieldMapping `json:"fieldMapping"`
+	// GroupBaseDN is the base DN to search for groups. When not set, the BaseDN
+	// will be used.
+	GroupBaseDN string `json:"groupBaseDn"`
+	// GroupFilter is the filter to search for groups, e.g.
+	// "(objectClass=groupOfNames)". When not set, DefaultGroupFilter will be used.
+	GroupFilter string `json:"groupFilter"`
+	// GroupMemberAttribute is the attribute of the group that contains the DNs
+	// of the members. When not set, "member" will be used.
+	GroupMemberAttribute string `json:"groupMemberAttribute"`
 }
 
+const (
+	// DefaultGroupFilter is the default filter to search for the groups of
+	// Active Directory and OpenLDAP.
+	DefaultGroupFilter = "(|(objectClass=group)(objectClass=groupOfNames))"
+	// defaultGroupMemberAttribute is the default attribute of the group members.
+	defaultGroupMemberAttribute = "member"
+	// maxGroupDepth is the maximum depth of the nested groups to resolve.
+	maxGroupDepth = 10
+)
+
+// ErrUserNotFound is returned when the user is not found in the LDAP server.
+var ErrUserNotFound = errors.New("user not found")
+
 // NewIdentityProvider initializes a new LDAP Identity Provider with the given
 // configuration.
 func NewIdentityProvider(config IdentityProviderConfig) (*IdentityProvider, error) {
@@ -85,6 +107,16 @@ func NewIdentityProvider(config IdentityProviderConfig) (*IdentityProvider, erro
 		}
 	}
 
+	if config.GroupBaseDN == "" {
+		config.GroupBaseDN = config.BaseDN
+	}
+	if config.GroupFilter == "" {
+		config.GroupFilter = DefaultGroupFilter
+	}
+	if config.GroupMemberAttribute == "" {
+		config.GroupMemberAttribute = defaultGroupMemberAttribute
+	}
+
 	return &IdentityProvider{
 		config: config,
 	}, nil
@@ -114,6 +146,7 @@ func (p *IdentityProvider) dial() (*ldap.Conn, error) {
 			return nil, errors.Errorf("start TLS: %v", err)
 		}
 	}
+	conn.SetTimeout(requestTimeout)
 	return conn, nil
 }
 
@@ -135,12 +168,88 @@ func (p *IdentityProvider) Connect() (*ldap.Conn, error) {
 
 // Authenticate authenticates the user with the given username and password.
 func (p *IdentityProvider) Authenticate(username, password string) (*storepb.IdentityProviderUserInfo, error) {
-	conn, err := p.Connect()
+	conn, err := p.acquire()
 	if err != nil {
 		return nil, errors.Errorf("connect: %v", err)
 	}
-	defer func() { _ = conn.Close() }()
 
+	entry, err := p.searchUser(conn, strings.ReplaceAll(p.config.UserFilter, "%s", username))
+	if err != nil {
+		p.release(conn, !conn.IsClosing())
+		return nil, err
+	}
+
+	// Bind as the user to verify their password
+	bindErr := conn.Bind(entry.DN, password)
+	// Bind with the system account again before returning the connection to the pool.
+	p.release(conn, conn.Bind(p.config.BindDN, p.config.BindPassword) == nil)
+	if bindErr != nil {
+		return nil, errors.Errorf("bind user: %v", bindErr)
+	}
+
+	return p.getUserInfo(entry)
+}
+
+// GetUserGroups returns the normalized DNs of the groups of the user with the
+// given identifier, including the groups containing the groups of the user. It
+// returns ErrUserNotFound if the user no longer exists or no longer matches the
+// user filter.
+func (p *IdentityProvider) GetUserGroups(identifier string) ([]string, error) {
+	conn, err := p.acquire()
+	if err != nil {
+		return nil, errors.Errorf("connect: %v", err)
+	}
+	defer func() { p.release(conn, !conn.IsClosing()) }()
+
+	entry, err := p.searchUser(conn, p.getIdentifierFilter(identifier))
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []string
+	visited := map[string]bool{NormalizeDNOrLower(entry.DN): true}
+	members := []string{entry.DN}
+	for depth := 0; depth < maxGroupDepth && len(members) > 0; depth++ {
+		var next []string
+		for _, member := range members {
+			sr, err := conn.Search(
+				ldap.NewSearchRequest(
+					p.config.GroupBaseDN,
+					ldap.ScopeWholeSubtree,
+					ldap.NeverDerefAliases,
+					0,
+					0,
+					false,
+					fmt.Sprintf("(&%s(%s=%s))", p.config.GroupFilter, p.config.GroupMemberAttribute, ldap.EscapeFilter(member)),
+					[]string{"dn"},
+					nil,
+				),
+			)
+			if err != nil {
+				return nil, errors.Errorf("search groups of %q: %v", member, err)
+			}
+			for _, group := range sr.Entries {
+				dn := NormalizeDNOrLower(group.DN)
+				if visited[dn] {
+					continue
+				}
+				visited[dn] = true
+				groups = append(groups, dn)
+				next = append(next, group.DN)
+			}
+		}
+		members = next
+	}
+	return groups, nil
+}
+
+// getIdentifierFilter returns the filter to search for the user by the
+// identifier, within the users matching the user filter.
+func (p *IdentityProvider) getIdentifierFilter(identifier string) string {
+	return fmt.Sprintf("(&%s(%s=%s))", strings.ReplaceAll(p.config.UserFilter, "%s", "*"), p.config.FieldMapping.Identifier, ldap.EscapeFilter(identifier))
+}
+
+func (p *IdentityProvider) searchUser(conn *ldap.Conn, filter string) (*ldap.Entry, error) {
 	sr, err := conn.Search(
 		ldap.NewSearchRequest(
 			p.config.BaseDN,
@@ -149,24 +258,25 @@ func (p *IdentityProvider) Authenticate(username, password string) (*storepb.Ide
 			0,
 			0,
 			false,
-			strings.ReplaceAll(p.config.UserFilter, "%s", username),
+			filter,
 			[]string{"dn", p.config.FieldMapping.Identifier, p.config.FieldMapping.DisplayName, p.config.FieldMapping.Email},
 			nil,
 		),
 	)
 	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+			return nil, ErrUserNotFound
+		}
 		return nil, errors.Errorf("search user DN: %v", err)
+	} else if len(sr.Entries) == 0 {
+		return nil, ErrUserNotFound
 	} else if len(sr.Entries) != 1 {
 		return nil, errors.Errorf("expect 1 user DN but got %d", len(sr.Entries))
 	}
-	entry := sr.Entries[0]
-
-	// Bind as the user to verify their password
-	err = conn.Bind(entry.DN, password)
-	if err != nil {
-		return nil, errors.Errorf("bind user: %v", err)
-	}
+	return sr.Entries[0], nil
+}
 
+func (p *IdentityProvider) getUserInfo(entry *ldap.Entry) (*storepb.IdentityProviderUserInfo, error) {
 	identifier := entry.GetAttributeValue(p.config.FieldMapping.Identifier)
 	if identifier == "" {
 		return nil, errors.Errorf("the attribute %q is not found or has empty value", p.config.FieldMapping.Identifier)
@@ -177,3 +287,25 @@ func (p *IdentityProvider) Authenticate(username, password string) (*storepb.Ide
 		Email:       entry.GetAttributeValue(p.config.FieldMapping.Email),
 	}, nil
 }
+
+// NormalizeDN returns the normalized DN to compare the DNs, the attribute types
+// and values are case-insensitive.
+func NormalizeDN(dn string) (string, error) {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil {
+		return "", err
+	}
+	if len(parsed.RDNs) == 0 {
+		return "", errors.Errorf("empty DN")
+	}
+	return strings.ToLower(parsed.String()), nil
+}
+
+// NormalizeDNOrLower returns the normalized DN, or the lower-case DN if it
+// cannot be parsed.
+func NormalizeDNOrLower(dn string) string {
+	if normalized, err := NormalizeDN(dn); err == nil {
+		return normalized
+	}
+	return strings.ToLower(dn)
+}
@@ -3,7 +3,9 @@ package ldap
 import (
 	"crypto/tls"
 	"flag"
+	"fmt"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -143,7 +145,9 @@ func TestNewIdentityProvider(t *testing.T) {
 	}
 }
 
-func newMockServer(t *testing.T, uid, displayName, mail string) (host string, port int) {
+// newMockServer starts a mock LDAPS server with the user, and the groups keyed by
+// the DN of the member.
+func newMockServer(t *testing.T, port int, uid, displayName, mail string, groups map[string][]string) string {
 	// localhostCert is a PEM-encoded TLS cert with SAN IPs
 	// "127.0.0.1" and "[::1]", expiring at Jan 29 16:00:00 2084 GMT.
 	// generated from src/crypto/tls:
@@ -211,6 +215,19 @@ ZboOWVe3icTy64BT3OQhmg==
 		w.Write(ldapserver.NewBindResponse(ldapserver.LDAPResultSuccess))
 	})
 	routes.Search(func(w ldapserver.ResponseWriter, m *ldapserver.Message) {
+		r := m.GetSearchRequest()
+		if filter := r.FilterString(); strings.Contains(filter, "(member=") {
+			for member, dns := range groups {
+				if !strings.Contains(filter, "(member="+member+")") {
+					continue
+				}
+				for _, dn := range dns {
+					w.Write(ldapserver.NewSearchResultEntry(dn))
+				}
+			}
+			w.Write(ldapserver.NewSearchResultDoneResponse(ldapserver.LDAPResultSuccess))
+			return
+		}
 		e := ldapserver.NewSearchResultEntry(uid)
 		e.AddAttribute("uid", message.AttributeValue(uid))
 		e.AddAttribute("displayName", message.AttributeValue(displayName))
@@ -222,7 +239,7 @@ ZboOWVe3icTy64BT3OQhmg==
 
 	go func() {
 		err := server.ListenAndServe(
-			"127.0.0.1:10389",
+			fmt.Sprintf("127.0.0.1:%d", port),
 			func(s *ldapserver.Server) {
 				s.Listener = tls.NewListener(s.Listener, tlsConfig)
 			},
@@ -233,7 +250,7 @@ ZboOWVe3icTy64BT3OQhmg==
 
 	// Give a second for the server to start
 	time.Sleep(time.Second)
-	return "127.0.0.1", 10389
+	return "127.0.0.1"
 }
 
 func TestIdentityProvider(t *testing.T) {
@@ -242,7 +259,8 @@ func TestIdentityProvider(t *testing.T) {
 		testDisplayName = "Alice Smith"
 		testMail        = "alice@example.com"
 	)
-	host, port := newMockServer(t, testUID, testDisplayName, testMail)
+	port := 10389
+	host := newMockServer(t, port, testUID, testDisplayName, testMail, nil)
 	ldap, err := NewIdentityProvider(
 		IdentityProviderConfig{
 			Host:             host,
@@ -272,3 +290,50 @@ func TestIdentityProvider(t *testing.T) {
 	}
 	assert.Equal(t, wantUserInfo, userInfo)
 }
+
+func TestGetUserGroups(t *testing.T) {
+	const (
+		dba         = "cn=DBA,ou=Groups,dc=example,dc=com"
+		engineering = "cn=Engineering,ou=Groups,dc=example,dc=com"
+	)
+	port := 10390
+	host := newMockServer(t, port, "alice", "Alice Smith", "alice@example.com", map[string][]string{
+		"alice": {dba},
+		dba:     {engineering},
+		// The cycle of the nested groups should be resolved.
+		engineering: {dba},
+	})
+	ldap, err := NewIdentityProvider(
+		IdentityProviderConfig{
+			Host:             host,
+			Port:             port,
+			SkipTLSVerify:    true,
+			BindDN:           "uid=system,ou=Users,dc=example,dc=com",
+			BindPassword:     "pa$$word",
+			BaseDN:           "ou=Users,dc=example,dc=com",
+			UserFilter:       "(uid=%s)",
+			SecurityProtocol: SecurityProtocolLDAPS,
+			FieldMapping: &storepb.FieldMapping{
+				Identifier: "uid",
+			},
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "(&(uid=*)(uid=a\\2a\\28\\29))", ldap.getIdentifierFilter("a*()"))
+
+	groups, err := ldap.GetUserGroups("alice")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cn=dba,ou=groups,dc=example,dc=com", "cn=engineering,ou=groups,dc=example,dc=com"}, groups)
+}
+
+func TestNormalizeDN(t *testing.T) {
+	dn, err := NormalizeDN("CN=DBA, OU=Groups,DC=example,DC=com")
+	require.NoError(t, err)
+	assert.Equal(t, "cn=dba,ou=groups,dc=example,dc=com", dn)
+
+	_, err = NormalizeDN("")
+	assert.Error(t, err)
+	_, err = NormalizeDN("dba")
+	assert.Error(t, err)
+	assert.Equal(t, "dba", NormalizeDNOrLower("DBA"))
+}
@@ -0,0 +1,84 @@
+package ldap
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+const (
+	// maxIdleConns is the maximum number of the idle connections kept for each LDAP server.
+	maxIdleConns = 8
+	// maxIdleTime is the maximum time a connection may be idle before being closed,
+	// as the LDAP servers and the firewalls close the idle connections silently.
+	maxIdleTime = 5 * time.Minute
+	// requestTimeout is the timeout of the LDAP requests.
+	requestTimeout = 30 * time.Second
+)
+
+// pools are the connection pools of the LDAP servers, keyed by the connection settings,
+// so that the identity providers created for each sign-in share the connections.
+var pools sync.Map // map[string]*connPool
+
+type idleConn struct {
+	conn     *ldap.Conn
+	idleFrom time.Time
+}
+
+// connPool is the pool of the connections bound as the service account.
+type connPool struct {
+	mu   sync.Mutex
+	idle []*idleConn
+}
+
+func (p *IdentityProvider) getPool() *connPool {
+	key := fmt.Sprintf("%s:%d|%s|%t|%s|%s", p.config.Host, p.config.Port, p.config.SecurityProtocol, p.config.SkipTLSVerify, p.config.BindDN, p.config.BindPassword)
+	pool, _ := pools.LoadOrStore(key, &connPool{})
+	return pool.(*connPool)
+}
+
+// get returns an idle connection, or nil if there is no usable idle connection.
+func (cp *connPool) get() *ldap.Conn {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	for len(cp.idle) > 0 {
+		c := cp.idle[len(cp.idle)-1]
+		cp.idle = cp.idle[:len(cp.idle)-1]
+		if c.conn.IsClosing() || time.Since(c.idleFrom) > maxIdleTime {
+			_ = c.conn.Close()
+			continue
+		}
+		return c.conn
+	}
+	return nil
+}
+
+// put returns the connection bound as the service account to the pool.
+func (cp *connPool) put(conn *ldap.Conn) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	if conn.IsClosing() || len(cp.idle) >= maxIdleConns {
+		_ = conn.Close()
+		return
+	}
+	cp.idle = append(cp.idle, &idleConn{conn: conn, idleFrom: time.Now()})
+}
+
+// acquire returns a connection bound as the service account from the pool, or establishes a new one.
+func (p *IdentityProvider) acquire() (*ldap.Conn, error) {
+	if conn := p.getPool().get(); conn != nil {
+		return conn, nil
+	}
+	return p.Connect()
+}
+
+// release returns the connection to the pool, or closes it if it's no longer bound as the service account.
+func (p *IdentityProvider) release(conn *ldap.Conn, reusable bool) {
+	if !reusable {
+		_ = conn.Close()
+		return
+	}
+	p.getPool().put(conn)
+}
@@ -118,6 +118,8 @@ type ContentType string
 const (
 	// ContentTypeImagePNG is the content type of the file with png extension.
 	ContentTypeImagePNG ContentType = "image/png"
+	// ContentTypeTextCSV is the content type of the file with csv extension.
+	ContentTypeTextCSV ContentType = "text/csv"
 )
 
 // Attach attaches the file to the email, and returns the filename of the attachment.
@@ -0,0 +1,33 @@
+package base
+
+// IndexColumnUsageType is the way a column is used by a query, which decides its position in an index.
+type IndexColumnUsageType int
+
+const (
+	// IndexColumnUsageEquality is the column compared with a constant by "=" or "IN".
+	IndexColumnUsageEquality IndexColumnUsageType = iota
+	// IndexColumnUsageJoin is the column compared with a column of another table by "=".
+	IndexColumnUsageJoin
+	// IndexColumnUsageRange is the column compared with a constant by "<", ">", "BETWEEN" or "LIKE".
+	IndexColumnUsageRange
+	// IndexColumnUsageOrderBy is the column in the ORDER BY clause.
+	IndexColumnUsageOrderBy
+)
+
+// IndexColumnUsage is a column used by a query that an index could speed up.
+type IndexColumnUsage struct {
+	// Table is the table qualifying the column with the aliases resolved.
+	// The Table field is empty if the column is not qualified.
+	Table  SchemaResource
+	Column string
+	Type   IndexColumnUsageType
+}
+
+// IndexColumnUsages is the tables and the columns used by a query.
+type IndexColumnUsages struct {
+	// Tables is the tables read by the query, in the order of appearance.
+	Tables []SchemaResource
+	// Columns is the columns used by the query, in the order of appearance.
+	// The predicates under OR are not included, as a single index cannot serve them.
+	Columns []IndexColumnUsage
+}
@@ -23,6 +23,8 @@ var (
 	spans                   = make(map[storepb.Engine]GetQuerySpanFunc)
 	affectedRows            = make(map[storepb.Engine]GetAffectedRowsFunc)
 	transformDMLToSelect    = make(map[storepb.Engine]TransformDMLToSelectFunc)
+	rowFilterInjectors      = make(map[storepb.Engine]InjectRowFiltersFunc)
+	indexColumnExtractors   = make(map[storepb.Engine]ExtractIndexColumnsFunc)
 )
 
 type ValidateSQLForEditorFunc func(string) (bool, error)
@@ -42,6 +44,15 @@ type GetAffectedRowsFunc func(ctx context.Context, stmt any, getAffectedRowsByQu
 // TransformDMLToSelectFunc is the interface of transforming DML statements to SELECT statements.
 type TransformDMLToSelectFunc func(statement string, sourceDatabase string, targetDatabase string, tableSuffix string) ([]RollbackStatement, error)
 
+// GetRowFilterFunc returns the predicate of the visible rows of the table, or empty if all rows are visible.
+type GetRowFilterFunc func(database, schema, table string) string
+
+// InjectRowFiltersFunc is the interface of rewriting the query to read the tables with the row filters.
+type InjectRowFiltersFunc func(statement, database string, getRowFilter GetRowFilterFunc) (string, error)
+
+// ExtractIndexColumnsFunc is the interface of extracting the columns used by the query predicates, joins and sorting.
+type ExtractIndexColumnsFunc func(statement, database string) (*IndexColumnUsages, error)
+
 func RegisterQueryValidator(engine storepb.Engine, f ValidateSQLForEditorFunc) {
 	mux.Lock()
 	defer mux.Unlock()
@@ -246,3 +257,41 @@ func TransformDMLToSelect(engine storepb.Engine, statement string, sourceDatabas
 	}
 	return f(statement, sourceDatabase, targetDatabase, tableSuffix)
 }
+
+// RegisterInjectRowFilters registers the injectRowFilters function for the engine.
+func RegisterInjectRowFilters(engine storepb.Engine, f InjectRowFiltersFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := rowFilterInjectors[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	rowFilterInjectors[engine] = f
+}
+
+// InjectRowFilters rewrites the query so that every table read is replaced by its rows matching the row filter.
+func InjectRowFilters(engine storepb.Engine, statement, database string, getRowFilter GetRowFilterFunc) (string, error) {
+	f, ok := rowFilterInjectors[engine]
+	if !ok {
+		return "", errors.Errorf("engine %s is not supported", engine)
+	}
+	return f(statement, database, getRowFilter)
+}
+
+// RegisterExtractIndexColumns registers the extractIndexColumns function for the engine.
+func RegisterExtractIndexColumns(engine storepb.Engine, f ExtractIndexColumnsFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := indexColumnExtractors[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	indexColumnExtractors[engine] = f
+}
+
+// ExtractIndexColumns extracts the tables read by the query and the columns an index could serve.
+func ExtractIndexColumns(engine storepb.Engine, statement, database string) (*IndexColumnUsages, error) {
+	f, ok := indexColumnExtractors[engine]
+	if !ok {
+		return nil, errors.Errorf("engine %s is not supported", engine)
+	}
+	return f(statement, database)
+}
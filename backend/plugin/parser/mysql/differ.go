@@ -47,6 +47,8 @@ type diffNode struct {
 	// Ignore the case sensitive when comparing the table and view names.
 	ignoreCaseSensitive bool
 
+	revokeList []*grantDef
+
 	dropFunctionList  []*functionDef
 	dropProcedureList []*procedureDef
 	dropEventList     []*eventDef
@@ -78,6 +80,8 @@ type diffNode struct {
 	createTriggerList   []*triggerDef
 	createFunctionList  []*functionDef
 	createProcedureList []*procedureDef
+
+	grantList []*grantDef
 }
 
 func (diff *diffNode) diffStatement(oldStatement string, newStatement string) error {
@@ -115,6 +119,8 @@ func (diff *diffNode) diffStatement(oldStatement string, newStatement string) er
 		return errors.Wrapf(err, "failed to diff trigger")
 	}
 
+	diff.diffGrant(oldDatabaseDef.schemas[""], newDatabaseDef.schemas[""])
+
 	return nil
 }
 
@@ -429,10 +435,11 @@ func (diff *diffNode) diffFunction(oldDatabase, newDatabase *databaseDef) error
 
 		oldFunction, ok := oldDatabase.schemas[""].functions[functionName]
 		if ok {
-			if !isFunctionEqual(oldFunction, function) {
-				diff.dropFunctionList = append(diff.dropFunctionList, oldFunction)
-			}
 			delete(oldDatabase.schemas[""].functions, functionName)
+			if isFunctionEqual(oldFunction, function) {
+				continue
+			}
+			diff.dropFunctionList = append(diff.dropFunctionList, oldFunction)
 		}
 		diff.createFunctionList = append(diff.createFunctionList, function)
 	}
@@ -453,10 +460,11 @@ func (diff *diffNode) diffProcedure(oldDatabase, newDatabase *databaseDef) error
 
 		oldProcedure, ok := oldDatabase.schemas[""].procedures[procedureName]
 		if ok {
-			if !isProcedureEqual(oldProcedure, procedure) {
-				diff.dropProcedureList = append(diff.dropProcedureList, oldProcedure)
+			delete(oldDatabase.schemas[""].procedures, procedureName)
+			if isProcedureEqual(oldProcedure, procedure) {
+				continue
 			}
-			delete(oldDatabase.schemas[""].functions, procedureName)
+			diff.dropProcedureList = append(diff.dropProcedureList, oldProcedure)
 		}
 		diff.createProcedureList = append(diff.createProcedureList, procedure)
 	}
@@ -477,10 +485,11 @@ func (diff *diffNode) diffEvent(oldSchema, newSchema *schemaDef) error {
 
 		oldEvent, ok := oldSchema.events[eventName]
 		if ok {
-			if !isEventEqual(oldEvent, event) {
-				diff.dropEventList = append(diff.dropEventList, oldEvent)
-			}
 			delete(oldSchema.events, eventName)
+			if isEventEqual(oldEvent, event) {
+				continue
+			}
+			diff.dropEventList = append(diff.dropEventList, oldEvent)
 		}
 		diff.createEventList = append(diff.createEventList, event)
 	}
@@ -500,10 +509,11 @@ func (diff *diffNode) diffTrigger(oldSchema, newSchema *schemaDef) error {
 
 		oldTrigger, ok := oldSchema.triggers[triggerName]
 		if ok {
-			if !isTriggerEqual(oldTrigger, trigger) {
-				diff.dropTriggerList = append(diff.dropTriggerList, oldTrigger)
-			}
 			delete(oldSchema.triggers, triggerName)
+			if isTriggerEqual(oldTrigger, trigger) {
+				continue
+			}
+			diff.dropTriggerList = append(diff.dropTriggerList, oldTrigger)
 		}
 		diff.createTriggerList = append(diff.createTriggerList, trigger)
 	}
@@ -517,6 +527,27 @@ func isTriggerEqual(old, new *triggerDef) bool {
 	return old.ctx.GetText() == new.ctx.GetText()
 }
 
+// diffGrant revokes the privileges granted only in the old schema and grants the privileges only in the new schema.
+func (diff *diffNode) diffGrant(oldSchema, newSchema *schemaDef) {
+	oldGrantMap := make(map[string]*grantDef)
+	for _, grant := range oldSchema.grants {
+		oldGrantMap[grant.ctx.GetText()] = grant
+	}
+	for _, grant := range newSchema.grants {
+		text := grant.ctx.GetText()
+		if _, ok := oldGrantMap[text]; ok {
+			delete(oldGrantMap, text)
+			continue
+		}
+		diff.grantList = append(diff.grantList, grant)
+	}
+	for _, grant := range oldSchema.grants {
+		if _, ok := oldGrantMap[grant.ctx.GetText()]; ok {
+			diff.revokeList = append(diff.revokeList, grant)
+		}
+	}
+}
+
 func (diff *diffNode) diffColumn(oldTable, newTable *tableDef) {
 	// We use a single ALTER TABLE statement to add and modify columns,
 	// because we need to maintain a fixed order of these two operations.
@@ -695,6 +726,9 @@ func isKeyPartEqual(old, new []string) bool {
 
 func (diff *diffNode) deparse() (string, error) {
 	var buf strings.Builder
+	if err := writeRevokeList(&buf, diff.revokeList); err != nil {
+		return "", err
+	}
 	if err := sortAndWriteDropFunctionList(&buf, diff.dropFunctionList); err != nil {
 		return "", err
 	}
@@ -785,6 +819,9 @@ func (diff *diffNode) deparse() (string, error) {
 	if err := sortAndWriteCreateTriggerList(&buf, diff.createTriggerList); err != nil {
 		return "", err
 	}
+	if err := writeGrantList(&buf, diff.grantList); err != nil {
+		return "", err
+	}
 
 	text := buf.String()
 	if len(text) > 0 {
@@ -1089,6 +1126,68 @@ func writeCreateTriggerStatement(buf *strings.Builder, trigger *triggerDef) erro
 	return nil
 }
 
+func writeGrantList(buf *strings.Builder, grants []*grantDef) error {
+	for _, grant := range grants {
+		text := grant.ctx.GetParser().GetTokenStream().GetTextFromRuleContext(grant.ctx.GetRuleContext())
+		if _, err := buf.WriteString(fmt.Sprintf("%s;\n\n", text)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeRevokeList(buf *strings.Builder, grants []*grantDef) error {
+	for _, grant := range grants {
+		if err := writeRevokeStatement(buf, grant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeRevokeStatement writes the REVOKE statement reverting the GRANT statement.
+func writeRevokeStatement(buf *strings.Builder, grant *grantDef) error {
+	ctx := grant.ctx
+	tokens := ctx.GetParser().GetTokenStream()
+	var revoke string
+	switch {
+	case ctx.PROXY_SYMBOL() != nil:
+		// GRANT PROXY ON user TO user_list.
+		revoke = fmt.Sprintf("REVOKE PROXY ON %s FROM %s", tokens.GetTextFromRuleContext(ctx.User()), getGrantTargetUsers(ctx.GrantTargetList()))
+	case ctx.ON_SYMBOL() != nil:
+		// GRANT privileges ON [object_type] priv_level TO user_list.
+		privileges := "ALL PRIVILEGES"
+		if ctx.RoleOrPrivilegesList() != nil {
+			privileges = tokens.GetTextFromRuleContext(ctx.RoleOrPrivilegesList())
+		}
+		object := tokens.GetTextFromRuleContext(ctx.GrantIdentifier())
+		if ctx.AclType() != nil {
+			object = fmt.Sprintf("%s %s", tokens.GetTextFromRuleContext(ctx.AclType()), object)
+		}
+		revoke = fmt.Sprintf("REVOKE %s ON %s FROM %s", privileges, object, getGrantTargetUsers(ctx.GrantTargetList()))
+	default:
+		// GRANT roles TO user_list.
+		revoke = fmt.Sprintf("REVOKE %s FROM %s", tokens.GetTextFromRuleContext(ctx.RoleOrPrivilegesList()), tokens.GetTextFromRuleContext(ctx.UserList()))
+	}
+	if _, err := buf.WriteString(fmt.Sprintf("%s;\n\n", revoke)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// getGrantTargetUsers returns the users of the grant targets without the authentication options.
+func getGrantTargetUsers(ctx mysql.IGrantTargetListContext) string {
+	tokens := ctx.GetParser().GetTokenStream()
+	if ctx.UserList() != nil {
+		return tokens.GetTextFromRuleContext(ctx.UserList())
+	}
+	var users []string
+	for _, entry := range ctx.CreateUserList().AllCreateUserEntry() {
+		users = append(users, tokens.GetTextFromRuleContext(entry.User()))
+	}
+	return strings.Join(users, ", ")
+}
+
 func sortAndWriteDropIndexList(buf *strings.Builder, indexes []*indexDef) error {
 	sort.Slice(indexes, func(i, j int) bool {
 		c1 := fmt.Sprintf("%s.%s", indexes[i].tableName, indexes[i].name)
@@ -1513,6 +1612,8 @@ type schemaDef struct {
 	triggers   map[string]*triggerDef
 	functions  map[string]*functionDef
 	procedures map[string]*procedureDef
+	// grants is in the order of the statements.
+	grants []*grantDef
 }
 
 func newSchemaDef() *schemaDef {
@@ -1536,6 +1637,10 @@ type triggerDef struct {
 	name string
 }
 
+type grantDef struct {
+	ctx *mysql.GrantContext
+}
+
 type tableDef struct {
 	ctx              *mysql.CreateTableContext
 	id               int
@@ -2158,3 +2263,10 @@ func (t *mysqlTransformer) EnterCreateProcedure(ctx *mysql.CreateProcedureContex
 		name: procedureName,
 	}
 }
+
+// EnterGrant is called when production grant is entered.
+func (t *mysqlTransformer) EnterGrant(ctx *mysql.GrantContext) {
+	t.db.schemas[""].grants = append(t.db.schemas[""].grants, &grantDef{
+		ctx: ctx,
+	})
+}
@@ -94,6 +94,11 @@ func TestSchemaDiffTrigger(t *testing.T) {
 	runDifferTest(t, testFile, false /* record */)
 }
 
+func TestSchemaDiffGrant(t *testing.T) {
+	testFile := "test_differ_grant.yaml"
+	runDifferTest(t, testFile, false /* record */)
+}
+
 func TestSchemaDiffConstraint(t *testing.T) {
 	testFile := "test_differ_constraint.yaml"
 	runDifferTest(t, testFile, false /* record */)
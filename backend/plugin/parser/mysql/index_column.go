@@ -0,0 +1,208 @@
+package mysql
+
+import (
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+
+	parser "github.com/bytebase/mysql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterExtractIndexColumns(storepb.Engine_MYSQL, ExtractIndexColumns)
+	base.RegisterExtractIndexColumns(storepb.Engine_MARIADB, ExtractIndexColumns)
+}
+
+// ExtractIndexColumns extracts the tables read by the statement, and the columns used by the predicates
+// in the WHERE and JOIN ON clauses and by the ORDER BY clause.
+// The statement can be a slow query fingerprint, e.g. "select * from `t` where `id` in(?+)".
+// The table aliases are resolved in the whole statement regardless of the query block scopes.
+func ExtractIndexColumns(statement, database string) (*base.IndexColumnUsages, error) {
+	// The fingerprints collapse the value lists to "(?+)".
+	statement = strings.ReplaceAll(statement, "(?+)", "(?)")
+	list, err := ParseMySQL(statement)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &base.IndexColumnUsages{}
+	for _, stmt := range list {
+		listener := &indexColumnExtractor{
+			database: database,
+			aliases:  make(map[string]base.SchemaResource),
+		}
+		antlr.ParseTreeWalkerDefault.Walk(listener, stmt.Tree)
+		result.Tables = append(result.Tables, listener.tables...)
+		for _, column := range listener.columns {
+			if column.qualifier != "" {
+				table, ok := listener.aliases[strings.ToLower(column.qualifier)]
+				if !ok {
+					table = base.SchemaResource{Database: column.database, Table: column.qualifier}
+					if table.Database == "" {
+						table.Database = database
+					}
+				}
+				column.usage.Table = table
+			}
+			result.Columns = append(result.Columns, column.usage)
+		}
+	}
+	return result, nil
+}
+
+type indexColumn struct {
+	database  string
+	qualifier string
+	usage     base.IndexColumnUsage
+}
+
+type indexColumnExtractor struct {
+	*parser.BaseMySQLParserListener
+
+	database string
+	tables   []base.SchemaResource
+	// aliases is the map from the lower case alias or table name to the table.
+	aliases map[string]base.SchemaResource
+	// columns is resolved after the walk, as the tables may be declared after the columns, e.g. in the select list.
+	columns []*indexColumn
+}
+
+func (l *indexColumnExtractor) EnterSingleTable(ctx *parser.SingleTableContext) {
+	databaseName, tableName := NormalizeMySQLTableRef(ctx.TableRef())
+	if databaseName == "" {
+		databaseName = l.database
+	}
+	table := base.SchemaResource{Database: databaseName, Table: tableName}
+	l.tables = append(l.tables, table)
+	alias := tableName
+	if ctx.TableAlias() != nil {
+		alias = NormalizeMySQLIdentifier(ctx.TableAlias().Identifier())
+	}
+	l.aliases[strings.ToLower(alias)] = table
+}
+
+func (l *indexColumnExtractor) EnterJoinedTable(ctx *parser.JoinedTableContext) {
+	if ctx.USING_SYMBOL() == nil || ctx.IdentifierListWithParentheses() == nil {
+		return
+	}
+	for _, column := range NormalizeIdentifierListWithParentheses(ctx.IdentifierListWithParentheses()) {
+		l.columns = append(l.columns, &indexColumn{
+			usage: base.IndexColumnUsage{Column: column, Type: base.IndexColumnUsageJoin},
+		})
+	}
+}
+
+func (l *indexColumnExtractor) EnterPrimaryExprCompare(ctx *parser.PrimaryExprCompareContext) {
+	if !isIndexablePredicate(ctx) {
+		return
+	}
+	var usageType base.IndexColumnUsageType
+	switch op := ctx.CompOp(); {
+	case op.EQUAL_OPERATOR() != nil, op.NULL_SAFE_EQUAL_OPERATOR() != nil:
+		usageType = base.IndexColumnUsageEquality
+	case op.NOT_EQUAL_OPERATOR() != nil:
+		return
+	default:
+		usageType = base.IndexColumnUsageRange
+	}
+
+	left, right := bareColumnRef(ctx.BoolPri()), bareColumnRef(ctx.Predicate())
+	switch {
+	case left != nil && right != nil:
+		if usageType == base.IndexColumnUsageEquality {
+			l.addColumn(left, base.IndexColumnUsageJoin)
+			l.addColumn(right, base.IndexColumnUsageJoin)
+		}
+	case left != nil && !hasColumnRef(ctx.Predicate()):
+		l.addColumn(left, usageType)
+	case right != nil && !hasColumnRef(ctx.BoolPri()):
+		l.addColumn(right, usageType)
+	}
+}
+
+func (l *indexColumnExtractor) EnterPredicate(ctx *parser.PredicateContext) {
+	if ctx.NotRule() != nil || ctx.PredicateOperations() == nil || !isIndexablePredicate(ctx) {
+		return
+	}
+	column := bareColumnRef(ctx.BitExpr(0))
+	if column == nil {
+		return
+	}
+	switch op := ctx.PredicateOperations().(type) {
+	case *parser.PredicateExprInContext:
+		if op.ExprList() != nil && !hasColumnRef(op.ExprList()) {
+			l.addColumn(column, base.IndexColumnUsageEquality)
+		}
+	case *parser.PredicateExprBetweenContext, *parser.PredicateExprLikeContext:
+		if !hasColumnRef(op) {
+			l.addColumn(column, base.IndexColumnUsageRange)
+		}
+	}
+}
+
+func (l *indexColumnExtractor) EnterOrderExpression(ctx *parser.OrderExpressionContext) {
+	// Only the ORDER BY clauses of the queries, but not of the window functions or GROUP_CONCAT.
+	orderClause, ok := ctx.GetParent().GetParent().(*parser.OrderClauseContext)
+	if !ok {
+		return
+	}
+	switch orderClause.GetParent().(type) {
+	case *parser.QueryExpressionContext, *parser.UpdateStatementContext, *parser.DeleteStatementContext:
+	default:
+		return
+	}
+	if column := bareColumnRef(ctx.Expr()); column != nil {
+		l.addColumn(column, base.IndexColumnUsageOrderBy)
+	}
+}
+
+func (l *indexColumnExtractor) addColumn(ctx *parser.ColumnRefContext, usageType base.IndexColumnUsageType) {
+	databaseName, tableName, columnName := NormalizeMySQLFieldIdentifier(ctx.FieldIdentifier())
+	l.columns = append(l.columns, &indexColumn{
+		database:  databaseName,
+		qualifier: tableName,
+		usage:     base.IndexColumnUsage{Column: columnName, Type: usageType},
+	})
+}
+
+// isIndexablePredicate returns true if the predicate is in the WHERE or JOIN ON clause and is not under OR, XOR or NOT.
+func isIndexablePredicate(ctx antlr.Tree) bool {
+	for parent := ctx.GetParent(); parent != nil; parent = parent.GetParent() {
+		switch parent.(type) {
+		case *parser.WhereClauseContext, *parser.JoinedTableContext:
+			return true
+		case *parser.ExprOrContext, *parser.ExprXorContext, *parser.ExprNotContext:
+			return false
+		}
+	}
+	return false
+}
+
+// bareColumnRef returns the column reference if the expression is a column without any operators or functions.
+func bareColumnRef(tree antlr.Tree) *parser.ColumnRefContext {
+	for tree != nil {
+		if columnRef, ok := tree.(*parser.ColumnRefContext); ok {
+			return columnRef
+		}
+		if tree.GetChildCount() != 1 {
+			return nil
+		}
+		tree = tree.GetChild(0)
+	}
+	return nil
+}
+
+func hasColumnRef(tree antlr.Tree) bool {
+	if _, ok := tree.(*parser.ColumnRefContext); ok {
+		return true
+	}
+	for _, child := range tree.GetChildren() {
+		if hasColumnRef(child) {
+			return true
+		}
+	}
+	return false
+}
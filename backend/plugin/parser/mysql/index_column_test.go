@@ -0,0 +1,69 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestExtractIndexColumns(t *testing.T) {
+	orders := base.SchemaResource{Database: "db", Table: "orders"}
+	users := base.SchemaResource{Database: "db", Table: "users"}
+	tests := []struct {
+		stmt string
+		want *base.IndexColumnUsages
+	}{
+		{
+			stmt: "select * from `orders` where `status` = ? and `created_at` > ? order by `id` desc",
+			want: &base.IndexColumnUsages{
+				Tables: []base.SchemaResource{orders},
+				Columns: []base.IndexColumnUsage{
+					{Column: "status", Type: base.IndexColumnUsageEquality},
+					{Column: "created_at", Type: base.IndexColumnUsageRange},
+					{Column: "id", Type: base.IndexColumnUsageOrderBy},
+				},
+			},
+		},
+		{
+			stmt: "select o.id from orders o join db.users u on o.user_id = u.id where u.email in(?+) and o.amount between ? and ?",
+			want: &base.IndexColumnUsages{
+				Tables: []base.SchemaResource{orders, users},
+				Columns: []base.IndexColumnUsage{
+					{Table: orders, Column: "user_id", Type: base.IndexColumnUsageJoin},
+					{Table: users, Column: "id", Type: base.IndexColumnUsageJoin},
+					{Table: users, Column: "email", Type: base.IndexColumnUsageEquality},
+					{Table: orders, Column: "amount", Type: base.IndexColumnUsageRange},
+				},
+			},
+		},
+		{
+			// The predicates under OR and the non-sargable predicates are skipped.
+			stmt: "select * from orders where (status = ? or region = ?) and lower(code) = ? and price != ? and user_id = ?",
+			want: &base.IndexColumnUsages{
+				Tables: []base.SchemaResource{orders},
+				Columns: []base.IndexColumnUsage{
+					{Column: "user_id", Type: base.IndexColumnUsageEquality},
+				},
+			},
+		},
+		{
+			stmt: "update orders join users using (user_id) set orders.status = ? where users.name like ?",
+			want: &base.IndexColumnUsages{
+				Tables: []base.SchemaResource{orders, users},
+				Columns: []base.IndexColumnUsage{
+					{Column: "user_id", Type: base.IndexColumnUsageJoin},
+					{Table: users, Column: "name", Type: base.IndexColumnUsageRange},
+				},
+			},
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := ExtractIndexColumns(test.stmt, "db")
+		a.NoError(err)
+		a.Equal(test.want, got, test.stmt)
+	}
+}
@@ -0,0 +1,87 @@
+package mysql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/mysql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterInjectRowFilters(storepb.Engine_MYSQL, InjectRowFilters)
+	base.RegisterInjectRowFilters(storepb.Engine_MARIADB, InjectRowFilters)
+}
+
+// InjectRowFilters replaces the tables having row filters with the derived tables selecting the visible rows,
+// e.g. "SELECT * FROM t" becomes "SELECT * FROM (SELECT * FROM t WHERE (region = 'EU')) AS `t`".
+func InjectRowFilters(statement, database string, getRowFilter base.GetRowFilterFunc) (string, error) {
+	list, err := ParseMySQL(statement)
+	if err != nil {
+		return "", err
+	}
+
+	var result []string
+	for _, stmt := range list {
+		listener := &rowFilterInjector{
+			rewriter:     antlr.NewTokenStreamRewriter(stmt.Tokens),
+			tokens:       stmt.Tokens,
+			database:     database,
+			getRowFilter: getRowFilter,
+		}
+		antlr.ParseTreeWalkerDefault.Walk(listener, stmt.Tree)
+		if listener.err != nil {
+			return "", listener.err
+		}
+		result = append(result, listener.rewriter.GetTextDefault())
+	}
+	return strings.Join(result, "\n"), nil
+}
+
+type rowFilterInjector struct {
+	*parser.BaseMySQLParserListener
+
+	rewriter     *antlr.TokenStreamRewriter
+	tokens       *antlr.CommonTokenStream
+	database     string
+	getRowFilter base.GetRowFilterFunc
+	err          error
+}
+
+func (l *rowFilterInjector) EnterSingleTable(ctx *parser.SingleTableContext) {
+	if l.err != nil {
+		return
+	}
+	databaseName, tableName := NormalizeMySQLTableRef(ctx.TableRef())
+	if databaseName == "" {
+		databaseName = l.database
+	}
+	filter := l.getRowFilter(databaseName, "", tableName)
+	if filter == "" {
+		return
+	}
+	if databaseName == "" {
+		l.err = errors.Errorf("cannot determine the database of table %q with row filter", tableName)
+		return
+	}
+
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "(SELECT * FROM %s", l.tokens.GetTextFromRuleContext(ctx.TableRef()))
+	if ctx.UsePartition() != nil {
+		_, _ = fmt.Fprintf(&buf, " %s", l.tokens.GetTextFromRuleContext(ctx.UsePartition()))
+	}
+	if ctx.IndexHintList() != nil {
+		_, _ = fmt.Fprintf(&buf, " %s", l.tokens.GetTextFromRuleContext(ctx.IndexHintList()))
+	}
+	alias := fmt.Sprintf("`%s`", strings.ReplaceAll(tableName, "`", "``"))
+	if ctx.TableAlias() != nil {
+		alias = l.tokens.GetTextFromRuleContext(ctx.TableAlias().Identifier())
+	}
+	_, _ = fmt.Fprintf(&buf, " WHERE (%s)) AS %s", filter, alias)
+	l.rewriter.ReplaceDefault(ctx.GetStart().GetTokenIndex(), ctx.GetStop().GetTokenIndex(), buf.String())
+}
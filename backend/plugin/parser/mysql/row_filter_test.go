@@ -0,0 +1,40 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjectRowFilters(t *testing.T) {
+	getRowFilter := func(database, _, table string) string {
+		if database == "db" && table == "orders" {
+			return "region = 'EU'"
+		}
+		return ""
+	}
+	tests := []struct {
+		stmt string
+		want string
+	}{
+		{
+			stmt: "SELECT * FROM orders",
+			want: "SELECT * FROM (SELECT * FROM orders WHERE (region = 'EU')) AS `orders`;",
+		},
+		{
+			stmt: "SELECT o.id FROM db.orders o USE INDEX (idx_region) JOIN users u ON o.user_id = u.id;",
+			want: "SELECT o.id FROM (SELECT * FROM db.orders USE INDEX (idx_region) WHERE (region = 'EU')) AS o JOIN users u ON o.user_id = u.id;",
+		},
+		{
+			stmt: "SELECT * FROM other.orders WHERE id IN (SELECT order_id FROM `orders`)",
+			want: "SELECT * FROM other.orders WHERE id IN (SELECT order_id FROM (SELECT * FROM `orders` WHERE (region = 'EU')) AS `orders`);",
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := InjectRowFilters(test.stmt, "db", getRowFilter)
+		a.NoError(err)
+		a.Equal(test.want, got, test.stmt)
+	}
+}
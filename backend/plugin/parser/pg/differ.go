@@ -22,10 +22,14 @@ func init() {
 // diffNode defines different modification types as the safe change order.
 // The safe change order means we can change them with no dependency conflicts as this order.
 type diffNode struct {
+	// Revoke nodes
+	revokeList []ast.Node
+
 	// Drop nodes
 	dropForeignKeyList         []ast.Node
 	dropConstraintExceptFkList []ast.Node
 	dropTriggerList            []ast.Node
+	dropViewList               []ast.Node
 	dropIndexList              []ast.Node
 	dropDefaultList            []ast.Node
 	dropSequenceOwnedByList    []ast.Node
@@ -50,11 +54,15 @@ type diffNode struct {
 	alterColumnList                []ast.Node
 	setSequenceOwnedByList         []ast.Node
 	setDefaultList                 []ast.Node
+	createViewList                 []ast.Node
 	createIndexList                []ast.Node
 	createTriggerList              []ast.Node
 	createConstraintExceptFkList   []ast.Node
 	createForeignKeyList           []ast.Node
 	setCommentList                 []ast.Node
+
+	// Grant nodes, including the REVOKE statements only in the new schema.
+	grantList []ast.Node
 }
 
 type schemaMap map[string]*schemaInfo
@@ -66,6 +74,7 @@ type extensionMap map[string]*extensionInfo
 type functionMap map[string]*functionInfo
 type triggerMap map[string]*triggerInfo
 type typeMap map[string]*typeInfo
+type viewMap map[string]*viewInfo
 
 type schemaInfo struct {
 	id           int
@@ -77,6 +86,7 @@ type schemaInfo struct {
 	extensionMap extensionMap
 	functionMap  functionMap
 	typeMap      typeMap
+	viewMap      viewMap
 }
 
 func newSchemaInfo(id int, createSchema *ast.CreateSchemaStmt) *schemaInfo {
@@ -90,6 +100,7 @@ func newSchemaInfo(id int, createSchema *ast.CreateSchemaStmt) *schemaInfo {
 		extensionMap: make(extensionMap),
 		functionMap:  make(functionMap),
 		typeMap:      make(typeMap),
+		viewMap:      make(viewMap),
 	}
 }
 
@@ -228,6 +239,36 @@ func newTypeInfo(id int, createType *ast.CreateTypeStmt) *typeInfo {
 	}
 }
 
+type viewInfo struct {
+	id          int
+	existsInNew bool
+	createView  *ast.CreateViewStmt
+	triggerMap  triggerMap
+}
+
+func newViewInfo(id int, createView *ast.CreateViewStmt) *viewInfo {
+	return &viewInfo{
+		id:          id,
+		existsInNew: false,
+		createView:  createView,
+		triggerMap:  make(triggerMap),
+	}
+}
+
+type grantInfo struct {
+	id          int
+	existsInNew bool
+	grant       *ast.GrantStmt
+}
+
+func newGrantInfo(id int, grant *ast.GrantStmt) *grantInfo {
+	return &grantInfo{
+		id:          id,
+		existsInNew: false,
+		grant:       grant,
+	}
+}
+
 func (m schemaMap) addTable(id int, table *ast.CreateTableStmt) error {
 	if IsSystemSchema(table.Name.Schema) {
 		return nil
@@ -372,11 +413,16 @@ func (m schemaMap) addTrigger(id int, trigger *ast.CreateTriggerStmt) error {
 	if !exists {
 		return errors.Errorf("failed to add trigger: schema %s not found", trigger.Trigger.Table.Schema)
 	}
-	table, exists := schema.tableMap[trigger.Trigger.Table.Name]
+	if table, exists := schema.tableMap[trigger.Trigger.Table.Name]; exists {
+		table.triggerMap[trigger.Trigger.Name] = newTriggerInfo(id, trigger)
+		return nil
+	}
+	// The INSTEAD OF triggers are defined on the views.
+	view, exists := schema.viewMap[trigger.Trigger.Table.Name]
 	if !exists {
 		return errors.Errorf("failed to add trigger: table %s no found", trigger.Trigger.Table.Name)
 	}
-	table.triggerMap[trigger.Trigger.Name] = newTriggerInfo(id, trigger)
+	view.triggerMap[trigger.Trigger.Name] = newTriggerInfo(id, trigger)
 	return nil
 }
 
@@ -385,11 +431,33 @@ func (m schemaMap) getTrigger(schemaName string, tableName string, triggerName s
 	if !exists {
 		return nil
 	}
-	table, exists := schema.tableMap[tableName]
+	if table, exists := schema.tableMap[tableName]; exists {
+		return table.triggerMap[triggerName]
+	}
+	if view, exists := schema.viewMap[tableName]; exists {
+		return view.triggerMap[triggerName]
+	}
+	return nil
+}
+
+func (m schemaMap) addView(id int, view *ast.CreateViewStmt) error {
+	if IsSystemSchema(view.Name.Schema) {
+		return nil
+	}
+	schema, exists := m[view.Name.Schema]
+	if !exists {
+		return errors.Errorf("failed to add view: schema %s not found", view.Name.Schema)
+	}
+	schema.viewMap[view.Name.Name] = newViewInfo(id, view)
+	return nil
+}
+
+func (m schemaMap) getView(schemaName string, viewName string) *viewInfo {
+	schema, exists := m[schemaName]
 	if !exists {
 		return nil
 	}
-	return table.triggerMap[triggerName]
+	return schema.viewMap[viewName]
 }
 
 func (m schemaMap) addType(id int, createType *ast.CreateTypeStmt) error {
@@ -636,6 +704,7 @@ func SchemaDiff(oldStmt, newStmt string, _ bool) (string, error) {
 	oldSchemaMap := make(schemaMap)
 	oldSchemaMap["public"] = newSchemaInfo(-1, &ast.CreateSchemaStmt{Name: "public"})
 	oldSchemaMap["public"].existsInNew = true
+	oldGrantMap := make(map[string]*grantInfo)
 	oldPartitionMap := make(map[string]bool)
 	for _, partition := range oldPartitions {
 		oldPartitionMap[partition] = true
@@ -709,6 +778,12 @@ func SchemaDiff(oldStmt, newStmt string, _ bool) (string, error) {
 			if err := oldSchemaMap.addType(i, stmt); err != nil {
 				return "", err
 			}
+		case *ast.CreateViewStmt:
+			if err := oldSchemaMap.addView(i, stmt); err != nil {
+				return "", err
+			}
+		case *ast.GrantStmt:
+			oldGrantMap[stmt.Text()] = newGrantInfo(i, stmt)
 		case *ast.CommentStmt:
 			if err := oldSchemaMap.addComment(stmt); err != nil {
 				return "", err
@@ -718,6 +793,9 @@ func SchemaDiff(oldStmt, newStmt string, _ bool) (string, error) {
 	}
 
 	diff := &diffNode{}
+	var newViewList []*ast.CreateViewStmt
+	var newTriggerList []*ast.CreateTriggerStmt
+	var newGrantList []*ast.GrantStmt
 	newPartitionMap := make(map[string]bool)
 	for _, partition := range newPartitions {
 		newPartitionMap[partition] = true
@@ -882,6 +960,7 @@ func SchemaDiff(oldStmt, newStmt string, _ bool) (string, error) {
 			if IsSystemSchema(stmt.Trigger.Table.Schema) {
 				continue
 			}
+			newTriggerList = append(newTriggerList, stmt)
 			oldTrigger := oldSchemaMap.getTrigger(stmt.Trigger.Table.Schema, stmt.Trigger.Table.Name, stmt.Trigger.Name)
 			// Add the trigger.
 			if oldTrigger == nil {
@@ -908,6 +987,13 @@ func SchemaDiff(oldStmt, newStmt string, _ bool) (string, error) {
 			if err := diff.modifyType(oldType.createType, stmt); err != nil {
 				return "", err
 			}
+		case *ast.CreateViewStmt:
+			if IsSystemSchema(stmt.Name.Schema) {
+				continue
+			}
+			newViewList = append(newViewList, stmt)
+		case *ast.GrantStmt:
+			newGrantList = append(newGrantList, stmt)
 		case *ast.CommentStmt:
 			switch stmt.Type {
 			case ast.ObjectTypeTable:
@@ -938,6 +1024,11 @@ func SchemaDiff(oldStmt, newStmt string, _ bool) (string, error) {
 		}
 	}
 
+	// Modify the views after the tables, because the views depending on the altered tables need to be re-created.
+	recreatedViewSet := diff.modifyView(oldSchemaMap, newViewList)
+	diff.recreateViewTrigger(oldSchemaMap, newTriggerList, recreatedViewSet)
+	diff.modifyGrant(oldGrantMap, newGrantList, recreatedViewSet)
+
 	// Drop remaining old objects.
 	if err := diff.dropObject(oldSchemaMap); err != nil {
 		return "", err
@@ -1398,6 +1489,159 @@ func (diff *diffNode) modifyTrigger(oldTrigger *ast.CreateTriggerStmt, newTrigge
 	return nil
 }
 
+// modifyView diffs the views and re-creates the views in the dependency order.
+// A view is re-created if its definition is changed, or any table or view it depends on is dropped or altered,
+// because PostgreSQL doesn't allow to drop them or alter their column types while they are referenced by the views.
+// It returns the set of the re-created views.
+func (diff *diffNode) modifyView(oldSchemaMap schemaMap, newViewList []*ast.CreateViewStmt) map[string]bool {
+	var oldViewList []*viewInfo
+	for _, schema := range oldSchemaMap {
+		for _, view := range schema.viewMap {
+			oldViewList = append(oldViewList, view)
+		}
+	}
+	sort.Slice(oldViewList, func(i, j int) bool {
+		return oldViewList[i].id < oldViewList[j].id
+	})
+
+	dropViewSet := make(map[string]bool)
+	for _, newView := range newViewList {
+		oldView := oldSchemaMap.getView(newView.Name.Schema, newView.Name.Name)
+		if oldView == nil {
+			continue
+		}
+		oldView.existsInNew = true
+		// TODO(rebelice): not use Text(), it only works for pg_dump.
+		if oldView.createView.Text() != newView.Text() {
+			dropViewSet[relationKey(oldView.createView.Name)] = true
+		}
+	}
+	for _, oldView := range oldViewList {
+		if !oldView.existsInNew {
+			dropViewSet[relationKey(oldView.createView.Name)] = true
+		}
+	}
+
+	// Drop the views depending on the dropped views and the dropped or altered tables until no more views are found.
+	alteredTableSet := diff.getAlteredTableSet()
+	for found := true; found; {
+		found = false
+		for _, oldView := range oldViewList {
+			key := relationKey(oldView.createView.Name)
+			if dropViewSet[key] {
+				continue
+			}
+			for _, dependency := range oldView.createView.DependencyList {
+				dependencyKey := relationKey(dependency)
+				table := oldSchemaMap.getTable(dependency.Schema, dependency.Name)
+				if dropViewSet[dependencyKey] || alteredTableSet[dependencyKey] || (table != nil && !table.existsInNew) {
+					dropViewSet[key] = true
+					found = true
+					break
+				}
+			}
+		}
+	}
+
+	// Drop the views in the reverse order of the old schema, so the views are dropped before their dependencies.
+	for i := len(oldViewList) - 1; i >= 0; i-- {
+		viewName := oldViewList[i].createView.Name
+		if dropViewSet[relationKey(viewName)] {
+			diff.dropViewList = append(diff.dropViewList, &ast.DropTableStmt{
+				TableList: []*ast.TableDef{{Type: ast.TableTypeView, Schema: viewName.Schema, Name: viewName.Name}},
+			})
+		}
+	}
+	// Create the views in the order of the new schema, which follows the dependency order for pg_dump.
+	recreatedViewSet := make(map[string]bool)
+	for _, newView := range newViewList {
+		key := relationKey(newView.Name)
+		oldView := oldSchemaMap.getView(newView.Name.Schema, newView.Name.Name)
+		if oldView != nil && !dropViewSet[key] {
+			continue
+		}
+		if oldView != nil {
+			recreatedViewSet[key] = true
+		}
+		diff.createViewList = append(diff.createViewList, newView)
+	}
+	return recreatedViewSet
+}
+
+// getAlteredTableSet returns the tables whose columns are dropped or whose column types are altered.
+func (diff *diffNode) getAlteredTableSet() map[string]bool {
+	result := make(map[string]bool)
+	for _, node := range diff.dropColumnList {
+		if stmt, ok := node.(*ast.AlterTableStmt); ok {
+			result[relationKey(stmt.Table)] = true
+		}
+	}
+	for _, node := range diff.alterColumnList {
+		stmt, ok := node.(*ast.AlterTableStmt)
+		if !ok {
+			continue
+		}
+		for _, item := range stmt.AlterItemList {
+			if _, ok := item.(*ast.AlterColumnTypeStmt); ok {
+				result[relationKey(stmt.Table)] = true
+			}
+		}
+	}
+	return result
+}
+
+// recreateViewTrigger creates the unchanged triggers on the re-created views again, because they are dropped with the views.
+func (diff *diffNode) recreateViewTrigger(oldSchemaMap schemaMap, newTriggerList []*ast.CreateTriggerStmt, recreatedViewSet map[string]bool) {
+	for _, newTrigger := range newTriggerList {
+		if !recreatedViewSet[relationKey(newTrigger.Trigger.Table)] {
+			continue
+		}
+		oldTrigger := oldSchemaMap.getTrigger(newTrigger.Trigger.Table.Schema, newTrigger.Trigger.Table.Name, newTrigger.Trigger.Name)
+		// The new and changed triggers are already created.
+		if oldTrigger != nil && oldTrigger.createTrigger.Text() == newTrigger.Text() {
+			diff.createTriggerList = append(diff.createTriggerList, newTrigger)
+		}
+	}
+}
+
+// modifyGrant revokes the privileges only granted in the old schema and grants the privileges only in the new schema.
+// The privileges on the re-created views are granted again, because they are dropped with the views.
+func (diff *diffNode) modifyGrant(oldGrantMap map[string]*grantInfo, newGrantList []*ast.GrantStmt, recreatedViewSet map[string]bool) {
+	for _, newGrant := range newGrantList {
+		oldGrant, exists := oldGrantMap[newGrant.Text()]
+		if !exists {
+			diff.grantList = append(diff.grantList, newGrant)
+			continue
+		}
+		oldGrant.existsInNew = true
+		for _, table := range newGrant.TableList {
+			if recreatedViewSet[relationKey(table)] {
+				diff.grantList = append(diff.grantList, newGrant)
+				break
+			}
+		}
+	}
+
+	var revokeList []*grantInfo
+	for _, grant := range oldGrantMap {
+		if !grant.existsInNew {
+			revokeList = append(revokeList, grant)
+		}
+	}
+	sort.Slice(revokeList, func(i, j int) bool {
+		return revokeList[i].id < revokeList[j].id
+	})
+	for _, grant := range revokeList {
+		revoke := &ast.GrantStmt{
+			IsGrant:    !grant.grant.IsGrant,
+			TableList:  grant.grant.TableList,
+			RevertText: grant.grant.Text(),
+		}
+		revoke.SetText(grant.grant.RevertText)
+		diff.revokeList = append(diff.revokeList, revoke)
+	}
+}
+
 func (diff *diffNode) modifyIndex(oldIndex *ast.CreateIndexStmt, newIndex *ast.CreateIndexStmt) error {
 	// TODO(rebelice): not use Text(), it only works for pg_dump.
 	if oldIndex.Text() != newIndex.Text() {
@@ -1607,6 +1851,11 @@ func printStmtSlice(buf io.Writer, nodeList []ast.Node) error {
 func (diff *diffNode) deparse() (string, error) {
 	var buf bytes.Buffer
 
+	// revoke
+	if err := printStmtSliceByText(&buf, diff.revokeList); err != nil {
+		return "", err
+	}
+
 	// drop
 	if err := printStmtSlice(&buf, diff.dropForeignKeyList); err != nil {
 		return "", err
@@ -1617,6 +1866,9 @@ func (diff *diffNode) deparse() (string, error) {
 	if err := printStmtSlice(&buf, diff.dropTriggerList); err != nil {
 		return "", err
 	}
+	if err := printStmtSlice(&buf, diff.dropViewList); err != nil {
+		return "", err
+	}
 	if err := printStmtSlice(&buf, diff.dropIndexList); err != nil {
 		return "", err
 	}
@@ -1685,6 +1937,9 @@ func (diff *diffNode) deparse() (string, error) {
 	if err := printStmtSlice(&buf, diff.setDefaultList); err != nil {
 		return "", err
 	}
+	if err := printStmtSliceByText(&buf, diff.createViewList); err != nil {
+		return "", err
+	}
 	if err := printStmtSliceByText(&buf, diff.createIndexList); err != nil {
 		return "", err
 	}
@@ -1701,6 +1956,11 @@ func (diff *diffNode) deparse() (string, error) {
 		return "", err
 	}
 
+	// grant
+	if err := printStmtSliceByText(&buf, diff.grantList); err != nil {
+		return "", err
+	}
+
 	return buf.String(), nil
 }
 
@@ -1920,6 +2180,15 @@ func (diff *diffNode) dropTriggerStmt(m schemaMap) {
 				triggerList = append(triggerList, trigger)
 			}
 		}
+		for _, view := range schema.viewMap {
+			for _, trigger := range view.triggerMap {
+				if trigger.existsInNew || !view.existsInNew {
+					// no need to drop, because the triggers are dropped when drop view
+					continue
+				}
+				triggerList = append(triggerList, trigger)
+			}
+		}
 	}
 	if len(triggerList) == 0 {
 		return
@@ -2022,6 +2291,10 @@ func dropSequence(m schemaMap) *ast.DropSequenceStmt {
 	}
 }
 
+func relationKey(table *ast.TableDef) string {
+	return fmt.Sprintf("%s.%s", table.Schema, table.Name)
+}
+
 func writeStringWithNewLine(out io.Writer, str string) error {
 	if _, err := out.Write([]byte(str)); err != nil {
 		return err
@@ -62,6 +62,10 @@ func TestComputeDiff(t *testing.T) {
 		"test_differ_merge.yaml",
 		// Sequence
 		"test_differ_sequence.yaml",
+		// View
+		"test_differ_view.yaml",
+		// Grant
+		"test_differ_grant.yaml",
 	}
 	for _, test := range testFileList {
 		runDifferTest(t, test, false /* record */)
@@ -0,0 +1,254 @@
+package pg
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/postgresql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterExtractIndexColumns(storepb.Engine_POSTGRES, ExtractIndexColumns)
+}
+
+// ExtractIndexColumns extracts the tables read by the statement, and the columns used by the predicates
+// in the WHERE and JOIN ON clauses and by the ORDER BY clause.
+// The statement can be a normalized query of pg_stat_statements, e.g. "SELECT * FROM t WHERE id = $1".
+// The tables without schema are considered in the public schema.
+// The table aliases are resolved in the whole statement regardless of the query block scopes.
+func ExtractIndexColumns(statement, database string) (*base.IndexColumnUsages, error) {
+	result, err := ParsePostgreSQL(statement)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := &indexColumnExtractor{
+		database: database,
+		aliases:  make(map[string]base.SchemaResource),
+	}
+	antlr.ParseTreeWalkerDefault.Walk(listener, result.Tree)
+
+	usages := &base.IndexColumnUsages{Tables: listener.tables}
+	for _, column := range listener.columns {
+		if column.table != "" {
+			table, ok := listener.aliases[column.table]
+			if column.schema != "" || !ok {
+				table = base.SchemaResource{Database: database, Schema: column.schema, Table: column.table}
+				if table.Schema == "" {
+					table.Schema = "public"
+				}
+			}
+			column.usage.Table = table
+		}
+		usages.Columns = append(usages.Columns, column.usage)
+	}
+	return usages, nil
+}
+
+type indexColumn struct {
+	schema string
+	table  string
+	usage  base.IndexColumnUsage
+}
+
+type indexColumnExtractor struct {
+	*parser.BasePostgreSQLParserListener
+
+	database string
+	tables   []base.SchemaResource
+	// aliases is the map from the alias or table name to the table.
+	aliases map[string]base.SchemaResource
+	// columns is resolved after the walk, as the tables may be declared after the columns, e.g. in the select list.
+	columns []*indexColumn
+}
+
+func (l *indexColumnExtractor) EnterTable_ref(ctx *parser.Table_refContext) {
+	if ctx.Relation_expr() == nil {
+		return
+	}
+	alias := ""
+	if ctx.Opt_alias_clause() != nil && ctx.Opt_alias_clause().Table_alias_clause() != nil {
+		alias = normalizePostgreSQLTableAlias(ctx.Opt_alias_clause().Table_alias_clause().Table_alias())
+	}
+	l.addTable(ctx.Relation_expr(), alias)
+}
+
+func (l *indexColumnExtractor) EnterRelation_expr_opt_alias(ctx *parser.Relation_expr_opt_aliasContext) {
+	alias := ""
+	if ctx.Colid() != nil {
+		alias = NormalizePostgreSQLColid(ctx.Colid())
+	}
+	l.addTable(ctx.Relation_expr(), alias)
+}
+
+func (l *indexColumnExtractor) addTable(relation parser.IRelation_exprContext, alias string) {
+	schemaName, tableName, err := NormalizePostgreSQLQualifiedNameAsTableName(relation.Qualified_name())
+	if err != nil || tableName == "" {
+		return
+	}
+	if schemaName == "" {
+		schemaName = "public"
+	}
+	table := base.SchemaResource{Database: l.database, Schema: schemaName, Table: tableName}
+	l.tables = append(l.tables, table)
+	if alias == "" {
+		alias = tableName
+	}
+	l.aliases[alias] = table
+}
+
+func (l *indexColumnExtractor) EnterJoin_qual(ctx *parser.Join_qualContext) {
+	if ctx.USING() == nil {
+		return
+	}
+	for _, column := range normalizePostgreSQLNameList(ctx.Name_list()) {
+		l.columns = append(l.columns, &indexColumn{
+			usage: base.IndexColumnUsage{Column: column, Type: base.IndexColumnUsageJoin},
+		})
+	}
+}
+
+func (l *indexColumnExtractor) EnterA_expr_compare(ctx *parser.A_expr_compareContext) {
+	operands := ctx.AllA_expr_like()
+	if len(operands) != 2 || !isIndexablePredicate(ctx) {
+		return
+	}
+	var usageType base.IndexColumnUsageType
+	switch {
+	case ctx.EQUAL() != nil:
+		usageType = base.IndexColumnUsageEquality
+	case ctx.LT() != nil, ctx.GT() != nil, ctx.LESS_EQUALS() != nil, ctx.GREATER_EQUALS() != nil:
+		usageType = base.IndexColumnUsageRange
+	default:
+		return
+	}
+
+	left, right := bareColumnref(operands[0]), bareColumnref(operands[1])
+	switch {
+	case left != nil && right != nil:
+		if usageType == base.IndexColumnUsageEquality {
+			l.addColumn(left, base.IndexColumnUsageJoin)
+			l.addColumn(right, base.IndexColumnUsageJoin)
+		}
+	case left != nil && !hasColumnref(operands[1]):
+		l.addColumn(left, usageType)
+	case right != nil && !hasColumnref(operands[0]):
+		l.addColumn(right, usageType)
+	}
+}
+
+func (l *indexColumnExtractor) EnterA_expr_in(ctx *parser.A_expr_inContext) {
+	if ctx.IN_P() == nil || ctx.NOT() != nil || !isIndexablePredicate(ctx) {
+		return
+	}
+	list, ok := ctx.In_expr().(*parser.In_expr_listContext)
+	if !ok || hasColumnref(list) {
+		return
+	}
+	if column := bareColumnref(ctx.A_expr_unary_not()); column != nil {
+		l.addColumn(column, base.IndexColumnUsageEquality)
+	}
+}
+
+func (l *indexColumnExtractor) EnterA_expr_between(ctx *parser.A_expr_betweenContext) {
+	operands := ctx.AllA_expr_in()
+	if ctx.BETWEEN() == nil || ctx.NOT() != nil || len(operands) != 3 || !isIndexablePredicate(ctx) {
+		return
+	}
+	if hasColumnref(operands[1]) || hasColumnref(operands[2]) {
+		return
+	}
+	if column := bareColumnref(operands[0]); column != nil {
+		l.addColumn(column, base.IndexColumnUsageRange)
+	}
+}
+
+func (l *indexColumnExtractor) EnterA_expr_like(ctx *parser.A_expr_likeContext) {
+	operands := ctx.AllA_expr_qual_op()
+	if ctx.LIKE() == nil || ctx.NOT() != nil || len(operands) != 2 || !isIndexablePredicate(ctx) {
+		return
+	}
+	if hasColumnref(operands[1]) {
+		return
+	}
+	if column := bareColumnref(operands[0]); column != nil {
+		l.addColumn(column, base.IndexColumnUsageRange)
+	}
+}
+
+func (l *indexColumnExtractor) EnterSortby(ctx *parser.SortbyContext) {
+	// Only the ORDER BY clauses of the queries, but not of the window functions or aggregates.
+	sortClause := ctx.GetParent().GetParent()
+	if sortClause.GetParent() == nil {
+		return
+	}
+	if _, ok := sortClause.GetParent().GetParent().(*parser.Select_no_parensContext); !ok {
+		return
+	}
+	if column := bareColumnref(ctx.A_expr()); column != nil {
+		l.addColumn(column, base.IndexColumnUsageOrderBy)
+	}
+}
+
+func (l *indexColumnExtractor) addColumn(ctx *parser.ColumnrefContext, usageType base.IndexColumnUsageType) {
+	list := []string{NormalizePostgreSQLColid(ctx.Colid())}
+	if ctx.Indirection() != nil {
+		list = append(list, normalizePostgreSQLIndirection(ctx.Indirection())...)
+	}
+	column := &indexColumn{usage: base.IndexColumnUsage{Column: list[len(list)-1], Type: usageType}}
+	switch len(list) {
+	case 1:
+	case 2:
+		column.table = list[0]
+	default:
+		// The database qualifier is ignored as the cross database query is not supported.
+		column.schema, column.table = list[len(list)-3], list[len(list)-2]
+	}
+	l.columns = append(l.columns, column)
+}
+
+// isIndexablePredicate returns true if the predicate is in the WHERE or JOIN ON clause and is not under OR or NOT.
+func isIndexablePredicate(ctx antlr.Tree) bool {
+	for parent := ctx.GetParent(); parent != nil; parent = parent.GetParent() {
+		switch parent := parent.(type) {
+		case *parser.Where_clauseContext, *parser.Where_or_current_clauseContext, *parser.Join_qualContext:
+			return true
+		case *parser.A_expr_orContext:
+			if len(parent.AllOR()) > 0 {
+				return false
+			}
+		case *parser.A_expr_unary_notContext:
+			if parent.NOT() != nil {
+				return false
+			}
+		}
+	}
+	return false
+}
+
+// bareColumnref returns the column reference if the expression is a column without any operators or functions.
+func bareColumnref(tree antlr.Tree) *parser.ColumnrefContext {
+	for tree != nil {
+		if columnref, ok := tree.(*parser.ColumnrefContext); ok {
+			return columnref
+		}
+		if tree.GetChildCount() != 1 {
+			return nil
+		}
+		tree = tree.GetChild(0)
+	}
+	return nil
+}
+
+func hasColumnref(tree antlr.Tree) bool {
+	if _, ok := tree.(*parser.ColumnrefContext); ok {
+		return true
+	}
+	for _, child := range tree.GetChildren() {
+		if hasColumnref(child) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestExtractIndexColumns(t *testing.T) {
+	orders := base.SchemaResource{Database: "db", Schema: "public", Table: "orders"}
+	users := base.SchemaResource{Database: "db", Schema: "app", Table: "users"}
+	tests := []struct {
+		stmt string
+		want *base.IndexColumnUsages
+	}{
+		{
+			stmt: "SELECT * FROM orders WHERE status = $1 AND created_at > $2 ORDER BY id DESC LIMIT $3",
+			want: &base.IndexColumnUsages{
+				Tables: []base.SchemaResource{orders},
+				Columns: []base.IndexColumnUsage{
+					{Column: "status", Type: base.IndexColumnUsageEquality},
+					{Column: "created_at", Type: base.IndexColumnUsageRange},
+					{Column: "id", Type: base.IndexColumnUsageOrderBy},
+				},
+			},
+		},
+		{
+			stmt: "SELECT o.id FROM orders AS o JOIN app.users u ON o.user_id = u.id WHERE u.email IN ($1, $2) AND o.amount BETWEEN $3 AND $4",
+			want: &base.IndexColumnUsages{
+				Tables: []base.SchemaResource{orders, users},
+				Columns: []base.IndexColumnUsage{
+					{Table: orders, Column: "user_id", Type: base.IndexColumnUsageJoin},
+					{Table: users, Column: "id", Type: base.IndexColumnUsageJoin},
+					{Table: users, Column: "email", Type: base.IndexColumnUsageEquality},
+					{Table: orders, Column: "amount", Type: base.IndexColumnUsageRange},
+				},
+			},
+		},
+		{
+			// The predicates under OR and the non-sargable predicates are skipped.
+			stmt: "SELECT * FROM orders WHERE (status = $1 OR region = $2) AND lower(code) = $3 AND price <> $4 AND user_id = $5",
+			want: &base.IndexColumnUsages{
+				Tables: []base.SchemaResource{orders},
+				Columns: []base.IndexColumnUsage{
+					{Column: "user_id", Type: base.IndexColumnUsageEquality},
+				},
+			},
+		},
+		{
+			stmt: "UPDATE orders o SET status = $1 FROM app.users JOIN orders USING (user_id) WHERE app.users.name LIKE $2",
+			want: &base.IndexColumnUsages{
+				Tables: []base.SchemaResource{orders, users, orders},
+				Columns: []base.IndexColumnUsage{
+					{Column: "user_id", Type: base.IndexColumnUsageJoin},
+					{Table: users, Column: "name", Type: base.IndexColumnUsageRange},
+				},
+			},
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := ExtractIndexColumns(test.stmt, "db")
+		a.NoError(err)
+		a.Equal(test.want, got, test.stmt)
+	}
+}
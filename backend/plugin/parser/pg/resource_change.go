@@ -0,0 +1,63 @@
+package pg
+
+import (
+	"sort"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
+	pgrawparser "github.com/bytebase/bytebase/backend/plugin/parser/sql/engine/pg"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterExtractChangedResourcesFunc(storepb.Engine_POSTGRES, extractChangedResources)
+}
+
+// extractChangedResources extracts the tables created, dropped, altered or renamed by the statement.
+// The tables without the schema are in the public schema, the same as the default search path.
+func extractChangedResources(currentDatabase string, _ string, statement string) ([]base.SchemaResource, error) {
+	nodes, err := pgrawparser.Parse(pgrawparser.ParseContext{}, statement)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceMap := make(map[string]base.SchemaResource)
+	addTable := func(schema, table string) {
+		if schema == "" {
+			schema = "public"
+		}
+		resource := base.SchemaResource{
+			Database: currentDatabase,
+			Schema:   schema,
+			Table:    table,
+		}
+		resourceMap[resource.String()] = resource
+	}
+	for _, node := range nodes {
+		switch node := node.(type) {
+		case *ast.CreateTableStmt:
+			addTable(node.Name.Schema, node.Name.Name)
+		case *ast.DropTableStmt:
+			for _, table := range node.TableList {
+				addTable(table.Schema, table.Name)
+			}
+		case *ast.AlterTableStmt:
+			addTable(node.Table.Schema, node.Table.Name)
+			for _, item := range node.AlterItemList {
+				// The renamed table stays in its schema.
+				if rename, ok := item.(*ast.RenameTableStmt); ok {
+					addTable(node.Table.Schema, rename.NewName)
+				}
+			}
+		}
+	}
+
+	var result []base.SchemaResource
+	for _, resource := range resourceMap {
+		result = append(result, resource)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].String() < result[j].String()
+	})
+	return result, nil
+}
@@ -0,0 +1,47 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestExtractChangedResources(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      []base.SchemaResource
+	}{
+		{
+			statement: "CREATE TABLE t(a int);",
+			want:      []base.SchemaResource{{Database: "db", Schema: "public", Table: "t"}},
+		},
+		{
+			statement: "ALTER TABLE s1.t ADD COLUMN b int; DROP TABLE t1, s2.t2;",
+			want: []base.SchemaResource{
+				{Database: "db", Schema: "public", Table: "t1"},
+				{Database: "db", Schema: "s1", Table: "t"},
+				{Database: "db", Schema: "s2", Table: "t2"},
+			},
+		},
+		{
+			statement: "ALTER TABLE s1.t RENAME TO t_new;",
+			want: []base.SchemaResource{
+				{Database: "db", Schema: "s1", Table: "t"},
+				{Database: "db", Schema: "s1", Table: "t_new"},
+			},
+		},
+		{
+			statement: "SELECT * FROM t; INSERT INTO t VALUES (1);",
+			want:      nil,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		resources, err := extractChangedResources("db", "", test.statement)
+		a.NoError(err)
+		a.Equal(test.want, resources, test.statement)
+	}
+}
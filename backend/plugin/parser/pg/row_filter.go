@@ -0,0 +1,78 @@
+package pg
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/postgresql-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterInjectRowFilters(storepb.Engine_POSTGRES, InjectRowFilters)
+}
+
+// InjectRowFilters replaces the tables having row filters with the subqueries selecting the visible rows,
+// e.g. "SELECT * FROM t" becomes `SELECT * FROM (SELECT * FROM t WHERE (region = 'EU')) AS "t"`.
+// The tables without schema are considered in the public schema.
+func InjectRowFilters(statement, database string, getRowFilter base.GetRowFilterFunc) (string, error) {
+	result, err := ParsePostgreSQL(statement)
+	if err != nil {
+		return "", err
+	}
+
+	listener := &rowFilterInjector{
+		rewriter:     antlr.NewTokenStreamRewriter(result.Tokens),
+		tokens:       result.Tokens,
+		database:     database,
+		getRowFilter: getRowFilter,
+	}
+	antlr.ParseTreeWalkerDefault.Walk(listener, result.Tree)
+	if listener.err != nil {
+		return "", listener.err
+	}
+	return listener.rewriter.GetTextDefault(), nil
+}
+
+type rowFilterInjector struct {
+	*parser.BasePostgreSQLParserListener
+
+	rewriter     *antlr.TokenStreamRewriter
+	tokens       *antlr.CommonTokenStream
+	database     string
+	getRowFilter base.GetRowFilterFunc
+	err          error
+}
+
+func (l *rowFilterInjector) EnterTable_ref(ctx *parser.Table_refContext) {
+	if l.err != nil || ctx.Relation_expr() == nil {
+		return
+	}
+	relation := ctx.Relation_expr()
+	schemaName, tableName, err := NormalizePostgreSQLQualifiedNameAsTableName(relation.Qualified_name())
+	if err != nil {
+		l.err = err
+		return
+	}
+	if schemaName == "" {
+		schemaName = "public"
+	}
+	filter := l.getRowFilter(l.database, schemaName, tableName)
+	if filter == "" {
+		return
+	}
+	if ctx.Tablesample_clause() != nil {
+		l.err = errors.Errorf("TABLESAMPLE is not supported on table %q with row filter", tableName)
+		return
+	}
+
+	text := fmt.Sprintf("(SELECT * FROM %s WHERE (%s))", l.tokens.GetTextFromRuleContext(relation), filter)
+	if ctx.Opt_alias_clause() == nil {
+		text += fmt.Sprintf(` AS "%s"`, strings.ReplaceAll(tableName, `"`, `""`))
+	}
+	l.rewriter.ReplaceDefault(relation.GetStart().GetTokenIndex(), relation.GetStop().GetTokenIndex(), text)
+}
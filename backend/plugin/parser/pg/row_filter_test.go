@@ -0,0 +1,43 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjectRowFilters(t *testing.T) {
+	getRowFilter := func(database, schema, table string) string {
+		if database == "db" && schema == "public" && table == "orders" {
+			return "region = 'EU'"
+		}
+		return ""
+	}
+	tests := []struct {
+		stmt string
+		want string
+	}{
+		{
+			stmt: "SELECT * FROM orders",
+			want: `SELECT * FROM (SELECT * FROM orders WHERE (region = 'EU')) AS "orders"`,
+		},
+		{
+			stmt: "SELECT o.id FROM public.orders AS o JOIN users u ON o.user_id = u.id;",
+			want: "SELECT o.id FROM (SELECT * FROM public.orders WHERE (region = 'EU')) AS o JOIN users u ON o.user_id = u.id;",
+		},
+		{
+			stmt: "SELECT * FROM sales.orders WHERE id IN (SELECT order_id FROM ONLY orders)",
+			want: `SELECT * FROM sales.orders WHERE id IN (SELECT order_id FROM (SELECT * FROM ONLY orders WHERE (region = 'EU')) AS "orders")`,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := InjectRowFilters(test.stmt, "db", getRowFilter)
+		a.NoError(err)
+		a.Equal(test.want, got, test.stmt)
+	}
+
+	_, err := InjectRowFilters("SELECT * FROM orders TABLESAMPLE SYSTEM (10)", "db", getRowFilter)
+	a.Error(err)
+}
@@ -0,0 +1,11 @@
+package ast
+
+// CreateViewStmt is the struct for create view statement.
+type CreateViewStmt struct {
+	ddl
+
+	Name    *TableDef
+	Replace bool
+	// DependencyList is the list of the tables and views referenced by the view definition.
+	DependencyList []*TableDef
+}
@@ -0,0 +1,12 @@
+package ast
+
+// GrantStmt is the struct for grant and revoke privilege statements.
+type GrantStmt struct {
+	node
+
+	IsGrant bool
+	// TableList is the list of the tables and views whose privileges are granted or revoked.
+	TableList []*TableDef
+	// RevertText is the statement reverting this statement, e.g. the REVOKE statement for a GRANT statement.
+	RevertText string
+}
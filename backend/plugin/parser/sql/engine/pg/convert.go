@@ -7,6 +7,8 @@ import (
 
 	pgquery "github.com/pganalyze/pg_query_go/v4"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	"github.com/bytebase/bytebase/backend/plugin/parser/tokenizer"
@@ -816,6 +818,32 @@ func convert(node *pgquery.Node, statement base.SingleSQL) (res ast.Node, err er
 			}, nil
 		}
 		// TODO(rebelice): support RENAME ENUM VALUE statements
+	case *pgquery.Node_ViewStmt:
+		createViewStmt := &ast.CreateViewStmt{
+			Name:    convertRangeVarToTableName(in.ViewStmt.View, ast.TableTypeView),
+			Replace: in.ViewStmt.Replace,
+		}
+		for _, rangeVar := range extractRangeVarList(in.ViewStmt.Query) {
+			createViewStmt.DependencyList = append(createViewStmt.DependencyList, convertRangeVarToTableName(rangeVar, ast.TableTypeUnknown))
+		}
+		return createViewStmt, nil
+	case *pgquery.Node_GrantStmt:
+		revertText, err := getRevertGrantText(in.GrantStmt)
+		if err != nil {
+			return nil, err
+		}
+		grantStmt := &ast.GrantStmt{
+			IsGrant:    in.GrantStmt.IsGrant,
+			RevertText: revertText,
+		}
+		if in.GrantStmt.Targtype == pgquery.GrantTargetType_ACL_TARGET_OBJECT && in.GrantStmt.Objtype == pgquery.ObjectType_OBJECT_TABLE {
+			for _, object := range in.GrantStmt.Objects {
+				if rangeVar, ok := object.Node.(*pgquery.Node_RangeVar); ok {
+					grantStmt.TableList = append(grantStmt.TableList, convertRangeVarToTableName(rangeVar.RangeVar, ast.TableTypeUnknown))
+				}
+			}
+		}
+		return grantStmt, nil
 	case *pgquery.Node_TransactionStmt:
 		if in.TransactionStmt.Kind == pgquery.TransactionStmtKind_TRANS_STMT_COMMIT {
 			return &ast.CommitStmt{}, nil
@@ -1431,6 +1459,59 @@ func convertRangeVarToTableName(in *pgquery.RangeVar, tableType ast.TableType) *
 	}
 }
 
+// extractRangeVarList returns all the RangeVar nodes in the node tree, e.g. the tables and views referenced by a query.
+func extractRangeVarList(node *pgquery.Node) []*pgquery.RangeVar {
+	var result []*pgquery.RangeVar
+	var walk func(message protoreflect.Message)
+	walk = func(message protoreflect.Message) {
+		if rangeVar, ok := message.Interface().(*pgquery.RangeVar); ok {
+			result = append(result, rangeVar)
+			return
+		}
+		message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+			if field.Kind() != protoreflect.MessageKind {
+				return true
+			}
+			if field.IsList() {
+				list := value.List()
+				for i := 0; i < list.Len(); i++ {
+					walk(list.Get(i).Message())
+				}
+				return true
+			}
+			walk(value.Message())
+			return true
+		})
+	}
+	if node != nil {
+		walk(node.ProtoReflect())
+	}
+	return result
+}
+
+// getRevertGrantText returns the statement reverting the GRANT or REVOKE statement.
+func getRevertGrantText(in *pgquery.GrantStmt) (string, error) {
+	revert, ok := proto.Clone(in).(*pgquery.GrantStmt)
+	if !ok {
+		return "", NewConvertErrorf("failed to clone grant statement")
+	}
+	revert.IsGrant = !in.IsGrant
+	if in.IsGrant {
+		// Revoking the privilege also revokes the grant option.
+		revert.GrantOption = false
+		revert.Behavior = pgquery.DropBehavior_DROP_RESTRICT
+	}
+	text, err := pgquery.Deparse(&pgquery.ParseResult{
+		Stmts: []*pgquery.RawStmt{
+			{Stmt: &pgquery.Node{Node: &pgquery.Node_GrantStmt{GrantStmt: revert}}},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return text + ";", nil
+}
+
 func convertRangeVarToSeqName(in *pgquery.RangeVar) *ast.SequenceNameDef {
 	return &ast.SequenceNameDef{
 		Schema: in.Schemaname,
@@ -366,7 +366,11 @@ func deparseDropTable(context DeparseContext, in *ast.DropTableStmt, buf *string
 		return err
 	}
 
-	if _, err := buf.WriteString("DROP TABLE "); err != nil {
+	object := "TABLE"
+	if len(in.TableList) > 0 && in.TableList[0].Type == ast.TableTypeView {
+		object = "VIEW"
+	}
+	if _, err := buf.WriteString(fmt.Sprintf("DROP %s ", object)); err != nil {
 		return err
 	}
 	if in.IfExists {
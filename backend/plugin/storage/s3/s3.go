@@ -103,6 +103,14 @@ func (c *Client) DeleteObjects(ctx context.Context, pathList ...string) (*s3.Del
 	})
 }
 
+// Ping verifies the bucket is reachable with the credentials.
+func (c *Client) Ping(ctx context.Context) error {
+	if _, err := c.c.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: &c.bucket}); err != nil {
+		return errors.Wrapf(err, "failed to head bucket %q", c.bucket)
+	}
+	return nil
+}
+
 // GetBucket returns the bucket.
 func (c *Client) GetBucket() string {
 	return c.bucket
@@ -1239,6 +1239,11 @@ func (p *Provider) CreatePullRequest(ctx context.Context, oauthCtx *common.Oauth
 	}, nil
 }
 
+// CreatePullRequestComment creates the comment thread in the pull request.
+func (*Provider) CreatePullRequestComment(ctx context.Context, oauthCtx *common.OauthContext, _, repositoryID, pullRequestID, comment string) error {
+	return CreatePullRequestComment(ctx, oauthCtx, repositoryID, pullRequestID, comment)
+}
+
 // UpsertEnvironmentVariable creates or updates the environment variable in the repository.
 func (*Provider) UpsertEnvironmentVariable(context.Context, *common.OauthContext, string, string, string, string) error {
 	// We will set the variable in pipeline. Check sql_review.go/createSQLReviewPipeline function.
@@ -0,0 +1,130 @@
+package azure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/internal/oauth"
+)
+
+const (
+	// SQLReviewStatusGenre is the genre of the SQL review pull request status.
+	SQLReviewStatusGenre = "bytebase"
+	// SQLReviewStatusName is the name of the SQL review pull request status.
+	// The "bytebase/sql-review" status can be required by the "Require approval from additional services" branch policy.
+	SQLReviewStatusName = "sql-review"
+)
+
+// PullRequestStatusState is the state of the pull request status.
+type PullRequestStatusState string
+
+const (
+	// PullRequestStatusSucceeded is the succeeded pull request status.
+	PullRequestStatusSucceeded PullRequestStatusState = "succeeded"
+	// PullRequestStatusFailed is the failed pull request status.
+	PullRequestStatusFailed PullRequestStatusState = "failed"
+)
+
+// PullRequestStatus is the API message for the pull request status.
+type PullRequestStatus struct {
+	State       PullRequestStatusState    `json:"state"`
+	Description string                    `json:"description"`
+	TargetURL   string                    `json:"targetUrl,omitempty"`
+	Context     *PullRequestStatusContext `json:"context"`
+}
+
+// PullRequestStatusContext is the API message for the pull request status context, which identifies the status.
+type PullRequestStatusContext struct {
+	Genre string `json:"genre"`
+	Name  string `json:"name"`
+}
+
+// CreatePullRequestStatus creates the status of the pull request, which requires the "vso.code_status" scope.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-statuses/create?view=azure-devops-rest-7.1
+func CreatePullRequestStatus(ctx context.Context, oauthCtx *common.OauthContext, repositoryID, pullRequestID string, status *PullRequestStatus) error {
+	return postPullRequestResource(ctx, oauthCtx, repositoryID, pullRequestID, "statuses", status)
+}
+
+type pullRequestThreadComment struct {
+	ParentCommentID int    `json:"parentCommentId"`
+	Content         string `json:"content"`
+	// CommentType 1 is the text comment.
+	CommentType int `json:"commentType"`
+}
+
+type pullRequestThread struct {
+	Comments []*pullRequestThreadComment `json:"comments"`
+	// Status 1 is the active thread.
+	Status int `json:"status"`
+}
+
+// CreatePullRequestComment creates the comment thread in the pull request, and the content supports the markdown.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-threads/create?view=azure-devops-rest-7.1
+func CreatePullRequestComment(ctx context.Context, oauthCtx *common.OauthContext, repositoryID, pullRequestID, content string) error {
+	thread := &pullRequestThread{
+		Comments: []*pullRequestThreadComment{
+			{
+				ParentCommentID: 0,
+				Content:         content,
+				CommentType:     1,
+			},
+		},
+		Status: 1,
+	}
+	return postPullRequestResource(ctx, oauthCtx, repositoryID, pullRequestID, "threads", thread)
+}
+
+func postPullRequestResource(ctx context.Context, oauthCtx *common.OauthContext, repositoryID, pullRequestID, resource string, payload any) error {
+	apiURL, err := getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return err
+	}
+
+	urlParams := &url.Values{}
+	urlParams.Set("api-version", "7.1-preview.1")
+	url := fmt.Sprintf("%s/pullRequests/%s/%s?%s", apiURL, url.PathEscape(pullRequestID), resource, urlParams.Encode())
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrapf(err, "marshal pull request %s", resource)
+	}
+
+	code, _, resp, err := oauth.Post(
+		ctx,
+		&http.Client{},
+		url,
+		&oauthCtx.AccessToken,
+		bytes.NewReader(body),
+		tokenRefresher(
+			oauthContext{
+				RefreshToken: oauthCtx.RefreshToken,
+				ClientSecret: oauthCtx.ClientSecret,
+				RedirectURL:  oauthCtx.RedirectURL,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create pull request %s from URL %s", resource, url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create pull request %s from URL %s, status code: %d, body: %s",
+			resource,
+			url,
+			code,
+			resp,
+		)
+	}
+	return nil
+}
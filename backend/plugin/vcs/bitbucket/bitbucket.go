@@ -851,6 +851,60 @@ func (p *Provider) CreatePullRequest(ctx context.Context, oauthCtx *common.Oauth
 	}, nil
 }
 
+type pullRequestCommentContent struct {
+	Raw string `json:"raw"`
+}
+
+type pullRequestCommentCreate struct {
+	Content pullRequestCommentContent `json:"content"`
+}
+
+// CreatePullRequestComment creates the comment in the pull request, and the content is rendered as markdown.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-pull-request-id-comments-post
+func (p *Provider) CreatePullRequestComment(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, repositoryID, pullRequestID, comment string) error {
+	payload, err := json.Marshal(
+		pullRequestCommentCreate{
+			Content: pullRequestCommentContent{Raw: comment},
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "marshal pull request comment create")
+	}
+
+	url := fmt.Sprintf("%s/repositories/%s/pullrequests/%s/comments", p.APIURL(instanceURL), repositoryID, pullRequestID)
+	code, _, body, err := oauth.Post(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		bytes.NewReader(payload),
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create pull request comment from URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create pull request comment from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
 // UpsertEnvironmentVariable creates or updates the environment variable in the repository.
 //
 // WARNING: This is not supported in Bitbucket Cloud.
@@ -0,0 +1,595 @@
+// Package gerrit is the plugin for Gerrit Code Review.
+package gerrit
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+)
+
+func init() {
+	vcs.Register(vcs.Gerrit, newProvider)
+}
+
+const (
+	// WebhookRemoteName is the name of the remote created in the Gerrit webhooks plugin.
+	WebhookRemoteName = "bytebase"
+
+	// SQLReviewLabel is the label voted by the SQL review, -1 if there are errors and +1 otherwise.
+	// The label is ignored if it's not configured in the Gerrit project or the user cannot vote on it.
+	SQLReviewLabel = "Verified"
+
+	// magicPrefix is the prefix of the Gerrit JSON responses to prevent XSSI.
+	magicPrefix = ")]}'"
+	// commitMessageFile and mergeListFile are the magic files in the Gerrit file list.
+	commitMessageFile = "/COMMIT_MSG"
+	mergeListFile     = "/MERGE_LIST"
+)
+
+var _ vcs.Provider = (*Provider)(nil)
+
+// Provider is a Gerrit VCS provider.
+//
+// Gerrit doesn't support OAuth, so the access token is the HTTP credentials in the format of "username:http-password".
+// The Gerrit project name is used as the repository ID.
+type Provider struct {
+	client *http.Client
+}
+
+func newProvider(config vcs.ProviderConfig) vcs.Provider {
+	if config.Client == nil {
+		config.Client = &http.Client{}
+	}
+	return &Provider{
+		client: config.Client,
+	}
+}
+
+// APIURL returns the authenticated REST API URL of Gerrit.
+func (*Provider) APIURL(instanceURL string) string {
+	return fmt.Sprintf("%s/a", instanceURL)
+}
+
+// AccountInfo is the API message for Gerrit account.
+type AccountInfo struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Username string `json:"username"`
+}
+
+// GitPersonInfo is the API message for the author or committer of the commit.
+type GitPersonInfo struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	// Date is the timestamp in the format of "2006-01-02 15:04:05.000000000".
+	Date string `json:"date"`
+}
+
+// CommitInfo is the API message for Gerrit commit.
+type CommitInfo struct {
+	Commit  string        `json:"commit"`
+	Parents []*CommitInfo `json:"parents"`
+	Author  GitPersonInfo `json:"author"`
+	Subject string        `json:"subject"`
+	Message string        `json:"message"`
+}
+
+// FileInfo is the API message for the file in the commit or the revision.
+type FileInfo struct {
+	// Status is "A" for added, "D" for deleted, "R" for renamed, "C" for copied, "W" for rewritten, and empty for modified.
+	Status string `json:"status"`
+}
+
+// ProjectInfo is the API message for Gerrit project.
+type ProjectInfo struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+// BranchInfo is the API message for Gerrit branch.
+type BranchInfo struct {
+	Ref      string `json:"ref"`
+	Revision string `json:"revision"`
+}
+
+// ChangeInfo is the API message for Gerrit change.
+type ChangeInfo struct {
+	ID              string `json:"id"`
+	Project         string `json:"project"`
+	Branch          string `json:"branch"`
+	Number          int    `json:"_number"`
+	CurrentRevision string `json:"current_revision"`
+}
+
+// WebhookCreate is the API message for the remote of the Gerrit webhooks plugin.
+//
+// Docs: https://gerrit.googlesource.com/plugins/webhooks/+/refs/heads/master/src/main/resources/Documentation/rest-api-config.md
+type WebhookCreate struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	// SSLVerify verifies the SSL certificate of the URL.
+	SSLVerify bool `json:"ssl_verify"`
+}
+
+// ReviewInput is the API message for reviewing the revision.
+//
+// Docs: https://gerrit-review.googlesource.com/Documentation/rest-api-changes.html#review-input
+type ReviewInput struct {
+	Message  string                    `json:"message"`
+	Labels   map[string]int            `json:"labels,omitempty"`
+	Comments map[string][]CommentInput `json:"comments,omitempty"`
+	// StrictLabels is false to ignore the labels the user cannot vote on instead of failing the review.
+	StrictLabels bool `json:"strict_labels"`
+	// Tag marks the review as the automated review, which can be filtered in the Gerrit UI.
+	Tag string `json:"tag,omitempty"`
+}
+
+// CommentInput is the API message for the inline comment of the review.
+type CommentInput struct {
+	Line       int    `json:"line,omitempty"`
+	Message    string `json:"message"`
+	Unresolved bool   `json:"unresolved"`
+}
+
+// Event is the webhook event sent by the Gerrit webhooks plugin, which is the same as the stream event.
+//
+// Docs: https://gerrit-review.googlesource.com/Documentation/cmd-stream-events.html#events
+type Event struct {
+	Type           string          `json:"type"`
+	Change         EventChange     `json:"change"`
+	PatchSet       EventPatchSet   `json:"patchSet"`
+	Submitter      EventAccount    `json:"submitter"`
+	Uploader       EventAccount    `json:"uploader"`
+	NewRev         string          `json:"newRev"`
+	EventCreatedOn int64           `json:"eventCreatedOn"`
+	RefUpdate      *EventRefUpdate `json:"refUpdate,omitempty"`
+}
+
+// EventChange is the change of the webhook event.
+type EventChange struct {
+	Project       string       `json:"project"`
+	Branch        string       `json:"branch"`
+	ID            string       `json:"id"`
+	Number        int          `json:"number"`
+	Subject       string       `json:"subject"`
+	Owner         EventAccount `json:"owner"`
+	URL           string       `json:"url"`
+	CommitMessage string       `json:"commitMessage"`
+}
+
+// EventPatchSet is the patch set of the webhook event.
+type EventPatchSet struct {
+	Number   int          `json:"number"`
+	Revision string       `json:"revision"`
+	Parents  []string     `json:"parents"`
+	Ref      string       `json:"ref"`
+	Author   EventAccount `json:"author"`
+}
+
+// EventAccount is the account of the webhook event.
+type EventAccount struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Username string `json:"username"`
+}
+
+// EventRefUpdate is the ref update of the ref-updated webhook event.
+type EventRefUpdate struct {
+	OldRev  string `json:"oldRev"`
+	NewRev  string `json:"newRev"`
+	RefName string `json:"refName"`
+	Project string `json:"project"`
+}
+
+const (
+	// EventChangeMerged is the event type of the merged change.
+	EventChangeMerged = "change-merged"
+	// EventPatchSetCreated is the event type of the uploaded patch set.
+	EventPatchSetCreated = "patchset-created"
+)
+
+// GetChangeID returns the change ID used in the REST API, which is "<project>~<number>".
+func (e *Event) GetChangeID() string {
+	return fmt.Sprintf("%s~%d", e.Change.Project, e.Change.Number)
+}
+
+// ExchangeOAuthToken is not supported because Gerrit authenticates with the HTTP credentials.
+func (*Provider) ExchangeOAuthToken(context.Context, string, *common.OAuthExchange) (*vcs.OAuthToken, error) {
+	return nil, errors.New("Gerrit doesn't support OAuth, use the HTTP credentials as the access token instead")
+}
+
+// FetchCommitByID fetches the commit data by its ID from the repository.
+//
+// Docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#get-commit
+func (p *Provider) FetchCommitByID(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, repositoryID, commitID string) (*vcs.Commit, error) {
+	commit, err := p.getCommit(ctx, oauthCtx, instanceURL, repositoryID, commitID)
+	if err != nil {
+		return nil, err
+	}
+	return &vcs.Commit{
+		ID:          commit.Commit,
+		Title:       commit.Subject,
+		Message:     commit.Message,
+		AuthorName:  commit.Author.Name,
+		AuthorEmail: commit.Author.Email,
+	}, nil
+}
+
+func (p *Provider) getCommit(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, repositoryID, commitID string) (*CommitInfo, error) {
+	url := fmt.Sprintf("%s/projects/%s/commits/%s", p.APIURL(instanceURL), url.PathEscape(repositoryID), url.PathEscape(commitID))
+	var commit CommitInfo
+	if err := get(ctx, p.client, oauthCtx, url, &commit); err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch commit %s", commitID)
+	}
+	return &commit, nil
+}
+
+// GetDiffFileList gets the diff files list between two commits.
+// Gerrit only compares the commit with its parent, so the before commit must be the parent of the after commit.
+//
+// Docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#list-files
+func (p *Provider) GetDiffFileList(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, repositoryID, beforeCommit, afterCommit string) ([]vcs.FileDiff, error) {
+	commit, err := p.getCommit(ctx, oauthCtx, instanceURL, repositoryID, afterCommit)
+	if err != nil {
+		return nil, err
+	}
+	parent := 0
+	for i, c := range commit.Parents {
+		if c.Commit == beforeCommit {
+			parent = i + 1
+			break
+		}
+	}
+	if parent == 0 {
+		return nil, errors.Errorf("commit %s is not the parent of commit %s", beforeCommit, afterCommit)
+	}
+
+	url := fmt.Sprintf("%s/projects/%s/commits/%s/files/?parent=%d", p.APIURL(instanceURL), url.PathEscape(repositoryID), url.PathEscape(afterCommit), parent)
+	files := make(map[string]*FileInfo)
+	if err := get(ctx, p.client, oauthCtx, url, &files); err != nil {
+		return nil, errors.Wrapf(err, "failed to list files of commit %s", afterCommit)
+	}
+	var diffs []vcs.FileDiff
+	for _, filePath := range getSortedFilePaths(files) {
+		diff := vcs.FileDiff{
+			Path: filePath,
+			Type: vcs.FileDiffTypeModified,
+		}
+		switch files[filePath].Status {
+		case "A", "C", "R":
+			diff.Type = vcs.FileDiffTypeAdded
+		case "D":
+			diff.Type = vcs.FileDiffTypeRemoved
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
+
+// FetchAllRepositoryList fetches all the code projects visible to the user.
+//
+// Docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#list-projects
+func (p *Provider) FetchAllRepositoryList(ctx context.Context, oauthCtx *common.OauthContext, instanceURL string) ([]*vcs.Repository, error) {
+	url := fmt.Sprintf("%s/projects/?type=CODE", p.APIURL(instanceURL))
+	projects := make(map[string]*ProjectInfo)
+	if err := get(ctx, p.client, oauthCtx, url, &projects); err != nil {
+		return nil, errors.Wrap(err, "failed to list projects")
+	}
+	var names []string
+	for name, project := range projects {
+		if project.State == "HIDDEN" {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var repositories []*vcs.Repository
+	for _, name := range names {
+		repositories = append(repositories, &vcs.Repository{
+			ID:       name,
+			Name:     path.Base(name),
+			FullPath: name,
+			WebURL:   fmt.Sprintf("%s/admin/repos/%s", instanceURL, name),
+		})
+	}
+	return repositories, nil
+}
+
+// FetchRepositoryFileList is not supported because Gerrit doesn't provide the tree API.
+func (*Provider) FetchRepositoryFileList(context.Context, *common.OauthContext, string, string, string, string) ([]*vcs.RepositoryTreeNode, error) {
+	return nil, errors.New("Gerrit doesn't support listing repository files")
+}
+
+// CreateFile is not supported because the changes are pushed for review in Gerrit.
+func (*Provider) CreateFile(context.Context, *common.OauthContext, string, string, string, vcs.FileCommitCreate) error {
+	return errors.New("Gerrit doesn't support creating files")
+}
+
+// OverwriteFile is not supported because the changes are pushed for review in Gerrit.
+func (*Provider) OverwriteFile(context.Context, *common.OauthContext, string, string, string, vcs.FileCommitCreate) error {
+	return errors.New("Gerrit doesn't support overwriting files")
+}
+
+// ReadFileMeta reads the metadata of the given file in the repository.
+func (p *Provider) ReadFileMeta(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, repositoryID, filePath string, refInfo vcs.RefInfo) (*vcs.FileMeta, error) {
+	commitID, err := p.getRefCommitID(ctx, oauthCtx, instanceURL, repositoryID, refInfo)
+	if err != nil {
+		return nil, err
+	}
+	content, err := p.ReadFileContent(ctx, oauthCtx, instanceURL, repositoryID, filePath, vcs.RefInfo{RefType: vcs.RefTypeCommit, RefName: commitID})
+	if err != nil {
+		return nil, err
+	}
+	return &vcs.FileMeta{
+		Name:         path.Base(filePath),
+		Path:         filePath,
+		Size:         int64(len(content)),
+		LastCommitID: commitID,
+	}, nil
+}
+
+func (p *Provider) getRefCommitID(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, repositoryID string, refInfo vcs.RefInfo) (string, error) {
+	if refInfo.RefType == vcs.RefTypeCommit {
+		return refInfo.RefName, nil
+	}
+	resource := "branches"
+	if refInfo.RefType == vcs.RefTypeTag {
+		resource = "tags"
+	}
+	url := fmt.Sprintf("%s/projects/%s/%s/%s", p.APIURL(instanceURL), url.PathEscape(repositoryID), resource, url.PathEscape(refInfo.RefName))
+	var branch BranchInfo
+	if err := get(ctx, p.client, oauthCtx, url, &branch); err != nil {
+		return "", errors.Wrapf(err, "failed to get %s %s", refInfo.RefType, refInfo.RefName)
+	}
+	return branch.Revision, nil
+}
+
+// ReadFileContent reads the content of the given file in the repository.
+//
+// Docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#get-content-from-commit
+func (p *Provider) ReadFileContent(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, repositoryID, filePath string, refInfo vcs.RefInfo) (string, error) {
+	commitID, err := p.getRefCommitID(ctx, oauthCtx, instanceURL, repositoryID, refInfo)
+	if err != nil {
+		return "", err
+	}
+	url := fmt.Sprintf("%s/projects/%s/commits/%s/files/%s/content", p.APIURL(instanceURL), url.PathEscape(repositoryID), url.PathEscape(commitID), url.PathEscape(filePath))
+	code, body, err := do(ctx, p.client, oauthCtx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to read file content from URL %s", url)
+	} else if code >= 300 {
+		return "", errors.Errorf("failed to read file content from URL %s, status code: %d, body: %s", url, code, body)
+	}
+	// The file content is returned as the base64 encoded string.
+	content, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(body)))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to decode file content from URL %s", url)
+	}
+	return string(content), nil
+}
+
+// GetBranch gets the given branch in the repository.
+//
+// Docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#get-branch
+func (p *Provider) GetBranch(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, repositoryID, branchName string) (*vcs.BranchInfo, error) {
+	commitID, err := p.getRefCommitID(ctx, oauthCtx, instanceURL, repositoryID, vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: branchName})
+	if err != nil {
+		return nil, err
+	}
+	return &vcs.BranchInfo{
+		Name:         branchName,
+		LastCommitID: commitID,
+	}, nil
+}
+
+// CreateBranch creates the branch in the repository.
+//
+// Docs: https://gerrit-review.googlesource.com/Documentation/rest-api-projects.html#create-branch
+func (p *Provider) CreateBranch(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, repositoryID string, branch *vcs.BranchInfo) error {
+	body, err := json.Marshal(map[string]string{"revision": branch.LastCommitID})
+	if err != nil {
+		return errors.Wrap(err, "marshal branch create")
+	}
+	url := fmt.Sprintf("%s/projects/%s/branches/%s", p.APIURL(instanceURL), url.PathEscape(repositoryID), url.PathEscape(branch.Name))
+	code, resp, err := do(ctx, p.client, oauthCtx, http.MethodPut, url, body)
+	if err != nil {
+		return errors.Wrapf(err, "PUT %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to create branch from URL %s, status code: %d, body: %s", url, code, resp)
+	}
+	return nil
+}
+
+// ListPullRequestFile lists the changed files of the current revision in the change, and the pull request ID is the change ID.
+//
+// Docs: https://gerrit-review.googlesource.com/Documentation/rest-api-changes.html#list-files
+func (p *Provider) ListPullRequestFile(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, _, pullRequestID string) ([]*vcs.PullRequestFile, error) {
+	url := fmt.Sprintf("%s/changes/%s?o=CURRENT_REVISION", p.APIURL(instanceURL), url.PathEscape(pullRequestID))
+	var change ChangeInfo
+	if err := get(ctx, p.client, oauthCtx, url, &change); err != nil {
+		return nil, errors.Wrapf(err, "failed to get change %s", pullRequestID)
+	}
+	return ListRevisionFile(ctx, p.client, oauthCtx, instanceURL, pullRequestID, change.CurrentRevision)
+}
+
+// ListRevisionFile lists the changed files of the revision in the change.
+//
+// Docs: https://gerrit-review.googlesource.com/Documentation/rest-api-changes.html#list-files
+func ListRevisionFile(ctx context.Context, client *http.Client, oauthCtx *common.OauthContext, instanceURL, changeID, revision string) ([]*vcs.PullRequestFile, error) {
+	url := fmt.Sprintf("%s/changes/%s/revisions/%s/files/", (&Provider{}).APIURL(instanceURL), url.PathEscape(changeID), url.PathEscape(revision))
+	files := make(map[string]*FileInfo)
+	if err := get(ctx, client, oauthCtx, url, &files); err != nil {
+		return nil, errors.Wrapf(err, "failed to list files of change %s revision %s", changeID, revision)
+	}
+	var res []*vcs.PullRequestFile
+	for _, filePath := range getSortedFilePaths(files) {
+		res = append(res, &vcs.PullRequestFile{
+			Path:         filePath,
+			LastCommitID: revision,
+			IsDeleted:    files[filePath].Status == "D",
+		})
+	}
+	return res, nil
+}
+
+// CreatePullRequest is not supported because the changes are pushed for review in Gerrit.
+func (*Provider) CreatePullRequest(context.Context, *common.OauthContext, string, string, *vcs.PullRequestCreate) (*vcs.PullRequest, error) {
+	return nil, errors.New("Gerrit doesn't support creating changes")
+}
+
+// CreatePullRequestComment posts the comment as the review message on the current revision of the change, and the pull request ID is the change ID.
+func (p *Provider) CreatePullRequestComment(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, _, pullRequestID, comment string) error {
+	return SetReview(ctx, p.client, oauthCtx, instanceURL, pullRequestID, "current", &ReviewInput{Message: comment})
+}
+
+// UpsertEnvironmentVariable is a no-op because the SQL review of Gerrit is triggered by the webhook instead of the CI.
+func (*Provider) UpsertEnvironmentVariable(context.Context, *common.OauthContext, string, string, string, string) error {
+	return nil
+}
+
+// CreateWebhook creates the remote of the Gerrit webhooks plugin in the project, and returns the remote name as the webhook ID.
+//
+// Docs: https://gerrit.googlesource.com/plugins/webhooks/+/refs/heads/master/src/main/resources/Documentation/rest-api-config.md
+func (p *Provider) CreateWebhook(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, repositoryID string, payload []byte) (string, error) {
+	if err := p.PatchWebhook(ctx, oauthCtx, instanceURL, repositoryID, WebhookRemoteName, payload); err != nil {
+		return "", err
+	}
+	return WebhookRemoteName, nil
+}
+
+// PatchWebhook creates or updates the remote of the Gerrit webhooks plugin in the project.
+func (p *Provider) PatchWebhook(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, repositoryID, webhookID string, payload []byte) error {
+	url := fmt.Sprintf("%s/projects/%s/webhooks~remotes/%s", p.APIURL(instanceURL), url.PathEscape(repositoryID), url.PathEscape(webhookID))
+	code, resp, err := do(ctx, p.client, oauthCtx, http.MethodPut, url, payload)
+	if err != nil {
+		return errors.Wrapf(err, "PUT %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to put webhook from URL %s, status code: %d, body: %s", url, code, resp)
+	}
+	return nil
+}
+
+// DeleteWebhook deletes the remote of the Gerrit webhooks plugin in the project.
+func (p *Provider) DeleteWebhook(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, repositoryID, webhookID string) error {
+	url := fmt.Sprintf("%s/projects/%s/webhooks~remotes/%s", p.APIURL(instanceURL), url.PathEscape(repositoryID), url.PathEscape(webhookID))
+	code, resp, err := do(ctx, p.client, oauthCtx, http.MethodDelete, url, nil)
+	if err != nil {
+		return errors.Wrapf(err, "DELETE %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil
+	} else if code >= 300 {
+		return errors.Errorf("failed to delete webhook from URL %s, status code: %d, body: %s", url, code, resp)
+	}
+	return nil
+}
+
+// SetReview posts the review with the inline comments and the label votes on the revision of the change.
+//
+// Docs: https://gerrit-review.googlesource.com/Documentation/rest-api-changes.html#set-review
+func SetReview(ctx context.Context, client *http.Client, oauthCtx *common.OauthContext, instanceURL, changeID, revision string, review *ReviewInput) error {
+	body, err := json.Marshal(review)
+	if err != nil {
+		return errors.Wrap(err, "marshal review")
+	}
+	url := fmt.Sprintf("%s/changes/%s/revisions/%s/review", (&Provider{}).APIURL(instanceURL), url.PathEscape(changeID), url.PathEscape(revision))
+	code, resp, err := do(ctx, client, oauthCtx, http.MethodPost, url, body)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to set review from URL %s, status code: %d, body: %s", url, code, resp)
+	}
+	return nil
+}
+
+// get sends the GET request and decodes the JSON response.
+func get(ctx context.Context, client *http.Client, oauthCtx *common.OauthContext, url string, result any) error {
+	code, body, err := do(ctx, client, oauthCtx, http.MethodGet, url, nil)
+	if err != nil {
+		return errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to get from URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to get from URL %s, status code: %d, body: %s", url, code, body)
+	}
+	return json.Unmarshal(bytes.TrimPrefix(body, []byte(magicPrefix)), result)
+}
+
+// do sends the request authenticated by the HTTP credentials.
+func do(ctx context.Context, client *http.Client, oauthCtx *common.OauthContext, method, url string, body []byte) (int, []byte, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return 0, nil, errors.Wrapf(err, "construct %s %s", method, url)
+	}
+	username, password, err := getHTTPCredentials(oauthCtx)
+	if err != nil {
+		return 0, nil, err
+	}
+	req.SetBasicAuth(username, password)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, errors.Wrapf(err, "read response body with status code %d", resp.StatusCode)
+	}
+	return resp.StatusCode, respBody, nil
+}
+
+// getHTTPCredentials returns the HTTP credentials from the access token in the format of "username:http-password",
+// or from the client ID and secret of the VCS if the access token is empty.
+func getHTTPCredentials(oauthCtx *common.OauthContext) (string, string, error) {
+	if oauthCtx.AccessToken == "" {
+		if oauthCtx.ClientID == "" {
+			return "", "", errors.New("the Gerrit HTTP credentials are missing")
+		}
+		return oauthCtx.ClientID, oauthCtx.ClientSecret, nil
+	}
+	username, password, ok := strings.Cut(oauthCtx.AccessToken, ":")
+	if !ok {
+		return "", "", errors.New(`the Gerrit access token should be the HTTP credentials in the format of "username:http-password"`)
+	}
+	return username, password, nil
+}
+
+func getSortedFilePaths(files map[string]*FileInfo) []string {
+	var paths []string
+	for filePath := range files {
+		if filePath == commitMessageFile || filePath == mergeListFile {
+			continue
+		}
+		paths = append(paths, filePath)
+	}
+	sort.Strings(paths)
+	return paths
+}
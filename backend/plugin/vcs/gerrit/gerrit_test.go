@@ -0,0 +1,168 @@
+package gerrit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+)
+
+func TestProvider_FetchAllRepositoryList(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/a/projects/", r.URL.Path)
+		assert.Equal(t, "CODE", r.URL.Query().Get("type"))
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "bytebase", username)
+		assert.Equal(t, "secret", password)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`)]}'
+{
+  "platform/db": {"id": "platform%2Fdb", "state": "ACTIVE"},
+  "archived": {"id": "archived", "state": "HIDDEN"},
+  "app": {"id": "app", "state": "ACTIVE"}
+}
+`)),
+		}, nil
+	})
+
+	got, err := p.FetchAllRepositoryList(context.Background(), &common.OauthContext{AccessToken: "bytebase:secret"}, "https://gerrit.example.com")
+	require.NoError(t, err)
+	want := []*vcs.Repository{
+		{
+			ID:       "app",
+			Name:     "app",
+			FullPath: "app",
+			WebURL:   "https://gerrit.example.com/admin/repos/app",
+		},
+		{
+			ID:       "platform/db",
+			Name:     "db",
+			FullPath: "platform/db",
+			WebURL:   "https://gerrit.example.com/admin/repos/platform/db",
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestListRevisionFile(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/a/changes/platform%2Fdb~42/revisions/abc/files/", r.URL.EscapedPath())
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`)]}'
+{
+  "/COMMIT_MSG": {"status": "A"},
+  "migrations/prod/db__202310011200__migrate__create_t.sql": {"status": "A"},
+  "migrations/prod/db__202309011200__migrate__drop_t.sql": {"status": "D"}
+}
+`)),
+		}, nil
+	})
+
+	got, err := ListRevisionFile(context.Background(), p.(*Provider).client, &common.OauthContext{AccessToken: "bytebase:secret"}, "https://gerrit.example.com", "platform/db~42", "abc")
+	require.NoError(t, err)
+	want := []*vcs.PullRequestFile{
+		{
+			Path:         "migrations/prod/db__202309011200__migrate__drop_t.sql",
+			LastCommitID: "abc",
+			IsDeleted:    true,
+		},
+		{
+			Path:         "migrations/prod/db__202310011200__migrate__create_t.sql",
+			LastCommitID: "abc",
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_ReadFileContent(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		switch r.URL.EscapedPath() {
+		case "/a/projects/app/branches/main":
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`)]}'` + "\n" + `{"ref": "refs/heads/main", "revision": "abc"}`)),
+			}, nil
+		case "/a/projects/app/commits/abc/files/migrations%2Fcreate_t.sql/content":
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				// "CREATE TABLE t (id INT);" in base64.
+				Body: io.NopCloser(strings.NewReader("Q1JFQVRFIFRBQkxFIHQgKGlkIElOVCk7")),
+			}, nil
+		}
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader("Not found")),
+		}, nil
+	})
+
+	got, err := p.ReadFileContent(context.Background(), &common.OauthContext{AccessToken: "bytebase:secret"}, "https://gerrit.example.com", "app", "migrations/create_t.sql", vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: "main"})
+	require.NoError(t, err)
+	assert.Equal(t, "CREATE TABLE t (id INT);", got)
+
+	_, err = p.ReadFileContent(context.Background(), &common.OauthContext{AccessToken: "bytebase:secret"}, "https://gerrit.example.com", "app", "missing.sql", vcs.RefInfo{RefType: vcs.RefTypeCommit, RefName: "abc"})
+	assert.True(t, common.ErrorCode(err) == common.NotFound)
+}
+
+func TestSetReview(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/a/changes/app~7/revisions/abc/review", r.URL.EscapedPath())
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var review ReviewInput
+		require.NoError(t, json.Unmarshal(body, &review))
+		assert.Equal(t, -1, review.Labels[SQLReviewLabel])
+		assert.Equal(t, []CommentInput{{Line: 3, Message: "error", Unresolved: true}}, review.Comments["create_t.sql"])
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`)]}'` + "\n" + `{"labels": {"Verified": -1}}`)),
+		}, nil
+	})
+
+	err := SetReview(context.Background(), p.(*Provider).client, &common.OauthContext{AccessToken: "bytebase:secret"}, "https://gerrit.example.com", "app~7", "abc", &ReviewInput{
+		Message: "SQL review failed",
+		Labels:  map[string]int{SQLReviewLabel: -1},
+		Comments: map[string][]CommentInput{
+			"create_t.sql": {{Line: 3, Message: "error", Unresolved: true}},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestGetHTTPCredentials(t *testing.T) {
+	username, password, err := getHTTPCredentials(&common.OauthContext{AccessToken: "bytebase:pass:word"})
+	require.NoError(t, err)
+	assert.Equal(t, "bytebase", username)
+	assert.Equal(t, "pass:word", password)
+
+	username, password, err = getHTTPCredentials(&common.OauthContext{ClientID: "bot", ClientSecret: "secret"})
+	require.NoError(t, err)
+	assert.Equal(t, "bot", username)
+	assert.Equal(t, "secret", password)
+
+	_, _, err = getHTTPCredentials(&common.OauthContext{AccessToken: "token"})
+	require.Error(t, err)
+}
+
+func newMockProvider(mockRoundTrip func(r *http.Request) (*http.Response, error)) vcs.Provider {
+	return newProvider(
+		vcs.ProviderConfig{
+			Client: &http.Client{
+				Transport: &common.MockRoundTripper{
+					MockRoundTrip: mockRoundTrip,
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,148 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/vcs/internal/oauth"
+)
+
+const (
+	// SQLReviewCheckRunName is the name of the SQL review check run, which can be added as the required status check in the branch protection rules.
+	SQLReviewCheckRunName = "Bytebase SQL Review"
+
+	// maxCheckRunAnnotations is the max annotations count in one check run request.
+	maxCheckRunAnnotations = 50
+)
+
+// CheckRunConclusion is the conclusion of the check run.
+type CheckRunConclusion string
+
+const (
+	// CheckRunSuccess is the conclusion of the passed check run.
+	CheckRunSuccess CheckRunConclusion = "success"
+	// CheckRunNeutral is the conclusion of the check run with warnings, which doesn't block the merge.
+	CheckRunNeutral CheckRunConclusion = "neutral"
+	// CheckRunFailure is the conclusion of the failed check run, which blocks the merge if the check is required.
+	CheckRunFailure CheckRunConclusion = "failure"
+)
+
+// CheckRunAnnotationLevel is the level of the check run annotation.
+type CheckRunAnnotationLevel string
+
+const (
+	// CheckRunAnnotationWarning is the warning annotation level.
+	CheckRunAnnotationWarning CheckRunAnnotationLevel = "warning"
+	// CheckRunAnnotationFailure is the failure annotation level.
+	CheckRunAnnotationFailure CheckRunAnnotationLevel = "failure"
+)
+
+// CheckRunCreate is the API message to create the completed check run.
+type CheckRunCreate struct {
+	Name       string             `json:"name"`
+	HeadSHA    string             `json:"head_sha"`
+	Status     string             `json:"status"`
+	Conclusion CheckRunConclusion `json:"conclusion"`
+	DetailsURL string             `json:"details_url,omitempty"`
+	Output     *CheckRunOutput    `json:"output"`
+}
+
+// CheckRunUpdate is the API message to update the check run.
+type CheckRunUpdate struct {
+	Output *CheckRunOutput `json:"output"`
+}
+
+// CheckRunOutput is the API message for the check run output.
+type CheckRunOutput struct {
+	Title       string                `json:"title"`
+	Summary     string                `json:"summary"`
+	Annotations []*CheckRunAnnotation `json:"annotations,omitempty"`
+}
+
+// CheckRunAnnotation is the API message for the check run annotation on the file lines.
+type CheckRunAnnotation struct {
+	Path            string                  `json:"path"`
+	StartLine       int                     `json:"start_line"`
+	EndLine         int                     `json:"end_line"`
+	AnnotationLevel CheckRunAnnotationLevel `json:"annotation_level"`
+	Title           string                  `json:"title"`
+	Message         string                  `json:"message"`
+}
+
+// CheckRun is the API message for the check run.
+type CheckRun struct {
+	ID      int64  `json:"id"`
+	HTMLURL string `json:"html_url"`
+}
+
+// CreateCheckRun creates the completed check run with the annotations.
+// GitHub only allows the GitHub Apps to create the check runs, so the token should be the installation token,
+// e.g. the GITHUB_TOKEN of the GitHub action with the "checks: write" permission.
+// The annotations exceeding the limit of one request are appended by updating the check run.
+//
+// Docs: https://docs.github.com/en/rest/checks/runs#create-a-check-run
+func CreateCheckRun(ctx context.Context, client *http.Client, instanceURL, repositoryID, token string, create *CheckRunCreate) (*CheckRun, error) {
+	apiURL := (&Provider{}).APIURL(instanceURL)
+	annotations := create.Output.Annotations
+	first := *create
+	firstOutput := *create.Output
+	firstOutput.Annotations = annotations[:min(len(annotations), maxCheckRunAnnotations)]
+	first.Output = &firstOutput
+
+	var checkRun CheckRun
+	if err := sendCheckRunRequest(ctx, client, http.MethodPost, fmt.Sprintf("%s/repos/%s/check-runs", apiURL, repositoryID), token, &first, &checkRun); err != nil {
+		return nil, err
+	}
+	// The annotations of the updates are appended to the check run.
+	//
+	// Docs: https://docs.github.com/en/rest/checks/runs#update-a-check-run
+	for i := maxCheckRunAnnotations; i < len(annotations); i += maxCheckRunAnnotations {
+		update := &CheckRunUpdate{
+			Output: &CheckRunOutput{
+				Title:       create.Output.Title,
+				Summary:     create.Output.Summary,
+				Annotations: annotations[i:min(len(annotations), i+maxCheckRunAnnotations)],
+			},
+		}
+		if err := sendCheckRunRequest(ctx, client, http.MethodPatch, fmt.Sprintf("%s/repos/%s/check-runs/%d", apiURL, repositoryID, checkRun.ID), token, update, nil); err != nil {
+			return nil, err
+		}
+	}
+	return &checkRun, nil
+}
+
+func sendCheckRunRequest(ctx context.Context, client *http.Client, method, url, token string, payload, result any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal check run")
+	}
+	// The installation token cannot be refreshed.
+	refresher := func(context.Context, *http.Client, *string) error {
+		return errors.Errorf("the check run token is expired or invalid")
+	}
+	var code int
+	var resp string
+	switch method {
+	case http.MethodPost:
+		code, _, resp, err = oauth.Post(ctx, client, url, &token, bytes.NewReader(body), refresher)
+	case http.MethodPatch:
+		code, _, resp, err = oauth.Patch(ctx, client, url, &token, bytes.NewReader(body), refresher)
+	default:
+		return errors.Errorf("unsupported method %s", method)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "%s %s", method, url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to %s check run from URL %s, status code: %d, body: %s", method, url, code, resp)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal([]byte(resp), result)
+}
@@ -1020,6 +1020,53 @@ func (p *Provider) CreatePullRequest(ctx context.Context, oauthCtx *common.Oauth
 	}, nil
 }
 
+// PullRequestCommentCreate is the API message to create the pull request comment.
+type PullRequestCommentCreate struct {
+	Body string `json:"body"`
+}
+
+// CreatePullRequestComment creates the comment in the pull request, which is the issue comment in GitHub.
+//
+// Docs: https://docs.github.com/en/rest/issues/comments#create-an-issue-comment
+func (p *Provider) CreatePullRequestComment(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, repositoryID, pullRequestID, comment string) error {
+	body, err := json.Marshal(PullRequestCommentCreate{Body: comment})
+	if err != nil {
+		return errors.Wrap(err, "marshal pull request comment create")
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/issues/%s/comments", p.APIURL(instanceURL), repositoryID, pullRequestID)
+	code, _, resp, err := oauth.Post(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		bytes.NewReader(body),
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create pull request comment from URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create pull request comment from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			resp,
+		)
+	}
+	return nil
+}
+
 // RepositorySecretUpdate is the API message to update the repository secret.
 type RepositorySecretUpdate struct {
 	EncryptedValue string `json:"encrypted_value"`
@@ -946,6 +946,24 @@ func TestProvider_CreatePullRequest(t *testing.T) {
 	assert.Equal(t, "https://github.com/octocat/Hello-World/pull/1347", res.URL)
 }
 
+func TestProvider_CreatePullRequestComment(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/repos/octocat/Hello-World/issues/1347/comments", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"body": "### Bytebase Plan"}`, string(body))
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(`{"id": 1, "body": "### Bytebase Plan"}`)),
+		}, nil
+	},
+	)
+
+	err := p.CreatePullRequestComment(context.Background(), &common.OauthContext{}, githubComURL, "octocat/Hello-World", "1347", "### Bytebase Plan")
+	require.NoError(t, err)
+}
+
 func TestProvider_UpsertEnvironmentVariable(t *testing.T) {
 	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
 		switch r.URL.Path {
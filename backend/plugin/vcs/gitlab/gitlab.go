@@ -859,6 +859,53 @@ func (p *Provider) CreatePullRequest(ctx context.Context, oauthCtx *common.Oauth
 	}, nil
 }
 
+// MergeRequestNoteCreate is the API message to create the merge request note.
+type MergeRequestNoteCreate struct {
+	Body string `json:"body"`
+}
+
+// CreatePullRequestComment creates the note in the merge request, and the pull request ID is the merge request IID.
+//
+// Docs: https://docs.gitlab.com/ee/api/notes.html#create-new-merge-request-note
+func (p *Provider) CreatePullRequestComment(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, repositoryID, pullRequestID, comment string) error {
+	body, err := json.Marshal(MergeRequestNoteCreate{Body: comment})
+	if err != nil {
+		return errors.Wrap(err, "marshal merge request note create")
+	}
+
+	url := fmt.Sprintf("%s/projects/%s/merge_requests/%s/notes", p.APIURL(instanceURL), repositoryID, pullRequestID)
+	code, _, resp, err := oauth.Post(
+		ctx,
+		p.client,
+		url,
+		&oauthCtx.AccessToken,
+		bytes.NewReader(body),
+		tokenRefresher(
+			instanceURL,
+			oauthContext{
+				ClientID:     oauthCtx.ClientID,
+				ClientSecret: oauthCtx.ClientSecret,
+				RefreshToken: oauthCtx.RefreshToken,
+			},
+			oauthCtx.Refresher,
+		),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create merge request note from URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create merge request note from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			resp,
+		)
+	}
+	return nil
+}
+
 // EnvironmentVariable is the API message for environment variable in GitLab project.
 type EnvironmentVariable struct {
 	Key   string `json:"key"`
@@ -681,6 +681,24 @@ func TestProvider_CreatePullRequest(t *testing.T) {
 	assert.Equal(t, "http://gitlab.example.com/my-group/my-project/merge_requests/1", res.URL)
 }
 
+func TestProvider_CreatePullRequestComment(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v4/projects/1/merge_requests/7/notes", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"body": "### Bytebase Plan"}`, string(body))
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(`{"id": 302, "body": "### Bytebase Plan"}`)),
+		}, nil
+	},
+	)
+
+	err := p.CreatePullRequestComment(context.Background(), &common.OauthContext{}, "", "1", "7", "### Bytebase Plan")
+	require.NoError(t, err)
+}
+
 func TestProvider_UpsertEnvironmentVariable(t *testing.T) {
 	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
 		switch r.URL.Path {
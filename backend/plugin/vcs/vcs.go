@@ -26,6 +26,8 @@ const (
 	Bitbucket Type = "BITBUCKET"
 	// AzureDevOps is the VCS type for Azure DevOps.
 	AzureDevOps Type = "AZURE_DEVOPS"
+	// Gerrit is the VCS type for Gerrit Code Review.
+	Gerrit Type = "GERRIT"
 
 	// SQLReviewAPISecretName is the api secret name used in GitHub action or GitLab CI workflow.
 	SQLReviewAPISecretName = "SQL_REVIEW_API_SECRET"
@@ -305,6 +307,14 @@ type Provider interface {
 	ListPullRequestFile(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, repositoryID, pullRequestID string) ([]*PullRequestFile, error)
 	// pullRequestCreate: the new pull request info
 	CreatePullRequest(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, repositoryID string, pullRequestCreate *PullRequestCreate) (*PullRequest, error)
+	// CreatePullRequestComment creates the comment in the pull request.
+	//
+	// oauthCtx: OAuth context to create the comment
+	// instanceURL: VCS instance URL
+	// repositoryID: the repository ID from the external VCS system (note this is NOT the ID of Bytebase's own repository resource)
+	// pullRequestID: the pull request id
+	// comment: the comment content in markdown
+	CreatePullRequestComment(ctx context.Context, oauthCtx *common.OauthContext, instanceURL, repositoryID, pullRequestID, comment string) error
 	// UpsertEnvironmentVariable creates or updates the environment variable in the repository.
 	//
 	// oauthCtx: OAuth context to create the webhook
@@ -2,13 +2,25 @@ package webhook
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"io"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
+const (
+	// signatureTimestampHeader is the header of the unix timestamp of the signed custom webhook request.
+	signatureTimestampHeader = "X-Bytebase-Timestamp"
+	// signatureHeader is the header of the HMAC-SHA256 signature of the custom webhook request.
+	signatureHeader = "X-Bytebase-Signature-256"
+)
+
 // CustomWebhookResponse is the API message for Custom webhook response.
 type CustomWebhookResponse struct {
 	Code    int    `json:"code"`
@@ -37,21 +49,7 @@ func init() {
 type CustomReceiver struct{}
 
 func (*CustomReceiver) post(context Context) error {
-	// TODO(p0ny): handle context.Task
-	payload := CustomWebhookRequest{
-		Level:        context.Level,
-		ActivityType: context.ActivityType,
-		Title:        context.Title,
-		Description:  context.Description,
-		Link:         context.Link,
-		CreatorID:    context.CreatorID,
-		CreatorName:  context.CreatorName,
-		CreatedTS:    context.CreatedTs,
-		Issue:        context.Issue,
-		Project:      context.Project,
-	}
-
-	body, err := json.Marshal(&payload)
+	body, err := getCustomWebhookBody(context)
 	if err != nil {
 		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
 	}
@@ -62,6 +60,14 @@ func (*CustomReceiver) post(context Context) error {
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if context.PayloadVersion != PayloadVersionLegacy {
+		req.Header.Set(payloadVersionHeader, strconv.Itoa(context.PayloadVersion))
+	}
+	if context.SigningSecret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(signatureTimestampHeader, timestamp)
+		req.Header.Set(signatureHeader, SignPayload(context.SigningSecret, timestamp, body))
+	}
 	client := &http.Client{
 		Timeout: timeout,
 	}
@@ -91,3 +97,40 @@ func (*CustomReceiver) post(context Context) error {
 
 	return nil
 }
+
+func getCustomWebhookBody(context Context) ([]byte, error) {
+	switch context.PayloadVersion {
+	case PayloadVersionLegacy:
+		// TODO(p0ny): handle context.Task
+		payload := CustomWebhookRequest{
+			Level:        context.Level,
+			ActivityType: context.ActivityType,
+			Title:        context.Title,
+			Description:  context.Description,
+			Link:         context.Link,
+			CreatorID:    context.CreatorID,
+			CreatorName:  context.CreatorName,
+			CreatedTS:    context.CreatedTs,
+			Issue:        context.Issue,
+			Project:      context.Project,
+		}
+		return json.Marshal(&payload)
+	case PayloadVersionV1:
+		payload, err := getPayloadV1(context)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(payload)
+	default:
+		return nil, errors.Errorf("unsupported payload version %d", context.PayloadVersion)
+	}
+}
+
+// SignPayload returns the signature of the custom webhook request, which is "sha256=" followed by the hex HMAC-SHA256 of "{timestamp}.{body}".
+// The receivers should verify the signature and reject the stale timestamps to prevent the replay attacks.
+func SignPayload(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,193 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// GoogleChatWebhookResponse is the API message for Google Chat webhook response.
+type GoogleChatWebhookResponse struct {
+	Error *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// GoogleChatWebhookOpenLink is the API message for Google Chat webhook open link.
+type GoogleChatWebhookOpenLink struct {
+	URL string `json:"url"`
+}
+
+// GoogleChatWebhookOnClick is the API message for Google Chat webhook on click.
+type GoogleChatWebhookOnClick struct {
+	OpenLink GoogleChatWebhookOpenLink `json:"openLink"`
+}
+
+// GoogleChatWebhookButton is the API message for Google Chat webhook button.
+type GoogleChatWebhookButton struct {
+	Text    string                   `json:"text"`
+	OnClick GoogleChatWebhookOnClick `json:"onClick"`
+}
+
+// GoogleChatWebhookButtonList is the API message for Google Chat webhook button list.
+type GoogleChatWebhookButtonList struct {
+	ButtonList []GoogleChatWebhookButton `json:"buttons"`
+}
+
+// GoogleChatWebhookTextParagraph is the API message for Google Chat webhook text paragraph.
+type GoogleChatWebhookTextParagraph struct {
+	Text string `json:"text"`
+}
+
+// GoogleChatWebhookDecoratedText is the API message for Google Chat webhook decorated text.
+type GoogleChatWebhookDecoratedText struct {
+	TopLabel string `json:"topLabel"`
+	Text     string `json:"text"`
+	WrapText bool   `json:"wrapText"`
+}
+
+// GoogleChatWebhookWidget is the API message for Google Chat webhook widget, only one of the fields is set.
+type GoogleChatWebhookWidget struct {
+	TextParagraph *GoogleChatWebhookTextParagraph `json:"textParagraph,omitempty"`
+	DecoratedText *GoogleChatWebhookDecoratedText `json:"decoratedText,omitempty"`
+	ButtonList    *GoogleChatWebhookButtonList    `json:"buttonList,omitempty"`
+}
+
+// GoogleChatWebhookSection is the API message for Google Chat webhook section.
+type GoogleChatWebhookSection struct {
+	WidgetList []GoogleChatWebhookWidget `json:"widgets"`
+}
+
+// GoogleChatWebhookCardHeader is the API message for Google Chat webhook card header.
+type GoogleChatWebhookCardHeader struct {
+	Title    string `json:"title"`
+	Subtitle string `json:"subtitle"`
+}
+
+// GoogleChatWebhookCard is the API message for Google Chat webhook card.
+type GoogleChatWebhookCard struct {
+	Header      GoogleChatWebhookCardHeader `json:"header"`
+	SectionList []GoogleChatWebhookSection  `json:"sections"`
+}
+
+// GoogleChatWebhookCardV2 is the API message for Google Chat webhook card with the ID.
+type GoogleChatWebhookCardV2 struct {
+	CardID string                `json:"cardId"`
+	Card   GoogleChatWebhookCard `json:"card"`
+}
+
+// GoogleChatWebhook is the API message for Google Chat webhook.
+type GoogleChatWebhook struct {
+	Text       string                    `json:"text"`
+	CardV2List []GoogleChatWebhookCardV2 `json:"cardsV2"`
+}
+
+func init() {
+	register("bb.plugin.webhook.googlechat", &GoogleChatReceiver{})
+}
+
+// GoogleChatReceiver is the receiver for Google Chat.
+type GoogleChatReceiver struct {
+}
+
+func (*GoogleChatReceiver) post(context Context) error {
+	status := ""
+	switch context.Level {
+	case WebhookSuccess:
+		status = "✅ "
+	case WebhookWarn:
+		status = "⚠️ "
+	case WebhookError:
+		status = "❗ "
+	}
+
+	widgetList := []GoogleChatWebhookWidget{}
+	for _, meta := range context.getMetaList() {
+		widgetList = append(widgetList, GoogleChatWebhookWidget{
+			DecoratedText: &GoogleChatWebhookDecoratedText{
+				TopLabel: meta.Name,
+				Text:     meta.Value,
+				WrapText: true,
+			},
+		})
+	}
+	if context.Description != "" {
+		widgetList = append(widgetList, GoogleChatWebhookWidget{
+			TextParagraph: &GoogleChatWebhookTextParagraph{
+				Text: context.Description,
+			},
+		})
+	}
+	widgetList = append(widgetList, GoogleChatWebhookWidget{
+		ButtonList: &GoogleChatWebhookButtonList{
+			ButtonList: []GoogleChatWebhookButton{
+				{
+					Text: "View in Bytebase",
+					OnClick: GoogleChatWebhookOnClick{
+						OpenLink: GoogleChatWebhookOpenLink{URL: context.Link},
+					},
+				},
+			},
+		},
+	})
+
+	post := GoogleChatWebhook{
+		Text: fmt.Sprintf("%s%s", status, context.Title),
+		CardV2List: []GoogleChatWebhookCardV2{
+			{
+				CardID: "bytebase",
+				Card: GoogleChatWebhookCard{
+					Header: GoogleChatWebhookCardHeader{
+						Title:    context.Title,
+						Subtitle: fmt.Sprintf("By: %s (%s)", context.CreatorName, context.CreatorEmail),
+					},
+					SectionList: []GoogleChatWebhookSection{
+						{WidgetList: widgetList},
+					},
+				},
+			},
+		},
+	}
+	body, err := json.Marshal(post)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
+	}
+	req, err := http.NewRequest("POST",
+		context.URL, bytes.NewBuffer(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
+	}
+
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	client := &http.Client{
+		Timeout: timeout,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
+	}
+	defer resp.Body.Close()
+
+	webhookResponse := &GoogleChatWebhookResponse{}
+	if err := json.Unmarshal(b, webhookResponse); err != nil {
+		return errors.Wrapf(err, "malformed webhook response from %s", context.URL)
+	}
+	if webhookResponse.Error != nil {
+		return errors.Errorf("%s", webhookResponse.Error.Message)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
+	}
+
+	return nil
+}
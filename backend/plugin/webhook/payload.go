@@ -0,0 +1,192 @@
+package webhook
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// PayloadVersionLegacy is the legacy custom webhook payload whose shape might change between releases.
+	PayloadVersionLegacy = 0
+	// PayloadVersionV1 is the schema version 1 of the custom webhook payload.
+	PayloadVersionV1 = 1
+
+	// payloadVersionHeader is the header of the schema version of the custom webhook payload.
+	payloadVersionHeader = "X-Bytebase-Webhook-Version"
+)
+
+// payloadV1 is the custom webhook payload of schema version 1.
+// The fields must not be removed or changed, new fields are only added in the new schema versions.
+type payloadV1 struct {
+	Version      int                  `json:"version"`
+	Level        Level                `json:"level"`
+	ActivityType string               `json:"activityType"`
+	Title        string               `json:"title"`
+	Description  string               `json:"description"`
+	Link         string               `json:"link"`
+	Creator      payloadV1Creator     `json:"creator"`
+	CreateTime   string               `json:"createTime"`
+	Issue        *payloadV1Issue      `json:"issue,omitempty"`
+	Project      *payloadV1Project    `json:"project,omitempty"`
+	TaskResult   *payloadV1TaskResult `json:"taskResult,omitempty"`
+}
+
+type payloadV1Creator struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type payloadV1Issue struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Status      string `json:"status"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+type payloadV1Project struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type payloadV1TaskResult struct {
+	Name          string `json:"name"`
+	Status        string `json:"status"`
+	Detail        string `json:"detail"`
+	SkippedReason string `json:"skippedReason"`
+}
+
+// payloadV1Fields are the field paths of the payload of schema version 1.
+var payloadV1Fields = map[string]bool{
+	"level":                    true,
+	"activityType":             true,
+	"title":                    true,
+	"description":              true,
+	"link":                     true,
+	"creator":                  true,
+	"creator.id":               true,
+	"creator.name":             true,
+	"creator.email":            true,
+	"createTime":               true,
+	"issue":                    true,
+	"issue.id":                 true,
+	"issue.name":               true,
+	"issue.status":             true,
+	"issue.type":               true,
+	"issue.description":        true,
+	"project":                  true,
+	"project.id":               true,
+	"project.name":             true,
+	"taskResult":               true,
+	"taskResult.name":          true,
+	"taskResult.status":        true,
+	"taskResult.detail":        true,
+	"taskResult.skippedReason": true,
+}
+
+// ValidatePayloadFields validates the field paths of the custom webhook payload.
+func ValidatePayloadFields(version int, fields []string) error {
+	switch version {
+	case PayloadVersionLegacy:
+		if len(fields) > 0 {
+			return errors.Errorf("payload fields are not supported for the legacy payload")
+		}
+		return nil
+	case PayloadVersionV1:
+		for _, field := range fields {
+			if !payloadV1Fields[field] {
+				return errors.Errorf("invalid payload field %q", field)
+			}
+		}
+		return nil
+	default:
+		return errors.Errorf("unsupported payload version %d", version)
+	}
+}
+
+// getPayloadV1 returns the payload of schema version 1 only with the given fields.
+func getPayloadV1(context Context) (map[string]any, error) {
+	payload := payloadV1{
+		Version:      PayloadVersionV1,
+		Level:        context.Level,
+		ActivityType: context.ActivityType,
+		Title:        context.Title,
+		Description:  context.Description,
+		Link:         context.Link,
+		Creator: payloadV1Creator{
+			ID:    context.CreatorID,
+			Name:  context.CreatorName,
+			Email: context.CreatorEmail,
+		},
+		CreateTime: time.Unix(context.CreatedTs, 0).UTC().Format(time.RFC3339),
+	}
+	if v := context.Issue; v != nil {
+		payload.Issue = &payloadV1Issue{
+			ID:          v.ID,
+			Name:        v.Name,
+			Status:      v.Status,
+			Type:        v.Type,
+			Description: v.Description,
+		}
+	}
+	if v := context.Project; v != nil {
+		payload.Project = &payloadV1Project{
+			ID:   v.ID,
+			Name: v.Name,
+		}
+	}
+	if v := context.TaskResult; v != nil {
+		payload.TaskResult = &payloadV1TaskResult{
+			Name:          v.Name,
+			Status:        v.Status,
+			Detail:        v.Detail,
+			SkippedReason: v.SkippedReason,
+		}
+	}
+
+	// Convert to the map to select the fields by the json names.
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	m := map[string]any{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	if len(context.PayloadFields) == 0 {
+		return m, nil
+	}
+	selected := map[string]any{
+		"version": m["version"],
+	}
+	for _, field := range context.PayloadFields {
+		selectField(m, selected, strings.Split(field, "."))
+	}
+	return selected, nil
+}
+
+// selectField copies the value of the path from src to dst.
+func selectField(src, dst map[string]any, path []string) {
+	v, ok := src[path[0]]
+	if !ok {
+		return
+	}
+	if len(path) == 1 {
+		dst[path[0]] = v
+		return
+	}
+	srcChild, ok := v.(map[string]any)
+	if !ok {
+		return
+	}
+	dstChild, ok := dst[path[0]].(map[string]any)
+	if !ok {
+		dstChild = map[string]any{}
+		dst[path[0]] = dstChild
+	}
+	selectField(srcChild, dstChild, path[1:])
+}
@@ -6,10 +6,18 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/pkg/errors"
 )
 
+const (
+	// SlackActionApproveIssue is the action ID of the Slack button approving the issue, whose value is the issue ID.
+	SlackActionApproveIssue = "bb.issue.approve"
+	// SlackActionRejectIssue is the action ID of the Slack button rejecting the issue, whose value is the issue ID.
+	SlackActionRejectIssue = "bb.issue.reject"
+)
+
 // SlackWebhookBlockMarkdown is the API message for Slack webhook block markdown.
 type SlackWebhookBlockMarkdown struct {
 	Type string `json:"type"`
@@ -24,9 +32,12 @@ type SlackWebhookElementButton struct {
 
 // SlackWebhookElement is the API message for Slack webhook element.
 type SlackWebhookElement struct {
-	Type   string                    `json:"type"`
-	Button SlackWebhookElementButton `json:"text,omitempty"`
-	URL    string                    `json:"url,omitempty"`
+	Type     string                    `json:"type"`
+	Button   SlackWebhookElementButton `json:"text,omitempty"`
+	URL      string                    `json:"url,omitempty"`
+	ActionID string                    `json:"action_id,omitempty"`
+	Value    string                    `json:"value,omitempty"`
+	Style    string                    `json:"style,omitempty"`
 }
 
 // SlackWebhookBlock is the API message for Slack webhook block.
@@ -98,18 +109,44 @@ func (*SlackReceiver) post(context Context) error {
 		},
 	})
 
-	blockList = append(blockList, SlackWebhookBlock{
-		Type: "actions",
-		ElementList: []SlackWebhookElement{
-			{
+	elementList := []SlackWebhookElement{
+		{
+			Type: "button",
+			Button: SlackWebhookElementButton{
+				Type: "plain_text",
+				Text: "View in Bytebase",
+			},
+			URL: context.Link,
+		},
+	}
+	// The buttons are handled by the Bytebase Slack app, which approves or rejects the issue as the Slack user clicking them.
+	if context.ApprovalActions && context.Issue != nil {
+		elementList = append(elementList,
+			SlackWebhookElement{
 				Type: "button",
 				Button: SlackWebhookElementButton{
 					Type: "plain_text",
-					Text: "View in Bytebase",
+					Text: "Approve",
 				},
-				URL: context.Link,
+				ActionID: SlackActionApproveIssue,
+				Value:    strconv.Itoa(context.Issue.ID),
+				Style:    "primary",
 			},
-		},
+			SlackWebhookElement{
+				Type: "button",
+				Button: SlackWebhookElementButton{
+					Type: "plain_text",
+					Text: "Reject",
+				},
+				ActionID: SlackActionRejectIssue,
+				Value:    strconv.Itoa(context.Issue.ID),
+				Style:    "danger",
+			},
+		)
+	}
+	blockList = append(blockList, SlackWebhookBlock{
+		Type:        "actions",
+		ElementList: elementList,
 	})
 
 	post := SlackWebhook{
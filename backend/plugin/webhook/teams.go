@@ -10,44 +10,51 @@ import (
 	"github.com/pkg/errors"
 )
 
-var themeColor = "4f46e5"
+// TeamsWebhookCardFact is the API message for Teams adaptive card fact.
+type TeamsWebhookCardFact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
 
-// TeamsWebhookActionTarget is the API message for Teams webhook action target.
-type TeamsWebhookActionTarget struct {
-	OS  string `json:"os"`
-	URI string `json:"uri"`
+// TeamsWebhookCardElement is the API message for Teams adaptive card element.
+type TeamsWebhookCardElement struct {
+	Type     string                 `json:"type"`
+	Text     string                 `json:"text,omitempty"`
+	Weight   string                 `json:"weight,omitempty"`
+	Size     string                 `json:"size,omitempty"`
+	Color    string                 `json:"color,omitempty"`
+	IsSubtle bool                   `json:"isSubtle,omitempty"`
+	Wrap     bool                   `json:"wrap,omitempty"`
+	FactList []TeamsWebhookCardFact `json:"facts,omitempty"`
 }
 
-// TeamsWebhookAction is the API message for Teams webhook action.
-type TeamsWebhookAction struct {
-	Type       string                     `json:"@type"`
-	Name       string                     `json:"name"`
-	TargetList []TeamsWebhookActionTarget `json:"targets"`
+// TeamsWebhookCardAction is the API message for Teams adaptive card action.
+type TeamsWebhookCardAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
 }
 
-// TeamsWebhookSectionFact is the API message for Teams webhook section fact.
-type TeamsWebhookSectionFact struct {
-	Name  string `json:"name"`
-	Value string `json:"value"`
+// TeamsWebhookCard is the API message for Teams adaptive card.
+type TeamsWebhookCard struct {
+	Schema     string                    `json:"$schema"`
+	Type       string                    `json:"type"`
+	Version    string                    `json:"version"`
+	Body       []TeamsWebhookCardElement `json:"body"`
+	ActionList []TeamsWebhookCardAction  `json:"actions,omitempty"`
 }
 
-// TeamsWebhookSection is the API message for Teams webhook section.
-type TeamsWebhookSection struct {
-	ActivityTitle    string                    `json:"activityTitle"`
-	ActivitySubtitle string                    `json:"activitySubtitle"`
-	FactList         []TeamsWebhookSectionFact `json:"facts"`
-	Text             string                    `json:"text"`
+// TeamsWebhookAttachment is the API message for Teams webhook attachment.
+type TeamsWebhookAttachment struct {
+	ContentType string           `json:"contentType"`
+	Content     TeamsWebhookCard `json:"content"`
 }
 
 // TeamsWebhook is the API message for Teams webhook.
 type TeamsWebhook struct {
-	Type        string                `json:"@type"`
-	Context     string                `json:"@context"`
-	Summary     string                `json:"summary"`
-	ThemeColor  string                `json:"themeColor"`
-	Title       string                `json:"title"`
-	SectionList []TeamsWebhookSection `json:"sections"`
-	ActionList  []TeamsWebhookAction  `json:"potentialAction"`
+	Type           string                   `json:"type"`
+	Summary        string                   `json:"summary"`
+	AttachmentList []TeamsWebhookAttachment `json:"attachments"`
 }
 
 func init() {
@@ -55,47 +62,83 @@ func init() {
 }
 
 // TeamsReceiver is the receiver for Teams.
+// The message is posted as the adaptive card, which is supported by both the Workflows and the legacy incoming webhooks.
 type TeamsReceiver struct {
 }
 
 func (*TeamsReceiver) post(context Context) error {
-	factList := []TeamsWebhookSectionFact{}
+	// https://adaptivecards.io/explorer/TextBlock.html
+	color := "Default"
+	switch context.Level {
+	case WebhookSuccess:
+		color = "Good"
+	case WebhookWarn:
+		color = "Warning"
+	case WebhookError:
+		color = "Attention"
+	}
+	body := []TeamsWebhookCardElement{
+		{
+			Type:   "TextBlock",
+			Text:   context.Title,
+			Weight: "Bolder",
+			Size:   "Medium",
+			Color:  color,
+			Wrap:   true,
+		},
+		{
+			Type:     "TextBlock",
+			Text:     fmt.Sprintf("By: %s (%s)", context.CreatorName, context.CreatorEmail),
+			IsSubtle: true,
+			Wrap:     true,
+		},
+	}
+	factList := []TeamsWebhookCardFact{}
 	for _, meta := range context.getMetaList() {
-		factList = append(factList, TeamsWebhookSectionFact(meta))
+		factList = append(factList, TeamsWebhookCardFact{Title: meta.Name, Value: meta.Value})
+	}
+	if len(factList) > 0 {
+		body = append(body, TeamsWebhookCardElement{
+			Type:     "FactSet",
+			FactList: factList,
+		})
+	}
+	if context.Description != "" {
+		body = append(body, TeamsWebhookCardElement{
+			Type: "TextBlock",
+			Text: context.Description,
+			Wrap: true,
+		})
 	}
 
 	post := TeamsWebhook{
-		Type:       "MessageCard",
-		Context:    "https://schema.org/extensions",
-		Summary:    context.Title,
-		ThemeColor: themeColor,
-		Title:      context.Title,
-		SectionList: []TeamsWebhookSection{
+		Type:    "message",
+		Summary: context.Title,
+		AttachmentList: []TeamsWebhookAttachment{
 			{
-				ActivityTitle: fmt.Sprintf("%s (%s)", context.CreatorName, context.CreatorEmail),
-				FactList:      factList,
-				Text:          context.Description,
-			},
-		},
-		ActionList: []TeamsWebhookAction{
-			{
-				Type: "OpenUri",
-				Name: "View in Bytebase",
-				TargetList: []TeamsWebhookActionTarget{
-					{
-						OS:  "default",
-						URI: context.Link,
+				ContentType: "application/vnd.microsoft.card.adaptive",
+				Content: TeamsWebhookCard{
+					Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+					Type:    "AdaptiveCard",
+					Version: "1.4",
+					Body:    body,
+					ActionList: []TeamsWebhookCardAction{
+						{
+							Type:  "Action.OpenUrl",
+							Title: "View in Bytebase",
+							URL:   context.Link,
+						},
 					},
 				},
 			},
 		},
 	}
-	body, err := json.Marshal(post)
+	b, err := json.Marshal(post)
 	if err != nil {
 		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
 	}
 	req, err := http.NewRequest("POST",
-		context.URL, bytes.NewBuffer(body))
+		context.URL, bytes.NewBuffer(b))
 	if err != nil {
 		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
 	}
@@ -109,19 +152,22 @@ func (*TeamsReceiver) post(context Context) error {
 		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
 	}
 
-	b, err := io.ReadAll(resp.Body)
+	b, err = io.ReadAll(resp.Body)
 	if err != nil {
 		return errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
+	// The legacy incoming webhooks respond 200 with "1", and the Workflows webhooks respond 202 with the empty body.
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if string(b) != "1" {
+			return errors.Errorf("%.100s", string(b))
+		}
+	case http.StatusAccepted:
+	default:
 		return errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
 	}
 
-	if string(b) != "1" {
-		return errors.Errorf("%.100s", string(b))
-	}
-
 	return nil
 }
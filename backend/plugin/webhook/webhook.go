@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/bytebase/bytebase/backend/common"
 )
@@ -15,8 +16,19 @@ var (
 	receivers  = make(map[string]Receiver)
 	// Based on the local test, Teams sometimes cannot finish the request in 1 second, so use 3s.
 	timeout = 3 * time.Second
+
+	deliveries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bb",
+		Subsystem: "webhook",
+		Name:      "deliveries_total",
+		Help:      "The number of the webhook deliveries by the webhook type and the result of success or failure.",
+	}, []string{"type", "result"})
 )
 
+func init() {
+	prometheus.MustRegister(deliveries)
+}
+
 // meta is the webhook metadata.
 type meta struct {
 	Name  string
@@ -79,6 +91,15 @@ type Context struct {
 	Project             *Project
 	TaskResult          *TaskResult
 	MentionUsersByPhone []string
+	// ApprovalActions is whether the receivers supporting it post the actions approving and rejecting the issue.
+	ApprovalActions bool
+	// PayloadVersion is the schema version of the custom webhook payload.
+	PayloadVersion int
+	// PayloadFields are the field paths of the versioned custom webhook payload, all fields are sent if empty.
+	PayloadFields []string
+	// SigningSecret is the secret signing the custom webhook requests, which are not signed if empty.
+	// It's never serialized, so that it's not recorded in the webhook deliveries.
+	SigningSecret string `json:"-"`
 }
 
 // Receiver is the webhook receiver.
@@ -211,5 +232,10 @@ func Post(webhookType string, context Context) error {
 	if !ok {
 		return errors.Errorf("webhook: no applicable receiver for webhook type: %v", webhookType)
 	}
-	return r.post(context)
+	if err := r.post(context); err != nil {
+		deliveries.WithLabelValues(webhookType, "failure").Inc()
+		return err
+	}
+	deliveries.WithLabelValues(webhookType, "success").Inc()
+	return nil
 }
@@ -2,6 +2,10 @@ package webhook
 
 import (
 	"crypto/rand"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -122,3 +126,102 @@ func TestContext_getMetaList(t *testing.T) {
 		a.Equal(want, context.getMetaList())
 	})
 }
+
+func TestGetCustomWebhookBody(t *testing.T) {
+	a := require.New(t)
+	context := Context{
+		Level:        WebhookInfo,
+		ActivityType: "bb.issue.create",
+		Title:        "Issue created",
+		CreatorID:    101,
+		CreatorName:  "Alice",
+		CreatorEmail: "alice@example.com",
+		CreatedTs:    1700000000,
+		Issue: &Issue{
+			ID:   1,
+			Name: "issue",
+		},
+		Project: &Project{
+			ID:   2,
+			Name: "project",
+		},
+	}
+
+	body, err := getCustomWebhookBody(context)
+	a.NoError(err)
+	a.JSONEq(`{"level":"INFO","activity_type":"bb.issue.create","title":"Issue created","description":"","link":"","creator_id":101,"creator_name":"Alice","created_ts":1700000000,"issue":{"id":1,"name":"issue","status":"","type":"","description":""},"project":{"id":2,"name":"project"}}`, string(body))
+
+	context.PayloadVersion = PayloadVersionV1
+	context.PayloadFields = []string{"title", "creator.email", "issue.id", "taskResult"}
+	body, err = getCustomWebhookBody(context)
+	a.NoError(err)
+	a.JSONEq(`{"version":1,"title":"Issue created","creator":{"email":"alice@example.com"},"issue":{"id":1}}`, string(body))
+}
+
+func TestValidatePayloadFields(t *testing.T) {
+	a := require.New(t)
+	a.NoError(ValidatePayloadFields(PayloadVersionLegacy, nil))
+	a.Error(ValidatePayloadFields(PayloadVersionLegacy, []string{"title"}))
+	a.NoError(ValidatePayloadFields(PayloadVersionV1, []string{"title", "issue.name"}))
+	a.Error(ValidatePayloadFields(PayloadVersionV1, []string{"issue.unknown"}))
+	a.Error(ValidatePayloadFields(2, nil))
+}
+
+func TestGoogleChatReceiver(t *testing.T) {
+	a := require.New(t)
+	var post GoogleChatWebhook
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		a.NoError(err)
+		a.NoError(json.Unmarshal(body, &post))
+		_, _ = w.Write([]byte(`{"name":"spaces/AAA/messages/BBB"}`))
+	}))
+	defer server.Close()
+
+	receiver := &GoogleChatReceiver{}
+	a.NoError(receiver.post(Context{
+		URL:          server.URL,
+		Level:        WebhookError,
+		Title:        "Task run failed - task",
+		Link:         "https://bytebase.example.com/issue/issue-1",
+		CreatorName:  "Alice",
+		CreatorEmail: "alice@example.com",
+		Project: &Project{
+			ID:   2,
+			Name: "project",
+		},
+	}))
+	a.Equal("❗ Task run failed - task", post.Text)
+	a.Len(post.CardV2List, 1)
+	widgets := post.CardV2List[0].Card.SectionList[0].WidgetList
+	a.Len(widgets, 2)
+	a.Equal("Project", widgets[0].DecoratedText.TopLabel)
+	a.Equal("https://bytebase.example.com/issue/issue-1", widgets[1].ButtonList.ButtonList[0].OnClick.OpenLink.URL)
+}
+
+func TestCustomReceiverSignature(t *testing.T) {
+	a := require.New(t)
+	var header http.Header
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		header = r.Header
+		body, err = io.ReadAll(r.Body)
+		a.NoError(err)
+		_, _ = w.Write([]byte(`{"code":0}`))
+	}))
+	defer server.Close()
+
+	receiver := &CustomReceiver{}
+	a.NoError(receiver.post(Context{URL: server.URL, Title: "title"}))
+	a.Empty(header.Get(signatureHeader))
+
+	a.NoError(receiver.post(Context{URL: server.URL, Title: "title", SigningSecret: "secret"}))
+	timestamp := header.Get(signatureTimestampHeader)
+	a.NotEmpty(timestamp)
+	a.Equal(SignPayload("secret", timestamp, body), header.Get(signatureHeader))
+	a.NotContains(string(body), "secret")
+
+	// echo -n "1700000000.{}" | openssl dgst -sha256 -hmac secret
+	a.Equal("sha256=b8569b78799ff9e3cbff0fc2d63a33a2b57f3282abd07c37ae5e8e7d79a5f163", SignPayload("secret", "1700000000", []byte("{}")))
+}
@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
-	"math"
 	"reflect"
 	"sort"
 	"strconv"
@@ -33,7 +32,6 @@ import (
 	"github.com/bytebase/bytebase/backend/store"
 
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
-	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
 // Runner is the runner for finding approval templates for issues.
@@ -160,7 +158,7 @@ func (r *Runner) findApprovalTemplateForIssue(ctx context.Context, issue *store.
 			return nil, false, nil
 		}
 
-		approvalTemplate, err := getApprovalTemplate(approvalSetting, riskLevel, riskSource)
+		approvalTemplate, err := utils.GetApprovalTemplate(approvalSetting, riskLevel, riskSource)
 		if err != nil {
 			err = errors.Wrapf(err, "failed to get approval template, riskLevel: %v", riskLevel)
 		}
@@ -329,44 +327,6 @@ func (r *Runner) findApprovalTemplateForIssue(ctx context.Context, issue *store.
 	return true, nil
 }
 
-func getApprovalTemplate(approvalSetting *storepb.WorkspaceApprovalSetting, riskLevel int32, riskSource store.RiskSource) (*storepb.ApprovalTemplate, error) {
-	e, err := cel.NewEnv(common.ApprovalFactors...)
-	if err != nil {
-		return nil, err
-	}
-	for _, rule := range approvalSetting.Rules {
-		if rule.Condition == nil || rule.Condition.Expression == "" {
-			continue
-		}
-		ast, issues := e.Compile(rule.Condition.Expression)
-		if issues != nil && issues.Err() != nil {
-			return nil, issues.Err()
-		}
-
-		prg, err := e.Program(ast)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to compile expression")
-		}
-
-		res, _, err := prg.Eval(map[string]any{
-			"level":  riskLevel,
-			"source": int64(convertToSource(riskSource)),
-		})
-		if err != nil {
-			return nil, err
-		}
-
-		val, err := res.ConvertToNative(reflect.TypeOf(false))
-		if err != nil {
-			return nil, errors.Wrap(err, "expect bool result")
-		}
-		if boolVal, ok := val.(bool); ok && boolVal {
-			return rule.Template, nil
-		}
-	}
-	return nil, nil
-}
-
 func getIssueRisk(ctx context.Context, s *store.Store, licenseService enterprise.LicenseService, dbFactory *dbfactory.DBFactory, issue *store.IssueMessage, risks []*store.RiskMessage) (int32, store.RiskSource, bool, error) {
 	switch issue.Type {
 	case api.IssueGrantRequest:
@@ -397,13 +357,9 @@ func getDatabaseGeneralIssueRisk(ctx context.Context, s *store.Store, licenseSer
 	if err != nil {
 		return 0, store.RiskSourceUnknown, false, errors.Wrapf(err, "failed to list plan check runs for plan %v", plan.UID)
 	}
-	type Key struct {
-		InstanceUID  int
-		DatabaseName string
-	}
-	latestPlanCheckRun := map[Key]*store.PlanCheckRunMessage{}
+	latestPlanCheckRun := map[utils.PlanCheckRunKey]*store.PlanCheckRunMessage{}
 	for _, run := range planCheckRuns {
-		key := Key{
+		key := utils.PlanCheckRunKey{
 			InstanceUID:  int(run.Config.InstanceUid),
 			DatabaseName: run.Config.DatabaseName,
 		}
@@ -419,30 +375,34 @@ func getDatabaseGeneralIssueRisk(ctx context.Context, s *store.Store, licenseSer
 		}
 	}
 
-	pipelineCreate, err := apiv1.GetPipelineCreate(ctx, s, licenseService, dbFactory, plan.Config.Steps, issue.Project)
+	// Wait for the bulk SQL review of the multi-database changes, so that the findings on every target database
+	// are reported before the approval.
+	bulkAdviseRuns, err := s.ListPlanCheckRuns(ctx, &store.FindPlanCheckRunMessage{
+		PlanUID: &plan.UID,
+		Type:    &[]store.PlanCheckRunType{store.PlanCheckDatabaseStatementBulkAdvise},
+	})
 	if err != nil {
-		return 0, store.RiskSourceUnknown, false, errors.Wrap(err, "failed to get pipeline create")
+		return 0, store.RiskSourceUnknown, false, errors.Wrapf(err, "failed to list bulk advise plan check runs for plan %v", plan.UID)
 	}
-
-	// Conclude risk source from task types.
-	seenTaskType := map[api.TaskType]bool{}
-	for _, stage := range pipelineCreate.Stages {
-		for _, task := range stage.TaskList {
-			seenTaskType[task.Type] = true
+	latestBulkAdviseRun := map[int32]*store.PlanCheckRunMessage{}
+	for _, run := range bulkAdviseRuns {
+		oldValue, ok := latestBulkAdviseRun[run.Config.SheetUid]
+		if !ok || oldValue.UID < run.UID {
+			latestBulkAdviseRun[run.Config.SheetUid] = run
 		}
 	}
-	riskSource := func() store.RiskSource {
-		if seenTaskType[api.TaskDatabaseCreate] {
-			return store.RiskSourceDatabaseCreate
-		}
-		if seenTaskType[api.TaskDatabaseSchemaUpdate] || seenTaskType[api.TaskDatabaseSchemaUpdateSDL] || seenTaskType[api.TaskDatabaseSchemaUpdateGhostSync] {
-			return store.RiskSourceDatabaseSchemaUpdate
-		}
-		if seenTaskType[api.TaskDatabaseDataUpdate] {
-			return store.RiskSourceDatabaseDataUpdate
+	for _, run := range latestBulkAdviseRun {
+		if run.Status == store.PlanCheckRunStatusRunning {
+			return 0, store.RiskSourceUnknown, false, nil
 		}
-		return store.RiskSourceUnknown
-	}()
+	}
+
+	pipelineCreate, err := apiv1.GetPipelineCreate(ctx, s, licenseService, dbFactory, plan.Config.Steps, issue.Project)
+	if err != nil {
+		return 0, store.RiskSourceUnknown, false, errors.Wrap(err, "failed to get pipeline create")
+	}
+
+	riskSource := utils.GetPipelineRiskSource(pipelineCreate)
 
 	// cannot conclude risk source
 	if riskSource == store.RiskSourceUnknown {
@@ -468,128 +428,10 @@ func getDatabaseGeneralIssueRisk(ctx context.Context, s *store.Store, licenseSer
 		}
 	}
 
-	e, err := cel.NewEnv(common.RiskFactors...)
+	maxRiskLevel, err := utils.GetPipelineRiskLevel(ctx, s, issue.Project.ResourceID, pipelineCreate, riskSource, risks, latestPlanCheckRun)
 	if err != nil {
 		return 0, store.RiskSourceUnknown, false, err
 	}
-
-	var maxRiskLevel int32
-	for _, stage := range pipelineCreate.Stages {
-		for _, task := range stage.TaskList {
-			instance, err := s.GetInstanceV2(ctx, &store.FindInstanceMessage{
-				UID: &task.InstanceID,
-			})
-			if err != nil {
-				return 0, store.RiskSourceUnknown, false, errors.Wrapf(err, "failed to get instance %v", task.InstanceID)
-			}
-			if instance.Deleted {
-				continue
-			}
-
-			environmentID := instance.EnvironmentID
-			var databaseName string
-			if task.Type == api.TaskDatabaseCreate {
-				payload := &api.TaskDatabaseCreatePayload{}
-				if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
-					return 0, store.RiskSourceUnknown, false, err
-				}
-				databaseName = payload.DatabaseName
-				if payload.EnvironmentID != "" {
-					environmentID = payload.EnvironmentID
-				}
-			} else {
-				database, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
-					UID: task.DatabaseID,
-				})
-				if err != nil {
-					return 0, store.RiskSourceUnknown, false, err
-				}
-				databaseName = database.DatabaseName
-				environmentID = database.EffectiveEnvironmentID
-			}
-
-			risk, err := func() (int32, error) {
-				for _, risk := range risks {
-					if !risk.Active {
-						continue
-					}
-					if risk.Source != riskSource {
-						continue
-					}
-					if risk.Expression == nil || risk.Expression.Expression == "" {
-						continue
-					}
-					ast, issues := e.Parse(risk.Expression.Expression)
-					if issues != nil && issues.Err() != nil {
-						return 0, errors.Errorf("failed to parse expression: %v", issues.Err())
-					}
-					prg, err := e.Program(ast)
-					if err != nil {
-						return 0, err
-					}
-					args := map[string]any{
-						"environment_id": environmentID,
-						"project_id":     issue.Project.ResourceID,
-						"database_name":  databaseName,
-						// convert to string type otherwise cel-go will complain that storepb.Engine is not string type.
-						"db_engine":     instance.Engine.String(),
-						"sql_type":      "UNKNOWN",
-						"affected_rows": math.MaxInt32,
-					}
-
-					if run, ok := latestPlanCheckRun[Key{
-						InstanceUID:  instance.UID,
-						DatabaseName: databaseName,
-					}]; ok {
-						for _, result := range run.Result.Results {
-							report := result.GetSqlSummaryReport()
-							if report == nil {
-								continue
-							}
-							args["affected_rows"] = report.AffectedRows
-							for _, statementType := range report.StatementTypes {
-								args["sql_type"] = statementType
-								res, _, err := prg.Eval(args)
-								if err != nil {
-									return 0, err
-								}
-								val, err := res.ConvertToNative(reflect.TypeOf(false))
-								if err != nil {
-									return 0, errors.Wrap(err, "expect bool result")
-								}
-								if boolVal, ok := val.(bool); ok && boolVal {
-									return risk.Level, nil
-								}
-							}
-						}
-					}
-
-					args["sql_type"] = "UNKNOWN"
-					args["affected_rows"] = math.MaxInt32
-					res, _, err := prg.Eval(args)
-					if err != nil {
-						return 0, err
-					}
-					val, err := res.ConvertToNative(reflect.TypeOf(false))
-					if err != nil {
-						return 0, errors.Wrap(err, "expect bool result")
-					}
-					if boolVal, ok := val.(bool); ok && boolVal {
-						return risk.Level, nil
-					}
-				}
-				return 0, nil
-			}()
-			if err != nil {
-				return 0, store.RiskSourceUnknown, false, errors.Wrapf(err, "failed to evaluate risk expression for risk source %v", riskSource)
-			}
-
-			if maxRiskLevel < risk {
-				maxRiskLevel = risk
-			}
-		}
-	}
-
 	return maxRiskLevel, riskSource, true, nil
 }
 
@@ -605,6 +447,8 @@ func getGrantRequestIssueRisk(ctx context.Context, s *store.Store, issue *store.
 		riskSource = store.RiskRequestExport
 	case common.FormatRole(api.ProjectQuerier.String()):
 		riskSource = store.RiskRequestQuery
+	case common.FormatRole(api.ProjectDMLExecutor.String()):
+		riskSource = store.RiskSourceDatabaseDataUpdate
 	default:
 		return 0, store.RiskSourceUnknown, false, errors.Errorf("unknown grant request role %v", payload.GrantRequest.Role)
 	}
@@ -702,6 +546,7 @@ func getGrantRequestIssueRisk(ctx context.Context, s *store.Store, issue *store.
 					"db_engine":       instance.Engine.String(),
 					"export_rows":     factors.ExportRows,
 					"expiration_days": expirationDays,
+					"database_labels": utils.GetDatabaseLabels(database),
 				}
 				res, _, err := prg.Eval(args)
 				if err != nil {
@@ -718,7 +563,7 @@ func getGrantRequestIssueRisk(ctx context.Context, s *store.Store, issue *store.
 					}
 				}
 			}
-		} else if riskSource == store.RiskRequestQuery {
+		} else if riskSource == store.RiskRequestQuery || riskSource == store.RiskSourceDatabaseDataUpdate {
 			for _, database := range databases {
 				instance, err := s.GetInstanceV2(ctx, &store.FindInstanceMessage{
 					ResourceID: &database.InstanceID,
@@ -733,9 +578,18 @@ func getGrantRequestIssueRisk(ctx context.Context, s *store.Store, issue *store.
 					// convert to string type otherwise cel-go will complain that storepb.Engine is not string type.
 					"db_engine":       instance.Engine.String(),
 					"expiration_days": expirationDays,
+					"database_labels": utils.GetDatabaseLabels(database),
 				}
 				res, _, err := prg.Eval(args)
 				if err != nil {
+					// The DML risk may be evaluated on the statement factors which the privileged DML access request doesn't have,
+					// so we take the risk to be conservative.
+					if riskSource == store.RiskSourceDatabaseDataUpdate {
+						if risk.Level > maxRisk {
+							maxRisk = risk.Level
+						}
+						continue
+					}
 					return 0, store.RiskSourceUnknown, false, err
 				}
 
@@ -765,19 +619,3 @@ func updateIssueApprovalPayload(ctx context.Context, s *store.Store, issue *stor
 	}
 	return nil
 }
-
-func convertToSource(source store.RiskSource) v1pb.Risk_Source {
-	switch source {
-	case store.RiskSourceDatabaseCreate:
-		return v1pb.Risk_CREATE_DATABASE
-	case store.RiskSourceDatabaseSchemaUpdate:
-		return v1pb.Risk_DDL
-	case store.RiskSourceDatabaseDataUpdate:
-		return v1pb.Risk_DML
-	case store.RiskRequestQuery:
-		return v1pb.Risk_QUERY
-	case store.RiskRequestExport:
-		return v1pb.Risk_EXPORT
-	}
-	return v1pb.Risk_SOURCE_UNSPECIFIED
-}
@@ -0,0 +1,79 @@
+// Package archive is the runner archiving the stale issues and databases to keep the hot tables small.
+package archive
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const archiveInterval = 1 * time.Hour
+
+// NewRunner creates a new archive runner.
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		store: store,
+	}
+}
+
+// Runner is the runner archiving the issues done or canceled and the databases not found beyond the retention.
+type Runner struct {
+	store *store.Store
+}
+
+// Run is the runner for archive runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(archiveInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug("Archive runner started", slog.Duration("interval", archiveInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Archive runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.archive(ctx)
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) archive(ctx context.Context) {
+	setting, err := r.store.GetArchiveSetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get archive setting.", log.BBError(err))
+		return
+	}
+	if days := int(setting.IssueRetentionDays); days > 0 {
+		count, err := r.store.ArchiveIssues(ctx, time.Now().AddDate(0, 0, -days).Unix())
+		if err != nil {
+			slog.Error("Failed to archive issues.", log.BBError(err))
+		} else if count > 0 {
+			slog.Debug("Archived issues.", slog.Int("count", count))
+		}
+	}
+	if days := int(setting.DatabaseRetentionDays); days > 0 {
+		count, err := r.store.ArchiveStaleDatabases(ctx, time.Now().AddDate(0, 0, -days).Unix())
+		if err != nil {
+			slog.Error("Failed to archive stale databases.", log.BBError(err))
+		} else if count > 0 {
+			slog.Debug("Archived stale databases.", slog.Int64("count", count))
+		}
+	}
+}
@@ -0,0 +1,237 @@
+// Package auditlog is the runner exporting the audit logs to S3 or syslog and deleting the expired audit logs.
+package auditlog
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/auditlog"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/auditexport"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	auditLogInterval = 1 * time.Minute
+	// exportBatchSize is the max number of the audit logs exported in a batch.
+	exportBatchSize = 1000
+	// maxExportBatchCount is the max number of the batches exported in a run, so that the retention is not starved.
+	maxExportBatchCount = 10
+)
+
+// NewRunner creates a new audit log runner.
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		store: store,
+	}
+}
+
+// Runner is the runner exporting the audit logs and deleting the expired ones.
+type Runner struct {
+	store *store.Store
+
+	// exporter is created from the exporterSetting, and recreated if the setting changes.
+	exporter        auditexport.Exporter
+	exporterSetting *storepb.AuditLogSetting
+}
+
+// Run is the runner for audit log runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(auditLogInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	defer r.closeExporter()
+	slog.Debug("Audit log runner started", slog.Duration("interval", auditLogInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Audit log runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.run(ctx)
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) run(ctx context.Context) {
+	setting, err := r.store.GetAuditLogSetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get audit log setting", log.BBError(err))
+		return
+	}
+	exportEnabled := setting.ExportType != storepb.AuditLogSetting_EXPORT_TYPE_UNSPECIFIED
+	if exportEnabled {
+		if err := r.exportAuditLogs(ctx, setting); err != nil {
+			slog.Error("Failed to export audit logs", log.BBError(err))
+		}
+	} else {
+		r.closeExporter()
+	}
+
+	retention := setting.Retention.AsDuration()
+	if retention <= 0 {
+		return
+	}
+	// The audit logs not exported yet are kept until they are exported.
+	maxUID := int64(math.MaxInt64)
+	if exportEnabled {
+		cursor, err := r.getExportCursor(ctx)
+		if err != nil {
+			slog.Error("Failed to get audit log export cursor", log.BBError(err))
+			return
+		}
+		maxUID = cursor
+	}
+	count, err := r.store.DeleteExpiredAuditLogs(ctx, time.Now().Add(-retention).Unix(), maxUID)
+	if err != nil {
+		slog.Error("Failed to delete expired audit logs", log.BBError(err))
+		return
+	}
+	if count > 0 {
+		slog.Info("Deleted expired audit logs", slog.Int64("count", count))
+	}
+}
+
+// exportAuditLogs exports the audit logs after the export cursor in batches, and advances the cursor after each batch exported.
+func (r *Runner) exportAuditLogs(ctx context.Context, setting *storepb.AuditLogSetting) error {
+	exporter, err := r.getExporter(ctx, setting)
+	if err != nil {
+		return err
+	}
+	cursor, err := r.getExportCursor(ctx)
+	if err != nil {
+		return err
+	}
+	emails := map[int]string{}
+	for i := 0; i < maxExportBatchCount; i++ {
+		limit := exportBatchSize
+		order := api.ASC
+		auditLogs, err := r.store.ListAuditLogs(ctx, &store.FindAuditLogMessage{
+			AfterUID: &cursor,
+			Order:    &order,
+			Limit:    &limit,
+		})
+		if err != nil {
+			return err
+		}
+		if len(auditLogs) == 0 {
+			return nil
+		}
+		// The audit logs are listed in the (created_ts, id) order, which may differ from the ID order slightly.
+		// Only the audit logs in the consecutive ID order are exported so that the cursor never skips one.
+		var messages []*auditexport.Message
+		for _, auditLog := range auditLogs {
+			if len(messages) > 0 && auditLog.UID < messages[len(messages)-1].ID {
+				break
+			}
+			email, err := r.getUserEmail(ctx, emails, auditLog.CreatorUID)
+			if err != nil {
+				return err
+			}
+			value, err := json.Marshal(auditlog.NewRecord(auditLog, email))
+			if err != nil {
+				return errors.Wrapf(err, "failed to marshal audit log %d", auditLog.UID)
+			}
+			messages = append(messages, &auditexport.Message{
+				ID:    auditLog.UID,
+				Time:  time.Unix(auditLog.CreatedTs, 0),
+				Value: value,
+			})
+		}
+		if err := exporter.Export(ctx, messages...); err != nil {
+			return err
+		}
+		cursor = messages[len(messages)-1].ID
+		if _, err := r.store.UpsertSettingV2(ctx, &store.SetSettingMessage{
+			Name:  api.SettingAuditLogExportCursor,
+			Value: strconv.FormatInt(cursor, 10),
+		}, api.SystemBotID); err != nil {
+			return errors.Wrapf(err, "failed to update audit log export cursor")
+		}
+		if len(auditLogs) < exportBatchSize {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (r *Runner) getExporter(ctx context.Context, setting *storepb.AuditLogSetting) (auditexport.Exporter, error) {
+	if r.exporter != nil && proto.Equal(r.exporterSetting, setting) {
+		return r.exporter, nil
+	}
+	r.closeExporter()
+	exporter, err := auditexport.NewExporter(ctx, setting)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create audit log exporter")
+	}
+	r.exporter = exporter
+	r.exporterSetting = setting
+	return exporter, nil
+}
+
+func (r *Runner) closeExporter() {
+	if r.exporter == nil {
+		return
+	}
+	if err := r.exporter.Close(); err != nil {
+		slog.Warn("Failed to close audit log exporter", log.BBError(err))
+	}
+	r.exporter = nil
+	r.exporterSetting = nil
+}
+
+// getExportCursor returns the ID of the last exported audit log.
+func (r *Runner) getExportCursor(ctx context.Context) (int64, error) {
+	name := api.SettingAuditLogExportCursor
+	setting, err := r.store.GetSettingV2(ctx, &store.FindSettingMessage{Name: &name})
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get audit log export cursor")
+	}
+	if setting == nil || setting.Value == "" {
+		return 0, nil
+	}
+	cursor, err := strconv.ParseInt(setting.Value, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid audit log export cursor %q", setting.Value)
+	}
+	return cursor, nil
+}
+
+func (r *Runner) getUserEmail(ctx context.Context, emails map[int]string, userID int) (string, error) {
+	if userID == 0 {
+		return "", nil
+	}
+	if email, ok := emails[userID]; ok {
+		return email, nil
+	}
+	user, err := r.store.GetUserByID(ctx, userID)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get user %d", userID)
+	}
+	email := ""
+	if user != nil {
+		email = user.Email
+	}
+	emails[userID] = email
+	return email, nil
+}
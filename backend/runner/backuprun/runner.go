@@ -366,6 +366,18 @@ func (r *Runner) startAutoBackups(ctx context.Context) {
 		if len(backupList) > 0 {
 			continue
 		}
+		remainingBytes, err := utils.GetRemainingBackupStorageBytes(ctx, r.store, database.ProjectID)
+		if err != nil {
+			slog.Error("Failed to get remaining backup storage bytes", log.BBError(err))
+			continue
+		}
+		if remainingBytes == 0 {
+			slog.Warn("Skip auto backup for the backup storage quota of the project is exhausted",
+				slog.String("database", database.DatabaseName),
+				slog.String("project", database.ProjectID),
+			)
+			continue
+		}
 
 		r.stateCfg.RunningBackupDatabases.Store(backupSetting.DatabaseUID, true)
 		go func(database *store.DatabaseMessage, backupName string, hookURL string) {
@@ -0,0 +1,167 @@
+// Package changehistoryintegrity is the runner checking the integrity of the new change histories,
+// and reporting the violations as the database anomalies.
+package changehistoryintegrity
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+)
+
+const checkInterval = 1 * time.Hour
+
+// NewRunner creates a new change history integrity runner.
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		store:       store,
+		checkpoints: make(map[int]*utils.ChangeHistoryIntegrityCheckpoint),
+	}
+}
+
+// Runner is the runner checking the change histories created since its last check.
+type Runner struct {
+	store *store.Store
+	// checkpoints are the progress of the checks by the database UID.
+	// The change histories of a database are all checked in the first check after the server starts.
+	checkpoints map[int]*utils.ChangeHistoryIntegrityCheckpoint
+}
+
+// Run is the runner for change history integrity runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug("Change history integrity runner started", slog.Duration("interval", checkInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Change history integrity runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.checkAll(ctx)
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) checkAll(ctx context.Context) {
+	instances, err := r.store.ListInstancesV2(ctx, &store.FindInstanceMessage{})
+	if err != nil {
+		slog.Error("Failed to retrieve instances", log.BBError(err))
+		return
+	}
+	instancesMap := map[string]*store.InstanceMessage{}
+	for _, instance := range instances {
+		instancesMap[instance.ResourceID] = instance
+	}
+
+	databases, err := r.store.ListDatabases(ctx, &store.FindDatabaseMessage{})
+	if err != nil {
+		slog.Error("Failed to retrieve databases", log.BBError(err))
+		return
+	}
+	for _, database := range databases {
+		instance, ok := instancesMap[database.InstanceID]
+		if !ok {
+			continue
+		}
+		if err := r.checkDatabase(ctx, instance, database); err != nil {
+			slog.Error("Failed to check change history integrity",
+				slog.String("instance", instance.ResourceID),
+				slog.String("database", database.DatabaseName),
+				log.BBError(err))
+		}
+	}
+}
+
+// checkDatabase checks the change histories of the database after its checkpoint.
+// The violations found are added to the active anomaly, which is archived once a full check finds no violation.
+func (r *Runner) checkDatabase(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage) error {
+	checkpoint, ok := r.checkpoints[database.UID]
+	fullCheck := !ok
+	if fullCheck {
+		checkpoint = &utils.ChangeHistoryIntegrityCheckpoint{}
+	}
+	violations, err := utils.CheckNewChangeHistoryIntegrity(ctx, r.store, instance, database, checkpoint)
+	if err != nil {
+		return err
+	}
+	r.checkpoints[database.UID] = checkpoint
+
+	if len(violations) == 0 {
+		if !fullCheck {
+			return nil
+		}
+		if err := r.store.ArchiveAnomalyV2(ctx, &store.ArchiveAnomalyMessage{
+			DatabaseUID: &database.UID,
+			Type:        api.AnomalyDatabaseChangeHistoryIntegrity,
+		}); err != nil && common.ErrorCode(err) != common.NotFound {
+			return errors.Wrapf(err, "failed to close anomaly")
+		}
+		return nil
+	}
+
+	if !fullCheck {
+		previous, err := r.getAnomalyViolations(ctx, database)
+		if err != nil {
+			return err
+		}
+		violations = append(previous, violations...)
+	}
+	payload, err := json.Marshal(api.AnomalyDatabaseChangeHistoryIntegrityPayload{
+		Violations: violations,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal anomaly payload")
+	}
+	if _, err := r.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
+		InstanceID:  instance.ResourceID,
+		DatabaseUID: &database.UID,
+		Type:        api.AnomalyDatabaseChangeHistoryIntegrity,
+		Payload:     string(payload),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to create anomaly")
+	}
+	return nil
+}
+
+// getAnomalyViolations returns the violations in the active anomaly of the database.
+func (r *Runner) getAnomalyViolations(ctx context.Context, database *store.DatabaseMessage) ([]*api.ChangeHistoryIntegrityViolation, error) {
+	rowStatus := api.Normal
+	anomalies, err := r.store.ListAnomalyV2(ctx, &store.ListAnomalyMessage{
+		RowStatus:   &rowStatus,
+		DatabaseUID: &database.UID,
+		Types:       []api.AnomalyType{api.AnomalyDatabaseChangeHistoryIntegrity},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list anomalies")
+	}
+	var violations []*api.ChangeHistoryIntegrityViolation
+	for _, anomaly := range anomalies {
+		var payload api.AnomalyDatabaseChangeHistoryIntegrityPayload
+		if err := json.Unmarshal([]byte(anomaly.Payload), &payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal anomaly payload")
+		}
+		violations = append(violations, payload.Violations...)
+	}
+	return violations, nil
+}
@@ -0,0 +1,131 @@
+// Package grantexpiry is the runner revoking the privileged DML access granted just in time at expiry.
+package grantexpiry
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/activity"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const grantExpiryInterval = 1 * time.Minute
+
+// NewRunner creates a new grant expiry runner.
+func NewRunner(store *store.Store, activityManager *activity.Manager) *Runner {
+	return &Runner{
+		store:           store,
+		activityManager: activityManager,
+	}
+}
+
+// Runner is the runner removing the expired privileged DML access bindings from the project IAM policies.
+type Runner struct {
+	store           *store.Store
+	activityManager *activity.Manager
+}
+
+// Run is the runner for grant expiry runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(grantExpiryInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug("Grant expiry runner started", slog.Duration("interval", grantExpiryInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Grant expiry runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.revokeExpiredGrants(ctx)
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) revokeExpiredGrants(ctx context.Context) {
+	projects, err := r.store.ListProjectV2(ctx, &store.FindProjectMessage{})
+	if err != nil {
+		slog.Error("Failed to list projects.", log.BBError(err))
+		return
+	}
+	for _, project := range projects {
+		if err := r.revokeProjectExpiredGrants(ctx, project, time.Now()); err != nil {
+			slog.Error("Failed to revoke expired grants", slog.String("project", project.ResourceID), log.BBError(err))
+		}
+	}
+}
+
+func (r *Runner) revokeProjectExpiredGrants(ctx context.Context, project *store.ProjectMessage, now time.Time) error {
+	policy, err := r.store.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{UID: &project.UID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project policy")
+	}
+	bindings, expiredBindings := splitExpiredBindings(policy.Bindings, now)
+	if len(expiredBindings) == 0 {
+		return nil
+	}
+	policy.Bindings = bindings
+	if _, err := r.store.SetProjectIAMPolicy(ctx, policy, api.SystemBotID, project.UID); err != nil {
+		return errors.Wrapf(err, "failed to set project policy")
+	}
+
+	for _, binding := range expiredBindings {
+		for _, member := range binding.Members {
+			comment := fmt.Sprintf("Revoked %s from %s (%s) at expiry.", binding.Role, member.Name, member.Email)
+			if description := binding.Condition.GetDescription(); description != "" {
+				comment = fmt.Sprintf("Revoked %s from %s (%s) granted in %s at expiry.", binding.Role, member.Name, member.Email, description)
+			}
+			if _, err := r.activityManager.CreateActivity(ctx, &store.ActivityMessage{
+				CreatorUID:   api.SystemBotID,
+				ContainerUID: project.UID,
+				Type:         api.ActivityProjectMemberDelete,
+				Level:        api.ActivityInfo,
+				Comment:      comment,
+			}, &activity.Metadata{}); err != nil {
+				slog.Warn("Failed to create project activity", log.BBError(err))
+			}
+		}
+	}
+	return nil
+}
+
+// splitExpiredBindings splits the privileged DML access bindings expired at now from the others.
+func splitExpiredBindings(bindings []*store.PolicyBinding, now time.Time) ([]*store.PolicyBinding, []*store.PolicyBinding) {
+	var remaining, expired []*store.PolicyBinding
+	for _, binding := range bindings {
+		if binding.Role != api.ProjectDMLExecutor {
+			remaining = append(remaining, binding)
+			continue
+		}
+		expireTime, err := common.GetExpirationTime(binding.Condition.GetExpression())
+		if err != nil {
+			slog.Warn("Failed to get expiration time", slog.String("condition", binding.Condition.GetExpression()), log.BBError(err))
+			remaining = append(remaining, binding)
+			continue
+		}
+		if expireTime == nil || expireTime.After(now) {
+			remaining = append(remaining, binding)
+			continue
+		}
+		expired = append(expired, binding)
+	}
+	return remaining, expired
+}
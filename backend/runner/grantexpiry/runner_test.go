@@ -0,0 +1,39 @@
+package grantexpiry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/expr"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestSplitExpiredBindings(t *testing.T) {
+	a := require.New(t)
+
+	now := time.Date(2023, 7, 4, 8, 0, 0, 0, time.UTC)
+	expired := &store.PolicyBinding{
+		Role:      api.ProjectDMLExecutor,
+		Condition: &expr.Expr{Expression: `resource.database == "instances/prod/databases/db" && request.time < timestamp("2023-07-04T07:00:00Z")`},
+	}
+	active := &store.PolicyBinding{
+		Role:      api.ProjectDMLExecutor,
+		Condition: &expr.Expr{Expression: `resource.database == "instances/prod/databases/db" && request.time < timestamp("2023-07-04T09:00:00Z")`},
+	}
+	permanent := &store.PolicyBinding{
+		Role:      api.ProjectDMLExecutor,
+		Condition: &expr.Expr{Expression: `resource.database == "instances/prod/databases/db"`},
+	}
+	// Only the privileged DML access is revoked at expiry.
+	querier := &store.PolicyBinding{
+		Role:      api.ProjectQuerier,
+		Condition: &expr.Expr{Expression: `request.time < timestamp("2023-07-04T07:00:00Z")`},
+	}
+
+	remaining, revoked := splitExpiredBindings([]*store.PolicyBinding{expired, active, permanent, querier}, now)
+	a.Equal([]*store.PolicyBinding{active, permanent, querier}, remaining)
+	a.Equal([]*store.PolicyBinding{expired}, revoked)
+}
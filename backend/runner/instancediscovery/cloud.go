@@ -0,0 +1,178 @@
+package instancediscovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	awscredentials "github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	sqladmin "google.golang.org/api/sqladmin/v1"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// listInstances lists the instances of the source with the supported engines.
+func listInstances(ctx context.Context, source *storepb.InstanceDiscoverySetting_Source) ([]*storepb.DiscoveredInstance, error) {
+	switch source.Provider {
+	case storepb.InstanceDiscoverySetting_Source_AWS:
+		var instances []*storepb.DiscoveredInstance
+		for _, region := range source.Regions {
+			regionInstances, err := listAWSInstances(ctx, source, region)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to list instances in region %q", region)
+			}
+			instances = append(instances, regionInstances...)
+		}
+		return instances, nil
+	case storepb.InstanceDiscoverySetting_Source_GCP:
+		return listGCPInstances(ctx, source)
+	default:
+		return nil, errors.Errorf("unsupported provider %v", source.Provider)
+	}
+}
+
+// listAWSInstances lists the Aurora and Multi-AZ DB clusters and the standalone RDS instances.
+// The instances of the clusters are not listed, the cluster writer endpoints are registered instead.
+func listAWSInstances(ctx context.Context, source *storepb.InstanceDiscoverySetting_Source, region string) ([]*storepb.DiscoveredInstance, error) {
+	optFns := []func(*awsconfig.LoadOptions) error{awsconfig.WithRegion(region)}
+	if source.AccessKeyId != "" {
+		optFns = append(optFns, awsconfig.WithCredentialsProvider(awscredentials.NewStaticCredentialsProvider(source.AccessKeyId, source.SecretAccessKey, "")))
+	}
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load AWS config")
+	}
+	client := rds.NewFromConfig(cfg)
+
+	var instances []*storepb.DiscoveredInstance
+	clusters := rds.NewDescribeDBClustersPaginator(client, &rds.DescribeDBClustersInput{})
+	for clusters.HasMorePages() {
+		output, err := clusters.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe DB clusters")
+		}
+		for _, cluster := range output.DBClusters {
+			engine := getAWSEngine(aws.ToString(cluster.Engine))
+			if engine == storepb.Engine_ENGINE_UNSPECIFIED || cluster.Endpoint == nil || cluster.Port == nil {
+				continue
+			}
+			instances = append(instances, newDiscoveredInstance(source, aws.ToString(cluster.DBClusterArn), aws.ToString(cluster.DBClusterIdentifier), engine, aws.ToString(cluster.Endpoint), int(aws.ToInt32(cluster.Port)), getAWSLabels(cluster.TagList)))
+		}
+	}
+	dbInstances := rds.NewDescribeDBInstancesPaginator(client, &rds.DescribeDBInstancesInput{})
+	for dbInstances.HasMorePages() {
+		output, err := dbInstances.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe DB instances")
+		}
+		for _, instance := range output.DBInstances {
+			if instance.DBClusterIdentifier != nil {
+				continue
+			}
+			engine := getAWSEngine(aws.ToString(instance.Engine))
+			// The endpoint is not available until the instance is created.
+			if engine == storepb.Engine_ENGINE_UNSPECIFIED || instance.Endpoint == nil || instance.Endpoint.Address == nil {
+				continue
+			}
+			instances = append(instances, newDiscoveredInstance(source, aws.ToString(instance.DBInstanceArn), aws.ToString(instance.DBInstanceIdentifier), engine, aws.ToString(instance.Endpoint.Address), int(aws.ToInt32(instance.Endpoint.Port)), getAWSLabels(instance.TagList)))
+		}
+	}
+	return instances, nil
+}
+
+// listGCPInstances lists the Cloud SQL primary instances.
+func listGCPInstances(ctx context.Context, source *storepb.InstanceDiscoverySetting_Source) ([]*storepb.DiscoveredInstance, error) {
+	var opts []option.ClientOption
+	if source.ServiceAccountKey != "" {
+		opts = append(opts, option.WithCredentialsJSON([]byte(source.ServiceAccountKey)))
+	}
+	service, err := sqladmin.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create Cloud SQL admin client")
+	}
+
+	var instances []*storepb.DiscoveredInstance
+	if err := service.Instances.List(source.Project).Pages(ctx, func(response *sqladmin.InstancesListResponse) error {
+		for _, instance := range response.Items {
+			if instance.InstanceType != "CLOUD_SQL_INSTANCE" {
+				continue
+			}
+			engine, port := getGCPEngine(instance.DatabaseVersion)
+			host := getGCPHost(instance.IpAddresses)
+			if engine == storepb.Engine_ENGINE_UNSPECIFIED || host == "" {
+				continue
+			}
+			var labels map[string]string
+			if instance.Settings != nil {
+				labels = instance.Settings.UserLabels
+			}
+			cloudResource := fmt.Sprintf("//sqladmin.googleapis.com/projects/%s/instances/%s", instance.Project, instance.Name)
+			instances = append(instances, newDiscoveredInstance(source, cloudResource, instance.Name, engine, host, port, labels))
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to list Cloud SQL instances")
+	}
+	return instances, nil
+}
+
+// getAWSEngine returns the engine of the RDS engine, e.g. "aurora-postgresql", or ENGINE_UNSPECIFIED if it is not supported.
+func getAWSEngine(engine string) storepb.Engine {
+	switch {
+	case engine == "mysql", engine == "aurora-mysql", engine == "aurora":
+		return storepb.Engine_MYSQL
+	case engine == "mariadb":
+		return storepb.Engine_MARIADB
+	case engine == "postgres", engine == "aurora-postgresql":
+		return storepb.Engine_POSTGRES
+	case strings.HasPrefix(engine, "oracle-"), strings.HasPrefix(engine, "custom-oracle-"):
+		return storepb.Engine_ORACLE
+	case strings.HasPrefix(engine, "sqlserver-"), strings.HasPrefix(engine, "custom-sqlserver-"):
+		return storepb.Engine_MSSQL
+	default:
+		return storepb.Engine_ENGINE_UNSPECIFIED
+	}
+}
+
+// getGCPEngine returns the engine and the default port of the Cloud SQL database version, e.g. "POSTGRES_15",
+// or ENGINE_UNSPECIFIED if it is not supported.
+func getGCPEngine(databaseVersion string) (storepb.Engine, int) {
+	switch {
+	case strings.HasPrefix(databaseVersion, "MYSQL_"):
+		return storepb.Engine_MYSQL, 3306
+	case strings.HasPrefix(databaseVersion, "POSTGRES_"):
+		return storepb.Engine_POSTGRES, 5432
+	case strings.HasPrefix(databaseVersion, "SQLSERVER_"):
+		return storepb.Engine_MSSQL, 1433
+	default:
+		return storepb.Engine_ENGINE_UNSPECIFIED, 0
+	}
+}
+
+// getGCPHost returns the private IP address of the Cloud SQL instance, or the public IP address if it has no private IP address.
+func getGCPHost(ipAddresses []*sqladmin.IpMapping) string {
+	var host string
+	for _, ip := range ipAddresses {
+		switch ip.Type {
+		case "PRIVATE":
+			return ip.IpAddress
+		case "PRIMARY":
+			host = ip.IpAddress
+		}
+	}
+	return host
+}
+
+func getAWSLabels(tags []rdstypes.Tag) map[string]string {
+	labels := map[string]string{}
+	for _, tag := range tags {
+		labels[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return labels
+}
@@ -0,0 +1,274 @@
+// Package instancediscovery is the runner discovering the RDS, Aurora and Cloud SQL instances from the cloud accounts.
+package instancediscovery
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	instanceDiscoveryInterval = 10 * time.Minute
+	// maxInstanceIDLength is the maximum length of the instance resource ID.
+	maxInstanceIDLength = 63
+)
+
+var invalidInstanceIDCharacters = regexp.MustCompile("[^a-z0-9]+")
+
+// CreateInstanceFunc creates the instance.
+type CreateInstanceFunc func(ctx context.Context, request *v1pb.CreateInstanceRequest) (*v1pb.Instance, error)
+
+// NewRunner creates a new instance discovery runner.
+func NewRunner(store *store.Store, createInstance CreateInstanceFunc) *Runner {
+	return &Runner{
+		store:          store,
+		createInstance: createInstance,
+	}
+}
+
+// Runner is the runner discovering the cloud instances periodically.
+// The discovered instances are registered if the source auto-registers them, otherwise they are proposed for the adoption.
+// The labels of the registered instances are kept in sync with the tags of the cloud instances.
+type Runner struct {
+	store          *store.Store
+	createInstance CreateInstanceFunc
+}
+
+// Run is the runner for instance discovery runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(instanceDiscoveryInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug("Instance discovery runner started", slog.Duration("interval", instanceDiscoveryInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Instance discovery runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.discover(ctx)
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) discover(ctx context.Context) {
+	setting, err := r.store.GetInstanceDiscoverySetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get instance discovery setting.", log.BBError(err))
+		return
+	}
+	if len(setting.Sources) == 0 {
+		return
+	}
+	instances, err := r.store.ListInstancesV2(ctx, &store.FindInstanceMessage{ShowDeleted: true})
+	if err != nil {
+		slog.Error("Failed to list instances.", log.BBError(err))
+		return
+	}
+	// The archived instances are also registered, so that they are not proposed again.
+	registered := map[string]*store.InstanceMessage{}
+	instanceIDs := map[string]bool{}
+	for _, instance := range instances {
+		instanceIDs[instance.ResourceID] = true
+		if cloudResource := instance.Options.GetCloudResource(); cloudResource != "" {
+			registered[cloudResource] = instance
+		}
+	}
+
+	var proposals []*storepb.DiscoveredInstance
+	for _, source := range setting.Sources {
+		discovered, err := listInstances(ctx, source)
+		if err != nil {
+			slog.Warn("Failed to discover instances.", slog.String("source", source.Id), log.BBError(err))
+			// Keep the proposals of the source, so that they don't disappear on the transient errors.
+			proposals = append(proposals, r.getProposals(ctx, source.Id)...)
+			continue
+		}
+		for _, d := range discovered {
+			if !matchTags(d.Labels, source.TagFilters) {
+				continue
+			}
+			if instance, ok := registered[d.CloudResource]; ok {
+				r.syncLabels(ctx, instance, d.Labels)
+				continue
+			}
+			if source.AutoRegister {
+				instanceID := GetInstanceID(d.Title, instanceIDs)
+				if err := r.register(ctx, source, d, instanceID); err != nil {
+					slog.Warn("Failed to register discovered instance, it is proposed for the adoption instead.", slog.String("source", source.Id), slog.String("cloud_resource", d.CloudResource), log.BBError(err))
+				} else {
+					instanceIDs[instanceID] = true
+					continue
+				}
+			}
+			proposals = append(proposals, d)
+		}
+	}
+
+	sort.Slice(proposals, func(i, j int) bool {
+		return proposals[i].CloudResource < proposals[j].CloudResource
+	})
+	bytes, err := protojson.Marshal(&storepb.DiscoveredInstances{Instances: proposals})
+	if err != nil {
+		slog.Error("Failed to marshal discovered instances.", log.BBError(err))
+		return
+	}
+	if _, err := r.store.UpsertSettingV2(ctx, &store.SetSettingMessage{
+		Name:  api.SettingDiscoveredInstances,
+		Value: string(bytes),
+	}, api.SystemBotID); err != nil {
+		slog.Error("Failed to update discovered instances.", log.BBError(err))
+	}
+}
+
+// getProposals returns the current proposals of the source.
+func (r *Runner) getProposals(ctx context.Context, sourceID string) []*storepb.DiscoveredInstance {
+	discovered, err := r.store.GetDiscoveredInstances(ctx)
+	if err != nil {
+		slog.Error("Failed to get discovered instances.", log.BBError(err))
+		return nil
+	}
+	var proposals []*storepb.DiscoveredInstance
+	for _, d := range discovered.Instances {
+		if d.Source == sourceID {
+			proposals = append(proposals, d)
+		}
+	}
+	return proposals
+}
+
+func (r *Runner) register(ctx context.Context, source *storepb.InstanceDiscoverySetting_Source, discovered *storepb.DiscoveredInstance, instanceID string) error {
+	childCtx := context.WithValue(ctx, common.PrincipalIDContextKey, api.SystemBotID)
+	childCtx = context.WithValue(childCtx, common.LoopbackContextKey, true)
+	instance, err := r.createInstance(childCtx, NewCreateInstanceRequest(source, discovered, instanceID))
+	if err != nil {
+		return err
+	}
+	slog.Info("Registered discovered instance.", slog.String("instance", instance.Name), slog.String("cloud_resource", discovered.CloudResource))
+	return nil
+}
+
+// syncLabels updates the labels of the registered instance if the tags of the cloud instance are changed.
+func (r *Runner) syncLabels(ctx context.Context, instance *store.InstanceMessage, labels map[string]string) {
+	if instance.Deleted || equalLabels(instance.Options.GetLabels(), labels) {
+		return
+	}
+	if _, err := r.store.UpdateInstanceV2(ctx, &store.UpdateInstanceMessage{
+		UpdaterID:     api.SystemBotID,
+		EnvironmentID: instance.EnvironmentID,
+		ResourceID:    instance.ResourceID,
+		OptionsUpsert: &storepb.InstanceOptions{Labels: labels},
+	}, -1); err != nil {
+		slog.Error("Failed to sync instance labels.", slog.String("instance", instance.ResourceID), log.BBError(err))
+	}
+}
+
+// NewCreateInstanceRequest returns the request registering the discovered instance with the admin data source of the source.
+func NewCreateInstanceRequest(source *storepb.InstanceDiscoverySetting_Source, discovered *storepb.DiscoveredInstance, instanceID string) *v1pb.CreateInstanceRequest {
+	return &v1pb.CreateInstanceRequest{
+		InstanceId: instanceID,
+		Instance: &v1pb.Instance{
+			Title:       discovered.Title,
+			Engine:      v1pb.Engine(discovered.Engine),
+			Environment: source.Environment,
+			DataSources: []*v1pb.DataSource{
+				{
+					Id:       "admin",
+					Type:     v1pb.DataSourceType_ADMIN,
+					Host:     discovered.Host,
+					Port:     discovered.Port,
+					Username: source.Username,
+					Password: source.Password,
+				},
+			},
+			Options: &v1pb.InstanceOptions{
+				Labels:        discovered.Labels,
+				CloudResource: discovered.CloudResource,
+			},
+		},
+	}
+}
+
+// GetInstanceID derives the instance resource ID from the title of the discovered instance,
+// e.g. "Prod_DB.1" becomes "prod-db-1". A numeric suffix is added if the ID is taken.
+func GetInstanceID(title string, taken map[string]bool) string {
+	id := strings.Trim(invalidInstanceIDCharacters.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	if id == "" || id[0] < 'a' || id[0] > 'z' {
+		id = "instance-" + id
+	}
+	id = trimInstanceID(id, maxInstanceIDLength)
+	candidate := id
+	for i := 2; taken[candidate]; i++ {
+		suffix := fmt.Sprintf("-%d", i)
+		candidate = trimInstanceID(id, maxInstanceIDLength-len(suffix)) + suffix
+	}
+	return candidate
+}
+
+func trimInstanceID(id string, length int) string {
+	if len(id) > length {
+		id = id[:length]
+	}
+	return strings.TrimRight(id, "-")
+}
+
+// matchTags returns true if the tags have all the filters.
+func matchTags(tags, filters map[string]string) bool {
+	for k, v := range filters {
+		if tag, ok := tags[k]; !ok || tag != v {
+			return false
+		}
+	}
+	return true
+}
+
+func equalLabels(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
+
+func newDiscoveredInstance(source *storepb.InstanceDiscoverySetting_Source, cloudResource, title string, engine storepb.Engine, host string, port int, labels map[string]string) *storepb.DiscoveredInstance {
+	return &storepb.DiscoveredInstance{
+		Source:        source.Id,
+		CloudResource: cloudResource,
+		Title:         title,
+		Engine:        engine,
+		Host:          host,
+		Port:          fmt.Sprintf("%d", port),
+		Labels:        labels,
+		DiscoverTime:  timestamppb.Now(),
+	}
+}
@@ -0,0 +1,68 @@
+package instancediscovery
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	sqladmin "google.golang.org/api/sqladmin/v1"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetInstanceID(t *testing.T) {
+	a := require.New(t)
+
+	a.Equal("prod-db-1", GetInstanceID("Prod_DB.1", nil))
+	a.Equal("instance-1db", GetInstanceID("1db", nil))
+	a.Equal("instance", GetInstanceID("--", nil))
+	a.Equal("prod-db-3", GetInstanceID("prod-db", map[string]bool{"prod-db": true, "prod-db-2": true}))
+
+	long := strings.Repeat("a", 70)
+	a.Equal(strings.Repeat("a", 63), GetInstanceID(long, nil))
+	a.Equal(strings.Repeat("a", 61)+"-2", GetInstanceID(long, map[string]bool{strings.Repeat("a", 63): true}))
+}
+
+func TestMatchTags(t *testing.T) {
+	a := require.New(t)
+
+	tags := map[string]string{"team": "payments", "bytebase": "true"}
+	a.True(matchTags(tags, nil))
+	a.True(matchTags(tags, map[string]string{"bytebase": "true"}))
+	a.False(matchTags(tags, map[string]string{"bytebase": "false"}))
+	a.False(matchTags(tags, map[string]string{"env": "prod"}))
+	a.False(matchTags(nil, map[string]string{"bytebase": "true"}))
+}
+
+func TestGetEngine(t *testing.T) {
+	a := require.New(t)
+
+	a.Equal(storepb.Engine_MYSQL, getAWSEngine("aurora-mysql"))
+	a.Equal(storepb.Engine_POSTGRES, getAWSEngine("aurora-postgresql"))
+	a.Equal(storepb.Engine_MARIADB, getAWSEngine("mariadb"))
+	a.Equal(storepb.Engine_ORACLE, getAWSEngine("oracle-se2"))
+	a.Equal(storepb.Engine_MSSQL, getAWSEngine("sqlserver-ex"))
+	a.Equal(storepb.Engine_ENGINE_UNSPECIFIED, getAWSEngine("neptune"))
+
+	engine, port := getGCPEngine("POSTGRES_15")
+	a.Equal(storepb.Engine_POSTGRES, engine)
+	a.Equal(5432, port)
+	engine, _ = getGCPEngine("SQLSERVER_2019_STANDARD")
+	a.Equal(storepb.Engine_MSSQL, engine)
+	engine, _ = getGCPEngine("SQL_DATABASE_VERSION_UNSPECIFIED")
+	a.Equal(storepb.Engine_ENGINE_UNSPECIFIED, engine)
+}
+
+func TestGetGCPHost(t *testing.T) {
+	a := require.New(t)
+
+	a.Equal("10.0.0.3", getGCPHost([]*sqladmin.IpMapping{
+		{Type: "PRIMARY", IpAddress: "34.1.2.3"},
+		{Type: "PRIVATE", IpAddress: "10.0.0.3"},
+	}))
+	a.Equal("34.1.2.3", getGCPHost([]*sqladmin.IpMapping{
+		{Type: "OUTGOING", IpAddress: "34.1.2.4"},
+		{Type: "PRIMARY", IpAddress: "34.1.2.3"},
+	}))
+	a.Equal("", getGCPHost(nil))
+}
@@ -0,0 +1,200 @@
+// Package issueschedule is the runner creating the recurring issues of the project issue schedules.
+package issueschedule
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/runner/scheduledquery"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const issueScheduleInterval = 1 * time.Minute
+
+// CreateIssueFunc creates the issue.
+type CreateIssueFunc func(ctx context.Context, request *v1pb.CreateIssueRequest) (*v1pb.Issue, error)
+
+// CreateRolloutFunc creates the rollout of the plan.
+type CreateRolloutFunc func(ctx context.Context, request *v1pb.CreateRolloutRequest) (*v1pb.Rollout, error)
+
+// NewRunner creates a new issue schedule runner.
+func NewRunner(store *store.Store, createIssue CreateIssueFunc, createRollout CreateRolloutFunc) *Runner {
+	return &Runner{
+		store:         store,
+		createIssue:   createIssue,
+		createRollout: createRollout,
+	}
+}
+
+// Runner is the runner creating the issues of the due issue schedules.
+type Runner struct {
+	store         *store.Store
+	createIssue   CreateIssueFunc
+	createRollout CreateRolloutFunc
+}
+
+// Run is the runner for issue schedule runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(issueScheduleInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug("Issue schedule runner started", slog.Duration("interval", issueScheduleInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Issue schedule runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.runDueSchedules(ctx)
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) runDueSchedules(ctx context.Context) {
+	now := time.Now()
+	projects, err := r.store.ListProjectV2(ctx, &store.FindProjectMessage{})
+	if err != nil {
+		slog.Error("Failed to list projects.", log.BBError(err))
+		return
+	}
+	for _, project := range projects {
+		for _, schedule := range getDueSchedules(project.Setting, now) {
+			next, err := getNextRunTs(schedule.Schedule, now)
+			if err != nil {
+				slog.Error("Failed to get the next run time.", slog.String("project", project.ResourceID), slog.String("issue_schedule", schedule.Id), log.BBError(err))
+				continue
+			}
+			// Move to the next run before running, so that a failing schedule is not retried until the next run.
+			if err := r.updateSchedule(ctx, project.ResourceID, schedule.Id, func(schedule *storepb.IssueSchedule) {
+				schedule.NextRunTs = next
+			}); err != nil {
+				slog.Error("Failed to update the next run time of issue schedule.", slog.String("project", project.ResourceID), slog.String("issue_schedule", schedule.Id), log.BBError(err))
+				continue
+			}
+
+			issueID, runErr := r.run(ctx, project.ResourceID, schedule)
+			if runErr != nil {
+				slog.Warn("Failed to run issue schedule.", slog.String("project", project.ResourceID), slog.String("issue_schedule", schedule.Id), log.BBError(runErr))
+			}
+			if err := r.updateSchedule(ctx, project.ResourceID, schedule.Id, func(schedule *storepb.IssueSchedule) {
+				schedule.LastError = ""
+				if runErr != nil {
+					schedule.LastError = runErr.Error()
+				}
+				if issueID > 0 {
+					schedule.LastIssueId = int32(issueID)
+				}
+			}); err != nil {
+				slog.Error("Failed to update the last run of issue schedule.", slog.String("project", project.ResourceID), slog.String("issue_schedule", schedule.Id), log.BBError(err))
+			}
+		}
+	}
+}
+
+// run creates the issue from the issue template of the schedule on behalf of its creator, and creates the rollout of the issue.
+// It returns the created issue ID, which is set even if the rollout fails to be created.
+func (r *Runner) run(ctx context.Context, projectID string, schedule *storepb.IssueSchedule) (int, error) {
+	childCtx := context.WithValue(ctx, common.PrincipalIDContextKey, int(schedule.CreatorId))
+	childCtx = context.WithValue(childCtx, common.LoopbackContextKey, true)
+
+	issue, err := r.createIssue(childCtx, &v1pb.CreateIssueRequest{
+		Parent: common.FormatProject(projectID),
+		Issue: &v1pb.Issue{
+			Type:        v1pb.Issue_DATABASE_CHANGE,
+			Description: fmt.Sprintf("Created by issue schedule %q.", schedule.Title),
+		},
+		Template:          fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, projectID, common.IssueTemplatePrefix, schedule.Template),
+		TemplateVariables: schedule.TemplateVariables,
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to create issue")
+	}
+	issueID, err := common.GetIssueID(issue.Name)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to parse issue %q", issue.Name)
+	}
+	if _, err := r.createRollout(childCtx, &v1pb.CreateRolloutRequest{
+		Parent: common.FormatProject(projectID),
+		Plan:   issue.Plan,
+	}); err != nil {
+		return issueID, errors.Wrapf(err, "failed to create rollout")
+	}
+	return issueID, nil
+}
+
+// updateSchedule updates the issue schedule in the latest project setting.
+func (r *Runner) updateSchedule(ctx context.Context, projectID, scheduleID string, update func(*storepb.IssueSchedule)) error {
+	project, err := r.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+	if err != nil {
+		return err
+	}
+	if project == nil || project.Setting == nil {
+		return errors.Errorf("project %q not found", projectID)
+	}
+	// Clone the setting to keep the cached project intact.
+	setting, ok := proto.Clone(project.Setting).(*storepb.Project)
+	if !ok {
+		return errors.Errorf("failed to clone the setting of project %q", projectID)
+	}
+	found := false
+	for _, schedule := range setting.IssueSchedules {
+		if schedule.Id == scheduleID {
+			update(schedule)
+			found = true
+		}
+	}
+	if !found {
+		return errors.Errorf("issue schedule %q not found", scheduleID)
+	}
+	_, err = r.store.UpdateProjectV2(ctx, &store.UpdateProjectMessage{
+		UpdaterID:  api.SystemBotID,
+		ResourceID: projectID,
+		Setting:    setting,
+	})
+	return err
+}
+
+// getDueSchedules returns the enabled issue schedules whose next run time has come.
+func getDueSchedules(setting *storepb.Project, now time.Time) []*storepb.IssueSchedule {
+	var schedules []*storepb.IssueSchedule
+	for _, schedule := range setting.GetIssueSchedules() {
+		if schedule.Disabled || schedule.NextRunTs <= 0 || schedule.NextRunTs > now.Unix() {
+			continue
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules
+}
+
+func getNextRunTs(expression string, now time.Time) (int64, error) {
+	schedule, err := scheduledquery.ParseSchedule(expression)
+	if err != nil {
+		return 0, err
+	}
+	next := schedule.Next(now)
+	if next.IsZero() {
+		return 0, errors.Errorf("schedule %q never runs", expression)
+	}
+	return next.Unix(), nil
+}
@@ -0,0 +1,31 @@
+package issueschedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetDueSchedules(t *testing.T) {
+	a := require.New(t)
+
+	now := time.Date(2024, 1, 8, 3, 0, 0, 0, time.UTC)
+	setting := &storepb.Project{
+		IssueSchedules: []*storepb.IssueSchedule{
+			{Id: "due", NextRunTs: now.Unix()},
+			{Id: "overdue", NextRunTs: now.Add(-time.Hour).Unix()},
+			{Id: "future", NextRunTs: now.Add(time.Minute).Unix()},
+			{Id: "disabled", NextRunTs: now.Unix(), Disabled: true},
+			{Id: "unscheduled"},
+		},
+	}
+	var ids []string
+	for _, schedule := range getDueSchedules(setting, now) {
+		ids = append(ids, schedule.Id)
+	}
+	a.Equal([]string{"due", "overdue"}, ids)
+	a.Empty(getDueSchedules(nil, now))
+}
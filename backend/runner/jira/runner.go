@@ -0,0 +1,182 @@
+// Package jira is the runner mirroring the status of the linked Jira tickets to the issues and their JIRA mode external approvals.
+package jira
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/activity"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	jiraplugin "github.com/bytebase/bytebase/backend/plugin/app/jira"
+	"github.com/bytebase/bytebase/backend/runner/relay"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// jiraRunnerInterval is the interval polling the linked Jira tickets of the pending external approvals,
+// in case the Jira webhooks are missed.
+const jiraRunnerInterval = 10 * time.Minute
+
+// NewRunner creates a new Jira runner.
+func NewRunner(store *store.Store, activityManager *activity.Manager, relayRunner *relay.Runner) *Runner {
+	return &Runner{
+		store:           store,
+		activityManager: activityManager,
+		relayRunner:     relayRunner,
+	}
+}
+
+// Runner is the runner syncing the Jira ticket status back to the issues.
+type Runner struct {
+	store           *store.Store
+	activityManager *activity.Manager
+	// relayRunner approves and rejects the external approval nodes.
+	relayRunner *relay.Runner
+}
+
+// Run is the runner for the Jira runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(jiraRunnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug("Jira runner started", slog.Duration("interval", jiraRunnerInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Jira runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.checkPendingApprovals(ctx)
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) checkPendingApprovals(ctx context.Context) {
+	setting, err := r.store.GetJiraSetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get Jira setting.", log.BBError(err))
+		return
+	}
+	if setting.Url == "" {
+		return
+	}
+	externalApprovalType := api.ExternalApprovalTypeJira
+	approvals, err := r.store.ListExternalApprovalV2(ctx, &store.ListExternalApprovalMessage{
+		Type: &externalApprovalType,
+	})
+	if err != nil {
+		slog.Error("Failed to list Jira external approvals.", log.BBError(err))
+		return
+	}
+	client := jiraplugin.NewClient(setting.Url, setting.Email, setting.ApiToken)
+	for _, approval := range approvals {
+		issue, err := r.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &approval.IssueUID})
+		if err != nil {
+			slog.Error("Failed to get issue.", slog.Int("issue", approval.IssueUID), log.BBError(err))
+			continue
+		}
+		if issue == nil {
+			continue
+		}
+		key := issue.Payload.GetJiraIssueKey()
+		if key == "" {
+			continue
+		}
+		jiraIssue, err := client.GetIssue(key)
+		if err != nil {
+			slog.Warn("Failed to get Jira issue.", slog.String("key", key), log.BBError(err))
+			continue
+		}
+		if err := r.resolveApprovals(ctx, setting, issue, []*store.ExternalApprovalMessage{approval}, jiraIssue.Fields.Status.Name); err != nil {
+			slog.Error("Failed to resolve Jira external approval.", slog.Int("issue", issue.UID), log.BBError(err))
+		}
+	}
+}
+
+// syncIssueStatus mirrors the status of the Jira ticket to its linked issue.
+// The approved and rejected statuses resolve the pending JIRA mode external approvals of the issue,
+// and the statuses mirrored with the issue statuses change the issue status.
+func (r *Runner) syncIssueStatus(ctx context.Context, setting *storepb.JiraSetting, key, jiraStatus string) error {
+	issue, err := r.store.GetIssueV2(ctx, &store.FindIssueMessage{JiraIssueKey: &key})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get issue of Jira issue %s", key)
+	}
+	if issue == nil {
+		return nil
+	}
+
+	externalApprovalType := api.ExternalApprovalTypeJira
+	approvals, err := r.store.ListExternalApprovalV2(ctx, &store.ListExternalApprovalMessage{
+		IssueUID: &issue.UID,
+		Type:     &externalApprovalType,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list Jira external approvals of issue %d", issue.UID)
+	}
+	if err := r.resolveApprovals(ctx, setting, issue, approvals, jiraStatus); err != nil {
+		return err
+	}
+
+	newStatus := getIssueStatus(setting, jiraStatus)
+	if newStatus == "" || newStatus == issue.Status {
+		return nil
+	}
+	return utils.ChangeIssueStatus(ctx, r.store, r.activityManager, issue, newStatus, api.SystemBotID, fmt.Sprintf("Synced from Jira issue %s.", key))
+}
+
+// resolveApprovals approves or rejects the external approval nodes of the approvals by the Jira status, and archives the approvals.
+func (r *Runner) resolveApprovals(ctx context.Context, setting *storepb.JiraSetting, issue *store.IssueMessage, approvals []*store.ExternalApprovalMessage, jiraStatus string) error {
+	for _, approval := range approvals {
+		switch {
+		case setting.ApprovedStatus != "" && strings.EqualFold(jiraStatus, setting.ApprovedStatus):
+			if err := r.relayRunner.ApproveExternalApprovalNode(ctx, issue.UID); err != nil {
+				return errors.Wrapf(err, "failed to approve external approval node of issue %d", issue.UID)
+			}
+		case setting.RejectedStatus != "" && strings.EqualFold(jiraStatus, setting.RejectedStatus):
+			if err := r.relayRunner.RejectExternalApprovalNode(ctx, issue.UID); err != nil {
+				return errors.Wrapf(err, "failed to reject external approval node of issue %d", issue.UID)
+			}
+		default:
+			continue
+		}
+		if _, err := r.store.UpdateExternalApprovalV2(ctx, &store.UpdateExternalApprovalMessage{
+			ID:        approval.ID,
+			RowStatus: api.Archived,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to archive external approval %d", approval.ID)
+		}
+	}
+	return nil
+}
+
+// getIssueStatus returns the issue status mirrored with the Jira status, empty if none.
+func getIssueStatus(setting *storepb.JiraSetting, jiraStatus string) api.IssueStatus {
+	switch {
+	case setting.OpenStatus != "" && strings.EqualFold(jiraStatus, setting.OpenStatus):
+		return api.IssueOpen
+	case setting.DoneStatus != "" && strings.EqualFold(jiraStatus, setting.DoneStatus):
+		return api.IssueDone
+	case setting.CanceledStatus != "" && strings.EqualFold(jiraStatus, setting.CanceledStatus):
+		return api.IssueCanceled
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,31 @@
+package jira
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/component/activity"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetIssueStatus(t *testing.T) {
+	a := require.New(t)
+
+	setting := &storepb.JiraSetting{
+		OpenStatus: "To Do",
+		DoneStatus: "Done",
+	}
+	a.Equal(api.IssueOpen, getIssueStatus(setting, "to do"))
+	a.Equal(api.IssueDone, getIssueStatus(setting, "Done"))
+	a.Equal(api.IssueStatus(""), getIssueStatus(setting, "In Progress"))
+	// The status not configured is not mirrored.
+	a.Equal(api.IssueStatus(""), getIssueStatus(setting, ""))
+
+	// The issue statuses round trip through the Jira statuses.
+	for _, status := range []api.IssueStatus{api.IssueOpen, api.IssueDone} {
+		a.Equal(status, getIssueStatus(setting, activity.GetJiraStatus(setting, status)))
+	}
+	a.Empty(activity.GetJiraStatus(setting, api.IssueCanceled))
+}
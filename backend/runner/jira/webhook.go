@@ -0,0 +1,44 @@
+package jira
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	jiraplugin "github.com/bytebase/bytebase/backend/plugin/app/jira"
+)
+
+// webhookPayload is the request body posted by the Jira webhook.
+type webhookPayload struct {
+	WebhookEvent string            `json:"webhookEvent"`
+	Issue        *jiraplugin.Issue `json:"issue"`
+}
+
+// RegisterWebhookRoutes registers the route of the Jira webhook posting the issue updates.
+func (r *Runner) RegisterWebhookRoutes(g *echo.Group) {
+	g.POST("/jira/:token", func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		setting, err := r.store.GetJiraSetting(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get Jira setting").SetInternal(err)
+		}
+		if setting.Url == "" || setting.WebhookToken == "" || subtle.ConstantTimeCompare([]byte(setting.WebhookToken), []byte(c.Param("token"))) != 1 {
+			return echo.NewHTTPError(http.StatusNotFound, "Jira webhook not found")
+		}
+
+		var body webhookPayload
+		if err := json.NewDecoder(c.Request().Body).Decode(&body); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Malformed Jira webhook").SetInternal(err)
+		}
+		if body.Issue == nil || body.Issue.Key == "" {
+			return c.String(http.StatusOK, "OK")
+		}
+		if err := r.syncIssueStatus(ctx, setting, body.Issue.Key, body.Issue.Fields.Status.Name); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to sync Jira issue").SetInternal(err)
+		}
+		return c.String(http.StatusOK, "OK")
+	})
+}
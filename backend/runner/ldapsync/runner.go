@@ -0,0 +1,226 @@
+// Package ldapsync is the runner syncing the users signed in through the LDAP identity providers with the mapped LDAP groups.
+package ldapsync
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/idp/ldap"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const ldapSyncInterval = 1 * time.Hour
+
+// NewRunner creates a new LDAP sync runner.
+func NewRunner(store *store.Store, licenseService enterprise.LicenseService) *Runner {
+	return &Runner{
+		store:          store,
+		licenseService: licenseService,
+	}
+}
+
+// Runner is the runner deactivating the users removed from the mapped LDAP groups and syncing the roles of the others.
+type Runner struct {
+	store          *store.Store
+	licenseService enterprise.LicenseService
+}
+
+// Run is the runner for LDAP sync runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(ldapSyncInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug("LDAP sync runner started", slog.Duration("interval", ldapSyncInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("LDAP sync runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.syncIdentityProviders(ctx)
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) syncIdentityProviders(ctx context.Context) {
+	if err := r.licenseService.IsFeatureEnabled(api.FeatureSSO); err != nil {
+		return
+	}
+	idps, err := r.store.ListIdentityProviders(ctx, &store.FindIdentityProviderMessage{})
+	if err != nil {
+		slog.Error("Failed to list identity providers.", log.BBError(err))
+		return
+	}
+	for _, idp := range idps {
+		if idp.Type != storepb.IdentityProviderType_LDAP || len(idp.Config.GetLdapConfig().GetGroupMappings()) == 0 {
+			continue
+		}
+		if err := r.syncIdentityProvider(ctx, idp); err != nil {
+			slog.Error("Failed to sync LDAP identity provider", slog.String("idp", idp.ResourceID), log.BBError(err))
+		}
+	}
+}
+
+func (r *Runner) syncIdentityProvider(ctx context.Context, idp *store.IdentityProviderMessage) error {
+	config := idp.Config.GetLdapConfig()
+	provider, err := NewIdentityProvider(config)
+	if err != nil {
+		return err
+	}
+	idpUsers, err := r.store.ListIdentityProviderUsers(ctx, &store.FindIdentityProviderUserMessage{IdentityProviderUID: &idp.UID})
+	if err != nil {
+		return err
+	}
+	for _, idpUser := range idpUsers {
+		user, err := r.store.GetUserByID(ctx, idpUser.PrincipalUID)
+		if err != nil {
+			return err
+		}
+		if user == nil || user.MemberDeleted {
+			continue
+		}
+		groups, err := provider.GetUserGroups(idpUser.Identifier)
+		if err != nil && !errors.Is(err, ldap.ErrUserNotFound) {
+			// Keep the user if the LDAP server is unavailable.
+			return errors.Wrapf(err, "failed to get the groups of user %q", idpUser.Identifier)
+		}
+		if !IsMappedGroupMember(config.GroupMappings, groups) {
+			slog.Info("Deactivate the user removed from the mapped LDAP groups", slog.String("idp", idp.ResourceID), slog.String("user", user.Email))
+			deleted := true
+			if user, err = r.store.UpdateUser(ctx, user.ID, &store.UpdateUserMessage{Delete: &deleted}, api.SystemBotID); err != nil {
+				return err
+			}
+		}
+		if err := SyncUserRoles(ctx, r.store, config.GroupMappings, user, groups); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewIdentityProvider creates the LDAP identity provider of the config.
+func NewIdentityProvider(config *storepb.LDAPIdentityProviderConfig) (*ldap.IdentityProvider, error) {
+	return ldap.NewIdentityProvider(
+		ldap.IdentityProviderConfig{
+			Host:                 config.Host,
+			Port:                 int(config.Port),
+			SkipTLSVerify:        config.SkipTlsVerify,
+			BindDN:               config.BindDn,
+			BindPassword:         config.BindPassword,
+			BaseDN:               config.BaseDn,
+			UserFilter:           config.UserFilter,
+			SecurityProtocol:     ldap.SecurityProtocol(config.SecurityProtocol),
+			FieldMapping:         config.FieldMapping,
+			GroupBaseDN:          config.GroupBaseDn,
+			GroupFilter:          config.GroupFilter,
+			GroupMemberAttribute: config.GroupMemberAttribute,
+		},
+	)
+}
+
+// IsMappedGroupMember returns whether the groups contain any mapped group.
+func IsMappedGroupMember(mappings []*storepb.LDAPGroupMapping, groups []string) bool {
+	for _, mapping := range mappings {
+		if slices.Contains(groups, ldap.NormalizeDNOrLower(mapping.Group)) {
+			return true
+		}
+	}
+	return false
+}
+
+type projectRole struct {
+	projectID string
+	role      api.Role
+}
+
+// SyncUserRoles syncs the roles of the user with the mappings of the LDAP groups of the user.
+// The workspace roles and the project roles in the mappings are managed by LDAP, that is,
+// they are granted to the members of the mapped groups and revoked from the others.
+// The other roles granted manually are kept.
+func SyncUserRoles(ctx context.Context, s *store.Store, mappings []*storepb.LDAPGroupMapping, user *store.UserMessage, groups []string) error {
+	var managedRoles, grantedRoles []api.Role
+	var managedProjectRoles, grantedProjectRoles []projectRole
+	for _, mapping := range mappings {
+		isMember := slices.Contains(groups, ldap.NormalizeDNOrLower(mapping.Group))
+		if mapping.WorkspaceRole != "" {
+			// The mappings are validated when updating the identity provider.
+			roleID, _ := common.GetRoleID(mapping.WorkspaceRole)
+			managedRoles = append(managedRoles, api.Role(roleID))
+			if isMember {
+				grantedRoles = append(grantedRoles, api.Role(roleID))
+			}
+		}
+		for _, r := range mapping.ProjectRoles {
+			projectID, _ := common.GetProjectID(r.Project)
+			roleID, _ := common.GetRoleID(r.Role)
+			pr := projectRole{projectID: projectID, role: api.Role(roleID)}
+			managedProjectRoles = append(managedProjectRoles, pr)
+			if isMember {
+				grantedProjectRoles = append(grantedProjectRoles, pr)
+			}
+		}
+	}
+
+	if roles := getWorkspaceRoles(user.Roles, managedRoles, grantedRoles); !slices.Equal(roles, user.Roles) {
+		updated, err := s.UpdateUser(ctx, user.ID, &store.UpdateUserMessage{Roles: &roles}, api.SystemBotID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to update the roles of user %q", user.Email)
+		}
+		user = updated
+	}
+
+	for _, pr := range managedProjectRoles {
+		project, err := s.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &pr.projectID})
+		if err != nil {
+			return err
+		}
+		if project == nil || project.Deleted {
+			slog.Warn("Skip the project role of the LDAP group mapping", slog.String("project", pr.projectID), slog.String("role", string(pr.role)))
+			continue
+		}
+		if err := s.UpdateProjectMemberRole(ctx, project.UID, pr.role, user, slices.Contains(grantedProjectRoles, pr), api.SystemBotID); err != nil {
+			return errors.Wrapf(err, "failed to update the role %q of user %q in project %q", pr.role, user.Email, pr.projectID)
+		}
+	}
+	return nil
+}
+
+// getWorkspaceRoles returns the workspace roles without the managed roles not granted, and with the granted roles.
+func getWorkspaceRoles(current, managed, granted []api.Role) []api.Role {
+	var roles []api.Role
+	for _, role := range current {
+		if slices.Contains(managed, role) && !slices.Contains(granted, role) {
+			continue
+		}
+		roles = append(roles, role)
+	}
+	for _, role := range granted {
+		if !slices.Contains(roles, role) {
+			roles = append(roles, role)
+		}
+	}
+	if len(roles) == 0 {
+		roles = []api.Role{api.WorkspaceMember}
+	}
+	return roles
+}
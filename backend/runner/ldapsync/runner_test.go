@@ -0,0 +1,32 @@
+package ldapsync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestIsMappedGroupMember(t *testing.T) {
+	a := require.New(t)
+
+	mappings := []*storepb.LDAPGroupMapping{
+		{Group: "CN=DBA, OU=Groups,DC=example,DC=com", WorkspaceRole: "roles/workspaceDBA"},
+	}
+	a.True(IsMappedGroupMember(mappings, []string{"cn=engineering,ou=groups,dc=example,dc=com", "cn=dba,ou=groups,dc=example,dc=com"}))
+	a.False(IsMappedGroupMember(mappings, []string{"cn=engineering,ou=groups,dc=example,dc=com"}))
+	a.False(IsMappedGroupMember(mappings, nil))
+}
+
+func TestGetWorkspaceRoles(t *testing.T) {
+	a := require.New(t)
+
+	managed := []api.Role{api.WorkspaceAdmin, api.WorkspaceDBA}
+	// The roles granted manually are kept.
+	a.Equal([]api.Role{api.WorkspaceMember, api.WorkspaceDBA}, getWorkspaceRoles([]api.Role{api.WorkspaceMember, api.WorkspaceAdmin}, managed, []api.Role{api.WorkspaceDBA}))
+	a.Equal([]api.Role{api.WorkspaceDBA}, getWorkspaceRoles([]api.Role{api.WorkspaceDBA}, managed, []api.Role{api.WorkspaceDBA}))
+	// The user has the workspace member role at least.
+	a.Equal([]api.Role{api.WorkspaceMember}, getWorkspaceRoles([]api.Role{api.WorkspaceAdmin}, managed, nil))
+}
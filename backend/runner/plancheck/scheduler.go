@@ -106,9 +106,18 @@ func (s *Scheduler) runPlanCheckRun(ctx context.Context, planCheckRun *store.Pla
 	}
 
 	instanceUID := int(planCheckRun.Config.InstanceUid)
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &instanceUID})
+	if err != nil {
+		slog.Error("Failed to get instance for plan check", slog.Int("instance_uid", instanceUID), log.BBError(err))
+		return
+	}
+	var instanceOptions *storepb.InstanceOptions
+	if instance != nil {
+		instanceOptions = instance.Options
+	}
 
 	s.stateCfg.Lock()
-	if s.stateCfg.InstanceOutstandingConnections[instanceUID] >= state.InstanceMaximumConnectionNumber {
+	if s.stateCfg.InstanceOutstandingConnections[instanceUID] >= state.GetInstanceMaximumConnectionNumber(instanceOptions) {
 		s.stateCfg.Unlock()
 		return
 	}
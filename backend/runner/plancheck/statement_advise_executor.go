@@ -3,6 +3,7 @@ package plancheck
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -17,6 +18,9 @@ import (
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
+// sqlReviewTimeBudget bounds the SQL review of a database, the rules not checked within the budget are reported as skipped.
+const sqlReviewTimeBudget = 2 * time.Minute
+
 // NewStatementAdviseExecutor creates a plan check statement advise executor.
 func NewStatementAdviseExecutor(
 	store *store.Store,
@@ -161,17 +165,21 @@ func (e *StatementAdviseExecutor) runForDatabaseTarget(ctx context.Context, conf
 	materials := utils.GetSecretMapFromDatabaseMessage(database)
 	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
 	renderedStatement := utils.RenderStatement(statement, materials)
+	stats := &advisor.SQLReviewCheckStats{}
 	adviceList, err := advisor.SQLReviewCheck(renderedStatement, policy.RuleList, advisor.SQLReviewCheckContext{
-		Charset:   dbSchema.GetMetadata().CharacterSet,
-		Collation: dbSchema.GetMetadata().Collation,
-		DbType:    instance.Engine,
-		Catalog:   catalog,
-		Driver:    connection,
-		Context:   ctx,
+		Charset:    dbSchema.GetMetadata().CharacterSet,
+		Collation:  dbSchema.GetMetadata().Collation,
+		DbType:     instance.Engine,
+		Catalog:    catalog,
+		Driver:     connection,
+		Context:    ctx,
+		TimeBudget: sqlReviewTimeBudget,
+		Stats:      stats,
 	})
 	if err != nil {
 		return nil, err
 	}
+	advisor.ObserveSQLReviewCheck("plan_check", instance.Engine, stats)
 
 	var results []*storepb.PlanCheckRunResult_Result
 	for _, advice := range adviceList {
@@ -246,7 +254,7 @@ func (e *StatementAdviseExecutor) runForDatabaseGroupTarget(ctx context.Context,
 		return nil, errors.Wrapf(err, "failed to list databases for project %q", project.ResourceID)
 	}
 
-	matchedDatabases, _, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, databaseGroup, allDatabases)
+	matchedDatabases, _, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, e.store, databaseGroup, allDatabases)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get matched and unmatched databases in database group %q", databaseGroup.ResourceID)
 	}
@@ -373,17 +381,21 @@ func (e *StatementAdviseExecutor) runForDatabaseGroupTarget(ctx context.Context,
 				materials := utils.GetSecretMapFromDatabaseMessage(database)
 				// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
 				renderedStatement := utils.RenderStatement(statement, materials)
+				stats := &advisor.SQLReviewCheckStats{}
 				adviceList, err := advisor.SQLReviewCheck(renderedStatement, policy.RuleList, advisor.SQLReviewCheckContext{
-					Charset:   dbSchema.GetMetadata().CharacterSet,
-					Collation: dbSchema.GetMetadata().Collation,
-					DbType:    instance.Engine,
-					Catalog:   catalog,
-					Driver:    connection,
-					Context:   ctx,
+					Charset:    dbSchema.GetMetadata().CharacterSet,
+					Collation:  dbSchema.GetMetadata().Collation,
+					DbType:     instance.Engine,
+					Catalog:    catalog,
+					Driver:     connection,
+					Context:    ctx,
+					TimeBudget: sqlReviewTimeBudget,
+					Stats:      stats,
 				})
 				if err != nil {
 					return nil, err
 				}
+				advisor.ObserveSQLReviewCheck("plan_check", instance.Engine, stats)
 
 				var results []*storepb.PlanCheckRunResult_Result
 				for _, advice := range adviceList {
@@ -0,0 +1,309 @@
+package plancheck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// maxBulkAdviseDatabaseNames is the maximum number of database names listed in a bulk advise finding.
+const maxBulkAdviseDatabaseNames = 10
+
+// NewStatementBulkAdviseExecutor creates a plan check statement bulk advise executor.
+func NewStatementBulkAdviseExecutor(
+	store *store.Store,
+	licenseService enterprise.LicenseService,
+) Executor {
+	return &StatementBulkAdviseExecutor{
+		store:          store,
+		licenseService: licenseService,
+	}
+}
+
+// StatementBulkAdviseExecutor is the plan check statement bulk advise executor.
+// It reviews the statement against the schema snapshot of every target database instead of connecting to them,
+// and reports each finding with the databases it's found on.
+type StatementBulkAdviseExecutor struct {
+	store          *store.Store
+	licenseService enterprise.LicenseService
+}
+
+// bulkAdviseTarget is a database reviewed by the bulk advise.
+type bulkAdviseTarget struct {
+	instance *store.InstanceMessage
+	database *store.DatabaseMessage
+}
+
+// bulkAdviseFinding is the advice found on one or more target databases.
+type bulkAdviseFinding struct {
+	advice    advisor.Advice
+	databases []string
+}
+
+// Run will run the plan check statement bulk advise executor once.
+func (e *StatementBulkAdviseExecutor) Run(ctx context.Context, config *storepb.PlanCheckRunConfig) ([]*storepb.PlanCheckRunResult_Result, error) {
+	if config.ChangeDatabaseType == storepb.PlanCheckRunConfig_CHANGE_DATABASE_TYPE_UNSPECIFIED {
+		return nil, errors.Errorf("change database type is unspecified")
+	}
+	if err := e.licenseService.IsFeatureEnabled(api.FeatureSQLReview); err != nil {
+		// nolint:nilerr
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Code:    0,
+				Title:   "SQL review disabled",
+				Content: err.Error(),
+				Report: &storepb.PlanCheckRunResult_Result_SqlReviewReport_{
+					SqlReviewReport: &storepb.PlanCheckRunResult_Result_SqlReviewReport{
+						Line:   0,
+						Detail: "",
+						Code:   advisor.Unsupported.Int32(),
+					},
+				},
+			},
+		}, nil
+	}
+
+	sheetUID := int(config.SheetUid)
+	sheet, err := e.store.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID}, api.SystemBotID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet %d", sheetUID)
+	}
+	if sheet == nil {
+		return nil, errors.Errorf("sheet %d not found", sheetUID)
+	}
+	if sheet.Size > common.MaxSheetCheckSize {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Code:    common.SizeExceeded.Int32(),
+				Title:   "Large SQL review policy is disabled",
+				Content: "",
+			},
+		}, nil
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet statement %d", sheetUID)
+	}
+
+	var schemaGroups []*store.SchemaGroupMessage
+	if config.DatabaseGroupUid != nil {
+		schemaGroups, err = e.store.ListSchemaGroups(ctx, &store.FindSchemaGroupMessage{DatabaseGroupUID: config.DatabaseGroupUid})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list schema groups for database group %d", *config.DatabaseGroupUid)
+		}
+	}
+
+	targets, err := e.getTargets(ctx, config.DatabaseTargets)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []*bulkAdviseFinding
+	findingIndex := make(map[advisor.Advice]int)
+	for _, target := range targets {
+		name := common.FormatDatabase(target.instance.ResourceID, target.database.DatabaseName)
+		adviceList, err := e.reviewTarget(ctx, config.ChangeDatabaseType, target, statement, schemaGroups)
+		if err != nil {
+			adviceList = []advisor.Advice{
+				{
+					Status:  advisor.Error,
+					Code:    advisor.Internal,
+					Title:   "Failed to run SQL review",
+					Content: err.Error(),
+				},
+			}
+		}
+		for _, advice := range adviceList {
+			if advice.Status == advisor.Success {
+				continue
+			}
+			// The statement is the same for all the targets, so the same advice is found at the same position.
+			key := advisor.Advice{
+				Status:  advice.Status,
+				Code:    advice.Code,
+				Title:   advice.Title,
+				Content: advice.Content,
+				Line:    advice.Line,
+				Column:  advice.Column,
+			}
+			i, ok := findingIndex[key]
+			if !ok {
+				i = len(findings)
+				findingIndex[key] = i
+				findings = append(findings, &bulkAdviseFinding{advice: advice})
+			}
+			findings[i].databases = append(findings[i].databases, name)
+		}
+	}
+
+	return convertBulkAdviseFindings(findings, len(targets)), nil
+}
+
+func (e *StatementBulkAdviseExecutor) getTargets(ctx context.Context, databaseTargets []*storepb.PlanCheckRunConfig_DatabaseTarget) ([]*bulkAdviseTarget, error) {
+	var targets []*bulkAdviseTarget
+	for _, databaseTarget := range databaseTargets {
+		instanceUID := int(databaseTarget.InstanceUid)
+		instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &instanceUID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get instance UID %v", instanceUID)
+		}
+		if instance == nil {
+			return nil, errors.Errorf("instance not found UID %v", instanceUID)
+		}
+		database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &databaseTarget.DatabaseName})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get database %q", databaseTarget.DatabaseName)
+		}
+		if database == nil {
+			return nil, errors.Errorf("database not found %q", databaseTarget.DatabaseName)
+		}
+		targets = append(targets, &bulkAdviseTarget{instance: instance, database: database})
+	}
+	return targets, nil
+}
+
+// reviewTarget reviews the statement against the schema snapshot of the target database.
+func (e *StatementBulkAdviseExecutor) reviewTarget(ctx context.Context, changeType storepb.PlanCheckRunConfig_ChangeDatabaseType, target *bulkAdviseTarget, statement string, schemaGroups []*store.SchemaGroupMessage) ([]advisor.Advice, error) {
+	instance, database := target.instance, target.database
+	if !isStatementAdviseSupported(instance.Engine) {
+		return []advisor.Advice{
+			{
+				Status: advisor.Warn,
+				Code:   advisor.Unsupported,
+				Title:  fmt.Sprintf("Statement advise is not supported for %s", instance.Engine),
+			},
+		}, nil
+	}
+
+	environment, err := e.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &database.EffectiveEnvironmentID})
+	if err != nil {
+		return nil, err
+	}
+	if environment == nil {
+		return nil, errors.Errorf("environment %q not found", database.EffectiveEnvironmentID)
+	}
+	policy, err := e.store.GetSQLReviewPolicy(ctx, environment.UID)
+	if err != nil {
+		if e, ok := err.(*common.Error); ok && e.Code == common.NotFound {
+			policy = &storepb.SQLReviewPolicy{
+				Name: "Default",
+			}
+		} else {
+			return nil, common.Wrapf(err, common.Internal, "failed to get SQL review policy")
+		}
+	}
+
+	dbSchema, err := e.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get db schema %q", database.UID)
+	}
+	if dbSchema == nil {
+		return nil, errors.Errorf("schema of database %q is not synced", database.DatabaseName)
+	}
+
+	statements := []string{statement}
+	if len(schemaGroups) > 0 {
+		schemaGroupsMatchedTables := map[string][]string{}
+		for _, schemaGroup := range schemaGroups {
+			matches, _, err := utils.GetMatchedAndUnmatchedTablesInSchemaGroup(ctx, dbSchema, schemaGroup)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get matched and unmatched tables in schema group %q", schemaGroup.ResourceID)
+			}
+			schemaGroupsMatchedTables[schemaGroup.ResourceID] = matches
+		}
+		parserEngineType, err := utils.ConvertDatabaseToParserEngineType(instance.Engine)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert database engine %q to parser engine type", instance.Engine)
+		}
+		statements, _, err = utils.GetStatementsAndSchemaGroupsFromSchemaGroups(statement, parserEngineType, "", schemaGroups, schemaGroupsMatchedTables)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get statements from schema groups")
+		}
+	}
+
+	materials := utils.GetSecretMapFromDatabaseMessage(database)
+	var adviceList []advisor.Advice
+	for _, statement := range statements {
+		// The catalog is changed by the walk-through, so it's created for each statement.
+		catalog, err := e.store.NewCatalog(ctx, database.UID, instance.Engine, store.IgnoreDatabaseAndTableCaseSensitive(instance), getSyntaxMode(changeType))
+		if err != nil {
+			return nil, common.Wrapf(err, common.Internal, "failed to create a catalog")
+		}
+		// The driver is not set because the review only relies on the schema snapshot,
+		// the advisors requiring the connection are skipped.
+		stats := &advisor.SQLReviewCheckStats{}
+		advices, err := advisor.SQLReviewCheck(utils.RenderStatement(statement, materials), policy.RuleList, advisor.SQLReviewCheckContext{
+			Charset:    dbSchema.GetMetadata().CharacterSet,
+			Collation:  dbSchema.GetMetadata().Collation,
+			DbType:     instance.Engine,
+			Catalog:    catalog,
+			Context:    ctx,
+			TimeBudget: sqlReviewTimeBudget,
+			Stats:      stats,
+		})
+		if err != nil {
+			return nil, err
+		}
+		advisor.ObserveSQLReviewCheck("plan_check", instance.Engine, stats)
+		adviceList = append(adviceList, advices...)
+	}
+	return adviceList, nil
+}
+
+// convertBulkAdviseFindings converts the findings to the plan check results, each result tells how many of the databases the advice is found on.
+func convertBulkAdviseFindings(findings []*bulkAdviseFinding, total int) []*storepb.PlanCheckRunResult_Result {
+	if len(findings) == 0 {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Title:   "OK",
+				Content: fmt.Sprintf("No SQL review findings on %d databases", total),
+				Code:    common.Ok.Int32(),
+				Report:  nil,
+			},
+		}
+	}
+
+	var results []*storepb.PlanCheckRunResult_Result
+	for _, finding := range findings {
+		status := storepb.PlanCheckRunResult_Result_WARNING
+		if finding.advice.Status == advisor.Error {
+			status = storepb.PlanCheckRunResult_Result_ERROR
+		}
+		names := finding.databases
+		if len(names) > maxBulkAdviseDatabaseNames {
+			names = append(names[:maxBulkAdviseDatabaseNames:maxBulkAdviseDatabaseNames], fmt.Sprintf("and %d more", len(finding.databases)-maxBulkAdviseDatabaseNames))
+		}
+		content := fmt.Sprintf("Found on %d of %d databases: %s", len(finding.databases), total, strings.Join(names, ", "))
+		if finding.advice.Content != "" {
+			content = fmt.Sprintf("%s\n%s", finding.advice.Content, content)
+		}
+		results = append(results, &storepb.PlanCheckRunResult_Result{
+			Status:  status,
+			Title:   finding.advice.Title,
+			Content: content,
+			Code:    0,
+			Report: &storepb.PlanCheckRunResult_Result_SqlReviewReport_{
+				SqlReviewReport: &storepb.PlanCheckRunResult_Result_SqlReviewReport{
+					Line:   int32(finding.advice.Line),
+					Column: int32(finding.advice.Column),
+					Code:   finding.advice.Code.Int32(),
+					Detail: finding.advice.Details,
+				},
+			},
+		})
+	}
+	return results
+}
@@ -0,0 +1,55 @@
+package plancheck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestConvertBulkAdviseFindings(t *testing.T) {
+	a := require.New(t)
+
+	results := convertBulkAdviseFindings(nil, 50)
+	a.Len(results, 1)
+	a.Equal(storepb.PlanCheckRunResult_Result_SUCCESS, results[0].Status)
+	a.Equal("No SQL review findings on 50 databases", results[0].Content)
+
+	var databases []string
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"} {
+		databases = append(databases, "instances/prod/databases/"+name)
+	}
+	results = convertBulkAdviseFindings([]*bulkAdviseFinding{
+		{
+			advice: advisor.Advice{
+				Status:  advisor.Error,
+				Code:    advisor.ColumnExists,
+				Title:   "Column already exists",
+				Content: "The column \"name\" already exists in table \"user\"",
+				Line:    1,
+			},
+			databases: databases[:3],
+		},
+		{
+			advice: advisor.Advice{
+				Status: advisor.Warn,
+				Code:   advisor.NoTableComment,
+				Title:  "Table comment is required",
+				Line:   2,
+			},
+			databases: databases,
+		},
+	}, 50)
+	a.Len(results, 2)
+	a.Equal(storepb.PlanCheckRunResult_Result_ERROR, results[0].Status)
+	a.Equal("Column already exists", results[0].Title)
+	a.Equal("The column \"name\" already exists in table \"user\"\nFound on 3 of 50 databases: instances/prod/databases/a, instances/prod/databases/b, instances/prod/databases/c", results[0].Content)
+	a.Equal(advisor.ColumnExists.Int32(), results[0].GetSqlReviewReport().Code)
+	a.Equal(int32(1), results[0].GetSqlReviewReport().Line)
+	a.Equal(storepb.PlanCheckRunResult_Result_WARNING, results[1].Status)
+	a.Equal("Found on 12 of 50 databases: instances/prod/databases/a, instances/prod/databases/b, instances/prod/databases/c, instances/prod/databases/d, instances/prod/databases/e, instances/prod/databases/f, instances/prod/databases/g, instances/prod/databases/h, instances/prod/databases/i, instances/prod/databases/j, and 2 more", results[1].Content)
+	// The databases of the finding are not overwritten by the truncated names.
+	a.Equal("instances/prod/databases/k", databases[10])
+}
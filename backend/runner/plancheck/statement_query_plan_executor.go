@@ -0,0 +1,322 @@
+package plancheck
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// defaultCostIncreaseFactor is the cost increase factor to flag if it is not configured.
+	defaultCostIncreaseFactor = 1.5
+	// queryPlanLockTimeout and queryPlanStatementTimeout limit the time the migration holds the locks
+	// in the transaction, as it runs against the production database.
+	queryPlanLockTimeout      = "3s"
+	queryPlanStatementTimeout = "10s"
+	queryPlanSavepoint        = "bb_query_plan"
+)
+
+// NewStatementQueryPlanExecutor creates a statement query plan executor.
+func NewStatementQueryPlanExecutor(store *store.Store, dbFactory *dbfactory.DBFactory) Executor {
+	return &StatementQueryPlanExecutor{
+		store:     store,
+		dbFactory: dbFactory,
+	}
+}
+
+// StatementQueryPlanExecutor is the statement query plan executor.
+// It explains the benchmark queries of the project before and after applying the statement in a transaction,
+// and rolls back the transaction so that the database is not changed.
+type StatementQueryPlanExecutor struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+}
+
+// queryPlan is the plan of a query from EXPLAIN.
+type queryPlan struct {
+	cost float64
+	// sequentialScans is the tables scanned sequentially.
+	sequentialScans []string
+	text            string
+}
+
+// Run runs the statement query plan executor.
+func (e *StatementQueryPlanExecutor) Run(ctx context.Context, config *storepb.PlanCheckRunConfig) ([]*storepb.PlanCheckRunResult_Result, error) {
+	instanceUID := int(config.InstanceUid)
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &instanceUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance UID %v", instanceUID)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance not found UID %v", instanceUID)
+	}
+	if instance.Engine != storepb.Engine_POSTGRES {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   "Not available",
+				Content: fmt.Sprintf("Query plan check is not supported for %s", instance.Engine),
+			},
+		}, nil
+	}
+
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &config.DatabaseName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", config.DatabaseName)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found %q", config.DatabaseName)
+	}
+	project, err := e.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get project %q", database.ProjectID)
+	}
+	if project == nil {
+		return nil, errors.Errorf("project not found %q", database.ProjectID)
+	}
+	check := project.Setting.GetQueryPerformanceCheck()
+	if len(check.GetBenchmarkQueries()) == 0 {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   "OK",
+				Content: "No benchmark queries are configured in the project",
+			},
+		}, nil
+	}
+	factor := check.GetCostIncreaseFactor()
+	if factor == 0 {
+		factor = defaultCostIncreaseFactor
+	}
+
+	sheetUID := int(config.SheetUid)
+	sheet, err := e.store.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID}, api.SystemBotID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet %d", sheetUID)
+	}
+	if sheet == nil {
+		return nil, errors.Errorf("sheet %d not found", sheetUID)
+	}
+	if sheet.Size > common.MaxSheetCheckSize {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Code:    common.SizeExceeded.Int32(),
+				Title:   "Query plan check for large SQL is not supported",
+				Content: "",
+			},
+		}, nil
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet statement %d", sheetUID)
+	}
+	materials := utils.GetSecretMapFromDatabaseMessage(database)
+	renderedStatement := utils.RenderStatement(statement, materials)
+
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+
+	return explainBenchmarkQueries(ctx, driver.GetDB(), renderedStatement, check.GetBenchmarkQueries(), factor)
+}
+
+// explainBenchmarkQueries explains the queries before and after applying the statement in a transaction which is always rolled back.
+// PostgreSQL supports the transactional DDL, so the plans after the migration see the new schema.
+func explainBenchmarkQueries(ctx context.Context, sqlDB *sql.DB, statement string, queries []*storepb.BenchmarkQuery, factor float64) ([]*storepb.PlanCheckRunResult_Result, error) {
+	tx, err := sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL lock_timeout = '%s'", queryPlanLockTimeout)); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL statement_timeout = '%s'", queryPlanStatementTimeout)); err != nil {
+		return nil, err
+	}
+
+	befores := make([]*queryPlan, len(queries))
+	beforeErrors := make([]error, len(queries))
+	for i, query := range queries {
+		befores[i], beforeErrors[i] = explainQuery(ctx, tx, query.Statement)
+	}
+
+	if _, err := tx.ExecContext(ctx, statement); err != nil {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status: storepb.PlanCheckRunResult_Result_WARNING,
+				Code:   common.DbExecutionError.Int32(),
+				Title:  "Failed to apply the statement to compare the query plans",
+				Content: fmt.Sprintf("The statement must be able to run in a transaction within %s, e.g. CREATE INDEX CONCURRENTLY is not supported: %v",
+					queryPlanStatementTimeout, err),
+			},
+		}, nil
+	}
+
+	var results []*storepb.PlanCheckRunResult_Result
+	for i, query := range queries {
+		if beforeErrors[i] != nil {
+			results = append(results, &storepb.PlanCheckRunResult_Result{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Code:    common.DbExecutionError.Int32(),
+				Title:   fmt.Sprintf("Failed to explain the benchmark query %q", query.Title),
+				Content: beforeErrors[i].Error(),
+			})
+			continue
+		}
+		after, err := explainQuery(ctx, tx, query.Statement)
+		if err != nil {
+			results = append(results, &storepb.PlanCheckRunResult_Result{
+				Status:  storepb.PlanCheckRunResult_Result_ERROR,
+				Code:    common.TaskQueryPlanRegressed.Int32(),
+				Title:   fmt.Sprintf("The benchmark query %q fails after the migration", query.Title),
+				Content: err.Error(),
+			})
+			continue
+		}
+		results = append(results, compareQueryPlans(query.Title, befores[i], after, factor))
+	}
+	return results, nil
+}
+
+// explainQuery explains the query in a savepoint, so that the failed query does not abort the transaction.
+func explainQuery(ctx context.Context, tx *sql.Tx, query string) (*queryPlan, error) {
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("SAVEPOINT %s", queryPlanSavepoint)); err != nil {
+		return nil, err
+	}
+	plan, err := func() (*queryPlan, error) {
+		var planJSON string
+		if err := tx.QueryRowContext(ctx, fmt.Sprintf("EXPLAIN (FORMAT JSON) %s", query)).Scan(&planJSON); err != nil {
+			return nil, err
+		}
+		plan, err := parseQueryPlan(planJSON)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := tx.QueryContext(ctx, fmt.Sprintf("EXPLAIN %s", query))
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		var lines []string
+		for rows.Next() {
+			var line string
+			if err := rows.Scan(&line); err != nil {
+				return nil, err
+			}
+			lines = append(lines, line)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		plan.text = strings.Join(lines, "\n")
+		return plan, nil
+	}()
+	if err != nil {
+		if _, rollbackErr := tx.ExecContext(ctx, fmt.Sprintf("ROLLBACK TO SAVEPOINT %s", queryPlanSavepoint)); rollbackErr != nil {
+			return nil, errors.Wrapf(rollbackErr, "failed to roll back to savepoint after error %v", err)
+		}
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("RELEASE SAVEPOINT %s", queryPlanSavepoint)); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+type explainPlanNode struct {
+	NodeType     string             `json:"Node Type"`
+	RelationName string             `json:"Relation Name"`
+	TotalCost    float64            `json:"Total Cost"`
+	Plans        []*explainPlanNode `json:"Plans"`
+}
+
+// parseQueryPlan parses the output of EXPLAIN (FORMAT JSON).
+func parseQueryPlan(planJSON string) (*queryPlan, error) {
+	var explains []struct {
+		Plan *explainPlanNode `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(planJSON), &explains); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the query plan")
+	}
+	if len(explains) == 0 || explains[0].Plan == nil {
+		return nil, errors.Errorf("query plan not found")
+	}
+
+	plan := &queryPlan{cost: explains[0].Plan.TotalCost}
+	var walk func(node *explainPlanNode)
+	walk = func(node *explainPlanNode) {
+		if node.NodeType == "Seq Scan" && node.RelationName != "" && !slices.Contains(plan.sequentialScans, node.RelationName) {
+			plan.sequentialScans = append(plan.sequentialScans, node.RelationName)
+		}
+		for _, child := range node.Plans {
+			walk(child)
+		}
+	}
+	walk(explains[0].Plan)
+	return plan, nil
+}
+
+// compareQueryPlans flags the plan after the migration if it scans a table sequentially which is not scanned sequentially before,
+// or its total cost increases by the factor.
+func compareQueryPlans(title string, before, after *queryPlan, factor float64) *storepb.PlanCheckRunResult_Result {
+	var newSequentialScans []string
+	for _, table := range after.sequentialScans {
+		if !slices.Contains(before.sequentialScans, table) {
+			newSequentialScans = append(newSequentialScans, table)
+		}
+	}
+	report := &storepb.PlanCheckRunResult_Result_QueryPlanReport_{
+		QueryPlanReport: &storepb.PlanCheckRunResult_Result_QueryPlanReport{
+			QueryTitle:         title,
+			CostBefore:         before.cost,
+			CostAfter:          after.cost,
+			NewSequentialScans: newSequentialScans,
+			PlanBefore:         before.text,
+			PlanAfter:          after.text,
+		},
+	}
+
+	var regressions []string
+	if len(newSequentialScans) > 0 {
+		regressions = append(regressions, fmt.Sprintf("new sequential scans on %s", strings.Join(newSequentialScans, ", ")))
+	}
+	if after.cost > before.cost*factor {
+		regressions = append(regressions, fmt.Sprintf("the total cost increases from %.2f to %.2f", before.cost, after.cost))
+	}
+	if len(regressions) > 0 {
+		return &storepb.PlanCheckRunResult_Result{
+			Status:  storepb.PlanCheckRunResult_Result_WARNING,
+			Code:    common.TaskQueryPlanRegressed.Int32(),
+			Title:   fmt.Sprintf("The plan of the benchmark query %q regresses", title),
+			Content: fmt.Sprintf("After the migration, %s.", strings.Join(regressions, " and ")),
+			Report:  report,
+		}
+	}
+	return &storepb.PlanCheckRunResult_Result{
+		Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+		Code:    common.Ok.Int32(),
+		Title:   fmt.Sprintf("The plan of the benchmark query %q does not regress", title),
+		Content: fmt.Sprintf("The total cost changes from %.2f to %.2f.", before.cost, after.cost),
+		Report:  report,
+	}
+}
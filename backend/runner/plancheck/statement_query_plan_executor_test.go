@@ -0,0 +1,54 @@
+package plancheck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestParseQueryPlan(t *testing.T) {
+	a := require.New(t)
+	plan, err := parseQueryPlan(`[
+  {
+    "Plan": {
+      "Node Type": "Hash Join",
+      "Total Cost": 37.52,
+      "Plans": [
+        {"Node Type": "Seq Scan", "Relation Name": "orders", "Total Cost": 20.5},
+        {"Node Type": "Hash", "Total Cost": 10.2, "Plans": [
+          {"Node Type": "Seq Scan", "Relation Name": "users", "Total Cost": 10.2},
+          {"Node Type": "Seq Scan", "Relation Name": "orders", "Total Cost": 20.5}
+        ]},
+        {"Node Type": "Index Scan", "Relation Name": "items", "Total Cost": 8.3}
+      ]
+    }
+  }
+]`)
+	a.NoError(err)
+	a.Equal(37.52, plan.cost)
+	a.Equal([]string{"orders", "users"}, plan.sequentialScans)
+
+	_, err = parseQueryPlan(`[]`)
+	a.Error(err)
+}
+
+func TestCompareQueryPlans(t *testing.T) {
+	a := require.New(t)
+	before := &queryPlan{cost: 10, sequentialScans: []string{"users"}, text: "before"}
+
+	got := compareQueryPlans("q", before, &queryPlan{cost: 14, sequentialScans: []string{"users"}, text: "after"}, 1.5)
+	a.Equal(storepb.PlanCheckRunResult_Result_SUCCESS, got.Status)
+	a.Equal("before", got.GetQueryPlanReport().PlanBefore)
+	a.Equal("after", got.GetQueryPlanReport().PlanAfter)
+
+	got = compareQueryPlans("q", before, &queryPlan{cost: 16, sequentialScans: []string{"users"}}, 1.5)
+	a.Equal(storepb.PlanCheckRunResult_Result_WARNING, got.Status)
+	a.Equal("After the migration, the total cost increases from 10.00 to 16.00.", got.Content)
+
+	got = compareQueryPlans("q", before, &queryPlan{cost: 12, sequentialScans: []string{"users", "orders"}}, 1.5)
+	a.Equal(storepb.PlanCheckRunResult_Result_WARNING, got.Status)
+	a.Equal([]string{"orders"}, got.GetQueryPlanReport().NewSequentialScans)
+	a.Equal("After the migration, new sequential scans on orders.", got.Content)
+}
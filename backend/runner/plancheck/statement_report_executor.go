@@ -125,7 +125,11 @@ func (e *StatementReportExecutor) runForDatabaseTarget(ctx context.Context, conf
 		defer driver.Close(ctx)
 		sqlDB := driver.GetDB()
 
-		return reportForPostgres(ctx, sqlDB, database.DatabaseName, renderedStatement, dbSchema.GetMetadata())
+		results, err := reportForPostgres(ctx, sqlDB, database.DatabaseName, renderedStatement, dbSchema.GetMetadata())
+		if err != nil {
+			return nil, err
+		}
+		return e.checkAffectedRowsLimit(ctx, config, database, results)
 	case storepb.Engine_MYSQL, storepb.Engine_OCEANBASE:
 		driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
 		if err != nil {
@@ -134,7 +138,11 @@ func (e *StatementReportExecutor) runForDatabaseTarget(ctx context.Context, conf
 		defer driver.Close(ctx)
 		sqlDB := driver.GetDB()
 
-		return reportForMySQL(ctx, sqlDB, instance.Engine, database.DatabaseName, renderedStatement, dbSchema)
+		results, err := reportForMySQL(ctx, sqlDB, instance.Engine, database.DatabaseName, renderedStatement, dbSchema)
+		if err != nil {
+			return nil, err
+		}
+		return e.checkAffectedRowsLimit(ctx, config, database, results)
 	case storepb.Engine_ORACLE, storepb.Engine_DM, storepb.Engine_OCEANBASE_ORACLE:
 		schema := ""
 		if instance.Options == nil || !instance.Options.SchemaTenantMode {
@@ -185,7 +193,7 @@ func (e *StatementReportExecutor) runForDatabaseGroupTarget(ctx context.Context,
 		return nil, errors.Wrapf(err, "failed to list databases for project %q", project.ResourceID)
 	}
 
-	matchedDatabases, _, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, databaseGroup, allDatabases)
+	matchedDatabases, _, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, e.store, databaseGroup, allDatabases)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get matched and unmatched databases in database group %q", databaseGroup.ResourceID)
 	}
@@ -282,7 +290,11 @@ func (e *StatementReportExecutor) runForDatabaseGroupTarget(ctx context.Context,
 					defer driver.Close(ctx)
 					sqlDB := driver.GetDB()
 
-					return reportForPostgres(ctx, sqlDB, database.DatabaseName, renderedStatement, dbSchema.GetMetadata())
+					results, err := reportForPostgres(ctx, sqlDB, database.DatabaseName, renderedStatement, dbSchema.GetMetadata())
+					if err != nil {
+						return nil, err
+					}
+					return e.checkAffectedRowsLimit(ctx, config, database, results)
 				case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
 					driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
 					if err != nil {
@@ -291,7 +303,11 @@ func (e *StatementReportExecutor) runForDatabaseGroupTarget(ctx context.Context,
 					defer driver.Close(ctx)
 					sqlDB := driver.GetDB()
 
-					return reportForMySQL(ctx, sqlDB, instance.Engine, database.DatabaseName, renderedStatement, dbSchema)
+					results, err := reportForMySQL(ctx, sqlDB, instance.Engine, database.DatabaseName, renderedStatement, dbSchema)
+					if err != nil {
+						return nil, err
+					}
+					return e.checkAffectedRowsLimit(ctx, config, database, results)
 				case storepb.Engine_ORACLE, storepb.Engine_DM, storepb.Engine_OCEANBASE_ORACLE:
 					schema := ""
 					if instance.Options == nil || !instance.Options.SchemaTenantMode {
@@ -334,6 +350,46 @@ func (e *StatementReportExecutor) runForDatabaseGroupTarget(ctx context.Context,
 	return results, nil
 }
 
+// checkAffectedRowsLimit turns the DML reports whose estimated affected rows exceed the environment limit into errors,
+// so that the rollout of the task is blocked.
+func (e *StatementReportExecutor) checkAffectedRowsLimit(ctx context.Context, config *storepb.PlanCheckRunConfig, database *store.DatabaseMessage, results []*storepb.PlanCheckRunResult_Result) ([]*storepb.PlanCheckRunResult_Result, error) {
+	if config.ChangeDatabaseType != storepb.PlanCheckRunConfig_DML {
+		return results, nil
+	}
+	environment, err := e.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &database.EffectiveEnvironmentID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get environment %q", database.EffectiveEnvironmentID)
+	}
+	if environment == nil {
+		return nil, errors.Errorf("environment %q not found", database.EffectiveEnvironmentID)
+	}
+	policy, err := e.store.GetAffectedRowsLimitPolicy(ctx, environment.UID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get affected rows limit policy for environment %q", environment.ResourceID)
+	}
+	applyAffectedRowsLimit(results, policy.MaxAffectedRows, environment.Title)
+	return results, nil
+}
+
+func applyAffectedRowsLimit(results []*storepb.PlanCheckRunResult_Result, maxAffectedRows int64, environmentTitle string) {
+	if maxAffectedRows <= 0 {
+		return
+	}
+	for _, result := range results {
+		report := result.GetSqlSummaryReport()
+		if report == nil || result.Status != storepb.PlanCheckRunResult_Result_SUCCESS {
+			continue
+		}
+		if int64(report.AffectedRows) <= maxAffectedRows {
+			continue
+		}
+		result.Status = storepb.PlanCheckRunResult_Result_ERROR
+		result.Code = common.TaskAffectedRowsExceeded.Int32()
+		result.Title = "Affected rows exceed the limit"
+		result.Content = fmt.Sprintf("The estimated affected rows %d exceed the limit %d of environment %q", report.AffectedRows, maxAffectedRows, environmentTitle)
+	}
+}
+
 func reportForOracle(databaseName string, schemaName string, statement string) ([]*storepb.PlanCheckRunResult_Result, error) {
 	singleSQLs, err := base.SplitMultiSQL(storepb.Engine_ORACLE, statement)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
 
+	"github.com/bytebase/bytebase/backend/common"
 	mysqlparser "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
 	"github.com/bytebase/bytebase/backend/store/model"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -99,3 +100,30 @@ func getMetadataForAffectedRowsTest() *model.DBSchema {
 	}
 	return model.NewDBSchema(metadata, nil /* schema */, nil /* config */)
 }
+
+func TestApplyAffectedRowsLimit(t *testing.T) {
+	a := require.New(t)
+	newResult := func(affectedRows int32) *storepb.PlanCheckRunResult_Result {
+		return &storepb.PlanCheckRunResult_Result{
+			Status: storepb.PlanCheckRunResult_Result_SUCCESS,
+			Code:   common.Ok.Int32(),
+			Title:  "OK",
+			Report: &storepb.PlanCheckRunResult_Result_SqlSummaryReport_{
+				SqlSummaryReport: &storepb.PlanCheckRunResult_Result_SqlSummaryReport{
+					AffectedRows: affectedRows,
+				},
+			},
+		}
+	}
+
+	results := []*storepb.PlanCheckRunResult_Result{newResult(100), newResult(1000)}
+	applyAffectedRowsLimit(results, 0, "Prod")
+	a.Equal(storepb.PlanCheckRunResult_Result_SUCCESS, results[0].Status)
+	a.Equal(storepb.PlanCheckRunResult_Result_SUCCESS, results[1].Status)
+
+	applyAffectedRowsLimit(results, 100, "Prod")
+	a.Equal(storepb.PlanCheckRunResult_Result_SUCCESS, results[0].Status)
+	a.Equal(storepb.PlanCheckRunResult_Result_ERROR, results[1].Status)
+	a.Equal(common.TaskAffectedRowsExceeded.Int32(), results[1].Code)
+	a.Equal(int32(1000), results[1].GetSqlSummaryReport().AffectedRows)
+}
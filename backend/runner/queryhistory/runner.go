@@ -0,0 +1,80 @@
+// Package queryhistory is the runner deleting the SQL Editor query histories beyond the retention.
+package queryhistory
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	queryHistoryInterval = 1 * time.Hour
+
+	// DefaultRetentionDays is the default days to keep the query histories.
+	DefaultRetentionDays = 90
+)
+
+// NewRunner creates a new query history runner.
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		store: store,
+	}
+}
+
+// Runner is the runner deleting the query histories beyond the retention.
+type Runner struct {
+	store *store.Store
+}
+
+// Run is the runner for query history runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(queryHistoryInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug("Query history runner started", slog.Duration("interval", queryHistoryInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Query history runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.deleteExpiredHistories(ctx)
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) deleteExpiredHistories(ctx context.Context) {
+	setting, err := r.store.GetQueryHistorySetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get query history setting.", log.BBError(err))
+		return
+	}
+	retentionDays := int(setting.RetentionDays)
+	if retentionDays <= 0 {
+		retentionDays = DefaultRetentionDays
+	}
+	count, err := r.store.DeleteQueryHistoriesBefore(ctx, time.Now().AddDate(0, 0, -retentionDays).Unix())
+	if err != nil {
+		slog.Error("Failed to delete expired query histories.", log.BBError(err))
+		return
+	}
+	if count > 0 {
+		slog.Debug("Deleted expired query histories.", slog.Int64("count", count))
+	}
+}
@@ -0,0 +1,74 @@
+package relay
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	relayplugin "github.com/bytebase/bytebase/backend/plugin/app/relay"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// callbackPayload is the request body posted by the external service to the callback URL.
+type callbackPayload struct {
+	Status relayplugin.Status `json:"status"`
+}
+
+// RegisterCallbackRoutes registers the callback route for the external approvals of the CALLBACK mode nodes.
+func (r *Runner) RegisterCallbackRoutes(g *echo.Group) {
+	g.POST("/approval/:token", func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		var body callbackPayload
+		if err := json.NewDecoder(c.Request().Body).Decode(&body); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Malformed external approval callback").SetInternal(err)
+		}
+		if body.Status != relayplugin.StatusApproved && body.Status != relayplugin.StatusRejected {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid status %q, want %q or %q", body.Status, relayplugin.StatusApproved, relayplugin.StatusRejected))
+		}
+
+		approval, err := r.findExternalApprovalByCallbackToken(ctx, c.Param("token"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find external approval").SetInternal(err)
+		}
+		if approval == nil {
+			return echo.NewHTTPError(http.StatusNotFound, "External approval not found")
+		}
+		if err := r.resolveExternalApproval(ctx, approval, body.Status); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to resolve external approval").SetInternal(err)
+		}
+		return c.String(http.StatusOK, "OK")
+	})
+}
+
+// findExternalApprovalByCallbackToken returns the pending external approval of the callback token, nil if not found.
+func (r *Runner) findExternalApprovalByCallbackToken(ctx context.Context, token string) (*store.ExternalApprovalMessage, error) {
+	if token == "" {
+		return nil, nil
+	}
+	externalApprovalType := api.ExternalApprovalTypeRelay
+	approvals, err := r.store.ListExternalApprovalV2(ctx, &store.ListExternalApprovalMessage{
+		Type: &externalApprovalType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, approval := range approvals {
+		payload := &api.ExternalApprovalPayloadRelay{}
+		if err := json.Unmarshal([]byte(approval.Payload), payload); err != nil {
+			return nil, err
+		}
+		if payload.CallbackToken == "" {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(payload.CallbackToken), []byte(token)) == 1 {
+			return approval, nil
+		}
+	}
+	return nil, nil
+}
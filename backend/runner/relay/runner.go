@@ -123,6 +123,10 @@ func (r *Runner) checkExternalApproval(ctx context.Context, approval *store.Exte
 	if err := json.Unmarshal([]byte(approval.Payload), payload); err != nil {
 		return errors.Wrapf(err, "failed to unmarshal external approval payload")
 	}
+	// The decision of the approval with a callback token is posted to the callback URL.
+	if payload.CallbackToken != "" {
+		return nil
+	}
 	node, err := getExternalApprovalByID(ctx, r.store, payload.ExternalApprovalNodeID)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get external approval node %s", payload.ExternalApprovalNodeID)
@@ -132,26 +136,29 @@ func (r *Runner) checkExternalApproval(ctx context.Context, approval *store.Exte
 	if err != nil {
 		return errors.Wrapf(err, "failed to get external approval status, id: %v, endpoint: %s, id: %s", node.Id, node.Endpoint, id)
 	}
-	if resp.Status == relayplugin.StatusApproved {
+	return r.resolveExternalApproval(ctx, approval, resp.Status)
+}
+
+// resolveExternalApproval approves or rejects the external approval node of the issue by the status, and archives the external approval.
+// The pending status is ignored.
+func (r *Runner) resolveExternalApproval(ctx context.Context, approval *store.ExternalApprovalMessage, status relayplugin.Status) error {
+	switch status {
+	case relayplugin.StatusApproved:
 		if err := r.ApproveExternalApprovalNode(ctx, approval.IssueUID); err != nil {
 			return err
 		}
-		if _, err := r.store.UpdateExternalApprovalV2(ctx, &store.UpdateExternalApprovalMessage{
-			ID:        approval.ID,
-			RowStatus: api.Archived,
-		}); err != nil {
-			return err
-		}
-	} else if resp.Status == relayplugin.StatusRejected {
+	case relayplugin.StatusRejected:
 		if err := r.RejectExternalApprovalNode(ctx, approval.IssueUID); err != nil {
 			return err
 		}
-		if _, err := r.store.UpdateExternalApprovalV2(ctx, &store.UpdateExternalApprovalMessage{
-			ID:        approval.ID,
-			RowStatus: api.Archived,
-		}); err != nil {
-			return err
-		}
+	default:
+		return nil
+	}
+	if _, err := r.store.UpdateExternalApprovalV2(ctx, &store.UpdateExternalApprovalMessage{
+		ID:        approval.ID,
+		RowStatus: api.Archived,
+	}); err != nil {
+		return err
 	}
 	return nil
 }
@@ -188,6 +195,10 @@ func (r *Runner) cancelExternalApproval(ctx context.Context, issueUID int) {
 			slog.Error("failed to archive external approval", log.BBError(err))
 			continue
 		}
+		// Only the relay service is notified of the cancellation.
+		if approval.Type != api.ExternalApprovalTypeRelay {
+			continue
+		}
 		payload := &api.ExternalApprovalPayloadRelay{}
 		if err := json.Unmarshal([]byte(approval.Payload), payload); err != nil {
 			slog.Error("failed to unmarshal external approval payload", log.BBError(err))
@@ -353,16 +364,9 @@ func (r *Runner) ApproveExternalApprovalNode(ctx context.Context, issueUID int)
 
 	// Grant the privilege if the issue is approved.
 	if approved && issue.Type == api.IssueGrantRequest {
-		policy, err := r.store.GetProjectPolicy(ctx, &store.GetProjectPolicyMessage{ProjectID: &issue.Project.ResourceID})
-		if err != nil {
+		if err := utils.UpdateProjectPolicyFromGrantIssue(ctx, r.store, issue, payload.GrantRequest); err != nil {
 			return err
 		}
-		var newConditionExpr string
-		if payload.GrantRequest.Condition != nil {
-			newConditionExpr = payload.GrantRequest.Condition.Expression
-		}
-		updated := false
-
 		userID, err := strconv.Atoi(strings.TrimPrefix(payload.GrantRequest.User, "users/"))
 		if err != nil {
 			return err
@@ -374,35 +378,7 @@ func (r *Runner) ApproveExternalApprovalNode(ctx context.Context, issueUID int)
 		if newUser == nil {
 			return errors.Errorf("user %v not found", userID)
 		}
-		for _, binding := range policy.Bindings {
-			if binding.Role != api.Role(payload.GrantRequest.Role) {
-				continue
-			}
-			var oldConditionExpr string
-			if binding.Condition != nil {
-				oldConditionExpr = binding.Condition.Expression
-			}
-			if oldConditionExpr != newConditionExpr {
-				continue
-			}
-			// Append
-			binding.Members = append(binding.Members, newUser)
-			updated = true
-			break
-		}
 		role := api.Role(strings.TrimPrefix(payload.GrantRequest.Role, "roles/"))
-		if !updated {
-			condition := payload.GrantRequest.Condition
-			condition.Description = fmt.Sprintf("#%d", issue.UID)
-			policy.Bindings = append(policy.Bindings, &store.PolicyBinding{
-				Role:      role,
-				Members:   []*store.UserMessage{newUser},
-				Condition: condition,
-			})
-		}
-		if _, err := r.store.SetProjectIAMPolicy(ctx, policy, api.SystemBotID, issue.Project.UID); err != nil {
-			return err
-		}
 		// Post project IAM policy update activity.
 		if _, err := r.activityManager.CreateActivity(ctx, &store.ActivityMessage{
 			CreatorUID:   api.SystemBotID,
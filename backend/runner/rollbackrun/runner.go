@@ -1,4 +1,4 @@
-// Package rollbackrun is the runner for generating rollback statements for DMLs.
+// Package rollbackrun is the runner for generating rollback statements for DMLs and DDLs.
 package rollbackrun
 
 import (
@@ -15,6 +15,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/artifact"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/state"
@@ -26,21 +27,23 @@ import (
 )
 
 // NewRunner creates a new rollback runner.
-func NewRunner(profile *config.Profile, store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State) *Runner {
+func NewRunner(profile *config.Profile, store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State, artifactManager *artifact.Manager) *Runner {
 	return &Runner{
-		profile:   profile,
-		store:     store,
-		dbFactory: dbFactory,
-		stateCfg:  stateCfg,
+		profile:         profile,
+		store:           store,
+		dbFactory:       dbFactory,
+		stateCfg:        stateCfg,
+		artifactManager: artifactManager,
 	}
 }
 
 // Runner is the rollback runner generating rollback SQL statements.
 type Runner struct {
-	profile   *config.Profile
-	store     *store.Store
-	dbFactory *dbfactory.DBFactory
-	stateCfg  *state.State
+	profile         *config.Profile
+	store           *store.Store
+	dbFactory       *dbfactory.DBFactory
+	stateCfg        *state.State
+	artifactManager *artifact.Manager
 }
 
 // Run starts the rollback runner.
@@ -77,8 +80,8 @@ func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 func (r *Runner) retryGenerateRollbackSQL(ctx context.Context) {
 	taskList, err := r.store.ListTasks(ctx, &api.TaskFind{
 		LatestTaskRunStatusList: &[]api.TaskRunStatus{api.TaskRunDone},
-		TypeList:                &[]api.TaskType{api.TaskDatabaseDataUpdate},
-		Payload:                 "(task.payload->>'rollbackEnabled')::BOOLEAN IS TRUE AND (task.payload->>'threadId'!='' OR task.payload->>'transactionId' != '') AND task.payload->>'rollbackSqlStatus'='PENDING'",
+		TypeList:                &[]api.TaskType{api.TaskDatabaseDataUpdate, api.TaskDatabaseSchemaUpdate},
+		Payload:                 "(task.payload->>'rollbackEnabled')::BOOLEAN IS TRUE AND (task.payload->>'threadId'!='' OR task.payload->>'transactionId' != '' OR (task.type = 'bb.task.database.schema.update' AND task.payload->>'migrationId' != '')) AND task.payload->>'rollbackSqlStatus'='PENDING'",
 	})
 	if err != nil {
 		slog.Error("Failed to get running DML tasks", log.BBError(err))
@@ -101,11 +104,6 @@ func (r *Runner) generateRollbackSQL(ctx context.Context, task *store.TaskMessag
 		}
 	}()
 
-	payload := &api.TaskDatabaseDataUpdatePayload{}
-	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
-		slog.Error("Invalid database data update payload", log.BBError(err))
-		return
-	}
 	instance, err := r.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
 	if err != nil {
 		slog.Error("Failed to find instance", log.BBError(err))
@@ -122,6 +120,16 @@ func (r *Runner) generateRollbackSQL(ctx context.Context, task *store.TaskMessag
 		return
 	}
 
+	if task.Type == api.TaskDatabaseSchemaUpdate {
+		r.generateSchemaRollbackDDL(ctx, task, instance, project)
+		return
+	}
+
+	payload := &api.TaskDatabaseDataUpdatePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		slog.Error("Invalid database data update payload", log.BBError(err))
+		return
+	}
 	switch instance.Engine {
 	case storepb.Engine_MYSQL:
 		// TODO(d): support MariaDB.
@@ -131,6 +139,26 @@ func (r *Runner) generateRollbackSQL(ctx context.Context, task *store.TaskMessag
 	}
 }
 
+// attachRollbackArtifact attaches the rollback statement to the latest task run of the task,
+// so that it can be downloaded as a file along with the other results of the task run.
+func (r *Runner) attachRollbackArtifact(ctx context.Context, task *store.TaskMessage, statement string) {
+	if statement == "" {
+		return
+	}
+	taskRuns, err := r.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{TaskUID: &task.ID})
+	if err != nil {
+		slog.Error("Failed to list task runs", slog.Int("taskID", task.ID), log.BBError(err))
+		return
+	}
+	if len(taskRuns) == 0 {
+		return
+	}
+	taskRun := taskRuns[len(taskRuns)-1]
+	if _, err := r.artifactManager.Attach(ctx, taskRun.ID, store.TaskRunArtifactRollbackSQL, fmt.Sprintf("rollback-task-%d.sql", task.ID), "application/sql", []byte(statement)); err != nil {
+		slog.Error("Failed to attach the rollback SQL artifact", slog.Int("taskRunID", taskRun.ID), log.BBError(err))
+	}
+}
+
 func (r *Runner) generateOracleRollbackSQL(ctx context.Context, task *store.TaskMessage, payload *api.TaskDatabaseDataUpdatePayload, instance *store.InstanceMessage, project *store.ProjectMessage) {
 	var rollbackSQLStatus api.RollbackSQLStatus
 	var rollbackStatement, rollbackError string
@@ -170,6 +198,9 @@ func (r *Runner) generateOracleRollbackSQL(ctx context.Context, task *store.Task
 		slog.Error("Failed to patch task with the Oracle payload", slog.Int("taskID", task.ID))
 		return
 	}
+	if rollbackSQLStatus == api.RollbackSQLStatusDone {
+		r.attachRollbackArtifact(ctx, task, rollbackStatement)
+	}
 	slog.Debug("Rollback SQL generation success", slog.Int("taskID", task.ID))
 }
 
@@ -256,6 +287,9 @@ func (r *Runner) generateMySQLRollbackSQL(ctx context.Context, task *store.TaskM
 		slog.Error("Failed to patch task with the MySQL thread ID", slog.Int("taskID", task.ID))
 		return
 	}
+	if rollbackSQLStatus == api.RollbackSQLStatusDone {
+		r.attachRollbackArtifact(ctx, task, rollbackStatement)
+	}
 	slog.Debug("Rollback SQL generation success", slog.Int("taskID", task.ID))
 }
 
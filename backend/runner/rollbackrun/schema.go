@@ -0,0 +1,99 @@
+package rollbackrun
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// schemaRollbackWarning is prepended to the generated rollback DDL because the schema differ only restores the structure.
+const schemaRollbackWarning = "-- The rollback statements restore the schema before the migration. The data in the dropped tables and columns cannot be restored.\n"
+
+func (r *Runner) generateSchemaRollbackDDL(ctx context.Context, task *store.TaskMessage, instance *store.InstanceMessage, project *store.ProjectMessage) {
+	var rollbackSQLStatus api.RollbackSQLStatus
+	var rollbackStatement, rollbackError string
+
+	statement, err := r.generateSchemaRollbackDDLImpl(ctx, task, instance)
+	if err != nil {
+		slog.Error("Failed to generate rollback DDL statement", log.BBError(err))
+		rollbackSQLStatus = api.RollbackSQLStatusFailed
+		rollbackError = err.Error()
+	} else {
+		rollbackSQLStatus = api.RollbackSQLStatusDone
+		rollbackStatement = statement
+	}
+
+	sheet, err := r.store.CreateSheet(ctx, &store.SheetMessage{
+		CreatorID:  api.SystemBotID,
+		ProjectUID: project.UID,
+		Title:      fmt.Sprintf("Sheet for rolling back task %d", task.ID),
+		Statement:  rollbackStatement,
+		Visibility: store.ProjectSheet,
+		Source:     store.SheetFromBytebaseArtifact,
+		Type:       store.SheetForSQL,
+	})
+	if err != nil {
+		slog.Error("failed to create rollback sheet", log.BBError(err))
+		return
+	}
+	patch := &api.TaskPatch{
+		ID:                task.ID,
+		UpdaterID:         api.SystemBotID,
+		RollbackSQLStatus: &rollbackSQLStatus,
+		RollbackSheetID:   &sheet.UID,
+		RollbackError:     &rollbackError,
+	}
+	if _, err := r.store.UpdateTaskV2(ctx, patch); err != nil {
+		slog.Error("Failed to patch task with the rollback DDL", slog.Int("taskID", task.ID))
+		return
+	}
+	if rollbackSQLStatus == api.RollbackSQLStatusDone {
+		r.attachRollbackArtifact(ctx, task, rollbackStatement)
+	}
+	slog.Debug("Rollback DDL generation success", slog.Int("taskID", task.ID))
+}
+
+// generateSchemaRollbackDDLImpl diffs the schema after the migration against the schema snapshot taken before it,
+// so that the result reverses the migration, e.g. dropping the created tables and re-adding the dropped columns.
+func (r *Runner) generateSchemaRollbackDDLImpl(ctx context.Context, task *store.TaskMessage, instance *store.InstanceMessage) (string, error) {
+	payload := &api.TaskDatabaseSchemaUpdatePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return "", errors.Wrap(err, "invalid database schema update payload")
+	}
+	if payload.MigrationID == "" {
+		return "", errors.New("missing migration ID")
+	}
+	history, err := r.store.GetInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+		InstanceID: &instance.UID,
+		ID:         &payload.MigrationID,
+		ShowFull:   true,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to find migration history with ID %s", payload.MigrationID)
+	}
+	if history == nil {
+		return "", errors.Errorf("migration history with ID %s not found", payload.MigrationID)
+	}
+	return diffSchemaRollbackDDL(instance.Engine, history.Schema, history.SchemaPrev, store.IgnoreDatabaseAndTableCaseSensitive(instance))
+}
+
+// diffSchemaRollbackDDL returns the statements migrating the schema after the migration back to the schema before it.
+func diffSchemaRollbackDDL(engine storepb.Engine, schema, schemaPrev string, ignoreCaseSensitive bool) (string, error) {
+	diff, err := base.SchemaDiff(engine, schema, schemaPrev, ignoreCaseSensitive)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to diff the schema")
+	}
+	if diff == "" {
+		return "", nil
+	}
+	return schemaRollbackWarning + diff, nil
+}
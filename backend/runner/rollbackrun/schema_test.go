@@ -0,0 +1,64 @@
+package rollbackrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/pg"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestDiffSchemaRollbackDDL(t *testing.T) {
+	tests := []struct {
+		name       string
+		engine     storepb.Engine
+		schemaPrev string
+		schema     string
+		want       string
+	}{
+		{
+			name:       "MySQL create table",
+			engine:     storepb.Engine_MYSQL,
+			schemaPrev: "CREATE TABLE `t1` (\n  `id` int NOT NULL\n);\n",
+			schema:     "CREATE TABLE `t1` (\n  `id` int NOT NULL\n);\nCREATE TABLE `t2` (\n  `id` int NOT NULL\n);\n",
+			want:       schemaRollbackWarning + "SET FOREIGN_KEY_CHECKS=0;\n\nDROP TABLE IF EXISTS `t2`;\n\nSET FOREIGN_KEY_CHECKS=1;\n",
+		},
+		{
+			name:       "MySQL drop column",
+			engine:     storepb.Engine_MYSQL,
+			schemaPrev: "CREATE TABLE `t1` (\n  `id` int NOT NULL,\n  `name` varchar(255) DEFAULT NULL\n);\n",
+			schema:     "CREATE TABLE `t1` (\n  `id` int NOT NULL\n);\n",
+			want:       schemaRollbackWarning + "SET FOREIGN_KEY_CHECKS=0;\n\nALTER TABLE `t1` ADD COLUMN `name` varchar(255) DEFAULT NULL AFTER `id`;\n\nSET FOREIGN_KEY_CHECKS=1;\n",
+		},
+		{
+			name:       "PostgreSQL create table",
+			engine:     storepb.Engine_POSTGRES,
+			schemaPrev: "CREATE TABLE public.t1 (\n    id integer NOT NULL\n);\n",
+			schema:     "CREATE TABLE public.t1 (\n    id integer NOT NULL\n);\nCREATE TABLE public.t2 (\n    id integer NOT NULL\n);\n",
+			want:       schemaRollbackWarning + "DROP TABLE \"public\".\"t2\";\n\n",
+		},
+		{
+			name:       "PostgreSQL drop column",
+			engine:     storepb.Engine_POSTGRES,
+			schemaPrev: "CREATE TABLE public.t1 (\n    id integer NOT NULL,\n    name text\n);\n",
+			schema:     "CREATE TABLE public.t1 (\n    id integer NOT NULL\n);\n",
+			want:       schemaRollbackWarning + "ALTER TABLE \"public\".\"t1\"\n    ADD COLUMN \"name\" text;\n\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := require.New(t)
+			statement, err := diffSchemaRollbackDDL(test.engine, test.schema, test.schemaPrev, false)
+			a.NoError(err)
+			a.Equal(test.want, statement)
+		})
+	}
+
+	// The rollback DDL is empty if the migration doesn't change the schema.
+	statement, err := diffSchemaRollbackDDL(storepb.Engine_MYSQL, "CREATE TABLE `t1` (\n  `id` int NOT NULL\n);\n", "CREATE TABLE `t1` (\n  `id` int NOT NULL\n);\n", false)
+	require.NoError(t, err)
+	require.Empty(t, statement)
+}
@@ -0,0 +1,464 @@
+// Package sandbox is the runner provisioning the sandbox databases and dropping the expired ones.
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/maskingpolicy"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/runner/schemasync"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	sandboxInterval = 1 * time.Minute
+	// maxConcurrentProvisions is the max number of the sandbox databases provisioned concurrently.
+	maxConcurrentProvisions = 4
+)
+
+// SupportedEngines are the engines supporting the sandbox databases.
+var SupportedEngines = map[storepb.Engine]bool{
+	storepb.Engine_MYSQL:    true,
+	storepb.Engine_MARIADB:  true,
+	storepb.Engine_POSTGRES: true,
+}
+
+// NewRunner creates a new sandbox runner.
+func NewRunner(store *store.Store, dbFactory *dbfactory.DBFactory, schemaSyncer *schemasync.Syncer) *Runner {
+	return &Runner{
+		store:            store,
+		dbFactory:        dbFactory,
+		schemaSyncer:     schemaSyncer,
+		provisioningUIDs: make(map[int]bool),
+	}
+}
+
+// Runner is the runner provisioning the pending sandbox databases and dropping the expired ones.
+type Runner struct {
+	store        *store.Store
+	dbFactory    *dbfactory.DBFactory
+	schemaSyncer *schemasync.Syncer
+
+	// provisioningUIDs are the UIDs of the sandbox databases being provisioned.
+	provisioningUIDs map[int]bool
+	provisionWg      sync.WaitGroup
+	provisionMu      sync.Mutex
+}
+
+// Run is the runner for sandbox runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(sandboxInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug("Sandbox runner started", slog.Duration("interval", sandboxInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Sandbox runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.provisionPendingSandboxes(ctx)
+				r.dropExpiredSandboxes(ctx)
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			r.provisionWg.Wait()
+			return
+		}
+	}
+}
+
+func (r *Runner) provisionPendingSandboxes(ctx context.Context) {
+	// List the pending sandbox databases with the lock held, so that the sandbox databases just provisioned are not listed.
+	r.provisionMu.Lock()
+	defer r.provisionMu.Unlock()
+	sandboxes, err := r.store.ListSandboxDatabases(ctx, &store.FindSandboxDatabaseMessage{
+		StatusList: []store.SandboxDatabaseStatus{store.SandboxDatabaseStatusPending},
+	})
+	if err != nil {
+		slog.Error("Failed to list pending sandbox databases.", log.BBError(err))
+		return
+	}
+	for _, sandbox := range sandboxes {
+		// The rest of the pending sandbox databases are provisioned in the next rounds.
+		if len(r.provisioningUIDs) >= maxConcurrentProvisions {
+			return
+		}
+		if r.provisioningUIDs[sandbox.UID] {
+			continue
+		}
+		r.provisioningUIDs[sandbox.UID] = true
+		r.provisionWg.Add(1)
+		go r.provisionPendingSandbox(ctx, sandbox)
+	}
+}
+
+func (r *Runner) provisionPendingSandbox(ctx context.Context, sandbox *store.SandboxDatabaseMessage) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, ok := r.(error)
+			if !ok {
+				err = errors.Errorf("%v", r)
+			}
+			slog.Error("Sandbox provisioning PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+		}
+		r.provisionMu.Lock()
+		delete(r.provisioningUIDs, sandbox.UID)
+		r.provisionMu.Unlock()
+		r.provisionWg.Done()
+	}()
+
+	status := store.SandboxDatabaseStatusReady
+	payload := sandbox.Payload
+	if err := r.provision(ctx, sandbox); err != nil {
+		slog.Error("Failed to provision sandbox database.", slog.String("database", sandbox.DatabaseName), log.BBError(err))
+		status = store.SandboxDatabaseStatusFailed
+		payload.Error = err.Error()
+	}
+	if err := r.store.UpdateSandboxDatabase(ctx, &store.UpdateSandboxDatabaseMessage{
+		UID:     sandbox.UID,
+		Status:  &status,
+		Payload: payload,
+	}); err != nil {
+		slog.Error("Failed to update sandbox database.", slog.String("database", sandbox.DatabaseName), log.BBError(err))
+	}
+}
+
+func (r *Runner) dropExpiredSandboxes(ctx context.Context) {
+	now := time.Now().Unix()
+	sandboxes, err := r.store.ListSandboxDatabases(ctx, &store.FindSandboxDatabaseMessage{
+		StatusList:     []store.SandboxDatabaseStatus{store.SandboxDatabaseStatusReady, store.SandboxDatabaseStatusFailed},
+		ExpireTsBefore: &now,
+	})
+	if err != nil {
+		slog.Error("Failed to list expired sandbox databases.", log.BBError(err))
+		return
+	}
+	for _, sandbox := range sandboxes {
+		if err := r.drop(ctx, sandbox); err != nil {
+			slog.Error("Failed to drop expired sandbox database.", slog.String("database", sandbox.DatabaseName), log.BBError(err))
+			continue
+		}
+		status := store.SandboxDatabaseStatusDropped
+		if err := r.store.UpdateSandboxDatabase(ctx, &store.UpdateSandboxDatabaseMessage{
+			UID:    sandbox.UID,
+			Status: &status,
+		}); err != nil {
+			slog.Error("Failed to update sandbox database.", slog.String("database", sandbox.DatabaseName), log.BBError(err))
+		}
+	}
+}
+
+// provision creates the sandbox database, and restores the dump of the source database into it.
+func (r *Runner) provision(ctx context.Context, sandbox *store.SandboxDatabaseMessage) error {
+	sourceDatabase, err := r.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: &sandbox.SourceDatabaseUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get source database")
+	}
+	if sourceDatabase == nil {
+		return errors.Errorf("source database %d not found", sandbox.SourceDatabaseUID)
+	}
+	sourceInstance, err := r.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &sourceDatabase.InstanceID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get source instance")
+	}
+	if sourceInstance == nil {
+		return errors.Errorf("source instance %q not found", sourceDatabase.InstanceID)
+	}
+	instance, err := r.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &sandbox.InstanceUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get sandbox instance")
+	}
+	if instance == nil {
+		return errors.Errorf("sandbox instance %d not found", sandbox.InstanceUID)
+	}
+
+	if err := r.execute(ctx, instance, getCreateDatabaseStatement(instance.Engine, sandbox.DatabaseName)); err != nil {
+		return errors.Wrapf(err, "failed to create sandbox database")
+	}
+	// The sandbox database is registered in the project only after the data is masked,
+	// and dropped on any failure so that the unmasked data is never exposed.
+	if err := r.restore(ctx, sandbox, sourceInstance, sourceDatabase, instance); err != nil {
+		r.cleanUp(ctx, instance, sandbox, false /* registered */)
+		return err
+	}
+	database, err := r.store.UpsertDatabase(ctx, &store.DatabaseMessage{
+		ProjectID:            sourceDatabase.ProjectID,
+		InstanceID:           instance.ResourceID,
+		DatabaseName:         sandbox.DatabaseName,
+		SyncState:            api.OK,
+		SuccessfulSyncTimeTs: time.Now().Unix(),
+		Metadata: &storepb.DatabaseMetadata{
+			Labels: map[string]string{},
+		},
+	})
+	if err != nil {
+		r.cleanUp(ctx, instance, sandbox, false /* registered */)
+		return errors.Wrapf(err, "failed to upsert sandbox database")
+	}
+	if err := r.schemaSyncer.SyncDatabaseSchema(ctx, database, true /* force */); err != nil {
+		r.cleanUp(ctx, instance, sandbox, true /* registered */)
+		return errors.Wrapf(err, "failed to sync sandbox database schema")
+	}
+	return nil
+}
+
+// restore restores the dump of the source database into the sandbox database, and masks the restored data in the MASKED_DATA mode.
+func (r *Runner) restore(ctx context.Context, sandbox *store.SandboxDatabaseMessage, sourceInstance *store.InstanceMessage, sourceDatabase *store.DatabaseMessage, instance *store.InstanceMessage) error {
+	sourceDriver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, sourceInstance, sourceDatabase, db.ConnectionContext{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect source database")
+	}
+	defer sourceDriver.Close(ctx)
+	driver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, instance, &store.DatabaseMessage{
+		InstanceID:   instance.ResourceID,
+		DatabaseName: sandbox.DatabaseName,
+	}, db.ConnectionContext{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect sandbox database")
+	}
+	defer driver.Close(ctx)
+
+	// Stream the dump into the sandbox database.
+	schemaOnly := sandbox.Payload.Mode != storepb.SandboxDatabasePayload_MASKED_DATA
+	reader, writer := io.Pipe()
+	go func() {
+		_, err := sourceDriver.Dump(ctx, writer, schemaOnly)
+		writer.CloseWithError(err)
+	}()
+	if err := driver.Restore(ctx, reader); err != nil {
+		reader.CloseWithError(err)
+		return errors.Wrapf(err, "failed to restore the dump of the source database")
+	}
+
+	if !schemaOnly {
+		if err := r.mask(ctx, driver, instance.Engine, sourceDatabase); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cleanUp drops the sandbox database failed to provision, and marks it as not found if it's registered in the project.
+func (r *Runner) cleanUp(ctx context.Context, instance *store.InstanceMessage, sandbox *store.SandboxDatabaseMessage, registered bool) {
+	if err := r.execute(ctx, instance, getDropDatabaseStatement(instance.Engine, sandbox.DatabaseName)); err != nil {
+		slog.Error("Failed to drop sandbox database failed to provision.", slog.String("database", sandbox.DatabaseName), log.BBError(err))
+	}
+	if !registered {
+		return
+	}
+	syncStatus := api.NotFound
+	if _, err := r.store.UpdateDatabase(ctx, &store.UpdateDatabaseMessage{
+		InstanceID:   instance.ResourceID,
+		DatabaseName: sandbox.DatabaseName,
+		SyncState:    &syncStatus,
+	}, api.SystemBotID); err != nil {
+		slog.Error("Failed to update sandbox database failed to provision.", slog.String("database", sandbox.DatabaseName), log.BBError(err))
+	}
+}
+
+// mask overwrites the sensitive columns of the restored data, which are resolved the same as the SQL editor from
+// the masking policies of the source database and its project, the global masking rules and the data classification.
+func (r *Runner) mask(ctx context.Context, driver db.Driver, engine storepb.Engine, sourceDatabase *store.DatabaseMessage) error {
+	project, err := r.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &sourceDatabase.ProjectID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project of the source database")
+	}
+	if project == nil {
+		return errors.Errorf("project %q not found", sourceDatabase.ProjectID)
+	}
+	resolver, err := maskingpolicy.Load(ctx, r.store, project, sourceDatabase)
+	if err != nil {
+		return err
+	}
+	maskingRulePolicy, err := r.store.GetMaskingRulePolicy(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get masking rule policy")
+	}
+	classificationSetting, err := r.store.GetDataClassificationSetting(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get data classification setting")
+	}
+	var classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig
+	for _, config := range classificationSetting.GetConfigs() {
+		if config.Id == project.DataClassificationConfigID {
+			classificationConfig = config
+			break
+		}
+	}
+	dbSchema, err := r.store.GetDBSchema(ctx, sourceDatabase.UID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get schema of the source database")
+	}
+	if dbSchema == nil {
+		return errors.Errorf("schema of the source database %q not found", sourceDatabase.DatabaseName)
+	}
+
+	statements, err := getMaskStatements(engine, dbSchema.GetMetadata(), func(schemaName, tableName string, column *storepb.ColumnMetadata) (bool, error) {
+		classificationLevel := maskingpolicy.GetClassificationLevel(column.Classification, classificationConfig)
+		level, err := maskingpolicy.EvaluateLevel(sourceDatabase, schemaName, tableName, column.Name, classificationLevel, resolver, maskingRulePolicy.GetRules())
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to evaluate masking level of column %q", column.Name)
+		}
+		return level == storepb.MaskingLevel_PARTIAL || level == storepb.MaskingLevel_FULL, nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(statements) == 0 {
+		return nil
+	}
+	if _, err := driver.Execute(ctx, strings.Join(statements, "\n"), db.ExecuteOptions{}); err != nil {
+		return errors.Wrapf(err, "failed to mask the sensitive data")
+	}
+	return nil
+}
+
+// drop drops the sandbox database, and marks it as not found if it's registered.
+func (r *Runner) drop(ctx context.Context, sandbox *store.SandboxDatabaseMessage) error {
+	instance, err := r.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &sandbox.InstanceUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get sandbox instance")
+	}
+	if instance == nil {
+		return errors.Errorf("sandbox instance %d not found", sandbox.InstanceUID)
+	}
+	if err := r.execute(ctx, instance, getDropDatabaseStatement(instance.Engine, sandbox.DatabaseName)); err != nil {
+		return err
+	}
+	// The sandbox database failed to provision may not be registered.
+	database, err := r.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:   &instance.ResourceID,
+		DatabaseName: &sandbox.DatabaseName,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get sandbox database")
+	}
+	if database == nil {
+		return nil
+	}
+	syncStatus := api.NotFound
+	if _, err := r.store.UpdateDatabase(ctx, &store.UpdateDatabaseMessage{
+		InstanceID:   instance.ResourceID,
+		DatabaseName: sandbox.DatabaseName,
+		SyncState:    &syncStatus,
+	}, api.SystemBotID); err != nil {
+		return errors.Wrapf(err, "failed to update sandbox database")
+	}
+	return nil
+}
+
+// execute executes the database level statement out of transactions.
+func (r *Runner) execute(ctx context.Context, instance *store.InstanceMessage, statement string) error {
+	driver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */, db.ConnectionContext{})
+	if err != nil {
+		return err
+	}
+	defer driver.Close(ctx)
+	if _, err := driver.GetDB().ExecContext(ctx, statement); err != nil {
+		return errors.Wrapf(err, "failed to execute %q", statement)
+	}
+	return nil
+}
+
+func getCreateDatabaseStatement(engine storepb.Engine, databaseName string) string {
+	if engine == storepb.Engine_POSTGRES {
+		return fmt.Sprintf(`CREATE DATABASE "%s";`, databaseName)
+	}
+	return fmt.Sprintf("CREATE DATABASE `%s`;", databaseName)
+}
+
+func getDropDatabaseStatement(engine storepb.Engine, databaseName string) string {
+	if engine == storepb.Engine_POSTGRES {
+		return fmt.Sprintf(`DROP DATABASE IF EXISTS "%s";`, databaseName)
+	}
+	return fmt.Sprintf("DROP DATABASE IF EXISTS `%s`;", databaseName)
+}
+
+// getMaskStatements returns the UPDATE statements overwriting the masked columns.
+func getMaskStatements(engine storepb.Engine, metadata *storepb.DatabaseSchemaMetadata, isMasked func(schemaName, tableName string, column *storepb.ColumnMetadata) (bool, error)) ([]string, error) {
+	var statements []string
+	for _, schema := range metadata.GetSchemas() {
+		for _, table := range schema.Tables {
+			var sets []string
+			for _, column := range table.Columns {
+				masked, err := isMasked(schema.Name, table.Name, column)
+				if err != nil {
+					return nil, err
+				}
+				if !masked {
+					continue
+				}
+				sets = append(sets, fmt.Sprintf("%s = %s", quoteIdentifier(engine, column.Name), getMaskedValue(column)))
+			}
+			if len(sets) == 0 {
+				continue
+			}
+			tableName := quoteIdentifier(engine, table.Name)
+			if schema.Name != "" {
+				tableName = fmt.Sprintf("%s.%s", quoteIdentifier(engine, schema.Name), tableName)
+			}
+			statements = append(statements, fmt.Sprintf("UPDATE %s SET %s;", tableName, strings.Join(sets, ", ")))
+		}
+	}
+	return statements, nil
+}
+
+var numericTypes = map[string]bool{
+	"tinyint":   true,
+	"smallint":  true,
+	"mediumint": true,
+	"int":       true,
+	"integer":   true,
+	"bigint":    true,
+	"decimal":   true,
+	"numeric":   true,
+	"float":     true,
+	"double":    true,
+	"real":      true,
+}
+
+// getMaskedValue returns the value overwriting the masked column.
+// The string and numeric columns are overwritten with constants, the others with NULL or the default value if not nullable.
+func getMaskedValue(column *storepb.ColumnMetadata) string {
+	columnType := strings.ToLower(column.Type)
+	if i := strings.Index(columnType, "("); i >= 0 {
+		columnType = columnType[:i]
+	}
+	fields := strings.Fields(columnType)
+	switch {
+	case strings.Contains(columnType, "char"), strings.Contains(columnType, "text"):
+		return "'******'"
+	case len(fields) > 0 && numericTypes[fields[0]]:
+		return "0"
+	case column.Nullable:
+		return "NULL"
+	default:
+		return "DEFAULT"
+	}
+}
+
+func quoteIdentifier(engine storepb.Engine, identifier string) string {
+	if engine == storepb.Engine_POSTGRES {
+		return fmt.Sprintf(`"%s"`, identifier)
+	}
+	return fmt.Sprintf("`%s`", identifier)
+}
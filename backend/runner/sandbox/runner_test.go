@@ -0,0 +1,114 @@
+package sandbox
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetMaskStatements(t *testing.T) {
+	a := require.New(t)
+
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "employee",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "int"},
+							{Name: "email", Type: "varchar(255)"},
+							{Name: "salary", Type: "decimal(10,2)"},
+						},
+					},
+					{
+						Name: "dept",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "name", Type: "varchar(64)"},
+						},
+					},
+				},
+			},
+		},
+	}
+	masked := map[string]bool{
+		"employee.email":  true,
+		"employee.salary": true,
+	}
+	isMasked := func(schemaName, tableName string, column *storepb.ColumnMetadata) (bool, error) {
+		return masked[tableName+"."+column.Name], nil
+	}
+
+	for _, engine := range []storepb.Engine{storepb.Engine_MYSQL, storepb.Engine_MARIADB} {
+		statements, err := getMaskStatements(engine, metadata, isMasked)
+		a.NoError(err)
+		a.Equal([]string{"UPDATE `employee` SET `email` = '******', `salary` = 0;"}, statements, engine)
+	}
+
+	pgMetadata := &storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "employee",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+							{Name: "email", Type: "character varying(255)"},
+							{Name: "salary", Type: "numeric(10,2)"},
+							{Name: "birthday", Type: "date", Nullable: true},
+						},
+					},
+				},
+			},
+		},
+	}
+	masked["employee.birthday"] = true
+	statements, err := getMaskStatements(storepb.Engine_POSTGRES, pgMetadata, isMasked)
+	a.NoError(err)
+	a.Equal([]string{`UPDATE "public"."employee" SET "email" = '******', "salary" = 0, "birthday" = NULL;`}, statements)
+
+	statements, err = getMaskStatements(storepb.Engine_POSTGRES, pgMetadata, func(string, string, *storepb.ColumnMetadata) (bool, error) {
+		return false, nil
+	})
+	a.NoError(err)
+	a.Empty(statements)
+
+	_, err = getMaskStatements(storepb.Engine_POSTGRES, pgMetadata, func(string, string, *storepb.ColumnMetadata) (bool, error) {
+		return false, errors.New("failed to evaluate")
+	})
+	a.Error(err)
+}
+
+func TestGetMaskedValue(t *testing.T) {
+	tests := []struct {
+		column *storepb.ColumnMetadata
+		want   string
+	}{
+		// MySQL and MariaDB.
+		{column: &storepb.ColumnMetadata{Type: "varchar(255)"}, want: "'******'"},
+		{column: &storepb.ColumnMetadata{Type: "longtext"}, want: "'******'"},
+		{column: &storepb.ColumnMetadata{Type: "int(11) unsigned"}, want: "0"},
+		{column: &storepb.ColumnMetadata{Type: "bigint"}, want: "0"},
+		{column: &storepb.ColumnMetadata{Type: "DECIMAL(10,2)"}, want: "0"},
+		{column: &storepb.ColumnMetadata{Type: "double"}, want: "0"},
+		{column: &storepb.ColumnMetadata{Type: "datetime", Nullable: true}, want: "NULL"},
+		{column: &storepb.ColumnMetadata{Type: "json"}, want: "DEFAULT"},
+		// PostgreSQL.
+		{column: &storepb.ColumnMetadata{Type: "character varying(64)"}, want: "'******'"},
+		{column: &storepb.ColumnMetadata{Type: "text"}, want: "'******'"},
+		{column: &storepb.ColumnMetadata{Type: "integer"}, want: "0"},
+		{column: &storepb.ColumnMetadata{Type: "numeric"}, want: "0"},
+		{column: &storepb.ColumnMetadata{Type: "real"}, want: "0"},
+		{column: &storepb.ColumnMetadata{Type: "timestamp with time zone", Nullable: true}, want: "NULL"},
+		{column: &storepb.ColumnMetadata{Type: "uuid"}, want: "DEFAULT"},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, getMaskedValue(test.column), test.column.Type)
+	}
+}
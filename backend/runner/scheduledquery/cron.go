@@ -0,0 +1,158 @@
+package scheduledquery
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Schedule is a parsed cron expression with the fields of minute, hour, day of month, month and day of week.
+type Schedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+	// The day matches either the day of month or the day of week if both are restricted, as the standard cron does.
+	dayOfMonthStar bool
+	dayOfWeekStar  bool
+}
+
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	// Both 0 and 7 are Sunday.
+	{name: "day of week", min: 0, max: 7},
+}
+
+// maxScheduleYears bounds the search of the next run time, e.g. for "0 0 30 2 *" never matching.
+const maxScheduleYears = 5
+
+// ParseSchedule parses the cron expression in UTC, supporting "*", lists, ranges and steps, e.g. "*/15 8-18 * * 1-5".
+func ParseSchedule(expression string) (*Schedule, error) {
+	parts := strings.Fields(expression)
+	if len(parts) != len(cronFields) {
+		return nil, errors.Errorf("expecting %d fields in the cron expression %q, but got %d", len(cronFields), expression, len(parts))
+	}
+	var bits []uint64
+	for i, part := range parts {
+		b, err := parseCronField(part, cronFields[i])
+		if err != nil {
+			return nil, err
+		}
+		bits = append(bits, b)
+	}
+	schedule := &Schedule{
+		minute:         bits[0],
+		hour:           bits[1],
+		dayOfMonth:     bits[2],
+		month:          bits[3],
+		dayOfWeek:      bits[4],
+		dayOfMonthStar: parts[2] == "*",
+		dayOfWeekStar:  parts[4] == "*",
+	}
+	// Fold Sunday 7 into 0.
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek = schedule.dayOfWeek&^(1<<7) | 1
+	}
+	return schedule, nil
+}
+
+func parseCronField(part string, field cronField) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(part, ",") {
+		rangePart, step := item, 1
+		if i := strings.Index(item, "/"); i >= 0 {
+			s, err := strconv.Atoi(item[i+1:])
+			if err != nil || s <= 0 {
+				return 0, errors.Errorf("invalid step %q in the %s field", item[i+1:], field.name)
+			}
+			rangePart, step = item[:i], s
+		}
+		start, end := field.min, field.max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if start, err = parseCronValue(bounds[0], field); err != nil {
+				return 0, err
+			}
+			if end, err = parseCronValue(bounds[1], field); err != nil {
+				return 0, err
+			}
+			if start > end {
+				return 0, errors.Errorf("invalid range %q in the %s field", rangePart, field.name)
+			}
+		default:
+			v, err := parseCronValue(rangePart, field)
+			if err != nil {
+				return 0, err
+			}
+			start = v
+			// "5/10" means from 5 to the max every 10.
+			if !strings.Contains(item, "/") {
+				end = v
+			}
+		}
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func parseCronValue(s string, field cronField) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, errors.Errorf("invalid value %q in the %s field", s, field.name)
+	}
+	if v < field.min || v > field.max {
+		return 0, errors.Errorf("value %d out of the range [%d, %d] in the %s field", v, field.min, field.max, field.name)
+	}
+	return v, nil
+}
+
+// Next returns the next time matching the schedule strictly after t, or the zero time if there is none in a few years.
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxScheduleYears, 0, 0)
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, time.UTC)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s *Schedule) matchDay(t time.Time) bool {
+	dayOfMonth := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+	if s.dayOfMonthStar || s.dayOfWeekStar {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
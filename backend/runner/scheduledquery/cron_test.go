@@ -0,0 +1,63 @@
+package scheduledquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule(t *testing.T) {
+	tests := []struct {
+		expression string
+		wantErr    bool
+	}{
+		{expression: "* * * * *"},
+		{expression: "*/15 8-18 * * 1-5"},
+		{expression: "0 0 1,15 * 7"},
+		{expression: "5/10 * * * *"},
+		{expression: "* * * *", wantErr: true},
+		{expression: "60 * * * *", wantErr: true},
+		{expression: "* * 0 * *", wantErr: true},
+		{expression: "* 18-8 * * *", wantErr: true},
+		{expression: "*/0 * * * *", wantErr: true},
+		{expression: "a * * * *", wantErr: true},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		_, err := ParseSchedule(test.expression)
+		if test.wantErr {
+			a.Error(err, test.expression)
+		} else {
+			a.NoError(err, test.expression)
+		}
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	// 2024-01-01 is Monday.
+	from := time.Date(2024, 1, 1, 10, 7, 30, 0, time.UTC)
+	tests := []struct {
+		expression string
+		want       time.Time
+	}{
+		{expression: "* * * * *", want: time.Date(2024, 1, 1, 10, 8, 0, 0, time.UTC)},
+		{expression: "*/15 * * * *", want: time.Date(2024, 1, 1, 10, 15, 0, 0, time.UTC)},
+		{expression: "0 8 * * *", want: time.Date(2024, 1, 2, 8, 0, 0, 0, time.UTC)},
+		{expression: "30 9 * * 6", want: time.Date(2024, 1, 6, 9, 30, 0, 0, time.UTC)},
+		{expression: "0 0 * * 7", want: time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)},
+		{expression: "0 0 1 * *", want: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{expression: "0 0 29 2 *", want: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// Either the day of month or the day of week matches.
+		{expression: "0 0 15 * 3", want: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{expression: "0 0 30 2 *", want: time.Time{}},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		schedule, err := ParseSchedule(test.expression)
+		a.NoError(err)
+		a.Equal(test.want, schedule.Next(from), test.expression)
+	}
+}
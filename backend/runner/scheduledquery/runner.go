@@ -0,0 +1,294 @@
+// Package scheduledquery is the runner running the scheduled queries and delivering their results.
+package scheduledquery
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/activity"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/mail"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	scheduledQueryInterval = 1 * time.Minute
+	// maxPreviewLength bounds the result preview in the webhook message.
+	maxPreviewLength = 1000
+
+	// DefaultLimit is the default maximum number of rows of a result snapshot.
+	DefaultLimit = 1000
+	// MaximumLimit is the maximum number of rows of a result snapshot.
+	MaximumLimit = 100000
+	// DefaultRetentionDays is the default days to keep the result snapshots.
+	DefaultRetentionDays = 30
+)
+
+// ExecuteFunc executes the scheduled query on behalf of its creator, and returns the result in CSV and the row count.
+type ExecuteFunc func(ctx context.Context, scheduledQuery *store.ScheduledQueryMessage) (string, int64, error)
+
+// NewRunner creates a new scheduled query runner.
+func NewRunner(store *store.Store, activityManager *activity.Manager, execute ExecuteFunc) *Runner {
+	return &Runner{
+		store:           store,
+		activityManager: activityManager,
+		execute:         execute,
+	}
+}
+
+// Runner is the runner running the due scheduled queries and deleting the expired results.
+type Runner struct {
+	store           *store.Store
+	activityManager *activity.Manager
+	execute         ExecuteFunc
+}
+
+// Run is the runner for scheduled query runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(scheduledQueryInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug("Scheduled query runner started", slog.Duration("interval", scheduledQueryInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Scheduled query runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.runDueQueries(ctx)
+				r.deleteExpiredResults(ctx)
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) runDueQueries(ctx context.Context) {
+	now := time.Now()
+	nowTs := now.Unix()
+	scheduledQueries, err := r.store.ListScheduledQueries(ctx, &store.FindScheduledQueryMessage{
+		NextRunTsBefore: &nowTs,
+	})
+	if err != nil {
+		slog.Error("Failed to list due scheduled queries.", log.BBError(err))
+		return
+	}
+	for _, scheduledQuery := range scheduledQueries {
+		schedule, err := ParseSchedule(scheduledQuery.Schedule)
+		if err != nil {
+			slog.Error("Failed to parse schedule.", slog.Int("scheduled_query", scheduledQuery.UID), log.BBError(err))
+			continue
+		}
+		next := schedule.Next(now)
+		if next.IsZero() {
+			slog.Error("Failed to get the next run time.", slog.Int("scheduled_query", scheduledQuery.UID), slog.String("schedule", scheduledQuery.Schedule))
+			continue
+		}
+		// Move to the next run before running, so that a failing query is not retried until the next run.
+		nextRunTs := next.Unix()
+		if _, err := r.store.UpdateScheduledQuery(ctx, &store.UpdateScheduledQueryMessage{
+			UID:       scheduledQuery.UID,
+			NextRunTs: &nextRunTs,
+		}); err != nil {
+			slog.Error("Failed to update the next run time of scheduled query.", slog.Int("scheduled_query", scheduledQuery.UID), log.BBError(err))
+			continue
+		}
+		r.run(ctx, scheduledQuery, now)
+	}
+}
+
+// run runs the scheduled query, stores the result snapshot and delivers it.
+func (r *Runner) run(ctx context.Context, scheduledQuery *store.ScheduledQueryMessage, now time.Time) {
+	retentionDays := int(scheduledQuery.Payload.RetentionDays)
+	if retentionDays <= 0 {
+		retentionDays = DefaultRetentionDays
+	}
+	create := &store.ScheduledQueryResultMessage{
+		ScheduledQueryUID: scheduledQuery.UID,
+		Status:            store.ScheduledQueryResultStatusDone,
+		ExpireTs:          now.AddDate(0, 0, retentionDays).Unix(),
+	}
+	content, rowCount, err := r.execute(ctx, scheduledQuery)
+	if err != nil {
+		slog.Debug("Failed to run scheduled query.", slog.Int("scheduled_query", scheduledQuery.UID), log.BBError(err))
+		create.Status = store.ScheduledQueryResultStatusFailed
+		create.Error = err.Error()
+	} else {
+		create.Content = content
+		create.RowCount = rowCount
+	}
+	result, err := r.store.CreateScheduledQueryResult(ctx, create)
+	if err != nil {
+		slog.Error("Failed to create scheduled query result.", slog.Int("scheduled_query", scheduledQuery.UID), log.BBError(err))
+		return
+	}
+
+	if scheduledQuery.Payload.WebhookDelivery {
+		if err := r.postWebhooks(ctx, scheduledQuery, result); err != nil {
+			slog.Error("Failed to post scheduled query result to webhooks.", slog.Int("scheduled_query", scheduledQuery.UID), log.BBError(err))
+		}
+	}
+	if len(scheduledQuery.Payload.EmailRecipients) > 0 {
+		if err := r.sendEmail(ctx, scheduledQuery, result); err != nil {
+			slog.Error("Failed to email scheduled query result.", slog.Int("scheduled_query", scheduledQuery.UID), log.BBError(err))
+		}
+	}
+}
+
+func (r *Runner) deleteExpiredResults(ctx context.Context) {
+	count, err := r.store.DeleteExpiredScheduledQueryResults(ctx, time.Now().Unix())
+	if err != nil {
+		slog.Error("Failed to delete expired scheduled query results.", log.BBError(err))
+		return
+	}
+	if count > 0 {
+		slog.Debug("Deleted expired scheduled query results.", slog.Int64("count", count))
+	}
+}
+
+func (r *Runner) postWebhooks(ctx context.Context, scheduledQuery *store.ScheduledQueryMessage, result *store.ScheduledQueryResultMessage) error {
+	database, err := r.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: &scheduledQuery.DatabaseUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get database %d", scheduledQuery.DatabaseUID)
+	}
+	if database == nil {
+		return errors.Errorf("database %d not found", scheduledQuery.DatabaseUID)
+	}
+	project, err := r.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project %q", database.ProjectID)
+	}
+	if project == nil {
+		return errors.Errorf("project %q not found", database.ProjectID)
+	}
+	creator, err := r.store.GetUserByID(ctx, scheduledQuery.CreatorUID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get creator %d", scheduledQuery.CreatorUID)
+	}
+	if creator == nil {
+		return errors.Errorf("creator %d not found", scheduledQuery.CreatorUID)
+	}
+	generalSetting, err := r.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get workspace general setting")
+	}
+
+	level := webhook.WebhookSuccess
+	title := fmt.Sprintf("Scheduled query %q returned %d rows", scheduledQuery.Title, result.RowCount)
+	titleZh := fmt.Sprintf("定时查询 %q 返回 %d 行", scheduledQuery.Title, result.RowCount)
+	description := preview(result.Content)
+	if result.Status == store.ScheduledQueryResultStatusFailed {
+		level = webhook.WebhookError
+		title = fmt.Sprintf("Scheduled query %q failed", scheduledQuery.Title)
+		titleZh = fmt.Sprintf("定时查询 %q 失败", scheduledQuery.Title)
+		description = result.Error
+	}
+	return r.activityManager.PostProjectWebhooks(ctx, project.UID, api.ActivityNotifySQLScheduledQuery, &webhook.Context{
+		Level:        level,
+		ActivityType: string(api.ActivityNotifySQLScheduledQuery),
+		Title:        title,
+		TitleZh:      titleZh,
+		Description:  description,
+		Link:         fmt.Sprintf("%s/sql-editor", generalSetting.ExternalUrl),
+		CreatorID:    creator.ID,
+		CreatorName:  creator.Name,
+		CreatorEmail: creator.Email,
+		Project: &webhook.Project{
+			ID:   project.UID,
+			Name: project.Title,
+		},
+	})
+}
+
+// preview returns the leading lines of the CSV content within the max preview length.
+func preview(content string) string {
+	if len(content) <= maxPreviewLength {
+		return content
+	}
+	content = content[:maxPreviewLength]
+	if i := strings.LastIndexByte(content, '\n'); i > 0 {
+		content = content[:i]
+	}
+	return content + "\n..."
+}
+
+func (r *Runner) sendEmail(ctx context.Context, scheduledQuery *store.ScheduledQueryMessage, result *store.ScheduledQueryResultMessage) error {
+	name := api.SettingWorkspaceMailDelivery
+	mailSetting, err := r.store.GetSettingV2(ctx, &store.FindSettingMessage{Name: &name})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get mail setting")
+	}
+	if mailSetting == nil {
+		return errors.Errorf("mail delivery is not configured")
+	}
+	var storeValue storepb.SMTPMailDeliverySetting
+	if err := protojson.Unmarshal([]byte(mailSetting.Value), &storeValue); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal mail setting")
+	}
+
+	createdTime := time.Unix(result.CreatedTs, 0).UTC().Format(time.RFC3339)
+	subject := fmt.Sprintf("Scheduled query %s - %s", scheduledQuery.Title, createdTime)
+	body := fmt.Sprintf("<p>The scheduled query <b>%s</b> returned %d rows at %s, see the attachment.</p>", html.EscapeString(scheduledQuery.Title), result.RowCount, createdTime)
+	if result.Status == store.ScheduledQueryResultStatusFailed {
+		body = fmt.Sprintf("<p>The scheduled query <b>%s</b> failed at %s.</p><pre>%s</pre>", html.EscapeString(scheduledQuery.Title), createdTime, html.EscapeString(result.Error))
+	}
+	email := mail.NewEmailMsg().
+		SetFrom(fmt.Sprintf("Bytebase <%s>", storeValue.From)).
+		AddTo(scheduledQuery.Payload.EmailRecipients...).
+		SetSubject(subject).
+		SetBody(body)
+	if result.Status == store.ScheduledQueryResultStatusDone {
+		if _, err := email.Attach(bytes.NewBufferString(result.Content), fmt.Sprintf("scheduled-query-%d.csv", result.UID), mail.ContentTypeTextCSV); err != nil {
+			return errors.Wrapf(err, "failed to attach result")
+		}
+	}
+	client := mail.NewSMTPClient(storeValue.Server, int(storeValue.Port))
+	client.SetAuthType(convertToMailSMTPAuthType(storeValue.Authentication)).
+		SetAuthCredentials(storeValue.Username, storeValue.Password).
+		SetEncryptionType(convertToMailSMTPEncryptionType(storeValue.Encryption))
+	return client.SendMail(email)
+}
+
+func convertToMailSMTPEncryptionType(encryption storepb.SMTPMailDeliverySetting_Encryption) mail.SMTPEncryptionType {
+	switch encryption {
+	case storepb.SMTPMailDeliverySetting_ENCRYPTION_SSL_TLS:
+		return mail.SMTPEncryptionTypeSSLTLS
+	case storepb.SMTPMailDeliverySetting_ENCRYPTION_STARTTLS:
+		return mail.SMTPEncryptionTypeSTARTTLS
+	default:
+		return mail.SMTPEncryptionTypeNone
+	}
+}
+
+func convertToMailSMTPAuthType(auth storepb.SMTPMailDeliverySetting_Authentication) mail.SMTPAuthType {
+	switch auth {
+	case storepb.SMTPMailDeliverySetting_AUTHENTICATION_PLAIN:
+		return mail.SMTPAuthTypePlain
+	case storepb.SMTPMailDeliverySetting_AUTHENTICATION_CRAM_MD5:
+		return mail.SMTPAuthTypeCRAMMD5
+	case storepb.SMTPMailDeliverySetting_AUTHENTICATION_LOGIN:
+		return mail.SMTPAuthTypeLogin
+	default:
+		return mail.SMTPAuthTypeNone
+	}
+}
@@ -0,0 +1,144 @@
+package schemasync
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	// pgTypeCastRegexp matches the trailing type casts in PostgreSQL, e.g. 'a'::character varying.
+	pgTypeCastRegexp = regexp.MustCompile(`(::[a-z_ ]+(\([0-9, ]*\))?(\[\])?)+$`)
+	// currentTimestampRegexp matches the functions equivalent to CURRENT_TIMESTAMP with the optional precision.
+	currentTimestampRegexp = regexp.MustCompile(`^(current_timestamp|now|localtimestamp|localtime|transaction_timestamp)(\(\s*([0-9]*)\s*\))?$`)
+	quotedNumberRegexp     = regexp.MustCompile(`^'(-?[0-9]+(\.[0-9]+)?)'$`)
+)
+
+// isSchemaDrifted compares the schema recorded in the latest change history with the actual schema.
+// The schemas formatted differently are parsed to the metadata, and they are not drifted
+// if the metadata only differ in the semantically equal column default expressions,
+// e.g. now() and CURRENT_TIMESTAMP.
+func isSchemaDrifted(engine storepb.Engine, expectSchema, actualSchema string) bool {
+	if expectSchema == actualSchema {
+		return false
+	}
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_POSTGRES:
+	default:
+		return true
+	}
+	expectMetadata, err := schema.ParseToMetadata(engine, expectSchema)
+	if err != nil {
+		return true
+	}
+	actualMetadata, err := schema.ParseToMetadata(engine, actualSchema)
+	if err != nil {
+		return true
+	}
+	// The difference is not captured by the metadata, e.g. the triggers, so we cannot tell it's only the default expressions.
+	if equalDatabaseMetadata(expectMetadata, actualMetadata) {
+		return true
+	}
+	normalizeColumnDefaults(engine, expectMetadata)
+	normalizeColumnDefaults(engine, actualMetadata)
+	return !equalDatabaseMetadata(expectMetadata, actualMetadata)
+}
+
+// normalizeColumnDefaults converts the column defaults to the normalized default expressions.
+func normalizeColumnDefaults(engine storepb.Engine, metadata *storepb.DatabaseSchemaMetadata) {
+	for _, schema := range metadata.GetSchemas() {
+		for _, table := range schema.GetTables() {
+			for _, column := range table.GetColumns() {
+				var expression string
+				switch value := column.DefaultValue.(type) {
+				case nil:
+					continue
+				case *storepb.ColumnMetadata_DefaultNull:
+					expression = "null"
+				case *storepb.ColumnMetadata_Default:
+					if value.Default == nil {
+						continue
+					}
+					expression = "'" + strings.ReplaceAll(value.Default.GetValue(), "'", "''") + "'"
+				case *storepb.ColumnMetadata_DefaultExpression:
+					expression = value.DefaultExpression
+				}
+				column.DefaultValue = &storepb.ColumnMetadata_DefaultExpression{
+					DefaultExpression: normalizeDefaultExpression(engine, expression),
+				}
+			}
+		}
+	}
+}
+
+// normalizeDefaultExpression normalizes the default expression for comparison.
+func normalizeDefaultExpression(engine storepb.Engine, expression string) string {
+	expression = lowerOutsideQuotes(strings.TrimSpace(expression))
+	for {
+		trimmed := strings.TrimSpace(trimEnclosingParentheses(expression))
+		if engine == storepb.Engine_POSTGRES {
+			trimmed = strings.TrimSpace(pgTypeCastRegexp.ReplaceAllString(trimmed, ""))
+		}
+		if trimmed == expression {
+			break
+		}
+		expression = trimmed
+	}
+	if matches := currentTimestampRegexp.FindStringSubmatch(expression); matches != nil {
+		// LOCALTIME is a TIME value in PostgreSQL.
+		if !(engine == storepb.Engine_POSTGRES && matches[1] == "localtime") {
+			if matches[3] != "" && matches[3] != "0" {
+				return "current_timestamp(" + matches[3] + ")"
+			}
+			return "current_timestamp"
+		}
+	}
+	if matches := quotedNumberRegexp.FindStringSubmatch(expression); matches != nil {
+		return matches[1]
+	}
+	return expression
+}
+
+// trimEnclosingParentheses trims the parentheses enclosing the whole expression, e.g. (0).
+func trimEnclosingParentheses(expression string) string {
+	if !strings.HasPrefix(expression, "(") || !strings.HasSuffix(expression, ")") {
+		return expression
+	}
+	depth := 0
+	inQuote := false
+	for i, c := range expression {
+		switch {
+		case c == '\'':
+			inQuote = !inQuote
+		case inQuote:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			// The first parenthesis is closed before the end, e.g. (a) + (b).
+			if depth == 0 && i != len(expression)-1 {
+				return expression
+			}
+		}
+	}
+	return expression[1 : len(expression)-1]
+}
+
+// lowerOutsideQuotes converts the expression to lower case except the quoted string literals.
+func lowerOutsideQuotes(expression string) string {
+	var buf strings.Builder
+	inQuote := false
+	for _, c := range expression {
+		if c == '\'' {
+			inQuote = !inQuote
+		}
+		if inQuote {
+			_, _ = buf.WriteRune(c)
+			continue
+		}
+		_, _ = buf.WriteString(strings.ToLower(string(c)))
+	}
+	return buf.String()
+}
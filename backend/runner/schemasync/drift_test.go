@@ -0,0 +1,79 @@
+package schemasync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	// Register the schema parsers.
+	_ "github.com/bytebase/bytebase/backend/plugin/schema/mysql"
+	_ "github.com/bytebase/bytebase/backend/plugin/schema/pg"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestNormalizeDefaultExpression(t *testing.T) {
+	tests := []struct {
+		engine     storepb.Engine
+		expression string
+		want       string
+	}{
+		{storepb.Engine_MYSQL, "CURRENT_TIMESTAMP", "current_timestamp"},
+		{storepb.Engine_MYSQL, "now()", "current_timestamp"},
+		{storepb.Engine_MYSQL, "CURRENT_TIMESTAMP(3)", "current_timestamp(3)"},
+		{storepb.Engine_MYSQL, "'0'", "0"},
+		{storepb.Engine_MYSQL, "(0)", "0"},
+		{storepb.Engine_MYSQL, "'Hello'", "'Hello'"},
+		{storepb.Engine_POSTGRES, "now()", "current_timestamp"},
+		{storepb.Engine_POSTGRES, "'1'::integer", "1"},
+		{storepb.Engine_POSTGRES, "'Hello'::character varying", "'Hello'"},
+		{storepb.Engine_POSTGRES, "('a'::text)::character varying(10)", "'a'"},
+		{storepb.Engine_POSTGRES, "(a + 1) * (b + 1)", "(a + 1) * (b + 1)"},
+		{storepb.Engine_POSTGRES, "LOCALTIME", "localtime"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, normalizeDefaultExpression(test.engine, test.expression), test.expression)
+	}
+}
+
+func TestIsSchemaDrifted(t *testing.T) {
+	tests := []struct {
+		engine  storepb.Engine
+		expect  string
+		actual  string
+		drifted bool
+	}{
+		{
+			engine:  storepb.Engine_POSTGRES,
+			expect:  "CREATE TABLE public.t (\n    id integer DEFAULT 0,\n    created_at timestamp with time zone DEFAULT now()\n);\n",
+			actual:  "CREATE TABLE public.t (\n    id integer DEFAULT '0'::integer,\n    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP\n);\n",
+			drifted: false,
+		},
+		{
+			engine:  storepb.Engine_POSTGRES,
+			expect:  "CREATE TABLE public.t (\n    id integer DEFAULT 0\n);\n",
+			actual:  "CREATE TABLE public.t (\n    id integer DEFAULT 1\n);\n",
+			drifted: true,
+		},
+		{
+			engine:  storepb.Engine_POSTGRES,
+			expect:  "CREATE TABLE public.t (\n    id integer DEFAULT 0\n);\n",
+			actual:  "CREATE TABLE public.t (\n    id integer DEFAULT 0,\n    name text\n);\n",
+			drifted: true,
+		},
+		{
+			engine:  storepb.Engine_MYSQL,
+			expect:  "CREATE TABLE `t` (\n  `id` int DEFAULT '0',\n  `created_at` datetime DEFAULT CURRENT_TIMESTAMP\n);\n",
+			actual:  "CREATE TABLE `t` (\n  `id` int DEFAULT 0,\n  `created_at` datetime DEFAULT now()\n);\n",
+			drifted: false,
+		},
+		{
+			engine:  storepb.Engine_ORACLE,
+			expect:  "CREATE TABLE t (id INT DEFAULT 0);",
+			actual:  "CREATE TABLE t (id INT DEFAULT (0));",
+			drifted: true,
+		},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.drifted, isSchemaDrifted(test.engine, test.expect, test.actual), test.actual)
+	}
+}
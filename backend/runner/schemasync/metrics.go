@@ -0,0 +1,34 @@
+package schemasync
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	syncDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "bb",
+		Subsystem: "schema_sync",
+		Name:      "duration_seconds",
+		Help:      "The time syncing the instances or the databases by the instance and the sync kind of instance or database.",
+		Buckets:   []float64{0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 300},
+	}, []string{"instance", "kind"})
+	syncFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bb",
+		Subsystem: "schema_sync",
+		Name:      "failures_total",
+		Help:      "The number of the failed instance or database syncs by the instance and the sync kind of instance or database.",
+	}, []string{"instance", "kind"})
+)
+
+func init() {
+	prometheus.MustRegister(syncDuration, syncFailures)
+}
+
+func observeSync(instanceID, kind string, startTime time.Time, err error) {
+	syncDuration.WithLabelValues(instanceID, kind).Observe(time.Since(startTime).Seconds())
+	if err != nil {
+		syncFailures.WithLabelValues(instanceID, kind).Inc()
+	}
+}
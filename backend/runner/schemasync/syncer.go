@@ -7,22 +7,27 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"path"
 	"sync"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/gosimple/slug"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/common/tracing"
+	"github.com/bytebase/bytebase/backend/component/activity"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/store/model"
 	"github.com/bytebase/bytebase/backend/utils"
@@ -36,23 +41,25 @@ const (
 )
 
 // NewSyncer creates a schema syncer.
-func NewSyncer(store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State, profile config.Profile, licenseService enterprise.LicenseService) *Syncer {
+func NewSyncer(store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State, profile config.Profile, licenseService enterprise.LicenseService, activityManager *activity.Manager) *Syncer {
 	return &Syncer{
-		store:          store,
-		dbFactory:      dbFactory,
-		stateCfg:       stateCfg,
-		profile:        profile,
-		licenseService: licenseService,
+		store:           store,
+		dbFactory:       dbFactory,
+		stateCfg:        stateCfg,
+		profile:         profile,
+		licenseService:  licenseService,
+		activityManager: activityManager,
 	}
 }
 
 // Syncer is the schema syncer.
 type Syncer struct {
-	store          *store.Store
-	dbFactory      *dbfactory.DBFactory
-	stateCfg       *state.State
-	profile        config.Profile
-	licenseService enterprise.LicenseService
+	store           *store.Store
+	dbFactory       *dbfactory.DBFactory
+	stateCfg        *state.State
+	profile         config.Profile
+	licenseService  enterprise.LicenseService
+	activityManager *activity.Manager
 }
 
 // Run will run the schema syncer once.
@@ -248,10 +255,14 @@ func (s *Syncer) syncAllDatabases(ctx context.Context, instance *store.InstanceM
 }
 
 // SyncInstance syncs the schema for all databases in an instance.
-func (s *Syncer) SyncInstance(ctx context.Context, instance *store.InstanceMessage) error {
+func (s *Syncer) SyncInstance(ctx context.Context, instance *store.InstanceMessage) (retErr error) {
 	if s.profile.Readonly {
 		return nil
 	}
+	startTime := time.Now()
+	defer func() {
+		observeSync(instance.ResourceID, "instance", startTime, retErr)
+	}()
 
 	driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */, db.ConnectionContext{})
 	if err != nil {
@@ -261,10 +272,14 @@ func (s *Syncer) SyncInstance(ctx context.Context, instance *store.InstanceMessa
 	defer driver.Close(ctx)
 	s.upsertInstanceConnectionAnomaly(ctx, instance, nil)
 
-	instanceMeta, err := driver.SyncInstance(ctx)
+	syncCtx, span := db.StartSpan(ctx, driver, "SyncInstance", "")
+	instanceMeta, err := driver.SyncInstance(syncCtx)
+	tracing.End(span, err)
 	if err != nil {
 		return errors.Wrapf(err, "failed to sync instance: %s", instance.ResourceID)
 	}
+	// The databases filtered out are handled in the same way as the dropped databases.
+	instanceMeta.Databases = filterSyncDatabases(instance.Options, instanceMeta.Databases)
 
 	updateInstance := &store.UpdateInstanceMessage{
 		UpdaterID:     api.SystemBotID,
@@ -349,6 +364,10 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 	if s.profile.Readonly {
 		return nil
 	}
+	startTime := time.Now()
+	defer func() {
+		observeSync(database.InstanceID, "database", startTime, retErr)
+	}()
 
 	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
 	if err != nil {
@@ -365,7 +384,9 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 	defer driver.Close(ctx)
 	s.upsertDatabaseConnectionAnomaly(ctx, instance, database, nil)
 	// Sync database schema
-	databaseMetadata, err := driver.SyncDBSchema(ctx)
+	syncCtx, span := db.StartSpan(ctx, driver, "SyncDBSchema", "")
+	databaseMetadata, err := driver.SyncDBSchema(syncCtx)
+	tracing.End(span, err)
 	if err != nil {
 		return errors.Wrapf(err, "failed to sync database schema for database %q", database.DatabaseName)
 	}
@@ -450,7 +471,7 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 		}
 		latestSchema := string(rawDump)
 		if len(list) > 0 {
-			if list[0].Schema != latestSchema {
+			if isSchemaDrifted(instance.Engine, list[0].Schema, latestSchema) {
 				anomalyPayload := api.AnomalyDatabaseSchemaDriftPayload{
 					Version: list[0].Version.Version,
 					Expect:  list[0].Schema,
@@ -464,6 +485,20 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 						slog.String("type", string(api.AnomalyDatabaseSchemaDrift)),
 						log.BBError(err))
 				} else {
+					status := api.Normal
+					activeAnomalies, listErr := s.store.ListAnomalyV2(ctx, &store.ListAnomalyMessage{
+						RowStatus:   &status,
+						InstanceID:  &instance.ResourceID,
+						DatabaseUID: &database.UID,
+						Types:       []api.AnomalyType{api.AnomalyDatabaseSchemaDrift},
+					})
+					if listErr != nil {
+						slog.Error("Failed to list anomalies",
+							slog.String("instance", instance.ResourceID),
+							slog.String("database", database.DatabaseName),
+							slog.String("type", string(api.AnomalyDatabaseSchemaDrift)),
+							log.BBError(listErr))
+					}
 					if _, err = s.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
 						InstanceID:  instance.ResourceID,
 						DatabaseUID: &database.UID,
@@ -475,6 +510,14 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 							slog.String("database", database.DatabaseName),
 							slog.String("type", string(api.AnomalyDatabaseSchemaDrift)),
 							log.BBError(err))
+					} else if listErr == nil && len(activeAnomalies) == 0 {
+						// Only the newly detected drift is notified, rather than on every sync.
+						if err := s.postSchemaDriftWebhooks(ctx, instance, database, anomalyPayload.Version); err != nil {
+							slog.Warn("Failed to post schema drift webhooks",
+								slog.String("instance", instance.ResourceID),
+								slog.String("database", database.DatabaseName),
+								log.BBError(err))
+						}
 					}
 				}
 			} else {
@@ -495,6 +538,40 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 	return nil
 }
 
+func (s *Syncer) postSchemaDriftWebhooks(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, version string) error {
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project %q", database.ProjectID)
+	}
+	if project == nil {
+		return errors.Errorf("project %q not found", database.ProjectID)
+	}
+	generalSetting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get workspace general setting")
+	}
+	bot, err := s.store.GetUserByID(ctx, api.SystemBotID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get system bot")
+	}
+
+	return s.activityManager.PostProjectWebhooks(ctx, project.UID, api.ActivityNotifySchemaDrift, &webhook.Context{
+		Level:        webhook.WebhookWarn,
+		ActivityType: string(api.ActivityNotifySchemaDrift),
+		Title:        fmt.Sprintf("Schema drift detected on database %s", database.DatabaseName),
+		TitleZh:      fmt.Sprintf("数据库 %s 检测到 schema 漂移", database.DatabaseName),
+		Description:  fmt.Sprintf("The schema of database %s on instance %s drifted from the schema of the latest version %s.", database.DatabaseName, instance.Title, version),
+		Link:         fmt.Sprintf("%s/db/%s-%d", generalSetting.ExternalUrl, slug.Make(database.DatabaseName), database.UID),
+		CreatorID:    bot.ID,
+		CreatorName:  bot.Name,
+		CreatorEmail: bot.Email,
+		Project: &webhook.Project{
+			ID:   project.UID,
+			Name: project.Title,
+		},
+	})
+}
+
 func (s *Syncer) upsertInstanceConnectionAnomaly(ctx context.Context, instance *store.InstanceMessage, connErr error) {
 	if connErr != nil {
 		anomalyPayload := api.AnomalyInstanceConnectionPayload{
@@ -761,6 +838,48 @@ func setClassificationAndUserCommentFromComment(dbSchema *storepb.DatabaseSchema
 	}
 }
 
+// ValidateSyncDatabasePatterns validates the glob patterns of the database names to sync.
+func ValidateSyncDatabasePatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if pattern == "" {
+			return errors.Errorf("database name pattern must not be empty")
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return errors.Wrapf(err, "invalid database name pattern %q", pattern)
+		}
+	}
+	return nil
+}
+
+// filterSyncDatabases filters the databases by the include and exclude patterns of the instance.
+func filterSyncDatabases(options *storepb.InstanceOptions, databases []*storepb.DatabaseSchemaMetadata) []*storepb.DatabaseSchemaMetadata {
+	includePatterns, excludePatterns := options.GetSyncDatabaseIncludePatterns(), options.GetSyncDatabaseExcludePatterns()
+	if len(includePatterns) == 0 && len(excludePatterns) == 0 {
+		return databases
+	}
+	var result []*storepb.DatabaseSchemaMetadata
+	for _, database := range databases {
+		if len(includePatterns) > 0 && !matchAnyPattern(includePatterns, database.Name) {
+			continue
+		}
+		if matchAnyPattern(excludePatterns, database.Name) {
+			continue
+		}
+		result = append(result, database)
+	}
+	return result
+}
+
+func matchAnyPattern(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		// The patterns are validated when they are saved.
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
 func getOrDefaultSyncInterval(instance *store.InstanceMessage) time.Duration {
 	if !instance.Activation {
 		return defaultSyncInterval
@@ -134,3 +134,44 @@ func TestEqualDatabaseMetadata(t *testing.T) {
 		assert.Equal(t, test.want, got)
 	}
 }
+
+func TestFilterSyncDatabases(t *testing.T) {
+	databases := []*storepb.DatabaseSchemaMetadata{
+		{Name: "app_1"},
+		{Name: "app_2"},
+		{Name: "monitor"},
+		{Name: "app_monitor"},
+	}
+	tests := []struct {
+		options *storepb.InstanceOptions
+		want    []string
+	}{
+		{
+			options: nil,
+			want:    []string{"app_1", "app_2", "monitor", "app_monitor"},
+		},
+		{
+			options: &storepb.InstanceOptions{SyncDatabaseExcludePatterns: []string{"*monitor"}},
+			want:    []string{"app_1", "app_2"},
+		},
+		{
+			options: &storepb.InstanceOptions{SyncDatabaseIncludePatterns: []string{"app_*"}},
+			want:    []string{"app_1", "app_2", "app_monitor"},
+		},
+		{
+			options: &storepb.InstanceOptions{SyncDatabaseIncludePatterns: []string{"app_*"}, SyncDatabaseExcludePatterns: []string{"app_[2m]*"}},
+			want:    []string{"app_1"},
+		},
+	}
+	for _, test := range tests {
+		var got []string
+		for _, database := range filterSyncDatabases(test.options, databases) {
+			got = append(got, database.Name)
+		}
+		assert.Equal(t, test.want, got)
+	}
+
+	assert.NoError(t, ValidateSyncDatabasePatterns([]string{"app_*", "monitor"}))
+	assert.Error(t, ValidateSyncDatabasePatterns([]string{"app_["}))
+	assert.Error(t, ValidateSyncDatabasePatterns([]string{""}))
+}
@@ -0,0 +1,262 @@
+// Package servicenow is the runner opening, polling and closing the ServiceNow change requests of the stages.
+package servicenow
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/gosimple/slug"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	servicenowplugin "github.com/bytebase/bytebase/backend/plugin/app/servicenow"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const serviceNowRunnerInterval = 1 * time.Minute
+
+// NewRunner creates a new ServiceNow runner.
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		store: store,
+	}
+}
+
+// Runner is the runner syncing the change requests of the stages with ServiceNow.
+type Runner struct {
+	store *store.Store
+	// mu serializes the checks of the runner and the webhooks.
+	mu sync.Mutex
+}
+
+// Run is the runner for the ServiceNow runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(serviceNowRunnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug("ServiceNow runner started", slog.Duration("interval", serviceNowRunnerInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("ServiceNow runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.checkStages(ctx, "")
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+// checkStages checks the stages with the active change requests, only the one of the sys_id if it's set.
+func (r *Runner) checkStages(ctx context.Context, sysID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stages, err := r.store.ListActiveChangeRequestStages(ctx)
+	if err != nil {
+		slog.Error("Failed to list stages with active change requests.", log.BBError(err))
+		return
+	}
+	if len(stages) == 0 {
+		return
+	}
+	setting, err := r.store.GetServiceNowSetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get ServiceNow setting.", log.BBError(err))
+		return
+	}
+	client := servicenowplugin.NewClient(setting.Url, setting.Username, setting.Password)
+	for _, stage := range stages {
+		changeRequest := stage.Payload.ChangeRequest
+		if sysID != "" && changeRequest.SysId != sysID {
+			continue
+		}
+		original, ok := proto.Clone(changeRequest).(*storepb.StageChangeRequest)
+		if !ok {
+			continue
+		}
+		checkErr := errors.New("ServiceNow is not configured")
+		if setting.Url != "" {
+			checkErr = r.checkStage(ctx, client, setting, stage)
+		}
+		errMessage := ""
+		if checkErr != nil {
+			slog.Warn("Failed to check the change request of stage.", slog.Int("stage", stage.ID), log.BBError(checkErr))
+			errMessage = checkErr.Error()
+		}
+		changeRequest.Error = errMessage
+		if proto.Equal(original, changeRequest) {
+			continue
+		}
+		if err := r.store.UpdateStagePayload(ctx, stage.ID, stage.Payload); err != nil {
+			slog.Error("Failed to update the change request of stage.", slog.Int("stage", stage.ID), log.BBError(err))
+		}
+	}
+}
+
+// checkStage opens the requested change request, moves the change request to IMPLEMENT once it reaches the "Implement" state,
+// and closes the change request with the task results once all the tasks of the stage finish.
+// The change request of the stage in the closed issue is canceled if it's pending, or closed if it's being implemented.
+func (r *Runner) checkStage(ctx context.Context, client *servicenowplugin.Client, setting *storepb.ServiceNowSetting, stage *store.StageMessage) error {
+	changeRequest := stage.Payload.ChangeRequest
+	issue, err := r.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &stage.PipelineID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get issue of pipeline %d", stage.PipelineID)
+	}
+	issueOpen := issue == nil || issue.Status == api.IssueOpen
+
+	switch changeRequest.Status {
+	case storepb.StageChangeRequest_PENDING:
+		if !issueOpen {
+			if changeRequest.SysId != "" {
+				if err := client.CancelChangeRequest(changeRequest.SysId, fmt.Sprintf("The Bytebase issue is %s.", issue.Status)); err != nil {
+					return err
+				}
+			}
+			changeRequest.Status = storepb.StageChangeRequest_CANCELED
+			return nil
+		}
+		if changeRequest.SysId == "" {
+			created, err := client.CreateChangeRequest(r.getCreateChangeRequestPayload(ctx, setting, issue, stage))
+			if err != nil {
+				return err
+			}
+			changeRequest.SysId, changeRequest.Number = created.SysID, created.Number
+			return nil
+		}
+		current, err := client.GetChangeRequest(changeRequest.SysId)
+		if err != nil {
+			return err
+		}
+		switch current.State {
+		case servicenowplugin.StateImplement, servicenowplugin.StateReview:
+			changeRequest.Status = storepb.StageChangeRequest_IMPLEMENT
+		case servicenowplugin.StateClosed, servicenowplugin.StateCanceled:
+			// The pending task runs are canceled rather than waiting forever, and running them again requests a new change request.
+			changeRequest.Status = storepb.StageChangeRequest_CANCELED
+			return r.cancelPendingTaskRuns(ctx, stage)
+		default:
+		}
+		return nil
+	case storepb.StageChangeRequest_IMPLEMENT:
+		tasks, err := r.store.ListTasks(ctx, &api.TaskFind{StageID: &stage.ID})
+		if err != nil {
+			return errors.Wrapf(err, "failed to list tasks of stage %d", stage.ID)
+		}
+		result, err := getTaskResult(tasks)
+		if err != nil {
+			return err
+		}
+		if !result.finished() && issueOpen {
+			return nil
+		}
+		closeCode := "successful"
+		if !result.successful() || !issueOpen {
+			closeCode = "unsuccessful"
+		}
+		if err := client.CloseChangeRequest(changeRequest.SysId, closeCode, result.String()); err != nil {
+			return err
+		}
+		changeRequest.Status = storepb.StageChangeRequest_CLOSED
+		return nil
+	default:
+		return nil
+	}
+}
+
+func (r *Runner) getCreateChangeRequestPayload(ctx context.Context, setting *storepb.ServiceNowSetting, issue *store.IssueMessage, stage *store.StageMessage) *servicenowplugin.CreateChangeRequestPayload {
+	payload := &servicenowplugin.CreateChangeRequestPayload{
+		ShortDescription: fmt.Sprintf("Bytebase rollout of stage %q", stage.Name),
+		AssignmentGroup:  setting.AssignmentGroup,
+	}
+	if issue == nil {
+		return payload
+	}
+	payload.ShortDescription = fmt.Sprintf("%s - %s", issue.Title, stage.Name)
+	payload.Description = issue.Description
+	generalSetting, err := r.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		slog.Warn("Failed to get workspace general setting.", log.BBError(err))
+		return payload
+	}
+	if generalSetting.ExternalUrl != "" {
+		payload.Description = fmt.Sprintf("%s\n\nBytebase issue: %s/issue/%s-%d", payload.Description, generalSetting.ExternalUrl, slug.Make(issue.Title), issue.UID)
+	}
+	return payload
+}
+
+func (r *Runner) cancelPendingTaskRuns(ctx context.Context, stage *store.StageMessage) error {
+	taskRuns, err := r.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
+		StageUID: &stage.ID,
+		Status:   &[]api.TaskRunStatus{api.TaskRunPending},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list pending task runs of stage %d", stage.ID)
+	}
+	var taskRunIDs []int
+	for _, taskRun := range taskRuns {
+		taskRunIDs = append(taskRunIDs, taskRun.ID)
+	}
+	if len(taskRunIDs) == 0 {
+		return nil
+	}
+	return r.store.BatchCancelTaskRuns(ctx, taskRunIDs, api.SystemBotID)
+}
+
+// taskResult is the result of the tasks of the stage.
+type taskResult struct {
+	done, failed, canceled, skipped, unfinished int
+}
+
+func getTaskResult(tasks []*store.TaskMessage) (*taskResult, error) {
+	result := &taskResult{}
+	for _, task := range tasks {
+		skipped, err := utils.GetTaskSkipped(task)
+		if err != nil {
+			return nil, err
+		}
+		if skipped {
+			result.skipped++
+			continue
+		}
+		switch task.LatestTaskRunStatus {
+		case api.TaskRunDone:
+			result.done++
+		case api.TaskRunFailed:
+			result.failed++
+		case api.TaskRunCanceled:
+			result.canceled++
+		default:
+			result.unfinished++
+		}
+	}
+	return result, nil
+}
+
+func (t *taskResult) finished() bool {
+	return t.unfinished == 0
+}
+
+func (t *taskResult) successful() bool {
+	return t.unfinished == 0 && t.failed == 0 && t.canceled == 0
+}
+
+func (t *taskResult) String() string {
+	return fmt.Sprintf("Bytebase tasks: %d done, %d failed, %d canceled, %d skipped, %d unfinished.", t.done, t.failed, t.canceled, t.skipped, t.unfinished)
+}
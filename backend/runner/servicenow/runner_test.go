@@ -0,0 +1,34 @@
+package servicenow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestGetTaskResult(t *testing.T) {
+	a := require.New(t)
+
+	tasks := []*store.TaskMessage{
+		{Payload: "{}", LatestTaskRunStatus: api.TaskRunDone},
+		{Payload: `{"skipped": true}`},
+		{Payload: "{}", LatestTaskRunStatus: api.TaskRunFailed},
+	}
+	result, err := getTaskResult(tasks)
+	a.NoError(err)
+	a.True(result.finished())
+	a.False(result.successful())
+	a.Equal("Bytebase tasks: 1 done, 1 failed, 0 canceled, 1 skipped, 0 unfinished.", result.String())
+
+	result, err = getTaskResult(append(tasks[:2], &store.TaskMessage{Payload: "{}", LatestTaskRunStatus: api.TaskRunRunning}))
+	a.NoError(err)
+	a.False(result.finished())
+	a.False(result.successful())
+
+	result, err = getTaskResult(tasks[:2])
+	a.NoError(err)
+	a.True(result.successful())
+}
@@ -0,0 +1,40 @@
+package servicenow
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// webhookPayload is the request body posted by the ServiceNow business rule on the change request updates.
+type webhookPayload struct {
+	SysID string `json:"sys_id"`
+}
+
+// RegisterWebhookRoutes registers the route of the ServiceNow webhook notifying the change request updates.
+// The webhook only triggers checking the change request, whose state is always read from ServiceNow.
+func (r *Runner) RegisterWebhookRoutes(g *echo.Group) {
+	g.POST("/servicenow/:token", func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		setting, err := r.store.GetServiceNowSetting(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get ServiceNow setting").SetInternal(err)
+		}
+		if setting.Url == "" || setting.WebhookToken == "" || subtle.ConstantTimeCompare([]byte(setting.WebhookToken), []byte(c.Param("token"))) != 1 {
+			return echo.NewHTTPError(http.StatusNotFound, "ServiceNow webhook not found")
+		}
+
+		var body webhookPayload
+		if err := json.NewDecoder(c.Request().Body).Decode(&body); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Malformed ServiceNow webhook").SetInternal(err)
+		}
+		if body.SysID == "" {
+			return echo.NewHTTPError(http.StatusBadRequest, "sys_id is required")
+		}
+		r.checkStages(ctx, body.SysID)
+		return c.String(http.StatusOK, "OK")
+	})
+}
@@ -0,0 +1,152 @@
+// Package slowqueryregression is the runner alerting the week over week slow query regressions of the projects.
+package slowqueryregression
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gosimple/slug"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/activity"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	slowQueryRegressionInterval = 1 * time.Hour
+	// maxAlertDigests bounds the regressed digests listed in the webhook message.
+	maxAlertDigests = 5
+)
+
+// NewRunner creates a new slow query regression runner.
+func NewRunner(store *store.Store, activityManager *activity.Manager) *Runner {
+	return &Runner{
+		store:           store,
+		activityManager: activityManager,
+	}
+}
+
+// Runner is the runner posting the slow query regressions to the project webhooks weekly.
+type Runner struct {
+	store           *store.Store
+	activityManager *activity.Manager
+}
+
+// Run is the runner for slow query regression runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(slowQueryRegressionInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug("Slow query regression runner started", slog.Duration("interval", slowQueryRegressionInterval))
+	for {
+		select {
+		case <-ticker.C:
+			now := time.Now().UTC()
+			// Check the regressions every Monday in 00:00 ~ 00:59 UTC, after the slow queries of the last week are synced.
+			if now.Weekday() != time.Monday || now.Hour() != 0 {
+				continue
+			}
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Slow query regression runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.alertRegressions(ctx, now)
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) alertRegressions(ctx context.Context, now time.Time) {
+	projects, err := r.store.ListProjectV2(ctx, &store.FindProjectMessage{})
+	if err != nil {
+		slog.Error("Failed to list projects", log.BBError(err))
+		return
+	}
+	for _, project := range projects {
+		factor := project.Setting.GetSlowQueryRegressionFactor()
+		if factor <= 0 {
+			continue
+		}
+		// The report of the last full week, as the runner runs at the start of Monday.
+		report, err := utils.GetProjectSlowQueryReport(ctx, r.store, project.ResourceID, now.AddDate(0, 0, -1), factor)
+		if err != nil {
+			slog.Error("Failed to get slow query report", slog.String("project", project.ResourceID), log.BBError(err))
+			continue
+		}
+		var regressed []*v1pb.SlowQueryDigest
+		for _, digest := range report.Digests {
+			if digest.Regressed {
+				regressed = append(regressed, digest)
+			}
+		}
+		if len(regressed) == 0 {
+			continue
+		}
+		if err := r.postRegressionWebhooks(ctx, project, factor, regressed); err != nil {
+			slog.Error("Failed to post slow query regression webhooks", slog.String("project", project.ResourceID), log.BBError(err))
+		}
+	}
+}
+
+func (r *Runner) postRegressionWebhooks(ctx context.Context, project *store.ProjectMessage, factor float64, regressed []*v1pb.SlowQueryDigest) error {
+	generalSetting, err := r.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get workspace general setting")
+	}
+	bot, err := r.store.GetUserByID(ctx, api.SystemBotID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get system bot")
+	}
+
+	return r.activityManager.PostProjectWebhooks(ctx, project.UID, api.ActivityNotifySlowQueryRegression, &webhook.Context{
+		Level:        webhook.WebhookWarn,
+		ActivityType: string(api.ActivityNotifySlowQueryRegression),
+		Title:        fmt.Sprintf("%d slow queries regressed in project %s", len(regressed), project.Title),
+		TitleZh:      fmt.Sprintf("项目 %s 有 %d 条慢查询性能退化", project.Title, len(regressed)),
+		Description:  formatRegressionDescription(factor, regressed),
+		Link:         fmt.Sprintf("%s/project/%s-%d", generalSetting.ExternalUrl, slug.Make(project.Title), project.UID),
+		CreatorID:    bot.ID,
+		CreatorName:  bot.Name,
+		CreatorEmail: bot.Email,
+		Project: &webhook.Project{
+			ID:   project.UID,
+			Name: project.Title,
+		},
+	})
+}
+
+func formatRegressionDescription(factor float64, regressed []*v1pb.SlowQueryDigest) string {
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "The average query time of the following queries increased by %.1fx or more compared with the previous week.", factor)
+	for i, digest := range regressed {
+		if i == maxAlertDigests {
+			_, _ = fmt.Fprintf(&buf, "\n... and %d more", len(regressed)-maxAlertDigests)
+			break
+		}
+		_, _ = fmt.Fprintf(&buf, "\n- %.1fx (%s -> %s) on %s: %s",
+			digest.RegressionRatio,
+			digest.PreviousAverageQueryTime.AsDuration(),
+			digest.AverageQueryTime.AsDuration(),
+			strings.Join(digest.Databases, ", "),
+			digest.SqlFingerprint,
+		)
+	}
+	return buf.String()
+}
@@ -0,0 +1,299 @@
+package taskrun
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/activity"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// scheduleCanaryStages promotes or halts the baking canary stages.
+// A canary stage is promoted once its tasks finish within the failure limit, the bake period passes and the post-checks pass.
+func (s *SchedulerV2) scheduleCanaryStages(ctx context.Context) error {
+	stages, err := s.store.ListBakingCanaryStages(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list baking canary stages")
+	}
+	for _, stage := range stages {
+		if err := s.scheduleCanaryStage(ctx, stage); err != nil {
+			slog.Error("failed to schedule canary stage", slog.Int("stage", stage.ID), log.BBError(err))
+		}
+	}
+	return nil
+}
+
+func (s *SchedulerV2) scheduleCanaryStage(ctx context.Context, stage *store.StageMessage) error {
+	canary := stage.Payload.GetCanary()
+	tasks, err := s.store.ListTasks(ctx, &api.TaskFind{StageID: &stage.ID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list tasks")
+	}
+	finished, failurePercentage, err := getCanaryFailurePercentage(tasks)
+	if err != nil {
+		return err
+	}
+	if !finished {
+		return nil
+	}
+	if failurePercentage > canary.MaxFailurePercentage {
+		return s.haltCanaryStage(ctx, stage, fmt.Sprintf("%d%% of the canary tasks failed, exceeding the maximum %d%%", failurePercentage, canary.MaxFailurePercentage))
+	}
+
+	// The bake period starts when the last canary task finishes.
+	taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{StageUID: &stage.ID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list task runs")
+	}
+	var finishedTs int64
+	for _, taskRun := range taskRuns {
+		finishedTs = max(finishedTs, taskRun.UpdatedTs)
+	}
+	if time.Now().Before(time.Unix(finishedTs, 0).Add(canary.BakeDuration.AsDuration())) {
+		return nil
+	}
+
+	for _, task := range tasks {
+		if task.LatestTaskRunStatus != api.TaskRunDone || task.DatabaseID == nil {
+			continue
+		}
+		reason, err := s.runCanaryPostChecks(ctx, task, canary.PostCheckStatements)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			return s.haltCanaryStage(ctx, stage, reason)
+		}
+	}
+	return s.promoteCanaryStage(ctx, stage)
+}
+
+// getCanaryFailurePercentage returns whether all the canary tasks are finished and the percentage of the failed ones.
+// The skipped tasks are not counted.
+func getCanaryFailurePercentage(tasks []*store.TaskMessage) (bool, int32, error) {
+	var total, failed int32
+	for _, task := range tasks {
+		skipped, err := utils.GetTaskSkipped(task)
+		if err != nil {
+			return false, 0, err
+		}
+		if skipped {
+			continue
+		}
+		switch task.LatestTaskRunStatus {
+		case api.TaskRunDone:
+		case api.TaskRunFailed, api.TaskRunCanceled:
+			failed++
+		default:
+			return false, 0, nil
+		}
+		total++
+	}
+	if total == 0 {
+		return true, 0, nil
+	}
+	return true, failed * 100 / total, nil
+}
+
+// runCanaryPostChecks runs the post-check statements against the database of the task.
+// It returns the reason of halting if any statement fails or returns rows.
+func (s *SchedulerV2) runCanaryPostChecks(ctx context.Context, task *store.TaskMessage, statements []string) (string, error) {
+	if len(statements) == 0 {
+		return "", nil
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get instance %d", task.InstanceID)
+	}
+	if instance == nil {
+		return "", errors.Errorf("instance %d not found", task.InstanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get database %d", *task.DatabaseID)
+	}
+	if database == nil {
+		return "", errors.Errorf("database %d not found", *task.DatabaseID)
+	}
+
+	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, "" /* dataSourceID */)
+	if err != nil {
+		return fmt.Sprintf("failed to connect database %q for the post-checks: %v", database.DatabaseName, err), nil
+	}
+	defer driver.Close(ctx)
+	var conn *sql.Conn
+	if sqlDB := driver.GetDB(); sqlDB != nil {
+		conn, err = sqlDB.Conn(ctx)
+		if err != nil {
+			return fmt.Sprintf("failed to connect database %q for the post-checks: %v", database.DatabaseName, err), nil
+		}
+		defer conn.Close()
+	}
+
+	for _, statement := range statements {
+		results, err := driver.QueryConn(ctx, conn, statement, &db.QueryContext{
+			Limit:           1,
+			ReadOnly:        true,
+			CurrentDatabase: database.DatabaseName,
+		})
+		if err != nil {
+			return fmt.Sprintf("post-check %q failed on database %q: %v", statement, database.DatabaseName, err), nil
+		}
+		for _, result := range results {
+			if result.Error != "" {
+				return fmt.Sprintf("post-check %q failed on database %q: %s", statement, database.DatabaseName, result.Error), nil
+			}
+			if len(result.Rows) > 0 {
+				return fmt.Sprintf("post-check %q returned rows on database %q", statement, database.DatabaseName), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// promoteCanaryStage promotes the canary stage and runs the tasks of the next stage.
+func (s *SchedulerV2) promoteCanaryStage(ctx context.Context, stage *store.StageMessage) error {
+	stage.Payload.Canary.Status = storepb.StageCanary_PROMOTED
+	if err := s.store.UpdateStagePayload(ctx, stage.ID, stage.Payload); err != nil {
+		return err
+	}
+
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &stage.PipelineID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get issue")
+	}
+	nextStage, err := s.getNextStage(ctx, stage)
+	if err != nil {
+		return err
+	}
+	if nextStage != nil {
+		tasks, err := s.store.ListTasks(ctx, &api.TaskFind{StageID: &nextStage.ID})
+		if err != nil {
+			return errors.Wrapf(err, "failed to list tasks")
+		}
+		var runTasks []*store.TaskMessage
+		var creates []*store.TaskRunMessage
+		for _, task := range tasks {
+			skipped, err := utils.GetTaskSkipped(task)
+			if err != nil {
+				return err
+			}
+			if skipped || task.LatestTaskRunStatus != api.TaskRunNotStarted {
+				continue
+			}
+			runTasks = append(runTasks, task)
+			creates = append(creates, &store.TaskRunMessage{
+				CreatorID: api.SystemBotID,
+				TaskUID:   task.ID,
+				Name:      fmt.Sprintf("%s %d", task.Name, time.Now().Unix()),
+			})
+		}
+		if len(creates) > 0 {
+			if err := s.store.CreatePendingTaskRuns(ctx, creates...); err != nil {
+				return errors.Wrapf(err, "failed to create pending task runs")
+			}
+			if err := s.activityManager.BatchCreateActivitiesForRunTasks(ctx, runTasks, issue, "", api.SystemBotID); err != nil {
+				slog.Error("failed to create activities for running tasks", log.BBError(err))
+			}
+		}
+	}
+
+	s.createActivityForCanaryUpdate(ctx, stage, issue, api.ActivityInfo, "")
+	s.stateCfg.TaskRunTickleChan <- 0
+	return nil
+}
+
+// haltCanaryStage halts the canary stage and cancels the pending task runs of the next stage.
+func (s *SchedulerV2) haltCanaryStage(ctx context.Context, stage *store.StageMessage, reason string) error {
+	stage.Payload.Canary.Status = storepb.StageCanary_HALTED
+	stage.Payload.Canary.HaltReason = reason
+	if err := s.store.UpdateStagePayload(ctx, stage.ID, stage.Payload); err != nil {
+		return err
+	}
+
+	nextStage, err := s.getNextStage(ctx, stage)
+	if err != nil {
+		return err
+	}
+	if nextStage != nil {
+		taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
+			StageUID: &nextStage.ID,
+			Status:   &[]api.TaskRunStatus{api.TaskRunPending},
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to list pending task runs")
+		}
+		var taskRunIDs []int
+		for _, taskRun := range taskRuns {
+			taskRunIDs = append(taskRunIDs, taskRun.ID)
+		}
+		if len(taskRunIDs) > 0 {
+			if err := s.store.BatchCancelTaskRuns(ctx, taskRunIDs, api.SystemBotID); err != nil {
+				return errors.Wrapf(err, "failed to cancel pending task runs")
+			}
+		}
+	}
+
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &stage.PipelineID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get issue")
+	}
+	s.createActivityForCanaryUpdate(ctx, stage, issue, api.ActivityError, reason)
+	return nil
+}
+
+func (s *SchedulerV2) getNextStage(ctx context.Context, stage *store.StageMessage) (*store.StageMessage, error) {
+	stages, err := s.store.ListStageV2(ctx, stage.PipelineID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list stages")
+	}
+	for i := range stages {
+		if stages[i].ID == stage.ID && i < len(stages)-1 {
+			return stages[i+1], nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *SchedulerV2) createActivityForCanaryUpdate(ctx context.Context, stage *store.StageMessage, issue *store.IssueMessage, level api.ActivityLevel, reason string) {
+	if issue == nil {
+		return
+	}
+	if err := func() error {
+		payload, err := json.Marshal(api.ActivityPipelineStageCanaryUpdatePayload{
+			StageID:   stage.ID,
+			Status:    stage.Payload.Canary.Status.String(),
+			Reason:    reason,
+			IssueName: issue.Title,
+			StageName: stage.Name,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal activity payload")
+		}
+		create := &store.ActivityMessage{
+			CreatorUID:   api.SystemBotID,
+			ContainerUID: stage.PipelineID,
+			Type:         api.ActivityPipelineStageCanaryUpdate,
+			Level:        level,
+			Comment:      reason,
+			Payload:      string(payload),
+		}
+		if _, err := s.activityManager.CreateActivity(ctx, create, &activity.Metadata{Issue: issue}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		slog.Error("failed to create canary update activity", log.BBError(err))
+	}
+}
@@ -0,0 +1,29 @@
+package taskrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestGetCanaryFailurePercentage(t *testing.T) {
+	a := require.New(t)
+	tasks := []*store.TaskMessage{
+		{Payload: "{}", LatestTaskRunStatus: api.TaskRunDone},
+		{Payload: "{}", LatestTaskRunStatus: api.TaskRunFailed},
+		{Payload: "{}", LatestTaskRunStatus: api.TaskRunDone},
+		{Payload: `{"skipped":true}`, LatestTaskRunStatus: api.TaskRunNotStarted},
+	}
+	finished, failurePercentage, err := getCanaryFailurePercentage(tasks)
+	a.NoError(err)
+	a.True(finished)
+	a.Equal(int32(33), failurePercentage)
+
+	tasks = append(tasks, &store.TaskMessage{Payload: "{}", LatestTaskRunStatus: api.TaskRunRunning})
+	finished, _, err = getCanaryFailurePercentage(tasks)
+	a.NoError(err)
+	a.False(finished)
+}
@@ -0,0 +1,53 @@
+package taskrun
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// isChangeRequestImplemented returns whether the ServiceNow change request of the task stage allows the task to run.
+// If the rollout policy of the task environment requires a change request and the stage has none or a finished one,
+// a new change request is requested for the stage, which is opened in ServiceNow by the ServiceNow runner.
+func (s *SchedulerV2) isChangeRequestImplemented(ctx context.Context, task *store.TaskMessage) (bool, error) {
+	policy, err := utils.GetTaskRolloutPolicy(ctx, s.store, task)
+	if err != nil {
+		return false, err
+	}
+	if !policy.GetRequireChangeRequest() {
+		return true, nil
+	}
+	stages, err := s.store.ListStageV2(ctx, task.PipelineID)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list stages of pipeline %d", task.PipelineID)
+	}
+	for _, stage := range stages {
+		if stage.ID != task.StageID {
+			continue
+		}
+		switch stage.Payload.GetChangeRequest().GetStatus() {
+		case storepb.StageChangeRequest_IMPLEMENT:
+			return true, nil
+		case storepb.StageChangeRequest_PENDING:
+			return false, nil
+		default:
+			// The tasks run again after the change request is closed or canceled need a new change request.
+			payload := stage.Payload
+			if payload == nil {
+				payload = &storepb.StagePayload{}
+			}
+			payload.ChangeRequest = &storepb.StageChangeRequest{
+				Status: storepb.StageChangeRequest_PENDING,
+			}
+			if err := s.store.UpdateStagePayload(ctx, stage.ID, payload); err != nil {
+				return false, err
+			}
+			return false, nil
+		}
+	}
+	return false, errors.Errorf("stage %d not found", task.StageID)
+}
@@ -0,0 +1,95 @@
+package taskrun
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// cancelDependentTaskRuns cancels the pending task runs of the tasks depending on the task transitively.
+// It's called after the task run of the task failed or was canceled, so that the dependents don't wait forever.
+func (s *SchedulerV2) cancelDependentTaskRuns(ctx context.Context, task *store.TaskMessage, status api.TaskRunStatus) {
+	if err := func() error {
+		taskDAGs, err := s.store.ListTaskDags(ctx, &store.TaskDAGFind{PipelineID: &task.PipelineID})
+		if err != nil {
+			return errors.Wrapf(err, "failed to list task DAGs")
+		}
+		dependentTaskIDs := getDependentTaskIDs(taskDAGs, task.ID)
+		if len(dependentTaskIDs) == 0 {
+			return nil
+		}
+		taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
+			PipelineUID: &task.PipelineID,
+			Status:      &[]api.TaskRunStatus{api.TaskRunPending},
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to list pending task runs")
+		}
+		var taskRunIDs []int
+		var tasks []*store.TaskMessage
+		for _, taskRun := range taskRuns {
+			if !slices.Contains(dependentTaskIDs, taskRun.TaskUID) {
+				continue
+			}
+			dependentTask, err := s.store.GetTaskV2ByID(ctx, taskRun.TaskUID)
+			if err != nil {
+				return errors.Wrapf(err, "failed to get task %d", taskRun.TaskUID)
+			}
+			taskRunIDs = append(taskRunIDs, taskRun.ID)
+			tasks = append(tasks, dependentTask)
+		}
+		if len(taskRunIDs) == 0 {
+			return nil
+		}
+		if err := s.store.BatchCancelTaskRuns(ctx, taskRunIDs, api.SystemBotID); err != nil {
+			return errors.Wrapf(err, "failed to cancel task runs %v", taskRunIDs)
+		}
+
+		issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &task.PipelineID})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get issue")
+		}
+		if issue == nil {
+			return nil
+		}
+		comment := fmt.Sprintf("Canceled because the task %q depended on is %s.", task.Name, status)
+		if err := s.activityManager.BatchCreateActivitiesForCancelTaskRuns(ctx, tasks, issue, comment, api.SystemBotID); err != nil {
+			slog.Error("failed to create activities for canceling dependent task runs", log.BBError(err))
+		}
+		return nil
+	}(); err != nil {
+		slog.Error("failed to cancel dependent task runs", slog.Int("task_id", task.ID), log.BBError(err))
+	}
+}
+
+// getDependentTaskIDs returns the IDs of the tasks depending on the task transitively in the ascending order.
+func getDependentTaskIDs(taskDAGs []*store.TaskDAGMessage, taskID int) []int {
+	edges := map[int][]int{}
+	for _, taskDAG := range taskDAGs {
+		edges[taskDAG.FromTaskID] = append(edges[taskDAG.FromTaskID], taskDAG.ToTaskID)
+	}
+	visited := map[int]bool{taskID: true}
+	queue := []int{taskID}
+	var dependentTaskIDs []int
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, to := range edges[id] {
+			if visited[to] {
+				continue
+			}
+			visited[to] = true
+			dependentTaskIDs = append(dependentTaskIDs, to)
+			queue = append(queue, to)
+		}
+	}
+	slices.Sort(dependentTaskIDs)
+	return dependentTaskIDs
+}
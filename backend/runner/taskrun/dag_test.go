@@ -0,0 +1,26 @@
+package taskrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestGetDependentTaskIDs(t *testing.T) {
+	a := require.New(t)
+
+	// 1 -> 3 -> 5, 2 -> 3, 1 -> 4 -> 5.
+	taskDAGs := []*store.TaskDAGMessage{
+		{FromTaskID: 1, ToTaskID: 3},
+		{FromTaskID: 2, ToTaskID: 3},
+		{FromTaskID: 3, ToTaskID: 5},
+		{FromTaskID: 1, ToTaskID: 4},
+		{FromTaskID: 4, ToTaskID: 5},
+	}
+	a.Equal([]int{3, 4, 5}, getDependentTaskIDs(taskDAGs, 1))
+	a.Equal([]int{3, 5}, getDependentTaskIDs(taskDAGs, 2))
+	a.Equal([]int{5}, getDependentTaskIDs(taskDAGs, 4))
+	a.Nil(getDependentTaskIDs(taskDAGs, 5))
+}
@@ -0,0 +1,461 @@
+package taskrun
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/state"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// DefaultDataMigrateChunkSize is the default number of rows copied in a transaction.
+	DefaultDataMigrateChunkSize = 1000
+	// MaximumDataMigrateChunkSize is the maximum number of rows copied in a transaction.
+	MaximumDataMigrateChunkSize = 100000
+)
+
+// NewDataMigrateExecutor creates a data migrate task executor.
+func NewDataMigrateExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State) Executor {
+	return &DataMigrateExecutor{
+		store:     store,
+		dbFactory: dbFactory,
+		stateCfg:  stateCfg,
+	}
+}
+
+// DataMigrateExecutor is the task executor copying the tables of the source database into the task database.
+// The tables with primary keys are copied in primary key ordered chunks, each chunk is committed in its own transaction
+// and checkpointed in the task payload, so the task resumes from the last committed chunk after failure.
+// The tables without primary keys are copied in a single transaction.
+type DataMigrateExecutor struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+	stateCfg  *state.State
+}
+
+type migrateTable struct {
+	schema string
+	table  *storepb.TableMetadata
+	// keyIndexes are the indexes of the primary key columns in the table columns, empty if the table has no primary key.
+	keyIndexes []int
+}
+
+// RunOnce will run the data migrate task executor once.
+func (exec *DataMigrateExecutor) RunOnce(ctx context.Context, driverCtx context.Context, task *store.TaskMessage, taskRunUID int) (bool, *api.TaskRunResultPayload, error) {
+	exec.stateCfg.TaskRunExecutionStatuses.Store(taskRunUID,
+		state.TaskRunExecutionStatus{
+			ExecutionStatus: v1pb.TaskRun_PRE_EXECUTING,
+			UpdateTime:      time.Now(),
+		})
+
+	payload := &api.TaskDatabaseDataMigratePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return true, nil, errors.Wrap(err, "invalid database data migrate payload")
+	}
+
+	instance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return true, nil, err
+	}
+	database, err := exec.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return true, nil, err
+	}
+	sourceDatabase, err := exec.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: &payload.SourceDatabaseID})
+	if err != nil {
+		return true, nil, err
+	}
+	if sourceDatabase == nil {
+		return true, nil, errors.Errorf("source database %d not found", payload.SourceDatabaseID)
+	}
+	sourceInstance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &sourceDatabase.InstanceID})
+	if err != nil {
+		return true, nil, err
+	}
+	if !DataProvisionSupportedEngines[instance.Engine] || sourceInstance.Engine != instance.Engine {
+		return true, nil, errors.Errorf("copying data from %s to %s is not supported", sourceInstance.Engine, instance.Engine)
+	}
+
+	tables, err := exec.getTables(ctx, sourceDatabase, payload.Tables)
+	if err != nil {
+		return true, nil, err
+	}
+	chunkSize := payload.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultDataMigrateChunkSize
+	}
+
+	sourceDriver, err := exec.dbFactory.GetAdminDatabaseDriver(ctx, sourceInstance, sourceDatabase, db.ConnectionContext{})
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to connect source database %q", sourceDatabase.DatabaseName)
+	}
+	defer sourceDriver.Close(ctx)
+	driver, err := exec.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to connect database %q", database.DatabaseName)
+	}
+	defer driver.Close(ctx)
+
+	m := &dataMigrator{
+		exec:       exec,
+		task:       task,
+		taskRunUID: taskRunUID,
+		source:     sourceDriver.GetDB(),
+		target:     driver.GetDB(),
+		engine:     instance.Engine,
+		tables:     tables,
+		chunkSize:  chunkSize,
+		// A checkpoint is saved before copying anything, so the task is resumed if it has one.
+		resumed:    payload.Checkpoint != nil,
+		checkpoint: payload.Checkpoint,
+	}
+	if m.checkpoint == nil {
+		if err := m.saveCheckpoint(ctx, &api.DataMigrateCheckpoint{}); err != nil {
+			return true, nil, err
+		}
+	} else {
+		appendTaskRunLog(ctx, exec.store, taskRunUID, store.TaskRunLogInfo, fmt.Sprintf("Resume copying from table %d with %d rows copied", m.checkpoint.TableIndex+1, m.checkpoint.RowsCompleted))
+	}
+
+	startTime := time.Now()
+	startRows := m.checkpoint.RowsCompleted
+	for m.checkpoint.TableIndex < len(tables) {
+		t := tables[m.checkpoint.TableIndex]
+		m.updateExecutionStatus()
+		tableStartTime := time.Now()
+		tableStartRows := m.checkpoint.RowsCompleted
+		if err := m.copyTable(ctx, driverCtx, t, payload.ClearTarget); err != nil {
+			appendTaskRunLog(ctx, exec.store, taskRunUID, store.TaskRunLogError, fmt.Sprintf("Failed to copy table %q: %v", t.table.Name, err))
+			return true, nil, errors.Wrapf(err, "failed to copy table %q", t.table.Name)
+		}
+		rows, duration := m.checkpoint.RowsCompleted-tableStartRows, time.Since(tableStartTime)
+		appendTaskRunLog(ctx, exec.store, taskRunUID, store.TaskRunLogInfo, fmt.Sprintf("Copied %d rows of table %q in %s, %.0f rows/s", rows, t.table.Name, duration.Round(time.Millisecond), getRowsPerSecond(rows, duration)))
+	}
+
+	rows := m.checkpoint.RowsCompleted - startRows
+	slog.DebugContext(ctx, "Migrated data",
+		slog.String("source", sourceDatabase.DatabaseName),
+		slog.String("database", database.DatabaseName),
+		slog.Int64("rows", m.checkpoint.RowsCompleted),
+	)
+	return true, &api.TaskRunResultPayload{
+		Detail: fmt.Sprintf("Copied %d rows of %d tables from database %q, %.0f rows/s", m.checkpoint.RowsCompleted, len(tables), sourceDatabase.DatabaseName, getRowsPerSecond(rows, time.Since(startTime))),
+	}, nil
+}
+
+// getTables returns the tables to copy in the order of the names.
+func (exec *DataMigrateExecutor) getTables(ctx context.Context, sourceDatabase *store.DatabaseMessage, names []string) ([]*migrateTable, error) {
+	dbSchema, err := exec.store.GetDBSchema(ctx, sourceDatabase.UID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get schema of the source database")
+	}
+	if dbSchema == nil {
+		return nil, errors.Errorf("schema of the source database %q not found", sourceDatabase.DatabaseName)
+	}
+	tableMap := make(map[string]*migrateTable)
+	for _, schema := range dbSchema.GetMetadata().GetSchemas() {
+		for _, table := range schema.Tables {
+			name := table.Name
+			if schema.Name != "" {
+				name = fmt.Sprintf("%s.%s", schema.Name, table.Name)
+			}
+			tableMap[name] = &migrateTable{
+				schema:     schema.Name,
+				table:      table,
+				keyIndexes: getPrimaryKeyColumnIndexes(table),
+			}
+		}
+	}
+	var tables []*migrateTable
+	for _, name := range names {
+		t, ok := tableMap[name]
+		if !ok {
+			return nil, errors.Errorf("table %q not found in the source database %q", name, sourceDatabase.DatabaseName)
+		}
+		tables = append(tables, t)
+	}
+	return tables, nil
+}
+
+// getPrimaryKeyColumnIndexes returns the indexes of the primary key columns in the table columns.
+// It returns nil if the table has no primary key, or the primary key contains expressions.
+func getPrimaryKeyColumnIndexes(table *storepb.TableMetadata) []int {
+	for _, index := range table.Indexes {
+		if !index.Primary {
+			continue
+		}
+		var indexes []int
+		for _, expression := range index.Expressions {
+			found := false
+			for i, column := range table.Columns {
+				if column.Name == expression {
+					indexes = append(indexes, i)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil
+			}
+		}
+		return indexes
+	}
+	return nil
+}
+
+type dataMigrator struct {
+	exec       *DataMigrateExecutor
+	task       *store.TaskMessage
+	taskRunUID int
+	source     *sql.DB
+	target     *sql.DB
+	engine     storepb.Engine
+	tables     []*migrateTable
+	chunkSize  int
+	// resumed is set if the task run continues a failed one, the rows of the chunk committed without the checkpoint are skipped.
+	resumed    bool
+	checkpoint *api.DataMigrateCheckpoint
+}
+
+// copyTable copies the table in progress, and moves the checkpoint to the next table.
+func (m *dataMigrator) copyTable(ctx context.Context, driverCtx context.Context, t *migrateTable, clearTarget bool) error {
+	tableName := getMigrateTableName(m.engine, t)
+	if clearTarget && m.checkpoint.LastKey == nil {
+		if _, err := m.target.ExecContext(driverCtx, fmt.Sprintf("DELETE FROM %s;", tableName)); err != nil {
+			return errors.Wrapf(err, "failed to clear the table")
+		}
+	}
+
+	if len(t.keyIndexes) == 0 {
+		// The rows can't be resumed without a key, so the table is copied in a single transaction.
+		rows, err := m.copyRows(driverCtx, t, getMigrateSelectStatement(m.engine, t, nil, 0), false, nil)
+		if err != nil {
+			return err
+		}
+		return m.saveCheckpoint(ctx, &api.DataMigrateCheckpoint{
+			TableIndex:    m.checkpoint.TableIndex + 1,
+			RowsCompleted: m.checkpoint.RowsCompleted + rows,
+		})
+	}
+
+	for {
+		statement := getMigrateSelectStatement(m.engine, t, m.checkpoint.LastKey, m.chunkSize)
+		lastKey, rows, err := m.copyChunk(driverCtx, t, statement)
+		if err != nil {
+			return err
+		}
+		m.resumed = false
+		if rows < int64(m.chunkSize) {
+			return m.saveCheckpoint(ctx, &api.DataMigrateCheckpoint{
+				TableIndex:    m.checkpoint.TableIndex + 1,
+				RowsCompleted: m.checkpoint.RowsCompleted + rows,
+			})
+		}
+		if err := m.saveCheckpoint(ctx, &api.DataMigrateCheckpoint{
+			TableIndex:    m.checkpoint.TableIndex,
+			LastKey:       lastKey,
+			RowsCompleted: m.checkpoint.RowsCompleted + rows,
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+// copyChunk copies the rows of the chunk in a transaction, and returns the key literals of the last copied row.
+func (m *dataMigrator) copyChunk(ctx context.Context, t *migrateTable, statement string) ([]string, int64, error) {
+	var lastKey []string
+	rows, err := m.copyRows(ctx, t, statement, m.resumed, func(literals []string) {
+		lastKey = lastKey[:0]
+		for _, i := range t.keyIndexes {
+			lastKey = append(lastKey, literals[i])
+		}
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return lastKey, rows, nil
+}
+
+// copyRows inserts the rows of the statement into the target table in a transaction, onRow is called with the literals of each row if not nil.
+// At most dataProvisionBatchSize rows are kept in memory.
+func (m *dataMigrator) copyRows(ctx context.Context, t *migrateTable, statement string, ignoreDuplicates bool, onRow func([]string)) (int64, error) {
+	tableName := getMigrateTableName(m.engine, t)
+	columns := getMigrateColumnList(m.engine, t)
+
+	tx, err := m.target.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	rows, err := m.source.QueryContext(ctx, statement)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to read the source table")
+	}
+	defer rows.Close()
+
+	values := make([]sql.NullString, len(t.table.Columns))
+	dest := make([]any, len(values))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	var count int64
+	var batch []string
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if _, err := tx.ExecContext(ctx, getMigrateInsertStatement(m.engine, tableName, columns, batch, ignoreDuplicates)); err != nil {
+			return errors.Wrapf(err, "failed to insert the rows")
+		}
+		batch = nil
+		return nil
+	}
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return 0, err
+		}
+		literals := make([]string, len(values))
+		for i, column := range t.table.Columns {
+			literals[i] = getMigrateLiteral(m.engine, column, &values[i])
+		}
+		if onRow != nil {
+			onRow(literals)
+		}
+		batch = append(batch, fmt.Sprintf("(%s)", strings.Join(literals, ", ")))
+		count++
+		if len(batch) >= dataProvisionBatchSize {
+			if err := flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if err := flush(); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (m *dataMigrator) saveCheckpoint(ctx context.Context, checkpoint *api.DataMigrateCheckpoint) error {
+	task, err := m.exec.store.GetTaskV2ByID(ctx, m.task.ID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get task %d", m.task.ID)
+	}
+	payload := &api.TaskDatabaseDataMigratePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return errors.Wrap(err, "invalid database data migrate payload")
+	}
+	payload.Checkpoint = checkpoint
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal task payload")
+	}
+	payloadString := string(payloadBytes)
+	if _, err := m.exec.store.UpdateTaskV2(ctx, &api.TaskPatch{
+		ID:        task.ID,
+		UpdaterID: api.SystemBotID,
+		Payload:   &payloadString,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to patch task %d with the data migrate checkpoint", task.ID)
+	}
+	m.checkpoint = checkpoint
+	m.updateExecutionStatus()
+	return nil
+}
+
+func (m *dataMigrator) updateExecutionStatus() {
+	if m.exec.stateCfg == nil {
+		return
+	}
+	m.exec.stateCfg.TaskRunExecutionStatuses.Store(m.taskRunUID,
+		state.TaskRunExecutionStatus{
+			ExecutionStatus: v1pb.TaskRun_EXECUTING,
+			ExecutionDetail: &v1pb.TaskRun_ExecutionDetail{
+				CommandsTotal:     int32(len(m.tables)),
+				CommandsCompleted: int32(m.checkpoint.TableIndex),
+				RowsCompleted:     m.checkpoint.RowsCompleted,
+			},
+			UpdateTime: time.Now(),
+		})
+}
+
+func getMigrateTableName(engine storepb.Engine, t *migrateTable) string {
+	tableName := quoteProvisionIdentifier(engine, t.table.Name)
+	if t.schema != "" {
+		tableName = fmt.Sprintf("%s.%s", quoteProvisionIdentifier(engine, t.schema), tableName)
+	}
+	return tableName
+}
+
+func getMigrateColumnList(engine storepb.Engine, t *migrateTable) string {
+	var columnNames []string
+	for _, column := range t.table.Columns {
+		columnNames = append(columnNames, quoteProvisionIdentifier(engine, column.Name))
+	}
+	return strings.Join(columnNames, ", ")
+}
+
+// getMigrateSelectStatement returns the statement reading the rows of the table.
+// For the tables with primary keys, it reads the chunk of at most limit rows after the last key in the primary key order.
+func getMigrateSelectStatement(engine storepb.Engine, t *migrateTable, lastKey []string, limit int) string {
+	statement := fmt.Sprintf("SELECT %s FROM %s", getMigrateColumnList(engine, t), getMigrateTableName(engine, t))
+	if len(t.keyIndexes) == 0 {
+		return statement + ";"
+	}
+	var keyColumns []string
+	for _, i := range t.keyIndexes {
+		keyColumns = append(keyColumns, quoteProvisionIdentifier(engine, t.table.Columns[i].Name))
+	}
+	keys := strings.Join(keyColumns, ", ")
+	if len(lastKey) > 0 {
+		statement += fmt.Sprintf(" WHERE (%s) > (%s)", keys, strings.Join(lastKey, ", "))
+	}
+	return statement + fmt.Sprintf(" ORDER BY %s LIMIT %d;", keys, limit)
+}
+
+// getMigrateInsertStatement returns the INSERT statement of the rows.
+// The duplicate rows are skipped if ignoreDuplicates, it's used to re-copy the chunk committed without the checkpoint.
+func getMigrateInsertStatement(engine storepb.Engine, tableName, columns string, rows []string, ignoreDuplicates bool) string {
+	if !ignoreDuplicates {
+		return fmt.Sprintf("INSERT INTO %s (%s) VALUES\n%s;", tableName, columns, strings.Join(rows, ",\n"))
+	}
+	if engine == storepb.Engine_POSTGRES {
+		return fmt.Sprintf("INSERT INTO %s (%s) VALUES\n%s\nON CONFLICT DO NOTHING;", tableName, columns, strings.Join(rows, ",\n"))
+	}
+	return fmt.Sprintf("INSERT IGNORE INTO %s (%s) VALUES\n%s;", tableName, columns, strings.Join(rows, ",\n"))
+}
+
+// getMigrateLiteral returns the SQL literal of the value.
+// The numbers are not quoted, so that comparing the primary keys with them doesn't lose precision.
+func getMigrateLiteral(engine storepb.Engine, column *storepb.ColumnMetadata, value *sql.NullString) string {
+	if value.Valid && isProvisionNumericType(column.Type) {
+		return value.String
+	}
+	return getProvisionLiteral(engine, column, value, nil)
+}
+
+func getRowsPerSecond(rows int64, duration time.Duration) float64 {
+	if duration <= 0 {
+		return 0
+	}
+	return float64(rows) / duration.Seconds()
+}
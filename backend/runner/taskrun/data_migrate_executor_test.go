@@ -0,0 +1,65 @@
+package taskrun
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetPrimaryKeyColumnIndexes(t *testing.T) {
+	a := require.New(t)
+
+	table := &storepb.TableMetadata{
+		Columns: []*storepb.ColumnMetadata{{Name: "name"}, {Name: "tenant"}, {Name: "id"}},
+		Indexes: []*storepb.IndexMetadata{
+			{Name: "idx_name", Expressions: []string{"name"}},
+			{Name: "pk", Primary: true, Expressions: []string{"tenant", "id"}},
+		},
+	}
+	a.Equal([]int{1, 2}, getPrimaryKeyColumnIndexes(table))
+
+	table.Indexes[1].Expressions = []string{"lower(name)"}
+	a.Nil(getPrimaryKeyColumnIndexes(table))
+
+	table.Indexes = table.Indexes[:1]
+	a.Nil(getPrimaryKeyColumnIndexes(table))
+}
+
+func TestGetMigrateSelectStatement(t *testing.T) {
+	a := require.New(t)
+
+	table := &migrateTable{
+		schema: "public",
+		table: &storepb.TableMetadata{
+			Name:    "user",
+			Columns: []*storepb.ColumnMetadata{{Name: "tenant"}, {Name: "id"}, {Name: "name"}},
+		},
+		keyIndexes: []int{0, 1},
+	}
+	a.Equal(`SELECT "tenant", "id", "name" FROM "public"."user" ORDER BY "tenant", "id" LIMIT 100;`, getMigrateSelectStatement(storepb.Engine_POSTGRES, table, nil, 100))
+	a.Equal(`SELECT "tenant", "id", "name" FROM "public"."user" WHERE ("tenant", "id") > ('a', 5) ORDER BY "tenant", "id" LIMIT 100;`, getMigrateSelectStatement(storepb.Engine_POSTGRES, table, []string{"'a'", "5"}, 100))
+
+	table.schema = ""
+	table.keyIndexes = nil
+	a.Equal("SELECT `tenant`, `id`, `name` FROM `user`;", getMigrateSelectStatement(storepb.Engine_MYSQL, table, nil, 0))
+}
+
+func TestGetMigrateInsertStatement(t *testing.T) {
+	a := require.New(t)
+
+	rows := []string{"(1, 'a')", "(2, 'b')"}
+	a.Equal("INSERT INTO `t` (`id`, `name`) VALUES\n(1, 'a'),\n(2, 'b');", getMigrateInsertStatement(storepb.Engine_MYSQL, "`t`", "`id`, `name`", rows, false))
+	a.Equal("INSERT IGNORE INTO `t` (`id`, `name`) VALUES\n(1, 'a'),\n(2, 'b');", getMigrateInsertStatement(storepb.Engine_MYSQL, "`t`", "`id`, `name`", rows, true))
+	a.Equal("INSERT INTO \"t\" (\"id\", \"name\") VALUES\n(1, 'a'),\n(2, 'b')\nON CONFLICT DO NOTHING;", getMigrateInsertStatement(storepb.Engine_POSTGRES, `"t"`, `"id", "name"`, rows, true))
+}
+
+func TestGetMigrateLiteral(t *testing.T) {
+	a := require.New(t)
+
+	a.Equal("9007199254740993", getMigrateLiteral(storepb.Engine_MYSQL, &storepb.ColumnMetadata{Type: "bigint unsigned"}, &sql.NullString{String: "9007199254740993", Valid: true}))
+	a.Equal("NULL", getMigrateLiteral(storepb.Engine_MYSQL, &storepb.ColumnMetadata{Type: "int"}, &sql.NullString{}))
+	a.Equal("'it''s'", getMigrateLiteral(storepb.Engine_POSTGRES, &storepb.ColumnMetadata{Type: "text"}, &sql.NullString{String: "it's", Valid: true}))
+}
@@ -0,0 +1,490 @@
+package taskrun
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/masker"
+	"github.com/bytebase/bytebase/backend/component/maskingpolicy"
+	"github.com/bytebase/bytebase/backend/component/state"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// dataProvisionBatchSize is the number of rows inserted by each INSERT statement.
+const dataProvisionBatchSize = 500
+
+// DataProvisionSupportedEngines are the engines supporting copying the masked data between databases.
+var DataProvisionSupportedEngines = map[storepb.Engine]bool{
+	storepb.Engine_MYSQL:    true,
+	storepb.Engine_MARIADB:  true,
+	storepb.Engine_POSTGRES: true,
+}
+
+// NewDataProvisionExecutor creates a data provision task executor.
+func NewDataProvisionExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State) Executor {
+	return &DataProvisionExecutor{
+		store:     store,
+		dbFactory: dbFactory,
+		stateCfg:  stateCfg,
+	}
+}
+
+// DataProvisionExecutor is the task executor copying the data of the source database into the task database.
+// The values are masked with the masking policies of the source database on the fly, so the sensitive data never reach the task database.
+type DataProvisionExecutor struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+	stateCfg  *state.State
+}
+
+type provisionTable struct {
+	schema string
+	table  *storepb.TableMetadata
+	// maskers are the maskers of the columns, nil for the columns not masked.
+	maskers []masker.Masker
+}
+
+// RunOnce will run the data provision task executor once.
+func (exec *DataProvisionExecutor) RunOnce(ctx context.Context, driverCtx context.Context, task *store.TaskMessage, taskRunUID int) (bool, *api.TaskRunResultPayload, error) {
+	exec.stateCfg.TaskRunExecutionStatuses.Store(taskRunUID,
+		state.TaskRunExecutionStatus{
+			ExecutionStatus: v1pb.TaskRun_PRE_EXECUTING,
+			UpdateTime:      time.Now(),
+		})
+
+	payload := &api.TaskDatabaseDataProvisionPayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return true, nil, errors.Wrap(err, "invalid database data provision payload")
+	}
+
+	instance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return true, nil, err
+	}
+	database, err := exec.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return true, nil, err
+	}
+	sourceDatabase, err := exec.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: &payload.SourceDatabaseID})
+	if err != nil {
+		return true, nil, err
+	}
+	if sourceDatabase == nil {
+		return true, nil, errors.Errorf("source database %d not found", payload.SourceDatabaseID)
+	}
+	sourceInstance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &sourceDatabase.InstanceID})
+	if err != nil {
+		return true, nil, err
+	}
+	if !DataProvisionSupportedEngines[instance.Engine] || sourceInstance.Engine != instance.Engine {
+		return true, nil, errors.Errorf("copying data from %s to %s is not supported", sourceInstance.Engine, instance.Engine)
+	}
+
+	tables, err := exec.getTables(ctx, sourceDatabase, payload.Tables)
+	if err != nil {
+		return true, nil, err
+	}
+
+	sourceDriver, err := exec.dbFactory.GetAdminDatabaseDriver(ctx, sourceInstance, sourceDatabase, db.ConnectionContext{})
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to connect source database %q", sourceDatabase.DatabaseName)
+	}
+	defer sourceDriver.Close(ctx)
+	driver, err := exec.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to connect database %q", database.DatabaseName)
+	}
+	defer driver.Close(ctx)
+
+	var rowsCompleted int64
+	for i, table := range tables {
+		exec.stateCfg.TaskRunExecutionStatuses.Store(taskRunUID,
+			state.TaskRunExecutionStatus{
+				ExecutionStatus: v1pb.TaskRun_EXECUTING,
+				ExecutionDetail: &v1pb.TaskRun_ExecutionDetail{
+					CommandsTotal:     int32(len(tables)),
+					CommandsCompleted: int32(i),
+					RowsCompleted:     rowsCompleted,
+				},
+				UpdateTime: time.Now(),
+			})
+		rows, err := copyProvisionTable(driverCtx, sourceDriver.GetDB(), driver.GetDB(), instance.Engine, table)
+		if err != nil {
+			appendTaskRunLog(ctx, exec.store, taskRunUID, store.TaskRunLogError, fmt.Sprintf("Failed to copy table %q: %v", table.table.Name, err))
+			return true, nil, errors.Wrapf(err, "failed to copy table %q", table.table.Name)
+		}
+		rowsCompleted += rows
+		maskedColumns := 0
+		for _, m := range table.maskers {
+			if m != nil {
+				maskedColumns++
+			}
+		}
+		appendTaskRunLog(ctx, exec.store, taskRunUID, store.TaskRunLogInfo, fmt.Sprintf("Copied %d rows of table %q with %d masked columns", rows, table.table.Name, maskedColumns))
+	}
+
+	slog.DebugContext(ctx, "Copied masked data",
+		slog.String("source", sourceDatabase.DatabaseName),
+		slog.String("database", database.DatabaseName),
+		slog.Int64("rows", rowsCompleted),
+	)
+	return true, &api.TaskRunResultPayload{
+		Detail: fmt.Sprintf("Copied %d rows of %d tables from database %q", rowsCompleted, len(tables), sourceDatabase.DatabaseName),
+	}, nil
+}
+
+// getTables returns the tables to copy and the maskers of their columns.
+func (exec *DataProvisionExecutor) getTables(ctx context.Context, sourceDatabase *store.DatabaseMessage, names []string) ([]*provisionTable, error) {
+	dbSchema, err := exec.store.GetDBSchema(ctx, sourceDatabase.UID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get schema of the source database")
+	}
+	if dbSchema == nil {
+		return nil, errors.Errorf("schema of the source database %q not found", sourceDatabase.DatabaseName)
+	}
+	project, err := exec.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &sourceDatabase.ProjectID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get project %q", sourceDatabase.ProjectID)
+	}
+	resolver, err := maskingpolicy.Load(ctx, exec.store, project, sourceDatabase)
+	if err != nil {
+		return nil, err
+	}
+	algorithmSetting, err := exec.store.GetMaskingAlgorithmSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find masking algorithm setting")
+	}
+	semanticTypesSetting, err := exec.store.GetSemanticTypesSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find semantic types setting")
+	}
+	maskingSalt, err := exec.store.GetMaskingSalt(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find masking salt")
+	}
+	algorithms := make(map[string]*storepb.MaskingAlgorithmSetting_Algorithm)
+	for _, algorithm := range algorithmSetting.GetAlgorithms() {
+		algorithms[algorithm.Id] = algorithm
+	}
+	semanticTypes := make(map[string]*storepb.SemanticTypeSetting_SemanticType)
+	for _, semanticType := range semanticTypesSetting.GetTypes() {
+		semanticTypes[semanticType.Id] = semanticType
+	}
+
+	selected := make(map[string]bool)
+	for _, name := range names {
+		selected[name] = false
+	}
+	var tables []*provisionTable
+	for _, schema := range dbSchema.GetMetadata().GetSchemas() {
+		for _, table := range schema.Tables {
+			name := table.Name
+			if schema.Name != "" {
+				name = fmt.Sprintf("%s.%s", schema.Name, table.Name)
+			}
+			if _, ok := selected[name]; len(selected) > 0 && !ok {
+				continue
+			}
+			selected[name] = true
+			t := &provisionTable{
+				schema: schema.Name,
+				table:  table,
+			}
+			for _, column := range table.Columns {
+				maskData := resolver.Resolve(schema.Name, table.Name, column.Name)
+				t.maskers = append(t.maskers, getProvisionMasker(maskData, algorithms, semanticTypes, maskingSalt))
+			}
+			if err := checkProvisionKeyColumns(t); err != nil {
+				return nil, errors.Wrapf(err, "cannot copy table %q", name)
+			}
+			tables = append(tables, t)
+		}
+	}
+	for _, name := range names {
+		if !selected[name] {
+			return nil, errors.Errorf("table %q not found in the source database %q", name, sourceDatabase.DatabaseName)
+		}
+	}
+	return tables, nil
+}
+
+// getProvisionMasker returns the masker of the column, or nil if the column is not masked.
+// Unlike querying, the masking algorithms not found fall back to the default maskers of the masking level,
+// so the sensitive data are never copied unmasked.
+func getProvisionMasker(maskData *storepb.MaskData, algorithms map[string]*storepb.MaskingAlgorithmSetting_Algorithm, semanticTypes map[string]*storepb.SemanticTypeSetting_SemanticType, maskingSalt string) masker.Masker {
+	if maskData == nil {
+		return nil
+	}
+	level := maskData.MaskingLevel
+	algorithmID := ""
+	switch level {
+	case storepb.MaskingLevel_FULL:
+		algorithmID = maskData.FullMaskingAlgorithmId
+		if semanticType, ok := semanticTypes[maskData.SemanticTypeId]; ok && algorithmID == "" {
+			algorithmID = semanticType.FullMaskAlgorithmId
+		}
+	case storepb.MaskingLevel_PARTIAL:
+		algorithmID = maskData.PartialMaskingAlgorithmId
+		if semanticType, ok := semanticTypes[maskData.SemanticTypeId]; ok && algorithmID == "" {
+			algorithmID = semanticType.PartialMaskAlgorithmId
+		}
+	default:
+		return nil
+	}
+	return maskingpolicy.NewMasker(algorithms[algorithmID], level, maskingSalt)
+}
+
+// checkProvisionKeyColumns returns an error if a masked primary key or unique column may get the same masked values,
+// which would collide in the target table, e.g. the fully masked values, or the hashes of the numeric columns.
+func checkProvisionKeyColumns(t *provisionTable) error {
+	keyColumns := make(map[string]bool)
+	for _, index := range t.table.Indexes {
+		if !index.Primary && !index.Unique {
+			continue
+		}
+		for _, expression := range index.Expressions {
+			keyColumns[expression] = true
+		}
+	}
+	for i, column := range t.table.Columns {
+		m := t.maskers[i]
+		if m == nil || !keyColumns[column.Name] {
+			continue
+		}
+		if !isProvisionDistinctMasker(column, m) {
+			return errors.Errorf("the primary key or unique column %q is masked, the masked values may collide", column.Name)
+		}
+	}
+	return nil
+}
+
+// isProvisionDistinctMasker returns whether the masker keeps the distinct values of the column distinct.
+// The format-preserving masker only replaces the digits, and the hashes are not numbers.
+func isProvisionDistinctMasker(column *storepb.ColumnMetadata, m masker.Masker) bool {
+	switch m.(type) {
+	case *masker.FormatPreservingMasker:
+		return true
+	case *masker.DeterministicHashMasker, *masker.MD5Masker:
+		return !isProvisionNumericType(column.Type)
+	default:
+		return false
+	}
+}
+
+// getProvisionGeneratedColumns returns the generated columns of the table in the database, which can't be inserted.
+func getProvisionGeneratedColumns(ctx context.Context, db *sql.DB, engine storepb.Engine, schema, table string) (map[string]bool, error) {
+	var rows *sql.Rows
+	var err error
+	if engine == storepb.Engine_POSTGRES {
+		rows, err = db.QueryContext(ctx, `
+			SELECT column_name FROM information_schema.columns
+			WHERE table_schema = $1 AND table_name = $2 AND is_generated = 'ALWAYS'`, schema, table)
+	} else {
+		rows, err = db.QueryContext(ctx, `
+			SELECT COLUMN_NAME FROM information_schema.COLUMNS
+			WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND GENERATION_EXPRESSION <> ''`, table)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	generatedColumns := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		generatedColumns[name] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return generatedColumns, nil
+}
+
+// getProvisionColumns returns the indexes of the columns to copy, the generated columns are computed by the target database.
+func getProvisionColumns(t *provisionTable, generatedColumns map[string]bool) []int {
+	var columns []int
+	for i, column := range t.table.Columns {
+		if generatedColumns[column.Name] {
+			continue
+		}
+		columns = append(columns, i)
+	}
+	return columns
+}
+
+// copyProvisionTable replaces the rows of the table in the target database with the masked rows of the source database.
+func copyProvisionTable(ctx context.Context, source, target *sql.DB, engine storepb.Engine, t *provisionTable) (int64, error) {
+	tableName := quoteProvisionIdentifier(engine, t.table.Name)
+	if t.schema != "" {
+		tableName = fmt.Sprintf("%s.%s", quoteProvisionIdentifier(engine, t.schema), tableName)
+	}
+	generatedColumns, err := getProvisionGeneratedColumns(ctx, target, engine, t.schema, t.table.Name)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get the generated columns")
+	}
+	columnIndexes := getProvisionColumns(t, generatedColumns)
+	var columnNames []string
+	for _, i := range columnIndexes {
+		columnNames = append(columnNames, quoteProvisionIdentifier(engine, t.table.Columns[i].Name))
+	}
+	columns := strings.Join(columnNames, ", ")
+
+	tx, err := target.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s;", tableName)); err != nil {
+		return 0, errors.Wrapf(err, "failed to clear the table")
+	}
+
+	rows, err := source.QueryContext(ctx, fmt.Sprintf("SELECT %s FROM %s;", columns, tableName))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to read the source table")
+	}
+	defer rows.Close()
+
+	values := make([]sql.NullString, len(columnIndexes))
+	dest := make([]any, len(values))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	var count int64
+	var batch []string
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("INSERT INTO %s (%s) VALUES\n%s;", tableName, columns, strings.Join(batch, ",\n"))); err != nil {
+			return errors.Wrapf(err, "failed to insert the masked rows")
+		}
+		batch = nil
+		return nil
+	}
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return 0, err
+		}
+		literals := make([]string, len(values))
+		for i, columnIndex := range columnIndexes {
+			literals[i] = getProvisionLiteral(engine, t.table.Columns[columnIndex], &values[i], t.maskers[columnIndex])
+		}
+		batch = append(batch, fmt.Sprintf("(%s)", strings.Join(literals, ", ")))
+		count++
+		if len(batch) >= dataProvisionBatchSize {
+			if err := flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if err := flush(); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// getProvisionLiteral returns the SQL literal of the value, masked if the masker is not nil.
+// The masked values of the numeric columns are kept only if they are still numbers, e.g. by the format-preserving masking.
+func getProvisionLiteral(engine storepb.Engine, column *storepb.ColumnMetadata, value *sql.NullString, m masker.Masker) string {
+	if !value.Valid {
+		return "NULL"
+	}
+	if m == nil {
+		if isProvisionBinaryType(column.Type) {
+			if engine == storepb.Engine_POSTGRES {
+				return fmt.Sprintf(`'\x%s'`, hex.EncodeToString([]byte(value.String)))
+			}
+			return fmt.Sprintf("X'%s'", hex.EncodeToString([]byte(value.String)))
+		}
+		return quoteProvisionString(engine, value.String)
+	}
+
+	masked := m.Mask(&masker.MaskData{Data: value})
+	s, ok := masked.GetKind().(*v1pb.RowValue_StringValue)
+	if !ok {
+		if column.Nullable {
+			return "NULL"
+		}
+		return "DEFAULT"
+	}
+	if isProvisionNumericType(column.Type) {
+		if _, err := strconv.ParseFloat(s.StringValue, 64); err != nil {
+			return "0"
+		}
+		return s.StringValue
+	}
+	return quoteProvisionString(engine, s.StringValue)
+}
+
+var provisionNumericTypes = map[string]bool{
+	"tinyint":          true,
+	"smallint":         true,
+	"mediumint":        true,
+	"int":              true,
+	"integer":          true,
+	"bigint":           true,
+	"int2":             true,
+	"int4":             true,
+	"int8":             true,
+	"decimal":          true,
+	"numeric":          true,
+	"float":            true,
+	"float4":           true,
+	"float8":           true,
+	"double":           true,
+	"double precision": true,
+	"real":             true,
+}
+
+func isProvisionNumericType(columnType string) bool {
+	columnType = strings.ToLower(columnType)
+	if i := strings.Index(columnType, "("); i >= 0 {
+		columnType = columnType[:i]
+	}
+	columnType = strings.TrimSuffix(strings.TrimSpace(columnType), " unsigned")
+	return provisionNumericTypes[columnType]
+}
+
+func isProvisionBinaryType(columnType string) bool {
+	columnType = strings.ToLower(columnType)
+	return columnType == "bytea" || strings.Contains(columnType, "binary") || strings.Contains(columnType, "blob")
+}
+
+func quoteProvisionIdentifier(engine storepb.Engine, identifier string) string {
+	if engine == storepb.Engine_POSTGRES {
+		return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+	}
+	return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+}
+
+// provisionMySQLStringReplacer escapes the MySQL string literals, the backslashes are escape characters in MySQL by default.
+var provisionMySQLStringReplacer = strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\x00", `\0`)
+
+func quoteProvisionString(engine storepb.Engine, s string) string {
+	if engine == storepb.Engine_POSTGRES {
+		return fmt.Sprintf("'%s'", strings.ReplaceAll(s, "'", "''"))
+	}
+	return fmt.Sprintf("'%s'", provisionMySQLStringReplacer.Replace(s))
+}
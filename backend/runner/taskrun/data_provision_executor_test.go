@@ -0,0 +1,182 @@
+package taskrun
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/component/masker"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetProvisionLiteral(t *testing.T) {
+	a := require.New(t)
+
+	tests := []struct {
+		engine storepb.Engine
+		column *storepb.ColumnMetadata
+		value  sql.NullString
+		masker masker.Masker
+		want   string
+	}{
+		{
+			engine: storepb.Engine_MYSQL,
+			column: &storepb.ColumnMetadata{Type: "varchar(255)"},
+			value:  sql.NullString{},
+			masker: masker.NewDefaultFullMasker(),
+			want:   "NULL",
+		},
+		{
+			engine: storepb.Engine_MYSQL,
+			column: &storepb.ColumnMetadata{Type: "varchar(255)"},
+			value:  sql.NullString{String: `it's a \ test`, Valid: true},
+			want:   `'it\'s a \\ test'`,
+		},
+		{
+			engine: storepb.Engine_POSTGRES,
+			column: &storepb.ColumnMetadata{Type: "text"},
+			value:  sql.NullString{String: `it's a \ test`, Valid: true},
+			want:   `'it''s a \ test'`,
+		},
+		{
+			engine: storepb.Engine_MYSQL,
+			column: &storepb.ColumnMetadata{Type: "blob"},
+			value:  sql.NullString{String: "\x00\x01", Valid: true},
+			want:   "X'0001'",
+		},
+		{
+			engine: storepb.Engine_POSTGRES,
+			column: &storepb.ColumnMetadata{Type: "bytea"},
+			value:  sql.NullString{String: "\x00\x01", Valid: true},
+			want:   `'\x0001'`,
+		},
+		{
+			engine: storepb.Engine_MYSQL,
+			column: &storepb.ColumnMetadata{Type: "varchar(255)"},
+			value:  sql.NullString{String: "alice@example.com", Valid: true},
+			masker: masker.NewDefaultFullMasker(),
+			want:   "'******'",
+		},
+		{
+			engine: storepb.Engine_POSTGRES,
+			column: &storepb.ColumnMetadata{Type: "bigint"},
+			value:  sql.NullString{String: "13800138000", Valid: true},
+			masker: masker.NewDefaultFullMasker(),
+			want:   "0",
+		},
+		{
+			engine: storepb.Engine_MYSQL,
+			column: &storepb.ColumnMetadata{Type: "bigint unsigned"},
+			value:  sql.NullString{String: "13800138000", Valid: true},
+			masker: masker.NewFormatPreservingMasker("key", 4),
+			want:   masker.NewFormatPreservingMasker("key", 4).Mask(&masker.MaskData{Data: &sql.NullString{String: "13800138000", Valid: true}}).GetStringValue(),
+		},
+	}
+
+	for _, test := range tests {
+		value := test.value
+		got := getProvisionLiteral(test.engine, test.column, &value, test.masker)
+		a.Equal(test.want, got)
+	}
+}
+
+func TestGetProvisionMasker(t *testing.T) {
+	a := require.New(t)
+
+	algorithms := map[string]*storepb.MaskingAlgorithmSetting_Algorithm{
+		"hash": {
+			Id: "hash",
+			Mask: &storepb.MaskingAlgorithmSetting_Algorithm_DeterministicHashMask_{
+				DeterministicHashMask: &storepb.MaskingAlgorithmSetting_Algorithm_DeterministicHashMask{},
+			},
+		},
+	}
+	semanticTypes := map[string]*storepb.SemanticTypeSetting_SemanticType{
+		"email": {
+			Id:                  "email",
+			FullMaskAlgorithmId: "hash",
+		},
+	}
+
+	a.Nil(getProvisionMasker(nil, algorithms, semanticTypes, "salt"))
+	a.Nil(getProvisionMasker(&storepb.MaskData{MaskingLevel: storepb.MaskingLevel_NONE}, algorithms, semanticTypes, "salt"))
+	a.True(masker.NewDeterministicHashMasker("salt").Equal(getProvisionMasker(&storepb.MaskData{
+		MaskingLevel:   storepb.MaskingLevel_FULL,
+		SemanticTypeId: "email",
+	}, algorithms, semanticTypes, "salt")))
+	// The masking algorithms not found fall back to the default maskers.
+	a.True(masker.NewDefaultFullMasker().Equal(getProvisionMasker(&storepb.MaskData{
+		MaskingLevel:           storepb.MaskingLevel_FULL,
+		FullMaskingAlgorithmId: "unknown",
+	}, algorithms, semanticTypes, "salt")))
+}
+
+func TestCheckProvisionKeyColumns(t *testing.T) {
+	a := require.New(t)
+
+	table := &storepb.TableMetadata{
+		Name: "users",
+		Columns: []*storepb.ColumnMetadata{
+			{Name: "id", Type: "bigint"},
+			{Name: "email", Type: "varchar(255)"},
+			{Name: "phone", Type: "varchar(32)"},
+		},
+		Indexes: []*storepb.IndexMetadata{
+			{Name: "PRIMARY", Expressions: []string{"id"}, Primary: true, Unique: true},
+			{Name: "uk_email", Expressions: []string{"email"}, Unique: true},
+		},
+	}
+	tests := []struct {
+		maskers []masker.Masker
+		wantErr bool
+	}{
+		{
+			maskers: []masker.Masker{nil, nil, masker.NewDefaultFullMasker()},
+		},
+		{
+			maskers: []masker.Masker{masker.NewFormatPreservingMasker("key", 0), masker.NewDeterministicHashMasker("salt"), nil},
+		},
+		{
+			// The masked numeric primary keys would all be 0.
+			maskers: []masker.Masker{masker.NewDefaultFullMasker(), nil, nil},
+			wantErr: true,
+		},
+		{
+			// The hashes of the numeric primary keys are not numbers.
+			maskers: []masker.Masker{masker.NewDeterministicHashMasker("salt"), nil, nil},
+			wantErr: true,
+		},
+		{
+			maskers: []masker.Masker{nil, masker.NewDefaultFullMasker(), nil},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		err := checkProvisionKeyColumns(&provisionTable{table: table, maskers: test.maskers})
+		if test.wantErr {
+			a.Error(err)
+		} else {
+			a.NoError(err)
+		}
+	}
+}
+
+func TestGetProvisionColumns(t *testing.T) {
+	a := require.New(t)
+
+	table := &provisionTable{
+		table: &storepb.TableMetadata{
+			Name: "orders",
+			Columns: []*storepb.ColumnMetadata{
+				{Name: "id", Type: "int"},
+				{Name: "price", Type: "decimal(10,2)"},
+				{Name: "quantity", Type: "int"},
+				{Name: "total", Type: "decimal(10,2)"},
+			},
+		},
+	}
+	a.Equal([]int{0, 1, 2, 3}, getProvisionColumns(table, map[string]bool{}))
+	a.Equal([]int{0, 1, 2}, getProvisionColumns(table, map[string]bool{"total": true}))
+}
@@ -0,0 +1,618 @@
+package taskrun
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/component/state"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// batchDMLEngines are the engines supporting executing the DML statements in chunks.
+var batchDMLEngines = map[storepb.Engine]bool{
+	storepb.Engine_MYSQL:    true,
+	storepb.Engine_MARIADB:  true,
+	storepb.Engine_TIDB:     true,
+	storepb.Engine_POSTGRES: true,
+}
+
+// runBatchMigration runs the DML statements in primary key range chunks.
+// The position after each committed chunk is saved in the task payload, so that the next task run resumes from there.
+func (exec *DataUpdateExecutor) runBatchMigration(ctx context.Context, driverCtx context.Context, task *store.TaskMessage, taskRunUID int, statement string, payload *api.TaskDatabaseDataUpdatePayload) (bool, *api.TaskRunResultPayload, error) {
+	instance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return true, nil, err
+	}
+	if !batchDMLEngines[instance.Engine] {
+		return true, nil, errors.Errorf("batch DML is not supported for engine %v", instance.Engine)
+	}
+	database, err := exec.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return true, nil, err
+	}
+	if database == nil {
+		return true, nil, errors.Errorf("database not found")
+	}
+	dbSchema, err := exec.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return true, nil, err
+	}
+	if dbSchema == nil {
+		return true, nil, errors.Errorf("database schema not found: %d", database.UID)
+	}
+
+	version := model.Version{Version: payload.SchemaVersion}
+	mi, err := getMigrationInfo(ctx, exec.store, exec.profile, task, db.Data, statement, version)
+	if err != nil {
+		return true, nil, err
+	}
+
+	driver, err := exec.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get driver connection for instance %q", instance.ResourceID)
+	}
+	defer driver.Close(ctx)
+
+	b := &batchDMLExecutor{
+		exec:       exec,
+		driver:     driver,
+		engine:     instance.Engine,
+		dbSchema:   dbSchema,
+		task:       task,
+		taskRunUID: taskRunUID,
+		config:     payload.BatchConfig,
+		checkpoint: &api.BatchDMLCheckpoint{},
+	}
+	if payload.BatchCheckpoint != nil {
+		b.checkpoint = payload.BatchCheckpoint
+	}
+	migrationID, schema, err := utils.ExecuteMigrationWithFunc(ctx, driverCtx, exec.store, exec.stateCfg, taskRunUID, driver, mi, statement, &payload.SheetID, func(execCtx context.Context, execStatement string) error {
+		return b.execute(ctx, execCtx, execStatement)
+	})
+	if err != nil {
+		return true, nil, err
+	}
+	return postMigration(ctx, exec.store, exec.activityManager, exec.license, task, mi, migrationID, schema, &payload.SheetID)
+}
+
+type batchDMLExecutor struct {
+	exec       *DataUpdateExecutor
+	driver     db.Driver
+	engine     storepb.Engine
+	dbSchema   *model.DBSchema
+	task       *store.TaskMessage
+	taskRunUID int
+	config     *api.BatchDMLConfig
+
+	checkpoint *api.BatchDMLCheckpoint
+	rowsTotal  int64
+	commands   int
+}
+
+// batchDMLChunk is the chunkable statement with the primary key column.
+type batchDMLChunk struct {
+	statement *batchDMLStatement
+	column    string
+}
+
+func (b *batchDMLExecutor) execute(ctx context.Context, execCtx context.Context, statement string) error {
+	list, err := base.SplitMultiSQL(b.engine, statement)
+	if err != nil {
+		return errors.Wrapf(err, "failed to split statement")
+	}
+	list = base.FilterEmptySQL(list)
+	b.commands = len(list)
+
+	chunks := make([]*batchDMLChunk, len(list))
+	b.rowsTotal = b.checkpoint.RowsCompleted
+	for i := b.checkpoint.StatementIndex; i < len(list); i++ {
+		chunk := b.getChunk(list[i].Text)
+		if chunk == nil {
+			continue
+		}
+		chunks[i] = chunk
+		var nextKey *int64
+		if i == b.checkpoint.StatementIndex {
+			nextKey = b.checkpoint.NextKey
+		}
+		count, err := b.countRows(execCtx, chunk, nextKey)
+		if err != nil {
+			return err
+		}
+		b.rowsTotal += count
+	}
+	b.updateExecutionStatus()
+
+	for i := b.checkpoint.StatementIndex; i < len(list); i++ {
+		rowsBefore := b.checkpoint.RowsCompleted
+		if chunk := chunks[i]; chunk != nil {
+			if err := b.executeChunks(ctx, execCtx, i, chunk); err != nil {
+				return err
+			}
+		} else {
+			if _, err := b.driver.Execute(execCtx, list[i].Text, db.ExecuteOptions{}); err != nil {
+				return err
+			}
+		}
+		if err := b.saveCheckpoint(ctx, &api.BatchDMLCheckpoint{
+			StatementIndex: i + 1,
+			RowsCompleted:  b.checkpoint.RowsCompleted,
+		}); err != nil {
+			return err
+		}
+		if chunks[i] != nil {
+			appendTaskRunLog(ctx, b.exec.store, b.taskRunUID, store.TaskRunLogInfo, fmt.Sprintf("Statement %d of %d changed %d rows in batches.", i+1, len(list), b.checkpoint.RowsCompleted-rowsBefore))
+		}
+	}
+	return nil
+}
+
+func (b *batchDMLExecutor) executeChunks(ctx context.Context, execCtx context.Context, index int, chunk *batchDMLChunk) error {
+	minKey, maxKey, err := b.getKeyRange(execCtx, chunk)
+	if err != nil {
+		return err
+	}
+	if minKey == nil || maxKey == nil {
+		return nil
+	}
+	lo := *minKey
+	if index == b.checkpoint.StatementIndex && b.checkpoint.NextKey != nil && *b.checkpoint.NextKey > lo {
+		lo = *b.checkpoint.NextKey
+	}
+	sqlDB := b.driver.GetDB()
+	for lo <= *maxKey {
+		hi := lo + int64(b.config.BatchSize)
+		result, err := sqlDB.ExecContext(execCtx, chunk.statement.chunkStatement(b.quote(chunk.column), lo, hi))
+		if err != nil {
+			return errors.Wrapf(err, "failed to execute the chunk [%d, %d) of statement %d", lo, hi, index+1)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return errors.Wrapf(err, "failed to get the affected rows")
+		}
+		next := hi
+		if err := b.saveCheckpoint(ctx, &api.BatchDMLCheckpoint{
+			StatementIndex: index,
+			NextKey:        &next,
+			RowsCompleted:  b.checkpoint.RowsCompleted + rowsAffected,
+		}); err != nil {
+			return err
+		}
+		lo = hi
+		if lo <= *maxKey && b.config.SleepIntervalMs > 0 {
+			select {
+			case <-time.After(time.Duration(b.config.SleepIntervalMs) * time.Millisecond):
+			case <-execCtx.Done():
+				return execCtx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+// getChunk returns the chunk of the statement, or nil if the statement cannot be split.
+// Only the single table UPDATE and DELETE statements on the table with a single integer primary key column can be split.
+func (b *batchDMLExecutor) getChunk(statement string) *batchDMLChunk {
+	parsed, ok := parseBatchDMLStatement(b.engine, statement)
+	if !ok {
+		return nil
+	}
+	schemaName := parsed.schema
+	switch b.engine {
+	case storepb.Engine_POSTGRES:
+		if schemaName == "" {
+			schemaName = "public"
+		}
+	default:
+		// The qualifier is the database name for MySQL.
+		if schemaName != "" && schemaName != b.dbSchema.GetMetadata().GetName() {
+			return nil
+		}
+		schemaName = ""
+	}
+	schema := b.dbSchema.GetDatabaseMetadata().GetSchema(schemaName)
+	if schema == nil {
+		return nil
+	}
+	table := schema.GetTable(parsed.table)
+	if table == nil {
+		return nil
+	}
+	column := getIntegerPrimaryKeyColumn(table)
+	if column == "" {
+		return nil
+	}
+	return &batchDMLChunk{statement: parsed, column: column}
+}
+
+func (b *batchDMLExecutor) getKeyRange(ctx context.Context, chunk *batchDMLChunk) (*int64, *int64, error) {
+	column := b.quote(chunk.column)
+	query := fmt.Sprintf("SELECT MIN(%s), MAX(%s) FROM %s%s", column, column, chunk.statement.tableReference, chunk.statement.whereClause())
+	var minKey, maxKey sql.NullInt64
+	if err := b.driver.GetDB().QueryRowContext(ctx, query).Scan(&minKey, &maxKey); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get the primary key range of table %q", chunk.statement.table)
+	}
+	if !minKey.Valid || !maxKey.Valid {
+		return nil, nil, nil
+	}
+	return &minKey.Int64, &maxKey.Int64, nil
+}
+
+func (b *batchDMLExecutor) countRows(ctx context.Context, chunk *batchDMLChunk, nextKey *int64) (int64, error) {
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s%s", chunk.statement.tableReference, chunk.statement.whereClause())
+	if nextKey != nil {
+		query = chunk.statement.conditionStatement(fmt.Sprintf("SELECT COUNT(*) FROM %s", chunk.statement.tableReference), fmt.Sprintf("%s >= %d", b.quote(chunk.column), *nextKey))
+	}
+	var count int64
+	if err := b.driver.GetDB().QueryRowContext(ctx, query).Scan(&count); err != nil {
+		return 0, errors.Wrapf(err, "failed to count the rows of table %q", chunk.statement.table)
+	}
+	return count, nil
+}
+
+func (b *batchDMLExecutor) saveCheckpoint(ctx context.Context, checkpoint *api.BatchDMLCheckpoint) error {
+	task, err := b.exec.store.GetTaskV2ByID(ctx, b.task.ID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get task %d", b.task.ID)
+	}
+	payload := &api.TaskDatabaseDataUpdatePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return errors.Wrap(err, "invalid database data update payload")
+	}
+	payload.BatchCheckpoint = checkpoint
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal task payload")
+	}
+	payloadString := string(payloadBytes)
+	if _, err := b.exec.store.UpdateTaskV2(ctx, &api.TaskPatch{
+		ID:        task.ID,
+		UpdaterID: api.SystemBotID,
+		Payload:   &payloadString,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to patch task %d with the batch checkpoint", task.ID)
+	}
+	b.checkpoint = checkpoint
+	b.updateExecutionStatus()
+	return nil
+}
+
+func (b *batchDMLExecutor) updateExecutionStatus() {
+	if b.exec.stateCfg == nil {
+		return
+	}
+	rowsTotal := b.rowsTotal
+	if b.checkpoint.RowsCompleted > rowsTotal {
+		// The estimation might be smaller than the actually changed rows.
+		rowsTotal = b.checkpoint.RowsCompleted
+	}
+	b.exec.stateCfg.TaskRunExecutionStatuses.Store(b.taskRunUID,
+		state.TaskRunExecutionStatus{
+			ExecutionStatus: v1pb.TaskRun_EXECUTING,
+			ExecutionDetail: &v1pb.TaskRun_ExecutionDetail{
+				CommandsTotal:     int32(b.commands),
+				CommandsCompleted: int32(b.checkpoint.StatementIndex),
+				RowsTotal:         rowsTotal,
+				RowsCompleted:     b.checkpoint.RowsCompleted,
+			},
+			UpdateTime: time.Now(),
+		})
+}
+
+func (b *batchDMLExecutor) quote(identifier string) string {
+	if b.engine == storepb.Engine_POSTGRES {
+		return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+	}
+	return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+}
+
+var integerColumnTypes = map[string]bool{
+	"tinyint":   true,
+	"smallint":  true,
+	"mediumint": true,
+	"int":       true,
+	"integer":   true,
+	"bigint":    true,
+	"int2":      true,
+	"int4":      true,
+	"int8":      true,
+}
+
+// getIntegerPrimaryKeyColumn returns the primary key column if the primary key of the table is a single integer column.
+func getIntegerPrimaryKeyColumn(table *model.TableMetadata) string {
+	for _, index := range table.GetProto().GetIndexes() {
+		if !index.Primary {
+			continue
+		}
+		if len(index.Expressions) != 1 {
+			return ""
+		}
+		column := table.GetColumn(index.Expressions[0])
+		if column == nil {
+			return ""
+		}
+		tp := strings.ToLower(column.Type)
+		if i := strings.IndexAny(tp, "( "); i >= 0 {
+			tp = tp[:i]
+		}
+		if !integerColumnTypes[tp] {
+			return ""
+		}
+		return column.Name
+	}
+	return ""
+}
+
+// batchDMLStatement is the single table UPDATE or DELETE statement which can be split into chunks.
+type batchDMLStatement struct {
+	schema string
+	table  string
+	// tableReference is the original text of the table name and the alias.
+	tableReference string
+	// prefix is the statement before the WHERE clause.
+	prefix string
+	// condition is the original WHERE condition, it's empty if the statement has no WHERE clause.
+	condition string
+}
+
+func (s *batchDMLStatement) whereClause() string {
+	if s.condition == "" {
+		return ""
+	}
+	return fmt.Sprintf(" WHERE %s", s.condition)
+}
+
+func (s *batchDMLStatement) conditionStatement(prefix string, condition string) string {
+	if s.condition == "" {
+		return fmt.Sprintf("%s WHERE %s", prefix, condition)
+	}
+	return fmt.Sprintf("%s WHERE (%s) AND %s", prefix, s.condition, condition)
+}
+
+func (s *batchDMLStatement) chunkStatement(column string, lo, hi int64) string {
+	return s.conditionStatement(s.prefix, fmt.Sprintf("%s >= %d AND %s < %d", column, lo, column, hi))
+}
+
+type batchDMLTokenType int
+
+const (
+	batchDMLTokenWord batchDMLTokenType = iota
+	batchDMLTokenIdentifier
+	batchDMLTokenPunctuation
+	batchDMLTokenOther
+)
+
+type batchDMLToken struct {
+	tp    batchDMLTokenType
+	text  string
+	start int
+	end   int
+}
+
+func (t *batchDMLToken) isKeyword(keywords ...string) bool {
+	if t.tp != batchDMLTokenWord {
+		return false
+	}
+	for _, keyword := range keywords {
+		if strings.EqualFold(t.text, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenizeBatchDMLStatement returns the tokens out of the parentheses, the strings and the comments are skipped.
+func tokenizeBatchDMLStatement(engine storepb.Engine, statement string) ([]*batchDMLToken, bool) {
+	isMySQL := engine != storepb.Engine_POSTGRES
+	var tokens []*batchDMLToken
+	depth := 0
+	appendToken := func(tp batchDMLTokenType, text string, start, end int) {
+		if depth == 0 {
+			tokens = append(tokens, &batchDMLToken{tp: tp, text: text, start: start, end: end})
+		}
+	}
+	// skipQuoted returns the position after the closing quote.
+	skipQuoted := func(i int, quote byte, backslash bool) (int, bool) {
+		for j := i + 1; j < len(statement); j++ {
+			switch statement[j] {
+			case '\\':
+				if backslash {
+					j++
+				}
+			case quote:
+				if j+1 < len(statement) && statement[j+1] == quote {
+					j++
+					continue
+				}
+				return j + 1, true
+			}
+		}
+		return 0, false
+	}
+	for i := 0; i < len(statement); {
+		c := statement[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '-' && strings.HasPrefix(statement[i:], "--"), c == '#' && isMySQL:
+			end := strings.IndexByte(statement[i:], '\n')
+			if end < 0 {
+				i = len(statement)
+			} else {
+				i += end + 1
+			}
+		case c == '/' && strings.HasPrefix(statement[i:], "/*"):
+			end := strings.Index(statement[i+2:], "*/")
+			if end < 0 {
+				return nil, false
+			}
+			i += end + 4
+		case c == '\'' || (c == '"' && isMySQL):
+			end, ok := skipQuoted(i, c, isMySQL)
+			if !ok {
+				return nil, false
+			}
+			appendToken(batchDMLTokenOther, statement[i:end], i, end)
+			i = end
+		case c == '"' || (c == '`' && isMySQL):
+			end, ok := skipQuoted(i, c, false)
+			if !ok {
+				return nil, false
+			}
+			appendToken(batchDMLTokenIdentifier, strings.ReplaceAll(statement[i+1:end-1], string([]byte{c, c}), string(c)), i, end)
+			i = end
+		case c == '$' && !isMySQL:
+			// Dollar-quoted strings aren't supported.
+			return nil, false
+		case c == '(':
+			appendToken(batchDMLTokenOther, "(", i, i+1)
+			depth++
+			i++
+		case c == ')':
+			depth--
+			if depth < 0 {
+				return nil, false
+			}
+			appendToken(batchDMLTokenOther, ")", i, i+1)
+			i++
+		case c == '.' || c == ',' || c == ';':
+			appendToken(batchDMLTokenPunctuation, string(c), i, i+1)
+			i++
+		case isBatchDMLWordByte(c):
+			end := i + 1
+			for end < len(statement) && (isBatchDMLWordByte(statement[end]) || statement[end] == '$') {
+				end++
+			}
+			tp := batchDMLTokenWord
+			if c >= '0' && c <= '9' {
+				tp = batchDMLTokenOther
+			}
+			appendToken(tp, statement[i:end], i, end)
+			i = end
+		default:
+			appendToken(batchDMLTokenOther, string(c), i, i+1)
+			i++
+		}
+	}
+	if depth != 0 {
+		return nil, false
+	}
+	return tokens, true
+}
+
+func isBatchDMLWordByte(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c >= 0x80
+}
+
+// parseBatchDMLStatement parses the single table UPDATE or DELETE statement without ORDER BY, LIMIT and RETURNING.
+func parseBatchDMLStatement(engine storepb.Engine, statement string) (*batchDMLStatement, bool) {
+	tokens, ok := tokenizeBatchDMLStatement(engine, statement)
+	if !ok || len(tokens) == 0 {
+		return nil, false
+	}
+	// Trim the trailing semicolons and comments.
+	for len(tokens) > 0 && tokens[len(tokens)-1].tp == batchDMLTokenPunctuation && tokens[len(tokens)-1].text == ";" {
+		tokens = tokens[:len(tokens)-1]
+	}
+	if len(tokens) == 0 {
+		return nil, false
+	}
+	end := tokens[len(tokens)-1].end
+
+	i := 0
+	isUpdate := tokens[0].isKeyword("UPDATE")
+	switch {
+	case isUpdate:
+		i++
+	case tokens[0].isKeyword("DELETE"):
+		i++
+		for i < len(tokens) && tokens[i].isKeyword("LOW_PRIORITY", "QUICK", "IGNORE") {
+			i++
+		}
+		if i >= len(tokens) || !tokens[i].isKeyword("FROM") {
+			return nil, false
+		}
+		i++
+	default:
+		return nil, false
+	}
+	for i < len(tokens) && tokens[i].isKeyword("LOW_PRIORITY", "IGNORE", "ONLY") {
+		i++
+	}
+
+	// The table name.
+	isName := func(i int) bool {
+		return i < len(tokens) && (tokens[i].tp == batchDMLTokenWord || tokens[i].tp == batchDMLTokenIdentifier)
+	}
+	if !isName(i) {
+		return nil, false
+	}
+	result := &batchDMLStatement{table: tokens[i].text}
+	tableStart, tableEnd := tokens[i].start, tokens[i].end
+	i++
+	if i+1 < len(tokens) && tokens[i].tp == batchDMLTokenPunctuation && tokens[i].text == "." && isName(i+1) {
+		result.schema, result.table = result.table, tokens[i+1].text
+		tableEnd = tokens[i+1].end
+		i += 2
+	}
+
+	// The optional alias.
+	if i < len(tokens) && tokens[i].isKeyword("AS") {
+		i++
+		if !isName(i) {
+			return nil, false
+		}
+		tableEnd = tokens[i].end
+		i++
+	} else if isName(i) && !tokens[i].isKeyword("SET", "WHERE") {
+		tableEnd = tokens[i].end
+		i++
+	}
+	result.tableReference = statement[tableStart:tableEnd]
+
+	if isUpdate {
+		if i >= len(tokens) || !tokens[i].isKeyword("SET") {
+			return nil, false
+		}
+		i++
+	}
+
+	whereIndex := -1
+	for j := i; j < len(tokens); j++ {
+		switch {
+		case tokens[j].isKeyword("ORDER", "LIMIT", "RETURNING", "FROM", "USING", "JOIN"):
+			return nil, false
+		case tokens[j].isKeyword("WHERE"):
+			if whereIndex >= 0 {
+				return nil, false
+			}
+			whereIndex = j
+		case !isUpdate && whereIndex < 0:
+			// Only the WHERE clause is allowed after the table of the DELETE statement.
+			return nil, false
+		}
+	}
+	if whereIndex < 0 {
+		result.prefix = strings.TrimSpace(statement[:end])
+		return result, true
+	}
+	result.prefix = strings.TrimSpace(statement[:tokens[whereIndex].start])
+	result.condition = strings.TrimSpace(statement[tokens[whereIndex].end:end])
+	if result.condition == "" {
+		return nil, false
+	}
+	return result, true
+}
@@ -0,0 +1,80 @@
+package taskrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestParseBatchDMLStatement(t *testing.T) {
+	tests := []struct {
+		engine    storepb.Engine
+		statement string
+		ok        bool
+		schema    string
+		table     string
+		chunk     string
+	}{
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "UPDATE `t` SET a = 1 WHERE b = 'x;y' OR c IN (SELECT id FROM u WHERE d LIMIT 1);",
+			ok:        true,
+			table:     "t",
+			chunk:     "UPDATE `t` SET a = 1 WHERE (b = 'x;y' OR c IN (SELECT id FROM u WHERE d LIMIT 1)) AND `id` >= 1 AND `id` < 101",
+		},
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "DELETE FROM db.t -- comment\n",
+			ok:        true,
+			schema:    "db",
+			table:     "t",
+			chunk:     "DELETE FROM db.t WHERE `id` >= 1 AND `id` < 101",
+		},
+		{
+			engine:    storepb.Engine_POSTGRES,
+			statement: `UPDATE ONLY "public"."T" AS x SET a = 'it''s' WHERE x.b > 0`,
+			ok:        true,
+			schema:    "public",
+			table:     "T",
+			chunk:     `UPDATE ONLY "public"."T" AS x SET a = 'it''s' WHERE (x.b > 0) AND "id" >= 1 AND "id" < 101`,
+		},
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "UPDATE t1 JOIN t2 ON t1.id = t2.id SET t1.a = 1",
+		},
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "DELETE FROM t WHERE a = 1 ORDER BY id LIMIT 10",
+		},
+		{
+			engine:    storepb.Engine_POSTGRES,
+			statement: "UPDATE t SET a = u.a FROM u WHERE t.id = u.id",
+		},
+		{
+			engine:    storepb.Engine_POSTGRES,
+			statement: "DELETE FROM t USING u WHERE t.id = u.id",
+		},
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "INSERT INTO t VALUES (1)",
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		parsed, ok := parseBatchDMLStatement(test.engine, test.statement)
+		a.Equal(test.ok, ok, test.statement)
+		if !ok {
+			continue
+		}
+		a.Equal(test.schema, parsed.schema, test.statement)
+		a.Equal(test.table, parsed.table, test.statement)
+		column := "`id`"
+		if test.engine == storepb.Engine_POSTGRES {
+			column = `"id"`
+		}
+		a.Equal(test.chunk, parsed.chunkStatement(column, 1, 101), test.statement)
+	}
+}
@@ -71,6 +71,9 @@ func (exec *DataUpdateExecutor) RunOnce(ctx context.Context, driverCtx context.C
 	if err := exec.backupData(ctx, driverCtx, statement, payload, task); err != nil {
 		return true, nil, err
 	}
+	if payload.BatchConfig != nil {
+		return exec.runBatchMigration(ctx, driverCtx, task, taskRunUID, statement, payload)
+	}
 	version := model.Version{Version: payload.SchemaVersion}
 	return runMigration(ctx, driverCtx, exec.store, exec.dbFactory, exec.activityManager, exec.license, exec.stateCfg, exec.profile, task, taskRunUID, db.Data, statement, version, &payload.SheetID)
 }
@@ -153,7 +156,7 @@ func (exec *DataUpdateExecutor) backupData(
 			Payload:      string(bytes),
 		}
 		if _, err := exec.activityManager.CreateActivity(ctx, activityCreate, &activity.Metadata{Issue: issue}); err != nil {
-			slog.Error("failed to create activity",
+			slog.ErrorContext(ctx, "failed to create activity",
 				slog.Int("task", task.ID),
 				log.BBError(err),
 			)
@@ -161,7 +164,7 @@ func (exec *DataUpdateExecutor) backupData(
 	}
 
 	if err := exec.schemaSyncer.SyncDatabaseSchema(ctx, backupDatabase, true /* force */); err != nil {
-		slog.Error("failed to sync backup database schema",
+		slog.ErrorContext(ctx, "failed to sync backup database schema",
 			slog.String("instanceName", backupInstance.ResourceID),
 			slog.String("databaseName", backupDatabase.DatabaseName),
 			log.BBError(err),
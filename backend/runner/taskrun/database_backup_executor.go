@@ -13,6 +13,7 @@ import (
 	"golang.org/x/sys/unix"
 
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/common/tracing"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/state"
@@ -98,8 +99,20 @@ func (exec *DatabaseBackupExecutor) RunOnce(ctx context.Context, _ context.Conte
 			UpdateTime:      time.Now(),
 		})
 
-	slog.Debug("Start database backup.", slog.String("instance", instance.Title), slog.String("database", database.DatabaseName), slog.String("backup", backup.Name))
+	slog.DebugContext(ctx, "Start database backup.", slog.String("instance", instance.Title), slog.String("database", database.DatabaseName), slog.String("backup", backup.Name))
+	appendTaskRunLog(ctx, exec.store, taskRunUID, store.TaskRunLogInfo, fmt.Sprintf("Start backup %q of database %q to %s storage", backup.Name, database.DatabaseName, backup.StorageBackend))
+	startTime := time.Now()
 	backupPayload, backupErr := exec.backupDatabase(ctx, exec.dbFactory, exec.s3Client, exec.profile, instance, database, backup)
+	backupStatus := api.BackupStatusDone
+	if backupErr != nil {
+		backupStatus = api.BackupStatusFailed
+	}
+	backupDuration.WithLabelValues(string(backup.StorageBackend), string(backupStatus)).Observe(time.Since(startTime).Seconds())
+	if backupErr != nil {
+		appendTaskRunLog(ctx, exec.store, taskRunUID, store.TaskRunLogError, fmt.Sprintf("Backup %q failed: %v", backup.Name, backupErr))
+	} else {
+		appendTaskRunLog(ctx, exec.store, taskRunUID, store.TaskRunLogInfo, fmt.Sprintf("Backup %q done", backup.Name))
+	}
 
 	exec.stateCfg.TaskRunExecutionStatuses.Store(taskRunUID,
 		state.TaskRunExecutionStatus{
@@ -107,18 +120,17 @@ func (exec *DatabaseBackupExecutor) RunOnce(ctx context.Context, _ context.Conte
 			UpdateTime:      time.Now(),
 		})
 
-	backupStatus := string(api.BackupStatusDone)
+	status := string(backupStatus)
 	comment := ""
 	if backupErr != nil {
-		backupStatus = string(api.BackupStatusFailed)
 		comment = backupErr.Error()
 		if err := removeLocalBackupFile(exec.profile.DataDir, backup); err != nil {
-			slog.Warn(err.Error())
+			slog.WarnContext(ctx, err.Error())
 		}
 	}
 	backupPatch := store.UpdateBackupMessage{
 		UID:       backup.UID,
-		Status:    &backupStatus,
+		Status:    &status,
 		UpdaterID: api.SystemBotID,
 		Comment:   &comment,
 		Payload:   &backupPayload,
@@ -169,7 +181,9 @@ func dumpBackupFile(ctx context.Context, driver db.Driver, backupFilePath string
 		return "", errors.Errorf("failed to open backup path %q", backupFilePath)
 	}
 	defer backupFile.Close()
+	ctx, span := db.StartSpan(ctx, driver, "Dump", "")
 	payload, err := driver.Dump(ctx, backupFile, false /* schemaOnly */)
+	tracing.End(span, err)
 	if err != nil {
 		return "", errors.Wrapf(err, "failed to dump database to local backup file %q", backupFilePath)
 	}
@@ -189,12 +203,19 @@ func (*DatabaseBackupExecutor) backupDatabase(ctx context.Context, dbFactory *db
 	if err != nil {
 		return "", errors.Wrapf(err, "failed to dump backup file %q", backupFilePathLocal)
 	}
+	if fileInfo, err := os.Stat(backupFilePathLocal); err == nil {
+		backupSize.WithLabelValues(string(backup.StorageBackend)).Observe(float64(fileInfo.Size()))
+		// Record the size for the backup storage quota of the project.
+		if payload, err = setBackupPayloadSize(payload, fileInfo.Size()); err != nil {
+			return "", err
+		}
+	}
 
 	switch backup.StorageBackend {
 	case api.BackupStorageBackendLocal:
 		return payload, nil
 	case api.BackupStorageBackendS3:
-		slog.Debug("Uploading backup to s3 bucket.", slog.String("bucket", s3Client.GetBucket()), slog.String("path", backupFilePathLocal))
+		slog.DebugContext(ctx, "Uploading backup to s3 bucket.", slog.String("bucket", s3Client.GetBucket()), slog.String("path", backupFilePathLocal))
 		bucketFileToUpload, err := os.Open(backupFilePathLocal)
 		if err != nil {
 			return "", errors.Wrapf(err, "failed to open backup file %q for uploading to s3 bucket", backupFilePathLocal)
@@ -204,15 +225,31 @@ func (*DatabaseBackupExecutor) backupDatabase(ctx context.Context, dbFactory *db
 		if _, err := s3Client.UploadObject(ctx, backup.Path, bucketFileToUpload); err != nil {
 			return "", errors.Wrapf(err, "failed to upload backup to AWS S3")
 		}
-		slog.Debug("Successfully uploaded backup to s3 bucket.")
+		slog.DebugContext(ctx, "Successfully uploaded backup to s3 bucket.")
 
 		if err := os.Remove(backupFilePathLocal); err != nil {
-			slog.Warn("Failed to remove the local backup file after uploading to s3 bucket.", slog.String("path", backupFilePathLocal), log.BBError(err))
+			slog.WarnContext(ctx, "Failed to remove the local backup file after uploading to s3 bucket.", slog.String("path", backupFilePathLocal), log.BBError(err))
 		} else {
-			slog.Debug("Successfully removed the local backup file after uploading to s3 bucket.", slog.String("path", backupFilePathLocal))
+			slog.DebugContext(ctx, "Successfully removed the local backup file after uploading to s3 bucket.", slog.String("path", backupFilePathLocal))
 		}
 		return payload, nil
 	default:
 		return "", errors.Errorf("backup to %s not implemented yet", backup.StorageBackend)
 	}
 }
+
+// setBackupPayloadSize sets the backup file size in the backup payload returned by the dump.
+func setBackupPayloadSize(payload string, size int64) (string, error) {
+	backupPayload := api.BackupPayload{}
+	if payload != "" {
+		if err := json.Unmarshal([]byte(payload), &backupPayload); err != nil {
+			return "", errors.Wrap(err, "failed to unmarshal backup payload")
+		}
+	}
+	backupPayload.Size = size
+	bytes, err := json.Marshal(backupPayload)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal backup payload")
+	}
+	return string(bytes), nil
+}
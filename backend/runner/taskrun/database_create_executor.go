@@ -99,7 +99,7 @@ func (exec *DatabaseCreateExecutor) RunOnce(ctx context.Context, driverCtx conte
 	}
 
 	// Create database.
-	slog.Debug("Start creating database...",
+	slog.DebugContext(ctx, "Start creating database...",
 		slog.String("instance", instance.Title),
 		slog.String("database", payload.DatabaseName),
 		slog.String("statement", statement),
@@ -224,7 +224,7 @@ func (exec *DatabaseCreateExecutor) RunOnce(ctx context.Context, driverCtx conte
 	}
 
 	if err := exec.schemaSyncer.SyncDatabaseSchema(ctx, database, true /* force */); err != nil {
-		slog.Error("failed to sync database schema",
+		slog.ErrorContext(ctx, "failed to sync database schema",
 			slog.String("instanceName", instance.ResourceID),
 			slog.String("databaseName", database.DatabaseName),
 			log.BBError(err),
@@ -233,7 +233,7 @@ func (exec *DatabaseCreateExecutor) RunOnce(ctx context.Context, driverCtx conte
 
 	storedVersion, err := peerSchemaVersion.Marshal()
 	if err != nil {
-		slog.Error("failed to convert database schema version",
+		slog.ErrorContext(ctx, "failed to convert database schema version",
 			slog.String("version", peerSchemaVersion.Version),
 			log.BBError(err),
 		)
@@ -282,7 +282,7 @@ func (exec *DatabaseCreateExecutor) createInitialSchema(ctx context.Context, dri
 	if err != nil {
 		// If somehow we unable to find the principal, we just emit the error since it's not
 		// critical enough to fail the entire operation.
-		slog.Error("Failed to fetch creator for composing the migration info",
+		slog.ErrorContext(ctx, "Failed to fetch creator for composing the migration info",
 			slog.Int("task_id", task.ID),
 			log.BBError(err),
 		)
@@ -293,14 +293,14 @@ func (exec *DatabaseCreateExecutor) createInitialSchema(ctx context.Context, dri
 	if err != nil {
 		// If somehow we unable to find the issue, we just emit the error since it's not
 		// critical enough to fail the entire operation.
-		slog.Error("Failed to fetch containing issue for composing the migration info",
+		slog.ErrorContext(ctx, "Failed to fetch containing issue for composing the migration info",
 			slog.Int("task_id", task.ID),
 			log.BBError(err),
 		)
 	}
 	if issue == nil {
 		err := errors.Errorf("failed to fetch containing issue for composing the migration info, issue not found with pipeline ID %v", task.PipelineID)
-		slog.Error(err.Error(),
+		slog.ErrorContext(ctx, err.Error(),
 			slog.Int("task_id", task.ID),
 			log.BBError(err),
 		)
@@ -373,7 +373,11 @@ func (*DatabaseCreateExecutor) getSchemaFromPeerTenantDatabase(ctx context.Conte
 	if err != nil {
 		return model.Version{}, "", errors.Errorf("Failed to get deployment schedule")
 	}
-	matrix, err := utils.GetDatabaseMatrixFromDeploymentSchedule(deploySchedule, databases)
+	engines, err := utils.GetInstanceEngines(ctx, stores, databases)
+	if err != nil {
+		return model.Version{}, "", errors.Wrapf(err, "Failed to get instance engines")
+	}
+	matrix, err := utils.GetDatabaseMatrixFromDeploymentSchedule(deploySchedule, databases, engines)
 	if err != nil {
 		return model.Version{}, "", errors.Errorf("Failed to create deployment pipeline")
 	}
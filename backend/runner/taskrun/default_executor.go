@@ -19,8 +19,8 @@ type DefaultExecutor struct {
 }
 
 // RunOnce will run the default task executor once.
-func (*DefaultExecutor) RunOnce(_ context.Context, _ context.Context, task *store.TaskMessage, _ int) (terminated bool, result *api.TaskRunResultPayload, err error) {
-	slog.Info("Run default task type", slog.String("task", task.Name))
+func (*DefaultExecutor) RunOnce(ctx context.Context, _ context.Context, task *store.TaskMessage, _ int) (terminated bool, result *api.TaskRunResultPayload, err error) {
+	slog.InfoContext(ctx, "Run default task type", slog.String("task", task.Name))
 
 	return true, &api.TaskRunResultPayload{Detail: fmt.Sprintf("No-op task %s", task.Name)}, nil
 }
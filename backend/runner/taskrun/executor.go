@@ -55,7 +55,7 @@ func RunExecutorOnce(ctx context.Context, driverCtx context.Context, exec Execut
 			if !ok {
 				panicErr = errors.Errorf("%v", r)
 			}
-			slog.Error("TaskExecutor PANIC RECOVER", log.BBError(panicErr), log.BBStack("panic-stack"))
+			slog.ErrorContext(ctx, "TaskExecutor PANIC RECOVER", log.BBError(panicErr), log.BBStack("panic-stack"))
 			terminated = true
 			result = nil
 			err = errors.Errorf("encounter internal error when executing task")
@@ -65,6 +65,18 @@ func RunExecutorOnce(ctx context.Context, driverCtx context.Context, exec Execut
 	return exec.RunOnce(ctx, driverCtx, task, taskRunUID)
 }
 
+// appendTaskRunLog appends a log to the task run.
+// Task run logs are best effort, so the error is only logged.
+func appendTaskRunLog(ctx context.Context, stores *store.Store, taskRunUID int, level store.TaskRunLogLevel, content string) {
+	if err := stores.CreateTaskRunLog(ctx, &store.TaskRunLogMessage{
+		TaskRunUID: taskRunUID,
+		Level:      level,
+		Content:    content,
+	}); err != nil {
+		slog.ErrorContext(ctx, "failed to create task run log", slog.Int("taskRun", taskRunUID), log.BBError(err))
+	}
+}
+
 func getMigrationInfo(ctx context.Context, stores *store.Store, profile config.Profile, task *store.TaskMessage, migrationType db.MigrationType, statement string, schemaVersion model.Version) (*db.MigrationInfo, error) {
 	instance, err := stores.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
 	if err != nil {
@@ -143,7 +155,7 @@ func getMigrationInfo(ctx context.Context, stores *store.Store, profile config.P
 
 	issue, err := stores.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &task.PipelineID})
 	if err != nil {
-		slog.Error("failed to find containing issue", log.BBError(err))
+		slog.ErrorContext(ctx, "failed to find containing issue", log.BBError(err))
 	}
 	if issue != nil {
 		// Concat issue title and task name as the migration description so that user can see
@@ -157,7 +169,7 @@ func getMigrationInfo(ctx context.Context, stores *store.Store, profile config.P
 	if err != nil {
 		// If somehow we unable to find the principal, we just emit the error since it's not
 		// critical enough to fail the entire operation.
-		slog.Error("Failed to fetch creator for composing the migration info",
+		slog.ErrorContext(ctx, "Failed to fetch creator for composing the migration info",
 			slog.Int("task_id", task.ID),
 			log.BBError(err),
 		)
@@ -185,24 +197,24 @@ func executeMigration(
 	taskRunUID int,
 	statement string,
 	sheetID *int,
-	mi *db.MigrationInfo) (string, string, error) {
+	mi *db.MigrationInfo) (string, string, []*storepb.TaskRunResult_CheckResult, error) {
 	instance, err := stores.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
 	if err != nil {
-		return "", "", err
+		return "", "", nil, err
 	}
 	database, err := stores.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
 	if err != nil {
-		return "", "", err
+		return "", "", nil, err
 	}
 
 	driver, err := dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
 	if err != nil {
-		return "", "", errors.Wrapf(err, "failed to get driver connection for instance %q", instance.ResourceID)
+		return "", "", nil, errors.Wrapf(err, "failed to get driver connection for instance %q", instance.ResourceID)
 	}
 	defer driver.Close(ctx)
 
 	statementRecord, _ := common.TruncateString(statement, common.MaxSheetSize)
-	slog.Debug("Start migration...",
+	slog.DebugContext(ctx, "Start migration...",
 		slog.String("instance", instance.ResourceID),
 		slog.String("database", database.DatabaseName),
 		slog.String("source", string(mi.Source)),
@@ -211,6 +223,8 @@ func executeMigration(
 	)
 
 	var migrationID string
+	var resumable bool
+	var preChecks, postChecks []*api.MigrationCheck
 	opts := db.ExecuteOptions{}
 	if task.Type == api.TaskDatabaseDataUpdate && (instance.Engine == storepb.Engine_MYSQL || instance.Engine == storepb.Engine_MARIADB) {
 		opts.BeginFunc = func(ctx context.Context, conn *sql.Conn) error {
@@ -222,6 +236,20 @@ func executeMigration(
 			return nil
 		}
 	}
+	if task.Type == api.TaskDatabaseSchemaUpdate || task.Type == api.TaskDatabaseDataUpdate {
+		executeConfig := struct {
+			Transactional bool                  `json:"transactional"`
+			Resumable     bool                  `json:"resumable"`
+			PreChecks     []*api.MigrationCheck `json:"preChecks"`
+			PostChecks    []*api.MigrationCheck `json:"postChecks"`
+		}{}
+		if err := json.Unmarshal([]byte(task.Payload), &executeConfig); err != nil {
+			return "", "", nil, errors.Wrapf(err, "failed to unmarshal task payload")
+		}
+		opts.Transactional = executeConfig.Transactional
+		resumable = executeConfig.Resumable
+		preChecks, postChecks = executeConfig.PreChecks, executeConfig.PostChecks
+	}
 	if task.Type == api.TaskDatabaseDataUpdate && instance.Engine == storepb.Engine_ORACLE {
 		// getSetOracleTransactionIdFunc will update the task payload to set the Oracle transaction id, we need to re-retrieve the task to store to the RollbackGenerate.
 		opts.EndTransactionFunc = getSetOracleTransactionIDFunc(ctx, task, stores)
@@ -247,20 +275,61 @@ func executeMigration(
 		}
 	}
 
-	migrationID, schema, err := utils.ExecuteMigrationDefault(ctx, driverCtx, stores, stateCfg, taskRunUID, driver, mi, statement, sheetID, opts)
+	checker := &migrationChecker{stores: stores, taskRunUID: taskRunUID}
+	if (len(preChecks) > 0 || len(postChecks) > 0) && driver.GetDB() == nil {
+		return "", "", nil, errors.Errorf("pre-checks and post-checks are not supported for engine %v", instance.Engine)
+	}
+	if err := checker.run(driverCtx, driver.GetDB(), storepb.TaskRunResult_CheckResult_PRE_CHECK, preChecks); err != nil {
+		return "", "", nil, err
+	}
+	// The post-checks of the transactional migration run before committing, so that the migration is rolled back if any check fails.
+	if len(postChecks) > 0 && opts.Transactional {
+		opts.EndTransactionFunc = func(tx *sql.Tx) error {
+			return checker.run(driverCtx, tx, storepb.TaskRunResult_CheckResult_POST_CHECK, postChecks)
+		}
+	}
+
+	execFunc := func(execCtx context.Context, execStatement string) error {
+		if _, err := driver.Execute(execCtx, execStatement, opts); err != nil {
+			return err
+		}
+		return nil
+	}
+	if resumable {
+		r := &resumableMigrationExecutor{
+			stores:     stores,
+			driver:     driver,
+			engine:     instance.Engine,
+			mi:         mi,
+			taskRunUID: taskRunUID,
+		}
+		execFunc = func(execCtx context.Context, execStatement string) error {
+			return r.execute(ctx, execCtx, execStatement)
+		}
+	}
+	if len(postChecks) > 0 && !opts.Transactional {
+		migrate := execFunc
+		execFunc = func(execCtx context.Context, execStatement string) error {
+			if err := migrate(execCtx, execStatement); err != nil {
+				return err
+			}
+			return checker.run(execCtx, driver.GetDB(), storepb.TaskRunResult_CheckResult_POST_CHECK, postChecks)
+		}
+	}
+	migrationID, schema, err := utils.ExecuteMigrationWithFunc(ctx, driverCtx, stores, stateCfg, taskRunUID, driver, mi, statement, sheetID, execFunc)
 	if err != nil {
-		return "", "", err
+		return "", "", nil, err
 	}
 
 	// If the migration is a data migration, enable the rollback SQL generation and the type of the driver is Oracle, we need to get the rollback SQL before the transaction is committed.
 	if task.Type == api.TaskDatabaseDataUpdate && instance.Engine == storepb.Engine_ORACLE {
 		updatedTask, err := stores.GetTaskV2ByID(ctx, task.ID)
 		if err != nil {
-			return "", "", errors.Wrapf(err, "cannot get task by id %d", task.ID)
+			return "", "", nil, errors.Wrapf(err, "cannot get task by id %d", task.ID)
 		}
 		payload := &api.TaskDatabaseDataUpdatePayload{}
 		if err := json.Unmarshal([]byte(updatedTask.Payload), payload); err != nil {
-			return "", "", errors.Wrap(err, "invalid database data update payload")
+			return "", "", nil, errors.Wrap(err, "invalid database data update payload")
 		}
 		if payload.RollbackEnabled {
 			// The runner will periodically scan the map to generate rollback SQL asynchronously.
@@ -271,17 +340,17 @@ func executeMigration(
 	if task.Type == api.TaskDatabaseDataUpdate && (instance.Engine == storepb.Engine_MYSQL || instance.Engine == storepb.Engine_MARIADB) {
 		conn, err := driver.GetDB().Conn(ctx)
 		if err != nil {
-			return "", "", errors.Wrap(err, "failed to create connection")
+			return "", "", nil, errors.Wrap(err, "failed to create connection")
 		}
 		defer conn.Close()
 		updatedTask, err := setMigrationIDAndEndBinlogCoordinate(ctx, conn, task, stores, migrationID)
 		if err != nil {
-			return "", "", errors.Wrap(err, "failed to update the task payload for MySQL rollback SQL")
+			return "", "", nil, errors.Wrap(err, "failed to update the task payload for MySQL rollback SQL")
 		}
 
 		payload := &api.TaskDatabaseDataUpdatePayload{}
 		if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
-			return "", "", errors.Wrap(err, "invalid database data update payload")
+			return "", "", nil, errors.Wrap(err, "invalid database data update payload")
 		}
 		if payload.RollbackEnabled {
 			// The runner will periodically scan the map to generate rollback SQL asynchronously.
@@ -289,20 +358,61 @@ func executeMigration(
 		}
 	}
 
-	return migrationID, schema, nil
+	if task.Type == api.TaskDatabaseSchemaUpdate {
+		if err := enqueueSchemaUpdateRollback(ctx, stores, stateCfg, task, migrationID); err != nil {
+			return "", "", nil, err
+		}
+	}
+
+	return migrationID, schema, checker.results, nil
+}
+
+// enqueueSchemaUpdateRollback saves the migration ID to the schema update task payload so that the rollback DDL can be generated
+// from its schema snapshots, and enqueues the task for the rollback DDL generation if the rollback is enabled.
+func enqueueSchemaUpdateRollback(ctx context.Context, stores *store.Store, stateCfg *state.State, task *store.TaskMessage, migrationID string) error {
+	if migrationID == "" {
+		return nil
+	}
+	latestTask, err := stores.GetTaskV2ByID(ctx, task.ID)
+	if err != nil {
+		return errors.Wrapf(err, "cannot get task by id %d", task.ID)
+	}
+	payload := &api.TaskDatabaseSchemaUpdatePayload{}
+	if err := json.Unmarshal([]byte(latestTask.Payload), payload); err != nil {
+		return errors.Wrap(err, "invalid database schema update payload")
+	}
+	payload.MigrationID = migrationID
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal task payload")
+	}
+	payloadString := string(payloadBytes)
+	updatedTask, err := stores.UpdateTaskV2(ctx, &api.TaskPatch{
+		ID:        task.ID,
+		UpdaterID: api.SystemBotID,
+		Payload:   &payloadString,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to patch task %d with the migration ID", task.ID)
+	}
+	if payload.RollbackEnabled && stateCfg != nil {
+		// The runner will periodically scan the map to generate rollback DDL asynchronously.
+		stateCfg.RollbackGenerate.Store(task.ID, updatedTask)
+	}
+	return nil
 }
 
 func getSetOracleTransactionIDFunc(ctx context.Context, task *store.TaskMessage, store *store.Store) func(tx *sql.Tx) error {
 	return func(tx *sql.Tx) error {
 		payload := &api.TaskDatabaseDataUpdatePayload{}
 		if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
-			slog.Error("failed to unmarshal task payload", slog.Int("TaskId", task.ID), log.BBError(err))
+			slog.ErrorContext(ctx, "failed to unmarshal task payload", slog.Int("TaskId", task.ID), log.BBError(err))
 			return nil
 		}
 		// Get oracle current transaction id;
 		transactionID, err := tx.QueryContext(ctx, "SELECT RAWTOHEX(tx.xid) FROM v$transaction tx JOIN v$session s ON tx.ses_addr = s.saddr")
 		if err != nil {
-			slog.Error("failed to transaction id in task", slog.Int("TaskId", task.ID), log.BBError(err))
+			slog.ErrorContext(ctx, "failed to transaction id in task", slog.Int("TaskId", task.ID), log.BBError(err))
 			return nil
 		}
 		defer transactionID.Close()
@@ -310,7 +420,7 @@ func getSetOracleTransactionIDFunc(ctx context.Context, task *store.TaskMessage,
 		for transactionID.Next() {
 			err := transactionID.Scan(&txID)
 			if err != nil {
-				slog.Error("failed to the Oracle transaction id in task", slog.Int("TaskId", task.ID), log.BBError(err))
+				slog.ErrorContext(ctx, "failed to the Oracle transaction id in task", slog.Int("TaskId", task.ID), log.BBError(err))
 				return nil
 			}
 		}
@@ -320,7 +430,7 @@ func getSetOracleTransactionIDFunc(ctx context.Context, task *store.TaskMessage,
 		payload.TransactionID = txID
 		updatedPayload, err := json.Marshal(payload)
 		if err != nil {
-			slog.Error("failed to unmarshal task payload", slog.Int("TaskId", task.ID), log.BBError(err), slog.Any("payload", updatedPayload))
+			slog.ErrorContext(ctx, "failed to unmarshal task payload", slog.Int("TaskId", task.ID), log.BBError(err), slog.Any("payload", updatedPayload))
 			return nil
 		}
 		updatedPayloadString := string(updatedPayload)
@@ -330,7 +440,7 @@ func getSetOracleTransactionIDFunc(ctx context.Context, task *store.TaskMessage,
 			Payload:   &updatedPayloadString,
 		}
 		if _, err = store.UpdateTaskV2(ctx, patch); err != nil {
-			slog.Error("failed to update task with new payload", slog.Any("TaskPatch", patch), log.BBError(err))
+			slog.ErrorContext(ctx, "failed to update task with new payload", slog.Any("TaskPatch", patch), log.BBError(err))
 			return nil
 		}
 		return nil
@@ -354,7 +464,7 @@ func setThreadIDAndStartBinlogCoordinate(ctx context.Context, conn *sql.Conn, ta
 		return nil, errors.Wrap(err, "failed to get the binlog info before executing the migration transaction")
 	}
 	if (binlogInfo == api.BinlogInfo{}) {
-		slog.Warn("binlog is not enabled", slog.Int("task", task.ID))
+		slog.WarnContext(ctx, "binlog is not enabled", slog.Int("task", task.ID))
 		return task, nil
 	}
 	payload.BinlogFileStart = binlogInfo.FileName
@@ -389,7 +499,7 @@ func setMigrationIDAndEndBinlogCoordinate(ctx context.Context, conn *sql.Conn, t
 		return nil, errors.Wrap(err, "failed to get the binlog info before executing the migration transaction")
 	}
 	if (binlogInfo == api.BinlogInfo{}) {
-		slog.Warn("binlog is not enabled", slog.Int("task", task.ID))
+		slog.WarnContext(ctx, "binlog is not enabled", slog.Int("task", task.ID))
 		return task, nil
 	}
 	payload.BinlogFileEnd = binlogInfo.FileName
@@ -428,11 +538,11 @@ func postMigration(ctx context.Context, stores *store.Store, activityManager *ac
 
 	issue, err := stores.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &task.PipelineID})
 	if err != nil {
-		slog.Error("failed to find containing issue", log.BBError(err))
+		slog.ErrorContext(ctx, "failed to find containing issue", log.BBError(err))
 	}
 	if err != nil {
 		// If somehow we cannot find the issue, emit the error since it's not fatal.
-		slog.Error("failed to find containing issue", log.BBError(err))
+		slog.ErrorContext(ctx, "failed to find containing issue", log.BBError(err))
 	}
 	repo, err := stores.GetRepositoryV2(ctx, &store.FindRepositoryMessage{
 		ProjectResourceID: &project.ResourceID,
@@ -456,7 +566,7 @@ func postMigration(ctx context.Context, stores *store.Store, activityManager *ac
 		return true, nil, err
 	}
 
-	slog.Debug("Post migration...",
+	slog.DebugContext(ctx, "Post migration...",
 		slog.String("instance", instance.ResourceID),
 		slog.String("database", database.DatabaseName),
 		slog.String("writeback_branch", writebackBranch),
@@ -510,7 +620,7 @@ func postMigration(ctx context.Context, stores *store.Store, activityManager *ac
 				CommitID:           commitID,
 			})
 			if err != nil {
-				slog.Error("Failed to marshal file commit activity after writing back the latest schema",
+				slog.ErrorContext(ctx, "Failed to marshal file commit activity after writing back the latest schema",
 					slog.Int("task_id", task.ID),
 					slog.String("repository", repo.WebURL),
 					slog.String("file_path", latestSchemaFile),
@@ -531,7 +641,7 @@ func postMigration(ctx context.Context, stores *store.Store, activityManager *ac
 			}
 
 			if _, err := activityManager.CreateActivity(ctx, activityCreate, &activity.Metadata{}); err != nil {
-				slog.Error("Failed to create file commit activity after writing back the latest schema",
+				slog.ErrorContext(ctx, "Failed to create file commit activity after writing back the latest schema",
 					slog.Int("task_id", task.ID),
 					slog.String("repository", repo.WebURL),
 					slog.String("file_path", latestSchemaFile),
@@ -547,18 +657,18 @@ func postMigration(ctx context.Context, stores *store.Store, activityManager *ac
 			UID: sheetID,
 		}, api.SystemBotID)
 		if err != nil {
-			slog.Error("Failed to get sheet from store", slog.Int("sheetID", *sheetID), log.BBError(err))
+			slog.ErrorContext(ctx, "Failed to get sheet from store", slog.Int("sheetID", *sheetID), log.BBError(err))
 		} else if sheet.Payload != nil && (sheet.Payload.DatabaseConfig != nil || sheet.Payload.BaselineDatabaseConfig != nil) {
 			databaseSchema, err := stores.GetDBSchema(ctx, *task.DatabaseID)
 			if err != nil {
-				slog.Error("Failed to get database config from store", slog.Int("sheetID", *sheetID), slog.Int("databaseUID", *task.DatabaseID), log.BBError(err))
+				slog.ErrorContext(ctx, "Failed to get database config from store", slog.Int("sheetID", *sheetID), slog.Int("databaseUID", *task.DatabaseID), log.BBError(err))
 			} else {
 				updatedDatabaseConfig := utils.MergeDatabaseConfig(sheet.Payload.BaselineDatabaseConfig, databaseSchema.GetConfig(), sheet.Payload.DatabaseConfig)
 				err = stores.UpdateDBSchema(ctx, *task.DatabaseID, &store.UpdateDBSchemaMessage{
 					Config: updatedDatabaseConfig,
 				}, api.SystemBotID)
 				if err != nil {
-					slog.Error("Failed to update database config", slog.Int("sheetID", *sheetID), slog.Int("databaseUID", *task.DatabaseID), log.BBError(err))
+					slog.ErrorContext(ctx, "Failed to update database config", slog.Int("sheetID", *sheetID), slog.Int("databaseUID", *task.DatabaseID), log.BBError(err))
 				}
 			}
 		}
@@ -569,7 +679,7 @@ func postMigration(ctx context.Context, stores *store.Store, activityManager *ac
 		DatabaseUID: task.DatabaseID,
 		Type:        api.AnomalyDatabaseSchemaDrift,
 	}); err != nil && common.ErrorCode(err) != common.NotFound {
-		slog.Error("Failed to archive anomaly",
+		slog.ErrorContext(ctx, "Failed to archive anomaly",
 			slog.String("instance", instance.ResourceID),
 			slog.String("database", database.DatabaseName),
 			slog.String("type", string(api.AnomalyDatabaseSchemaDrift)),
@@ -583,7 +693,7 @@ func postMigration(ctx context.Context, stores *store.Store, activityManager *ac
 
 	storedVersion, err := mi.Version.Marshal()
 	if err != nil {
-		slog.Error("failed to convert database schema version",
+		slog.ErrorContext(ctx, "failed to convert database schema version",
 			slog.String("version", mi.Version.Version),
 			log.BBError(err),
 		)
@@ -619,7 +729,7 @@ func isWriteBack(ctx context.Context, stores *store.Store, license enterprise.Li
 	}
 
 	if err := license.IsFeatureEnabledForInstance(api.FeatureVCSSchemaWriteBack, instance); err != nil {
-		slog.Debug(err.Error(), slog.String("instance", instance.ResourceID))
+		slog.DebugContext(ctx, err.Error(), slog.String("instance", instance.ResourceID))
 		return "", nil
 	}
 
@@ -665,11 +775,15 @@ func runMigration(ctx context.Context, driverCtx context.Context, store *store.S
 		return true, nil, err
 	}
 
-	migrationID, schema, err := executeMigration(ctx, driverCtx, store, dbFactory, stateCfg, profile, task, taskRunUID, statement, sheetID, mi)
+	migrationID, schema, checkResults, err := executeMigration(ctx, driverCtx, store, dbFactory, stateCfg, profile, task, taskRunUID, statement, sheetID, mi)
 	if err != nil {
 		return true, nil, err
 	}
-	return postMigration(ctx, store, activityManager, license, task, mi, migrationID, schema, sheetID)
+	terminated, result, err = postMigration(ctx, store, activityManager, license, task, mi, migrationID, schema, sheetID)
+	if result != nil {
+		result.CheckResults = checkResults
+	}
+	return terminated, result, err
 }
 
 // Writes back the latest schema to the repository after migration.
@@ -757,7 +871,7 @@ func writeBackLatestSchema(
 		AuthorEmail:   vcsplugin.BytebaseAuthorEmail,
 	}
 	if createSchemaFile {
-		slog.Debug("Create latest schema file",
+		slog.DebugContext(ctx, "Create latest schema file",
 			slog.String("schema_file", latestSchemaFile),
 		)
 
@@ -773,7 +887,7 @@ func writeBackLatestSchema(
 			return "", errors.Wrapf(err, "failed to create file after applying migration %s to %q", mi.Version.Version, mi.Database)
 		}
 	} else {
-		slog.Debug("Update latest schema file",
+		slog.DebugContext(ctx, "Update latest schema file",
 			slog.String("schema_file", latestSchemaFile),
 		)
 
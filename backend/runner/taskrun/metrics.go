@@ -0,0 +1,93 @@
+package taskrun
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+)
+
+var (
+	taskRunQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "bb",
+		Subsystem: "task_run",
+		Name:      "queue_depth",
+		Help:      "The number of the pending or running task runs by the task type and the status.",
+	}, []string{"type", "status"})
+	taskRunQueueLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "bb",
+		Subsystem: "task_run",
+		Name:      "queue_latency_seconds",
+		Help:      "The time from the task run creation to its execution start by the task type.",
+		Buckets:   []float64{1, 5, 10, 30, 60, 300, 900, 3600, 4 * 3600, 24 * 3600},
+	}, []string{"type"})
+	taskRunDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "bb",
+		Subsystem: "task_run",
+		Name:      "duration_seconds",
+		Help:      "The execution time of the task runs by the task type and the result status.",
+		Buckets:   []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300, 900, 3600, 4 * 3600},
+	}, []string{"type", "status"})
+	taskRunsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bb",
+		Subsystem: "task_run",
+		Name:      "completed_total",
+		Help:      "The number of the task runs completed by the task type and the result status of DONE, FAILED or CANCELED.",
+	}, []string{"type", "status"})
+
+	backupDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "bb",
+		Subsystem: "backup",
+		Name:      "duration_seconds",
+		Help:      "The time taking the database backups by the storage backend and the result status.",
+		Buckets:   []float64{1, 5, 10, 30, 60, 300, 900, 3600, 4 * 3600},
+	}, []string{"storage_backend", "status"})
+	backupSize = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "bb",
+		Subsystem: "backup",
+		Name:      "size_bytes",
+		Help:      "The size of the database backup files by the storage backend.",
+		// From 1KB to 1TB.
+		Buckets: prometheus.ExponentialBuckets(1024, 8, 11),
+	}, []string{"storage_backend"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		taskRunQueueDepth,
+		taskRunQueueLatency,
+		taskRunDuration,
+		taskRunsTotal,
+		backupDuration,
+		backupSize,
+	)
+}
+
+// setTaskRunQueueDepth sets the queue depth of the task runs in the status by the task type.
+// The task types without the task runs in the status are reset to zero.
+func setTaskRunQueueDepth(status api.TaskRunStatus, queueDepth map[api.TaskType]int) {
+	taskRunQueueDepth.DeletePartialMatch(prometheus.Labels{"status": string(status)})
+	for taskType, count := range queueDepth {
+		taskRunQueueDepth.WithLabelValues(string(taskType), string(status)).Set(float64(count))
+	}
+}
+
+func observeTaskRunCompleted(taskType api.TaskType, status api.TaskRunStatus, duration time.Duration) {
+	taskRunDuration.WithLabelValues(string(taskType), string(status)).Observe(duration.Seconds())
+	taskRunsTotal.WithLabelValues(string(taskType), string(status)).Inc()
+}
+
+// getTaskRunCompletedStatus returns the status of the task run completed with the error.
+func getTaskRunCompletedStatus(err error) api.TaskRunStatus {
+	switch {
+	case err == nil:
+		return api.TaskRunDone
+	case errors.Is(err, context.Canceled):
+		return api.TaskRunCanceled
+	default:
+		return api.TaskRunFailed
+	}
+}
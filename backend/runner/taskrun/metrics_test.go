@@ -0,0 +1,42 @@
+package taskrun
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+)
+
+func TestSetTaskRunQueueDepth(t *testing.T) {
+	a := require.New(t)
+
+	setTaskRunQueueDepth(api.TaskRunPending, map[api.TaskType]int{
+		api.TaskDatabaseSchemaUpdate: 2,
+		api.TaskDatabaseDataUpdate:   1,
+	})
+	setTaskRunQueueDepth(api.TaskRunRunning, map[api.TaskType]int{
+		api.TaskDatabaseSchemaUpdate: 3,
+	})
+	a.Equal(float64(2), testutil.ToFloat64(taskRunQueueDepth.WithLabelValues(string(api.TaskDatabaseSchemaUpdate), string(api.TaskRunPending))))
+	a.Equal(float64(3), testutil.ToFloat64(taskRunQueueDepth.WithLabelValues(string(api.TaskDatabaseSchemaUpdate), string(api.TaskRunRunning))))
+
+	// The drained task types are reset, and the other statuses are kept.
+	setTaskRunQueueDepth(api.TaskRunPending, map[api.TaskType]int{
+		api.TaskDatabaseSchemaUpdate: 1,
+	})
+	a.Equal(2, testutil.CollectAndCount(taskRunQueueDepth))
+	a.Equal(float64(1), testutil.ToFloat64(taskRunQueueDepth.WithLabelValues(string(api.TaskDatabaseSchemaUpdate), string(api.TaskRunPending))))
+	a.Equal(float64(3), testutil.ToFloat64(taskRunQueueDepth.WithLabelValues(string(api.TaskDatabaseSchemaUpdate), string(api.TaskRunRunning))))
+}
+
+func TestGetTaskRunCompletedStatus(t *testing.T) {
+	a := require.New(t)
+
+	a.Equal(api.TaskRunDone, getTaskRunCompletedStatus(nil))
+	a.Equal(api.TaskRunCanceled, getTaskRunCompletedStatus(errors.Wrap(context.Canceled, "failed to execute")))
+	a.Equal(api.TaskRunFailed, getTaskRunCompletedStatus(errors.New("syntax error")))
+}
@@ -0,0 +1,120 @@
+package taskrun
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// migrationCheckError is the error of a failed pre-check or post-check.
+// It carries the results of the checks run so far, which are saved on the task run.
+type migrationCheckError struct {
+	err     error
+	results []*storepb.TaskRunResult_CheckResult
+}
+
+func (e *migrationCheckError) Error() string {
+	return e.err.Error()
+}
+
+// migrationCheckQuerier is implemented by both *sql.DB and *sql.Tx,
+// so that the post-checks of a transactional migration see the uncommitted changes.
+type migrationCheckQuerier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// migrationChecker runs the pre-checks and post-checks of the migration and collects their results.
+type migrationChecker struct {
+	stores     *store.Store
+	taskRunUID int
+	results    []*storepb.TaskRunResult_CheckResult
+}
+
+func (c *migrationChecker) run(ctx context.Context, q migrationCheckQuerier, checkType storepb.TaskRunResult_CheckResult_Type, checks []*api.MigrationCheck) error {
+	for i, check := range checks {
+		detail, err := runMigrationCheck(ctx, q, check)
+		if err != nil {
+			detail = fmt.Sprintf("failed to run the query: %v", err)
+		}
+		result := &storepb.TaskRunResult_CheckResult{
+			Type:      checkType,
+			Statement: check.Statement,
+			Passed:    detail == "",
+			Detail:    detail,
+		}
+		c.results = append(c.results, result)
+
+		name := "Pre-check"
+		if checkType == storepb.TaskRunResult_CheckResult_POST_CHECK {
+			name = "Post-check"
+		}
+		if result.Passed {
+			appendTaskRunLog(ctx, c.stores, c.taskRunUID, store.TaskRunLogInfo, fmt.Sprintf("%s %d of %d passed.", name, i+1, len(checks)))
+			continue
+		}
+		appendTaskRunLog(ctx, c.stores, c.taskRunUID, store.TaskRunLogError, fmt.Sprintf("%s %d of %d failed: %s", name, i+1, len(checks), detail))
+		return &migrationCheckError{
+			err:     errors.Errorf("%s %q failed: %s", name, check.Statement, detail),
+			results: c.results,
+		}
+	}
+	return nil
+}
+
+// runMigrationCheck runs the query of the check and returns the reason of the failure, or empty if the check passes.
+func runMigrationCheck(ctx context.Context, q migrationCheckQuerier, check *api.MigrationCheck) (string, error) {
+	rows, err := q.QueryContext(ctx, check.Statement)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return "", err
+	}
+
+	// Two rows are enough to tell whether the query returns zero rows or exactly one row.
+	var values [][]sql.NullString
+	for len(values) < 2 && rows.Next() {
+		row := make([]sql.NullString, len(columns))
+		dest := make([]any, len(columns))
+		for i := range row {
+			dest[i] = &row[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return "", err
+		}
+		values = append(values, row)
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+	return evaluateMigrationCheck(check, values), nil
+}
+
+// evaluateMigrationCheck returns the reason of the failure, or empty if the rows returned by the check query pass.
+func evaluateMigrationCheck(check *api.MigrationCheck, rows [][]sql.NullString) string {
+	if check.ExpectedValue == nil {
+		if len(rows) > 0 {
+			return "the query is expected to return zero rows"
+		}
+		return ""
+	}
+	if len(rows) != 1 || len(rows[0]) == 0 {
+		return fmt.Sprintf("the query is expected to return exactly one row with value %q", *check.ExpectedValue)
+	}
+	value := rows[0][0]
+	if !value.Valid {
+		return fmt.Sprintf("the query returned NULL, expected %q", *check.ExpectedValue)
+	}
+	if value.String != *check.ExpectedValue {
+		return fmt.Sprintf("the query returned %q, expected %q", value.String, *check.ExpectedValue)
+	}
+	return ""
+}
@@ -0,0 +1,36 @@
+package taskrun
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+)
+
+func TestEvaluateMigrationCheck(t *testing.T) {
+	a := require.New(t)
+
+	expected := "3"
+	row := func(values ...sql.NullString) []sql.NullString {
+		return values
+	}
+	tests := []struct {
+		check  *api.MigrationCheck
+		rows   [][]sql.NullString
+		passed bool
+	}{
+		{check: &api.MigrationCheck{Statement: "SELECT 1 FROM t WHERE id IS NULL"}, rows: nil, passed: true},
+		{check: &api.MigrationCheck{Statement: "SELECT 1 FROM t WHERE id IS NULL"}, rows: [][]sql.NullString{row(sql.NullString{String: "1", Valid: true})}, passed: false},
+		{check: &api.MigrationCheck{Statement: "SELECT count(*) FROM t", ExpectedValue: &expected}, rows: [][]sql.NullString{row(sql.NullString{String: "3", Valid: true})}, passed: true},
+		{check: &api.MigrationCheck{Statement: "SELECT count(*) FROM t", ExpectedValue: &expected}, rows: [][]sql.NullString{row(sql.NullString{String: "2", Valid: true})}, passed: false},
+		{check: &api.MigrationCheck{Statement: "SELECT max(id) FROM t", ExpectedValue: &expected}, rows: [][]sql.NullString{row(sql.NullString{})}, passed: false},
+		{check: &api.MigrationCheck{Statement: "SELECT id FROM t", ExpectedValue: &expected}, rows: nil, passed: false},
+		{check: &api.MigrationCheck{Statement: "SELECT id FROM t", ExpectedValue: &expected}, rows: [][]sql.NullString{row(sql.NullString{String: "3", Valid: true}), row(sql.NullString{String: "3", Valid: true})}, passed: false},
+	}
+	for _, test := range tests {
+		detail := evaluateMigrationCheck(test.check, test.rows)
+		a.Equal(test.passed, detail == "", test.check.Statement)
+	}
+}
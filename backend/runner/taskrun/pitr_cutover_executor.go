@@ -62,10 +62,10 @@ func (exec *PITRCutoverExecutor) RunOnce(ctx context.Context, _ context.Context,
 			UpdateTime:      time.Now(),
 		})
 
-	slog.Info("Run PITR cutover task", slog.String("task", task.Name))
+	slog.InfoContext(ctx, "Run PITR cutover task", slog.String("task", task.Name))
 	issue, err := exec.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &task.PipelineID})
 	if err != nil {
-		slog.Error("failed to fetch containing issue doing pitr cutover task", log.BBError(err))
+		slog.ErrorContext(ctx, "failed to fetch containing issue doing pitr cutover task", log.BBError(err))
 		return true, nil, err
 	}
 	if issue == nil {
@@ -94,7 +94,7 @@ func (exec *PITRCutoverExecutor) RunOnce(ctx context.Context, _ context.Context,
 		TaskName:  task.Name,
 	})
 	if err != nil {
-		slog.Error("failed to marshal activity", log.BBError(err))
+		slog.ErrorContext(ctx, "failed to marshal activity", log.BBError(err))
 		return terminated, result, nil
 	}
 
@@ -107,7 +107,7 @@ func (exec *PITRCutoverExecutor) RunOnce(ctx context.Context, _ context.Context,
 		Comment:      fmt.Sprintf("Restore database %s in instance %s successfully.", database.DatabaseName, instance.Title),
 	}
 	if _, err = exec.activityManager.CreateActivity(ctx, activityCreate, &activity.Metadata{Issue: issue}); err != nil {
-		slog.Error("cannot create an pitr activity", log.BBError(err))
+		slog.ErrorContext(ctx, "cannot create an pitr activity", log.BBError(err))
 	}
 
 	return terminated, result, nil
@@ -142,7 +142,7 @@ func (exec *PITRCutoverExecutor) pitrCutover(ctx context.Context, dbFactory *dbf
 	// RestorePITR will create the pitr database.
 	// Since it's ephemeral and will be renamed to the original database soon, we will reuse the original
 	// database's migration history, and append a new BRANCH migration.
-	slog.Debug("Appending new migration history record")
+	slog.DebugContext(ctx, "Appending new migration history record")
 	m := &db.MigrationInfo{
 		InstanceID:     &task.InstanceID,
 		IssueUID:       &issue.UID,
@@ -167,7 +167,7 @@ func (exec *PITRCutoverExecutor) pitrCutover(ctx context.Context, dbFactory *dbf
 	defer driver.Close(ctx)
 
 	if _, _, err := utils.ExecuteMigrationDefault(ctx, ctx, exec.store, exec.stateCfg, taskRunUID, driver, m, "" /* pitr cutover */, nil, db.ExecuteOptions{}); err != nil {
-		slog.Error("Failed to add migration history record", log.BBError(err))
+		slog.ErrorContext(ctx, "Failed to add migration history record", log.BBError(err))
 		return true, nil, errors.Wrap(err, "failed to add migration history record")
 	}
 
@@ -179,7 +179,7 @@ func (exec *PITRCutoverExecutor) pitrCutover(ctx context.Context, dbFactory *dbf
 
 	// Sync database schema after restore is completed.
 	if err := schemaSyncer.SyncDatabaseSchema(ctx, database, true /* force */); err != nil {
-		slog.Error("failed to sync database schema",
+		slog.ErrorContext(ctx, "failed to sync database schema",
 			slog.String("instanceName", instance.ResourceID),
 			slog.String("databaseName", database.DatabaseName),
 			log.BBError(err),
@@ -207,7 +207,7 @@ func (exec *PITRCutoverExecutor) doCutover(ctx context.Context, instance *store.
 					if retry == maxRetry {
 						return errors.Wrapf(err, "failed to do cutover for PostgreSQL after retried for %d times", maxRetry)
 					}
-					slog.Debug("Failed to do cutover for PostgreSQL. Retry later.", log.BBError(err))
+					slog.DebugContext(ctx, "Failed to do cutover for PostgreSQL. Retry later.", log.BBError(err))
 				} else {
 					return nil
 				}
@@ -237,13 +237,13 @@ func (exec *PITRCutoverExecutor) pitrCutoverMySQL(ctx context.Context, instance
 		return err
 	}
 	defer conn.Close()
-	slog.Debug("Swapping the original and PITR database", slog.String("originalDatabase", databaseName))
+	slog.DebugContext(ctx, "Swapping the original and PITR database", slog.String("originalDatabase", databaseName))
 	pitrDatabaseName, pitrOldDatabaseName, err := mysql.SwapPITRDatabase(ctx, conn, databaseName, issue.CreatedTime.Unix())
 	if err != nil {
-		slog.Error("Failed to swap the original and PITR database", slog.String("originalDatabase", databaseName), slog.String("pitrDatabase", pitrDatabaseName), log.BBError(err))
+		slog.ErrorContext(ctx, "Failed to swap the original and PITR database", slog.String("originalDatabase", databaseName), slog.String("pitrDatabase", pitrDatabaseName), log.BBError(err))
 		return errors.Wrap(err, "failed to swap the original and PITR database")
 	}
-	slog.Debug("Finished swapping the original and PITR database", slog.String("originalDatabase", databaseName), slog.String("pitrDatabase", pitrDatabaseName), slog.String("oldDatabase", pitrOldDatabaseName))
+	slog.DebugContext(ctx, "Finished swapping the original and PITR database", slog.String("originalDatabase", databaseName), slog.String("pitrDatabase", pitrDatabaseName), slog.String("oldDatabase", pitrOldDatabaseName))
 	return nil
 }
 
@@ -272,7 +272,7 @@ func (exec *PITRCutoverExecutor) pitrCutoverPostgres(ctx context.Context, instan
 		if _, err := db.ExecContext(ctx, fmt.Sprintf("ALTER DATABASE %s RENAME TO %s;", databaseName, pitrOldDatabaseName)); err != nil {
 			return errors.Wrapf(err, "failed to rename database %q to %q", databaseName, pitrOldDatabaseName)
 		}
-		slog.Debug("Successfully renamed database", slog.String("from", databaseName), slog.String("to", pitrOldDatabaseName))
+		slog.DebugContext(ctx, "Successfully renamed database", slog.String("from", databaseName), slog.String("to", pitrOldDatabaseName))
 	}
 
 	// The _pitr database may not exist.
@@ -294,7 +294,7 @@ func (exec *PITRCutoverExecutor) pitrCutoverPostgres(ctx context.Context, instan
 		if _, err := db.ExecContext(ctx, fmt.Sprintf("ALTER DATABASE %s RENAME TO %s;", pitrDatabaseName, databaseName)); err != nil {
 			return errors.Wrapf(err, "failed to rename database %q to %q", pitrDatabaseName, databaseName)
 		}
-		slog.Debug("Successfully renamed database", slog.String("from", pitrDatabaseName), slog.String("to", databaseName))
+		slog.DebugContext(ctx, "Successfully renamed database", slog.String("from", pitrDatabaseName), slog.String("to", databaseName))
 	}
 
 	return nil
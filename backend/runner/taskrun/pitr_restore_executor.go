@@ -63,7 +63,7 @@ func (exec *PITRRestoreExecutor) RunOnce(ctx context.Context, _ context.Context,
 			UpdateTime:      time.Now(),
 		})
 
-	slog.Info("Run PITR restore task", slog.String("task", task.Name))
+	slog.InfoContext(ctx, "Run PITR restore task", slog.String("task", task.Name))
 
 	payload := api.TaskDatabasePITRRestorePayload{}
 	if err := json.Unmarshal([]byte(task.Payload), &payload); err != nil {
@@ -141,7 +141,7 @@ func (exec *PITRRestoreExecutor) doBackupRestore(ctx context.Context, stores *st
 	if targetDatabase == nil {
 		return nil, errors.Wrapf(err, "target database %q not found in instance %q", *payload.DatabaseName, instance.Title)
 	}
-	slog.Debug("Start database restore from backup...",
+	slog.DebugContext(ctx, "Start database restore from backup...",
 		slog.String("source_instance", sourceDatabase.InstanceID),
 		slog.String("source_database", sourceDatabase.DatabaseName),
 		slog.String("target_instance", targetInstance.ResourceID),
@@ -174,7 +174,7 @@ func (exec *PITRRestoreExecutor) doBackupRestore(ctx context.Context, stores *st
 
 	// Sync database schema after restore is completed.
 	if err := schemaSyncer.SyncDatabaseSchema(ctx, targetDatabase, true /* force */); err != nil {
-		slog.Error("failed to sync database schema",
+		slog.ErrorContext(ctx, "failed to sync database schema",
 			slog.String("instanceName", targetDatabase.InstanceID),
 			slog.String("databaseName", targetDatabase.DatabaseName),
 			log.BBError(err),
@@ -183,7 +183,7 @@ func (exec *PITRRestoreExecutor) doBackupRestore(ctx context.Context, stores *st
 
 	storedVersion, err := version.Marshal()
 	if err != nil {
-		slog.Error("failed to convert database schema version",
+		slog.ErrorContext(ctx, "failed to convert database schema version",
 			slog.String("version", version.Version),
 			log.BBError(err),
 		)
@@ -238,26 +238,26 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 	if err != nil {
 		return nil, err
 	}
-	slog.Debug("Found backup list", "backups", store.SLogBackupArray(backupList))
+	slog.DebugContext(ctx, "Found backup list", "backups", store.SLogBackupArray(backupList))
 
 	mysqlSourceDriver, sourceOk := sourceDriver.(*mysql.Driver)
 	mysqlTargetDriver, targetOk := targetDriver.(*mysql.Driver)
 	if (!sourceOk) || (!targetOk) {
-		slog.Error("Failed to cast driver to mysql.Driver")
+		slog.ErrorContext(ctx, "Failed to cast driver to mysql.Driver")
 		return nil, errors.Errorf("[internal] cast driver to mysql.Driver failed")
 	}
 
-	slog.Debug("Downloading all binlog files")
+	slog.DebugContext(ctx, "Downloading all binlog files")
 	if err := mysqlSourceDriver.FetchAllBinlogFiles(ctx, true /* downloadLatestBinlogFile */, s3Client); err != nil {
 		return nil, err
 	}
 
 	targetTs := *payload.PointInTimeTs
-	slog.Debug("Getting latest backup before or equal to targetTs", slog.Int64("targetTs", targetTs))
+	slog.DebugContext(ctx, "Getting latest backup before or equal to targetTs", slog.Int64("targetTs", targetTs))
 	backup, targetBinlogInfo, err := mysqlSourceDriver.GetLatestBackupBeforeOrEqualTs(ctx, backupList, targetTs, s3Client)
 	if err != nil {
 		targetTsHuman := time.Unix(targetTs, 0).Format(time.RFC822)
-		slog.Error("Failed to get backup before or equal to time",
+		slog.ErrorContext(ctx, "Failed to get backup before or equal to time",
 			slog.Int64("targetTs", targetTs),
 			slog.String("targetTsHuman", targetTsHuman),
 			log.BBError(err))
@@ -265,7 +265,7 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 	}
 	startBinlogInfo := backup.Payload.BinlogInfo
 	binlogDir := common.GetBinlogAbsDir(profile.DataDir, instance.UID)
-	slog.Debug("Got latest backup before or equal to targetTs", slog.String("backup", backup.Name))
+	slog.DebugContext(ctx, "Got latest backup before or equal to targetTs", slog.String("backup", backup.Name))
 
 	backupAbsPathLocal := backuprun.GetBackupAbsFilePath(profile.DataDir, backup.DatabaseUID, backup.Name)
 	if backup.StorageBackend == api.BackupStorageBackendS3 {
@@ -280,7 +280,7 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 		defer func() {
 			for _, binlogPath := range replayBinlogPathList {
 				if err := os.Remove(binlogPath); err != nil {
-					slog.Warn("Failed to remove downloaded local binlog file after PITR", slog.String("path", binlogPath))
+					slog.WarnContext(ctx, "Failed to remove downloaded local binlog file after PITR", slog.String("path", binlogPath))
 				}
 			}
 		}()
@@ -291,9 +291,9 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 		return nil, errors.Wrapf(err, "failed to open backup file %q", backupAbsPathLocal)
 	}
 	defer backupFile.Close()
-	slog.Debug("Successfully opened backup file", slog.String("filename", backupAbsPathLocal))
+	slog.DebugContext(ctx, "Successfully opened backup file", slog.String("filename", backupAbsPathLocal))
 
-	slog.Debug("Start creating and restoring PITR database",
+	slog.DebugContext(ctx, "Start creating and restoring PITR database",
 		slog.String("instance", instance.ResourceID),
 		slog.String("database", database.DatabaseName),
 	)
@@ -305,14 +305,14 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 	if payload.DatabaseName != nil {
 		// case 1: PITR to a new database.
 		if err := mysqlTargetDriver.RestoreBackupToDatabase(ctx, backupFile, *payload.DatabaseName); err != nil {
-			slog.Error("failed to restore full backup in the new database",
+			slog.ErrorContext(ctx, "failed to restore full backup in the new database",
 				slog.Int("issueID", issue.UID),
 				slog.String("databaseName", *payload.DatabaseName),
 				log.BBError(err))
 			return nil, errors.Wrap(err, "failed to restore full backup in the new database")
 		}
 		if err := mysqlTargetDriver.ReplayBinlogToDatabase(ctx, database.DatabaseName, *payload.DatabaseName, startBinlogInfo, *targetBinlogInfo, targetTs, mysqlSourceDriver.GetBinlogDir()); err != nil {
-			slog.Error("failed to perform a PITR restore in the new database",
+			slog.ErrorContext(ctx, "failed to perform a PITR restore in the new database",
 				slog.Int("issueID", issue.UID),
 				slog.String("databaseName", *payload.DatabaseName),
 				log.BBError(err))
@@ -321,14 +321,14 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 	} else {
 		// case 2: in-place PITR.
 		if err := mysqlTargetDriver.RestoreBackupToPITRDatabase(ctx, backupFile, database.DatabaseName, issue.CreatedTime.Unix()); err != nil {
-			slog.Error("failed to restore full backup in the PITR database",
+			slog.ErrorContext(ctx, "failed to restore full backup in the PITR database",
 				slog.Int("issueID", issue.UID),
 				slog.String("databaseName", database.DatabaseName),
 				log.BBError(err))
 			return nil, errors.Wrap(err, "failed to perform a backup restore in the PITR database")
 		}
 		if err := mysqlTargetDriver.ReplayBinlogToPITRDatabase(ctx, database.DatabaseName, startBinlogInfo, *targetBinlogInfo, issue.CreatedTime.Unix(), targetTs); err != nil {
-			slog.Error("failed to perform a PITR restore in the PITR database",
+			slog.ErrorContext(ctx, "failed to perform a PITR restore in the PITR database",
 				slog.Int("issueID", issue.UID),
 				slog.String("databaseName", database.DatabaseName),
 				log.BBError(err))
@@ -340,7 +340,7 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 	if payload.DatabaseName != nil {
 		targetDatabaseName = *payload.DatabaseName
 	}
-	slog.Info("PITR restore success", slog.String("target database", targetDatabaseName))
+	slog.InfoContext(ctx, "PITR restore success", slog.String("target database", targetDatabaseName))
 	return &api.TaskRunResultPayload{
 		Detail: fmt.Sprintf("PITR restore success for target database %q", targetDatabaseName),
 	}, nil
@@ -396,7 +396,7 @@ func (*PITRRestoreExecutor) doRestoreInPlacePostgres(ctx context.Context, stores
 
 	pgDriver, ok := driver.(*pg.Driver)
 	if !ok {
-		slog.Error("Failed to cast driver to pg.Driver")
+		slog.ErrorContext(ctx, "Failed to cast driver to pg.Driver")
 		return nil, errors.Errorf("[internal] cast driver to pg.Driver failed")
 	}
 	originalOwner, err := pgDriver.GetCurrentDatabaseOwner()
@@ -508,7 +508,7 @@ func (*PITRRestoreExecutor) restoreDatabase(ctx context.Context, dbFactory *dbfa
 }
 
 func downloadBackupFileFromCloud(ctx context.Context, s3Client *bbs3.Client, backupPath, backupAbsPathLocal string) error {
-	slog.Debug("Downloading backup file from s3 bucket.", slog.String("path", backupPath))
+	slog.DebugContext(ctx, "Downloading backup file from s3 bucket.", slog.String("path", backupPath))
 	backupFileDownload, err := os.Create(backupAbsPathLocal)
 	if err != nil {
 		return errors.Wrapf(err, "failed to create local backup file %q for downloading from s3 bucket", backupAbsPathLocal)
@@ -517,7 +517,7 @@ func downloadBackupFileFromCloud(ctx context.Context, s3Client *bbs3.Client, bac
 	if _, err := s3Client.DownloadObject(ctx, backupPath, backupFileDownload); err != nil {
 		return errors.Wrapf(err, "failed to download backup file %q from s3 bucket", backupPath)
 	}
-	slog.Debug("Successfully downloaded backup file from s3 bucket.")
+	slog.DebugContext(ctx, "Successfully downloaded backup file from s3 bucket.")
 	return nil
 }
 
@@ -0,0 +1,48 @@
+package taskrun
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// getIssuePriorityRank returns the scheduling rank of the issue priority, the higher rank is scheduled first.
+// The unspecified priority is ranked as NORMAL.
+func getIssuePriorityRank(priority storepb.IssuePayload_Priority) int {
+	if priority == storepb.IssuePayload_PRIORITY_UNSPECIFIED {
+		return int(storepb.IssuePayload_NORMAL)
+	}
+	return int(priority)
+}
+
+// getPipelinePriorityRanks returns the priority ranks of the issues of the task run pipelines.
+func (s *SchedulerV2) getPipelinePriorityRanks(ctx context.Context, taskRuns []*store.TaskRunMessage) map[int]int {
+	ranks := map[int]int{}
+	for _, taskRun := range taskRuns {
+		if _, ok := ranks[taskRun.PipelineUID]; ok {
+			continue
+		}
+		rank := getIssuePriorityRank(storepb.IssuePayload_PRIORITY_UNSPECIFIED)
+		issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &taskRun.PipelineUID})
+		if err != nil {
+			slog.Error("failed to get issue", slog.Int("pipeline", taskRun.PipelineUID), log.BBError(err))
+		} else if issue != nil {
+			rank = getIssuePriorityRank(issue.Payload.GetPriority())
+		}
+		ranks[taskRun.PipelineUID] = rank
+	}
+	return ranks
+}
+
+// sortTaskRunsByPriority sorts the task runs by the priority ranks of their pipelines in descending order.
+// The task runs of the same priority keep their order, i.e. the creation order.
+func sortTaskRunsByPriority(taskRuns []*store.TaskRunMessage, ranks map[int]int) {
+	slices.SortStableFunc(taskRuns, func(a, b *store.TaskRunMessage) int {
+		return cmp.Compare(ranks[b.PipelineUID], ranks[a.PipelineUID])
+	})
+}
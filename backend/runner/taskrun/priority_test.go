@@ -0,0 +1,34 @@
+package taskrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestSortTaskRunsByPriority(t *testing.T) {
+	a := require.New(t)
+
+	taskRuns := []*store.TaskRunMessage{
+		{ID: 1, PipelineUID: 10},
+		{ID: 2, PipelineUID: 20},
+		{ID: 3, PipelineUID: 10},
+		{ID: 4, PipelineUID: 30},
+		{ID: 5, PipelineUID: 20},
+	}
+	ranks := map[int]int{
+		10: getIssuePriorityRank(storepb.IssuePayload_PRIORITY_UNSPECIFIED),
+		20: getIssuePriorityRank(storepb.IssuePayload_HIGH),
+		30: getIssuePriorityRank(storepb.IssuePayload_LOW),
+	}
+	sortTaskRunsByPriority(taskRuns, ranks)
+
+	var ids []int
+	for _, taskRun := range taskRuns {
+		ids = append(ids, taskRun.ID)
+	}
+	a.Equal([]int{2, 5, 1, 3, 4}, ids)
+}
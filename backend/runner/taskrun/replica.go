@@ -0,0 +1,133 @@
+package taskrun
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// claimTaskRun claims the running task run for the replica, and returns whether the replica may execute it.
+// The task run claimed by another live replica is skipped, and the one orphaned by a stopped replica is taken over.
+// The replica IDs are generated on startup, so the task runs orphaned by a restarted server are taken over as well.
+// The orphaned task run may have applied part of its statements, so it's marked FAILED for a manual retry unless it can be rerun.
+func (s *SchedulerV2) claimTaskRun(ctx context.Context, taskRun *store.TaskRunMessage, task *store.TaskMessage) bool {
+	claimed, previousReplicaID, err := s.store.ClaimTaskRun(ctx, taskRun.ID, s.replicaID, taskRunHeartbeatTimeout)
+	if err != nil {
+		slog.Error("failed to claim task run", slog.Int("task run", taskRun.ID), log.BBError(err))
+		return false
+	}
+	if !claimed {
+		return false
+	}
+	if previousReplicaID != "" && previousReplicaID != s.replicaID {
+		rerun, err := canRerunOrphanedTaskRun(task)
+		if err != nil {
+			slog.Error("failed to check whether the orphaned task run can be rerun", slog.Int("task run", taskRun.ID), log.BBError(err))
+		}
+		if !rerun {
+			s.failOrphanedTaskRun(ctx, taskRun, task, previousReplicaID)
+			return false
+		}
+		slog.Warn("take over orphaned task run",
+			slog.Int("task run", taskRun.ID),
+			slog.String("replica", s.replicaID),
+			slog.String("previous replica", previousReplicaID),
+		)
+		appendTaskRunLog(ctx, s.store, taskRun.ID, store.TaskRunLogWarn, fmt.Sprintf("The task run is taken over by replica %s because replica %s stopped heartbeating.", s.replicaID, previousReplicaID))
+	}
+	return true
+}
+
+// canRerunOrphanedTaskRun returns whether the orphaned task run can be executed again from the start,
+// the same as the task runs retried automatically, e.g. the migrations running in a single transaction or resumable.
+func canRerunOrphanedTaskRun(task *store.TaskMessage) (bool, error) {
+	_, ok, err := getRetryPolicy(task)
+	if err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+// failOrphanedTaskRun marks the orphaned task run claimed by the replica FAILED, so that it's retried manually after checking the database.
+func (s *SchedulerV2) failOrphanedTaskRun(ctx context.Context, taskRun *store.TaskRunMessage, task *store.TaskMessage, previousReplicaID string) {
+	detail := fmt.Sprintf("The task run is interrupted because replica %s stopped heartbeating, it may have been partially applied. Check the database before retrying it.", previousReplicaID)
+	slog.Warn("fail orphaned task run",
+		slog.Int("task run", taskRun.ID),
+		slog.String("replica", s.replicaID),
+		slog.String("previous replica", previousReplicaID),
+	)
+	appendTaskRunLog(ctx, s.store, taskRun.ID, store.TaskRunLogError, detail)
+	resultBytes, err := protojson.Marshal(&storepb.TaskRunResult{
+		Detail: detail,
+	})
+	if err != nil {
+		slog.Error("Failed to marshal task run result", slog.Int("task run", taskRun.ID), log.BBError(err))
+		return
+	}
+	code := common.Internal
+	result := string(resultBytes)
+	taskRunStatusPatch := &store.TaskRunStatusPatch{
+		ID:        taskRun.ID,
+		UpdaterID: api.SystemBotID,
+		Status:    api.TaskRunFailed,
+		Code:      &code,
+		Result:    &result,
+		ReplicaID: &s.replicaID,
+	}
+	activities, err := s.newTaskRunStatusUpdateActivities(ctx, task, api.TaskRunFailed)
+	if err != nil {
+		slog.Error("failed to create activity for task run status update", log.BBError(err))
+	}
+	taskRunStatusPatch.Activities = activities
+	if _, err := s.store.UpdateTaskRunStatus(ctx, taskRunStatusPatch); err != nil {
+		slog.Error("Failed to mark orphaned task run as FAILED", slog.Int("task run", taskRun.ID), log.BBError(err))
+		return
+	}
+	s.activityManager.Notify()
+	s.cancelDependentTaskRuns(ctx, task, api.TaskRunFailed)
+}
+
+// heartbeatTaskRuns refreshes the heartbeat of the task runs claimed by the replica periodically.
+func (s *SchedulerV2) heartbeatTaskRuns(ctx context.Context) {
+	ticker := time.NewTicker(taskRunHeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.heartbeatTaskRunsOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// heartbeatTaskRunsOnce refreshes the heartbeat of the task runs claimed by the replica.
+// The task runs executing locally but no longer claimed, i.e. canceled on another replica or taken over, are canceled.
+func (s *SchedulerV2) heartbeatTaskRunsOnce(ctx context.Context) {
+	ids, err := s.store.HeartbeatTaskRuns(ctx, s.replicaID)
+	if err != nil {
+		slog.Error("failed to heartbeat task runs", log.BBError(err))
+		return
+	}
+	claimed := map[int]bool{}
+	for _, id := range ids {
+		claimed[id] = true
+	}
+	s.stateCfg.RunningTaskRunsCancelFunc.Range(func(key, value any) bool {
+		taskRunID := key.(int)
+		if !claimed[taskRunID] {
+			slog.Warn("cancel task run no longer claimed by the replica", slog.Int("task run", taskRunID), slog.String("replica", s.replicaID))
+			value.(context.CancelFunc)()
+		}
+		return true
+	})
+}
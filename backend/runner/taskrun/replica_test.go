@@ -0,0 +1,35 @@
+package taskrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestCanRerunOrphanedTaskRun(t *testing.T) {
+	a := require.New(t)
+
+	tests := []struct {
+		task *store.TaskMessage
+		want bool
+	}{
+		// The migration may have committed some statements before its replica stopped.
+		{task: &store.TaskMessage{Type: api.TaskDatabaseSchemaUpdate, Payload: `{"sheetId":101}`}, want: false},
+		{task: &store.TaskMessage{Type: api.TaskDatabaseDataUpdate, Payload: `{"sheetId":101}`}, want: false},
+		{task: &store.TaskMessage{Type: api.TaskDatabaseSchemaUpdate, Payload: `{"sheetId":101,"transactional":true}`}, want: true},
+		{task: &store.TaskMessage{Type: api.TaskDatabaseDataUpdate, Payload: `{"sheetId":101,"resumable":true}`}, want: true},
+		{task: &store.TaskMessage{Type: api.TaskDatabaseCreate, Payload: "{}"}, want: true},
+		{task: &store.TaskMessage{Type: api.TaskDatabaseRestorePITRRestore, Payload: "{}"}, want: false},
+	}
+
+	for _, test := range tests {
+		got, err := canRerunOrphanedTaskRun(test.task)
+		a.NoError(err)
+		a.Equal(test.want, got, test.task.Type)
+	}
+	_, err := canRerunOrphanedTaskRun(&store.TaskMessage{Type: api.TaskDatabaseSchemaUpdate, Payload: "invalid"})
+	a.Error(err)
+}
@@ -0,0 +1,88 @@
+package taskrun
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// resumableMigrationExecutor executes the statements of the migration one by one.
+// The checksum of each committed statement is saved on the change history, so that the next task run
+// skips the statements already applied instead of failing on them, e.g. "table already exists".
+type resumableMigrationExecutor struct {
+	stores     *store.Store
+	driver     db.Driver
+	engine     storepb.Engine
+	mi         *db.MigrationInfo
+	taskRunUID int
+}
+
+func (r *resumableMigrationExecutor) execute(ctx context.Context, execCtx context.Context, statement string) error {
+	list, err := base.SplitMultiSQL(r.engine, statement)
+	if err != nil {
+		return errors.Wrapf(err, "failed to split the statement for the resumable migration")
+	}
+	list = base.FilterEmptySQL(list)
+
+	changeHistory, err := r.stores.GetInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+		InstanceID: r.mi.InstanceID,
+		DatabaseID: r.mi.DatabaseID,
+		Version:    &r.mi.Version,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get change history")
+	}
+	if changeHistory == nil {
+		return errors.Errorf("change history of version %s not found", r.mi.Version.Version)
+	}
+	checksums := changeHistory.Payload.GetStatementCheckpoint().GetStatementSha256S()
+	applied, err := getResumableStatementIndex(list, checksums)
+	if err != nil {
+		return err
+	}
+	if applied > 0 {
+		appendTaskRunLog(ctx, r.stores, r.taskRunUID, store.TaskRunLogInfo, fmt.Sprintf("Resumed the migration from statement %d of %d, skipping %d applied statements.", applied+1, len(list), applied))
+	}
+
+	payload := &storepb.InstanceChangeHistoryPayload{}
+	if changeHistory.Payload != nil {
+		payload = proto.Clone(changeHistory.Payload).(*storepb.InstanceChangeHistoryPayload)
+	}
+	checkpoint := &storepb.StatementCheckpoint{StatementSha256S: checksums[:applied]}
+	payload.StatementCheckpoint = checkpoint
+	for i := applied; i < len(list); i++ {
+		if _, err := r.driver.Execute(execCtx, list[i].Text, db.ExecuteOptions{}); err != nil {
+			return errors.Wrapf(err, "failed to execute statement %d of %d at line %d", i+1, len(list), list[i].FirstStatementLine+1)
+		}
+		checkpoint.StatementSha256S = append(checkpoint.StatementSha256S, common.SHA256Hex(list[i].Text))
+		if err := r.stores.UpdateInstanceChangeHistory(ctx, &store.UpdateInstanceChangeHistoryMessage{
+			ID:      changeHistory.UID,
+			Payload: payload,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to save the statement checkpoint")
+		}
+	}
+	return nil
+}
+
+// getResumableStatementIndex returns the index of the first statement to execute.
+// The statements already applied must match the checksums of the checkpoint, otherwise the migration can't be resumed.
+func getResumableStatementIndex(list []base.SingleSQL, checksums []string) (int, error) {
+	if len(checksums) > len(list) {
+		return 0, errors.Errorf("the migration has %d statements but %d statements have been applied, the statement has been changed since the last attempt", len(list), len(checksums))
+	}
+	for i, checksum := range checksums {
+		if common.SHA256Hex(list[i].Text) != checksum {
+			return 0, errors.Errorf("statement %d doesn't match the applied one, the statement has been changed since the last attempt", i+1)
+		}
+	}
+	return len(checksums), nil
+}
@@ -0,0 +1,34 @@
+package taskrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetResumableStatementIndex(t *testing.T) {
+	a := require.New(t)
+
+	list, err := base.SplitMultiSQL(storepb.Engine_POSTGRES, "CREATE TABLE t1 (id INT);\nCREATE TABLE t2 (id INT);\nCREATE TABLE t3 (id INT);")
+	a.NoError(err)
+	list = base.FilterEmptySQL(list)
+	a.Len(list, 3)
+
+	index, err := getResumableStatementIndex(list, nil)
+	a.NoError(err)
+	a.Equal(0, index)
+
+	index, err = getResumableStatementIndex(list, []string{common.SHA256Hex(list[0].Text), common.SHA256Hex(list[1].Text)})
+	a.NoError(err)
+	a.Equal(2, index)
+
+	_, err = getResumableStatementIndex(list, []string{common.SHA256Hex(list[1].Text)})
+	a.Error(err)
+
+	_, err = getResumableStatementIndex(list[:1], []string{common.SHA256Hex(list[0].Text), common.SHA256Hex(list[1].Text)})
+	a.Error(err)
+}
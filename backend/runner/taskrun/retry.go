@@ -0,0 +1,176 @@
+package taskrun
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// retryPolicy is the policy to retry the task runs failed with transient errors automatically.
+type retryPolicy struct {
+	// maxAttempts is the maximum number of attempts, including the first one.
+	maxAttempts int
+	// backoff is the delay before the first retry, doubled on every retry up to maxBackoff.
+	backoff    time.Duration
+	maxBackoff time.Duration
+}
+
+// retryPolicies are the retry policies of the task types.
+// The task types not listed here are never retried automatically,
+// and the migrations are only retried if the retry doesn't apply the statements committed by the failed attempt again.
+var retryPolicies = map[api.TaskType]retryPolicy{
+	api.TaskDatabaseCreate:          {maxAttempts: 3, backoff: 10 * time.Second, maxBackoff: time.Minute},
+	api.TaskDatabaseSchemaBaseline:  {maxAttempts: 3, backoff: 10 * time.Second, maxBackoff: time.Minute},
+	api.TaskDatabaseSchemaUpdate:    {maxAttempts: 3, backoff: 30 * time.Second, maxBackoff: 5 * time.Minute},
+	api.TaskDatabaseSchemaUpdateSDL: {maxAttempts: 3, backoff: 30 * time.Second, maxBackoff: 5 * time.Minute},
+	api.TaskDatabaseDataUpdate:      {maxAttempts: 3, backoff: 30 * time.Second, maxBackoff: 5 * time.Minute},
+	api.TaskDatabaseBackup:          {maxAttempts: 3, backoff: time.Minute, maxBackoff: 10 * time.Minute},
+}
+
+// getRetryPolicy returns the retry policy of the task, or false if the task is never retried automatically.
+// A migration re-runs the whole sheet on retry, so it's only retried if it runs in a single transaction,
+// or it's resumable and skips the statements applied by the failed attempt.
+func getRetryPolicy(task *store.TaskMessage) (retryPolicy, bool, error) {
+	policy, ok := retryPolicies[task.Type]
+	if !ok {
+		return retryPolicy{}, false, nil
+	}
+	switch task.Type {
+	case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseSchemaUpdateSDL, api.TaskDatabaseDataUpdate:
+		payload := struct {
+			Transactional bool `json:"transactional"`
+			Resumable     bool `json:"resumable"`
+		}{}
+		if err := json.Unmarshal([]byte(task.Payload), &payload); err != nil {
+			return retryPolicy{}, false, errors.Wrapf(err, "failed to unmarshal task payload")
+		}
+		if !payload.Transactional && !payload.Resumable {
+			return retryPolicy{}, false, nil
+		}
+	}
+	return policy, true, nil
+}
+
+// getBackoff returns the delay before the retry after the given number of failed attempts.
+func (p retryPolicy) getBackoff(failedAttempts int) time.Duration {
+	backoff := p.backoff
+	for i := 1; i < failedAttempts && backoff < p.maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, p.maxBackoff)
+}
+
+// transientErrorMessages are the messages of the transient errors whose types are lost in wrapping.
+var transientErrorMessages = []string{
+	"deadlock found when trying to get lock",
+	"lock wait timeout exceeded",
+	"deadlock detected",
+	"could not serialize access",
+	"connection reset by peer",
+	"broken pipe",
+	"bad connection",
+}
+
+// isTransientError returns whether the error is transient so the task run may succeed on retry,
+// such as the deadlocks, the lock wait timeouts and the connection resets.
+func isTransientError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		// ER_LOCK_WAIT_TIMEOUT, ER_LOCK_DEADLOCK.
+		case 1205, 1213:
+			return true
+		}
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		// serialization_failure, deadlock_detected, lock_not_available.
+		case "40001", "40P01", "55P03":
+			return true
+		}
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) || errors.Is(err, driver.ErrBadConn) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	message := strings.ToLower(err.Error())
+	for _, m := range transientErrorMessages {
+		if strings.Contains(message, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// getTransientFailedAttempts returns the number of the latest consecutive task runs failed with transient errors.
+// The task runs are sorted in the creation order.
+func getTransientFailedAttempts(taskRuns []*store.TaskRunMessage) int {
+	count := 0
+	for i := len(taskRuns) - 1; i >= 0; i-- {
+		if taskRuns[i].Status != api.TaskRunFailed || taskRuns[i].Code != common.TaskTransientError {
+			break
+		}
+		count++
+	}
+	return count
+}
+
+// retryTaskRun creates the next attempt of the task failed with a transient error, unless the retry policy of the task type is exhausted.
+func (s *SchedulerV2) retryTaskRun(ctx context.Context, task *store.TaskMessage) (bool, error) {
+	policy, ok, err := getRetryPolicy(task)
+	if err != nil || !ok {
+		return false, err
+	}
+	taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{TaskUID: &task.ID})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list task runs")
+	}
+	failedAttempts := getTransientFailedAttempts(taskRuns)
+	if failedAttempts >= policy.maxAttempts {
+		return false, nil
+	}
+	if err := s.store.CreatePendingTaskRuns(ctx, &store.TaskRunMessage{
+		CreatorID: api.SystemBotID,
+		TaskUID:   task.ID,
+		Name:      fmt.Sprintf("%s %d", task.Name, time.Now().Unix()),
+	}); err != nil {
+		return false, errors.Wrapf(err, "failed to create pending task run")
+	}
+	if len(taskRuns) > 0 {
+		appendTaskRunLog(ctx, s.store, taskRuns[len(taskRuns)-1].ID, store.TaskRunLogWarn, fmt.Sprintf("The error is transient, retry in %v (attempt %d of %d)", policy.getBackoff(failedAttempts), failedAttempts+1, policy.maxAttempts))
+	}
+	return true, nil
+}
+
+// getTaskRetryBackoff returns the backoff of the next attempt of the task, or zero if the latest task run didn't fail with a transient error.
+func (s *SchedulerV2) getTaskRetryBackoff(ctx context.Context, task *store.TaskMessage) (time.Duration, error) {
+	policy, ok, err := getRetryPolicy(task)
+	if err != nil || !ok {
+		return 0, err
+	}
+	taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
+		TaskUID: &task.ID,
+		Status:  &[]api.TaskRunStatus{api.TaskRunDone, api.TaskRunFailed, api.TaskRunCanceled},
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to list task runs")
+	}
+	failedAttempts := getTransientFailedAttempts(taskRuns)
+	if failedAttempts == 0 {
+		return 0, nil
+	}
+	return policy.getBackoff(failedAttempts), nil
+}
@@ -0,0 +1,82 @@
+package taskrun
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestIsTransientError(t *testing.T) {
+	a := require.New(t)
+
+	a.True(isTransientError(errors.Wrap(&mysql.MySQLError{Number: 1213, Message: "Deadlock"}, "failed to execute")))
+	a.True(isTransientError(errors.Wrap(&mysql.MySQLError{Number: 1205, Message: "Lock wait timeout"}, "failed to execute")))
+	a.False(isTransientError(&mysql.MySQLError{Number: 1064, Message: "You have an error in your SQL syntax"}))
+	a.True(isTransientError(errors.Wrap(&pgconn.PgError{Code: "40P01"}, "failed to execute")))
+	a.False(isTransientError(&pgconn.PgError{Code: "42P01"}))
+	a.True(isTransientError(errors.Wrap(driver.ErrBadConn, "failed to execute")))
+	a.True(isTransientError(errors.New("read tcp 10.0.0.1:5432: connection reset by peer")))
+	a.False(isTransientError(errors.New("relation \"t\" does not exist")))
+}
+
+func TestGetTransientFailedAttempts(t *testing.T) {
+	a := require.New(t)
+
+	a.Equal(0, getTransientFailedAttempts(nil))
+	taskRuns := []*store.TaskRunMessage{
+		{Status: api.TaskRunFailed, Code: common.TaskTransientError},
+		{Status: api.TaskRunFailed, Code: common.DbExecutionError},
+		{Status: api.TaskRunFailed, Code: common.TaskTransientError},
+		{Status: api.TaskRunFailed, Code: common.TaskTransientError},
+	}
+	a.Equal(2, getTransientFailedAttempts(taskRuns))
+	taskRuns = append(taskRuns, &store.TaskRunMessage{Status: api.TaskRunDone})
+	a.Equal(0, getTransientFailedAttempts(taskRuns))
+}
+
+func TestRetryPolicyGetBackoff(t *testing.T) {
+	a := require.New(t)
+	policy := retryPolicy{maxAttempts: 5, backoff: 10 * time.Second, maxBackoff: 30 * time.Second}
+
+	a.Equal(10*time.Second, policy.getBackoff(1))
+	a.Equal(20*time.Second, policy.getBackoff(2))
+	a.Equal(30*time.Second, policy.getBackoff(3))
+	a.Equal(30*time.Second, policy.getBackoff(4))
+}
+
+func TestGetRetryPolicy(t *testing.T) {
+	a := require.New(t)
+
+	// The non-resumable migrations may have committed some statements before failing, so they are not retried.
+	for _, taskType := range []api.TaskType{api.TaskDatabaseSchemaUpdate, api.TaskDatabaseSchemaUpdateSDL, api.TaskDatabaseDataUpdate} {
+		_, ok, err := getRetryPolicy(&store.TaskMessage{Type: taskType, Payload: `{"sheetId":101}`})
+		a.NoError(err)
+		a.False(ok)
+	}
+	policy, ok, err := getRetryPolicy(&store.TaskMessage{Type: api.TaskDatabaseSchemaUpdate, Payload: `{"sheetId":101,"transactional":true}`})
+	a.NoError(err)
+	a.True(ok)
+	a.Equal(retryPolicies[api.TaskDatabaseSchemaUpdate], policy)
+	_, ok, err = getRetryPolicy(&store.TaskMessage{Type: api.TaskDatabaseDataUpdate, Payload: `{"sheetId":101,"resumable":true}`})
+	a.NoError(err)
+	a.True(ok)
+
+	// The idempotent tasks are retried.
+	for _, taskType := range []api.TaskType{api.TaskDatabaseCreate, api.TaskDatabaseSchemaBaseline, api.TaskDatabaseBackup} {
+		_, ok, err := getRetryPolicy(&store.TaskMessage{Type: taskType, Payload: "{}"})
+		a.NoError(err)
+		a.True(ok)
+	}
+	_, ok, err = getRetryPolicy(&store.TaskMessage{Type: api.TaskDatabaseDataProvision, Payload: "{}"})
+	a.NoError(err)
+	a.False(ok)
+}
@@ -6,14 +6,20 @@ import (
 	"fmt"
 	"log/slog"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/common/tracing"
 	"github.com/bytebase/bytebase/backend/component/activity"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/ghost"
 	"github.com/bytebase/bytebase/backend/component/state"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -24,23 +30,36 @@ import (
 
 const (
 	taskSchedulerInterval = 5 * time.Second
+	// taskRunHeartbeatInterval is the interval for the replica to refresh the heartbeat of its running task runs.
+	taskRunHeartbeatInterval = 10 * time.Second
+	// taskRunHeartbeatTimeout is the duration without heartbeat after which a running task run is orphaned and taken over by another replica.
+	taskRunHeartbeatTimeout = time.Minute
 )
 
 // SchedulerV2 is the V2 scheduler for task run.
+// Multiple server replicas may run the scheduler against the same metadata database.
+// A running task run is executed by the replica which claims it, and the replica keeps the claim by heartbeating.
 type SchedulerV2 struct {
 	store           *store.Store
 	stateCfg        *state.State
 	activityManager *activity.Manager
+	dbFactory       *dbfactory.DBFactory
 	executorMap     map[api.TaskType]Executor
+	// replicaID identifies the server replica in the task run claims.
+	replicaID string
+	// lastRunTs is the unix timestamp of the latest scheduling round, for the liveness probe.
+	lastRunTs atomic.Int64
 }
 
 // NewSchedulerV2 will create a new scheduler.
-func NewSchedulerV2(store *store.Store, stateCfg *state.State, activityManager *activity.Manager) *SchedulerV2 {
+func NewSchedulerV2(store *store.Store, stateCfg *state.State, activityManager *activity.Manager, dbFactory *dbfactory.DBFactory) *SchedulerV2 {
 	return &SchedulerV2{
 		store:           store,
 		stateCfg:        stateCfg,
 		activityManager: activityManager,
+		dbFactory:       dbFactory,
 		executorMap:     map[api.TaskType]Executor{},
+		replicaID:       uuid.NewString(),
 	}
 }
 
@@ -58,11 +77,13 @@ func (s *SchedulerV2) Register(taskType api.TaskType, executorGetter Executor) {
 // Run will start the scheduler.
 func (s *SchedulerV2) Run(ctx context.Context, wg *sync.WaitGroup) {
 	go s.ListenTaskSkippedOrDone(ctx)
+	go s.heartbeatTaskRuns(ctx)
 
 	ticker := time.NewTicker(taskSchedulerInterval)
 	defer ticker.Stop()
 	defer wg.Done()
 	slog.Debug(fmt.Sprintf("Task scheduler V2 started and will run every %v", taskSchedulerInterval))
+	s.lastRunTs.Store(time.Now().Unix())
 	for {
 		select {
 		case <-ticker.C:
@@ -75,7 +96,17 @@ func (s *SchedulerV2) Run(ctx context.Context, wg *sync.WaitGroup) {
 	}
 }
 
+// LastRunTime returns the time of the latest scheduling round. It's zero if the scheduler isn't running.
+func (s *SchedulerV2) LastRunTime() time.Time {
+	ts := s.lastRunTs.Load()
+	if ts == 0 {
+		return time.Time{}
+	}
+	return time.Unix(ts, 0)
+}
+
 func (s *SchedulerV2) runOnce(ctx context.Context) {
+	s.lastRunTs.Store(time.Now().Unix())
 	defer func() {
 		if r := recover(); r != nil {
 			err, ok := r.(error)
@@ -86,6 +117,20 @@ func (s *SchedulerV2) runOnce(ctx context.Context) {
 		}
 	}()
 
+	// No task run is started in the maintenance mode, the executing task runs run to the end.
+	maintenance, err := s.store.GetMaintenanceSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get maintenance setting", log.BBError(err))
+		return
+	}
+	if maintenance.Enabled {
+		return
+	}
+
+	if err := s.scheduleCanaryStages(ctx); err != nil {
+		slog.Error("failed to schedule canary stages", log.BBError(err))
+	}
+
 	if err := s.scheduleAutoRolloutTasks(ctx); err != nil {
 		slog.Error("failed to schedule auto rollout tasks", log.BBError(err))
 	}
@@ -143,6 +188,16 @@ func (s *SchedulerV2) scheduleAutoRolloutTask(ctx context.Context, taskUID int)
 		return nil
 	}
 
+	if task.Type == api.TaskDatabaseSchemaUpdateGhostCutover {
+		postponed, err := isGhostCutoverPostponed(ctx, s.store, task, instance)
+		if err != nil {
+			return errors.Wrapf(err, "failed to check if the gh-ost cutover is postponed")
+		}
+		if postponed {
+			return nil
+		}
+	}
+
 	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &task.PipelineID})
 	if err != nil {
 		return err
@@ -225,6 +280,22 @@ func (s *SchedulerV2) scheduleAutoRolloutTask(ctx context.Context, taskUID int)
 	return nil
 }
 
+// isGhostCutoverPostponed returns whether the gh-ost cutover task waits for an explicit cutover.
+func isGhostCutoverPostponed(ctx context.Context, stores *store.Store, task *store.TaskMessage, instance *store.InstanceMessage) (bool, error) {
+	if len(task.BlockedBy) != 1 {
+		return false, errors.Errorf("failed to find task dag for ToTask %v", task.ID)
+	}
+	syncTask, err := stores.GetTaskV2ByID(ctx, task.BlockedBy[0])
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get schema update gh-ost sync task for cutover task")
+	}
+	payload := &api.TaskDatabaseSchemaUpdateGhostSyncPayload{}
+	if err := json.Unmarshal([]byte(syncTask.Payload), payload); err != nil {
+		return false, errors.Wrap(err, "invalid database schema update gh-ost sync payload")
+	}
+	return ghost.IsCutoverPostponed(ghost.MergeFlags(instance.Options.GetGhostFlags(), payload.Flags)), nil
+}
+
 func (s *SchedulerV2) schedulePendingTaskRuns(ctx context.Context) error {
 	taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
 		Status: &[]api.TaskRunStatus{api.TaskRunPending},
@@ -232,21 +303,97 @@ func (s *SchedulerV2) schedulePendingTaskRuns(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrapf(err, "failed to list pending tasks")
 	}
+	queueDepth := map[api.TaskType]int{}
 	for _, taskRun := range taskRuns {
-		if err := s.schedulePendingTaskRun(ctx, taskRun); err != nil {
+		task, err := s.store.GetTaskV2ByID(ctx, taskRun.TaskUID)
+		if err != nil {
+			slog.Error("failed to get task", slog.Int("task id", taskRun.TaskUID), log.BBError(err))
+			continue
+		}
+		queueDepth[task.Type]++
+		if err := s.schedulePendingTaskRun(ctx, taskRun, task); err != nil {
 			slog.Error("failed to schedule pending task run", log.BBError(err))
 		}
 	}
+	setTaskRunQueueDepth(api.TaskRunPending, queueDepth)
 
 	return nil
 }
 
-func (s *SchedulerV2) schedulePendingTaskRun(ctx context.Context, taskRun *store.TaskRunMessage) error {
-	task, err := s.store.GetTaskV2ByID(ctx, taskRun.TaskUID)
+func (s *SchedulerV2) schedulePendingTaskRun(ctx context.Context, taskRun *store.TaskRunMessage, task *store.TaskMessage) error {
+	if task.EarliestAllowedTs != 0 && time.Now().Before(time.Unix(task.EarliestAllowedTs, 0)) {
+		return nil
+	}
+	// Keep the automatic retry pending until the backoff passes.
+	if taskRun.CreatorID == api.SystemBotID {
+		backoff, err := s.getTaskRetryBackoff(ctx, task)
+		if err != nil {
+			return err
+		}
+		if time.Now().Before(time.Unix(taskRun.CreatedTs, 0).Add(backoff)) {
+			return nil
+		}
+	}
+	// Keep the task run pending until the rollout window of the environment opens and its freezes end, unless the task is run now.
+	rolloutPolicy, err := utils.GetTaskRolloutPolicy(ctx, s.store, task)
 	if err != nil {
-		return errors.Wrapf(err, "failed to get task")
+		return err
 	}
-	if task.EarliestAllowedTs != 0 && time.Now().Before(time.Unix(task.EarliestAllowedTs, 0)) {
+	if now := time.Now(); utils.NextRolloutStart(rolloutPolicy, now).After(now) {
+		runNow, err := utils.IsTaskRunNow(task)
+		if err != nil {
+			return err
+		}
+		if !runNow {
+			return nil
+		}
+	}
+	// Keep the task run pending until the database is unfrozen.
+	freeze, err := utils.GetTaskDatabaseFreeze(ctx, s.store, task)
+	if err != nil {
+		return err
+	}
+	if freeze != nil {
+		return nil
+	}
+	// Keep the task run pending until all the approval gate roles of the environment sign off the task or the stage.
+	pendingRoles, err := utils.GetTaskPendingApprovalGateRoles(ctx, s.store, task)
+	if err != nil {
+		return err
+	}
+	if len(pendingRoles) > 0 {
+		return nil
+	}
+	// Keep the task run pending until the canary stage before is promoted.
+	canaryStage, err := utils.GetTaskUnpromotedCanaryStage(ctx, s.store, task)
+	if err != nil {
+		return err
+	}
+	if canaryStage != nil {
+		return nil
+	}
+	// Keep the task run pending until the issues blocking the task issue are done.
+	blockingIssues, err := utils.GetTaskUndoneBlockingIssues(ctx, s.store, task)
+	if err != nil {
+		return err
+	}
+	if len(blockingIssues) > 0 {
+		return nil
+	}
+	// Keep the task run pending until a rollout of the project finishes if the project runs the maximum concurrent rollouts.
+	quotaExceeded, err := utils.IsTaskRolloutQuotaExceeded(ctx, s.store, task)
+	if err != nil {
+		return err
+	}
+	if quotaExceeded {
+		return nil
+	}
+	// Keep the task run pending until the ServiceNow change request of the stage reaches the "Implement" state.
+	implemented, err := s.isChangeRequestImplemented(ctx, task)
+	if err != nil {
+		return err
+	}
+	if !implemented {
 		return nil
 	}
 	for _, blockingTaskUID := range task.BlockedBy {
@@ -265,7 +412,16 @@ func (s *SchedulerV2) schedulePendingTaskRun(ctx context.Context, taskRun *store
 			continue
 		}
 
-		if blockingTask.LatestTaskRunStatus != api.TaskRunDone {
+		switch blockingTask.LatestTaskRunStatus {
+		case api.TaskRunDone:
+		case api.TaskRunFailed, api.TaskRunCanceled:
+			// The dependents of the failed or canceled task are canceled rather than waiting forever.
+			if err := s.store.BatchCancelTaskRuns(ctx, []int{taskRun.ID}, api.SystemBotID); err != nil {
+				return errors.Wrapf(err, "failed to cancel task run %v blocked by task %v", taskRun.ID, blockingTaskUID)
+			}
+			s.cancelDependentTaskRuns(ctx, task, api.TaskRunCanceled)
+			return nil
+		default:
 			return nil
 		}
 	}
@@ -288,15 +444,20 @@ func (s *SchedulerV2) scheduleRunningTaskRuns(ctx context.Context) error {
 		return errors.Wrapf(err, "failed to list pending tasks")
 	}
 
+	// The task runs of the higher priority issues take the instance connections first.
+	sortTaskRunsByPriority(taskRuns, s.getPipelinePriorityRanks(ctx, taskRuns))
+
 	// Find the minimum task ID for each database.
 	// We only run the first (i.e. which has the minimum task ID) task for each database.
 	minTaskIDForDatabase := map[int]int{}
+	queueDepth := map[api.TaskType]int{}
 	for _, taskRun := range taskRuns {
 		task, err := s.store.GetTaskV2ByID(ctx, taskRun.TaskUID)
 		if err != nil {
 			slog.Error("failed to get task", slog.Int("task id", taskRun.TaskUID), log.BBError(err))
 			continue
 		}
+		queueDepth[task.Type]++
 		if task.DatabaseID == nil {
 			continue
 		}
@@ -306,6 +467,7 @@ func (s *SchedulerV2) scheduleRunningTaskRuns(ctx context.Context) error {
 			minTaskIDForDatabase[*task.DatabaseID] = task.ID
 		}
 	}
+	setTaskRunQueueDepth(api.TaskRunRunning, queueDepth)
 
 	for _, taskRun := range taskRuns {
 		// Skip the task run if it is already executing.
@@ -337,14 +499,21 @@ func (s *SchedulerV2) scheduleRunningTaskRuns(ctx context.Context) error {
 			continue
 		}
 		s.stateCfg.Lock()
-		if s.stateCfg.InstanceOutstandingConnections[task.InstanceID] >= state.InstanceMaximumConnectionNumber {
+		if s.stateCfg.InstanceOutstandingConnections[task.InstanceID] >= state.GetInstanceMaximumConnectionNumber(instance.Options) {
 			s.stateCfg.Unlock()
 			continue
 		}
 		s.stateCfg.InstanceOutstandingConnections[task.InstanceID]++
 		s.stateCfg.Unlock()
 
+		if !s.claimTaskRun(ctx, taskRun, task) {
+			s.stateCfg.Lock()
+			s.stateCfg.InstanceOutstandingConnections[task.InstanceID]--
+			s.stateCfg.Unlock()
+			continue
+		}
 		s.stateCfg.RunningTaskRuns.Store(taskRun.ID, true)
+		taskRunQueueLatency.WithLabelValues(string(task.Type)).Observe(time.Since(time.Unix(taskRun.CreatedTs, 0)).Seconds())
 		go s.runTaskRunOnce(ctx, taskRun, task, executor)
 	}
 
@@ -361,13 +530,25 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 		s.stateCfg.Unlock()
 	}()
 
+	ctx, span := tracing.Start(ctx, "TaskRun",
+		attribute.Int("task_run.id", taskRun.ID),
+		attribute.Int("task.id", task.ID),
+		attribute.String("task.type", string(task.Type)),
+		attribute.Int("pipeline.id", task.PipelineID),
+	)
+	ctx = s.withTaskRunLogAttrs(ctx, taskRun, task)
 	driverCtx, cancel := context.WithCancel(ctx)
 	s.stateCfg.RunningTaskRunsCancelFunc.Store(taskRun.ID, cancel)
 
+	startTime := time.Now()
 	done, result, err := RunExecutorOnce(ctx, driverCtx, executor, task, taskRun.ID)
+	if done {
+		observeTaskRunCompleted(task.Type, getTaskRunCompletedStatus(err), time.Since(startTime))
+	}
+	tracing.End(span, err)
 
 	if !done && err != nil {
-		slog.Debug("Encountered transient error running task, will retry",
+		slog.DebugContext(ctx, "Encountered transient error running task, will retry",
 			slog.Int("id", task.ID),
 			slog.String("name", task.Name),
 			slog.String("type", string(task.Type)),
@@ -377,19 +558,32 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 	}
 
 	if done && err != nil && errors.Is(err, context.Canceled) {
-		slog.Warn("task run is canceled",
+		slog.WarnContext(ctx, "task run is canceled",
 			slog.Int("id", task.ID),
 			slog.String("name", task.Name),
 			slog.String("type", string(task.Type)),
 			log.BBError(err),
 		)
-		resultBytes, marshalErr := protojson.Marshal(&storepb.TaskRunResult{
+		taskRunResult := &storepb.TaskRunResult{
 			Detail:        "The task run is canceled",
 			ChangeHistory: "",
 			Version:       "",
-		})
+		}
+		// Mark the partial progress, the statements before the canceled one are completed.
+		if v, ok := s.stateCfg.TaskRunExecutionStatuses.Load(taskRun.ID); ok {
+			if detail := v.(state.TaskRunExecutionStatus).ExecutionDetail; detail != nil && detail.CommandsTotal > 0 {
+				taskRunResult.Detail = fmt.Sprintf("The task run is canceled with %d of %d statements completed", detail.CommandsCompleted, detail.CommandsTotal)
+			}
+		}
+		var errWithPosition *db.ErrorWithPosition
+		if errors.As(err, &errWithPosition) {
+			taskRunResult.StartPosition = errWithPosition.Start
+			taskRunResult.EndPosition = errWithPosition.End
+		}
+		appendTaskRunLog(ctx, s.store, taskRun.ID, store.TaskRunLogWarn, taskRunResult.Detail)
+		resultBytes, marshalErr := protojson.Marshal(taskRunResult)
 		if marshalErr != nil {
-			slog.Error("Failed to marshal task run result",
+			slog.ErrorContext(ctx, "Failed to marshal task run result",
 				slog.Int("task_id", task.ID),
 				slog.String("type", string(task.Type)),
 				log.BBError(marshalErr),
@@ -404,21 +598,23 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 			Status:    api.TaskRunCanceled,
 			Code:      &code,
 			Result:    &result,
+			ReplicaID: &s.replicaID,
 		}
 
 		if _, err := s.store.UpdateTaskRunStatus(ctx, taskRunStatusPatch); err != nil {
-			slog.Error("Failed to mark task as CANCELED",
+			slog.ErrorContext(ctx, "Failed to mark task as CANCELED",
 				slog.Int("id", task.ID),
 				slog.String("name", task.Name),
 				log.BBError(err),
 			)
 			return
 		}
+		s.cancelDependentTaskRuns(ctx, task, api.TaskRunCanceled)
 		return
 	}
 
 	if done && err != nil {
-		slog.Warn("task run failed",
+		slog.WarnContext(ctx, "task run failed",
 			slog.Int("id", task.ID),
 			slog.String("name", task.Name),
 			slog.String("type", string(task.Type)),
@@ -436,17 +632,25 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 			taskRunResult.StartPosition = errWithPosition.Start
 			taskRunResult.EndPosition = errWithPosition.End
 		}
+		var checkErr *migrationCheckError
+		if errors.As(err, &checkErr) {
+			taskRunResult.CheckResults = checkErr.results
+		}
 
 		resultBytes, marshalErr := protojson.Marshal(taskRunResult)
 		if marshalErr != nil {
-			slog.Error("Failed to marshal task run result",
+			slog.ErrorContext(ctx, "Failed to marshal task run result",
 				slog.Int("task_id", task.ID),
 				slog.String("type", string(task.Type)),
 				log.BBError(marshalErr),
 			)
 			return
 		}
+		appendTaskRunLog(ctx, s.store, taskRun.ID, store.TaskRunLogError, fmt.Sprintf("Task run failed: %v", err))
 		code := common.ErrorCode(err)
+		if isTransientError(err) {
+			code = common.TaskTransientError
+		}
 		result := string(resultBytes)
 		taskRunStatusPatch := &store.TaskRunStatusPatch{
 			ID:        taskRun.ID,
@@ -454,17 +658,37 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 			Status:    api.TaskRunFailed,
 			Code:      &code,
 			Result:    &result,
+			ReplicaID: &s.replicaID,
 		}
 
+		activities, activityErr := s.newTaskRunStatusUpdateActivities(ctx, task, api.TaskRunFailed)
+		if activityErr != nil {
+			slog.Error("failed to create activity for task run status update", log.BBError(activityErr))
+		}
+		taskRunStatusPatch.Activities = activities
 		if _, err := s.store.UpdateTaskRunStatus(ctx, taskRunStatusPatch); err != nil {
-			slog.Error("Failed to mark task as FAILED",
+			slog.ErrorContext(ctx, "Failed to mark task as FAILED",
 				slog.Int("id", task.ID),
 				slog.String("name", task.Name),
 				log.BBError(err),
 			)
 			return
 		}
-		s.createActivityForTaskRunStatusUpdate(ctx, task, api.TaskRunFailed)
+		s.activityManager.Notify()
+		if code == common.TaskTransientError {
+			retried, err := s.retryTaskRun(ctx, task)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to retry task run",
+					slog.Int("id", task.ID),
+					slog.String("name", task.Name),
+					log.BBError(err),
+				)
+			}
+			if retried {
+				return
+			}
+		}
+		s.cancelDependentTaskRuns(ctx, task, api.TaskRunFailed)
 		return
 	}
 
@@ -473,9 +697,10 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 			Detail:        result.Detail,
 			ChangeHistory: result.ChangeHistory,
 			Version:       result.Version,
+			CheckResults:  result.CheckResults,
 		})
 		if marshalErr != nil {
-			slog.Error("Failed to marshal task run result",
+			slog.ErrorContext(ctx, "Failed to marshal task run result",
 				slog.Int("task_id", task.ID),
 				slog.String("type", string(task.Type)),
 				log.BBError(marshalErr),
@@ -490,21 +715,43 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 			Status:    api.TaskRunDone,
 			Code:      &code,
 			Result:    &result,
+			ReplicaID: &s.replicaID,
 		}
+		activities, activityErr := s.newTaskRunStatusUpdateActivities(ctx, task, api.TaskRunDone)
+		if activityErr != nil {
+			slog.Error("failed to create activity for task run status update", log.BBError(activityErr))
+		}
+		taskRunStatusPatch.Activities = activities
 		if _, err := s.store.UpdateTaskRunStatus(ctx, taskRunStatusPatch); err != nil {
-			slog.Error("Failed to mark task as DONE",
+			slog.ErrorContext(ctx, "Failed to mark task as DONE",
 				slog.Int("id", task.ID),
 				slog.String("name", task.Name),
 				log.BBError(err),
 			)
 			return
 		}
-		s.createActivityForTaskRunStatusUpdate(ctx, task, api.TaskRunDone)
+		s.activityManager.Notify()
 		s.stateCfg.TaskSkippedOrDoneChan <- task.ID
 		return
 	}
 }
 
+// withTaskRunLogAttrs attaches the task run, task, pipeline and issue IDs to the logs emitted during the task run.
+func (s *SchedulerV2) withTaskRunLogAttrs(ctx context.Context, taskRun *store.TaskRunMessage, task *store.TaskMessage) context.Context {
+	attrs := []slog.Attr{
+		slog.Int("task_run_id", taskRun.ID),
+		slog.Int("task_id", task.ID),
+		slog.Int("pipeline_id", task.PipelineID),
+	}
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &task.PipelineID})
+	if err != nil {
+		slog.Debug("failed to get issue of the task run", slog.Int("task_run_id", taskRun.ID), log.BBError(err))
+	} else if issue != nil {
+		attrs = append(attrs, slog.Int("issue_id", issue.UID))
+	}
+	return log.ContextWithAttrs(ctx, attrs...)
+}
+
 func (s *SchedulerV2) ListenTaskSkippedOrDone(ctx context.Context) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -684,70 +931,52 @@ func (s *SchedulerV2) ListenTaskSkippedOrDone(ctx context.Context) {
 	}
 }
 
-func (s *SchedulerV2) createActivityForTaskRunStatusUpdate(ctx context.Context, task *store.TaskMessage, newStatus api.TaskRunStatus) {
-	if err := func() error {
-		issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{
-			PipelineID: &task.PipelineID,
-		})
-		if err != nil {
-			return errors.Wrap(err, "failed to get issue")
-		}
-		if issue == nil {
-			return nil
-		}
+// newTaskRunStatusUpdateActivities returns the activities of the task run status update, to create in the transaction updating the task run status.
+func (s *SchedulerV2) newTaskRunStatusUpdateActivities(ctx context.Context, task *store.TaskMessage, newStatus api.TaskRunStatus) ([]*store.PendingActivity, error) {
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{
+		PipelineID: &task.PipelineID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get issue")
+	}
+	if issue == nil {
+		return nil, nil
+	}
 
-		createActivityPayload := api.ActivityPipelineTaskRunStatusUpdatePayload{
-			TaskID:    task.ID,
-			NewStatus: newStatus,
-			IssueName: issue.Title,
-			TaskName:  task.Name,
-		}
-		bytes, err := json.Marshal(createActivityPayload)
-		if err != nil {
-			return errors.Wrap(err, "failed to marshal ActivityPipelineTaskRunStatusUpdatePayload payload")
-		}
-		activityCreate := &store.ActivityMessage{
+	createActivityPayload := api.ActivityPipelineTaskRunStatusUpdatePayload{
+		TaskID:    task.ID,
+		NewStatus: newStatus,
+		IssueName: issue.Title,
+		TaskName:  task.Name,
+	}
+	bytes, err := json.Marshal(createActivityPayload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal ActivityPipelineTaskRunStatusUpdatePayload payload")
+	}
+	activities := []*store.PendingActivity{
+		s.activityManager.NewPendingActivity(ctx, &store.ActivityMessage{
 			CreatorUID:   api.SystemBotID,
 			ContainerUID: task.PipelineID,
 			Type:         api.ActivityPipelineTaskRunStatusUpdate,
 			Level:        api.ActivityInfo,
 			Payload:      string(bytes),
-		}
-		if _, err := s.activityManager.CreateActivity(ctx, activityCreate, &activity.Metadata{
+		}, &activity.Metadata{
 			Issue: issue,
-		}); err != nil {
-			return errors.Wrap(err, "failed to create activity")
-		}
-
-		return nil
-	}(); err != nil {
-		slog.Error("failed to create activity for task run status update", log.BBError(err))
-	}
-}
-
-// ClearRunningTaskRuns changes all RUNNING taskRuns to CANCELED.
-// When there are running taskRuns and Bytebase server is shutdown, these task executors are stopped, but the taskRuns' status are still RUNNING.
-// When Bytebase is restarted, the task scheduler will re-schedule those RUNNING tasks, which should be CANCELED instead.
-// So we change their status to CANCELED before starting the scheduler.
-// And corresponding taskRuns are also changed to CANCELED.
-func (s *SchedulerV2) ClearRunningTaskRuns(ctx context.Context) error {
-	runningTaskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
-		Status: &[]api.TaskRunStatus{api.TaskRunRunning},
-	})
-	if err != nil {
-		return errors.Wrap(err, "failed to list running task runs")
+		}),
 	}
-
-	if len(runningTaskRuns) > 0 {
-		var taskRunIDs []int
-		for _, taskRun := range runningTaskRuns {
-			taskRunIDs = append(taskRunIDs, taskRun.ID)
-		}
-		if err := s.store.BatchCancelTaskRuns(ctx, taskRunIDs, api.SystemBotID); err != nil {
-			return errors.Wrapf(err, "failed to change task run %v's status to %s", taskRunIDs, api.TaskRunCanceled)
-		}
+	// The failures are also notified separately, so that they can be routed to the webhooks other than the ones of all the status updates.
+	if newStatus == api.TaskRunFailed {
+		activities = append(activities, s.activityManager.NewPendingActivity(ctx, &store.ActivityMessage{
+			CreatorUID:   api.SystemBotID,
+			ContainerUID: task.PipelineID,
+			Type:         api.ActivityNotifyRolloutFailed,
+			Level:        api.ActivityError,
+			Payload:      string(bytes),
+		}, &activity.Metadata{
+			Issue: issue,
+		}))
 	}
-	return nil
+	return activities, nil
 }
 
 func tasksSkippedOrDone(tasks []*store.TaskMessage) (bool, error) {
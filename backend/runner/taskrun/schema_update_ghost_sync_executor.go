@@ -3,6 +3,7 @@ package taskrun
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log/slog"
 	"strings"
 	"sync/atomic"
@@ -11,6 +12,7 @@ import (
 	"github.com/github/gh-ost/go/base"
 	"github.com/github/gh-ost/go/logic"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
@@ -56,15 +58,18 @@ func (exec *SchemaUpdateGhostSyncExecutor) RunOnce(ctx context.Context, _ contex
 		return true, nil, err
 	}
 
-	return exec.runGhostMigration(ctx, task, statement, payload.Flags)
+	return exec.runGhostMigration(ctx, task, taskRunUID, statement, payload.Flags)
 }
 
+// ghostProgressLogIntervalSeconds is the interval to record the gh-ost row copy progress in the task run log.
+const ghostProgressLogIntervalSeconds = 60
+
 type sharedGhostState struct {
 	migrationContext *base.MigrationContext
 	errCh            <-chan error
 }
 
-func (exec *SchemaUpdateGhostSyncExecutor) runGhostMigration(ctx context.Context, task *store.TaskMessage, statement string, flags map[string]string) (terminated bool, result *api.TaskRunResultPayload, err error) {
+func (exec *SchemaUpdateGhostSyncExecutor) runGhostMigration(ctx context.Context, task *store.TaskMessage, taskRunUID int, statement string, flags map[string]string) (terminated bool, result *api.TaskRunResultPayload, err error) {
 	syncDone := make(chan struct{})
 	// set buffer size to 1 to unblock the sender because there is no listner if the task is canceled.
 	// see PR #2919.
@@ -101,12 +106,14 @@ func (exec *SchemaUpdateGhostSyncExecutor) runGhostMigration(ctx context.Context
 	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
 	renderedStatement := utils.RenderStatement(statement, materials)
 
+	flags = ghost.MergeFlags(instance.Options.GetGhostFlags(), flags)
 	migrationContext, err := ghost.NewMigrationContext(task.ID, task.CreatedTs, database, adminDataSource, exec.secret, tableName, renderedStatement, false, flags, 10000000)
 	if err != nil {
 		return true, nil, errors.Wrap(err, "failed to init migrationContext for gh-ost")
 	}
 
 	migrator := logic.NewMigrator(migrationContext, "bb")
+	appendTaskRunLog(ctx, exec.store, taskRunUID, store.TaskRunLogInfo, fmt.Sprintf("Start gh-ost migration on table %q", tableName))
 
 	childCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -114,6 +121,7 @@ func (exec *SchemaUpdateGhostSyncExecutor) runGhostMigration(ctx context.Context
 		ticker := time.NewTicker(1 * time.Second)
 		defer ticker.Stop()
 		createdTs := time.Now().Unix()
+		lastLogTs := createdTs
 		for {
 			select {
 			case <-ticker.C:
@@ -128,6 +136,24 @@ func (exec *SchemaUpdateGhostSyncExecutor) runGhostMigration(ctx context.Context
 					CreatedTs:     createdTs,
 					UpdatedTs:     updatedTs,
 				})
+				executionDetail := &v1pb.TaskRun_ExecutionDetail{
+					RowsTotal:      totalUnit,
+					RowsCompleted:  completedUnit,
+					ReplicationLag: durationpb.New(migrationContext.GetCurrentLagDuration()),
+				}
+				if eta := migrationContext.GetETADuration(); eta >= 0 {
+					executionDetail.EstimatedTimeRemaining = durationpb.New(eta)
+				}
+				exec.stateCfg.TaskRunExecutionStatuses.Store(taskRunUID,
+					state.TaskRunExecutionStatus{
+						ExecutionStatus: v1pb.TaskRun_EXECUTING,
+						ExecutionDetail: executionDetail,
+						UpdateTime:      time.Now(),
+					})
+				if updatedTs-lastLogTs >= ghostProgressLogIntervalSeconds {
+					appendTaskRunLog(childCtx, exec.store, taskRunUID, store.TaskRunLogInfo, fmt.Sprintf("Copied %d/%d rows, replication lag %v", completedUnit, totalUnit, migrationContext.GetCurrentLagDuration().Round(time.Millisecond)))
+					lastLogTs = updatedTs
+				}
 				// Since we are using postpone flag file to postpone cutover, it's gh-ost mechanism to set migrationContext.IsPostponingCutOver to 1 after synced and before postpone flag file is removed. We utilize this mechanism here to check if synced.
 				if atomic.LoadInt64(&migrationContext.IsPostponingCutOver) > 0 {
 					close(syncDone)
@@ -141,7 +167,7 @@ func (exec *SchemaUpdateGhostSyncExecutor) runGhostMigration(ctx context.Context
 
 	go func() {
 		if err := migrator.Migrate(); err != nil {
-			slog.Error("failed to run gh-ost migration", log.BBError(err))
+			slog.ErrorContext(ctx, "failed to run gh-ost migration", log.BBError(err))
 			migrationError <- err
 			return
 		}
@@ -153,9 +179,17 @@ func (exec *SchemaUpdateGhostSyncExecutor) runGhostMigration(ctx context.Context
 
 	select {
 	case <-syncDone:
+		if ghost.IsCutoverPostponed(flags) {
+			appendTaskRunLog(ctx, exec.store, taskRunUID, store.TaskRunLogInfo, "gh-ost sync done, the cutover is postponed until it's triggered explicitly")
+		} else {
+			appendTaskRunLog(ctx, exec.store, taskRunUID, store.TaskRunLogInfo, "gh-ost sync done, waiting for cutover")
+		}
 		exec.stateCfg.GhostTaskState.Store(task.ID, sharedGhostState{migrationContext: migrationContext, errCh: migrationError})
 		return true, &api.TaskRunResultPayload{Detail: "sync done"}, nil
 	case err := <-migrationError:
+		if err != nil {
+			appendTaskRunLog(ctx, exec.store, taskRunUID, store.TaskRunLogError, fmt.Sprintf("gh-ost migration failed: %v", err))
+		}
 		return true, nil, err
 	case <-ctx.Done():
 		migrationContext.PanicAbort <- errors.New("task canceled")
@@ -0,0 +1,196 @@
+package taskrun
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/component/pgonline"
+	"github.com/bytebase/bytebase/backend/component/state"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// runOnlineMigration rewrites the DDL statements to the concurrent-safe steps and runs them one by one.
+// The index of the next step is saved in the task payload after each step, so that the next task run resumes from there.
+func (exec *SchemaUpdateExecutor) runOnlineMigration(ctx context.Context, driverCtx context.Context, task *store.TaskMessage, taskRunUID int, statement string, payload *api.TaskDatabaseSchemaUpdatePayload) (bool, *api.TaskRunResultPayload, error) {
+	instance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return true, nil, err
+	}
+	if instance.Engine != storepb.Engine_POSTGRES {
+		return true, nil, errors.Errorf("online migration is not supported for engine %v", instance.Engine)
+	}
+	database, err := exec.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return true, nil, err
+	}
+	if database == nil {
+		return true, nil, errors.Errorf("database not found")
+	}
+
+	version := model.Version{Version: payload.SchemaVersion}
+	mi, err := getMigrationInfo(ctx, exec.store, exec.profile, task, db.Migrate, statement, version)
+	if err != nil {
+		return true, nil, err
+	}
+
+	driver, err := exec.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get driver connection for instance %q", instance.ResourceID)
+	}
+	defer driver.Close(ctx)
+
+	o := &onlineMigrationExecutor{
+		exec:       exec,
+		driver:     driver,
+		task:       task,
+		taskRunUID: taskRunUID,
+		config:     payload.OnlineMigrationConfig,
+		checkpoint: &api.OnlineMigrationCheckpoint{},
+	}
+	if payload.OnlineMigrationCheckpoint != nil {
+		o.checkpoint = payload.OnlineMigrationCheckpoint
+	}
+	migrationID, schema, err := utils.ExecuteMigrationWithFunc(ctx, driverCtx, exec.store, exec.stateCfg, taskRunUID, driver, mi, statement, &payload.SheetID, func(execCtx context.Context, execStatement string) error {
+		return o.execute(ctx, execCtx, execStatement)
+	})
+	if err != nil {
+		return true, nil, err
+	}
+	if err := enqueueSchemaUpdateRollback(ctx, exec.store, exec.stateCfg, task, migrationID); err != nil {
+		return true, nil, err
+	}
+	return postMigration(ctx, exec.store, exec.activityManager, exec.license, task, mi, migrationID, schema, &payload.SheetID)
+}
+
+type onlineMigrationExecutor struct {
+	exec       *SchemaUpdateExecutor
+	driver     db.Driver
+	task       *store.TaskMessage
+	taskRunUID int
+	config     *api.OnlineMigrationConfig
+
+	checkpoint *api.OnlineMigrationCheckpoint
+	steps      []*pgonline.Step
+	// running is the index of the running step, -1 if no step is running.
+	running int
+}
+
+func (o *onlineMigrationExecutor) execute(ctx context.Context, execCtx context.Context, statement string) error {
+	steps, err := pgonline.Plan(statement, o.config.PgRepack)
+	if err != nil {
+		return err
+	}
+	o.steps = steps
+	if o.checkpoint.StepIndex > len(steps) {
+		return errors.Errorf("invalid online migration checkpoint %d, the migration has %d steps", o.checkpoint.StepIndex, len(steps))
+	}
+	if o.checkpoint.StepIndex == 0 {
+		appendTaskRunLog(ctx, o.exec.store, o.taskRunUID, store.TaskRunLogInfo, fmt.Sprintf("Planned %d steps for the online migration.", len(steps)))
+	} else {
+		appendTaskRunLog(ctx, o.exec.store, o.taskRunUID, store.TaskRunLogInfo, fmt.Sprintf("Resumed the online migration from step %d of %d.", o.checkpoint.StepIndex+1, len(steps)))
+	}
+
+	for i := o.checkpoint.StepIndex; i < len(steps); i++ {
+		step := steps[i]
+		o.running = i
+		o.updateExecutionStatus()
+		if err := o.executeStep(execCtx, step); err != nil {
+			return errors.Wrapf(err, "failed to execute step %d %q", i+1, step.Description)
+		}
+		o.running = -1
+		if err := o.saveCheckpoint(ctx, &api.OnlineMigrationCheckpoint{StepIndex: i + 1}); err != nil {
+			return err
+		}
+		appendTaskRunLog(ctx, o.exec.store, o.taskRunUID, store.TaskRunLogInfo, fmt.Sprintf("Step %d of %d completed: %s", i+1, len(steps), step.Description))
+	}
+	return nil
+}
+
+func (o *onlineMigrationExecutor) executeStep(ctx context.Context, step *pgonline.Step) error {
+	switch step.Type {
+	case pgonline.StepTypeSQL:
+		// The concurrent statements are executed outside of the transaction by the driver.
+		_, err := o.driver.Execute(ctx, step.Statement, db.ExecuteOptions{})
+		return err
+	case pgonline.StepTypeRepack:
+		pgDriver, ok := o.driver.(*pg.Driver)
+		if !ok {
+			return errors.Errorf("pg_repack requires the PostgreSQL driver")
+		}
+		return pgDriver.Repack(ctx, pg.RepackOptions{
+			Schema:     step.Schema,
+			Table:      step.Table,
+			Tablespace: step.Tablespace,
+		})
+	default:
+		return errors.Errorf("unknown online migration step type %v", step.Type)
+	}
+}
+
+func (o *onlineMigrationExecutor) saveCheckpoint(ctx context.Context, checkpoint *api.OnlineMigrationCheckpoint) error {
+	task, err := o.exec.store.GetTaskV2ByID(ctx, o.task.ID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get task %d", o.task.ID)
+	}
+	payload := &api.TaskDatabaseSchemaUpdatePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return errors.Wrap(err, "invalid database schema update payload")
+	}
+	payload.OnlineMigrationCheckpoint = checkpoint
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal task payload")
+	}
+	payloadString := string(payloadBytes)
+	if _, err := o.exec.store.UpdateTaskV2(ctx, &api.TaskPatch{
+		ID:        task.ID,
+		UpdaterID: api.SystemBotID,
+		Payload:   &payloadString,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to patch task %d with the online migration checkpoint", task.ID)
+	}
+	o.checkpoint = checkpoint
+	o.updateExecutionStatus()
+	return nil
+}
+
+func (o *onlineMigrationExecutor) updateExecutionStatus() {
+	if o.exec.stateCfg == nil {
+		return
+	}
+	var steps []*v1pb.TaskRun_ExecutionDetail_Step
+	for i, step := range o.steps {
+		status := v1pb.TaskRun_ExecutionDetail_Step_PENDING
+		switch {
+		case i < o.checkpoint.StepIndex:
+			status = v1pb.TaskRun_ExecutionDetail_Step_DONE
+		case i == o.running:
+			status = v1pb.TaskRun_ExecutionDetail_Step_RUNNING
+		}
+		steps = append(steps, &v1pb.TaskRun_ExecutionDetail_Step{
+			Description: step.Description,
+			Status:      status,
+		})
+	}
+	o.exec.stateCfg.TaskRunExecutionStatuses.Store(o.taskRunUID,
+		state.TaskRunExecutionStatus{
+			ExecutionStatus: v1pb.TaskRun_EXECUTING,
+			ExecutionDetail: &v1pb.TaskRun_ExecutionDetail{
+				CommandsTotal:     int32(len(o.steps)),
+				CommandsCompleted: int32(o.checkpoint.StepIndex),
+				Steps:             steps,
+			},
+			UpdateTime: time.Now(),
+		})
+}
@@ -0,0 +1,190 @@
+// Package taskrunlog is the runner for purging expired task run logs and artifacts.
+package taskrunlog
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/artifact"
+	"github.com/bytebase/bytebase/backend/plugin/storage/s3"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	purgeInterval = 1 * time.Hour
+	// purgeBatchSize is the number of logs purged at a time.
+	purgeBatchSize = 1000
+	// exportPathPrefix is the object storage path prefix of the exported task run logs.
+	exportPathPrefix = "task-run-logs"
+)
+
+// NewRunner creates a new task run log runner.
+func NewRunner(store *store.Store, s3Client *s3.Client, artifactManager *artifact.Manager) *Runner {
+	return &Runner{
+		store:           store,
+		s3Client:        s3Client,
+		artifactManager: artifactManager,
+	}
+}
+
+// Runner is the runner purging task run logs and artifacts by the retention policy of each environment.
+type Runner struct {
+	store           *store.Store
+	s3Client        *s3.Client
+	artifactManager *artifact.Manager
+}
+
+// Run is the runner for task run log runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug("Task run log runner started", slog.Duration("interval", purgeInterval))
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Task run log runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.purgeExpiredTaskRunLogs(ctx)
+			}()
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) purgeExpiredTaskRunLogs(ctx context.Context) {
+	environments, err := r.store.ListEnvironmentV2(ctx, &store.FindEnvironmentMessage{})
+	if err != nil {
+		slog.Error("Failed to list environments.", log.BBError(err))
+		return
+	}
+	for _, environment := range environments {
+		policy, err := r.store.GetTaskRunLogRetentionPolicy(ctx, environment.UID)
+		if err != nil {
+			slog.Error("Failed to get task run log retention policy.", slog.String("environment", environment.ResourceID), log.BBError(err))
+			continue
+		}
+		if policy.RetentionDays > 0 {
+			if err := r.purgeEnvironment(ctx, environment, policy); err != nil {
+				slog.Error("Failed to purge expired task run logs.", slog.String("environment", environment.ResourceID), log.BBError(err))
+			}
+		}
+		if policy.ArtifactRetentionDays > 0 {
+			if err := r.purgeEnvironmentArtifacts(ctx, environment, policy); err != nil {
+				slog.Error("Failed to purge expired task run artifacts.", slog.String("environment", environment.ResourceID), log.BBError(err))
+			}
+		}
+	}
+}
+
+func (r *Runner) purgeEnvironment(ctx context.Context, environment *store.EnvironmentMessage, policy *storepb.TaskRunLogRetentionPolicy) error {
+	if policy.ExportBeforeDelete && r.s3Client == nil {
+		return errors.Errorf("object storage is not configured to export task run logs before deleting them")
+	}
+	createdTsBefore := time.Now().AddDate(0, 0, -int(policy.RetentionDays)).Unix()
+	limit := purgeBatchSize
+	for {
+		taskRunLogs, err := r.store.ListTaskRunLogs(ctx, &store.FindTaskRunLogMessage{
+			EnvironmentID:   &environment.ResourceID,
+			CreatedTsBefore: &createdTsBefore,
+			Limit:           &limit,
+		})
+		if err != nil {
+			return err
+		}
+		if len(taskRunLogs) == 0 {
+			return nil
+		}
+		if policy.ExportBeforeDelete {
+			if err := r.export(ctx, environment.ResourceID, taskRunLogs); err != nil {
+				return err
+			}
+		}
+		var uids []int64
+		for _, taskRunLog := range taskRunLogs {
+			uids = append(uids, taskRunLog.ID)
+		}
+		if err := r.store.DeleteTaskRunLogs(ctx, &store.DeleteTaskRunLogMessage{UIDs: uids}); err != nil {
+			return err
+		}
+		slog.Debug("Purged expired task run logs", slog.String("environment", environment.ResourceID), slog.Int("count", len(uids)))
+		if len(taskRunLogs) < limit {
+			return nil
+		}
+	}
+}
+
+func (r *Runner) purgeEnvironmentArtifacts(ctx context.Context, environment *store.EnvironmentMessage, policy *storepb.TaskRunLogRetentionPolicy) error {
+	createdTsBefore := time.Now().AddDate(0, 0, -int(policy.ArtifactRetentionDays)).Unix()
+	limit := purgeBatchSize
+	for {
+		artifacts, err := r.store.ListTaskRunArtifacts(ctx, &store.FindTaskRunArtifactMessage{
+			EnvironmentID:   &environment.ResourceID,
+			CreatedTsBefore: &createdTsBefore,
+			Limit:           &limit,
+		})
+		if err != nil {
+			return err
+		}
+		if len(artifacts) == 0 {
+			return nil
+		}
+		if err := r.artifactManager.Delete(ctx, artifacts); err != nil {
+			return err
+		}
+		slog.Debug("Purged expired task run artifacts", slog.String("environment", environment.ResourceID), slog.Int("count", len(artifacts)))
+		if len(artifacts) < limit {
+			return nil
+		}
+	}
+}
+
+// export uploads the logs of each task run as a gzip compressed object.
+// The object path is task-run-logs/{environment}/{task run}/{first log}-{last log}.log.gz, so that repeated exports never overwrite each other.
+func (r *Runner) export(ctx context.Context, environmentID string, taskRunLogs []*store.TaskRunLogMessage) error {
+	var taskRunUIDs []int
+	logMap := make(map[int][]*store.TaskRunLogMessage)
+	for _, taskRunLog := range taskRunLogs {
+		if _, ok := logMap[taskRunLog.TaskRunUID]; !ok {
+			taskRunUIDs = append(taskRunUIDs, taskRunLog.TaskRunUID)
+		}
+		logMap[taskRunLog.TaskRunUID] = append(logMap[taskRunLog.TaskRunUID], taskRunLog)
+	}
+
+	for _, taskRunUID := range taskRunUIDs {
+		logs := logMap[taskRunUID]
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		for _, taskRunLog := range logs {
+			if _, err := fmt.Fprintf(w, "%s %s %s\n", time.Unix(taskRunLog.CreatedTs, 0).UTC().Format(time.RFC3339), taskRunLog.Level, taskRunLog.Content); err != nil {
+				return errors.Wrapf(err, "failed to compress logs of task run %d", taskRunUID)
+			}
+		}
+		if err := w.Close(); err != nil {
+			return errors.Wrapf(err, "failed to compress logs of task run %d", taskRunUID)
+		}
+		path := fmt.Sprintf("%s/%s/%d/%d-%d.log.gz", exportPathPrefix, environmentID, taskRunUID, logs[0].ID, logs[len(logs)-1].ID)
+		if _, err := r.s3Client.UploadObject(ctx, path, &buf); err != nil {
+			return errors.Wrapf(err, "failed to export logs of task run %d to %q", taskRunUID, path)
+		}
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
@@ -12,9 +13,12 @@ import (
 
 	apiv1 "github.com/bytebase/bytebase/backend/api/v1"
 	"github.com/bytebase/bytebase/backend/component/activity"
+	"github.com/bytebase/bytebase/backend/component/artifact"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/queryaudit"
+	"github.com/bytebase/bytebase/backend/component/querycursor"
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
@@ -43,14 +47,16 @@ func configureGrpcRouters(
 	backupRunner *backuprun.Runner,
 	relayRunner *relay.Runner,
 	planCheckScheduler *plancheck.Scheduler,
+	artifactManager *artifact.Manager,
+	cursorManager *querycursor.Manager,
 	postCreateUser apiv1.CreateUserFunc,
 	secret string,
 	errorRecordRing *api.ErrorRecordRing,
-	tokenDuration time.Duration) (*apiv1.RolloutService, *apiv1.IssueService, error) {
+	tokenDuration time.Duration) (*apiv1.RolloutService, *apiv1.IssueService, *apiv1.SQLService, *apiv1.InstanceService, error) {
 	// Register services.
-	authService, err := apiv1.NewAuthService(stores, secret, tokenDuration, licenseService, metricReporter, profile, stateCfg, postCreateUser)
+	authService, err := apiv1.NewAuthService(stores, secret, tokenDuration, licenseService, metricReporter, profile, stateCfg, postCreateUser, activityManager)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	v1pb.RegisterAuthServiceServer(grpcServer, authService)
 	v1pb.RegisterActuatorServiceServer(grpcServer, apiv1.NewActuatorService(stores, profile, errorRecordRing))
@@ -59,28 +65,33 @@ func configureGrpcRouters(
 		profile,
 		metricReporter,
 		licenseService))
-	v1pb.RegisterEnvironmentServiceServer(grpcServer, apiv1.NewEnvironmentService(stores, licenseService))
-	v1pb.RegisterInstanceServiceServer(grpcServer, apiv1.NewInstanceService(
+	environmentService := apiv1.NewEnvironmentService(stores, licenseService)
+	v1pb.RegisterEnvironmentServiceServer(grpcServer, environmentService)
+	instanceService := apiv1.NewInstanceService(
 		stores,
 		licenseService,
 		metricReporter,
 		secret,
 		stateCfg,
 		dbFactory,
-		schemaSyncer))
-	v1pb.RegisterProjectServiceServer(grpcServer, apiv1.NewProjectService(stores, activityManager, profile, iamManager, licenseService))
-	v1pb.RegisterDatabaseServiceServer(grpcServer, apiv1.NewDatabaseService(stores, backupRunner, schemaSyncer, licenseService, profile, iamManager))
+		schemaSyncer)
+	v1pb.RegisterInstanceServiceServer(grpcServer, instanceService)
+	projectService := apiv1.NewProjectService(stores, activityManager, profile, iamManager, licenseService)
+	v1pb.RegisterProjectServiceServer(grpcServer, projectService)
+	v1pb.RegisterDatabaseServiceServer(grpcServer, apiv1.NewDatabaseService(stores, backupRunner, schemaSyncer, licenseService, profile, iamManager, dbFactory, activityManager))
 	v1pb.RegisterInstanceRoleServiceServer(grpcServer, apiv1.NewInstanceRoleService(stores, dbFactory))
-	v1pb.RegisterOrgPolicyServiceServer(grpcServer, apiv1.NewOrgPolicyService(stores, licenseService))
+	orgPolicyService := apiv1.NewOrgPolicyService(stores, licenseService)
+	v1pb.RegisterOrgPolicyServiceServer(grpcServer, orgPolicyService)
 	v1pb.RegisterIdentityProviderServiceServer(grpcServer, apiv1.NewIdentityProviderService(stores, licenseService))
-	v1pb.RegisterSettingServiceServer(grpcServer, apiv1.NewSettingService(stores, profile, licenseService, stateCfg))
+	v1pb.RegisterSettingServiceServer(grpcServer, apiv1.NewSettingService(stores, profile, licenseService, stateCfg, environmentService, instanceService, projectService, orgPolicyService))
 	v1pb.RegisterAnomalyServiceServer(grpcServer, apiv1.NewAnomalyService(stores))
-	v1pb.RegisterSQLServiceServer(grpcServer, apiv1.NewSQLService(stores, schemaSyncer, dbFactory, activityManager, licenseService))
+	sqlService := apiv1.NewSQLService(stores, schemaSyncer, dbFactory, activityManager, queryaudit.NewAuditor(stores, activityManager), cursorManager, licenseService)
+	v1pb.RegisterSQLServiceServer(grpcServer, sqlService)
 	v1pb.RegisterExternalVersionControlServiceServer(grpcServer, apiv1.NewExternalVersionControlService(stores))
 	v1pb.RegisterRiskServiceServer(grpcServer, apiv1.NewRiskService(stores, licenseService))
 	issueService := apiv1.NewIssueService(stores, activityManager, relayRunner, stateCfg, licenseService, profile, iamManager, metricReporter)
 	v1pb.RegisterIssueServiceServer(grpcServer, issueService)
-	rolloutService := apiv1.NewRolloutService(stores, licenseService, dbFactory, planCheckScheduler, stateCfg, activityManager, profile, iamManager)
+	rolloutService := apiv1.NewRolloutService(stores, licenseService, dbFactory, planCheckScheduler, stateCfg, activityManager, profile, iamManager, artifactManager)
 	v1pb.RegisterRolloutServiceServer(grpcServer, rolloutService)
 	v1pb.RegisterRoleServiceServer(grpcServer, apiv1.NewRoleService(stores, iamManager, licenseService))
 	v1pb.RegisterSheetServiceServer(grpcServer, apiv1.NewSheetService(stores, licenseService))
@@ -92,73 +103,73 @@ func configureGrpcRouters(
 
 	// REST gateway proxy.
 	grpcEndpoint := fmt.Sprintf(":%d", profile.GrpcPort)
-	grpcConn, err := grpc.Dial(grpcEndpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	grpcConn, err := grpc.Dial(grpcEndpoint, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 
 	if err := v1pb.RegisterAuthServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterActuatorServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterSubscriptionServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterEnvironmentServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterInstanceServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterProjectServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterDatabaseServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterInstanceRoleServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterOrgPolicyServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterIdentityProviderServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterSettingServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterAnomalyServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterSQLServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterExternalVersionControlServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterRoleServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterSheetServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterRolloutServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterIssueServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterLoggingServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterInboxServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := v1pb.RegisterChangelistServiceHandler(ctx, mux, grpcConn); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
-	return rolloutService, issueService, nil
+	return rolloutService, issueService, sqlService, instanceService, nil
 }
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+const (
+	// healthCheckTimeout is the timeout of each dependency check.
+	healthCheckTimeout = 5 * time.Second
+	// schedulerStaleThreshold is the duration without a scheduling round after which the task scheduler is considered stuck.
+	schedulerStaleThreshold = 1 * time.Minute
+
+	healthStatusOK      = "ok"
+	healthStatusError   = "error"
+	healthStatusSkipped = "skipped"
+)
+
+// healthCheck is the result of a dependency check.
+type healthCheck struct {
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// healthResponse is the response of the liveness and readiness probes.
+type healthResponse struct {
+	Status string                  `json:"status"`
+	Checks map[string]*healthCheck `json:"checks"`
+}
+
+// healthzHandler is the liveness probe. It fails if the task scheduler is stuck, so that the server is restarted.
+func (s *Server) healthzHandler(c echo.Context) error {
+	return s.respondHealth(c, map[string]func(context.Context) *healthCheck{
+		"scheduler": s.checkScheduler,
+	})
+}
+
+// readyzHandler is the readiness probe. It fails if the server cannot serve the requests because of its dependencies.
+func (s *Server) readyzHandler(c echo.Context) error {
+	return s.respondHealth(c, map[string]func(context.Context) *healthCheck{
+		"metadata_db": s.checkMetadataDB,
+		"migration":   s.checkMigration,
+		"s3":          s.checkS3,
+		"scheduler":   s.checkScheduler,
+	})
+}
+
+// respondHealth runs the checks concurrently and responds 503 if any check fails.
+func (s *Server) respondHealth(c echo.Context, checks map[string]func(context.Context) *healthCheck) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), healthCheckTimeout)
+	defer cancel()
+
+	response := &healthResponse{Status: healthStatusOK, Checks: map[string]*healthCheck{}}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, check := range checks {
+		name, check := name, check
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := check(ctx)
+			mu.Lock()
+			defer mu.Unlock()
+			response.Checks[name] = result
+			if result.Status == healthStatusError {
+				response.Status = healthStatusError
+			}
+		}()
+	}
+	wg.Wait()
+
+	code := http.StatusOK
+	if response.Status != healthStatusOK {
+		code = http.StatusServiceUnavailable
+	}
+	return c.JSON(code, response)
+}
+
+func (s *Server) checkMetadataDB(ctx context.Context) *healthCheck {
+	if err := s.store.Ping(ctx); err != nil {
+		return newHealthCheckError(errors.Wrap(err, "failed to ping the metadata database"))
+	}
+	return &healthCheck{Status: healthStatusOK}
+}
+
+func (s *Server) checkMigration(context.Context) *healthCheck {
+	if s.profile.Readonly {
+		// The readonly server doesn't migrate the schema.
+		return &healthCheck{Status: healthStatusSkipped}
+	}
+	if s.schemaVersion == nil {
+		return newHealthCheckError(errors.New("the metadata schema is not migrated"))
+	}
+	return &healthCheck{Status: healthStatusOK, Message: s.schemaVersion.String()}
+}
+
+func (s *Server) checkS3(ctx context.Context) *healthCheck {
+	if s.s3Client == nil {
+		return &healthCheck{Status: healthStatusSkipped}
+	}
+	if err := s.s3Client.Ping(ctx); err != nil {
+		return newHealthCheckError(err)
+	}
+	return &healthCheck{Status: healthStatusOK}
+}
+
+func (s *Server) checkScheduler(context.Context) *healthCheck {
+	if s.profile.Readonly || s.taskSchedulerV2 == nil {
+		// The readonly server doesn't run the task scheduler.
+		return &healthCheck{Status: healthStatusSkipped}
+	}
+	lastRunTime := s.taskSchedulerV2.LastRunTime()
+	if lastRunTime.IsZero() {
+		return newHealthCheckError(errors.New("the task scheduler is not running"))
+	}
+	if elapsed := time.Since(lastRunTime); elapsed > schedulerStaleThreshold {
+		return newHealthCheckError(errors.Errorf("the task scheduler has not run for %v", elapsed.Truncate(time.Second)))
+	}
+	return &healthCheck{Status: healthStatusOK}
+}
+
+func newHealthCheckError(err error) *healthCheck {
+	return &healthCheck{Status: healthStatusError, Message: err.Error()}
+}
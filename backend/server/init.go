@@ -56,6 +56,19 @@ func (s *Server) getInitSetting(ctx context.Context, datastore *store.Store) (st
 		return "", "", 0, err
 	}
 
+	// initial masking salt
+	maskingSalt, err := common.RandomString(secretLength)
+	if err != nil {
+		return "", "", 0, errors.Wrap(err, "failed to generate random masking salt")
+	}
+	if _, _, err := datastore.CreateSettingIfNotExistV2(ctx, &store.SettingMessage{
+		Name:        api.SettingMaskingSalt,
+		Value:       maskingSalt,
+		Description: "Random string used as the salt of the deterministic masking algorithms.",
+	}, api.SystemBotID); err != nil {
+		return "", "", 0, err
+	}
+
 	// initial license
 	if _, _, err = datastore.CreateSettingIfNotExistV2(ctx, &store.SettingMessage{
 		Name:        api.SettingEnterpriseLicense,
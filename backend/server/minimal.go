@@ -14,6 +14,7 @@ import (
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/pg"
 
 	// Advisors.
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/custom"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/pg"
 
 	// Editors.
@@ -14,8 +14,10 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/pkg/errors"
 	"github.com/tmc/grpc-websocket-proxy/wsproxy"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
 
+	apiv1 "github.com/bytebase/bytebase/backend/api/v1"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 )
@@ -42,11 +44,12 @@ func configureEchoRouters(e *echo.Echo, grpcServer *grpc.Server, mux *grpcruntim
 	}))
 
 	grpcSkipper := func(c echo.Context) bool {
-		// Skip grpc and webhook calls.
+		// Skip grpc, webhook and SCIM provisioning calls.
 		return strings.HasPrefix(c.Request().URL.Path, "/bytebase.v1.") ||
 			strings.HasPrefix(c.Request().URL.Path, "/v1:adminExecute") ||
 			strings.HasPrefix(c.Request().URL.Path, lspAPI) ||
-			strings.HasPrefix(c.Request().URL.Path, webhookAPIPrefix)
+			strings.HasPrefix(c.Request().URL.Path, webhookAPIPrefix) ||
+			strings.HasPrefix(c.Request().URL.Path, scimAPIPrefix)
 	}
 	e.Use(middleware.TimeoutWithConfig(middleware.TimeoutConfig{
 		Skipper: grpcSkipper,
@@ -74,16 +77,14 @@ func configureEchoRouters(e *echo.Echo, grpcServer *grpc.Server, mux *grpcruntim
 	p := prometheus.NewPrometheus("api", nil)
 	p.Use(e)
 
-	e.GET("/healthz", func(c echo.Context) error {
-		return c.String(http.StatusOK, "OK")
-	})
 	e.GET("/v1:adminExecute", echo.WrapHandler(wsproxy.WebsocketProxy(
 		mux,
 		wsproxy.WithTokenCookieName("access-token"),
 		// 10M.
 		wsproxy.WithMaxRespBodyBufferSize(10*1024*1024),
 	)))
-	e.Any("/v1/*", echo.WrapHandler(mux))
+	// The trace context of the HTTP requests is propagated to the gRPC server through the gateway.
+	e.Any("/v1/*", echo.WrapHandler(otelhttp.NewHandler(mux, "grpc-gateway")))
 
 	// GRPC web proxy.
 	options := []grpcweb.Option{
@@ -100,6 +101,26 @@ func configureEchoRouters(e *echo.Echo, grpcServer *grpc.Server, mux *grpcruntim
 	e.Any("/bytebase.v1.*", echo.WrapHandler(wrappedGrpc))
 }
 
+// incomingHeaderMatcher forwards the request ID header of the HTTP request to the gRPC metadata,
+// other HTTP headers are matched by the gateway default.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, apiv1.RequestIDHeader) {
+		return apiv1.RequestIDHeader, true
+	}
+	return grpcruntime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher forwards the rate limit and request ID headers to the HTTP response as is,
+// other gRPC headers are prefixed by the gateway default.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	switch key {
+	case apiv1.RateLimitLimitHeader, apiv1.RateLimitRemainingHeader, apiv1.RateLimitRetryAfterHeader, apiv1.RequestIDHeader:
+		return key, true
+	default:
+		return grpcruntime.MetadataHeaderPrefix + key, true
+	}
+}
+
 func recoverMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		defer func() {
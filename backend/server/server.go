@@ -10,9 +10,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/blang/semver/v4"
 	grpcruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
@@ -21,15 +23,22 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 
 	"github.com/bytebase/bytebase/backend/api/auth"
+	"github.com/bytebase/bytebase/backend/api/auth/scim"
 	"github.com/bytebase/bytebase/backend/api/gitops"
 	"github.com/bytebase/bytebase/backend/api/lsp"
+	"github.com/bytebase/bytebase/backend/api/slack"
 	apiv1 "github.com/bytebase/bytebase/backend/api/v1"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/common/stacktrace"
+	"github.com/bytebase/bytebase/backend/common/tracing"
 	"github.com/bytebase/bytebase/backend/component/activity"
+	"github.com/bytebase/bytebase/backend/component/artifact"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/leader"
+	"github.com/bytebase/bytebase/backend/component/metadatabackup"
+	"github.com/bytebase/bytebase/backend/component/querycursor"
 	"github.com/bytebase/bytebase/backend/component/state"
 	"github.com/bytebase/bytebase/backend/demo"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
@@ -42,15 +51,29 @@ import (
 	"github.com/bytebase/bytebase/backend/resources/mysqlutil"
 	"github.com/bytebase/bytebase/backend/resources/postgres"
 	"github.com/bytebase/bytebase/backend/runner/approval"
+	"github.com/bytebase/bytebase/backend/runner/archive"
+	"github.com/bytebase/bytebase/backend/runner/auditlog"
 	"github.com/bytebase/bytebase/backend/runner/backuprun"
+	"github.com/bytebase/bytebase/backend/runner/changehistoryintegrity"
+	"github.com/bytebase/bytebase/backend/runner/grantexpiry"
+	"github.com/bytebase/bytebase/backend/runner/instancediscovery"
+	"github.com/bytebase/bytebase/backend/runner/issueschedule"
+	"github.com/bytebase/bytebase/backend/runner/jira"
+	"github.com/bytebase/bytebase/backend/runner/ldapsync"
 	"github.com/bytebase/bytebase/backend/runner/mail"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
 	"github.com/bytebase/bytebase/backend/runner/plancheck"
+	"github.com/bytebase/bytebase/backend/runner/queryhistory"
 	"github.com/bytebase/bytebase/backend/runner/relay"
 	"github.com/bytebase/bytebase/backend/runner/rollbackrun"
+	"github.com/bytebase/bytebase/backend/runner/sandbox"
+	"github.com/bytebase/bytebase/backend/runner/scheduledquery"
 	"github.com/bytebase/bytebase/backend/runner/schemasync"
+	"github.com/bytebase/bytebase/backend/runner/servicenow"
+	"github.com/bytebase/bytebase/backend/runner/slowqueryregression"
 	"github.com/bytebase/bytebase/backend/runner/slowquerysync"
 	"github.com/bytebase/bytebase/backend/runner/taskrun"
+	"github.com/bytebase/bytebase/backend/runner/taskrunlog"
 	"github.com/bytebase/bytebase/backend/store"
 	_ "github.com/bytebase/bytebase/docs/openapi" // initial the swagger doc
 )
@@ -58,6 +81,8 @@ import (
 const (
 	// webhookAPIPrefix is the API prefix for Bytebase webhook.
 	webhookAPIPrefix = "/hook"
+	// scimAPIPrefix is the API prefix for SCIM 2.0 provisioning.
+	scimAPIPrefix = "/scim/v2"
 	// lspAPI is the API for Bytebase Language Server Protocol.
 	lspAPI                 = "/lsp"
 	maxStacksize           = 1024 * 10240
@@ -77,7 +102,28 @@ type Server struct {
 	rollbackRunner     *rollbackrun.Runner
 	approvalRunner     *approval.Runner
 	relayRunner        *relay.Runner
-	runnerWG           sync.WaitGroup
+	taskRunLogRunner   *taskrunlog.Runner
+	sandboxRunner      *sandbox.Runner
+	queryHistoryRunner *queryhistory.Runner
+	archiveRunner      *archive.Runner
+	jiraRunner         *jira.Runner
+	serviceNowRunner   *servicenow.Runner
+	ldapSyncRunner     *ldapsync.Runner
+	grantExpiryRunner  *grantexpiry.Runner
+	auditLogRunner     *auditlog.Runner
+	// changeHistoryIntegrityRunner checks the new change histories, instead of checking all of them in each schema sync.
+	changeHistoryIntegrityRunner *changehistoryintegrity.Runner
+	// scheduledQueryRunner runs the queries with the SQL service, so it's created after the gRPC routers.
+	scheduledQueryRunner *scheduledquery.Runner
+	// issueScheduleRunner creates the issues with the issue and rollout services, so it's created after the gRPC routers.
+	issueScheduleRunner *issueschedule.Runner
+	// instanceDiscoveryRunner registers the instances with the instance service, so it's created after the gRPC routers.
+	instanceDiscoveryRunner *instancediscovery.Runner
+	// slowQueryRegressionRunner posts the weekly slow query regressions to the project webhooks.
+	slowQueryRegressionRunner *slowqueryregression.Runner
+	// leaderElector runs the singleton runners on the leader replica only.
+	leaderElector *leader.Elector
+	runnerWG      sync.WaitGroup
 
 	activityManager *activity.Manager
 	iamManager      *iam.Manager
@@ -107,11 +153,21 @@ type Server struct {
 	// PG server stoppers.
 	stopper []func()
 
-	s3Client *bbs3.Client
+	// schemaVersion is the metadata schema version after the migration, nil in the readonly mode.
+	schemaVersion *semver.Version
+
+	s3Client        *bbs3.Client
+	artifactManager *artifact.Manager
+	// metadataBackupManager backs up the metadata database on schedule.
+	metadataBackupManager *metadatabackup.Manager
+	cursorManager         *querycursor.Manager
 
 	// stateCfg is the shared in-momory state within the server.
 	stateCfg *state.State
 
+	// shutdownTracing flushes the traces not exported yet.
+	shutdownTracing func(context.Context) error
+
 	// boot specifies that whether the server boot correctly
 	cancel context.CancelFunc
 }
@@ -135,6 +191,8 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	slog.Info(fmt.Sprintf("backupBucket=%s", profile.BackupBucket))
 	slog.Info(fmt.Sprintf("backupRegion=%s", profile.BackupRegion))
 	slog.Info(fmt.Sprintf("backupCredentialFile=%s", profile.BackupCredentialFile))
+	slog.Info(fmt.Sprintf("metadataBackupInterval=%s", profile.MetadataBackupInterval))
+	slog.Info(fmt.Sprintf("traceEndpoint=%s", profile.TraceEndpoint))
 	slog.Info("-----Config END-------")
 
 	serverStarted := false
@@ -145,6 +203,15 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	}()
 
 	var err error
+	s.shutdownTracing, err = tracing.Setup(ctx, tracing.Config{
+		Endpoint:    profile.TraceEndpoint,
+		Insecure:    profile.TraceInsecure,
+		SampleRatio: profile.TraceSampleRatio,
+		Version:     profile.Version,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to set up tracing")
+	}
 	if err = os.MkdirAll(profile.ResourceDir, os.ModePerm); err != nil {
 		return nil, errors.Wrapf(err, "failed to create directory: %q", profile.ResourceDir)
 	}
@@ -200,6 +267,15 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		// return s so that caller can call s.Close() to shut down the postgres server if embedded.
 		return nil, errors.Wrap(err, "cannot open metadb")
 	}
+	if profile.PgReplicaURL != "" {
+		replicaCfg, err := store.GetConnectionConfig(profile.PgReplicaURL)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid metadb replica url")
+		}
+		if err := storeDB.OpenReplica(ctx, replicaCfg, profile.PgReplicaMaxLag); err != nil {
+			return nil, errors.Wrap(err, "cannot open metadb replica")
+		}
+	}
 	storeInstance, err := store.New(storeDB, &profile)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to new store")
@@ -210,9 +286,11 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		if err := demo.LoadDemoDataIfNeeded(ctx, storeDB, s.pgBinDir, profile.DemoName, profile.Mode); err != nil {
 			return nil, errors.Wrapf(err, "failed to load demo data")
 		}
-		if _, err := migrator.MigrateSchema(ctx, storeDB, storeInstance, s.pgBinDir, profile.Version, profile.Mode); err != nil {
+		schemaVersion, err := migrator.MigrateSchema(ctx, storeDB, storeInstance, s.pgBinDir, profile.Version, profile.Mode)
+		if err != nil {
 			return nil, err
 		}
+		s.schemaVersion = schemaVersion
 	}
 	s.store = storeInstance
 
@@ -251,7 +329,11 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	// Note: the gateway response modifier takes the external url on server startup. If the external URL is changed,
 	// the user has to restart the server to take the latest value.
 	gatewayModifier := auth.GatewayResponseModifier{ExternalURL: externalURL, TokenDuration: tokenDuration}
-	mux := grpcruntime.NewServeMux(grpcruntime.WithForwardResponseOption(gatewayModifier.Modify))
+	mux := grpcruntime.NewServeMux(
+		grpcruntime.WithForwardResponseOption(gatewayModifier.Modify),
+		grpcruntime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		grpcruntime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+	)
 
 	if profile.BackupBucket != "" {
 		credentials, err := bbs3.GetCredentialsFromFile(ctx, profile.BackupCredentialFile)
@@ -264,18 +346,32 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		}
 		s.s3Client = s3Client
 	}
+	s.artifactManager = artifact.NewManager(storeInstance, s.s3Client, &s.profile)
+	s.metadataBackupManager = metadatabackup.NewManager(&s.profile, s.pgBinDir, storeDB.ConnCfg, s.s3Client)
+	s.cursorManager = querycursor.NewManager(storeInstance)
 
 	s.metricReporter = metricreport.NewReporter(s.store, s.licenseService, &s.profile, false)
-	s.schemaSyncer = schemasync.NewSyncer(storeInstance, s.dbFactory, s.stateCfg, profile, s.licenseService)
+	s.schemaSyncer = schemasync.NewSyncer(storeInstance, s.dbFactory, s.stateCfg, profile, s.licenseService, s.activityManager)
 	if !profile.Readonly {
 		s.slowQuerySyncer = slowquerysync.NewSyncer(storeInstance, s.dbFactory, s.stateCfg, profile)
 		s.backupRunner = backuprun.NewRunner(storeInstance, s.dbFactory, s.s3Client, s.stateCfg, &profile)
-		s.rollbackRunner = rollbackrun.NewRunner(&profile, storeInstance, s.dbFactory, s.stateCfg)
+		s.rollbackRunner = rollbackrun.NewRunner(&profile, storeInstance, s.dbFactory, s.stateCfg, s.artifactManager)
 		s.mailSender = mail.NewSender(s.store, s.stateCfg)
 		s.relayRunner = relay.NewRunner(storeInstance, s.activityManager, s.stateCfg)
 		s.approvalRunner = approval.NewRunner(storeInstance, s.dbFactory, s.stateCfg, s.activityManager, s.relayRunner, s.licenseService)
-
-		s.taskSchedulerV2 = taskrun.NewSchedulerV2(storeInstance, s.stateCfg, s.activityManager)
+		s.taskRunLogRunner = taskrunlog.NewRunner(storeInstance, s.s3Client, s.artifactManager)
+		s.sandboxRunner = sandbox.NewRunner(storeInstance, s.dbFactory, s.schemaSyncer)
+		s.queryHistoryRunner = queryhistory.NewRunner(storeInstance)
+		s.archiveRunner = archive.NewRunner(storeInstance)
+		s.jiraRunner = jira.NewRunner(storeInstance, s.activityManager, s.relayRunner)
+		s.serviceNowRunner = servicenow.NewRunner(storeInstance)
+		s.ldapSyncRunner = ldapsync.NewRunner(storeInstance, s.licenseService)
+		s.grantExpiryRunner = grantexpiry.NewRunner(storeInstance, s.activityManager)
+		s.slowQueryRegressionRunner = slowqueryregression.NewRunner(storeInstance, s.activityManager)
+		s.auditLogRunner = auditlog.NewRunner(storeInstance)
+		s.changeHistoryIntegrityRunner = changehistoryintegrity.NewRunner(storeInstance)
+
+		s.taskSchedulerV2 = taskrun.NewSchedulerV2(storeInstance, s.stateCfg, s.activityManager, s.dbFactory)
 		s.taskSchedulerV2.Register(api.TaskGeneral, taskrun.NewDefaultExecutor())
 		s.taskSchedulerV2.Register(api.TaskDatabaseCreate, taskrun.NewDatabaseCreateExecutor(storeInstance, s.dbFactory, s.schemaSyncer, s.stateCfg, profile))
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaBaseline, taskrun.NewSchemaBaselineExecutor(storeInstance, s.dbFactory, s.activityManager, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
@@ -287,6 +383,8 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateGhostCutover, taskrun.NewSchemaUpdateGhostCutoverExecutor(storeInstance, s.dbFactory, s.activityManager, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
 		s.taskSchedulerV2.Register(api.TaskDatabaseRestorePITRRestore, taskrun.NewPITRRestoreExecutor(storeInstance, s.dbFactory, s.s3Client, s.schemaSyncer, s.stateCfg, profile))
 		s.taskSchedulerV2.Register(api.TaskDatabaseRestorePITRCutover, taskrun.NewPITRCutoverExecutor(storeInstance, s.dbFactory, s.schemaSyncer, s.stateCfg, s.backupRunner, s.activityManager, profile))
+		s.taskSchedulerV2.Register(api.TaskDatabaseDataProvision, taskrun.NewDataProvisionExecutor(storeInstance, s.dbFactory, s.stateCfg))
+		s.taskSchedulerV2.Register(api.TaskDatabaseDataMigrate, taskrun.NewDataMigrateExecutor(storeInstance, s.dbFactory, s.stateCfg))
 
 		s.planCheckScheduler = plancheck.NewScheduler(storeInstance, s.licenseService, s.stateCfg)
 		databaseConnectExecutor := plancheck.NewDatabaseConnectExecutor(storeInstance, s.dbFactory)
@@ -295,12 +393,16 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementType, statementTypeExecutor)
 		statementAdviseExecutor := plancheck.NewStatementAdviseExecutor(storeInstance, s.dbFactory, s.licenseService)
 		s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementAdvise, statementAdviseExecutor)
+		statementBulkAdviseExecutor := plancheck.NewStatementBulkAdviseExecutor(storeInstance, s.licenseService)
+		s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementBulkAdvise, statementBulkAdviseExecutor)
 		ghostSyncExecutor := plancheck.NewGhostSyncExecutor(storeInstance, s.secret)
 		s.planCheckScheduler.Register(store.PlanCheckDatabaseGhostSync, ghostSyncExecutor)
 		pitrMySQLExecutor := plancheck.NewPITRMySQLExecutor(storeInstance, s.dbFactory)
 		s.planCheckScheduler.Register(store.PlanCheckDatabasePITRMySQL, pitrMySQLExecutor)
 		statementReportExecutor := plancheck.NewStatementReportExecutor(storeInstance, s.dbFactory)
 		s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementSummaryReport, statementReportExecutor)
+		statementQueryPlanExecutor := plancheck.NewStatementQueryPlanExecutor(storeInstance, s.dbFactory)
+		s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementQueryPlan, statementQueryPlanExecutor)
 
 		// Metric reporter
 		s.initMetricReporter()
@@ -308,7 +410,10 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 
 	// Setup the gRPC and grpc-gateway.
 	authProvider := auth.New(s.store, s.secret, tokenDuration, s.licenseService, s.stateCfg, profile.Mode)
+	auditProvider := apiv1.NewAuditInterceptor(s.store)
 	aclProvider := apiv1.NewACLInterceptor(s.store, s.secret, s.licenseService, s.iamManager, &profile)
+	rateLimitProvider := apiv1.NewRateLimitInterceptor(s.store, s.metricReporter)
+	maintenanceProvider := apiv1.NewMaintenanceInterceptor(s.store)
 	debugProvider := apiv1.NewDebugInterceptor(&s.errorRecordRing, &profile, s.metricReporter)
 	onPanic := func(p any) error {
 		stack := stacktrace.TakeStacktrace(20 /* n */, 5 /* skip */)
@@ -322,18 +427,25 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	s.grpcServer = grpc.NewServer(
 		// Override the maximum receiving message size to 100M for uploading large sheets.
 		grpc.MaxRecvMsgSize(100*1024*1024),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.InitialWindowSize(100000000),
 		grpc.InitialConnWindowSize(100000000),
 		grpc.ChainUnaryInterceptor(
 			debugProvider.DebugInterceptor,
 			authProvider.AuthenticationInterceptor,
+			auditProvider.AuditInterceptor,
 			aclProvider.ACLInterceptor,
+			maintenanceProvider.MaintenanceInterceptor,
+			rateLimitProvider.RateLimitInterceptor,
 			recoveryUnaryInterceptor,
 		),
 		grpc.ChainStreamInterceptor(
 			debugProvider.DebugStreamInterceptor,
 			authProvider.AuthenticationStreamInterceptor,
+			auditProvider.AuditStreamInterceptor,
 			aclProvider.ACLStreamInterceptor,
+			maintenanceProvider.MaintenanceStreamInterceptor,
+			rateLimitProvider.RateLimitStreamInterceptor,
 			recoveryStreamInterceptor,
 		),
 	)
@@ -355,20 +467,40 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		}
 		return nil
 	}
-	rolloutService, issueService, err := configureGrpcRouters(ctx, mux, s.grpcServer, s.store, s.dbFactory, s.licenseService, &s.profile, s.metricReporter, s.stateCfg, s.schemaSyncer, s.activityManager, s.iamManager, s.backupRunner, s.relayRunner, s.planCheckScheduler, postCreateUser, s.secret, &s.errorRecordRing, tokenDuration)
+	rolloutService, issueService, sqlService, instanceService, err := configureGrpcRouters(ctx, mux, s.grpcServer, s.store, s.dbFactory, s.licenseService, &s.profile, s.metricReporter, s.stateCfg, s.schemaSyncer, s.activityManager, s.iamManager, s.backupRunner, s.relayRunner, s.planCheckScheduler, s.artifactManager, s.cursorManager, postCreateUser, s.secret, &s.errorRecordRing, tokenDuration)
 	if err != nil {
 		return nil, err
 	}
 	s.rolloutService, s.issueService = rolloutService, issueService
+	if !profile.Readonly {
+		s.scheduledQueryRunner = scheduledquery.NewRunner(s.store, s.activityManager, sqlService.ExecuteScheduledQuery)
+		s.issueScheduleRunner = issueschedule.NewRunner(s.store, issueService.CreateIssue, rolloutService.CreateRollout)
+		s.instanceDiscoveryRunner = instancediscovery.NewRunner(s.store, instanceService.CreateInstance)
+	}
 
 	webhookGroup := s.e.Group(webhookAPIPrefix)
 	gitOpsService := gitops.NewService(s.store, s.dbFactory, s.activityManager, s.stateCfg, s.licenseService, rolloutService, issueService)
 	gitOpsService.RegisterWebhookRoutes(webhookGroup)
+	slackService := slack.NewService(s.store, s.iamManager, issueService)
+	slackService.RegisterWebhookRoutes(webhookGroup)
+	if s.relayRunner != nil {
+		s.relayRunner.RegisterCallbackRoutes(webhookGroup)
+	}
+	if s.jiraRunner != nil {
+		s.jiraRunner.RegisterWebhookRoutes(webhookGroup)
+	}
+	if s.serviceNowRunner != nil {
+		s.serviceNowRunner.RegisterWebhookRoutes(webhookGroup)
+	}
+
+	scim.NewService(s.store, s.licenseService).RegisterRoutes(s.e.Group(scimAPIPrefix))
 
 	reflection.Register(s.grpcServer)
 
 	s.lspServer = lsp.NewServer(s.store)
 	s.e.GET(lspAPI, s.lspServer.Router)
+	s.e.GET("/healthz", s.healthzHandler)
+	s.e.GET("/readyz", s.readyzHandler)
 
 	serverStarted = true
 	return s, nil
@@ -380,32 +512,52 @@ func (s *Server) Run(ctx context.Context, port int) error {
 	s.cancel = cancel
 	if !s.profile.Readonly {
 		// runnerWG waits for all goroutines to complete.
-		if err := s.taskSchedulerV2.ClearRunningTaskRuns(ctx); err != nil {
-			return errors.Wrap(err, "failed to clear existing RUNNING tasks before starting the task scheduler")
-		}
+		// The RUNNING task runs left by a stopped replica are taken over once their heartbeats time out.
 		s.runnerWG.Add(1)
 		go s.taskSchedulerV2.Run(ctx, &s.runnerWG)
+		// The runners below work on the in-memory queues fed by the API of this replica, so they run on every replica.
 		s.runnerWG.Add(1)
 		go s.schemaSyncer.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
 		go s.slowQuerySyncer.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
-		go s.mailSender.Run(ctx, &s.runnerWG)
-		s.runnerWG.Add(1)
-		go s.backupRunner.Run(ctx, &s.runnerWG)
-		s.runnerWG.Add(1)
 		go s.rollbackRunner.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
 		go s.approvalRunner.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
 		go s.relayRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.activityManager.Run(ctx, &s.runnerWG)
 
 		s.runnerWG.Add(1)
 		go s.metricReporter.Run(ctx, &s.runnerWG)
 
+		// The singleton runners run on the leader replica only, so that their work is done once.
+		s.leaderElector = leader.NewElector(s.store)
+		s.leaderElector.Register(s.planCheckScheduler)
+		s.leaderElector.Register(s.mailSender)
+		s.leaderElector.Register(s.backupRunner)
+		s.leaderElector.Register(s.taskRunLogRunner)
+		s.leaderElector.Register(s.sandboxRunner)
+		s.leaderElector.Register(s.queryHistoryRunner)
+		s.leaderElector.Register(s.archiveRunner)
+		s.leaderElector.Register(s.jiraRunner)
+		s.leaderElector.Register(s.serviceNowRunner)
+		s.leaderElector.Register(s.ldapSyncRunner)
+		s.leaderElector.Register(s.grantExpiryRunner)
+		s.leaderElector.Register(s.auditLogRunner)
+		s.leaderElector.Register(s.slowQueryRegressionRunner)
+		s.leaderElector.Register(s.metadataBackupManager)
+		s.leaderElector.Register(s.scheduledQueryRunner)
+		s.leaderElector.Register(s.issueScheduleRunner)
+		s.leaderElector.Register(s.instanceDiscoveryRunner)
+		s.leaderElector.Register(s.changeHistoryIntegrityRunner)
 		s.runnerWG.Add(1)
-		go s.planCheckScheduler.Run(ctx, &s.runnerWG)
+		go s.leaderElector.Run(ctx, &s.runnerWG)
 	}
+	// The query cursors are read-only, so they are served by the readonly server as well.
+	s.runnerWG.Add(1)
+	go s.cursorManager.Run(ctx, &s.runnerWG)
 
 	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", port+1))
 	if err != nil {
@@ -475,5 +627,11 @@ func (s *Server) Shutdown(ctx context.Context) error {
 		stopper()
 	}
 
+	if s.shutdownTracing != nil {
+		if err := s.shutdownTracing(ctx); err != nil {
+			slog.Warn("Failed to flush the traces", log.BBError(err))
+		}
+	}
+
 	return nil
 }
@@ -247,8 +247,10 @@ type FindActivityMessage struct {
 	ContainerUID    *int
 	CreatedTsAfter  *int64
 	CreatedTsBefore *int64
-	Limit           *int
-	Offset          *int
+	// After lists the activities after the cursor in the order.
+	After  *KeysetCursor
+	Limit  *int
+	Offset *int
 	// If specified, sorts the returned list by id in <<ORDER>>
 	// Different use cases want different orders.
 	// e.g. Issue activity list wants ASC, while view recent activity list wants DESC.
@@ -268,8 +270,7 @@ type UpdateActivityMessage struct {
 // CreateActivityV2 creates an instance of Activity.
 // Certain types of activities are not stored in the database.
 func (s *Store) CreateActivityV2(ctx context.Context, create *ActivityMessage) (*ActivityMessage, error) {
-	switch create.Type {
-	case api.ActivityNotifyIssueApproved, api.ActivityNotifyPipelineRollout:
+	if isActivityNotStored(create.Type) {
 		return create, nil
 	}
 
@@ -295,6 +296,15 @@ func (s *Store) CreateActivityV2(ctx context.Context, create *ActivityMessage) (
 	return activityList[0], nil
 }
 
+// isActivityNotStored returns whether the activities of the type are only notified and not stored in the database.
+func isActivityNotStored(activityType api.ActivityType) bool {
+	switch activityType {
+	case api.ActivityNotifyIssueApproved, api.ActivityNotifyPipelineRollout, api.ActivityNotifySQLQueryAnomaly, api.ActivityNotifySQLScheduledQuery, api.ActivityNotifyRolloutFailed, api.ActivityNotifySchemaDrift, api.ActivityNotifySlowQueryRegression:
+		return true
+	}
+	return false
+}
+
 // BatchCreateActivityV2 creates activities in batch.
 func (s *Store) BatchCreateActivityV2(ctx context.Context, creates []*ActivityMessage) ([]*ActivityMessage, error) {
 	tx, err := s.db.BeginTx(ctx, nil)
@@ -409,8 +419,9 @@ func (s *Store) GetActivityV2(ctx context.Context, uid int) (*ActivityMessage, e
 }
 
 // ListActivityV2 lists the activity.
+// It reads from the replica if configured.
 func (s *Store) ListActivityV2(ctx context.Context, find *FindActivityMessage) ([]*ActivityMessage, error) {
-	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	tx, err := s.db.BeginReplicaTx(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -476,6 +487,15 @@ func listActivityImplV2(ctx context.Context, tx *Tx, find *FindActivityMessage)
 	if v := find.CreatedTsBefore; v != nil {
 		where, args = append(where, fmt.Sprintf("created_ts <= $%d", len(args)+1)), append(args, *v)
 	}
+	order := api.ASC
+	if v := find.Order; v != nil {
+		order = *v
+	}
+	if v := find.After; v != nil {
+		var condition string
+		condition, args = getKeysetCondition("activity", v, order, args)
+		where = append(where, condition)
+	}
 
 	query := `
 		SELECT
@@ -492,16 +512,14 @@ func listActivityImplV2(ctx context.Context, tx *Tx, find *FindActivityMessage)
 		FROM activity
 		WHERE ` + strings.Join(where, " AND ")
 
-	if v := find.Order; v != nil {
-		query += fmt.Sprintf(" ORDER BY id %s", *v)
-	} else {
-		query += " ORDER BY id ASC"
-	}
+	query += fmt.Sprintf(" ORDER BY created_ts %s, id %s", order, order)
 	if v := find.Limit; v != nil {
-		query += fmt.Sprintf(" LIMIT %d", *v)
+		query += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, *v)
 	}
 	if v := find.Offset; v != nil {
-		query += fmt.Sprintf(" OFFSET %d", *v)
+		query += fmt.Sprintf(" OFFSET $%d", len(args)+1)
+		args = append(args, *v)
 	}
 
 	rows, err := tx.QueryContext(ctx, query, args...)
@@ -0,0 +1,51 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/pkg/errors"
+)
+
+// AdvisoryLock is the session-level advisory lock held on a dedicated connection to the metadata database.
+// The lock is released with the session, so it's never held by a replica which lost its connection.
+type AdvisoryLock struct {
+	conn *sql.Conn
+}
+
+// TryAdvisoryLock tries to acquire the advisory lock of the key without waiting.
+// It returns nil if the lock is held by another session.
+func (s *Store) TryAdvisoryLock(ctx context.Context, key int64) (*AdvisoryLock, error) {
+	conn, err := s.db.db.Conn(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get connection")
+	}
+	lock := &AdvisoryLock{conn: conn}
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, key).Scan(&acquired); err != nil {
+		_ = lock.Release()
+		return nil, errors.Wrapf(err, "failed to acquire advisory lock %d", key)
+	}
+	if !acquired {
+		_ = lock.Release()
+		return nil, nil
+	}
+	return lock, nil
+}
+
+// Check checks that the lock is still held, i.e. the session holding it is alive.
+func (l *AdvisoryLock) Check(ctx context.Context) error {
+	return l.conn.PingContext(ctx)
+}
+
+// Release releases the lock by closing the session holding it.
+func (l *AdvisoryLock) Release() error {
+	// Discard the connection instead of returning it to the pool, so that the session-level lock is released with the session.
+	if err := l.conn.Raw(func(any) error {
+		return driver.ErrBadConn
+	}); err != nil && !errors.Is(err, driver.ErrBadConn) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const apiTokenColumns = "id, creator_id, created_ts, principal_id, title, token_hash, payload, expire_ts, last_used_ts, revoked_ts"
+
+// APITokenMessage is the message for an API token.
+type APITokenMessage struct {
+	PrincipalUID int
+	Title        string
+	// TokenHash is the hex-encoded SHA-256 hash of the token.
+	TokenHash string
+	Payload   *storepb.APITokenPayload
+	// ExpireTs is 0 if the token never expires.
+	ExpireTs int64
+
+	// Output only.
+	UID        int
+	CreatorUID int
+	CreatedTs  int64
+	// LastUsedTs is 0 if the token has never been used.
+	LastUsedTs int64
+	// RevokedTs is 0 if the token is not revoked.
+	RevokedTs int64
+}
+
+// FindAPITokenMessage is the message for finding API tokens.
+type FindAPITokenMessage struct {
+	UID          *int
+	PrincipalUID *int
+	TokenHash    *string
+}
+
+// CreateAPIToken creates an API token.
+func (s *Store) CreateAPIToken(ctx context.Context, create *APITokenMessage, creatorUID int) (*APITokenMessage, error) {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal API token payload")
+	}
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		INSERT INTO api_token (creator_id, principal_id, title, token_hash, payload, expire_ts)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING %s`, apiTokenColumns),
+		creatorUID, create.PrincipalUID, create.Title, create.TokenHash, payload, create.ExpireTs,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create API token")
+	}
+	defer rows.Close()
+	tokens, err := scanAPITokens(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) != 1 {
+		return nil, errors.Errorf("expect to create one API token, got %d", len(tokens))
+	}
+	return tokens[0], nil
+}
+
+// GetAPIToken gets an API token.
+func (s *Store) GetAPIToken(ctx context.Context, find *FindAPITokenMessage) (*APITokenMessage, error) {
+	tokens, err := s.ListAPITokens(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	if len(tokens) > 1 {
+		return nil, errors.Errorf("found %d API tokens with filter %+v, expect 1", len(tokens), find)
+	}
+	return tokens[0], nil
+}
+
+// ListAPITokens lists the API tokens in the reverse creation order.
+func (s *Store) ListAPITokens(ctx context.Context, find *FindAPITokenMessage) ([]*APITokenMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.PrincipalUID; v != nil {
+		where, args = append(where, fmt.Sprintf("principal_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.TokenHash; v != nil {
+		where, args = append(where, fmt.Sprintf("token_hash = $%d", len(args)+1)), append(args, *v)
+	}
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM api_token
+		WHERE %s
+		ORDER BY id DESC`, apiTokenColumns, strings.Join(where, " AND ")),
+		args...,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list API tokens")
+	}
+	defer rows.Close()
+	return scanAPITokens(rows)
+}
+
+// RevokeAPIToken revokes an API token.
+func (s *Store) RevokeAPIToken(ctx context.Context, uid int, revokedTs int64) (*APITokenMessage, error) {
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		UPDATE api_token
+		SET revoked_ts = $1
+		WHERE id = $2
+		RETURNING %s`, apiTokenColumns),
+		revokedTs, uid,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to revoke API token")
+	}
+	defer rows.Close()
+	tokens, err := scanAPITokens(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) != 1 {
+		return nil, errors.Errorf("API token %d not found", uid)
+	}
+	return tokens[0], nil
+}
+
+// UpdateAPITokenLastUsedTs updates the last used time of an API token.
+func (s *Store) UpdateAPITokenLastUsedTs(ctx context.Context, uid int, lastUsedTs int64) error {
+	if _, err := s.db.ExecContext(ctx, `UPDATE api_token SET last_used_ts = $1 WHERE id = $2`, lastUsedTs, uid); err != nil {
+		return errors.Wrapf(err, "failed to update the last used time of API token %d", uid)
+	}
+	return nil
+}
+
+func scanAPITokens(rows *sql.Rows) ([]*APITokenMessage, error) {
+	var tokens []*APITokenMessage
+	for rows.Next() {
+		token := &APITokenMessage{
+			Payload: &storepb.APITokenPayload{},
+		}
+		var payload []byte
+		if err := rows.Scan(
+			&token.UID,
+			&token.CreatorUID,
+			&token.CreatedTs,
+			&token.PrincipalUID,
+			&token.Title,
+			&token.TokenHash,
+			&payload,
+			&token.ExpireTs,
+			&token.LastUsedTs,
+			&token.RevokedTs,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan API token")
+		}
+		if err := protojsonUnmarshaler.Unmarshal(payload, token.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal API token payload")
+		}
+		tokens = append(tokens, token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan API tokens")
+	}
+	return tokens, nil
+}
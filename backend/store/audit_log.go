@@ -0,0 +1,154 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const auditLogColumns = "id, created_ts, creator_id, method, resource, payload"
+
+// AuditLogMessage is the message for an audit log.
+type AuditLogMessage struct {
+	// CreatorUID is 0 if the request is not authenticated.
+	CreatorUID int
+	Method     string
+	Resource   string
+	Payload    *storepb.AuditLogPayload
+
+	// Output only.
+	UID       int64
+	CreatedTs int64
+}
+
+// FindAuditLogMessage is the message for finding audit logs.
+type FindAuditLogMessage struct {
+	CreatorUID      *int
+	Method          *string
+	Resource        *string
+	Status          *string
+	CreatedTsAfter  *int64
+	CreatedTsBefore *int64
+	// AfterUID lists the audit logs after the ID, e.g. the last exported one.
+	AfterUID *int64
+	After    *KeysetCursor
+	Order    *api.SortOrder
+	Limit    *int
+}
+
+// CreateAuditLog creates an audit log.
+func (s *Store) CreateAuditLog(ctx context.Context, create *AuditLogMessage) error {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal audit log payload")
+	}
+	var creatorUID sql.NullInt32
+	if create.CreatorUID != 0 {
+		creatorUID = sql.NullInt32{Int32: int32(create.CreatorUID), Valid: true}
+	}
+	if _, err := s.db.ExecContext(ctx, `
+		INSERT INTO audit_log (creator_id, method, resource, payload)
+		VALUES ($1, $2, $3, $4)`,
+		creatorUID, create.Method, create.Resource, payload,
+	); err != nil {
+		return errors.Wrapf(err, "failed to create audit log")
+	}
+	return nil
+}
+
+// ListAuditLogs lists the audit logs in the (created_ts, id) order, ascending by default.
+func (s *Store) ListAuditLogs(ctx context.Context, find *FindAuditLogMessage) ([]*AuditLogMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.CreatorUID; v != nil {
+		where, args = append(where, fmt.Sprintf("audit_log.creator_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.Method; v != nil {
+		where, args = append(where, fmt.Sprintf("audit_log.method = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.Resource; v != nil {
+		where, args = append(where, fmt.Sprintf("audit_log.resource = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.Status; v != nil {
+		where, args = append(where, fmt.Sprintf("audit_log.payload->>'status' = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatedTsAfter; v != nil {
+		where, args = append(where, fmt.Sprintf("audit_log.created_ts >= $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatedTsBefore; v != nil {
+		where, args = append(where, fmt.Sprintf("audit_log.created_ts <= $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.AfterUID; v != nil {
+		where, args = append(where, fmt.Sprintf("audit_log.id > $%d", len(args)+1)), append(args, *v)
+	}
+	order := api.ASC
+	if v := find.Order; v != nil {
+		order = *v
+	}
+	if v := find.After; v != nil {
+		var condition string
+		condition, args = getKeysetCondition("audit_log", v, order, args)
+		where = append(where, condition)
+	}
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM audit_log
+		WHERE %s
+		ORDER BY audit_log.created_ts %s, audit_log.id %s`, auditLogColumns, strings.Join(where, " AND "), order, order)
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, *v)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list audit logs")
+	}
+	defer rows.Close()
+
+	var auditLogs []*AuditLogMessage
+	for rows.Next() {
+		auditLog := &AuditLogMessage{
+			Payload: &storepb.AuditLogPayload{},
+		}
+		var creatorUID sql.NullInt32
+		var payload []byte
+		if err := rows.Scan(
+			&auditLog.UID,
+			&auditLog.CreatedTs,
+			&creatorUID,
+			&auditLog.Method,
+			&auditLog.Resource,
+			&payload,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan audit log")
+		}
+		if creatorUID.Valid {
+			auditLog.CreatorUID = int(creatorUID.Int32)
+		}
+		if err := protojsonUnmarshaler.Unmarshal(payload, auditLog.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal audit log payload")
+		}
+		auditLogs = append(auditLogs, auditLog)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan audit logs")
+	}
+	return auditLogs, nil
+}
+
+// DeleteExpiredAuditLogs deletes the audit logs created before createdTsBefore with the ID not greater than maxUID,
+// and returns the number of the deleted audit logs.
+func (s *Store) DeleteExpiredAuditLogs(ctx context.Context, createdTsBefore int64, maxUID int64) (int64, error) {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM audit_log WHERE created_ts < $1 AND id <= $2`, createdTsBefore, maxUID)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to delete expired audit logs")
+	}
+	return result.RowsAffected()
+}
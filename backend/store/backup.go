@@ -183,6 +183,10 @@ type FindBackupMessage struct {
 	Status *api.BackupStatus
 	// backupUID is the UID of the backup.
 	backupUID *int
+	// After lists the backups after the cursor in the reverse creation order.
+	After  *KeysetCursor
+	Limit  *int
+	Offset *int
 }
 
 // UpdateBackupMessage is the message for updating backup.
@@ -567,6 +571,20 @@ func (*Store) listBackupImplV2(ctx context.Context, tx *Tx, find *FindBackupMess
 	if v := find.Status; v != nil {
 		where, args = append(where, fmt.Sprintf("status = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := find.After; v != nil {
+		var condition string
+		condition, args = getKeysetCondition("backup", v, api.DESC, args)
+		where = append(where, condition)
+	}
+	limitOffsetClause := ""
+	if v := find.Limit; v != nil {
+		limitOffsetClause = fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, *v)
+	}
+	if v := find.Offset; v != nil {
+		limitOffsetClause += fmt.Sprintf(" OFFSET $%d", len(args)+1)
+		args = append(args, *v)
+	}
 
 	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
 		SELECT
@@ -583,7 +601,9 @@ func (*Store) listBackupImplV2(ctx context.Context, tx *Tx, find *FindBackupMess
 			comment,
 			database_id,
 			payload
-		FROM backup WHERE %s;`, strings.Join(where, " AND ")), args...)
+		FROM backup
+		WHERE %s
+		ORDER BY backup.created_ts DESC, backup.id DESC%s;`, strings.Join(where, " AND "), limitOffsetClause), args...)
 	if err != nil {
 		return nil, err
 	}
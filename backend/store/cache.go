@@ -0,0 +1,95 @@
+package store
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/component/config"
+)
+
+// defaultCacheTTL is the default TTL of the cached entries.
+// The caches are invalidated on the writes of this server, the TTL bounds the staleness of the writes by the other replicas or from the database directly.
+const defaultCacheTTL = 10 * time.Minute
+
+// defaultCacheSizes is the default max entries of the caches by the namespace.
+// The caches by the name and by the ID of the same object share the namespace.
+var defaultCacheSizes = map[string]int{
+	"user":               2048,
+	"environment":        32,
+	"instance":           2048,
+	"database":           32768,
+	"project":            128,
+	"project_policy":     128,
+	"project_deployment": 128,
+	"policy":             128,
+	"issue":              256,
+	"pipeline":           256,
+	"setting":            64,
+	"idp":                4,
+	"risk":               1,
+	"database_group":     10,
+	"schema_group":       10,
+	"vcs":                10,
+	"role":               64,
+	"sheet":              10,
+	"db_schema":          100,
+	"user_session":       4096,
+}
+
+// defaultCacheTTLs are the default TTLs of the namespaces overriding defaultCacheTTL.
+var defaultCacheTTLs = map[string]time.Duration{
+	// The sessions are read for every request. The short TTL bounds the staleness of the revocations by the other replicas.
+	"user_session": 10 * time.Second,
+	// The roles are read for every permission check. The short TTL bounds the staleness of the permission revocations by the other replicas.
+	"role": 10 * time.Second,
+}
+
+// ValidateCacheConfig validates the namespaces and the values of the cache overrides in the profile.
+func ValidateCacheConfig(profile *config.Profile) error {
+	for namespace, size := range profile.CacheSizes {
+		if _, ok := defaultCacheSizes[namespace]; !ok {
+			return errors.Errorf("unknown cache namespace %q, should be one of %v", namespace, getCacheNamespaces())
+		}
+		if size <= 0 {
+			return errors.Errorf("max entries of cache namespace %q must be positive", namespace)
+		}
+	}
+	for namespace, ttl := range profile.CacheTTLs {
+		if _, ok := defaultCacheSizes[namespace]; !ok {
+			return errors.Errorf("unknown cache namespace %q, should be one of %v", namespace, getCacheNamespaces())
+		}
+		if ttl < 0 {
+			return errors.Errorf("TTL of cache namespace %q must not be negative", namespace)
+		}
+	}
+	return nil
+}
+
+func getCacheNamespaces() []string {
+	var namespaces []string
+	for namespace := range defaultCacheSizes {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// newCache creates the LRU cache of the namespace with the max entries and the TTL overridden by the profile.
+// A zero TTL never expires the entries.
+func newCache[K comparable, V any](profile *config.Profile, namespace string) *expirable.LRU[K, V] {
+	size := defaultCacheSizes[namespace]
+	if v, ok := profile.CacheSizes[namespace]; ok {
+		size = v
+	}
+	ttl := defaultCacheTTL
+	if v, ok := defaultCacheTTLs[namespace]; ok {
+		ttl = v
+	}
+	if v, ok := profile.CacheTTLs[namespace]; ok {
+		ttl = v
+	}
+	return expirable.NewLRU[K, V](size, nil, ttl)
+}
@@ -0,0 +1,44 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/component/config"
+)
+
+func TestValidateCacheConfig(t *testing.T) {
+	a := require.New(t)
+
+	a.NoError(ValidateCacheConfig(&config.Profile{}))
+	a.NoError(ValidateCacheConfig(&config.Profile{
+		CacheSizes: map[string]int{"database": 65536},
+		CacheTTLs:  map[string]time.Duration{"setting": 0, "user_session": time.Minute},
+	}))
+	a.Error(ValidateCacheConfig(&config.Profile{CacheSizes: map[string]int{"unknown": 1}}))
+	a.Error(ValidateCacheConfig(&config.Profile{CacheSizes: map[string]int{"issue": 0}}))
+	a.Error(ValidateCacheConfig(&config.Profile{CacheTTLs: map[string]time.Duration{"issue": -time.Second}}))
+}
+
+func TestNewCache(t *testing.T) {
+	a := require.New(t)
+
+	cache := newCache[int, string](&config.Profile{
+		CacheSizes: map[string]int{"sheet": 2},
+		CacheTTLs:  map[string]time.Duration{"sheet": 50 * time.Millisecond},
+	}, "sheet")
+	cache.Add(1, "a")
+	cache.Add(2, "b")
+	cache.Add(3, "c")
+	_, ok := cache.Get(1)
+	a.False(ok)
+	v, ok := cache.Get(3)
+	a.True(ok)
+	a.Equal("c", v)
+
+	time.Sleep(100 * time.Millisecond)
+	_, ok = cache.Get(3)
+	a.False(ok)
+}
@@ -1,5 +1,12 @@
 package store
 
+import (
+	"fmt"
+	"strings"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+)
+
 // RowStatus is the status for a row.
 type RowStatus string
 
@@ -9,3 +16,31 @@ const (
 	// Archived is the status for an archived row.
 	Archived RowStatus = "ARCHIVED"
 )
+
+// KeysetCursor is the (created_ts, id) of the last row of the previous page.
+// Listing the rows after the cursor instead of skipping an offset keeps the deep pages as fast as the first one.
+type KeysetCursor struct {
+	CreatedTs int64
+	ID        int
+}
+
+// getKeysetCondition returns the condition of the rows after the cursor in the (created_ts, id) order of the table.
+func getKeysetCondition(table string, cursor *KeysetCursor, order api.SortOrder, args []any) (string, []any) {
+	operator := ">"
+	if order == api.DESC {
+		operator = "<"
+	}
+	return fmt.Sprintf("(%s.created_ts, %s.id) %s ($%d, $%d)", table, table, operator, len(args)+1, len(args)+2), append(args, cursor.CreatedTs, cursor.ID)
+}
+
+// batchInsertSize is the max number of rows in a multi-row INSERT, which keeps the parameters below the limit of 65535.
+const batchInsertSize = 1000
+
+// getValuesPlaceholder returns the placeholder of the row in a multi-row INSERT, e.g. ($4, $5, $6) for the second row of 3 columns.
+func getValuesPlaceholder(row, columnCount int) string {
+	var placeholders []string
+	for i := 1; i <= columnCount; i++ {
+		placeholders = append(placeholders, fmt.Sprintf("$%d", row*columnCount+i))
+	}
+	return "(" + strings.Join(placeholders, ", ") + ")"
+}
@@ -51,6 +51,8 @@ type UpdateDatabaseMessage struct {
 
 	// MetadataUpsert upserts the top-level messages.
 	MetadataUpsert *storepb.DatabaseMetadata
+	// RemoveFreeze removes the freeze from the metadata.
+	RemoveFreeze bool
 }
 
 // FindDatabaseMessage is the message for finding databases.
@@ -204,7 +206,8 @@ func (*Store) createDatabaseDefaultImpl(ctx context.Context, tx *Tx, projectUID,
 			sync_status = EXCLUDED.sync_status,
 			last_successful_sync_ts = EXCLUDED.last_successful_sync_ts,
 			datashare = EXCLUDED.datashare,
-			service_name = EXCLUDED.service_name
+			service_name = EXCLUDED.service_name,
+			row_status = 'NORMAL'
 		RETURNING id`
 	var databaseUID int
 	if err := tx.QueryRowContext(ctx, query,
@@ -360,6 +363,10 @@ func (s *Store) UpdateDatabase(ctx context.Context, patch *UpdateDatabaseMessage
 	}
 	if v := patch.SyncState; v != nil {
 		set, args = append(set, fmt.Sprintf("sync_status = $%d", len(args)+1)), append(args, *v)
+		// The archived database is restored once it's found in the instance again.
+		if *v == api.OK {
+			set, args = append(set, fmt.Sprintf("row_status = $%d", len(args)+1)), append(args, api.Normal)
+		}
 	}
 	if v := patch.SuccessfulSyncTimeTs; v != nil {
 		set, args = append(set, fmt.Sprintf("last_successful_sync_ts = $%d", len(args)+1)), append(args, *v)
@@ -384,16 +391,23 @@ func (s *Store) UpdateDatabase(ctx context.Context, patch *UpdateDatabaseMessage
 	if v := patch.ServiceName; v != nil {
 		set, args = append(set, fmt.Sprintf("service_name = $%d", len(args)+1)), append(args, *v)
 	}
-	if v := patch.MetadataUpsert; v != nil {
-		metadataBytes, err := protojson.Marshal(v)
-		if err != nil {
-			return nil, err
+	if patch.MetadataUpsert != nil || patch.RemoveFreeze {
+		metadata := "metadata"
+		if v := patch.MetadataUpsert; v != nil {
+			metadataBytes, err := protojson.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			if v.Labels != nil && len(v.Labels) == 0 {
+				metadata, args = fmt.Sprintf("%s || $%d || $%d", metadata, len(args)+1, len(args)+2), append(args, metadataBytes, `{"labels": {}}`)
+			} else {
+				metadata, args = fmt.Sprintf("%s || $%d", metadata, len(args)+1), append(args, metadataBytes)
+			}
 		}
-		if v.Labels != nil && len(v.Labels) == 0 {
-			set, args = append(set, fmt.Sprintf("metadata = metadata || $%d || $%d", len(args)+1, len(args)+2)), append(args, metadataBytes, `{"labels": {}}`)
-		} else {
-			set, args = append(set, fmt.Sprintf("metadata = metadata || $%d", len(args)+1)), append(args, metadataBytes)
+		if patch.RemoveFreeze {
+			metadata = fmt.Sprintf("(%s) - 'freeze'", metadata)
 		}
+		set = append(set, fmt.Sprintf("metadata = %s", metadata))
 	}
 	args = append(args, instance.UID, patch.DatabaseName)
 
@@ -443,6 +457,25 @@ func (s *Store) UpdateDatabase(ctx context.Context, patch *UpdateDatabaseMessage
 	return s.GetDatabaseV2(ctx, &FindDatabaseMessage{UID: &databaseUID})
 }
 
+// ArchiveStaleDatabases archives the databases not found in the instances since the timestamp.
+// It returns the number of the archived databases.
+func (s *Store) ArchiveStaleDatabases(ctx context.Context, lastSuccessfulSyncTsBefore int64) (int64, error) {
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE db
+		SET row_status = $1
+		WHERE row_status = $2 AND sync_status = $3 AND last_successful_sync_ts < $4`,
+		api.Archived, api.Normal, api.NotFound, lastSuccessfulSyncTsBefore,
+	)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to archive stale databases")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get the number of archived databases")
+	}
+	return count, nil
+}
+
 // BatchUpdateDatabaseProject updates the project for databases in batch.
 func (s *Store) BatchUpdateDatabaseProject(ctx context.Context, databases []*DatabaseMessage, projectID string, updaterID int) ([]*DatabaseMessage, error) {
 	if len(databases) == 0 {
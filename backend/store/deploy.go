@@ -45,12 +45,23 @@ type Schedule struct {
 func (s *Schedule) toAPIDeploymentSchedule() *api.DeploymentSchedule {
 	var deployments []*api.Deployment
 	for _, d := range s.Deployments {
-		deployments = append(deployments, &api.Deployment{
+		deployment := &api.Deployment{
 			Name: d.Name,
 			Spec: &api.DeploymentSpec{
-				Selector: d.Spec.Selector.toAPILabelSelector(),
+				Selector:   d.Spec.Selector.toAPILabelSelector(),
+				Expression: d.Spec.Expression,
 			},
-		})
+		}
+		if c := d.Canary; c != nil {
+			deployment.Canary = &api.DeploymentCanary{
+				Percentage:           c.Percentage,
+				Databases:            c.Databases,
+				BakeSeconds:          c.BakeSeconds,
+				MaxFailurePercentage: c.MaxFailurePercentage,
+				PostCheckStatements:  c.PostCheckStatements,
+			}
+		}
+		deployments = append(deployments, deployment)
 	}
 	return &api.DeploymentSchedule{
 		Deployments: deployments,
@@ -59,13 +70,27 @@ func (s *Schedule) toAPIDeploymentSchedule() *api.DeploymentSchedule {
 
 // Deployment is the message for deployment.
 type Deployment struct {
-	Name string          `json:"name"`
-	Spec *DeploymentSpec `json:"spec"`
+	Name   string            `json:"name"`
+	Spec   *DeploymentSpec   `json:"spec"`
+	Canary *DeploymentCanary `json:"canary,omitempty"`
+}
+
+// DeploymentCanary is the message for the canary of a deployment.
+type DeploymentCanary struct {
+	// Percentage is the percentage of the databases of the deployment to roll out first, it's ignored if the databases are set.
+	Percentage int32 `json:"percentage,omitempty"`
+	// Databases are the databases to roll out first, in the format of instances/{instance}/databases/{database}.
+	Databases            []string `json:"databases,omitempty"`
+	BakeSeconds          int64    `json:"bakeSeconds,omitempty"`
+	MaxFailurePercentage int32    `json:"maxFailurePercentage,omitempty"`
+	PostCheckStatements  []string `json:"postCheckStatements,omitempty"`
 }
 
 // DeploymentSpec is the message for deployment specification.
 type DeploymentSpec struct {
 	Selector *LabelSelector `json:"selector"`
+	// Expression is the CEL expression matching the databases, it takes precedence over the selector.
+	Expression string `json:"expression,omitempty"`
 }
 
 // LabelSelector is the message for label selector.
@@ -76,6 +101,9 @@ type LabelSelector struct {
 
 func (ls *LabelSelector) toAPILabelSelector() *api.LabelSelector {
 	labelSelector := &api.LabelSelector{}
+	if ls == nil {
+		return labelSelector
+	}
 	for _, r := range ls.MatchExpressions {
 		operatorTp := api.InOperatorType
 		switch r.Operator {
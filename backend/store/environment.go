@@ -18,6 +18,8 @@ type EnvironmentMessage struct {
 	Title      string
 	Order      int32
 	Protected  bool
+	// Class is the environment class whose policies are inherited by the environment.
+	Class api.EnvironmentClass
 
 	// The following fields are output only and not used for create().
 	UID     int
@@ -38,6 +40,7 @@ type UpdateEnvironmentMessage struct {
 	Name      *string
 	Order     *int32
 	Protected *bool
+	Class     *api.EnvironmentClass
 	Delete    *bool
 }
 
@@ -118,15 +121,17 @@ func (s *Store) CreateEnvironmentV2(ctx context.Context, create *EnvironmentMess
 				resource_id,
 				name,
 				"order",
+				class,
 				creator_id,
 				updater_id
 			)
-			VALUES ($1, $2, $3, $4, $5)
+			VALUES ($1, $2, $3, $4, $5, $6)
 			RETURNING id
 		`,
 		create.ResourceID,
 		create.Title,
 		create.Order,
+		string(create.Class),
 		creatorID,
 		creatorID,
 	).Scan(
@@ -163,6 +168,7 @@ func (s *Store) CreateEnvironmentV2(ctx context.Context, create *EnvironmentMess
 		Title:      create.Title,
 		Order:      create.Order,
 		Protected:  create.Protected,
+		Class:      create.Class,
 		UID:        uid,
 		Deleted:    false,
 	}
@@ -180,6 +186,9 @@ func (s *Store) UpdateEnvironmentV2(ctx context.Context, environmentID string, p
 	if v := patch.Order; v != nil {
 		set, args = append(set, fmt.Sprintf(`"order" = $%d`, len(args)+1)), append(args, *v)
 	}
+	if v := patch.Class; v != nil {
+		set, args = append(set, fmt.Sprintf("class = $%d", len(args)+1)), append(args, string(*v))
+	}
 	if v := patch.Delete; v != nil {
 		rowStatus := api.Normal
 		if *patch.Delete {
@@ -279,6 +288,7 @@ func listEnvironmentImplV2(ctx context.Context, tx *Tx, find *FindEnvironmentMes
 			environment.resource_id,
 			environment.name,
 			environment.order,
+			environment.class,
 			environment.row_status,
 			policy.payload
 		FROM environment
@@ -300,6 +310,7 @@ func listEnvironmentImplV2(ctx context.Context, tx *Tx, find *FindEnvironmentMes
 			&environment.ResourceID,
 			&environment.Title,
 			&environment.Order,
+			&environment.Class,
 			&rowStatus,
 			&tierPayload,
 		); err != nil {
@@ -0,0 +1,185 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ExportAuditMessage is the message for the audit records of the exports of query results.
+type ExportAuditMessage struct {
+	CreatorUID  int
+	InstanceUID int
+	// DatabaseUID is nil if the export is not against a database.
+	DatabaseUID   *int
+	Statement     string
+	Format        string
+	Columns       []string
+	MaskedColumns []string
+	RowCount      int64
+	Admin         bool
+	Watermark     string
+
+	// Output only.
+	UID       int64
+	CreatedTs int64
+}
+
+// FindExportAuditMessage is the message for finding export audits.
+type FindExportAuditMessage struct {
+	UID             *int64
+	CreatorUID      *int
+	InstanceUID     *int
+	DatabaseUID     *int
+	CreatedTsAfter  *int64
+	CreatedTsBefore *int64
+	Limit           *int
+	Offset          *int
+}
+
+// CreateExportAudit creates an export audit.
+func (s *Store) CreateExportAudit(ctx context.Context, create *ExportAuditMessage) (*ExportAuditMessage, error) {
+	columns, err := json.Marshal(nonNilStrings(create.Columns))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal columns")
+	}
+	maskedColumns, err := json.Marshal(nonNilStrings(create.MaskedColumns))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal masked columns")
+	}
+	query := `
+		INSERT INTO export_audit (
+			creator_id,
+			instance_id,
+			database_id,
+			statement,
+			format,
+			columns,
+			masked_columns,
+			row_count,
+			admin,
+			watermark
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, created_ts
+	`
+	exportAudit := *create
+	if err := s.db.QueryRowContext(ctx, query,
+		create.CreatorUID,
+		create.InstanceUID,
+		create.DatabaseUID,
+		create.Statement,
+		create.Format,
+		columns,
+		maskedColumns,
+		create.RowCount,
+		create.Admin,
+		create.Watermark,
+	).Scan(&exportAudit.UID, &exportAudit.CreatedTs); err != nil {
+		return nil, errors.Wrapf(err, "failed to create export audit")
+	}
+	return &exportAudit, nil
+}
+
+// ListExportAudits lists export audits in the reverse creation order.
+func (s *Store) ListExportAudits(ctx context.Context, find *FindExportAuditMessage) ([]*ExportAuditMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatorUID; v != nil {
+		where, args = append(where, fmt.Sprintf("creator_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.InstanceUID; v != nil {
+		where, args = append(where, fmt.Sprintf("instance_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.DatabaseUID; v != nil {
+		where, args = append(where, fmt.Sprintf("database_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatedTsAfter; v != nil {
+		where, args = append(where, fmt.Sprintf("created_ts >= $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatedTsBefore; v != nil {
+		where, args = append(where, fmt.Sprintf("created_ts <= $%d", len(args)+1)), append(args, *v)
+	}
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			creator_id,
+			created_ts,
+			instance_id,
+			database_id,
+			statement,
+			format,
+			columns,
+			masked_columns,
+			row_count,
+			admin,
+			watermark
+		FROM export_audit
+		WHERE %s
+		ORDER BY id DESC`, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, *v)
+	}
+	if v := find.Offset; v != nil {
+		query += fmt.Sprintf(" OFFSET $%d", len(args)+1)
+		args = append(args, *v)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list export audits")
+	}
+	defer rows.Close()
+
+	var exportAudits []*ExportAuditMessage
+	for rows.Next() {
+		var exportAudit ExportAuditMessage
+		var databaseUID sql.NullInt32
+		var columns, maskedColumns []byte
+		if err := rows.Scan(
+			&exportAudit.UID,
+			&exportAudit.CreatorUID,
+			&exportAudit.CreatedTs,
+			&exportAudit.InstanceUID,
+			&databaseUID,
+			&exportAudit.Statement,
+			&exportAudit.Format,
+			&columns,
+			&maskedColumns,
+			&exportAudit.RowCount,
+			&exportAudit.Admin,
+			&exportAudit.Watermark,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan export audit")
+		}
+		if databaseUID.Valid {
+			v := int(databaseUID.Int32)
+			exportAudit.DatabaseUID = &v
+		}
+		if err := json.Unmarshal(columns, &exportAudit.Columns); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal columns")
+		}
+		if err := json.Unmarshal(maskedColumns, &exportAudit.MaskedColumns); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal masked columns")
+		}
+		exportAudits = append(exportAudits, &exportAudit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan export audits")
+	}
+	return exportAudits, nil
+}
+
+// nonNilStrings keeps the nil slices from being marshaled into JSON null.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
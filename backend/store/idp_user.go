@@ -0,0 +1,72 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// IdentityProviderUserMessage is the message of a user signed in through an identity provider.
+type IdentityProviderUserMessage struct {
+	IdentityProviderUID int
+	PrincipalUID        int
+	// Identifier is the unique identifier of the user in the identity provider.
+	Identifier string
+}
+
+// FindIdentityProviderUserMessage is the message for finding identity provider users.
+type FindIdentityProviderUserMessage struct {
+	IdentityProviderUID *int
+	PrincipalUID        *int
+}
+
+// UpsertIdentityProviderUser records the user signed in through the identity provider.
+func (s *Store) UpsertIdentityProviderUser(ctx context.Context, upsert *IdentityProviderUserMessage) error {
+	if _, err := s.db.ExecContext(ctx, `
+		INSERT INTO idp_user (idp_id, principal_id, identifier)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (idp_id, principal_id) DO UPDATE SET
+			identifier = EXCLUDED.identifier`,
+		upsert.IdentityProviderUID, upsert.PrincipalUID, upsert.Identifier,
+	); err != nil {
+		return errors.Wrapf(err, "failed to upsert identity provider user")
+	}
+	return nil
+}
+
+// ListIdentityProviderUsers lists the users signed in through the identity providers.
+func (s *Store) ListIdentityProviderUsers(ctx context.Context, find *FindIdentityProviderUserMessage) ([]*IdentityProviderUserMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.IdentityProviderUID; v != nil {
+		where, args = append(where, fmt.Sprintf("idp_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.PrincipalUID; v != nil {
+		where, args = append(where, fmt.Sprintf("principal_id = $%d", len(args)+1)), append(args, *v)
+	}
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT idp_id, principal_id, identifier
+		FROM idp_user
+		WHERE %s
+		ORDER BY id`, strings.Join(where, " AND ")),
+		args...,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list identity provider users")
+	}
+	defer rows.Close()
+
+	var users []*IdentityProviderUserMessage
+	for rows.Next() {
+		user := &IdentityProviderUserMessage{}
+		if err := rows.Scan(&user.IdentityProviderUID, &user.PrincipalUID, &user.Identifier); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan identity provider user")
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan identity provider users")
+	}
+	return users, nil
+}
@@ -210,10 +210,12 @@ func findInboxImpl(ctx context.Context, tx *Tx, find *FindInboxMessage) ([]*Inbo
 		ORDER BY activity.created_ts DESC`
 
 	if v := find.Limit; v != nil {
-		query += fmt.Sprintf(" LIMIT %d", *v)
+		query += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, *v)
 	}
 	if v := find.Offset; v != nil {
-		query += fmt.Sprintf(" OFFSET %d", *v)
+		query += fmt.Sprintf(" OFFSET $%d", len(args)+1)
+		args = append(args, *v)
 	}
 
 	rows, err := tx.QueryContext(ctx, query,
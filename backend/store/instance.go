@@ -479,7 +479,7 @@ func (s *Store) listInstanceImplV2(ctx context.Context, tx *Tx, find *FindInstan
 
 // FindInstanceWithDatabaseBackupEnabled finds instances with at least one database who enables backup policy.
 func (s *Store) FindInstanceWithDatabaseBackupEnabled(ctx context.Context) ([]*InstanceMessage, error) {
-	rows, err := s.db.db.QueryContext(ctx, `
+	rows, err := s.db.QueryContext(ctx, `
 		SELECT DISTINCT
 			instance.id
 		FROM instance
@@ -524,7 +524,7 @@ var countActivateInstanceQuery = "SELECT COUNT(*) FROM instance WHERE activation
 // CheckActivationLimit checks if activation instance count reaches the limit.
 func (s *Store) CheckActivationLimit(ctx context.Context, maximumActivation int) error {
 	count := 0
-	if err := s.db.db.QueryRowContext(ctx, countActivateInstanceQuery).Scan(&count); err != nil {
+	if err := s.db.QueryRowContext(ctx, countActivateInstanceQuery).Scan(&count); err != nil {
 		return err
 	}
 
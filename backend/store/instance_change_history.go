@@ -12,9 +12,11 @@ import (
 	"github.com/google/cel-go/checker/decls"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 
+	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/store/model"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -81,6 +83,7 @@ type UpdateInstanceChangeHistoryMessage struct {
 	Schema              *string
 	SchemaPrev          *string
 	Sheet               *int
+	Payload             *storepb.InstanceChangeHistoryPayload
 }
 
 // CreateInstanceChangeHistoryForMigrator creates an instance change history for migrator.
@@ -98,6 +101,67 @@ func (s *Store) CreateInstanceChangeHistoryForMigrator(ctx context.Context, crea
 }
 
 func (*Store) createInstanceChangeHistoryImpl(ctx context.Context, tx *Tx, create *InstanceChangeHistoryMessage) (string, error) {
+	uids, err := createInstanceChangeHistoriesImpl(ctx, tx, []*InstanceChangeHistoryMessage{create})
+	if err != nil {
+		return "", err
+	}
+	return uids[0], nil
+}
+
+// createInstanceChangeHistoriesImpl creates the instance change histories with a multi-row INSERT.
+// The created_ts and updated_ts default to now unless CreatedTs is set.
+func createInstanceChangeHistoriesImpl(ctx context.Context, tx *Tx, creates []*InstanceChangeHistoryMessage) ([]string, error) {
+	const columnCount = 19
+	var values []string
+	var args []any
+	for i, create := range creates {
+		payload, err := protojson.Marshal(create.Payload)
+		if err != nil {
+			return nil, err
+		}
+		storedVersion, err := create.Version.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		statement := create.Statement
+		if create.SheetID != nil {
+			statement = ""
+		}
+		var createdTs *int64
+		if create.CreatedTs != 0 {
+			createdTs = &create.CreatedTs
+		}
+
+		var placeholders []string
+		for j := 1; j < columnCount; j++ {
+			placeholders = append(placeholders, fmt.Sprintf("$%d", i*columnCount+j))
+		}
+		// The created_ts and updated_ts share the last parameter.
+		createdTsPlaceholder := fmt.Sprintf("COALESCE($%d::BIGINT, extract(epoch from now())::BIGINT)", (i+1)*columnCount)
+		placeholders = append(placeholders, createdTsPlaceholder, createdTsPlaceholder)
+		values = append(values, "("+strings.Join(placeholders, ", ")+")")
+		args = append(args,
+			create.CreatorID,
+			create.CreatorID,
+			create.InstanceUID,
+			create.DatabaseUID,
+			create.IssueUID,
+			create.ReleaseVersion,
+			create.Sequence,
+			create.Source,
+			create.Type,
+			create.Status,
+			storedVersion,
+			create.Description,
+			statement,
+			create.Schema,
+			create.SheetID,
+			create.SchemaPrev,
+			create.ExecutionDurationNs,
+			payload,
+			createdTs,
+		)
+	}
 	query := `
 		INSERT INTO instance_change_history (
 			creator_id,
@@ -117,49 +181,29 @@ func (*Store) createInstanceChangeHistoryImpl(ctx context.Context, tx *Tx, creat
 			sheet_id,
 			schema_prev,
 			execution_duration_ns,
-			payload
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+			payload,
+			created_ts,
+			updated_ts
+		) VALUES ` + strings.Join(values, ", ") + `
 		RETURNING id`
 
-	payload, err := protojson.Marshal(create.Payload)
-	if err != nil {
-		return "", err
-	}
-	storedVersion, err := create.Version.Marshal()
+	rows, err := tx.QueryContext(ctx, query, args...)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-
-	statement := create.Statement
-	if create.SheetID != nil {
-		statement = ""
+	defer rows.Close()
+	var uids []string
+	for rows.Next() {
+		var uid string
+		if err := rows.Scan(&uid); err != nil {
+			return nil, err
+		}
+		uids = append(uids, uid)
 	}
-
-	var uid string
-	if err := tx.QueryRowContext(ctx, query,
-		create.CreatorID,
-		create.CreatorID,
-		create.InstanceUID,
-		create.DatabaseUID,
-		create.IssueUID,
-		create.ReleaseVersion,
-		create.Sequence,
-		create.Source,
-		create.Type,
-		create.Status,
-		storedVersion,
-		create.Description,
-		statement,
-		create.Schema,
-		create.SheetID,
-		create.SchemaPrev,
-		create.ExecutionDurationNs,
-		payload,
-	).Scan(&uid); err != nil {
-		return "", err
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
-
-	return uid, nil
+	return uids, nil
 }
 
 func (*Store) createInstanceChangeHistoryImplForMigrator(ctx context.Context, tx *Tx, create *InstanceChangeHistoryMessage) (string, error) {
@@ -525,10 +569,12 @@ func (s *Store) ListInstanceChangeHistory(ctx context.Context, find *FindInstanc
 		LEFT JOIN db on db.id = instance_change_history.database_id
 		WHERE `+strings.Join(where, " AND ")+` ORDER BY instance_change_history.instance_id, instance_change_history.database_id, instance_change_history.sequence DESC`, statementField, schemaField, schemaPrevField, sheetField)
 	if v := find.Limit; v != nil {
-		query += fmt.Sprintf(" LIMIT %d", *v)
+		query += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, *v)
 	}
 	if v := find.Offset; v != nil {
-		query += fmt.Sprintf(" OFFSET %d", *v)
+		query += fmt.Sprintf(" OFFSET $%d", len(args)+1)
+		args = append(args, *v)
 	}
 
 	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
@@ -671,6 +717,13 @@ func (s *Store) UpdateInstanceChangeHistory(ctx context.Context, update *UpdateI
 	if v := update.Sheet; v != nil {
 		set, args = append(set, fmt.Sprintf("sheet_id = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := update.Payload; v != nil {
+		payload, err := protojson.Marshal(v)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal payload")
+		}
+		set, args = append(set, fmt.Sprintf("payload = $%d", len(args)+1)), append(args, payload)
+	}
 	if len(set) == 0 {
 		return nil
 	}
@@ -727,6 +780,13 @@ func (s *Store) CreatePendingInstanceChangeHistory(ctx context.Context, prevSche
 	if err != nil {
 		return "", err
 	}
+	// The checksum is computed on the statement recorded in the change history when the change is applied,
+	// so that the later edits of the sheet are not reported as tampering.
+	payload := &storepb.InstanceChangeHistoryPayload{}
+	if m.Payload != nil {
+		payload = proto.Clone(m.Payload).(*storepb.InstanceChangeHistoryPayload)
+	}
+	payload.StatementSha256 = common.SHA256Hex(statement)
 	instanceChange := &InstanceChangeHistoryMessage{
 		CreatorID:           m.CreatorID,
 		InstanceUID:         m.InstanceID,
@@ -744,7 +804,7 @@ func (s *Store) CreatePendingInstanceChangeHistory(ctx context.Context, prevSche
 		Schema:              prevSchema,
 		SchemaPrev:          prevSchema,
 		ExecutionDurationNs: 0,
-		Payload:             m.Payload,
+		Payload:             payload,
 	}
 	var uid string
 	if instanceChange.InstanceUID == nil {
@@ -768,6 +828,51 @@ func (s *Store) CreatePendingInstanceChangeHistory(ctx context.Context, prevSche
 	return uid, nil
 }
 
+// CreateInstanceChangeHistories creates the instance change histories in a transaction with multi-row INSERTs.
+// The histories are appended in order to the sequences of their databases, and the created_ts is set to CreatedTs if it's set.
+func (s *Store) CreateInstanceChangeHistories(ctx context.Context, creates []*InstanceChangeHistoryMessage) ([]string, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	type databaseKey struct {
+		instanceUID int
+		databaseUID int
+	}
+	nextSequences := make(map[databaseKey]int64)
+	for _, create := range creates {
+		var key databaseKey
+		if create.InstanceUID != nil && create.DatabaseUID != nil {
+			key = databaseKey{instanceUID: *create.InstanceUID, databaseUID: *create.DatabaseUID}
+		}
+		if _, ok := nextSequences[key]; !ok {
+			nextSequence, err := s.getNextInstanceChangeHistorySequence(ctx, tx, create.InstanceUID, create.DatabaseUID)
+			if err != nil {
+				return nil, err
+			}
+			nextSequences[key] = nextSequence
+		}
+		create.Sequence = nextSequences[key]
+		nextSequences[key]++
+	}
+
+	var uids []string
+	for i := 0; i < len(creates); i += batchInsertSize {
+		batch, err := createInstanceChangeHistoriesImpl(ctx, tx, creates[i:min(i+batchInsertSize, len(creates))])
+		if err != nil {
+			return nil, err
+		}
+		uids = append(uids, batch...)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return uids, nil
+}
+
 // ListInstanceChangeHistoryForMigrator finds the instance change history for the migrator,
 // the users are not composed.
 // The sheet_id is not loaded.
@@ -838,10 +943,12 @@ func (s *Store) ListInstanceChangeHistoryForMigrator(ctx context.Context, find *
 		LEFT JOIN db on db.id = instance_change_history.database_id
 		WHERE `+strings.Join(where, " AND ")+` ORDER BY instance_change_history.instance_id, instance_change_history.database_id, instance_change_history.sequence DESC`, statementField, schemaField, schemaPrevField)
 	if v := find.Limit; v != nil {
-		query += fmt.Sprintf(" LIMIT %d", *v)
+		query += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, *v)
 	}
 	if v := find.Offset; v != nil {
-		query += fmt.Sprintf(" OFFSET %d", *v)
+		query += fmt.Sprintf(" OFFSET $%d", len(args)+1)
+		args = append(args, *v)
 	}
 
 	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
@@ -923,3 +1030,64 @@ func (s *Store) ListInstanceChangeHistoryForMigrator(ctx context.Context, find *
 
 	return list, nil
 }
+
+// ListInstanceChangeHistoryForIntegrityCheck lists at most limit change histories of the database after the sequence in the applied order.
+// Only the fields used by the integrity check are loaded, and the statement is the one recorded in the change history.
+func (s *Store) ListInstanceChangeHistoryForIntegrityCheck(ctx context.Context, instanceID, databaseID int, afterSequence int64, limit int) ([]*InstanceChangeHistoryMessage, error) {
+	query := `
+		SELECT
+			id,
+			sequence,
+			type,
+			status,
+			version,
+			statement,
+			sheet_id,
+			payload
+		FROM instance_change_history
+		WHERE instance_id = $1 AND database_id = $2 AND sequence > $3
+		ORDER BY sequence ASC
+		LIMIT $4`
+	rows, err := s.db.QueryContext(ctx, query, instanceID, databaseID, afterSequence, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*InstanceChangeHistoryMessage
+	for rows.Next() {
+		var changeHistory InstanceChangeHistoryMessage
+		var storedVersion, payload string
+		var sheetID sql.NullInt32
+		if err := rows.Scan(
+			&changeHistory.UID,
+			&changeHistory.Sequence,
+			&changeHistory.Type,
+			&changeHistory.Status,
+			&storedVersion,
+			&changeHistory.Statement,
+			&sheetID,
+			&payload,
+		); err != nil {
+			return nil, err
+		}
+		if sheetID.Valid {
+			n := int(sheetID.Int32)
+			changeHistory.SheetID = &n
+		}
+		version, err := model.NewVersion(storedVersion)
+		if err != nil {
+			return nil, err
+		}
+		changeHistory.Version = version
+		changeHistory.Payload = &storepb.InstanceChangeHistoryPayload{}
+		if err := protojson.Unmarshal([]byte(payload), changeHistory.Payload); err != nil {
+			return nil, err
+		}
+		list = append(list, &changeHistory)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -43,6 +43,8 @@ type IssueMessage struct {
 	Subscribers []*UserMessage
 	PipelineUID *int
 	PlanUID     *int64
+	// BlockingIssueUIDs are the issues which must be done before the issue can be rolled out.
+	BlockingIssueUIDs []int
 
 	// The following fields are output only and not used for create().
 	UID         int
@@ -50,6 +52,8 @@ type IssueMessage struct {
 	CreatedTime time.Time
 	Updater     *UserMessage
 	UpdatedTime time.Time
+	// Archived is set if the issue has been archived, it's hidden from the issue list by default.
+	Archived bool
 
 	// Internal fields.
 	projectUID     int
@@ -71,6 +75,12 @@ type UpdateIssueMessage struct {
 	// PayloadUpsert upserts the presented top-level keys.
 	PayloadUpsert *storepb.IssuePayload
 	Subscribers   *[]*UserMessage
+	// BlockingIssueUIDs replaces the blocking issues.
+	BlockingIssueUIDs *[]int
+	// Labels replaces the labels in the payload.
+	Labels *[]string
+	// Activities are created with their outbox events in the same transaction as the update.
+	Activities []*PendingActivity
 
 	PipelineUID *int
 }
@@ -89,13 +99,21 @@ type FindIssueMessage struct {
 	SubscriberID    *int
 	CreatedTsBefore *int64
 	CreatedTsAfter  *int64
+	// BlockingIssueUID finds the issues blocked by the issue.
+	BlockingIssueUID *int
+	// JiraIssueKey finds the issue linked with the Jira ticket.
+	JiraIssueKey *string
 
 	StatusList []api.IssueStatus
 	TaskTypes  *[]api.TaskType
+	RowStatus  *api.RowStatus
 	// Any of the task in the issue changes the instance with InstanceResourceID.
 	InstanceResourceID *string
 	// Any of the task in the issue changes the database with DatabaseUID.
 	DatabaseUID *int
+	// After lists the issues after the cursor in the reverse creation order.
+	// It must not be used with Query, whose results are ordered by the rank.
+	After *KeysetCursor
 	// If specified, then it will only fetch "Limit" most recently updated issues
 	Limit  *int
 	Offset *int
@@ -201,6 +219,9 @@ func (s *Store) CreateIssueV2(ctx context.Context, create *IssueMessage, creator
 		}
 		return nil, err
 	}
+	if err := setBlockingIssues(ctx, tx, create.UID, create.BlockingIssueUIDs); err != nil {
+		return nil, err
+	}
 	create.CreatedTime = time.Unix(create.createdTs, 0)
 	create.UpdatedTime = time.Unix(create.updatedTs, 0)
 	create.Creator = creator
@@ -245,12 +266,24 @@ func (s *Store) UpdateIssueV2(ctx context.Context, uid int, patch *UpdateIssueMe
 			set = append(set, "assignee_id = NULL")
 		}
 	}
+	payload := "payload"
 	if v := patch.PayloadUpsert; v != nil {
 		p, err := protojson.Marshal(v)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to marshal patch.PayloadUpsert")
 		}
-		set, args = append(set, fmt.Sprintf("payload = payload || $%d", len(args)+1)), append(args, p)
+		payload, args = fmt.Sprintf("%s || $%d", payload, len(args)+1), append(args, p)
+	}
+	if v := patch.Labels; v != nil {
+		// Empty labels are omitted in the marshaled payload, so the old labels are removed first.
+		p, err := protojson.Marshal(&storepb.IssuePayload{Labels: *v})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal patch.Labels")
+		}
+		payload, args = fmt.Sprintf("(%s - 'labels') || $%d", payload, len(args)+1), append(args, p)
+	}
+	if payload != "payload" {
+		set = append(set, fmt.Sprintf("payload = %s", payload))
 	}
 	if patch.Title != nil || patch.Description != nil {
 		title := oldIssue.Title
@@ -289,6 +322,17 @@ func (s *Store) UpdateIssueV2(ctx context.Context, uid int, patch *UpdateIssueMe
 			return nil, err
 		}
 	}
+	if patch.BlockingIssueUIDs != nil {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM issue_dependency WHERE issue_id = $1`, uid); err != nil {
+			return nil, err
+		}
+		if err := setBlockingIssues(ctx, tx, uid, *patch.BlockingIssueUIDs); err != nil {
+			return nil, err
+		}
+	}
+	if err := createPendingActivitiesImpl(ctx, tx, patch.Activities); err != nil {
+		return nil, err
+	}
 
 	if err := tx.Commit(); err != nil {
 		return nil, err
@@ -373,9 +417,27 @@ func setSubscribers(ctx context.Context, tx *Tx, issueUID int, subscribers []*Us
 	return nil
 }
 
+// setBlockingIssues inserts the blocking issues of the issue.
+func setBlockingIssues(ctx context.Context, tx *Tx, issueUID int, blockingIssueUIDs []int) error {
+	if len(blockingIssueUIDs) == 0 {
+		return nil
+	}
+	var tokens []string
+	var args []any
+	for i, v := range blockingIssueUIDs {
+		tokens = append(tokens, fmt.Sprintf("($%d, $%d)", 2*i+1, 2*i+2))
+		args = append(args, issueUID, v)
+	}
+	query := fmt.Sprintf(`INSERT INTO issue_dependency (issue_id, blocking_issue_id) VALUES %s ON CONFLICT DO NOTHING`, strings.Join(tokens, ", "))
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
 // ListIssueV2 returns the list of issues by find query.
 func (s *Store) ListIssueV2(ctx context.Context, find *FindIssueMessage) ([]*IssueMessage, error) {
-	orderByClause := "ORDER BY issue.id DESC"
+	orderByClause := "ORDER BY issue.created_ts DESC, issue.id DESC"
 	from := "issue"
 	where, args := []string{"TRUE"}, []any{}
 	if v := find.UID; v != nil {
@@ -414,6 +476,12 @@ func (s *Store) ListIssueV2(ctx context.Context, find *FindIssueMessage) ([]*Iss
 	if v := find.SubscriberID; v != nil {
 		where, args = append(where, fmt.Sprintf("EXISTS (SELECT 1 FROM issue_subscriber WHERE issue_subscriber.issue_id = issue.id AND issue_subscriber.subscriber_id = $%d)", len(args)+1)), append(args, *v)
 	}
+	if v := find.BlockingIssueUID; v != nil {
+		where, args = append(where, fmt.Sprintf("EXISTS (SELECT 1 FROM issue_dependency WHERE issue_dependency.issue_id = issue.id AND issue_dependency.blocking_issue_id = $%d)", len(args)+1)), append(args, *v)
+	}
+	if v := find.JiraIssueKey; v != nil {
+		where, args = append(where, fmt.Sprintf("issue.payload->>'jiraIssueKey' = $%d", len(args)+1)), append(args, *v)
+	}
 	if v := find.Query; v != nil && *v != "" {
 		if tsQuery := getTsQuery(*v); tsQuery != "" {
 			from += fmt.Sprintf(`, CAST($%d AS tsquery) AS query`, len(args)+1)
@@ -434,12 +502,22 @@ func (s *Store) ListIssueV2(ctx context.Context, find *FindIssueMessage) ([]*Iss
 		where = append(where, fmt.Sprintf("EXISTS (SELECT 1 FROM task WHERE task.pipeline_id = issue.pipeline_id AND task.type = ANY($%d))", len(args)+1))
 		args = append(args, *v)
 	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, fmt.Sprintf("issue.row_status = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.After; v != nil {
+		var condition string
+		condition, args = getKeysetCondition("issue", v, api.DESC, args)
+		where = append(where, condition)
+	}
 	limitOffsetClause := ""
 	if v := find.Limit; v != nil {
-		limitOffsetClause = fmt.Sprintf(" LIMIT %d", *v)
+		limitOffsetClause = fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, *v)
 	}
 	if v := find.Offset; v != nil {
-		limitOffsetClause += fmt.Sprintf(" OFFSET %d", *v)
+		limitOffsetClause += fmt.Sprintf(" OFFSET $%d", len(args)+1)
+		args = append(args, *v)
 	}
 
 	var issues []*IssueMessage
@@ -465,7 +543,9 @@ func (s *Store) ListIssueV2(ctx context.Context, find *FindIssueMessage) ([]*Iss
 		issue.description,
 		issue.assignee_id,
 		issue.payload,
-		(SELECT ARRAY_AGG (issue_subscriber.subscriber_id) FROM issue_subscriber WHERE issue_subscriber.issue_id = issue.id) subscribers
+		issue.row_status,
+		(SELECT ARRAY_AGG (issue_subscriber.subscriber_id) FROM issue_subscriber WHERE issue_subscriber.issue_id = issue.id) subscribers,
+		(SELECT ARRAY_AGG (issue_dependency.blocking_issue_id ORDER BY issue_dependency.blocking_issue_id) FROM issue_dependency WHERE issue_dependency.issue_id = issue.id) blocking_issues
 	FROM %s
 	WHERE %s
 	%s
@@ -481,7 +561,9 @@ func (s *Store) ListIssueV2(ctx context.Context, find *FindIssueMessage) ([]*Iss
 			Payload: &storepb.IssuePayload{},
 		}
 		var payload []byte
+		var rowStatus api.RowStatus
 		var subscriberUIDs pgtype.Int4Array
+		var blockingIssueUIDs pgtype.Int4Array
 		if err := rows.Scan(
 			&issue.UID,
 			&issue.creatorUID,
@@ -497,13 +579,19 @@ func (s *Store) ListIssueV2(ctx context.Context, find *FindIssueMessage) ([]*Iss
 			&issue.Description,
 			&issue.assigneeUID,
 			&payload,
+			&rowStatus,
 			&subscriberUIDs,
+			&blockingIssueUIDs,
 		); err != nil {
 			return nil, err
 		}
+		issue.Archived = rowStatus == api.Archived
 		if err := subscriberUIDs.AssignTo(&issue.subscriberUIDs); err != nil {
 			return nil, err
 		}
+		if err := blockingIssueUIDs.AssignTo(&issue.BlockingIssueUIDs); err != nil {
+			return nil, err
+		}
 		if err := protojson.Unmarshal(payload, issue.Payload); err != nil {
 			return nil, errors.Wrapf(err, "failed to unmarshal issue payload")
 		}
@@ -561,7 +649,8 @@ func (s *Store) ListIssueV2(ctx context.Context, find *FindIssueMessage) ([]*Iss
 }
 
 // BatchUpdateIssueStatuses updates the status of multiple issues.
-func (s *Store) BatchUpdateIssueStatuses(ctx context.Context, issueUIDs []int, status api.IssueStatus, updaterID int) error {
+// The activities are created with their outbox events in the same transaction.
+func (s *Store) BatchUpdateIssueStatuses(ctx context.Context, issueUIDs []int, status api.IssueStatus, updaterID int, activities []*PendingActivity) error {
 	var ids []string
 	for _, id := range issueUIDs {
 		ids = append(ids, fmt.Sprintf("%d", id))
@@ -601,6 +690,10 @@ func (s *Store) BatchUpdateIssueStatuses(ctx context.Context, issueUIDs []int, s
 	if err := rows.Err(); err != nil {
 		return errors.Wrapf(err, "failed to scan issues")
 	}
+	rows.Close()
+	if err := createPendingActivitiesImpl(ctx, tx, activities); err != nil {
+		return errors.Wrapf(err, "failed to create activities")
+	}
 
 	if err := tx.Commit(); err != nil {
 		return errors.Wrapf(err, "failed to commit")
@@ -616,6 +709,102 @@ func (s *Store) BatchUpdateIssueStatuses(ctx context.Context, issueUIDs []int, s
 	return nil
 }
 
+// ArchiveIssues archives the done and canceled issues updated before the timestamp, and their pipelines.
+// It returns the number of the archived issues.
+func (s *Store) ArchiveIssues(ctx context.Context, updatedTsBefore int64) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	issueIDs, pipelineIDs, err := setIssueRowStatus(ctx, tx, `
+		UPDATE issue
+		SET row_status = $1
+		WHERE row_status = $2 AND status IN ($3, $4) AND updated_ts < $5
+		RETURNING id, pipeline_id`,
+		api.Archived, api.Normal, api.IssueDone, api.IssueCanceled, updatedTsBefore,
+	)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE pipeline SET row_status = $1 WHERE id = ANY($2)`, api.Archived, pipelineIDs); err != nil {
+		return 0, errors.Wrapf(err, "failed to archive pipelines")
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, errors.Wrapf(err, "failed to commit")
+	}
+
+	s.removeIssueCache(issueIDs, pipelineIDs)
+	return len(issueIDs), nil
+}
+
+// UnarchiveIssue restores the archived issue and its pipeline.
+func (s *Store) UnarchiveIssue(ctx context.Context, uid int, updaterID int) (*IssueMessage, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	issueIDs, pipelineIDs, err := setIssueRowStatus(ctx, tx, `
+		UPDATE issue
+		SET row_status = $1, updater_id = $2
+		WHERE id = $3
+		RETURNING id, pipeline_id`,
+		api.Normal, updaterID, uid,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE pipeline SET row_status = $1, updater_id = $2 WHERE id = ANY($3)`, api.Normal, updaterID, pipelineIDs); err != nil {
+		return nil, errors.Wrapf(err, "failed to restore pipeline")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit")
+	}
+
+	s.removeIssueCache(issueIDs, pipelineIDs)
+	return s.GetIssueV2(ctx, &FindIssueMessage{UID: &uid})
+}
+
+// setIssueRowStatus executes the query updating the row status of the issues, and returns the IDs of the updated issues and their pipelines.
+func setIssueRowStatus(ctx context.Context, tx *Tx, query string, args ...any) ([]int, []int, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to update issue row status")
+	}
+	defer rows.Close()
+
+	var issueIDs []int
+	var pipelineIDs []int
+	for rows.Next() {
+		var issueID int
+		var pipelineID sql.NullInt32
+		if err := rows.Scan(&issueID, &pipelineID); err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to scan")
+		}
+		issueIDs = append(issueIDs, issueID)
+		if pipelineID.Valid {
+			pipelineIDs = append(pipelineIDs, int(pipelineID.Int32))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to scan issues")
+	}
+	return issueIDs, pipelineIDs, nil
+}
+
+func (s *Store) removeIssueCache(issueIDs []int, pipelineIDs []int) {
+	for _, issueID := range issueIDs {
+		s.issueCache.Remove(issueID)
+	}
+	for _, pipelineID := range pipelineIDs {
+		s.issueByPipelineCache.Remove(pipelineID)
+		s.pipelineCache.Remove(pipelineID)
+	}
+}
+
 func getTsVector(text string) string {
 	seg := getSegmenter()
 	parts := seg.CutTrim(text)
@@ -0,0 +1,268 @@
+package store
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// OutboxEventStatus is the status of an outbox event.
+type OutboxEventStatus string
+
+const (
+	// OutboxEventPending is the status of the events to deliver.
+	OutboxEventPending OutboxEventStatus = "PENDING"
+	// OutboxEventDone is the status of the delivered events.
+	OutboxEventDone OutboxEventStatus = "DONE"
+	// OutboxEventFailed is the status of the events failed to deliver after all the attempts.
+	OutboxEventFailed OutboxEventStatus = "FAILED"
+)
+
+// OutboxEventMessage is the message of an outbox event.
+// The events are written in the same transaction as the activities, and delivered by the dispatcher at least once.
+type OutboxEventMessage struct {
+	// DedupKey deduplicates the events enqueued more than once.
+	DedupKey string
+	Payload  *storepb.OutboxEventPayload
+
+	// Output only.
+	UID       int64
+	CreatedTs int64
+	Attempts  int
+}
+
+// PendingActivity is an activity with the outbox event of its side effects, which is created in the same transaction
+// as the change causing it, e.g. UpdateIssueMessage.Activities, so that the side effects are delivered if and only if
+// the change is committed.
+type PendingActivity struct {
+	Create *ActivityMessage
+	// BuildEvent builds the outbox event from the created activity, nil if the activity has no side effects.
+	BuildEvent func(*ActivityMessage) (*OutboxEventMessage, error)
+}
+
+// UpdateOutboxEventMessage is the message for updating an outbox event.
+type UpdateOutboxEventMessage struct {
+	UID int64
+
+	Status        *OutboxEventStatus
+	Payload       *storepb.OutboxEventPayload
+	Attempts      *int
+	NextAttemptTs *int64
+	LastError     *string
+}
+
+// CreateActivityWithOutboxEvent creates the activity and the outbox event of its side effects in a transaction.
+// The event is built from the created activity so that it can refer to the activity ID.
+// Only the outbox event is created for the activities which are not stored.
+func (s *Store) CreateActivityWithOutboxEvent(ctx context.Context, create *ActivityMessage, buildEvent func(*ActivityMessage) (*OutboxEventMessage, error)) (*ActivityMessage, error) {
+	activities, err := s.BatchCreateActivityWithOutboxEvent(ctx, []*ActivityMessage{create}, func(activities ...*ActivityMessage) (*OutboxEventMessage, error) {
+		return buildEvent(activities[0])
+	})
+	if err != nil {
+		return nil, err
+	}
+	return activities[0], nil
+}
+
+// BatchCreateActivityWithOutboxEvent creates the activities and one outbox event of their side effects in a transaction.
+func (s *Store) BatchCreateActivityWithOutboxEvent(ctx context.Context, creates []*ActivityMessage, buildEvent func(...*ActivityMessage) (*OutboxEventMessage, error)) ([]*ActivityMessage, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	activities, err := batchCreateActivityWithOutboxEventImpl(ctx, tx, creates, buildEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return activities, nil
+}
+
+// createPendingActivitiesImpl creates the pending activities and their outbox events in the transaction of the change causing them.
+func createPendingActivitiesImpl(ctx context.Context, tx *Tx, pendings []*PendingActivity) error {
+	for _, pending := range pendings {
+		if pending.BuildEvent == nil {
+			if isActivityNotStored(pending.Create.Type) {
+				continue
+			}
+			if _, err := createActivityImplV2(ctx, tx, pending.Create); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := batchCreateActivityWithOutboxEventImpl(ctx, tx, []*ActivityMessage{pending.Create}, func(activities ...*ActivityMessage) (*OutboxEventMessage, error) {
+			return pending.BuildEvent(activities[0])
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func batchCreateActivityWithOutboxEventImpl(ctx context.Context, tx *Tx, creates []*ActivityMessage, buildEvent func(...*ActivityMessage) (*OutboxEventMessage, error)) ([]*ActivityMessage, error) {
+	var storedCreates []*ActivityMessage
+	var err error
+	for _, create := range creates {
+		if !isActivityNotStored(create.Type) {
+			storedCreates = append(storedCreates, create)
+		}
+	}
+	var stored []*ActivityMessage
+	if len(storedCreates) > 0 {
+		if stored, err = createActivityImplV2(ctx, tx, storedCreates...); err != nil {
+			return nil, err
+		}
+		if len(stored) != len(storedCreates) {
+			return nil, errors.Errorf("expect to create %d activities, got %d", len(storedCreates), len(stored))
+		}
+	}
+	var activities []*ActivityMessage
+	for _, create := range creates {
+		if isActivityNotStored(create.Type) {
+			activities = append(activities, create)
+			continue
+		}
+		activities = append(activities, stored[0])
+		stored = stored[1:]
+	}
+
+	event, err := buildEvent(activities...)
+	if err != nil {
+		return nil, err
+	}
+	if err := createOutboxEventImpl(ctx, tx, event); err != nil {
+		return nil, err
+	}
+	return activities, nil
+}
+
+// CreateOutboxEvent creates an outbox event, it's a no-op if the dedup key exists.
+func (s *Store) CreateOutboxEvent(ctx context.Context, create *OutboxEventMessage) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := createOutboxEventImpl(ctx, tx, create); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func createOutboxEventImpl(ctx context.Context, tx *Tx, create *OutboxEventMessage) error {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal outbox event payload")
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO outbox (dedup_key, payload)
+		VALUES ($1, $2)
+		ON CONFLICT (dedup_key) DO NOTHING`,
+		create.DedupKey, payload,
+	); err != nil {
+		return errors.Wrapf(err, "failed to create outbox event %q", create.DedupKey)
+	}
+	return nil
+}
+
+// ClaimOutboxEvents claims at most limit pending events due to deliver in the creation order.
+// The claimed events are leased for the lease duration, so that the other replicas skip them, and they are delivered again if the dispatcher crashes.
+func (s *Store) ClaimOutboxEvents(ctx context.Context, limit int, lease time.Duration) ([]*OutboxEventMessage, error) {
+	now := time.Now().Unix()
+	rows, err := s.db.QueryContext(ctx, `
+		UPDATE outbox
+		SET next_attempt_ts = $1, updated_ts = $2
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE status = $3 AND next_attempt_ts <= $2
+			ORDER BY id
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, created_ts, dedup_key, payload, attempts`,
+		now+int64(lease.Seconds()), now, OutboxEventPending, limit,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to claim outbox events")
+	}
+	defer rows.Close()
+
+	var events []*OutboxEventMessage
+	for rows.Next() {
+		event := &OutboxEventMessage{
+			Payload: &storepb.OutboxEventPayload{},
+		}
+		var payload []byte
+		if err := rows.Scan(&event.UID, &event.CreatedTs, &event.DedupKey, &payload, &event.Attempts); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan outbox event")
+		}
+		if err := protojsonUnmarshaler.Unmarshal(payload, event.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal outbox event payload")
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan outbox events")
+	}
+	// The UPDATE ... RETURNING doesn't keep the order of the subquery.
+	slices.SortFunc(events, func(a, b *OutboxEventMessage) int {
+		return cmp.Compare(a.UID, b.UID)
+	})
+	return events, nil
+}
+
+// UpdateOutboxEvent updates an outbox event.
+func (s *Store) UpdateOutboxEvent(ctx context.Context, patch *UpdateOutboxEventMessage) error {
+	set, args := []string{"updated_ts = extract(epoch from now())"}, []any{}
+	if v := patch.Status; v != nil {
+		set, args = append(set, fmt.Sprintf("status = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := patch.Payload; v != nil {
+		payload, err := protojson.Marshal(v)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal outbox event payload")
+		}
+		set, args = append(set, fmt.Sprintf("payload = $%d", len(args)+1)), append(args, payload)
+	}
+	if v := patch.Attempts; v != nil {
+		set, args = append(set, fmt.Sprintf("attempts = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := patch.NextAttemptTs; v != nil {
+		set, args = append(set, fmt.Sprintf("next_attempt_ts = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := patch.LastError; v != nil {
+		set, args = append(set, fmt.Sprintf("last_error = $%d", len(args)+1)), append(args, *v)
+	}
+	args = append(args, patch.UID)
+	if _, err := s.db.ExecContext(ctx, fmt.Sprintf(`UPDATE outbox SET %s WHERE id = $%d`, strings.Join(set, ", "), len(args)), args...); err != nil {
+		return errors.Wrapf(err, "failed to update outbox event %d", patch.UID)
+	}
+	return nil
+}
+
+// DeleteDoneOutboxEventsBefore deletes the delivered outbox events created before the timestamp.
+func (s *Store) DeleteDoneOutboxEventsBefore(ctx context.Context, ts int64) (int64, error) {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM outbox WHERE status = $1 AND created_ts < $2`, OutboxEventDone, ts)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to delete outbox events")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get the number of deleted outbox events")
+	}
+	return count, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -19,6 +20,8 @@ import (
 type DB struct {
 	metadataDriver dbdriver.Driver
 	db             *sql.DB
+	// replica is the optional read-only replica serving the read-heavy queries.
+	replica *replica
 
 	// db.ConnCfg is the connection configuration to a Postgres database.
 	// The user has superuser privilege to the database.
@@ -71,7 +74,7 @@ func (db *DB) Open(ctx context.Context, createDB bool) error {
 	metadataDriver, err := dbdriver.Open(
 		ctx,
 		storepb.Engine_POSTGRES,
-		dbdriver.DriverConfig{DbBinDir: db.binDir},
+		dbdriver.DriverConfig{DbBinDir: db.binDir, PgxTracer: getStatementCacheTracer("metadata")},
 		db.ConnCfg,
 	)
 	if err != nil {
@@ -95,14 +98,47 @@ func (db *DB) Open(ctx context.Context, createDB bool) error {
 		maxOpenConns = 50
 	}
 	db.db.SetMaxOpenConns(maxOpenConns)
+	registerDBStatsCollector(db.db, "metadata")
 	return nil
 }
 
 // Close closes the database connection.
 func (db *DB) Close(ctx context.Context) error {
+	if db.replica != nil {
+		if err := db.replica.close(ctx); err != nil {
+			return err
+		}
+	}
 	return db.metadataDriver.Close(ctx)
 }
 
+// ExecContext executes the query.
+func (db *DB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	logQuery("ExecContext", query)
+	defer observeQuery("exec", time.Now())
+	ctx, span := startQuerySpan(ctx, "exec", query)
+	defer span.End()
+	return db.db.ExecContext(ctx, query, args...)
+}
+
+// QueryContext queries the rows.
+func (db *DB) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	logQuery("QueryContext", query)
+	defer observeQuery("query", time.Now())
+	ctx, span := startQuerySpan(ctx, "query", query)
+	defer span.End()
+	return db.db.QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext queries a row.
+func (db *DB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	logQuery("QueryRowContext", query)
+	defer observeQuery("query_row", time.Now())
+	ctx, span := startQuerySpan(ctx, "query_row", query)
+	defer span.End()
+	return db.db.QueryRowContext(ctx, query, args...)
+}
+
 // BeginTx starts a transaction and returns a wrapper Tx type. This type
 // provides a reference to the database and a fixed timestamp at the start of
 // the transaction. The timestamp allows us to mock time during tests as well.
@@ -122,3 +158,36 @@ func (db *DB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
 type Tx struct {
 	*sql.Tx
 }
+
+// PrepareContext overrides sql.Tx PrepareContext.
+func (tx *Tx) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	logQuery("PrepareContext", query)
+	return tx.Tx.PrepareContext(ctx, query)
+}
+
+// ExecContext overrides sql.Tx ExecContext.
+func (tx *Tx) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	logQuery("ExecContext", query)
+	defer observeQuery("exec", time.Now())
+	ctx, span := startQuerySpan(ctx, "exec", query)
+	defer span.End()
+	return tx.Tx.ExecContext(ctx, query, args...)
+}
+
+// QueryContext overrides sql.Tx QueryContext.
+func (tx *Tx) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	logQuery("QueryContext", query)
+	defer observeQuery("query", time.Now())
+	ctx, span := startQuerySpan(ctx, "query", query)
+	defer span.End()
+	return tx.Tx.QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext overrides sql.Tx QueryRowContext.
+func (tx *Tx) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	logQuery("QueryRowContext", query)
+	defer observeQuery("query_row", time.Now())
+	ctx, span := startQuerySpan(ctx, "query_row", query)
+	defer span.End()
+	return tx.Tx.QueryRowContext(ctx, query, args...)
+}
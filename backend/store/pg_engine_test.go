@@ -0,0 +1,177 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path"
+	"sync"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	dbdriver "github.com/bytebase/bytebase/backend/plugin/db"
+	_ "github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/resources/postgres"
+)
+
+const pgPort = 6010
+
+// setupTestDB starts a postgres instance, and returns the metadata database connected to it.
+func setupTestDB(t testing.TB) *DB {
+	pgBinDir, err := postgres.Install(path.Join(t.TempDir(), "resource"))
+	require.NoError(t, err)
+	stopInstance := postgres.SetupTestInstance(pgBinDir, t.TempDir(), pgPort)
+	t.Cleanup(stopInstance)
+
+	ctx := context.Background()
+	db := NewDB(dbdriver.ConnectionConfig{
+		Username: postgres.TestPgUser,
+		Host:     common.GetPostgresSocketDir(),
+		Port:     fmt.Sprintf("%d", pgPort),
+		Database: "hidb",
+	}, pgBinDir, false, common.ReleaseModeDev)
+	require.NoError(t, db.Open(ctx, true /* createDB */))
+	t.Cleanup(func() {
+		_ = db.Close(ctx)
+	})
+	return db
+}
+
+// openTracedDB opens a single connection to the database caching at most capacity statements, traced by the tracer.
+func openTracedDB(t *testing.T, db *DB, capacity int, tracer *statementCacheTracer) *sql.DB {
+	conn, err := db.db.Conn(context.Background())
+	require.NoError(t, err)
+	var connConfig *pgx.ConnConfig
+	require.NoError(t, conn.Raw(func(driverConn any) error {
+		connConfig = driverConn.(*stdlib.Conn).Conn().Config()
+		return nil
+	}))
+	require.NoError(t, conn.Close())
+	connConfig.StatementCacheCapacity = capacity
+	connConfig.Tracer = tracer
+	tracedDB := stdlib.OpenDB(*connConfig)
+	tracedDB.SetMaxOpenConns(1)
+	t.Cleanup(func() {
+		_ = tracedDB.Close()
+	})
+	return tracedDB
+}
+
+func TestStatementCacheTracer(t *testing.T) {
+	a := require.New(t)
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	// The metadata queries are traced.
+	tracer := getStatementCacheTracer("metadata")
+	lookups := tracer.hits.Load() + tracer.misses.Load()
+	var v int
+	a.NoError(db.QueryRowContext(ctx, "SELECT $1::INT", 1).Scan(&v))
+	a.Equal(lookups+1, tracer.hits.Load()+tracer.misses.Load())
+
+	tracer = &statementCacheTracer{name: "test"}
+	tracedDB := openTracedDB(t, db, 512, tracer)
+	for i := 0; i < 3; i++ {
+		a.NoError(tracedDB.QueryRowContext(ctx, "SELECT $1::INT + 1", i).Scan(&v))
+		a.Equal(i+1, v)
+	}
+	_, err := tracedDB.ExecContext(ctx, "SELECT $1::INT", 1)
+	a.NoError(err)
+	// The statements without arguments bypass the cache.
+	_, err = tracedDB.ExecContext(ctx, "SELECT 1")
+	a.NoError(err)
+	a.Equal(int64(2), tracer.hits.Load())
+	a.Equal(int64(2), tracer.misses.Load())
+	a.Equal(0.5, tracer.hitRate())
+}
+
+func TestStatementCacheEvict(t *testing.T) {
+	a := require.New(t)
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	// Cache a single statement on a single connection, so that the queries evict each other.
+	tracer := &statementCacheTracer{name: "test"}
+	evictDB := openTracedDB(t, db, 1, tracer)
+
+	// The statements evicted are prepared again, within the transaction as well.
+	tx, err := evictDB.BeginTx(ctx, nil)
+	a.NoError(err)
+	defer tx.Rollback()
+	rows, err := tx.QueryContext(ctx, "SELECT generate_series(1, $1::INT)", 3)
+	a.NoError(err)
+	var values []int
+	for rows.Next() {
+		var v int
+		a.NoError(rows.Scan(&v))
+		values = append(values, v)
+	}
+	a.NoError(rows.Err())
+	a.NoError(rows.Close())
+	a.Equal([]int{1, 2, 3}, values)
+	for i := 0; i < 3; i++ {
+		var v int
+		a.NoError(tx.QueryRowContext(ctx, "SELECT $1::INT + 1", i).Scan(&v))
+		a.Equal(i+1, v)
+		a.NoError(tx.QueryRowContext(ctx, "SELECT $1::INT * 2", i).Scan(&v))
+		a.Equal(i*2, v)
+	}
+	a.NoError(tx.Commit())
+	// The statements invalidated within the transaction aren't cached again until the transaction ends,
+	// so the "* 2" statement stays cached after the first round.
+	a.Equal(int64(2), tracer.hits.Load())
+	a.Equal(int64(5), tracer.misses.Load())
+}
+
+func TestStatementCacheConcurrentPrepare(t *testing.T) {
+	a := require.New(t)
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	const count = 16
+	values := make([]int, count)
+	errs := make([]error, count)
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = db.QueryRowContext(ctx, "SELECT $1::INT", i).Scan(&values[i])
+		}(i)
+	}
+	wg.Wait()
+	for i := 0; i < count; i++ {
+		a.NoError(errs[i])
+		a.Equal(i, values[i])
+	}
+}
+
+func TestStatementCacheFailure(t *testing.T) {
+	a := require.New(t)
+	db := setupTestDB(t)
+	ctx := context.Background()
+	// Use a single connection, so that the queries hit the cache of the connection that failed to prepare the query.
+	db.db.SetMaxOpenConns(1)
+
+	query := "SELECT count(*) FROM statement_cache_failure"
+	var count int
+	a.Error(db.QueryRowContext(ctx, query).Scan(&count))
+
+	// The query failed to prepare isn't cached, so it succeeds once the table is created.
+	_, err := db.ExecContext(ctx, "CREATE TABLE statement_cache_failure (id INT)")
+	a.NoError(err)
+	a.NoError(db.QueryRowContext(ctx, query).Scan(&count))
+	a.Equal(0, count)
+
+	// The cached statement is re-prepared after the table is altered.
+	_, err = db.ExecContext(ctx, "ALTER TABLE statement_cache_failure ADD COLUMN name TEXT")
+	a.NoError(err)
+	_, err = db.ExecContext(ctx, "INSERT INTO statement_cache_failure (id, name) VALUES ($1, $2)", 1, "a")
+	a.NoError(err)
+	a.NoError(db.QueryRowContext(ctx, query).Scan(&count))
+	a.Equal(1, count)
+}
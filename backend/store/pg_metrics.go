@@ -0,0 +1,55 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/common/tracing"
+)
+
+var queryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "bb",
+	Subsystem: "store",
+	Name:      "query_duration_seconds",
+	Help:      "The latency of the metadata queries by the operation of exec, query or query_row.",
+	Buckets:   []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5},
+}, []string{"operation"})
+
+func init() {
+	prometheus.MustRegister(queryDuration)
+}
+
+// observeQuery observes the latency of the metadata query started at startTime.
+// The query rows are read after the query returns, so the latency of the query operation only covers the first rows.
+func observeQuery(operation string, startTime time.Time) {
+	queryDuration.WithLabelValues(operation).Observe(time.Since(startTime).Seconds())
+}
+
+// startQuerySpan starts the span of the metadata query as a child of the span in the context.
+func startQuerySpan(ctx context.Context, operation string, query string) (context.Context, trace.Span) {
+	return tracing.StartChild(ctx, "store."+operation,
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", operation),
+		tracing.StatementDigest(query),
+	)
+}
+
+// registerDBStatsCollector exposes the connection pool stats of the database, e.g. go_sql_open_connections{db_name="metadata"}.
+func registerDBStatsCollector(db *sql.DB, name string) {
+	if err := prometheus.Register(collectors.NewDBStatsCollector(db, name)); err != nil {
+		var alreadyRegistered prometheus.AlreadyRegisteredError
+		if errors.As(err, &alreadyRegistered) {
+			return
+		}
+		slog.Warn("Failed to register the connection pool stats collector", slog.String("db", name), log.BBError(err))
+	}
+}
@@ -0,0 +1,122 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	dbdriver "github.com/bytebase/bytebase/backend/plugin/db"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// replicaCheckInterval is the interval re-checking the replication lag of the replica.
+	replicaCheckInterval = 5 * time.Second
+	// replicaCheckTimeout is the timeout checking the replication lag, the replica is considered unhealthy if it's exceeded.
+	replicaCheckTimeout = 1 * time.Second
+	// replicaMaxOpenConns is the max open connections to the replica.
+	replicaMaxOpenConns = 50
+)
+
+// replica is the read-only replica of the metadata database serving the read-heavy queries.
+type replica struct {
+	driver dbdriver.Driver
+	db     *sql.DB
+	// maxLag is the max replication lag to read from the replica, the reads go to the primary beyond it.
+	maxLag time.Duration
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	healthy   bool
+}
+
+// OpenReplica opens the connection to the read-only replica of the metadata database.
+// The read-heavy queries are routed to the replica as long as its replication lag is within maxLag.
+func (db *DB) OpenReplica(ctx context.Context, connCfg dbdriver.ConnectionConfig, maxLag time.Duration) error {
+	connCfg.ReadOnly = true
+	driver, err := dbdriver.Open(
+		ctx,
+		storepb.Engine_POSTGRES,
+		dbdriver.DriverConfig{DbBinDir: db.binDir, PgxTracer: getStatementCacheTracer("metadata_replica")},
+		connCfg,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to open the metadata replica")
+	}
+	sqlDB := driver.GetDB()
+	sqlDB.SetMaxOpenConns(replicaMaxOpenConns)
+	registerDBStatsCollector(sqlDB, "metadata_replica")
+	db.replica = &replica{
+		driver: driver,
+		db:     sqlDB,
+		maxLag: maxLag,
+	}
+	return nil
+}
+
+// BeginReplicaTx starts a read-only transaction on the replica if it's healthy, otherwise on the primary.
+// It's only used by the read-heavy queries tolerating the replication lag.
+func (db *DB) BeginReplicaTx(ctx context.Context) (*Tx, error) {
+	if db.replica != nil && db.replica.isHealthy(ctx) {
+		ptx, err := db.replica.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+		if err == nil {
+			return &Tx{
+				Tx: ptx,
+			}, nil
+		}
+		slog.Warn("Failed to begin transaction on the metadata replica, fall back to the primary", log.BBError(err))
+	}
+	return db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+}
+
+// isHealthy returns whether the replica is reachable and its replication lag is within the max lag.
+// The lag is re-checked at most every replicaCheckInterval.
+func (r *replica) isHealthy(ctx context.Context) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if time.Since(r.checkedAt) < replicaCheckInterval {
+		return r.healthy
+	}
+
+	healthy := true
+	lag, err := r.getLag(ctx)
+	if err != nil {
+		healthy = false
+		if r.healthy {
+			slog.Warn("Failed to check the lag of the metadata replica, route the reads to the primary", log.BBError(err))
+		}
+	} else if lag > r.maxLag {
+		healthy = false
+		if r.healthy {
+			slog.Warn("The metadata replica lags behind, route the reads to the primary", slog.Duration("lag", lag), slog.Duration("maxLag", r.maxLag))
+		}
+	}
+	r.checkedAt = time.Now()
+	r.healthy = healthy
+	return healthy
+}
+
+func (r *replica) getLag(ctx context.Context) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), replicaCheckTimeout)
+	defer cancel()
+	// The replay timestamp doesn't advance if there is no write on the primary, so the replica having replayed all received WAL is up to date.
+	var seconds float64
+	if err := r.db.QueryRowContext(ctx, `
+		SELECT CASE
+			WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+			ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+		END`,
+	).Scan(&seconds); err != nil {
+		return 0, err
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+func (r *replica) close(ctx context.Context) error {
+	return r.driver.Close(ctx)
+}
@@ -0,0 +1,112 @@
+package store
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+)
+
+var statementCacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "bb",
+	Subsystem: "store",
+	Name:      "statement_cache_requests_total",
+	Help:      "The number of the metadata queries looked up in the prepared statement cache of pgx by the result of hit or miss, the statement is prepared on a miss.",
+}, []string{"db_name", "result"})
+
+func init() {
+	prometheus.MustRegister(statementCacheRequests)
+}
+
+var (
+	statementCacheTracersMu sync.Mutex
+	// statementCacheTracers are the tracers by the database name, so that reopening a database keeps counting on its metrics.
+	statementCacheTracers = map[string]*statementCacheTracer{}
+)
+
+// statementCacheTracer counts the hits and misses of the prepared statement cache of pgx on the connections of a database.
+// pgx prepares and caches the statements by the query text on each connection in the default cache statement mode,
+// so the store queries bind all the values, including LIMIT and OFFSET, to reuse the statements.
+type statementCacheTracer struct {
+	name   string
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+type statementCacheLookupKey struct{}
+
+// statementCacheLookup is the cache lookup of a query, it's a miss if the query prepares its statement.
+type statementCacheLookup struct {
+	prepared bool
+}
+
+// getStatementCacheTracer returns the statement cache tracer of the database, and exposes its hit rate on the first call,
+// e.g. bb_store_statement_cache_hit_rate{db_name="metadata"}.
+func getStatementCacheTracer(name string) *statementCacheTracer {
+	statementCacheTracersMu.Lock()
+	defer statementCacheTracersMu.Unlock()
+	if t, ok := statementCacheTracers[name]; ok {
+		return t
+	}
+	t := &statementCacheTracer{name: name}
+	if err := prometheus.Register(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace:   "bb",
+		Subsystem:   "store",
+		Name:        "statement_cache_hit_rate",
+		Help:        "The ratio of the metadata queries served by the prepared statements cached by pgx.",
+		ConstLabels: prometheus.Labels{"db_name": name},
+	}, t.hitRate)); err != nil {
+		slog.Warn("Failed to register the statement cache hit rate", slog.String("db", name), log.BBError(err))
+	}
+	statementCacheTracers[name] = t
+	return t
+}
+
+func (t *statementCacheTracer) hitRate() float64 {
+	hits, misses := t.hits.Load(), t.misses.Load()
+	if hits+misses == 0 {
+		return 0
+	}
+	return float64(hits) / float64(hits+misses)
+}
+
+// TraceQueryStart implements pgx.QueryTracer.
+func (*statementCacheTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	// pgx executes the statements without arguments in the simple protocol bypassing the cache.
+	// The queries from database/sql always carry the result formats as the first argument.
+	if len(data.Args) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, statementCacheLookupKey{}, &statementCacheLookup{})
+}
+
+// TraceQueryEnd implements pgx.QueryTracer.
+func (t *statementCacheTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, _ pgx.TraceQueryEndData) {
+	lookup, ok := ctx.Value(statementCacheLookupKey{}).(*statementCacheLookup)
+	if !ok {
+		return
+	}
+	if lookup.prepared {
+		t.misses.Add(1)
+		statementCacheRequests.WithLabelValues(t.name, "miss").Inc()
+		return
+	}
+	t.hits.Add(1)
+	statementCacheRequests.WithLabelValues(t.name, "hit").Inc()
+}
+
+// TracePrepareStart implements pgx.PrepareTracer, pgx prepares the statement of the query missing the cache.
+func (*statementCacheTracer) TracePrepareStart(ctx context.Context, _ *pgx.Conn, _ pgx.TracePrepareStartData) context.Context {
+	if lookup, ok := ctx.Value(statementCacheLookupKey{}).(*statementCacheLookup); ok {
+		lookup.prepared = true
+	}
+	return ctx
+}
+
+// TracePrepareEnd implements pgx.PrepareTracer.
+func (*statementCacheTracer) TracePrepareEnd(context.Context, *pgx.Conn, pgx.TracePrepareEndData) {}
@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
 )
 
 // PipelineMessage is the message for pipelines.
@@ -22,7 +23,12 @@ type PipelineMessage struct {
 
 // PipelineFind is the API message for finding pipelines.
 type PipelineFind struct {
-	ID *int
+	ID        *int
+	ProjectID *string
+	// After lists the pipelines after the cursor in the reverse creation order.
+	After  *KeysetCursor
+	Limit  *int
+	Offset *int
 }
 
 // CreatePipelineV2 creates a pipeline.
@@ -99,6 +105,14 @@ func (s *Store) ListPipelineV2(ctx context.Context, find *PipelineFind) ([]*Pipe
 	if v := find.ID; v != nil {
 		where, args = append(where, fmt.Sprintf("pipeline.id = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := find.ProjectID; v != nil {
+		where, args = append(where, fmt.Sprintf("project.resource_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.After; v != nil {
+		var condition string
+		condition, args = getKeysetCondition("pipeline", v, api.DESC, args)
+		where = append(where, condition)
+	}
 	query := fmt.Sprintf(`
 		SELECT
 			pipeline.id,
@@ -106,7 +120,16 @@ func (s *Store) ListPipelineV2(ctx context.Context, find *PipelineFind) ([]*Pipe
 			pipeline.name
 		FROM pipeline
 		LEFT JOIN project ON pipeline.project_id = project.id
-		WHERE %s`, strings.Join(where, " AND "))
+		WHERE %s
+		ORDER BY pipeline.created_ts DESC, pipeline.id DESC`, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, *v)
+	}
+	if v := find.Offset; v != nil {
+		query += fmt.Sprintf(" OFFSET $%d", len(args)+1)
+		args = append(args, *v)
+	}
 
 	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
 	if err != nil {
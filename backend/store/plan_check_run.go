@@ -21,10 +21,14 @@ const (
 	PlanCheckDatabaseStatementCompatibility PlanCheckRunType = "bb.plan-check.database.statement.compatibility"
 	// PlanCheckDatabaseStatementAdvise is the plan check type for schema system review policy.
 	PlanCheckDatabaseStatementAdvise PlanCheckRunType = "bb.plan-check.database.statement.advise"
+	// PlanCheckDatabaseStatementBulkAdvise is the plan check type for reviewing the statement against the schemas of all target databases.
+	PlanCheckDatabaseStatementBulkAdvise PlanCheckRunType = "bb.plan-check.database.statement.bulk-advise"
 	// PlanCheckDatabaseStatementType is the plan check type for statement type.
 	PlanCheckDatabaseStatementType PlanCheckRunType = "bb.plan-check.database.statement.type"
 	// PlanCheckDatabaseStatementSummaryReport is the plan check type for statement summary report.
 	PlanCheckDatabaseStatementSummaryReport PlanCheckRunType = "bb.plan-check.database.statement.summary.report"
+	// PlanCheckDatabaseStatementQueryPlan is the plan check type for comparing the plans of the benchmark queries before and after the migration.
+	PlanCheckDatabaseStatementQueryPlan PlanCheckRunType = "bb.plan-check.database.statement.query-plan"
 	// PlanCheckDatabaseConnect is the plan check type for database connection.
 	PlanCheckDatabaseConnect PlanCheckRunType = "bb.plan-check.database.connect"
 	// PlanCheckDatabaseGhostSync is the plan check type for the gh-ost sync task.
@@ -119,7 +123,7 @@ func (s *Store) CreatePlanCheckRuns(ctx context.Context, creates ...*PlanCheckRu
 			return err
 		}
 	}
-	if _, err := s.db.db.ExecContext(ctx, query.String(), values...); err != nil {
+	if _, err := s.db.ExecContext(ctx, query.String(), values...); err != nil {
 		return errors.Wrapf(err, "failed to execute insert")
 	}
 	return nil
@@ -155,7 +159,7 @@ func (s *Store) ListPlanCheckRuns(ctx context.Context, find *FindPlanCheckRunMes
 		FROM plan_check_run
 		WHERE %s
 	`, strings.Join(where, " AND "))
-	rows, err := s.db.db.QueryContext(ctx, query, args...)
+	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -211,7 +215,7 @@ func (s *Store) UpdatePlanCheckRun(ctx context.Context, updaterUID int, status P
 		status = $2,
 		result = $3
 	WHERE id = $4`
-	if _, err := s.db.db.ExecContext(ctx, query, updaterUID, status, resultBytes, uid); err != nil {
+	if _, err := s.db.ExecContext(ctx, query, updaterUID, status, resultBytes, uid); err != nil {
 		return errors.Wrapf(err, "failed to update plan check run")
 	}
 	return nil
@@ -16,13 +16,7 @@ import (
 
 // GetBackupPlanPolicyByEnvID will get the backup plan policy for an environment.
 func (s *Store) GetBackupPlanPolicyByEnvID(ctx context.Context, environmentID int) (*api.BackupPlanPolicy, error) {
-	resourceType := api.PolicyResourceTypeEnvironment
-	pType := api.PolicyTypeBackupPlan
-	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
-		ResourceType: &resourceType,
-		ResourceUID:  &environmentID,
-		Type:         &pType,
-	})
+	policy, err := s.getEnvironmentPolicy(ctx, environmentID, api.PolicyTypeBackupPlan)
 	if err != nil {
 		return nil, err
 	}
@@ -35,8 +29,63 @@ func (s *Store) GetBackupPlanPolicyByEnvID(ctx context.Context, environmentID in
 }
 
 func (s *Store) GetRolloutPolicy(ctx context.Context, environmentID int) (*storepb.RolloutPolicy, error) {
+	policy, err := s.getEnvironmentPolicy(ctx, environmentID, api.PolicyTypeRollout)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get policy")
+	}
+	if policy == nil {
+		return &storepb.RolloutPolicy{
+			Automatic: true,
+		}, nil
+	}
+
+	p := &storepb.RolloutPolicy{}
+	if err := protojson.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal rollout policy")
+	}
+
+	return p, nil
+}
+
+// getEnvironmentPolicy gets the policy of the environment.
+// The environment without its own policy inherits the policy of its environment class.
+func (s *Store) getEnvironmentPolicy(ctx context.Context, environmentID int, pType api.PolicyType) (*PolicyMessage, error) {
+	resourceType := api.PolicyResourceTypeEnvironment
+	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
+		ResourceType: &resourceType,
+		ResourceUID:  &environmentID,
+		Type:         &pType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if policy != nil {
+		return policy, nil
+	}
+
+	environment, err := s.GetEnvironmentV2(ctx, &FindEnvironmentMessage{UID: &environmentID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get environment %d", environmentID)
+	}
+	if environment == nil {
+		return nil, nil
+	}
+	classUID, ok := api.GetEnvironmentClassUID(environment.Class)
+	if !ok {
+		return nil, nil
+	}
+	classResourceType := api.PolicyResourceTypeEnvironmentClass
+	return s.GetPolicyV2(ctx, &FindPolicyMessage{
+		ResourceType: &classResourceType,
+		ResourceUID:  &classUID,
+		Type:         &pType,
+	})
+}
+
+// GetTaskRunLogRetentionPolicy will get the task run log retention policy for an environment.
+func (s *Store) GetTaskRunLogRetentionPolicy(ctx context.Context, environmentID int) (*storepb.TaskRunLogRetentionPolicy, error) {
 	resourceType := api.PolicyResourceTypeEnvironment
-	pType := api.PolicyTypeRollout
+	pType := api.PolicyTypeTaskRunLogRetention
 	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
 		ResourceType: &resourceType,
 		ResourceUID:  &environmentID,
@@ -46,28 +95,44 @@ func (s *Store) GetRolloutPolicy(ctx context.Context, environmentID int) (*store
 		return nil, errors.Wrapf(err, "failed to get policy")
 	}
 	if policy == nil {
-		return &storepb.RolloutPolicy{
-			Automatic: true,
-		}, nil
+		return &storepb.TaskRunLogRetentionPolicy{}, nil
 	}
 
-	p := &storepb.RolloutPolicy{}
+	p := &storepb.TaskRunLogRetentionPolicy{}
 	if err := protojson.Unmarshal([]byte(policy.Payload), p); err != nil {
-		return nil, errors.Wrapf(err, "failed to unmarshal rollout policy")
+		return nil, errors.Wrapf(err, "failed to unmarshal task run log retention policy")
 	}
 
 	return p, nil
 }
 
-// GetSQLReviewPolicy will get the SQL review policy for an environment.
-func (s *Store) GetSQLReviewPolicy(ctx context.Context, environmentID int) (*storepb.SQLReviewPolicy, error) {
+// GetAffectedRowsLimitPolicy will get the affected rows limit policy for an environment.
+func (s *Store) GetAffectedRowsLimitPolicy(ctx context.Context, environmentID int) (*storepb.AffectedRowsLimitPolicy, error) {
 	resourceType := api.PolicyResourceTypeEnvironment
-	pType := api.PolicyTypeSQLReview
+	pType := api.PolicyTypeAffectedRowsLimit
 	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
 		ResourceType: &resourceType,
 		ResourceUID:  &environmentID,
 		Type:         &pType,
 	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get policy")
+	}
+	if policy == nil {
+		return &storepb.AffectedRowsLimitPolicy{}, nil
+	}
+
+	p := &storepb.AffectedRowsLimitPolicy{}
+	if err := protojson.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal affected rows limit policy")
+	}
+
+	return p, nil
+}
+
+// GetSQLReviewPolicy will get the SQL review policy for an environment.
+func (s *Store) GetSQLReviewPolicy(ctx context.Context, environmentID int) (*storepb.SQLReviewPolicy, error) {
+	policy, err := s.getEnvironmentPolicy(ctx, environmentID, api.PolicyTypeSQLReview)
 	if err != nil {
 		return nil, err
 	}
@@ -152,6 +217,31 @@ func (s *Store) GetMaskingPolicyByDatabaseUID(ctx context.Context, databaseUID i
 	return p, nil
 }
 
+// GetMaskingPolicyByProjectUID gets the masking policy with the wildcard rules for a project.
+func (s *Store) GetMaskingPolicyByProjectUID(ctx context.Context, projectUID int) (*storepb.MaskingPolicy, error) {
+	resourceType := api.PolicyResourceTypeProject
+	pType := api.PolicyTypeMasking
+	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
+		ResourceType: &resourceType,
+		ResourceUID:  &projectUID,
+		Type:         &pType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if policy == nil {
+		return &storepb.MaskingPolicy{}, nil
+	}
+
+	p := new(storepb.MaskingPolicy)
+	if err := protojson.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
 // GetMaskingExceptionPolicyByProjectUID gets the masking exception policy for a project.
 func (s *Store) GetMaskingExceptionPolicyByProjectUID(ctx context.Context, projectUID int) (*storepb.MaskingExceptionPolicy, error) {
 	resourceType := api.PolicyResourceTypeProject
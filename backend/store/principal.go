@@ -182,7 +182,8 @@ func (*Store) listUserImpl(ctx context.Context, tx *Tx, find *FindUserMessage) (
 	WHERE ` + strings.Join(where, " AND ")
 
 	if v := find.Limit; v != nil {
-		query += fmt.Sprintf(" LIMIT %d", *v)
+		query += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, *v)
 	}
 
 	var userMessages []*UserMessage
@@ -371,6 +372,32 @@ func (s *Store) UpdateUser(ctx context.Context, userID int, patch *UpdateUserMes
 	); err != nil {
 		return nil, err
 	}
+	// Sign out the deactivated user from all the sessions.
+	var revokedSessionIDs []int
+	if v := patch.Delete; v != nil && *v {
+		rows, err := tx.QueryContext(ctx, `
+			UPDATE user_session
+			SET revoked_ts = extract(epoch from now())
+			WHERE principal_id = $1 AND revoked_ts = 0
+			RETURNING id
+		`, userID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to revoke user sessions")
+		}
+		for rows.Next() {
+			var id int
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return nil, errors.Wrapf(err, "failed to scan revoked user session")
+			}
+			revokedSessionIDs = append(revokedSessionIDs, id)
+		}
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return nil, errors.Wrapf(err, "failed to scan revoked user sessions")
+		}
+		rows.Close()
+	}
 
 	var patchRoles []api.Role
 	doPatchRoles := false
@@ -398,6 +425,9 @@ func (s *Store) UpdateUser(ctx context.Context, userID int, patch *UpdateUserMes
 		s.projectPolicyCache.Purge()
 	}
 	s.userIDCache.Remove(userID)
+	for _, id := range revokedSessionIDs {
+		s.userSessionCache.Remove(id)
+	}
 	return s.GetUserByID(ctx, userID)
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -102,7 +103,7 @@ func (s *Store) GetProjectUsingRole(ctx context.Context, role api.Role) (bool, s
 		LIMIT 1
 	`
 	var project string
-	if err := s.db.db.QueryRowContext(ctx, query, role).Scan(&project); err != nil {
+	if err := s.db.QueryRowContext(ctx, query, role).Scan(&project); err != nil {
 		if err == sql.ErrNoRows {
 			return false, "", nil
 		}
@@ -191,6 +192,50 @@ func (s *Store) SetProjectIAMPolicy(ctx context.Context, set *IAMPolicyMessage,
 	return s.GetProjectPolicy(ctx, &GetProjectPolicyMessage{UID: &projectUID})
 }
 
+// UpdateProjectMemberRole adds the user to or removes the user from the unconditional binding of the role in the project.
+// It's a no-op if the user is already in the desired state.
+func (s *Store) UpdateProjectMemberRole(ctx context.Context, projectUID int, role api.Role, user *UserMessage, isMember bool, updaterUID int) error {
+	policy, err := s.GetProjectPolicy(ctx, &GetProjectPolicyMessage{UID: &projectUID})
+	if err != nil {
+		return err
+	}
+
+	changed, found := false, false
+	var bindings []*PolicyBinding
+	for _, binding := range policy.Bindings {
+		if binding.Role != role || (binding.Condition != nil && binding.Condition.Expression != "") {
+			bindings = append(bindings, binding)
+			continue
+		}
+		found = true
+		index := slices.IndexFunc(binding.Members, func(member *UserMessage) bool {
+			return member.ID == user.ID
+		})
+		members := binding.Members
+		if isMember && index < 0 {
+			members = append(slices.Clone(members), user)
+			changed = true
+		} else if !isMember && index >= 0 {
+			members = slices.Delete(slices.Clone(members), index, index+1)
+			changed = true
+		}
+		if len(members) > 0 {
+			bindings = append(bindings, &PolicyBinding{Role: binding.Role, Members: members, Condition: binding.Condition})
+		}
+	}
+	if isMember && !found {
+		bindings = append(bindings, &PolicyBinding{Role: role, Members: []*UserMessage{user}})
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	if _, err := s.SetProjectIAMPolicy(ctx, &IAMPolicyMessage{Bindings: bindings}, updaterUID, projectUID); err != nil {
+		return err
+	}
+	return nil
+}
+
 type roleConditionMapKey struct {
 	role      api.Role
 	condition string
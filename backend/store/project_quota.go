@@ -0,0 +1,71 @@
+package store
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// ListProjectRunningPipelineUIDs lists the UIDs of the project pipelines having running task runs.
+func (s *Store) ListProjectRunningPipelineUIDs(ctx context.Context, projectID string) ([]int, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT DISTINCT pipeline.id
+		FROM task_run
+		INNER JOIN task ON task_run.task_id = task.id
+		INNER JOIN pipeline ON task.pipeline_id = pipeline.id
+		INNER JOIN project ON pipeline.project_id = project.id
+		WHERE project.resource_id = $1 AND task_run.status = 'RUNNING'
+		ORDER BY pipeline.id`,
+		projectID,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list running pipelines of project %q", projectID)
+	}
+	defer rows.Close()
+
+	var pipelineUIDs []int
+	for rows.Next() {
+		var pipelineUID int
+		if err := rows.Scan(&pipelineUID); err != nil {
+			return nil, err
+		}
+		pipelineUIDs = append(pipelineUIDs, pipelineUID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return pipelineUIDs, nil
+}
+
+// GetProjectExportedRowCount returns the number of rows exported from the project databases since the given time.
+func (s *Store) GetProjectExportedRowCount(ctx context.Context, projectID string, sinceTs int64) (int64, error) {
+	var count int64
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT COALESCE(SUM(export_audit.row_count), 0)::BIGINT
+		FROM export_audit
+		INNER JOIN db ON export_audit.database_id = db.id
+		INNER JOIN project ON db.project_id = project.id
+		WHERE project.resource_id = $1 AND export_audit.created_ts >= $2`,
+		projectID, sinceTs,
+	).Scan(&count); err != nil {
+		return 0, errors.Wrapf(err, "failed to get exported row count of project %q", projectID)
+	}
+	return count, nil
+}
+
+// GetProjectBackupStorageBytes returns the total size of the done backups of the project databases.
+// The backups taken before the size is recorded are not counted.
+func (s *Store) GetProjectBackupStorageBytes(ctx context.Context, projectID string) (int64, error) {
+	var size int64
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT COALESCE(SUM((backup.payload->>'size')::BIGINT), 0)::BIGINT
+		FROM backup
+		INNER JOIN db ON backup.database_id = db.id
+		INNER JOIN project ON db.project_id = project.id
+		WHERE project.resource_id = $1 AND backup.row_status = 'NORMAL' AND backup.status = 'DONE'`,
+		projectID,
+	).Scan(&size); err != nil {
+		return 0, errors.Wrapf(err, "failed to get backup storage bytes of project %q", projectID)
+	}
+	return size, nil
+}
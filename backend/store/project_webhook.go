@@ -8,9 +8,11 @@ import (
 
 	"github.com/jackc/pgtype"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/bytebase/bytebase/backend/common"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
 // ProjectWebhookMessage is the store model for an project webhook.
@@ -23,6 +25,8 @@ type ProjectWebhookMessage struct {
 	URL string
 	// ActivityList is the list of activities that the webhook is interested in.
 	ActivityList []string
+	// Payload is the payload config of the webhook.
+	Payload *storepb.ProjectWebhookPayload
 	// Output only fields.
 	//
 	// ID is the unique identifier of the project webhook.
@@ -38,6 +42,8 @@ type UpdateProjectWebhookMessage struct {
 	URL *string
 	// ActivityList is the list of activities that the webhook is interested in.
 	ActivityList []string
+	// Payload is the payload config of the webhook.
+	Payload *storepb.ProjectWebhookPayload
 }
 
 // FindProjectWebhookMessage is the message for finding project webhooks,
@@ -51,6 +57,13 @@ type FindProjectWebhookMessage struct {
 
 // CreateProjectWebhookV2 creates an instance of ProjectWebhook.
 func (s *Store) CreateProjectWebhookV2(ctx context.Context, principalUID int, projectUID int, projectResourceID string, create *ProjectWebhookMessage) (*ProjectWebhookMessage, error) {
+	if create.Payload == nil {
+		create.Payload = &storepb.ProjectWebhookPayload{}
+	}
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal payload")
+	}
 	query := `
 		INSERT INTO project_webhook (
 			creator_id,
@@ -59,12 +72,15 @@ func (s *Store) CreateProjectWebhookV2(ctx context.Context, principalUID int, pr
 			type,
 			name,
 			url,
-			activity_list
+			activity_list,
+			payload
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 		RETURNING id, project_id, type, name, url, activity_list
 	`
-	var projectWebhook ProjectWebhookMessage
+	projectWebhook := ProjectWebhookMessage{
+		Payload: create.Payload,
+	}
 	var txtArray pgtype.TextArray
 
 	tx, err := s.db.BeginTx(ctx, nil)
@@ -80,6 +96,7 @@ func (s *Store) CreateProjectWebhookV2(ctx context.Context, principalUID int, pr
 		create.Title,
 		create.URL,
 		create.ActivityList,
+		payload,
 	).Scan(
 		&projectWebhook.ID,
 		&projectWebhook.ProjectID,
@@ -165,17 +182,27 @@ func (s *Store) UpdateProjectWebhookV2(ctx context.Context, principalUID int, pr
 	if v := update.ActivityList; v != nil {
 		set, args = append(set, fmt.Sprintf("activity_list = $%d", len(args)+1)), append(args, v)
 	}
+	if v := update.Payload; v != nil {
+		payload, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal payload")
+		}
+		set, args = append(set, fmt.Sprintf("payload = $%d", len(args)+1)), append(args, payload)
+	}
 
 	args = append(args, projectWebhookID)
 
-	var projectWebhook ProjectWebhookMessage
+	projectWebhook := ProjectWebhookMessage{
+		Payload: &storepb.ProjectWebhookPayload{},
+	}
 	var txtArray pgtype.TextArray
+	var payload []byte
 	// Execute update query with RETURNING.
 	if err := tx.QueryRowContext(ctx, fmt.Sprintf(`
 	UPDATE project_webhook
 	SET `+strings.Join(set, ", ")+`
 	WHERE id = $%d
-	RETURNING id, project_id, type, name, url, activity_list
+	RETURNING id, project_id, type, name, url, activity_list, payload
 `, len(args)),
 		args...,
 	).Scan(
@@ -185,6 +212,7 @@ func (s *Store) UpdateProjectWebhookV2(ctx context.Context, principalUID int, pr
 		&projectWebhook.Title,
 		&projectWebhook.URL,
 		&txtArray,
+		&payload,
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("project hook ID not found: %d", projectWebhookID)}
@@ -194,6 +222,9 @@ func (s *Store) UpdateProjectWebhookV2(ctx context.Context, principalUID int, pr
 	if err := txtArray.AssignTo(&projectWebhook.ActivityList); err != nil {
 		return nil, err
 	}
+	if err := protojson.Unmarshal(payload, projectWebhook.Payload); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal payload")
+	}
 
 	if err := tx.Commit(); err != nil {
 		return nil, errors.Wrapf(err, "failed to commit transaction")
@@ -241,7 +272,8 @@ func (*Store) findProjectWebhookImplV2(ctx context.Context, tx *Tx, find *FindPr
 			type,
 			name,
 			url,
-			activity_list
+			activity_list,
+			payload
 		FROM project_webhook
 		WHERE `+strings.Join(where, " AND "),
 		args...,
@@ -253,8 +285,11 @@ func (*Store) findProjectWebhookImplV2(ctx context.Context, tx *Tx, find *FindPr
 
 	var projectWebhooks []*ProjectWebhookMessage
 	for rows.Next() {
-		var projectWebhook ProjectWebhookMessage
+		projectWebhook := ProjectWebhookMessage{
+			Payload: &storepb.ProjectWebhookPayload{},
+		}
 		var txtArray pgtype.TextArray
+		var payload []byte
 
 		if err := rows.Scan(
 			&projectWebhook.ID,
@@ -262,6 +297,7 @@ func (*Store) findProjectWebhookImplV2(ctx context.Context, tx *Tx, find *FindPr
 			&projectWebhook.Title,
 			&projectWebhook.URL,
 			&txtArray,
+			&payload,
 		); err != nil {
 			return nil, err
 		}
@@ -269,6 +305,9 @@ func (*Store) findProjectWebhookImplV2(ctx context.Context, tx *Tx, find *FindPr
 		if err := txtArray.AssignTo(&projectWebhook.ActivityList); err != nil {
 			return nil, err
 		}
+		if err := protojson.Unmarshal(payload, projectWebhook.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal payload")
+		}
 
 		if v := find.ActivityType; v != nil {
 			for _, activity := range projectWebhook.ActivityList {
@@ -0,0 +1,296 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// QueryHistoryMessage is the message for a query history of the SQL Editor.
+type QueryHistoryMessage struct {
+	CreatorUID  int
+	InstanceUID int
+	// DatabaseUID is nil if the query is against the instance.
+	DatabaseUID *int
+	Statement   string
+	DurationNs  int64
+	RowCount    int64
+	Error       string
+	Tables      []string
+
+	// Output only.
+	UID       int64
+	CreatedTs int64
+}
+
+// FindQueryHistoryMessage is the message for finding query histories.
+type FindQueryHistoryMessage struct {
+	CreatorUID      *int
+	InstanceUID     *int
+	DatabaseUID     *int
+	CreatedTsAfter  *int64
+	CreatedTsBefore *int64
+	// StatementContains finds the query histories with the statement containing the text case-insensitively.
+	StatementContains *string
+	Limit             *int
+	Offset            *int
+}
+
+// QueryHistoryUserStatsMessage is the message for the query history statistics of a user.
+type QueryHistoryUserStatsMessage struct {
+	CreatorUID    int
+	QueryCount    int64
+	ErrorCount    int64
+	RowCount      int64
+	P95DurationNs int64
+	TopTables     []*QueryHistoryTableCountMessage
+}
+
+// QueryHistoryTableCountMessage is the message for the number of the queries against a table.
+type QueryHistoryTableCountMessage struct {
+	Table string
+	Count int64
+}
+
+// CreateQueryHistory creates a query history.
+func (s *Store) CreateQueryHistory(ctx context.Context, create *QueryHistoryMessage) (*QueryHistoryMessage, error) {
+	tables := create.Tables
+	if tables == nil {
+		tables = []string{}
+	}
+	tablesBytes, err := json.Marshal(tables)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal tables")
+	}
+	query := `
+		INSERT INTO query_history (
+			creator_id,
+			instance_id,
+			database_id,
+			statement,
+			duration_ns,
+			row_count,
+			error,
+			tables
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_ts
+	`
+	history := *create
+	history.Tables = tables
+	if err := s.db.QueryRowContext(ctx, query,
+		create.CreatorUID,
+		create.InstanceUID,
+		create.DatabaseUID,
+		create.Statement,
+		create.DurationNs,
+		create.RowCount,
+		create.Error,
+		tablesBytes,
+	).Scan(&history.UID, &history.CreatedTs); err != nil {
+		return nil, errors.Wrapf(err, "failed to create query history")
+	}
+	return &history, nil
+}
+
+// ListQueryHistories lists query histories in the reverse creation order.
+// It reads from the replica if configured.
+func (s *Store) ListQueryHistories(ctx context.Context, find *FindQueryHistoryMessage) ([]*QueryHistoryMessage, error) {
+	where, args := getQueryHistoryWhere(find)
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			creator_id,
+			created_ts,
+			instance_id,
+			database_id,
+			statement,
+			duration_ns,
+			row_count,
+			error,
+			tables
+		FROM query_history
+		WHERE %s
+		ORDER BY id DESC`, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, *v)
+	}
+	if v := find.Offset; v != nil {
+		query += fmt.Sprintf(" OFFSET $%d", len(args)+1)
+		args = append(args, *v)
+	}
+
+	tx, err := s.db.BeginReplicaTx(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list query histories")
+	}
+	defer rows.Close()
+
+	var histories []*QueryHistoryMessage
+	for rows.Next() {
+		var history QueryHistoryMessage
+		var tablesBytes []byte
+		if err := rows.Scan(
+			&history.UID,
+			&history.CreatorUID,
+			&history.CreatedTs,
+			&history.InstanceUID,
+			&history.DatabaseUID,
+			&history.Statement,
+			&history.DurationNs,
+			&history.RowCount,
+			&history.Error,
+			&tablesBytes,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan query history")
+		}
+		if err := json.Unmarshal(tablesBytes, &history.Tables); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal tables")
+		}
+		histories = append(histories, &history)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan query histories")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit transaction")
+	}
+	return histories, nil
+}
+
+// GetQueryHistoryUserStats gets the query history statistics of each user in the descending order of the query count.
+// Only the top topTableCount tables are returned for each user.
+// It reads from the replica if configured.
+func (s *Store) GetQueryHistoryUserStats(ctx context.Context, find *FindQueryHistoryMessage, topTableCount int) ([]*QueryHistoryUserStatsMessage, error) {
+	where, args := getQueryHistoryWhere(find)
+	query := fmt.Sprintf(`
+		SELECT
+			creator_id,
+			COUNT(*),
+			COUNT(*) FILTER (WHERE error <> ''),
+			COALESCE(SUM(row_count), 0),
+			COALESCE(percentile_cont(0.95) WITHIN GROUP (ORDER BY duration_ns), 0)::BIGINT
+		FROM query_history
+		WHERE %s
+		GROUP BY creator_id
+		ORDER BY COUNT(*) DESC, creator_id`, strings.Join(where, " AND "))
+	tx, err := s.db.BeginReplicaTx(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get query history statistics")
+	}
+	defer rows.Close()
+
+	var stats []*QueryHistoryUserStatsMessage
+	statsMap := make(map[int]*QueryHistoryUserStatsMessage)
+	for rows.Next() {
+		var stat QueryHistoryUserStatsMessage
+		if err := rows.Scan(
+			&stat.CreatorUID,
+			&stat.QueryCount,
+			&stat.ErrorCount,
+			&stat.RowCount,
+			&stat.P95DurationNs,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan query history statistics")
+		}
+		stats = append(stats, &stat)
+		statsMap[stat.CreatorUID] = &stat
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan query history statistics")
+	}
+
+	tableQuery := fmt.Sprintf(`
+		SELECT creator_id, tbl, cnt FROM (
+			SELECT
+				creator_id,
+				tbl,
+				COUNT(*) AS cnt,
+				ROW_NUMBER() OVER (PARTITION BY creator_id ORDER BY COUNT(*) DESC, tbl) AS rn
+			FROM query_history, jsonb_array_elements_text(tables) AS tbl
+			WHERE %s
+			GROUP BY creator_id, tbl
+		) t
+		WHERE rn <= %d
+		ORDER BY creator_id, rn`, strings.Join(where, " AND "), topTableCount)
+	tableRows, err := tx.QueryContext(ctx, tableQuery, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get top queried tables")
+	}
+	defer tableRows.Close()
+
+	for tableRows.Next() {
+		var creatorUID int
+		var tableCount QueryHistoryTableCountMessage
+		if err := tableRows.Scan(&creatorUID, &tableCount.Table, &tableCount.Count); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan top queried table")
+		}
+		if stat, ok := statsMap[creatorUID]; ok {
+			stat.TopTables = append(stat.TopTables, &tableCount)
+		}
+	}
+	if err := tableRows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan top queried tables")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit transaction")
+	}
+	return stats, nil
+}
+
+// DeleteQueryHistoriesBefore deletes the query histories created before the timestamp.
+func (s *Store) DeleteQueryHistoriesBefore(ctx context.Context, ts int64) (int64, error) {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM query_history WHERE created_ts < $1`, ts)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to delete query histories")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get the number of deleted query histories")
+	}
+	return count, nil
+}
+
+func getQueryHistoryWhere(find *FindQueryHistoryMessage) ([]string, []any) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.CreatorUID; v != nil {
+		where, args = append(where, fmt.Sprintf("creator_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.InstanceUID; v != nil {
+		where, args = append(where, fmt.Sprintf("instance_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.DatabaseUID; v != nil {
+		where, args = append(where, fmt.Sprintf("database_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatedTsAfter; v != nil {
+		where, args = append(where, fmt.Sprintf("created_ts >= $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatedTsBefore; v != nil {
+		where, args = append(where, fmt.Sprintf("created_ts <= $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.StatementContains; v != nil {
+		where, args = append(where, fmt.Sprintf("statement ILIKE $%d", len(args)+1)), append(args, "%"+escapeLikePattern(*v)+"%")
+	}
+	return where, args
+}
+
+// escapeLikePattern escapes the wildcards of the LIKE pattern.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -7,9 +7,11 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/bytebase/bytebase/backend/common"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
 // RepositoryMessage is the message for a repository.
@@ -31,15 +33,18 @@ type RepositoryMessage struct {
 	SchemaPathTemplate string
 	SheetPathTemplate  string
 	EnableSQLReviewCI  bool
-	EnableCD           bool
-	ExternalID         string
-	ExternalWebhookID  string
-	WebhookURLHost     string
-	WebhookEndpointID  string
-	WebhookSecretToken string
-	AccessToken        string
-	ExpiresTs          int64
-	RefreshToken       string
+	// EnableSQLReviewCheckRun reports the SQL review results as the GitHub check runs.
+	EnableSQLReviewCheckRun bool
+	EnableCD                bool
+	ExternalID              string
+	ExternalWebhookID       string
+	WebhookURLHost          string
+	WebhookEndpointID       string
+	WebhookSecretToken      string
+	AccessToken             string
+	ExpiresTs               int64
+	RefreshToken            string
+	Payload                 *storepb.RepositoryPayload
 }
 
 // FindRepositoryMessage is the message for finding repositories.
@@ -57,16 +62,18 @@ type PatchRepositoryMessage struct {
 	WebURL *string
 
 	// Domain specific fields
-	BranchFilter       *string
-	BaseDirectory      *string
-	FilePathTemplate   *string
-	SchemaPathTemplate *string
-	SheetPathTemplate  *string
-	EnableSQLReviewCI  *bool
-	EnableCD           *bool
-	AccessToken        *string
-	ExpiresTs          *int64
-	RefreshToken       *string
+	BranchFilter            *string
+	BaseDirectory           *string
+	FilePathTemplate        *string
+	SchemaPathTemplate      *string
+	SheetPathTemplate       *string
+	EnableSQLReviewCI       *bool
+	EnableSQLReviewCheckRun *bool
+	EnableCD                *bool
+	AccessToken             *string
+	ExpiresTs               *int64
+	RefreshToken            *string
+	Payload                 *storepb.RepositoryPayload
 }
 
 // CreateRepositoryV2 creates the repository.
@@ -198,6 +205,7 @@ func (s *Store) createRepositoryImplV2(ctx context.Context, tx *Tx, create *Repo
 
 	repository := RepositoryMessage{
 		ProjectResourceID: project.ResourceID,
+		Payload:           &storepb.RepositoryPayload{},
 	}
 	// Insert row into database.
 	query := `
@@ -314,6 +322,7 @@ func (*Store) listRepositoryImplV2(ctx context.Context, tx *Tx, find *FindReposi
 			schema_path_template,
 			sheet_path_template,
 			enable_sql_review_ci,
+			enable_sql_review_check_run,
 			enable_cd,
 			external_id,
 			external_webhook_id,
@@ -322,7 +331,8 @@ func (*Store) listRepositoryImplV2(ctx context.Context, tx *Tx, find *FindReposi
 			webhook_secret_token,
 			access_token,
 			expires_ts,
-			refresh_token
+			refresh_token,
+			repository.payload
 		FROM repository
 		LEFT JOIN project ON project.id = repository.project_id
 		WHERE `+strings.Join(where, " AND "),
@@ -337,6 +347,7 @@ func (*Store) listRepositoryImplV2(ctx context.Context, tx *Tx, find *FindReposi
 	var repoRawList []*RepositoryMessage
 	for rows.Next() {
 		var repository RepositoryMessage
+		var payload []byte
 		if err := rows.Scan(
 			&repository.UID,
 			&repository.VCSUID,
@@ -350,6 +361,7 @@ func (*Store) listRepositoryImplV2(ctx context.Context, tx *Tx, find *FindReposi
 			&repository.SchemaPathTemplate,
 			&repository.SheetPathTemplate,
 			&repository.EnableSQLReviewCI,
+			&repository.EnableSQLReviewCheckRun,
 			&repository.EnableCD,
 			&repository.ExternalID,
 			&repository.ExternalWebhookID,
@@ -359,9 +371,14 @@ func (*Store) listRepositoryImplV2(ctx context.Context, tx *Tx, find *FindReposi
 			&repository.AccessToken,
 			&repository.ExpiresTs,
 			&repository.RefreshToken,
+			&payload,
 		); err != nil {
 			return nil, err
 		}
+		repository.Payload = &storepb.RepositoryPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payload, repository.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal repository payload")
+		}
 
 		repoRawList = append(repoRawList, &repository)
 	}
@@ -404,9 +421,19 @@ func (*Store) patchRepositoryImplV2(ctx context.Context, tx *Tx, patch *PatchRep
 	if v := patch.EnableSQLReviewCI; v != nil {
 		set, args = append(set, fmt.Sprintf("enable_sql_review_ci = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := patch.EnableSQLReviewCheckRun; v != nil {
+		set, args = append(set, fmt.Sprintf("enable_sql_review_check_run = $%d", len(args)+1)), append(args, *v)
+	}
 	if v := patch.EnableCD; v != nil {
 		set, args = append(set, fmt.Sprintf("enable_cd = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := patch.Payload; v != nil {
+		payload, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal repository payload")
+		}
+		set, args = append(set, fmt.Sprintf("payload = $%d", len(args)+1)), append(args, payload)
+	}
 
 	where := []string{}
 	if v := patch.UID; v != nil {
@@ -420,6 +447,7 @@ func (*Store) patchRepositoryImplV2(ctx context.Context, tx *Tx, patch *PatchRep
 	}
 
 	var repository RepositoryMessage
+	var payload []byte
 	// Execute update query with RETURNING.
 	if err := tx.QueryRowContext(ctx, `
 		UPDATE repository
@@ -439,6 +467,7 @@ func (*Store) patchRepositoryImplV2(ctx context.Context, tx *Tx, patch *PatchRep
 			schema_path_template,
 			sheet_path_template,
 			enable_sql_review_ci,
+			enable_sql_review_check_run,
 			external_id,
 			external_webhook_id,
 			webhook_url_host,
@@ -446,7 +475,8 @@ func (*Store) patchRepositoryImplV2(ctx context.Context, tx *Tx, patch *PatchRep
 			webhook_secret_token,
 			access_token,
 			expires_ts,
-			refresh_token
+			refresh_token,
+			repository.payload
 		`,
 		args...,
 	).Scan(
@@ -462,6 +492,7 @@ func (*Store) patchRepositoryImplV2(ctx context.Context, tx *Tx, patch *PatchRep
 		&repository.SchemaPathTemplate,
 		&repository.SheetPathTemplate,
 		&repository.EnableSQLReviewCI,
+		&repository.EnableSQLReviewCheckRun,
 		&repository.ExternalID,
 		&repository.ExternalWebhookID,
 		&repository.WebhookURLHost,
@@ -470,12 +501,17 @@ func (*Store) patchRepositoryImplV2(ctx context.Context, tx *Tx, patch *PatchRep
 		&repository.AccessToken,
 		&repository.ExpiresTs,
 		&repository.RefreshToken,
+		&payload,
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("repository ID not found: %d", patch.UID)}
 		}
 		return nil, err
 	}
+	repository.Payload = &storepb.RepositoryPayload{}
+	if err := protojsonUnmarshaler.Unmarshal(payload, repository.Payload); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal repository payload")
+	}
 	return &repository, nil
 }
 
@@ -6,7 +6,11 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
 // RoleMessage is the message for roles.
@@ -14,6 +18,8 @@ type RoleMessage struct {
 	ResourceID  string
 	Name        string
 	Description string
+	// Permissions are the permissions of the custom roles. It's nil for the built-in roles.
+	Permissions *storepb.RolePermissions
 
 	// Output only
 	CreatorID int
@@ -26,37 +32,54 @@ type UpdateRoleMessage struct {
 
 	Name        *string
 	Description *string
+	Permissions *storepb.RolePermissions
 }
 
 // CreateRole creates a new role.
 func (s *Store) CreateRole(ctx context.Context, create *RoleMessage, creatorID int) (*RoleMessage, error) {
+	permissions, err := protojson.Marshal(create.Permissions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal role permissions")
+	}
 	query := `
 		INSERT INTO
-			role (creator_id, updater_id, resource_id, name, description)
-		VALUES ($1, $2, $3, $4, $5)
+			role (creator_id, updater_id, resource_id, name, description, permissions)
+		VALUES ($1, $2, $3, $4, $5, $6)
 	`
-	if _, err := s.db.db.ExecContext(ctx, query, creatorID, creatorID, create.ResourceID, create.Name, create.Description); err != nil {
+	if _, err := s.db.ExecContext(ctx, query, creatorID, creatorID, create.ResourceID, create.Name, create.Description, permissions); err != nil {
 		return nil, err
 	}
+	create.CreatorID = creatorID
+	s.roleCache.Add(create.ResourceID, create)
 	return create, nil
 }
 
 // GetRole returns a role by ID.
 func (s *Store) GetRole(ctx context.Context, resourceID string) (*RoleMessage, error) {
+	if v, ok := s.roleCache.Get(resourceID); ok {
+		return v, nil
+	}
 	query := `
 		SELECT
-			creator_id, name, description
+			creator_id, name, description, permissions
 		FROM role
 		WHERE resource_id = $1
 	`
-	var role RoleMessage
-	if err := s.db.db.QueryRowContext(ctx, query, resourceID).Scan(&role.CreatorID, &role.Name, &role.Description); err != nil {
+	role := RoleMessage{
+		ResourceID:  resourceID,
+		Permissions: &storepb.RolePermissions{},
+	}
+	var permissions []byte
+	if err := s.db.QueryRowContext(ctx, query, resourceID).Scan(&role.CreatorID, &role.Name, &role.Description, &permissions); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
 		return nil, err
 	}
-	role.ResourceID = resourceID
+	if err := protojsonUnmarshaler.Unmarshal(permissions, role.Permissions); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal role permissions")
+	}
+	s.roleCache.Add(resourceID, &role)
 	return &role, nil
 }
 
@@ -64,10 +87,10 @@ func (s *Store) GetRole(ctx context.Context, resourceID string) (*RoleMessage, e
 func (s *Store) ListRoles(ctx context.Context) ([]*RoleMessage, error) {
 	query := `
 		SELECT
-			creator_id, resource_id, name, description
+			creator_id, resource_id, name, description, permissions
 		FROM role
 	`
-	rows, err := s.db.db.QueryContext(ctx, query)
+	rows, err := s.db.QueryContext(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -133,13 +156,25 @@ func (s *Store) ListRoles(ctx context.Context) ([]*RoleMessage, error) {
 			Name:        "Project viewer",
 			Description: "",
 		},
+		&RoleMessage{
+			CreatorID:   api.SystemBotID,
+			ResourceID:  api.ProjectDMLExecutor.String(),
+			Name:        "Project DML executor",
+			Description: "",
+		},
 	)
 
 	for rows.Next() {
-		var role RoleMessage
-		if err := rows.Scan(&role.CreatorID, &role.ResourceID, &role.Name, &role.Description); err != nil {
+		role := RoleMessage{
+			Permissions: &storepb.RolePermissions{},
+		}
+		var permissions []byte
+		if err := rows.Scan(&role.CreatorID, &role.ResourceID, &role.Name, &role.Description, &permissions); err != nil {
 			return nil, err
 		}
+		if err := protojsonUnmarshaler.Unmarshal(permissions, role.Permissions); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal role permissions")
+		}
 		roles = append(roles, &role)
 	}
 
@@ -159,21 +194,34 @@ func (s *Store) UpdateRole(ctx context.Context, patch *UpdateRoleMessage) (*Role
 	if v := patch.Description; v != nil {
 		set, args = append(set, fmt.Sprintf("description = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := patch.Permissions; v != nil {
+		permissions, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal role permissions")
+		}
+		set, args = append(set, fmt.Sprintf("permissions = $%d", len(args)+1)), append(args, permissions)
+	}
 	args = append(args, patch.ResourceID)
 
 	query := fmt.Sprintf(`
 		UPDATE role
 		SET `+strings.Join(set, ", ")+`
 		WHERE resource_id = $%d
-		RETURNING creator_id, name, description
+		RETURNING creator_id, name, description, permissions
 	`, len(args))
 
 	role := RoleMessage{
-		ResourceID: patch.ResourceID,
+		ResourceID:  patch.ResourceID,
+		Permissions: &storepb.RolePermissions{},
 	}
-	if err := s.db.db.QueryRowContext(ctx, query, args...).Scan(&role.CreatorID, &role.Name, &role.Description); err != nil {
+	var permissions []byte
+	if err := s.db.QueryRowContext(ctx, query, args...).Scan(&role.CreatorID, &role.Name, &role.Description, &permissions); err != nil {
 		return nil, err
 	}
+	if err := protojsonUnmarshaler.Unmarshal(permissions, role.Permissions); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal role permissions")
+	}
+	s.roleCache.Add(patch.ResourceID, &role)
 
 	return &role, nil
 }
@@ -184,8 +232,27 @@ func (s *Store) DeleteRole(ctx context.Context, resourceID string) error {
 		DELETE FROM role
 		WHERE resource_id = $1
 	`
-	if _, err := s.db.db.ExecContext(ctx, query, resourceID); err != nil {
+	if _, err := s.db.ExecContext(ctx, query, resourceID); err != nil {
 		return err
 	}
+	s.roleCache.Remove(resourceID)
 	return nil
 }
+
+// GetUserUsingRole gets the email of a user that has the role in the workspace.
+func (s *Store) GetUserUsingRole(ctx context.Context, role api.Role) (bool, string, error) {
+	query := `
+		SELECT principal.email
+		FROM member, principal
+		WHERE member.role = $1 AND member.principal_id = principal.id
+		LIMIT 1
+	`
+	var email string
+	if err := s.db.QueryRowContext(ctx, query, role).Scan(&email); err != nil {
+		if err == sql.ErrNoRows {
+			return false, "", nil
+		}
+		return false, "", err
+	}
+	return true, email, nil
+}
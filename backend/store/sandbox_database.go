@@ -0,0 +1,204 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// SandboxDatabaseStatus is the status of a sandbox database.
+type SandboxDatabaseStatus string
+
+const (
+	// SandboxDatabaseStatusPending is the status of the sandbox database being provisioned.
+	SandboxDatabaseStatusPending SandboxDatabaseStatus = "PENDING"
+	// SandboxDatabaseStatusReady is the status of the provisioned sandbox database.
+	SandboxDatabaseStatusReady SandboxDatabaseStatus = "READY"
+	// SandboxDatabaseStatusFailed is the status of the sandbox database failed to provision.
+	SandboxDatabaseStatusFailed SandboxDatabaseStatus = "FAILED"
+	// SandboxDatabaseStatusDropped is the status of the expired sandbox database which has been dropped.
+	SandboxDatabaseStatusDropped SandboxDatabaseStatus = "DROPPED"
+)
+
+// SandboxDatabaseMessage is the message for a sandbox database.
+type SandboxDatabaseMessage struct {
+	SourceDatabaseUID int
+	// InstanceUID is the UID of the sandbox instance.
+	InstanceUID  int
+	DatabaseName string
+	Status       SandboxDatabaseStatus
+	ExpireTs     int64
+	Payload      *storepb.SandboxDatabasePayload
+
+	// Output only.
+	UID        int
+	CreatorUID int
+	CreatedTs  int64
+	UpdatedTs  int64
+}
+
+// FindSandboxDatabaseMessage is the message for finding sandbox databases.
+type FindSandboxDatabaseMessage struct {
+	UID               *int
+	SourceDatabaseUID *int
+	InstanceUID       *int
+	DatabaseName      *string
+	StatusList        []SandboxDatabaseStatus
+	// ExpireTsBefore finds the sandbox databases expired before the timestamp.
+	ExpireTsBefore *int64
+}
+
+// UpdateSandboxDatabaseMessage is the message for updating a sandbox database.
+type UpdateSandboxDatabaseMessage struct {
+	UID int
+
+	Status  *SandboxDatabaseStatus
+	Payload *storepb.SandboxDatabasePayload
+}
+
+// CreateSandboxDatabase creates a sandbox database in the pending status.
+func (s *Store) CreateSandboxDatabase(ctx context.Context, create *SandboxDatabaseMessage, creatorUID int) (*SandboxDatabaseMessage, error) {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal payload")
+	}
+	query := `
+		INSERT INTO sandbox_database (
+			creator_id,
+			source_database_id,
+			instance_id,
+			name,
+			status,
+			expire_ts,
+			payload
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_ts, updated_ts
+	`
+	sandbox := &SandboxDatabaseMessage{
+		SourceDatabaseUID: create.SourceDatabaseUID,
+		InstanceUID:       create.InstanceUID,
+		DatabaseName:      create.DatabaseName,
+		Status:            SandboxDatabaseStatusPending,
+		ExpireTs:          create.ExpireTs,
+		Payload:           create.Payload,
+		CreatorUID:        creatorUID,
+	}
+	if err := s.db.QueryRowContext(ctx, query,
+		creatorUID,
+		create.SourceDatabaseUID,
+		create.InstanceUID,
+		create.DatabaseName,
+		SandboxDatabaseStatusPending,
+		create.ExpireTs,
+		payload,
+	).Scan(
+		&sandbox.UID,
+		&sandbox.CreatedTs,
+		&sandbox.UpdatedTs,
+	); err != nil {
+		return nil, errors.Wrapf(err, "failed to create sandbox database")
+	}
+	return sandbox, nil
+}
+
+// ListSandboxDatabases lists sandbox databases.
+func (s *Store) ListSandboxDatabases(ctx context.Context, find *FindSandboxDatabaseMessage) ([]*SandboxDatabaseMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.SourceDatabaseUID; v != nil {
+		where, args = append(where, fmt.Sprintf("source_database_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.InstanceUID; v != nil {
+		where, args = append(where, fmt.Sprintf("instance_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.DatabaseName; v != nil {
+		where, args = append(where, fmt.Sprintf("name = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.StatusList; v != nil {
+		where, args = append(where, fmt.Sprintf("status = ANY($%d)", len(args)+1)), append(args, v)
+	}
+	if v := find.ExpireTsBefore; v != nil {
+		where, args = append(where, fmt.Sprintf("expire_ts < $%d", len(args)+1)), append(args, *v)
+	}
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			creator_id,
+			created_ts,
+			updated_ts,
+			source_database_id,
+			instance_id,
+			name,
+			status,
+			expire_ts,
+			payload
+		FROM sandbox_database
+		WHERE %s
+		ORDER BY id DESC`, strings.Join(where, " AND "))
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list sandbox databases")
+	}
+	defer rows.Close()
+
+	var sandboxes []*SandboxDatabaseMessage
+	for rows.Next() {
+		sandbox := &SandboxDatabaseMessage{
+			Payload: &storepb.SandboxDatabasePayload{},
+		}
+		var payload []byte
+		if err := rows.Scan(
+			&sandbox.UID,
+			&sandbox.CreatorUID,
+			&sandbox.CreatedTs,
+			&sandbox.UpdatedTs,
+			&sandbox.SourceDatabaseUID,
+			&sandbox.InstanceUID,
+			&sandbox.DatabaseName,
+			&sandbox.Status,
+			&sandbox.ExpireTs,
+			&payload,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan sandbox database")
+		}
+		if err := protojson.Unmarshal(payload, sandbox.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal payload of sandbox database %d", sandbox.UID)
+		}
+		sandboxes = append(sandboxes, sandbox)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan sandbox databases")
+	}
+	return sandboxes, nil
+}
+
+// UpdateSandboxDatabase updates a sandbox database.
+func (s *Store) UpdateSandboxDatabase(ctx context.Context, patch *UpdateSandboxDatabaseMessage) error {
+	set, args := []string{}, []any{}
+	if v := patch.Status; v != nil {
+		set, args = append(set, fmt.Sprintf("status = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := patch.Payload; v != nil {
+		payload, err := protojson.Marshal(v)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal payload")
+		}
+		set, args = append(set, fmt.Sprintf("payload = $%d", len(args)+1)), append(args, payload)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	args = append(args, patch.UID)
+	query := fmt.Sprintf(`UPDATE sandbox_database SET %s WHERE id = $%d`, strings.Join(set, ", "), len(args))
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to update sandbox database %d", patch.UID)
+	}
+	return nil
+}
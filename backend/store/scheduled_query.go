@@ -0,0 +1,351 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// ScheduledQueryResultStatus is the status of a scheduled query result.
+type ScheduledQueryResultStatus string
+
+const (
+	// ScheduledQueryResultStatusDone is the status of the result of a successful run.
+	ScheduledQueryResultStatusDone ScheduledQueryResultStatus = "DONE"
+	// ScheduledQueryResultStatusFailed is the status of the result of a failed run.
+	ScheduledQueryResultStatusFailed ScheduledQueryResultStatus = "FAILED"
+)
+
+// ScheduledQueryMessage is the message for a scheduled query.
+type ScheduledQueryMessage struct {
+	DatabaseUID int
+	Title       string
+	Statement   string
+	Schedule    string
+	NextRunTs   int64
+	Payload     *storepb.ScheduledQueryPayload
+
+	// Output only.
+	UID        int
+	CreatorUID int
+	CreatedTs  int64
+	UpdatedTs  int64
+}
+
+// FindScheduledQueryMessage is the message for finding scheduled queries.
+type FindScheduledQueryMessage struct {
+	UID        *int
+	CreatorUID *int
+	// NextRunTsBefore finds the scheduled queries due before the timestamp.
+	NextRunTsBefore *int64
+	Limit           *int
+	Offset          *int
+}
+
+// UpdateScheduledQueryMessage is the message for updating a scheduled query.
+type UpdateScheduledQueryMessage struct {
+	UID int
+
+	DatabaseUID *int
+	Title       *string
+	Statement   *string
+	Schedule    *string
+	NextRunTs   *int64
+	Payload     *storepb.ScheduledQueryPayload
+}
+
+// ScheduledQueryResultMessage is the message for a result snapshot of a scheduled query.
+type ScheduledQueryResultMessage struct {
+	ScheduledQueryUID int
+	Status            ScheduledQueryResultStatus
+	RowCount          int64
+	Content           string
+	Error             string
+	ExpireTs          int64
+
+	// Output only.
+	UID       int64
+	CreatedTs int64
+}
+
+// FindScheduledQueryResultMessage is the message for finding scheduled query results.
+type FindScheduledQueryResultMessage struct {
+	ScheduledQueryUID *int
+	Limit             *int
+	Offset            *int
+}
+
+// CreateScheduledQuery creates a scheduled query.
+func (s *Store) CreateScheduledQuery(ctx context.Context, create *ScheduledQueryMessage, creatorUID int) (*ScheduledQueryMessage, error) {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal payload")
+	}
+	query := `
+		INSERT INTO scheduled_query (
+			creator_id,
+			database_id,
+			title,
+			statement,
+			schedule,
+			next_run_ts,
+			payload
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_ts, updated_ts
+	`
+	scheduledQuery := &ScheduledQueryMessage{
+		DatabaseUID: create.DatabaseUID,
+		Title:       create.Title,
+		Statement:   create.Statement,
+		Schedule:    create.Schedule,
+		NextRunTs:   create.NextRunTs,
+		Payload:     create.Payload,
+		CreatorUID:  creatorUID,
+	}
+	if err := s.db.QueryRowContext(ctx, query,
+		creatorUID,
+		create.DatabaseUID,
+		create.Title,
+		create.Statement,
+		create.Schedule,
+		create.NextRunTs,
+		payload,
+	).Scan(
+		&scheduledQuery.UID,
+		&scheduledQuery.CreatedTs,
+		&scheduledQuery.UpdatedTs,
+	); err != nil {
+		return nil, errors.Wrapf(err, "failed to create scheduled query")
+	}
+	return scheduledQuery, nil
+}
+
+// GetScheduledQuery gets a scheduled query.
+func (s *Store) GetScheduledQuery(ctx context.Context, uid int) (*ScheduledQueryMessage, error) {
+	scheduledQueries, err := s.ListScheduledQueries(ctx, &FindScheduledQueryMessage{UID: &uid})
+	if err != nil {
+		return nil, err
+	}
+	if len(scheduledQueries) == 0 {
+		return nil, nil
+	}
+	return scheduledQueries[0], nil
+}
+
+// ListScheduledQueries lists scheduled queries in the reverse creation order.
+func (s *Store) ListScheduledQueries(ctx context.Context, find *FindScheduledQueryMessage) ([]*ScheduledQueryMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatorUID; v != nil {
+		where, args = append(where, fmt.Sprintf("creator_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.NextRunTsBefore; v != nil {
+		where, args = append(where, fmt.Sprintf("next_run_ts <= $%d", len(args)+1)), append(args, *v)
+	}
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			creator_id,
+			created_ts,
+			updated_ts,
+			database_id,
+			title,
+			statement,
+			schedule,
+			next_run_ts,
+			payload
+		FROM scheduled_query
+		WHERE %s
+		ORDER BY id DESC`, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, *v)
+	}
+	if v := find.Offset; v != nil {
+		query += fmt.Sprintf(" OFFSET $%d", len(args)+1)
+		args = append(args, *v)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list scheduled queries")
+	}
+	defer rows.Close()
+
+	var scheduledQueries []*ScheduledQueryMessage
+	for rows.Next() {
+		scheduledQuery := &ScheduledQueryMessage{
+			Payload: &storepb.ScheduledQueryPayload{},
+		}
+		var payload []byte
+		if err := rows.Scan(
+			&scheduledQuery.UID,
+			&scheduledQuery.CreatorUID,
+			&scheduledQuery.CreatedTs,
+			&scheduledQuery.UpdatedTs,
+			&scheduledQuery.DatabaseUID,
+			&scheduledQuery.Title,
+			&scheduledQuery.Statement,
+			&scheduledQuery.Schedule,
+			&scheduledQuery.NextRunTs,
+			&payload,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan scheduled query")
+		}
+		if err := protojson.Unmarshal(payload, scheduledQuery.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal payload of scheduled query %d", scheduledQuery.UID)
+		}
+		scheduledQueries = append(scheduledQueries, scheduledQuery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan scheduled queries")
+	}
+	return scheduledQueries, nil
+}
+
+// UpdateScheduledQuery updates a scheduled query.
+func (s *Store) UpdateScheduledQuery(ctx context.Context, patch *UpdateScheduledQueryMessage) (*ScheduledQueryMessage, error) {
+	set, args := []string{}, []any{}
+	if v := patch.DatabaseUID; v != nil {
+		set, args = append(set, fmt.Sprintf("database_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := patch.Title; v != nil {
+		set, args = append(set, fmt.Sprintf("title = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := patch.Statement; v != nil {
+		set, args = append(set, fmt.Sprintf("statement = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := patch.Schedule; v != nil {
+		set, args = append(set, fmt.Sprintf("schedule = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := patch.NextRunTs; v != nil {
+		set, args = append(set, fmt.Sprintf("next_run_ts = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := patch.Payload; v != nil {
+		payload, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal payload")
+		}
+		set, args = append(set, fmt.Sprintf("payload = $%d", len(args)+1)), append(args, payload)
+	}
+	if len(set) > 0 {
+		args = append(args, patch.UID)
+		query := fmt.Sprintf(`UPDATE scheduled_query SET %s WHERE id = $%d`, strings.Join(set, ", "), len(args))
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return nil, errors.Wrapf(err, "failed to update scheduled query %d", patch.UID)
+		}
+	}
+	return s.GetScheduledQuery(ctx, patch.UID)
+}
+
+// DeleteScheduledQuery deletes a scheduled query and its results.
+func (s *Store) DeleteScheduledQuery(ctx context.Context, uid int) error {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM scheduled_query WHERE id = $1`, uid); err != nil {
+		return errors.Wrapf(err, "failed to delete scheduled query %d", uid)
+	}
+	return nil
+}
+
+// CreateScheduledQueryResult creates a result snapshot of a scheduled query.
+func (s *Store) CreateScheduledQueryResult(ctx context.Context, create *ScheduledQueryResultMessage) (*ScheduledQueryResultMessage, error) {
+	query := `
+		INSERT INTO scheduled_query_result (
+			scheduled_query_id,
+			status,
+			row_count,
+			content,
+			error,
+			expire_ts
+		) VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_ts
+	`
+	result := *create
+	if err := s.db.QueryRowContext(ctx, query,
+		create.ScheduledQueryUID,
+		create.Status,
+		create.RowCount,
+		create.Content,
+		create.Error,
+		create.ExpireTs,
+	).Scan(&result.UID, &result.CreatedTs); err != nil {
+		return nil, errors.Wrapf(err, "failed to create scheduled query result")
+	}
+	return &result, nil
+}
+
+// ListScheduledQueryResults lists scheduled query results in the reverse creation order.
+func (s *Store) ListScheduledQueryResults(ctx context.Context, find *FindScheduledQueryResultMessage) ([]*ScheduledQueryResultMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.ScheduledQueryUID; v != nil {
+		where, args = append(where, fmt.Sprintf("scheduled_query_id = $%d", len(args)+1)), append(args, *v)
+	}
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			scheduled_query_id,
+			created_ts,
+			status,
+			row_count,
+			content,
+			error,
+			expire_ts
+		FROM scheduled_query_result
+		WHERE %s
+		ORDER BY id DESC`, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, *v)
+	}
+	if v := find.Offset; v != nil {
+		query += fmt.Sprintf(" OFFSET $%d", len(args)+1)
+		args = append(args, *v)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list scheduled query results")
+	}
+	defer rows.Close()
+
+	var results []*ScheduledQueryResultMessage
+	for rows.Next() {
+		var result ScheduledQueryResultMessage
+		if err := rows.Scan(
+			&result.UID,
+			&result.ScheduledQueryUID,
+			&result.CreatedTs,
+			&result.Status,
+			&result.RowCount,
+			&result.Content,
+			&result.Error,
+			&result.ExpireTs,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan scheduled query result")
+		}
+		results = append(results, &result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan scheduled query results")
+	}
+	return results, nil
+}
+
+// DeleteExpiredScheduledQueryResults deletes the scheduled query results expired before the timestamp.
+func (s *Store) DeleteExpiredScheduledQueryResults(ctx context.Context, ts int64) (int64, error) {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM scheduled_query_result WHERE expire_ts < $1`, ts)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to delete expired scheduled query results")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get the count of deleted scheduled query results")
+	}
+	return count, nil
+}
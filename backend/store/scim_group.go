@@ -0,0 +1,181 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const scimGroupColumns = "id, resource_id, display_name, payload"
+
+// SCIMGroupMessage is the message of a group provisioned through SCIM.
+type SCIMGroupMessage struct {
+	// ResourceID is the SCIM id of the group.
+	ResourceID  string
+	DisplayName string
+	Payload     *storepb.SCIMGroupPayload
+
+	// Output only.
+	UID int
+}
+
+// FindSCIMGroupMessage is the message for finding SCIM groups.
+type FindSCIMGroupMessage struct {
+	ResourceID  *string
+	DisplayName *string
+	// MemberID finds the groups with the member.
+	MemberID *int
+}
+
+// UpdateSCIMGroupMessage is the message for updating a SCIM group.
+type UpdateSCIMGroupMessage struct {
+	ResourceID string
+
+	DisplayName *string
+	Payload     *storepb.SCIMGroupPayload
+}
+
+// CreateSCIMGroup creates a SCIM group.
+func (s *Store) CreateSCIMGroup(ctx context.Context, create *SCIMGroupMessage) (*SCIMGroupMessage, error) {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal SCIM group payload")
+	}
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		INSERT INTO scim_group (resource_id, display_name, payload)
+		VALUES ($1, $2, $3)
+		RETURNING %s`, scimGroupColumns),
+		create.ResourceID, create.DisplayName, payload,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create SCIM group")
+	}
+	defer rows.Close()
+	groups, err := scanSCIMGroups(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) != 1 {
+		return nil, errors.Errorf("expect to create one SCIM group, got %d", len(groups))
+	}
+	return groups[0], nil
+}
+
+// GetSCIMGroup gets a SCIM group.
+func (s *Store) GetSCIMGroup(ctx context.Context, find *FindSCIMGroupMessage) (*SCIMGroupMessage, error) {
+	groups, err := s.ListSCIMGroups(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+	if len(groups) > 1 {
+		return nil, errors.Errorf("found %d SCIM groups with filter %+v, expect 1", len(groups), find)
+	}
+	return groups[0], nil
+}
+
+// ListSCIMGroups lists the SCIM groups in the creation order.
+func (s *Store) ListSCIMGroups(ctx context.Context, find *FindSCIMGroupMessage) ([]*SCIMGroupMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.ResourceID; v != nil {
+		where, args = append(where, fmt.Sprintf("resource_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.DisplayName; v != nil {
+		where, args = append(where, fmt.Sprintf("display_name = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.MemberID; v != nil {
+		where, args = append(where, fmt.Sprintf("payload->'memberIds' @> $%d::JSONB", len(args)+1)), append(args, fmt.Sprintf("[%d]", *v))
+	}
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM scim_group
+		WHERE %s
+		ORDER BY id`, scimGroupColumns, strings.Join(where, " AND ")),
+		args...,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list SCIM groups")
+	}
+	defer rows.Close()
+	return scanSCIMGroups(rows)
+}
+
+// UpdateSCIMGroup updates a SCIM group.
+func (s *Store) UpdateSCIMGroup(ctx context.Context, patch *UpdateSCIMGroupMessage) (*SCIMGroupMessage, error) {
+	set, args := []string{}, []any{}
+	if v := patch.DisplayName; v != nil {
+		set, args = append(set, fmt.Sprintf("display_name = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := patch.Payload; v != nil {
+		payload, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal SCIM group payload")
+		}
+		set, args = append(set, fmt.Sprintf("payload = $%d", len(args)+1)), append(args, payload)
+	}
+	if len(set) == 0 {
+		return s.GetSCIMGroup(ctx, &FindSCIMGroupMessage{ResourceID: &patch.ResourceID})
+	}
+	args = append(args, patch.ResourceID)
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		UPDATE scim_group
+		SET %s
+		WHERE resource_id = $%d
+		RETURNING %s`, strings.Join(set, ", "), len(args), scimGroupColumns),
+		args...,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update SCIM group")
+	}
+	defer rows.Close()
+	groups, err := scanSCIMGroups(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) != 1 {
+		return nil, errors.Errorf("SCIM group %q not found", patch.ResourceID)
+	}
+	return groups[0], nil
+}
+
+// DeleteSCIMGroup deletes a SCIM group.
+func (s *Store) DeleteSCIMGroup(ctx context.Context, resourceID string) error {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM scim_group WHERE resource_id = $1`, resourceID); err != nil {
+		return errors.Wrapf(err, "failed to delete SCIM group %q", resourceID)
+	}
+	return nil
+}
+
+func scanSCIMGroups(rows *sql.Rows) ([]*SCIMGroupMessage, error) {
+	var groups []*SCIMGroupMessage
+	for rows.Next() {
+		group := &SCIMGroupMessage{
+			Payload: &storepb.SCIMGroupPayload{},
+		}
+		var payload []byte
+		if err := rows.Scan(
+			&group.UID,
+			&group.ResourceID,
+			&group.DisplayName,
+			&payload,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan SCIM group")
+		}
+		if err := protojsonUnmarshaler.Unmarshal(payload, group.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal SCIM group payload")
+		}
+		groups = append(groups, group)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan SCIM groups")
+	}
+	return groups, nil
+}
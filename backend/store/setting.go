@@ -72,6 +72,21 @@ func (s *Store) GetWorkspaceID(ctx context.Context) (string, error) {
 	return setting.Value, nil
 }
 
+// GetMaskingSalt finds the per-workspace salt of the deterministic masking algorithms.
+func (s *Store) GetMaskingSalt(ctx context.Context) (string, error) {
+	settingName := api.SettingMaskingSalt
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return "", errors.Errorf("cannot find setting %v", settingName)
+	}
+	return setting.Value, nil
+}
+
 // GetWorkspaceApprovalSetting gets the workspace approval setting.
 func (s *Store) GetWorkspaceApprovalSetting(ctx context.Context) (*storepb.WorkspaceApprovalSetting, error) {
 	settingName := api.SettingWorkspaceApproval
@@ -192,6 +207,286 @@ func (s *Store) GetDataClassificationSetting(ctx context.Context) (*storepb.Data
 	return payload, nil
 }
 
+// GetRateLimitSetting gets the rate limit setting.
+func (s *Store) GetRateLimitSetting(ctx context.Context) (*storepb.RateLimitSetting, error) {
+	settingName := api.SettingRateLimit
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.RateLimitSetting{}, nil
+	}
+
+	payload := new(storepb.RateLimitSetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetQueryAuditSetting gets the query audit setting.
+func (s *Store) GetQueryAuditSetting(ctx context.Context) (*storepb.QueryAuditSetting, error) {
+	settingName := api.SettingQueryAudit
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.QueryAuditSetting{}, nil
+	}
+
+	payload := new(storepb.QueryAuditSetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetQueryCursorSetting gets the query cursor setting.
+func (s *Store) GetQueryCursorSetting(ctx context.Context) (*storepb.QueryCursorSetting, error) {
+	settingName := api.SettingQueryCursor
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.QueryCursorSetting{}, nil
+	}
+
+	payload := new(storepb.QueryCursorSetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetQueryHistorySetting gets the query history setting.
+func (s *Store) GetQueryHistorySetting(ctx context.Context) (*storepb.QueryHistorySetting, error) {
+	settingName := api.SettingQueryHistory
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.QueryHistorySetting{}, nil
+	}
+
+	payload := new(storepb.QueryHistorySetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetArchiveSetting gets the archive setting.
+func (s *Store) GetArchiveSetting(ctx context.Context) (*storepb.ArchiveSetting, error) {
+	settingName := api.SettingArchive
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.ArchiveSetting{}, nil
+	}
+
+	payload := new(storepb.ArchiveSetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetJiraSetting gets the Jira setting.
+func (s *Store) GetJiraSetting(ctx context.Context) (*storepb.JiraSetting, error) {
+	settingName := api.SettingJira
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.JiraSetting{}, nil
+	}
+
+	payload := new(storepb.JiraSetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetServiceNowSetting gets the ServiceNow setting.
+func (s *Store) GetServiceNowSetting(ctx context.Context) (*storepb.ServiceNowSetting, error) {
+	settingName := api.SettingServiceNow
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.ServiceNowSetting{}, nil
+	}
+
+	payload := new(storepb.ServiceNowSetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetSlackAppSetting gets the Slack app setting.
+func (s *Store) GetSlackAppSetting(ctx context.Context) (*storepb.SlackAppSetting, error) {
+	settingName := api.SettingSlackApp
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.SlackAppSetting{}, nil
+	}
+
+	payload := new(storepb.SlackAppSetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetEventBusSetting gets the event bus setting.
+func (s *Store) GetEventBusSetting(ctx context.Context) (*storepb.EventBusSetting, error) {
+	settingName := api.SettingEventBus
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.EventBusSetting{}, nil
+	}
+
+	payload := new(storepb.EventBusSetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetSCIMSetting gets the SCIM provisioning setting.
+func (s *Store) GetSCIMSetting(ctx context.Context) (*storepb.SCIMSetting, error) {
+	settingName := api.SettingSCIM
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.SCIMSetting{}, nil
+	}
+
+	payload := new(storepb.SCIMSetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetAuditLogSetting gets the audit log retention and export setting.
+func (s *Store) GetAuditLogSetting(ctx context.Context) (*storepb.AuditLogSetting, error) {
+	settingName := api.SettingAuditLog
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.AuditLogSetting{}, nil
+	}
+
+	payload := new(storepb.AuditLogSetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetMaintenanceSetting gets the maintenance mode setting.
+func (s *Store) GetMaintenanceSetting(ctx context.Context) (*storepb.MaintenanceSetting, error) {
+	settingName := api.SettingMaintenance
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.MaintenanceSetting{}, nil
+	}
+
+	payload := new(storepb.MaintenanceSetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetInstanceDiscoverySetting gets the instance discovery setting.
+func (s *Store) GetInstanceDiscoverySetting(ctx context.Context) (*storepb.InstanceDiscoverySetting, error) {
+	settingName := api.SettingInstanceDiscovery
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.InstanceDiscoverySetting{}, nil
+	}
+
+	payload := new(storepb.InstanceDiscoverySetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetDiscoveredInstances gets the discovered instances which are not registered yet.
+func (s *Store) GetDiscoveredInstances(ctx context.Context) (*storepb.DiscoveredInstances, error) {
+	settingName := api.SettingDiscoveredInstances
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.DiscoveredInstances{}, nil
+	}
+
+	payload := new(storepb.DiscoveredInstances)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
 // DeleteCache deletes the cache.
 func (s *Store) DeleteCache() {
 	s.settingCache.Purge()
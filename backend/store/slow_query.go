@@ -29,8 +29,9 @@ type ListSlowQueryMessage struct {
 }
 
 // ListSlowQuery lists slow query logs.
+// It reads from the replica if configured.
 func (s *Store) ListSlowQuery(ctx context.Context, list *ListSlowQueryMessage) ([]*v1pb.SlowQueryLog, error) {
-	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	tx, err := s.db.BeginReplicaTx(ctx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to begin transaction")
 	}
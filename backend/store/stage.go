@@ -5,6 +5,11 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
 // StageMessage is the message for stage.
@@ -13,6 +18,7 @@ type StageMessage struct {
 	EnvironmentID int
 	PipelineID    int
 	TaskList      []*TaskMessage
+	Payload       *storepb.StagePayload
 
 	// Output only.
 	ID     int
@@ -41,15 +47,24 @@ func (s *Store) CreateStageV2(ctx context.Context, stagesCreate []*StageMessage,
 	var valueStr []string
 	var values []any
 	for i, create := range stagesCreate {
+		payload := []byte("{}")
+		if create.Payload != nil {
+			p, err := protojson.Marshal(create.Payload)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to marshal stage payload")
+			}
+			payload = p
+		}
 		values = append(values,
 			creatorID,
 			creatorID,
 			create.PipelineID,
 			create.EnvironmentID,
 			create.Name,
+			payload,
 		)
-		const count = 5
-		valueStr = append(valueStr, fmt.Sprintf("($%d,$%d,$%d,$%d,$%d)", i*count+1, i*count+2, i*count+3, i*count+4, i*count+5))
+		const count = 6
+		valueStr = append(valueStr, getValuesPlaceholder(i, count))
 	}
 
 	query := fmt.Sprintf(`
@@ -59,9 +74,10 @@ func (s *Store) CreateStageV2(ctx context.Context, stagesCreate []*StageMessage,
 	  		updater_id,
 	  		pipeline_id,
 	  		environment_id,
-	  		name
+	  		name,
+	  		payload
 	  	) VALUES %s
-	  	RETURNING id, pipeline_id, environment_id, name
+	  	RETURNING id, pipeline_id, environment_id, name, payload
     ) SELECT * FROM inserted ORDER BY id ASC
     `, strings.Join(valueStr, ","))
 	rows, err := tx.QueryContext(ctx, query, values...)
@@ -73,14 +89,20 @@ func (s *Store) CreateStageV2(ctx context.Context, stagesCreate []*StageMessage,
 	var stages []*StageMessage
 	for rows.Next() {
 		var stage StageMessage
+		var payload []byte
 		if err := rows.Scan(
 			&stage.ID,
 			&stage.PipelineID,
 			&stage.EnvironmentID,
 			&stage.Name,
+			&payload,
 		); err != nil {
 			return nil, err
 		}
+		stage.Payload = &storepb.StagePayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payload, stage.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal stage payload")
+		}
 		stages = append(stages, &stage)
 	}
 	if err := rows.Err(); err != nil {
@@ -111,6 +133,7 @@ func (s *Store) ListStageV2(ctx context.Context, pipelineUID int) ([]*StageMessa
 			stage.pipeline_id,
 			stage.environment_id,
 			stage.name,
+			stage.payload,
 			(
 				SELECT EXISTS (
 					SELECT 1 FROM task
@@ -145,15 +168,21 @@ func (s *Store) ListStageV2(ctx context.Context, pipelineUID int) ([]*StageMessa
 	var stages []*StageMessage
 	for rows.Next() {
 		var stage StageMessage
+		var payload []byte
 		if err := rows.Scan(
 			&stage.ID,
 			&stage.PipelineID,
 			&stage.EnvironmentID,
 			&stage.Name,
+			&payload,
 			&stage.Active,
 		); err != nil {
 			return nil, err
 		}
+		stage.Payload = &storepb.StagePayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payload, stage.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal stage payload")
+		}
 
 		stages = append(stages, &stage)
 	}
@@ -165,3 +194,87 @@ func (s *Store) ListStageV2(ctx context.Context, pipelineUID int) ([]*StageMessa
 	}
 	return stages, nil
 }
+
+// UpdateStagePayload updates the payload of the stage.
+func (s *Store) UpdateStagePayload(ctx context.Context, stageID int, payload *storepb.StagePayload) error {
+	p, err := protojson.Marshal(payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal stage payload")
+	}
+	if _, err := s.db.ExecContext(ctx, `UPDATE stage SET payload = $1, updated_ts = extract(epoch from now()) WHERE id = $2`, p, stageID); err != nil {
+		return errors.Wrapf(err, "failed to update stage %d", stageID)
+	}
+	return nil
+}
+
+// ListBakingCanaryStages lists the canary stages which are neither promoted nor halted, in the open issues.
+func (s *Store) ListBakingCanaryStages(ctx context.Context) ([]*StageMessage, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT
+			stage.id,
+			stage.pipeline_id,
+			stage.environment_id,
+			stage.name,
+			stage.payload
+		FROM stage
+		LEFT JOIN issue ON issue.pipeline_id = stage.pipeline_id
+		WHERE stage.payload->'canary'->>'status' = $1
+		AND COALESCE(issue.status, 'OPEN') = 'OPEN'
+		ORDER BY stage.id`,
+		storepb.StageCanary_BAKING.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanStagePayloads(rows)
+}
+
+// ListActiveChangeRequestStages lists the stages whose change requests are pending or being implemented.
+// The stages of the closed issues are listed as well, so that their change requests are closed or canceled.
+func (s *Store) ListActiveChangeRequestStages(ctx context.Context) ([]*StageMessage, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT
+			stage.id,
+			stage.pipeline_id,
+			stage.environment_id,
+			stage.name,
+			stage.payload
+		FROM stage
+		WHERE stage.payload->'changeRequest'->>'status' IN ($1, $2)
+		ORDER BY stage.id`,
+		storepb.StageChangeRequest_PENDING.String(),
+		storepb.StageChangeRequest_IMPLEMENT.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanStagePayloads(rows)
+}
+
+func scanStagePayloads(rows *sql.Rows) ([]*StageMessage, error) {
+	var stages []*StageMessage
+	for rows.Next() {
+		var stage StageMessage
+		var payload []byte
+		if err := rows.Scan(
+			&stage.ID,
+			&stage.PipelineID,
+			&stage.EnvironmentID,
+			&stage.Name,
+			&payload,
+		); err != nil {
+			return nil, err
+		}
+		stage.Payload = &storepb.StagePayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payload, stage.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal stage payload")
+		}
+		stages = append(stages, &stage)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return stages, nil
+}
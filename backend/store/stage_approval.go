@@ -0,0 +1,135 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// StageApprovalMessage is the message for the sign-offs of the stage approval gates.
+type StageApprovalMessage struct {
+	StageID int
+	// TaskID is the task signed off, nil if the whole stage is signed off.
+	TaskID *int
+	// Role is the approval gate role signed off as, e.g. roles/workspaceDBA.
+	Role      string
+	Comment   string
+	CreatorID int
+
+	// Output only.
+	UID       int
+	CreatedTs int64
+}
+
+// FindStageApprovalMessage is the message for finding stage approvals.
+type FindStageApprovalMessage struct {
+	StageID    *int
+	PipelineID *int
+}
+
+// CreateStageApprovals creates the stage approvals in a transaction with multi-row INSERTs.
+func (s *Store) CreateStageApprovals(ctx context.Context, creates ...*StageApprovalMessage) error {
+	if len(creates) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for i := 0; i < len(creates); i += batchInsertSize {
+		batch := creates[i:min(i+batchInsertSize, len(creates))]
+		var values []string
+		var args []any
+		for j, create := range batch {
+			values = append(values, getValuesPlaceholder(j, 5))
+			args = append(args, create.CreatorID, create.StageID, create.TaskID, create.Role, create.Comment)
+		}
+		query := `
+			INSERT INTO stage_approval (
+				creator_id,
+				stage_id,
+				task_id,
+				role,
+				comment
+			)
+			VALUES ` + strings.Join(values, ", ")
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListStageApprovals lists stage approvals in the creation order.
+func (s *Store) ListStageApprovals(ctx context.Context, find *FindStageApprovalMessage) ([]*StageApprovalMessage, error) {
+	joinClause := ""
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.StageID; v != nil {
+		where, args = append(where, fmt.Sprintf("stage_approval.stage_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.PipelineID; v != nil {
+		joinClause = "JOIN stage ON stage.id = stage_approval.stage_id"
+		where, args = append(where, fmt.Sprintf("stage.pipeline_id = $%d", len(args)+1)), append(args, *v)
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+		SELECT
+			stage_approval.id,
+			stage_approval.creator_id,
+			stage_approval.created_ts,
+			stage_approval.stage_id,
+			stage_approval.task_id,
+			stage_approval.role,
+			stage_approval.comment
+		FROM stage_approval
+		%s
+		WHERE %s
+		ORDER BY stage_approval.id`, joinClause, strings.Join(where, " AND ")),
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var approvals []*StageApprovalMessage
+	for rows.Next() {
+		var approval StageApprovalMessage
+		var taskID sql.NullInt32
+		if err := rows.Scan(
+			&approval.UID,
+			&approval.CreatorID,
+			&approval.CreatedTs,
+			&approval.StageID,
+			&taskID,
+			&approval.Role,
+			&approval.Comment,
+		); err != nil {
+			return nil, err
+		}
+		if taskID.Valid {
+			v := int(taskID.Int32)
+			approval.TaskID = &v
+		}
+		approvals = append(approvals, &approval)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return approvals, nil
+}
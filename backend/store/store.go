@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/hashicorp/golang-lru/v2/expirable"
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/bytebase/bytebase/backend/component/config"
@@ -25,135 +25,40 @@ type Store struct {
 	db      *DB
 	profile *config.Profile
 
-	userIDCache            *lru.Cache[int, *UserMessage]
-	environmentCache       *lru.Cache[string, *EnvironmentMessage]
-	environmentIDCache     *lru.Cache[int, *EnvironmentMessage]
-	instanceCache          *lru.Cache[string, *InstanceMessage]
-	instanceIDCache        *lru.Cache[int, *InstanceMessage]
-	databaseCache          *lru.Cache[string, *DatabaseMessage]
-	databaseIDCache        *lru.Cache[int, *DatabaseMessage]
-	projectCache           *lru.Cache[string, *ProjectMessage]
-	projectIDCache         *lru.Cache[int, *ProjectMessage]
-	projectPolicyCache     *lru.Cache[string, *IAMPolicyMessage]
-	projectIDPolicyCache   *lru.Cache[int, *IAMPolicyMessage]
-	projectDeploymentCache *lru.Cache[int, *DeploymentConfigMessage]
-	policyCache            *lru.Cache[string, *PolicyMessage]
-	issueCache             *lru.Cache[int, *IssueMessage]
-	issueByPipelineCache   *lru.Cache[int, *IssueMessage]
-	pipelineCache          *lru.Cache[int, *PipelineMessage]
-	settingCache           *lru.Cache[api.SettingName, *SettingMessage]
-	idpCache               *lru.Cache[string, *IdentityProviderMessage]
-	risksCache             *lru.Cache[int, []*RiskMessage] // Use 0 as the key.
-	databaseGroupCache     *lru.Cache[string, *DatabaseGroupMessage]
-	databaseGroupIDCache   *lru.Cache[int64, *DatabaseGroupMessage]
-	schemaGroupCache       *lru.Cache[string, *SchemaGroupMessage]
-	vcsIDCache             *lru.Cache[int, *ExternalVersionControlMessage]
+	userIDCache            *expirable.LRU[int, *UserMessage]
+	environmentCache       *expirable.LRU[string, *EnvironmentMessage]
+	environmentIDCache     *expirable.LRU[int, *EnvironmentMessage]
+	instanceCache          *expirable.LRU[string, *InstanceMessage]
+	instanceIDCache        *expirable.LRU[int, *InstanceMessage]
+	databaseCache          *expirable.LRU[string, *DatabaseMessage]
+	databaseIDCache        *expirable.LRU[int, *DatabaseMessage]
+	projectCache           *expirable.LRU[string, *ProjectMessage]
+	projectIDCache         *expirable.LRU[int, *ProjectMessage]
+	projectPolicyCache     *expirable.LRU[string, *IAMPolicyMessage]
+	projectIDPolicyCache   *expirable.LRU[int, *IAMPolicyMessage]
+	projectDeploymentCache *expirable.LRU[int, *DeploymentConfigMessage]
+	policyCache            *expirable.LRU[string, *PolicyMessage]
+	issueCache             *expirable.LRU[int, *IssueMessage]
+	issueByPipelineCache   *expirable.LRU[int, *IssueMessage]
+	pipelineCache          *expirable.LRU[int, *PipelineMessage]
+	settingCache           *expirable.LRU[api.SettingName, *SettingMessage]
+	idpCache               *expirable.LRU[string, *IdentityProviderMessage]
+	risksCache             *expirable.LRU[int, []*RiskMessage] // Use 0 as the key.
+	databaseGroupCache     *expirable.LRU[string, *DatabaseGroupMessage]
+	databaseGroupIDCache   *expirable.LRU[int64, *DatabaseGroupMessage]
+	schemaGroupCache       *expirable.LRU[string, *SchemaGroupMessage]
+	vcsIDCache             *expirable.LRU[int, *ExternalVersionControlMessage]
+	roleCache              *expirable.LRU[string, *RoleMessage]
+	userSessionCache       *expirable.LRU[int, *UserSessionMessage]
 
 	// Large objects.
-	sheetCache    *lru.Cache[int, string]
-	dbSchemaCache *lru.Cache[int, *model.DBSchema]
+	sheetCache    *expirable.LRU[int, string]
+	dbSchemaCache *expirable.LRU[int, *model.DBSchema]
 }
 
 // New creates a new instance of Store.
 func New(db *DB, profile *config.Profile) (*Store, error) {
-	userIDCache, err := lru.New[int, *UserMessage](2048)
-	if err != nil {
-		return nil, err
-	}
-	environmentCache, err := lru.New[string, *EnvironmentMessage](32)
-	if err != nil {
-		return nil, err
-	}
-	environmentIDCache, err := lru.New[int, *EnvironmentMessage](32)
-	if err != nil {
-		return nil, err
-	}
-	instanceCache, err := lru.New[string, *InstanceMessage](2048)
-	if err != nil {
-		return nil, err
-	}
-	instanceIDCache, err := lru.New[int, *InstanceMessage](2048)
-	if err != nil {
-		return nil, err
-	}
-	databaseCache, err := lru.New[string, *DatabaseMessage](32768)
-	if err != nil {
-		return nil, err
-	}
-	databaseIDCache, err := lru.New[int, *DatabaseMessage](32768)
-	if err != nil {
-		return nil, err
-	}
-	projectCache, err := lru.New[string, *ProjectMessage](128)
-	if err != nil {
-		return nil, err
-	}
-	projectIDCache, err := lru.New[int, *ProjectMessage](128)
-	if err != nil {
-		return nil, err
-	}
-	projectPolicyCache, err := lru.New[string, *IAMPolicyMessage](128)
-	if err != nil {
-		return nil, err
-	}
-	projectIDPolicyCache, err := lru.New[int, *IAMPolicyMessage](128)
-	if err != nil {
-		return nil, err
-	}
-	projectDeploymentCache, err := lru.New[int, *DeploymentConfigMessage](128)
-	if err != nil {
-		return nil, err
-	}
-	policyCache, err := lru.New[string, *PolicyMessage](128)
-	if err != nil {
-		return nil, err
-	}
-	issueCache, err := lru.New[int, *IssueMessage](256)
-	if err != nil {
-		return nil, err
-	}
-	issueByPipelineCache, err := lru.New[int, *IssueMessage](256)
-	if err != nil {
-		return nil, err
-	}
-	pipelineCache, err := lru.New[int, *PipelineMessage](256)
-	if err != nil {
-		return nil, err
-	}
-	settingCache, err := lru.New[api.SettingName, *SettingMessage](64)
-	if err != nil {
-		return nil, err
-	}
-	idpCache, err := lru.New[string, *IdentityProviderMessage](4)
-	if err != nil {
-		return nil, err
-	}
-	risksCache, err := lru.New[int, []*RiskMessage](1)
-	if err != nil {
-		return nil, err
-	}
-	databaseGroupCache, err := lru.New[string, *DatabaseGroupMessage](10)
-	if err != nil {
-		return nil, err
-	}
-	databaseGroupIDCache, err := lru.New[int64, *DatabaseGroupMessage](10)
-	if err != nil {
-		return nil, err
-	}
-	schemaGroupCache, err := lru.New[string, *SchemaGroupMessage](10)
-	if err != nil {
-		return nil, err
-	}
-	vcsIDCache, err := lru.New[int, *ExternalVersionControlMessage](10)
-	if err != nil {
-		return nil, err
-	}
-	sheetCache, err := lru.New[int, string](10)
-	if err != nil {
-		return nil, err
-	}
-	dbSchemaCache, err := lru.New[int, *model.DBSchema](100)
-	if err != nil {
+	if err := ValidateCacheConfig(profile); err != nil {
 		return nil, err
 	}
 
@@ -162,31 +67,33 @@ func New(db *DB, profile *config.Profile) (*Store, error) {
 		profile: profile,
 
 		// Cache.
-		userIDCache:            userIDCache,
-		environmentCache:       environmentCache,
-		environmentIDCache:     environmentIDCache,
-		instanceCache:          instanceCache,
-		instanceIDCache:        instanceIDCache,
-		databaseCache:          databaseCache,
-		databaseIDCache:        databaseIDCache,
-		projectCache:           projectCache,
-		projectIDCache:         projectIDCache,
-		projectPolicyCache:     projectPolicyCache,
-		projectIDPolicyCache:   projectIDPolicyCache,
-		projectDeploymentCache: projectDeploymentCache,
-		policyCache:            policyCache,
-		issueCache:             issueCache,
-		issueByPipelineCache:   issueByPipelineCache,
-		pipelineCache:          pipelineCache,
-		settingCache:           settingCache,
-		idpCache:               idpCache,
-		risksCache:             risksCache,
-		databaseGroupCache:     databaseGroupCache,
-		databaseGroupIDCache:   databaseGroupIDCache,
-		schemaGroupCache:       schemaGroupCache,
-		vcsIDCache:             vcsIDCache,
-		sheetCache:             sheetCache,
-		dbSchemaCache:          dbSchemaCache,
+		userIDCache:            newCache[int, *UserMessage](profile, "user"),
+		environmentCache:       newCache[string, *EnvironmentMessage](profile, "environment"),
+		environmentIDCache:     newCache[int, *EnvironmentMessage](profile, "environment"),
+		instanceCache:          newCache[string, *InstanceMessage](profile, "instance"),
+		instanceIDCache:        newCache[int, *InstanceMessage](profile, "instance"),
+		databaseCache:          newCache[string, *DatabaseMessage](profile, "database"),
+		databaseIDCache:        newCache[int, *DatabaseMessage](profile, "database"),
+		projectCache:           newCache[string, *ProjectMessage](profile, "project"),
+		projectIDCache:         newCache[int, *ProjectMessage](profile, "project"),
+		projectPolicyCache:     newCache[string, *IAMPolicyMessage](profile, "project_policy"),
+		projectIDPolicyCache:   newCache[int, *IAMPolicyMessage](profile, "project_policy"),
+		projectDeploymentCache: newCache[int, *DeploymentConfigMessage](profile, "project_deployment"),
+		policyCache:            newCache[string, *PolicyMessage](profile, "policy"),
+		issueCache:             newCache[int, *IssueMessage](profile, "issue"),
+		issueByPipelineCache:   newCache[int, *IssueMessage](profile, "issue"),
+		pipelineCache:          newCache[int, *PipelineMessage](profile, "pipeline"),
+		settingCache:           newCache[api.SettingName, *SettingMessage](profile, "setting"),
+		idpCache:               newCache[string, *IdentityProviderMessage](profile, "idp"),
+		risksCache:             newCache[int, []*RiskMessage](profile, "risk"),
+		databaseGroupCache:     newCache[string, *DatabaseGroupMessage](profile, "database_group"),
+		databaseGroupIDCache:   newCache[int64, *DatabaseGroupMessage](profile, "database_group"),
+		schemaGroupCache:       newCache[string, *SchemaGroupMessage](profile, "schema_group"),
+		vcsIDCache:             newCache[int, *ExternalVersionControlMessage](profile, "vcs"),
+		roleCache:              newCache[string, *RoleMessage](profile, "role"),
+		userSessionCache:       newCache[int, *UserSessionMessage](profile, "user_session"),
+		sheetCache:             newCache[int, string](profile, "sheet"),
+		dbSchemaCache:          newCache[int, *model.DBSchema](profile, "db_schema"),
 	}, nil
 }
 
@@ -195,6 +102,11 @@ func (s *Store) Close(ctx context.Context) error {
 	return s.db.Close(ctx)
 }
 
+// Ping verifies the connection to the metadata database.
+func (s *Store) Ping(ctx context.Context) error {
+	return s.db.db.PingContext(ctx)
+}
+
 func getInstanceCacheKey(instanceID string) string {
 	return instanceID
 }
@@ -64,8 +64,31 @@ func (s *Store) GetTaskV2ByID(ctx context.Context, id int) (*TaskMessage, error)
 	return tasks[0], nil
 }
 
-// CreateTasksV2 creates a new task.
+// CreateTasksV2 creates the tasks in a transaction with multi-row INSERTs.
+// The created tasks are returned in the order of the creates.
 func (s *Store) CreateTasksV2(ctx context.Context, creates ...*TaskMessage) ([]*TaskMessage, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var tasks []*TaskMessage
+	for i := 0; i < len(creates); i += batchInsertSize {
+		batch, err := createTasksImpl(ctx, tx, creates[i:min(i+batchInsertSize, len(creates))])
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, batch...)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+func createTasksImpl(ctx context.Context, tx *Tx, creates []*TaskMessage) ([]*TaskMessage, error) {
 	var query strings.Builder
 	var values []any
 	var queryValues []string
@@ -115,12 +138,6 @@ func (s *Store) CreateTasksV2(ctx context.Context, creates ...*TaskMessage) ([]*
 		return nil, err
 	}
 
-	tx, err := s.db.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer tx.Rollback()
-
 	var tasks []*TaskMessage
 	rows, err := tx.QueryContext(ctx, query.String(), values...)
 	if err != nil {
@@ -157,10 +174,6 @@ func (s *Store) CreateTasksV2(ctx context.Context, creates ...*TaskMessage) ([]*
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
-
-	if err := tx.Commit(); err != nil {
-		return nil, err
-	}
 	return tasks, nil
 }
 
@@ -182,6 +195,15 @@ func (s *Store) ListTasks(ctx context.Context, find *api.TaskFind) ([]*TaskMessa
 	if v := find.DatabaseID; v != nil {
 		where, args = append(where, fmt.Sprintf("task.database_id = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := find.InstanceID; v != nil {
+		where, args = append(where, fmt.Sprintf("task.instance_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.ProjectUID; v != nil {
+		where, args = append(where, fmt.Sprintf("task.pipeline_id IN (SELECT id FROM pipeline WHERE project_id = $%d)", len(args)+1)), append(args, *v)
+	}
+	if v := find.EnvironmentID; v != nil {
+		where, args = append(where, fmt.Sprintf("task.stage_id IN (SELECT id FROM stage WHERE environment_id = $%d)", len(args)+1)), append(args, *v)
+	}
 	if v := find.StatusList; v != nil {
 		list := []string{}
 		for _, status := range *v {
@@ -209,7 +231,7 @@ func (s *Store) ListTasks(ctx context.Context, find *api.TaskFind) ([]*TaskMessa
 		where = append(where, "(SELECT NOT EXISTS (SELECT 1 FROM task as other_task WHERE other_task.pipeline_id = task.pipeline_id AND other_task.stage_id < task.stage_id AND other_task.status != 'DONE'))")
 	}
 	if find.NonRollbackTask {
-		where = append(where, "(NOT (task.type='bb.task.database.data.update' AND task.payload->>'rollbackFromTaskId' IS NOT NULL))")
+		where = append(where, "(NOT (task.type IN ('bb.task.database.data.update', 'bb.task.database.schema.update') AND task.payload->>'rollbackFromTaskId' IS NOT NULL))")
 	}
 
 	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
@@ -299,6 +321,48 @@ func (s *Store) ListTasks(ctx context.Context, find *api.TaskFind) ([]*TaskMessa
 // UpdateTaskV2 updates an existing task.
 // Returns ENOTFOUND if task does not exist.
 func (s *Store) UpdateTaskV2(ctx context.Context, patch *api.TaskPatch) (*TaskMessage, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	task, err := updateTaskImpl(ctx, tx, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// BatchUpdateTasks updates the tasks in a transaction.
+// Returns ENOTFOUND if any task does not exist.
+func (s *Store) BatchUpdateTasks(ctx context.Context, patches []*api.TaskPatch) ([]*TaskMessage, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var tasks []*TaskMessage
+	for _, patch := range patches {
+		task, err := updateTaskImpl(ctx, tx, patch)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+func updateTaskImpl(ctx context.Context, tx *Tx, patch *api.TaskPatch) (*TaskMessage, error) {
 	set, args := []string{"updater_id = $1"}, []any{patch.UpdaterID}
 	if v := patch.DatabaseID; v != nil {
 		set, args = append(set, fmt.Sprintf("database_id = $%d", len(args)+1)), append(args, *v)
@@ -336,7 +400,12 @@ func (s *Store) UpdateTaskV2(ctx context.Context, patch *api.TaskPatch) (*TaskMe
 		payloadSet, args = append(payloadSet, fmt.Sprintf(`jsonb_build_object('flags', $%d::JSONB)`, len(args)+1)), append(args, jsonb)
 	}
 	if len(payloadSet) != 0 {
-		set = append(set, fmt.Sprintf(`payload = payload || %s`, strings.Join(payloadSet, "||")))
+		payloadExpr := fmt.Sprintf(`payload || %s`, strings.Join(payloadSet, "||"))
+		if patch.SheetID != nil {
+			// The batch and online migration checkpoints are the positions in the previous statement.
+			payloadExpr = fmt.Sprintf(`(%s) - 'batchCheckpoint' - 'onlineMigrationCheckpoint'`, payloadExpr)
+		}
+		set = append(set, fmt.Sprintf(`payload = %s`, payloadExpr))
 	}
 	if v := patch.Payload; v != nil {
 		payload := "{}"
@@ -350,12 +419,6 @@ func (s *Store) UpdateTaskV2(ctx context.Context, patch *api.TaskPatch) (*TaskMe
 	}
 	args = append(args, patch.ID)
 
-	tx, err := s.db.BeginTx(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer tx.Rollback()
-
 	task := &TaskMessage{}
 	// Execute update query with RETURNING.
 	if err := tx.QueryRowContext(ctx, fmt.Sprintf(`
@@ -386,11 +449,6 @@ func (s *Store) UpdateTaskV2(ctx context.Context, patch *api.TaskPatch) (*TaskMe
 		}
 		return nil, err
 	}
-
-	if err := tx.Commit(); err != nil {
-		return nil, err
-	}
-
 	return task, nil
 }
 
@@ -402,13 +460,28 @@ func (s *Store) BatchSkipTasks(ctx context.Context, taskUIDs []int, comment stri
 	WHERE id = ANY($4)`
 	args := []any{updaterUID, true, comment, taskUIDs}
 
-	if _, err := s.db.db.ExecContext(ctx, query, args...); err != nil {
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
 		return errors.Wrapf(err, "failed to batch skip tasks")
 	}
 
 	return nil
 }
 
+// BatchRunTasksNow marks the tasks to run immediately regardless of the rollout window.
+func (s *Store) BatchRunTasksNow(ctx context.Context, taskUIDs []int, updaterUID int) error {
+	query := `
+	UPDATE task
+	SET updater_id = $1, payload = payload || jsonb_build_object('runNow', to_jsonb($2::BOOLEAN))
+	WHERE id = ANY($3)`
+	args := []any{updaterUID, true, taskUIDs}
+
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to batch run tasks now")
+	}
+
+	return nil
+}
+
 // ListTasksToAutoRollout returns tasks that
 // 1. have no task runs
 // 2. are not skipped
@@ -416,7 +489,7 @@ func (s *Store) BatchSkipTasks(ctx context.Context, taskUIDs []int, comment stri
 // 4. are in an environment that has auto rollout enabled
 // 5. are in the stage that is the first among the selected stages in the pipeline.
 func (s *Store) ListTasksToAutoRollout(ctx context.Context, environmentIDs []int) ([]int, error) {
-	rows, err := s.db.db.QueryContext(ctx, `
+	rows, err := s.db.QueryContext(ctx, `
 	SELECT
 		task.pipeline_id,
 		task.stage_id,
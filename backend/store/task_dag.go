@@ -19,33 +19,36 @@ type TaskDAGFind struct {
 	PipelineID *int
 }
 
-// CreateTaskDAGV2 creates a task DAG.
-func (s *Store) CreateTaskDAGV2(ctx context.Context, create *TaskDAGMessage) error {
+// CreateTaskDAGs creates the task DAG edges in a transaction with multi-row INSERTs.
+func (s *Store) CreateTaskDAGs(ctx context.Context, creates ...*TaskDAGMessage) error {
+	if len(creates) == 0 {
+		return nil
+	}
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
-	query := `
-		INSERT INTO task_dag (
-			from_task_id,
-			to_task_id,
-			payload
-		)
-		VALUES ($1, $2, $3)
-		RETURNING from_task_id, to_task_id
-	`
-	var taskDAG TaskDAGMessage
-	if err := tx.QueryRowContext(ctx, query,
-		create.FromTaskID,
-		create.ToTaskID,
-		"{}", /* payload */
-	).Scan(
-		&taskDAG.FromTaskID,
-		&taskDAG.ToTaskID,
-	); err != nil {
-		return err
+	for i := 0; i < len(creates); i += batchInsertSize {
+		batch := creates[i:min(i+batchInsertSize, len(creates))]
+		var values []string
+		var args []any
+		for j, create := range batch {
+			values = append(values, getValuesPlaceholder(j, 3))
+			args = append(args, create.FromTaskID, create.ToTaskID, "{}" /* payload */)
+		}
+		query := `
+			INSERT INTO task_dag (
+				from_task_id,
+				to_task_id,
+				payload
+			)
+			VALUES ` + strings.Join(values, ", ")
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return err
+		}
 	}
 
 	return tx.Commit()
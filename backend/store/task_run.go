@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -46,6 +47,10 @@ type FindTaskRunMessage struct {
 	StageUID    *int
 	PipelineUID *int
 	Status      *[]api.TaskRunStatus
+	// After lists the task runs after the cursor in the creation order.
+	After  *KeysetCursor
+	Limit  *int
+	Offset *int
 }
 
 // TaskRunFind is the API message for finding task runs.
@@ -70,6 +75,11 @@ type TaskRunStatusPatch struct {
 	Status api.TaskRunStatus
 	Code   *common.Code
 	Result *string
+
+	// ReplicaID is set to patch the task run only if it's claimed by the replica.
+	ReplicaID *string
+	// Activities are created with their outbox events in the same transaction as the update.
+	Activities []*PendingActivity
 }
 
 // ListTaskRunsV2 lists task runs.
@@ -98,8 +108,22 @@ func (s *Store) ListTaskRunsV2(ctx context.Context, find *FindTaskRunMessage) ([
 		}
 		where = append(where, fmt.Sprintf("task_run.status in (%s)", strings.Join(list, ",")))
 	}
+	if v := find.After; v != nil {
+		var condition string
+		condition, args = getKeysetCondition("task_run", v, api.ASC, args)
+		where = append(where, condition)
+	}
+	limitOffsetClause := ""
+	if v := find.Limit; v != nil {
+		limitOffsetClause = fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, *v)
+	}
+	if v := find.Offset; v != nil {
+		limitOffsetClause += fmt.Sprintf(" OFFSET $%d", len(args)+1)
+		args = append(args, *v)
+	}
 
-	rows, err := s.db.db.QueryContext(ctx, fmt.Sprintf(`
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
 		SELECT
 			task_run.id,
 			task_run.creator_id,
@@ -120,7 +144,7 @@ func (s *Store) ListTaskRunsV2(ctx context.Context, find *FindTaskRunMessage) ([
 		LEFT JOIN pipeline ON pipeline.id = task.pipeline_id
 		LEFT JOIN project ON project.id = pipeline.project_id
 		WHERE %s
-		ORDER BY task_run.id ASC`, strings.Join(where, " AND ")),
+		ORDER BY task_run.created_ts ASC, task_run.id ASC%s`, strings.Join(where, " AND "), limitOffsetClause),
 		args...,
 	)
 	if err != nil {
@@ -191,6 +215,9 @@ func (s *Store) UpdateTaskRunStatus(ctx context.Context, patch *TaskRunStatusPat
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to update task run")
 	}
+	if err := createPendingActivitiesImpl(ctx, tx, patch.Activities); err != nil {
+		return nil, errors.Wrapf(err, "failed to create activities")
+	}
 
 	if err := tx.Commit(); err != nil {
 		return nil, errors.Wrapf(err, "failed to commit tx")
@@ -275,14 +302,14 @@ func (*Store) getTaskNextAttempt(ctx context.Context, tx *Tx, taskIDs []int) ([]
 	return attempts, nil
 }
 
-func (s *Store) createPendingTaskRunsTx(ctx context.Context, tx *Tx, attempts []int, creates []*TaskRunMessage) error {
+func (*Store) createPendingTaskRunsTx(ctx context.Context, tx *Tx, attempts []int, creates []*TaskRunMessage) error {
 	if len(attempts) != len(creates) {
 		return errors.Errorf("length of attempts and creates are different")
 	}
 
-	// TODO(p0ny): batch create.
-	for i, create := range creates {
-		if err := s.createTaskRunImpl(ctx, tx, create, attempts[i], api.TaskRunPending, create.CreatorID); err != nil {
+	for i := 0; i < len(creates); i += batchInsertSize {
+		end := min(i+batchInsertSize, len(creates))
+		if err := createTaskRunsImpl(ctx, tx, creates[i:end], attempts[i:end], api.TaskRunPending); err != nil {
 			return err
 		}
 	}
@@ -305,8 +332,21 @@ func (*Store) checkTaskRunsExist(ctx context.Context, tx *Tx, taskIDs []int, sta
 	return exist, nil
 }
 
-// createTaskRunImpl creates a new taskRun.
-func (*Store) createTaskRunImpl(ctx context.Context, tx *Tx, create *TaskRunMessage, attempt int, status api.TaskRunStatus, creatorID int) error {
+// createTaskRunsImpl creates the task runs with a multi-row INSERT.
+func createTaskRunsImpl(ctx context.Context, tx *Tx, creates []*TaskRunMessage, attempts []int, status api.TaskRunStatus) error {
+	var values []string
+	var args []any
+	for i, create := range creates {
+		values = append(values, getValuesPlaceholder(i, 6))
+		args = append(args,
+			create.CreatorID,
+			create.CreatorID,
+			create.TaskUID,
+			attempts[i],
+			create.Name,
+			status,
+		)
+	}
 	query := `
 		INSERT INTO task_run (
 			creator_id,
@@ -315,16 +355,8 @@ func (*Store) createTaskRunImpl(ctx context.Context, tx *Tx, create *TaskRunMess
 			attempt,
 			name,
 			status
-		) VALUES ($1, $2, $3, $4, $5, $6)
-	`
-	if _, err := tx.ExecContext(ctx, query,
-		creatorID,
-		creatorID,
-		create.TaskUID,
-		attempt,
-		create.Name,
-		status,
-	); err != nil {
+		) VALUES ` + strings.Join(values, ", ")
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
 		return err
 	}
 	return nil
@@ -351,6 +383,9 @@ func (*Store) patchTaskRunStatusImpl(ctx context.Context, tx *Tx, patch *TaskRun
 	// Build WHERE clause.
 	where := []string{"TRUE"}
 	where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, patch.ID)
+	if v := patch.ReplicaID; v != nil {
+		where, args = append(where, fmt.Sprintf("replica_id = $%d", len(args)+1)), append(args, *v)
+	}
 
 	var taskRun TaskRunMessage
 	if err := tx.QueryRowContext(ctx, `
@@ -475,13 +510,63 @@ func (*Store) findTaskRunImpl(ctx context.Context, tx *Tx, find *TaskRunFind) ([
 	return taskRuns, nil
 }
 
+// ClaimTaskRun claims the running task run for the replica to execute.
+// The task run can be claimed if it's unclaimed, already claimed by the replica,
+// or its heartbeat is older than the heartbeat timeout, i.e. the replica executing it is gone.
+// The staleness is computed with the clock of the database, which also writes the heartbeats, to be immune to the clock skew of the replicas.
+// It returns whether the task run is claimed and the replica which claimed it before.
+func (s *Store) ClaimTaskRun(ctx context.Context, taskRunID int, replicaID string, heartbeatTimeout time.Duration) (bool, string, error) {
+	query := `
+		UPDATE task_run
+		SET replica_id = $1, heartbeat_ts = extract(epoch from now())
+		FROM (SELECT id, replica_id FROM task_run WHERE id = $2 FOR UPDATE) AS previous
+		WHERE task_run.id = previous.id
+			AND task_run.status = $3
+			AND (task_run.replica_id = '' OR task_run.replica_id = $1 OR task_run.heartbeat_ts < extract(epoch from now()) - $4)
+		RETURNING previous.replica_id`
+	var previousReplicaID string
+	if err := s.db.QueryRowContext(ctx, query, replicaID, taskRunID, api.TaskRunRunning, int64(heartbeatTimeout.Seconds())).Scan(&previousReplicaID); err != nil {
+		if err == sql.ErrNoRows {
+			return false, "", nil
+		}
+		return false, "", err
+	}
+	return true, previousReplicaID, nil
+}
+
+// HeartbeatTaskRuns refreshes the heartbeat of the running task runs claimed by the replica, and returns their IDs.
+func (s *Store) HeartbeatTaskRuns(ctx context.Context, replicaID string) ([]int, error) {
+	query := `
+		UPDATE task_run
+		SET heartbeat_ts = extract(epoch from now())
+		WHERE replica_id = $1 AND status = $2
+		RETURNING id`
+	rows, err := s.db.QueryContext(ctx, query, replicaID, api.TaskRunRunning)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // BatchCancelTaskRuns updates the status of taskRuns to CANCELED.
 func (s *Store) BatchCancelTaskRuns(ctx context.Context, taskRunIDs []int, updaterID int) error {
 	query := `
 		UPDATE task_run
 		SET status = $1, updater_id = $2
 		WHERE id = ANY($3)`
-	if _, err := s.db.db.ExecContext(ctx, query, api.TaskRunCanceled, updaterID, taskRunIDs); err != nil {
+	if _, err := s.db.ExecContext(ctx, query, api.TaskRunCanceled, updaterID, taskRunIDs); err != nil {
 		return err
 	}
 	return nil
@@ -0,0 +1,183 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+)
+
+// TaskRunArtifactType is the type of a task run artifact.
+type TaskRunArtifactType string
+
+const (
+	// TaskRunArtifactRollbackSQL is the artifact type of the rollback SQL statements.
+	TaskRunArtifactRollbackSQL TaskRunArtifactType = "ROLLBACK_SQL"
+	// TaskRunArtifactGhostChangelog is the artifact type of the gh-ost changelog.
+	TaskRunArtifactGhostChangelog TaskRunArtifactType = "GHOST_CHANGELOG"
+	// TaskRunArtifactVerificationOutput is the artifact type of the verification query output.
+	TaskRunArtifactVerificationOutput TaskRunArtifactType = "VERIFICATION_OUTPUT"
+	// TaskRunArtifactDataCompareReport is the artifact type of the data compare report.
+	TaskRunArtifactDataCompareReport TaskRunArtifactType = "DATA_COMPARE_REPORT"
+)
+
+// TaskRunArtifactMessage is the message for task run artifacts.
+// The content of the artifact is saved in the storage backend, only the location is saved in the metadata database.
+type TaskRunArtifactMessage struct {
+	TaskRunUID     int
+	Type           TaskRunArtifactType
+	Name           string
+	ContentType    string
+	Size           int64
+	StorageBackend api.BackupStorageBackend
+	Path           string
+
+	// Output only.
+	ID        int64
+	CreatedTs int64
+}
+
+// FindTaskRunArtifactMessage is the message for finding task run artifacts.
+type FindTaskRunArtifactMessage struct {
+	ID         *int64
+	TaskRunUID *int
+	// EnvironmentID finds the artifacts of the task runs whose database belongs to the environment.
+	EnvironmentID *string
+	// CreatedTsBefore finds the artifacts created before the timestamp.
+	CreatedTsBefore *int64
+	Limit           *int
+}
+
+// DeleteTaskRunArtifactMessage is the message for deleting task run artifacts.
+type DeleteTaskRunArtifactMessage struct {
+	UIDs []int64
+}
+
+// CreateTaskRunArtifact creates a task run artifact.
+func (s *Store) CreateTaskRunArtifact(ctx context.Context, create *TaskRunArtifactMessage) (*TaskRunArtifactMessage, error) {
+	query := `
+		INSERT INTO task_run_artifact (
+			task_run_id,
+			type,
+			name,
+			content_type,
+			size,
+			storage_backend,
+			path
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_ts
+	`
+	artifact := *create
+	if err := s.db.QueryRowContext(ctx, query,
+		create.TaskRunUID,
+		create.Type,
+		create.Name,
+		create.ContentType,
+		create.Size,
+		create.StorageBackend,
+		create.Path,
+	).Scan(&artifact.ID, &artifact.CreatedTs); err != nil {
+		return nil, errors.Wrapf(err, "failed to create task run artifact")
+	}
+	return &artifact, nil
+}
+
+// GetTaskRunArtifact gets a task run artifact.
+func (s *Store) GetTaskRunArtifact(ctx context.Context, find *FindTaskRunArtifactMessage) (*TaskRunArtifactMessage, error) {
+	artifacts, err := s.ListTaskRunArtifacts(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(artifacts) == 0 {
+		return nil, nil
+	}
+	if len(artifacts) > 1 {
+		return nil, errors.Errorf("found %d task run artifacts with filter %+v, expect 1", len(artifacts), find)
+	}
+	return artifacts[0], nil
+}
+
+// ListTaskRunArtifacts lists task run artifacts in the creation order.
+func (s *Store) ListTaskRunArtifacts(ctx context.Context, find *FindTaskRunArtifactMessage) ([]*TaskRunArtifactMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, fmt.Sprintf("task_run_artifact.id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.TaskRunUID; v != nil {
+		where, args = append(where, fmt.Sprintf("task_run_artifact.task_run_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.EnvironmentID; v != nil {
+		where, args = append(where, fmt.Sprintf(`COALESCE(
+			(SELECT environment.resource_id FROM environment WHERE environment.id = db.environment_id),
+			(SELECT environment.resource_id FROM environment WHERE environment.id = instance.environment_id)
+		) = $%d`, len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatedTsBefore; v != nil {
+		where, args = append(where, fmt.Sprintf("task_run_artifact.created_ts < $%d", len(args)+1)), append(args, *v)
+	}
+	query := fmt.Sprintf(`
+		SELECT
+			task_run_artifact.id,
+			task_run_artifact.task_run_id,
+			task_run_artifact.created_ts,
+			task_run_artifact.type,
+			task_run_artifact.name,
+			task_run_artifact.content_type,
+			task_run_artifact.size,
+			task_run_artifact.storage_backend,
+			task_run_artifact.path
+		FROM task_run_artifact
+		LEFT JOIN task_run ON task_run.id = task_run_artifact.task_run_id
+		LEFT JOIN task ON task.id = task_run.task_id
+		LEFT JOIN instance ON instance.id = task.instance_id
+		LEFT JOIN db ON db.id = task.database_id
+		WHERE %s
+		ORDER BY task_run_artifact.id ASC`, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, *v)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list task run artifacts")
+	}
+	defer rows.Close()
+
+	var artifacts []*TaskRunArtifactMessage
+	for rows.Next() {
+		var artifact TaskRunArtifactMessage
+		if err := rows.Scan(
+			&artifact.ID,
+			&artifact.TaskRunUID,
+			&artifact.CreatedTs,
+			&artifact.Type,
+			&artifact.Name,
+			&artifact.ContentType,
+			&artifact.Size,
+			&artifact.StorageBackend,
+			&artifact.Path,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan task run artifact")
+		}
+		artifacts = append(artifacts, &artifact)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan task run artifacts")
+	}
+	return artifacts, nil
+}
+
+// DeleteTaskRunArtifacts deletes task run artifacts.
+func (s *Store) DeleteTaskRunArtifacts(ctx context.Context, delete *DeleteTaskRunArtifactMessage) error {
+	if len(delete.UIDs) == 0 {
+		return nil
+	}
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM task_run_artifact WHERE id = ANY($1)`, delete.UIDs); err != nil {
+		return errors.Wrapf(err, "failed to delete task run artifacts")
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package store
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// TaskRunLogLevel is the level of a task run log.
+type TaskRunLogLevel string
+
+const (
+	// TaskRunLogDebug is the DEBUG level of task run logs.
+	TaskRunLogDebug TaskRunLogLevel = "DEBUG"
+	// TaskRunLogInfo is the INFO level of task run logs.
+	TaskRunLogInfo TaskRunLogLevel = "INFO"
+	// TaskRunLogWarn is the WARN level of task run logs.
+	TaskRunLogWarn TaskRunLogLevel = "WARN"
+	// TaskRunLogError is the ERROR level of task run logs.
+	TaskRunLogError TaskRunLogLevel = "ERROR"
+)
+
+// TaskRunLogMessage is the message for task run logs.
+type TaskRunLogMessage struct {
+	TaskRunUID int
+	Level      TaskRunLogLevel
+	// Content is the plain log content. It's compressed at rest.
+	Content string
+
+	// Output only.
+	ID        int64
+	CreatedTs int64
+}
+
+// FindTaskRunLogMessage is the message for finding task run logs.
+type FindTaskRunLogMessage struct {
+	TaskRunUID *int
+	// AfterID finds the logs after the log with the ID, which is used to read the logs incrementally.
+	AfterID *int64
+	// EnvironmentID finds the logs of the task runs whose database belongs to the environment.
+	EnvironmentID *string
+	// CreatedTsBefore finds the logs created before the timestamp.
+	CreatedTsBefore *int64
+	Limit           *int
+}
+
+// DeleteTaskRunLogMessage is the message for deleting task run logs.
+type DeleteTaskRunLogMessage struct {
+	UIDs []int64
+}
+
+// CreateTaskRunLog creates a task run log.
+func (s *Store) CreateTaskRunLog(ctx context.Context, create *TaskRunLogMessage) error {
+	payload, err := compressTaskRunLog(create.Content)
+	if err != nil {
+		return err
+	}
+	query := `
+		INSERT INTO task_run_log (
+			task_run_id,
+			level,
+			payload
+		) VALUES ($1, $2, $3)
+	`
+	level := create.Level
+	if level == "" {
+		level = TaskRunLogInfo
+	}
+	if _, err := s.db.ExecContext(ctx, query, create.TaskRunUID, level, payload); err != nil {
+		return errors.Wrapf(err, "failed to create task run log")
+	}
+	return nil
+}
+
+// ListTaskRunLogs lists task run logs in the creation order.
+func (s *Store) ListTaskRunLogs(ctx context.Context, find *FindTaskRunLogMessage) ([]*TaskRunLogMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.TaskRunUID; v != nil {
+		where, args = append(where, fmt.Sprintf("task_run_log.task_run_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.AfterID; v != nil {
+		where, args = append(where, fmt.Sprintf("task_run_log.id > $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.EnvironmentID; v != nil {
+		where, args = append(where, fmt.Sprintf(`COALESCE(
+			(SELECT environment.resource_id FROM environment WHERE environment.id = db.environment_id),
+			(SELECT environment.resource_id FROM environment WHERE environment.id = instance.environment_id)
+		) = $%d`, len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatedTsBefore; v != nil {
+		where, args = append(where, fmt.Sprintf("task_run_log.created_ts < $%d", len(args)+1)), append(args, *v)
+	}
+	query := fmt.Sprintf(`
+		SELECT
+			task_run_log.id,
+			task_run_log.task_run_id,
+			task_run_log.created_ts,
+			task_run_log.level,
+			task_run_log.payload
+		FROM task_run_log
+		LEFT JOIN task_run ON task_run.id = task_run_log.task_run_id
+		LEFT JOIN task ON task.id = task_run.task_id
+		LEFT JOIN instance ON instance.id = task.instance_id
+		LEFT JOIN db ON db.id = task.database_id
+		WHERE %s
+		ORDER BY task_run_log.id ASC`, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, *v)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list task run logs")
+	}
+	defer rows.Close()
+
+	var taskRunLogs []*TaskRunLogMessage
+	for rows.Next() {
+		var taskRunLog TaskRunLogMessage
+		var payload []byte
+		if err := rows.Scan(
+			&taskRunLog.ID,
+			&taskRunLog.TaskRunUID,
+			&taskRunLog.CreatedTs,
+			&taskRunLog.Level,
+			&payload,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan task run log")
+		}
+		content, err := decompressTaskRunLog(payload)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decompress task run log %d", taskRunLog.ID)
+		}
+		taskRunLog.Content = content
+		taskRunLogs = append(taskRunLogs, &taskRunLog)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan task run logs")
+	}
+	return taskRunLogs, nil
+}
+
+// DeleteTaskRunLogs deletes task run logs.
+func (s *Store) DeleteTaskRunLogs(ctx context.Context, delete *DeleteTaskRunLogMessage) error {
+	if len(delete.UIDs) == 0 {
+		return nil
+	}
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM task_run_log WHERE id = ANY($1)`, delete.UIDs); err != nil {
+		return errors.Wrapf(err, "failed to delete task run logs")
+	}
+	return nil
+}
+
+func compressTaskRunLog(content string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write([]byte(content)); err != nil {
+		return nil, errors.Wrapf(err, "failed to compress task run log")
+	}
+	if err := w.Close(); err != nil {
+		return nil, errors.Wrapf(err, "failed to compress task run log")
+	}
+	return buf.Bytes(), nil
+}
+
+func decompressTaskRunLog(payload []byte) (string, error) {
+	r, err := gzip.NewReader(bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
@@ -0,0 +1,19 @@
+package store
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressTaskRunLog(t *testing.T) {
+	a := require.New(t)
+	content := strings.Repeat("Copied 1000/2000 rows\n", 100)
+	payload, err := compressTaskRunLog(content)
+	a.NoError(err)
+	a.Less(len(payload), len(content))
+	got, err := decompressTaskRunLog(payload)
+	a.NoError(err)
+	a.Equal(content, got)
+}
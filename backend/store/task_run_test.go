@@ -0,0 +1,163 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/component/config"
+)
+
+// setupTaskRunStore starts a postgres instance with the task_run table, and returns the store connected to it.
+func setupTaskRunStore(t *testing.T) *Store {
+	ctx := context.Background()
+	db := setupTestDB(t)
+	// The columns used by the claims, the same as the task_run table in the metadata schema.
+	_, err := db.db.ExecContext(ctx, `
+		CREATE TABLE task_run (
+			id SERIAL PRIMARY KEY,
+			status TEXT NOT NULL CHECK (status IN ('PENDING', 'RUNNING', 'DONE', 'FAILED', 'CANCELED')),
+			replica_id TEXT NOT NULL DEFAULT '',
+			heartbeat_ts BIGINT NOT NULL DEFAULT 0
+		)`)
+	require.NoError(t, err)
+	s, err := New(db, &config.Profile{})
+	require.NoError(t, err)
+	return s
+}
+
+func createTaskRun(t *testing.T, s *Store, status string, replicaID string, heartbeatAgo time.Duration) int {
+	var id int
+	err := s.db.db.QueryRowContext(context.Background(), `
+		INSERT INTO task_run (status, replica_id, heartbeat_ts)
+		VALUES ($1, $2, extract(epoch from now()) - $3)
+		RETURNING id`,
+		status, replicaID, int64(heartbeatAgo.Seconds()),
+	).Scan(&id)
+	require.NoError(t, err)
+	return id
+}
+
+func TestTaskRunClaim(t *testing.T) {
+	a := require.New(t)
+	s := setupTaskRunStore(t)
+	ctx := context.Background()
+	timeout := time.Minute
+
+	unclaimed := createTaskRun(t, s, "RUNNING", "", 0)
+	live := createTaskRun(t, s, "RUNNING", "replica-b", 10*time.Second)
+	orphaned := createTaskRun(t, s, "RUNNING", "replica-b", 2*time.Minute)
+	done := createTaskRun(t, s, "DONE", "", 0)
+
+	// The unclaimed task run is claimed by the first replica only.
+	claimed, previous, err := s.ClaimTaskRun(ctx, unclaimed, "replica-a", timeout)
+	a.NoError(err)
+	a.True(claimed)
+	a.Equal("", previous)
+	claimed, _, err = s.ClaimTaskRun(ctx, unclaimed, "replica-c", timeout)
+	a.NoError(err)
+	a.False(claimed)
+	// The replica can claim its own task run again.
+	claimed, previous, err = s.ClaimTaskRun(ctx, unclaimed, "replica-a", timeout)
+	a.NoError(err)
+	a.True(claimed)
+	a.Equal("replica-a", previous)
+
+	// The task run heartbeating within the timeout is not taken over.
+	claimed, _, err = s.ClaimTaskRun(ctx, live, "replica-a", timeout)
+	a.NoError(err)
+	a.False(claimed)
+
+	// The orphaned task run is taken over.
+	claimed, previous, err = s.ClaimTaskRun(ctx, orphaned, "replica-a", timeout)
+	a.NoError(err)
+	a.True(claimed)
+	a.Equal("replica-b", previous)
+
+	// The task run not running can't be claimed.
+	claimed, _, err = s.ClaimTaskRun(ctx, done, "replica-a", timeout)
+	a.NoError(err)
+	a.False(claimed)
+}
+
+func TestTaskRunClaimConcurrently(t *testing.T) {
+	a := require.New(t)
+	s := setupTaskRunStore(t)
+	ctx := context.Background()
+
+	id := createTaskRun(t, s, "RUNNING", "", 0)
+	type result struct {
+		claimed bool
+		err     error
+	}
+	results := make(chan result, 8)
+	for i := 0; i < 8; i++ {
+		go func(i int) {
+			claimed, _, err := s.ClaimTaskRun(ctx, id, fmt.Sprintf("replica-%d", i), time.Minute)
+			results <- result{claimed: claimed, err: err}
+		}(i)
+	}
+	count := 0
+	for i := 0; i < 8; i++ {
+		r := <-results
+		a.NoError(r.err)
+		if r.claimed {
+			count++
+		}
+	}
+	a.Equal(1, count)
+}
+
+func TestHeartbeatTaskRuns(t *testing.T) {
+	a := require.New(t)
+	s := setupTaskRunStore(t)
+	ctx := context.Background()
+
+	mine := createTaskRun(t, s, "RUNNING", "replica-a", 2*time.Minute)
+	createTaskRun(t, s, "RUNNING", "replica-b", 2*time.Minute)
+	createTaskRun(t, s, "DONE", "replica-a", 2*time.Minute)
+
+	ids, err := s.HeartbeatTaskRuns(ctx, "replica-a")
+	a.NoError(err)
+	a.Equal([]int{mine}, ids)
+
+	// The heartbeat keeps the task run from being taken over.
+	claimed, _, err := s.ClaimTaskRun(ctx, mine, "replica-b", time.Minute)
+	a.NoError(err)
+	a.False(claimed)
+
+	// The task run taken over is no longer heartbeated by the previous replica.
+	_, err = s.db.db.ExecContext(ctx, `UPDATE task_run SET heartbeat_ts = heartbeat_ts - 120 WHERE id = $1`, mine)
+	a.NoError(err)
+	claimed, _, err = s.ClaimTaskRun(ctx, mine, "replica-b", time.Minute)
+	a.NoError(err)
+	a.True(claimed)
+	ids, err = s.HeartbeatTaskRuns(ctx, "replica-a")
+	a.NoError(err)
+	a.Empty(ids)
+}
+
+func TestAdvisoryLock(t *testing.T) {
+	a := require.New(t)
+	s := setupTaskRunStore(t)
+	ctx := context.Background()
+
+	lock, err := s.TryAdvisoryLock(ctx, 1)
+	a.NoError(err)
+	a.NotNil(lock)
+	a.NoError(lock.Check(ctx))
+
+	// The lock held by another session can't be acquired.
+	other, err := s.TryAdvisoryLock(ctx, 1)
+	a.NoError(err)
+	a.Nil(other)
+
+	a.NoError(lock.Release())
+	other, err = s.TryAdvisoryLock(ctx, 1)
+	a.NoError(err)
+	a.NotNil(other)
+	a.NoError(other.Release())
+}
@@ -0,0 +1,188 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const userSessionColumns = "id, created_ts, principal_id, ip_address, user_agent, expire_ts, last_active_ts, revoked_ts"
+
+// UserSessionMessage is the message for a sign-in session of a user.
+type UserSessionMessage struct {
+	PrincipalUID int
+	IPAddress    string
+	UserAgent    string
+	ExpireTs     int64
+
+	// Output only.
+	UID          int
+	CreatedTs    int64
+	LastActiveTs int64
+	// RevokedTs is 0 if the session is not revoked.
+	RevokedTs int64
+}
+
+// FindUserSessionMessage is the message for finding user sessions.
+type FindUserSessionMessage struct {
+	UID          *int
+	PrincipalUID *int
+	// ShowRevoked shows the revoked sessions if specified.
+	ShowRevoked bool
+}
+
+// RevokeUserSessionMessage is the message for revoking user sessions.
+type RevokeUserSessionMessage struct {
+	UID          *int
+	PrincipalUID *int
+	// ExcludeUID keeps the session unrevoked, e.g. the session changing the password.
+	ExcludeUID *int
+}
+
+// CreateUserSession creates a user session.
+func (s *Store) CreateUserSession(ctx context.Context, create *UserSessionMessage) (*UserSessionMessage, error) {
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		INSERT INTO user_session (principal_id, ip_address, user_agent, expire_ts)
+		VALUES ($1, $2, $3, $4)
+		RETURNING %s`, userSessionColumns),
+		create.PrincipalUID, create.IPAddress, create.UserAgent, create.ExpireTs,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create user session")
+	}
+	defer rows.Close()
+	sessions, err := scanUserSessions(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) != 1 {
+		return nil, errors.Errorf("expect to create one user session, got %d", len(sessions))
+	}
+	return sessions[0], nil
+}
+
+// GetUserSession gets a user session.
+// The session found by ID including the revoked ones is cached, since it's read for every request.
+func (s *Store) GetUserSession(ctx context.Context, find *FindUserSessionMessage) (*UserSessionMessage, error) {
+	cacheable := find.UID != nil && find.ShowRevoked
+	if cacheable {
+		if v, ok := s.userSessionCache.Get(*find.UID); ok {
+			if find.PrincipalUID != nil && *find.PrincipalUID != v.PrincipalUID {
+				return nil, nil
+			}
+			return v, nil
+		}
+	}
+	sessions, err := s.ListUserSessions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return nil, nil
+	}
+	if len(sessions) > 1 {
+		return nil, errors.Errorf("found %d user sessions with filter %+v, expect 1", len(sessions), find)
+	}
+	if cacheable {
+		s.userSessionCache.Add(sessions[0].UID, sessions[0])
+	}
+	return sessions[0], nil
+}
+
+// ListUserSessions lists the user sessions in the reverse creation order.
+func (s *Store) ListUserSessions(ctx context.Context, find *FindUserSessionMessage) ([]*UserSessionMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.PrincipalUID; v != nil {
+		where, args = append(where, fmt.Sprintf("principal_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if !find.ShowRevoked {
+		where = append(where, "revoked_ts = 0")
+	}
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM user_session
+		WHERE %s
+		ORDER BY id DESC`, userSessionColumns, strings.Join(where, " AND ")),
+		args...,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list user sessions")
+	}
+	defer rows.Close()
+	return scanUserSessions(rows)
+}
+
+// RevokeUserSessions revokes the unrevoked user sessions and returns the revoked ones.
+func (s *Store) RevokeUserSessions(ctx context.Context, revoke *RevokeUserSessionMessage, revokedTs int64) ([]*UserSessionMessage, error) {
+	where, args := []string{"revoked_ts = 0"}, []any{revokedTs}
+	if v := revoke.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := revoke.PrincipalUID; v != nil {
+		where, args = append(where, fmt.Sprintf("principal_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := revoke.ExcludeUID; v != nil {
+		where, args = append(where, fmt.Sprintf("id != $%d", len(args)+1)), append(args, *v)
+	}
+	if revoke.UID == nil && revoke.PrincipalUID == nil {
+		return nil, errors.Errorf("either session ID or principal ID must be set to revoke user sessions")
+	}
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+		UPDATE user_session
+		SET revoked_ts = $1
+		WHERE %s
+		RETURNING %s`, strings.Join(where, " AND "), userSessionColumns),
+		args...,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to revoke user sessions")
+	}
+	defer rows.Close()
+	sessions, err := scanUserSessions(rows)
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range sessions {
+		s.userSessionCache.Remove(session.UID)
+	}
+	return sessions, nil
+}
+
+// UpdateUserSessionLastActiveTs updates the last active time of a user session.
+func (s *Store) UpdateUserSessionLastActiveTs(ctx context.Context, uid int, lastActiveTs int64) error {
+	if _, err := s.db.ExecContext(ctx, `UPDATE user_session SET last_active_ts = $1 WHERE id = $2`, lastActiveTs, uid); err != nil {
+		return errors.Wrapf(err, "failed to update the last active time of user session %d", uid)
+	}
+	s.userSessionCache.Remove(uid)
+	return nil
+}
+
+func scanUserSessions(rows *sql.Rows) ([]*UserSessionMessage, error) {
+	var sessions []*UserSessionMessage
+	for rows.Next() {
+		session := &UserSessionMessage{}
+		if err := rows.Scan(
+			&session.UID,
+			&session.CreatedTs,
+			&session.PrincipalUID,
+			&session.IPAddress,
+			&session.UserAgent,
+			&session.ExpireTs,
+			&session.LastActiveTs,
+			&session.RevokedTs,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan user session")
+		}
+		sessions = append(sessions, session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan user sessions")
+	}
+	return sessions, nil
+}
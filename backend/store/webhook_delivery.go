@@ -0,0 +1,184 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+)
+
+const webhookDeliveryColumns = "id, created_ts, webhook_id, activity_type, status, attempt, webhook_context, error"
+
+// WebhookDeliveryStatus is the status of a webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliverySucceeded is the status of the succeeded deliveries.
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	// WebhookDeliveryFailed is the status of the failed deliveries which are retried.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "FAILED"
+	// WebhookDeliveryDead is the status of the deliveries failed after all the attempts, which are kept in the dead-letter list.
+	WebhookDeliveryDead WebhookDeliveryStatus = "DEAD"
+	// WebhookDeliveryRedelivered is the status of the dead deliveries redelivered manually.
+	WebhookDeliveryRedelivered WebhookDeliveryStatus = "REDELIVERED"
+)
+
+// WebhookDeliveryMessage is the message of a webhook delivery.
+type WebhookDeliveryMessage struct {
+	WebhookID    int
+	ActivityType string
+	Status       WebhookDeliveryStatus
+	// Attempt is the attempt of the outbox event delivering, starting from 1, and 0 for the manual redeliveries.
+	Attempt int
+	// WebhookContext is the JSON of the webhook context posted.
+	WebhookContext string
+	Error          string
+
+	// Output only.
+	UID       int64
+	CreatedTs int64
+}
+
+// FindWebhookDeliveryMessage is the message for finding webhook deliveries.
+type FindWebhookDeliveryMessage struct {
+	UID       *int64
+	WebhookID *int
+	Status    *WebhookDeliveryStatus
+	// After lists the deliveries after the cursor in the reverse creation order.
+	After  *KeysetCursor
+	Limit  *int
+	Offset *int
+}
+
+// CreateWebhookDeliveries creates the webhook deliveries, and returns the created ones.
+func (s *Store) CreateWebhookDeliveries(ctx context.Context, creates ...*WebhookDeliveryMessage) ([]*WebhookDeliveryMessage, error) {
+	if len(creates) == 0 {
+		return nil, nil
+	}
+	var values []string
+	var args []any
+	for _, create := range creates {
+		webhookContext := create.WebhookContext
+		if webhookContext == "" {
+			webhookContext = "{}"
+		}
+		values = append(values, fmt.Sprintf("($%d::INTEGER, $%d::TEXT, $%d::TEXT, $%d::INTEGER, $%d::JSONB, $%d::TEXT)", len(args)+1, len(args)+2, len(args)+3, len(args)+4, len(args)+5, len(args)+6))
+		args = append(args, create.WebhookID, create.ActivityType, create.Status, create.Attempt, webhookContext, create.Error)
+	}
+	// The deliveries of the deleted webhooks are skipped.
+	query := fmt.Sprintf(`
+		INSERT INTO webhook_delivery (webhook_id, activity_type, status, attempt, webhook_context, error)
+		SELECT v.webhook_id, v.activity_type, v.status, v.attempt, v.webhook_context, v.error
+		FROM (VALUES %s) AS v(webhook_id, activity_type, status, attempt, webhook_context, error)
+		WHERE EXISTS (SELECT 1 FROM project_webhook WHERE project_webhook.id = v.webhook_id)
+		RETURNING %s`, strings.Join(values, ", "), webhookDeliveryColumns)
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create webhook deliveries")
+	}
+	defer rows.Close()
+	return scanWebhookDeliveries(rows)
+}
+
+// GetWebhookDelivery gets a webhook delivery.
+func (s *Store) GetWebhookDelivery(ctx context.Context, find *FindWebhookDeliveryMessage) (*WebhookDeliveryMessage, error) {
+	deliveries, err := s.ListWebhookDeliveries(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+	if len(deliveries) > 1 {
+		return nil, errors.Errorf("found %d webhook deliveries with filter %+v, expect 1", len(deliveries), find)
+	}
+	return deliveries[0], nil
+}
+
+// ListWebhookDeliveries lists the webhook deliveries in the reverse creation order.
+func (s *Store) ListWebhookDeliveries(ctx context.Context, find *FindWebhookDeliveryMessage) ([]*WebhookDeliveryMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.WebhookID; v != nil {
+		where, args = append(where, fmt.Sprintf("webhook_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.Status; v != nil {
+		where, args = append(where, fmt.Sprintf("status = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.After; v != nil {
+		var condition string
+		condition, args = getKeysetCondition("webhook_delivery", v, api.DESC, args)
+		where = append(where, condition)
+	}
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM webhook_delivery
+		WHERE %s
+		ORDER BY created_ts DESC, id DESC`, webhookDeliveryColumns, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT $%d", len(args)+1)
+		args = append(args, *v)
+	}
+	if v := find.Offset; v != nil {
+		query += fmt.Sprintf(" OFFSET $%d", len(args)+1)
+		args = append(args, *v)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list webhook deliveries")
+	}
+	defer rows.Close()
+	return scanWebhookDeliveries(rows)
+}
+
+func scanWebhookDeliveries(rows *sql.Rows) ([]*WebhookDeliveryMessage, error) {
+	var deliveries []*WebhookDeliveryMessage
+	for rows.Next() {
+		delivery := &WebhookDeliveryMessage{}
+		if err := rows.Scan(
+			&delivery.UID,
+			&delivery.CreatedTs,
+			&delivery.WebhookID,
+			&delivery.ActivityType,
+			&delivery.Status,
+			&delivery.Attempt,
+			&delivery.WebhookContext,
+			&delivery.Error,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan webhook delivery")
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan webhook deliveries")
+	}
+	return deliveries, nil
+}
+
+// UpdateWebhookDeliveryStatus updates the status of the webhook delivery.
+func (s *Store) UpdateWebhookDeliveryStatus(ctx context.Context, uid int64, status WebhookDeliveryStatus) error {
+	if _, err := s.db.ExecContext(ctx, `UPDATE webhook_delivery SET status = $1 WHERE id = $2`, status, uid); err != nil {
+		return errors.Wrapf(err, "failed to update webhook delivery %d", uid)
+	}
+	return nil
+}
+
+// DeleteWebhookDeliveriesBefore deletes the webhook deliveries created before the timestamp, except the dead ones.
+func (s *Store) DeleteWebhookDeliveriesBefore(ctx context.Context, ts int64) (int64, error) {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM webhook_delivery WHERE status != $1 AND created_ts < $2`, WebhookDeliveryDead, ts)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to delete webhook deliveries")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get the number of deleted webhook deliveries")
+	}
+	return count, nil
+}
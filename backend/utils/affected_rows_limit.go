@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// GetTaskAffectedRowsExceededResult returns the result of the latest statement report check of the task
+// if its estimated affected rows exceed the environment limit.
+func GetTaskAffectedRowsExceededResult(ctx context.Context, stores *store.Store, task *store.TaskMessage) (*storepb.PlanCheckRunResult_Result, error) {
+	if task.DatabaseID == nil {
+		return nil, nil
+	}
+	database, err := stores.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID, ShowDeleted: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %d", *task.DatabaseID)
+	}
+	if database == nil {
+		return nil, nil
+	}
+	plans, err := stores.ListPlans(ctx, &store.FindPlanMessage{PipelineID: &task.PipelineID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list plans")
+	}
+	if len(plans) != 1 {
+		return nil, nil
+	}
+	planTypes := []store.PlanCheckRunType{store.PlanCheckDatabaseStatementSummaryReport}
+	status := []store.PlanCheckRunStatus{store.PlanCheckRunStatusDone}
+	runs, err := stores.ListPlanCheckRuns(ctx, &store.FindPlanCheckRunMessage{
+		PlanUID: &plans[0].UID,
+		Type:    &planTypes,
+		Status:  &status,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list plan check runs")
+	}
+	var latest *store.PlanCheckRunMessage
+	for _, run := range runs {
+		if run.Config.InstanceUid != int32(task.InstanceID) || run.Config.DatabaseName != database.DatabaseName {
+			continue
+		}
+		if latest == nil || latest.UID < run.UID {
+			latest = run
+		}
+	}
+	if latest == nil || latest.Result == nil {
+		return nil, nil
+	}
+	for _, result := range latest.Result.Results {
+		if result.Code == common.TaskAffectedRowsExceeded.Int32() {
+			return result, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// GetTaskPendingApprovalGateRoles returns the approval gate roles of the task environment which haven't signed off the task.
+// The task is allowed to run only if it returns empty.
+func GetTaskPendingApprovalGateRoles(ctx context.Context, stores *store.Store, task *store.TaskMessage) ([]string, error) {
+	policy, err := GetTaskRolloutPolicy(ctx, stores, task)
+	if err != nil {
+		return nil, err
+	}
+	if len(policy.GetApprovalGateRoles()) == 0 {
+		return nil, nil
+	}
+	approvals, err := stores.ListStageApprovals(ctx, &store.FindStageApprovalMessage{StageID: &task.StageID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list approvals of stage %d", task.StageID)
+	}
+	return GetPendingApprovalGateRoles(policy.GetApprovalGateRoles(), approvals, task.ID), nil
+}
+
+// GetPendingApprovalGateRoles returns the gate roles which haven't signed off the task.
+// A role signs off the task by approving either the task or the whole stage.
+func GetPendingApprovalGateRoles(gateRoles []string, approvals []*store.StageApprovalMessage, taskID int) []string {
+	var pending []string
+	for _, role := range gateRoles {
+		if !slices.ContainsFunc(approvals, func(approval *store.StageApprovalMessage) bool {
+			return approval.Role == role && (approval.TaskID == nil || *approval.TaskID == taskID)
+		}) {
+			pending = append(pending, role)
+		}
+	}
+	return pending
+}
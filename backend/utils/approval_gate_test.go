@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestGetPendingApprovalGateRoles(t *testing.T) {
+	a := require.New(t)
+	task1, task2 := 1, 2
+	gateRoles := []string{"roles/workspaceDBA", "roles/projectOwner"}
+
+	a.Equal(gateRoles, GetPendingApprovalGateRoles(gateRoles, nil, task1))
+
+	approvals := []*store.StageApprovalMessage{
+		// The whole stage is signed off by the DBA.
+		{Role: "roles/workspaceDBA"},
+		// Only the task 2 is signed off by the project owner.
+		{Role: "roles/projectOwner", TaskID: &task2},
+	}
+	a.Equal([]string{"roles/projectOwner"}, GetPendingApprovalGateRoles(gateRoles, approvals, task1))
+	a.Nil(GetPendingApprovalGateRoles(gateRoles, approvals, task2))
+	a.Nil(GetPendingApprovalGateRoles(nil, approvals, task1))
+}
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// GetTaskUnpromotedCanaryStage returns the previous stage of the task stage if it's a canary stage which hasn't been promoted.
+// The task is allowed to run only if it returns nil.
+func GetTaskUnpromotedCanaryStage(ctx context.Context, stores *store.Store, task *store.TaskMessage) (*store.StageMessage, error) {
+	stages, err := stores.ListStageV2(ctx, task.PipelineID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list stages of pipeline %d", task.PipelineID)
+	}
+	return GetUnpromotedCanaryStage(stages, task.StageID), nil
+}
+
+// GetUnpromotedCanaryStage returns the stage before the stage if it's a canary stage which hasn't been promoted.
+// The stages are sorted in the rollout order.
+func GetUnpromotedCanaryStage(stages []*store.StageMessage, stageID int) *store.StageMessage {
+	for i, stage := range stages {
+		if stage.ID != stageID {
+			continue
+		}
+		if i == 0 {
+			return nil
+		}
+		previous := stages[i-1]
+		canary := previous.Payload.GetCanary()
+		if canary == nil || canary.Status == storepb.StageCanary_PROMOTED {
+			return nil
+		}
+		return previous
+	}
+	return nil
+}
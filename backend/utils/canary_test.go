@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetUnpromotedCanaryStage(t *testing.T) {
+	a := require.New(t)
+	canaryStage := &store.StageMessage{
+		ID:      1,
+		Payload: &storepb.StagePayload{Canary: &storepb.StageCanary{Status: storepb.StageCanary_BAKING}},
+	}
+	stages := []*store.StageMessage{
+		canaryStage,
+		{ID: 2, Payload: &storepb.StagePayload{}},
+		{ID: 3, Payload: &storepb.StagePayload{}},
+	}
+
+	a.Nil(GetUnpromotedCanaryStage(stages, 1))
+	a.Equal(canaryStage, GetUnpromotedCanaryStage(stages, 2))
+	a.Nil(GetUnpromotedCanaryStage(stages, 3))
+
+	canaryStage.Payload.Canary.Status = storepb.StageCanary_HALTED
+	a.Equal(canaryStage, GetUnpromotedCanaryStage(stages, 2))
+
+	canaryStage.Payload.Canary.Status = storepb.StageCanary_PROMOTED
+	a.Nil(GetUnpromotedCanaryStage(stages, 2))
+}
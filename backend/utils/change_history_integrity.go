@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// changeHistoryIntegrityPageSize bounds the change histories loaded at a time, as the statements can be large.
+const changeHistoryIntegrityPageSize = 100
+
+// ChangeHistoryIntegrityCheckpoint is the progress of checking the change histories of a database in the applied order.
+// The zero value checks from the first change history.
+type ChangeHistoryIntegrityCheckpoint struct {
+	// Sequence is the sequence of the last checked change history.
+	Sequence int64
+
+	// lastVersion is the stored version of the last applied change history, and lastChangeHistory is the change history.
+	// The versions of the change histories applied after it must be greater.
+	lastVersion       string
+	lastChangeHistory *store.InstanceChangeHistoryMessage
+}
+
+// CheckChangeHistoryIntegrity checks all the change histories of the database.
+func CheckChangeHistoryIntegrity(ctx context.Context, stores *store.Store, instance *store.InstanceMessage, database *store.DatabaseMessage) ([]*api.ChangeHistoryIntegrityViolation, error) {
+	return CheckNewChangeHistoryIntegrity(ctx, stores, instance, database, &ChangeHistoryIntegrityCheckpoint{})
+}
+
+// CheckNewChangeHistoryIntegrity checks the change histories of the database after the checkpoint, and advances the checkpoint.
+// It re-computes the statement checksums recorded when the changes are applied,
+// and verifies the versions of the applied changes are monotonically increasing.
+func CheckNewChangeHistoryIntegrity(ctx context.Context, stores *store.Store, instance *store.InstanceMessage, database *store.DatabaseMessage, checkpoint *ChangeHistoryIntegrityCheckpoint) ([]*api.ChangeHistoryIntegrityViolation, error) {
+	var violations []*api.ChangeHistoryIntegrityViolation
+	for {
+		changeHistories, err := stores.ListInstanceChangeHistoryForIntegrityCheck(ctx, instance.UID, database.UID, checkpoint.Sequence, changeHistoryIntegrityPageSize)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list change histories of database %q", database.DatabaseName)
+		}
+		pageViolations, done, err := checkChangeHistoryIntegrity(changeHistories, checkpoint)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, pageViolations...)
+		if done || len(changeHistories) < changeHistoryIntegrityPageSize {
+			return violations, nil
+		}
+	}
+}
+
+// checkChangeHistoryIntegrity checks the change histories sorted by the sequence in ascending order, and advances the checkpoint.
+// The checkpoint stops before the first pending change history, whose version is checked once it's applied.
+// It returns done if the checkpoint is stopped.
+func checkChangeHistoryIntegrity(changeHistories []*store.InstanceChangeHistoryMessage, checkpoint *ChangeHistoryIntegrityCheckpoint) ([]*api.ChangeHistoryIntegrityViolation, bool, error) {
+	var violations []*api.ChangeHistoryIntegrityViolation
+	for _, changeHistory := range changeHistories {
+		if changeHistory.Status == db.Pending {
+			return violations, true, nil
+		}
+		checkpoint.Sequence = changeHistory.Sequence
+
+		// The change histories created before the checksum is introduced have no checksum.
+		if expected := changeHistory.Payload.GetStatementSha256(); expected != "" {
+			if actual := common.SHA256Hex(changeHistory.Statement); actual != expected {
+				violations = append(violations, &api.ChangeHistoryIntegrityViolation{
+					ChangeHistoryUID: changeHistory.UID,
+					Version:          changeHistory.Version.Version,
+					Type:             api.ChangeHistoryIntegrityChecksumMismatch,
+					Detail:           fmt.Sprintf("statement checksum %s doesn't match the recorded checksum %s", actual, expected),
+				})
+			}
+		}
+
+		if changeHistory.Status != db.Done || changeHistory.Version.Version == "" {
+			continue
+		}
+		storedVersion, err := changeHistory.Version.Marshal()
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "failed to marshal version %q of change history %s", changeHistory.Version.Version, changeHistory.UID)
+		}
+		if checkpoint.lastChangeHistory != nil && storedVersion <= checkpoint.lastVersion {
+			violations = append(violations, &api.ChangeHistoryIntegrityViolation{
+				ChangeHistoryUID: changeHistory.UID,
+				Version:          changeHistory.Version.Version,
+				Type:             api.ChangeHistoryIntegrityOutOfOrderVersion,
+				Detail:           fmt.Sprintf("version %s is not greater than version %s applied before it in change history %s", changeHistory.Version.Version, checkpoint.lastChangeHistory.Version.Version, checkpoint.lastChangeHistory.UID),
+			})
+			continue
+		}
+		checkpoint.lastVersion = storedVersion
+		checkpoint.lastChangeHistory = changeHistory
+	}
+	return violations, false, nil
+}
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// GetDatabaseFreeze returns the freeze of the database, or nil if the database isn't frozen or the freeze has expired.
+func GetDatabaseFreeze(database *store.DatabaseMessage) *storepb.DatabaseFreeze {
+	freeze := database.Metadata.GetFreeze()
+	if freeze == nil {
+		return nil
+	}
+	if freeze.ExpireTime != nil && !time.Now().Before(freeze.ExpireTime.AsTime()) {
+		return nil
+	}
+	return freeze
+}
+
+// GetTaskDatabaseFreeze returns the freeze of the database if it blocks the task.
+// The backups are allowed on the frozen databases.
+func GetTaskDatabaseFreeze(ctx context.Context, stores *store.Store, task *store.TaskMessage) (*storepb.DatabaseFreeze, error) {
+	if task.DatabaseID == nil || task.Type == api.TaskDatabaseBackup {
+		return nil, nil
+	}
+	database, err := stores.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID, ShowDeleted: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %d", *task.DatabaseID)
+	}
+	if database == nil {
+		return nil, nil
+	}
+	return GetDatabaseFreeze(database), nil
+}
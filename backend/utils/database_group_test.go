@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestMatchDatabaseGroup(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	eu := &store.DatabaseMessage{
+		InstanceID:             "pg-prod",
+		EffectiveEnvironmentID: "prod",
+		DatabaseName:           "tenant_a",
+		Metadata:               &storepb.DatabaseMetadata{Labels: map[string]string{"region": "eu"}},
+	}
+	us := &store.DatabaseMessage{
+		InstanceID:             "pg-prod",
+		EffectiveEnvironmentID: "prod",
+		DatabaseName:           "tenant_b",
+		Metadata:               &storepb.DatabaseMetadata{Labels: map[string]string{"region": "us"}},
+	}
+	unlabeled := &store.DatabaseMessage{
+		InstanceID:             "pg-prod",
+		EffectiveEnvironmentID: "prod",
+		DatabaseName:           "tenant_c",
+	}
+
+	tests := []struct {
+		expression string
+		database   *store.DatabaseMessage
+		want       bool
+	}{
+		{`resource.database_name.matches("tenant_.*") && resource.labels.region == "eu"`, eu, true},
+		{`resource.database_name.matches("tenant_.*") && resource.labels.region == "eu"`, us, false},
+		{`resource.database_name.matches("tenant_.*") && resource.labels.region == "eu"`, unlabeled, false},
+		{`resource.engine == "POSTGRES" && resource.environment_name == "environments/prod"`, unlabeled, true},
+		{`resource.engine == "MYSQL"`, eu, false},
+		{`!("region" in resource.labels)`, unlabeled, true},
+	}
+	for _, test := range tests {
+		prog, err := common.ValidateGroupCELExpr(test.expression)
+		a.NoError(err)
+		matched, err := matchDatabaseGroup(ctx, prog, test.database, storepb.Engine_POSTGRES.String())
+		a.NoError(err)
+		a.Equal(test.want, matched, test.expression)
+	}
+}
@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// NewDeploymentProgram compiles the CEL expression matching the databases of a deployment.
+func NewDeploymentProgram(expression string) (cel.Program, error) {
+	e, err := cel.NewEnv(common.DeploymentCELAttributes...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cel env")
+	}
+	ast, issues := e.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, errors.Errorf("failed to compile expression %q, error: %v", expression, issues.Err())
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, errors.Errorf("expression %q should return a bool value, got %v", expression, ast.OutputType())
+	}
+	return e.Program(ast)
+}
+
+// isMatchDeploymentProgram checks whether the database matches the deployment expression.
+func isMatchDeploymentProgram(prg cel.Program, database *store.DatabaseMessage, engine storepb.Engine) (bool, error) {
+	labels := make(map[string]string)
+	for k, v := range database.Metadata.GetLabels() {
+		labels[k] = v
+	}
+	out, _, err := prg.Eval(map[string]any{
+		"resource.environment_id": database.EffectiveEnvironmentID,
+		"resource.instance_id":    database.InstanceID,
+		"resource.database_name":  database.DatabaseName,
+		"resource.engine":         engine.String(),
+		"resource.labels":         labels,
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to evaluate expression for database %q", database.DatabaseName)
+	}
+	match, ok := out.Value().(bool)
+	if !ok {
+		return false, errors.Errorf("expression result %v is not a bool value", out.Value())
+	}
+	return match, nil
+}
+
+// GetDatabasesMatchingDeploymentExpression returns the databases matching the deployment expression.
+// engines maps the instance resource ID to the engine of the instance.
+func GetDatabasesMatchingDeploymentExpression(expression string, databaseList []*store.DatabaseMessage, engines map[string]storepb.Engine) ([]*store.DatabaseMessage, error) {
+	prg, err := NewDeploymentProgram(expression)
+	if err != nil {
+		return nil, err
+	}
+	var matched []*store.DatabaseMessage
+	for _, database := range databaseList {
+		if database.SyncState == api.NotFound {
+			continue
+		}
+		match, err := isMatchDeploymentProgram(prg, database, engines[database.InstanceID])
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			matched = append(matched, database)
+		}
+	}
+	return matched, nil
+}
+
+// GetInstanceEngines returns the engines of the instances of the databases, keyed by the instance resource ID.
+func GetInstanceEngines(ctx context.Context, stores *store.Store, databaseList []*store.DatabaseMessage) (map[string]storepb.Engine, error) {
+	engines := make(map[string]storepb.Engine)
+	for _, database := range databaseList {
+		if _, ok := engines[database.InstanceID]; ok {
+			continue
+		}
+		instanceID := database.InstanceID
+		instance, err := stores.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get instance %q", instanceID)
+		}
+		if instance == nil {
+			return nil, errors.Errorf("instance %q not found", instanceID)
+		}
+		engines[instanceID] = instance.Engine
+	}
+	return engines, nil
+}
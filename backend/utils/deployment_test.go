@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetDatabasesMatchingDeploymentExpression(t *testing.T) {
+	a := require.New(t)
+	dbs := []*store.DatabaseMessage{
+		{
+			UID:                    1,
+			InstanceID:             "mysql-prod",
+			EffectiveEnvironmentID: "prod",
+			DatabaseName:           "db1",
+			Metadata:               &storepb.DatabaseMetadata{Labels: map[string]string{"tenant": "bytebase"}},
+		},
+		{
+			UID:                    2,
+			InstanceID:             "pg-prod",
+			EffectiveEnvironmentID: "prod",
+			DatabaseName:           "db2",
+			Metadata:               &storepb.DatabaseMetadata{Labels: map[string]string{"tenant": "acme"}},
+		},
+		{
+			UID:                    3,
+			InstanceID:             "mysql-test",
+			EffectiveEnvironmentID: "test",
+			DatabaseName:           "db3",
+			Metadata:               &storepb.DatabaseMetadata{},
+		},
+	}
+	engines := map[string]storepb.Engine{
+		"mysql-prod": storepb.Engine_MYSQL,
+		"pg-prod":    storepb.Engine_POSTGRES,
+		"mysql-test": storepb.Engine_MYSQL,
+	}
+
+	tests := []struct {
+		expression string
+		want       []int
+		wantErr    bool
+	}{
+		{expression: `resource.environment_id == "prod"`, want: []int{1, 2}},
+		{expression: `resource.engine == "MYSQL"`, want: []int{1, 3}},
+		{expression: `"tenant" in resource.labels && resource.labels["tenant"] == "acme"`, want: []int{2}},
+		{expression: `resource.database_name.startsWith("db") && resource.instance_id != "pg-prod"`, want: []int{1, 3}},
+		{expression: `resource.environment_id`, wantErr: true},
+		{expression: `resource.unknown == "x"`, wantErr: true},
+	}
+	for _, test := range tests {
+		matched, err := GetDatabasesMatchingDeploymentExpression(test.expression, dbs, engines)
+		if test.wantErr {
+			a.Error(err, test.expression)
+			continue
+		}
+		a.NoError(err, test.expression)
+		var got []int
+		for _, database := range matched {
+			got = append(got, database.UID)
+		}
+		a.Equal(test.want, got, test.expression)
+	}
+}
@@ -0,0 +1,279 @@
+package utils
+
+import (
+	"cmp"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// maxIndexColumns is the maximum number of columns of a recommended index.
+	maxIndexColumns = 4
+	// maxIndexNameLength is the shorter maximum identifier length of MySQL and PostgreSQL.
+	maxIndexNameLength = 63
+)
+
+// indexCandidate is the columns of a table that a query could use an index on.
+type indexCandidate struct {
+	schema  string
+	table   string
+	columns []string
+	// equalityCount is the number of the leading columns compared by equality, whose order does not matter.
+	equalityCount int
+}
+
+type indexRecommendation struct {
+	candidate      *indexCandidate
+	tableRowCount  int64
+	fingerprints   []string
+	count          int64
+	totalQueryTime time.Duration
+}
+
+// AdviseWorkloadIndexes recommends the indexes for the slow queries of the database.
+// The columns of a recommended index are the equality and join columns followed by a range column,
+// or the ORDER BY columns if there's no range column. The indexes covered by the existing indexes are skipped.
+func AdviseWorkloadIndexes(engine storepb.Engine, databaseName string, metadata *model.DatabaseMetadata, logs []*v1pb.SlowQueryLog) []*v1pb.IndexRecommendation {
+	stats := map[string]*slowQueryDigestStats{}
+	mergeSlowQueryDigestStats(stats, databaseName, logs)
+
+	recommendations := map[string]*indexRecommendation{}
+	for fingerprint, stat := range stats {
+		usages, err := base.ExtractIndexColumns(engine, fingerprint, databaseName)
+		if err != nil {
+			// The fingerprints may be truncated or not parsable, e.g. the administrator commands.
+			slog.Debug("Failed to extract index columns", slog.String("fingerprint", fingerprint), log.BBError(err))
+			continue
+		}
+		for _, candidate := range getIndexCandidates(databaseName, metadata, usages) {
+			table := getTableMetadata(metadata, candidate.schema, candidate.table)
+			if isIndexCandidateCovered(table.GetProto().GetIndexes(), candidate) {
+				continue
+			}
+			key := fmt.Sprintf("%s.%s(%s)", candidate.schema, candidate.table, strings.Join(candidate.columns, ","))
+			recommendation, ok := recommendations[key]
+			if !ok {
+				recommendation = &indexRecommendation{candidate: candidate, tableRowCount: table.GetRowCount()}
+				recommendations[key] = recommendation
+			}
+			recommendation.fingerprints = append(recommendation.fingerprints, fingerprint)
+			recommendation.count += int64(stat.count)
+			recommendation.totalQueryTime += stat.totalQueryTime
+		}
+	}
+
+	var result []*v1pb.IndexRecommendation
+	for _, recommendation := range mergeIndexRecommendations(recommendations) {
+		candidate := recommendation.candidate
+		slices.Sort(recommendation.fingerprints)
+		result = append(result, &v1pb.IndexRecommendation{
+			Schema:               candidate.schema,
+			Table:                candidate.table,
+			Columns:              candidate.columns,
+			CreateIndexStatement: getCreateIndexStatement(engine, candidate),
+			SqlFingerprints:      recommendation.fingerprints,
+			QueryCount:           recommendation.count,
+			EstimatedBenefit:     durationpb.New(recommendation.totalQueryTime),
+			TableRowCount:        recommendation.tableRowCount,
+		})
+	}
+	slices.SortFunc(result, func(a, b *v1pb.IndexRecommendation) int {
+		if c := cmp.Compare(b.EstimatedBenefit.AsDuration(), a.EstimatedBenefit.AsDuration()); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.CreateIndexStatement, b.CreateIndexStatement)
+	})
+	return result
+}
+
+// getIndexCandidates groups the columns used by the query by the tables of the database.
+// The unqualified columns are resolved to the table having the column, and the ambiguous ones are skipped
+// except for the join columns of USING, which belong to all the tables having them.
+func getIndexCandidates(databaseName string, metadata *model.DatabaseMetadata, usages *base.IndexColumnUsages) []*indexCandidate {
+	type tableColumns struct {
+		schema, table                   string
+		equality, join, ranges, orderBy []string
+	}
+	var tables []*tableColumns
+	getTableColumns := func(schema, table string) *tableColumns {
+		for _, t := range tables {
+			if t.schema == schema && t.table == table {
+				return t
+			}
+		}
+		t := &tableColumns{schema: schema, table: table}
+		tables = append(tables, t)
+		return t
+	}
+
+	for _, usage := range usages.Columns {
+		var resources []base.SchemaResource
+		if usage.Table.Table != "" {
+			resources = append(resources, usage.Table)
+		} else {
+			for _, resource := range usages.Tables {
+				if !slices.Contains(resources, resource) {
+					resources = append(resources, resource)
+				}
+			}
+		}
+		var owners []base.SchemaResource
+		for _, resource := range resources {
+			if resource.Database != databaseName {
+				continue
+			}
+			if table := getTableMetadata(metadata, resource.Schema, resource.Table); table != nil && table.GetColumn(usage.Column) != nil {
+				owners = append(owners, resource)
+			}
+		}
+		if len(owners) == 0 || (len(owners) > 1 && usage.Type != base.IndexColumnUsageJoin) {
+			continue
+		}
+		for _, owner := range owners {
+			t := getTableColumns(owner.Schema, owner.Table)
+			switch usage.Type {
+			case base.IndexColumnUsageEquality:
+				t.equality = append(t.equality, usage.Column)
+			case base.IndexColumnUsageJoin:
+				t.join = append(t.join, usage.Column)
+			case base.IndexColumnUsageRange:
+				t.ranges = append(t.ranges, usage.Column)
+			case base.IndexColumnUsageOrderBy:
+				t.orderBy = append(t.orderBy, usage.Column)
+			}
+		}
+	}
+
+	var candidates []*indexCandidate
+	for _, t := range tables {
+		candidate := &indexCandidate{schema: t.schema, table: t.table}
+		appendColumns := func(columns ...string) {
+			for _, column := range columns {
+				if len(candidate.columns) < maxIndexColumns && !slices.Contains(candidate.columns, column) {
+					candidate.columns = append(candidate.columns, column)
+				}
+			}
+		}
+		appendColumns(t.equality...)
+		appendColumns(t.join...)
+		candidate.equalityCount = len(candidate.columns)
+		// The columns after a range column cannot be used to seek or sort.
+		if len(t.ranges) > 0 {
+			appendColumns(t.ranges[0])
+		} else {
+			appendColumns(t.orderBy...)
+		}
+		if len(candidate.columns) > 0 {
+			candidates = append(candidates, candidate)
+		}
+	}
+	return candidates
+}
+
+func getTableMetadata(metadata *model.DatabaseMetadata, schemaName, tableName string) *model.TableMetadata {
+	schema := metadata.GetSchema(schemaName)
+	if schema == nil {
+		return nil
+	}
+	return schema.GetTable(tableName)
+}
+
+// isIndexCandidateCovered returns true if an existing index starts with the candidate columns,
+// or an existing unique index is on the equality columns which already locate at most one row.
+func isIndexCandidateCovered(indexes []*storepb.IndexMetadata, candidate *indexCandidate) bool {
+	equality := slices.Clone(candidate.columns[:candidate.equalityCount])
+	slices.Sort(equality)
+	for _, index := range indexes {
+		var expressions []string
+		for _, expression := range index.Expressions {
+			expressions = append(expressions, strings.Trim(expression, "`\""))
+		}
+		if index.Unique || index.Primary {
+			if len(expressions) > 0 && !slices.ContainsFunc(expressions, func(expression string) bool {
+				return !slices.Contains(equality, expression)
+			}) {
+				return true
+			}
+		}
+		if len(expressions) < len(candidate.columns) {
+			continue
+		}
+		leading := slices.Clone(expressions[:candidate.equalityCount])
+		slices.Sort(leading)
+		if slices.Equal(leading, equality) && slices.Equal(expressions[candidate.equalityCount:len(candidate.columns)], candidate.columns[candidate.equalityCount:]) {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeIndexRecommendations merges the recommendation into the one on the same table starting with its columns,
+// as the longer index serves the queries of both.
+func mergeIndexRecommendations(recommendations map[string]*indexRecommendation) []*indexRecommendation {
+	var sorted []*indexRecommendation
+	for _, recommendation := range recommendations {
+		sorted = append(sorted, recommendation)
+	}
+	slices.SortFunc(sorted, func(a, b *indexRecommendation) int {
+		if c := cmp.Compare(len(b.candidate.columns), len(a.candidate.columns)); c != 0 {
+			return c
+		}
+		return slices.Compare(a.candidate.columns, b.candidate.columns)
+	})
+
+	var result []*indexRecommendation
+	for _, recommendation := range sorted {
+		candidate := recommendation.candidate
+		i := slices.IndexFunc(result, func(merged *indexRecommendation) bool {
+			return merged.candidate.schema == candidate.schema &&
+				merged.candidate.table == candidate.table &&
+				slices.Equal(merged.candidate.columns[:len(candidate.columns)], candidate.columns)
+		})
+		if i < 0 {
+			result = append(result, recommendation)
+			continue
+		}
+		result[i].fingerprints = append(result[i].fingerprints, recommendation.fingerprints...)
+		result[i].count += recommendation.count
+		result[i].totalQueryTime += recommendation.totalQueryTime
+	}
+	return result
+}
+
+func getCreateIndexStatement(engine storepb.Engine, candidate *indexCandidate) string {
+	name := strings.Join(append([]string{"idx", candidate.table}, candidate.columns...), "_")
+	if len(name) > maxIndexNameLength {
+		name = name[:maxIndexNameLength]
+	}
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB:
+		quote := func(identifier string) string {
+			return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+		}
+		var columns []string
+		for _, column := range candidate.columns {
+			columns = append(columns, quote(column))
+		}
+		return fmt.Sprintf("CREATE INDEX %s ON %s (%s);", quote(name), quote(candidate.table), strings.Join(columns, ", "))
+	default:
+		quote := func(identifier string) string {
+			return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+		}
+		var columns []string
+		for _, column := range candidate.columns {
+			columns = append(columns, quote(column))
+		}
+		return fmt.Sprintf("CREATE INDEX %s ON %s.%s (%s);", quote(name), quote(candidate.schema), quote(candidate.table), strings.Join(columns, ", "))
+	}
+}
@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+)
+
+func TestAdviseWorkloadIndexes(t *testing.T) {
+	a := require.New(t)
+
+	metadata := model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{
+		Name: "db",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Tables: []*storepb.TableMetadata{
+					{
+						Name:     "orders",
+						RowCount: 1000,
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id"}, {Name: "user_id"}, {Name: "status"}, {Name: "created_at"},
+						},
+						Indexes: []*storepb.IndexMetadata{
+							{Name: "PRIMARY", Expressions: []string{"id"}, Primary: true},
+						},
+					},
+					{
+						Name: "users",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id"}, {Name: "email"},
+						},
+						Indexes: []*storepb.IndexMetadata{
+							{Name: "PRIMARY", Expressions: []string{"id"}, Primary: true},
+							{Name: "idx_email", Expressions: []string{"email"}, Unique: true},
+						},
+					},
+				},
+			},
+		},
+	})
+	newLog := func(fingerprint string, count int32, average time.Duration) *v1pb.SlowQueryLog {
+		return &v1pb.SlowQueryLog{
+			Statistics: &v1pb.SlowQueryStatistics{
+				SqlFingerprint:   fingerprint,
+				Count:            count,
+				AverageQueryTime: durationpb.New(average),
+				MaximumQueryTime: durationpb.New(average),
+			},
+		}
+	}
+	logs := []*v1pb.SlowQueryLog{
+		newLog("select * from `orders` where `status` = ? and `created_at` > ?", 10, 2*time.Second),
+		// Served by the index on (status, created_at).
+		newLog("select * from `orders` where `status` = ?", 5, time.Second),
+		// Covered by the existing indexes.
+		newLog("select * from `orders` where `id` = ?", 100, time.Second),
+		newLog("select * from `users` where `email` = ?", 100, time.Second),
+		// The join column of orders is not indexed.
+		newLog("select * from `orders` o join `users` u on o.`user_id` = u.`id` where u.`email` = ?", 2, 3*time.Second),
+		// Not parsable.
+		newLog("administrator command: Ping", 1, time.Second),
+	}
+
+	got := AdviseWorkloadIndexes(storepb.Engine_MYSQL, "db", metadata, logs)
+	a.Len(got, 2)
+
+	a.Equal("orders", got[0].Table)
+	a.Equal([]string{"status", "created_at"}, got[0].Columns)
+	a.Equal("CREATE INDEX `idx_orders_status_created_at` ON `orders` (`status`, `created_at`);", got[0].CreateIndexStatement)
+	a.Equal([]string{
+		"select * from `orders` where `status` = ?",
+		"select * from `orders` where `status` = ? and `created_at` > ?",
+	}, got[0].SqlFingerprints)
+	a.Equal(int64(15), got[0].QueryCount)
+	a.Equal(25*time.Second, got[0].EstimatedBenefit.AsDuration())
+	a.Equal(int64(1000), got[0].TableRowCount)
+
+	a.Equal("orders", got[1].Table)
+	a.Equal([]string{"user_id"}, got[1].Columns)
+	a.Equal(int64(2), got[1].QueryCount)
+	a.Equal(6*time.Second, got[1].EstimatedBenefit.AsDuration())
+}
+
+func TestGetCreateIndexStatement(t *testing.T) {
+	a := require.New(t)
+	candidate := &indexCandidate{schema: "public", table: "orders", columns: []string{"user_id", "createdAt"}}
+	a.Equal(`CREATE INDEX "idx_orders_user_id_createdAt" ON "public"."orders" ("user_id", "createdAt");`, getCreateIndexStatement(storepb.Engine_POSTGRES, candidate))
+	a.Equal("CREATE INDEX `idx_orders_user_id_createdAt` ON `orders` (`user_id`, `createdAt`);", getCreateIndexStatement(storepb.Engine_MYSQL, candidate))
+}
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// GetTaskUndoneBlockingIssues returns the blocking issues of the task issue which are not done.
+// The task is allowed to run only if it returns none.
+func GetTaskUndoneBlockingIssues(ctx context.Context, stores *store.Store, task *store.TaskMessage) ([]*store.IssueMessage, error) {
+	issue, err := stores.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &task.PipelineID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get issue of pipeline %d", task.PipelineID)
+	}
+	if issue == nil {
+		return nil, nil
+	}
+	return GetUndoneBlockingIssues(ctx, stores, issue)
+}
+
+// GetUndoneBlockingIssues returns the blocking issues of the issue which are not done.
+// The canceled blocking issues keep blocking the issue until the dependencies are removed.
+func GetUndoneBlockingIssues(ctx context.Context, stores *store.Store, issue *store.IssueMessage) ([]*store.IssueMessage, error) {
+	var undone []*store.IssueMessage
+	for _, blockingIssueUID := range issue.BlockingIssueUIDs {
+		blockingIssue, err := stores.GetIssueV2(ctx, &store.FindIssueMessage{UID: &blockingIssueUID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get blocking issue %d", blockingIssueUID)
+		}
+		if blockingIssue == nil || blockingIssue.Status == api.IssueDone {
+			continue
+		}
+		undone = append(undone, blockingIssue)
+	}
+	return undone, nil
+}
@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// GetProjectQuota returns the quota of the project, nil if the project is not found.
+func GetProjectQuota(ctx context.Context, stores *store.Store, projectID string) (*storepb.ProjectQuota, error) {
+	project, err := stores.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get project %q", projectID)
+	}
+	if project == nil {
+		return nil, nil
+	}
+	return project.Setting.GetQuota(), nil
+}
+
+// IsTaskRolloutQuotaExceeded returns true if the task rollout is not running and
+// the project already has the maximum concurrent rollouts running.
+// The task is allowed to run only if it returns false.
+func IsTaskRolloutQuotaExceeded(ctx context.Context, stores *store.Store, task *store.TaskMessage) (bool, error) {
+	pipeline, err := stores.GetPipelineV2ByID(ctx, task.PipelineID)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get pipeline %d", task.PipelineID)
+	}
+	if pipeline == nil {
+		return false, nil
+	}
+	quota, err := GetProjectQuota(ctx, stores, pipeline.ProjectID)
+	if err != nil {
+		return false, err
+	}
+	if quota.GetMaxConcurrentRollouts() <= 0 {
+		return false, nil
+	}
+	running, err := stores.ListProjectRunningPipelineUIDs(ctx, pipeline.ProjectID)
+	if err != nil {
+		return false, err
+	}
+	if slices.Contains(running, pipeline.ID) {
+		return false, nil
+	}
+	return len(running) >= int(quota.GetMaxConcurrentRollouts()), nil
+}
+
+// GetRemainingExportRows returns the number of rows the project is allowed to export for the rest of the UTC day.
+// It returns -1 if the export rows are unlimited.
+func GetRemainingExportRows(ctx context.Context, stores *store.Store, projectID string, now time.Time) (int64, error) {
+	quota, err := GetProjectQuota(ctx, stores, projectID)
+	if err != nil {
+		return 0, err
+	}
+	if quota.GetMaxExportedRowsPerDay() <= 0 {
+		return -1, nil
+	}
+	exported, err := stores.GetProjectExportedRowCount(ctx, projectID, StartOfUTCDay(now).Unix())
+	if err != nil {
+		return 0, err
+	}
+	return max(quota.GetMaxExportedRowsPerDay()-exported, 0), nil
+}
+
+// GetRemainingBackupStorageBytes returns the number of bytes the project backups are allowed to take more.
+// It returns -1 if the backup storage is unlimited.
+func GetRemainingBackupStorageBytes(ctx context.Context, stores *store.Store, projectID string) (int64, error) {
+	quota, err := GetProjectQuota(ctx, stores, projectID)
+	if err != nil {
+		return 0, err
+	}
+	if quota.GetMaxBackupStorageBytes() <= 0 {
+		return -1, nil
+	}
+	size, err := stores.GetProjectBackupStorageBytes(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+	return max(quota.GetMaxBackupStorageBytes()-size, 0), nil
+}
+
+// StartOfUTCDay returns the start of the UTC day of the time.
+func StartOfUTCDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
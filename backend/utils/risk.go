@@ -0,0 +1,239 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"reflect"
+
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// PlanCheckRunKey is the key of the latest plan check run for a database.
+type PlanCheckRunKey struct {
+	InstanceUID  int
+	DatabaseName string
+}
+
+// GetPipelineRiskSource concludes the risk source from the task types of the pipeline.
+func GetPipelineRiskSource(pipeline *store.PipelineMessage) store.RiskSource {
+	seenTaskType := map[api.TaskType]bool{}
+	for _, stage := range pipeline.Stages {
+		for _, task := range stage.TaskList {
+			seenTaskType[task.Type] = true
+		}
+	}
+	if seenTaskType[api.TaskDatabaseCreate] {
+		return store.RiskSourceDatabaseCreate
+	}
+	if seenTaskType[api.TaskDatabaseSchemaUpdate] || seenTaskType[api.TaskDatabaseSchemaUpdateSDL] || seenTaskType[api.TaskDatabaseSchemaUpdateGhostSync] {
+		return store.RiskSourceDatabaseSchemaUpdate
+	}
+	if seenTaskType[api.TaskDatabaseDataUpdate] {
+		return store.RiskSourceDatabaseDataUpdate
+	}
+	return store.RiskSourceUnknown
+}
+
+// GetPipelineRiskLevel returns the max risk level of the tasks in the pipeline.
+// The SQL type and the affected rows are taken from the SQL summary reports of the plan check runs,
+// and they are UNKNOWN and math.MaxInt32 if the plan check run of the database is absent.
+func GetPipelineRiskLevel(ctx context.Context, s *store.Store, projectID string, pipeline *store.PipelineMessage, riskSource store.RiskSource, risks []*store.RiskMessage, planCheckRuns map[PlanCheckRunKey]*store.PlanCheckRunMessage) (int32, error) {
+	e, err := cel.NewEnv(common.RiskFactors...)
+	if err != nil {
+		return 0, err
+	}
+
+	var maxRiskLevel int32
+	for _, stage := range pipeline.Stages {
+		for _, task := range stage.TaskList {
+			instance, err := s.GetInstanceV2(ctx, &store.FindInstanceMessage{
+				UID: &task.InstanceID,
+			})
+			if err != nil {
+				return 0, errors.Wrapf(err, "failed to get instance %v", task.InstanceID)
+			}
+			if instance.Deleted {
+				continue
+			}
+
+			environmentID := instance.EnvironmentID
+			var databaseName string
+			databaseLabels := map[string]string{}
+			if task.Type == api.TaskDatabaseCreate {
+				payload := &api.TaskDatabaseCreatePayload{}
+				if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+					return 0, err
+				}
+				databaseName = payload.DatabaseName
+				if payload.EnvironmentID != "" {
+					environmentID = payload.EnvironmentID
+				}
+			} else {
+				database, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+					UID: task.DatabaseID,
+				})
+				if err != nil {
+					return 0, err
+				}
+				databaseName = database.DatabaseName
+				environmentID = database.EffectiveEnvironmentID
+				databaseLabels = GetDatabaseLabels(database)
+			}
+
+			risk, err := func() (int32, error) {
+				for _, risk := range risks {
+					if !risk.Active {
+						continue
+					}
+					if risk.Source != riskSource {
+						continue
+					}
+					if risk.Expression == nil || risk.Expression.Expression == "" {
+						continue
+					}
+					ast, issues := e.Parse(risk.Expression.Expression)
+					if issues != nil && issues.Err() != nil {
+						return 0, errors.Errorf("failed to parse expression: %v", issues.Err())
+					}
+					prg, err := e.Program(ast)
+					if err != nil {
+						return 0, err
+					}
+					args := map[string]any{
+						"environment_id": environmentID,
+						"project_id":     projectID,
+						"database_name":  databaseName,
+						// convert to string type otherwise cel-go will complain that storepb.Engine is not string type.
+						"db_engine":       instance.Engine.String(),
+						"sql_type":        "UNKNOWN",
+						"affected_rows":   math.MaxInt32,
+						"database_labels": databaseLabels,
+					}
+
+					if run, ok := planCheckRuns[PlanCheckRunKey{
+						InstanceUID:  instance.UID,
+						DatabaseName: databaseName,
+					}]; ok {
+						for _, result := range run.Result.Results {
+							report := result.GetSqlSummaryReport()
+							if report == nil {
+								continue
+							}
+							args["affected_rows"] = report.AffectedRows
+							for _, statementType := range report.StatementTypes {
+								args["sql_type"] = statementType
+								res, _, err := prg.Eval(args)
+								if err != nil {
+									return 0, err
+								}
+								val, err := res.ConvertToNative(reflect.TypeOf(false))
+								if err != nil {
+									return 0, errors.Wrap(err, "expect bool result")
+								}
+								if boolVal, ok := val.(bool); ok && boolVal {
+									return risk.Level, nil
+								}
+							}
+						}
+					}
+
+					args["sql_type"] = "UNKNOWN"
+					args["affected_rows"] = math.MaxInt32
+					res, _, err := prg.Eval(args)
+					if err != nil {
+						return 0, err
+					}
+					val, err := res.ConvertToNative(reflect.TypeOf(false))
+					if err != nil {
+						return 0, errors.Wrap(err, "expect bool result")
+					}
+					if boolVal, ok := val.(bool); ok && boolVal {
+						return risk.Level, nil
+					}
+				}
+				return 0, nil
+			}()
+			if err != nil {
+				return 0, errors.Wrapf(err, "failed to evaluate risk expression for risk source %v", riskSource)
+			}
+
+			if maxRiskLevel < risk {
+				maxRiskLevel = risk
+			}
+		}
+	}
+	return maxRiskLevel, nil
+}
+
+// GetDatabaseLabels returns the labels of the database for the risk expressions, an empty map if the database has no labels.
+func GetDatabaseLabels(database *store.DatabaseMessage) map[string]string {
+	labels := database.Metadata.GetLabels()
+	if labels == nil {
+		return map[string]string{}
+	}
+	return labels
+}
+
+// GetApprovalTemplate returns the approval template of the first approval rule matching the risk level and the risk source.
+func GetApprovalTemplate(approvalSetting *storepb.WorkspaceApprovalSetting, riskLevel int32, riskSource store.RiskSource) (*storepb.ApprovalTemplate, error) {
+	e, err := cel.NewEnv(common.ApprovalFactors...)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range approvalSetting.Rules {
+		if rule.Condition == nil || rule.Condition.Expression == "" {
+			continue
+		}
+		ast, issues := e.Compile(rule.Condition.Expression)
+		if issues != nil && issues.Err() != nil {
+			return nil, issues.Err()
+		}
+
+		prg, err := e.Program(ast)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to compile expression")
+		}
+
+		res, _, err := prg.Eval(map[string]any{
+			"level":  riskLevel,
+			"source": int64(convertToRiskSource(riskSource)),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		val, err := res.ConvertToNative(reflect.TypeOf(false))
+		if err != nil {
+			return nil, errors.Wrap(err, "expect bool result")
+		}
+		if boolVal, ok := val.(bool); ok && boolVal {
+			return rule.Template, nil
+		}
+	}
+	return nil, nil
+}
+
+// convertToRiskSource converts the store risk source to the v1 risk source.
+func convertToRiskSource(source store.RiskSource) v1pb.Risk_Source {
+	switch source {
+	case store.RiskSourceDatabaseCreate:
+		return v1pb.Risk_CREATE_DATABASE
+	case store.RiskSourceDatabaseSchemaUpdate:
+		return v1pb.Risk_DDL
+	case store.RiskSourceDatabaseDataUpdate:
+		return v1pb.Risk_DML
+	case store.RiskRequestQuery:
+		return v1pb.Risk_QUERY
+	case store.RiskRequestExport:
+		return v1pb.Risk_EXPORT
+	}
+	return v1pb.Risk_SOURCE_UNSPECIFIED
+}
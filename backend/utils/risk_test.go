@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/expr"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetPipelineRiskSource(t *testing.T) {
+	a := require.New(t)
+	newPipeline := func(taskTypes ...api.TaskType) *store.PipelineMessage {
+		stage := &store.StageMessage{}
+		for _, taskType := range taskTypes {
+			stage.TaskList = append(stage.TaskList, &store.TaskMessage{Type: taskType})
+		}
+		return &store.PipelineMessage{Stages: []*store.StageMessage{stage}}
+	}
+
+	a.Equal(store.RiskSourceUnknown, GetPipelineRiskSource(newPipeline(api.TaskDatabaseBackup)))
+	a.Equal(store.RiskSourceDatabaseDataUpdate, GetPipelineRiskSource(newPipeline(api.TaskDatabaseDataUpdate)))
+	a.Equal(store.RiskSourceDatabaseSchemaUpdate, GetPipelineRiskSource(newPipeline(api.TaskDatabaseDataUpdate, api.TaskDatabaseSchemaUpdate)))
+	a.Equal(store.RiskSourceDatabaseCreate, GetPipelineRiskSource(newPipeline(api.TaskDatabaseSchemaUpdate, api.TaskDatabaseCreate)))
+}
+
+func TestGetApprovalTemplate(t *testing.T) {
+	a := require.New(t)
+	highRiskTemplate := &storepb.ApprovalTemplate{Title: "High risk"}
+	ddlTemplate := &storepb.ApprovalTemplate{Title: "DDL"}
+	approvalSetting := &storepb.WorkspaceApprovalSetting{
+		Rules: []*storepb.WorkspaceApprovalSetting_Rule{
+			{
+				Template:  highRiskTemplate,
+				Condition: &expr.Expr{Expression: "level >= 300"},
+			},
+			{
+				Template:  ddlTemplate,
+				Condition: &expr.Expr{Expression: "source == 1"},
+			},
+		},
+	}
+
+	template, err := GetApprovalTemplate(approvalSetting, 300, store.RiskSourceDatabaseSchemaUpdate)
+	a.NoError(err)
+	a.Equal(highRiskTemplate, template)
+	template, err = GetApprovalTemplate(approvalSetting, 100, store.RiskSourceDatabaseSchemaUpdate)
+	a.NoError(err)
+	a.Equal(ddlTemplate, template)
+	template, err = GetApprovalTemplate(approvalSetting, 100, store.RiskSourceDatabaseDataUpdate)
+	a.NoError(err)
+	a.Nil(template)
+}
+
+func TestRiskExpressionDatabaseLabels(t *testing.T) {
+	a := require.New(t)
+	e, err := cel.NewEnv(common.RiskFactors...)
+	a.NoError(err)
+	ast, issues := e.Compile(`environment_id == "prod" && "tier" in database_labels && database_labels["tier"] == "critical" && affected_rows > 1000`)
+	a.NoError(issues.Err())
+	prg, err := e.Program(ast)
+	a.NoError(err)
+
+	eval := func(database *store.DatabaseMessage) bool {
+		res, _, err := prg.Eval(map[string]any{
+			"environment_id":  "prod",
+			"affected_rows":   5000,
+			"database_labels": GetDatabaseLabels(database),
+		})
+		a.NoError(err)
+		return res.Value().(bool)
+	}
+	a.True(eval(&store.DatabaseMessage{Metadata: &storepb.DatabaseMetadata{Labels: map[string]string{"tier": "critical"}}}))
+	a.False(eval(&store.DatabaseMessage{Metadata: &storepb.DatabaseMetadata{Labels: map[string]string{"tier": "batch"}}}))
+	a.False(eval(&store.DatabaseMessage{}))
+}
@@ -0,0 +1,121 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// GetTaskRolloutPolicy returns the rollout policy of the task environment.
+func GetTaskRolloutPolicy(ctx context.Context, stores *store.Store, task *store.TaskMessage) (*storepb.RolloutPolicy, error) {
+	stages, err := stores.ListStageV2(ctx, task.PipelineID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list stages of pipeline %d", task.PipelineID)
+	}
+	for _, stage := range stages {
+		if stage.ID != task.StageID {
+			continue
+		}
+		policy, err := stores.GetRolloutPolicy(ctx, stage.EnvironmentID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get rollout policy for environment ID %d", stage.EnvironmentID)
+		}
+		return policy, nil
+	}
+	return nil, errors.Errorf("stage %d not found", task.StageID)
+}
+
+// IsTaskRunNow returns whether the task is requested to run now regardless of the rollout window.
+func IsTaskRunNow(task *store.TaskMessage) (bool, error) {
+	payload := struct {
+		RunNow bool `json:"runNow"`
+	}{}
+	if err := json.Unmarshal([]byte(task.Payload), &payload); err != nil {
+		return false, errors.Wrapf(err, "failed to unmarshal task payload")
+	}
+	return payload.RunNow, nil
+}
+
+// NextRolloutStart returns the time when the tasks are allowed to run at or after t by the rollout policy,
+// i.e. the window is open and no freeze is in effect.
+// The t is returned if the tasks are allowed to run at t.
+func NextRolloutStart(policy *storepb.RolloutPolicy, t time.Time) time.Time {
+	// Each iteration moves past a freeze, so it ends after all the freezes are checked.
+	for i := 0; i <= len(policy.GetFreezes()); i++ {
+		if window := policy.GetWindow(); window != nil {
+			t = NextRolloutWindowStart(window, t)
+		}
+		freeze := getRolloutFreeze(policy.GetFreezes(), t)
+		if freeze == nil {
+			return t
+		}
+		t = freeze.EndTime.AsTime()
+	}
+	return t
+}
+
+// getRolloutFreeze returns the freeze in effect at t, or nil if there is none.
+func getRolloutFreeze(freezes []*storepb.RolloutFreeze, t time.Time) *storepb.RolloutFreeze {
+	for _, freeze := range freezes {
+		if !t.Before(freeze.StartTime.AsTime()) && t.Before(freeze.EndTime.AsTime()) {
+			return freeze
+		}
+	}
+	return nil
+}
+
+// NextRolloutWindowStart returns the time when the window opens at or after t.
+// The t is returned if it's in the window.
+func NextRolloutWindowStart(window *storepb.RolloutWindow, t time.Time) time.Time {
+	location := time.UTC
+	if window.TimeZone != "" {
+		// The time zone is validated when updating the policy.
+		if l, err := time.LoadLocation(window.TimeZone); err == nil {
+			location = l
+		}
+	}
+	local := t.In(location)
+	if inRolloutWindow(window, local) {
+		return t
+	}
+	// The window opens within a week if any day of week is valid.
+	for i := 0; i <= 7; i++ {
+		start := time.Date(local.Year(), local.Month(), local.Day()+i, int(window.StartHour), 0, 0, 0, location)
+		if start.After(local) && isRolloutWindowDay(window, start.Weekday()) {
+			return start
+		}
+	}
+	return t
+}
+
+func inRolloutWindow(window *storepb.RolloutWindow, local time.Time) bool {
+	start, end, hour := int(window.StartHour), int(window.EndHour), local.Hour()
+	if start == end {
+		// The window covers the whole day.
+		return isRolloutWindowDay(window, local.Weekday())
+	}
+	if start < end {
+		return start <= hour && hour < end && isRolloutWindowDay(window, local.Weekday())
+	}
+	// The window spans midnight, e.g. 22:00-06:00, the early hours belong to the window of the previous day.
+	if hour >= start {
+		return isRolloutWindowDay(window, local.Weekday())
+	}
+	if hour < end {
+		return isRolloutWindowDay(window, local.AddDate(0, 0, -1).Weekday())
+	}
+	return false
+}
+
+func isRolloutWindowDay(window *storepb.RolloutWindow, weekday time.Weekday) bool {
+	if len(window.DaysOfWeek) == 0 {
+		return true
+	}
+	return slices.Contains(window.DaysOfWeek, int32(weekday))
+}
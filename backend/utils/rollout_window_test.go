@@ -0,0 +1,136 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestNextRolloutWindowStart(t *testing.T) {
+	a := require.New(t)
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	a.NoError(err)
+
+	tests := []struct {
+		window *storepb.RolloutWindow
+		now    time.Time
+		want   time.Time
+	}{
+		{
+			// In the window.
+			window: &storepb.RolloutWindow{StartHour: 1, EndHour: 5},
+			now:    time.Date(2024, 1, 2, 3, 30, 0, 0, time.UTC),
+			want:   time.Date(2024, 1, 2, 3, 30, 0, 0, time.UTC),
+		},
+		{
+			// Before the window of the day.
+			window: &storepb.RolloutWindow{StartHour: 1, EndHour: 5},
+			now:    time.Date(2024, 1, 2, 0, 30, 0, 0, time.UTC),
+			want:   time.Date(2024, 1, 2, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			// After the window of the day.
+			window: &storepb.RolloutWindow{StartHour: 1, EndHour: 5},
+			now:    time.Date(2024, 1, 2, 5, 0, 0, 0, time.UTC),
+			want:   time.Date(2024, 1, 3, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			// The window spans midnight.
+			window: &storepb.RolloutWindow{StartHour: 22, EndHour: 6},
+			now:    time.Date(2024, 1, 2, 2, 0, 0, 0, time.UTC),
+			want:   time.Date(2024, 1, 2, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			window: &storepb.RolloutWindow{StartHour: 22, EndHour: 6},
+			now:    time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+			want:   time.Date(2024, 1, 2, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			// 12:00 in UTC is 20:00 in Asia/Shanghai.
+			window: &storepb.RolloutWindow{TimeZone: "Asia/Shanghai", StartHour: 22, EndHour: 6},
+			now:    time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+			want:   time.Date(2024, 1, 2, 22, 0, 0, 0, shanghai),
+		},
+		{
+			// 2024-01-02 is Tuesday, the window opens on Saturday and Sunday.
+			window: &storepb.RolloutWindow{StartHour: 1, EndHour: 5, DaysOfWeek: []int32{0, 6}},
+			now:    time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC),
+			want:   time.Date(2024, 1, 6, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			// The whole day on Saturday and Sunday.
+			window: &storepb.RolloutWindow{StartHour: 0, EndHour: 0, DaysOfWeek: []int32{0, 6}},
+			now:    time.Date(2024, 1, 7, 23, 0, 0, 0, time.UTC),
+			want:   time.Date(2024, 1, 7, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			// The early hours of Saturday belong to the window starting on Friday.
+			window: &storepb.RolloutWindow{StartHour: 22, EndHour: 6, DaysOfWeek: []int32{5}},
+			now:    time.Date(2024, 1, 6, 2, 0, 0, 0, time.UTC),
+			want:   time.Date(2024, 1, 6, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			window: &storepb.RolloutWindow{StartHour: 22, EndHour: 6, DaysOfWeek: []int32{5}},
+			now:    time.Date(2024, 1, 7, 2, 0, 0, 0, time.UTC),
+			want:   time.Date(2024, 1, 12, 22, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, test := range tests {
+		got := NextRolloutWindowStart(test.window, test.now)
+		a.True(test.want.Equal(got), "window %v at %v: want %v, got %v", test.window, test.now, test.want, got)
+	}
+}
+
+func TestNextRolloutStart(t *testing.T) {
+	a := require.New(t)
+
+	freeze := &storepb.RolloutFreeze{
+		Reason:    "new year",
+		StartTime: timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		EndTime:   timestamppb.New(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)),
+	}
+	tests := []struct {
+		policy *storepb.RolloutPolicy
+		now    time.Time
+		want   time.Time
+	}{
+		{
+			// No window nor freeze.
+			policy: &storepb.RolloutPolicy{},
+			now:    time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC),
+			want:   time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			// The freeze ends.
+			policy: &storepb.RolloutPolicy{Freezes: []*storepb.RolloutFreeze{freeze}},
+			now:    time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC),
+			want:   time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// The window opens after the freeze ends.
+			policy: &storepb.RolloutPolicy{
+				Window:  &storepb.RolloutWindow{StartHour: 1, EndHour: 5},
+				Freezes: []*storepb.RolloutFreeze{freeze},
+			},
+			now:  time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 1, 3, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			// After the freeze.
+			policy: &storepb.RolloutPolicy{
+				Window:  &storepb.RolloutWindow{StartHour: 1, EndHour: 5},
+				Freezes: []*storepb.RolloutFreeze{freeze},
+			},
+			now:  time.Date(2024, 1, 3, 3, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 1, 3, 3, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, test := range tests {
+		got := NextRolloutStart(test.policy, test.now)
+		a.True(test.want.Equal(got), "policy %v at %v: want %v, got %v", test.policy, test.now, test.want, got)
+	}
+}
@@ -0,0 +1,130 @@
+package utils
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// DefaultSlowQueryRegressionFactor is the regression factor of the slow query report if it is not configured.
+const DefaultSlowQueryRegressionFactor = 2.0
+
+type slowQueryDigestStats struct {
+	databases        []string
+	count            int32
+	totalQueryTime   time.Duration
+	maximumQueryTime time.Duration
+}
+
+// GetProjectSlowQueryReport aggregates the slow queries of the project databases by the statement digest,
+// and compares the 7 days until now with the 7 days before.
+func GetProjectSlowQueryReport(ctx context.Context, stores *store.Store, projectID string, now time.Time, factor float64) (*v1pb.SlowQueryReport, error) {
+	// The slow queries are logged by date, so this week ends at the end of today.
+	endTime := now.UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+	startTime := endTime.AddDate(0, 0, -7)
+	previousStartTime := startTime.AddDate(0, 0, -7)
+
+	databases, err := stores.ListDatabases(ctx, &store.FindDatabaseMessage{ProjectID: &projectID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list databases of project %q", projectID)
+	}
+	current, previous := map[string]*slowQueryDigestStats{}, map[string]*slowQueryDigestStats{}
+	for _, database := range databases {
+		instance, err := stores.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get instance %q", database.InstanceID)
+		}
+		if instance == nil {
+			continue
+		}
+		databaseName := common.FormatDatabase(database.InstanceID, database.DatabaseName)
+		for _, window := range []struct {
+			stats      map[string]*slowQueryDigestStats
+			start, end time.Time
+		}{
+			{stats: current, start: startTime, end: endTime},
+			{stats: previous, start: previousStartTime, end: startTime},
+		} {
+			logs, err := stores.ListSlowQuery(ctx, &store.ListSlowQueryMessage{
+				InstanceUID:  &instance.UID,
+				DatabaseUID:  &database.UID,
+				StartLogDate: &window.start,
+				EndLogDate:   &window.end,
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to list slow queries of database %q", databaseName)
+			}
+			mergeSlowQueryDigestStats(window.stats, databaseName, logs)
+		}
+	}
+
+	return &v1pb.SlowQueryReport{
+		Name:             common.FormatProject(projectID) + common.SlowQueryReportSuffix,
+		StartTime:        timestamppb.New(startTime),
+		EndTime:          timestamppb.New(endTime),
+		RegressionFactor: factor,
+		Digests:          buildSlowQueryDigests(current, previous, factor),
+	}, nil
+}
+
+func mergeSlowQueryDigestStats(stats map[string]*slowQueryDigestStats, databaseName string, logs []*v1pb.SlowQueryLog) {
+	for _, log := range logs {
+		statistics := log.Statistics
+		stat, ok := stats[statistics.SqlFingerprint]
+		if !ok {
+			stat = &slowQueryDigestStats{}
+			stats[statistics.SqlFingerprint] = stat
+		}
+		if !slices.Contains(stat.databases, databaseName) {
+			stat.databases = append(stat.databases, databaseName)
+		}
+		stat.count += statistics.Count
+		stat.totalQueryTime += statistics.AverageQueryTime.AsDuration() * time.Duration(statistics.Count)
+		stat.maximumQueryTime = max(stat.maximumQueryTime, statistics.MaximumQueryTime.AsDuration())
+	}
+}
+
+// buildSlowQueryDigests builds the digests of this week, sorted by the regression ratio in descending order.
+func buildSlowQueryDigests(current, previous map[string]*slowQueryDigestStats, factor float64) []*v1pb.SlowQueryDigest {
+	var digests []*v1pb.SlowQueryDigest
+	for fingerprint, stat := range current {
+		if stat.count == 0 {
+			continue
+		}
+		average := stat.totalQueryTime / time.Duration(stat.count)
+		digest := &v1pb.SlowQueryDigest{
+			SqlFingerprint:   fingerprint,
+			Databases:        stat.databases,
+			Count:            stat.count,
+			AverageQueryTime: durationpb.New(average),
+			MaximumQueryTime: durationpb.New(stat.maximumQueryTime),
+		}
+		slices.Sort(digest.Databases)
+		if previousStat, ok := previous[fingerprint]; ok && previousStat.count > 0 {
+			previousAverage := previousStat.totalQueryTime / time.Duration(previousStat.count)
+			digest.PreviousCount = previousStat.count
+			digest.PreviousAverageQueryTime = durationpb.New(previousAverage)
+			if previousAverage > 0 {
+				digest.RegressionRatio = float64(average) / float64(previousAverage)
+			}
+		}
+		digest.Regressed = factor > 0 && digest.RegressionRatio >= factor
+		digests = append(digests, digest)
+	}
+	slices.SortFunc(digests, func(a, b *v1pb.SlowQueryDigest) int {
+		if c := cmp.Compare(b.RegressionRatio, a.RegressionRatio); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.SqlFingerprint, b.SqlFingerprint)
+	})
+	return digests
+}
@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestBuildSlowQueryDigests(t *testing.T) {
+	a := require.New(t)
+
+	current := map[string]*slowQueryDigestStats{}
+	mergeSlowQueryDigestStats(current, "instances/i1/databases/db1", []*v1pb.SlowQueryLog{
+		{Statistics: &v1pb.SlowQueryStatistics{SqlFingerprint: "select ?", Count: 2, AverageQueryTime: durationpb.New(3 * time.Second), MaximumQueryTime: durationpb.New(4 * time.Second)}},
+		{Statistics: &v1pb.SlowQueryStatistics{SqlFingerprint: "update ?", Count: 1, AverageQueryTime: durationpb.New(time.Second), MaximumQueryTime: durationpb.New(time.Second)}},
+	})
+	mergeSlowQueryDigestStats(current, "instances/i1/databases/db0", []*v1pb.SlowQueryLog{
+		{Statistics: &v1pb.SlowQueryStatistics{SqlFingerprint: "select ?", Count: 2, AverageQueryTime: durationpb.New(5 * time.Second), MaximumQueryTime: durationpb.New(6 * time.Second)}},
+		{Statistics: &v1pb.SlowQueryStatistics{SqlFingerprint: "delete ?", Count: 1, AverageQueryTime: durationpb.New(time.Second), MaximumQueryTime: durationpb.New(time.Second)}},
+	})
+	previous := map[string]*slowQueryDigestStats{}
+	mergeSlowQueryDigestStats(previous, "instances/i1/databases/db1", []*v1pb.SlowQueryLog{
+		{Statistics: &v1pb.SlowQueryStatistics{SqlFingerprint: "select ?", Count: 4, AverageQueryTime: durationpb.New(2 * time.Second), MaximumQueryTime: durationpb.New(2 * time.Second)}},
+		{Statistics: &v1pb.SlowQueryStatistics{SqlFingerprint: "update ?", Count: 1, AverageQueryTime: durationpb.New(time.Second), MaximumQueryTime: durationpb.New(time.Second)}},
+	})
+
+	digests := buildSlowQueryDigests(current, previous, 2)
+	a.Len(digests, 3)
+
+	// The select digest is aggregated across the databases, and its average doubled.
+	a.Equal("select ?", digests[0].SqlFingerprint)
+	a.Equal([]string{"instances/i1/databases/db0", "instances/i1/databases/db1"}, digests[0].Databases)
+	a.Equal(int32(4), digests[0].Count)
+	a.Equal(4*time.Second, digests[0].AverageQueryTime.AsDuration())
+	a.Equal(6*time.Second, digests[0].MaximumQueryTime.AsDuration())
+	a.Equal(int32(4), digests[0].PreviousCount)
+	a.Equal(2*time.Second, digests[0].PreviousAverageQueryTime.AsDuration())
+	a.Equal(2.0, digests[0].RegressionRatio)
+	a.True(digests[0].Regressed)
+
+	a.Equal("update ?", digests[1].SqlFingerprint)
+	a.Equal(1.0, digests[1].RegressionRatio)
+	a.False(digests[1].Regressed)
+
+	// The new digest has no previous week to compare with.
+	a.Equal("delete ?", digests[2].SqlFingerprint)
+	a.Zero(digests[2].RegressionRatio)
+	a.Nil(digests[2].PreviousAverageQueryTime)
+	a.False(digests[2].Regressed)
+
+	// The zero factor disables the regression detection.
+	for _, digest := range buildSlowQueryDigests(current, previous, 0) {
+		a.False(digest.Regressed)
+	}
+}
@@ -14,13 +14,16 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/cel-go/cel"
 	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/type/expr"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/common/tracing"
 	"github.com/bytebase/bytebase/backend/component/activity"
 	"github.com/bytebase/bytebase/backend/component/state"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
@@ -100,7 +103,8 @@ func isMatchExpressions(labels map[string]string, expressionList []*api.LabelSel
 
 // GetDatabaseMatrixFromDeploymentSchedule gets a pipeline based on deployment schedule.
 // The matrix will include the stage even if the stage has no database.
-func GetDatabaseMatrixFromDeploymentSchedule(schedule *api.DeploymentSchedule, databaseList []*store.DatabaseMessage) ([][]*store.DatabaseMessage, error) {
+// engines maps the instance resource ID to the engine of the instance, it's used by the deployment expressions.
+func GetDatabaseMatrixFromDeploymentSchedule(schedule *api.DeploymentSchedule, databaseList []*store.DatabaseMessage, engines map[string]storepb.Engine) ([][]*store.DatabaseMessage, error) {
 	var matrix [][]*store.DatabaseMessage
 
 	// idToLabels maps databaseID -> label key -> label value
@@ -122,6 +126,14 @@ func GetDatabaseMatrixFromDeploymentSchedule(schedule *api.DeploymentSchedule, d
 
 	// For each stage, we loop over all databases to see if it is a match.
 	for _, deployment := range schedule.Deployments {
+		var prg cel.Program
+		if deployment.Spec.Expression != "" {
+			p, err := NewDeploymentProgram(deployment.Spec.Expression)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid expression for deployment %q", deployment.Name)
+			}
+			prg = p
+		}
 		// For each stage, we will get a list of matched databases.
 		var matchedDatabaseList []int
 		// Loop over databaseList instead of idToLabels to get determinant results.
@@ -135,6 +147,17 @@ func GetDatabaseMatrixFromDeploymentSchedule(schedule *api.DeploymentSchedule, d
 				continue
 			}
 
+			if prg != nil {
+				match, err := isMatchDeploymentProgram(prg, database, engines[database.InstanceID])
+				if err != nil {
+					return nil, err
+				}
+				if match {
+					matchedDatabaseList = append(matchedDatabaseList, database.UID)
+					idsSeen[database.UID] = true
+				}
+				continue
+			}
 			if isMatchExpressions(idToLabels[database.UID], deployment.Spec.Selector.MatchExpressions) {
 				matchedDatabaseList = append(matchedDatabaseList, database.UID)
 				idsSeen[database.UID] = true
@@ -235,7 +258,10 @@ func ExecuteMigrationWithFunc(ctx context.Context, driverCtx context.Context, s
 	// Don't record schema if the database hasn't existed yet or is schemaless, e.g. MongoDB.
 	// For baseline migration, we also record the live schema to detect the schema drift.
 	// See https://bytebase.com/blog/what-is-database-schema-drift
-	if _, err := driver.Dump(ctx, &prevSchemaBuf, true /* schemaOnly */); err != nil {
+	dumpCtx, span := db.StartSpan(ctx, driver, "Dump", "")
+	_, err := driver.Dump(dumpCtx, &prevSchemaBuf, true /* schemaOnly */)
+	tracing.End(span, err)
+	if err != nil {
 		return "", "", err
 	}
 
@@ -289,7 +315,11 @@ func ExecuteMigrationWithFunc(ctx context.Context, driverCtx context.Context, s
 				})
 		}
 
-		if err := execFunc(driverCtx, renderedStatement); err != nil {
+		// The digest of the original statement is recorded, the rendered statement may contain the secrets.
+		execCtx, span := db.StartSpan(driverCtx, driver, "Execute", statement)
+		err := execFunc(execCtx, renderedStatement)
+		tracing.End(span, err)
+		if err != nil {
 			return "", "", err
 		}
 	}
@@ -304,7 +334,10 @@ func ExecuteMigrationWithFunc(ctx context.Context, driverCtx context.Context, s
 
 	// Phase 4 - Dump the schema after migration
 	var afterSchemaBuf bytes.Buffer
-	if _, err := driver.Dump(ctx, &afterSchemaBuf, true /* schemaOnly */); err != nil {
+	dumpCtx, span = db.StartSpan(ctx, driver, "Dump", "")
+	_, err = driver.Dump(dumpCtx, &afterSchemaBuf, true /* schemaOnly */)
+	tracing.End(span, err)
+	if err != nil {
 		// We will ignore the dump error if the database is dropped.
 		if strings.Contains(err.Error(), "not found") {
 			return insertedID, "", nil
@@ -504,20 +537,55 @@ func handleApprovalNodeExternalNode(ctx context.Context, s *store.Store, relayCl
 	if node == nil {
 		return errors.Errorf("external approval node %s not found", externalNodeID)
 	}
-	id, err := relayClient.Create(node.Endpoint, &relay.CreatePayload{
+	if node.Mode == storepb.ExternalApprovalSetting_Node_JIRA {
+		payload, err := json.Marshal(&api.ExternalApprovalPayloadJira{
+			ExternalApprovalNodeID: node.Id,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal external approval payload")
+		}
+		if _, err := s.CreateExternalApprovalV2(ctx, &store.ExternalApprovalMessage{
+			IssueUID:     issue.UID,
+			ApproverUID:  api.SystemBotID,
+			Type:         api.ExternalApprovalTypeJira,
+			Payload:      string(payload),
+			RequesterUID: api.SystemBotID,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to create external approval")
+		}
+		return nil
+	}
+	createPayload := &relay.CreatePayload{
 		IssueID:     fmt.Sprintf("%d", issue.UID),
 		Title:       issue.Title,
 		Description: issue.Description,
 		Project:     issue.Project.ResourceID,
 		CreateTime:  issue.CreatedTime,
 		Creator:     issue.Creator.Email,
-	})
+	}
+	var callbackToken string
+	if node.Mode == storepb.ExternalApprovalSetting_Node_CALLBACK {
+		setting, err := s.GetWorkspaceGeneralSetting(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get workspace general setting")
+		}
+		if setting.ExternalUrl == "" {
+			return errors.Errorf("external URL is required for the callback of external approval node %s", externalNodeID)
+		}
+		callbackToken, err = common.RandomString(32)
+		if err != nil {
+			return errors.Wrapf(err, "failed to generate callback token")
+		}
+		createPayload.CallbackURL = fmt.Sprintf("%s/hook/approval/%s", setting.ExternalUrl, callbackToken)
+	}
+	id, err := relayClient.Create(node.Endpoint, createPayload)
 	if err != nil {
 		return errors.Wrapf(err, "failed to create external approval")
 	}
 	payload, err := json.Marshal(&api.ExternalApprovalPayloadRelay{
 		ExternalApprovalNodeID: node.Id,
 		ID:                     id,
+		CallbackToken:          callbackToken,
 	})
 	if err != nil {
 		return errors.Wrapf(err, "failed to marshal external approval payload")
@@ -540,10 +608,22 @@ func UpdateProjectPolicyFromGrantIssue(ctx context.Context, stores *store.Store,
 	if err != nil {
 		return err
 	}
-	var newConditionExpr string
-	if grantRequest.Condition != nil {
-		newConditionExpr = grantRequest.Condition.Expression
+	condition := grantRequest.Condition
+	if condition == nil {
+		condition = &expr.Expr{}
+	}
+	roleID := api.Role(strings.TrimPrefix(grantRequest.Role, "roles/"))
+	// The privileged DML access is granted just in time, so it must expire.
+	if roleID == api.ProjectDMLExecutor {
+		expireTime, err := common.GetExpirationTime(condition.Expression)
+		if err != nil {
+			return err
+		}
+		if expireTime == nil {
+			condition.Expression = common.AppendExpiration(condition.Expression, time.Now().Add(grantRequest.Expiration.AsDuration()))
+		}
 	}
+	newConditionExpr := condition.Expression
 	updated := false
 
 	userID, err := strconv.Atoi(strings.TrimPrefix(grantRequest.User, "users/"))
@@ -573,9 +653,7 @@ func UpdateProjectPolicyFromGrantIssue(ctx context.Context, stores *store.Store,
 		updated = true
 		break
 	}
-	roleID := api.Role(strings.TrimPrefix(grantRequest.Role, "roles/"))
 	if !updated {
-		condition := grantRequest.Condition
 		condition.Description = fmt.Sprintf("#%d", issue.UID)
 		policy.Bindings = append(policy.Bindings, &store.PolicyBinding{
 			Role:      roleID,
@@ -696,7 +774,9 @@ func ConvertVcsPushEvent(pushEvent *vcs.PushEvent) *storepb.PushEvent {
 }
 
 // GetMatchedAndUnmatchedDatabasesInDatabaseGroup returns the matched and unmatched databases in the given database group.
-func GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx context.Context, databaseGroup *store.DatabaseGroupMessage, allDatabases []*store.DatabaseMessage) ([]*store.DatabaseMessage, []*store.DatabaseMessage, error) {
+// The membership is evaluated against the current database name, labels, engine and environment,
+// so the databases created or relabeled by the sync join or leave the group without updating it.
+func GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx context.Context, stores *store.Store, databaseGroup *store.DatabaseGroupMessage, allDatabases []*store.DatabaseMessage) ([]*store.DatabaseMessage, []*store.DatabaseMessage, error) {
 	prog, err := common.ValidateGroupCELExpr(databaseGroup.Expression.Expression)
 	if err != nil {
 		return nil, nil, err
@@ -704,25 +784,26 @@ func GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx context.Context, databas
 	var matches []*store.DatabaseMessage
 	var unmatches []*store.DatabaseMessage
 
+	engines := map[string]string{}
 	// DONOT check bb.feature.database-grouping for instance. The API here is read-only in the frontend, we need to show if the instance is matched but missing required license.
 	// The feature guard will works during issue creation.
 	for _, database := range allDatabases {
-		res, _, err := prog.ContextEval(ctx, map[string]any{
-			"resource": map[string]any{
-				"database_name":    database.DatabaseName,
-				"environment_name": fmt.Sprintf("%s%s", common.EnvironmentNamePrefix, database.EffectiveEnvironmentID),
-				"instance_id":      database.InstanceID,
-			},
-		})
-		if err != nil {
-			return nil, nil, status.Errorf(codes.Internal, err.Error())
+		engine, ok := engines[database.InstanceID]
+		if !ok {
+			instance, err := stores.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+			if err != nil {
+				return nil, nil, status.Errorf(codes.Internal, "failed to get instance %q, error: %v", database.InstanceID, err)
+			}
+			if instance != nil {
+				engine = instance.Engine.String()
+			}
+			engines[database.InstanceID] = engine
 		}
-
-		val, err := res.ConvertToNative(reflect.TypeOf(false))
+		matched, err := matchDatabaseGroup(ctx, prog, database, engine)
 		if err != nil {
-			return nil, nil, status.Errorf(codes.Internal, "expect bool result")
+			return nil, nil, err
 		}
-		if boolVal, ok := val.(bool); ok && boolVal {
+		if matched {
 			matches = append(matches, database)
 		} else {
 			unmatches = append(unmatches, database)
@@ -731,6 +812,33 @@ func GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx context.Context, databas
 	return matches, unmatches, nil
 }
 
+func matchDatabaseGroup(ctx context.Context, prog cel.Program, database *store.DatabaseMessage, engine string) (bool, error) {
+	labels := database.Metadata.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	res, _, err := prog.ContextEval(ctx, map[string]any{
+		"resource": map[string]any{
+			"database_name":    database.DatabaseName,
+			"environment_name": fmt.Sprintf("%s%s", common.EnvironmentNamePrefix, database.EffectiveEnvironmentID),
+			"instance_id":      database.InstanceID,
+			"engine":           engine,
+			"labels":           labels,
+		},
+	})
+	if err != nil {
+		// The expression fails on the databases without the referenced labels, e.g. resource.labels.region == "eu".
+		return false, nil
+	}
+
+	val, err := res.ConvertToNative(reflect.TypeOf(false))
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "expect bool result")
+	}
+	boolVal, ok := val.(bool)
+	return ok && boolVal, nil
+}
+
 // GetMatchedAndUnmatchedTablesInSchemaGroup returns the matched and unmatched tables in the given schema group.
 func GetMatchedAndUnmatchedTablesInSchemaGroup(ctx context.Context, dbSchema *model.DBSchema, schemaGroup *store.SchemaGroupMessage) ([]string, []string, error) {
 	prog, err := common.ValidateGroupCELExpr(schemaGroup.Expression.Expression)
@@ -1,13 +1,19 @@
 package utils
 
 import (
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/bytebase/bytebase/backend/common"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
@@ -271,7 +277,7 @@ func TestGetDatabaseMatrixFromDeploymentSchedule(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		matrix, _ := GetDatabaseMatrixFromDeploymentSchedule(test.schedule, test.databaseList)
+		matrix, _ := GetDatabaseMatrixFromDeploymentSchedule(test.schedule, test.databaseList, nil /* engines */)
 		assert.Equal(t, matrix, test.want, test.name)
 	}
 }
@@ -432,3 +438,74 @@ func TestGetRenderedStatement(t *testing.T) {
 		assert.Equal(t, tc.expected, actual)
 	}
 }
+
+func TestCheckChangeHistoryIntegrity(t *testing.T) {
+	a := require.New(t)
+	newChangeHistory := func(sequence int64, version string, status db.MigrationStatus, statement string, checksum string) *store.InstanceChangeHistoryMessage {
+		return &store.InstanceChangeHistoryMessage{
+			UID:       strconv.FormatInt(sequence, 10),
+			Sequence:  sequence,
+			Status:    status,
+			Version:   model.Version{Version: version},
+			Statement: statement,
+			Payload:   &storepb.InstanceChangeHistoryPayload{StatementSha256: checksum},
+		}
+	}
+
+	checkpoint := &ChangeHistoryIntegrityCheckpoint{}
+	violations, done, err := checkChangeHistoryIntegrity([]*store.InstanceChangeHistoryMessage{
+		newChangeHistory(101, "20240101", db.Done, "CREATE TABLE t(id INT);", common.SHA256Hex("CREATE TABLE t(id INT);")),
+		// The legacy change history has no checksum.
+		newChangeHistory(102, "20240102", db.Done, "ALTER TABLE t ADD COLUMN a INT;", ""),
+		// The statement is tampered.
+		newChangeHistory(103, "20240103", db.Done, "DROP TABLE t;", common.SHA256Hex("ALTER TABLE t ADD COLUMN b INT;")),
+		// The failed change history is not counted in the version ordering.
+		newChangeHistory(104, "20230101", db.Failed, "SELECT 1;", common.SHA256Hex("SELECT 1;")),
+		// The version is out of order.
+		newChangeHistory(105, "20240102", db.Done, "SELECT 2;", common.SHA256Hex("SELECT 2;")),
+		newChangeHistory(106, "20240104", db.Done, "SELECT 3;", common.SHA256Hex("SELECT 3;")),
+		// The checkpoint stops before the pending change history.
+		newChangeHistory(107, "20240105", db.Pending, "SELECT 4;", common.SHA256Hex("SELECT 4;")),
+		newChangeHistory(108, "20240106", db.Done, "SELECT 5;", common.SHA256Hex("SELECT 5;")),
+	}, checkpoint)
+	a.NoError(err)
+	a.True(done)
+	a.Len(violations, 2)
+	a.Equal("103", violations[0].ChangeHistoryUID)
+	a.Equal(api.ChangeHistoryIntegrityChecksumMismatch, violations[0].Type)
+	a.Equal("105", violations[1].ChangeHistoryUID)
+	a.Equal(api.ChangeHistoryIntegrityOutOfOrderVersion, violations[1].Type)
+	a.Equal(int64(106), checkpoint.Sequence)
+
+	// The next check continues from the checkpoint, and the versions are compared with the ones checked before.
+	violations, done, err = checkChangeHistoryIntegrity([]*store.InstanceChangeHistoryMessage{
+		newChangeHistory(107, "20240105", db.Done, "SELECT 4;", common.SHA256Hex("SELECT 4;")),
+		newChangeHistory(108, "20240106", db.Done, "SELECT 5;", common.SHA256Hex("SELECT 5;")),
+		newChangeHistory(109, "20240103", db.Done, "SELECT 6;", common.SHA256Hex("SELECT 6;")),
+	}, checkpoint)
+	a.NoError(err)
+	a.False(done)
+	a.Len(violations, 1)
+	a.Equal("109", violations[0].ChangeHistoryUID)
+	a.Equal(api.ChangeHistoryIntegrityOutOfOrderVersion, violations[0].Type)
+	a.Equal(int64(109), checkpoint.Sequence)
+}
+
+func TestGetDatabaseFreeze(t *testing.T) {
+	a := require.New(t)
+	newDatabase := func(freeze *storepb.DatabaseFreeze) *store.DatabaseMessage {
+		return &store.DatabaseMessage{Metadata: &storepb.DatabaseMetadata{Freeze: freeze}}
+	}
+
+	a.Nil(GetDatabaseFreeze(&store.DatabaseMessage{}))
+	a.Nil(GetDatabaseFreeze(newDatabase(nil)))
+
+	freeze := &storepb.DatabaseFreeze{Reason: "incident"}
+	a.Equal(freeze, GetDatabaseFreeze(newDatabase(freeze)))
+
+	freeze = &storepb.DatabaseFreeze{Reason: "incident", ExpireTime: timestamppb.New(time.Now().Add(time.Hour))}
+	a.Equal(freeze, GetDatabaseFreeze(newDatabase(freeze)))
+
+	freeze = &storepb.DatabaseFreeze{Reason: "incident", ExpireTime: timestamppb.New(time.Now().Add(-time.Hour))}
+	a.Nil(GetDatabaseFreeze(newDatabase(freeze)))
+}
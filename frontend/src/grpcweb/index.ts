@@ -32,6 +32,7 @@ import { SubscriptionServiceDefinition } from "@/types/proto/v1/subscription_ser
 import {
   authInterceptorMiddleware,
   errorNotificationMiddleware,
+  localeMiddleware,
 } from "./middlewares";
 
 // Create each grpc service client.
@@ -54,7 +55,8 @@ const clientFactory = createClientFactory()
   // A middleware that is attached first, will be invoked last.
   .use(authInterceptorMiddleware)
   .use(errorDetailsClientMiddleware)
-  .use(errorNotificationMiddleware);
+  .use(errorNotificationMiddleware)
+  .use(localeMiddleware);
 /**
  * Example to use error notification middleware.
  * Errors occurs during all requests will cause UI notifications automatically.
@@ -1,2 +1,3 @@
 export * from "./authInterceptorMiddleware";
 export * from "./errorNotificationMiddleware";
+export * from "./localeMiddleware";
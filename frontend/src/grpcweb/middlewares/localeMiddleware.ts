@@ -0,0 +1,18 @@
+import { Metadata } from "nice-grpc-common";
+import { ClientMiddleware } from "nice-grpc-web";
+import { curLocale } from "@/plugins/i18n";
+
+/**
+ * Sends the locale selected by the user as the Accept-Language header,
+ * so the server returns the SQL review findings and task details in the locale.
+ */
+export const localeMiddleware: ClientMiddleware = async function* (
+  call,
+  options
+) {
+  const metadata = Metadata(options.metadata);
+  if (!metadata.has("accept-language")) {
+    metadata.set("accept-language", curLocale.value);
+  }
+  return yield* call.next(call.request, { ...options, metadata });
+};
@@ -101,6 +101,7 @@ export type ProjectPermission =
   | "bb.slowQueries.list"
   | "bb.taskRuns.list"
   | "bb.tasks.run"
+  | "bb.tasks.runNow"
   | "bb.tasks.skip";
 
 export type Permission = WorkspacePermission | ProjectPermission;
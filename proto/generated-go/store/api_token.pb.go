@@ -0,0 +1,227 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: store/api_token.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type APITokenPayload_Scope int32
+
+const (
+	APITokenPayload_SCOPE_UNSPECIFIED APITokenPayload_Scope = 0
+	// ALL allows all the methods the owner of the token is permitted.
+	APITokenPayload_ALL APITokenPayload_Scope = 1
+	// READ_ONLY allows the get and list methods.
+	APITokenPayload_READ_ONLY APITokenPayload_Scope = 2
+	// SQL_CHECK allows checking the SQL statements.
+	APITokenPayload_SQL_CHECK APITokenPayload_Scope = 3
+	// ISSUE_CREATE allows creating the sheets, plans and issues.
+	APITokenPayload_ISSUE_CREATE APITokenPayload_Scope = 4
+)
+
+// Enum value maps for APITokenPayload_Scope.
+var (
+	APITokenPayload_Scope_name = map[int32]string{
+		0: "SCOPE_UNSPECIFIED",
+		1: "ALL",
+		2: "READ_ONLY",
+		3: "SQL_CHECK",
+		4: "ISSUE_CREATE",
+	}
+	APITokenPayload_Scope_value = map[string]int32{
+		"SCOPE_UNSPECIFIED": 0,
+		"ALL":               1,
+		"READ_ONLY":         2,
+		"SQL_CHECK":         3,
+		"ISSUE_CREATE":      4,
+	}
+)
+
+func (x APITokenPayload_Scope) Enum() *APITokenPayload_Scope {
+	p := new(APITokenPayload_Scope)
+	*p = x
+	return p
+}
+
+func (x APITokenPayload_Scope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (APITokenPayload_Scope) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_api_token_proto_enumTypes[0].Descriptor()
+}
+
+func (APITokenPayload_Scope) Type() protoreflect.EnumType {
+	return &file_store_api_token_proto_enumTypes[0]
+}
+
+func (x APITokenPayload_Scope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use APITokenPayload_Scope.Descriptor instead.
+func (APITokenPayload_Scope) EnumDescriptor() ([]byte, []int) {
+	return file_store_api_token_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type APITokenPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// scopes are the scopes of the token. A method is allowed if any of the scopes allows it.
+	Scopes []APITokenPayload_Scope `protobuf:"varint,1,rep,packed,name=scopes,proto3,enum=bytebase.store.APITokenPayload_Scope" json:"scopes,omitempty"`
+	// projects are the IDs of the projects the token is restricted to.
+	// The token is not restricted to any project if empty.
+	Projects []string `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects,omitempty"`
+}
+
+func (x *APITokenPayload) Reset() {
+	*x = APITokenPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_api_token_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APITokenPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APITokenPayload) ProtoMessage() {}
+
+func (x *APITokenPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_api_token_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APITokenPayload.ProtoReflect.Descriptor instead.
+func (*APITokenPayload) Descriptor() ([]byte, []int) {
+	return file_store_api_token_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *APITokenPayload) GetScopes() []APITokenPayload_Scope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APITokenPayload) GetProjects() []string {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+var File_store_api_token_proto protoreflect.FileDescriptor
+
+var file_store_api_token_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x0f, 0x41, 0x50, 0x49, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3d, 0x0a, 0x06, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x50, 0x49,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x57, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12,
+	0x15, 0x0a, 0x11, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12,
+	0x0d, 0x0a, 0x09, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x02, 0x12, 0x0d,
+	0x0a, 0x09, 0x53, 0x51, 0x4c, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x10, 0x0a,
+	0x0c, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x04, 0x42,
+	0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_store_api_token_proto_rawDescOnce sync.Once
+	file_store_api_token_proto_rawDescData = file_store_api_token_proto_rawDesc
+)
+
+func file_store_api_token_proto_rawDescGZIP() []byte {
+	file_store_api_token_proto_rawDescOnce.Do(func() {
+		file_store_api_token_proto_rawDescData = protoimpl.X.CompressGZIP(file_store_api_token_proto_rawDescData)
+	})
+	return file_store_api_token_proto_rawDescData
+}
+
+var file_store_api_token_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_store_api_token_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_api_token_proto_goTypes = []interface{}{
+	(APITokenPayload_Scope)(0), // 0: bytebase.store.APITokenPayload.Scope
+	(*APITokenPayload)(nil),    // 1: bytebase.store.APITokenPayload
+}
+var file_store_api_token_proto_depIdxs = []int32{
+	0, // 0: bytebase.store.APITokenPayload.scopes:type_name -> bytebase.store.APITokenPayload.Scope
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_store_api_token_proto_init() }
+func file_store_api_token_proto_init() {
+	if File_store_api_token_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_store_api_token_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APITokenPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_store_api_token_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_api_token_proto_goTypes,
+		DependencyIndexes: file_store_api_token_proto_depIdxs,
+		EnumInfos:         file_store_api_token_proto_enumTypes,
+		MessageInfos:      file_store_api_token_proto_msgTypes,
+	}.Build()
+	File_store_api_token_proto = out.File
+	file_store_api_token_proto_rawDesc = nil
+	file_store_api_token_proto_goTypes = nil
+	file_store_api_token_proto_depIdxs = nil
+}
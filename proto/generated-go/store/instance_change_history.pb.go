@@ -27,6 +27,11 @@ type InstanceChangeHistoryPayload struct {
 
 	PushEvent        *PushEvent        `protobuf:"bytes,1,opt,name=push_event,json=pushEvent,proto3" json:"push_event,omitempty"`
 	ChangedResources *ChangedResources `protobuf:"bytes,2,opt,name=changed_resources,json=changedResources,proto3" json:"changed_resources,omitempty"`
+	// The hex-encoded SHA-256 checksum of the statement when the change is applied.
+	// It's used to detect the statement being tampered afterwards.
+	StatementSha256 string `protobuf:"bytes,3,opt,name=statement_sha256,json=statementSha256,proto3" json:"statement_sha256,omitempty"`
+	// The checkpoint of the resumable migration.
+	StatementCheckpoint *StatementCheckpoint `protobuf:"bytes,4,opt,name=statement_checkpoint,json=statementCheckpoint,proto3" json:"statement_checkpoint,omitempty"`
 }
 
 func (x *InstanceChangeHistoryPayload) Reset() {
@@ -75,6 +80,68 @@ func (x *InstanceChangeHistoryPayload) GetChangedResources() *ChangedResources {
 	return nil
 }
 
+func (x *InstanceChangeHistoryPayload) GetStatementSha256() string {
+	if x != nil {
+		return x.StatementSha256
+	}
+	return ""
+}
+
+func (x *InstanceChangeHistoryPayload) GetStatementCheckpoint() *StatementCheckpoint {
+	if x != nil {
+		return x.StatementCheckpoint
+	}
+	return nil
+}
+
+type StatementCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hex-encoded SHA-256 checksums of the committed statements in the execution order.
+	StatementSha256S []string `protobuf:"bytes,1,rep,name=statement_sha256s,json=statementSha256s,proto3" json:"statement_sha256s,omitempty"`
+}
+
+func (x *StatementCheckpoint) Reset() {
+	*x = StatementCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_instance_change_history_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatementCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatementCheckpoint) ProtoMessage() {}
+
+func (x *StatementCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_change_history_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatementCheckpoint.ProtoReflect.Descriptor instead.
+func (*StatementCheckpoint) Descriptor() ([]byte, []int) {
+	return file_store_instance_change_history_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StatementCheckpoint) GetStatementSha256S() []string {
+	if x != nil {
+		return x.StatementSha256S
+	}
+	return nil
+}
+
 type ChangedResources struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -86,7 +153,7 @@ type ChangedResources struct {
 func (x *ChangedResources) Reset() {
 	*x = ChangedResources{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_instance_change_history_proto_msgTypes[1]
+		mi := &file_store_instance_change_history_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -99,7 +166,7 @@ func (x *ChangedResources) String() string {
 func (*ChangedResources) ProtoMessage() {}
 
 func (x *ChangedResources) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_change_history_proto_msgTypes[1]
+	mi := &file_store_instance_change_history_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -112,7 +179,7 @@ func (x *ChangedResources) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangedResources.ProtoReflect.Descriptor instead.
 func (*ChangedResources) Descriptor() ([]byte, []int) {
-	return file_store_instance_change_history_proto_rawDescGZIP(), []int{1}
+	return file_store_instance_change_history_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ChangedResources) GetDatabases() []*ChangedResourceDatabase {
@@ -134,7 +201,7 @@ type ChangedResourceDatabase struct {
 func (x *ChangedResourceDatabase) Reset() {
 	*x = ChangedResourceDatabase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_instance_change_history_proto_msgTypes[2]
+		mi := &file_store_instance_change_history_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -147,7 +214,7 @@ func (x *ChangedResourceDatabase) String() string {
 func (*ChangedResourceDatabase) ProtoMessage() {}
 
 func (x *ChangedResourceDatabase) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_change_history_proto_msgTypes[2]
+	mi := &file_store_instance_change_history_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -160,7 +227,7 @@ func (x *ChangedResourceDatabase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangedResourceDatabase.ProtoReflect.Descriptor instead.
 func (*ChangedResourceDatabase) Descriptor() ([]byte, []int) {
-	return file_store_instance_change_history_proto_rawDescGZIP(), []int{2}
+	return file_store_instance_change_history_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ChangedResourceDatabase) GetName() string {
@@ -189,7 +256,7 @@ type ChangedResourceSchema struct {
 func (x *ChangedResourceSchema) Reset() {
 	*x = ChangedResourceSchema{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_instance_change_history_proto_msgTypes[3]
+		mi := &file_store_instance_change_history_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -202,7 +269,7 @@ func (x *ChangedResourceSchema) String() string {
 func (*ChangedResourceSchema) ProtoMessage() {}
 
 func (x *ChangedResourceSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_change_history_proto_msgTypes[3]
+	mi := &file_store_instance_change_history_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,7 +282,7 @@ func (x *ChangedResourceSchema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangedResourceSchema.ProtoReflect.Descriptor instead.
 func (*ChangedResourceSchema) Descriptor() ([]byte, []int) {
-	return file_store_instance_change_history_proto_rawDescGZIP(), []int{3}
+	return file_store_instance_change_history_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ChangedResourceSchema) GetName() string {
@@ -243,7 +310,7 @@ type ChangedResourceTable struct {
 func (x *ChangedResourceTable) Reset() {
 	*x = ChangedResourceTable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_instance_change_history_proto_msgTypes[4]
+		mi := &file_store_instance_change_history_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -256,7 +323,7 @@ func (x *ChangedResourceTable) String() string {
 func (*ChangedResourceTable) ProtoMessage() {}
 
 func (x *ChangedResourceTable) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_change_history_proto_msgTypes[4]
+	mi := &file_store_instance_change_history_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,7 +336,7 @@ func (x *ChangedResourceTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangedResourceTable.ProtoReflect.Descriptor instead.
 func (*ChangedResourceTable) Descriptor() ([]byte, []int) {
-	return file_store_instance_change_history_proto_rawDescGZIP(), []int{4}
+	return file_store_instance_change_history_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ChangedResourceTable) GetName() string {
@@ -286,7 +353,7 @@ var file_store_instance_change_history_proto_rawDesc = []byte{
 	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
 	0x73, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x0f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x63, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x01, 0x0a, 0x1c, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaa, 0x02, 0x0a, 0x1c, 0x49, 0x6e, 0x73, 0x74, 0x61,
 	0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
 	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x75, 0x73, 0x68, 0x5f,
 	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x79,
@@ -297,30 +364,43 @@ var file_store_instance_change_history_proto_rawDesc = []byte{
 	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x68,
 	0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x10,
 	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x22, 0x59, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x22, 0x6e, 0x0a, 0x17, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x52, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x22, 0x69, 0x0a, 0x15, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x06,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d,
-	0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x29, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x56, 0x0a, 0x14, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x13,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x22, 0x42, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x73, 0x22, 0x59, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x09, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x73, 0x22, 0x6e, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x3f, 0x0a, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x73, 0x22, 0x69, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x3c, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x2a, 0x0a,
+	0x14, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -335,26 +415,28 @@ func file_store_instance_change_history_proto_rawDescGZIP() []byte {
 	return file_store_instance_change_history_proto_rawDescData
 }
 
-var file_store_instance_change_history_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_store_instance_change_history_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_store_instance_change_history_proto_goTypes = []interface{}{
 	(*InstanceChangeHistoryPayload)(nil), // 0: bytebase.store.InstanceChangeHistoryPayload
-	(*ChangedResources)(nil),             // 1: bytebase.store.ChangedResources
-	(*ChangedResourceDatabase)(nil),      // 2: bytebase.store.ChangedResourceDatabase
-	(*ChangedResourceSchema)(nil),        // 3: bytebase.store.ChangedResourceSchema
-	(*ChangedResourceTable)(nil),         // 4: bytebase.store.ChangedResourceTable
-	(*PushEvent)(nil),                    // 5: bytebase.store.PushEvent
+	(*StatementCheckpoint)(nil),          // 1: bytebase.store.StatementCheckpoint
+	(*ChangedResources)(nil),             // 2: bytebase.store.ChangedResources
+	(*ChangedResourceDatabase)(nil),      // 3: bytebase.store.ChangedResourceDatabase
+	(*ChangedResourceSchema)(nil),        // 4: bytebase.store.ChangedResourceSchema
+	(*ChangedResourceTable)(nil),         // 5: bytebase.store.ChangedResourceTable
+	(*PushEvent)(nil),                    // 6: bytebase.store.PushEvent
 }
 var file_store_instance_change_history_proto_depIdxs = []int32{
-	5, // 0: bytebase.store.InstanceChangeHistoryPayload.push_event:type_name -> bytebase.store.PushEvent
-	1, // 1: bytebase.store.InstanceChangeHistoryPayload.changed_resources:type_name -> bytebase.store.ChangedResources
-	2, // 2: bytebase.store.ChangedResources.databases:type_name -> bytebase.store.ChangedResourceDatabase
-	3, // 3: bytebase.store.ChangedResourceDatabase.schemas:type_name -> bytebase.store.ChangedResourceSchema
-	4, // 4: bytebase.store.ChangedResourceSchema.tables:type_name -> bytebase.store.ChangedResourceTable
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	6, // 0: bytebase.store.InstanceChangeHistoryPayload.push_event:type_name -> bytebase.store.PushEvent
+	2, // 1: bytebase.store.InstanceChangeHistoryPayload.changed_resources:type_name -> bytebase.store.ChangedResources
+	1, // 2: bytebase.store.InstanceChangeHistoryPayload.statement_checkpoint:type_name -> bytebase.store.StatementCheckpoint
+	3, // 3: bytebase.store.ChangedResources.databases:type_name -> bytebase.store.ChangedResourceDatabase
+	4, // 4: bytebase.store.ChangedResourceDatabase.schemas:type_name -> bytebase.store.ChangedResourceSchema
+	5, // 5: bytebase.store.ChangedResourceSchema.tables:type_name -> bytebase.store.ChangedResourceTable
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_store_instance_change_history_proto_init() }
@@ -377,7 +459,7 @@ func file_store_instance_change_history_proto_init() {
 			}
 		}
 		file_store_instance_change_history_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangedResources); i {
+			switch v := v.(*StatementCheckpoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -389,7 +471,7 @@ func file_store_instance_change_history_proto_init() {
 			}
 		}
 		file_store_instance_change_history_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangedResourceDatabase); i {
+			switch v := v.(*ChangedResources); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -401,7 +483,7 @@ func file_store_instance_change_history_proto_init() {
 			}
 		}
 		file_store_instance_change_history_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangedResourceSchema); i {
+			switch v := v.(*ChangedResourceDatabase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -413,6 +495,18 @@ func file_store_instance_change_history_proto_init() {
 			}
 		}
 		file_store_instance_change_history_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangedResourceSchema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_instance_change_history_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChangedResourceTable); i {
 			case 0:
 				return &v.state
@@ -431,7 +525,7 @@ func file_store_instance_change_history_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_instance_change_history_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
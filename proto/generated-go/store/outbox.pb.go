@@ -0,0 +1,595 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: store/outbox.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OutboxEventPayload is the payload of the side effects delivered by the outbox dispatcher.
+type OutboxEventPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The project of the webhooks.
+	ProjectId int32 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// The activity type subscribed by the webhooks.
+	ActivityType string `protobuf:"bytes,2,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	// Types that are assignable to Event:
+	//
+	//	*OutboxEventPayload_IssueActivity_
+	//	*OutboxEventPayload_WebhookContext
+	//	*OutboxEventPayload_AuditActivities_
+	Event isOutboxEventPayload_Event `protobuf_oneof:"event"`
+	// The webhooks already delivered, they are skipped on retries.
+	DeliveredWebhookIds []int32 `protobuf:"varint,5,rep,packed,name=delivered_webhook_ids,json=deliveredWebhookIds,proto3" json:"delivered_webhook_ids,omitempty"`
+	// Whether the inbox of the issue activity has been posted.
+	InboxPosted bool `protobuf:"varint,6,opt,name=inbox_posted,json=inboxPosted,proto3" json:"inbox_posted,omitempty"`
+	// Whether the issue activity has been synced to the linked Jira ticket.
+	JiraSynced bool `protobuf:"varint,7,opt,name=jira_synced,json=jiraSynced,proto3" json:"jira_synced,omitempty"`
+	// Whether the event has been published to the event bus.
+	EventPublished bool `protobuf:"varint,8,opt,name=event_published,json=eventPublished,proto3" json:"event_published,omitempty"`
+}
+
+func (x *OutboxEventPayload) Reset() {
+	*x = OutboxEventPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_outbox_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutboxEventPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboxEventPayload) ProtoMessage() {}
+
+func (x *OutboxEventPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_outbox_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboxEventPayload.ProtoReflect.Descriptor instead.
+func (*OutboxEventPayload) Descriptor() ([]byte, []int) {
+	return file_store_outbox_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OutboxEventPayload) GetProjectId() int32 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *OutboxEventPayload) GetActivityType() string {
+	if x != nil {
+		return x.ActivityType
+	}
+	return ""
+}
+
+func (m *OutboxEventPayload) GetEvent() isOutboxEventPayload_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *OutboxEventPayload) GetIssueActivity() *OutboxEventPayload_IssueActivity {
+	if x, ok := x.GetEvent().(*OutboxEventPayload_IssueActivity_); ok {
+		return x.IssueActivity
+	}
+	return nil
+}
+
+func (x *OutboxEventPayload) GetWebhookContext() string {
+	if x, ok := x.GetEvent().(*OutboxEventPayload_WebhookContext); ok {
+		return x.WebhookContext
+	}
+	return ""
+}
+
+func (x *OutboxEventPayload) GetAuditActivities() *OutboxEventPayload_AuditActivities {
+	if x, ok := x.GetEvent().(*OutboxEventPayload_AuditActivities_); ok {
+		return x.AuditActivities
+	}
+	return nil
+}
+
+func (x *OutboxEventPayload) GetDeliveredWebhookIds() []int32 {
+	if x != nil {
+		return x.DeliveredWebhookIds
+	}
+	return nil
+}
+
+func (x *OutboxEventPayload) GetInboxPosted() bool {
+	if x != nil {
+		return x.InboxPosted
+	}
+	return false
+}
+
+func (x *OutboxEventPayload) GetJiraSynced() bool {
+	if x != nil {
+		return x.JiraSynced
+	}
+	return false
+}
+
+func (x *OutboxEventPayload) GetEventPublished() bool {
+	if x != nil {
+		return x.EventPublished
+	}
+	return false
+}
+
+type isOutboxEventPayload_Event interface {
+	isOutboxEventPayload_Event()
+}
+
+type OutboxEventPayload_IssueActivity_ struct {
+	// The issue activity posting the inbox and the webhooks.
+	IssueActivity *OutboxEventPayload_IssueActivity `protobuf:"bytes,3,opt,name=issue_activity,json=issueActivity,proto3,oneof"`
+}
+
+type OutboxEventPayload_WebhookContext struct {
+	// The JSON of the webhook context posted to the webhooks as is.
+	WebhookContext string `protobuf:"bytes,4,opt,name=webhook_context,json=webhookContext,proto3,oneof"`
+}
+
+type OutboxEventPayload_AuditActivities_ struct {
+	// The activities not bound to an issue, which are only published to the event bus.
+	AuditActivities *OutboxEventPayload_AuditActivities `protobuf:"bytes,9,opt,name=audit_activities,json=auditActivities,proto3,oneof"`
+}
+
+func (*OutboxEventPayload_IssueActivity_) isOutboxEventPayload_Event() {}
+
+func (*OutboxEventPayload_WebhookContext) isOutboxEventPayload_Event() {}
+
+func (*OutboxEventPayload_AuditActivities_) isOutboxEventPayload_Event() {}
+
+type OutboxEventPayload_IssueActivity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IssueId int32 `protobuf:"varint,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	// The activity ID, 0 for the notification activities which are not stored.
+	ActivityId int32  `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	CreatorId  int32  `protobuf:"varint,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Level      string `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`
+	Comment    string `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	Payload    string `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *OutboxEventPayload_IssueActivity) Reset() {
+	*x = OutboxEventPayload_IssueActivity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_outbox_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutboxEventPayload_IssueActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboxEventPayload_IssueActivity) ProtoMessage() {}
+
+func (x *OutboxEventPayload_IssueActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_store_outbox_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboxEventPayload_IssueActivity.ProtoReflect.Descriptor instead.
+func (*OutboxEventPayload_IssueActivity) Descriptor() ([]byte, []int) {
+	return file_store_outbox_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *OutboxEventPayload_IssueActivity) GetIssueId() int32 {
+	if x != nil {
+		return x.IssueId
+	}
+	return 0
+}
+
+func (x *OutboxEventPayload_IssueActivity) GetActivityId() int32 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *OutboxEventPayload_IssueActivity) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *OutboxEventPayload_IssueActivity) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *OutboxEventPayload_IssueActivity) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *OutboxEventPayload_IssueActivity) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+type OutboxEventPayload_AuditActivity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityId  int32  `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	CreatorId   int32  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	ContainerId int32  `protobuf:"varint,3,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Type        string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Level       string `protobuf:"bytes,5,opt,name=level,proto3" json:"level,omitempty"`
+	Comment     string `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	Payload     string `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	CreatedTs   int64  `protobuf:"varint,8,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+}
+
+func (x *OutboxEventPayload_AuditActivity) Reset() {
+	*x = OutboxEventPayload_AuditActivity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_outbox_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutboxEventPayload_AuditActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboxEventPayload_AuditActivity) ProtoMessage() {}
+
+func (x *OutboxEventPayload_AuditActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_store_outbox_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboxEventPayload_AuditActivity.ProtoReflect.Descriptor instead.
+func (*OutboxEventPayload_AuditActivity) Descriptor() ([]byte, []int) {
+	return file_store_outbox_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *OutboxEventPayload_AuditActivity) GetActivityId() int32 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *OutboxEventPayload_AuditActivity) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *OutboxEventPayload_AuditActivity) GetContainerId() int32 {
+	if x != nil {
+		return x.ContainerId
+	}
+	return 0
+}
+
+func (x *OutboxEventPayload_AuditActivity) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OutboxEventPayload_AuditActivity) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *OutboxEventPayload_AuditActivity) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *OutboxEventPayload_AuditActivity) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *OutboxEventPayload_AuditActivity) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+type OutboxEventPayload_AuditActivities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Activities []*OutboxEventPayload_AuditActivity `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities,omitempty"`
+}
+
+func (x *OutboxEventPayload_AuditActivities) Reset() {
+	*x = OutboxEventPayload_AuditActivities{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_outbox_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutboxEventPayload_AuditActivities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboxEventPayload_AuditActivities) ProtoMessage() {}
+
+func (x *OutboxEventPayload_AuditActivities) ProtoReflect() protoreflect.Message {
+	mi := &file_store_outbox_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboxEventPayload_AuditActivities.ProtoReflect.Descriptor instead.
+func (*OutboxEventPayload_AuditActivities) Descriptor() ([]byte, []int) {
+	return file_store_outbox_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *OutboxEventPayload_AuditActivities) GetActivities() []*OutboxEventPayload_AuditActivity {
+	if x != nil {
+		return x.Activities
+	}
+	return nil
+}
+
+var File_store_outbox_proto protoreflect.FileDescriptor
+
+var file_store_outbox_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x22, 0xf7, 0x07, 0x0a, 0x12, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x59, 0x0a, 0x0e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x48, 0x00, 0x52, 0x0d, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x0f, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x5f, 0x0a, 0x10, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x65, 0x64, 0x5f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x13, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e,
+	0x62, 0x6f, 0x78, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x69, 0x6e, 0x62, 0x6f, 0x78, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6a, 0x69, 0x72, 0x61, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x6a, 0x69, 0x72, 0x61, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x1a, 0xb4, 0x01, 0x0a, 0x0d, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x1a, 0xef,
+	0x01, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x73,
+	0x1a, 0x63, 0x0a, 0x0f, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x14,
+	0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_store_outbox_proto_rawDescOnce sync.Once
+	file_store_outbox_proto_rawDescData = file_store_outbox_proto_rawDesc
+)
+
+func file_store_outbox_proto_rawDescGZIP() []byte {
+	file_store_outbox_proto_rawDescOnce.Do(func() {
+		file_store_outbox_proto_rawDescData = protoimpl.X.CompressGZIP(file_store_outbox_proto_rawDescData)
+	})
+	return file_store_outbox_proto_rawDescData
+}
+
+var file_store_outbox_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_store_outbox_proto_goTypes = []interface{}{
+	(*OutboxEventPayload)(nil),                 // 0: bytebase.store.OutboxEventPayload
+	(*OutboxEventPayload_IssueActivity)(nil),   // 1: bytebase.store.OutboxEventPayload.IssueActivity
+	(*OutboxEventPayload_AuditActivity)(nil),   // 2: bytebase.store.OutboxEventPayload.AuditActivity
+	(*OutboxEventPayload_AuditActivities)(nil), // 3: bytebase.store.OutboxEventPayload.AuditActivities
+}
+var file_store_outbox_proto_depIdxs = []int32{
+	1, // 0: bytebase.store.OutboxEventPayload.issue_activity:type_name -> bytebase.store.OutboxEventPayload.IssueActivity
+	3, // 1: bytebase.store.OutboxEventPayload.audit_activities:type_name -> bytebase.store.OutboxEventPayload.AuditActivities
+	2, // 2: bytebase.store.OutboxEventPayload.AuditActivities.activities:type_name -> bytebase.store.OutboxEventPayload.AuditActivity
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_store_outbox_proto_init() }
+func file_store_outbox_proto_init() {
+	if File_store_outbox_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_store_outbox_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutboxEventPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_outbox_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutboxEventPayload_IssueActivity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_outbox_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutboxEventPayload_AuditActivity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_outbox_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutboxEventPayload_AuditActivities); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_store_outbox_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*OutboxEventPayload_IssueActivity_)(nil),
+		(*OutboxEventPayload_WebhookContext)(nil),
+		(*OutboxEventPayload_AuditActivities_)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_store_outbox_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_outbox_proto_goTypes,
+		DependencyIndexes: file_store_outbox_proto_depIdxs,
+		MessageInfos:      file_store_outbox_proto_msgTypes,
+	}.Build()
+	File_store_outbox_proto = out.File
+	file_store_outbox_proto_rawDesc = nil
+	file_store_outbox_proto_goTypes = nil
+	file_store_outbox_proto_depIdxs = nil
+}
@@ -9,6 +9,7 @@ package store
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -67,7 +68,113 @@ func (x ProtectionRule_Target) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProtectionRule_Target.Descriptor instead.
 func (ProtectionRule_Target) EnumDescriptor() ([]byte, []int) {
-	return file_store_project_proto_rawDescGZIP(), []int{1, 0}
+	return file_store_project_proto_rawDescGZIP(), []int{4, 0}
+}
+
+// The type of the field value.
+type IssueFormField_Type int32
+
+const (
+	IssueFormField_TYPE_UNSPECIFIED IssueFormField_Type = 0
+	IssueFormField_STRING           IssueFormField_Type = 1
+	IssueFormField_NUMBER           IssueFormField_Type = 2
+	IssueFormField_BOOLEAN          IssueFormField_Type = 3
+	IssueFormField_SELECT           IssueFormField_Type = 4
+)
+
+// Enum value maps for IssueFormField_Type.
+var (
+	IssueFormField_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "STRING",
+		2: "NUMBER",
+		3: "BOOLEAN",
+		4: "SELECT",
+	}
+	IssueFormField_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"STRING":           1,
+		"NUMBER":           2,
+		"BOOLEAN":          3,
+		"SELECT":           4,
+	}
+)
+
+func (x IssueFormField_Type) Enum() *IssueFormField_Type {
+	p := new(IssueFormField_Type)
+	*p = x
+	return p
+}
+
+func (x IssueFormField_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IssueFormField_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_project_proto_enumTypes[1].Descriptor()
+}
+
+func (IssueFormField_Type) Type() protoreflect.EnumType {
+	return &file_store_project_proto_enumTypes[1]
+}
+
+func (x IssueFormField_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IssueFormField_Type.Descriptor instead.
+func (IssueFormField_Type) EnumDescriptor() ([]byte, []int) {
+	return file_store_project_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type IssueTemplate_ChangeType int32
+
+const (
+	// The unspecified change type is treated as DDL.
+	IssueTemplate_CHANGE_TYPE_UNSPECIFIED IssueTemplate_ChangeType = 0
+	IssueTemplate_DDL                     IssueTemplate_ChangeType = 1
+	IssueTemplate_DML                     IssueTemplate_ChangeType = 2
+)
+
+// Enum value maps for IssueTemplate_ChangeType.
+var (
+	IssueTemplate_ChangeType_name = map[int32]string{
+		0: "CHANGE_TYPE_UNSPECIFIED",
+		1: "DDL",
+		2: "DML",
+	}
+	IssueTemplate_ChangeType_value = map[string]int32{
+		"CHANGE_TYPE_UNSPECIFIED": 0,
+		"DDL":                     1,
+		"DML":                     2,
+	}
+)
+
+func (x IssueTemplate_ChangeType) Enum() *IssueTemplate_ChangeType {
+	p := new(IssueTemplate_ChangeType)
+	*p = x
+	return p
+}
+
+func (x IssueTemplate_ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IssueTemplate_ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_project_proto_enumTypes[2].Descriptor()
+}
+
+func (IssueTemplate_ChangeType) Type() protoreflect.EnumType {
+	return &file_store_project_proto_enumTypes[2]
+}
+
+func (x IssueTemplate_ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IssueTemplate_ChangeType.Descriptor instead.
+func (IssueTemplate_ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_store_project_proto_rawDescGZIP(), []int{7, 0}
 }
 
 type Project struct {
@@ -76,6 +183,13 @@ type Project struct {
 	unknownFields protoimpl.UnknownFields
 
 	ProtectionRules []*ProtectionRule `protobuf:"bytes,1,rep,name=protection_rules,json=protectionRules,proto3" json:"protection_rules,omitempty"`
+	IssueForms      []*IssueForm      `protobuf:"bytes,2,rep,name=issue_forms,json=issueForms,proto3" json:"issue_forms,omitempty"`
+	IssueTemplates  []*IssueTemplate  `protobuf:"bytes,3,rep,name=issue_templates,json=issueTemplates,proto3" json:"issue_templates,omitempty"`
+	IssueSchedules  []*IssueSchedule  `protobuf:"bytes,4,rep,name=issue_schedules,json=issueSchedules,proto3" json:"issue_schedules,omitempty"`
+	Quota           *ProjectQuota     `protobuf:"bytes,5,opt,name=quota,proto3" json:"quota,omitempty"`
+	// The factor of the week over week slow query regression to alert, 0 disables the alert.
+	SlowQueryRegressionFactor float64                `protobuf:"fixed64,6,opt,name=slow_query_regression_factor,json=slowQueryRegressionFactor,proto3" json:"slow_query_regression_factor,omitempty"`
+	QueryPerformanceCheck     *QueryPerformanceCheck `protobuf:"bytes,7,opt,name=query_performance_check,json=queryPerformanceCheck,proto3" json:"query_performance_check,omitempty"`
 }
 
 func (x *Project) Reset() {
@@ -117,6 +231,242 @@ func (x *Project) GetProtectionRules() []*ProtectionRule {
 	return nil
 }
 
+func (x *Project) GetIssueForms() []*IssueForm {
+	if x != nil {
+		return x.IssueForms
+	}
+	return nil
+}
+
+func (x *Project) GetIssueTemplates() []*IssueTemplate {
+	if x != nil {
+		return x.IssueTemplates
+	}
+	return nil
+}
+
+func (x *Project) GetIssueSchedules() []*IssueSchedule {
+	if x != nil {
+		return x.IssueSchedules
+	}
+	return nil
+}
+
+func (x *Project) GetQuota() *ProjectQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *Project) GetSlowQueryRegressionFactor() float64 {
+	if x != nil {
+		return x.SlowQueryRegressionFactor
+	}
+	return 0
+}
+
+func (x *Project) GetQueryPerformanceCheck() *QueryPerformanceCheck {
+	if x != nil {
+		return x.QueryPerformanceCheck
+	}
+	return nil
+}
+
+// QueryPerformanceCheck replays the benchmark queries with EXPLAIN before and after the migration
+// in a rolled back transaction, and flags the queries whose plans regress.
+// The check is enabled if there are benchmark queries.
+type QueryPerformanceCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BenchmarkQueries []*BenchmarkQuery `protobuf:"bytes,1,rep,name=benchmark_queries,json=benchmarkQueries,proto3" json:"benchmark_queries,omitempty"`
+	// The factor of the total cost increase to flag, e.g. 1.5 for 50% more costly.
+	// The default is 1.5 if it is 0.
+	CostIncreaseFactor float64 `protobuf:"fixed64,2,opt,name=cost_increase_factor,json=costIncreaseFactor,proto3" json:"cost_increase_factor,omitempty"`
+}
+
+func (x *QueryPerformanceCheck) Reset() {
+	*x = QueryPerformanceCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_project_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPerformanceCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPerformanceCheck) ProtoMessage() {}
+
+func (x *QueryPerformanceCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPerformanceCheck.ProtoReflect.Descriptor instead.
+func (*QueryPerformanceCheck) Descriptor() ([]byte, []int) {
+	return file_store_project_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryPerformanceCheck) GetBenchmarkQueries() []*BenchmarkQuery {
+	if x != nil {
+		return x.BenchmarkQueries
+	}
+	return nil
+}
+
+func (x *QueryPerformanceCheck) GetCostIncreaseFactor() float64 {
+	if x != nil {
+		return x.CostIncreaseFactor
+	}
+	return 0
+}
+
+type BenchmarkQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The display title of the query.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// The SELECT statement to explain.
+	Statement string `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+}
+
+func (x *BenchmarkQuery) Reset() {
+	*x = BenchmarkQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_project_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BenchmarkQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkQuery) ProtoMessage() {}
+
+func (x *BenchmarkQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkQuery.ProtoReflect.Descriptor instead.
+func (*BenchmarkQuery) Descriptor() ([]byte, []int) {
+	return file_store_project_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BenchmarkQuery) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BenchmarkQuery) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+// ProjectQuota is the resource quota of the project.
+// The zero value of a limit means unlimited.
+type ProjectQuota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of rollouts running at the same time.
+	MaxConcurrentRollouts int32 `protobuf:"varint,1,opt,name=max_concurrent_rollouts,json=maxConcurrentRollouts,proto3" json:"max_concurrent_rollouts,omitempty"`
+	// The maximum number of rows exported per UTC day.
+	MaxExportedRowsPerDay int64 `protobuf:"varint,2,opt,name=max_exported_rows_per_day,json=maxExportedRowsPerDay,proto3" json:"max_exported_rows_per_day,omitempty"`
+	// The maximum runtime of a SQL editor query.
+	MaxQueryRuntime *durationpb.Duration `protobuf:"bytes,3,opt,name=max_query_runtime,json=maxQueryRuntime,proto3" json:"max_query_runtime,omitempty"`
+	// The maximum size of the backups in bytes.
+	MaxBackupStorageBytes int64 `protobuf:"varint,4,opt,name=max_backup_storage_bytes,json=maxBackupStorageBytes,proto3" json:"max_backup_storage_bytes,omitempty"`
+}
+
+func (x *ProjectQuota) Reset() {
+	*x = ProjectQuota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_project_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectQuota) ProtoMessage() {}
+
+func (x *ProjectQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectQuota.ProtoReflect.Descriptor instead.
+func (*ProjectQuota) Descriptor() ([]byte, []int) {
+	return file_store_project_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProjectQuota) GetMaxConcurrentRollouts() int32 {
+	if x != nil {
+		return x.MaxConcurrentRollouts
+	}
+	return 0
+}
+
+func (x *ProjectQuota) GetMaxExportedRowsPerDay() int64 {
+	if x != nil {
+		return x.MaxExportedRowsPerDay
+	}
+	return 0
+}
+
+func (x *ProjectQuota) GetMaxQueryRuntime() *durationpb.Duration {
+	if x != nil {
+		return x.MaxQueryRuntime
+	}
+	return nil
+}
+
+func (x *ProjectQuota) GetMaxBackupStorageBytes() int64 {
+	if x != nil {
+		return x.MaxBackupStorageBytes
+	}
+	return 0
+}
+
 type ProtectionRule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -135,7 +485,7 @@ type ProtectionRule struct {
 func (x *ProtectionRule) Reset() {
 	*x = ProtectionRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_project_proto_msgTypes[1]
+		mi := &file_store_project_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -148,7 +498,7 @@ func (x *ProtectionRule) String() string {
 func (*ProtectionRule) ProtoMessage() {}
 
 func (x *ProtectionRule) ProtoReflect() protoreflect.Message {
-	mi := &file_store_project_proto_msgTypes[1]
+	mi := &file_store_project_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -161,7 +511,7 @@ func (x *ProtectionRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtectionRule.ProtoReflect.Descriptor instead.
 func (*ProtectionRule) Descriptor() ([]byte, []int) {
-	return file_store_project_proto_rawDescGZIP(), []int{1}
+	return file_store_project_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ProtectionRule) GetId() string {
@@ -192,85 +542,775 @@ func (x *ProtectionRule) GetCreateAllowedRoles() []string {
 	return nil
 }
 
-var File_store_project_proto protoreflect.FileDescriptor
+// IssueForm is the form that issues of the issue type must fill in.
+type IssueForm struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_store_project_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x54, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x12, 0x49, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
-	0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x79, 0x74,
-	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x74,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xfb, 0x01, 0x0a, 0x0e,
-	0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3d,
-	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
-	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
-	0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x30,
-	0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
-	0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73,
-	0x22, 0x47, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x52,
-	0x4f, 0x54, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x42, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x48, 0x41,
-	0x4e, 0x47, 0x45, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x02, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	// The type of issues the form applies to, e.g. bb.issue.database.general.
+	IssueType string            `protobuf:"bytes,1,opt,name=issue_type,json=issueType,proto3" json:"issue_type,omitempty"`
+	Fields    []*IssueFormField `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
 }
 
-var (
-	file_store_project_proto_rawDescOnce sync.Once
-	file_store_project_proto_rawDescData = file_store_project_proto_rawDesc
-)
+func (x *IssueForm) Reset() {
+	*x = IssueForm{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_project_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_store_project_proto_rawDescGZIP() []byte {
-	file_store_project_proto_rawDescOnce.Do(func() {
-		file_store_project_proto_rawDescData = protoimpl.X.CompressGZIP(file_store_project_proto_rawDescData)
-	})
-	return file_store_project_proto_rawDescData
+func (x *IssueForm) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_store_project_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_project_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_store_project_proto_goTypes = []interface{}{
-	(ProtectionRule_Target)(0), // 0: bytebase.store.ProtectionRule.Target
-	(*Project)(nil),            // 1: bytebase.store.Project
-	(*ProtectionRule)(nil),     // 2: bytebase.store.ProtectionRule
+func (*IssueForm) ProtoMessage() {}
+
+func (x *IssueForm) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_store_project_proto_depIdxs = []int32{
-	2, // 0: bytebase.store.Project.protection_rules:type_name -> bytebase.store.ProtectionRule
-	0, // 1: bytebase.store.ProtectionRule.target:type_name -> bytebase.store.ProtectionRule.Target
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+// Deprecated: Use IssueForm.ProtoReflect.Descriptor instead.
+func (*IssueForm) Descriptor() ([]byte, []int) {
+	return file_store_project_proto_rawDescGZIP(), []int{5}
 }
 
-func init() { file_store_project_proto_init() }
-func file_store_project_proto_init() {
-	if File_store_project_proto != nil {
-		return
+func (x *IssueForm) GetIssueType() string {
+	if x != nil {
+		return x.IssueType
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_store_project_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Project); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
+	return ""
+}
+
+func (x *IssueForm) GetFields() []*IssueFormField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type IssueFormField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key of the field in the issue form data.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The display title of the field.
+	Title    string              `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Type     IssueFormField_Type `protobuf:"varint,3,opt,name=type,proto3,enum=bytebase.store.IssueFormField_Type" json:"type,omitempty"`
+	Required bool                `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	// The value used if the field is not submitted.
+	DefaultValue string `protobuf:"bytes,5,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// The regular expression that STRING values must fully match.
+	Pattern string `protobuf:"bytes,6,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// The allowed values for SELECT fields.
+	Options []string `protobuf:"bytes,7,rep,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *IssueFormField) Reset() {
+	*x = IssueFormField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_project_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueFormField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueFormField) ProtoMessage() {}
+
+func (x *IssueFormField) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueFormField.ProtoReflect.Descriptor instead.
+func (*IssueFormField) Descriptor() ([]byte, []int) {
+	return file_store_project_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *IssueFormField) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IssueFormField) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *IssueFormField) GetType() IssueFormField_Type {
+	if x != nil {
+		return x.Type
+	}
+	return IssueFormField_TYPE_UNSPECIFIED
+}
+
+func (x *IssueFormField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *IssueFormField) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *IssueFormField) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *IssueFormField) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// IssueTemplate is the template to create database change issues from.
+// The variables are resolved in the title pattern and the statement when the issue is created.
+type IssueTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resource id of the template.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The display title of the template.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The pattern of the issue title, e.g. "Backfill {{table}}".
+	TitlePattern string `protobuf:"bytes,3,opt,name=title_pattern,json=titlePattern,proto3" json:"title_pattern,omitempty"`
+	// The SQL skeleton of the change, e.g. "UPDATE {{table}} SET status = '{{status}}';".
+	// The built-in variables {{run_date}} (2006-01-02) and {{run_date_compact}} (20060102)
+	// are resolved to the UTC date of the issue creation.
+	Statement string                   `protobuf:"bytes,4,opt,name=statement,proto3" json:"statement,omitempty"`
+	Variables []*IssueTemplateVariable `protobuf:"bytes,5,rep,name=variables,proto3" json:"variables,omitempty"`
+	// The default assignee of the issue.
+	// Format: users/{email}.
+	Assignee string `protobuf:"bytes,6,opt,name=assignee,proto3" json:"assignee,omitempty"`
+	// The default labels of the issue.
+	Labels []string `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty"`
+	// The CEL expression selecting the target databases, the same as the database group expression.
+	DatabaseExpression string                   `protobuf:"bytes,8,opt,name=database_expression,json=databaseExpression,proto3" json:"database_expression,omitempty"`
+	ChangeType         IssueTemplate_ChangeType `protobuf:"varint,9,opt,name=change_type,json=changeType,proto3,enum=bytebase.store.IssueTemplate_ChangeType" json:"change_type,omitempty"`
+}
+
+func (x *IssueTemplate) Reset() {
+	*x = IssueTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_project_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueTemplate) ProtoMessage() {}
+
+func (x *IssueTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueTemplate.ProtoReflect.Descriptor instead.
+func (*IssueTemplate) Descriptor() ([]byte, []int) {
+	return file_store_project_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *IssueTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IssueTemplate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *IssueTemplate) GetTitlePattern() string {
+	if x != nil {
+		return x.TitlePattern
+	}
+	return ""
+}
+
+func (x *IssueTemplate) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *IssueTemplate) GetVariables() []*IssueTemplateVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *IssueTemplate) GetAssignee() string {
+	if x != nil {
+		return x.Assignee
+	}
+	return ""
+}
+
+func (x *IssueTemplate) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *IssueTemplate) GetDatabaseExpression() string {
+	if x != nil {
+		return x.DatabaseExpression
+	}
+	return ""
+}
+
+func (x *IssueTemplate) GetChangeType() IssueTemplate_ChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return IssueTemplate_CHANGE_TYPE_UNSPECIFIED
+}
+
+type IssueTemplateVariable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the variable, referenced as {{name}}.
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Required    bool   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	// The value used if the variable is not submitted.
+	DefaultValue string `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+}
+
+func (x *IssueTemplateVariable) Reset() {
+	*x = IssueTemplateVariable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_project_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueTemplateVariable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueTemplateVariable) ProtoMessage() {}
+
+func (x *IssueTemplateVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueTemplateVariable.ProtoReflect.Descriptor instead.
+func (*IssueTemplateVariable) Descriptor() ([]byte, []int) {
+	return file_store_project_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *IssueTemplateVariable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IssueTemplateVariable) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *IssueTemplateVariable) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *IssueTemplateVariable) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+// IssueSchedule creates an issue from the issue template with a new rollout on every cycle of the schedule,
+// e.g. the weekly partition maintenance.
+type IssueSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resource id of the schedule.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The display title of the schedule.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The id of the issue template in the project.
+	Template string `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	// The values of the template variables.
+	TemplateVariables map[string]string `protobuf:"bytes,4,rep,name=template_variables,json=templateVariables,proto3" json:"template_variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The cron expression in UTC, e.g. "0 2 * * 1" for 02:00 every Monday.
+	Schedule string `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Disabled bool   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// The principal id of the creator of the issues.
+	CreatorId int32 `protobuf:"varint,7,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	// The next run time in unix seconds.
+	NextRunTs int64 `protobuf:"varint,8,opt,name=next_run_ts,json=nextRunTs,proto3" json:"next_run_ts,omitempty"`
+	// The issue created by the last run.
+	LastIssueId int32 `protobuf:"varint,9,opt,name=last_issue_id,json=lastIssueId,proto3" json:"last_issue_id,omitempty"`
+	// The error of the last run, empty if the last run succeeded.
+	LastError string `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (x *IssueSchedule) Reset() {
+	*x = IssueSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_project_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueSchedule) ProtoMessage() {}
+
+func (x *IssueSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueSchedule.ProtoReflect.Descriptor instead.
+func (*IssueSchedule) Descriptor() ([]byte, []int) {
+	return file_store_project_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *IssueSchedule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IssueSchedule) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *IssueSchedule) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *IssueSchedule) GetTemplateVariables() map[string]string {
+	if x != nil {
+		return x.TemplateVariables
+	}
+	return nil
+}
+
+func (x *IssueSchedule) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *IssueSchedule) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *IssueSchedule) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *IssueSchedule) GetNextRunTs() int64 {
+	if x != nil {
+		return x.NextRunTs
+	}
+	return 0
+}
+
+func (x *IssueSchedule) GetLastIssueId() int32 {
+	if x != nil {
+		return x.LastIssueId
+	}
+	return 0
+}
+
+func (x *IssueSchedule) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+var File_store_project_proto protoreflect.FileDescriptor
+
+var file_store_project_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf4, 0x03, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x49, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0f, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0b,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x52, 0x0a, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x46, 0x0a, 0x0f, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x0e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x46, 0x0a, 0x0f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x3f, 0x0a, 0x1c,
+	0x73, 0x6c, 0x6f, 0x77, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x19, 0x73, 0x6c, 0x6f, 0x77, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x5d, 0x0a,
+	0x17, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x15, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x96, 0x01, 0x0a,
+	0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
+	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x4b, 0x0a, 0x11, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x10, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x72,
+	0x65, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x12, 0x63, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x44, 0x0a, 0x0e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x80, 0x02, 0x0a, 0x0c,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x17,
+	0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x6d,
+	0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x75, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x45,
+	0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xfb,
+	0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x3d, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x25, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c,
+	0x65, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x52, 0x6f,
+	0x6c, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x21, 0x0a,
+	0x1d, 0x50, 0x52, 0x4f, 0x54, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x41, 0x52, 0x47,
+	0x45, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x42, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x02, 0x22, 0x62, 0x0a, 0x09,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x46,
+	0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x22, 0xb3, 0x02, 0x0a, 0x0e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x46, 0x6f,
+	0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4d, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x0b, 0x0a,
+	0x07, 0x42, 0x4f, 0x4f, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45,
+	0x4c, 0x45, 0x43, 0x54, 0x10, 0x04, 0x22, 0xaa, 0x03, 0x0a, 0x0d, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x43, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x0b, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x28, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3b, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x44, 0x4c, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x4d,
+	0x4c, 0x10, 0x02, 0x22, 0x8e, 0x01, 0x0a, 0x15, 0x49, 0x73, 0x73, 0x75, 0x65, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0xb6, 0x03, 0x0a, 0x0d, 0x49, 0x73, 0x73, 0x75, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e,
+	0x5f, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x52,
+	0x75, 0x6e, 0x54, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6c, 0x61, 0x73,
+	0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x44, 0x0a, 0x16, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x14, 0x5a,
+	0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_store_project_proto_rawDescOnce sync.Once
+	file_store_project_proto_rawDescData = file_store_project_proto_rawDesc
+)
+
+func file_store_project_proto_rawDescGZIP() []byte {
+	file_store_project_proto_rawDescOnce.Do(func() {
+		file_store_project_proto_rawDescData = protoimpl.X.CompressGZIP(file_store_project_proto_rawDescData)
+	})
+	return file_store_project_proto_rawDescData
+}
+
+var file_store_project_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_store_project_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_store_project_proto_goTypes = []interface{}{
+	(ProtectionRule_Target)(0),    // 0: bytebase.store.ProtectionRule.Target
+	(IssueFormField_Type)(0),      // 1: bytebase.store.IssueFormField.Type
+	(IssueTemplate_ChangeType)(0), // 2: bytebase.store.IssueTemplate.ChangeType
+	(*Project)(nil),               // 3: bytebase.store.Project
+	(*QueryPerformanceCheck)(nil), // 4: bytebase.store.QueryPerformanceCheck
+	(*BenchmarkQuery)(nil),        // 5: bytebase.store.BenchmarkQuery
+	(*ProjectQuota)(nil),          // 6: bytebase.store.ProjectQuota
+	(*ProtectionRule)(nil),        // 7: bytebase.store.ProtectionRule
+	(*IssueForm)(nil),             // 8: bytebase.store.IssueForm
+	(*IssueFormField)(nil),        // 9: bytebase.store.IssueFormField
+	(*IssueTemplate)(nil),         // 10: bytebase.store.IssueTemplate
+	(*IssueTemplateVariable)(nil), // 11: bytebase.store.IssueTemplateVariable
+	(*IssueSchedule)(nil),         // 12: bytebase.store.IssueSchedule
+	nil,                           // 13: bytebase.store.IssueSchedule.TemplateVariablesEntry
+	(*durationpb.Duration)(nil),   // 14: google.protobuf.Duration
+}
+var file_store_project_proto_depIdxs = []int32{
+	7,  // 0: bytebase.store.Project.protection_rules:type_name -> bytebase.store.ProtectionRule
+	8,  // 1: bytebase.store.Project.issue_forms:type_name -> bytebase.store.IssueForm
+	10, // 2: bytebase.store.Project.issue_templates:type_name -> bytebase.store.IssueTemplate
+	12, // 3: bytebase.store.Project.issue_schedules:type_name -> bytebase.store.IssueSchedule
+	6,  // 4: bytebase.store.Project.quota:type_name -> bytebase.store.ProjectQuota
+	4,  // 5: bytebase.store.Project.query_performance_check:type_name -> bytebase.store.QueryPerformanceCheck
+	5,  // 6: bytebase.store.QueryPerformanceCheck.benchmark_queries:type_name -> bytebase.store.BenchmarkQuery
+	14, // 7: bytebase.store.ProjectQuota.max_query_runtime:type_name -> google.protobuf.Duration
+	0,  // 8: bytebase.store.ProtectionRule.target:type_name -> bytebase.store.ProtectionRule.Target
+	9,  // 9: bytebase.store.IssueForm.fields:type_name -> bytebase.store.IssueFormField
+	1,  // 10: bytebase.store.IssueFormField.type:type_name -> bytebase.store.IssueFormField.Type
+	11, // 11: bytebase.store.IssueTemplate.variables:type_name -> bytebase.store.IssueTemplateVariable
+	2,  // 12: bytebase.store.IssueTemplate.change_type:type_name -> bytebase.store.IssueTemplate.ChangeType
+	13, // 13: bytebase.store.IssueSchedule.template_variables:type_name -> bytebase.store.IssueSchedule.TemplateVariablesEntry
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_store_project_proto_init() }
+func file_store_project_proto_init() {
+	if File_store_project_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_store_project_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Project); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
 			}
 		}
 		file_store_project_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPerformanceCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_project_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BenchmarkQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_project_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectQuota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_project_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtectionRule); i {
 			case 0:
 				return &v.state
@@ -282,14 +1322,74 @@ func file_store_project_proto_init() {
 				return nil
 			}
 		}
+		file_store_project_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueForm); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_project_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueFormField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_project_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_project_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueTemplateVariable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_project_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_project_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      3,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
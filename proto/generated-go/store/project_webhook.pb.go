@@ -0,0 +1,230 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: store/project_webhook.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ProjectWebhookPayload_PayloadVersion int32
+
+const (
+	// The legacy payload whose shape might change between releases.
+	ProjectWebhookPayload_PAYLOAD_VERSION_UNSPECIFIED ProjectWebhookPayload_PayloadVersion = 0
+	// The stable payload of schema version 1.
+	ProjectWebhookPayload_V1 ProjectWebhookPayload_PayloadVersion = 1
+)
+
+// Enum value maps for ProjectWebhookPayload_PayloadVersion.
+var (
+	ProjectWebhookPayload_PayloadVersion_name = map[int32]string{
+		0: "PAYLOAD_VERSION_UNSPECIFIED",
+		1: "V1",
+	}
+	ProjectWebhookPayload_PayloadVersion_value = map[string]int32{
+		"PAYLOAD_VERSION_UNSPECIFIED": 0,
+		"V1":                          1,
+	}
+)
+
+func (x ProjectWebhookPayload_PayloadVersion) Enum() *ProjectWebhookPayload_PayloadVersion {
+	p := new(ProjectWebhookPayload_PayloadVersion)
+	*p = x
+	return p
+}
+
+func (x ProjectWebhookPayload_PayloadVersion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProjectWebhookPayload_PayloadVersion) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_project_webhook_proto_enumTypes[0].Descriptor()
+}
+
+func (ProjectWebhookPayload_PayloadVersion) Type() protoreflect.EnumType {
+	return &file_store_project_webhook_proto_enumTypes[0]
+}
+
+func (x ProjectWebhookPayload_PayloadVersion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProjectWebhookPayload_PayloadVersion.Descriptor instead.
+func (ProjectWebhookPayload_PayloadVersion) EnumDescriptor() ([]byte, []int) {
+	return file_store_project_webhook_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type ProjectWebhookPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The schema version of the payload sent to the custom webhook.
+	PayloadVersion ProjectWebhookPayload_PayloadVersion `protobuf:"varint,1,opt,name=payload_version,json=payloadVersion,proto3,enum=bytebase.store.ProjectWebhookPayload_PayloadVersion" json:"payload_version,omitempty"`
+	// The paths of the fields in the versioned payload sent to the custom webhook, e.g. "issue.name".
+	// All fields are sent if empty.
+	PayloadFields []string `protobuf:"bytes,2,rep,name=payload_fields,json=payloadFields,proto3" json:"payload_fields,omitempty"`
+	// The secret signing the requests to the custom webhook with HMAC-SHA256.
+	// The requests are not signed if empty.
+	SigningSecret string `protobuf:"bytes,3,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+}
+
+func (x *ProjectWebhookPayload) Reset() {
+	*x = ProjectWebhookPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_project_webhook_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectWebhookPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectWebhookPayload) ProtoMessage() {}
+
+func (x *ProjectWebhookPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_webhook_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectWebhookPayload.ProtoReflect.Descriptor instead.
+func (*ProjectWebhookPayload) Descriptor() ([]byte, []int) {
+	return file_store_project_webhook_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProjectWebhookPayload) GetPayloadVersion() ProjectWebhookPayload_PayloadVersion {
+	if x != nil {
+		return x.PayloadVersion
+	}
+	return ProjectWebhookPayload_PAYLOAD_VERSION_UNSPECIFIED
+}
+
+func (x *ProjectWebhookPayload) GetPayloadFields() []string {
+	if x != nil {
+		return x.PayloadFields
+	}
+	return nil
+}
+
+func (x *ProjectWebhookPayload) GetSigningSecret() string {
+	if x != nil {
+		return x.SigningSecret
+	}
+	return ""
+}
+
+var File_store_project_webhook_proto protoreflect.FileDescriptor
+
+var file_store_project_webhook_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0xff, 0x01,
+	0x0a, 0x15, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x5d, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x34, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x22, 0x39, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41,
+	0x44, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x31, 0x10, 0x01, 0x42,
+	0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_store_project_webhook_proto_rawDescOnce sync.Once
+	file_store_project_webhook_proto_rawDescData = file_store_project_webhook_proto_rawDesc
+)
+
+func file_store_project_webhook_proto_rawDescGZIP() []byte {
+	file_store_project_webhook_proto_rawDescOnce.Do(func() {
+		file_store_project_webhook_proto_rawDescData = protoimpl.X.CompressGZIP(file_store_project_webhook_proto_rawDescData)
+	})
+	return file_store_project_webhook_proto_rawDescData
+}
+
+var file_store_project_webhook_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_store_project_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_project_webhook_proto_goTypes = []interface{}{
+	(ProjectWebhookPayload_PayloadVersion)(0), // 0: bytebase.store.ProjectWebhookPayload.PayloadVersion
+	(*ProjectWebhookPayload)(nil),             // 1: bytebase.store.ProjectWebhookPayload
+}
+var file_store_project_webhook_proto_depIdxs = []int32{
+	0, // 0: bytebase.store.ProjectWebhookPayload.payload_version:type_name -> bytebase.store.ProjectWebhookPayload.PayloadVersion
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_store_project_webhook_proto_init() }
+func file_store_project_webhook_proto_init() {
+	if File_store_project_webhook_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_store_project_webhook_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectWebhookPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_store_project_webhook_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_project_webhook_proto_goTypes,
+		DependencyIndexes: file_store_project_webhook_proto_depIdxs,
+		EnumInfos:         file_store_project_webhook_proto_enumTypes,
+		MessageInfos:      file_store_project_webhook_proto_msgTypes,
+	}.Build()
+	File_store_project_webhook_proto = out.File
+	file_store_project_webhook_proto_rawDesc = nil
+	file_store_project_webhook_proto_goTypes = nil
+	file_store_project_webhook_proto_depIdxs = nil
+}
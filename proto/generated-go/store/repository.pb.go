@@ -0,0 +1,266 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: store/repository.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RepositoryPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The routing rules are evaluated in order for each changed file in the repository,
+	// and the first matched rule routes the file to its project instead of the project of the repository.
+	// The files not matched by any rule fall back to the file path template of the repository.
+	RoutingRules []*RepositoryRoutingRule `protobuf:"bytes,1,rep,name=routing_rules,json=routingRules,proto3" json:"routing_rules,omitempty"`
+	// Set to true to post the rollout plan of the pull request as a comment before the merge,
+	// including the target databases and stages, the schema diffs of the SDL files and the SQL review findings.
+	// The plan is generated by the SQL review CI, and the changes are only rolled out after the merge.
+	EnableDryRun bool `protobuf:"varint,2,opt,name=enable_dry_run,json=enableDryRun,proto3" json:"enable_dry_run,omitempty"`
+}
+
+func (x *RepositoryPayload) Reset() {
+	*x = RepositoryPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_repository_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepositoryPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepositoryPayload) ProtoMessage() {}
+
+func (x *RepositoryPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_repository_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepositoryPayload.ProtoReflect.Descriptor instead.
+func (*RepositoryPayload) Descriptor() ([]byte, []int) {
+	return file_store_repository_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RepositoryPayload) GetRoutingRules() []*RepositoryRoutingRule {
+	if x != nil {
+		return x.RoutingRules
+	}
+	return nil
+}
+
+func (x *RepositoryPayload) GetEnableDryRun() bool {
+	if x != nil {
+		return x.EnableDryRun
+	}
+	return false
+}
+
+type RepositoryRoutingRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The glob matching the file path relative to the base directory, e.g. "services/payment/**/*.sql".
+	// "*" matches any characters except "/", and "**" matches any characters including "/".
+	PathGlob string `protobuf:"bytes,1,opt,name=path_glob,json=pathGlob,proto3" json:"path_glob,omitempty"`
+	// The resource ID of the project receiving the changes.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// The resource ID of the database group in the project, optional.
+	// If set, the changes are applied to the databases in the group, otherwise to the database named by the DB_NAME group of the version pattern.
+	DatabaseGroup string `protobuf:"bytes,3,opt,name=database_group,json=databaseGroup,proto3" json:"database_group,omitempty"`
+	// The regular expression matching the file path relative to the base directory.
+	// The named groups VERSION, DB_NAME, TYPE, ENV_ID and DESCRIPTION have the same meaning as the placeholders in the file path template,
+	// e.g. "(?P<VERSION>\\d+)__(?P<TYPE>migrate|data)__(?P<DESCRIPTION>.+)\\.sql$".
+	VersionPattern string `protobuf:"bytes,4,opt,name=version_pattern,json=versionPattern,proto3" json:"version_pattern,omitempty"`
+}
+
+func (x *RepositoryRoutingRule) Reset() {
+	*x = RepositoryRoutingRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_repository_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepositoryRoutingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepositoryRoutingRule) ProtoMessage() {}
+
+func (x *RepositoryRoutingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_store_repository_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepositoryRoutingRule.ProtoReflect.Descriptor instead.
+func (*RepositoryRoutingRule) Descriptor() ([]byte, []int) {
+	return file_store_repository_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RepositoryRoutingRule) GetPathGlob() string {
+	if x != nil {
+		return x.PathGlob
+	}
+	return ""
+}
+
+func (x *RepositoryRoutingRule) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *RepositoryRoutingRule) GetDatabaseGroup() string {
+	if x != nil {
+		return x.DatabaseGroup
+	}
+	return ""
+}
+
+func (x *RepositoryRoutingRule) GetVersionPattern() string {
+	if x != nil {
+		return x.VersionPattern
+	}
+	return ""
+}
+
+var File_store_repository_proto protoreflect.FileDescriptor
+
+var file_store_repository_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x4a,
+	0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x22, 0x9e, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x74, 0x68, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x74, 0x68, 0x47, 0x6c, 0x6f, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67,
+	0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_store_repository_proto_rawDescOnce sync.Once
+	file_store_repository_proto_rawDescData = file_store_repository_proto_rawDesc
+)
+
+func file_store_repository_proto_rawDescGZIP() []byte {
+	file_store_repository_proto_rawDescOnce.Do(func() {
+		file_store_repository_proto_rawDescData = protoimpl.X.CompressGZIP(file_store_repository_proto_rawDescData)
+	})
+	return file_store_repository_proto_rawDescData
+}
+
+var file_store_repository_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_repository_proto_goTypes = []interface{}{
+	(*RepositoryPayload)(nil),     // 0: bytebase.store.RepositoryPayload
+	(*RepositoryRoutingRule)(nil), // 1: bytebase.store.RepositoryRoutingRule
+}
+var file_store_repository_proto_depIdxs = []int32{
+	1, // 0: bytebase.store.RepositoryPayload.routing_rules:type_name -> bytebase.store.RepositoryRoutingRule
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_store_repository_proto_init() }
+func file_store_repository_proto_init() {
+	if File_store_repository_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_store_repository_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepositoryPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_repository_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepositoryRoutingRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_store_repository_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_repository_proto_goTypes,
+		DependencyIndexes: file_store_repository_proto_depIdxs,
+		MessageInfos:      file_store_repository_proto_msgTypes,
+	}.Build()
+	File_store_repository_proto = out.File
+	file_store_repository_proto_rawDesc = nil
+	file_store_repository_proto_goTypes = nil
+	file_store_repository_proto_depIdxs = nil
+}
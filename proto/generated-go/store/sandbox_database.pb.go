@@ -0,0 +1,216 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: store/sandbox_database.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SandboxDatabasePayload_Mode int32
+
+const (
+	SandboxDatabasePayload_MODE_UNSPECIFIED SandboxDatabasePayload_Mode = 0
+	// The sandbox database only has the schema of the source database.
+	SandboxDatabasePayload_SCHEMA_ONLY SandboxDatabasePayload_Mode = 1
+	// The sandbox database has the schema and data of the source database, the sensitive columns are masked.
+	SandboxDatabasePayload_MASKED_DATA SandboxDatabasePayload_Mode = 2
+)
+
+// Enum value maps for SandboxDatabasePayload_Mode.
+var (
+	SandboxDatabasePayload_Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "SCHEMA_ONLY",
+		2: "MASKED_DATA",
+	}
+	SandboxDatabasePayload_Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"SCHEMA_ONLY":      1,
+		"MASKED_DATA":      2,
+	}
+)
+
+func (x SandboxDatabasePayload_Mode) Enum() *SandboxDatabasePayload_Mode {
+	p := new(SandboxDatabasePayload_Mode)
+	*p = x
+	return p
+}
+
+func (x SandboxDatabasePayload_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SandboxDatabasePayload_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_sandbox_database_proto_enumTypes[0].Descriptor()
+}
+
+func (SandboxDatabasePayload_Mode) Type() protoreflect.EnumType {
+	return &file_store_sandbox_database_proto_enumTypes[0]
+}
+
+func (x SandboxDatabasePayload_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SandboxDatabasePayload_Mode.Descriptor instead.
+func (SandboxDatabasePayload_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_store_sandbox_database_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type SandboxDatabasePayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode SandboxDatabasePayload_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=bytebase.store.SandboxDatabasePayload_Mode" json:"mode,omitempty"`
+	// The error of the failed provisioning.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SandboxDatabasePayload) Reset() {
+	*x = SandboxDatabasePayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_sandbox_database_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SandboxDatabasePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SandboxDatabasePayload) ProtoMessage() {}
+
+func (x *SandboxDatabasePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_sandbox_database_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SandboxDatabasePayload.ProtoReflect.Descriptor instead.
+func (*SandboxDatabasePayload) Descriptor() ([]byte, []int) {
+	return file_store_sandbox_database_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SandboxDatabasePayload) GetMode() SandboxDatabasePayload_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return SandboxDatabasePayload_MODE_UNSPECIFIED
+}
+
+func (x *SandboxDatabasePayload) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_store_sandbox_database_proto protoreflect.FileDescriptor
+
+var file_store_sandbox_database_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0xaf,
+	0x01, 0x0a, 0x16, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3f, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x3e, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f,
+	0x0a, 0x0b, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x4d, 0x41, 0x53, 0x4b, 0x45, 0x44, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x02,
+	0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f,
+	0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_store_sandbox_database_proto_rawDescOnce sync.Once
+	file_store_sandbox_database_proto_rawDescData = file_store_sandbox_database_proto_rawDesc
+)
+
+func file_store_sandbox_database_proto_rawDescGZIP() []byte {
+	file_store_sandbox_database_proto_rawDescOnce.Do(func() {
+		file_store_sandbox_database_proto_rawDescData = protoimpl.X.CompressGZIP(file_store_sandbox_database_proto_rawDescData)
+	})
+	return file_store_sandbox_database_proto_rawDescData
+}
+
+var file_store_sandbox_database_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_store_sandbox_database_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_sandbox_database_proto_goTypes = []interface{}{
+	(SandboxDatabasePayload_Mode)(0), // 0: bytebase.store.SandboxDatabasePayload.Mode
+	(*SandboxDatabasePayload)(nil),   // 1: bytebase.store.SandboxDatabasePayload
+}
+var file_store_sandbox_database_proto_depIdxs = []int32{
+	0, // 0: bytebase.store.SandboxDatabasePayload.mode:type_name -> bytebase.store.SandboxDatabasePayload.Mode
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_store_sandbox_database_proto_init() }
+func file_store_sandbox_database_proto_init() {
+	if File_store_sandbox_database_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_store_sandbox_database_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SandboxDatabasePayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_store_sandbox_database_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_sandbox_database_proto_goTypes,
+		DependencyIndexes: file_store_sandbox_database_proto_depIdxs,
+		EnumInfos:         file_store_sandbox_database_proto_enumTypes,
+		MessageInfos:      file_store_sandbox_database_proto_msgTypes,
+	}.Build()
+	File_store_sandbox_database_proto = out.File
+	file_store_sandbox_database_proto_rawDesc = nil
+	file_store_sandbox_database_proto_goTypes = nil
+	file_store_sandbox_database_proto_depIdxs = nil
+}
@@ -0,0 +1,191 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: store/scheduled_query.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ScheduledQueryPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the data source to run the query against, the read-only data source is used if empty.
+	DataSourceId string `protobuf:"bytes,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	// The maximum number of rows of a result snapshot.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// The days to keep the result snapshots.
+	RetentionDays int32 `protobuf:"varint,3,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"`
+	// Post the results to the project webhooks subscribing the scheduled query notification.
+	WebhookDelivery bool `protobuf:"varint,4,opt,name=webhook_delivery,json=webhookDelivery,proto3" json:"webhook_delivery,omitempty"`
+	// The email recipients of the results.
+	EmailRecipients []string `protobuf:"bytes,5,rep,name=email_recipients,json=emailRecipients,proto3" json:"email_recipients,omitempty"`
+}
+
+func (x *ScheduledQueryPayload) Reset() {
+	*x = ScheduledQueryPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_scheduled_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledQueryPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledQueryPayload) ProtoMessage() {}
+
+func (x *ScheduledQueryPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_scheduled_query_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledQueryPayload.ProtoReflect.Descriptor instead.
+func (*ScheduledQueryPayload) Descriptor() ([]byte, []int) {
+	return file_store_scheduled_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ScheduledQueryPayload) GetDataSourceId() string {
+	if x != nil {
+		return x.DataSourceId
+	}
+	return ""
+}
+
+func (x *ScheduledQueryPayload) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ScheduledQueryPayload) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+func (x *ScheduledQueryPayload) GetWebhookDelivery() bool {
+	if x != nil {
+		return x.WebhookDelivery
+	}
+	return false
+}
+
+func (x *ScheduledQueryPayload) GetEmailRecipients() []string {
+	if x != nil {
+		return x.EmailRecipients
+	}
+	return nil
+}
+
+var File_store_scheduled_query_proto protoreflect.FileDescriptor
+
+var file_store_scheduled_query_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0xd0, 0x01,
+	0x0a, 0x15, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f,
+	0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_store_scheduled_query_proto_rawDescOnce sync.Once
+	file_store_scheduled_query_proto_rawDescData = file_store_scheduled_query_proto_rawDesc
+)
+
+func file_store_scheduled_query_proto_rawDescGZIP() []byte {
+	file_store_scheduled_query_proto_rawDescOnce.Do(func() {
+		file_store_scheduled_query_proto_rawDescData = protoimpl.X.CompressGZIP(file_store_scheduled_query_proto_rawDescData)
+	})
+	return file_store_scheduled_query_proto_rawDescData
+}
+
+var file_store_scheduled_query_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_scheduled_query_proto_goTypes = []interface{}{
+	(*ScheduledQueryPayload)(nil), // 0: bytebase.store.ScheduledQueryPayload
+}
+var file_store_scheduled_query_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_store_scheduled_query_proto_init() }
+func file_store_scheduled_query_proto_init() {
+	if File_store_scheduled_query_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_store_scheduled_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledQueryPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_store_scheduled_query_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_scheduled_query_proto_goTypes,
+		DependencyIndexes: file_store_scheduled_query_proto_depIdxs,
+		MessageInfos:      file_store_scheduled_query_proto_msgTypes,
+	}.Build()
+	File_store_scheduled_query_proto = out.File
+	file_store_scheduled_query_proto_rawDesc = nil
+	file_store_scheduled_query_proto_goTypes = nil
+	file_store_scheduled_query_proto_depIdxs = nil
+}
@@ -0,0 +1,156 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: store/scim_group.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SCIMGroupPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// external_id is the identifier of the group in the identity provider.
+	ExternalId string `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// member_ids are the principal IDs of the group members.
+	MemberIds []int32 `protobuf:"varint,2,rep,packed,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+}
+
+func (x *SCIMGroupPayload) Reset() {
+	*x = SCIMGroupPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_scim_group_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SCIMGroupPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SCIMGroupPayload) ProtoMessage() {}
+
+func (x *SCIMGroupPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_scim_group_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SCIMGroupPayload.ProtoReflect.Descriptor instead.
+func (*SCIMGroupPayload) Descriptor() ([]byte, []int) {
+	return file_store_scim_group_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SCIMGroupPayload) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *SCIMGroupPayload) GetMemberIds() []int32 {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+var File_store_scim_group_proto protoreflect.FileDescriptor
+
+var file_store_scim_group_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x63, 0x69, 0x6d, 0x5f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x52, 0x0a, 0x10, 0x53, 0x43, 0x49, 0x4d,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x73, 0x42, 0x14, 0x5a, 0x12,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_store_scim_group_proto_rawDescOnce sync.Once
+	file_store_scim_group_proto_rawDescData = file_store_scim_group_proto_rawDesc
+)
+
+func file_store_scim_group_proto_rawDescGZIP() []byte {
+	file_store_scim_group_proto_rawDescOnce.Do(func() {
+		file_store_scim_group_proto_rawDescData = protoimpl.X.CompressGZIP(file_store_scim_group_proto_rawDescData)
+	})
+	return file_store_scim_group_proto_rawDescData
+}
+
+var file_store_scim_group_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_scim_group_proto_goTypes = []interface{}{
+	(*SCIMGroupPayload)(nil), // 0: bytebase.store.SCIMGroupPayload
+}
+var file_store_scim_group_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_store_scim_group_proto_init() }
+func file_store_scim_group_proto_init() {
+	if File_store_scim_group_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_store_scim_group_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SCIMGroupPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_store_scim_group_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_scim_group_proto_goTypes,
+		DependencyIndexes: file_store_scim_group_proto_depIdxs,
+		MessageInfos:      file_store_scim_group_proto_msgTypes,
+	}.Build()
+	File_store_scim_group_proto = out.File
+	file_store_scim_group_proto_rawDesc = nil
+	file_store_scim_group_proto_goTypes = nil
+	file_store_scim_group_proto_depIdxs = nil
+}
@@ -12,6 +12,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -76,6 +77,65 @@ func (Announcement_AlertLevel) EnumDescriptor() ([]byte, []int) {
 	return file_store_setting_proto_rawDescGZIP(), []int{1, 0}
 }
 
+// How the decision of the external approval is learned.
+type ExternalApprovalSetting_Node_Mode int32
+
+const (
+	// The unspecified mode is treated as POLL.
+	ExternalApprovalSetting_Node_MODE_UNSPECIFIED ExternalApprovalSetting_Node_Mode = 0
+	// The relay service is polled for the decision.
+	ExternalApprovalSetting_Node_POLL ExternalApprovalSetting_Node_Mode = 1
+	// The external service, e.g. ServiceNow or Jira, posts the decision to the callback URL
+	// sent in the create request. It requires the workspace external URL.
+	ExternalApprovalSetting_Node_CALLBACK ExternalApprovalSetting_Node_Mode = 2
+	// The approval is decided by the status of the linked Jira ticket of the issue.
+	// It requires the workspace Jira setting.
+	ExternalApprovalSetting_Node_JIRA ExternalApprovalSetting_Node_Mode = 3
+)
+
+// Enum value maps for ExternalApprovalSetting_Node_Mode.
+var (
+	ExternalApprovalSetting_Node_Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "POLL",
+		2: "CALLBACK",
+		3: "JIRA",
+	}
+	ExternalApprovalSetting_Node_Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"POLL":             1,
+		"CALLBACK":         2,
+		"JIRA":             3,
+	}
+)
+
+func (x ExternalApprovalSetting_Node_Mode) Enum() *ExternalApprovalSetting_Node_Mode {
+	p := new(ExternalApprovalSetting_Node_Mode)
+	*p = x
+	return p
+}
+
+func (x ExternalApprovalSetting_Node_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExternalApprovalSetting_Node_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_setting_proto_enumTypes[1].Descriptor()
+}
+
+func (ExternalApprovalSetting_Node_Mode) Type() protoreflect.EnumType {
+	return &file_store_setting_proto_enumTypes[1]
+}
+
+func (x ExternalApprovalSetting_Node_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExternalApprovalSetting_Node_Mode.Descriptor instead.
+func (ExternalApprovalSetting_Node_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{4, 0, 0}
+}
+
 // We support three types of SMTP encryption: NONE, STARTTLS, and SSL/TLS.
 type SMTPMailDeliverySetting_Encryption int32
 
@@ -113,11 +173,11 @@ func (x SMTPMailDeliverySetting_Encryption) String() string {
 }
 
 func (SMTPMailDeliverySetting_Encryption) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_setting_proto_enumTypes[1].Descriptor()
+	return file_store_setting_proto_enumTypes[2].Descriptor()
 }
 
 func (SMTPMailDeliverySetting_Encryption) Type() protoreflect.EnumType {
-	return &file_store_setting_proto_enumTypes[1]
+	return &file_store_setting_proto_enumTypes[2]
 }
 
 func (x SMTPMailDeliverySetting_Encryption) Number() protoreflect.EnumNumber {
@@ -170,11 +230,11 @@ func (x SMTPMailDeliverySetting_Authentication) String() string {
 }
 
 func (SMTPMailDeliverySetting_Authentication) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_setting_proto_enumTypes[2].Descriptor()
+	return file_store_setting_proto_enumTypes[3].Descriptor()
 }
 
 func (SMTPMailDeliverySetting_Authentication) Type() protoreflect.EnumType {
-	return &file_store_setting_proto_enumTypes[2]
+	return &file_store_setting_proto_enumTypes[3]
 }
 
 func (x SMTPMailDeliverySetting_Authentication) Number() protoreflect.EnumNumber {
@@ -186,6 +246,157 @@ func (SMTPMailDeliverySetting_Authentication) EnumDescriptor() ([]byte, []int) {
 	return file_store_setting_proto_rawDescGZIP(), []int{5, 1}
 }
 
+type EventBusSetting_Type int32
+
+const (
+	// The events are not published.
+	EventBusSetting_TYPE_UNSPECIFIED EventBusSetting_Type = 0
+	EventBusSetting_KAFKA            EventBusSetting_Type = 1
+	EventBusSetting_NATS             EventBusSetting_Type = 2
+)
+
+// Enum value maps for EventBusSetting_Type.
+var (
+	EventBusSetting_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "KAFKA",
+		2: "NATS",
+	}
+	EventBusSetting_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"KAFKA":            1,
+		"NATS":             2,
+	}
+)
+
+func (x EventBusSetting_Type) Enum() *EventBusSetting_Type {
+	p := new(EventBusSetting_Type)
+	*p = x
+	return p
+}
+
+func (x EventBusSetting_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventBusSetting_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_setting_proto_enumTypes[4].Descriptor()
+}
+
+func (EventBusSetting_Type) Type() protoreflect.EnumType {
+	return &file_store_setting_proto_enumTypes[4]
+}
+
+func (x EventBusSetting_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventBusSetting_Type.Descriptor instead.
+func (EventBusSetting_Type) EnumDescriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{18, 0}
+}
+
+type AuditLogSetting_ExportType int32
+
+const (
+	// The audit logs are not exported.
+	AuditLogSetting_EXPORT_TYPE_UNSPECIFIED AuditLogSetting_ExportType = 0
+	AuditLogSetting_S3                      AuditLogSetting_ExportType = 1
+	AuditLogSetting_SYSLOG                  AuditLogSetting_ExportType = 2
+)
+
+// Enum value maps for AuditLogSetting_ExportType.
+var (
+	AuditLogSetting_ExportType_name = map[int32]string{
+		0: "EXPORT_TYPE_UNSPECIFIED",
+		1: "S3",
+		2: "SYSLOG",
+	}
+	AuditLogSetting_ExportType_value = map[string]int32{
+		"EXPORT_TYPE_UNSPECIFIED": 0,
+		"S3":                      1,
+		"SYSLOG":                  2,
+	}
+)
+
+func (x AuditLogSetting_ExportType) Enum() *AuditLogSetting_ExportType {
+	p := new(AuditLogSetting_ExportType)
+	*p = x
+	return p
+}
+
+func (x AuditLogSetting_ExportType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditLogSetting_ExportType) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_setting_proto_enumTypes[5].Descriptor()
+}
+
+func (AuditLogSetting_ExportType) Type() protoreflect.EnumType {
+	return &file_store_setting_proto_enumTypes[5]
+}
+
+func (x AuditLogSetting_ExportType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditLogSetting_ExportType.Descriptor instead.
+func (AuditLogSetting_ExportType) EnumDescriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{20, 0}
+}
+
+type InstanceDiscoverySetting_Source_Provider int32
+
+const (
+	InstanceDiscoverySetting_Source_PROVIDER_UNSPECIFIED InstanceDiscoverySetting_Source_Provider = 0
+	// AWS lists the RDS instances and the Aurora clusters.
+	InstanceDiscoverySetting_Source_AWS InstanceDiscoverySetting_Source_Provider = 1
+	// GCP lists the Cloud SQL instances.
+	InstanceDiscoverySetting_Source_GCP InstanceDiscoverySetting_Source_Provider = 2
+)
+
+// Enum value maps for InstanceDiscoverySetting_Source_Provider.
+var (
+	InstanceDiscoverySetting_Source_Provider_name = map[int32]string{
+		0: "PROVIDER_UNSPECIFIED",
+		1: "AWS",
+		2: "GCP",
+	}
+	InstanceDiscoverySetting_Source_Provider_value = map[string]int32{
+		"PROVIDER_UNSPECIFIED": 0,
+		"AWS":                  1,
+		"GCP":                  2,
+	}
+)
+
+func (x InstanceDiscoverySetting_Source_Provider) Enum() *InstanceDiscoverySetting_Source_Provider {
+	p := new(InstanceDiscoverySetting_Source_Provider)
+	*p = x
+	return p
+}
+
+func (x InstanceDiscoverySetting_Source_Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstanceDiscoverySetting_Source_Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_setting_proto_enumTypes[6].Descriptor()
+}
+
+func (InstanceDiscoverySetting_Source_Provider) Type() protoreflect.EnumType {
+	return &file_store_setting_proto_enumTypes[6]
+}
+
+func (x InstanceDiscoverySetting_Source_Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstanceDiscoverySetting_Source_Provider.Descriptor instead.
+func (InstanceDiscoverySetting_Source_Provider) EnumDescriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{22, 0, 0}
+}
+
 type WorkspaceProfileSetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -211,6 +422,12 @@ type WorkspaceProfileSetting struct {
 	TokenDuration *durationpb.Duration `protobuf:"bytes,6,opt,name=token_duration,json=tokenDuration,proto3" json:"token_duration,omitempty"`
 	// The setting of custom announcement
 	Announcement *Announcement `protobuf:"bytes,7,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	// The maximum number of the concurrent sessions of an end user.
+	// The oldest sessions are revoked when a new session exceeds the limit. No limit if not set.
+	MaximumSessionCount int32 `protobuf:"varint,8,opt,name=maximum_session_count,json=maximumSessionCount,proto3" json:"maximum_session_count,omitempty"`
+	// The idle timeout of the sessions.
+	// A session expires if there is no request during the idle timeout. No idle timeout if not set.
+	SessionIdleTimeout *durationpb.Duration `protobuf:"bytes,9,opt,name=session_idle_timeout,json=sessionIdleTimeout,proto3" json:"session_idle_timeout,omitempty"`
 }
 
 func (x *WorkspaceProfileSetting) Reset() {
@@ -294,6 +511,20 @@ func (x *WorkspaceProfileSetting) GetAnnouncement() *Announcement {
 	return nil
 }
 
+func (x *WorkspaceProfileSetting) GetMaximumSessionCount() int32 {
+	if x != nil {
+		return x.MaximumSessionCount
+	}
+	return 0
+}
+
+func (x *WorkspaceProfileSetting) GetSessionIdleTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.SessionIdleTimeout
+	}
+	return nil
+}
+
 type Announcement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -840,18 +1071,21 @@ func (x *MaskingAlgorithmSetting) GetAlgorithms() []*MaskingAlgorithmSetting_Alg
 	return nil
 }
 
-type WorkspaceApprovalSetting_Rule struct {
+type RateLimitSetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Expression *v1alpha1.ParsedExpr `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
-	Template   *ApprovalTemplate    `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
-	Condition  *expr.Expr           `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	// user_quota is the default quota for each end user.
+	UserQuota *RateLimitSetting_Quota `protobuf:"bytes,1,opt,name=user_quota,json=userQuota,proto3" json:"user_quota,omitempty"`
+	// service_account_quota is the default quota for each service account token.
+	ServiceAccountQuota *RateLimitSetting_Quota `protobuf:"bytes,2,opt,name=service_account_quota,json=serviceAccountQuota,proto3" json:"service_account_quota,omitempty"`
+	// overrides are the quotas for specific principals, taking precedence over the default quotas.
+	Overrides []*RateLimitSetting_Override `protobuf:"bytes,3,rep,name=overrides,proto3" json:"overrides,omitempty"`
 }
 
-func (x *WorkspaceApprovalSetting_Rule) Reset() {
-	*x = WorkspaceApprovalSetting_Rule{}
+func (x *RateLimitSetting) Reset() {
+	*x = RateLimitSetting{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_setting_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -859,13 +1093,13 @@ func (x *WorkspaceApprovalSetting_Rule) Reset() {
 	}
 }
 
-func (x *WorkspaceApprovalSetting_Rule) String() string {
+func (x *RateLimitSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceApprovalSetting_Rule) ProtoMessage() {}
+func (*RateLimitSetting) ProtoMessage() {}
 
-func (x *WorkspaceApprovalSetting_Rule) ProtoReflect() protoreflect.Message {
+func (x *RateLimitSetting) ProtoReflect() protoreflect.Message {
 	mi := &file_store_setting_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -877,49 +1111,60 @@ func (x *WorkspaceApprovalSetting_Rule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceApprovalSetting_Rule.ProtoReflect.Descriptor instead.
-func (*WorkspaceApprovalSetting_Rule) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use RateLimitSetting.ProtoReflect.Descriptor instead.
+func (*RateLimitSetting) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *WorkspaceApprovalSetting_Rule) GetExpression() *v1alpha1.ParsedExpr {
+func (x *RateLimitSetting) GetUserQuota() *RateLimitSetting_Quota {
 	if x != nil {
-		return x.Expression
+		return x.UserQuota
 	}
 	return nil
 }
 
-func (x *WorkspaceApprovalSetting_Rule) GetTemplate() *ApprovalTemplate {
+func (x *RateLimitSetting) GetServiceAccountQuota() *RateLimitSetting_Quota {
 	if x != nil {
-		return x.Template
+		return x.ServiceAccountQuota
 	}
 	return nil
 }
 
-func (x *WorkspaceApprovalSetting_Rule) GetCondition() *expr.Expr {
+func (x *RateLimitSetting) GetOverrides() []*RateLimitSetting_Override {
 	if x != nil {
-		return x.Condition
+		return x.Overrides
 	}
 	return nil
 }
 
-type ExternalApprovalSetting_Node struct {
+type QueryAuditSetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A unique identifier for a node in UUID format.
-	// We will also include the id in the message sending to the external relay
-	// service to identify the node.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The title of the node.
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// The external endpoint for the relay service, e.g. "http://hello:1234".
-	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-}
-
-func (x *ExternalApprovalSetting_Node) Reset() {
-	*x = ExternalApprovalSetting_Node{}
+	// enabled is whether to score the SQL editor and admin executions for suspicious patterns.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// sample_rate is the ratio of the executions recorded with their anomaly score in the audit log, between 0 and 1.
+	// The executions reaching the alert score are always recorded.
+	SampleRate float64 `protobuf:"fixed64,2,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	// alert_score is the anomaly score between 1 and 100 at which an alert is raised.
+	AlertScore int32 `protobuf:"varint,3,opt,name=alert_score,json=alertScore,proto3" json:"alert_score,omitempty"`
+	// classified_rows_threshold is the number of rows with classified columns selected at once considered as a mass select, 0 means disabled.
+	ClassifiedRowsThreshold int64 `protobuf:"varint,4,opt,name=classified_rows_threshold,json=classifiedRowsThreshold,proto3" json:"classified_rows_threshold,omitempty"`
+	// export_rows_threshold is the number of rows exported at once considered as a large export, 0 means disabled.
+	ExportRowsThreshold int64 `protobuf:"varint,5,opt,name=export_rows_threshold,json=exportRowsThreshold,proto3" json:"export_rows_threshold,omitempty"`
+	// working_hour_start and working_hour_end are the working hours in [0, 24), the executions outside are considered unusual.
+	// The unusual hours check is disabled if they are equal.
+	WorkingHourStart int32 `protobuf:"varint,6,opt,name=working_hour_start,json=workingHourStart,proto3" json:"working_hour_start,omitempty"`
+	WorkingHourEnd   int32 `protobuf:"varint,7,opt,name=working_hour_end,json=workingHourEnd,proto3" json:"working_hour_end,omitempty"`
+	// time_zone is the time zone of the working hours, e.g. "Asia/Shanghai", UTC is used if empty.
+	TimeZone string `protobuf:"bytes,8,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// export_watermark is whether to inject an invisible watermark of the requester and the export time into the exported CSV and XLSX files.
+	ExportWatermark bool `protobuf:"varint,9,opt,name=export_watermark,json=exportWatermark,proto3" json:"export_watermark,omitempty"`
+}
+
+func (x *QueryAuditSetting) Reset() {
+	*x = QueryAuditSetting{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_setting_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -927,13 +1172,13 @@ func (x *ExternalApprovalSetting_Node) Reset() {
 	}
 }
 
-func (x *ExternalApprovalSetting_Node) String() string {
+func (x *QueryAuditSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExternalApprovalSetting_Node) ProtoMessage() {}
+func (*QueryAuditSetting) ProtoMessage() {}
 
-func (x *ExternalApprovalSetting_Node) ProtoReflect() protoreflect.Message {
+func (x *QueryAuditSetting) ProtoReflect() protoreflect.Message {
 	mi := &file_store_setting_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -945,46 +1190,89 @@ func (x *ExternalApprovalSetting_Node) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExternalApprovalSetting_Node.ProtoReflect.Descriptor instead.
-func (*ExternalApprovalSetting_Node) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use QueryAuditSetting.ProtoReflect.Descriptor instead.
+func (*QueryAuditSetting) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ExternalApprovalSetting_Node) GetId() string {
+func (x *QueryAuditSetting) GetEnabled() bool {
 	if x != nil {
-		return x.Id
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *ExternalApprovalSetting_Node) GetTitle() string {
+func (x *QueryAuditSetting) GetSampleRate() float64 {
 	if x != nil {
-		return x.Title
+		return x.SampleRate
 	}
-	return ""
+	return 0
 }
 
-func (x *ExternalApprovalSetting_Node) GetEndpoint() string {
+func (x *QueryAuditSetting) GetAlertScore() int32 {
 	if x != nil {
-		return x.Endpoint
+		return x.AlertScore
+	}
+	return 0
+}
+
+func (x *QueryAuditSetting) GetClassifiedRowsThreshold() int64 {
+	if x != nil {
+		return x.ClassifiedRowsThreshold
+	}
+	return 0
+}
+
+func (x *QueryAuditSetting) GetExportRowsThreshold() int64 {
+	if x != nil {
+		return x.ExportRowsThreshold
+	}
+	return 0
+}
+
+func (x *QueryAuditSetting) GetWorkingHourStart() int32 {
+	if x != nil {
+		return x.WorkingHourStart
+	}
+	return 0
+}
+
+func (x *QueryAuditSetting) GetWorkingHourEnd() int32 {
+	if x != nil {
+		return x.WorkingHourEnd
+	}
+	return 0
+}
+
+func (x *QueryAuditSetting) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
 	}
 	return ""
 }
 
-type SchemaTemplateSetting_FieldTemplate struct {
+func (x *QueryAuditSetting) GetExportWatermark() bool {
+	if x != nil {
+		return x.ExportWatermark
+	}
+	return false
+}
+
+type QueryCursorSetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Engine   Engine          `protobuf:"varint,2,opt,name=engine,proto3,enum=bytebase.store.Engine" json:"engine,omitempty"`
-	Category string          `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	Column   *ColumnMetadata `protobuf:"bytes,4,opt,name=column,proto3" json:"column,omitempty"`
-	Config   *ColumnConfig   `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	// max_total_rows is the maximum number of rows fetched through a query cursor, 0 means the default 1,000,000 rows.
+	MaxTotalRows int64 `protobuf:"varint,1,opt,name=max_total_rows,json=maxTotalRows,proto3" json:"max_total_rows,omitempty"`
+	// max_total_bytes is the maximum bytes of the rows fetched through a query cursor, 0 means the default 1 GiB.
+	MaxTotalBytes int64 `protobuf:"varint,2,opt,name=max_total_bytes,json=maxTotalBytes,proto3" json:"max_total_bytes,omitempty"`
+	// idle_timeout_seconds is the seconds after which a query cursor not fetched or kept alive is closed, 0 means the default 5 minutes.
+	IdleTimeoutSeconds int32 `protobuf:"varint,3,opt,name=idle_timeout_seconds,json=idleTimeoutSeconds,proto3" json:"idle_timeout_seconds,omitempty"`
 }
 
-func (x *SchemaTemplateSetting_FieldTemplate) Reset() {
-	*x = SchemaTemplateSetting_FieldTemplate{}
+func (x *QueryCursorSetting) Reset() {
+	*x = QueryCursorSetting{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_setting_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -992,13 +1280,13 @@ func (x *SchemaTemplateSetting_FieldTemplate) Reset() {
 	}
 }
 
-func (x *SchemaTemplateSetting_FieldTemplate) String() string {
+func (x *QueryCursorSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchemaTemplateSetting_FieldTemplate) ProtoMessage() {}
+func (*QueryCursorSetting) ProtoMessage() {}
 
-func (x *SchemaTemplateSetting_FieldTemplate) ProtoReflect() protoreflect.Message {
+func (x *QueryCursorSetting) ProtoReflect() protoreflect.Message {
 	mi := &file_store_setting_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1010,58 +1298,45 @@ func (x *SchemaTemplateSetting_FieldTemplate) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchemaTemplateSetting_FieldTemplate.ProtoReflect.Descriptor instead.
-func (*SchemaTemplateSetting_FieldTemplate) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{6, 0}
-}
-
-func (x *SchemaTemplateSetting_FieldTemplate) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *SchemaTemplateSetting_FieldTemplate) GetEngine() Engine {
-	if x != nil {
-		return x.Engine
-	}
-	return Engine_ENGINE_UNSPECIFIED
+// Deprecated: Use QueryCursorSetting.ProtoReflect.Descriptor instead.
+func (*QueryCursorSetting) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SchemaTemplateSetting_FieldTemplate) GetCategory() string {
+func (x *QueryCursorSetting) GetMaxTotalRows() int64 {
 	if x != nil {
-		return x.Category
+		return x.MaxTotalRows
 	}
-	return ""
+	return 0
 }
 
-func (x *SchemaTemplateSetting_FieldTemplate) GetColumn() *ColumnMetadata {
+func (x *QueryCursorSetting) GetMaxTotalBytes() int64 {
 	if x != nil {
-		return x.Column
+		return x.MaxTotalBytes
 	}
-	return nil
+	return 0
 }
 
-func (x *SchemaTemplateSetting_FieldTemplate) GetConfig() *ColumnConfig {
+func (x *QueryCursorSetting) GetIdleTimeoutSeconds() int32 {
 	if x != nil {
-		return x.Config
+		return x.IdleTimeoutSeconds
 	}
-	return nil
+	return 0
 }
 
-type SchemaTemplateSetting_ColumnType struct {
+type QueryHistorySetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Engine  Engine   `protobuf:"varint,1,opt,name=engine,proto3,enum=bytebase.store.Engine" json:"engine,omitempty"`
-	Enabled bool     `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Types   []string `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty"`
+	// disabled is whether to stop recording the SQL Editor queries in the query history.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// retention_days is the days to keep the query history, 0 means the default 90 days.
+	RetentionDays int32 `protobuf:"varint,2,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"`
 }
 
-func (x *SchemaTemplateSetting_ColumnType) Reset() {
-	*x = SchemaTemplateSetting_ColumnType{}
+func (x *QueryHistorySetting) Reset() {
+	*x = QueryHistorySetting{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_setting_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1069,13 +1344,13 @@ func (x *SchemaTemplateSetting_ColumnType) Reset() {
 	}
 }
 
-func (x *SchemaTemplateSetting_ColumnType) String() string {
+func (x *QueryHistorySetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchemaTemplateSetting_ColumnType) ProtoMessage() {}
+func (*QueryHistorySetting) ProtoMessage() {}
 
-func (x *SchemaTemplateSetting_ColumnType) ProtoReflect() protoreflect.Message {
+func (x *QueryHistorySetting) ProtoReflect() protoreflect.Message {
 	mi := &file_store_setting_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1087,46 +1362,38 @@ func (x *SchemaTemplateSetting_ColumnType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchemaTemplateSetting_ColumnType.ProtoReflect.Descriptor instead.
-func (*SchemaTemplateSetting_ColumnType) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{6, 1}
+// Deprecated: Use QueryHistorySetting.ProtoReflect.Descriptor instead.
+func (*QueryHistorySetting) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SchemaTemplateSetting_ColumnType) GetEngine() Engine {
+func (x *QueryHistorySetting) GetDisabled() bool {
 	if x != nil {
-		return x.Engine
+		return x.Disabled
 	}
-	return Engine_ENGINE_UNSPECIFIED
+	return false
 }
 
-func (x *SchemaTemplateSetting_ColumnType) GetEnabled() bool {
+func (x *QueryHistorySetting) GetRetentionDays() int32 {
 	if x != nil {
-		return x.Enabled
+		return x.RetentionDays
 	}
-	return false
+	return 0
 }
 
-func (x *SchemaTemplateSetting_ColumnType) GetTypes() []string {
-	if x != nil {
-		return x.Types
-	}
-	return nil
-}
-
-type SchemaTemplateSetting_TableTemplate struct {
+type ArchiveSetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Engine   Engine         `protobuf:"varint,2,opt,name=engine,proto3,enum=bytebase.store.Engine" json:"engine,omitempty"`
-	Category string         `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	Table    *TableMetadata `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
-	Config   *TableConfig   `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	// issue_retention_days is the days after which the done and canceled issues and their rollouts are archived, 0 means never.
+	IssueRetentionDays int32 `protobuf:"varint,1,opt,name=issue_retention_days,json=issueRetentionDays,proto3" json:"issue_retention_days,omitempty"`
+	// database_retention_days is the days after which the databases not found in the instances are archived, 0 means never.
+	DatabaseRetentionDays int32 `protobuf:"varint,2,opt,name=database_retention_days,json=databaseRetentionDays,proto3" json:"database_retention_days,omitempty"`
 }
 
-func (x *SchemaTemplateSetting_TableTemplate) Reset() {
-	*x = SchemaTemplateSetting_TableTemplate{}
+func (x *ArchiveSetting) Reset() {
+	*x = ArchiveSetting{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_setting_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1134,13 +1401,13 @@ func (x *SchemaTemplateSetting_TableTemplate) Reset() {
 	}
 }
 
-func (x *SchemaTemplateSetting_TableTemplate) String() string {
+func (x *ArchiveSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchemaTemplateSetting_TableTemplate) ProtoMessage() {}
+func (*ArchiveSetting) ProtoMessage() {}
 
-func (x *SchemaTemplateSetting_TableTemplate) ProtoReflect() protoreflect.Message {
+func (x *ArchiveSetting) ProtoReflect() protoreflect.Message {
 	mi := &file_store_setting_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1152,65 +1419,56 @@ func (x *SchemaTemplateSetting_TableTemplate) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchemaTemplateSetting_TableTemplate.ProtoReflect.Descriptor instead.
-func (*SchemaTemplateSetting_TableTemplate) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{6, 2}
-}
-
-func (x *SchemaTemplateSetting_TableTemplate) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *SchemaTemplateSetting_TableTemplate) GetEngine() Engine {
-	if x != nil {
-		return x.Engine
-	}
-	return Engine_ENGINE_UNSPECIFIED
-}
-
-func (x *SchemaTemplateSetting_TableTemplate) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
+// Deprecated: Use ArchiveSetting.ProtoReflect.Descriptor instead.
+func (*ArchiveSetting) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *SchemaTemplateSetting_TableTemplate) GetTable() *TableMetadata {
+func (x *ArchiveSetting) GetIssueRetentionDays() int32 {
 	if x != nil {
-		return x.Table
+		return x.IssueRetentionDays
 	}
-	return nil
+	return 0
 }
 
-func (x *SchemaTemplateSetting_TableTemplate) GetConfig() *TableConfig {
+func (x *ArchiveSetting) GetDatabaseRetentionDays() int32 {
 	if x != nil {
-		return x.Config
+		return x.DatabaseRetentionDays
 	}
-	return nil
+	return 0
 }
 
-type DataClassificationSetting_DataClassificationConfig struct {
+type JiraSetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the uuid for classification. Each project can chose one
-	// classification config.
-	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// levels is user defined level list for classification.
-	// The order for the level decides its priority.
-	Levels []*DataClassificationSetting_DataClassificationConfig_Level `protobuf:"bytes,3,rep,name=levels,proto3" json:"levels,omitempty"`
-	// classification is the id - DataClassification map.
-	// The id should in [0-9]+-[0-9]+-[0-9]+ format.
-	Classification map[string]*DataClassificationSetting_DataClassificationConfig_DataClassification `protobuf:"bytes,4,rep,name=classification,proto3" json:"classification,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-}
-
-func (x *DataClassificationSetting_DataClassificationConfig) Reset() {
-	*x = DataClassificationSetting_DataClassificationConfig{}
+	// url is the base URL of the Jira site, e.g. "https://example.atlassian.net".
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// email is the email of the Jira account calling the Jira API.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// api_token is the API token of the Jira account.
+	ApiToken string `protobuf:"bytes,3,opt,name=api_token,json=apiToken,proto3" json:"api_token,omitempty"`
+	// project_key is the key of the Jira project where the linked tickets are created.
+	ProjectKey string `protobuf:"bytes,4,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// issue_type is the type of the linked tickets, e.g. "Task".
+	IssueType string `protobuf:"bytes,5,opt,name=issue_type,json=issueType,proto3" json:"issue_type,omitempty"`
+	// webhook_token authenticates the Jira webhooks posted to "/hook/jira/{webhook_token}".
+	WebhookToken string `protobuf:"bytes,6,opt,name=webhook_token,json=webhookToken,proto3" json:"webhook_token,omitempty"`
+	// open_status is the Jira status mirrored with the open issues.
+	OpenStatus string `protobuf:"bytes,7,opt,name=open_status,json=openStatus,proto3" json:"open_status,omitempty"`
+	// done_status is the Jira status mirrored with the done issues.
+	DoneStatus string `protobuf:"bytes,8,opt,name=done_status,json=doneStatus,proto3" json:"done_status,omitempty"`
+	// canceled_status is the Jira status mirrored with the canceled issues.
+	CanceledStatus string `protobuf:"bytes,9,opt,name=canceled_status,json=canceledStatus,proto3" json:"canceled_status,omitempty"`
+	// approved_status is the Jira status approving the pending JIRA mode external approval nodes.
+	ApprovedStatus string `protobuf:"bytes,10,opt,name=approved_status,json=approvedStatus,proto3" json:"approved_status,omitempty"`
+	// rejected_status is the Jira status rejecting the pending JIRA mode external approval nodes.
+	RejectedStatus string `protobuf:"bytes,11,opt,name=rejected_status,json=rejectedStatus,proto3" json:"rejected_status,omitempty"`
+}
+
+func (x *JiraSetting) Reset() {
+	*x = JiraSetting{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_setting_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1218,13 +1476,13 @@ func (x *DataClassificationSetting_DataClassificationConfig) Reset() {
 	}
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig) String() string {
+func (x *JiraSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataClassificationSetting_DataClassificationConfig) ProtoMessage() {}
+func (*JiraSetting) ProtoMessage() {}
 
-func (x *DataClassificationSetting_DataClassificationConfig) ProtoReflect() protoreflect.Message {
+func (x *JiraSetting) ProtoReflect() protoreflect.Message {
 	mi := &file_store_setting_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1236,51 +1494,107 @@ func (x *DataClassificationSetting_DataClassificationConfig) ProtoReflect() prot
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataClassificationSetting_DataClassificationConfig.ProtoReflect.Descriptor instead.
-func (*DataClassificationSetting_DataClassificationConfig) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{7, 0}
+// Deprecated: Use JiraSetting.ProtoReflect.Descriptor instead.
+func (*JiraSetting) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig) GetId() string {
+func (x *JiraSetting) GetUrl() string {
 	if x != nil {
-		return x.Id
+		return x.Url
 	}
 	return ""
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig) GetTitle() string {
+func (x *JiraSetting) GetEmail() string {
 	if x != nil {
-		return x.Title
+		return x.Email
 	}
 	return ""
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig) GetLevels() []*DataClassificationSetting_DataClassificationConfig_Level {
+func (x *JiraSetting) GetApiToken() string {
 	if x != nil {
-		return x.Levels
+		return x.ApiToken
 	}
-	return nil
+	return ""
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig) GetClassification() map[string]*DataClassificationSetting_DataClassificationConfig_DataClassification {
+func (x *JiraSetting) GetProjectKey() string {
 	if x != nil {
-		return x.Classification
+		return x.ProjectKey
 	}
-	return nil
+	return ""
 }
 
-type DataClassificationSetting_DataClassificationConfig_Level struct {
+func (x *JiraSetting) GetIssueType() string {
+	if x != nil {
+		return x.IssueType
+	}
+	return ""
+}
+
+func (x *JiraSetting) GetWebhookToken() string {
+	if x != nil {
+		return x.WebhookToken
+	}
+	return ""
+}
+
+func (x *JiraSetting) GetOpenStatus() string {
+	if x != nil {
+		return x.OpenStatus
+	}
+	return ""
+}
+
+func (x *JiraSetting) GetDoneStatus() string {
+	if x != nil {
+		return x.DoneStatus
+	}
+	return ""
+}
+
+func (x *JiraSetting) GetCanceledStatus() string {
+	if x != nil {
+		return x.CanceledStatus
+	}
+	return ""
+}
+
+func (x *JiraSetting) GetApprovedStatus() string {
+	if x != nil {
+		return x.ApprovedStatus
+	}
+	return ""
+}
+
+func (x *JiraSetting) GetRejectedStatus() string {
+	if x != nil {
+		return x.RejectedStatus
+	}
+	return ""
+}
+
+type ServiceNowSetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-}
-
-func (x *DataClassificationSetting_DataClassificationConfig_Level) Reset() {
-	*x = DataClassificationSetting_DataClassificationConfig_Level{}
+	// url is the base URL of the ServiceNow instance, e.g. "https://example.service-now.com".
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// username is the user calling the ServiceNow Table API.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// password is the password of the user.
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// assignment_group is the sys_id of the group assigned the change requests, optional.
+	AssignmentGroup string `protobuf:"bytes,4,opt,name=assignment_group,json=assignmentGroup,proto3" json:"assignment_group,omitempty"`
+	// webhook_token authenticates the ServiceNow webhooks posted to "/hook/servicenow/{webhook_token}".
+	WebhookToken string `protobuf:"bytes,5,opt,name=webhook_token,json=webhookToken,proto3" json:"webhook_token,omitempty"`
+}
+
+func (x *ServiceNowSetting) Reset() {
+	*x = ServiceNowSetting{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_setting_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1288,13 +1602,13 @@ func (x *DataClassificationSetting_DataClassificationConfig_Level) Reset() {
 	}
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig_Level) String() string {
+func (x *ServiceNowSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataClassificationSetting_DataClassificationConfig_Level) ProtoMessage() {}
+func (*ServiceNowSetting) ProtoMessage() {}
 
-func (x *DataClassificationSetting_DataClassificationConfig_Level) ProtoReflect() protoreflect.Message {
+func (x *ServiceNowSetting) ProtoReflect() protoreflect.Message {
 	mi := &file_store_setting_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1306,46 +1620,59 @@ func (x *DataClassificationSetting_DataClassificationConfig_Level) ProtoReflect(
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataClassificationSetting_DataClassificationConfig_Level.ProtoReflect.Descriptor instead.
-func (*DataClassificationSetting_DataClassificationConfig_Level) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{7, 0, 0}
+// Deprecated: Use ServiceNowSetting.ProtoReflect.Descriptor instead.
+func (*ServiceNowSetting) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig_Level) GetId() string {
+func (x *ServiceNowSetting) GetUrl() string {
 	if x != nil {
-		return x.Id
+		return x.Url
 	}
 	return ""
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig_Level) GetTitle() string {
+func (x *ServiceNowSetting) GetUsername() string {
 	if x != nil {
-		return x.Title
+		return x.Username
 	}
 	return ""
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig_Level) GetDescription() string {
+func (x *ServiceNowSetting) GetPassword() string {
 	if x != nil {
-		return x.Description
+		return x.Password
 	}
 	return ""
 }
 
-type DataClassificationSetting_DataClassificationConfig_DataClassification struct {
+func (x *ServiceNowSetting) GetAssignmentGroup() string {
+	if x != nil {
+		return x.AssignmentGroup
+	}
+	return ""
+}
+
+func (x *ServiceNowSetting) GetWebhookToken() string {
+	if x != nil {
+		return x.WebhookToken
+	}
+	return ""
+}
+
+type SlackAppSetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the classification id in [0-9]+-[0-9]+-[0-9]+ format.
-	Id          string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	LevelId     *string `protobuf:"bytes,4,opt,name=level_id,json=levelId,proto3,oneof" json:"level_id,omitempty"`
+	// bot_token is the bot user OAuth token of the Slack app, used to look up the Slack users.
+	BotToken string `protobuf:"bytes,1,opt,name=bot_token,json=botToken,proto3" json:"bot_token,omitempty"`
+	// signing_secret verifies the requests posted by Slack to "/hook/slack/interactions" and "/hook/slack/commands".
+	SigningSecret string `protobuf:"bytes,2,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) Reset() {
-	*x = DataClassificationSetting_DataClassificationConfig_DataClassification{}
+func (x *SlackAppSetting) Reset() {
+	*x = SlackAppSetting{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_store_setting_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1353,13 +1680,13 @@ func (x *DataClassificationSetting_DataClassificationConfig_DataClassification)
 	}
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) String() string {
+func (x *SlackAppSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataClassificationSetting_DataClassificationConfig_DataClassification) ProtoMessage() {}
+func (*SlackAppSetting) ProtoMessage() {}
 
-func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) ProtoReflect() protoreflect.Message {
+func (x *SlackAppSetting) ProtoReflect() protoreflect.Message {
 	mi := &file_store_setting_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1371,75 +1698,65 @@ func (x *DataClassificationSetting_DataClassificationConfig_DataClassification)
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataClassificationSetting_DataClassificationConfig_DataClassification.ProtoReflect.Descriptor instead.
-func (*DataClassificationSetting_DataClassificationConfig_DataClassification) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{7, 0, 1}
-}
-
-func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use SlackAppSetting.ProtoReflect.Descriptor instead.
+func (*SlackAppSetting) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) GetTitle() string {
+func (x *SlackAppSetting) GetBotToken() string {
 	if x != nil {
-		return x.Title
+		return x.BotToken
 	}
 	return ""
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) GetDescription() string {
+func (x *SlackAppSetting) GetSigningSecret() string {
 	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) GetLevelId() string {
-	if x != nil && x.LevelId != nil {
-		return *x.LevelId
+		return x.SigningSecret
 	}
 	return ""
 }
 
-type SemanticTypeSetting_SemanticType struct {
+type EventBusSetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the uuid for semantic type.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// the title of the semantic type, it should not be empty.
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// the description of the semantic type, it can be empty.
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// the partial mask algorithm id for the semantic type, if it is empty,
-	// should use the default partial mask algorithm.
-	PartialMaskAlgorithmId string `protobuf:"bytes,4,opt,name=partial_mask_algorithm_id,json=partialMaskAlgorithmId,proto3" json:"partial_mask_algorithm_id,omitempty"`
-	// the full mask algorithm id for the semantic type, if it is empty, should
-	// use the default full mask algorithm.
-	FullMaskAlgorithmId string `protobuf:"bytes,5,opt,name=full_mask_algorithm_id,json=fullMaskAlgorithmId,proto3" json:"full_mask_algorithm_id,omitempty"`
-}
-
-func (x *SemanticTypeSetting_SemanticType) Reset() {
-	*x = SemanticTypeSetting_SemanticType{}
+	Type EventBusSetting_Type `protobuf:"varint,1,opt,name=type,proto3,enum=bytebase.store.EventBusSetting_Type" json:"type,omitempty"`
+	// url is the comma separated broker addresses for Kafka, e.g. "kafka-1:9092,kafka-2:9092",
+	// or the server URL for NATS, e.g. "nats://nats:4222".
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// topic is the Kafka topic of the events keyed by the project, or the NATS subject prefix followed by the event type,
+	// e.g. the subject is "bytebase.events.bb.issue.create" for the prefix "bytebase.events".
+	// The events are encoded as the bytebase.v1.Event protobuf message.
+	Topic string `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	// username authenticates with SASL/PLAIN for Kafka, or the user credentials for NATS, optional.
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	// password is the password of the user.
+	Password string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	// tls connects to the Kafka brokers with TLS, NATS uses the "tls://" URL instead.
+	Tls bool `protobuf:"varint,6,opt,name=tls,proto3" json:"tls,omitempty"`
+	// event_types are the types of the events published, e.g. "bb.issue.create", all events are published if empty.
+	EventTypes []string `protobuf:"bytes,7,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+}
+
+func (x *EventBusSetting) Reset() {
+	*x = EventBusSetting{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_setting_proto_msgTypes[19]
+		mi := &file_store_setting_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SemanticTypeSetting_SemanticType) String() string {
+func (x *EventBusSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SemanticTypeSetting_SemanticType) ProtoMessage() {}
+func (*EventBusSetting) ProtoMessage() {}
 
-func (x *SemanticTypeSetting_SemanticType) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[19]
+func (x *EventBusSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1450,87 +1767,90 @@ func (x *SemanticTypeSetting_SemanticType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SemanticTypeSetting_SemanticType.ProtoReflect.Descriptor instead.
-func (*SemanticTypeSetting_SemanticType) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use EventBusSetting.ProtoReflect.Descriptor instead.
+func (*EventBusSetting) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SemanticTypeSetting_SemanticType) GetId() string {
+func (x *EventBusSetting) GetType() EventBusSetting_Type {
 	if x != nil {
-		return x.Id
+		return x.Type
 	}
-	return ""
+	return EventBusSetting_TYPE_UNSPECIFIED
 }
 
-func (x *SemanticTypeSetting_SemanticType) GetTitle() string {
+func (x *EventBusSetting) GetUrl() string {
 	if x != nil {
-		return x.Title
+		return x.Url
 	}
 	return ""
 }
 
-func (x *SemanticTypeSetting_SemanticType) GetDescription() string {
+func (x *EventBusSetting) GetTopic() string {
 	if x != nil {
-		return x.Description
+		return x.Topic
 	}
 	return ""
 }
 
-func (x *SemanticTypeSetting_SemanticType) GetPartialMaskAlgorithmId() string {
+func (x *EventBusSetting) GetUsername() string {
 	if x != nil {
-		return x.PartialMaskAlgorithmId
+		return x.Username
 	}
 	return ""
 }
 
-func (x *SemanticTypeSetting_SemanticType) GetFullMaskAlgorithmId() string {
+func (x *EventBusSetting) GetPassword() string {
 	if x != nil {
-		return x.FullMaskAlgorithmId
+		return x.Password
 	}
 	return ""
 }
 
-type MaskingAlgorithmSetting_Algorithm struct {
+func (x *EventBusSetting) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+func (x *EventBusSetting) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+type SCIMSetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the uuid for masking algorithm.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// title is the title for masking algorithm.
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// description is the description for masking algorithm.
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// Category is the category for masking algorithm. Currently, it accepts 2 categories only: MASKING and HASHING.
-	// The range of accepted Payload is decided by the category.
-	// Mask: FullMask, RangeMask
-	// Hash: MD5Mask
-	Category string `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
-	// Types that are assignable to Mask:
-	//
-	//	*MaskingAlgorithmSetting_Algorithm_FullMask_
-	//	*MaskingAlgorithmSetting_Algorithm_RangeMask_
-	//	*MaskingAlgorithmSetting_Algorithm_Md5Mask
-	Mask isMaskingAlgorithmSetting_Algorithm_Mask `protobuf_oneof:"mask"`
+	// token authenticates the SCIM requests to "/scim/v2" with the "Authorization: Bearer {token}" header.
+	// The SCIM provisioning is disabled if empty.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// group_mappings maps the provisioned groups to the roles granted to their members.
+	// The roles are revoked when the members are removed from the groups.
+	GroupMappings []*SCIMSetting_GroupMapping `protobuf:"bytes,2,rep,name=group_mappings,json=groupMappings,proto3" json:"group_mappings,omitempty"`
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm) Reset() {
-	*x = MaskingAlgorithmSetting_Algorithm{}
+func (x *SCIMSetting) Reset() {
+	*x = SCIMSetting{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_setting_proto_msgTypes[20]
+		mi := &file_store_setting_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm) String() string {
+func (x *SCIMSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaskingAlgorithmSetting_Algorithm) ProtoMessage() {}
+func (*SCIMSetting) ProtoMessage() {}
 
-func (x *MaskingAlgorithmSetting_Algorithm) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[20]
+func (x *SCIMSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1541,116 +1861,185 @@ func (x *MaskingAlgorithmSetting_Algorithm) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaskingAlgorithmSetting_Algorithm.ProtoReflect.Descriptor instead.
-func (*MaskingAlgorithmSetting_Algorithm) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use SCIMSetting.ProtoReflect.Descriptor instead.
+func (*SCIMSetting) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm) GetId() string {
+func (x *SCIMSetting) GetToken() string {
 	if x != nil {
-		return x.Id
+		return x.Token
 	}
 	return ""
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm) GetTitle() string {
+func (x *SCIMSetting) GetGroupMappings() []*SCIMSetting_GroupMapping {
 	if x != nil {
-		return x.Title
+		return x.GroupMappings
 	}
-	return ""
+	return nil
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm) GetDescription() string {
-	if x != nil {
-		return x.Description
+type AuditLogSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// retention is the duration the audit logs are kept, the audit logs are kept forever if not set.
+	// The audit logs are only deleted after they are exported if the export is enabled.
+	Retention *durationpb.Duration `protobuf:"bytes,1,opt,name=retention,proto3" json:"retention,omitempty"`
+	// export_type is the destination the audit logs are exported to in the JSON schema for SIEM ingestion.
+	ExportType AuditLogSetting_ExportType `protobuf:"varint,2,opt,name=export_type,json=exportType,proto3,enum=bytebase.store.AuditLogSetting_ExportType" json:"export_type,omitempty"`
+	// s3 exports the audit logs as the JSON Lines objects, one object per batch.
+	S3 *AuditLogSetting_S3Export `protobuf:"bytes,3,opt,name=s3,proto3" json:"s3,omitempty"`
+	// syslog exports the audit logs as the RFC 5424 messages, one message per audit log.
+	Syslog *AuditLogSetting_SyslogExport `protobuf:"bytes,4,opt,name=syslog,proto3" json:"syslog,omitempty"`
+}
+
+func (x *AuditLogSetting) Reset() {
+	*x = AuditLogSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm) GetCategory() string {
-	if x != nil {
-		return x.Category
+func (x *AuditLogSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogSetting) ProtoMessage() {}
+
+func (x *AuditLogSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (m *MaskingAlgorithmSetting_Algorithm) GetMask() isMaskingAlgorithmSetting_Algorithm_Mask {
-	if m != nil {
-		return m.Mask
+// Deprecated: Use AuditLogSetting.ProtoReflect.Descriptor instead.
+func (*AuditLogSetting) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AuditLogSetting) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.Retention
 	}
 	return nil
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm) GetFullMask() *MaskingAlgorithmSetting_Algorithm_FullMask {
-	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_FullMask_); ok {
-		return x.FullMask
+func (x *AuditLogSetting) GetExportType() AuditLogSetting_ExportType {
+	if x != nil {
+		return x.ExportType
 	}
-	return nil
+	return AuditLogSetting_EXPORT_TYPE_UNSPECIFIED
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm) GetRangeMask() *MaskingAlgorithmSetting_Algorithm_RangeMask {
-	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_RangeMask_); ok {
-		return x.RangeMask
+func (x *AuditLogSetting) GetS3() *AuditLogSetting_S3Export {
+	if x != nil {
+		return x.S3
 	}
 	return nil
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm) GetMd5Mask() *MaskingAlgorithmSetting_Algorithm_MD5Mask {
-	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_Md5Mask); ok {
-		return x.Md5Mask
+func (x *AuditLogSetting) GetSyslog() *AuditLogSetting_SyslogExport {
+	if x != nil {
+		return x.Syslog
 	}
 	return nil
 }
 
-type isMaskingAlgorithmSetting_Algorithm_Mask interface {
-	isMaskingAlgorithmSetting_Algorithm_Mask()
+type MaintenanceSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// enabled rejects the new rollouts and the SQL editor writes while the reads are still served,
+	// e.g. during the metadata database upgrade.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// message is shown to the users whose requests are rejected.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-type MaskingAlgorithmSetting_Algorithm_FullMask_ struct {
-	FullMask *MaskingAlgorithmSetting_Algorithm_FullMask `protobuf:"bytes,5,opt,name=full_mask,json=fullMask,proto3,oneof"`
+func (x *MaintenanceSetting) Reset() {
+	*x = MaintenanceSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type MaskingAlgorithmSetting_Algorithm_RangeMask_ struct {
-	RangeMask *MaskingAlgorithmSetting_Algorithm_RangeMask `protobuf:"bytes,6,opt,name=range_mask,json=rangeMask,proto3,oneof"`
+func (x *MaintenanceSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type MaskingAlgorithmSetting_Algorithm_Md5Mask struct {
-	Md5Mask *MaskingAlgorithmSetting_Algorithm_MD5Mask `protobuf:"bytes,7,opt,name=md5_mask,json=md5Mask,proto3,oneof"`
+func (*MaintenanceSetting) ProtoMessage() {}
+
+func (x *MaintenanceSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*MaskingAlgorithmSetting_Algorithm_FullMask_) isMaskingAlgorithmSetting_Algorithm_Mask() {}
+// Deprecated: Use MaintenanceSetting.ProtoReflect.Descriptor instead.
+func (*MaintenanceSetting) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{21}
+}
 
-func (*MaskingAlgorithmSetting_Algorithm_RangeMask_) isMaskingAlgorithmSetting_Algorithm_Mask() {}
+func (x *MaintenanceSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
 
-func (*MaskingAlgorithmSetting_Algorithm_Md5Mask) isMaskingAlgorithmSetting_Algorithm_Mask() {}
+func (x *MaintenanceSetting) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
 
-type MaskingAlgorithmSetting_Algorithm_FullMask struct {
+type InstanceDiscoverySetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// substitution is the string used to replace the original value, the
-	// max length of the string is 16 bytes.
-	Substitution string `protobuf:"bytes,1,opt,name=substitution,proto3" json:"substitution,omitempty"`
+	// sources are the cloud accounts the instances are discovered from periodically.
+	Sources []*InstanceDiscoverySetting_Source `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_FullMask) Reset() {
-	*x = MaskingAlgorithmSetting_Algorithm_FullMask{}
+func (x *InstanceDiscoverySetting) Reset() {
+	*x = InstanceDiscoverySetting{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_setting_proto_msgTypes[21]
+		mi := &file_store_setting_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_FullMask) String() string {
+func (x *InstanceDiscoverySetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaskingAlgorithmSetting_Algorithm_FullMask) ProtoMessage() {}
+func (*InstanceDiscoverySetting) ProtoMessage() {}
 
-func (x *MaskingAlgorithmSetting_Algorithm_FullMask) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[21]
+func (x *InstanceDiscoverySetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1661,45 +2050,44 @@ func (x *MaskingAlgorithmSetting_Algorithm_FullMask) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaskingAlgorithmSetting_Algorithm_FullMask.ProtoReflect.Descriptor instead.
-func (*MaskingAlgorithmSetting_Algorithm_FullMask) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{9, 0, 0}
+// Deprecated: Use InstanceDiscoverySetting.ProtoReflect.Descriptor instead.
+func (*InstanceDiscoverySetting) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_FullMask) GetSubstitution() string {
+func (x *InstanceDiscoverySetting) GetSources() []*InstanceDiscoverySetting_Source {
 	if x != nil {
-		return x.Substitution
+		return x.Sources
 	}
-	return ""
+	return nil
 }
 
-type MaskingAlgorithmSetting_Algorithm_RangeMask struct {
+// DiscoveredInstances are the discovered cloud instances which are not registered yet.
+type DiscoveredInstances struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// We store it as a repeated field to face the fact that the original value may have multiple parts should be masked.
-	// But frontend can be started with a single rule easily.
-	Slices []*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice `protobuf:"bytes,1,rep,name=slices,proto3" json:"slices,omitempty"`
+	Instances []*DiscoveredInstance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask) Reset() {
-	*x = MaskingAlgorithmSetting_Algorithm_RangeMask{}
+func (x *DiscoveredInstances) Reset() {
+	*x = DiscoveredInstances{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_setting_proto_msgTypes[22]
+		mi := &file_store_setting_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask) String() string {
+func (x *DiscoveredInstances) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaskingAlgorithmSetting_Algorithm_RangeMask) ProtoMessage() {}
+func (*DiscoveredInstances) ProtoMessage() {}
 
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[22]
+func (x *DiscoveredInstances) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1710,44 +2098,53 @@ func (x *MaskingAlgorithmSetting_Algorithm_RangeMask) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaskingAlgorithmSetting_Algorithm_RangeMask.ProtoReflect.Descriptor instead.
-func (*MaskingAlgorithmSetting_Algorithm_RangeMask) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{9, 0, 1}
+// Deprecated: Use DiscoveredInstances.ProtoReflect.Descriptor instead.
+func (*DiscoveredInstances) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask) GetSlices() []*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice {
+func (x *DiscoveredInstances) GetInstances() []*DiscoveredInstance {
 	if x != nil {
-		return x.Slices
+		return x.Instances
 	}
 	return nil
 }
 
-type MaskingAlgorithmSetting_Algorithm_MD5Mask struct {
+type DiscoveredInstance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// salt is the salt value to generate a different hash that with the word alone.
-	Salt string `protobuf:"bytes,1,opt,name=salt,proto3" json:"salt,omitempty"`
-}
-
-func (x *MaskingAlgorithmSetting_Algorithm_MD5Mask) Reset() {
-	*x = MaskingAlgorithmSetting_Algorithm_MD5Mask{}
+	// source is the ID of the instance discovery source which found the instance.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// cloud_resource is the cloud resource of the instance, e.g. "arn:aws:rds:us-east-1:123456789012:db:mydb".
+	CloudResource string `protobuf:"bytes,2,opt,name=cloud_resource,json=cloudResource,proto3" json:"cloud_resource,omitempty"`
+	Title         string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Engine        Engine `protobuf:"varint,4,opt,name=engine,proto3,enum=bytebase.store.Engine" json:"engine,omitempty"`
+	Host          string `protobuf:"bytes,5,opt,name=host,proto3" json:"host,omitempty"`
+	Port          string `protobuf:"bytes,6,opt,name=port,proto3" json:"port,omitempty"`
+	// labels are the tags of the AWS instance, or the user labels of the Cloud SQL instance.
+	Labels       map[string]string      `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DiscoverTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=discover_time,json=discoverTime,proto3" json:"discover_time,omitempty"`
+}
+
+func (x *DiscoveredInstance) Reset() {
+	*x = DiscoveredInstance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_setting_proto_msgTypes[23]
+		mi := &file_store_setting_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_MD5Mask) String() string {
+func (x *DiscoveredInstance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaskingAlgorithmSetting_Algorithm_MD5Mask) ProtoMessage() {}
+func (*DiscoveredInstance) ProtoMessage() {}
 
-func (x *MaskingAlgorithmSetting_Algorithm_MD5Mask) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[23]
+func (x *DiscoveredInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1758,485 +2155,2811 @@ func (x *MaskingAlgorithmSetting_Algorithm_MD5Mask) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaskingAlgorithmSetting_Algorithm_MD5Mask.ProtoReflect.Descriptor instead.
-func (*MaskingAlgorithmSetting_Algorithm_MD5Mask) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{9, 0, 2}
+// Deprecated: Use DiscoveredInstance.ProtoReflect.Descriptor instead.
+func (*DiscoveredInstance) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_MD5Mask) GetSalt() string {
+func (x *DiscoveredInstance) GetSource() string {
 	if x != nil {
-		return x.Salt
+		return x.Source
 	}
 	return ""
 }
 
-type MaskingAlgorithmSetting_Algorithm_RangeMask_Slice struct {
+func (x *DiscoveredInstance) GetCloudResource() string {
+	if x != nil {
+		return x.CloudResource
+	}
+	return ""
+}
+
+func (x *DiscoveredInstance) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DiscoveredInstance) GetEngine() Engine {
+	if x != nil {
+		return x.Engine
+	}
+	return Engine_ENGINE_UNSPECIFIED
+}
+
+func (x *DiscoveredInstance) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *DiscoveredInstance) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *DiscoveredInstance) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *DiscoveredInstance) GetDiscoverTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DiscoverTime
+	}
+	return nil
+}
+
+type WorkspaceApprovalSetting_Rule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// start is the start index of the original value, start from 0 and should be less than stop.
-	Start int32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
-	// stop is the stop index of the original value, should be less than the length of the original value.
-	End int32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
-	// OriginalValue[start:end) would be replaced with replace_with.
-	Substitution string `protobuf:"bytes,3,opt,name=substitution,proto3" json:"substitution,omitempty"`
+	Expression *v1alpha1.ParsedExpr `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	Template   *ApprovalTemplate    `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	Condition  *expr.Expr           `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) Reset() {
-	*x = MaskingAlgorithmSetting_Algorithm_RangeMask_Slice{}
+func (x *WorkspaceApprovalSetting_Rule) Reset() {
+	*x = WorkspaceApprovalSetting_Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_setting_proto_msgTypes[24]
+		mi := &file_store_setting_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) String() string {
+func (x *WorkspaceApprovalSetting_Rule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) ProtoMessage() {}
+func (*WorkspaceApprovalSetting_Rule) ProtoMessage() {}
 
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[24]
+func (x *WorkspaceApprovalSetting_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MaskingAlgorithmSetting_Algorithm_RangeMask_Slice.ProtoReflect.Descriptor instead.
-func (*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{9, 0, 1, 0}
-}
-
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) GetStart() int32 {
-	if x != nil {
-		return x.Start
-	}
-	return 0
-}
-
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) GetEnd() int32 {
-	if x != nil {
-		return x.End
-	}
-	return 0
-}
-
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) GetSubstitution() string {
-	if x != nil {
-		return x.Substitution
-	}
-	return ""
-}
-
-var File_store_setting_proto protoreflect.FileDescriptor
-
-var file_store_setting_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x25, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x65, 0x78, 0x70, 0x72, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
-	0x73, 0x79, 0x6e, 0x74, 0x61, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x65, 0x78, 0x70, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2, 0x02, 0x0a, 0x17, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x55, 0x72, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
-	0x73, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x69,
-	0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x12, 0x1f, 0x0a, 0x0b,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x32, 0x66, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x32, 0x66, 0x61, 0x12, 0x28, 0x0a,
-	0x10, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x70, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x49, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x67, 0x69, 0x74, 0x6f, 0x70,
-	0x73, 0x5f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x10, 0x67, 0x69, 0x74, 0x6f, 0x70, 0x73, 0x57, 0x65, 0x62, 0x68, 0x6f,
-	0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75,
-	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41,
-	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x6e, 0x6e,
-	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xe9, 0x01, 0x0a, 0x0c, 0x41, 0x6e,
-	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x05, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x62, 0x79, 0x74, 0x65,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75,
-	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e,
-	0x6b, 0x22, 0x72, 0x0a, 0x0a, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x1b, 0x0a, 0x17, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10,
-	0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x46, 0x4f,
-	0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x4c, 0x45, 0x56, 0x45,
-	0x4c, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x41,
-	0x4c, 0x45, 0x52, 0x54, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x43, 0x52, 0x49, 0x54, 0x49,
-	0x43, 0x41, 0x4c, 0x10, 0x03, 0x22, 0x3c, 0x0a, 0x12, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x9d, 0x02, 0x0a, 0x18, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x12, 0x43, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2d, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05,
-	0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0xbb, 0x01, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x44,
-	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x65, 0x78, 0x70, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61,
-	0x72, 0x73, 0x65, 0x64, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
-	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0xa7, 0x01, 0x0a, 0x17, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12,
-	0x42, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c,
-	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f,
-	0x64, 0x65, 0x73, 0x1a, 0x48, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x88, 0x05,
-	0x0a, 0x17, 0x53, 0x4d, 0x54, 0x50, 0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
-	0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x52, 0x0a, 0x0a, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x62, 0x79, 0x74, 0x65,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x4d, 0x54, 0x50, 0x4d,
-	0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65,
-	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x61, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x63, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x65, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x12,
-	0x5e, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x4d, 0x54, 0x50, 0x4d, 0x61, 0x69,
-	0x6c, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x6e, 0x0a, 0x0a, 0x45,
-	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x4e, 0x43,
-	0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e,
-	0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x54, 0x4c,
-	0x53, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x53, 0x53, 0x4c, 0x5f, 0x54, 0x4c, 0x53, 0x10, 0x03, 0x22, 0x9a, 0x01, 0x0a, 0x0e,
-	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
-	0x0a, 0x1a, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17,
-	0x0a, 0x13, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x54, 0x48, 0x45,
-	0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x10,
-	0x02, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x41,
-	0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52,
-	0x41, 0x4d, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x04, 0x22, 0xca, 0x06, 0x0a, 0x15, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x12, 0x5c, 0x0a, 0x0f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x74, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x52, 0x0e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
-	0x12, 0x53, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x54, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x5c, 0x0a, 0x0f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
-	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
-	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x52, 0x0e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x73, 0x1a, 0xd9, 0x01, 0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x06, 0x65,
-	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x12, 0x36, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a,
-	0x6c, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a,
-	0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
-	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x45,
-	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0xd5, 0x01,
-	0x0a, 0x0d, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x2e, 0x0a, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x16, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x79, 0x74,
-	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x12, 0x33, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x96, 0x06, 0x0a, 0x19, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c,
-	0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x12, 0x5c, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e,
-	0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x73, 0x1a, 0x9a, 0x05, 0x0a, 0x18, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x12, 0x60, 0x0a, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e,
-	0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x06,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x7e, 0x0a, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x56,
-	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
-	0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c,
-	0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x4f, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x89, 0x01, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61,
-	0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x5f, 0x69, 0x64, 0x1a, 0x98, 0x01, 0x0a, 0x13, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x6b, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x55, 0x2e, 0x62,
-	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x61,
-	0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73,
-	0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa6,
-	0x02, 0x0a, 0x13, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x46, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54,
-	0x79, 0x70, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x6d, 0x61, 0x6e,
-	0x74, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0xc6,
-	0x01, 0x0a, 0x0c, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x61, 0x72, 0x74, 0x69,
-	0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
-	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x70, 0x61, 0x72, 0x74,
-	0x69, 0x61, 0x6c, 0x4d, 0x61, 0x73, 0x6b, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
-	0x49, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x5f,
-	0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x13, 0x66, 0x75, 0x6c, 0x6c, 0x4d, 0x61, 0x73, 0x6b, 0x41, 0x6c, 0x67, 0x6f,
-	0x72, 0x69, 0x74, 0x68, 0x6d, 0x49, 0x64, 0x22, 0x84, 0x06, 0x0a, 0x17, 0x4d, 0x61, 0x73, 0x6b,
-	0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x12, 0x51, 0x0a, 0x0a, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67,
-	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x0a, 0x61, 0x6c, 0x67, 0x6f,
-	0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x1a, 0x95, 0x05, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72,
-	0x69, 0x74, 0x68, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x59, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c,
-	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4d, 0x61, 0x73,
-	0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e, 0x46,
-	0x75, 0x6c, 0x6c, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4d,
-	0x61, 0x73, 0x6b, 0x12, 0x5c, 0x0a, 0x0a, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x73,
-	0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67,
-	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x73,
-	0x6b, 0x12, 0x56, 0x0a, 0x08, 0x6d, 0x64, 0x35, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f,
-	0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67,
-	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e, 0x4d, 0x44, 0x35, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00,
-	0x52, 0x07, 0x6d, 0x64, 0x35, 0x4d, 0x61, 0x73, 0x6b, 0x1a, 0x2e, 0x0a, 0x08, 0x46, 0x75, 0x6c,
-	0x6c, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62,
-	0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xbb, 0x01, 0x0a, 0x09, 0x52, 0x61,
-	0x6e, 0x67, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x59, 0x0a, 0x06, 0x73, 0x6c, 0x69, 0x63, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67,
-	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x4d, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6c, 0x69, 0x63,
-	0x65, 0x73, 0x1a, 0x53, 0x0a, 0x05, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
-	0x65, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x74,
-	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1d, 0x0a, 0x07, 0x4d, 0x44, 0x35, 0x4d, 0x61,
-	0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x42, 0x14,
-	0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_store_setting_proto_rawDescOnce sync.Once
-	file_store_setting_proto_rawDescData = file_store_setting_proto_rawDesc
-)
-
-func file_store_setting_proto_rawDescGZIP() []byte {
-	file_store_setting_proto_rawDescOnce.Do(func() {
-		file_store_setting_proto_rawDescData = protoimpl.X.CompressGZIP(file_store_setting_proto_rawDescData)
-	})
-	return file_store_setting_proto_rawDescData
-}
-
-var file_store_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_store_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
-var file_store_setting_proto_goTypes = []interface{}{
-	(Announcement_AlertLevel)(0),                                                  // 0: bytebase.store.Announcement.AlertLevel
-	(SMTPMailDeliverySetting_Encryption)(0),                                       // 1: bytebase.store.SMTPMailDeliverySetting.Encryption
-	(SMTPMailDeliverySetting_Authentication)(0),                                   // 2: bytebase.store.SMTPMailDeliverySetting.Authentication
-	(*WorkspaceProfileSetting)(nil),                                               // 3: bytebase.store.WorkspaceProfileSetting
-	(*Announcement)(nil),                                                          // 4: bytebase.store.Announcement
-	(*AgentPluginSetting)(nil),                                                    // 5: bytebase.store.AgentPluginSetting
-	(*WorkspaceApprovalSetting)(nil),                                              // 6: bytebase.store.WorkspaceApprovalSetting
-	(*ExternalApprovalSetting)(nil),                                               // 7: bytebase.store.ExternalApprovalSetting
-	(*SMTPMailDeliverySetting)(nil),                                               // 8: bytebase.store.SMTPMailDeliverySetting
-	(*SchemaTemplateSetting)(nil),                                                 // 9: bytebase.store.SchemaTemplateSetting
-	(*DataClassificationSetting)(nil),                                             // 10: bytebase.store.DataClassificationSetting
-	(*SemanticTypeSetting)(nil),                                                   // 11: bytebase.store.SemanticTypeSetting
-	(*MaskingAlgorithmSetting)(nil),                                               // 12: bytebase.store.MaskingAlgorithmSetting
-	(*WorkspaceApprovalSetting_Rule)(nil),                                         // 13: bytebase.store.WorkspaceApprovalSetting.Rule
-	(*ExternalApprovalSetting_Node)(nil),                                          // 14: bytebase.store.ExternalApprovalSetting.Node
-	(*SchemaTemplateSetting_FieldTemplate)(nil),                                   // 15: bytebase.store.SchemaTemplateSetting.FieldTemplate
-	(*SchemaTemplateSetting_ColumnType)(nil),                                      // 16: bytebase.store.SchemaTemplateSetting.ColumnType
-	(*SchemaTemplateSetting_TableTemplate)(nil),                                   // 17: bytebase.store.SchemaTemplateSetting.TableTemplate
-	(*DataClassificationSetting_DataClassificationConfig)(nil),                    // 18: bytebase.store.DataClassificationSetting.DataClassificationConfig
-	(*DataClassificationSetting_DataClassificationConfig_Level)(nil),              // 19: bytebase.store.DataClassificationSetting.DataClassificationConfig.Level
-	(*DataClassificationSetting_DataClassificationConfig_DataClassification)(nil), // 20: bytebase.store.DataClassificationSetting.DataClassificationConfig.DataClassification
-	nil,                                      // 21: bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
-	(*SemanticTypeSetting_SemanticType)(nil), // 22: bytebase.store.SemanticTypeSetting.SemanticType
-	(*MaskingAlgorithmSetting_Algorithm)(nil),                 // 23: bytebase.store.MaskingAlgorithmSetting.Algorithm
-	(*MaskingAlgorithmSetting_Algorithm_FullMask)(nil),        // 24: bytebase.store.MaskingAlgorithmSetting.Algorithm.FullMask
-	(*MaskingAlgorithmSetting_Algorithm_RangeMask)(nil),       // 25: bytebase.store.MaskingAlgorithmSetting.Algorithm.RangeMask
-	(*MaskingAlgorithmSetting_Algorithm_MD5Mask)(nil),         // 26: bytebase.store.MaskingAlgorithmSetting.Algorithm.MD5Mask
-	(*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice)(nil), // 27: bytebase.store.MaskingAlgorithmSetting.Algorithm.RangeMask.Slice
-	(*durationpb.Duration)(nil),                               // 28: google.protobuf.Duration
-	(*v1alpha1.ParsedExpr)(nil),                               // 29: google.api.expr.v1alpha1.ParsedExpr
-	(*ApprovalTemplate)(nil),                                  // 30: bytebase.store.ApprovalTemplate
-	(*expr.Expr)(nil),                                         // 31: google.type.Expr
-	(Engine)(0),                                               // 32: bytebase.store.Engine
-	(*ColumnMetadata)(nil),                                    // 33: bytebase.store.ColumnMetadata
-	(*ColumnConfig)(nil),                                      // 34: bytebase.store.ColumnConfig
-	(*TableMetadata)(nil),                                     // 35: bytebase.store.TableMetadata
-	(*TableConfig)(nil),                                       // 36: bytebase.store.TableConfig
-}
-var file_store_setting_proto_depIdxs = []int32{
-	28, // 0: bytebase.store.WorkspaceProfileSetting.token_duration:type_name -> google.protobuf.Duration
-	4,  // 1: bytebase.store.WorkspaceProfileSetting.announcement:type_name -> bytebase.store.Announcement
-	0,  // 2: bytebase.store.Announcement.level:type_name -> bytebase.store.Announcement.AlertLevel
-	13, // 3: bytebase.store.WorkspaceApprovalSetting.rules:type_name -> bytebase.store.WorkspaceApprovalSetting.Rule
-	14, // 4: bytebase.store.ExternalApprovalSetting.nodes:type_name -> bytebase.store.ExternalApprovalSetting.Node
-	1,  // 5: bytebase.store.SMTPMailDeliverySetting.encryption:type_name -> bytebase.store.SMTPMailDeliverySetting.Encryption
-	2,  // 6: bytebase.store.SMTPMailDeliverySetting.authentication:type_name -> bytebase.store.SMTPMailDeliverySetting.Authentication
-	15, // 7: bytebase.store.SchemaTemplateSetting.field_templates:type_name -> bytebase.store.SchemaTemplateSetting.FieldTemplate
-	16, // 8: bytebase.store.SchemaTemplateSetting.column_types:type_name -> bytebase.store.SchemaTemplateSetting.ColumnType
-	17, // 9: bytebase.store.SchemaTemplateSetting.table_templates:type_name -> bytebase.store.SchemaTemplateSetting.TableTemplate
-	18, // 10: bytebase.store.DataClassificationSetting.configs:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig
-	22, // 11: bytebase.store.SemanticTypeSetting.types:type_name -> bytebase.store.SemanticTypeSetting.SemanticType
-	23, // 12: bytebase.store.MaskingAlgorithmSetting.algorithms:type_name -> bytebase.store.MaskingAlgorithmSetting.Algorithm
-	29, // 13: bytebase.store.WorkspaceApprovalSetting.Rule.expression:type_name -> google.api.expr.v1alpha1.ParsedExpr
-	30, // 14: bytebase.store.WorkspaceApprovalSetting.Rule.template:type_name -> bytebase.store.ApprovalTemplate
-	31, // 15: bytebase.store.WorkspaceApprovalSetting.Rule.condition:type_name -> google.type.Expr
-	32, // 16: bytebase.store.SchemaTemplateSetting.FieldTemplate.engine:type_name -> bytebase.store.Engine
-	33, // 17: bytebase.store.SchemaTemplateSetting.FieldTemplate.column:type_name -> bytebase.store.ColumnMetadata
-	34, // 18: bytebase.store.SchemaTemplateSetting.FieldTemplate.config:type_name -> bytebase.store.ColumnConfig
-	32, // 19: bytebase.store.SchemaTemplateSetting.ColumnType.engine:type_name -> bytebase.store.Engine
-	32, // 20: bytebase.store.SchemaTemplateSetting.TableTemplate.engine:type_name -> bytebase.store.Engine
-	35, // 21: bytebase.store.SchemaTemplateSetting.TableTemplate.table:type_name -> bytebase.store.TableMetadata
-	36, // 22: bytebase.store.SchemaTemplateSetting.TableTemplate.config:type_name -> bytebase.store.TableConfig
-	19, // 23: bytebase.store.DataClassificationSetting.DataClassificationConfig.levels:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.Level
-	21, // 24: bytebase.store.DataClassificationSetting.DataClassificationConfig.classification:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
-	20, // 25: bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry.value:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.DataClassification
-	24, // 26: bytebase.store.MaskingAlgorithmSetting.Algorithm.full_mask:type_name -> bytebase.store.MaskingAlgorithmSetting.Algorithm.FullMask
-	25, // 27: bytebase.store.MaskingAlgorithmSetting.Algorithm.range_mask:type_name -> bytebase.store.MaskingAlgorithmSetting.Algorithm.RangeMask
-	26, // 28: bytebase.store.MaskingAlgorithmSetting.Algorithm.md5_mask:type_name -> bytebase.store.MaskingAlgorithmSetting.Algorithm.MD5Mask
-	27, // 29: bytebase.store.MaskingAlgorithmSetting.Algorithm.RangeMask.slices:type_name -> bytebase.store.MaskingAlgorithmSetting.Algorithm.RangeMask.Slice
-	30, // [30:30] is the sub-list for method output_type
-	30, // [30:30] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
-}
-
-func init() { file_store_setting_proto_init() }
-func file_store_setting_proto_init() {
-	if File_store_setting_proto != nil {
-		return
-	}
-	file_store_approval_proto_init()
-	file_store_common_proto_init()
-	file_store_database_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_store_setting_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkspaceProfileSetting); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceApprovalSetting_Rule.ProtoReflect.Descriptor instead.
+func (*WorkspaceApprovalSetting_Rule) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *WorkspaceApprovalSetting_Rule) GetExpression() *v1alpha1.ParsedExpr {
+	if x != nil {
+		return x.Expression
+	}
+	return nil
+}
+
+func (x *WorkspaceApprovalSetting_Rule) GetTemplate() *ApprovalTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *WorkspaceApprovalSetting_Rule) GetCondition() *expr.Expr {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+type ExternalApprovalSetting_Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A unique identifier for a node in UUID format.
+	// We will also include the id in the message sending to the external relay
+	// service to identify the node.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The title of the node.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The external endpoint for the relay service, e.g. "http://hello:1234".
+	Endpoint string                            `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Mode     ExternalApprovalSetting_Node_Mode `protobuf:"varint,4,opt,name=mode,proto3,enum=bytebase.store.ExternalApprovalSetting_Node_Mode" json:"mode,omitempty"`
+}
+
+func (x *ExternalApprovalSetting_Node) Reset() {
+	*x = ExternalApprovalSetting_Node{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalApprovalSetting_Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalApprovalSetting_Node) ProtoMessage() {}
+
+func (x *ExternalApprovalSetting_Node) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalApprovalSetting_Node.ProtoReflect.Descriptor instead.
+func (*ExternalApprovalSetting_Node) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *ExternalApprovalSetting_Node) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExternalApprovalSetting_Node) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ExternalApprovalSetting_Node) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *ExternalApprovalSetting_Node) GetMode() ExternalApprovalSetting_Node_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return ExternalApprovalSetting_Node_MODE_UNSPECIFIED
+}
+
+type SchemaTemplateSetting_FieldTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Engine   Engine          `protobuf:"varint,2,opt,name=engine,proto3,enum=bytebase.store.Engine" json:"engine,omitempty"`
+	Category string          `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Column   *ColumnMetadata `protobuf:"bytes,4,opt,name=column,proto3" json:"column,omitempty"`
+	Config   *ColumnConfig   `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *SchemaTemplateSetting_FieldTemplate) Reset() {
+	*x = SchemaTemplateSetting_FieldTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchemaTemplateSetting_FieldTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaTemplateSetting_FieldTemplate) ProtoMessage() {}
+
+func (x *SchemaTemplateSetting_FieldTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaTemplateSetting_FieldTemplate.ProtoReflect.Descriptor instead.
+func (*SchemaTemplateSetting_FieldTemplate) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *SchemaTemplateSetting_FieldTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SchemaTemplateSetting_FieldTemplate) GetEngine() Engine {
+	if x != nil {
+		return x.Engine
+	}
+	return Engine_ENGINE_UNSPECIFIED
+}
+
+func (x *SchemaTemplateSetting_FieldTemplate) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SchemaTemplateSetting_FieldTemplate) GetColumn() *ColumnMetadata {
+	if x != nil {
+		return x.Column
+	}
+	return nil
+}
+
+func (x *SchemaTemplateSetting_FieldTemplate) GetConfig() *ColumnConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type SchemaTemplateSetting_ColumnType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Engine  Engine   `protobuf:"varint,1,opt,name=engine,proto3,enum=bytebase.store.Engine" json:"engine,omitempty"`
+	Enabled bool     `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Types   []string `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty"`
+}
+
+func (x *SchemaTemplateSetting_ColumnType) Reset() {
+	*x = SchemaTemplateSetting_ColumnType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchemaTemplateSetting_ColumnType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaTemplateSetting_ColumnType) ProtoMessage() {}
+
+func (x *SchemaTemplateSetting_ColumnType) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaTemplateSetting_ColumnType.ProtoReflect.Descriptor instead.
+func (*SchemaTemplateSetting_ColumnType) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *SchemaTemplateSetting_ColumnType) GetEngine() Engine {
+	if x != nil {
+		return x.Engine
+	}
+	return Engine_ENGINE_UNSPECIFIED
+}
+
+func (x *SchemaTemplateSetting_ColumnType) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SchemaTemplateSetting_ColumnType) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type SchemaTemplateSetting_TableTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Engine   Engine         `protobuf:"varint,2,opt,name=engine,proto3,enum=bytebase.store.Engine" json:"engine,omitempty"`
+	Category string         `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Table    *TableMetadata `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
+	Config   *TableConfig   `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *SchemaTemplateSetting_TableTemplate) Reset() {
+	*x = SchemaTemplateSetting_TableTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchemaTemplateSetting_TableTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaTemplateSetting_TableTemplate) ProtoMessage() {}
+
+func (x *SchemaTemplateSetting_TableTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaTemplateSetting_TableTemplate.ProtoReflect.Descriptor instead.
+func (*SchemaTemplateSetting_TableTemplate) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{6, 2}
+}
+
+func (x *SchemaTemplateSetting_TableTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SchemaTemplateSetting_TableTemplate) GetEngine() Engine {
+	if x != nil {
+		return x.Engine
+	}
+	return Engine_ENGINE_UNSPECIFIED
+}
+
+func (x *SchemaTemplateSetting_TableTemplate) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SchemaTemplateSetting_TableTemplate) GetTable() *TableMetadata {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *SchemaTemplateSetting_TableTemplate) GetConfig() *TableConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type DataClassificationSetting_DataClassificationConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the uuid for classification. Each project can chose one
+	// classification config.
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// levels is user defined level list for classification.
+	// The order for the level decides its priority.
+	Levels []*DataClassificationSetting_DataClassificationConfig_Level `protobuf:"bytes,3,rep,name=levels,proto3" json:"levels,omitempty"`
+	// classification is the id - DataClassification map.
+	// The id should in [0-9]+-[0-9]+-[0-9]+ format.
+	Classification map[string]*DataClassificationSetting_DataClassificationConfig_DataClassification `protobuf:"bytes,4,rep,name=classification,proto3" json:"classification,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig) Reset() {
+	*x = DataClassificationSetting_DataClassificationConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataClassificationSetting_DataClassificationConfig) ProtoMessage() {}
+
+func (x *DataClassificationSetting_DataClassificationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataClassificationSetting_DataClassificationConfig.ProtoReflect.Descriptor instead.
+func (*DataClassificationSetting_DataClassificationConfig) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig) GetLevels() []*DataClassificationSetting_DataClassificationConfig_Level {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig) GetClassification() map[string]*DataClassificationSetting_DataClassificationConfig_DataClassification {
+	if x != nil {
+		return x.Classification
+	}
+	return nil
+}
+
+type DataClassificationSetting_DataClassificationConfig_Level struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Level) Reset() {
+	*x = DataClassificationSetting_DataClassificationConfig_Level{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Level) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataClassificationSetting_DataClassificationConfig_Level) ProtoMessage() {}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Level) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataClassificationSetting_DataClassificationConfig_Level.ProtoReflect.Descriptor instead.
+func (*DataClassificationSetting_DataClassificationConfig_Level) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{7, 0, 0}
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Level) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Level) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Level) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type DataClassificationSetting_DataClassificationConfig_DataClassification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the classification id in [0-9]+-[0-9]+-[0-9]+ format.
+	Id          string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	LevelId     *string `protobuf:"bytes,4,opt,name=level_id,json=levelId,proto3,oneof" json:"level_id,omitempty"`
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) Reset() {
+	*x = DataClassificationSetting_DataClassificationConfig_DataClassification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataClassificationSetting_DataClassificationConfig_DataClassification) ProtoMessage() {}
+
+func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataClassificationSetting_DataClassificationConfig_DataClassification.ProtoReflect.Descriptor instead.
+func (*DataClassificationSetting_DataClassificationConfig_DataClassification) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{7, 0, 1}
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) GetLevelId() string {
+	if x != nil && x.LevelId != nil {
+		return *x.LevelId
+	}
+	return ""
+}
+
+type SemanticTypeSetting_SemanticType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the uuid for semantic type.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the title of the semantic type, it should not be empty.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the semantic type, it can be empty.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// the partial mask algorithm id for the semantic type, if it is empty,
+	// should use the default partial mask algorithm.
+	PartialMaskAlgorithmId string `protobuf:"bytes,4,opt,name=partial_mask_algorithm_id,json=partialMaskAlgorithmId,proto3" json:"partial_mask_algorithm_id,omitempty"`
+	// the full mask algorithm id for the semantic type, if it is empty, should
+	// use the default full mask algorithm.
+	FullMaskAlgorithmId string `protobuf:"bytes,5,opt,name=full_mask_algorithm_id,json=fullMaskAlgorithmId,proto3" json:"full_mask_algorithm_id,omitempty"`
+}
+
+func (x *SemanticTypeSetting_SemanticType) Reset() {
+	*x = SemanticTypeSetting_SemanticType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SemanticTypeSetting_SemanticType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SemanticTypeSetting_SemanticType) ProtoMessage() {}
+
+func (x *SemanticTypeSetting_SemanticType) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SemanticTypeSetting_SemanticType.ProtoReflect.Descriptor instead.
+func (*SemanticTypeSetting_SemanticType) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *SemanticTypeSetting_SemanticType) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SemanticTypeSetting_SemanticType) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SemanticTypeSetting_SemanticType) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SemanticTypeSetting_SemanticType) GetPartialMaskAlgorithmId() string {
+	if x != nil {
+		return x.PartialMaskAlgorithmId
+	}
+	return ""
+}
+
+func (x *SemanticTypeSetting_SemanticType) GetFullMaskAlgorithmId() string {
+	if x != nil {
+		return x.FullMaskAlgorithmId
+	}
+	return ""
+}
+
+type MaskingAlgorithmSetting_Algorithm struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the uuid for masking algorithm.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// title is the title for masking algorithm.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// description is the description for masking algorithm.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Category is the category for masking algorithm. Currently, it accepts 2 categories only: MASKING and HASHING.
+	// The range of accepted Payload is decided by the category.
+	// Mask: FullMask, RangeMask, FormatPreservingMask, DateShiftMask, RegexMask
+	// Hash: MD5Mask, DeterministicHashMask
+	Category string `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	// Types that are assignable to Mask:
+	//
+	//	*MaskingAlgorithmSetting_Algorithm_FullMask_
+	//	*MaskingAlgorithmSetting_Algorithm_RangeMask_
+	//	*MaskingAlgorithmSetting_Algorithm_Md5Mask
+	//	*MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_
+	//	*MaskingAlgorithmSetting_Algorithm_DeterministicHashMask_
+	//	*MaskingAlgorithmSetting_Algorithm_DateShiftMask_
+	//	*MaskingAlgorithmSetting_Algorithm_RegexMask_
+	Mask isMaskingAlgorithmSetting_Algorithm_Mask `protobuf_oneof:"mask"`
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm) Reset() {
+	*x = MaskingAlgorithmSetting_Algorithm{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaskingAlgorithmSetting_Algorithm) ProtoMessage() {}
+
+func (x *MaskingAlgorithmSetting_Algorithm) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaskingAlgorithmSetting_Algorithm.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting_Algorithm) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (m *MaskingAlgorithmSetting_Algorithm) GetMask() isMaskingAlgorithmSetting_Algorithm_Mask {
+	if m != nil {
+		return m.Mask
+	}
+	return nil
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm) GetFullMask() *MaskingAlgorithmSetting_Algorithm_FullMask {
+	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_FullMask_); ok {
+		return x.FullMask
+	}
+	return nil
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm) GetRangeMask() *MaskingAlgorithmSetting_Algorithm_RangeMask {
+	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_RangeMask_); ok {
+		return x.RangeMask
+	}
+	return nil
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm) GetMd5Mask() *MaskingAlgorithmSetting_Algorithm_MD5Mask {
+	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_Md5Mask); ok {
+		return x.Md5Mask
+	}
+	return nil
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm) GetFormatPreservingMask() *MaskingAlgorithmSetting_Algorithm_FormatPreservingMask {
+	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_); ok {
+		return x.FormatPreservingMask
+	}
+	return nil
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm) GetDeterministicHashMask() *MaskingAlgorithmSetting_Algorithm_DeterministicHashMask {
+	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_DeterministicHashMask_); ok {
+		return x.DeterministicHashMask
+	}
+	return nil
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm) GetDateShiftMask() *MaskingAlgorithmSetting_Algorithm_DateShiftMask {
+	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_DateShiftMask_); ok {
+		return x.DateShiftMask
+	}
+	return nil
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm) GetRegexMask() *MaskingAlgorithmSetting_Algorithm_RegexMask {
+	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_RegexMask_); ok {
+		return x.RegexMask
+	}
+	return nil
+}
+
+type isMaskingAlgorithmSetting_Algorithm_Mask interface {
+	isMaskingAlgorithmSetting_Algorithm_Mask()
+}
+
+type MaskingAlgorithmSetting_Algorithm_FullMask_ struct {
+	FullMask *MaskingAlgorithmSetting_Algorithm_FullMask `protobuf:"bytes,5,opt,name=full_mask,json=fullMask,proto3,oneof"`
+}
+
+type MaskingAlgorithmSetting_Algorithm_RangeMask_ struct {
+	RangeMask *MaskingAlgorithmSetting_Algorithm_RangeMask `protobuf:"bytes,6,opt,name=range_mask,json=rangeMask,proto3,oneof"`
+}
+
+type MaskingAlgorithmSetting_Algorithm_Md5Mask struct {
+	Md5Mask *MaskingAlgorithmSetting_Algorithm_MD5Mask `protobuf:"bytes,7,opt,name=md5_mask,json=md5Mask,proto3,oneof"`
+}
+
+type MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_ struct {
+	FormatPreservingMask *MaskingAlgorithmSetting_Algorithm_FormatPreservingMask `protobuf:"bytes,8,opt,name=format_preserving_mask,json=formatPreservingMask,proto3,oneof"`
+}
+
+type MaskingAlgorithmSetting_Algorithm_DeterministicHashMask_ struct {
+	DeterministicHashMask *MaskingAlgorithmSetting_Algorithm_DeterministicHashMask `protobuf:"bytes,9,opt,name=deterministic_hash_mask,json=deterministicHashMask,proto3,oneof"`
+}
+
+type MaskingAlgorithmSetting_Algorithm_DateShiftMask_ struct {
+	DateShiftMask *MaskingAlgorithmSetting_Algorithm_DateShiftMask `protobuf:"bytes,10,opt,name=date_shift_mask,json=dateShiftMask,proto3,oneof"`
+}
+
+type MaskingAlgorithmSetting_Algorithm_RegexMask_ struct {
+	RegexMask *MaskingAlgorithmSetting_Algorithm_RegexMask `protobuf:"bytes,11,opt,name=regex_mask,json=regexMask,proto3,oneof"`
+}
+
+func (*MaskingAlgorithmSetting_Algorithm_FullMask_) isMaskingAlgorithmSetting_Algorithm_Mask() {}
+
+func (*MaskingAlgorithmSetting_Algorithm_RangeMask_) isMaskingAlgorithmSetting_Algorithm_Mask() {}
+
+func (*MaskingAlgorithmSetting_Algorithm_Md5Mask) isMaskingAlgorithmSetting_Algorithm_Mask() {}
+
+func (*MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_) isMaskingAlgorithmSetting_Algorithm_Mask() {
+}
+
+func (*MaskingAlgorithmSetting_Algorithm_DeterministicHashMask_) isMaskingAlgorithmSetting_Algorithm_Mask() {
+}
+
+func (*MaskingAlgorithmSetting_Algorithm_DateShiftMask_) isMaskingAlgorithmSetting_Algorithm_Mask() {}
+
+func (*MaskingAlgorithmSetting_Algorithm_RegexMask_) isMaskingAlgorithmSetting_Algorithm_Mask() {}
+
+type MaskingAlgorithmSetting_Algorithm_FullMask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// substitution is the string used to replace the original value, the
+	// max length of the string is 16 bytes.
+	Substitution string `protobuf:"bytes,1,opt,name=substitution,proto3" json:"substitution,omitempty"`
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_FullMask) Reset() {
+	*x = MaskingAlgorithmSetting_Algorithm_FullMask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_FullMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaskingAlgorithmSetting_Algorithm_FullMask) ProtoMessage() {}
+
+func (x *MaskingAlgorithmSetting_Algorithm_FullMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaskingAlgorithmSetting_Algorithm_FullMask.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting_Algorithm_FullMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{9, 0, 0}
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_FullMask) GetSubstitution() string {
+	if x != nil {
+		return x.Substitution
+	}
+	return ""
+}
+
+type MaskingAlgorithmSetting_Algorithm_RangeMask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// We store it as a repeated field to face the fact that the original value may have multiple parts should be masked.
+	// But frontend can be started with a single rule easily.
+	Slices []*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice `protobuf:"bytes,1,rep,name=slices,proto3" json:"slices,omitempty"`
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask) Reset() {
+	*x = MaskingAlgorithmSetting_Algorithm_RangeMask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaskingAlgorithmSetting_Algorithm_RangeMask) ProtoMessage() {}
+
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaskingAlgorithmSetting_Algorithm_RangeMask.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting_Algorithm_RangeMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{9, 0, 1}
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask) GetSlices() []*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice {
+	if x != nil {
+		return x.Slices
+	}
+	return nil
+}
+
+type MaskingAlgorithmSetting_Algorithm_MD5Mask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// salt is the salt value to generate a different hash that with the word alone.
+	Salt string `protobuf:"bytes,1,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_MD5Mask) Reset() {
+	*x = MaskingAlgorithmSetting_Algorithm_MD5Mask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_MD5Mask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaskingAlgorithmSetting_Algorithm_MD5Mask) ProtoMessage() {}
+
+func (x *MaskingAlgorithmSetting_Algorithm_MD5Mask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaskingAlgorithmSetting_Algorithm_MD5Mask.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting_Algorithm_MD5Mask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{9, 0, 2}
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_MD5Mask) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+type MaskingAlgorithmSetting_Algorithm_FormatPreservingMask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key is the key to encrypt the digits of the original value, the length and the other characters
+	// are kept, e.g. the separators of the credit card and phone numbers.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// keep_suffix_digits is the number of the trailing digits kept as they are, e.g. 4 for the credit card numbers.
+	KeepSuffixDigits int32 `protobuf:"varint,2,opt,name=keep_suffix_digits,json=keepSuffixDigits,proto3" json:"keep_suffix_digits,omitempty"`
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_FormatPreservingMask) Reset() {
+	*x = MaskingAlgorithmSetting_Algorithm_FormatPreservingMask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_FormatPreservingMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaskingAlgorithmSetting_Algorithm_FormatPreservingMask) ProtoMessage() {}
+
+func (x *MaskingAlgorithmSetting_Algorithm_FormatPreservingMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaskingAlgorithmSetting_Algorithm_FormatPreservingMask.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting_Algorithm_FormatPreservingMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{9, 0, 3}
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_FormatPreservingMask) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_FormatPreservingMask) GetKeepSuffixDigits() int32 {
+	if x != nil {
+		return x.KeepSuffixDigits
+	}
+	return 0
+}
+
+type MaskingAlgorithmSetting_Algorithm_DeterministicHashMask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_DeterministicHashMask) Reset() {
+	*x = MaskingAlgorithmSetting_Algorithm_DeterministicHashMask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_DeterministicHashMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaskingAlgorithmSetting_Algorithm_DeterministicHashMask) ProtoMessage() {}
+
+func (x *MaskingAlgorithmSetting_Algorithm_DeterministicHashMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaskingAlgorithmSetting_Algorithm_DeterministicHashMask.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting_Algorithm_DeterministicHashMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{9, 0, 4}
+}
+
+type MaskingAlgorithmSetting_Algorithm_DateShiftMask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max_days is the window of the shift, the date is shifted by [-max_days, max_days] days.
+	// The number of days is derived from the original value and the per-workspace salt, so the same date is always shifted to the same date.
+	MaxDays int32 `protobuf:"varint,1,opt,name=max_days,json=maxDays,proto3" json:"max_days,omitempty"`
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_DateShiftMask) Reset() {
+	*x = MaskingAlgorithmSetting_Algorithm_DateShiftMask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_DateShiftMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaskingAlgorithmSetting_Algorithm_DateShiftMask) ProtoMessage() {}
+
+func (x *MaskingAlgorithmSetting_Algorithm_DateShiftMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaskingAlgorithmSetting_Algorithm_DateShiftMask.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting_Algorithm_DateShiftMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{9, 0, 5}
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_DateShiftMask) GetMaxDays() int32 {
+	if x != nil {
+		return x.MaxDays
+	}
+	return 0
+}
+
+type MaskingAlgorithmSetting_Algorithm_RegexMask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pattern is the RE2 regular expression matching the parts of the original value to be masked.
+	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// substitution is the string used to replace the matched parts, it can refer to the capturing groups, e.g. ${1}****.
+	Substitution string `protobuf:"bytes,2,opt,name=substitution,proto3" json:"substitution,omitempty"`
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_RegexMask) Reset() {
+	*x = MaskingAlgorithmSetting_Algorithm_RegexMask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_RegexMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaskingAlgorithmSetting_Algorithm_RegexMask) ProtoMessage() {}
+
+func (x *MaskingAlgorithmSetting_Algorithm_RegexMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaskingAlgorithmSetting_Algorithm_RegexMask.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting_Algorithm_RegexMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{9, 0, 6}
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_RegexMask) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_RegexMask) GetSubstitution() string {
+	if x != nil {
+		return x.Substitution
+	}
+	return ""
+}
+
+type MaskingAlgorithmSetting_Algorithm_RangeMask_Slice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start is the start index of the original value, start from 0 and should be less than stop.
+	Start int32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// stop is the stop index of the original value, should be less than the length of the original value.
+	End int32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	// OriginalValue[start:end) would be replaced with replace_with.
+	Substitution string `protobuf:"bytes,3,opt,name=substitution,proto3" json:"substitution,omitempty"`
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) Reset() {
+	*x = MaskingAlgorithmSetting_Algorithm_RangeMask_Slice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) ProtoMessage() {}
+
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaskingAlgorithmSetting_Algorithm_RangeMask_Slice.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{9, 0, 1, 0}
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) GetSubstitution() string {
+	if x != nil {
+		return x.Substitution
+	}
+	return ""
+}
+
+type RateLimitSetting_Quota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// requests_per_minute is the number of requests allowed per minute, 0 means unlimited.
+	RequestsPerMinute int32 `protobuf:"varint,1,opt,name=requests_per_minute,json=requestsPerMinute,proto3" json:"requests_per_minute,omitempty"`
+	// burst is the maximum number of requests allowed at once, it defaults to requests_per_minute if not set.
+	Burst int32 `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+}
+
+func (x *RateLimitSetting_Quota) Reset() {
+	*x = RateLimitSetting_Quota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimitSetting_Quota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitSetting_Quota) ProtoMessage() {}
+
+func (x *RateLimitSetting_Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitSetting_Quota.ProtoReflect.Descriptor instead.
+func (*RateLimitSetting_Quota) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *RateLimitSetting_Quota) GetRequestsPerMinute() int32 {
+	if x != nil {
+		return x.RequestsPerMinute
+	}
+	return 0
+}
+
+func (x *RateLimitSetting_Quota) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+type RateLimitSetting_Override struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// principal is the email of the user or service account.
+	Principal string                  `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	Quota     *RateLimitSetting_Quota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (x *RateLimitSetting_Override) Reset() {
+	*x = RateLimitSetting_Override{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimitSetting_Override) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitSetting_Override) ProtoMessage() {}
+
+func (x *RateLimitSetting_Override) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitSetting_Override.ProtoReflect.Descriptor instead.
+func (*RateLimitSetting_Override) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{10, 1}
+}
+
+func (x *RateLimitSetting_Override) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *RateLimitSetting_Override) GetQuota() *RateLimitSetting_Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type SCIMSetting_GroupMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// group is the display name of the identity provider group, e.g. "dba-team".
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// workspace_role is the workspace role granted to the group members, e.g. "roles/workspaceDBA", optional.
+	WorkspaceRole string                                  `protobuf:"bytes,2,opt,name=workspace_role,json=workspaceRole,proto3" json:"workspace_role,omitempty"`
+	ProjectRoles  []*SCIMSetting_GroupMapping_ProjectRole `protobuf:"bytes,3,rep,name=project_roles,json=projectRoles,proto3" json:"project_roles,omitempty"`
+}
+
+func (x *SCIMSetting_GroupMapping) Reset() {
+	*x = SCIMSetting_GroupMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SCIMSetting_GroupMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SCIMSetting_GroupMapping) ProtoMessage() {}
+
+func (x *SCIMSetting_GroupMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SCIMSetting_GroupMapping.ProtoReflect.Descriptor instead.
+func (*SCIMSetting_GroupMapping) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *SCIMSetting_GroupMapping) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *SCIMSetting_GroupMapping) GetWorkspaceRole() string {
+	if x != nil {
+		return x.WorkspaceRole
+	}
+	return ""
+}
+
+func (x *SCIMSetting_GroupMapping) GetProjectRoles() []*SCIMSetting_GroupMapping_ProjectRole {
+	if x != nil {
+		return x.ProjectRoles
+	}
+	return nil
+}
+
+type SCIMSetting_GroupMapping_ProjectRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Format: projects/{project}
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// role is the project role granted to the group members, e.g. "roles/projectDeveloper".
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *SCIMSetting_GroupMapping_ProjectRole) Reset() {
+	*x = SCIMSetting_GroupMapping_ProjectRole{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SCIMSetting_GroupMapping_ProjectRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SCIMSetting_GroupMapping_ProjectRole) ProtoMessage() {}
+
+func (x *SCIMSetting_GroupMapping_ProjectRole) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SCIMSetting_GroupMapping_ProjectRole.ProtoReflect.Descriptor instead.
+func (*SCIMSetting_GroupMapping_ProjectRole) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{19, 0, 0}
+}
+
+func (x *SCIMSetting_GroupMapping_ProjectRole) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *SCIMSetting_GroupMapping_ProjectRole) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AuditLogSetting_S3Export struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// prefix is the prefix of the object keys, e.g. "bytebase/audit-logs".
+	Prefix          string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	AccessKeyId     string `protobuf:"bytes,4,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	SecretAccessKey string `protobuf:"bytes,5,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
+}
+
+func (x *AuditLogSetting_S3Export) Reset() {
+	*x = AuditLogSetting_S3Export{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogSetting_S3Export) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogSetting_S3Export) ProtoMessage() {}
+
+func (x *AuditLogSetting_S3Export) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogSetting_S3Export.ProtoReflect.Descriptor instead.
+func (*AuditLogSetting_S3Export) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *AuditLogSetting_S3Export) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *AuditLogSetting_S3Export) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *AuditLogSetting_S3Export) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *AuditLogSetting_S3Export) GetAccessKeyId() string {
+	if x != nil {
+		return x.AccessKeyId
+	}
+	return ""
+}
+
+func (x *AuditLogSetting_S3Export) GetSecretAccessKey() string {
+	if x != nil {
+		return x.SecretAccessKey
+	}
+	return ""
+}
+
+type AuditLogSetting_SyslogExport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the syslog server address, e.g. "udp://syslog:514" or "tcp://syslog:514".
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *AuditLogSetting_SyslogExport) Reset() {
+	*x = AuditLogSetting_SyslogExport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogSetting_SyslogExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogSetting_SyslogExport) ProtoMessage() {}
+
+func (x *AuditLogSetting_SyslogExport) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogSetting_SyslogExport.ProtoReflect.Descriptor instead.
+func (*AuditLogSetting_SyslogExport) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{20, 1}
+}
+
+func (x *AuditLogSetting_SyslogExport) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type InstanceDiscoverySetting_Source struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique identifier of the source, e.g. "aws-prod".
+	Id       string                                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider InstanceDiscoverySetting_Source_Provider `protobuf:"varint,2,opt,name=provider,proto3,enum=bytebase.store.InstanceDiscoverySetting_Source_Provider" json:"provider,omitempty"`
+	// regions are the AWS regions to list, e.g. "us-east-1".
+	Regions []string `protobuf:"bytes,3,rep,name=regions,proto3" json:"regions,omitempty"`
+	// project is the GCP project to list.
+	Project string `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`
+	// access_key_id is the AWS access key ID. The default credential chain is used if it is empty.
+	AccessKeyId     string `protobuf:"bytes,5,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	SecretAccessKey string `protobuf:"bytes,6,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
+	// service_account_key is the GCP service account key in JSON. The application default credentials are used if it is empty.
+	ServiceAccountKey string `protobuf:"bytes,7,opt,name=service_account_key,json=serviceAccountKey,proto3" json:"service_account_key,omitempty"`
+	// tag_filters are the tags (AWS) or the user labels (GCP) the discovered instances must have, e.g. {"bytebase": "true"}.
+	// All the cloud instances are discovered if it is empty.
+	TagFilters map[string]string `protobuf:"bytes,8,rep,name=tag_filters,json=tagFilters,proto3" json:"tag_filters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// environment is the environment of the registered instances.
+	// Format: environments/{environment}
+	Environment string `protobuf:"bytes,9,opt,name=environment,proto3" json:"environment,omitempty"`
+	// auto_register registers the discovered instances automatically,
+	// otherwise the discovered instances are proposed for the adoption.
+	AutoRegister bool `protobuf:"varint,10,opt,name=auto_register,json=autoRegister,proto3" json:"auto_register,omitempty"`
+	// username is the username of the admin data source of the registered instances.
+	Username string `protobuf:"bytes,11,opt,name=username,proto3" json:"username,omitempty"`
+	// password is the password of the admin data source of the registered instances.
+	Password string `protobuf:"bytes,12,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *InstanceDiscoverySetting_Source) Reset() {
+	*x = InstanceDiscoverySetting_Source{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_setting_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceDiscoverySetting_Source) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceDiscoverySetting_Source) ProtoMessage() {}
+
+func (x *InstanceDiscoverySetting_Source) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceDiscoverySetting_Source.ProtoReflect.Descriptor instead.
+func (*InstanceDiscoverySetting_Source) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *InstanceDiscoverySetting_Source) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InstanceDiscoverySetting_Source) GetProvider() InstanceDiscoverySetting_Source_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return InstanceDiscoverySetting_Source_PROVIDER_UNSPECIFIED
+}
+
+func (x *InstanceDiscoverySetting_Source) GetRegions() []string {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *InstanceDiscoverySetting_Source) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *InstanceDiscoverySetting_Source) GetAccessKeyId() string {
+	if x != nil {
+		return x.AccessKeyId
+	}
+	return ""
+}
+
+func (x *InstanceDiscoverySetting_Source) GetSecretAccessKey() string {
+	if x != nil {
+		return x.SecretAccessKey
+	}
+	return ""
+}
+
+func (x *InstanceDiscoverySetting_Source) GetServiceAccountKey() string {
+	if x != nil {
+		return x.ServiceAccountKey
+	}
+	return ""
+}
+
+func (x *InstanceDiscoverySetting_Source) GetTagFilters() map[string]string {
+	if x != nil {
+		return x.TagFilters
+	}
+	return nil
+}
+
+func (x *InstanceDiscoverySetting_Source) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *InstanceDiscoverySetting_Source) GetAutoRegister() bool {
+	if x != nil {
+		return x.AutoRegister
+	}
+	return false
+}
+
+func (x *InstanceDiscoverySetting_Source) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *InstanceDiscoverySetting_Source) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+var File_store_setting_proto protoreflect.FileDescriptor
+
+var file_store_setting_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x25, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x65, 0x78, 0x70, 0x72, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x73, 0x79, 0x6e, 0x74, 0x61, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x65, 0x78, 0x70, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x14, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x03, 0x0a, 0x17, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x55, 0x72, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64,
+	0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x32, 0x66, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x32, 0x66, 0x61, 0x12, 0x28,
+	0x0a, 0x10, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x70, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x49, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x67, 0x69, 0x74, 0x6f,
+	0x70, 0x73, 0x5f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x67, 0x69, 0x74, 0x6f, 0x70, 0x73, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x61,
+	0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x69, 0x6d,
+	0x75, 0x6d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4b,
+	0x0a, 0x14, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xe9, 0x01, 0x0a, 0x0c,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c,
+	0x69, 0x6e, 0x6b, 0x22, 0x72, 0x0a, 0x0a, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14,
+	0x0a, 0x10, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x49, 0x4e,
+	0x46, 0x4f, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x4c, 0x45,
+	0x56, 0x45, 0x4c, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x18, 0x0a,
+	0x14, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x43, 0x52, 0x49,
+	0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x03, 0x22, 0x3c, 0x0a, 0x12, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9d, 0x02, 0x0a, 0x18, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x43, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0xbb, 0x01, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65,
+	0x12, 0x44, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x65, 0x78, 0x70, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaf, 0x02, 0x0a, 0x17, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x42, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x1a, 0xcf, 0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x45, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x3e, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x4c, 0x4c, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x08, 0x0a,
+	0x04, 0x4a, 0x49, 0x52, 0x41, 0x10, 0x03, 0x22, 0x88, 0x05, 0x0a, 0x17, 0x53, 0x4d, 0x54, 0x50,
+	0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x52, 0x0a, 0x0a, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x4d, 0x54, 0x50, 0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x63, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x12, 0x5e, 0x0a, 0x0e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x36, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x53, 0x4d, 0x54, 0x50, 0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x6e, 0x0a, 0x0a, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x13, 0x0a, 0x0f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x54, 0x4c, 0x53, 0x10, 0x02, 0x12, 0x16, 0x0a,
+	0x12, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x53, 0x4c, 0x5f,
+	0x54, 0x4c, 0x53, 0x10, 0x03, 0x22, 0x9a, 0x01, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x55, 0x54, 0x48,
+	0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x55, 0x54, 0x48,
+	0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
+	0x01, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x41,
+	0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f,
+	0x47, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54,
+	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x41, 0x4d, 0x5f, 0x4d, 0x44, 0x35,
+	0x10, 0x04, 0x22, 0xca, 0x06, 0x0a, 0x15, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x5c, 0x0a, 0x0f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x5c, 0x0a, 0x0f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x1a, 0xd9, 0x01,
+	0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x2e, 0x0a, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x36, 0x0a, 0x06, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x06, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x6c, 0x0a, 0x0a, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52,
+	0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0xd5, 0x01, 0x0a, 0x0d, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x52, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0x96, 0x06, 0x0a, 0x19, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x5c, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x1a, 0x9a, 0x05, 0x0a, 0x18,
+	0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x60,
+	0x0a, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73,
+	0x12, 0x7e, 0x0a, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x56, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x4f, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x89, 0x01, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1e, 0x0a, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x1a, 0x98, 0x01,
+	0x0a, 0x13, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x6b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x55, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa6, 0x02, 0x0a, 0x13, 0x53, 0x65, 0x6d,
+	0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x46, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0xc6, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x6d,
+	0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4d, 0x61, 0x73,
+	0x6b, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x16,
+	0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x66, 0x75,
+	0x6c, 0x6c, 0x4d, 0x61, 0x73, 0x6b, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x49,
+	0x64, 0x22, 0xb9, 0x0b, 0x0a, 0x17, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x51, 0x0a,
+	0x0a, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x52, 0x0a, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73,
+	0x1a, 0xca, 0x0a, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x12, 0x59, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x4d, 0x61, 0x73,
+	0x6b, 0x48, 0x00, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x5c, 0x0a,
+	0x0a, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3b, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00,
+	0x52, 0x09, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x56, 0x0a, 0x08, 0x6d,
+	0x64, 0x35, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4d,
+	0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x2e, 0x4d, 0x44, 0x35, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x64, 0x35, 0x4d,
+	0x61, 0x73, 0x6b, 0x12, 0x7e, 0x0a, 0x16, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x5f, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x14, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x4d,
+	0x61, 0x73, 0x6b, 0x12, 0x81, 0x01, 0x0a, 0x17, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x48, 0x61, 0x73, 0x68, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00,
+	0x52, 0x15, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x48,
+	0x61, 0x73, 0x68, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x69, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3f, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x4d, 0x61, 0x73,
+	0x6b, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x4d, 0x61,
+	0x73, 0x6b, 0x12, 0x5c, 0x0a, 0x0a, 0x72, 0x65, 0x67, 0x65, 0x78, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x78, 0x4d,
+	0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x67, 0x65, 0x78, 0x4d, 0x61, 0x73, 0x6b,
+	0x1a, 0x2e, 0x0a, 0x08, 0x46, 0x75, 0x6c, 0x6c, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x22, 0x0a, 0x0c,
+	0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0xbb, 0x01, 0x0a, 0x09, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x59,
+	0x0a, 0x06, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x6c, 0x69, 0x63,
+	0x65, 0x52, 0x06, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x53, 0x0a, 0x05, 0x53, 0x6c, 0x69,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75,
+	0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1d,
+	0x0a, 0x07, 0x4d, 0x44, 0x35, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x1a, 0x56, 0x0a,
+	0x14, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e,
+	0x67, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x6b, 0x65, 0x65, 0x70, 0x5f,
+	0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x5f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x10, 0x6b, 0x65, 0x65, 0x70, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x44,
+	0x69, 0x67, 0x69, 0x74, 0x73, 0x1a, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x48, 0x61, 0x73, 0x68, 0x4d, 0x61, 0x73, 0x6b, 0x1a, 0x2a,
+	0x0a, 0x0d, 0x44, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x4d, 0x61, 0x73, 0x6b, 0x12,
+	0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x44, 0x61, 0x79, 0x73, 0x1a, 0x49, 0x0a, 0x09, 0x52, 0x65,
+	0x67, 0x65, 0x78, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x22, 0xb5, 0x03,
+	0x0a, 0x10, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x45, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x5a, 0x0a, 0x15, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x52, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x1a, 0x4d,
+	0x0a, 0x05, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x65,
+	0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x1a, 0x66, 0x0a,
+	0x08, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69,
+	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0xff, 0x02, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x5f,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x6c, 0x65,
+	0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x6f,
+	0x77, 0x73, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x73, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x5f, 0x68, 0x6f, 0x75, 0x72, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x45, 0x6e, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x61,
+	0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x24,
+	0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x77, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x52, 0x6f, 0x77, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d,
+	0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14,
+	0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x69, 0x64, 0x6c, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x58,
+	0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
+	0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x22, 0x7a, 0x0a, 0x0e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x69, 0x73, 0x73, 0x75, 0x65, 0x52,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x36, 0x0a, 0x17,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x79, 0x73, 0x22, 0xf4, 0x02, 0x0a, 0x0b, 0x4a, 0x69, 0x72, 0x61, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x09,
+	0x61, 0x70, 0x69, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x61, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x6e, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x11,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x77, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x55, 0x0a, 0x0f, 0x53,
+	0x6c, 0x61, 0x63, 0x6b, 0x41, 0x70, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b,
+	0x0a, 0x09, 0x62, 0x6f, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x62, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x22, 0x91, 0x02, 0x0a, 0x0f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x74,
+	0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x4b, 0x41, 0x46, 0x4b, 0x41, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x4e, 0x41, 0x54, 0x53, 0x10, 0x02, 0x22, 0xda, 0x02, 0x0a, 0x0b, 0x53, 0x43, 0x49, 0x4d, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4f, 0x0a, 0x0e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x43, 0x49, 0x4d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0d,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0xe3, 0x01,
+	0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x14,
+	0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x59, 0x0a, 0x0d, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x53, 0x43, 0x49, 0x4d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x1a, 0x3b, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x22, 0xa5, 0x04, 0x0a, 0x0f, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x4b, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a,
+	0x02, 0x73, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x33, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x02, 0x73, 0x33, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x6c, 0x6f,
+	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f,
+	0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x73, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x1a, 0xa2, 0x01,
+	0x0a, 0x08, 0x53, 0x33, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b,
+	0x65, 0x79, 0x1a, 0x28, 0x0a, 0x0c, 0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3d, 0x0a, 0x0a,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x58,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x53, 0x33, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x59, 0x53, 0x4c, 0x4f, 0x47, 0x10, 0x02, 0x22, 0x48, 0x0a, 0x12, 0x4d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xe2, 0x05, 0x0a, 0x18, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x49, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x1a, 0xfa, 0x04,
+	0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x54, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b,
+	0x65, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x60, 0x0a, 0x0b, 0x74, 0x61, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x74, 0x61, 0x67, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61,
+	0x75, 0x74, 0x6f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x1a, 0x3d, 0x0a, 0x0f, 0x54, 0x61, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x36, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x14, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x57, 0x53, 0x10,
+	0x01, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x43, 0x50, 0x10, 0x02, 0x22, 0x57, 0x0a, 0x13, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x40, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x22, 0x85, 0x03, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x65, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x2e, 0x0a, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x46, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x65, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12,
+	0x3f, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65,
+	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x14, 0x5a, 0x12, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_store_setting_proto_rawDescOnce sync.Once
+	file_store_setting_proto_rawDescData = file_store_setting_proto_rawDesc
+)
+
+func file_store_setting_proto_rawDescGZIP() []byte {
+	file_store_setting_proto_rawDescOnce.Do(func() {
+		file_store_setting_proto_rawDescData = protoimpl.X.CompressGZIP(file_store_setting_proto_rawDescData)
+	})
+	return file_store_setting_proto_rawDescData
+}
+
+var file_store_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_store_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
+var file_store_setting_proto_goTypes = []interface{}{
+	(Announcement_AlertLevel)(0),                                     // 0: bytebase.store.Announcement.AlertLevel
+	(ExternalApprovalSetting_Node_Mode)(0),                           // 1: bytebase.store.ExternalApprovalSetting.Node.Mode
+	(SMTPMailDeliverySetting_Encryption)(0),                          // 2: bytebase.store.SMTPMailDeliverySetting.Encryption
+	(SMTPMailDeliverySetting_Authentication)(0),                      // 3: bytebase.store.SMTPMailDeliverySetting.Authentication
+	(EventBusSetting_Type)(0),                                        // 4: bytebase.store.EventBusSetting.Type
+	(AuditLogSetting_ExportType)(0),                                  // 5: bytebase.store.AuditLogSetting.ExportType
+	(InstanceDiscoverySetting_Source_Provider)(0),                    // 6: bytebase.store.InstanceDiscoverySetting.Source.Provider
+	(*WorkspaceProfileSetting)(nil),                                  // 7: bytebase.store.WorkspaceProfileSetting
+	(*Announcement)(nil),                                             // 8: bytebase.store.Announcement
+	(*AgentPluginSetting)(nil),                                       // 9: bytebase.store.AgentPluginSetting
+	(*WorkspaceApprovalSetting)(nil),                                 // 10: bytebase.store.WorkspaceApprovalSetting
+	(*ExternalApprovalSetting)(nil),                                  // 11: bytebase.store.ExternalApprovalSetting
+	(*SMTPMailDeliverySetting)(nil),                                  // 12: bytebase.store.SMTPMailDeliverySetting
+	(*SchemaTemplateSetting)(nil),                                    // 13: bytebase.store.SchemaTemplateSetting
+	(*DataClassificationSetting)(nil),                                // 14: bytebase.store.DataClassificationSetting
+	(*SemanticTypeSetting)(nil),                                      // 15: bytebase.store.SemanticTypeSetting
+	(*MaskingAlgorithmSetting)(nil),                                  // 16: bytebase.store.MaskingAlgorithmSetting
+	(*RateLimitSetting)(nil),                                         // 17: bytebase.store.RateLimitSetting
+	(*QueryAuditSetting)(nil),                                        // 18: bytebase.store.QueryAuditSetting
+	(*QueryCursorSetting)(nil),                                       // 19: bytebase.store.QueryCursorSetting
+	(*QueryHistorySetting)(nil),                                      // 20: bytebase.store.QueryHistorySetting
+	(*ArchiveSetting)(nil),                                           // 21: bytebase.store.ArchiveSetting
+	(*JiraSetting)(nil),                                              // 22: bytebase.store.JiraSetting
+	(*ServiceNowSetting)(nil),                                        // 23: bytebase.store.ServiceNowSetting
+	(*SlackAppSetting)(nil),                                          // 24: bytebase.store.SlackAppSetting
+	(*EventBusSetting)(nil),                                          // 25: bytebase.store.EventBusSetting
+	(*SCIMSetting)(nil),                                              // 26: bytebase.store.SCIMSetting
+	(*AuditLogSetting)(nil),                                          // 27: bytebase.store.AuditLogSetting
+	(*MaintenanceSetting)(nil),                                       // 28: bytebase.store.MaintenanceSetting
+	(*InstanceDiscoverySetting)(nil),                                 // 29: bytebase.store.InstanceDiscoverySetting
+	(*DiscoveredInstances)(nil),                                      // 30: bytebase.store.DiscoveredInstances
+	(*DiscoveredInstance)(nil),                                       // 31: bytebase.store.DiscoveredInstance
+	(*WorkspaceApprovalSetting_Rule)(nil),                            // 32: bytebase.store.WorkspaceApprovalSetting.Rule
+	(*ExternalApprovalSetting_Node)(nil),                             // 33: bytebase.store.ExternalApprovalSetting.Node
+	(*SchemaTemplateSetting_FieldTemplate)(nil),                      // 34: bytebase.store.SchemaTemplateSetting.FieldTemplate
+	(*SchemaTemplateSetting_ColumnType)(nil),                         // 35: bytebase.store.SchemaTemplateSetting.ColumnType
+	(*SchemaTemplateSetting_TableTemplate)(nil),                      // 36: bytebase.store.SchemaTemplateSetting.TableTemplate
+	(*DataClassificationSetting_DataClassificationConfig)(nil),       // 37: bytebase.store.DataClassificationSetting.DataClassificationConfig
+	(*DataClassificationSetting_DataClassificationConfig_Level)(nil), // 38: bytebase.store.DataClassificationSetting.DataClassificationConfig.Level
+	(*DataClassificationSetting_DataClassificationConfig_DataClassification)(nil), // 39: bytebase.store.DataClassificationSetting.DataClassificationConfig.DataClassification
+	nil,                                      // 40: bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
+	(*SemanticTypeSetting_SemanticType)(nil), // 41: bytebase.store.SemanticTypeSetting.SemanticType
+	(*MaskingAlgorithmSetting_Algorithm)(nil),                       // 42: bytebase.store.MaskingAlgorithmSetting.Algorithm
+	(*MaskingAlgorithmSetting_Algorithm_FullMask)(nil),              // 43: bytebase.store.MaskingAlgorithmSetting.Algorithm.FullMask
+	(*MaskingAlgorithmSetting_Algorithm_RangeMask)(nil),             // 44: bytebase.store.MaskingAlgorithmSetting.Algorithm.RangeMask
+	(*MaskingAlgorithmSetting_Algorithm_MD5Mask)(nil),               // 45: bytebase.store.MaskingAlgorithmSetting.Algorithm.MD5Mask
+	(*MaskingAlgorithmSetting_Algorithm_FormatPreservingMask)(nil),  // 46: bytebase.store.MaskingAlgorithmSetting.Algorithm.FormatPreservingMask
+	(*MaskingAlgorithmSetting_Algorithm_DeterministicHashMask)(nil), // 47: bytebase.store.MaskingAlgorithmSetting.Algorithm.DeterministicHashMask
+	(*MaskingAlgorithmSetting_Algorithm_DateShiftMask)(nil),         // 48: bytebase.store.MaskingAlgorithmSetting.Algorithm.DateShiftMask
+	(*MaskingAlgorithmSetting_Algorithm_RegexMask)(nil),             // 49: bytebase.store.MaskingAlgorithmSetting.Algorithm.RegexMask
+	(*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice)(nil),       // 50: bytebase.store.MaskingAlgorithmSetting.Algorithm.RangeMask.Slice
+	(*RateLimitSetting_Quota)(nil),                                  // 51: bytebase.store.RateLimitSetting.Quota
+	(*RateLimitSetting_Override)(nil),                               // 52: bytebase.store.RateLimitSetting.Override
+	(*SCIMSetting_GroupMapping)(nil),                                // 53: bytebase.store.SCIMSetting.GroupMapping
+	(*SCIMSetting_GroupMapping_ProjectRole)(nil),                    // 54: bytebase.store.SCIMSetting.GroupMapping.ProjectRole
+	(*AuditLogSetting_S3Export)(nil),                                // 55: bytebase.store.AuditLogSetting.S3Export
+	(*AuditLogSetting_SyslogExport)(nil),                            // 56: bytebase.store.AuditLogSetting.SyslogExport
+	(*InstanceDiscoverySetting_Source)(nil),                         // 57: bytebase.store.InstanceDiscoverySetting.Source
+	nil,                                                             // 58: bytebase.store.InstanceDiscoverySetting.Source.TagFiltersEntry
+	nil,                                                             // 59: bytebase.store.DiscoveredInstance.LabelsEntry
+	(*durationpb.Duration)(nil),                                     // 60: google.protobuf.Duration
+	(Engine)(0),                                                     // 61: bytebase.store.Engine
+	(*timestamppb.Timestamp)(nil),                                   // 62: google.protobuf.Timestamp
+	(*v1alpha1.ParsedExpr)(nil),                                     // 63: google.api.expr.v1alpha1.ParsedExpr
+	(*ApprovalTemplate)(nil),                                        // 64: bytebase.store.ApprovalTemplate
+	(*expr.Expr)(nil),                                               // 65: google.type.Expr
+	(*ColumnMetadata)(nil),                                          // 66: bytebase.store.ColumnMetadata
+	(*ColumnConfig)(nil),                                            // 67: bytebase.store.ColumnConfig
+	(*TableMetadata)(nil),                                           // 68: bytebase.store.TableMetadata
+	(*TableConfig)(nil),                                             // 69: bytebase.store.TableConfig
+}
+var file_store_setting_proto_depIdxs = []int32{
+	60, // 0: bytebase.store.WorkspaceProfileSetting.token_duration:type_name -> google.protobuf.Duration
+	8,  // 1: bytebase.store.WorkspaceProfileSetting.announcement:type_name -> bytebase.store.Announcement
+	60, // 2: bytebase.store.WorkspaceProfileSetting.session_idle_timeout:type_name -> google.protobuf.Duration
+	0,  // 3: bytebase.store.Announcement.level:type_name -> bytebase.store.Announcement.AlertLevel
+	32, // 4: bytebase.store.WorkspaceApprovalSetting.rules:type_name -> bytebase.store.WorkspaceApprovalSetting.Rule
+	33, // 5: bytebase.store.ExternalApprovalSetting.nodes:type_name -> bytebase.store.ExternalApprovalSetting.Node
+	2,  // 6: bytebase.store.SMTPMailDeliverySetting.encryption:type_name -> bytebase.store.SMTPMailDeliverySetting.Encryption
+	3,  // 7: bytebase.store.SMTPMailDeliverySetting.authentication:type_name -> bytebase.store.SMTPMailDeliverySetting.Authentication
+	34, // 8: bytebase.store.SchemaTemplateSetting.field_templates:type_name -> bytebase.store.SchemaTemplateSetting.FieldTemplate
+	35, // 9: bytebase.store.SchemaTemplateSetting.column_types:type_name -> bytebase.store.SchemaTemplateSetting.ColumnType
+	36, // 10: bytebase.store.SchemaTemplateSetting.table_templates:type_name -> bytebase.store.SchemaTemplateSetting.TableTemplate
+	37, // 11: bytebase.store.DataClassificationSetting.configs:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig
+	41, // 12: bytebase.store.SemanticTypeSetting.types:type_name -> bytebase.store.SemanticTypeSetting.SemanticType
+	42, // 13: bytebase.store.MaskingAlgorithmSetting.algorithms:type_name -> bytebase.store.MaskingAlgorithmSetting.Algorithm
+	51, // 14: bytebase.store.RateLimitSetting.user_quota:type_name -> bytebase.store.RateLimitSetting.Quota
+	51, // 15: bytebase.store.RateLimitSetting.service_account_quota:type_name -> bytebase.store.RateLimitSetting.Quota
+	52, // 16: bytebase.store.RateLimitSetting.overrides:type_name -> bytebase.store.RateLimitSetting.Override
+	4,  // 17: bytebase.store.EventBusSetting.type:type_name -> bytebase.store.EventBusSetting.Type
+	53, // 18: bytebase.store.SCIMSetting.group_mappings:type_name -> bytebase.store.SCIMSetting.GroupMapping
+	60, // 19: bytebase.store.AuditLogSetting.retention:type_name -> google.protobuf.Duration
+	5,  // 20: bytebase.store.AuditLogSetting.export_type:type_name -> bytebase.store.AuditLogSetting.ExportType
+	55, // 21: bytebase.store.AuditLogSetting.s3:type_name -> bytebase.store.AuditLogSetting.S3Export
+	56, // 22: bytebase.store.AuditLogSetting.syslog:type_name -> bytebase.store.AuditLogSetting.SyslogExport
+	57, // 23: bytebase.store.InstanceDiscoverySetting.sources:type_name -> bytebase.store.InstanceDiscoverySetting.Source
+	31, // 24: bytebase.store.DiscoveredInstances.instances:type_name -> bytebase.store.DiscoveredInstance
+	61, // 25: bytebase.store.DiscoveredInstance.engine:type_name -> bytebase.store.Engine
+	59, // 26: bytebase.store.DiscoveredInstance.labels:type_name -> bytebase.store.DiscoveredInstance.LabelsEntry
+	62, // 27: bytebase.store.DiscoveredInstance.discover_time:type_name -> google.protobuf.Timestamp
+	63, // 28: bytebase.store.WorkspaceApprovalSetting.Rule.expression:type_name -> google.api.expr.v1alpha1.ParsedExpr
+	64, // 29: bytebase.store.WorkspaceApprovalSetting.Rule.template:type_name -> bytebase.store.ApprovalTemplate
+	65, // 30: bytebase.store.WorkspaceApprovalSetting.Rule.condition:type_name -> google.type.Expr
+	1,  // 31: bytebase.store.ExternalApprovalSetting.Node.mode:type_name -> bytebase.store.ExternalApprovalSetting.Node.Mode
+	61, // 32: bytebase.store.SchemaTemplateSetting.FieldTemplate.engine:type_name -> bytebase.store.Engine
+	66, // 33: bytebase.store.SchemaTemplateSetting.FieldTemplate.column:type_name -> bytebase.store.ColumnMetadata
+	67, // 34: bytebase.store.SchemaTemplateSetting.FieldTemplate.config:type_name -> bytebase.store.ColumnConfig
+	61, // 35: bytebase.store.SchemaTemplateSetting.ColumnType.engine:type_name -> bytebase.store.Engine
+	61, // 36: bytebase.store.SchemaTemplateSetting.TableTemplate.engine:type_name -> bytebase.store.Engine
+	68, // 37: bytebase.store.SchemaTemplateSetting.TableTemplate.table:type_name -> bytebase.store.TableMetadata
+	69, // 38: bytebase.store.SchemaTemplateSetting.TableTemplate.config:type_name -> bytebase.store.TableConfig
+	38, // 39: bytebase.store.DataClassificationSetting.DataClassificationConfig.levels:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.Level
+	40, // 40: bytebase.store.DataClassificationSetting.DataClassificationConfig.classification:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
+	39, // 41: bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry.value:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.DataClassification
+	43, // 42: bytebase.store.MaskingAlgorithmSetting.Algorithm.full_mask:type_name -> bytebase.store.MaskingAlgorithmSetting.Algorithm.FullMask
+	44, // 43: bytebase.store.MaskingAlgorithmSetting.Algorithm.range_mask:type_name -> bytebase.store.MaskingAlgorithmSetting.Algorithm.RangeMask
+	45, // 44: bytebase.store.MaskingAlgorithmSetting.Algorithm.md5_mask:type_name -> bytebase.store.MaskingAlgorithmSetting.Algorithm.MD5Mask
+	46, // 45: bytebase.store.MaskingAlgorithmSetting.Algorithm.format_preserving_mask:type_name -> bytebase.store.MaskingAlgorithmSetting.Algorithm.FormatPreservingMask
+	47, // 46: bytebase.store.MaskingAlgorithmSetting.Algorithm.deterministic_hash_mask:type_name -> bytebase.store.MaskingAlgorithmSetting.Algorithm.DeterministicHashMask
+	48, // 47: bytebase.store.MaskingAlgorithmSetting.Algorithm.date_shift_mask:type_name -> bytebase.store.MaskingAlgorithmSetting.Algorithm.DateShiftMask
+	49, // 48: bytebase.store.MaskingAlgorithmSetting.Algorithm.regex_mask:type_name -> bytebase.store.MaskingAlgorithmSetting.Algorithm.RegexMask
+	50, // 49: bytebase.store.MaskingAlgorithmSetting.Algorithm.RangeMask.slices:type_name -> bytebase.store.MaskingAlgorithmSetting.Algorithm.RangeMask.Slice
+	51, // 50: bytebase.store.RateLimitSetting.Override.quota:type_name -> bytebase.store.RateLimitSetting.Quota
+	54, // 51: bytebase.store.SCIMSetting.GroupMapping.project_roles:type_name -> bytebase.store.SCIMSetting.GroupMapping.ProjectRole
+	6,  // 52: bytebase.store.InstanceDiscoverySetting.Source.provider:type_name -> bytebase.store.InstanceDiscoverySetting.Source.Provider
+	58, // 53: bytebase.store.InstanceDiscoverySetting.Source.tag_filters:type_name -> bytebase.store.InstanceDiscoverySetting.Source.TagFiltersEntry
+	54, // [54:54] is the sub-list for method output_type
+	54, // [54:54] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
+}
+
+func init() { file_store_setting_proto_init() }
+func file_store_setting_proto_init() {
+	if File_store_setting_proto != nil {
+		return
+	}
+	file_store_approval_proto_init()
+	file_store_common_proto_init()
+	file_store_database_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_store_setting_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceProfileSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Announcement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentPluginSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceApprovalSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalApprovalSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SMTPMailDeliverySetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaTemplateSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataClassificationSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SemanticTypeSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaskingAlgorithmSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimitSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAuditSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCursorSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryHistorySetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JiraSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2247,8 +4970,8 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Announcement); i {
+		file_store_setting_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceNowSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2259,8 +4982,8 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AgentPluginSetting); i {
+		file_store_setting_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlackAppSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2271,8 +4994,8 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkspaceApprovalSetting); i {
+		file_store_setting_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventBusSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2283,8 +5006,8 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalApprovalSetting); i {
+		file_store_setting_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SCIMSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2295,8 +5018,8 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SMTPMailDeliverySetting); i {
+		file_store_setting_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2307,8 +5030,8 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SchemaTemplateSetting); i {
+		file_store_setting_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2319,8 +5042,8 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataClassificationSetting); i {
+		file_store_setting_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceDiscoverySetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2331,8 +5054,8 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SemanticTypeSetting); i {
+		file_store_setting_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoveredInstances); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2343,8 +5066,8 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MaskingAlgorithmSetting); i {
+		file_store_setting_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoveredInstance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2355,7 +5078,7 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_store_setting_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WorkspaceApprovalSetting_Rule); i {
 			case 0:
 				return &v.state
@@ -2367,7 +5090,7 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_store_setting_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExternalApprovalSetting_Node); i {
 			case 0:
 				return &v.state
@@ -2379,7 +5102,7 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_store_setting_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SchemaTemplateSetting_FieldTemplate); i {
 			case 0:
 				return &v.state
@@ -2391,7 +5114,7 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_store_setting_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SchemaTemplateSetting_ColumnType); i {
 			case 0:
 				return &v.state
@@ -2403,7 +5126,7 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_store_setting_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SchemaTemplateSetting_TableTemplate); i {
 			case 0:
 				return &v.state
@@ -2415,7 +5138,7 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_store_setting_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DataClassificationSetting_DataClassificationConfig); i {
 			case 0:
 				return &v.state
@@ -2427,7 +5150,7 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_store_setting_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DataClassificationSetting_DataClassificationConfig_Level); i {
 			case 0:
 				return &v.state
@@ -2439,7 +5162,7 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_store_setting_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DataClassificationSetting_DataClassificationConfig_DataClassification); i {
 			case 0:
 				return &v.state
@@ -2451,7 +5174,7 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_store_setting_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SemanticTypeSetting_SemanticType); i {
 			case 0:
 				return &v.state
@@ -2463,7 +5186,7 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_store_setting_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MaskingAlgorithmSetting_Algorithm); i {
 			case 0:
 				return &v.state
@@ -2475,7 +5198,7 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_store_setting_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MaskingAlgorithmSetting_Algorithm_FullMask); i {
 			case 0:
 				return &v.state
@@ -2487,7 +5210,7 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_store_setting_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MaskingAlgorithmSetting_Algorithm_RangeMask); i {
 			case 0:
 				return &v.state
@@ -2499,7 +5222,7 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_store_setting_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MaskingAlgorithmSetting_Algorithm_MD5Mask); i {
 			case 0:
 				return &v.state
@@ -2511,7 +5234,55 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
-		file_store_setting_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_store_setting_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaskingAlgorithmSetting_Algorithm_FormatPreservingMask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaskingAlgorithmSetting_Algorithm_DeterministicHashMask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaskingAlgorithmSetting_Algorithm_DateShiftMask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaskingAlgorithmSetting_Algorithm_RegexMask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice); i {
 			case 0:
 				return &v.state
@@ -2523,20 +5294,108 @@ func file_store_setting_proto_init() {
 				return nil
 			}
 		}
+		file_store_setting_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimitSetting_Quota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimitSetting_Override); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SCIMSetting_GroupMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SCIMSetting_GroupMapping_ProjectRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogSetting_S3Export); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogSetting_SyslogExport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_setting_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceDiscoverySetting_Source); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_store_setting_proto_msgTypes[17].OneofWrappers = []interface{}{}
-	file_store_setting_proto_msgTypes[20].OneofWrappers = []interface{}{
+	file_store_setting_proto_msgTypes[32].OneofWrappers = []interface{}{}
+	file_store_setting_proto_msgTypes[35].OneofWrappers = []interface{}{
 		(*MaskingAlgorithmSetting_Algorithm_FullMask_)(nil),
 		(*MaskingAlgorithmSetting_Algorithm_RangeMask_)(nil),
 		(*MaskingAlgorithmSetting_Algorithm_Md5Mask)(nil),
+		(*MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_)(nil),
+		(*MaskingAlgorithmSetting_Algorithm_DeterministicHashMask_)(nil),
+		(*MaskingAlgorithmSetting_Algorithm_DateShiftMask_)(nil),
+		(*MaskingAlgorithmSetting_Algorithm_RegexMask_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_setting_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   25,
+			NumEnums:      7,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -20,6 +20,67 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SheetParameter_Type int32
+
+const (
+	SheetParameter_TYPE_UNSPECIFIED SheetParameter_Type = 0
+	SheetParameter_STRING           SheetParameter_Type = 1
+	SheetParameter_INTEGER          SheetParameter_Type = 2
+	SheetParameter_NUMBER           SheetParameter_Type = 3
+	SheetParameter_BOOLEAN          SheetParameter_Type = 4
+	SheetParameter_DATE             SheetParameter_Type = 5
+	SheetParameter_TIMESTAMP        SheetParameter_Type = 6
+)
+
+// Enum value maps for SheetParameter_Type.
+var (
+	SheetParameter_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "STRING",
+		2: "INTEGER",
+		3: "NUMBER",
+		4: "BOOLEAN",
+		5: "DATE",
+		6: "TIMESTAMP",
+	}
+	SheetParameter_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"STRING":           1,
+		"INTEGER":          2,
+		"NUMBER":           3,
+		"BOOLEAN":          4,
+		"DATE":             5,
+		"TIMESTAMP":        6,
+	}
+)
+
+func (x SheetParameter_Type) Enum() *SheetParameter_Type {
+	p := new(SheetParameter_Type)
+	*p = x
+	return p
+}
+
+func (x SheetParameter_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SheetParameter_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_sheet_proto_enumTypes[0].Descriptor()
+}
+
+func (SheetParameter_Type) Type() protoreflect.EnumType {
+	return &file_store_sheet_proto_enumTypes[0]
+}
+
+func (x SheetParameter_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SheetParameter_Type.Descriptor instead.
+func (SheetParameter_Type) EnumDescriptor() ([]byte, []int) {
+	return file_store_sheet_proto_rawDescGZIP(), []int{1, 0}
+}
+
 type SheetPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -30,6 +91,10 @@ type SheetPayload struct {
 	DatabaseConfig *DatabaseConfig `protobuf:"bytes,4,opt,name=database_config,json=databaseConfig,proto3" json:"database_config,omitempty"`
 	// The snapshot of the baseline database config when creating the sheet.
 	BaselineDatabaseConfig *DatabaseConfig `protobuf:"bytes,5,opt,name=baseline_database_config,json=baselineDatabaseConfig,proto3" json:"baseline_database_config,omitempty"`
+	// The definitions of the named parameters in the statement, e.g. {{start_date}}.
+	Parameters []*SheetParameter `protobuf:"bytes,6,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	// The token of the share link, the sheet is not shared if empty.
+	ShareToken string `protobuf:"bytes,7,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
 }
 
 func (x *SheetPayload) Reset() {
@@ -85,6 +150,100 @@ func (x *SheetPayload) GetBaselineDatabaseConfig() *DatabaseConfig {
 	return nil
 }
 
+func (x *SheetPayload) GetParameters() []*SheetParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *SheetPayload) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type SheetParameter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type SheetParameter_Type `protobuf:"varint,2,opt,name=type,proto3,enum=bytebase.store.SheetParameter_Type" json:"type,omitempty"`
+	// The value used if the parameter is not required and the value is not given.
+	DefaultValue string `protobuf:"bytes,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Required     bool   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	Description  string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *SheetParameter) Reset() {
+	*x = SheetParameter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_sheet_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SheetParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SheetParameter) ProtoMessage() {}
+
+func (x *SheetParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_store_sheet_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SheetParameter.ProtoReflect.Descriptor instead.
+func (*SheetParameter) Descriptor() ([]byte, []int) {
+	return file_store_sheet_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SheetParameter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SheetParameter) GetType() SheetParameter_Type {
+	if x != nil {
+		return x.Type
+	}
+	return SheetParameter_TYPE_UNSPECIFIED
+}
+
+func (x *SheetParameter) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *SheetParameter) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *SheetParameter) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
 type SheetPayload_VCSPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -102,7 +261,7 @@ type SheetPayload_VCSPayload struct {
 func (x *SheetPayload_VCSPayload) Reset() {
 	*x = SheetPayload_VCSPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_sheet_proto_msgTypes[1]
+		mi := &file_store_sheet_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -115,7 +274,7 @@ func (x *SheetPayload_VCSPayload) String() string {
 func (*SheetPayload_VCSPayload) ProtoMessage() {}
 
 func (x *SheetPayload_VCSPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_store_sheet_proto_msgTypes[1]
+	mi := &file_store_sheet_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -187,7 +346,7 @@ var file_store_sheet_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74,
 	0x6f, 0x72, 0x65, 0x1a, 0x14, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x62,
 	0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2f, 0x76, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf2, 0x03, 0x0a, 0x0c, 0x53,
+	0x2f, 0x76, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd3, 0x04, 0x0a, 0x0c, 0x53,
 	0x68, 0x65, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x48, 0x0a, 0x0b, 0x76,
 	0x63, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x27, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72,
@@ -203,24 +362,49 @@ var file_store_sheet_proto_rawDesc = []byte{
 	0x32, 0x1e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72,
 	0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x52, 0x16, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0xf4, 0x01, 0x0a, 0x0a, 0x56, 0x43, 0x53,
-	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x24, 0x0a,
-	0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63,
-	0x5f, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53,
-	0x79, 0x6e, 0x63, 0x54, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x79, 0x74, 0x65,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x70, 0x75, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42,
-	0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x68,
+	0x65, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0xf4, 0x01, 0x0a, 0x0a, 0x56, 0x43,
+	0x53, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x24,
+	0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e,
+	0x63, 0x5f, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74,
+	0x53, 0x79, 0x6e, 0x63, 0x54, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x75, 0x73, 0x68,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x70, 0x75, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x22, 0xa9, 0x02, 0x0a, 0x0e, 0x53, 0x68, 0x65, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x68, 0x65, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x67, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a,
+	0x07, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x55,
+	0x4d, 0x42, 0x45, 0x52, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x4f, 0x4f, 0x4c, 0x45, 0x41,
+	0x4e, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x41, 0x54, 0x45, 0x10, 0x05, 0x12, 0x0d, 0x0a,
+	0x09, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d, 0x50, 0x10, 0x06, 0x42, 0x14, 0x5a, 0x12,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -235,23 +419,28 @@ func file_store_sheet_proto_rawDescGZIP() []byte {
 	return file_store_sheet_proto_rawDescData
 }
 
-var file_store_sheet_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_sheet_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_store_sheet_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_store_sheet_proto_goTypes = []interface{}{
-	(*SheetPayload)(nil),            // 0: bytebase.store.SheetPayload
-	(*SheetPayload_VCSPayload)(nil), // 1: bytebase.store.SheetPayload.VCSPayload
-	(*DatabaseConfig)(nil),          // 2: bytebase.store.DatabaseConfig
-	(*PushEvent)(nil),               // 3: bytebase.store.PushEvent
+	(SheetParameter_Type)(0),        // 0: bytebase.store.SheetParameter.Type
+	(*SheetPayload)(nil),            // 1: bytebase.store.SheetPayload
+	(*SheetParameter)(nil),          // 2: bytebase.store.SheetParameter
+	(*SheetPayload_VCSPayload)(nil), // 3: bytebase.store.SheetPayload.VCSPayload
+	(*DatabaseConfig)(nil),          // 4: bytebase.store.DatabaseConfig
+	(*PushEvent)(nil),               // 5: bytebase.store.PushEvent
 }
 var file_store_sheet_proto_depIdxs = []int32{
-	1, // 0: bytebase.store.SheetPayload.vcs_payload:type_name -> bytebase.store.SheetPayload.VCSPayload
-	2, // 1: bytebase.store.SheetPayload.database_config:type_name -> bytebase.store.DatabaseConfig
-	2, // 2: bytebase.store.SheetPayload.baseline_database_config:type_name -> bytebase.store.DatabaseConfig
-	3, // 3: bytebase.store.SheetPayload.VCSPayload.push_event:type_name -> bytebase.store.PushEvent
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	3, // 0: bytebase.store.SheetPayload.vcs_payload:type_name -> bytebase.store.SheetPayload.VCSPayload
+	4, // 1: bytebase.store.SheetPayload.database_config:type_name -> bytebase.store.DatabaseConfig
+	4, // 2: bytebase.store.SheetPayload.baseline_database_config:type_name -> bytebase.store.DatabaseConfig
+	2, // 3: bytebase.store.SheetPayload.parameters:type_name -> bytebase.store.SheetParameter
+	0, // 4: bytebase.store.SheetParameter.type:type_name -> bytebase.store.SheetParameter.Type
+	5, // 5: bytebase.store.SheetPayload.VCSPayload.push_event:type_name -> bytebase.store.PushEvent
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_store_sheet_proto_init() }
@@ -275,6 +464,18 @@ func file_store_sheet_proto_init() {
 			}
 		}
 		file_store_sheet_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SheetParameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_sheet_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SheetPayload_VCSPayload); i {
 			case 0:
 				return &v.state
@@ -292,13 +493,14 @@ func file_store_sheet_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_sheet_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_store_sheet_proto_goTypes,
 		DependencyIndexes: file_store_sheet_proto_depIdxs,
+		EnumInfos:         file_store_sheet_proto_enumTypes,
 		MessageInfos:      file_store_sheet_proto_msgTypes,
 	}.Build()
 	File_store_sheet_proto = out.File
@@ -0,0 +1,508 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: store/stage.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StageCanary_Status int32
+
+const (
+	StageCanary_STATUS_UNSPECIFIED StageCanary_Status = 0
+	// The canary tasks are running or baking.
+	StageCanary_BAKING StageCanary_Status = 1
+	// The tasks of the next stage are run.
+	StageCanary_PROMOTED StageCanary_Status = 2
+	// The canary fails and the next stage isn't run.
+	StageCanary_HALTED StageCanary_Status = 3
+)
+
+// Enum value maps for StageCanary_Status.
+var (
+	StageCanary_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "BAKING",
+		2: "PROMOTED",
+		3: "HALTED",
+	}
+	StageCanary_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"BAKING":             1,
+		"PROMOTED":           2,
+		"HALTED":             3,
+	}
+)
+
+func (x StageCanary_Status) Enum() *StageCanary_Status {
+	p := new(StageCanary_Status)
+	*p = x
+	return p
+}
+
+func (x StageCanary_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StageCanary_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_stage_proto_enumTypes[0].Descriptor()
+}
+
+func (StageCanary_Status) Type() protoreflect.EnumType {
+	return &file_store_stage_proto_enumTypes[0]
+}
+
+func (x StageCanary_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StageCanary_Status.Descriptor instead.
+func (StageCanary_Status) EnumDescriptor() ([]byte, []int) {
+	return file_store_stage_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type StageChangeRequest_Status int32
+
+const (
+	StageChangeRequest_STATUS_UNSPECIFIED StageChangeRequest_Status = 0
+	// The change request is opened and waits to reach the "Implement" state.
+	StageChangeRequest_PENDING StageChangeRequest_Status = 1
+	// The change request is in the "Implement" state and the tasks of the stage are run.
+	StageChangeRequest_IMPLEMENT StageChangeRequest_Status = 2
+	// The change request is closed with the task results.
+	StageChangeRequest_CLOSED StageChangeRequest_Status = 3
+	// The change request is canceled before the tasks are run.
+	StageChangeRequest_CANCELED StageChangeRequest_Status = 4
+)
+
+// Enum value maps for StageChangeRequest_Status.
+var (
+	StageChangeRequest_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "PENDING",
+		2: "IMPLEMENT",
+		3: "CLOSED",
+		4: "CANCELED",
+	}
+	StageChangeRequest_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"PENDING":            1,
+		"IMPLEMENT":          2,
+		"CLOSED":             3,
+		"CANCELED":           4,
+	}
+)
+
+func (x StageChangeRequest_Status) Enum() *StageChangeRequest_Status {
+	p := new(StageChangeRequest_Status)
+	*p = x
+	return p
+}
+
+func (x StageChangeRequest_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StageChangeRequest_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_stage_proto_enumTypes[1].Descriptor()
+}
+
+func (StageChangeRequest_Status) Type() protoreflect.EnumType {
+	return &file_store_stage_proto_enumTypes[1]
+}
+
+func (x StageChangeRequest_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StageChangeRequest_Status.Descriptor instead.
+func (StageChangeRequest_Status) EnumDescriptor() ([]byte, []int) {
+	return file_store_stage_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type StagePayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The canary of the stage, set for the stage rolling out to the canary databases of a deployment before the next stage.
+	Canary *StageCanary `protobuf:"bytes,1,opt,name=canary,proto3" json:"canary,omitempty"`
+	// The ServiceNow change request of the stage, set if the rollout policy of the environment requires one.
+	ChangeRequest *StageChangeRequest `protobuf:"bytes,2,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
+}
+
+func (x *StagePayload) Reset() {
+	*x = StagePayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_stage_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StagePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StagePayload) ProtoMessage() {}
+
+func (x *StagePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_stage_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StagePayload.ProtoReflect.Descriptor instead.
+func (*StagePayload) Descriptor() ([]byte, []int) {
+	return file_store_stage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StagePayload) GetCanary() *StageCanary {
+	if x != nil {
+		return x.Canary
+	}
+	return nil
+}
+
+func (x *StagePayload) GetChangeRequest() *StageChangeRequest {
+	if x != nil {
+		return x.ChangeRequest
+	}
+	return nil
+}
+
+type StageCanary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The bake period after all the canary tasks finish, before the next stage is promoted.
+	BakeDuration *durationpb.Duration `protobuf:"bytes,1,opt,name=bake_duration,json=bakeDuration,proto3" json:"bake_duration,omitempty"`
+	// The max percentage of the failed or canceled canary tasks to promote the next stage.
+	MaxFailurePercentage int32 `protobuf:"varint,2,opt,name=max_failure_percentage,json=maxFailurePercentage,proto3" json:"max_failure_percentage,omitempty"`
+	// The queries run on the canary databases after the bake period.
+	// The check fails if any query fails or returns any row.
+	PostCheckStatements []string           `protobuf:"bytes,3,rep,name=post_check_statements,json=postCheckStatements,proto3" json:"post_check_statements,omitempty"`
+	Status              StageCanary_Status `protobuf:"varint,4,opt,name=status,proto3,enum=bytebase.store.StageCanary_Status" json:"status,omitempty"`
+	// The reason why the canary halts.
+	HaltReason string `protobuf:"bytes,5,opt,name=halt_reason,json=haltReason,proto3" json:"halt_reason,omitempty"`
+}
+
+func (x *StageCanary) Reset() {
+	*x = StageCanary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_stage_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StageCanary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageCanary) ProtoMessage() {}
+
+func (x *StageCanary) ProtoReflect() protoreflect.Message {
+	mi := &file_store_stage_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageCanary.ProtoReflect.Descriptor instead.
+func (*StageCanary) Descriptor() ([]byte, []int) {
+	return file_store_stage_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StageCanary) GetBakeDuration() *durationpb.Duration {
+	if x != nil {
+		return x.BakeDuration
+	}
+	return nil
+}
+
+func (x *StageCanary) GetMaxFailurePercentage() int32 {
+	if x != nil {
+		return x.MaxFailurePercentage
+	}
+	return 0
+}
+
+func (x *StageCanary) GetPostCheckStatements() []string {
+	if x != nil {
+		return x.PostCheckStatements
+	}
+	return nil
+}
+
+func (x *StageCanary) GetStatus() StageCanary_Status {
+	if x != nil {
+		return x.Status
+	}
+	return StageCanary_STATUS_UNSPECIFIED
+}
+
+func (x *StageCanary) GetHaltReason() string {
+	if x != nil {
+		return x.HaltReason
+	}
+	return ""
+}
+
+type StageChangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The sys_id of the ServiceNow change request, empty until it's opened.
+	SysId string `protobuf:"bytes,1,opt,name=sys_id,json=sysId,proto3" json:"sys_id,omitempty"`
+	// The number of the change request, e.g. "CHG0030001".
+	Number string                    `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	Status StageChangeRequest_Status `protobuf:"varint,3,opt,name=status,proto3,enum=bytebase.store.StageChangeRequest_Status" json:"status,omitempty"`
+	// The error of the last call to ServiceNow, cleared on success.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *StageChangeRequest) Reset() {
+	*x = StageChangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_stage_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StageChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageChangeRequest) ProtoMessage() {}
+
+func (x *StageChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_stage_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageChangeRequest.ProtoReflect.Descriptor instead.
+func (*StageChangeRequest) Descriptor() ([]byte, []int) {
+	return file_store_stage_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StageChangeRequest) GetSysId() string {
+	if x != nil {
+		return x.SysId
+	}
+	return ""
+}
+
+func (x *StageChangeRequest) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *StageChangeRequest) GetStatus() StageChangeRequest_Status {
+	if x != nil {
+		return x.Status
+	}
+	return StageChangeRequest_STATUS_UNSPECIFIED
+}
+
+func (x *StageChangeRequest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_store_stage_proto protoreflect.FileDescriptor
+
+var file_store_stage_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x67, 0x65, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x43, 0x61, 0x6e, 0x61, 0x72,
+	0x79, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x49, 0x0a, 0x0e, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0xdc, 0x02, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x67, 0x65, 0x43, 0x61,
+	0x6e, 0x61, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x0d, 0x62, 0x61, 0x6b, 0x65, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x62, 0x61, 0x6b, 0x65, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x6f,
+	0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x70, 0x6f, 0x73, 0x74, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3a,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x61,
+	0x6c, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x68, 0x61, 0x6c, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x42, 0x41, 0x4b, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f,
+	0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x41, 0x4c, 0x54, 0x45,
+	0x44, 0x10, 0x03, 0x22, 0xf4, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x79,
+	0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x79, 0x73, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x56, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02,
+	0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08,
+	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_store_stage_proto_rawDescOnce sync.Once
+	file_store_stage_proto_rawDescData = file_store_stage_proto_rawDesc
+)
+
+func file_store_stage_proto_rawDescGZIP() []byte {
+	file_store_stage_proto_rawDescOnce.Do(func() {
+		file_store_stage_proto_rawDescData = protoimpl.X.CompressGZIP(file_store_stage_proto_rawDescData)
+	})
+	return file_store_stage_proto_rawDescData
+}
+
+var file_store_stage_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_store_stage_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_store_stage_proto_goTypes = []interface{}{
+	(StageCanary_Status)(0),        // 0: bytebase.store.StageCanary.Status
+	(StageChangeRequest_Status)(0), // 1: bytebase.store.StageChangeRequest.Status
+	(*StagePayload)(nil),           // 2: bytebase.store.StagePayload
+	(*StageCanary)(nil),            // 3: bytebase.store.StageCanary
+	(*StageChangeRequest)(nil),     // 4: bytebase.store.StageChangeRequest
+	(*durationpb.Duration)(nil),    // 5: google.protobuf.Duration
+}
+var file_store_stage_proto_depIdxs = []int32{
+	3, // 0: bytebase.store.StagePayload.canary:type_name -> bytebase.store.StageCanary
+	4, // 1: bytebase.store.StagePayload.change_request:type_name -> bytebase.store.StageChangeRequest
+	5, // 2: bytebase.store.StageCanary.bake_duration:type_name -> google.protobuf.Duration
+	0, // 3: bytebase.store.StageCanary.status:type_name -> bytebase.store.StageCanary.Status
+	1, // 4: bytebase.store.StageChangeRequest.status:type_name -> bytebase.store.StageChangeRequest.Status
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_store_stage_proto_init() }
+func file_store_stage_proto_init() {
+	if File_store_stage_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_store_stage_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StagePayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_stage_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StageCanary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_stage_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StageChangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_store_stage_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_stage_proto_goTypes,
+		DependencyIndexes: file_store_stage_proto_depIdxs,
+		EnumInfos:         file_store_stage_proto_enumTypes,
+		MessageInfos:      file_store_stage_proto_msgTypes,
+	}.Build()
+	File_store_stage_proto = out.File
+	file_store_stage_proto_rawDesc = nil
+	file_store_stage_proto_goTypes = nil
+	file_store_stage_proto_depIdxs = nil
+}
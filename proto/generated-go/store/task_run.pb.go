@@ -20,6 +20,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TaskRunResult_CheckResult_Type int32
+
+const (
+	TaskRunResult_CheckResult_TYPE_UNSPECIFIED TaskRunResult_CheckResult_Type = 0
+	TaskRunResult_CheckResult_PRE_CHECK        TaskRunResult_CheckResult_Type = 1
+	TaskRunResult_CheckResult_POST_CHECK       TaskRunResult_CheckResult_Type = 2
+)
+
+// Enum value maps for TaskRunResult_CheckResult_Type.
+var (
+	TaskRunResult_CheckResult_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "PRE_CHECK",
+		2: "POST_CHECK",
+	}
+	TaskRunResult_CheckResult_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"PRE_CHECK":        1,
+		"POST_CHECK":       2,
+	}
+)
+
+func (x TaskRunResult_CheckResult_Type) Enum() *TaskRunResult_CheckResult_Type {
+	p := new(TaskRunResult_CheckResult_Type)
+	*p = x
+	return p
+}
+
+func (x TaskRunResult_CheckResult_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskRunResult_CheckResult_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_task_run_proto_enumTypes[0].Descriptor()
+}
+
+func (TaskRunResult_CheckResult_Type) Type() protoreflect.EnumType {
+	return &file_store_task_run_proto_enumTypes[0]
+}
+
+func (x TaskRunResult_CheckResult_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskRunResult_CheckResult_Type.Descriptor instead.
+func (TaskRunResult_CheckResult_Type) EnumDescriptor() ([]byte, []int) {
+	return file_store_task_run_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
 type TaskRunResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -31,6 +80,8 @@ type TaskRunResult struct {
 	Version       string                  `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
 	StartPosition *TaskRunResult_Position `protobuf:"bytes,4,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	EndPosition   *TaskRunResult_Position `protobuf:"bytes,5,opt,name=end_position,json=endPosition,proto3" json:"end_position,omitempty"`
+	// The results of the pre-checks and post-checks of the migration.
+	CheckResults []*TaskRunResult_CheckResult `protobuf:"bytes,6,rep,name=check_results,json=checkResults,proto3" json:"check_results,omitempty"`
 }
 
 func (x *TaskRunResult) Reset() {
@@ -100,6 +151,13 @@ func (x *TaskRunResult) GetEndPosition() *TaskRunResult_Position {
 	return nil
 }
 
+func (x *TaskRunResult) GetCheckResults() []*TaskRunResult_CheckResult {
+	if x != nil {
+		return x.CheckResults
+	}
+	return nil
+}
+
 // The following fields are used for error reporting.
 type TaskRunResult_Position struct {
 	state         protoimpl.MessageState
@@ -156,12 +214,84 @@ func (x *TaskRunResult_Position) GetColumn() int32 {
 	return 0
 }
 
+type TaskRunResult_CheckResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      TaskRunResult_CheckResult_Type `protobuf:"varint,1,opt,name=type,proto3,enum=bytebase.store.TaskRunResult_CheckResult_Type" json:"type,omitempty"`
+	Statement string                         `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+	Passed    bool                           `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`
+	// The reason of the failure.
+	Detail string `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *TaskRunResult_CheckResult) Reset() {
+	*x = TaskRunResult_CheckResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_task_run_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskRunResult_CheckResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRunResult_CheckResult) ProtoMessage() {}
+
+func (x *TaskRunResult_CheckResult) ProtoReflect() protoreflect.Message {
+	mi := &file_store_task_run_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRunResult_CheckResult.ProtoReflect.Descriptor instead.
+func (*TaskRunResult_CheckResult) Descriptor() ([]byte, []int) {
+	return file_store_task_run_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *TaskRunResult_CheckResult) GetType() TaskRunResult_CheckResult_Type {
+	if x != nil {
+		return x.Type
+	}
+	return TaskRunResult_CheckResult_TYPE_UNSPECIFIED
+}
+
+func (x *TaskRunResult_CheckResult) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *TaskRunResult_CheckResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *TaskRunResult_CheckResult) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
 var File_store_task_run_proto protoreflect.FileDescriptor
 
 var file_store_task_run_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x72, 0x75, 0x6e,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0xba, 0x02, 0x0a, 0x0d, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0xe9, 0x04, 0x0a, 0x0d, 0x54, 0x61, 0x73, 0x6b, 0x52,
 	0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61,
 	0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
 	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f,
@@ -177,13 +307,31 @@ var file_store_task_run_proto_rawDesc = []byte{
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
 	0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x52,
 	0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
-	0x65, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x36, 0x0a, 0x08, 0x50,
+	0x65, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x0d, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0c, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x36, 0x0a, 0x08, 0x50,
 	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63,
 	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
-	0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x75, 0x6d, 0x6e, 0x1a, 0xdc, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x42, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x3b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x52, 0x45, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x10, 0x02, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d,
+	0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -198,19 +346,24 @@ func file_store_task_run_proto_rawDescGZIP() []byte {
 	return file_store_task_run_proto_rawDescData
 }
 
-var file_store_task_run_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_task_run_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_store_task_run_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_store_task_run_proto_goTypes = []interface{}{
-	(*TaskRunResult)(nil),          // 0: bytebase.store.TaskRunResult
-	(*TaskRunResult_Position)(nil), // 1: bytebase.store.TaskRunResult.Position
+	(TaskRunResult_CheckResult_Type)(0), // 0: bytebase.store.TaskRunResult.CheckResult.Type
+	(*TaskRunResult)(nil),               // 1: bytebase.store.TaskRunResult
+	(*TaskRunResult_Position)(nil),      // 2: bytebase.store.TaskRunResult.Position
+	(*TaskRunResult_CheckResult)(nil),   // 3: bytebase.store.TaskRunResult.CheckResult
 }
 var file_store_task_run_proto_depIdxs = []int32{
-	1, // 0: bytebase.store.TaskRunResult.start_position:type_name -> bytebase.store.TaskRunResult.Position
-	1, // 1: bytebase.store.TaskRunResult.end_position:type_name -> bytebase.store.TaskRunResult.Position
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 0: bytebase.store.TaskRunResult.start_position:type_name -> bytebase.store.TaskRunResult.Position
+	2, // 1: bytebase.store.TaskRunResult.end_position:type_name -> bytebase.store.TaskRunResult.Position
+	3, // 2: bytebase.store.TaskRunResult.check_results:type_name -> bytebase.store.TaskRunResult.CheckResult
+	0, // 3: bytebase.store.TaskRunResult.CheckResult.type:type_name -> bytebase.store.TaskRunResult.CheckResult.Type
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_store_task_run_proto_init() }
@@ -243,19 +396,32 @@ func file_store_task_run_proto_init() {
 				return nil
 			}
 		}
+		file_store_task_run_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskRunResult_CheckResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_task_run_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_store_task_run_proto_goTypes,
 		DependencyIndexes: file_store_task_run_proto_depIdxs,
+		EnumInfos:         file_store_task_run_proto_enumTypes,
 		MessageInfos:      file_store_task_run_proto_msgTypes,
 	}.Build()
 	File_store_task_run_proto = out.File
@@ -229,6 +229,9 @@ const (
 	ExportFormat_JSON               ExportFormat = 2
 	ExportFormat_SQL                ExportFormat = 3
 	ExportFormat_XLSX               ExportFormat = 4
+	ExportFormat_PARQUET            ExportFormat = 5
+	// JSONL is the JSON Lines format with one JSON object per row.
+	ExportFormat_JSONL ExportFormat = 6
 )
 
 // Enum value maps for ExportFormat.
@@ -239,6 +242,8 @@ var (
 		2: "JSON",
 		3: "SQL",
 		4: "XLSX",
+		5: "PARQUET",
+		6: "JSONL",
 	}
 	ExportFormat_value = map[string]int32{
 		"FORMAT_UNSPECIFIED": 0,
@@ -246,6 +251,8 @@ var (
 		"JSON":               2,
 		"SQL":                3,
 		"XLSX":               4,
+		"PARQUET":            5,
+		"JSONL":              6,
 	}
 )
 
@@ -307,13 +314,15 @@ var file_v1_common_proto_rawDesc = []byte{
 	0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
 	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12,
 	0x0b, 0x0a, 0x07, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04,
-	0x46, 0x55, 0x4c, 0x4c, 0x10, 0x03, 0x2a, 0x4c, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x46, 0x55, 0x4c, 0x4c, 0x10, 0x03, 0x2a, 0x64, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
 	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
 	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07,
 	0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10,
 	0x02, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x51, 0x4c, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x58, 0x4c,
-	0x53, 0x58, 0x10, 0x04, 0x42, 0x11, 0x5a, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x53, 0x58, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x52, 0x51, 0x55, 0x45, 0x54, 0x10,
+	0x05, 0x12, 0x09, 0x0a, 0x05, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x10, 0x06, 0x42, 0x11, 0x5a, 0x0f,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
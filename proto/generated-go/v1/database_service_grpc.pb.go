@@ -20,27 +20,37 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	DatabaseService_GetDatabase_FullMethodName            = "/bytebase.v1.DatabaseService/GetDatabase"
-	DatabaseService_ListDatabases_FullMethodName          = "/bytebase.v1.DatabaseService/ListDatabases"
-	DatabaseService_SearchDatabases_FullMethodName        = "/bytebase.v1.DatabaseService/SearchDatabases"
-	DatabaseService_UpdateDatabase_FullMethodName         = "/bytebase.v1.DatabaseService/UpdateDatabase"
-	DatabaseService_BatchUpdateDatabases_FullMethodName   = "/bytebase.v1.DatabaseService/BatchUpdateDatabases"
-	DatabaseService_SyncDatabase_FullMethodName           = "/bytebase.v1.DatabaseService/SyncDatabase"
-	DatabaseService_GetDatabaseMetadata_FullMethodName    = "/bytebase.v1.DatabaseService/GetDatabaseMetadata"
-	DatabaseService_UpdateDatabaseMetadata_FullMethodName = "/bytebase.v1.DatabaseService/UpdateDatabaseMetadata"
-	DatabaseService_GetDatabaseSchema_FullMethodName      = "/bytebase.v1.DatabaseService/GetDatabaseSchema"
-	DatabaseService_DiffSchema_FullMethodName             = "/bytebase.v1.DatabaseService/DiffSchema"
-	DatabaseService_GetBackupSetting_FullMethodName       = "/bytebase.v1.DatabaseService/GetBackupSetting"
-	DatabaseService_UpdateBackupSetting_FullMethodName    = "/bytebase.v1.DatabaseService/UpdateBackupSetting"
-	DatabaseService_CreateBackup_FullMethodName           = "/bytebase.v1.DatabaseService/CreateBackup"
-	DatabaseService_ListBackups_FullMethodName            = "/bytebase.v1.DatabaseService/ListBackups"
-	DatabaseService_ListSlowQueries_FullMethodName        = "/bytebase.v1.DatabaseService/ListSlowQueries"
-	DatabaseService_ListSecrets_FullMethodName            = "/bytebase.v1.DatabaseService/ListSecrets"
-	DatabaseService_UpdateSecret_FullMethodName           = "/bytebase.v1.DatabaseService/UpdateSecret"
-	DatabaseService_DeleteSecret_FullMethodName           = "/bytebase.v1.DatabaseService/DeleteSecret"
-	DatabaseService_AdviseIndex_FullMethodName            = "/bytebase.v1.DatabaseService/AdviseIndex"
-	DatabaseService_ListChangeHistories_FullMethodName    = "/bytebase.v1.DatabaseService/ListChangeHistories"
-	DatabaseService_GetChangeHistory_FullMethodName       = "/bytebase.v1.DatabaseService/GetChangeHistory"
+	DatabaseService_GetDatabase_FullMethodName                 = "/bytebase.v1.DatabaseService/GetDatabase"
+	DatabaseService_ListDatabases_FullMethodName               = "/bytebase.v1.DatabaseService/ListDatabases"
+	DatabaseService_SearchDatabases_FullMethodName             = "/bytebase.v1.DatabaseService/SearchDatabases"
+	DatabaseService_UpdateDatabase_FullMethodName              = "/bytebase.v1.DatabaseService/UpdateDatabase"
+	DatabaseService_BatchUpdateDatabases_FullMethodName        = "/bytebase.v1.DatabaseService/BatchUpdateDatabases"
+	DatabaseService_SyncDatabase_FullMethodName                = "/bytebase.v1.DatabaseService/SyncDatabase"
+	DatabaseService_GetDatabaseMetadata_FullMethodName         = "/bytebase.v1.DatabaseService/GetDatabaseMetadata"
+	DatabaseService_UpdateDatabaseMetadata_FullMethodName      = "/bytebase.v1.DatabaseService/UpdateDatabaseMetadata"
+	DatabaseService_GetDatabaseSchema_FullMethodName           = "/bytebase.v1.DatabaseService/GetDatabaseSchema"
+	DatabaseService_GetDatabaseComments_FullMethodName         = "/bytebase.v1.DatabaseService/GetDatabaseComments"
+	DatabaseService_UpdateDatabaseComments_FullMethodName      = "/bytebase.v1.DatabaseService/UpdateDatabaseComments"
+	DatabaseService_DiffSchema_FullMethodName                  = "/bytebase.v1.DatabaseService/DiffSchema"
+	DatabaseService_GetBackupSetting_FullMethodName            = "/bytebase.v1.DatabaseService/GetBackupSetting"
+	DatabaseService_UpdateBackupSetting_FullMethodName         = "/bytebase.v1.DatabaseService/UpdateBackupSetting"
+	DatabaseService_CreateBackup_FullMethodName                = "/bytebase.v1.DatabaseService/CreateBackup"
+	DatabaseService_ListBackups_FullMethodName                 = "/bytebase.v1.DatabaseService/ListBackups"
+	DatabaseService_ListSlowQueries_FullMethodName             = "/bytebase.v1.DatabaseService/ListSlowQueries"
+	DatabaseService_GetSlowQueryReport_FullMethodName          = "/bytebase.v1.DatabaseService/GetSlowQueryReport"
+	DatabaseService_ListSecrets_FullMethodName                 = "/bytebase.v1.DatabaseService/ListSecrets"
+	DatabaseService_UpdateSecret_FullMethodName                = "/bytebase.v1.DatabaseService/UpdateSecret"
+	DatabaseService_DeleteSecret_FullMethodName                = "/bytebase.v1.DatabaseService/DeleteSecret"
+	DatabaseService_AdviseIndex_FullMethodName                 = "/bytebase.v1.DatabaseService/AdviseIndex"
+	DatabaseService_AdviseWorkloadIndexes_FullMethodName       = "/bytebase.v1.DatabaseService/AdviseWorkloadIndexes"
+	DatabaseService_ListChangeHistories_FullMethodName         = "/bytebase.v1.DatabaseService/ListChangeHistories"
+	DatabaseService_GetChangeHistory_FullMethodName            = "/bytebase.v1.DatabaseService/GetChangeHistory"
+	DatabaseService_CheckChangeHistoryIntegrity_FullMethodName = "/bytebase.v1.DatabaseService/CheckChangeHistoryIntegrity"
+	DatabaseService_ImportChangeHistories_FullMethodName       = "/bytebase.v1.DatabaseService/ImportChangeHistories"
+	DatabaseService_ExportChangeHistories_FullMethodName       = "/bytebase.v1.DatabaseService/ExportChangeHistories"
+	DatabaseService_CreateSandboxDatabase_FullMethodName       = "/bytebase.v1.DatabaseService/CreateSandboxDatabase"
+	DatabaseService_ListSandboxDatabases_FullMethodName        = "/bytebase.v1.DatabaseService/ListSandboxDatabases"
+	DatabaseService_ProvisionTenantDatabase_FullMethodName     = "/bytebase.v1.DatabaseService/ProvisionTenantDatabase"
 )
 
 // DatabaseServiceClient is the client API for DatabaseService service.
@@ -57,18 +67,42 @@ type DatabaseServiceClient interface {
 	GetDatabaseMetadata(ctx context.Context, in *GetDatabaseMetadataRequest, opts ...grpc.CallOption) (*DatabaseMetadata, error)
 	UpdateDatabaseMetadata(ctx context.Context, in *UpdateDatabaseMetadataRequest, opts ...grpc.CallOption) (*DatabaseMetadata, error)
 	GetDatabaseSchema(ctx context.Context, in *GetDatabaseSchemaRequest, opts ...grpc.CallOption) (*DatabaseSchema, error)
+	// GetDatabaseComments returns the comments of the tables and columns in the database.
+	GetDatabaseComments(ctx context.Context, in *GetDatabaseCommentsRequest, opts ...grpc.CallOption) (*DatabaseComments, error)
+	// UpdateDatabaseComments generates the statement updating the comments of the tables and columns,
+	// and saves it to a sheet. The sheet is rolled out through the plan and issue approval flow.
+	UpdateDatabaseComments(ctx context.Context, in *UpdateDatabaseCommentsRequest, opts ...grpc.CallOption) (*UpdateDatabaseCommentsResponse, error)
 	DiffSchema(ctx context.Context, in *DiffSchemaRequest, opts ...grpc.CallOption) (*DiffSchemaResponse, error)
 	GetBackupSetting(ctx context.Context, in *GetBackupSettingRequest, opts ...grpc.CallOption) (*BackupSetting, error)
 	UpdateBackupSetting(ctx context.Context, in *UpdateBackupSettingRequest, opts ...grpc.CallOption) (*BackupSetting, error)
 	CreateBackup(ctx context.Context, in *CreateBackupRequest, opts ...grpc.CallOption) (*Backup, error)
 	ListBackups(ctx context.Context, in *ListBackupsRequest, opts ...grpc.CallOption) (*ListBackupsResponse, error)
 	ListSlowQueries(ctx context.Context, in *ListSlowQueriesRequest, opts ...grpc.CallOption) (*ListSlowQueriesResponse, error)
+	GetSlowQueryReport(ctx context.Context, in *GetSlowQueryReportRequest, opts ...grpc.CallOption) (*SlowQueryReport, error)
 	ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error)
 	UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...grpc.CallOption) (*Secret, error)
 	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AdviseIndex(ctx context.Context, in *AdviseIndexRequest, opts ...grpc.CallOption) (*AdviseIndexResponse, error)
+	// AdviseWorkloadIndexes analyzes the slow queries of the database, and recommends the indexes
+	// on the columns used by their predicates, joins and sorting which the existing indexes do not cover.
+	AdviseWorkloadIndexes(ctx context.Context, in *AdviseWorkloadIndexesRequest, opts ...grpc.CallOption) (*AdviseWorkloadIndexesResponse, error)
 	ListChangeHistories(ctx context.Context, in *ListChangeHistoriesRequest, opts ...grpc.CallOption) (*ListChangeHistoriesResponse, error)
 	GetChangeHistory(ctx context.Context, in *GetChangeHistoryRequest, opts ...grpc.CallOption) (*ChangeHistory, error)
+	// CheckChangeHistoryIntegrity re-computes the statement checksums and verifies the version ordering of the change histories.
+	CheckChangeHistoryIntegrity(ctx context.Context, in *CheckChangeHistoryIntegrityRequest, opts ...grpc.CallOption) (*CheckChangeHistoryIntegrityResponse, error)
+	// ImportChangeHistories reads the history table of Flyway or Liquibase on the database
+	// and backfills the change histories which are not recorded yet.
+	ImportChangeHistories(ctx context.Context, in *ImportChangeHistoriesRequest, opts ...grpc.CallOption) (*ImportChangeHistoriesResponse, error)
+	// ExportChangeHistories exports the applied change histories of the database in the format of other migration tools.
+	ExportChangeHistories(ctx context.Context, in *ExportChangeHistoriesRequest, opts ...grpc.CallOption) (*ExportChangeHistoriesResponse, error)
+	// CreateSandboxDatabase clones the database into a sandbox instance.
+	// The sandbox database is provisioned asynchronously and dropped after it expires.
+	CreateSandboxDatabase(ctx context.Context, in *CreateSandboxDatabaseRequest, opts ...grpc.CallOption) (*SandboxDatabase, error)
+	ListSandboxDatabases(ctx context.Context, in *ListSandboxDatabasesRequest, opts ...grpc.CallOption) (*ListSandboxDatabasesResponse, error)
+	// ProvisionTenantDatabase creates the tenant database in the project from the baseline schema of the template database,
+	// and applies the migrations in the change history of the template database after the baseline.
+	// It returns when the tenant database is at the latest schema version of the template database.
+	ProvisionTenantDatabase(ctx context.Context, in *ProvisionTenantDatabaseRequest, opts ...grpc.CallOption) (*Database, error)
 }
 
 type databaseServiceClient struct {
@@ -160,6 +194,24 @@ func (c *databaseServiceClient) GetDatabaseSchema(ctx context.Context, in *GetDa
 	return out, nil
 }
 
+func (c *databaseServiceClient) GetDatabaseComments(ctx context.Context, in *GetDatabaseCommentsRequest, opts ...grpc.CallOption) (*DatabaseComments, error) {
+	out := new(DatabaseComments)
+	err := c.cc.Invoke(ctx, DatabaseService_GetDatabaseComments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) UpdateDatabaseComments(ctx context.Context, in *UpdateDatabaseCommentsRequest, opts ...grpc.CallOption) (*UpdateDatabaseCommentsResponse, error) {
+	out := new(UpdateDatabaseCommentsResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_UpdateDatabaseComments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseServiceClient) DiffSchema(ctx context.Context, in *DiffSchemaRequest, opts ...grpc.CallOption) (*DiffSchemaResponse, error) {
 	out := new(DiffSchemaResponse)
 	err := c.cc.Invoke(ctx, DatabaseService_DiffSchema_FullMethodName, in, out, opts...)
@@ -214,6 +266,15 @@ func (c *databaseServiceClient) ListSlowQueries(ctx context.Context, in *ListSlo
 	return out, nil
 }
 
+func (c *databaseServiceClient) GetSlowQueryReport(ctx context.Context, in *GetSlowQueryReportRequest, opts ...grpc.CallOption) (*SlowQueryReport, error) {
+	out := new(SlowQueryReport)
+	err := c.cc.Invoke(ctx, DatabaseService_GetSlowQueryReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseServiceClient) ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error) {
 	out := new(ListSecretsResponse)
 	err := c.cc.Invoke(ctx, DatabaseService_ListSecrets_FullMethodName, in, out, opts...)
@@ -250,6 +311,15 @@ func (c *databaseServiceClient) AdviseIndex(ctx context.Context, in *AdviseIndex
 	return out, nil
 }
 
+func (c *databaseServiceClient) AdviseWorkloadIndexes(ctx context.Context, in *AdviseWorkloadIndexesRequest, opts ...grpc.CallOption) (*AdviseWorkloadIndexesResponse, error) {
+	out := new(AdviseWorkloadIndexesResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_AdviseWorkloadIndexes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseServiceClient) ListChangeHistories(ctx context.Context, in *ListChangeHistoriesRequest, opts ...grpc.CallOption) (*ListChangeHistoriesResponse, error) {
 	out := new(ListChangeHistoriesResponse)
 	err := c.cc.Invoke(ctx, DatabaseService_ListChangeHistories_FullMethodName, in, out, opts...)
@@ -268,6 +338,60 @@ func (c *databaseServiceClient) GetChangeHistory(ctx context.Context, in *GetCha
 	return out, nil
 }
 
+func (c *databaseServiceClient) CheckChangeHistoryIntegrity(ctx context.Context, in *CheckChangeHistoryIntegrityRequest, opts ...grpc.CallOption) (*CheckChangeHistoryIntegrityResponse, error) {
+	out := new(CheckChangeHistoryIntegrityResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_CheckChangeHistoryIntegrity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) ImportChangeHistories(ctx context.Context, in *ImportChangeHistoriesRequest, opts ...grpc.CallOption) (*ImportChangeHistoriesResponse, error) {
+	out := new(ImportChangeHistoriesResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ImportChangeHistories_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) ExportChangeHistories(ctx context.Context, in *ExportChangeHistoriesRequest, opts ...grpc.CallOption) (*ExportChangeHistoriesResponse, error) {
+	out := new(ExportChangeHistoriesResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ExportChangeHistories_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) CreateSandboxDatabase(ctx context.Context, in *CreateSandboxDatabaseRequest, opts ...grpc.CallOption) (*SandboxDatabase, error) {
+	out := new(SandboxDatabase)
+	err := c.cc.Invoke(ctx, DatabaseService_CreateSandboxDatabase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) ListSandboxDatabases(ctx context.Context, in *ListSandboxDatabasesRequest, opts ...grpc.CallOption) (*ListSandboxDatabasesResponse, error) {
+	out := new(ListSandboxDatabasesResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ListSandboxDatabases_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) ProvisionTenantDatabase(ctx context.Context, in *ProvisionTenantDatabaseRequest, opts ...grpc.CallOption) (*Database, error) {
+	out := new(Database)
+	err := c.cc.Invoke(ctx, DatabaseService_ProvisionTenantDatabase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DatabaseServiceServer is the server API for DatabaseService service.
 // All implementations must embed UnimplementedDatabaseServiceServer
 // for forward compatibility
@@ -282,18 +406,42 @@ type DatabaseServiceServer interface {
 	GetDatabaseMetadata(context.Context, *GetDatabaseMetadataRequest) (*DatabaseMetadata, error)
 	UpdateDatabaseMetadata(context.Context, *UpdateDatabaseMetadataRequest) (*DatabaseMetadata, error)
 	GetDatabaseSchema(context.Context, *GetDatabaseSchemaRequest) (*DatabaseSchema, error)
+	// GetDatabaseComments returns the comments of the tables and columns in the database.
+	GetDatabaseComments(context.Context, *GetDatabaseCommentsRequest) (*DatabaseComments, error)
+	// UpdateDatabaseComments generates the statement updating the comments of the tables and columns,
+	// and saves it to a sheet. The sheet is rolled out through the plan and issue approval flow.
+	UpdateDatabaseComments(context.Context, *UpdateDatabaseCommentsRequest) (*UpdateDatabaseCommentsResponse, error)
 	DiffSchema(context.Context, *DiffSchemaRequest) (*DiffSchemaResponse, error)
 	GetBackupSetting(context.Context, *GetBackupSettingRequest) (*BackupSetting, error)
 	UpdateBackupSetting(context.Context, *UpdateBackupSettingRequest) (*BackupSetting, error)
 	CreateBackup(context.Context, *CreateBackupRequest) (*Backup, error)
 	ListBackups(context.Context, *ListBackupsRequest) (*ListBackupsResponse, error)
 	ListSlowQueries(context.Context, *ListSlowQueriesRequest) (*ListSlowQueriesResponse, error)
+	GetSlowQueryReport(context.Context, *GetSlowQueryReportRequest) (*SlowQueryReport, error)
 	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error)
 	UpdateSecret(context.Context, *UpdateSecretRequest) (*Secret, error)
 	DeleteSecret(context.Context, *DeleteSecretRequest) (*emptypb.Empty, error)
 	AdviseIndex(context.Context, *AdviseIndexRequest) (*AdviseIndexResponse, error)
+	// AdviseWorkloadIndexes analyzes the slow queries of the database, and recommends the indexes
+	// on the columns used by their predicates, joins and sorting which the existing indexes do not cover.
+	AdviseWorkloadIndexes(context.Context, *AdviseWorkloadIndexesRequest) (*AdviseWorkloadIndexesResponse, error)
 	ListChangeHistories(context.Context, *ListChangeHistoriesRequest) (*ListChangeHistoriesResponse, error)
 	GetChangeHistory(context.Context, *GetChangeHistoryRequest) (*ChangeHistory, error)
+	// CheckChangeHistoryIntegrity re-computes the statement checksums and verifies the version ordering of the change histories.
+	CheckChangeHistoryIntegrity(context.Context, *CheckChangeHistoryIntegrityRequest) (*CheckChangeHistoryIntegrityResponse, error)
+	// ImportChangeHistories reads the history table of Flyway or Liquibase on the database
+	// and backfills the change histories which are not recorded yet.
+	ImportChangeHistories(context.Context, *ImportChangeHistoriesRequest) (*ImportChangeHistoriesResponse, error)
+	// ExportChangeHistories exports the applied change histories of the database in the format of other migration tools.
+	ExportChangeHistories(context.Context, *ExportChangeHistoriesRequest) (*ExportChangeHistoriesResponse, error)
+	// CreateSandboxDatabase clones the database into a sandbox instance.
+	// The sandbox database is provisioned asynchronously and dropped after it expires.
+	CreateSandboxDatabase(context.Context, *CreateSandboxDatabaseRequest) (*SandboxDatabase, error)
+	ListSandboxDatabases(context.Context, *ListSandboxDatabasesRequest) (*ListSandboxDatabasesResponse, error)
+	// ProvisionTenantDatabase creates the tenant database in the project from the baseline schema of the template database,
+	// and applies the migrations in the change history of the template database after the baseline.
+	// It returns when the tenant database is at the latest schema version of the template database.
+	ProvisionTenantDatabase(context.Context, *ProvisionTenantDatabaseRequest) (*Database, error)
 	mustEmbedUnimplementedDatabaseServiceServer()
 }
 
@@ -328,6 +476,12 @@ func (UnimplementedDatabaseServiceServer) UpdateDatabaseMetadata(context.Context
 func (UnimplementedDatabaseServiceServer) GetDatabaseSchema(context.Context, *GetDatabaseSchemaRequest) (*DatabaseSchema, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDatabaseSchema not implemented")
 }
+func (UnimplementedDatabaseServiceServer) GetDatabaseComments(context.Context, *GetDatabaseCommentsRequest) (*DatabaseComments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDatabaseComments not implemented")
+}
+func (UnimplementedDatabaseServiceServer) UpdateDatabaseComments(context.Context, *UpdateDatabaseCommentsRequest) (*UpdateDatabaseCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDatabaseComments not implemented")
+}
 func (UnimplementedDatabaseServiceServer) DiffSchema(context.Context, *DiffSchemaRequest) (*DiffSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DiffSchema not implemented")
 }
@@ -346,6 +500,9 @@ func (UnimplementedDatabaseServiceServer) ListBackups(context.Context, *ListBack
 func (UnimplementedDatabaseServiceServer) ListSlowQueries(context.Context, *ListSlowQueriesRequest) (*ListSlowQueriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSlowQueries not implemented")
 }
+func (UnimplementedDatabaseServiceServer) GetSlowQueryReport(context.Context, *GetSlowQueryReportRequest) (*SlowQueryReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSlowQueryReport not implemented")
+}
 func (UnimplementedDatabaseServiceServer) ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSecrets not implemented")
 }
@@ -358,12 +515,33 @@ func (UnimplementedDatabaseServiceServer) DeleteSecret(context.Context, *DeleteS
 func (UnimplementedDatabaseServiceServer) AdviseIndex(context.Context, *AdviseIndexRequest) (*AdviseIndexResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdviseIndex not implemented")
 }
+func (UnimplementedDatabaseServiceServer) AdviseWorkloadIndexes(context.Context, *AdviseWorkloadIndexesRequest) (*AdviseWorkloadIndexesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdviseWorkloadIndexes not implemented")
+}
 func (UnimplementedDatabaseServiceServer) ListChangeHistories(context.Context, *ListChangeHistoriesRequest) (*ListChangeHistoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListChangeHistories not implemented")
 }
 func (UnimplementedDatabaseServiceServer) GetChangeHistory(context.Context, *GetChangeHistoryRequest) (*ChangeHistory, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChangeHistory not implemented")
 }
+func (UnimplementedDatabaseServiceServer) CheckChangeHistoryIntegrity(context.Context, *CheckChangeHistoryIntegrityRequest) (*CheckChangeHistoryIntegrityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckChangeHistoryIntegrity not implemented")
+}
+func (UnimplementedDatabaseServiceServer) ImportChangeHistories(context.Context, *ImportChangeHistoriesRequest) (*ImportChangeHistoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportChangeHistories not implemented")
+}
+func (UnimplementedDatabaseServiceServer) ExportChangeHistories(context.Context, *ExportChangeHistoriesRequest) (*ExportChangeHistoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportChangeHistories not implemented")
+}
+func (UnimplementedDatabaseServiceServer) CreateSandboxDatabase(context.Context, *CreateSandboxDatabaseRequest) (*SandboxDatabase, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSandboxDatabase not implemented")
+}
+func (UnimplementedDatabaseServiceServer) ListSandboxDatabases(context.Context, *ListSandboxDatabasesRequest) (*ListSandboxDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSandboxDatabases not implemented")
+}
+func (UnimplementedDatabaseServiceServer) ProvisionTenantDatabase(context.Context, *ProvisionTenantDatabaseRequest) (*Database, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProvisionTenantDatabase not implemented")
+}
 func (UnimplementedDatabaseServiceServer) mustEmbedUnimplementedDatabaseServiceServer() {}
 
 // UnsafeDatabaseServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -539,6 +717,42 @@ func _DatabaseService_GetDatabaseSchema_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_GetDatabaseComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDatabaseCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).GetDatabaseComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_GetDatabaseComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).GetDatabaseComments(ctx, req.(*GetDatabaseCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_UpdateDatabaseComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDatabaseCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).UpdateDatabaseComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_UpdateDatabaseComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).UpdateDatabaseComments(ctx, req.(*UpdateDatabaseCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DatabaseService_DiffSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DiffSchemaRequest)
 	if err := dec(in); err != nil {
@@ -647,6 +861,24 @@ func _DatabaseService_ListSlowQueries_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_GetSlowQueryReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSlowQueryReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).GetSlowQueryReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_GetSlowQueryReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).GetSlowQueryReport(ctx, req.(*GetSlowQueryReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DatabaseService_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSecretsRequest)
 	if err := dec(in); err != nil {
@@ -719,6 +951,24 @@ func _DatabaseService_AdviseIndex_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_AdviseWorkloadIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdviseWorkloadIndexesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).AdviseWorkloadIndexes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_AdviseWorkloadIndexes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).AdviseWorkloadIndexes(ctx, req.(*AdviseWorkloadIndexesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DatabaseService_ListChangeHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListChangeHistoriesRequest)
 	if err := dec(in); err != nil {
@@ -755,6 +1005,114 @@ func _DatabaseService_GetChangeHistory_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_CheckChangeHistoryIntegrity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckChangeHistoryIntegrityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).CheckChangeHistoryIntegrity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_CheckChangeHistoryIntegrity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).CheckChangeHistoryIntegrity(ctx, req.(*CheckChangeHistoryIntegrityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_ImportChangeHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportChangeHistoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ImportChangeHistories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ImportChangeHistories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ImportChangeHistories(ctx, req.(*ImportChangeHistoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_ExportChangeHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportChangeHistoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ExportChangeHistories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ExportChangeHistories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ExportChangeHistories(ctx, req.(*ExportChangeHistoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_CreateSandboxDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSandboxDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).CreateSandboxDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_CreateSandboxDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).CreateSandboxDatabase(ctx, req.(*CreateSandboxDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_ListSandboxDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSandboxDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ListSandboxDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ListSandboxDatabases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ListSandboxDatabases(ctx, req.(*ListSandboxDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_ProvisionTenantDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProvisionTenantDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ProvisionTenantDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ProvisionTenantDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ProvisionTenantDatabase(ctx, req.(*ProvisionTenantDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DatabaseService_ServiceDesc is the grpc.ServiceDesc for DatabaseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -798,6 +1156,14 @@ var DatabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDatabaseSchema",
 			Handler:    _DatabaseService_GetDatabaseSchema_Handler,
 		},
+		{
+			MethodName: "GetDatabaseComments",
+			Handler:    _DatabaseService_GetDatabaseComments_Handler,
+		},
+		{
+			MethodName: "UpdateDatabaseComments",
+			Handler:    _DatabaseService_UpdateDatabaseComments_Handler,
+		},
 		{
 			MethodName: "DiffSchema",
 			Handler:    _DatabaseService_DiffSchema_Handler,
@@ -822,6 +1188,10 @@ var DatabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSlowQueries",
 			Handler:    _DatabaseService_ListSlowQueries_Handler,
 		},
+		{
+			MethodName: "GetSlowQueryReport",
+			Handler:    _DatabaseService_GetSlowQueryReport_Handler,
+		},
 		{
 			MethodName: "ListSecrets",
 			Handler:    _DatabaseService_ListSecrets_Handler,
@@ -838,6 +1208,10 @@ var DatabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdviseIndex",
 			Handler:    _DatabaseService_AdviseIndex_Handler,
 		},
+		{
+			MethodName: "AdviseWorkloadIndexes",
+			Handler:    _DatabaseService_AdviseWorkloadIndexes_Handler,
+		},
 		{
 			MethodName: "ListChangeHistories",
 			Handler:    _DatabaseService_ListChangeHistories_Handler,
@@ -846,6 +1220,30 @@ var DatabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChangeHistory",
 			Handler:    _DatabaseService_GetChangeHistory_Handler,
 		},
+		{
+			MethodName: "CheckChangeHistoryIntegrity",
+			Handler:    _DatabaseService_CheckChangeHistoryIntegrity_Handler,
+		},
+		{
+			MethodName: "ImportChangeHistories",
+			Handler:    _DatabaseService_ImportChangeHistories_Handler,
+		},
+		{
+			MethodName: "ExportChangeHistories",
+			Handler:    _DatabaseService_ExportChangeHistories_Handler,
+		},
+		{
+			MethodName: "CreateSandboxDatabase",
+			Handler:    _DatabaseService_CreateSandboxDatabase_Handler,
+		},
+		{
+			MethodName: "ListSandboxDatabases",
+			Handler:    _DatabaseService_ListSandboxDatabases_Handler,
+		},
+		{
+			MethodName: "ProvisionTenantDatabase",
+			Handler:    _DatabaseService_ProvisionTenantDatabase_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/database_service.proto",
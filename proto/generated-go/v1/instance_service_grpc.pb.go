@@ -20,19 +20,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	InstanceService_GetInstance_FullMethodName       = "/bytebase.v1.InstanceService/GetInstance"
-	InstanceService_ListInstances_FullMethodName     = "/bytebase.v1.InstanceService/ListInstances"
-	InstanceService_SearchInstances_FullMethodName   = "/bytebase.v1.InstanceService/SearchInstances"
-	InstanceService_CreateInstance_FullMethodName    = "/bytebase.v1.InstanceService/CreateInstance"
-	InstanceService_UpdateInstance_FullMethodName    = "/bytebase.v1.InstanceService/UpdateInstance"
-	InstanceService_DeleteInstance_FullMethodName    = "/bytebase.v1.InstanceService/DeleteInstance"
-	InstanceService_UndeleteInstance_FullMethodName  = "/bytebase.v1.InstanceService/UndeleteInstance"
-	InstanceService_SyncInstance_FullMethodName      = "/bytebase.v1.InstanceService/SyncInstance"
-	InstanceService_BatchSyncInstance_FullMethodName = "/bytebase.v1.InstanceService/BatchSyncInstance"
-	InstanceService_AddDataSource_FullMethodName     = "/bytebase.v1.InstanceService/AddDataSource"
-	InstanceService_RemoveDataSource_FullMethodName  = "/bytebase.v1.InstanceService/RemoveDataSource"
-	InstanceService_UpdateDataSource_FullMethodName  = "/bytebase.v1.InstanceService/UpdateDataSource"
-	InstanceService_SyncSlowQueries_FullMethodName   = "/bytebase.v1.InstanceService/SyncSlowQueries"
+	InstanceService_GetInstance_FullMethodName             = "/bytebase.v1.InstanceService/GetInstance"
+	InstanceService_ListInstances_FullMethodName           = "/bytebase.v1.InstanceService/ListInstances"
+	InstanceService_SearchInstances_FullMethodName         = "/bytebase.v1.InstanceService/SearchInstances"
+	InstanceService_CreateInstance_FullMethodName          = "/bytebase.v1.InstanceService/CreateInstance"
+	InstanceService_UpdateInstance_FullMethodName          = "/bytebase.v1.InstanceService/UpdateInstance"
+	InstanceService_DeleteInstance_FullMethodName          = "/bytebase.v1.InstanceService/DeleteInstance"
+	InstanceService_UndeleteInstance_FullMethodName        = "/bytebase.v1.InstanceService/UndeleteInstance"
+	InstanceService_SyncInstance_FullMethodName            = "/bytebase.v1.InstanceService/SyncInstance"
+	InstanceService_BatchSyncInstance_FullMethodName       = "/bytebase.v1.InstanceService/BatchSyncInstance"
+	InstanceService_AddDataSource_FullMethodName           = "/bytebase.v1.InstanceService/AddDataSource"
+	InstanceService_RemoveDataSource_FullMethodName        = "/bytebase.v1.InstanceService/RemoveDataSource"
+	InstanceService_UpdateDataSource_FullMethodName        = "/bytebase.v1.InstanceService/UpdateDataSource"
+	InstanceService_SyncSlowQueries_FullMethodName         = "/bytebase.v1.InstanceService/SyncSlowQueries"
+	InstanceService_ListDiscoveredInstances_FullMethodName = "/bytebase.v1.InstanceService/ListDiscoveredInstances"
+	InstanceService_AdoptDiscoveredInstance_FullMethodName = "/bytebase.v1.InstanceService/AdoptDiscoveredInstance"
 )
 
 // InstanceServiceClient is the client API for InstanceService service.
@@ -52,6 +54,10 @@ type InstanceServiceClient interface {
 	RemoveDataSource(ctx context.Context, in *RemoveDataSourceRequest, opts ...grpc.CallOption) (*Instance, error)
 	UpdateDataSource(ctx context.Context, in *UpdateDataSourceRequest, opts ...grpc.CallOption) (*Instance, error)
 	SyncSlowQueries(ctx context.Context, in *SyncSlowQueriesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListDiscoveredInstances lists the cloud instances found by the instance discovery which are not registered yet.
+	ListDiscoveredInstances(ctx context.Context, in *ListDiscoveredInstancesRequest, opts ...grpc.CallOption) (*ListDiscoveredInstancesResponse, error)
+	// AdoptDiscoveredInstance registers the discovered cloud instance as an instance.
+	AdoptDiscoveredInstance(ctx context.Context, in *AdoptDiscoveredInstanceRequest, opts ...grpc.CallOption) (*Instance, error)
 }
 
 type instanceServiceClient struct {
@@ -179,6 +185,24 @@ func (c *instanceServiceClient) SyncSlowQueries(ctx context.Context, in *SyncSlo
 	return out, nil
 }
 
+func (c *instanceServiceClient) ListDiscoveredInstances(ctx context.Context, in *ListDiscoveredInstancesRequest, opts ...grpc.CallOption) (*ListDiscoveredInstancesResponse, error) {
+	out := new(ListDiscoveredInstancesResponse)
+	err := c.cc.Invoke(ctx, InstanceService_ListDiscoveredInstances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) AdoptDiscoveredInstance(ctx context.Context, in *AdoptDiscoveredInstanceRequest, opts ...grpc.CallOption) (*Instance, error) {
+	out := new(Instance)
+	err := c.cc.Invoke(ctx, InstanceService_AdoptDiscoveredInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InstanceServiceServer is the server API for InstanceService service.
 // All implementations must embed UnimplementedInstanceServiceServer
 // for forward compatibility
@@ -196,6 +220,10 @@ type InstanceServiceServer interface {
 	RemoveDataSource(context.Context, *RemoveDataSourceRequest) (*Instance, error)
 	UpdateDataSource(context.Context, *UpdateDataSourceRequest) (*Instance, error)
 	SyncSlowQueries(context.Context, *SyncSlowQueriesRequest) (*emptypb.Empty, error)
+	// ListDiscoveredInstances lists the cloud instances found by the instance discovery which are not registered yet.
+	ListDiscoveredInstances(context.Context, *ListDiscoveredInstancesRequest) (*ListDiscoveredInstancesResponse, error)
+	// AdoptDiscoveredInstance registers the discovered cloud instance as an instance.
+	AdoptDiscoveredInstance(context.Context, *AdoptDiscoveredInstanceRequest) (*Instance, error)
 	mustEmbedUnimplementedInstanceServiceServer()
 }
 
@@ -242,6 +270,12 @@ func (UnimplementedInstanceServiceServer) UpdateDataSource(context.Context, *Upd
 func (UnimplementedInstanceServiceServer) SyncSlowQueries(context.Context, *SyncSlowQueriesRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncSlowQueries not implemented")
 }
+func (UnimplementedInstanceServiceServer) ListDiscoveredInstances(context.Context, *ListDiscoveredInstancesRequest) (*ListDiscoveredInstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDiscoveredInstances not implemented")
+}
+func (UnimplementedInstanceServiceServer) AdoptDiscoveredInstance(context.Context, *AdoptDiscoveredInstanceRequest) (*Instance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdoptDiscoveredInstance not implemented")
+}
 func (UnimplementedInstanceServiceServer) mustEmbedUnimplementedInstanceServiceServer() {}
 
 // UnsafeInstanceServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -489,6 +523,42 @@ func _InstanceService_SyncSlowQueries_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InstanceService_ListDiscoveredInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDiscoveredInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).ListDiscoveredInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_ListDiscoveredInstances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).ListDiscoveredInstances(ctx, req.(*ListDiscoveredInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_AdoptDiscoveredInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdoptDiscoveredInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).AdoptDiscoveredInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_AdoptDiscoveredInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).AdoptDiscoveredInstance(ctx, req.(*AdoptDiscoveredInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InstanceService_ServiceDesc is the grpc.ServiceDesc for InstanceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -548,6 +618,14 @@ var InstanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SyncSlowQueries",
 			Handler:    _InstanceService_SyncSlowQueries_Handler,
 		},
+		{
+			MethodName: "ListDiscoveredInstances",
+			Handler:    _InstanceService_ListDiscoveredInstances_Handler,
+		},
+		{
+			MethodName: "AdoptDiscoveredInstance",
+			Handler:    _InstanceService_AdoptDiscoveredInstance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/instance_service.proto",
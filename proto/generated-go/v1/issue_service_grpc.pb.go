@@ -26,9 +26,13 @@ const (
 	IssueService_CreateIssueComment_FullMethodName      = "/bytebase.v1.IssueService/CreateIssueComment"
 	IssueService_UpdateIssueComment_FullMethodName      = "/bytebase.v1.IssueService/UpdateIssueComment"
 	IssueService_BatchUpdateIssuesStatus_FullMethodName = "/bytebase.v1.IssueService/BatchUpdateIssuesStatus"
+	IssueService_BatchUpdateIssues_FullMethodName       = "/bytebase.v1.IssueService/BatchUpdateIssues"
+	IssueService_UnarchiveIssue_FullMethodName          = "/bytebase.v1.IssueService/UnarchiveIssue"
 	IssueService_ApproveIssue_FullMethodName            = "/bytebase.v1.IssueService/ApproveIssue"
+	IssueService_BatchApproveIssues_FullMethodName      = "/bytebase.v1.IssueService/BatchApproveIssues"
 	IssueService_RejectIssue_FullMethodName             = "/bytebase.v1.IssueService/RejectIssue"
 	IssueService_RequestIssue_FullMethodName            = "/bytebase.v1.IssueService/RequestIssue"
+	IssueService_GetIssueDependencyGraph_FullMethodName = "/bytebase.v1.IssueService/GetIssueDependencyGraph"
 )
 
 // IssueServiceClient is the client API for IssueService service.
@@ -42,9 +46,13 @@ type IssueServiceClient interface {
 	CreateIssueComment(ctx context.Context, in *CreateIssueCommentRequest, opts ...grpc.CallOption) (*IssueComment, error)
 	UpdateIssueComment(ctx context.Context, in *UpdateIssueCommentRequest, opts ...grpc.CallOption) (*IssueComment, error)
 	BatchUpdateIssuesStatus(ctx context.Context, in *BatchUpdateIssuesStatusRequest, opts ...grpc.CallOption) (*BatchUpdateIssuesStatusResponse, error)
+	BatchUpdateIssues(ctx context.Context, in *BatchUpdateIssuesRequest, opts ...grpc.CallOption) (*BatchUpdateIssuesResponse, error)
+	UnarchiveIssue(ctx context.Context, in *UnarchiveIssueRequest, opts ...grpc.CallOption) (*Issue, error)
 	ApproveIssue(ctx context.Context, in *ApproveIssueRequest, opts ...grpc.CallOption) (*Issue, error)
+	BatchApproveIssues(ctx context.Context, in *BatchApproveIssuesRequest, opts ...grpc.CallOption) (*BatchApproveIssuesResponse, error)
 	RejectIssue(ctx context.Context, in *RejectIssueRequest, opts ...grpc.CallOption) (*Issue, error)
 	RequestIssue(ctx context.Context, in *RequestIssueRequest, opts ...grpc.CallOption) (*Issue, error)
+	GetIssueDependencyGraph(ctx context.Context, in *GetIssueDependencyGraphRequest, opts ...grpc.CallOption) (*IssueDependencyGraph, error)
 }
 
 type issueServiceClient struct {
@@ -118,6 +126,24 @@ func (c *issueServiceClient) BatchUpdateIssuesStatus(ctx context.Context, in *Ba
 	return out, nil
 }
 
+func (c *issueServiceClient) BatchUpdateIssues(ctx context.Context, in *BatchUpdateIssuesRequest, opts ...grpc.CallOption) (*BatchUpdateIssuesResponse, error) {
+	out := new(BatchUpdateIssuesResponse)
+	err := c.cc.Invoke(ctx, IssueService_BatchUpdateIssues_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) UnarchiveIssue(ctx context.Context, in *UnarchiveIssueRequest, opts ...grpc.CallOption) (*Issue, error) {
+	out := new(Issue)
+	err := c.cc.Invoke(ctx, IssueService_UnarchiveIssue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *issueServiceClient) ApproveIssue(ctx context.Context, in *ApproveIssueRequest, opts ...grpc.CallOption) (*Issue, error) {
 	out := new(Issue)
 	err := c.cc.Invoke(ctx, IssueService_ApproveIssue_FullMethodName, in, out, opts...)
@@ -127,6 +153,15 @@ func (c *issueServiceClient) ApproveIssue(ctx context.Context, in *ApproveIssueR
 	return out, nil
 }
 
+func (c *issueServiceClient) BatchApproveIssues(ctx context.Context, in *BatchApproveIssuesRequest, opts ...grpc.CallOption) (*BatchApproveIssuesResponse, error) {
+	out := new(BatchApproveIssuesResponse)
+	err := c.cc.Invoke(ctx, IssueService_BatchApproveIssues_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *issueServiceClient) RejectIssue(ctx context.Context, in *RejectIssueRequest, opts ...grpc.CallOption) (*Issue, error) {
 	out := new(Issue)
 	err := c.cc.Invoke(ctx, IssueService_RejectIssue_FullMethodName, in, out, opts...)
@@ -145,6 +180,15 @@ func (c *issueServiceClient) RequestIssue(ctx context.Context, in *RequestIssueR
 	return out, nil
 }
 
+func (c *issueServiceClient) GetIssueDependencyGraph(ctx context.Context, in *GetIssueDependencyGraphRequest, opts ...grpc.CallOption) (*IssueDependencyGraph, error) {
+	out := new(IssueDependencyGraph)
+	err := c.cc.Invoke(ctx, IssueService_GetIssueDependencyGraph_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IssueServiceServer is the server API for IssueService service.
 // All implementations must embed UnimplementedIssueServiceServer
 // for forward compatibility
@@ -156,9 +200,13 @@ type IssueServiceServer interface {
 	CreateIssueComment(context.Context, *CreateIssueCommentRequest) (*IssueComment, error)
 	UpdateIssueComment(context.Context, *UpdateIssueCommentRequest) (*IssueComment, error)
 	BatchUpdateIssuesStatus(context.Context, *BatchUpdateIssuesStatusRequest) (*BatchUpdateIssuesStatusResponse, error)
+	BatchUpdateIssues(context.Context, *BatchUpdateIssuesRequest) (*BatchUpdateIssuesResponse, error)
+	UnarchiveIssue(context.Context, *UnarchiveIssueRequest) (*Issue, error)
 	ApproveIssue(context.Context, *ApproveIssueRequest) (*Issue, error)
+	BatchApproveIssues(context.Context, *BatchApproveIssuesRequest) (*BatchApproveIssuesResponse, error)
 	RejectIssue(context.Context, *RejectIssueRequest) (*Issue, error)
 	RequestIssue(context.Context, *RequestIssueRequest) (*Issue, error)
+	GetIssueDependencyGraph(context.Context, *GetIssueDependencyGraphRequest) (*IssueDependencyGraph, error)
 	mustEmbedUnimplementedIssueServiceServer()
 }
 
@@ -187,15 +235,27 @@ func (UnimplementedIssueServiceServer) UpdateIssueComment(context.Context, *Upda
 func (UnimplementedIssueServiceServer) BatchUpdateIssuesStatus(context.Context, *BatchUpdateIssuesStatusRequest) (*BatchUpdateIssuesStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateIssuesStatus not implemented")
 }
+func (UnimplementedIssueServiceServer) BatchUpdateIssues(context.Context, *BatchUpdateIssuesRequest) (*BatchUpdateIssuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateIssues not implemented")
+}
+func (UnimplementedIssueServiceServer) UnarchiveIssue(context.Context, *UnarchiveIssueRequest) (*Issue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveIssue not implemented")
+}
 func (UnimplementedIssueServiceServer) ApproveIssue(context.Context, *ApproveIssueRequest) (*Issue, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApproveIssue not implemented")
 }
+func (UnimplementedIssueServiceServer) BatchApproveIssues(context.Context, *BatchApproveIssuesRequest) (*BatchApproveIssuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchApproveIssues not implemented")
+}
 func (UnimplementedIssueServiceServer) RejectIssue(context.Context, *RejectIssueRequest) (*Issue, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RejectIssue not implemented")
 }
 func (UnimplementedIssueServiceServer) RequestIssue(context.Context, *RequestIssueRequest) (*Issue, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestIssue not implemented")
 }
+func (UnimplementedIssueServiceServer) GetIssueDependencyGraph(context.Context, *GetIssueDependencyGraphRequest) (*IssueDependencyGraph, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssueDependencyGraph not implemented")
+}
 func (UnimplementedIssueServiceServer) mustEmbedUnimplementedIssueServiceServer() {}
 
 // UnsafeIssueServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -335,6 +395,42 @@ func _IssueService_BatchUpdateIssuesStatus_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IssueService_BatchUpdateIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).BatchUpdateIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_BatchUpdateIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).BatchUpdateIssues(ctx, req.(*BatchUpdateIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_UnarchiveIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).UnarchiveIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_UnarchiveIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).UnarchiveIssue(ctx, req.(*UnarchiveIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IssueService_ApproveIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApproveIssueRequest)
 	if err := dec(in); err != nil {
@@ -353,6 +449,24 @@ func _IssueService_ApproveIssue_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IssueService_BatchApproveIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchApproveIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).BatchApproveIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_BatchApproveIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).BatchApproveIssues(ctx, req.(*BatchApproveIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IssueService_RejectIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RejectIssueRequest)
 	if err := dec(in); err != nil {
@@ -389,6 +503,24 @@ func _IssueService_RequestIssue_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IssueService_GetIssueDependencyGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueDependencyGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).GetIssueDependencyGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_GetIssueDependencyGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).GetIssueDependencyGraph(ctx, req.(*GetIssueDependencyGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IssueService_ServiceDesc is the grpc.ServiceDesc for IssueService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -424,10 +556,22 @@ var IssueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchUpdateIssuesStatus",
 			Handler:    _IssueService_BatchUpdateIssuesStatus_Handler,
 		},
+		{
+			MethodName: "BatchUpdateIssues",
+			Handler:    _IssueService_BatchUpdateIssues_Handler,
+		},
+		{
+			MethodName: "UnarchiveIssue",
+			Handler:    _IssueService_UnarchiveIssue_Handler,
+		},
 		{
 			MethodName: "ApproveIssue",
 			Handler:    _IssueService_ApproveIssue_Handler,
 		},
+		{
+			MethodName: "BatchApproveIssues",
+			Handler:    _IssueService_BatchApproveIssues_Handler,
+		},
 		{
 			MethodName: "RejectIssue",
 			Handler:    _IssueService_RejectIssue_Handler,
@@ -436,6 +580,10 @@ var IssueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestIssue",
 			Handler:    _IssueService_RequestIssue_Handler,
 		},
+		{
+			MethodName: "GetIssueDependencyGraph",
+			Handler:    _IssueService_GetIssueDependencyGraph_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/issue_service.proto",
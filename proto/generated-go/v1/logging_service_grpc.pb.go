@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	LoggingService_ListLogs_FullMethodName   = "/bytebase.v1.LoggingService/ListLogs"
-	LoggingService_GetLog_FullMethodName     = "/bytebase.v1.LoggingService/GetLog"
-	LoggingService_ExportLogs_FullMethodName = "/bytebase.v1.LoggingService/ExportLogs"
+	LoggingService_ListLogs_FullMethodName        = "/bytebase.v1.LoggingService/ListLogs"
+	LoggingService_GetLog_FullMethodName          = "/bytebase.v1.LoggingService/GetLog"
+	LoggingService_ExportLogs_FullMethodName      = "/bytebase.v1.LoggingService/ExportLogs"
+	LoggingService_SearchAuditLogs_FullMethodName = "/bytebase.v1.LoggingService/SearchAuditLogs"
 )
 
 // LoggingServiceClient is the client API for LoggingService service.
@@ -31,6 +32,7 @@ type LoggingServiceClient interface {
 	ListLogs(ctx context.Context, in *ListLogsRequest, opts ...grpc.CallOption) (*ListLogsResponse, error)
 	GetLog(ctx context.Context, in *GetLogRequest, opts ...grpc.CallOption) (*LogEntity, error)
 	ExportLogs(ctx context.Context, in *ExportLogsRequest, opts ...grpc.CallOption) (*ExportLogsResponse, error)
+	SearchAuditLogs(ctx context.Context, in *SearchAuditLogsRequest, opts ...grpc.CallOption) (*SearchAuditLogsResponse, error)
 }
 
 type loggingServiceClient struct {
@@ -68,6 +70,15 @@ func (c *loggingServiceClient) ExportLogs(ctx context.Context, in *ExportLogsReq
 	return out, nil
 }
 
+func (c *loggingServiceClient) SearchAuditLogs(ctx context.Context, in *SearchAuditLogsRequest, opts ...grpc.CallOption) (*SearchAuditLogsResponse, error) {
+	out := new(SearchAuditLogsResponse)
+	err := c.cc.Invoke(ctx, LoggingService_SearchAuditLogs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LoggingServiceServer is the server API for LoggingService service.
 // All implementations must embed UnimplementedLoggingServiceServer
 // for forward compatibility
@@ -75,6 +86,7 @@ type LoggingServiceServer interface {
 	ListLogs(context.Context, *ListLogsRequest) (*ListLogsResponse, error)
 	GetLog(context.Context, *GetLogRequest) (*LogEntity, error)
 	ExportLogs(context.Context, *ExportLogsRequest) (*ExportLogsResponse, error)
+	SearchAuditLogs(context.Context, *SearchAuditLogsRequest) (*SearchAuditLogsResponse, error)
 	mustEmbedUnimplementedLoggingServiceServer()
 }
 
@@ -91,6 +103,9 @@ func (UnimplementedLoggingServiceServer) GetLog(context.Context, *GetLogRequest)
 func (UnimplementedLoggingServiceServer) ExportLogs(context.Context, *ExportLogsRequest) (*ExportLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportLogs not implemented")
 }
+func (UnimplementedLoggingServiceServer) SearchAuditLogs(context.Context, *SearchAuditLogsRequest) (*SearchAuditLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAuditLogs not implemented")
+}
 func (UnimplementedLoggingServiceServer) mustEmbedUnimplementedLoggingServiceServer() {}
 
 // UnsafeLoggingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +173,24 @@ func _LoggingService_ExportLogs_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoggingService_SearchAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoggingServiceServer).SearchAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoggingService_SearchAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoggingServiceServer).SearchAuditLogs(ctx, req.(*SearchAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LoggingService_ServiceDesc is the grpc.ServiceDesc for LoggingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +210,10 @@ var LoggingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExportLogs",
 			Handler:    _LoggingService_ExportLogs_Handler,
 		},
+		{
+			MethodName: "SearchAuditLogs",
+			Handler:    _LoggingService_SearchAuditLogs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/logging_service.proto",
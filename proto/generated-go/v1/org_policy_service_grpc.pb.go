@@ -20,11 +20,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	OrgPolicyService_GetPolicy_FullMethodName    = "/bytebase.v1.OrgPolicyService/GetPolicy"
-	OrgPolicyService_ListPolicies_FullMethodName = "/bytebase.v1.OrgPolicyService/ListPolicies"
-	OrgPolicyService_CreatePolicy_FullMethodName = "/bytebase.v1.OrgPolicyService/CreatePolicy"
-	OrgPolicyService_UpdatePolicy_FullMethodName = "/bytebase.v1.OrgPolicyService/UpdatePolicy"
-	OrgPolicyService_DeletePolicy_FullMethodName = "/bytebase.v1.OrgPolicyService/DeletePolicy"
+	OrgPolicyService_GetPolicy_FullMethodName             = "/bytebase.v1.OrgPolicyService/GetPolicy"
+	OrgPolicyService_ListPolicies_FullMethodName          = "/bytebase.v1.OrgPolicyService/ListPolicies"
+	OrgPolicyService_CreatePolicy_FullMethodName          = "/bytebase.v1.OrgPolicyService/CreatePolicy"
+	OrgPolicyService_UpdatePolicy_FullMethodName          = "/bytebase.v1.OrgPolicyService/UpdatePolicy"
+	OrgPolicyService_DeletePolicy_FullMethodName          = "/bytebase.v1.OrgPolicyService/DeletePolicy"
+	OrgPolicyService_ExportSQLReviewPolicy_FullMethodName = "/bytebase.v1.OrgPolicyService/ExportSQLReviewPolicy"
+	OrgPolicyService_ImportSQLReviewPolicy_FullMethodName = "/bytebase.v1.OrgPolicyService/ImportSQLReviewPolicy"
 )
 
 // OrgPolicyServiceClient is the client API for OrgPolicyService service.
@@ -36,6 +38,10 @@ type OrgPolicyServiceClient interface {
 	CreatePolicy(ctx context.Context, in *CreatePolicyRequest, opts ...grpc.CallOption) (*Policy, error)
 	UpdatePolicy(ctx context.Context, in *UpdatePolicyRequest, opts ...grpc.CallOption) (*Policy, error)
 	DeletePolicy(ctx context.Context, in *DeletePolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ExportSQLReviewPolicy exports the SQL review policy as a versioned document.
+	ExportSQLReviewPolicy(ctx context.Context, in *ExportSQLReviewPolicyRequest, opts ...grpc.CallOption) (*ExportSQLReviewPolicyResponse, error)
+	// ImportSQLReviewPolicy imports the SQL review policy document exported by ExportSQLReviewPolicy.
+	ImportSQLReviewPolicy(ctx context.Context, in *ImportSQLReviewPolicyRequest, opts ...grpc.CallOption) (*ImportSQLReviewPolicyResponse, error)
 }
 
 type orgPolicyServiceClient struct {
@@ -91,6 +97,24 @@ func (c *orgPolicyServiceClient) DeletePolicy(ctx context.Context, in *DeletePol
 	return out, nil
 }
 
+func (c *orgPolicyServiceClient) ExportSQLReviewPolicy(ctx context.Context, in *ExportSQLReviewPolicyRequest, opts ...grpc.CallOption) (*ExportSQLReviewPolicyResponse, error) {
+	out := new(ExportSQLReviewPolicyResponse)
+	err := c.cc.Invoke(ctx, OrgPolicyService_ExportSQLReviewPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgPolicyServiceClient) ImportSQLReviewPolicy(ctx context.Context, in *ImportSQLReviewPolicyRequest, opts ...grpc.CallOption) (*ImportSQLReviewPolicyResponse, error) {
+	out := new(ImportSQLReviewPolicyResponse)
+	err := c.cc.Invoke(ctx, OrgPolicyService_ImportSQLReviewPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrgPolicyServiceServer is the server API for OrgPolicyService service.
 // All implementations must embed UnimplementedOrgPolicyServiceServer
 // for forward compatibility
@@ -100,6 +124,10 @@ type OrgPolicyServiceServer interface {
 	CreatePolicy(context.Context, *CreatePolicyRequest) (*Policy, error)
 	UpdatePolicy(context.Context, *UpdatePolicyRequest) (*Policy, error)
 	DeletePolicy(context.Context, *DeletePolicyRequest) (*emptypb.Empty, error)
+	// ExportSQLReviewPolicy exports the SQL review policy as a versioned document.
+	ExportSQLReviewPolicy(context.Context, *ExportSQLReviewPolicyRequest) (*ExportSQLReviewPolicyResponse, error)
+	// ImportSQLReviewPolicy imports the SQL review policy document exported by ExportSQLReviewPolicy.
+	ImportSQLReviewPolicy(context.Context, *ImportSQLReviewPolicyRequest) (*ImportSQLReviewPolicyResponse, error)
 	mustEmbedUnimplementedOrgPolicyServiceServer()
 }
 
@@ -122,6 +150,12 @@ func (UnimplementedOrgPolicyServiceServer) UpdatePolicy(context.Context, *Update
 func (UnimplementedOrgPolicyServiceServer) DeletePolicy(context.Context, *DeletePolicyRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
 }
+func (UnimplementedOrgPolicyServiceServer) ExportSQLReviewPolicy(context.Context, *ExportSQLReviewPolicyRequest) (*ExportSQLReviewPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSQLReviewPolicy not implemented")
+}
+func (UnimplementedOrgPolicyServiceServer) ImportSQLReviewPolicy(context.Context, *ImportSQLReviewPolicyRequest) (*ImportSQLReviewPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSQLReviewPolicy not implemented")
+}
 func (UnimplementedOrgPolicyServiceServer) mustEmbedUnimplementedOrgPolicyServiceServer() {}
 
 // UnsafeOrgPolicyServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -225,6 +259,42 @@ func _OrgPolicyService_DeletePolicy_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrgPolicyService_ExportSQLReviewPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSQLReviewPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgPolicyServiceServer).ExportSQLReviewPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgPolicyService_ExportSQLReviewPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgPolicyServiceServer).ExportSQLReviewPolicy(ctx, req.(*ExportSQLReviewPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrgPolicyService_ImportSQLReviewPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSQLReviewPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgPolicyServiceServer).ImportSQLReviewPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgPolicyService_ImportSQLReviewPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgPolicyServiceServer).ImportSQLReviewPolicy(ctx, req.(*ImportSQLReviewPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrgPolicyService_ServiceDesc is the grpc.ServiceDesc for OrgPolicyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -252,6 +322,14 @@ var OrgPolicyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePolicy",
 			Handler:    _OrgPolicyService_DeletePolicy_Handler,
 		},
+		{
+			MethodName: "ExportSQLReviewPolicy",
+			Handler:    _OrgPolicyService_ExportSQLReviewPolicy_Handler,
+		},
+		{
+			MethodName: "ImportSQLReviewPolicy",
+			Handler:    _OrgPolicyService_ImportSQLReviewPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/org_policy_service.proto",
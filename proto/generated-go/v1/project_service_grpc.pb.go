@@ -32,10 +32,13 @@ const (
 	ProjectService_SetIamPolicy_FullMethodName                 = "/bytebase.v1.ProjectService/SetIamPolicy"
 	ProjectService_GetDeploymentConfig_FullMethodName          = "/bytebase.v1.ProjectService/GetDeploymentConfig"
 	ProjectService_UpdateDeploymentConfig_FullMethodName       = "/bytebase.v1.ProjectService/UpdateDeploymentConfig"
+	ProjectService_EvaluateDeploymentExpression_FullMethodName = "/bytebase.v1.ProjectService/EvaluateDeploymentExpression"
 	ProjectService_AddWebhook_FullMethodName                   = "/bytebase.v1.ProjectService/AddWebhook"
 	ProjectService_UpdateWebhook_FullMethodName                = "/bytebase.v1.ProjectService/UpdateWebhook"
 	ProjectService_RemoveWebhook_FullMethodName                = "/bytebase.v1.ProjectService/RemoveWebhook"
 	ProjectService_TestWebhook_FullMethodName                  = "/bytebase.v1.ProjectService/TestWebhook"
+	ProjectService_ListWebhookDeliveries_FullMethodName        = "/bytebase.v1.ProjectService/ListWebhookDeliveries"
+	ProjectService_RedeliverWebhookDelivery_FullMethodName     = "/bytebase.v1.ProjectService/RedeliverWebhookDelivery"
 	ProjectService_UpdateProjectGitOpsInfo_FullMethodName      = "/bytebase.v1.ProjectService/UpdateProjectGitOpsInfo"
 	ProjectService_UnsetProjectGitOpsInfo_FullMethodName       = "/bytebase.v1.ProjectService/UnsetProjectGitOpsInfo"
 	ProjectService_SetupProjectSQLReviewCI_FullMethodName      = "/bytebase.v1.ProjectService/SetupProjectSQLReviewCI"
@@ -52,6 +55,20 @@ const (
 	ProjectService_DeleteSchemaGroup_FullMethodName            = "/bytebase.v1.ProjectService/DeleteSchemaGroup"
 	ProjectService_GetProjectProtectionRules_FullMethodName    = "/bytebase.v1.ProjectService/GetProjectProtectionRules"
 	ProjectService_UpdateProjectProtectionRules_FullMethodName = "/bytebase.v1.ProjectService/UpdateProjectProtectionRules"
+	ProjectService_GetProjectIssueForms_FullMethodName         = "/bytebase.v1.ProjectService/GetProjectIssueForms"
+	ProjectService_UpdateProjectIssueForms_FullMethodName      = "/bytebase.v1.ProjectService/UpdateProjectIssueForms"
+	ProjectService_GetProjectQuota_FullMethodName              = "/bytebase.v1.ProjectService/GetProjectQuota"
+	ProjectService_UpdateProjectQuota_FullMethodName           = "/bytebase.v1.ProjectService/UpdateProjectQuota"
+	ProjectService_ListIssueTemplates_FullMethodName           = "/bytebase.v1.ProjectService/ListIssueTemplates"
+	ProjectService_GetIssueTemplate_FullMethodName             = "/bytebase.v1.ProjectService/GetIssueTemplate"
+	ProjectService_CreateIssueTemplate_FullMethodName          = "/bytebase.v1.ProjectService/CreateIssueTemplate"
+	ProjectService_UpdateIssueTemplate_FullMethodName          = "/bytebase.v1.ProjectService/UpdateIssueTemplate"
+	ProjectService_DeleteIssueTemplate_FullMethodName          = "/bytebase.v1.ProjectService/DeleteIssueTemplate"
+	ProjectService_ListIssueSchedules_FullMethodName           = "/bytebase.v1.ProjectService/ListIssueSchedules"
+	ProjectService_GetIssueSchedule_FullMethodName             = "/bytebase.v1.ProjectService/GetIssueSchedule"
+	ProjectService_CreateIssueSchedule_FullMethodName          = "/bytebase.v1.ProjectService/CreateIssueSchedule"
+	ProjectService_UpdateIssueSchedule_FullMethodName          = "/bytebase.v1.ProjectService/UpdateIssueSchedule"
+	ProjectService_DeleteIssueSchedule_FullMethodName          = "/bytebase.v1.ProjectService/DeleteIssueSchedule"
 )
 
 // ProjectServiceClient is the client API for ProjectService service.
@@ -70,10 +87,15 @@ type ProjectServiceClient interface {
 	SetIamPolicy(ctx context.Context, in *SetIamPolicyRequest, opts ...grpc.CallOption) (*IamPolicy, error)
 	GetDeploymentConfig(ctx context.Context, in *GetDeploymentConfigRequest, opts ...grpc.CallOption) (*DeploymentConfig, error)
 	UpdateDeploymentConfig(ctx context.Context, in *UpdateDeploymentConfigRequest, opts ...grpc.CallOption) (*DeploymentConfig, error)
+	// EvaluateDeploymentExpression returns the databases in the project matching the deployment expression.
+	EvaluateDeploymentExpression(ctx context.Context, in *EvaluateDeploymentExpressionRequest, opts ...grpc.CallOption) (*EvaluateDeploymentExpressionResponse, error)
 	AddWebhook(ctx context.Context, in *AddWebhookRequest, opts ...grpc.CallOption) (*Project, error)
 	UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*Project, error)
 	RemoveWebhook(ctx context.Context, in *RemoveWebhookRequest, opts ...grpc.CallOption) (*Project, error)
 	TestWebhook(ctx context.Context, in *TestWebhookRequest, opts ...grpc.CallOption) (*TestWebhookResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	// RedeliverWebhookDelivery posts the dead delivery to the webhook again.
+	RedeliverWebhookDelivery(ctx context.Context, in *RedeliverWebhookDeliveryRequest, opts ...grpc.CallOption) (*WebhookDelivery, error)
 	UpdateProjectGitOpsInfo(ctx context.Context, in *UpdateProjectGitOpsInfoRequest, opts ...grpc.CallOption) (*ProjectGitOpsInfo, error)
 	UnsetProjectGitOpsInfo(ctx context.Context, in *UnsetProjectGitOpsInfoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetupProjectSQLReviewCI(ctx context.Context, in *SetupSQLReviewCIRequest, opts ...grpc.CallOption) (*SetupSQLReviewCIResponse, error)
@@ -90,6 +112,20 @@ type ProjectServiceClient interface {
 	DeleteSchemaGroup(ctx context.Context, in *DeleteSchemaGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetProjectProtectionRules(ctx context.Context, in *GetProjectProtectionRulesRequest, opts ...grpc.CallOption) (*ProtectionRules, error)
 	UpdateProjectProtectionRules(ctx context.Context, in *UpdateProjectProtectionRulesRequest, opts ...grpc.CallOption) (*ProtectionRules, error)
+	GetProjectIssueForms(ctx context.Context, in *GetProjectIssueFormsRequest, opts ...grpc.CallOption) (*IssueForms, error)
+	UpdateProjectIssueForms(ctx context.Context, in *UpdateProjectIssueFormsRequest, opts ...grpc.CallOption) (*IssueForms, error)
+	GetProjectQuota(ctx context.Context, in *GetProjectQuotaRequest, opts ...grpc.CallOption) (*ProjectQuota, error)
+	UpdateProjectQuota(ctx context.Context, in *UpdateProjectQuotaRequest, opts ...grpc.CallOption) (*ProjectQuota, error)
+	ListIssueTemplates(ctx context.Context, in *ListIssueTemplatesRequest, opts ...grpc.CallOption) (*ListIssueTemplatesResponse, error)
+	GetIssueTemplate(ctx context.Context, in *GetIssueTemplateRequest, opts ...grpc.CallOption) (*IssueTemplate, error)
+	CreateIssueTemplate(ctx context.Context, in *CreateIssueTemplateRequest, opts ...grpc.CallOption) (*IssueTemplate, error)
+	UpdateIssueTemplate(ctx context.Context, in *UpdateIssueTemplateRequest, opts ...grpc.CallOption) (*IssueTemplate, error)
+	DeleteIssueTemplate(ctx context.Context, in *DeleteIssueTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListIssueSchedules(ctx context.Context, in *ListIssueSchedulesRequest, opts ...grpc.CallOption) (*ListIssueSchedulesResponse, error)
+	GetIssueSchedule(ctx context.Context, in *GetIssueScheduleRequest, opts ...grpc.CallOption) (*IssueSchedule, error)
+	CreateIssueSchedule(ctx context.Context, in *CreateIssueScheduleRequest, opts ...grpc.CallOption) (*IssueSchedule, error)
+	UpdateIssueSchedule(ctx context.Context, in *UpdateIssueScheduleRequest, opts ...grpc.CallOption) (*IssueSchedule, error)
+	DeleteIssueSchedule(ctx context.Context, in *DeleteIssueScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type projectServiceClient struct {
@@ -208,6 +244,15 @@ func (c *projectServiceClient) UpdateDeploymentConfig(ctx context.Context, in *U
 	return out, nil
 }
 
+func (c *projectServiceClient) EvaluateDeploymentExpression(ctx context.Context, in *EvaluateDeploymentExpressionRequest, opts ...grpc.CallOption) (*EvaluateDeploymentExpressionResponse, error) {
+	out := new(EvaluateDeploymentExpressionResponse)
+	err := c.cc.Invoke(ctx, ProjectService_EvaluateDeploymentExpression_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) AddWebhook(ctx context.Context, in *AddWebhookRequest, opts ...grpc.CallOption) (*Project, error) {
 	out := new(Project)
 	err := c.cc.Invoke(ctx, ProjectService_AddWebhook_FullMethodName, in, out, opts...)
@@ -244,6 +289,24 @@ func (c *projectServiceClient) TestWebhook(ctx context.Context, in *TestWebhookR
 	return out, nil
 }
 
+func (c *projectServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ListWebhookDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) RedeliverWebhookDelivery(ctx context.Context, in *RedeliverWebhookDeliveryRequest, opts ...grpc.CallOption) (*WebhookDelivery, error) {
+	out := new(WebhookDelivery)
+	err := c.cc.Invoke(ctx, ProjectService_RedeliverWebhookDelivery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) UpdateProjectGitOpsInfo(ctx context.Context, in *UpdateProjectGitOpsInfoRequest, opts ...grpc.CallOption) (*ProjectGitOpsInfo, error) {
 	out := new(ProjectGitOpsInfo)
 	err := c.cc.Invoke(ctx, ProjectService_UpdateProjectGitOpsInfo_FullMethodName, in, out, opts...)
@@ -388,6 +451,132 @@ func (c *projectServiceClient) UpdateProjectProtectionRules(ctx context.Context,
 	return out, nil
 }
 
+func (c *projectServiceClient) GetProjectIssueForms(ctx context.Context, in *GetProjectIssueFormsRequest, opts ...grpc.CallOption) (*IssueForms, error) {
+	out := new(IssueForms)
+	err := c.cc.Invoke(ctx, ProjectService_GetProjectIssueForms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) UpdateProjectIssueForms(ctx context.Context, in *UpdateProjectIssueFormsRequest, opts ...grpc.CallOption) (*IssueForms, error) {
+	out := new(IssueForms)
+	err := c.cc.Invoke(ctx, ProjectService_UpdateProjectIssueForms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetProjectQuota(ctx context.Context, in *GetProjectQuotaRequest, opts ...grpc.CallOption) (*ProjectQuota, error) {
+	out := new(ProjectQuota)
+	err := c.cc.Invoke(ctx, ProjectService_GetProjectQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) UpdateProjectQuota(ctx context.Context, in *UpdateProjectQuotaRequest, opts ...grpc.CallOption) (*ProjectQuota, error) {
+	out := new(ProjectQuota)
+	err := c.cc.Invoke(ctx, ProjectService_UpdateProjectQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ListIssueTemplates(ctx context.Context, in *ListIssueTemplatesRequest, opts ...grpc.CallOption) (*ListIssueTemplatesResponse, error) {
+	out := new(ListIssueTemplatesResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ListIssueTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetIssueTemplate(ctx context.Context, in *GetIssueTemplateRequest, opts ...grpc.CallOption) (*IssueTemplate, error) {
+	out := new(IssueTemplate)
+	err := c.cc.Invoke(ctx, ProjectService_GetIssueTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) CreateIssueTemplate(ctx context.Context, in *CreateIssueTemplateRequest, opts ...grpc.CallOption) (*IssueTemplate, error) {
+	out := new(IssueTemplate)
+	err := c.cc.Invoke(ctx, ProjectService_CreateIssueTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) UpdateIssueTemplate(ctx context.Context, in *UpdateIssueTemplateRequest, opts ...grpc.CallOption) (*IssueTemplate, error) {
+	out := new(IssueTemplate)
+	err := c.cc.Invoke(ctx, ProjectService_UpdateIssueTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) DeleteIssueTemplate(ctx context.Context, in *DeleteIssueTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ProjectService_DeleteIssueTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ListIssueSchedules(ctx context.Context, in *ListIssueSchedulesRequest, opts ...grpc.CallOption) (*ListIssueSchedulesResponse, error) {
+	out := new(ListIssueSchedulesResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ListIssueSchedules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetIssueSchedule(ctx context.Context, in *GetIssueScheduleRequest, opts ...grpc.CallOption) (*IssueSchedule, error) {
+	out := new(IssueSchedule)
+	err := c.cc.Invoke(ctx, ProjectService_GetIssueSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) CreateIssueSchedule(ctx context.Context, in *CreateIssueScheduleRequest, opts ...grpc.CallOption) (*IssueSchedule, error) {
+	out := new(IssueSchedule)
+	err := c.cc.Invoke(ctx, ProjectService_CreateIssueSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) UpdateIssueSchedule(ctx context.Context, in *UpdateIssueScheduleRequest, opts ...grpc.CallOption) (*IssueSchedule, error) {
+	out := new(IssueSchedule)
+	err := c.cc.Invoke(ctx, ProjectService_UpdateIssueSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) DeleteIssueSchedule(ctx context.Context, in *DeleteIssueScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ProjectService_DeleteIssueSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectServiceServer is the server API for ProjectService service.
 // All implementations must embed UnimplementedProjectServiceServer
 // for forward compatibility
@@ -404,10 +593,15 @@ type ProjectServiceServer interface {
 	SetIamPolicy(context.Context, *SetIamPolicyRequest) (*IamPolicy, error)
 	GetDeploymentConfig(context.Context, *GetDeploymentConfigRequest) (*DeploymentConfig, error)
 	UpdateDeploymentConfig(context.Context, *UpdateDeploymentConfigRequest) (*DeploymentConfig, error)
+	// EvaluateDeploymentExpression returns the databases in the project matching the deployment expression.
+	EvaluateDeploymentExpression(context.Context, *EvaluateDeploymentExpressionRequest) (*EvaluateDeploymentExpressionResponse, error)
 	AddWebhook(context.Context, *AddWebhookRequest) (*Project, error)
 	UpdateWebhook(context.Context, *UpdateWebhookRequest) (*Project, error)
 	RemoveWebhook(context.Context, *RemoveWebhookRequest) (*Project, error)
 	TestWebhook(context.Context, *TestWebhookRequest) (*TestWebhookResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// RedeliverWebhookDelivery posts the dead delivery to the webhook again.
+	RedeliverWebhookDelivery(context.Context, *RedeliverWebhookDeliveryRequest) (*WebhookDelivery, error)
 	UpdateProjectGitOpsInfo(context.Context, *UpdateProjectGitOpsInfoRequest) (*ProjectGitOpsInfo, error)
 	UnsetProjectGitOpsInfo(context.Context, *UnsetProjectGitOpsInfoRequest) (*emptypb.Empty, error)
 	SetupProjectSQLReviewCI(context.Context, *SetupSQLReviewCIRequest) (*SetupSQLReviewCIResponse, error)
@@ -424,6 +618,20 @@ type ProjectServiceServer interface {
 	DeleteSchemaGroup(context.Context, *DeleteSchemaGroupRequest) (*emptypb.Empty, error)
 	GetProjectProtectionRules(context.Context, *GetProjectProtectionRulesRequest) (*ProtectionRules, error)
 	UpdateProjectProtectionRules(context.Context, *UpdateProjectProtectionRulesRequest) (*ProtectionRules, error)
+	GetProjectIssueForms(context.Context, *GetProjectIssueFormsRequest) (*IssueForms, error)
+	UpdateProjectIssueForms(context.Context, *UpdateProjectIssueFormsRequest) (*IssueForms, error)
+	GetProjectQuota(context.Context, *GetProjectQuotaRequest) (*ProjectQuota, error)
+	UpdateProjectQuota(context.Context, *UpdateProjectQuotaRequest) (*ProjectQuota, error)
+	ListIssueTemplates(context.Context, *ListIssueTemplatesRequest) (*ListIssueTemplatesResponse, error)
+	GetIssueTemplate(context.Context, *GetIssueTemplateRequest) (*IssueTemplate, error)
+	CreateIssueTemplate(context.Context, *CreateIssueTemplateRequest) (*IssueTemplate, error)
+	UpdateIssueTemplate(context.Context, *UpdateIssueTemplateRequest) (*IssueTemplate, error)
+	DeleteIssueTemplate(context.Context, *DeleteIssueTemplateRequest) (*emptypb.Empty, error)
+	ListIssueSchedules(context.Context, *ListIssueSchedulesRequest) (*ListIssueSchedulesResponse, error)
+	GetIssueSchedule(context.Context, *GetIssueScheduleRequest) (*IssueSchedule, error)
+	CreateIssueSchedule(context.Context, *CreateIssueScheduleRequest) (*IssueSchedule, error)
+	UpdateIssueSchedule(context.Context, *UpdateIssueScheduleRequest) (*IssueSchedule, error)
+	DeleteIssueSchedule(context.Context, *DeleteIssueScheduleRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedProjectServiceServer()
 }
 
@@ -467,6 +675,9 @@ func (UnimplementedProjectServiceServer) GetDeploymentConfig(context.Context, *G
 func (UnimplementedProjectServiceServer) UpdateDeploymentConfig(context.Context, *UpdateDeploymentConfigRequest) (*DeploymentConfig, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeploymentConfig not implemented")
 }
+func (UnimplementedProjectServiceServer) EvaluateDeploymentExpression(context.Context, *EvaluateDeploymentExpressionRequest) (*EvaluateDeploymentExpressionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateDeploymentExpression not implemented")
+}
 func (UnimplementedProjectServiceServer) AddWebhook(context.Context, *AddWebhookRequest) (*Project, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddWebhook not implemented")
 }
@@ -479,6 +690,12 @@ func (UnimplementedProjectServiceServer) RemoveWebhook(context.Context, *RemoveW
 func (UnimplementedProjectServiceServer) TestWebhook(context.Context, *TestWebhookRequest) (*TestWebhookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TestWebhook not implemented")
 }
+func (UnimplementedProjectServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedProjectServiceServer) RedeliverWebhookDelivery(context.Context, *RedeliverWebhookDeliveryRequest) (*WebhookDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhookDelivery not implemented")
+}
 func (UnimplementedProjectServiceServer) UpdateProjectGitOpsInfo(context.Context, *UpdateProjectGitOpsInfoRequest) (*ProjectGitOpsInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectGitOpsInfo not implemented")
 }
@@ -527,6 +744,48 @@ func (UnimplementedProjectServiceServer) GetProjectProtectionRules(context.Conte
 func (UnimplementedProjectServiceServer) UpdateProjectProtectionRules(context.Context, *UpdateProjectProtectionRulesRequest) (*ProtectionRules, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectProtectionRules not implemented")
 }
+func (UnimplementedProjectServiceServer) GetProjectIssueForms(context.Context, *GetProjectIssueFormsRequest) (*IssueForms, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectIssueForms not implemented")
+}
+func (UnimplementedProjectServiceServer) UpdateProjectIssueForms(context.Context, *UpdateProjectIssueFormsRequest) (*IssueForms, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectIssueForms not implemented")
+}
+func (UnimplementedProjectServiceServer) GetProjectQuota(context.Context, *GetProjectQuotaRequest) (*ProjectQuota, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectQuota not implemented")
+}
+func (UnimplementedProjectServiceServer) UpdateProjectQuota(context.Context, *UpdateProjectQuotaRequest) (*ProjectQuota, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectQuota not implemented")
+}
+func (UnimplementedProjectServiceServer) ListIssueTemplates(context.Context, *ListIssueTemplatesRequest) (*ListIssueTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIssueTemplates not implemented")
+}
+func (UnimplementedProjectServiceServer) GetIssueTemplate(context.Context, *GetIssueTemplateRequest) (*IssueTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssueTemplate not implemented")
+}
+func (UnimplementedProjectServiceServer) CreateIssueTemplate(context.Context, *CreateIssueTemplateRequest) (*IssueTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIssueTemplate not implemented")
+}
+func (UnimplementedProjectServiceServer) UpdateIssueTemplate(context.Context, *UpdateIssueTemplateRequest) (*IssueTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIssueTemplate not implemented")
+}
+func (UnimplementedProjectServiceServer) DeleteIssueTemplate(context.Context, *DeleteIssueTemplateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIssueTemplate not implemented")
+}
+func (UnimplementedProjectServiceServer) ListIssueSchedules(context.Context, *ListIssueSchedulesRequest) (*ListIssueSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIssueSchedules not implemented")
+}
+func (UnimplementedProjectServiceServer) GetIssueSchedule(context.Context, *GetIssueScheduleRequest) (*IssueSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssueSchedule not implemented")
+}
+func (UnimplementedProjectServiceServer) CreateIssueSchedule(context.Context, *CreateIssueScheduleRequest) (*IssueSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIssueSchedule not implemented")
+}
+func (UnimplementedProjectServiceServer) UpdateIssueSchedule(context.Context, *UpdateIssueScheduleRequest) (*IssueSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIssueSchedule not implemented")
+}
+func (UnimplementedProjectServiceServer) DeleteIssueSchedule(context.Context, *DeleteIssueScheduleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIssueSchedule not implemented")
+}
 func (UnimplementedProjectServiceServer) mustEmbedUnimplementedProjectServiceServer() {}
 
 // UnsafeProjectServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -756,6 +1015,24 @@ func _ProjectService_UpdateDeploymentConfig_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_EvaluateDeploymentExpression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateDeploymentExpressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).EvaluateDeploymentExpression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_EvaluateDeploymentExpression_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).EvaluateDeploymentExpression(ctx, req.(*EvaluateDeploymentExpressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_AddWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddWebhookRequest)
 	if err := dec(in); err != nil {
@@ -828,6 +1105,42 @@ func _ProjectService_TestWebhook_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_RedeliverWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).RedeliverWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_RedeliverWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).RedeliverWebhookDelivery(ctx, req.(*RedeliverWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_UpdateProjectGitOpsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateProjectGitOpsInfoRequest)
 	if err := dec(in); err != nil {
@@ -1116,6 +1429,258 @@ func _ProjectService_UpdateProjectProtectionRules_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_GetProjectIssueForms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectIssueFormsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetProjectIssueForms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetProjectIssueForms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetProjectIssueForms(ctx, req.(*GetProjectIssueFormsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_UpdateProjectIssueForms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectIssueFormsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).UpdateProjectIssueForms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_UpdateProjectIssueForms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).UpdateProjectIssueForms(ctx, req.(*UpdateProjectIssueFormsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetProjectQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetProjectQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetProjectQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetProjectQuota(ctx, req.(*GetProjectQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_UpdateProjectQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).UpdateProjectQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_UpdateProjectQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).UpdateProjectQuota(ctx, req.(*UpdateProjectQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ListIssueTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssueTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListIssueTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ListIssueTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListIssueTemplates(ctx, req.(*ListIssueTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetIssueTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetIssueTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetIssueTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetIssueTemplate(ctx, req.(*GetIssueTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_CreateIssueTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIssueTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).CreateIssueTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_CreateIssueTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).CreateIssueTemplate(ctx, req.(*CreateIssueTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_UpdateIssueTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIssueTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).UpdateIssueTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_UpdateIssueTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).UpdateIssueTemplate(ctx, req.(*UpdateIssueTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_DeleteIssueTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIssueTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).DeleteIssueTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_DeleteIssueTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).DeleteIssueTemplate(ctx, req.(*DeleteIssueTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ListIssueSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssueSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListIssueSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ListIssueSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListIssueSchedules(ctx, req.(*ListIssueSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetIssueSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetIssueSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetIssueSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetIssueSchedule(ctx, req.(*GetIssueScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_CreateIssueSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIssueScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).CreateIssueSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_CreateIssueSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).CreateIssueSchedule(ctx, req.(*CreateIssueScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_UpdateIssueSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIssueScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).UpdateIssueSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_UpdateIssueSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).UpdateIssueSchedule(ctx, req.(*UpdateIssueScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_DeleteIssueSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIssueScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).DeleteIssueSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_DeleteIssueSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).DeleteIssueSchedule(ctx, req.(*DeleteIssueScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProjectService_ServiceDesc is the grpc.ServiceDesc for ProjectService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1171,6 +1736,10 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDeploymentConfig",
 			Handler:    _ProjectService_UpdateDeploymentConfig_Handler,
 		},
+		{
+			MethodName: "EvaluateDeploymentExpression",
+			Handler:    _ProjectService_EvaluateDeploymentExpression_Handler,
+		},
 		{
 			MethodName: "AddWebhook",
 			Handler:    _ProjectService_AddWebhook_Handler,
@@ -1187,6 +1756,14 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TestWebhook",
 			Handler:    _ProjectService_TestWebhook_Handler,
 		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _ProjectService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhookDelivery",
+			Handler:    _ProjectService_RedeliverWebhookDelivery_Handler,
+		},
 		{
 			MethodName: "UpdateProjectGitOpsInfo",
 			Handler:    _ProjectService_UpdateProjectGitOpsInfo_Handler,
@@ -1251,6 +1828,62 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProjectProtectionRules",
 			Handler:    _ProjectService_UpdateProjectProtectionRules_Handler,
 		},
+		{
+			MethodName: "GetProjectIssueForms",
+			Handler:    _ProjectService_GetProjectIssueForms_Handler,
+		},
+		{
+			MethodName: "UpdateProjectIssueForms",
+			Handler:    _ProjectService_UpdateProjectIssueForms_Handler,
+		},
+		{
+			MethodName: "GetProjectQuota",
+			Handler:    _ProjectService_GetProjectQuota_Handler,
+		},
+		{
+			MethodName: "UpdateProjectQuota",
+			Handler:    _ProjectService_UpdateProjectQuota_Handler,
+		},
+		{
+			MethodName: "ListIssueTemplates",
+			Handler:    _ProjectService_ListIssueTemplates_Handler,
+		},
+		{
+			MethodName: "GetIssueTemplate",
+			Handler:    _ProjectService_GetIssueTemplate_Handler,
+		},
+		{
+			MethodName: "CreateIssueTemplate",
+			Handler:    _ProjectService_CreateIssueTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateIssueTemplate",
+			Handler:    _ProjectService_UpdateIssueTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteIssueTemplate",
+			Handler:    _ProjectService_DeleteIssueTemplate_Handler,
+		},
+		{
+			MethodName: "ListIssueSchedules",
+			Handler:    _ProjectService_ListIssueSchedules_Handler,
+		},
+		{
+			MethodName: "GetIssueSchedule",
+			Handler:    _ProjectService_GetIssueSchedule_Handler,
+		},
+		{
+			MethodName: "CreateIssueSchedule",
+			Handler:    _ProjectService_CreateIssueSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateIssueSchedule",
+			Handler:    _ProjectService_UpdateIssueSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteIssueSchedule",
+			Handler:    _ProjectService_DeleteIssueSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/project_service.proto",
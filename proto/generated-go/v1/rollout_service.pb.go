@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -105,20 +106,26 @@ const (
 	PlanCheckRun_DATABASE_CONNECT                  PlanCheckRun_Type = 6
 	PlanCheckRun_DATABASE_GHOST_SYNC               PlanCheckRun_Type = 7
 	PlanCheckRun_DATABASE_PITR_MYSQL               PlanCheckRun_Type = 8
+	// Run the SQL review against the schema of every target database of a multi-database change.
+	PlanCheckRun_DATABASE_STATEMENT_BULK_ADVISE PlanCheckRun_Type = 9
+	// Compare the plans of the benchmark queries of the project before and after the migration.
+	PlanCheckRun_DATABASE_STATEMENT_QUERY_PLAN PlanCheckRun_Type = 10
 )
 
 // Enum value maps for PlanCheckRun_Type.
 var (
 	PlanCheckRun_Type_name = map[int32]string{
-		0: "TYPE_UNSPECIFIED",
-		1: "DATABASE_STATEMENT_FAKE_ADVISE",
-		2: "DATABASE_STATEMENT_COMPATIBILITY",
-		3: "DATABASE_STATEMENT_ADVISE",
-		4: "DATABASE_STATEMENT_TYPE",
-		5: "DATABASE_STATEMENT_SUMMARY_REPORT",
-		6: "DATABASE_CONNECT",
-		7: "DATABASE_GHOST_SYNC",
-		8: "DATABASE_PITR_MYSQL",
+		0:  "TYPE_UNSPECIFIED",
+		1:  "DATABASE_STATEMENT_FAKE_ADVISE",
+		2:  "DATABASE_STATEMENT_COMPATIBILITY",
+		3:  "DATABASE_STATEMENT_ADVISE",
+		4:  "DATABASE_STATEMENT_TYPE",
+		5:  "DATABASE_STATEMENT_SUMMARY_REPORT",
+		6:  "DATABASE_CONNECT",
+		7:  "DATABASE_GHOST_SYNC",
+		8:  "DATABASE_PITR_MYSQL",
+		9:  "DATABASE_STATEMENT_BULK_ADVISE",
+		10: "DATABASE_STATEMENT_QUERY_PLAN",
 	}
 	PlanCheckRun_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED":                  0,
@@ -130,6 +137,8 @@ var (
 		"DATABASE_CONNECT":                  6,
 		"DATABASE_GHOST_SYNC":               7,
 		"DATABASE_PITR_MYSQL":               8,
+		"DATABASE_STATEMENT_BULK_ADVISE":    9,
+		"DATABASE_STATEMENT_QUERY_PLAN":     10,
 	}
 )
 
@@ -157,7 +166,7 @@ func (x PlanCheckRun_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PlanCheckRun_Type.Descriptor instead.
 func (PlanCheckRun_Type) EnumDescriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{16, 0}
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{29, 0}
 }
 
 type PlanCheckRun_Status int32
@@ -212,7 +221,7 @@ func (x PlanCheckRun_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PlanCheckRun_Status.Descriptor instead.
 func (PlanCheckRun_Status) EnumDescriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{16, 1}
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{29, 1}
 }
 
 type PlanCheckRun_Result_Status int32
@@ -264,7 +273,121 @@ func (x PlanCheckRun_Result_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PlanCheckRun_Result_Status.Descriptor instead.
 func (PlanCheckRun_Result_Status) EnumDescriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{16, 0, 0}
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{29, 0, 0}
+}
+
+type StageChangeRequest_Status int32
+
+const (
+	StageChangeRequest_STATUS_UNSPECIFIED StageChangeRequest_Status = 0
+	// The change request is opened and waits to reach the "Implement" state.
+	StageChangeRequest_PENDING StageChangeRequest_Status = 1
+	// The change request is in the "Implement" state and the tasks of the stage are run.
+	StageChangeRequest_IMPLEMENT StageChangeRequest_Status = 2
+	// The change request is closed with the task results.
+	StageChangeRequest_CLOSED StageChangeRequest_Status = 3
+	// The change request is canceled before the tasks are run.
+	StageChangeRequest_CANCELED StageChangeRequest_Status = 4
+)
+
+// Enum value maps for StageChangeRequest_Status.
+var (
+	StageChangeRequest_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "PENDING",
+		2: "IMPLEMENT",
+		3: "CLOSED",
+		4: "CANCELED",
+	}
+	StageChangeRequest_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"PENDING":            1,
+		"IMPLEMENT":          2,
+		"CLOSED":             3,
+		"CANCELED":           4,
+	}
+)
+
+func (x StageChangeRequest_Status) Enum() *StageChangeRequest_Status {
+	p := new(StageChangeRequest_Status)
+	*p = x
+	return p
+}
+
+func (x StageChangeRequest_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StageChangeRequest_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_rollout_service_proto_enumTypes[4].Descriptor()
+}
+
+func (StageChangeRequest_Status) Type() protoreflect.EnumType {
+	return &file_v1_rollout_service_proto_enumTypes[4]
+}
+
+func (x StageChangeRequest_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StageChangeRequest_Status.Descriptor instead.
+func (StageChangeRequest_Status) EnumDescriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{39, 0}
+}
+
+type StageCanary_Status int32
+
+const (
+	StageCanary_STATUS_UNSPECIFIED StageCanary_Status = 0
+	// The canary tasks are running or baking.
+	StageCanary_BAKING StageCanary_Status = 1
+	// The tasks of the next stage are run.
+	StageCanary_PROMOTED StageCanary_Status = 2
+	// The canary fails and the next stage isn't run.
+	StageCanary_HALTED StageCanary_Status = 3
+)
+
+// Enum value maps for StageCanary_Status.
+var (
+	StageCanary_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "BAKING",
+		2: "PROMOTED",
+		3: "HALTED",
+	}
+	StageCanary_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"BAKING":             1,
+		"PROMOTED":           2,
+		"HALTED":             3,
+	}
+)
+
+func (x StageCanary_Status) Enum() *StageCanary_Status {
+	p := new(StageCanary_Status)
+	*p = x
+	return p
+}
+
+func (x StageCanary_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StageCanary_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_rollout_service_proto_enumTypes[5].Descriptor()
+}
+
+func (StageCanary_Status) Type() protoreflect.EnumType {
+	return &file_v1_rollout_service_proto_enumTypes[5]
+}
+
+func (x StageCanary_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StageCanary_Status.Descriptor instead.
+func (StageCanary_Status) EnumDescriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{40, 0}
 }
 
 type Task_Status int32
@@ -315,11 +438,11 @@ func (x Task_Status) String() string {
 }
 
 func (Task_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_rollout_service_proto_enumTypes[4].Descriptor()
+	return file_v1_rollout_service_proto_enumTypes[6].Descriptor()
 }
 
 func (Task_Status) Type() protoreflect.EnumType {
-	return &file_v1_rollout_service_proto_enumTypes[4]
+	return &file_v1_rollout_service_proto_enumTypes[6]
 }
 
 func (x Task_Status) Number() protoreflect.EnumNumber {
@@ -328,7 +451,7 @@ func (x Task_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Task_Status.Descriptor instead.
 func (Task_Status) EnumDescriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{24, 0}
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{42, 0}
 }
 
 type Task_Type int32
@@ -356,6 +479,10 @@ const (
 	Task_DATABASE_RESTORE_RESTORE Task_Type = 10
 	// use payload nil
 	Task_DATABASE_RESTORE_CUTOVER Task_Type = 11
+	// use payload DatabaseDataProvision
+	Task_DATABASE_DATA_PROVISION Task_Type = 12
+	// use payload DatabaseDataMigrate
+	Task_DATABASE_DATA_MIGRATE Task_Type = 13
 )
 
 // Enum value maps for Task_Type.
@@ -373,6 +500,8 @@ var (
 		9:  "DATABASE_BACKUP",
 		10: "DATABASE_RESTORE_RESTORE",
 		11: "DATABASE_RESTORE_CUTOVER",
+		12: "DATABASE_DATA_PROVISION",
+		13: "DATABASE_DATA_MIGRATE",
 	}
 	Task_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED":                     0,
@@ -387,6 +516,8 @@ var (
 		"DATABASE_BACKUP":                      9,
 		"DATABASE_RESTORE_RESTORE":             10,
 		"DATABASE_RESTORE_CUTOVER":             11,
+		"DATABASE_DATA_PROVISION":              12,
+		"DATABASE_DATA_MIGRATE":                13,
 	}
 )
 
@@ -401,11 +532,11 @@ func (x Task_Type) String() string {
 }
 
 func (Task_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_rollout_service_proto_enumTypes[5].Descriptor()
+	return file_v1_rollout_service_proto_enumTypes[7].Descriptor()
 }
 
 func (Task_Type) Type() protoreflect.EnumType {
-	return &file_v1_rollout_service_proto_enumTypes[5]
+	return &file_v1_rollout_service_proto_enumTypes[7]
 }
 
 func (x Task_Type) Number() protoreflect.EnumNumber {
@@ -414,7 +545,7 @@ func (x Task_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Task_Type.Descriptor instead.
 func (Task_Type) EnumDescriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{24, 1}
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{42, 1}
 }
 
 type Task_DatabaseDataUpdate_RollbackSqlStatus int32
@@ -453,11 +584,11 @@ func (x Task_DatabaseDataUpdate_RollbackSqlStatus) String() string {
 }
 
 func (Task_DatabaseDataUpdate_RollbackSqlStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_rollout_service_proto_enumTypes[6].Descriptor()
+	return file_v1_rollout_service_proto_enumTypes[8].Descriptor()
 }
 
 func (Task_DatabaseDataUpdate_RollbackSqlStatus) Type() protoreflect.EnumType {
-	return &file_v1_rollout_service_proto_enumTypes[6]
+	return &file_v1_rollout_service_proto_enumTypes[8]
 }
 
 func (x Task_DatabaseDataUpdate_RollbackSqlStatus) Number() protoreflect.EnumNumber {
@@ -466,7 +597,7 @@ func (x Task_DatabaseDataUpdate_RollbackSqlStatus) Number() protoreflect.EnumNum
 
 // Deprecated: Use Task_DatabaseDataUpdate_RollbackSqlStatus.Descriptor instead.
 func (Task_DatabaseDataUpdate_RollbackSqlStatus) EnumDescriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{24, 3, 0}
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{42, 3, 0}
 }
 
 type TaskRun_Status int32
@@ -511,11 +642,11 @@ func (x TaskRun_Status) String() string {
 }
 
 func (TaskRun_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_rollout_service_proto_enumTypes[7].Descriptor()
+	return file_v1_rollout_service_proto_enumTypes[9].Descriptor()
 }
 
 func (TaskRun_Status) Type() protoreflect.EnumType {
-	return &file_v1_rollout_service_proto_enumTypes[7]
+	return &file_v1_rollout_service_proto_enumTypes[9]
 }
 
 func (x TaskRun_Status) Number() protoreflect.EnumNumber {
@@ -524,7 +655,7 @@ func (x TaskRun_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TaskRun_Status.Descriptor instead.
 func (TaskRun_Status) EnumDescriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{25, 0}
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{43, 0}
 }
 
 type TaskRun_ExecutionStatus int32
@@ -563,11 +694,11 @@ func (x TaskRun_ExecutionStatus) String() string {
 }
 
 func (TaskRun_ExecutionStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_rollout_service_proto_enumTypes[8].Descriptor()
+	return file_v1_rollout_service_proto_enumTypes[10].Descriptor()
 }
 
 func (TaskRun_ExecutionStatus) Type() protoreflect.EnumType {
-	return &file_v1_rollout_service_proto_enumTypes[8]
+	return &file_v1_rollout_service_proto_enumTypes[10]
 }
 
 func (x TaskRun_ExecutionStatus) Number() protoreflect.EnumNumber {
@@ -576,7 +707,218 @@ func (x TaskRun_ExecutionStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TaskRun_ExecutionStatus.Descriptor instead.
 func (TaskRun_ExecutionStatus) EnumDescriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{25, 1}
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{43, 1}
+}
+
+type TaskRun_ExecutionDetail_Step_Status int32
+
+const (
+	TaskRun_ExecutionDetail_Step_STATUS_UNSPECIFIED TaskRun_ExecutionDetail_Step_Status = 0
+	TaskRun_ExecutionDetail_Step_PENDING            TaskRun_ExecutionDetail_Step_Status = 1
+	TaskRun_ExecutionDetail_Step_RUNNING            TaskRun_ExecutionDetail_Step_Status = 2
+	TaskRun_ExecutionDetail_Step_DONE               TaskRun_ExecutionDetail_Step_Status = 3
+)
+
+// Enum value maps for TaskRun_ExecutionDetail_Step_Status.
+var (
+	TaskRun_ExecutionDetail_Step_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "PENDING",
+		2: "RUNNING",
+		3: "DONE",
+	}
+	TaskRun_ExecutionDetail_Step_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"PENDING":            1,
+		"RUNNING":            2,
+		"DONE":               3,
+	}
+)
+
+func (x TaskRun_ExecutionDetail_Step_Status) Enum() *TaskRun_ExecutionDetail_Step_Status {
+	p := new(TaskRun_ExecutionDetail_Step_Status)
+	*p = x
+	return p
+}
+
+func (x TaskRun_ExecutionDetail_Step_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskRun_ExecutionDetail_Step_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_rollout_service_proto_enumTypes[11].Descriptor()
+}
+
+func (TaskRun_ExecutionDetail_Step_Status) Type() protoreflect.EnumType {
+	return &file_v1_rollout_service_proto_enumTypes[11]
+}
+
+func (x TaskRun_ExecutionDetail_Step_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskRun_ExecutionDetail_Step_Status.Descriptor instead.
+func (TaskRun_ExecutionDetail_Step_Status) EnumDescriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{43, 0, 1, 0}
+}
+
+type TaskRun_CheckResult_Type int32
+
+const (
+	TaskRun_CheckResult_TYPE_UNSPECIFIED TaskRun_CheckResult_Type = 0
+	TaskRun_CheckResult_PRE_CHECK        TaskRun_CheckResult_Type = 1
+	TaskRun_CheckResult_POST_CHECK       TaskRun_CheckResult_Type = 2
+)
+
+// Enum value maps for TaskRun_CheckResult_Type.
+var (
+	TaskRun_CheckResult_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "PRE_CHECK",
+		2: "POST_CHECK",
+	}
+	TaskRun_CheckResult_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"PRE_CHECK":        1,
+		"POST_CHECK":       2,
+	}
+)
+
+func (x TaskRun_CheckResult_Type) Enum() *TaskRun_CheckResult_Type {
+	p := new(TaskRun_CheckResult_Type)
+	*p = x
+	return p
+}
+
+func (x TaskRun_CheckResult_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskRun_CheckResult_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_rollout_service_proto_enumTypes[12].Descriptor()
+}
+
+func (TaskRun_CheckResult_Type) Type() protoreflect.EnumType {
+	return &file_v1_rollout_service_proto_enumTypes[12]
+}
+
+func (x TaskRun_CheckResult_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskRun_CheckResult_Type.Descriptor instead.
+func (TaskRun_CheckResult_Type) EnumDescriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{43, 1, 0}
+}
+
+type TaskRunArtifact_Type int32
+
+const (
+	TaskRunArtifact_TYPE_UNSPECIFIED    TaskRunArtifact_Type = 0
+	TaskRunArtifact_ROLLBACK_SQL        TaskRunArtifact_Type = 1
+	TaskRunArtifact_GHOST_CHANGELOG     TaskRunArtifact_Type = 2
+	TaskRunArtifact_VERIFICATION_OUTPUT TaskRunArtifact_Type = 3
+	TaskRunArtifact_DATA_COMPARE_REPORT TaskRunArtifact_Type = 4
+)
+
+// Enum value maps for TaskRunArtifact_Type.
+var (
+	TaskRunArtifact_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "ROLLBACK_SQL",
+		2: "GHOST_CHANGELOG",
+		3: "VERIFICATION_OUTPUT",
+		4: "DATA_COMPARE_REPORT",
+	}
+	TaskRunArtifact_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":    0,
+		"ROLLBACK_SQL":        1,
+		"GHOST_CHANGELOG":     2,
+		"VERIFICATION_OUTPUT": 3,
+		"DATA_COMPARE_REPORT": 4,
+	}
+)
+
+func (x TaskRunArtifact_Type) Enum() *TaskRunArtifact_Type {
+	p := new(TaskRunArtifact_Type)
+	*p = x
+	return p
+}
+
+func (x TaskRunArtifact_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskRunArtifact_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_rollout_service_proto_enumTypes[13].Descriptor()
+}
+
+func (TaskRunArtifact_Type) Type() protoreflect.EnumType {
+	return &file_v1_rollout_service_proto_enumTypes[13]
+}
+
+func (x TaskRunArtifact_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskRunArtifact_Type.Descriptor instead.
+func (TaskRunArtifact_Type) EnumDescriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{44, 0}
+}
+
+type TaskRunLogEntry_Level int32
+
+const (
+	TaskRunLogEntry_LEVEL_UNSPECIFIED TaskRunLogEntry_Level = 0
+	TaskRunLogEntry_DEBUG             TaskRunLogEntry_Level = 1
+	TaskRunLogEntry_INFO              TaskRunLogEntry_Level = 2
+	TaskRunLogEntry_WARN              TaskRunLogEntry_Level = 3
+	TaskRunLogEntry_ERROR             TaskRunLogEntry_Level = 4
+)
+
+// Enum value maps for TaskRunLogEntry_Level.
+var (
+	TaskRunLogEntry_Level_name = map[int32]string{
+		0: "LEVEL_UNSPECIFIED",
+		1: "DEBUG",
+		2: "INFO",
+		3: "WARN",
+		4: "ERROR",
+	}
+	TaskRunLogEntry_Level_value = map[string]int32{
+		"LEVEL_UNSPECIFIED": 0,
+		"DEBUG":             1,
+		"INFO":              2,
+		"WARN":              3,
+		"ERROR":             4,
+	}
+)
+
+func (x TaskRunLogEntry_Level) Enum() *TaskRunLogEntry_Level {
+	p := new(TaskRunLogEntry_Level)
+	*p = x
+	return p
+}
+
+func (x TaskRunLogEntry_Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskRunLogEntry_Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_rollout_service_proto_enumTypes[14].Descriptor()
+}
+
+func (TaskRunLogEntry_Level) Type() protoreflect.EnumType {
+	return &file_v1_rollout_service_proto_enumTypes[14]
+}
+
+func (x TaskRunLogEntry_Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskRunLogEntry_Level.Descriptor instead.
+func (TaskRunLogEntry_Level) EnumDescriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{45, 0}
 }
 
 type GetPlanRequest struct {
@@ -1203,6 +1545,10 @@ type BatchRunTasksRequest struct {
 	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}
 	Tasks  []string `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
 	Reason string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Run the tasks immediately instead of waiting for the rollout window or the rollout freezes of the environment.
+	// The tasks already queued for the window are run immediately as well.
+	// It's an emergency override requiring the bb.tasks.runNow permission.
+	RunNow bool `protobuf:"varint,4,opt,name=run_now,json=runNow,proto3" json:"run_now,omitempty"`
 }
 
 func (x *BatchRunTasksRequest) Reset() {
@@ -1258,6 +1604,13 @@ func (x *BatchRunTasksRequest) GetReason() string {
 	return ""
 }
 
+func (x *BatchRunTasksRequest) GetRunNow() bool {
+	if x != nil {
+		return x.RunNow
+	}
+	return false
+}
+
 type BatchRunTasksResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1401,23 +1754,25 @@ func (*BatchSkipTasksResponse) Descriptor() ([]byte, []int) {
 	return file_v1_rollout_service_proto_rawDescGZIP(), []int{13}
 }
 
-type BatchCancelTaskRunsRequest struct {
+type ApproveStageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of the parent of the taskRuns.
+	// The name of the stage.
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The tasks to sign off, the whole stage is signed off if empty.
 	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}
-	// Use `projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/-` to cancel task runs under the same stage.
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// The taskRuns to cancel.
-	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}/taskRuns/{taskRun}
-	TaskRuns []string `protobuf:"bytes,2,rep,name=task_runs,json=taskRuns,proto3" json:"task_runs,omitempty"`
-	Reason   string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Tasks []string `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	// The approval gate role to sign off as, the caller must have the role.
+	// Format: roles/{role}
+	Role    string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Comment string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
 }
 
-func (x *BatchCancelTaskRunsRequest) Reset() {
-	*x = BatchCancelTaskRunsRequest{}
+func (x *ApproveStageRequest) Reset() {
+	*x = ApproveStageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_rollout_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1425,13 +1780,13 @@ func (x *BatchCancelTaskRunsRequest) Reset() {
 	}
 }
 
-func (x *BatchCancelTaskRunsRequest) String() string {
+func (x *ApproveStageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCancelTaskRunsRequest) ProtoMessage() {}
+func (*ApproveStageRequest) ProtoMessage() {}
 
-func (x *BatchCancelTaskRunsRequest) ProtoReflect() protoreflect.Message {
+func (x *ApproveStageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_rollout_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1443,40 +1798,47 @@ func (x *BatchCancelTaskRunsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCancelTaskRunsRequest.ProtoReflect.Descriptor instead.
-func (*BatchCancelTaskRunsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApproveStageRequest.ProtoReflect.Descriptor instead.
+func (*ApproveStageRequest) Descriptor() ([]byte, []int) {
 	return file_v1_rollout_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *BatchCancelTaskRunsRequest) GetParent() string {
+func (x *ApproveStageRequest) GetName() string {
 	if x != nil {
-		return x.Parent
+		return x.Name
 	}
 	return ""
 }
 
-func (x *BatchCancelTaskRunsRequest) GetTaskRuns() []string {
+func (x *ApproveStageRequest) GetTasks() []string {
 	if x != nil {
-		return x.TaskRuns
+		return x.Tasks
 	}
 	return nil
 }
 
-func (x *BatchCancelTaskRunsRequest) GetReason() string {
+func (x *ApproveStageRequest) GetRole() string {
 	if x != nil {
-		return x.Reason
+		return x.Role
 	}
 	return ""
 }
 
-type BatchCancelTaskRunsResponse struct {
+func (x *ApproveStageRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type ApproveStageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *BatchCancelTaskRunsResponse) Reset() {
-	*x = BatchCancelTaskRunsResponse{}
+func (x *ApproveStageResponse) Reset() {
+	*x = ApproveStageResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_rollout_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1484,13 +1846,13 @@ func (x *BatchCancelTaskRunsResponse) Reset() {
 	}
 }
 
-func (x *BatchCancelTaskRunsResponse) String() string {
+func (x *ApproveStageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCancelTaskRunsResponse) ProtoMessage() {}
+func (*ApproveStageResponse) ProtoMessage() {}
 
-func (x *BatchCancelTaskRunsResponse) ProtoReflect() protoreflect.Message {
+func (x *ApproveStageResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_rollout_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1502,34 +1864,28 @@ func (x *BatchCancelTaskRunsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCancelTaskRunsResponse.ProtoReflect.Descriptor instead.
-func (*BatchCancelTaskRunsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApproveStageResponse.ProtoReflect.Descriptor instead.
+func (*ApproveStageResponse) Descriptor() ([]byte, []int) {
 	return file_v1_rollout_service_proto_rawDescGZIP(), []int{15}
 }
 
-type PlanCheckRun struct {
+type BatchCancelTaskRunsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Format: projects/{project}/plans/{plan}/planCheckRuns/{planCheckRun}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The system-assigned, unique identifier for a resource.
-	Uid    string              `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	Type   PlanCheckRun_Type   `protobuf:"varint,3,opt,name=type,proto3,enum=bytebase.v1.PlanCheckRun_Type" json:"type,omitempty"`
-	Status PlanCheckRun_Status `protobuf:"varint,4,opt,name=status,proto3,enum=bytebase.v1.PlanCheckRun_Status" json:"status,omitempty"`
-	// Format: instances/{instance}/databases/{database}
-	Target string `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
-	// Format: project/{project}/sheets/{sheet}
-	Sheet   string                 `protobuf:"bytes,6,opt,name=sheet,proto3" json:"sheet,omitempty"`
-	Results []*PlanCheckRun_Result `protobuf:"bytes,7,rep,name=results,proto3" json:"results,omitempty"`
-	// error is set if the Status is FAILED.
-	Error      string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The name of the parent of the taskRuns.
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}
+	// Use `projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/-` to cancel task runs under the same stage.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The taskRuns to cancel.
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}/taskRuns/{taskRun}
+	TaskRuns []string `protobuf:"bytes,2,rep,name=task_runs,json=taskRuns,proto3" json:"task_runs,omitempty"`
+	Reason   string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *PlanCheckRun) Reset() {
-	*x = PlanCheckRun{}
+func (x *BatchCancelTaskRunsRequest) Reset() {
+	*x = BatchCancelTaskRunsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_rollout_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1537,13 +1893,13 @@ func (x *PlanCheckRun) Reset() {
 	}
 }
 
-func (x *PlanCheckRun) String() string {
+func (x *BatchCancelTaskRunsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlanCheckRun) ProtoMessage() {}
+func (*BatchCancelTaskRunsRequest) ProtoMessage() {}
 
-func (x *PlanCheckRun) ProtoReflect() protoreflect.Message {
+func (x *BatchCancelTaskRunsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_rollout_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1555,86 +1911,40 @@ func (x *PlanCheckRun) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlanCheckRun.ProtoReflect.Descriptor instead.
-func (*PlanCheckRun) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchCancelTaskRunsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCancelTaskRunsRequest) Descriptor() ([]byte, []int) {
 	return file_v1_rollout_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *PlanCheckRun) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *PlanCheckRun) GetUid() string {
-	if x != nil {
-		return x.Uid
-	}
-	return ""
-}
-
-func (x *PlanCheckRun) GetType() PlanCheckRun_Type {
-	if x != nil {
-		return x.Type
-	}
-	return PlanCheckRun_TYPE_UNSPECIFIED
-}
-
-func (x *PlanCheckRun) GetStatus() PlanCheckRun_Status {
-	if x != nil {
-		return x.Status
-	}
-	return PlanCheckRun_STATUS_UNSPECIFIED
-}
-
-func (x *PlanCheckRun) GetTarget() string {
-	if x != nil {
-		return x.Target
-	}
-	return ""
-}
-
-func (x *PlanCheckRun) GetSheet() string {
+func (x *BatchCancelTaskRunsRequest) GetParent() string {
 	if x != nil {
-		return x.Sheet
+		return x.Parent
 	}
 	return ""
 }
 
-func (x *PlanCheckRun) GetResults() []*PlanCheckRun_Result {
+func (x *BatchCancelTaskRunsRequest) GetTaskRuns() []string {
 	if x != nil {
-		return x.Results
+		return x.TaskRuns
 	}
 	return nil
 }
 
-func (x *PlanCheckRun) GetError() string {
+func (x *BatchCancelTaskRunsRequest) GetReason() string {
 	if x != nil {
-		return x.Error
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *PlanCheckRun) GetCreateTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreateTime
-	}
-	return nil
-}
-
-type GetRolloutRequest struct {
+type BatchCancelTaskRunsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The name of the rollout to retrieve.
-	// Format: projects/{project}/rollouts/{rollout}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *GetRolloutRequest) Reset() {
-	*x = GetRolloutRequest{}
+func (x *BatchCancelTaskRunsResponse) Reset() {
+	*x = BatchCancelTaskRunsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_rollout_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1642,13 +1952,13 @@ func (x *GetRolloutRequest) Reset() {
 	}
 }
 
-func (x *GetRolloutRequest) String() string {
+func (x *BatchCancelTaskRunsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRolloutRequest) ProtoMessage() {}
+func (*BatchCancelTaskRunsResponse) ProtoMessage() {}
 
-func (x *GetRolloutRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchCancelTaskRunsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_rollout_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1660,32 +1970,34 @@ func (x *GetRolloutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRolloutRequest.ProtoReflect.Descriptor instead.
-func (*GetRolloutRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchCancelTaskRunsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCancelTaskRunsResponse) Descriptor() ([]byte, []int) {
 	return file_v1_rollout_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetRolloutRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-type CreateRolloutRequest struct {
+type BatchRetryFailedTasksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The parent project where this rollout will be created.
+	// The parent of the rollouts.
 	// Format: projects/{project}
+	// Use "projects/-" to retry the failed tasks in all projects.
 	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// The plan used to create rollout.
-	Plan string `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	// Filter is used to filter the failed tasks to retry.
+	// Supported filter:
+	// - environment: the environment full name in "environments/{id}" format, support "=" operator.
+	// - instance: the instance full name in "instances/{id}" format, support "=" operator.
+	// - database: the database full name in "instances/{id}/databases/{name}" format, support "=" operator.
+	//
+	// For example:
+	// environment = "environments/prod" && instance = "instances/mysql"
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *CreateRolloutRequest) Reset() {
-	*x = CreateRolloutRequest{}
+func (x *BatchRetryFailedTasksRequest) Reset() {
+	*x = BatchRetryFailedTasksRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_rollout_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1693,13 +2005,13 @@ func (x *CreateRolloutRequest) Reset() {
 	}
 }
 
-func (x *CreateRolloutRequest) String() string {
+func (x *BatchRetryFailedTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRolloutRequest) ProtoMessage() {}
+func (*BatchRetryFailedTasksRequest) ProtoMessage() {}
 
-func (x *CreateRolloutRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchRetryFailedTasksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_rollout_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1711,39 +2023,46 @@ func (x *CreateRolloutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRolloutRequest.ProtoReflect.Descriptor instead.
-func (*CreateRolloutRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchRetryFailedTasksRequest.ProtoReflect.Descriptor instead.
+func (*BatchRetryFailedTasksRequest) Descriptor() ([]byte, []int) {
 	return file_v1_rollout_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CreateRolloutRequest) GetParent() string {
+func (x *BatchRetryFailedTasksRequest) GetParent() string {
 	if x != nil {
 		return x.Parent
 	}
 	return ""
 }
 
-func (x *CreateRolloutRequest) GetPlan() string {
+func (x *BatchRetryFailedTasksRequest) GetFilter() string {
 	if x != nil {
-		return x.Plan
+		return x.Filter
 	}
 	return ""
 }
 
-type PreviewRolloutRequest struct {
+func (x *BatchRetryFailedTasksRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type BatchRetryFailedTasksResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of the project.
-	// Format: projects/{project}
-	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
-	// The plan used to preview rollout.
-	Plan *Plan `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	// The retried tasks.
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}
+	Tasks []string `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	// The tasks failed to retry.
+	Failures []*BatchOperationFailure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"`
 }
 
-func (x *PreviewRolloutRequest) Reset() {
-	*x = PreviewRolloutRequest{}
+func (x *BatchRetryFailedTasksResponse) Reset() {
+	*x = BatchRetryFailedTasksResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_rollout_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1751,13 +2070,13 @@ func (x *PreviewRolloutRequest) Reset() {
 	}
 }
 
-func (x *PreviewRolloutRequest) String() string {
+func (x *BatchRetryFailedTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PreviewRolloutRequest) ProtoMessage() {}
+func (*BatchRetryFailedTasksResponse) ProtoMessage() {}
 
-func (x *PreviewRolloutRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchRetryFailedTasksResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_rollout_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1769,49 +2088,42 @@ func (x *PreviewRolloutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PreviewRolloutRequest.ProtoReflect.Descriptor instead.
-func (*PreviewRolloutRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchRetryFailedTasksResponse.ProtoReflect.Descriptor instead.
+func (*BatchRetryFailedTasksResponse) Descriptor() ([]byte, []int) {
 	return file_v1_rollout_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *PreviewRolloutRequest) GetProject() string {
+func (x *BatchRetryFailedTasksResponse) GetTasks() []string {
 	if x != nil {
-		return x.Project
+		return x.Tasks
 	}
-	return ""
+	return nil
 }
 
-func (x *PreviewRolloutRequest) GetPlan() *Plan {
+func (x *BatchRetryFailedTasksResponse) GetFailures() []*BatchOperationFailure {
 	if x != nil {
-		return x.Plan
+		return x.Failures
 	}
 	return nil
 }
 
-type ListTaskRunsRequest struct {
+type BatchCancelPendingTaskRunsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The parent, which owns this collection of plans.
-	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}
-	// Use "projects/{project}/rollouts/{rollout}/stages/-/tasks/-" to list all taskRuns from a rollout.
+	// The parent of the rollouts.
+	// Format: projects/{project}
+	// Use "projects/-" to cancel the pending task runs in all projects.
 	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// The maximum number of taskRuns to return. The service may return fewer than
-	// this value.
-	// If unspecified, at most 50 taskRuns will be returned.
-	// The maximum value is 1000; values above 1000 will be coerced to 1000.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// A page token, received from a previous `ListRolloutTaskRuns` call.
-	// Provide this to retrieve the subsequent page.
-	//
-	// When paginating, all other parameters provided to `ListRolloutTaskRuns` must match
-	// the call that provided the page token.
-	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The environment of the task runs to cancel.
+	// Format: environments/{environment}
+	Environment string `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	Reason      string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *ListTaskRunsRequest) Reset() {
-	*x = ListTaskRunsRequest{}
+func (x *BatchCancelPendingTaskRunsRequest) Reset() {
+	*x = BatchCancelPendingTaskRunsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_rollout_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1819,13 +2131,13 @@ func (x *ListTaskRunsRequest) Reset() {
 	}
 }
 
-func (x *ListTaskRunsRequest) String() string {
+func (x *BatchCancelPendingTaskRunsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTaskRunsRequest) ProtoMessage() {}
+func (*BatchCancelPendingTaskRunsRequest) ProtoMessage() {}
 
-func (x *ListTaskRunsRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchCancelPendingTaskRunsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_rollout_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1837,46 +2149,46 @@ func (x *ListTaskRunsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTaskRunsRequest.ProtoReflect.Descriptor instead.
-func (*ListTaskRunsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchCancelPendingTaskRunsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCancelPendingTaskRunsRequest) Descriptor() ([]byte, []int) {
 	return file_v1_rollout_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListTaskRunsRequest) GetParent() string {
+func (x *BatchCancelPendingTaskRunsRequest) GetParent() string {
 	if x != nil {
 		return x.Parent
 	}
 	return ""
 }
 
-func (x *ListTaskRunsRequest) GetPageSize() int32 {
+func (x *BatchCancelPendingTaskRunsRequest) GetEnvironment() string {
 	if x != nil {
-		return x.PageSize
+		return x.Environment
 	}
-	return 0
+	return ""
 }
 
-func (x *ListTaskRunsRequest) GetPageToken() string {
+func (x *BatchCancelPendingTaskRunsRequest) GetReason() string {
 	if x != nil {
-		return x.PageToken
+		return x.Reason
 	}
 	return ""
 }
 
-type ListTaskRunsResponse struct {
+type BatchCancelPendingTaskRunsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The taskRuns from the specified request.
-	TaskRuns []*TaskRun `protobuf:"bytes,1,rep,name=task_runs,json=taskRuns,proto3" json:"task_runs,omitempty"`
-	// A token, which can be sent as `page_token` to retrieve the next page.
-	// If this field is omitted, there are no subsequent pages.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The canceled task runs.
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}/taskRuns/{taskRun}
+	TaskRuns []string `protobuf:"bytes,1,rep,name=task_runs,json=taskRuns,proto3" json:"task_runs,omitempty"`
+	// The task runs failed to cancel.
+	Failures []*BatchOperationFailure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"`
 }
 
-func (x *ListTaskRunsResponse) Reset() {
-	*x = ListTaskRunsResponse{}
+func (x *BatchCancelPendingTaskRunsResponse) Reset() {
+	*x = BatchCancelPendingTaskRunsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_rollout_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1884,13 +2196,13 @@ func (x *ListTaskRunsResponse) Reset() {
 	}
 }
 
-func (x *ListTaskRunsResponse) String() string {
+func (x *BatchCancelPendingTaskRunsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTaskRunsResponse) ProtoMessage() {}
+func (*BatchCancelPendingTaskRunsResponse) ProtoMessage() {}
 
-func (x *ListTaskRunsResponse) ProtoReflect() protoreflect.Message {
+func (x *BatchCancelPendingTaskRunsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_rollout_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1902,45 +2214,37 @@ func (x *ListTaskRunsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTaskRunsResponse.ProtoReflect.Descriptor instead.
-func (*ListTaskRunsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchCancelPendingTaskRunsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCancelPendingTaskRunsResponse) Descriptor() ([]byte, []int) {
 	return file_v1_rollout_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListTaskRunsResponse) GetTaskRuns() []*TaskRun {
+func (x *BatchCancelPendingTaskRunsResponse) GetTaskRuns() []string {
 	if x != nil {
 		return x.TaskRuns
 	}
 	return nil
 }
 
-func (x *ListTaskRunsResponse) GetNextPageToken() string {
+func (x *BatchCancelPendingTaskRunsResponse) GetFailures() []*BatchOperationFailure {
 	if x != nil {
-		return x.NextPageToken
+		return x.Failures
 	}
-	return ""
+	return nil
 }
 
-type Rollout struct {
+type CutoverTaskRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The resource name of the rollout.
-	// Format: projects/{project}/rollouts/{rollout}
+	// The name of the gh-ost cutover task.
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The system-assigned, unique identifier for a resource.
-	Uid string `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	// The plan that this rollout is based on.
-	// Format: projects/{project}/plans/{plan}
-	Plan  string `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan,omitempty"`
-	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	// stages and thus tasks of the rollout.
-	Stages []*Stage `protobuf:"bytes,5,rep,name=stages,proto3" json:"stages,omitempty"`
 }
 
-func (x *Rollout) Reset() {
-	*x = Rollout{}
+func (x *CutoverTaskRequest) Reset() {
+	*x = CutoverTaskRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_rollout_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1948,13 +2252,13 @@ func (x *Rollout) Reset() {
 	}
 }
 
-func (x *Rollout) String() string {
+func (x *CutoverTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Rollout) ProtoMessage() {}
+func (*CutoverTaskRequest) ProtoMessage() {}
 
-func (x *Rollout) ProtoReflect() protoreflect.Message {
+func (x *CutoverTaskRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_rollout_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1966,63 +2270,26 @@ func (x *Rollout) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Rollout.ProtoReflect.Descriptor instead.
-func (*Rollout) Descriptor() ([]byte, []int) {
+// Deprecated: Use CutoverTaskRequest.ProtoReflect.Descriptor instead.
+func (*CutoverTaskRequest) Descriptor() ([]byte, []int) {
 	return file_v1_rollout_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Rollout) GetName() string {
+func (x *CutoverTaskRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Rollout) GetUid() string {
-	if x != nil {
-		return x.Uid
-	}
-	return ""
-}
-
-func (x *Rollout) GetPlan() string {
-	if x != nil {
-		return x.Plan
-	}
-	return ""
-}
-
-func (x *Rollout) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *Rollout) GetStages() []*Stage {
-	if x != nil {
-		return x.Stages
-	}
-	return nil
-}
-
-type Stage struct {
+type CutoverTaskResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The system-assigned, unique identifier for a resource.
-	Uid string `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	// Format: environments/{environment}
-	Environment string  `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
-	Title       string  `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Tasks       []*Task `protobuf:"bytes,5,rep,name=tasks,proto3" json:"tasks,omitempty"`
 }
 
-func (x *Stage) Reset() {
-	*x = Stage{}
+func (x *CutoverTaskResponse) Reset() {
+	*x = CutoverTaskResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_rollout_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2030,13 +2297,13 @@ func (x *Stage) Reset() {
 	}
 }
 
-func (x *Stage) String() string {
+func (x *CutoverTaskResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Stage) ProtoMessage() {}
+func (*CutoverTaskResponse) ProtoMessage() {}
 
-func (x *Stage) ProtoReflect() protoreflect.Message {
+func (x *CutoverTaskResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_rollout_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2048,96 +2315,135 @@ func (x *Stage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Stage.ProtoReflect.Descriptor instead.
-func (*Stage) Descriptor() ([]byte, []int) {
+// Deprecated: Use CutoverTaskResponse.ProtoReflect.Descriptor instead.
+func (*CutoverTaskResponse) Descriptor() ([]byte, []int) {
 	return file_v1_rollout_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Stage) GetName() string {
-	if x != nil {
-		return x.Name
+type ListTaskRunArtifactsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The parent, which owns this collection of artifacts.
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}/taskRuns/{taskRun}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+}
+
+func (x *ListTaskRunArtifactsRequest) Reset() {
+	*x = ListTaskRunArtifactsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Stage) GetUid() string {
-	if x != nil {
-		return x.Uid
+func (x *ListTaskRunArtifactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskRunArtifactsRequest) ProtoMessage() {}
+
+func (x *ListTaskRunArtifactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Stage) GetEnvironment() string {
+// Deprecated: Use ListTaskRunArtifactsRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskRunArtifactsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListTaskRunArtifactsRequest) GetParent() string {
 	if x != nil {
-		return x.Environment
+		return x.Parent
 	}
 	return ""
 }
 
-func (x *Stage) GetTitle() string {
-	if x != nil {
-		return x.Title
+type ListTaskRunArtifactsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The artifacts of the task run, the content is not set.
+	Artifacts []*TaskRunArtifact `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+}
+
+func (x *ListTaskRunArtifactsResponse) Reset() {
+	*x = ListTaskRunArtifactsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Stage) GetTasks() []*Task {
+func (x *ListTaskRunArtifactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskRunArtifactsResponse) ProtoMessage() {}
+
+func (x *ListTaskRunArtifactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskRunArtifactsResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskRunArtifactsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListTaskRunArtifactsResponse) GetArtifacts() []*TaskRunArtifact {
 	if x != nil {
-		return x.Tasks
+		return x.Artifacts
 	}
 	return nil
 }
 
-type Task struct {
+type GetTaskRunArtifactRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}
+	// The name of the artifact to retrieve.
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}/taskRuns/{taskRun}/artifacts/{artifact}
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The system-assigned, unique identifier for a resource.
-	Uid   string `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	// A UUID4 string that uniquely identifies the Spec.
-	// Could be empty if the rollout of the task does not have an associating plan.
-	SpecId string `protobuf:"bytes,4,opt,name=spec_id,json=specId,proto3" json:"spec_id,omitempty"`
-	// Status is the status of the task.
-	Status        Task_Status `protobuf:"varint,5,opt,name=status,proto3,enum=bytebase.v1.Task_Status" json:"status,omitempty"`
-	SkippedReason string      `protobuf:"bytes,15,opt,name=skipped_reason,json=skippedReason,proto3" json:"skipped_reason,omitempty"`
-	Type          Task_Type   `protobuf:"varint,6,opt,name=type,proto3,enum=bytebase.v1.Task_Type" json:"type,omitempty"`
-	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}
-	BlockedByTasks []string `protobuf:"bytes,7,rep,name=blocked_by_tasks,json=blockedByTasks,proto3" json:"blocked_by_tasks,omitempty"`
-	// Format: instances/{instance} if the task is DatabaseCreate.
-	// Format: instances/{instance}/databases/{database}
-	Target string `protobuf:"bytes,8,opt,name=target,proto3" json:"target,omitempty"`
-	// Types that are assignable to Payload:
-	//
-	//	*Task_DatabaseCreate_
-	//	*Task_DatabaseSchemaBaseline_
-	//	*Task_DatabaseSchemaUpdate_
-	//	*Task_DatabaseDataUpdate_
-	//	*Task_DatabaseBackup_
-	//	*Task_DatabaseRestoreRestore_
-	Payload isTask_Payload `protobuf_oneof:"payload"`
 }
 
-func (x *Task) Reset() {
-	*x = Task{}
+func (x *GetTaskRunArtifactRequest) Reset() {
+	*x = GetTaskRunArtifactRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[24]
+		mi := &file_v1_rollout_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Task) String() string {
+func (x *GetTaskRunArtifactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task) ProtoMessage() {}
+func (*GetTaskRunArtifactRequest) ProtoMessage() {}
 
-func (x *Task) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[24]
+func (x *GetTaskRunArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2148,210 +2454,201 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task.ProtoReflect.Descriptor instead.
-func (*Task) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetTaskRunArtifactRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRunArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Task) GetName() string {
+func (x *GetTaskRunArtifactRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Task) GetUid() string {
-	if x != nil {
-		return x.Uid
-	}
-	return ""
+type ListTaskRunLogEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The parent, which owns this collection of log entries.
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}/taskRuns/{taskRun}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The maximum number of log entries to return. The service may return fewer than this value.
+	// If unspecified, at most 1000 log entries will be returned.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListTaskRunLogEntries` call.
+	// Provide this to retrieve the log entries written after the previous call.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The maximum duration to wait for new log entries if there is none yet, capped at 30 seconds.
+	// The call returns immediately if it's not set.
+	Wait *durationpb.Duration `protobuf:"bytes,4,opt,name=wait,proto3" json:"wait,omitempty"`
 }
 
-func (x *Task) GetTitle() string {
-	if x != nil {
-		return x.Title
+func (x *ListTaskRunLogEntriesRequest) Reset() {
+	*x = ListTaskRunLogEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Task) GetSpecId() string {
-	if x != nil {
-		return x.SpecId
-	}
-	return ""
+func (x *ListTaskRunLogEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Task) GetStatus() Task_Status {
-	if x != nil {
-		return x.Status
+func (*ListTaskRunLogEntriesRequest) ProtoMessage() {}
+
+func (x *ListTaskRunLogEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return Task_STATUS_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *Task) GetSkippedReason() string {
-	if x != nil {
-		return x.SkippedReason
-	}
-	return ""
+// Deprecated: Use ListTaskRunLogEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskRunLogEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *Task) GetType() Task_Type {
+func (x *ListTaskRunLogEntriesRequest) GetParent() string {
 	if x != nil {
-		return x.Type
+		return x.Parent
 	}
-	return Task_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *Task) GetBlockedByTasks() []string {
+func (x *ListTaskRunLogEntriesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.BlockedByTasks
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *Task) GetTarget() string {
+func (x *ListTaskRunLogEntriesRequest) GetPageToken() string {
 	if x != nil {
-		return x.Target
+		return x.PageToken
 	}
 	return ""
 }
 
-func (m *Task) GetPayload() isTask_Payload {
-	if m != nil {
-		return m.Payload
+func (x *ListTaskRunLogEntriesRequest) GetWait() *durationpb.Duration {
+	if x != nil {
+		return x.Wait
 	}
 	return nil
 }
 
-func (x *Task) GetDatabaseCreate() *Task_DatabaseCreate {
-	if x, ok := x.GetPayload().(*Task_DatabaseCreate_); ok {
-		return x.DatabaseCreate
-	}
-	return nil
+type ListTaskRunLogEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The log entries in the writing order.
+	Entries []*TaskRunLogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// A token to retrieve the log entries written later. It's always set so the client can keep polling.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Whether the task run is finished, so no more log entries will be written after the returned ones.
+	Finished bool `protobuf:"varint,3,opt,name=finished,proto3" json:"finished,omitempty"`
 }
 
-func (x *Task) GetDatabaseSchemaBaseline() *Task_DatabaseSchemaBaseline {
-	if x, ok := x.GetPayload().(*Task_DatabaseSchemaBaseline_); ok {
-		return x.DatabaseSchemaBaseline
+func (x *ListTaskRunLogEntriesResponse) Reset() {
+	*x = ListTaskRunLogEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Task) GetDatabaseSchemaUpdate() *Task_DatabaseSchemaUpdate {
-	if x, ok := x.GetPayload().(*Task_DatabaseSchemaUpdate_); ok {
-		return x.DatabaseSchemaUpdate
-	}
-	return nil
+func (x *ListTaskRunLogEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Task) GetDatabaseDataUpdate() *Task_DatabaseDataUpdate {
-	if x, ok := x.GetPayload().(*Task_DatabaseDataUpdate_); ok {
-		return x.DatabaseDataUpdate
+func (*ListTaskRunLogEntriesResponse) ProtoMessage() {}
+
+func (x *ListTaskRunLogEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Task) GetDatabaseBackup() *Task_DatabaseBackup {
-	if x, ok := x.GetPayload().(*Task_DatabaseBackup_); ok {
-		return x.DatabaseBackup
-	}
-	return nil
+// Deprecated: Use ListTaskRunLogEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskRunLogEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *Task) GetDatabaseRestoreRestore() *Task_DatabaseRestoreRestore {
-	if x, ok := x.GetPayload().(*Task_DatabaseRestoreRestore_); ok {
-		return x.DatabaseRestoreRestore
+func (x *ListTaskRunLogEntriesResponse) GetEntries() []*TaskRunLogEntry {
+	if x != nil {
+		return x.Entries
 	}
 	return nil
 }
 
-type isTask_Payload interface {
-	isTask_Payload()
-}
-
-type Task_DatabaseCreate_ struct {
-	DatabaseCreate *Task_DatabaseCreate `protobuf:"bytes,9,opt,name=database_create,json=databaseCreate,proto3,oneof"`
+func (x *ListTaskRunLogEntriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
 }
 
-type Task_DatabaseSchemaBaseline_ struct {
-	DatabaseSchemaBaseline *Task_DatabaseSchemaBaseline `protobuf:"bytes,10,opt,name=database_schema_baseline,json=databaseSchemaBaseline,proto3,oneof"`
+func (x *ListTaskRunLogEntriesResponse) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
 }
 
-type Task_DatabaseSchemaUpdate_ struct {
-	DatabaseSchemaUpdate *Task_DatabaseSchemaUpdate `protobuf:"bytes,11,opt,name=database_schema_update,json=databaseSchemaUpdate,proto3,oneof"`
-}
+type PlanCheckRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type Task_DatabaseDataUpdate_ struct {
-	DatabaseDataUpdate *Task_DatabaseDataUpdate `protobuf:"bytes,12,opt,name=database_data_update,json=databaseDataUpdate,proto3,oneof"`
+	// Format: projects/{project}/plans/{plan}/planCheckRuns/{planCheckRun}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The system-assigned, unique identifier for a resource.
+	Uid    string              `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Type   PlanCheckRun_Type   `protobuf:"varint,3,opt,name=type,proto3,enum=bytebase.v1.PlanCheckRun_Type" json:"type,omitempty"`
+	Status PlanCheckRun_Status `protobuf:"varint,4,opt,name=status,proto3,enum=bytebase.v1.PlanCheckRun_Status" json:"status,omitempty"`
+	// Format: instances/{instance}/databases/{database}
+	Target string `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	// Format: project/{project}/sheets/{sheet}
+	Sheet   string                 `protobuf:"bytes,6,opt,name=sheet,proto3" json:"sheet,omitempty"`
+	Results []*PlanCheckRun_Result `protobuf:"bytes,7,rep,name=results,proto3" json:"results,omitempty"`
+	// error is set if the Status is FAILED.
+	Error      string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 }
 
-type Task_DatabaseBackup_ struct {
-	DatabaseBackup *Task_DatabaseBackup `protobuf:"bytes,13,opt,name=database_backup,json=databaseBackup,proto3,oneof"`
-}
-
-type Task_DatabaseRestoreRestore_ struct {
-	DatabaseRestoreRestore *Task_DatabaseRestoreRestore `protobuf:"bytes,14,opt,name=database_restore_restore,json=databaseRestoreRestore,proto3,oneof"`
-}
-
-func (*Task_DatabaseCreate_) isTask_Payload() {}
-
-func (*Task_DatabaseSchemaBaseline_) isTask_Payload() {}
-
-func (*Task_DatabaseSchemaUpdate_) isTask_Payload() {}
-
-func (*Task_DatabaseDataUpdate_) isTask_Payload() {}
-
-func (*Task_DatabaseBackup_) isTask_Payload() {}
-
-func (*Task_DatabaseRestoreRestore_) isTask_Payload() {}
-
-type TaskRun struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}/taskRuns/{taskRun}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The system-assigned, unique identifier for a resource.
-	Uid string `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	// Format: user/hello@world.com
-	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
-	// Format: user/hello@world.com
-	Updater    string                 `protobuf:"bytes,4,opt,name=updater,proto3" json:"updater,omitempty"`
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	Title      string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
-	Status     TaskRun_Status         `protobuf:"varint,8,opt,name=status,proto3,enum=bytebase.v1.TaskRun_Status" json:"status,omitempty"`
-	// Below are the results of a task run.
-	Detail string `protobuf:"bytes,9,opt,name=detail,proto3" json:"detail,omitempty"`
-	// The resource name of the change history
-	// Format: instances/{instance}/databases/{database}/changeHistories/{changeHistory}
-	ChangeHistory   string                  `protobuf:"bytes,10,opt,name=change_history,json=changeHistory,proto3" json:"change_history,omitempty"`
-	SchemaVersion   string                  `protobuf:"bytes,11,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
-	ExecutionStatus TaskRun_ExecutionStatus `protobuf:"varint,12,opt,name=execution_status,json=executionStatus,proto3,enum=bytebase.v1.TaskRun_ExecutionStatus" json:"execution_status,omitempty"`
-	// Last execution status update timestamp.
-	ExecutionStatusUpdateTime *timestamppb.Timestamp   `protobuf:"bytes,13,opt,name=execution_status_update_time,json=executionStatusUpdateTime,proto3" json:"execution_status_update_time,omitempty"`
-	ExecutionDetail           *TaskRun_ExecutionDetail `protobuf:"bytes,15,opt,name=execution_detail,json=executionDetail,proto3" json:"execution_detail,omitempty"`
-	StartTime                 *timestamppb.Timestamp   `protobuf:"bytes,14,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-}
-
-func (x *TaskRun) Reset() {
-	*x = TaskRun{}
+func (x *PlanCheckRun) Reset() {
+	*x = PlanCheckRun{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[25]
+		mi := &file_v1_rollout_service_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TaskRun) String() string {
+func (x *PlanCheckRun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskRun) ProtoMessage() {}
+func (*PlanCheckRun) ProtoMessage() {}
 
-func (x *TaskRun) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[25]
+func (x *PlanCheckRun) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2362,141 +2659,152 @@ func (x *TaskRun) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskRun.ProtoReflect.Descriptor instead.
-func (*TaskRun) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use PlanCheckRun.ProtoReflect.Descriptor instead.
+func (*PlanCheckRun) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *TaskRun) GetName() string {
+func (x *PlanCheckRun) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *TaskRun) GetUid() string {
+func (x *PlanCheckRun) GetUid() string {
 	if x != nil {
 		return x.Uid
 	}
 	return ""
 }
 
-func (x *TaskRun) GetCreator() string {
-	if x != nil {
-		return x.Creator
-	}
-	return ""
-}
-
-func (x *TaskRun) GetUpdater() string {
+func (x *PlanCheckRun) GetType() PlanCheckRun_Type {
 	if x != nil {
-		return x.Updater
+		return x.Type
 	}
-	return ""
+	return PlanCheckRun_TYPE_UNSPECIFIED
 }
 
-func (x *TaskRun) GetCreateTime() *timestamppb.Timestamp {
+func (x *PlanCheckRun) GetStatus() PlanCheckRun_Status {
 	if x != nil {
-		return x.CreateTime
+		return x.Status
 	}
-	return nil
+	return PlanCheckRun_STATUS_UNSPECIFIED
 }
 
-func (x *TaskRun) GetUpdateTime() *timestamppb.Timestamp {
+func (x *PlanCheckRun) GetTarget() string {
 	if x != nil {
-		return x.UpdateTime
+		return x.Target
 	}
-	return nil
+	return ""
 }
 
-func (x *TaskRun) GetTitle() string {
+func (x *PlanCheckRun) GetSheet() string {
 	if x != nil {
-		return x.Title
+		return x.Sheet
 	}
 	return ""
 }
 
-func (x *TaskRun) GetStatus() TaskRun_Status {
+func (x *PlanCheckRun) GetResults() []*PlanCheckRun_Result {
 	if x != nil {
-		return x.Status
+		return x.Results
 	}
-	return TaskRun_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *TaskRun) GetDetail() string {
+func (x *PlanCheckRun) GetError() string {
 	if x != nil {
-		return x.Detail
+		return x.Error
 	}
 	return ""
 }
 
-func (x *TaskRun) GetChangeHistory() string {
+func (x *PlanCheckRun) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ChangeHistory
+		return x.CreateTime
 	}
-	return ""
+	return nil
 }
 
-func (x *TaskRun) GetSchemaVersion() string {
-	if x != nil {
-		return x.SchemaVersion
-	}
-	return ""
+type GetRolloutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the rollout to retrieve.
+	// Format: projects/{project}/rollouts/{rollout}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *TaskRun) GetExecutionStatus() TaskRun_ExecutionStatus {
-	if x != nil {
-		return x.ExecutionStatus
+func (x *GetRolloutRequest) Reset() {
+	*x = GetRolloutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return TaskRun_EXECUTION_STATUS_UNSPECIFIED
 }
 
-func (x *TaskRun) GetExecutionStatusUpdateTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ExecutionStatusUpdateTime
-	}
-	return nil
+func (x *GetRolloutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TaskRun) GetExecutionDetail() *TaskRun_ExecutionDetail {
-	if x != nil {
-		return x.ExecutionDetail
+func (*GetRolloutRequest) ProtoMessage() {}
+
+func (x *GetRolloutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *TaskRun) GetStartTime() *timestamppb.Timestamp {
+// Deprecated: Use GetRolloutRequest.ProtoReflect.Descriptor instead.
+func (*GetRolloutRequest) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetRolloutRequest) GetName() string {
 	if x != nil {
-		return x.StartTime
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type Plan_Step struct {
+type CreateRolloutRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Specs []*Plan_Spec `protobuf:"bytes,1,rep,name=specs,proto3" json:"specs,omitempty"`
+	// The parent project where this rollout will be created.
+	// Format: projects/{project}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The plan used to create rollout.
+	Plan string `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
 }
 
-func (x *Plan_Step) Reset() {
-	*x = Plan_Step{}
+func (x *CreateRolloutRequest) Reset() {
+	*x = CreateRolloutRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[26]
+		mi := &file_v1_rollout_service_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Plan_Step) String() string {
+func (x *CreateRolloutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Plan_Step) ProtoMessage() {}
+func (*CreateRolloutRequest) ProtoMessage() {}
 
-func (x *Plan_Step) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[26]
+func (x *CreateRolloutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2507,52 +2815,54 @@ func (x *Plan_Step) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Plan_Step.ProtoReflect.Descriptor instead.
-func (*Plan_Step) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use CreateRolloutRequest.ProtoReflect.Descriptor instead.
+func (*CreateRolloutRequest) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *Plan_Step) GetSpecs() []*Plan_Spec {
+func (x *CreateRolloutRequest) GetParent() string {
 	if x != nil {
-		return x.Specs
+		return x.Parent
 	}
-	return nil
+	return ""
 }
 
-type Plan_Spec struct {
+func (x *CreateRolloutRequest) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+type PreviewRolloutRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// earliest_allowed_time the earliest execution time of the change.
-	EarliestAllowedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=earliest_allowed_time,json=earliestAllowedTime,proto3" json:"earliest_allowed_time,omitempty"`
-	// A UUID4 string that uniquely identifies the Spec.
-	Id string `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
-	// Types that are assignable to Config:
-	//
-	//	*Plan_Spec_CreateDatabaseConfig
-	//	*Plan_Spec_ChangeDatabaseConfig
-	//	*Plan_Spec_RestoreDatabaseConfig
-	Config isPlan_Spec_Config `protobuf_oneof:"config"`
+	// The name of the project.
+	// Format: projects/{project}
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// The plan used to preview rollout.
+	Plan *Plan `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
 }
 
-func (x *Plan_Spec) Reset() {
-	*x = Plan_Spec{}
+func (x *PreviewRolloutRequest) Reset() {
+	*x = PreviewRolloutRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[27]
+		mi := &file_v1_rollout_service_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Plan_Spec) String() string {
+func (x *PreviewRolloutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Plan_Spec) ProtoMessage() {}
+func (*PreviewRolloutRequest) ProtoMessage() {}
 
-func (x *Plan_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[27]
+func (x *PreviewRolloutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2563,123 +2873,118 @@ func (x *Plan_Spec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Plan_Spec.ProtoReflect.Descriptor instead.
-func (*Plan_Spec) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 1}
+// Deprecated: Use PreviewRolloutRequest.ProtoReflect.Descriptor instead.
+func (*PreviewRolloutRequest) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *Plan_Spec) GetEarliestAllowedTime() *timestamppb.Timestamp {
+func (x *PreviewRolloutRequest) GetProject() string {
 	if x != nil {
-		return x.EarliestAllowedTime
+		return x.Project
 	}
-	return nil
+	return ""
 }
 
-func (x *Plan_Spec) GetId() string {
+func (x *PreviewRolloutRequest) GetPlan() *Plan {
 	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (m *Plan_Spec) GetConfig() isPlan_Spec_Config {
-	if m != nil {
-		return m.Config
+		return x.Plan
 	}
 	return nil
 }
 
-func (x *Plan_Spec) GetCreateDatabaseConfig() *Plan_CreateDatabaseConfig {
-	if x, ok := x.GetConfig().(*Plan_Spec_CreateDatabaseConfig); ok {
-		return x.CreateDatabaseConfig
-	}
-	return nil
-}
+type PreviewPlanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Plan_Spec) GetChangeDatabaseConfig() *Plan_ChangeDatabaseConfig {
-	if x, ok := x.GetConfig().(*Plan_Spec_ChangeDatabaseConfig); ok {
-		return x.ChangeDatabaseConfig
-	}
-	return nil
+	// The name of the project.
+	// Format: projects/{project}
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// The plan to preview.
+	Plan *Plan `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
 }
 
-func (x *Plan_Spec) GetRestoreDatabaseConfig() *Plan_RestoreDatabaseConfig {
-	if x, ok := x.GetConfig().(*Plan_Spec_RestoreDatabaseConfig); ok {
-		return x.RestoreDatabaseConfig
+func (x *PreviewPlanRequest) Reset() {
+	*x = PreviewPlanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type isPlan_Spec_Config interface {
-	isPlan_Spec_Config()
+func (x *PreviewPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type Plan_Spec_CreateDatabaseConfig struct {
-	CreateDatabaseConfig *Plan_CreateDatabaseConfig `protobuf:"bytes,1,opt,name=create_database_config,json=createDatabaseConfig,proto3,oneof"`
-}
+func (*PreviewPlanRequest) ProtoMessage() {}
 
-type Plan_Spec_ChangeDatabaseConfig struct {
-	ChangeDatabaseConfig *Plan_ChangeDatabaseConfig `protobuf:"bytes,2,opt,name=change_database_config,json=changeDatabaseConfig,proto3,oneof"`
+func (x *PreviewPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type Plan_Spec_RestoreDatabaseConfig struct {
-	RestoreDatabaseConfig *Plan_RestoreDatabaseConfig `protobuf:"bytes,3,opt,name=restore_database_config,json=restoreDatabaseConfig,proto3,oneof"`
+// Deprecated: Use PreviewPlanRequest.ProtoReflect.Descriptor instead.
+func (*PreviewPlanRequest) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (*Plan_Spec_CreateDatabaseConfig) isPlan_Spec_Config() {}
-
-func (*Plan_Spec_ChangeDatabaseConfig) isPlan_Spec_Config() {}
+func (x *PreviewPlanRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
 
-func (*Plan_Spec_RestoreDatabaseConfig) isPlan_Spec_Config() {}
+func (x *PreviewPlanRequest) GetPlan() *Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
 
-type Plan_CreateDatabaseConfig struct {
+type PlanPreview struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The resource name of the instance on which the database is created.
-	// Format: instances/{instance}
-	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	// The name of the database to create.
-	Database string `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
-	// table is the name of the table, if it is not empty, Bytebase should create a table after creating the database.
-	// For example, in MongoDB, it only creates the database when we first store data in that database.
-	Table string `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
-	// character_set is the character set of the database.
-	CharacterSet string `protobuf:"bytes,4,opt,name=character_set,json=characterSet,proto3" json:"character_set,omitempty"`
-	// collation is the collation of the database.
-	Collation string `protobuf:"bytes,5,opt,name=collation,proto3" json:"collation,omitempty"`
-	// cluster is the cluster of the database. This is only applicable to ClickHouse for "ON CLUSTER <<cluster>>".
-	Cluster string `protobuf:"bytes,6,opt,name=cluster,proto3" json:"cluster,omitempty"`
-	// owner is the owner of the database. This is only applicable to Postgres for "WITH OWNER <<owner>>".
-	Owner string `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
-	// backup is the resource name of the backup.
-	// Format: instances/{instance}/databases/{database}/backups/{backup-name}
-	Backup string `protobuf:"bytes,8,opt,name=backup,proto3" json:"backup,omitempty"`
-	// The environment resource.
-	// Format: environments/prod where prod is the environment resource ID.
-	Environment string `protobuf:"bytes,9,opt,name=environment,proto3" json:"environment,omitempty"`
-	// labels of the database.
-	Labels map[string]string `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-}
-
-func (x *Plan_CreateDatabaseConfig) Reset() {
-	*x = Plan_CreateDatabaseConfig{}
+	// The rollout to be created from the plan.
+	// The targets of the tasks are resolved from the deployment config and the database groups.
+	Rollout *Rollout `protobuf:"bytes,1,opt,name=rollout,proto3" json:"rollout,omitempty"`
+	// The policies of the stage environments, one for each environment.
+	StagePolicies []*PlanPreview_StagePolicy `protobuf:"bytes,2,rep,name=stage_policies,json=stagePolicies,proto3" json:"stage_policies,omitempty"`
+	// The risk is estimated without the plan check results,
+	// the statement types are unknown and the affected rows are unlimited.
+	RiskSource Risk_Source `protobuf:"varint,3,opt,name=risk_source,json=riskSource,proto3,enum=bytebase.v1.Risk_Source" json:"risk_source,omitempty"`
+	RiskLevel  int32       `protobuf:"varint,4,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	// The approval template required by the issue, unset if no approval is required.
+	ApprovalTemplate *ApprovalTemplate `protobuf:"bytes,5,opt,name=approval_template,json=approvalTemplate,proto3" json:"approval_template,omitempty"`
+}
+
+func (x *PlanPreview) Reset() {
+	*x = PlanPreview{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[28]
+		mi := &file_v1_rollout_service_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Plan_CreateDatabaseConfig) String() string {
+func (x *PlanPreview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Plan_CreateDatabaseConfig) ProtoMessage() {}
+func (*PlanPreview) ProtoMessage() {}
 
-func (x *Plan_CreateDatabaseConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[28]
+func (x *PlanPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2690,124 +2995,150 @@ func (x *Plan_CreateDatabaseConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Plan_CreateDatabaseConfig.ProtoReflect.Descriptor instead.
-func (*Plan_CreateDatabaseConfig) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 2}
+// Deprecated: Use PlanPreview.ProtoReflect.Descriptor instead.
+func (*PlanPreview) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *Plan_CreateDatabaseConfig) GetTarget() string {
+func (x *PlanPreview) GetRollout() *Rollout {
 	if x != nil {
-		return x.Target
+		return x.Rollout
 	}
-	return ""
+	return nil
 }
 
-func (x *Plan_CreateDatabaseConfig) GetDatabase() string {
+func (x *PlanPreview) GetStagePolicies() []*PlanPreview_StagePolicy {
 	if x != nil {
-		return x.Database
+		return x.StagePolicies
 	}
-	return ""
+	return nil
 }
 
-func (x *Plan_CreateDatabaseConfig) GetTable() string {
+func (x *PlanPreview) GetRiskSource() Risk_Source {
 	if x != nil {
-		return x.Table
+		return x.RiskSource
 	}
-	return ""
+	return Risk_SOURCE_UNSPECIFIED
 }
 
-func (x *Plan_CreateDatabaseConfig) GetCharacterSet() string {
+func (x *PlanPreview) GetRiskLevel() int32 {
 	if x != nil {
-		return x.CharacterSet
+		return x.RiskLevel
 	}
-	return ""
+	return 0
 }
 
-func (x *Plan_CreateDatabaseConfig) GetCollation() string {
+func (x *PlanPreview) GetApprovalTemplate() *ApprovalTemplate {
 	if x != nil {
-		return x.Collation
+		return x.ApprovalTemplate
 	}
-	return ""
+	return nil
 }
 
-func (x *Plan_CreateDatabaseConfig) GetCluster() string {
-	if x != nil {
-		return x.Cluster
+type ListTaskRunsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The parent, which owns this collection of plans.
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}
+	// Use "projects/{project}/rollouts/{rollout}/stages/-/tasks/-" to list all taskRuns from a rollout.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The maximum number of taskRuns to return. The service may return fewer than
+	// this value.
+	// If unspecified, all taskRuns will be returned.
+	// The maximum value is 1000; values above 1000 will be coerced to 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListRolloutTaskRuns` call.
+	// Provide this to retrieve the subsequent page.
+	//
+	// When paginating, all other parameters provided to `ListRolloutTaskRuns` must match
+	// the call that provided the page token.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListTaskRunsRequest) Reset() {
+	*x = ListTaskRunsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Plan_CreateDatabaseConfig) GetOwner() string {
-	if x != nil {
-		return x.Owner
+func (x *ListTaskRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskRunsRequest) ProtoMessage() {}
+
+func (x *ListTaskRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Plan_CreateDatabaseConfig) GetBackup() string {
+// Deprecated: Use ListTaskRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskRunsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListTaskRunsRequest) GetParent() string {
 	if x != nil {
-		return x.Backup
+		return x.Parent
 	}
 	return ""
 }
 
-func (x *Plan_CreateDatabaseConfig) GetEnvironment() string {
+func (x *ListTaskRunsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Environment
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *Plan_CreateDatabaseConfig) GetLabels() map[string]string {
+func (x *ListTaskRunsRequest) GetPageToken() string {
 	if x != nil {
-		return x.Labels
+		return x.PageToken
 	}
-	return nil
+	return ""
 }
 
-type Plan_ChangeDatabaseConfig struct {
+type ListTaskRunsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The resource name of the target.
-	// Format: instances/{instance-id}/databases/{database-name}.
-	// Format: projects/{project}/databaseGroups/{databaseGroup}.
-	// Format: projects/{project}/deploymentConfigs/default. The plan should
-	// have a single step and single spec for the deployment configuration type.
-	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	// The resource name of the sheet.
-	// Format: projects/{project}/sheets/{sheet}
-	Sheet string                         `protobuf:"bytes,2,opt,name=sheet,proto3" json:"sheet,omitempty"`
-	Type  Plan_ChangeDatabaseConfig_Type `protobuf:"varint,3,opt,name=type,proto3,enum=bytebase.v1.Plan_ChangeDatabaseConfig_Type" json:"type,omitempty"`
-	// schema_version is parsed from VCS file name.
-	// It is automatically generated in the UI workflow.
-	SchemaVersion string `protobuf:"bytes,4,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
-	// If RollbackEnabled, build the RollbackSheetID of the task after the task is completed.
-	RollbackEnabled bool                                      `protobuf:"varint,5,opt,name=rollback_enabled,json=rollbackEnabled,proto3" json:"rollback_enabled,omitempty"`
-	RollbackDetail  *Plan_ChangeDatabaseConfig_RollbackDetail `protobuf:"bytes,6,opt,name=rollback_detail,json=rollbackDetail,proto3,oneof" json:"rollback_detail,omitempty"`
-	GhostFlags      map[string]string                         `protobuf:"bytes,7,rep,name=ghost_flags,json=ghostFlags,proto3" json:"ghost_flags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// If set, a backup of the modified data will be created automatically before any changes are applied.
-	PreUpdateBackupDetail *Plan_ChangeDatabaseConfig_PreUpdateBackupDetail `protobuf:"bytes,8,opt,name=pre_update_backup_detail,json=preUpdateBackupDetail,proto3,oneof" json:"pre_update_backup_detail,omitempty"`
+	// The taskRuns from the specified request.
+	TaskRuns []*TaskRun `protobuf:"bytes,1,rep,name=task_runs,json=taskRuns,proto3" json:"task_runs,omitempty"`
+	// A token, which can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *Plan_ChangeDatabaseConfig) Reset() {
-	*x = Plan_ChangeDatabaseConfig{}
+func (x *ListTaskRunsResponse) Reset() {
+	*x = ListTaskRunsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[29]
+		mi := &file_v1_rollout_service_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Plan_ChangeDatabaseConfig) String() string {
+func (x *ListTaskRunsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Plan_ChangeDatabaseConfig) ProtoMessage() {}
+func (*ListTaskRunsResponse) ProtoMessage() {}
 
-func (x *Plan_ChangeDatabaseConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[29]
+func (x *ListTaskRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2818,105 +3149,148 @@ func (x *Plan_ChangeDatabaseConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Plan_ChangeDatabaseConfig.ProtoReflect.Descriptor instead.
-func (*Plan_ChangeDatabaseConfig) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 3}
+// Deprecated: Use ListTaskRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskRunsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *Plan_ChangeDatabaseConfig) GetTarget() string {
+func (x *ListTaskRunsResponse) GetTaskRuns() []*TaskRun {
 	if x != nil {
-		return x.Target
+		return x.TaskRuns
 	}
-	return ""
+	return nil
 }
 
-func (x *Plan_ChangeDatabaseConfig) GetSheet() string {
+func (x *ListTaskRunsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Sheet
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *Plan_ChangeDatabaseConfig) GetType() Plan_ChangeDatabaseConfig_Type {
-	if x != nil {
-		return x.Type
-	}
-	return Plan_ChangeDatabaseConfig_TYPE_UNSPECIFIED
-}
+type Rollout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Plan_ChangeDatabaseConfig) GetSchemaVersion() string {
+	// The resource name of the rollout.
+	// Format: projects/{project}/rollouts/{rollout}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The system-assigned, unique identifier for a resource.
+	Uid string `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// The plan that this rollout is based on.
+	// Format: projects/{project}/plans/{plan}
+	Plan  string `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan,omitempty"`
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// stages and thus tasks of the rollout.
+	Stages []*Stage `protobuf:"bytes,5,rep,name=stages,proto3" json:"stages,omitempty"`
+}
+
+func (x *Rollout) Reset() {
+	*x = Rollout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rollout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rollout) ProtoMessage() {}
+
+func (x *Rollout) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rollout.ProtoReflect.Descriptor instead.
+func (*Rollout) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Rollout) GetName() string {
 	if x != nil {
-		return x.SchemaVersion
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Plan_ChangeDatabaseConfig) GetRollbackEnabled() bool {
+func (x *Rollout) GetUid() string {
 	if x != nil {
-		return x.RollbackEnabled
+		return x.Uid
 	}
-	return false
+	return ""
 }
 
-func (x *Plan_ChangeDatabaseConfig) GetRollbackDetail() *Plan_ChangeDatabaseConfig_RollbackDetail {
+func (x *Rollout) GetPlan() string {
 	if x != nil {
-		return x.RollbackDetail
+		return x.Plan
 	}
-	return nil
+	return ""
 }
 
-func (x *Plan_ChangeDatabaseConfig) GetGhostFlags() map[string]string {
+func (x *Rollout) GetTitle() string {
 	if x != nil {
-		return x.GhostFlags
+		return x.Title
 	}
-	return nil
+	return ""
 }
 
-func (x *Plan_ChangeDatabaseConfig) GetPreUpdateBackupDetail() *Plan_ChangeDatabaseConfig_PreUpdateBackupDetail {
+func (x *Rollout) GetStages() []*Stage {
 	if x != nil {
-		return x.PreUpdateBackupDetail
+		return x.Stages
 	}
 	return nil
 }
 
-type Plan_RestoreDatabaseConfig struct {
+type Stage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The resource name of the target to restore.
-	// Format: instances/{instance}/databases/{database}
-	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	// create_database_config is present if the user wants to restore to a new database.
-	CreateDatabaseConfig *Plan_CreateDatabaseConfig `protobuf:"bytes,2,opt,name=create_database_config,json=createDatabaseConfig,proto3,oneof" json:"create_database_config,omitempty"`
-	// source determines how to restore the database.
-	// 1. from a backup
-	// 2. from a point in time
-	//
-	// Types that are assignable to Source:
-	//
-	//	*Plan_RestoreDatabaseConfig_Backup
-	//	*Plan_RestoreDatabaseConfig_PointInTime
-	Source isPlan_RestoreDatabaseConfig_Source `protobuf_oneof:"source"`
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The system-assigned, unique identifier for a resource.
+	Uid string `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Format: environments/{environment}
+	Environment string  `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	Title       string  `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Tasks       []*Task `protobuf:"bytes,5,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	// The approval gate of the stage from the rollout policy of the environment.
+	ApprovalGate *StageApprovalGate `protobuf:"bytes,6,opt,name=approval_gate,json=approvalGate,proto3" json:"approval_gate,omitempty"`
+	// The canary of the stage, set if the stage rolls out to the canary databases of a deployment before the next stage.
+	Canary *StageCanary `protobuf:"bytes,7,opt,name=canary,proto3" json:"canary,omitempty"`
+	// The ServiceNow change request of the stage, set if the rollout policy of the environment requires one.
+	ChangeRequest *StageChangeRequest `protobuf:"bytes,8,opt,name=change_request,json=changeRequest,proto3" json:"change_request,omitempty"`
 }
 
-func (x *Plan_RestoreDatabaseConfig) Reset() {
-	*x = Plan_RestoreDatabaseConfig{}
+func (x *Stage) Reset() {
+	*x = Stage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[30]
+		mi := &file_v1_rollout_service_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Plan_RestoreDatabaseConfig) String() string {
+func (x *Stage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Plan_RestoreDatabaseConfig) ProtoMessage() {}
+func (*Stage) ProtoMessage() {}
 
-func (x *Plan_RestoreDatabaseConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[30]
+func (x *Stage) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2927,93 +3301,96 @@ func (x *Plan_RestoreDatabaseConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Plan_RestoreDatabaseConfig.ProtoReflect.Descriptor instead.
-func (*Plan_RestoreDatabaseConfig) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 4}
+// Deprecated: Use Stage.ProtoReflect.Descriptor instead.
+func (*Stage) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *Plan_RestoreDatabaseConfig) GetTarget() string {
+func (x *Stage) GetName() string {
 	if x != nil {
-		return x.Target
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Plan_RestoreDatabaseConfig) GetCreateDatabaseConfig() *Plan_CreateDatabaseConfig {
+func (x *Stage) GetUid() string {
 	if x != nil {
-		return x.CreateDatabaseConfig
+		return x.Uid
 	}
-	return nil
+	return ""
 }
 
-func (m *Plan_RestoreDatabaseConfig) GetSource() isPlan_RestoreDatabaseConfig_Source {
-	if m != nil {
-		return m.Source
+func (x *Stage) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
 	}
-	return nil
+	return ""
 }
 
-func (x *Plan_RestoreDatabaseConfig) GetBackup() string {
-	if x, ok := x.GetSource().(*Plan_RestoreDatabaseConfig_Backup); ok {
-		return x.Backup
+func (x *Stage) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-func (x *Plan_RestoreDatabaseConfig) GetPointInTime() *timestamppb.Timestamp {
-	if x, ok := x.GetSource().(*Plan_RestoreDatabaseConfig_PointInTime); ok {
-		return x.PointInTime
+func (x *Stage) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
 	}
 	return nil
 }
 
-type isPlan_RestoreDatabaseConfig_Source interface {
-	isPlan_RestoreDatabaseConfig_Source()
+func (x *Stage) GetApprovalGate() *StageApprovalGate {
+	if x != nil {
+		return x.ApprovalGate
+	}
+	return nil
 }
 
-type Plan_RestoreDatabaseConfig_Backup struct {
-	Backup string `protobuf:"bytes,3,opt,name=backup,proto3,oneof"`
+func (x *Stage) GetCanary() *StageCanary {
+	if x != nil {
+		return x.Canary
+	}
+	return nil
 }
 
-type Plan_RestoreDatabaseConfig_PointInTime struct {
-	// After the PITR operations, the database will be recovered to the state at this time.
-	PointInTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=point_in_time,json=pointInTime,proto3,oneof"`
+func (x *Stage) GetChangeRequest() *StageChangeRequest {
+	if x != nil {
+		return x.ChangeRequest
+	}
+	return nil
 }
 
-func (*Plan_RestoreDatabaseConfig_Backup) isPlan_RestoreDatabaseConfig_Source() {}
-
-func (*Plan_RestoreDatabaseConfig_PointInTime) isPlan_RestoreDatabaseConfig_Source() {}
-
-type Plan_ChangeDatabaseConfig_RollbackDetail struct {
+type StageChangeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// rollback_from_task is the task from which the rollback SQL statement is generated for this task.
-	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}
-	RollbackFromTask string `protobuf:"bytes,1,opt,name=rollback_from_task,json=rollbackFromTask,proto3" json:"rollback_from_task,omitempty"`
-	// rollback_from_issue is the issue containing the original task from which the rollback SQL statement is generated for this task.
-	// Format: projects/{project}/issues/{issue}
-	RollbackFromIssue string `protobuf:"bytes,2,opt,name=rollback_from_issue,json=rollbackFromIssue,proto3" json:"rollback_from_issue,omitempty"`
+	// The number of the change request, e.g. "CHG0030001", empty until it's opened.
+	Number string                    `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	Status StageChangeRequest_Status `protobuf:"varint,2,opt,name=status,proto3,enum=bytebase.v1.StageChangeRequest_Status" json:"status,omitempty"`
+	// The error of the last call to ServiceNow.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *Plan_ChangeDatabaseConfig_RollbackDetail) Reset() {
-	*x = Plan_ChangeDatabaseConfig_RollbackDetail{}
+func (x *StageChangeRequest) Reset() {
+	*x = StageChangeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[32]
+		mi := &file_v1_rollout_service_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Plan_ChangeDatabaseConfig_RollbackDetail) String() string {
+func (x *StageChangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Plan_ChangeDatabaseConfig_RollbackDetail) ProtoMessage() {}
+func (*StageChangeRequest) ProtoMessage() {}
 
-func (x *Plan_ChangeDatabaseConfig_RollbackDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[32]
+func (x *StageChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3024,52 +3401,62 @@ func (x *Plan_ChangeDatabaseConfig_RollbackDetail) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Plan_ChangeDatabaseConfig_RollbackDetail.ProtoReflect.Descriptor instead.
-func (*Plan_ChangeDatabaseConfig_RollbackDetail) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 3, 0}
+// Deprecated: Use StageChangeRequest.ProtoReflect.Descriptor instead.
+func (*StageChangeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *Plan_ChangeDatabaseConfig_RollbackDetail) GetRollbackFromTask() string {
+func (x *StageChangeRequest) GetNumber() string {
 	if x != nil {
-		return x.RollbackFromTask
+		return x.Number
 	}
 	return ""
 }
 
-func (x *Plan_ChangeDatabaseConfig_RollbackDetail) GetRollbackFromIssue() string {
+func (x *StageChangeRequest) GetStatus() StageChangeRequest_Status {
 	if x != nil {
-		return x.RollbackFromIssue
+		return x.Status
+	}
+	return StageChangeRequest_STATUS_UNSPECIFIED
+}
+
+func (x *StageChangeRequest) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-type Plan_ChangeDatabaseConfig_PreUpdateBackupDetail struct {
+type StageCanary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The database for keeping the backup data.
-	// Format: instances/{instance}/databases/{database}
-	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	BakeDuration         *durationpb.Duration `protobuf:"bytes,1,opt,name=bake_duration,json=bakeDuration,proto3" json:"bake_duration,omitempty"`
+	MaxFailurePercentage int32                `protobuf:"varint,2,opt,name=max_failure_percentage,json=maxFailurePercentage,proto3" json:"max_failure_percentage,omitempty"`
+	PostCheckStatements  []string             `protobuf:"bytes,3,rep,name=post_check_statements,json=postCheckStatements,proto3" json:"post_check_statements,omitempty"`
+	Status               StageCanary_Status   `protobuf:"varint,4,opt,name=status,proto3,enum=bytebase.v1.StageCanary_Status" json:"status,omitempty"`
+	// The reason why the canary halts.
+	HaltReason string `protobuf:"bytes,5,opt,name=halt_reason,json=haltReason,proto3" json:"halt_reason,omitempty"`
 }
 
-func (x *Plan_ChangeDatabaseConfig_PreUpdateBackupDetail) Reset() {
-	*x = Plan_ChangeDatabaseConfig_PreUpdateBackupDetail{}
+func (x *StageCanary) Reset() {
+	*x = StageCanary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[34]
+		mi := &file_v1_rollout_service_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Plan_ChangeDatabaseConfig_PreUpdateBackupDetail) String() string {
+func (x *StageCanary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Plan_ChangeDatabaseConfig_PreUpdateBackupDetail) ProtoMessage() {}
+func (*StageCanary) ProtoMessage() {}
 
-func (x *Plan_ChangeDatabaseConfig_PreUpdateBackupDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[34]
+func (x *StageCanary) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3080,51 +3467,74 @@ func (x *Plan_ChangeDatabaseConfig_PreUpdateBackupDetail) ProtoReflect() protore
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Plan_ChangeDatabaseConfig_PreUpdateBackupDetail.ProtoReflect.Descriptor instead.
-func (*Plan_ChangeDatabaseConfig_PreUpdateBackupDetail) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 3, 2}
+// Deprecated: Use StageCanary.ProtoReflect.Descriptor instead.
+func (*StageCanary) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *Plan_ChangeDatabaseConfig_PreUpdateBackupDetail) GetDatabase() string {
+func (x *StageCanary) GetBakeDuration() *durationpb.Duration {
 	if x != nil {
-		return x.Database
+		return x.BakeDuration
+	}
+	return nil
+}
+
+func (x *StageCanary) GetMaxFailurePercentage() int32 {
+	if x != nil {
+		return x.MaxFailurePercentage
+	}
+	return 0
+}
+
+func (x *StageCanary) GetPostCheckStatements() []string {
+	if x != nil {
+		return x.PostCheckStatements
+	}
+	return nil
+}
+
+func (x *StageCanary) GetStatus() StageCanary_Status {
+	if x != nil {
+		return x.Status
+	}
+	return StageCanary_STATUS_UNSPECIFIED
+}
+
+func (x *StageCanary) GetHaltReason() string {
+	if x != nil {
+		return x.HaltReason
 	}
 	return ""
 }
 
-type PlanCheckRun_Result struct {
+type StageApprovalGate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status  PlanCheckRun_Result_Status `protobuf:"varint,1,opt,name=status,proto3,enum=bytebase.v1.PlanCheckRun_Result_Status" json:"status,omitempty"`
-	Title   string                     `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content string                     `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	Code    int32                      `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
-	// Types that are assignable to Report:
-	//
-	//	*PlanCheckRun_Result_SqlSummaryReport_
-	//	*PlanCheckRun_Result_SqlReviewReport_
-	Report isPlanCheckRun_Result_Report `protobuf_oneof:"report"`
+	// The roles required to sign off the stage before its tasks are scheduled.
+	// Format: roles/{role}
+	Roles     []string                      `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	Approvals []*StageApprovalGate_Approval `protobuf:"bytes,2,rep,name=approvals,proto3" json:"approvals,omitempty"`
 }
 
-func (x *PlanCheckRun_Result) Reset() {
-	*x = PlanCheckRun_Result{}
+func (x *StageApprovalGate) Reset() {
+	*x = StageApprovalGate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[35]
+		mi := &file_v1_rollout_service_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PlanCheckRun_Result) String() string {
+func (x *StageApprovalGate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlanCheckRun_Result) ProtoMessage() {}
+func (*StageApprovalGate) ProtoMessage() {}
 
-func (x *PlanCheckRun_Result) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[35]
+func (x *StageApprovalGate) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3135,105 +3545,76 @@ func (x *PlanCheckRun_Result) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlanCheckRun_Result.ProtoReflect.Descriptor instead.
-func (*PlanCheckRun_Result) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{16, 0}
+// Deprecated: Use StageApprovalGate.ProtoReflect.Descriptor instead.
+func (*StageApprovalGate) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *PlanCheckRun_Result) GetStatus() PlanCheckRun_Result_Status {
+func (x *StageApprovalGate) GetRoles() []string {
 	if x != nil {
-		return x.Status
+		return x.Roles
 	}
-	return PlanCheckRun_Result_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *PlanCheckRun_Result) GetTitle() string {
+func (x *StageApprovalGate) GetApprovals() []*StageApprovalGate_Approval {
 	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *PlanCheckRun_Result) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
-}
-
-func (x *PlanCheckRun_Result) GetCode() int32 {
-	if x != nil {
-		return x.Code
-	}
-	return 0
-}
-
-func (m *PlanCheckRun_Result) GetReport() isPlanCheckRun_Result_Report {
-	if m != nil {
-		return m.Report
-	}
-	return nil
-}
-
-func (x *PlanCheckRun_Result) GetSqlSummaryReport() *PlanCheckRun_Result_SqlSummaryReport {
-	if x, ok := x.GetReport().(*PlanCheckRun_Result_SqlSummaryReport_); ok {
-		return x.SqlSummaryReport
-	}
-	return nil
-}
-
-func (x *PlanCheckRun_Result) GetSqlReviewReport() *PlanCheckRun_Result_SqlReviewReport {
-	if x, ok := x.GetReport().(*PlanCheckRun_Result_SqlReviewReport_); ok {
-		return x.SqlReviewReport
+		return x.Approvals
 	}
 	return nil
 }
 
-type isPlanCheckRun_Result_Report interface {
-	isPlanCheckRun_Result_Report()
-}
-
-type PlanCheckRun_Result_SqlSummaryReport_ struct {
-	SqlSummaryReport *PlanCheckRun_Result_SqlSummaryReport `protobuf:"bytes,5,opt,name=sql_summary_report,json=sqlSummaryReport,proto3,oneof"`
-}
-
-type PlanCheckRun_Result_SqlReviewReport_ struct {
-	SqlReviewReport *PlanCheckRun_Result_SqlReviewReport `protobuf:"bytes,6,opt,name=sql_review_report,json=sqlReviewReport,proto3,oneof"`
-}
-
-func (*PlanCheckRun_Result_SqlSummaryReport_) isPlanCheckRun_Result_Report() {}
-
-func (*PlanCheckRun_Result_SqlReviewReport_) isPlanCheckRun_Result_Report() {}
-
-type PlanCheckRun_Result_SqlSummaryReport struct {
+type Task struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code int32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	// statement_types are the types of statements that are found in the sql.
-	StatementTypes   []string          `protobuf:"bytes,2,rep,name=statement_types,json=statementTypes,proto3" json:"statement_types,omitempty"`
-	AffectedRows     int32             `protobuf:"varint,3,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
-	ChangedResources *ChangedResources `protobuf:"bytes,4,opt,name=changed_resources,json=changedResources,proto3" json:"changed_resources,omitempty"`
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The system-assigned, unique identifier for a resource.
+	Uid   string `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// A UUID4 string that uniquely identifies the Spec.
+	// Could be empty if the rollout of the task does not have an associating plan.
+	SpecId string `protobuf:"bytes,4,opt,name=spec_id,json=specId,proto3" json:"spec_id,omitempty"`
+	// Status is the status of the task.
+	Status        Task_Status `protobuf:"varint,5,opt,name=status,proto3,enum=bytebase.v1.Task_Status" json:"status,omitempty"`
+	SkippedReason string      `protobuf:"bytes,15,opt,name=skipped_reason,json=skippedReason,proto3" json:"skipped_reason,omitempty"`
+	Type          Task_Type   `protobuf:"varint,6,opt,name=type,proto3,enum=bytebase.v1.Task_Type" json:"type,omitempty"`
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}
+	BlockedByTasks []string `protobuf:"bytes,7,rep,name=blocked_by_tasks,json=blockedByTasks,proto3" json:"blocked_by_tasks,omitempty"`
+	// Format: instances/{instance} if the task is DatabaseCreate.
+	// Format: instances/{instance}/databases/{database}
+	Target string `protobuf:"bytes,8,opt,name=target,proto3" json:"target,omitempty"`
+	// Types that are assignable to Payload:
+	//	*Task_DatabaseCreate_
+	//	*Task_DatabaseSchemaBaseline_
+	//	*Task_DatabaseSchemaUpdate_
+	//	*Task_DatabaseDataUpdate_
+	//	*Task_DatabaseBackup_
+	//	*Task_DatabaseRestoreRestore_
+	//	*Task_DatabaseDataProvision_
+	//	*Task_DatabaseDataMigrate_
+	Payload isTask_Payload `protobuf_oneof:"payload"`
 }
 
-func (x *PlanCheckRun_Result_SqlSummaryReport) Reset() {
-	*x = PlanCheckRun_Result_SqlSummaryReport{}
+func (x *Task) Reset() {
+	*x = Task{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[36]
+		mi := &file_v1_rollout_service_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PlanCheckRun_Result_SqlSummaryReport) String() string {
+func (x *Task) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlanCheckRun_Result_SqlSummaryReport) ProtoMessage() {}
+func (*Task) ProtoMessage() {}
 
-func (x *PlanCheckRun_Result_SqlSummaryReport) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[36]
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3244,293 +3625,238 @@ func (x *PlanCheckRun_Result_SqlSummaryReport) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlanCheckRun_Result_SqlSummaryReport.ProtoReflect.Descriptor instead.
-func (*PlanCheckRun_Result_SqlSummaryReport) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{16, 0, 0}
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *PlanCheckRun_Result_SqlSummaryReport) GetCode() int32 {
+func (x *Task) GetName() string {
 	if x != nil {
-		return x.Code
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *PlanCheckRun_Result_SqlSummaryReport) GetStatementTypes() []string {
+func (x *Task) GetUid() string {
 	if x != nil {
-		return x.StatementTypes
+		return x.Uid
 	}
-	return nil
+	return ""
 }
 
-func (x *PlanCheckRun_Result_SqlSummaryReport) GetAffectedRows() int32 {
+func (x *Task) GetTitle() string {
 	if x != nil {
-		return x.AffectedRows
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *PlanCheckRun_Result_SqlSummaryReport) GetChangedResources() *ChangedResources {
+func (x *Task) GetSpecId() string {
 	if x != nil {
-		return x.ChangedResources
+		return x.SpecId
 	}
-	return nil
-}
-
-type PlanCheckRun_Result_SqlReviewReport struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Line   int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
-	Column int32  `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
-	Detail string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
-	// Code from sql review.
-	Code int32 `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	return ""
 }
 
-func (x *PlanCheckRun_Result_SqlReviewReport) Reset() {
-	*x = PlanCheckRun_Result_SqlReviewReport{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[37]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Task) GetStatus() Task_Status {
+	if x != nil {
+		return x.Status
 	}
+	return Task_STATUS_UNSPECIFIED
 }
 
-func (x *PlanCheckRun_Result_SqlReviewReport) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PlanCheckRun_Result_SqlReviewReport) ProtoMessage() {}
-
-func (x *PlanCheckRun_Result_SqlReviewReport) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[37]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Task) GetSkippedReason() string {
+	if x != nil {
+		return x.SkippedReason
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PlanCheckRun_Result_SqlReviewReport.ProtoReflect.Descriptor instead.
-func (*PlanCheckRun_Result_SqlReviewReport) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{16, 0, 1}
+	return ""
 }
 
-func (x *PlanCheckRun_Result_SqlReviewReport) GetLine() int32 {
+func (x *Task) GetType() Task_Type {
 	if x != nil {
-		return x.Line
+		return x.Type
 	}
-	return 0
+	return Task_TYPE_UNSPECIFIED
 }
 
-func (x *PlanCheckRun_Result_SqlReviewReport) GetColumn() int32 {
+func (x *Task) GetBlockedByTasks() []string {
 	if x != nil {
-		return x.Column
+		return x.BlockedByTasks
 	}
-	return 0
+	return nil
 }
 
-func (x *PlanCheckRun_Result_SqlReviewReport) GetDetail() string {
+func (x *Task) GetTarget() string {
 	if x != nil {
-		return x.Detail
+		return x.Target
 	}
 	return ""
 }
 
-func (x *PlanCheckRun_Result_SqlReviewReport) GetCode() int32 {
-	if x != nil {
-		return x.Code
+func (m *Task) GetPayload() isTask_Payload {
+	if m != nil {
+		return m.Payload
 	}
-	return 0
+	return nil
 }
 
-type Task_DatabaseCreate struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The project owning the database.
-	// Format: projects/{project}
-	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
-	// database name
-	Database string `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
-	// table name
-	Table string `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
-	// Format: projects/{project}/sheets/{sheet}
-	Sheet        string            `protobuf:"bytes,4,opt,name=sheet,proto3" json:"sheet,omitempty"`
-	CharacterSet string            `protobuf:"bytes,5,opt,name=character_set,json=characterSet,proto3" json:"character_set,omitempty"`
-	Collation    string            `protobuf:"bytes,6,opt,name=collation,proto3" json:"collation,omitempty"`
-	Environment  string            `protobuf:"bytes,7,opt,name=environment,proto3" json:"environment,omitempty"`
-	Labels       map[string]string `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (x *Task) GetDatabaseCreate() *Task_DatabaseCreate {
+	if x, ok := x.GetPayload().(*Task_DatabaseCreate_); ok {
+		return x.DatabaseCreate
+	}
+	return nil
 }
 
-func (x *Task_DatabaseCreate) Reset() {
-	*x = Task_DatabaseCreate{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[38]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Task) GetDatabaseSchemaBaseline() *Task_DatabaseSchemaBaseline {
+	if x, ok := x.GetPayload().(*Task_DatabaseSchemaBaseline_); ok {
+		return x.DatabaseSchemaBaseline
 	}
+	return nil
 }
 
-func (x *Task_DatabaseCreate) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Task) GetDatabaseSchemaUpdate() *Task_DatabaseSchemaUpdate {
+	if x, ok := x.GetPayload().(*Task_DatabaseSchemaUpdate_); ok {
+		return x.DatabaseSchemaUpdate
+	}
+	return nil
 }
 
-func (*Task_DatabaseCreate) ProtoMessage() {}
+func (x *Task) GetDatabaseDataUpdate() *Task_DatabaseDataUpdate {
+	if x, ok := x.GetPayload().(*Task_DatabaseDataUpdate_); ok {
+		return x.DatabaseDataUpdate
+	}
+	return nil
+}
 
-func (x *Task_DatabaseCreate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[38]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Task) GetDatabaseBackup() *Task_DatabaseBackup {
+	if x, ok := x.GetPayload().(*Task_DatabaseBackup_); ok {
+		return x.DatabaseBackup
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Task_DatabaseCreate.ProtoReflect.Descriptor instead.
-func (*Task_DatabaseCreate) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{24, 0}
-}
-
-func (x *Task_DatabaseCreate) GetProject() string {
-	if x != nil {
-		return x.Project
+func (x *Task) GetDatabaseRestoreRestore() *Task_DatabaseRestoreRestore {
+	if x, ok := x.GetPayload().(*Task_DatabaseRestoreRestore_); ok {
+		return x.DatabaseRestoreRestore
 	}
-	return ""
+	return nil
 }
 
-func (x *Task_DatabaseCreate) GetDatabase() string {
-	if x != nil {
-		return x.Database
+func (x *Task) GetDatabaseDataProvision() *Task_DatabaseDataProvision {
+	if x, ok := x.GetPayload().(*Task_DatabaseDataProvision_); ok {
+		return x.DatabaseDataProvision
 	}
-	return ""
+	return nil
 }
 
-func (x *Task_DatabaseCreate) GetTable() string {
-	if x != nil {
-		return x.Table
+func (x *Task) GetDatabaseDataMigrate() *Task_DatabaseDataMigrate {
+	if x, ok := x.GetPayload().(*Task_DatabaseDataMigrate_); ok {
+		return x.DatabaseDataMigrate
 	}
-	return ""
+	return nil
 }
 
-func (x *Task_DatabaseCreate) GetSheet() string {
-	if x != nil {
-		return x.Sheet
-	}
-	return ""
+type isTask_Payload interface {
+	isTask_Payload()
 }
 
-func (x *Task_DatabaseCreate) GetCharacterSet() string {
-	if x != nil {
-		return x.CharacterSet
-	}
-	return ""
+type Task_DatabaseCreate_ struct {
+	DatabaseCreate *Task_DatabaseCreate `protobuf:"bytes,9,opt,name=database_create,json=databaseCreate,proto3,oneof"`
 }
 
-func (x *Task_DatabaseCreate) GetCollation() string {
-	if x != nil {
-		return x.Collation
-	}
-	return ""
+type Task_DatabaseSchemaBaseline_ struct {
+	DatabaseSchemaBaseline *Task_DatabaseSchemaBaseline `protobuf:"bytes,10,opt,name=database_schema_baseline,json=databaseSchemaBaseline,proto3,oneof"`
 }
 
-func (x *Task_DatabaseCreate) GetEnvironment() string {
-	if x != nil {
-		return x.Environment
-	}
-	return ""
+type Task_DatabaseSchemaUpdate_ struct {
+	DatabaseSchemaUpdate *Task_DatabaseSchemaUpdate `protobuf:"bytes,11,opt,name=database_schema_update,json=databaseSchemaUpdate,proto3,oneof"`
 }
 
-func (x *Task_DatabaseCreate) GetLabels() map[string]string {
-	if x != nil {
-		return x.Labels
-	}
-	return nil
+type Task_DatabaseDataUpdate_ struct {
+	DatabaseDataUpdate *Task_DatabaseDataUpdate `protobuf:"bytes,12,opt,name=database_data_update,json=databaseDataUpdate,proto3,oneof"`
 }
 
-type Task_DatabaseSchemaBaseline struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type Task_DatabaseBackup_ struct {
+	DatabaseBackup *Task_DatabaseBackup `protobuf:"bytes,13,opt,name=database_backup,json=databaseBackup,proto3,oneof"`
+}
 
-	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+type Task_DatabaseRestoreRestore_ struct {
+	DatabaseRestoreRestore *Task_DatabaseRestoreRestore `protobuf:"bytes,14,opt,name=database_restore_restore,json=databaseRestoreRestore,proto3,oneof"`
 }
 
-func (x *Task_DatabaseSchemaBaseline) Reset() {
-	*x = Task_DatabaseSchemaBaseline{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[39]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type Task_DatabaseDataProvision_ struct {
+	DatabaseDataProvision *Task_DatabaseDataProvision `protobuf:"bytes,16,opt,name=database_data_provision,json=databaseDataProvision,proto3,oneof"`
 }
 
-func (x *Task_DatabaseSchemaBaseline) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type Task_DatabaseDataMigrate_ struct {
+	DatabaseDataMigrate *Task_DatabaseDataMigrate `protobuf:"bytes,17,opt,name=database_data_migrate,json=databaseDataMigrate,proto3,oneof"`
 }
 
-func (*Task_DatabaseSchemaBaseline) ProtoMessage() {}
+func (*Task_DatabaseCreate_) isTask_Payload() {}
 
-func (x *Task_DatabaseSchemaBaseline) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[39]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
+func (*Task_DatabaseSchemaBaseline_) isTask_Payload() {}
 
-// Deprecated: Use Task_DatabaseSchemaBaseline.ProtoReflect.Descriptor instead.
-func (*Task_DatabaseSchemaBaseline) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{24, 1}
-}
+func (*Task_DatabaseSchemaUpdate_) isTask_Payload() {}
 
-func (x *Task_DatabaseSchemaBaseline) GetSchemaVersion() string {
-	if x != nil {
-		return x.SchemaVersion
-	}
-	return ""
-}
+func (*Task_DatabaseDataUpdate_) isTask_Payload() {}
 
-type Task_DatabaseSchemaUpdate struct {
+func (*Task_DatabaseBackup_) isTask_Payload() {}
+
+func (*Task_DatabaseRestoreRestore_) isTask_Payload() {}
+
+func (*Task_DatabaseDataProvision_) isTask_Payload() {}
+
+func (*Task_DatabaseDataMigrate_) isTask_Payload() {}
+
+type TaskRun struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Format: projects/{project}/sheets/{sheet}
-	Sheet         string `protobuf:"bytes,1,opt,name=sheet,proto3" json:"sheet,omitempty"`
-	SchemaVersion string `protobuf:"bytes,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}/taskRuns/{taskRun}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The system-assigned, unique identifier for a resource.
+	Uid string `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Format: user/hello@world.com
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Format: user/hello@world.com
+	Updater    string                 `protobuf:"bytes,4,opt,name=updater,proto3" json:"updater,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Title      string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	Status     TaskRun_Status         `protobuf:"varint,8,opt,name=status,proto3,enum=bytebase.v1.TaskRun_Status" json:"status,omitempty"`
+	// Below are the results of a task run.
+	Detail string `protobuf:"bytes,9,opt,name=detail,proto3" json:"detail,omitempty"`
+	// The resource name of the change history
+	// Format: instances/{instance}/databases/{database}/changeHistories/{changeHistory}
+	ChangeHistory   string                  `protobuf:"bytes,10,opt,name=change_history,json=changeHistory,proto3" json:"change_history,omitempty"`
+	SchemaVersion   string                  `protobuf:"bytes,11,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	ExecutionStatus TaskRun_ExecutionStatus `protobuf:"varint,12,opt,name=execution_status,json=executionStatus,proto3,enum=bytebase.v1.TaskRun_ExecutionStatus" json:"execution_status,omitempty"`
+	// Last execution status update timestamp.
+	ExecutionStatusUpdateTime *timestamppb.Timestamp   `protobuf:"bytes,13,opt,name=execution_status_update_time,json=executionStatusUpdateTime,proto3" json:"execution_status_update_time,omitempty"`
+	ExecutionDetail           *TaskRun_ExecutionDetail `protobuf:"bytes,15,opt,name=execution_detail,json=executionDetail,proto3" json:"execution_detail,omitempty"`
+	StartTime                 *timestamppb.Timestamp   `protobuf:"bytes,14,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The results of the pre-checks and post-checks of the migration.
+	CheckResults []*TaskRun_CheckResult `protobuf:"bytes,16,rep,name=check_results,json=checkResults,proto3" json:"check_results,omitempty"`
 }
 
-func (x *Task_DatabaseSchemaUpdate) Reset() {
-	*x = Task_DatabaseSchemaUpdate{}
+func (x *TaskRun) Reset() {
+	*x = TaskRun{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[40]
+		mi := &file_v1_rollout_service_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Task_DatabaseSchemaUpdate) String() string {
+func (x *TaskRun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task_DatabaseSchemaUpdate) ProtoMessage() {}
+func (*TaskRun) ProtoMessage() {}
 
-func (x *Task_DatabaseSchemaUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[40]
+func (x *TaskRun) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3541,224 +3867,160 @@ func (x *Task_DatabaseSchemaUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task_DatabaseSchemaUpdate.ProtoReflect.Descriptor instead.
-func (*Task_DatabaseSchemaUpdate) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{24, 2}
+// Deprecated: Use TaskRun.ProtoReflect.Descriptor instead.
+func (*TaskRun) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *Task_DatabaseSchemaUpdate) GetSheet() string {
+func (x *TaskRun) GetName() string {
 	if x != nil {
-		return x.Sheet
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Task_DatabaseSchemaUpdate) GetSchemaVersion() string {
+func (x *TaskRun) GetUid() string {
 	if x != nil {
-		return x.SchemaVersion
+		return x.Uid
 	}
 	return ""
 }
 
-type Task_DatabaseDataUpdate struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Format: projects/{project}/sheets/{sheet}
-	Sheet         string `protobuf:"bytes,1,opt,name=sheet,proto3" json:"sheet,omitempty"`
-	SchemaVersion string `protobuf:"bytes,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
-	// Build the rollback SQL if rollback_enabled.
-	RollbackEnabled bool `protobuf:"varint,3,opt,name=rollback_enabled,json=rollbackEnabled,proto3" json:"rollback_enabled,omitempty"`
-	// The status of the rollback SQL generation.
-	RollbackSqlStatus Task_DatabaseDataUpdate_RollbackSqlStatus `protobuf:"varint,4,opt,name=rollback_sql_status,json=rollbackSqlStatus,proto3,enum=bytebase.v1.Task_DatabaseDataUpdate_RollbackSqlStatus" json:"rollback_sql_status,omitempty"`
-	RollbackError     string                                    `protobuf:"bytes,5,opt,name=rollback_error,json=rollbackError,proto3" json:"rollback_error,omitempty"`
-	// rollback_sheet is the resource name of
-	// the sheet that stores the generated rollback SQL statement.
-	// Format: projects/{project}/sheets/{sheet}
-	RollbackSheet string `protobuf:"bytes,6,opt,name=rollback_sheet,json=rollbackSheet,proto3" json:"rollback_sheet,omitempty"`
-	// rollback_from_issue is the resource name of the issue that
-	// the rollback SQL statement is generated from.
-	// Format: projects/{project}/issues/{issue}
-	RollbackFromIssue string `protobuf:"bytes,7,opt,name=rollback_from_issue,json=rollbackFromIssue,proto3" json:"rollback_from_issue,omitempty"`
-	// rollback_from_task is the resource name of the task that
-	// the rollback SQL statement is generated from.
-	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}
-	RollbackFromTask string `protobuf:"bytes,8,opt,name=rollback_from_task,json=rollbackFromTask,proto3" json:"rollback_from_task,omitempty"`
-}
-
-func (x *Task_DatabaseDataUpdate) Reset() {
-	*x = Task_DatabaseDataUpdate{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[41]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Task_DatabaseDataUpdate) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Task_DatabaseDataUpdate) ProtoMessage() {}
-
-func (x *Task_DatabaseDataUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[41]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Task_DatabaseDataUpdate.ProtoReflect.Descriptor instead.
-func (*Task_DatabaseDataUpdate) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{24, 3}
-}
-
-func (x *Task_DatabaseDataUpdate) GetSheet() string {
+func (x *TaskRun) GetCreator() string {
 	if x != nil {
-		return x.Sheet
+		return x.Creator
 	}
 	return ""
 }
 
-func (x *Task_DatabaseDataUpdate) GetSchemaVersion() string {
+func (x *TaskRun) GetUpdater() string {
 	if x != nil {
-		return x.SchemaVersion
+		return x.Updater
 	}
 	return ""
 }
 
-func (x *Task_DatabaseDataUpdate) GetRollbackEnabled() bool {
+func (x *TaskRun) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RollbackEnabled
+		return x.CreateTime
 	}
-	return false
+	return nil
 }
 
-func (x *Task_DatabaseDataUpdate) GetRollbackSqlStatus() Task_DatabaseDataUpdate_RollbackSqlStatus {
+func (x *TaskRun) GetUpdateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RollbackSqlStatus
+		return x.UpdateTime
 	}
-	return Task_DatabaseDataUpdate_ROLLBACK_SQL_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *Task_DatabaseDataUpdate) GetRollbackError() string {
+func (x *TaskRun) GetTitle() string {
 	if x != nil {
-		return x.RollbackError
+		return x.Title
 	}
 	return ""
 }
 
-func (x *Task_DatabaseDataUpdate) GetRollbackSheet() string {
+func (x *TaskRun) GetStatus() TaskRun_Status {
 	if x != nil {
-		return x.RollbackSheet
+		return x.Status
 	}
-	return ""
+	return TaskRun_STATUS_UNSPECIFIED
 }
 
-func (x *Task_DatabaseDataUpdate) GetRollbackFromIssue() string {
+func (x *TaskRun) GetDetail() string {
 	if x != nil {
-		return x.RollbackFromIssue
+		return x.Detail
 	}
 	return ""
 }
 
-func (x *Task_DatabaseDataUpdate) GetRollbackFromTask() string {
+func (x *TaskRun) GetChangeHistory() string {
 	if x != nil {
-		return x.RollbackFromTask
+		return x.ChangeHistory
 	}
 	return ""
 }
 
-type Task_DatabaseBackup struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The resource name of the backup.
-	// Format: instances/{instance}/databases/{database}/backups/{backup-name}
-	Backup string `protobuf:"bytes,1,opt,name=backup,proto3" json:"backup,omitempty"`
+func (x *TaskRun) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
 }
 
-func (x *Task_DatabaseBackup) Reset() {
-	*x = Task_DatabaseBackup{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[42]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TaskRun) GetExecutionStatus() TaskRun_ExecutionStatus {
+	if x != nil {
+		return x.ExecutionStatus
 	}
+	return TaskRun_EXECUTION_STATUS_UNSPECIFIED
 }
 
-func (x *Task_DatabaseBackup) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TaskRun) GetExecutionStatusUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExecutionStatusUpdateTime
+	}
+	return nil
 }
 
-func (*Task_DatabaseBackup) ProtoMessage() {}
-
-func (x *Task_DatabaseBackup) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[42]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TaskRun) GetExecutionDetail() *TaskRun_ExecutionDetail {
+	if x != nil {
+		return x.ExecutionDetail
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Task_DatabaseBackup.ProtoReflect.Descriptor instead.
-func (*Task_DatabaseBackup) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{24, 4}
+func (x *TaskRun) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
 }
 
-func (x *Task_DatabaseBackup) GetBackup() string {
+func (x *TaskRun) GetCheckResults() []*TaskRun_CheckResult {
 	if x != nil {
-		return x.Backup
+		return x.CheckResults
 	}
-	return ""
+	return nil
 }
 
-type Task_DatabaseRestoreRestore struct {
+// TaskRunArtifact is a file generated by a task run, e.g. the rollback SQL statements.
+type TaskRunArtifact struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Target is only used when doing restore to a new database now.
-	// It is empty for the case of in-place restore.
-	// Target {instance} must be within the same environment as the instance of the original database.
-	// {database} is the target database name.
-	// Format: instances/{instance}/databases/database
-	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	// Types that are assignable to Source:
-	//
-	//	*Task_DatabaseRestoreRestore_Backup
-	//	*Task_DatabaseRestoreRestore_PointInTime
-	Source isTask_DatabaseRestoreRestore_Source `protobuf_oneof:"source"`
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}/taskRuns/{taskRun}/artifacts/{artifact}
+	Name string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type TaskRunArtifact_Type `protobuf:"varint,2,opt,name=type,proto3,enum=bytebase.v1.TaskRunArtifact_Type" json:"type,omitempty"`
+	// The file name of the artifact, e.g. "rollback.sql".
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// The MIME type of the content, e.g. "text/plain".
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// The size of the content in bytes.
+	Size       int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The content of the artifact, only set by GetTaskRunArtifact.
+	Content []byte `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *Task_DatabaseRestoreRestore) Reset() {
-	*x = Task_DatabaseRestoreRestore{}
+func (x *TaskRunArtifact) Reset() {
+	*x = TaskRunArtifact{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[43]
+		mi := &file_v1_rollout_service_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Task_DatabaseRestoreRestore) String() string {
+func (x *TaskRunArtifact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task_DatabaseRestoreRestore) ProtoMessage() {}
+func (*TaskRunArtifact) ProtoMessage() {}
 
-func (x *Task_DatabaseRestoreRestore) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[43]
+func (x *TaskRunArtifact) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3769,72 +4031,72 @@ func (x *Task_DatabaseRestoreRestore) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task_DatabaseRestoreRestore.ProtoReflect.Descriptor instead.
-func (*Task_DatabaseRestoreRestore) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{24, 5}
+// Deprecated: Use TaskRunArtifact.ProtoReflect.Descriptor instead.
+func (*TaskRunArtifact) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *Task_DatabaseRestoreRestore) GetTarget() string {
+func (x *TaskRunArtifact) GetName() string {
 	if x != nil {
-		return x.Target
+		return x.Name
 	}
 	return ""
 }
 
-func (m *Task_DatabaseRestoreRestore) GetSource() isTask_DatabaseRestoreRestore_Source {
-	if m != nil {
-		return m.Source
+func (x *TaskRunArtifact) GetType() TaskRunArtifact_Type {
+	if x != nil {
+		return x.Type
 	}
-	return nil
+	return TaskRunArtifact_TYPE_UNSPECIFIED
 }
 
-func (x *Task_DatabaseRestoreRestore) GetBackup() string {
-	if x, ok := x.GetSource().(*Task_DatabaseRestoreRestore_Backup); ok {
-		return x.Backup
+func (x *TaskRunArtifact) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-func (x *Task_DatabaseRestoreRestore) GetPointInTime() *timestamppb.Timestamp {
-	if x, ok := x.GetSource().(*Task_DatabaseRestoreRestore_PointInTime); ok {
-		return x.PointInTime
+func (x *TaskRunArtifact) GetContentType() string {
+	if x != nil {
+		return x.ContentType
 	}
-	return nil
+	return ""
 }
 
-type isTask_DatabaseRestoreRestore_Source interface {
-	isTask_DatabaseRestoreRestore_Source()
+func (x *TaskRunArtifact) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
 }
 
-type Task_DatabaseRestoreRestore_Backup struct {
-	// Only used when doing restore full backup only.
-	// Format: instances/{instance}/databases/{database}/backups/{backup-name}
-	Backup string `protobuf:"bytes,2,opt,name=backup,proto3,oneof"`
+func (x *TaskRunArtifact) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
 }
 
-type Task_DatabaseRestoreRestore_PointInTime struct {
-	// After the PITR operations, the database will be recovered to the state at this time.
-	PointInTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=point_in_time,json=pointInTime,proto3,oneof"`
+func (x *TaskRunArtifact) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
 }
 
-func (*Task_DatabaseRestoreRestore_Backup) isTask_DatabaseRestoreRestore_Source() {}
-
-func (*Task_DatabaseRestoreRestore_PointInTime) isTask_DatabaseRestoreRestore_Source() {}
-
-type TaskRun_ExecutionDetail struct {
+type TaskRunLogEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Currently, the following fields are only used for EXECUTING status.
-	CommandsTotal        int32                             `protobuf:"varint,1,opt,name=commands_total,json=commandsTotal,proto3" json:"commands_total,omitempty"`
-	CommandsCompleted    int32                             `protobuf:"varint,2,opt,name=commands_completed,json=commandsCompleted,proto3" json:"commands_completed,omitempty"`
-	CommandStartPosition *TaskRun_ExecutionDetail_Position `protobuf:"bytes,3,opt,name=command_start_position,json=commandStartPosition,proto3" json:"command_start_position,omitempty"`
-	CommandEndPosition   *TaskRun_ExecutionDetail_Position `protobuf:"bytes,4,opt,name=command_end_position,json=commandEndPosition,proto3" json:"command_end_position,omitempty"`
+	Level      TaskRunLogEntry_Level  `protobuf:"varint,1,opt,name=level,proto3,enum=bytebase.v1.TaskRunLogEntry_Level" json:"level,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	Content    string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *TaskRun_ExecutionDetail) Reset() {
-	*x = TaskRun_ExecutionDetail{}
+func (x *TaskRunLogEntry) Reset() {
+	*x = TaskRunLogEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_rollout_service_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3842,13 +4104,13 @@ func (x *TaskRun_ExecutionDetail) Reset() {
 	}
 }
 
-func (x *TaskRun_ExecutionDetail) String() string {
+func (x *TaskRunLogEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskRun_ExecutionDetail) ProtoMessage() {}
+func (*TaskRunLogEntry) ProtoMessage() {}
 
-func (x *TaskRun_ExecutionDetail) ProtoReflect() protoreflect.Message {
+func (x *TaskRunLogEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_rollout_service_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3860,67 +4122,117 @@ func (x *TaskRun_ExecutionDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskRun_ExecutionDetail.ProtoReflect.Descriptor instead.
-func (*TaskRun_ExecutionDetail) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{25, 0}
+// Deprecated: Use TaskRunLogEntry.ProtoReflect.Descriptor instead.
+func (*TaskRunLogEntry) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *TaskRun_ExecutionDetail) GetCommandsTotal() int32 {
+func (x *TaskRunLogEntry) GetLevel() TaskRunLogEntry_Level {
 	if x != nil {
-		return x.CommandsTotal
+		return x.Level
 	}
-	return 0
+	return TaskRunLogEntry_LEVEL_UNSPECIFIED
 }
 
-func (x *TaskRun_ExecutionDetail) GetCommandsCompleted() int32 {
+func (x *TaskRunLogEntry) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CommandsCompleted
+		return x.CreateTime
 	}
-	return 0
+	return nil
 }
 
-func (x *TaskRun_ExecutionDetail) GetCommandStartPosition() *TaskRun_ExecutionDetail_Position {
+func (x *TaskRunLogEntry) GetContent() string {
 	if x != nil {
-		return x.CommandStartPosition
+		return x.Content
 	}
-	return nil
+	return ""
 }
 
-func (x *TaskRun_ExecutionDetail) GetCommandEndPosition() *TaskRun_ExecutionDetail_Position {
+type Plan_Step struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Specs []*Plan_Spec `protobuf:"bytes,1,rep,name=specs,proto3" json:"specs,omitempty"`
+}
+
+func (x *Plan_Step) Reset() {
+	*x = Plan_Step{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Plan_Step) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan_Step) ProtoMessage() {}
+
+func (x *Plan_Step) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan_Step.ProtoReflect.Descriptor instead.
+func (*Plan_Step) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *Plan_Step) GetSpecs() []*Plan_Spec {
 	if x != nil {
-		return x.CommandEndPosition
+		return x.Specs
 	}
 	return nil
 }
 
-type TaskRun_ExecutionDetail_Position struct {
+type Plan_Spec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The line number, starting from 0.
-	Line int32 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
-	// The column number, starting from 0.
-	Column int32 `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
+	// earliest_allowed_time the earliest execution time of the change.
+	EarliestAllowedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=earliest_allowed_time,json=earliestAllowedTime,proto3" json:"earliest_allowed_time,omitempty"`
+	// A UUID4 string that uniquely identifies the Spec.
+	Id string `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	// The IDs of the specs in the same step whose tasks must be done or skipped before the tasks of this spec run.
+	// The tasks without dependencies in a stage run concurrently, and the tasks depending on a failed or canceled task are canceled.
+	DependsOnSpecs []string `protobuf:"bytes,8,rep,name=depends_on_specs,json=dependsOnSpecs,proto3" json:"depends_on_specs,omitempty"`
+	// Types that are assignable to Config:
+	//	*Plan_Spec_CreateDatabaseConfig
+	//	*Plan_Spec_ChangeDatabaseConfig
+	//	*Plan_Spec_RestoreDatabaseConfig
+	//	*Plan_Spec_ProvisionDatabaseConfig
+	//	*Plan_Spec_MigrateDataConfig
+	Config isPlan_Spec_Config `protobuf_oneof:"config"`
 }
 
-func (x *TaskRun_ExecutionDetail_Position) Reset() {
-	*x = TaskRun_ExecutionDetail_Position{}
+func (x *Plan_Spec) Reset() {
+	*x = Plan_Spec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_rollout_service_proto_msgTypes[46]
+		mi := &file_v1_rollout_service_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TaskRun_ExecutionDetail_Position) String() string {
+func (x *Plan_Spec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskRun_ExecutionDetail_Position) ProtoMessage() {}
+func (*Plan_Spec) ProtoMessage() {}
 
-func (x *TaskRun_ExecutionDetail_Position) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[46]
+func (x *Plan_Spec) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3931,754 +4243,3666 @@ func (x *TaskRun_ExecutionDetail_Position) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskRun_ExecutionDetail_Position.ProtoReflect.Descriptor instead.
-func (*TaskRun_ExecutionDetail_Position) Descriptor() ([]byte, []int) {
-	return file_v1_rollout_service_proto_rawDescGZIP(), []int{25, 0, 0}
+// Deprecated: Use Plan_Spec.ProtoReflect.Descriptor instead.
+func (*Plan_Spec) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 1}
 }
 
-func (x *TaskRun_ExecutionDetail_Position) GetLine() int32 {
+func (x *Plan_Spec) GetEarliestAllowedTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Line
+		return x.EarliestAllowedTime
 	}
-	return 0
+	return nil
 }
 
-func (x *TaskRun_ExecutionDetail_Position) GetColumn() int32 {
+func (x *Plan_Spec) GetId() string {
 	if x != nil {
-		return x.Column
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-var File_v1_rollout_service_proto protoreflect.FileDescriptor
+func (x *Plan_Spec) GetDependsOnSpecs() []string {
+	if x != nil {
+		return x.DependsOnSpecs
+	}
+	return nil
+}
 
-var file_v1_rollout_service_proto_rawDesc = []byte{
-	0x0a, 0x18, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x62, 0x79, 0x74, 0x65,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x19, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x29, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
-	0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6b, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
-	0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x64, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6c,
-	0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x79, 0x74, 0x65,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x05, 0x70, 0x6c,
-	0x61, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
-	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5c, 0x0a, 0x11, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a,
-	0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x42, 0x03,
-	0xe0, 0x41, 0x02, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x11, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2a, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0x40, 0x0a, 0x0b, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xf4, 0x11,
-	0x0a, 0x04, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x03, 0x75, 0x69,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x2c, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61,
-	0x6e, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x1a, 0x34, 0x0a,
-	0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x63, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x73, 0x70,
-	0x65, 0x63, 0x73, 0x1a, 0x93, 0x03, 0x0a, 0x04, 0x53, 0x70, 0x65, 0x63, 0x12, 0x4e, 0x0a, 0x15,
-	0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73,
-	0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x5e, 0x0a, 0x16,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62,
+func (m *Plan_Spec) GetConfig() isPlan_Spec_Config {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (x *Plan_Spec) GetCreateDatabaseConfig() *Plan_CreateDatabaseConfig {
+	if x, ok := x.GetConfig().(*Plan_Spec_CreateDatabaseConfig); ok {
+		return x.CreateDatabaseConfig
+	}
+	return nil
+}
+
+func (x *Plan_Spec) GetChangeDatabaseConfig() *Plan_ChangeDatabaseConfig {
+	if x, ok := x.GetConfig().(*Plan_Spec_ChangeDatabaseConfig); ok {
+		return x.ChangeDatabaseConfig
+	}
+	return nil
+}
+
+func (x *Plan_Spec) GetRestoreDatabaseConfig() *Plan_RestoreDatabaseConfig {
+	if x, ok := x.GetConfig().(*Plan_Spec_RestoreDatabaseConfig); ok {
+		return x.RestoreDatabaseConfig
+	}
+	return nil
+}
+
+func (x *Plan_Spec) GetProvisionDatabaseConfig() *Plan_ProvisionDatabaseConfig {
+	if x, ok := x.GetConfig().(*Plan_Spec_ProvisionDatabaseConfig); ok {
+		return x.ProvisionDatabaseConfig
+	}
+	return nil
+}
+
+func (x *Plan_Spec) GetMigrateDataConfig() *Plan_MigrateDataConfig {
+	if x, ok := x.GetConfig().(*Plan_Spec_MigrateDataConfig); ok {
+		return x.MigrateDataConfig
+	}
+	return nil
+}
+
+type isPlan_Spec_Config interface {
+	isPlan_Spec_Config()
+}
+
+type Plan_Spec_CreateDatabaseConfig struct {
+	CreateDatabaseConfig *Plan_CreateDatabaseConfig `protobuf:"bytes,1,opt,name=create_database_config,json=createDatabaseConfig,proto3,oneof"`
+}
+
+type Plan_Spec_ChangeDatabaseConfig struct {
+	ChangeDatabaseConfig *Plan_ChangeDatabaseConfig `protobuf:"bytes,2,opt,name=change_database_config,json=changeDatabaseConfig,proto3,oneof"`
+}
+
+type Plan_Spec_RestoreDatabaseConfig struct {
+	RestoreDatabaseConfig *Plan_RestoreDatabaseConfig `protobuf:"bytes,3,opt,name=restore_database_config,json=restoreDatabaseConfig,proto3,oneof"`
+}
+
+type Plan_Spec_ProvisionDatabaseConfig struct {
+	ProvisionDatabaseConfig *Plan_ProvisionDatabaseConfig `protobuf:"bytes,6,opt,name=provision_database_config,json=provisionDatabaseConfig,proto3,oneof"`
+}
+
+type Plan_Spec_MigrateDataConfig struct {
+	MigrateDataConfig *Plan_MigrateDataConfig `protobuf:"bytes,7,opt,name=migrate_data_config,json=migrateDataConfig,proto3,oneof"`
+}
+
+func (*Plan_Spec_CreateDatabaseConfig) isPlan_Spec_Config() {}
+
+func (*Plan_Spec_ChangeDatabaseConfig) isPlan_Spec_Config() {}
+
+func (*Plan_Spec_RestoreDatabaseConfig) isPlan_Spec_Config() {}
+
+func (*Plan_Spec_ProvisionDatabaseConfig) isPlan_Spec_Config() {}
+
+func (*Plan_Spec_MigrateDataConfig) isPlan_Spec_Config() {}
+
+type Plan_CreateDatabaseConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resource name of the instance on which the database is created.
+	// Format: instances/{instance}
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// The name of the database to create.
+	Database string `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	// table is the name of the table, if it is not empty, Bytebase should create a table after creating the database.
+	// For example, in MongoDB, it only creates the database when we first store data in that database.
+	Table string `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	// character_set is the character set of the database.
+	CharacterSet string `protobuf:"bytes,4,opt,name=character_set,json=characterSet,proto3" json:"character_set,omitempty"`
+	// collation is the collation of the database.
+	Collation string `protobuf:"bytes,5,opt,name=collation,proto3" json:"collation,omitempty"`
+	// cluster is the cluster of the database. This is only applicable to ClickHouse for "ON CLUSTER <<cluster>>".
+	Cluster string `protobuf:"bytes,6,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// owner is the owner of the database. This is only applicable to Postgres for "WITH OWNER <<owner>>".
+	Owner string `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	// backup is the resource name of the backup.
+	// Format: instances/{instance}/databases/{database}/backups/{backup-name}
+	Backup string `protobuf:"bytes,8,opt,name=backup,proto3" json:"backup,omitempty"`
+	// The environment resource.
+	// Format: environments/prod where prod is the environment resource ID.
+	Environment string `protobuf:"bytes,9,opt,name=environment,proto3" json:"environment,omitempty"`
+	// labels of the database.
+	Labels map[string]string `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Plan_CreateDatabaseConfig) Reset() {
+	*x = Plan_CreateDatabaseConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Plan_CreateDatabaseConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan_CreateDatabaseConfig) ProtoMessage() {}
+
+func (x *Plan_CreateDatabaseConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan_CreateDatabaseConfig.ProtoReflect.Descriptor instead.
+func (*Plan_CreateDatabaseConfig) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 2}
+}
+
+func (x *Plan_CreateDatabaseConfig) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Plan_CreateDatabaseConfig) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *Plan_CreateDatabaseConfig) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *Plan_CreateDatabaseConfig) GetCharacterSet() string {
+	if x != nil {
+		return x.CharacterSet
+	}
+	return ""
+}
+
+func (x *Plan_CreateDatabaseConfig) GetCollation() string {
+	if x != nil {
+		return x.Collation
+	}
+	return ""
+}
+
+func (x *Plan_CreateDatabaseConfig) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *Plan_CreateDatabaseConfig) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Plan_CreateDatabaseConfig) GetBackup() string {
+	if x != nil {
+		return x.Backup
+	}
+	return ""
+}
+
+func (x *Plan_CreateDatabaseConfig) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *Plan_CreateDatabaseConfig) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type Plan_ChangeDatabaseConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resource name of the target.
+	// Format: instances/{instance-id}/databases/{database-name}.
+	// Format: projects/{project}/databaseGroups/{databaseGroup}.
+	// Format: projects/{project}/deploymentConfigs/default. The plan should
+	// have a single step and single spec for the deployment configuration type.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// The resource name of the sheet.
+	// Format: projects/{project}/sheets/{sheet}
+	Sheet string                         `protobuf:"bytes,2,opt,name=sheet,proto3" json:"sheet,omitempty"`
+	Type  Plan_ChangeDatabaseConfig_Type `protobuf:"varint,3,opt,name=type,proto3,enum=bytebase.v1.Plan_ChangeDatabaseConfig_Type" json:"type,omitempty"`
+	// schema_version is parsed from VCS file name.
+	// It is automatically generated in the UI workflow.
+	SchemaVersion string `protobuf:"bytes,4,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// If RollbackEnabled, build the RollbackSheetID of the task after the task is completed.
+	RollbackEnabled bool                                      `protobuf:"varint,5,opt,name=rollback_enabled,json=rollbackEnabled,proto3" json:"rollback_enabled,omitempty"`
+	RollbackDetail  *Plan_ChangeDatabaseConfig_RollbackDetail `protobuf:"bytes,6,opt,name=rollback_detail,json=rollbackDetail,proto3,oneof" json:"rollback_detail,omitempty"`
+	GhostFlags      map[string]string                         `protobuf:"bytes,7,rep,name=ghost_flags,json=ghostFlags,proto3" json:"ghost_flags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If set, a backup of the modified data will be created automatically before any changes are applied.
+	PreUpdateBackupDetail *Plan_ChangeDatabaseConfig_PreUpdateBackupDetail `protobuf:"bytes,8,opt,name=pre_update_backup_detail,json=preUpdateBackupDetail,proto3,oneof" json:"pre_update_backup_detail,omitempty"`
+	// If set, the UPDATE and DELETE statements are split into primary key range chunks and executed chunk by chunk.
+	// A failed task resumes from the last committed chunk.
+	BatchConfig *Plan_ChangeDatabaseConfig_BatchConfig `protobuf:"bytes,9,opt,name=batch_config,json=batchConfig,proto3,oneof" json:"batch_config,omitempty"`
+	// If set, the PostgreSQL DDL statements are rewritten to the concurrent-safe equivalents and executed step by step.
+	// For example, CREATE INDEX is executed as CREATE INDEX CONCURRENTLY,
+	// and ADD CONSTRAINT is split into ADD CONSTRAINT ... NOT VALID and VALIDATE CONSTRAINT.
+	// A failed task resumes from the last completed step.
+	OnlineMigrationConfig *Plan_ChangeDatabaseConfig_OnlineMigrationConfig `protobuf:"bytes,10,opt,name=online_migration_config,json=onlineMigrationConfig,proto3,oneof" json:"online_migration_config,omitempty"`
+	// If true, the whole migration runs in a single transaction with a savepoint before each statement,
+	// and any statement failure rolls back the migration.
+	// The statements that cannot run in a transaction, e.g. CREATE INDEX CONCURRENTLY, are refused.
+	// Only supported for PostgreSQL.
+	Transactional bool `protobuf:"varint,11,opt,name=transactional,proto3" json:"transactional,omitempty"`
+	// If true, the statements are executed one by one and the checksums of the committed ones are recorded on the change history.
+	// A retry skips the statements already applied after verifying their checksums, instead of re-executing the whole migration.
+	Resumable bool `protobuf:"varint,12,opt,name=resumable,proto3" json:"resumable,omitempty"`
+	// The checks run before applying the migration. The migration isn't applied if any check fails.
+	PreChecks []*Plan_ChangeDatabaseConfig_Check `protobuf:"bytes,13,rep,name=pre_checks,json=preChecks,proto3" json:"pre_checks,omitempty"`
+	// The checks run after applying the migration. The task fails if any check fails,
+	// and the transactional migration is rolled back.
+	PostChecks []*Plan_ChangeDatabaseConfig_Check `protobuf:"bytes,14,rep,name=post_checks,json=postChecks,proto3" json:"post_checks,omitempty"`
+}
+
+func (x *Plan_ChangeDatabaseConfig) Reset() {
+	*x = Plan_ChangeDatabaseConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Plan_ChangeDatabaseConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan_ChangeDatabaseConfig) ProtoMessage() {}
+
+func (x *Plan_ChangeDatabaseConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan_ChangeDatabaseConfig.ProtoReflect.Descriptor instead.
+func (*Plan_ChangeDatabaseConfig) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 3}
+}
+
+func (x *Plan_ChangeDatabaseConfig) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Plan_ChangeDatabaseConfig) GetSheet() string {
+	if x != nil {
+		return x.Sheet
+	}
+	return ""
+}
+
+func (x *Plan_ChangeDatabaseConfig) GetType() Plan_ChangeDatabaseConfig_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Plan_ChangeDatabaseConfig_TYPE_UNSPECIFIED
+}
+
+func (x *Plan_ChangeDatabaseConfig) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *Plan_ChangeDatabaseConfig) GetRollbackEnabled() bool {
+	if x != nil {
+		return x.RollbackEnabled
+	}
+	return false
+}
+
+func (x *Plan_ChangeDatabaseConfig) GetRollbackDetail() *Plan_ChangeDatabaseConfig_RollbackDetail {
+	if x != nil {
+		return x.RollbackDetail
+	}
+	return nil
+}
+
+func (x *Plan_ChangeDatabaseConfig) GetGhostFlags() map[string]string {
+	if x != nil {
+		return x.GhostFlags
+	}
+	return nil
+}
+
+func (x *Plan_ChangeDatabaseConfig) GetPreUpdateBackupDetail() *Plan_ChangeDatabaseConfig_PreUpdateBackupDetail {
+	if x != nil {
+		return x.PreUpdateBackupDetail
+	}
+	return nil
+}
+
+func (x *Plan_ChangeDatabaseConfig) GetBatchConfig() *Plan_ChangeDatabaseConfig_BatchConfig {
+	if x != nil {
+		return x.BatchConfig
+	}
+	return nil
+}
+
+func (x *Plan_ChangeDatabaseConfig) GetOnlineMigrationConfig() *Plan_ChangeDatabaseConfig_OnlineMigrationConfig {
+	if x != nil {
+		return x.OnlineMigrationConfig
+	}
+	return nil
+}
+
+func (x *Plan_ChangeDatabaseConfig) GetTransactional() bool {
+	if x != nil {
+		return x.Transactional
+	}
+	return false
+}
+
+func (x *Plan_ChangeDatabaseConfig) GetResumable() bool {
+	if x != nil {
+		return x.Resumable
+	}
+	return false
+}
+
+func (x *Plan_ChangeDatabaseConfig) GetPreChecks() []*Plan_ChangeDatabaseConfig_Check {
+	if x != nil {
+		return x.PreChecks
+	}
+	return nil
+}
+
+func (x *Plan_ChangeDatabaseConfig) GetPostChecks() []*Plan_ChangeDatabaseConfig_Check {
+	if x != nil {
+		return x.PostChecks
+	}
+	return nil
+}
+
+type Plan_RestoreDatabaseConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resource name of the target to restore.
+	// Format: instances/{instance}/databases/{database}
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// create_database_config is present if the user wants to restore to a new database.
+	CreateDatabaseConfig *Plan_CreateDatabaseConfig `protobuf:"bytes,2,opt,name=create_database_config,json=createDatabaseConfig,proto3,oneof" json:"create_database_config,omitempty"`
+	// source determines how to restore the database.
+	// 1. from a backup
+	// 2. from a point in time
+	//
+	// Types that are assignable to Source:
+	//	*Plan_RestoreDatabaseConfig_Backup
+	//	*Plan_RestoreDatabaseConfig_PointInTime
+	Source isPlan_RestoreDatabaseConfig_Source `protobuf_oneof:"source"`
+}
+
+func (x *Plan_RestoreDatabaseConfig) Reset() {
+	*x = Plan_RestoreDatabaseConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Plan_RestoreDatabaseConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan_RestoreDatabaseConfig) ProtoMessage() {}
+
+func (x *Plan_RestoreDatabaseConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan_RestoreDatabaseConfig.ProtoReflect.Descriptor instead.
+func (*Plan_RestoreDatabaseConfig) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 4}
+}
+
+func (x *Plan_RestoreDatabaseConfig) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Plan_RestoreDatabaseConfig) GetCreateDatabaseConfig() *Plan_CreateDatabaseConfig {
+	if x != nil {
+		return x.CreateDatabaseConfig
+	}
+	return nil
+}
+
+func (m *Plan_RestoreDatabaseConfig) GetSource() isPlan_RestoreDatabaseConfig_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (x *Plan_RestoreDatabaseConfig) GetBackup() string {
+	if x, ok := x.GetSource().(*Plan_RestoreDatabaseConfig_Backup); ok {
+		return x.Backup
+	}
+	return ""
+}
+
+func (x *Plan_RestoreDatabaseConfig) GetPointInTime() *timestamppb.Timestamp {
+	if x, ok := x.GetSource().(*Plan_RestoreDatabaseConfig_PointInTime); ok {
+		return x.PointInTime
+	}
+	return nil
+}
+
+type isPlan_RestoreDatabaseConfig_Source interface {
+	isPlan_RestoreDatabaseConfig_Source()
+}
+
+type Plan_RestoreDatabaseConfig_Backup struct {
+	Backup string `protobuf:"bytes,3,opt,name=backup,proto3,oneof"`
+}
+
+type Plan_RestoreDatabaseConfig_PointInTime struct {
+	// After the PITR operations, the database will be recovered to the state at this time.
+	PointInTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=point_in_time,json=pointInTime,proto3,oneof"`
+}
+
+func (*Plan_RestoreDatabaseConfig_Backup) isPlan_RestoreDatabaseConfig_Source() {}
+
+func (*Plan_RestoreDatabaseConfig_PointInTime) isPlan_RestoreDatabaseConfig_Source() {}
+
+// ProvisionDatabaseConfig copies the data of the source database into the target database,
+// and masks the values with the masking policies of the source database on the fly.
+type Plan_ProvisionDatabaseConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resource name of the database to copy the data into, the tables must exist in the database.
+	// Format: instances/{instance}/databases/{database}
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// The resource name of the database to copy the data from.
+	// Format: instances/{instance}/databases/{database}
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// The tables to copy, all tables of the source database are copied if empty.
+	// Format: {schema}.{table} or {table} for the engines without schemas.
+	Tables []string `protobuf:"bytes,3,rep,name=tables,proto3" json:"tables,omitempty"`
+}
+
+func (x *Plan_ProvisionDatabaseConfig) Reset() {
+	*x = Plan_ProvisionDatabaseConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Plan_ProvisionDatabaseConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan_ProvisionDatabaseConfig) ProtoMessage() {}
+
+func (x *Plan_ProvisionDatabaseConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan_ProvisionDatabaseConfig.ProtoReflect.Descriptor instead.
+func (*Plan_ProvisionDatabaseConfig) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 5}
+}
+
+func (x *Plan_ProvisionDatabaseConfig) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Plan_ProvisionDatabaseConfig) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Plan_ProvisionDatabaseConfig) GetTables() []string {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+// MigrateDataConfig copies the tables of the source database into the target database in chunks.
+// The source and the target databases must be of the same engine, but could be of different versions.
+type Plan_MigrateDataConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resource name of the database to copy the data into, the tables must exist in the database.
+	// Format: instances/{instance}/databases/{database}
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// The resource name of the database to copy the data from.
+	// Format: instances/{instance}/databases/{database}
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// The tables to copy.
+	// Format: {schema}.{table} or {table} for the engines without schemas.
+	Tables []string `protobuf:"bytes,3,rep,name=tables,proto3" json:"tables,omitempty"`
+	// The number of rows copied in a transaction, the default is 1000.
+	ChunkSize int32 `protobuf:"varint,4,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	// Delete the existing rows of the target tables before copying.
+	ClearTarget bool `protobuf:"varint,5,opt,name=clear_target,json=clearTarget,proto3" json:"clear_target,omitempty"`
+}
+
+func (x *Plan_MigrateDataConfig) Reset() {
+	*x = Plan_MigrateDataConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Plan_MigrateDataConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan_MigrateDataConfig) ProtoMessage() {}
+
+func (x *Plan_MigrateDataConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan_MigrateDataConfig.ProtoReflect.Descriptor instead.
+func (*Plan_MigrateDataConfig) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 6}
+}
+
+func (x *Plan_MigrateDataConfig) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Plan_MigrateDataConfig) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Plan_MigrateDataConfig) GetTables() []string {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *Plan_MigrateDataConfig) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *Plan_MigrateDataConfig) GetClearTarget() bool {
+	if x != nil {
+		return x.ClearTarget
+	}
+	return false
+}
+
+type Plan_ChangeDatabaseConfig_RollbackDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// rollback_from_task is the task from which the rollback SQL statement is generated for this task.
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}
+	RollbackFromTask string `protobuf:"bytes,1,opt,name=rollback_from_task,json=rollbackFromTask,proto3" json:"rollback_from_task,omitempty"`
+	// rollback_from_issue is the issue containing the original task from which the rollback SQL statement is generated for this task.
+	// Format: projects/{project}/issues/{issue}
+	RollbackFromIssue string `protobuf:"bytes,2,opt,name=rollback_from_issue,json=rollbackFromIssue,proto3" json:"rollback_from_issue,omitempty"`
+}
+
+func (x *Plan_ChangeDatabaseConfig_RollbackDetail) Reset() {
+	*x = Plan_ChangeDatabaseConfig_RollbackDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Plan_ChangeDatabaseConfig_RollbackDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan_ChangeDatabaseConfig_RollbackDetail) ProtoMessage() {}
+
+func (x *Plan_ChangeDatabaseConfig_RollbackDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan_ChangeDatabaseConfig_RollbackDetail.ProtoReflect.Descriptor instead.
+func (*Plan_ChangeDatabaseConfig_RollbackDetail) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 3, 0}
+}
+
+func (x *Plan_ChangeDatabaseConfig_RollbackDetail) GetRollbackFromTask() string {
+	if x != nil {
+		return x.RollbackFromTask
+	}
+	return ""
+}
+
+func (x *Plan_ChangeDatabaseConfig_RollbackDetail) GetRollbackFromIssue() string {
+	if x != nil {
+		return x.RollbackFromIssue
+	}
+	return ""
+}
+
+type Plan_ChangeDatabaseConfig_PreUpdateBackupDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The database for keeping the backup data.
+	// Format: instances/{instance}/databases/{database}
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+}
+
+func (x *Plan_ChangeDatabaseConfig_PreUpdateBackupDetail) Reset() {
+	*x = Plan_ChangeDatabaseConfig_PreUpdateBackupDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Plan_ChangeDatabaseConfig_PreUpdateBackupDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan_ChangeDatabaseConfig_PreUpdateBackupDetail) ProtoMessage() {}
+
+func (x *Plan_ChangeDatabaseConfig_PreUpdateBackupDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan_ChangeDatabaseConfig_PreUpdateBackupDetail.ProtoReflect.Descriptor instead.
+func (*Plan_ChangeDatabaseConfig_PreUpdateBackupDetail) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 3, 2}
+}
+
+func (x *Plan_ChangeDatabaseConfig_PreUpdateBackupDetail) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+type Plan_ChangeDatabaseConfig_BatchConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum primary key range covered by each chunk.
+	BatchSize int32 `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// The interval to sleep between two chunks.
+	SleepInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=sleep_interval,json=sleepInterval,proto3" json:"sleep_interval,omitempty"`
+}
+
+func (x *Plan_ChangeDatabaseConfig_BatchConfig) Reset() {
+	*x = Plan_ChangeDatabaseConfig_BatchConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Plan_ChangeDatabaseConfig_BatchConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan_ChangeDatabaseConfig_BatchConfig) ProtoMessage() {}
+
+func (x *Plan_ChangeDatabaseConfig_BatchConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan_ChangeDatabaseConfig_BatchConfig.ProtoReflect.Descriptor instead.
+func (*Plan_ChangeDatabaseConfig_BatchConfig) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 3, 3}
+}
+
+func (x *Plan_ChangeDatabaseConfig_BatchConfig) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Plan_ChangeDatabaseConfig_BatchConfig) GetSleepInterval() *durationpb.Duration {
+	if x != nil {
+		return x.SleepInterval
+	}
+	return nil
+}
+
+type Plan_ChangeDatabaseConfig_OnlineMigrationConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether to rewrite the tables with pg_repack for VACUUM FULL, CLUSTER and ALTER TABLE ... SET TABLESPACE.
+	// The pg_repack extension must be installed in the database.
+	PgRepack bool `protobuf:"varint,1,opt,name=pg_repack,json=pgRepack,proto3" json:"pg_repack,omitempty"`
+}
+
+func (x *Plan_ChangeDatabaseConfig_OnlineMigrationConfig) Reset() {
+	*x = Plan_ChangeDatabaseConfig_OnlineMigrationConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Plan_ChangeDatabaseConfig_OnlineMigrationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan_ChangeDatabaseConfig_OnlineMigrationConfig) ProtoMessage() {}
+
+func (x *Plan_ChangeDatabaseConfig_OnlineMigrationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan_ChangeDatabaseConfig_OnlineMigrationConfig.ProtoReflect.Descriptor instead.
+func (*Plan_ChangeDatabaseConfig_OnlineMigrationConfig) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 3, 4}
+}
+
+func (x *Plan_ChangeDatabaseConfig_OnlineMigrationConfig) GetPgRepack() bool {
+	if x != nil {
+		return x.PgRepack
+	}
+	return false
+}
+
+type Plan_ChangeDatabaseConfig_Check struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The query to run against the database.
+	Statement string `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
+	// If set, the query must return exactly one row whose first column equals the value.
+	// Otherwise, the query must return zero rows.
+	ExpectedValue *string `protobuf:"bytes,2,opt,name=expected_value,json=expectedValue,proto3,oneof" json:"expected_value,omitempty"`
+}
+
+func (x *Plan_ChangeDatabaseConfig_Check) Reset() {
+	*x = Plan_ChangeDatabaseConfig_Check{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Plan_ChangeDatabaseConfig_Check) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan_ChangeDatabaseConfig_Check) ProtoMessage() {}
+
+func (x *Plan_ChangeDatabaseConfig_Check) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan_ChangeDatabaseConfig_Check.ProtoReflect.Descriptor instead.
+func (*Plan_ChangeDatabaseConfig_Check) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{5, 3, 5}
+}
+
+func (x *Plan_ChangeDatabaseConfig_Check) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *Plan_ChangeDatabaseConfig_Check) GetExpectedValue() string {
+	if x != nil && x.ExpectedValue != nil {
+		return *x.ExpectedValue
+	}
+	return ""
+}
+
+type PlanCheckRun_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  PlanCheckRun_Result_Status `protobuf:"varint,1,opt,name=status,proto3,enum=bytebase.v1.PlanCheckRun_Result_Status" json:"status,omitempty"`
+	Title   string                     `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content string                     `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Code    int32                      `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	// Types that are assignable to Report:
+	//	*PlanCheckRun_Result_SqlSummaryReport_
+	//	*PlanCheckRun_Result_SqlReviewReport_
+	//	*PlanCheckRun_Result_QueryPlanReport_
+	Report isPlanCheckRun_Result_Report `protobuf_oneof:"report"`
+}
+
+func (x *PlanCheckRun_Result) Reset() {
+	*x = PlanCheckRun_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanCheckRun_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCheckRun_Result) ProtoMessage() {}
+
+func (x *PlanCheckRun_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCheckRun_Result.ProtoReflect.Descriptor instead.
+func (*PlanCheckRun_Result) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *PlanCheckRun_Result) GetStatus() PlanCheckRun_Result_Status {
+	if x != nil {
+		return x.Status
+	}
+	return PlanCheckRun_Result_STATUS_UNSPECIFIED
+}
+
+func (x *PlanCheckRun_Result) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PlanCheckRun_Result) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PlanCheckRun_Result) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (m *PlanCheckRun_Result) GetReport() isPlanCheckRun_Result_Report {
+	if m != nil {
+		return m.Report
+	}
+	return nil
+}
+
+func (x *PlanCheckRun_Result) GetSqlSummaryReport() *PlanCheckRun_Result_SqlSummaryReport {
+	if x, ok := x.GetReport().(*PlanCheckRun_Result_SqlSummaryReport_); ok {
+		return x.SqlSummaryReport
+	}
+	return nil
+}
+
+func (x *PlanCheckRun_Result) GetSqlReviewReport() *PlanCheckRun_Result_SqlReviewReport {
+	if x, ok := x.GetReport().(*PlanCheckRun_Result_SqlReviewReport_); ok {
+		return x.SqlReviewReport
+	}
+	return nil
+}
+
+func (x *PlanCheckRun_Result) GetQueryPlanReport() *PlanCheckRun_Result_QueryPlanReport {
+	if x, ok := x.GetReport().(*PlanCheckRun_Result_QueryPlanReport_); ok {
+		return x.QueryPlanReport
+	}
+	return nil
+}
+
+type isPlanCheckRun_Result_Report interface {
+	isPlanCheckRun_Result_Report()
+}
+
+type PlanCheckRun_Result_SqlSummaryReport_ struct {
+	SqlSummaryReport *PlanCheckRun_Result_SqlSummaryReport `protobuf:"bytes,5,opt,name=sql_summary_report,json=sqlSummaryReport,proto3,oneof"`
+}
+
+type PlanCheckRun_Result_SqlReviewReport_ struct {
+	SqlReviewReport *PlanCheckRun_Result_SqlReviewReport `protobuf:"bytes,6,opt,name=sql_review_report,json=sqlReviewReport,proto3,oneof"`
+}
+
+type PlanCheckRun_Result_QueryPlanReport_ struct {
+	QueryPlanReport *PlanCheckRun_Result_QueryPlanReport `protobuf:"bytes,7,opt,name=query_plan_report,json=queryPlanReport,proto3,oneof"`
+}
+
+func (*PlanCheckRun_Result_SqlSummaryReport_) isPlanCheckRun_Result_Report() {}
+
+func (*PlanCheckRun_Result_SqlReviewReport_) isPlanCheckRun_Result_Report() {}
+
+func (*PlanCheckRun_Result_QueryPlanReport_) isPlanCheckRun_Result_Report() {}
+
+type PlanCheckRun_Result_SqlSummaryReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code int32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	// statement_types are the types of statements that are found in the sql.
+	StatementTypes   []string          `protobuf:"bytes,2,rep,name=statement_types,json=statementTypes,proto3" json:"statement_types,omitempty"`
+	AffectedRows     int32             `protobuf:"varint,3,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	ChangedResources *ChangedResources `protobuf:"bytes,4,opt,name=changed_resources,json=changedResources,proto3" json:"changed_resources,omitempty"`
+}
+
+func (x *PlanCheckRun_Result_SqlSummaryReport) Reset() {
+	*x = PlanCheckRun_Result_SqlSummaryReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanCheckRun_Result_SqlSummaryReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCheckRun_Result_SqlSummaryReport) ProtoMessage() {}
+
+func (x *PlanCheckRun_Result_SqlSummaryReport) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCheckRun_Result_SqlSummaryReport.ProtoReflect.Descriptor instead.
+func (*PlanCheckRun_Result_SqlSummaryReport) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{29, 0, 0}
+}
+
+func (x *PlanCheckRun_Result_SqlSummaryReport) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *PlanCheckRun_Result_SqlSummaryReport) GetStatementTypes() []string {
+	if x != nil {
+		return x.StatementTypes
+	}
+	return nil
+}
+
+func (x *PlanCheckRun_Result_SqlSummaryReport) GetAffectedRows() int32 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+func (x *PlanCheckRun_Result_SqlSummaryReport) GetChangedResources() *ChangedResources {
+	if x != nil {
+		return x.ChangedResources
+	}
+	return nil
+}
+
+type PlanCheckRun_Result_SqlReviewReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line   int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Column int32  `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
+	Detail string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	// Code from sql review.
+	Code int32 `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *PlanCheckRun_Result_SqlReviewReport) Reset() {
+	*x = PlanCheckRun_Result_SqlReviewReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanCheckRun_Result_SqlReviewReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCheckRun_Result_SqlReviewReport) ProtoMessage() {}
+
+func (x *PlanCheckRun_Result_SqlReviewReport) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCheckRun_Result_SqlReviewReport.ProtoReflect.Descriptor instead.
+func (*PlanCheckRun_Result_SqlReviewReport) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{29, 0, 1}
+}
+
+func (x *PlanCheckRun_Result_SqlReviewReport) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *PlanCheckRun_Result_SqlReviewReport) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *PlanCheckRun_Result_SqlReviewReport) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *PlanCheckRun_Result_SqlReviewReport) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+// QueryPlanReport compares the plans of a benchmark query before and after the migration.
+type PlanCheckRun_Result_QueryPlanReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The title of the benchmark query.
+	QueryTitle string  `protobuf:"bytes,1,opt,name=query_title,json=queryTitle,proto3" json:"query_title,omitempty"`
+	CostBefore float64 `protobuf:"fixed64,2,opt,name=cost_before,json=costBefore,proto3" json:"cost_before,omitempty"`
+	CostAfter  float64 `protobuf:"fixed64,3,opt,name=cost_after,json=costAfter,proto3" json:"cost_after,omitempty"`
+	// The tables scanned sequentially after the migration but not before.
+	NewSequentialScans []string `protobuf:"bytes,4,rep,name=new_sequential_scans,json=newSequentialScans,proto3" json:"new_sequential_scans,omitempty"`
+	// The plans in the text format of EXPLAIN.
+	PlanBefore string `protobuf:"bytes,5,opt,name=plan_before,json=planBefore,proto3" json:"plan_before,omitempty"`
+	PlanAfter  string `protobuf:"bytes,6,opt,name=plan_after,json=planAfter,proto3" json:"plan_after,omitempty"`
+}
+
+func (x *PlanCheckRun_Result_QueryPlanReport) Reset() {
+	*x = PlanCheckRun_Result_QueryPlanReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanCheckRun_Result_QueryPlanReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanCheckRun_Result_QueryPlanReport) ProtoMessage() {}
+
+func (x *PlanCheckRun_Result_QueryPlanReport) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanCheckRun_Result_QueryPlanReport.ProtoReflect.Descriptor instead.
+func (*PlanCheckRun_Result_QueryPlanReport) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{29, 0, 2}
+}
+
+func (x *PlanCheckRun_Result_QueryPlanReport) GetQueryTitle() string {
+	if x != nil {
+		return x.QueryTitle
+	}
+	return ""
+}
+
+func (x *PlanCheckRun_Result_QueryPlanReport) GetCostBefore() float64 {
+	if x != nil {
+		return x.CostBefore
+	}
+	return 0
+}
+
+func (x *PlanCheckRun_Result_QueryPlanReport) GetCostAfter() float64 {
+	if x != nil {
+		return x.CostAfter
+	}
+	return 0
+}
+
+func (x *PlanCheckRun_Result_QueryPlanReport) GetNewSequentialScans() []string {
+	if x != nil {
+		return x.NewSequentialScans
+	}
+	return nil
+}
+
+func (x *PlanCheckRun_Result_QueryPlanReport) GetPlanBefore() string {
+	if x != nil {
+		return x.PlanBefore
+	}
+	return ""
+}
+
+func (x *PlanCheckRun_Result_QueryPlanReport) GetPlanAfter() string {
+	if x != nil {
+		return x.PlanAfter
+	}
+	return ""
+}
+
+type PlanPreview_StagePolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The environment of the stage.
+	// Format: environments/{environment}
+	Environment   string         `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	RolloutPolicy *RolloutPolicy `protobuf:"bytes,2,opt,name=rollout_policy,json=rolloutPolicy,proto3" json:"rollout_policy,omitempty"`
+	// The name of the enforced SQL review policy, empty if no SQL review policy is enforced.
+	SqlReview string `protobuf:"bytes,3,opt,name=sql_review,json=sqlReview,proto3" json:"sql_review,omitempty"`
+	// The maximum estimated affected rows of the DML statements. Zero means no limit.
+	MaxAffectedRows int64 `protobuf:"varint,4,opt,name=max_affected_rows,json=maxAffectedRows,proto3" json:"max_affected_rows,omitempty"`
+}
+
+func (x *PlanPreview_StagePolicy) Reset() {
+	*x = PlanPreview_StagePolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanPreview_StagePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanPreview_StagePolicy) ProtoMessage() {}
+
+func (x *PlanPreview_StagePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanPreview_StagePolicy.ProtoReflect.Descriptor instead.
+func (*PlanPreview_StagePolicy) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{34, 0}
+}
+
+func (x *PlanPreview_StagePolicy) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *PlanPreview_StagePolicy) GetRolloutPolicy() *RolloutPolicy {
+	if x != nil {
+		return x.RolloutPolicy
+	}
+	return nil
+}
+
+func (x *PlanPreview_StagePolicy) GetSqlReview() string {
+	if x != nil {
+		return x.SqlReview
+	}
+	return ""
+}
+
+func (x *PlanPreview_StagePolicy) GetMaxAffectedRows() int64 {
+	if x != nil {
+		return x.MaxAffectedRows
+	}
+	return 0
+}
+
+type StageApprovalGate_Approval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The role signed off as.
+	// Format: roles/{role}
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// Format: users/hello@world.com
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// The task signed off, empty if the whole stage is signed off.
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}
+	Task       string                 `protobuf:"bytes,3,opt,name=task,proto3" json:"task,omitempty"`
+	Comment    string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+}
+
+func (x *StageApprovalGate_Approval) Reset() {
+	*x = StageApprovalGate_Approval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StageApprovalGate_Approval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageApprovalGate_Approval) ProtoMessage() {}
+
+func (x *StageApprovalGate_Approval) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageApprovalGate_Approval.ProtoReflect.Descriptor instead.
+func (*StageApprovalGate_Approval) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{41, 0}
+}
+
+func (x *StageApprovalGate_Approval) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *StageApprovalGate_Approval) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *StageApprovalGate_Approval) GetTask() string {
+	if x != nil {
+		return x.Task
+	}
+	return ""
+}
+
+func (x *StageApprovalGate_Approval) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *StageApprovalGate_Approval) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type Task_DatabaseCreate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The project owning the database.
+	// Format: projects/{project}
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// database name
+	Database string `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	// table name
+	Table string `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	// Format: projects/{project}/sheets/{sheet}
+	Sheet        string            `protobuf:"bytes,4,opt,name=sheet,proto3" json:"sheet,omitempty"`
+	CharacterSet string            `protobuf:"bytes,5,opt,name=character_set,json=characterSet,proto3" json:"character_set,omitempty"`
+	Collation    string            `protobuf:"bytes,6,opt,name=collation,proto3" json:"collation,omitempty"`
+	Environment  string            `protobuf:"bytes,7,opt,name=environment,proto3" json:"environment,omitempty"`
+	Labels       map[string]string `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Task_DatabaseCreate) Reset() {
+	*x = Task_DatabaseCreate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Task_DatabaseCreate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task_DatabaseCreate) ProtoMessage() {}
+
+func (x *Task_DatabaseCreate) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task_DatabaseCreate.ProtoReflect.Descriptor instead.
+func (*Task_DatabaseCreate) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{42, 0}
+}
+
+func (x *Task_DatabaseCreate) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *Task_DatabaseCreate) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *Task_DatabaseCreate) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *Task_DatabaseCreate) GetSheet() string {
+	if x != nil {
+		return x.Sheet
+	}
+	return ""
+}
+
+func (x *Task_DatabaseCreate) GetCharacterSet() string {
+	if x != nil {
+		return x.CharacterSet
+	}
+	return ""
+}
+
+func (x *Task_DatabaseCreate) GetCollation() string {
+	if x != nil {
+		return x.Collation
+	}
+	return ""
+}
+
+func (x *Task_DatabaseCreate) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *Task_DatabaseCreate) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type Task_DatabaseSchemaBaseline struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+}
+
+func (x *Task_DatabaseSchemaBaseline) Reset() {
+	*x = Task_DatabaseSchemaBaseline{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Task_DatabaseSchemaBaseline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task_DatabaseSchemaBaseline) ProtoMessage() {}
+
+func (x *Task_DatabaseSchemaBaseline) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task_DatabaseSchemaBaseline.ProtoReflect.Descriptor instead.
+func (*Task_DatabaseSchemaBaseline) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{42, 1}
+}
+
+func (x *Task_DatabaseSchemaBaseline) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+type Task_DatabaseSchemaUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Format: projects/{project}/sheets/{sheet}
+	Sheet         string `protobuf:"bytes,1,opt,name=sheet,proto3" json:"sheet,omitempty"`
+	SchemaVersion string `protobuf:"bytes,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// Build the rollback DDL from the schema snapshots if rollback_enabled.
+	RollbackEnabled bool `protobuf:"varint,3,opt,name=rollback_enabled,json=rollbackEnabled,proto3" json:"rollback_enabled,omitempty"`
+	// The status of the rollback DDL generation.
+	RollbackSqlStatus Task_DatabaseDataUpdate_RollbackSqlStatus `protobuf:"varint,4,opt,name=rollback_sql_status,json=rollbackSqlStatus,proto3,enum=bytebase.v1.Task_DatabaseDataUpdate_RollbackSqlStatus" json:"rollback_sql_status,omitempty"`
+	RollbackError     string                                    `protobuf:"bytes,5,opt,name=rollback_error,json=rollbackError,proto3" json:"rollback_error,omitempty"`
+	// rollback_sheet is the resource name of
+	// the sheet that stores the generated rollback DDL statement.
+	// Format: projects/{project}/sheets/{sheet}
+	RollbackSheet string `protobuf:"bytes,6,opt,name=rollback_sheet,json=rollbackSheet,proto3" json:"rollback_sheet,omitempty"`
+	// rollback_from_issue is the resource name of the issue that
+	// the rollback DDL statement is generated from.
+	// Format: projects/{project}/issues/{issue}
+	RollbackFromIssue string `protobuf:"bytes,7,opt,name=rollback_from_issue,json=rollbackFromIssue,proto3" json:"rollback_from_issue,omitempty"`
+	// rollback_from_task is the resource name of the task that
+	// the rollback DDL statement is generated from.
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}
+	RollbackFromTask string `protobuf:"bytes,8,opt,name=rollback_from_task,json=rollbackFromTask,proto3" json:"rollback_from_task,omitempty"`
+}
+
+func (x *Task_DatabaseSchemaUpdate) Reset() {
+	*x = Task_DatabaseSchemaUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Task_DatabaseSchemaUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task_DatabaseSchemaUpdate) ProtoMessage() {}
+
+func (x *Task_DatabaseSchemaUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task_DatabaseSchemaUpdate.ProtoReflect.Descriptor instead.
+func (*Task_DatabaseSchemaUpdate) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{42, 2}
+}
+
+func (x *Task_DatabaseSchemaUpdate) GetSheet() string {
+	if x != nil {
+		return x.Sheet
+	}
+	return ""
+}
+
+func (x *Task_DatabaseSchemaUpdate) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *Task_DatabaseSchemaUpdate) GetRollbackEnabled() bool {
+	if x != nil {
+		return x.RollbackEnabled
+	}
+	return false
+}
+
+func (x *Task_DatabaseSchemaUpdate) GetRollbackSqlStatus() Task_DatabaseDataUpdate_RollbackSqlStatus {
+	if x != nil {
+		return x.RollbackSqlStatus
+	}
+	return Task_DatabaseDataUpdate_ROLLBACK_SQL_STATUS_UNSPECIFIED
+}
+
+func (x *Task_DatabaseSchemaUpdate) GetRollbackError() string {
+	if x != nil {
+		return x.RollbackError
+	}
+	return ""
+}
+
+func (x *Task_DatabaseSchemaUpdate) GetRollbackSheet() string {
+	if x != nil {
+		return x.RollbackSheet
+	}
+	return ""
+}
+
+func (x *Task_DatabaseSchemaUpdate) GetRollbackFromIssue() string {
+	if x != nil {
+		return x.RollbackFromIssue
+	}
+	return ""
+}
+
+func (x *Task_DatabaseSchemaUpdate) GetRollbackFromTask() string {
+	if x != nil {
+		return x.RollbackFromTask
+	}
+	return ""
+}
+
+type Task_DatabaseDataUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Format: projects/{project}/sheets/{sheet}
+	Sheet         string `protobuf:"bytes,1,opt,name=sheet,proto3" json:"sheet,omitempty"`
+	SchemaVersion string `protobuf:"bytes,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// Build the rollback SQL if rollback_enabled.
+	RollbackEnabled bool `protobuf:"varint,3,opt,name=rollback_enabled,json=rollbackEnabled,proto3" json:"rollback_enabled,omitempty"`
+	// The status of the rollback SQL generation.
+	RollbackSqlStatus Task_DatabaseDataUpdate_RollbackSqlStatus `protobuf:"varint,4,opt,name=rollback_sql_status,json=rollbackSqlStatus,proto3,enum=bytebase.v1.Task_DatabaseDataUpdate_RollbackSqlStatus" json:"rollback_sql_status,omitempty"`
+	RollbackError     string                                    `protobuf:"bytes,5,opt,name=rollback_error,json=rollbackError,proto3" json:"rollback_error,omitempty"`
+	// rollback_sheet is the resource name of
+	// the sheet that stores the generated rollback SQL statement.
+	// Format: projects/{project}/sheets/{sheet}
+	RollbackSheet string `protobuf:"bytes,6,opt,name=rollback_sheet,json=rollbackSheet,proto3" json:"rollback_sheet,omitempty"`
+	// rollback_from_issue is the resource name of the issue that
+	// the rollback SQL statement is generated from.
+	// Format: projects/{project}/issues/{issue}
+	RollbackFromIssue string `protobuf:"bytes,7,opt,name=rollback_from_issue,json=rollbackFromIssue,proto3" json:"rollback_from_issue,omitempty"`
+	// rollback_from_task is the resource name of the task that
+	// the rollback SQL statement is generated from.
+	// Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}
+	RollbackFromTask string `protobuf:"bytes,8,opt,name=rollback_from_task,json=rollbackFromTask,proto3" json:"rollback_from_task,omitempty"`
+}
+
+func (x *Task_DatabaseDataUpdate) Reset() {
+	*x = Task_DatabaseDataUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Task_DatabaseDataUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task_DatabaseDataUpdate) ProtoMessage() {}
+
+func (x *Task_DatabaseDataUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task_DatabaseDataUpdate.ProtoReflect.Descriptor instead.
+func (*Task_DatabaseDataUpdate) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{42, 3}
+}
+
+func (x *Task_DatabaseDataUpdate) GetSheet() string {
+	if x != nil {
+		return x.Sheet
+	}
+	return ""
+}
+
+func (x *Task_DatabaseDataUpdate) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *Task_DatabaseDataUpdate) GetRollbackEnabled() bool {
+	if x != nil {
+		return x.RollbackEnabled
+	}
+	return false
+}
+
+func (x *Task_DatabaseDataUpdate) GetRollbackSqlStatus() Task_DatabaseDataUpdate_RollbackSqlStatus {
+	if x != nil {
+		return x.RollbackSqlStatus
+	}
+	return Task_DatabaseDataUpdate_ROLLBACK_SQL_STATUS_UNSPECIFIED
+}
+
+func (x *Task_DatabaseDataUpdate) GetRollbackError() string {
+	if x != nil {
+		return x.RollbackError
+	}
+	return ""
+}
+
+func (x *Task_DatabaseDataUpdate) GetRollbackSheet() string {
+	if x != nil {
+		return x.RollbackSheet
+	}
+	return ""
+}
+
+func (x *Task_DatabaseDataUpdate) GetRollbackFromIssue() string {
+	if x != nil {
+		return x.RollbackFromIssue
+	}
+	return ""
+}
+
+func (x *Task_DatabaseDataUpdate) GetRollbackFromTask() string {
+	if x != nil {
+		return x.RollbackFromTask
+	}
+	return ""
+}
+
+type Task_DatabaseBackup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resource name of the backup.
+	// Format: instances/{instance}/databases/{database}/backups/{backup-name}
+	Backup string `protobuf:"bytes,1,opt,name=backup,proto3" json:"backup,omitempty"`
+}
+
+func (x *Task_DatabaseBackup) Reset() {
+	*x = Task_DatabaseBackup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Task_DatabaseBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task_DatabaseBackup) ProtoMessage() {}
+
+func (x *Task_DatabaseBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task_DatabaseBackup.ProtoReflect.Descriptor instead.
+func (*Task_DatabaseBackup) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{42, 4}
+}
+
+func (x *Task_DatabaseBackup) GetBackup() string {
+	if x != nil {
+		return x.Backup
+	}
+	return ""
+}
+
+type Task_DatabaseRestoreRestore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Target is only used when doing restore to a new database now.
+	// It is empty for the case of in-place restore.
+	// Target {instance} must be within the same environment as the instance of the original database.
+	// {database} is the target database name.
+	// Format: instances/{instance}/databases/database
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// Types that are assignable to Source:
+	//	*Task_DatabaseRestoreRestore_Backup
+	//	*Task_DatabaseRestoreRestore_PointInTime
+	Source isTask_DatabaseRestoreRestore_Source `protobuf_oneof:"source"`
+}
+
+func (x *Task_DatabaseRestoreRestore) Reset() {
+	*x = Task_DatabaseRestoreRestore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Task_DatabaseRestoreRestore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task_DatabaseRestoreRestore) ProtoMessage() {}
+
+func (x *Task_DatabaseRestoreRestore) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task_DatabaseRestoreRestore.ProtoReflect.Descriptor instead.
+func (*Task_DatabaseRestoreRestore) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{42, 5}
+}
+
+func (x *Task_DatabaseRestoreRestore) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (m *Task_DatabaseRestoreRestore) GetSource() isTask_DatabaseRestoreRestore_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (x *Task_DatabaseRestoreRestore) GetBackup() string {
+	if x, ok := x.GetSource().(*Task_DatabaseRestoreRestore_Backup); ok {
+		return x.Backup
+	}
+	return ""
+}
+
+func (x *Task_DatabaseRestoreRestore) GetPointInTime() *timestamppb.Timestamp {
+	if x, ok := x.GetSource().(*Task_DatabaseRestoreRestore_PointInTime); ok {
+		return x.PointInTime
+	}
+	return nil
+}
+
+type isTask_DatabaseRestoreRestore_Source interface {
+	isTask_DatabaseRestoreRestore_Source()
+}
+
+type Task_DatabaseRestoreRestore_Backup struct {
+	// Only used when doing restore full backup only.
+	// Format: instances/{instance}/databases/{database}/backups/{backup-name}
+	Backup string `protobuf:"bytes,2,opt,name=backup,proto3,oneof"`
+}
+
+type Task_DatabaseRestoreRestore_PointInTime struct {
+	// After the PITR operations, the database will be recovered to the state at this time.
+	PointInTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=point_in_time,json=pointInTime,proto3,oneof"`
+}
+
+func (*Task_DatabaseRestoreRestore_Backup) isTask_DatabaseRestoreRestore_Source() {}
+
+func (*Task_DatabaseRestoreRestore_PointInTime) isTask_DatabaseRestoreRestore_Source() {}
+
+type Task_DatabaseDataProvision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resource name of the database to copy the data from.
+	// Format: instances/{instance}/databases/{database}
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// The tables to copy, all tables of the source database are copied if empty.
+	// Format: {schema}.{table} or {table} for the engines without schemas.
+	Tables []string `protobuf:"bytes,2,rep,name=tables,proto3" json:"tables,omitempty"`
+}
+
+func (x *Task_DatabaseDataProvision) Reset() {
+	*x = Task_DatabaseDataProvision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Task_DatabaseDataProvision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task_DatabaseDataProvision) ProtoMessage() {}
+
+func (x *Task_DatabaseDataProvision) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task_DatabaseDataProvision.ProtoReflect.Descriptor instead.
+func (*Task_DatabaseDataProvision) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{42, 6}
+}
+
+func (x *Task_DatabaseDataProvision) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Task_DatabaseDataProvision) GetTables() []string {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type Task_DatabaseDataMigrate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resource name of the database to copy the data from.
+	// Format: instances/{instance}/databases/{database}
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// The tables to copy.
+	// Format: {schema}.{table} or {table} for the engines without schemas.
+	Tables []string `protobuf:"bytes,2,rep,name=tables,proto3" json:"tables,omitempty"`
+}
+
+func (x *Task_DatabaseDataMigrate) Reset() {
+	*x = Task_DatabaseDataMigrate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Task_DatabaseDataMigrate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task_DatabaseDataMigrate) ProtoMessage() {}
+
+func (x *Task_DatabaseDataMigrate) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task_DatabaseDataMigrate.ProtoReflect.Descriptor instead.
+func (*Task_DatabaseDataMigrate) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{42, 7}
+}
+
+func (x *Task_DatabaseDataMigrate) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Task_DatabaseDataMigrate) GetTables() []string {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type TaskRun_ExecutionDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Currently, the following fields are only used for EXECUTING status.
+	CommandsTotal        int32                             `protobuf:"varint,1,opt,name=commands_total,json=commandsTotal,proto3" json:"commands_total,omitempty"`
+	CommandsCompleted    int32                             `protobuf:"varint,2,opt,name=commands_completed,json=commandsCompleted,proto3" json:"commands_completed,omitempty"`
+	CommandStartPosition *TaskRun_ExecutionDetail_Position `protobuf:"bytes,3,opt,name=command_start_position,json=commandStartPosition,proto3" json:"command_start_position,omitempty"`
+	CommandEndPosition   *TaskRun_ExecutionDetail_Position `protobuf:"bytes,4,opt,name=command_end_position,json=commandEndPosition,proto3" json:"command_end_position,omitempty"`
+	// The following fields are only used for the batch DML tasks and the gh-ost sync tasks.
+	// rows_total is the estimated number of rows to change or copy.
+	RowsTotal     int64 `protobuf:"varint,5,opt,name=rows_total,json=rowsTotal,proto3" json:"rows_total,omitempty"`
+	RowsCompleted int64 `protobuf:"varint,6,opt,name=rows_completed,json=rowsCompleted,proto3" json:"rows_completed,omitempty"`
+	// The following fields are only used for the gh-ost sync tasks.
+	// The replication lag of the gh-ost migration.
+	ReplicationLag *durationpb.Duration `protobuf:"bytes,7,opt,name=replication_lag,json=replicationLag,proto3" json:"replication_lag,omitempty"`
+	// The estimated time to finish copying rows, unset if unknown.
+	EstimatedTimeRemaining *durationpb.Duration `protobuf:"bytes,8,opt,name=estimated_time_remaining,json=estimatedTimeRemaining,proto3" json:"estimated_time_remaining,omitempty"`
+	// The steps of the online migration plan.
+	Steps []*TaskRun_ExecutionDetail_Step `protobuf:"bytes,9,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *TaskRun_ExecutionDetail) Reset() {
+	*x = TaskRun_ExecutionDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskRun_ExecutionDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRun_ExecutionDetail) ProtoMessage() {}
+
+func (x *TaskRun_ExecutionDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRun_ExecutionDetail.ProtoReflect.Descriptor instead.
+func (*TaskRun_ExecutionDetail) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{43, 0}
+}
+
+func (x *TaskRun_ExecutionDetail) GetCommandsTotal() int32 {
+	if x != nil {
+		return x.CommandsTotal
+	}
+	return 0
+}
+
+func (x *TaskRun_ExecutionDetail) GetCommandsCompleted() int32 {
+	if x != nil {
+		return x.CommandsCompleted
+	}
+	return 0
+}
+
+func (x *TaskRun_ExecutionDetail) GetCommandStartPosition() *TaskRun_ExecutionDetail_Position {
+	if x != nil {
+		return x.CommandStartPosition
+	}
+	return nil
+}
+
+func (x *TaskRun_ExecutionDetail) GetCommandEndPosition() *TaskRun_ExecutionDetail_Position {
+	if x != nil {
+		return x.CommandEndPosition
+	}
+	return nil
+}
+
+func (x *TaskRun_ExecutionDetail) GetRowsTotal() int64 {
+	if x != nil {
+		return x.RowsTotal
+	}
+	return 0
+}
+
+func (x *TaskRun_ExecutionDetail) GetRowsCompleted() int64 {
+	if x != nil {
+		return x.RowsCompleted
+	}
+	return 0
+}
+
+func (x *TaskRun_ExecutionDetail) GetReplicationLag() *durationpb.Duration {
+	if x != nil {
+		return x.ReplicationLag
+	}
+	return nil
+}
+
+func (x *TaskRun_ExecutionDetail) GetEstimatedTimeRemaining() *durationpb.Duration {
+	if x != nil {
+		return x.EstimatedTimeRemaining
+	}
+	return nil
+}
+
+func (x *TaskRun_ExecutionDetail) GetSteps() []*TaskRun_ExecutionDetail_Step {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type TaskRun_CheckResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      TaskRun_CheckResult_Type `protobuf:"varint,1,opt,name=type,proto3,enum=bytebase.v1.TaskRun_CheckResult_Type" json:"type,omitempty"`
+	Statement string                   `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+	Passed    bool                     `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`
+	// The reason of the failure.
+	Detail string `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *TaskRun_CheckResult) Reset() {
+	*x = TaskRun_CheckResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskRun_CheckResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRun_CheckResult) ProtoMessage() {}
+
+func (x *TaskRun_CheckResult) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRun_CheckResult.ProtoReflect.Descriptor instead.
+func (*TaskRun_CheckResult) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{43, 1}
+}
+
+func (x *TaskRun_CheckResult) GetType() TaskRun_CheckResult_Type {
+	if x != nil {
+		return x.Type
+	}
+	return TaskRun_CheckResult_TYPE_UNSPECIFIED
+}
+
+func (x *TaskRun_CheckResult) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *TaskRun_CheckResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *TaskRun_CheckResult) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type TaskRun_ExecutionDetail_Position struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The line number, starting from 0.
+	Line int32 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	// The column number, starting from 0.
+	Column int32 `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
+}
+
+func (x *TaskRun_ExecutionDetail_Position) Reset() {
+	*x = TaskRun_ExecutionDetail_Position{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskRun_ExecutionDetail_Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRun_ExecutionDetail_Position) ProtoMessage() {}
+
+func (x *TaskRun_ExecutionDetail_Position) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRun_ExecutionDetail_Position.ProtoReflect.Descriptor instead.
+func (*TaskRun_ExecutionDetail_Position) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{43, 0, 0}
+}
+
+func (x *TaskRun_ExecutionDetail_Position) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *TaskRun_ExecutionDetail_Position) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+// The following fields are only used for the online migration tasks.
+type TaskRun_ExecutionDetail_Step struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The description of the step, e.g. "CREATE INDEX CONCURRENTLY idx ON t (c)".
+	Description string                              `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Status      TaskRun_ExecutionDetail_Step_Status `protobuf:"varint,2,opt,name=status,proto3,enum=bytebase.v1.TaskRun_ExecutionDetail_Step_Status" json:"status,omitempty"`
+}
+
+func (x *TaskRun_ExecutionDetail_Step) Reset() {
+	*x = TaskRun_ExecutionDetail_Step{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_rollout_service_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskRun_ExecutionDetail_Step) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRun_ExecutionDetail_Step) ProtoMessage() {}
+
+func (x *TaskRun_ExecutionDetail_Step) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRun_ExecutionDetail_Step.ProtoReflect.Descriptor instead.
+func (*TaskRun_ExecutionDetail_Step) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{43, 0, 1}
+}
+
+func (x *TaskRun_ExecutionDetail_Step) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskRun_ExecutionDetail_Step) GetStatus() TaskRun_ExecutionDetail_Step_Status {
+	if x != nil {
+		return x.Status
+	}
+	return TaskRun_ExecutionDetail_Step_STATUS_UNSPECIFIED
+}
+
+var File_v1_rollout_service_proto protoreflect.FileDescriptor
+
+var file_v1_rollout_service_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x19, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x76,
+	0x31, 0x2f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x15, 0x76, 0x31, 0x2f, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6b, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x64, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5c, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
+	0x02, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x70, 0x6c, 0x61,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
+	0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x70,
+	0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x42, 0x03, 0xe0, 0x41,
+	0x02, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0x40, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xd1, 0x1b, 0x0a, 0x04, 0x50, 0x6c,
+	0x61, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x05, 0x73,
+	0x74, 0x65, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x53, 0x74,
+	0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x1a, 0x34, 0x0a, 0x04, 0x53, 0x74, 0x65,
+	0x70, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6c, 0x61, 0x6e, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x73, 0x70, 0x65, 0x63, 0x73, 0x1a,
+	0xfd, 0x04, 0x0a, 0x04, 0x53, 0x70, 0x65, 0x63, 0x12, 0x4e, 0x0a, 0x15, 0x65, 0x61, 0x72, 0x6c,
+	0x69, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x13, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x53, 0x70, 0x65,
+	0x63, 0x73, 0x12, 0x5e, 0x0a, 0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x14, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x5e, 0x0a, 0x16, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x14, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x61, 0x0a, 0x17, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x15,
+	0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x67, 0x0a, 0x19, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x17, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x55,
+	0x0a, 0x13, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x48, 0x00, 0x52, 0x11, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a,
+	0xc6, 0x03, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x0c, 0x63,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x63,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03,
+	0xe0, 0x41, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x03, 0xe0, 0x41, 0x01, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
+	0x01, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x06, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x25, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52,
+	0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x62,
 	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5e, 0x0a, 0x16,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a,
+	0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x89, 0x0d, 0x0a, 0x14, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x65,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x65, 0x65, 0x74, 0x12,
+	0x3f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x63, 0x0a, 0x0f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x57, 0x0a, 0x0b, 0x67, 0x68, 0x6f, 0x73, 0x74,
+	0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x62,
 	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e,
 	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x14, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x61, 0x0a, 0x17,
-	0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
-	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e,
-	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x15, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42,
-	0x08, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0xc6, 0x03, 0x0a, 0x14, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
-	0x1f, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x12, 0x19, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x03, 0xe0, 0x41, 0x01, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0d, 0x63,
-	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
-	0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x09, 0x63,
-	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x07,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x05, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12,
-	0x25, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72,
-	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52,
-	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x68, 0x6f, 0x73, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x67, 0x68, 0x6f, 0x73, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73,
+	0x12, 0x7a, 0x0a, 0x18, 0x70, 0x72, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x48, 0x01, 0x52, 0x15, 0x70, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x5a, 0x0a, 0x0c,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x02, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88, 0x01, 0x01, 0x12, 0x79, 0x0a, 0x17, 0x6f, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x03, 0x52, 0x15, 0x6f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x5f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x09, 0x70, 0x72, 0x65, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x12, 0x4d, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x1a, 0x6e, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x1a, 0x3d, 0x0a, 0x0f, 0x47, 0x68, 0x6f, 0x73, 0x74, 0x46, 0x6c, 0x61, 0x67,
 	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x99, 0x07, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x65, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x65, 0x65, 0x74, 0x12, 0x3f, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x6f, 0x6c,
-	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x63, 0x0a, 0x0f,
-	0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x6f,
-	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x48, 0x00, 0x52, 0x0e,
-	0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x88, 0x01,
-	0x01, 0x12, 0x57, 0x0a, 0x0b, 0x67, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47,
-	0x68, 0x6f, 0x73, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a,
-	0x67, 0x68, 0x6f, 0x73, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x7a, 0x0a, 0x18, 0x70, 0x72,
-	0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f,
-	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x62,
-	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61,
-	0x63, 0x6b, 0x75, 0x70, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x48, 0x01, 0x52, 0x15, 0x70, 0x72,
-	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x1a, 0x6e, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
-	0x63, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x6f, 0x6c, 0x6c,
-	0x62, 0x61, 0x63, 0x6b, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x46, 0x72,
-	0x6f, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
-	0x63, 0x6b, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x6f,
-	0x6d, 0x49, 0x73, 0x73, 0x75, 0x65, 0x1a, 0x3d, 0x0a, 0x0f, 0x47, 0x68, 0x6f, 0x73, 0x74, 0x46,
-	0x6c, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x33, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x71, 0x0a, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x41, 0x53, 0x45,
-	0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x49, 0x47, 0x52, 0x41, 0x54,
-	0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x49, 0x47, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x53,
-	0x44, 0x4c, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x49, 0x47, 0x52, 0x41, 0x54, 0x45, 0x5f,
-	0x47, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x52, 0x41, 0x4e, 0x43,
-	0x48, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x41, 0x54, 0x41, 0x10, 0x06, 0x42, 0x12, 0x0a,
-	0x10, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x1a, 0x93,
-	0x02, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x12, 0x61, 0x0a, 0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x6c, 0x61, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01, 0x52, 0x14, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x40, 0x0a,
-	0x0d, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x48, 0x00, 0x52, 0x0b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x42,
-	0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x22, 0x73, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x86, 0x01, 0x0a, 0x19, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0f, 0x70, 0x6c, 0x61, 0x6e, 0x5f,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x52, 0x0d, 0x70, 0x6c, 0x61,
-	0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
-	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x22, 0x2f, 0x0a, 0x14, 0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x14,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x75, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x73,
-	0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x75, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6b, 0x69, 0x70,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x22, 0x18, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6b, 0x69, 0x70, 0x54,
-	0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x1a,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x52,
-	0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x1d, 0x0a, 0x1b, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9d, 0x0b, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x6e, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x32, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x20, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x65, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x65, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x79, 0x74,
-	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x40, 0x0a, 0x0b, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41,
-	0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0xcf, 0x05,
-	0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x52, 0x75, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x61, 0x0a,
-	0x12, 0x73, 0x71, 0x6c, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x38, 0x01, 0x1a, 0x33, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x1a, 0x6e, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a, 0x34, 0x0a, 0x15, 0x4f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x67, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x67, 0x52, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x1a, 0x64, 0x0a,
+	0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x71, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x41, 0x53, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x12,
+	0x0b, 0x0a, 0x07, 0x4d, 0x49, 0x47, 0x52, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b,
+	0x4d, 0x49, 0x47, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x44, 0x4c, 0x10, 0x03, 0x12, 0x11, 0x0a,
+	0x0d, 0x4d, 0x49, 0x47, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x47, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x04,
+	0x12, 0x0a, 0x0a, 0x06, 0x42, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04,
+	0x44, 0x41, 0x54, 0x41, 0x10, 0x06, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x70,
+	0x72, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x1a, 0x93, 0x02, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x61, 0x0a, 0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01,
+	0x52, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x06, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x12, 0x40, 0x0a, 0x0d, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42,
+	0x19, 0x0a, 0x17, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x61, 0x0a, 0x17, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x9d, 0x01,
+	0x0a, 0x11, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c,
+	0x65, 0x61, 0x72, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x73, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x86, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x41, 0x0a, 0x0f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x72,
+	0x75, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x79, 0x74, 0x65,
 	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x53, 0x71, 0x6c, 0x53,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x10,
-	0x73, 0x71, 0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x12, 0x5e, 0x0a, 0x11, 0x73, 0x71, 0x6c, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x62, 0x79,
+	0x6b, 0x52, 0x75, 0x6e, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x75, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2f, 0x0a, 0x14, 0x52,
+	0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x17, 0x0a, 0x15,
+	0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x75,
+	0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x5f, 0x6e, 0x6f, 0x77, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x75, 0x6e, 0x4e, 0x6f, 0x77, 0x22, 0x17, 0x0a, 0x15,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x75, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6b,
+	0x69, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x18, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6b, 0x69,
+	0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6d,
+	0x0a, 0x13, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x73,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x16, 0x0a,
+	0x14, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x61, 0x73, 0x6b, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x1d, 0x0a, 0x1b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x66, 0x0a, 0x1c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x74, 0x72, 0x79, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x75, 0x0a, 0x1d, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x3e,
+	0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0x7a,
+	0x0a, 0x21, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0b, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x22, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x3e,
+	0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0x2d,
+	0x0a, 0x12, 0x43, 0x75, 0x74, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x15, 0x0a,
+	0x13, 0x43, 0x75, 0x74, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x75, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x22, 0x5a, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3a, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x22, 0x34, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x75, 0x6e, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2d, 0x0a, 0x04,
+	0x77, 0x61, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x77, 0x61, 0x69, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x1d,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x67, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x22, 0xab, 0x0e, 0x0a, 0x0c, 0x50, 0x6c,
+	0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64,
+	0x12, 0x32, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61,
+	0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x65, 0x65, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x65, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x40,
+	0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x1a, 0x96, 0x08, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x62, 0x79,
 	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x53, 0x71,
-	0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52,
-	0x0f, 0x73, 0x71, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x1a, 0xc0, 0x01, 0x0a, 0x10, 0x53, 0x71, 0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72,
-	0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x66, 0x66, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x4a, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x1a, 0x69, 0x0a, 0x0f, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x45,
-	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x57,
-	0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43,
-	0x45, 0x53, 0x53, 0x10, 0x03, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22,
-	0x91, 0x02, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22,
-	0x0a, 0x1e, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x41, 0x4b, 0x45, 0x5f, 0x41, 0x44, 0x56, 0x49, 0x53, 0x45,
-	0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x54, 0x49,
-	0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x41, 0x54, 0x41,
-	0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41,
-	0x44, 0x56, 0x49, 0x53, 0x45, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x41, 0x54, 0x41, 0x42,
-	0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x10, 0x04, 0x12, 0x25, 0x0a, 0x21, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x55, 0x4d, 0x4d, 0x41,
-	0x52, 0x59, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x44,
-	0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10,
-	0x06, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x47, 0x48,
-	0x4f, 0x53, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x07, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x41,
-	0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x50, 0x49, 0x54, 0x52, 0x5f, 0x4d, 0x59, 0x53, 0x51,
-	0x4c, 0x10, 0x08, 0x22, 0x51, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
-	0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
-	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06,
-	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43,
-	0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x22, 0x2c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x70, 0x6c, 0x61,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x70, 0x6c,
-	0x61, 0x6e, 0x22, 0x5d, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6c,
-	0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x04, 0x70, 0x6c, 0x61,
-	0x6e, 0x22, 0x6e, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0x71, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x74, 0x61, 0x73,
-	0x6b, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62,
-	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52,
-	0x75, 0x6e, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f,
-	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8a, 0x01, 0x0a, 0x07, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x6c, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65,
-	0x73, 0x22, 0x93, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x15, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
-	0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x76,
-	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x27,
-	0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b,
-	0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0xcb, 0x13, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b,
+	0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x61, 0x0a, 0x12, 0x73, 0x71, 0x6c, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x53,
+	0x71, 0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48,
+	0x00, 0x52, 0x10, 0x73, 0x71, 0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x5e, 0x0a, 0x11, 0x73, 0x71, 0x6c, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61,
+	0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x2e, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x48, 0x00, 0x52, 0x0f, 0x73, 0x71, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x5e, 0x0a, 0x11, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x6c, 0x61,
+	0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61,
+	0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x48, 0x00, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x1a, 0xc0, 0x01, 0x0a, 0x10, 0x53, 0x71, 0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x4a, 0x0a, 0x11, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x1a, 0x69, 0x0a, 0x0f, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x1a, 0xe4, 0x01, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6c, 0x61, 0x6e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x73,
+	0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x73,
+	0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x53, 0x63, 0x61, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x6e,
+	0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x6c, 0x61, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61,
+	0x6e, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x6c, 0x61, 0x6e, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x45, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x03, 0x42,
+	0x08, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xd8, 0x02, 0x0a, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x41, 0x54, 0x41,
+	0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46,
+	0x41, 0x4b, 0x45, 0x5f, 0x41, 0x44, 0x56, 0x49, 0x53, 0x45, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20,
+	0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x54, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59,
+	0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x44, 0x56, 0x49, 0x53, 0x45, 0x10,
+	0x03, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x04, 0x12, 0x25,
+	0x0a, 0x21, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x55, 0x4d, 0x4d, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x50,
+	0x4f, 0x52, 0x54, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53,
+	0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x44,
+	0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x47, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x53, 0x59,
+	0x4e, 0x43, 0x10, 0x07, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45,
+	0x5f, 0x50, 0x49, 0x54, 0x52, 0x5f, 0x4d, 0x59, 0x53, 0x51, 0x4c, 0x10, 0x08, 0x12, 0x22, 0x0a,
+	0x1e, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x4c, 0x4b, 0x5f, 0x41, 0x44, 0x56, 0x49, 0x53, 0x45, 0x10,
+	0x09, 0x12, 0x21, 0x0a, 0x1d, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x50, 0x4c,
+	0x41, 0x4e, 0x10, 0x0a, 0x22, 0x51, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
+	0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a,
+	0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e,
+	0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x22, 0x2c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
+	0x41, 0x02, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x70, 0x6c,
+	0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x70,
+	0x6c, 0x61, 0x6e, 0x22, 0x5d, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
+	0x41, 0x02, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x70,
+	0x6c, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x04, 0x70, 0x6c,
+	0x61, 0x6e, 0x22, 0x5f, 0x0a, 0x12, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x6c, 0x61,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x70,
+	0x6c, 0x61, 0x6e, 0x22, 0xf0, 0x03, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x75, 0x74, 0x12, 0x4b, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
+	0x12, 0x39, 0x0a, 0x0b, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x69, 0x73, 0x6b, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x0a, 0x72, 0x69, 0x73, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x69, 0x73, 0x6b, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x72, 0x69, 0x73, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x4a, 0x0a, 0x11, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x10, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x1a, 0xbd, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x71, 0x6c, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x71, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61,
+	0x78, 0x5f, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x41, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x22, 0x6e, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61,
+	0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
+	0x41, 0x02, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x71, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61,
+	0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8a, 0x01, 0x0a, 0x07, 0x52, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x6c, 0x61, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x22, 0xe1, 0x02, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x67, 0x65,
 	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x70, 0x65, 0x63, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x62, 0x79, 0x74,
-	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e,
-	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x16, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x28, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x74, 0x61,
-	0x73, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x65, 0x64, 0x42, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x12, 0x4b, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x79, 0x74,
-	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0e,
-	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x64,
-	0x0a, 0x18, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x28, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x61, 0x73, 0x6b, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x48, 0x00, 0x52, 0x16, 0x64, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x42, 0x61, 0x73, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x5e, 0x0a, 0x16, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x14,
-	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x12, 0x58, 0x0a, 0x14, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x12, 0x64, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x4b,
-	0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75,
-	0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x48, 0x0a, 0x0d,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x47,
+	0x61, 0x74, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x47, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79,
+	0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x4b, 0x0a,
+	0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0d, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xda, 0x01, 0x0a, 0x12, 0x53,
+	0x74, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x56, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a,
+	0x06, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e,
+	0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x22, 0xd9, 0x02, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x67,
+	0x65, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x0d, 0x62, 0x61, 0x6b, 0x65, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x62, 0x61, 0x6b, 0x65, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a,
+	0x15, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x70, 0x6f,
+	0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1f, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x61,
+	0x6c, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x68, 0x61, 0x6c, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x42, 0x41, 0x4b, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f,
+	0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x41, 0x4c, 0x54, 0x45,
+	0x44, 0x10, 0x03, 0x22, 0x96, 0x02, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x47, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12,
+	0x45, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x47, 0x61,
+	0x74, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x09, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x1a, 0xa3, 0x01, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x93, 0x19, 0x0a,
+	0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x63, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x70, 0x65, 0x63, 0x49, 0x64, 0x12,
+	0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61,
+	0x73, 0x6b, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x4b, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61,
+	0x73, 0x6b, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x48, 0x00, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x64, 0x0a, 0x18, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x48,
+	0x00, 0x52, 0x16, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x5e, 0x0a, 0x16, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x48, 0x00, 0x52, 0x14, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x58, 0x0a, 0x14, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52,
+	0x12, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x48, 0x00,
+	0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x12, 0x64, 0x0a, 0x18, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x48, 0x00, 0x52, 0x16,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x61, 0x0a, 0x17, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
 	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x48, 0x00, 0x52, 0x0e, 0x64, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x64, 0x0a, 0x18, 0x64,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f,
-	0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b,
-	0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x48, 0x00, 0x52, 0x16, 0x64, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x1a, 0xd8, 0x02, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x65, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x73, 0x68, 0x65, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63,
-	0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
-	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63,
-	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76,
-	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x44,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2e, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x16,
-	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x42, 0x61,
-	0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x53, 0x0a,
-	0x14, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x65, 0x65, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x65, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x15, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x15, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x48,
+	0x00, 0x52, 0x13, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x1a, 0xd8, 0x02, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x65, 0x65, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x65, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x53, 0x65, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x44, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x3f, 0x0a, 0x16, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x1a, 0xed, 0x03, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44,
-	0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x65,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x65, 0x65, 0x74, 0x12,
-	0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
-	0x63, 0x6b, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x12, 0x66, 0x0a, 0x13, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x71,
-	0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36,
-	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73,
-	0x6b, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x71, 0x6c,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x11, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
-	0x53, 0x71, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x68, 0x65,
-	0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
-	0x63, 0x6b, 0x53, 0x68, 0x65, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x6f, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x46, 0x72,
-	0x6f, 0x6d, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x6f, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x6f,
-	0x6d, 0x54, 0x61, 0x73, 0x6b, 0x22, 0x5b, 0x0a, 0x11, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
-	0x6b, 0x53, 0x71, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x4f,
-	0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x51, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
-	0x44, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
-	0x10, 0x03, 0x1a, 0x28, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x61,
-	0x63, 0x6b, 0x75, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x1a, 0x96, 0x01, 0x0a,
-	0x16, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
-	0x18, 0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x40, 0x0a, 0x0d, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0b,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x7c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x53,
-	0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44,
-	0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
-	0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06,
-	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43,
-	0x45, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45,
-	0x44, 0x10, 0x07, 0x22, 0xd4, 0x02, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x4c, 0x10, 0x01, 0x12,
-	0x13, 0x0a, 0x0f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41,
-	0x54, 0x45, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45,
-	0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x4c, 0x49, 0x4e, 0x45,
-	0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x53,
-	0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x1e,
-	0x0a, 0x1a, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d,
-	0x41, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x44, 0x4c, 0x10, 0x05, 0x12, 0x25,
-	0x0a, 0x21, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d,
-	0x41, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x47, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x53,
-	0x59, 0x4e, 0x43, 0x10, 0x06, 0x12, 0x28, 0x0a, 0x24, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53,
-	0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f,
-	0x47, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x54, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x07, 0x12,
-	0x18, 0x0a, 0x14, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x41,
-	0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x08, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x41, 0x54,
-	0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x09, 0x12, 0x1c,
-	0x0a, 0x18, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f,
-	0x52, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x0a, 0x12, 0x1c, 0x0a, 0x18,
-	0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45,
-	0x5f, 0x43, 0x55, 0x54, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x0b, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x8f, 0x0a, 0x0a, 0x07, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75,
-	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72,
-	0x12, 0x40, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x6f, 0x6e, 0x1a, 0x92, 0x03, 0x0a, 0x14, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x68, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x65, 0x65,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x66, 0x0a, 0x13, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f,
+	0x73, 0x71, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x36, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53,
+	0x71, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x11, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x53, 0x71, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x73,
+	0x68, 0x65, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x53, 0x68, 0x65, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x46, 0x72, 0x6f, 0x6d, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x46,
+	0x72, 0x6f, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0xed, 0x03, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x68, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x68, 0x65, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x72,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x66, 0x0a, 0x13, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x5f, 0x73, 0x71, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x53, 0x71, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x11, 0x72, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x71, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x5f, 0x73, 0x68, 0x65, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x68, 0x65, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13,
+	0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x2c, 0x0a, 0x12,
+	0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x61,
+	0x73, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x22, 0x5b, 0x0a, 0x11, 0x52, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x71, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x23, 0x0a, 0x1f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x51, 0x4c, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x28, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x1a, 0x96, 0x01, 0x0a, 0x16, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x40,
+	0x0a, 0x0d, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x79, 0x74,
+	0x70, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x47, 0x0a, 0x15, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x1a, 0x45, 0x0a, 0x13, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x7c, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
+	0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a,
+	0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55,
+	0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x4e, 0x45, 0x10,
+	0x04, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0c, 0x0a,
+	0x08, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x53,
+	0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x07, 0x22, 0x8c, 0x03, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x45, 0x4e, 0x45, 0x52,
+	0x41, 0x4c, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45,
+	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x41, 0x54,
+	0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x42, 0x41, 0x53,
+	0x45, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x41, 0x42,
+	0x41, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54,
+	0x45, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f,
+	0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x44,
+	0x4c, 0x10, 0x05, 0x12, 0x25, 0x0a, 0x21, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f,
+	0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x47, 0x48,
+	0x4f, 0x53, 0x54, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x06, 0x12, 0x28, 0x0a, 0x24, 0x44, 0x41,
+	0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x5f, 0x47, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x54, 0x4f, 0x56,
+	0x45, 0x52, 0x10, 0x07, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45,
+	0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x08, 0x12, 0x13,
+	0x0a, 0x0f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55,
+	0x50, 0x10, 0x09, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f,
+	0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10,
+	0x0a, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x52, 0x45,
+	0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x43, 0x55, 0x54, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x0b, 0x12,
+	0x1b, 0x0a, 0x17, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x41,
+	0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x0c, 0x12, 0x19, 0x0a, 0x15,
+	0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x4d, 0x49,
+	0x47, 0x52, 0x41, 0x54, 0x45, 0x10, 0x0d, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x22, 0x8c, 0x10, 0x0a, 0x07, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x03, 0xe0, 0x41, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x12, 0x40, 0x0a,
+	0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03,
+	0xe0, 0x41, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x40, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x75, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x5b, 0x0a, 0x1c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x19, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x4f, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x79, 0x74,
 	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x25,
-	0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x24, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61,
-	0x73, 0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5b, 0x0a, 0x1c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x19, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x4f, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
-	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b,
-	0x52, 0x75, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x12, 0x3e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x1a, 0xe5, 0x02, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12,
-	0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x63,
-	0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x3e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52,
+	0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0xc0, 0x06,
+	0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x63, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x2e, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5f, 0x0a, 0x14,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x45, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x72, 0x6f, 0x77, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x6f, 0x77, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x6f, 0x77, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x67, 0x12, 0x53, 0x0a, 0x18, 0x65, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x3f, 0x0a, 0x05,
+	0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75,
+	0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x1a, 0x36, 0x0a,
+	0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x1a, 0xb8, 0x01, 0x0a, 0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x48, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x30, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x44, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e,
+	0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x03,
+	0x1a, 0xd3, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x39, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
 	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73,
-	0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x5f, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x65,
-	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2d, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x45, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x36, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0x5e, 0x0a, 0x06,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b,
-	0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52,
-	0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x4e, 0x45,
-	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0c,
-	0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x22, 0x69, 0x0a, 0x0f,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x20, 0x0a, 0x1c, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x45, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49,
-	0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4e,
-	0x47, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x45, 0x58, 0x45, 0x43,
-	0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x32, 0xe8, 0x0e, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x75, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x67, 0x0a, 0x07, 0x47, 0x65,
-	0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1b, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0x2c, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x73,
-	0x2f, 0x2a, 0x7d, 0x12, 0x7a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73,
-	0x12, 0x1d, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2e, 0xda, 0x41, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
-	0x12, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x12,
-	0x6f, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1e, 0x2e,
-	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
-	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e,
-	0x22, 0x2e, 0xda, 0x41, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x04, 0x70, 0x6c, 0x61,
-	0x6e, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x73,
-	0x12, 0x84, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12,
-	0x1e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x11, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c,
-	0x61, 0x6e, 0x22, 0x43, 0xda, 0x41, 0x10, 0x70, 0x6c, 0x61, 0x6e, 0x2c, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x04, 0x70,
-	0x6c, 0x61, 0x6e, 0x32, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x6c, 0x61, 0x6e, 0x2e, 0x6e,
-	0x61, 0x6d, 0x65, 0x3d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x70,
-	0x6c, 0x61, 0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0x73, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x1e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x22, 0x2f, 0xda, 0x41, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f,
-	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a,
-	0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0x7b, 0x0a, 0x0d,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x21, 0x2e,
-	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x14, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x22, 0x31, 0xda, 0x41, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x28, 0x3a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x3d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x7d,
-	0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x85, 0x01, 0x0a, 0x0e, 0x50, 0x72,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x22, 0x2e, 0x62,
-	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x14, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x22, 0x39, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x01, 0x2a, 0x22, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x3d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f,
-	0x2a, 0x7d, 0x3a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75,
-	0x74, 0x12, 0xa2, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75,
-	0x6e, 0x73, 0x12, 0x20, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0xda, 0x41, 0x06, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70,
+	0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73,
+	0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x3b, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x52, 0x45, 0x5f, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x10, 0x02, 0x22, 0x5e, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43,
+	0x45, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x22, 0x69, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x58, 0x45,
+	0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x50,
+	0x52, 0x45, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x12, 0x0a,
+	0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10,
+	0x03, 0x22, 0xfc, 0x02, 0x0a, 0x0f, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x40, 0x0a,
+	0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03,
+	0xe0, 0x41, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x75, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x4f, 0x4c, 0x4c, 0x42,
+	0x41, 0x43, 0x4b, 0x5f, 0x53, 0x51, 0x4c, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x48, 0x4f,
+	0x53, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x4c, 0x4f, 0x47, 0x10, 0x02, 0x12, 0x17,
+	0x0a, 0x13, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f,
+	0x55, 0x54, 0x50, 0x55, 0x54, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x41, 0x54, 0x41, 0x5f,
+	0x43, 0x4f, 0x4d, 0x50, 0x41, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x04,
+	0x22, 0xec, 0x01, 0x0a, 0x0f, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3b,
+	0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x15,
+	0x0a, 0x11, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x01,
+	0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x41,
+	0x52, 0x4e, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04, 0x32,
+	0xf8, 0x19, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x67, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1b, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0x2c, 0xda,
+	0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76,
+	0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x2f, 0x2a, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0x7a, 0x0a, 0x09, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0xda, 0x41, 0x06, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70,
 	0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a,
-	0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x73, 0x74, 0x61, 0x67,
-	0x65, 0x73, 0x2f, 0x2a, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x74, 0x61,
-	0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12, 0xa2, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50,
-	0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x62,
-	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
-	0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
-	0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0xda, 0x41, 0x06,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x76,
-	0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x70, 0x6c,
-	0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x95, 0x01, 0x0a, 0x0d,
-	0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x21, 0x2e,
-	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x50,
-	0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x22, 0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x3d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x70, 0x6c, 0x61,
-	0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x72, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x73, 0x12, 0xa6, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x75, 0x6e,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x75, 0x6e, 0x54, 0x61, 0x73, 0x6b,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x75, 0x6e, 0x54,
-	0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0xda, 0x41,
-	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x3a, 0x01, 0x2a,
-	0x22, 0x3a, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x70, 0x72,
+	0x7d, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x6f, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0x2e, 0xda, 0x41, 0x00, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x25, 0x3a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x7b,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f,
+	0x2a, 0x7d, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0x43, 0xda, 0x41, 0x10, 0x70,
+	0x6c, 0x61, 0x6e, 0x2c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x32, 0x22, 0x2f, 0x76, 0x31,
+	0x2f, 0x7b, 0x70, 0x6c, 0x61, 0x6e, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x12,
+	0x73, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x1e, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x75, 0x74, 0x22, 0x2f, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x22, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x70, 0x72,
 	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74,
-	0x73, 0x2f, 0x2a, 0x2f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x74, 0x61,
-	0x73, 0x6b, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x52, 0x75, 0x6e, 0x12, 0xaa, 0x01, 0x0a,
-	0x0e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6b, 0x69, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12,
-	0x22, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x53, 0x6b, 0x69, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6b, 0x69, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0xda, 0x41, 0x06, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x40, 0x3a, 0x01, 0x2a, 0x22, 0x3b, 0x2f, 0x76,
-	0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x73, 0x2f, 0x2a, 0x2f,
-	0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x3a,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6b, 0x69, 0x70, 0x12, 0xc6, 0x01, 0x0a, 0x13, 0x42, 0x61,
+	0x73, 0x2f, 0x2a, 0x7d, 0x12, 0x7b, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x22, 0x31,
+	0xda, 0x41, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22,
+	0x20, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74,
+	0x73, 0x12, 0x85, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x75, 0x74, 0x12, 0x22, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x22, 0x39,
+	0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x01, 0x2a,
+	0x22, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x3d, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x79, 0x0a, 0x0b, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1f, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x6c,
+	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22, 0x24,
+	0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x3d, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x50, 0x6c, 0x61, 0x6e, 0x12, 0xa2, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0xda, 0x41, 0x06, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x76, 0x31,
+	0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x2f, 0x2a, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x73,
+	0x74, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x2a, 0x7d,
+	0x2f, 0x74, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12, 0xa2, 0x01, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12,
+	0x25, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e,
+	0xda, 0x41, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12,
+	0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x2f, 0x2a, 0x7d,
+	0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x95,
+	0x01, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x12, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x22, 0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x2f,
+	0x70, 0x6c, 0x61, 0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x72, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x6e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0xa6, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x75, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x75, 0x6e, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x75, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4e, 0xda, 0x41, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f,
+	0x3a, 0x01, 0x2a, 0x22, 0x3a, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x3d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x75, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a, 0x7d,
+	0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x52, 0x75, 0x6e, 0x12,
+	0xaa, 0x01, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6b, 0x69, 0x70, 0x54, 0x61, 0x73,
+	0x6b, 0x73, 0x12, 0x22, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6b, 0x69, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6b, 0x69, 0x70, 0x54, 0x61,
+	0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0xda, 0x41, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x40, 0x3a, 0x01, 0x2a, 0x22,
+	0x3b, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x73,
+	0x2f, 0x2a, 0x2f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x74, 0x61, 0x73,
+	0x6b, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6b, 0x69, 0x70, 0x12, 0x98, 0x01, 0x0a,
+	0x0c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x43, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x36, 0x3a, 0x01, 0x2a, 0x22, 0x31, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x75, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a, 0x7d, 0x3a,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0xc6, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12,
+	0x27, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
 	0x74, 0x63, 0x68, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e,
-	0x73, 0x12, 0x27, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x52,
-	0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x79, 0x74,
-	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0xda, 0x41, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x4d, 0x3a, 0x01, 0x2a, 0x22, 0x48, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a,
-	0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x73, 0x74, 0x61, 0x67,
-	0x65, 0x73, 0x2f, 0x2a, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x74, 0x61,
-	0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x42, 0x11, 0x5a, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d,
-	0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x5c, 0xda, 0x41, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x4d, 0x3a, 0x01, 0x2a, 0x22, 0x48, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x3d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73,
+	0x2f, 0x2a, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x74, 0x61, 0x73, 0x6b,
+	0x52, 0x75, 0x6e, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x12, 0xba, 0x01, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x74, 0x72, 0x79, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x29, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x74, 0x72, 0x79, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x4a, 0xda, 0x41, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x3b, 0x3a, 0x01, 0x2a, 0x22, 0x36, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x3d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0xce, 0x01,
+	0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x2e, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0xda,
+	0x41, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x40, 0x3a, 0x01,
+	0x2a, 0x22, 0x3b, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x75, 0x74, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x9d,
+	0x01, 0x0a, 0x0b, 0x43, 0x75, 0x74, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x1f,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x74,
+	0x6f, 0x76, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75,
+	0x74, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x4b, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e,
+	0x3a, 0x01, 0x2a, 0x22, 0x39, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75,
+	0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a, 0x2f, 0x74, 0x61,
+	0x73, 0x6b, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x63, 0x75, 0x74, 0x6f, 0x76, 0x65, 0x72, 0x12, 0xc6,
+	0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75,
+	0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0xda, 0x41,
+	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4a, 0x12, 0x48, 0x2f,
+	0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x73, 0x2f, 0x2a,
+	0x2f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f,
+	0x2a, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x61, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0xb3, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x26,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x41, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x22, 0x57, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x4a, 0x12, 0x48, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75,
+	0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a, 0x2f, 0x74, 0x61,
+	0x73, 0x6b, 0x73, 0x2f, 0x2a, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x2f, 0x2a,
+	0x2f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0xca, 0x01,
+	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75,
+	0x6e, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x4c, 0x6f, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a,
+	0xda, 0x41, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4b, 0x12,
+	0x49, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x2a, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x73,
+	0x2f, 0x2a, 0x2f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x2a, 0x2f, 0x74, 0x61, 0x73, 0x6b,
+	0x73, 0x2f, 0x2a, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x2f,
+	0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x11, 0x5a, 0x0f, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4693,151 +7917,250 @@ func file_v1_rollout_service_proto_rawDescGZIP() []byte {
 	return file_v1_rollout_service_proto_rawDescData
 }
 
-var file_v1_rollout_service_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
-var file_v1_rollout_service_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
+var file_v1_rollout_service_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
+var file_v1_rollout_service_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
 var file_v1_rollout_service_proto_goTypes = []interface{}{
 	(Plan_ChangeDatabaseConfig_Type)(0),              // 0: bytebase.v1.Plan.ChangeDatabaseConfig.Type
 	(PlanCheckRun_Type)(0),                           // 1: bytebase.v1.PlanCheckRun.Type
 	(PlanCheckRun_Status)(0),                         // 2: bytebase.v1.PlanCheckRun.Status
 	(PlanCheckRun_Result_Status)(0),                  // 3: bytebase.v1.PlanCheckRun.Result.Status
-	(Task_Status)(0),                                 // 4: bytebase.v1.Task.Status
-	(Task_Type)(0),                                   // 5: bytebase.v1.Task.Type
-	(Task_DatabaseDataUpdate_RollbackSqlStatus)(0),   // 6: bytebase.v1.Task.DatabaseDataUpdate.RollbackSqlStatus
-	(TaskRun_Status)(0),                              // 7: bytebase.v1.TaskRun.Status
-	(TaskRun_ExecutionStatus)(0),                     // 8: bytebase.v1.TaskRun.ExecutionStatus
-	(*GetPlanRequest)(nil),                           // 9: bytebase.v1.GetPlanRequest
-	(*ListPlansRequest)(nil),                         // 10: bytebase.v1.ListPlansRequest
-	(*ListPlansResponse)(nil),                        // 11: bytebase.v1.ListPlansResponse
-	(*CreatePlanRequest)(nil),                        // 12: bytebase.v1.CreatePlanRequest
-	(*UpdatePlanRequest)(nil),                        // 13: bytebase.v1.UpdatePlanRequest
-	(*Plan)(nil),                                     // 14: bytebase.v1.Plan
-	(*ListPlanCheckRunsRequest)(nil),                 // 15: bytebase.v1.ListPlanCheckRunsRequest
-	(*ListPlanCheckRunsResponse)(nil),                // 16: bytebase.v1.ListPlanCheckRunsResponse
-	(*RunPlanChecksRequest)(nil),                     // 17: bytebase.v1.RunPlanChecksRequest
-	(*RunPlanChecksResponse)(nil),                    // 18: bytebase.v1.RunPlanChecksResponse
-	(*BatchRunTasksRequest)(nil),                     // 19: bytebase.v1.BatchRunTasksRequest
-	(*BatchRunTasksResponse)(nil),                    // 20: bytebase.v1.BatchRunTasksResponse
-	(*BatchSkipTasksRequest)(nil),                    // 21: bytebase.v1.BatchSkipTasksRequest
-	(*BatchSkipTasksResponse)(nil),                   // 22: bytebase.v1.BatchSkipTasksResponse
-	(*BatchCancelTaskRunsRequest)(nil),               // 23: bytebase.v1.BatchCancelTaskRunsRequest
-	(*BatchCancelTaskRunsResponse)(nil),              // 24: bytebase.v1.BatchCancelTaskRunsResponse
-	(*PlanCheckRun)(nil),                             // 25: bytebase.v1.PlanCheckRun
-	(*GetRolloutRequest)(nil),                        // 26: bytebase.v1.GetRolloutRequest
-	(*CreateRolloutRequest)(nil),                     // 27: bytebase.v1.CreateRolloutRequest
-	(*PreviewRolloutRequest)(nil),                    // 28: bytebase.v1.PreviewRolloutRequest
-	(*ListTaskRunsRequest)(nil),                      // 29: bytebase.v1.ListTaskRunsRequest
-	(*ListTaskRunsResponse)(nil),                     // 30: bytebase.v1.ListTaskRunsResponse
-	(*Rollout)(nil),                                  // 31: bytebase.v1.Rollout
-	(*Stage)(nil),                                    // 32: bytebase.v1.Stage
-	(*Task)(nil),                                     // 33: bytebase.v1.Task
-	(*TaskRun)(nil),                                  // 34: bytebase.v1.TaskRun
-	(*Plan_Step)(nil),                                // 35: bytebase.v1.Plan.Step
-	(*Plan_Spec)(nil),                                // 36: bytebase.v1.Plan.Spec
-	(*Plan_CreateDatabaseConfig)(nil),                // 37: bytebase.v1.Plan.CreateDatabaseConfig
-	(*Plan_ChangeDatabaseConfig)(nil),                // 38: bytebase.v1.Plan.ChangeDatabaseConfig
-	(*Plan_RestoreDatabaseConfig)(nil),               // 39: bytebase.v1.Plan.RestoreDatabaseConfig
-	nil,                                              // 40: bytebase.v1.Plan.CreateDatabaseConfig.LabelsEntry
-	(*Plan_ChangeDatabaseConfig_RollbackDetail)(nil), // 41: bytebase.v1.Plan.ChangeDatabaseConfig.RollbackDetail
-	nil, // 42: bytebase.v1.Plan.ChangeDatabaseConfig.GhostFlagsEntry
-	(*Plan_ChangeDatabaseConfig_PreUpdateBackupDetail)(nil), // 43: bytebase.v1.Plan.ChangeDatabaseConfig.PreUpdateBackupDetail
-	(*PlanCheckRun_Result)(nil),                             // 44: bytebase.v1.PlanCheckRun.Result
-	(*PlanCheckRun_Result_SqlSummaryReport)(nil),            // 45: bytebase.v1.PlanCheckRun.Result.SqlSummaryReport
-	(*PlanCheckRun_Result_SqlReviewReport)(nil),             // 46: bytebase.v1.PlanCheckRun.Result.SqlReviewReport
-	(*Task_DatabaseCreate)(nil),                             // 47: bytebase.v1.Task.DatabaseCreate
-	(*Task_DatabaseSchemaBaseline)(nil),                     // 48: bytebase.v1.Task.DatabaseSchemaBaseline
-	(*Task_DatabaseSchemaUpdate)(nil),                       // 49: bytebase.v1.Task.DatabaseSchemaUpdate
-	(*Task_DatabaseDataUpdate)(nil),                         // 50: bytebase.v1.Task.DatabaseDataUpdate
-	(*Task_DatabaseBackup)(nil),                             // 51: bytebase.v1.Task.DatabaseBackup
-	(*Task_DatabaseRestoreRestore)(nil),                     // 52: bytebase.v1.Task.DatabaseRestoreRestore
-	nil,                                                     // 53: bytebase.v1.Task.DatabaseCreate.LabelsEntry
-	(*TaskRun_ExecutionDetail)(nil),                         // 54: bytebase.v1.TaskRun.ExecutionDetail
-	(*TaskRun_ExecutionDetail_Position)(nil),                // 55: bytebase.v1.TaskRun.ExecutionDetail.Position
-	(*fieldmaskpb.FieldMask)(nil),                           // 56: google.protobuf.FieldMask
-	(*timestamppb.Timestamp)(nil),                           // 57: google.protobuf.Timestamp
-	(*ChangedResources)(nil),                                // 58: bytebase.v1.ChangedResources
+	(StageChangeRequest_Status)(0),                   // 4: bytebase.v1.StageChangeRequest.Status
+	(StageCanary_Status)(0),                          // 5: bytebase.v1.StageCanary.Status
+	(Task_Status)(0),                                 // 6: bytebase.v1.Task.Status
+	(Task_Type)(0),                                   // 7: bytebase.v1.Task.Type
+	(Task_DatabaseDataUpdate_RollbackSqlStatus)(0),   // 8: bytebase.v1.Task.DatabaseDataUpdate.RollbackSqlStatus
+	(TaskRun_Status)(0),                              // 9: bytebase.v1.TaskRun.Status
+	(TaskRun_ExecutionStatus)(0),                     // 10: bytebase.v1.TaskRun.ExecutionStatus
+	(TaskRun_ExecutionDetail_Step_Status)(0),         // 11: bytebase.v1.TaskRun.ExecutionDetail.Step.Status
+	(TaskRun_CheckResult_Type)(0),                    // 12: bytebase.v1.TaskRun.CheckResult.Type
+	(TaskRunArtifact_Type)(0),                        // 13: bytebase.v1.TaskRunArtifact.Type
+	(TaskRunLogEntry_Level)(0),                       // 14: bytebase.v1.TaskRunLogEntry.Level
+	(*GetPlanRequest)(nil),                           // 15: bytebase.v1.GetPlanRequest
+	(*ListPlansRequest)(nil),                         // 16: bytebase.v1.ListPlansRequest
+	(*ListPlansResponse)(nil),                        // 17: bytebase.v1.ListPlansResponse
+	(*CreatePlanRequest)(nil),                        // 18: bytebase.v1.CreatePlanRequest
+	(*UpdatePlanRequest)(nil),                        // 19: bytebase.v1.UpdatePlanRequest
+	(*Plan)(nil),                                     // 20: bytebase.v1.Plan
+	(*ListPlanCheckRunsRequest)(nil),                 // 21: bytebase.v1.ListPlanCheckRunsRequest
+	(*ListPlanCheckRunsResponse)(nil),                // 22: bytebase.v1.ListPlanCheckRunsResponse
+	(*RunPlanChecksRequest)(nil),                     // 23: bytebase.v1.RunPlanChecksRequest
+	(*RunPlanChecksResponse)(nil),                    // 24: bytebase.v1.RunPlanChecksResponse
+	(*BatchRunTasksRequest)(nil),                     // 25: bytebase.v1.BatchRunTasksRequest
+	(*BatchRunTasksResponse)(nil),                    // 26: bytebase.v1.BatchRunTasksResponse
+	(*BatchSkipTasksRequest)(nil),                    // 27: bytebase.v1.BatchSkipTasksRequest
+	(*BatchSkipTasksResponse)(nil),                   // 28: bytebase.v1.BatchSkipTasksResponse
+	(*ApproveStageRequest)(nil),                      // 29: bytebase.v1.ApproveStageRequest
+	(*ApproveStageResponse)(nil),                     // 30: bytebase.v1.ApproveStageResponse
+	(*BatchCancelTaskRunsRequest)(nil),               // 31: bytebase.v1.BatchCancelTaskRunsRequest
+	(*BatchCancelTaskRunsResponse)(nil),              // 32: bytebase.v1.BatchCancelTaskRunsResponse
+	(*BatchRetryFailedTasksRequest)(nil),             // 33: bytebase.v1.BatchRetryFailedTasksRequest
+	(*BatchRetryFailedTasksResponse)(nil),            // 34: bytebase.v1.BatchRetryFailedTasksResponse
+	(*BatchCancelPendingTaskRunsRequest)(nil),        // 35: bytebase.v1.BatchCancelPendingTaskRunsRequest
+	(*BatchCancelPendingTaskRunsResponse)(nil),       // 36: bytebase.v1.BatchCancelPendingTaskRunsResponse
+	(*CutoverTaskRequest)(nil),                       // 37: bytebase.v1.CutoverTaskRequest
+	(*CutoverTaskResponse)(nil),                      // 38: bytebase.v1.CutoverTaskResponse
+	(*ListTaskRunArtifactsRequest)(nil),              // 39: bytebase.v1.ListTaskRunArtifactsRequest
+	(*ListTaskRunArtifactsResponse)(nil),             // 40: bytebase.v1.ListTaskRunArtifactsResponse
+	(*GetTaskRunArtifactRequest)(nil),                // 41: bytebase.v1.GetTaskRunArtifactRequest
+	(*ListTaskRunLogEntriesRequest)(nil),             // 42: bytebase.v1.ListTaskRunLogEntriesRequest
+	(*ListTaskRunLogEntriesResponse)(nil),            // 43: bytebase.v1.ListTaskRunLogEntriesResponse
+	(*PlanCheckRun)(nil),                             // 44: bytebase.v1.PlanCheckRun
+	(*GetRolloutRequest)(nil),                        // 45: bytebase.v1.GetRolloutRequest
+	(*CreateRolloutRequest)(nil),                     // 46: bytebase.v1.CreateRolloutRequest
+	(*PreviewRolloutRequest)(nil),                    // 47: bytebase.v1.PreviewRolloutRequest
+	(*PreviewPlanRequest)(nil),                       // 48: bytebase.v1.PreviewPlanRequest
+	(*PlanPreview)(nil),                              // 49: bytebase.v1.PlanPreview
+	(*ListTaskRunsRequest)(nil),                      // 50: bytebase.v1.ListTaskRunsRequest
+	(*ListTaskRunsResponse)(nil),                     // 51: bytebase.v1.ListTaskRunsResponse
+	(*Rollout)(nil),                                  // 52: bytebase.v1.Rollout
+	(*Stage)(nil),                                    // 53: bytebase.v1.Stage
+	(*StageChangeRequest)(nil),                       // 54: bytebase.v1.StageChangeRequest
+	(*StageCanary)(nil),                              // 55: bytebase.v1.StageCanary
+	(*StageApprovalGate)(nil),                        // 56: bytebase.v1.StageApprovalGate
+	(*Task)(nil),                                     // 57: bytebase.v1.Task
+	(*TaskRun)(nil),                                  // 58: bytebase.v1.TaskRun
+	(*TaskRunArtifact)(nil),                          // 59: bytebase.v1.TaskRunArtifact
+	(*TaskRunLogEntry)(nil),                          // 60: bytebase.v1.TaskRunLogEntry
+	(*Plan_Step)(nil),                                // 61: bytebase.v1.Plan.Step
+	(*Plan_Spec)(nil),                                // 62: bytebase.v1.Plan.Spec
+	(*Plan_CreateDatabaseConfig)(nil),                // 63: bytebase.v1.Plan.CreateDatabaseConfig
+	(*Plan_ChangeDatabaseConfig)(nil),                // 64: bytebase.v1.Plan.ChangeDatabaseConfig
+	(*Plan_RestoreDatabaseConfig)(nil),               // 65: bytebase.v1.Plan.RestoreDatabaseConfig
+	(*Plan_ProvisionDatabaseConfig)(nil),             // 66: bytebase.v1.Plan.ProvisionDatabaseConfig
+	(*Plan_MigrateDataConfig)(nil),                   // 67: bytebase.v1.Plan.MigrateDataConfig
+	nil,                                              // 68: bytebase.v1.Plan.CreateDatabaseConfig.LabelsEntry
+	(*Plan_ChangeDatabaseConfig_RollbackDetail)(nil), // 69: bytebase.v1.Plan.ChangeDatabaseConfig.RollbackDetail
+	nil, // 70: bytebase.v1.Plan.ChangeDatabaseConfig.GhostFlagsEntry
+	(*Plan_ChangeDatabaseConfig_PreUpdateBackupDetail)(nil), // 71: bytebase.v1.Plan.ChangeDatabaseConfig.PreUpdateBackupDetail
+	(*Plan_ChangeDatabaseConfig_BatchConfig)(nil),           // 72: bytebase.v1.Plan.ChangeDatabaseConfig.BatchConfig
+	(*Plan_ChangeDatabaseConfig_OnlineMigrationConfig)(nil), // 73: bytebase.v1.Plan.ChangeDatabaseConfig.OnlineMigrationConfig
+	(*Plan_ChangeDatabaseConfig_Check)(nil),                 // 74: bytebase.v1.Plan.ChangeDatabaseConfig.Check
+	(*PlanCheckRun_Result)(nil),                             // 75: bytebase.v1.PlanCheckRun.Result
+	(*PlanCheckRun_Result_SqlSummaryReport)(nil),            // 76: bytebase.v1.PlanCheckRun.Result.SqlSummaryReport
+	(*PlanCheckRun_Result_SqlReviewReport)(nil),             // 77: bytebase.v1.PlanCheckRun.Result.SqlReviewReport
+	(*PlanCheckRun_Result_QueryPlanReport)(nil),             // 78: bytebase.v1.PlanCheckRun.Result.QueryPlanReport
+	(*PlanPreview_StagePolicy)(nil),                         // 79: bytebase.v1.PlanPreview.StagePolicy
+	(*StageApprovalGate_Approval)(nil),                      // 80: bytebase.v1.StageApprovalGate.Approval
+	(*Task_DatabaseCreate)(nil),                             // 81: bytebase.v1.Task.DatabaseCreate
+	(*Task_DatabaseSchemaBaseline)(nil),                     // 82: bytebase.v1.Task.DatabaseSchemaBaseline
+	(*Task_DatabaseSchemaUpdate)(nil),                       // 83: bytebase.v1.Task.DatabaseSchemaUpdate
+	(*Task_DatabaseDataUpdate)(nil),                         // 84: bytebase.v1.Task.DatabaseDataUpdate
+	(*Task_DatabaseBackup)(nil),                             // 85: bytebase.v1.Task.DatabaseBackup
+	(*Task_DatabaseRestoreRestore)(nil),                     // 86: bytebase.v1.Task.DatabaseRestoreRestore
+	(*Task_DatabaseDataProvision)(nil),                      // 87: bytebase.v1.Task.DatabaseDataProvision
+	(*Task_DatabaseDataMigrate)(nil),                        // 88: bytebase.v1.Task.DatabaseDataMigrate
+	nil,                                                     // 89: bytebase.v1.Task.DatabaseCreate.LabelsEntry
+	(*TaskRun_ExecutionDetail)(nil),                         // 90: bytebase.v1.TaskRun.ExecutionDetail
+	(*TaskRun_CheckResult)(nil),                             // 91: bytebase.v1.TaskRun.CheckResult
+	(*TaskRun_ExecutionDetail_Position)(nil),                // 92: bytebase.v1.TaskRun.ExecutionDetail.Position
+	(*TaskRun_ExecutionDetail_Step)(nil),                    // 93: bytebase.v1.TaskRun.ExecutionDetail.Step
+	(*fieldmaskpb.FieldMask)(nil),                           // 94: google.protobuf.FieldMask
+	(*BatchOperationFailure)(nil),                           // 95: bytebase.v1.BatchOperationFailure
+	(*durationpb.Duration)(nil),                             // 96: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                           // 97: google.protobuf.Timestamp
+	(Risk_Source)(0),                                        // 98: bytebase.v1.Risk.Source
+	(*ApprovalTemplate)(nil),                                // 99: bytebase.v1.ApprovalTemplate
+	(*ChangedResources)(nil),                                // 100: bytebase.v1.ChangedResources
+	(*RolloutPolicy)(nil),                                   // 101: bytebase.v1.RolloutPolicy
 }
 var file_v1_rollout_service_proto_depIdxs = []int32{
-	14, // 0: bytebase.v1.ListPlansResponse.plans:type_name -> bytebase.v1.Plan
-	14, // 1: bytebase.v1.CreatePlanRequest.plan:type_name -> bytebase.v1.Plan
-	14, // 2: bytebase.v1.UpdatePlanRequest.plan:type_name -> bytebase.v1.Plan
-	56, // 3: bytebase.v1.UpdatePlanRequest.update_mask:type_name -> google.protobuf.FieldMask
-	35, // 4: bytebase.v1.Plan.steps:type_name -> bytebase.v1.Plan.Step
-	25, // 5: bytebase.v1.ListPlanCheckRunsResponse.plan_check_runs:type_name -> bytebase.v1.PlanCheckRun
-	1,  // 6: bytebase.v1.PlanCheckRun.type:type_name -> bytebase.v1.PlanCheckRun.Type
-	2,  // 7: bytebase.v1.PlanCheckRun.status:type_name -> bytebase.v1.PlanCheckRun.Status
-	44, // 8: bytebase.v1.PlanCheckRun.results:type_name -> bytebase.v1.PlanCheckRun.Result
-	57, // 9: bytebase.v1.PlanCheckRun.create_time:type_name -> google.protobuf.Timestamp
-	14, // 10: bytebase.v1.PreviewRolloutRequest.plan:type_name -> bytebase.v1.Plan
-	34, // 11: bytebase.v1.ListTaskRunsResponse.task_runs:type_name -> bytebase.v1.TaskRun
-	32, // 12: bytebase.v1.Rollout.stages:type_name -> bytebase.v1.Stage
-	33, // 13: bytebase.v1.Stage.tasks:type_name -> bytebase.v1.Task
-	4,  // 14: bytebase.v1.Task.status:type_name -> bytebase.v1.Task.Status
-	5,  // 15: bytebase.v1.Task.type:type_name -> bytebase.v1.Task.Type
-	47, // 16: bytebase.v1.Task.database_create:type_name -> bytebase.v1.Task.DatabaseCreate
-	48, // 17: bytebase.v1.Task.database_schema_baseline:type_name -> bytebase.v1.Task.DatabaseSchemaBaseline
-	49, // 18: bytebase.v1.Task.database_schema_update:type_name -> bytebase.v1.Task.DatabaseSchemaUpdate
-	50, // 19: bytebase.v1.Task.database_data_update:type_name -> bytebase.v1.Task.DatabaseDataUpdate
-	51, // 20: bytebase.v1.Task.database_backup:type_name -> bytebase.v1.Task.DatabaseBackup
-	52, // 21: bytebase.v1.Task.database_restore_restore:type_name -> bytebase.v1.Task.DatabaseRestoreRestore
-	57, // 22: bytebase.v1.TaskRun.create_time:type_name -> google.protobuf.Timestamp
-	57, // 23: bytebase.v1.TaskRun.update_time:type_name -> google.protobuf.Timestamp
-	7,  // 24: bytebase.v1.TaskRun.status:type_name -> bytebase.v1.TaskRun.Status
-	8,  // 25: bytebase.v1.TaskRun.execution_status:type_name -> bytebase.v1.TaskRun.ExecutionStatus
-	57, // 26: bytebase.v1.TaskRun.execution_status_update_time:type_name -> google.protobuf.Timestamp
-	54, // 27: bytebase.v1.TaskRun.execution_detail:type_name -> bytebase.v1.TaskRun.ExecutionDetail
-	57, // 28: bytebase.v1.TaskRun.start_time:type_name -> google.protobuf.Timestamp
-	36, // 29: bytebase.v1.Plan.Step.specs:type_name -> bytebase.v1.Plan.Spec
-	57, // 30: bytebase.v1.Plan.Spec.earliest_allowed_time:type_name -> google.protobuf.Timestamp
-	37, // 31: bytebase.v1.Plan.Spec.create_database_config:type_name -> bytebase.v1.Plan.CreateDatabaseConfig
-	38, // 32: bytebase.v1.Plan.Spec.change_database_config:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig
-	39, // 33: bytebase.v1.Plan.Spec.restore_database_config:type_name -> bytebase.v1.Plan.RestoreDatabaseConfig
-	40, // 34: bytebase.v1.Plan.CreateDatabaseConfig.labels:type_name -> bytebase.v1.Plan.CreateDatabaseConfig.LabelsEntry
-	0,  // 35: bytebase.v1.Plan.ChangeDatabaseConfig.type:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig.Type
-	41, // 36: bytebase.v1.Plan.ChangeDatabaseConfig.rollback_detail:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig.RollbackDetail
-	42, // 37: bytebase.v1.Plan.ChangeDatabaseConfig.ghost_flags:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig.GhostFlagsEntry
-	43, // 38: bytebase.v1.Plan.ChangeDatabaseConfig.pre_update_backup_detail:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig.PreUpdateBackupDetail
-	37, // 39: bytebase.v1.Plan.RestoreDatabaseConfig.create_database_config:type_name -> bytebase.v1.Plan.CreateDatabaseConfig
-	57, // 40: bytebase.v1.Plan.RestoreDatabaseConfig.point_in_time:type_name -> google.protobuf.Timestamp
-	3,  // 41: bytebase.v1.PlanCheckRun.Result.status:type_name -> bytebase.v1.PlanCheckRun.Result.Status
-	45, // 42: bytebase.v1.PlanCheckRun.Result.sql_summary_report:type_name -> bytebase.v1.PlanCheckRun.Result.SqlSummaryReport
-	46, // 43: bytebase.v1.PlanCheckRun.Result.sql_review_report:type_name -> bytebase.v1.PlanCheckRun.Result.SqlReviewReport
-	58, // 44: bytebase.v1.PlanCheckRun.Result.SqlSummaryReport.changed_resources:type_name -> bytebase.v1.ChangedResources
-	53, // 45: bytebase.v1.Task.DatabaseCreate.labels:type_name -> bytebase.v1.Task.DatabaseCreate.LabelsEntry
-	6,  // 46: bytebase.v1.Task.DatabaseDataUpdate.rollback_sql_status:type_name -> bytebase.v1.Task.DatabaseDataUpdate.RollbackSqlStatus
-	57, // 47: bytebase.v1.Task.DatabaseRestoreRestore.point_in_time:type_name -> google.protobuf.Timestamp
-	55, // 48: bytebase.v1.TaskRun.ExecutionDetail.command_start_position:type_name -> bytebase.v1.TaskRun.ExecutionDetail.Position
-	55, // 49: bytebase.v1.TaskRun.ExecutionDetail.command_end_position:type_name -> bytebase.v1.TaskRun.ExecutionDetail.Position
-	9,  // 50: bytebase.v1.RolloutService.GetPlan:input_type -> bytebase.v1.GetPlanRequest
-	10, // 51: bytebase.v1.RolloutService.ListPlans:input_type -> bytebase.v1.ListPlansRequest
-	12, // 52: bytebase.v1.RolloutService.CreatePlan:input_type -> bytebase.v1.CreatePlanRequest
-	13, // 53: bytebase.v1.RolloutService.UpdatePlan:input_type -> bytebase.v1.UpdatePlanRequest
-	26, // 54: bytebase.v1.RolloutService.GetRollout:input_type -> bytebase.v1.GetRolloutRequest
-	27, // 55: bytebase.v1.RolloutService.CreateRollout:input_type -> bytebase.v1.CreateRolloutRequest
-	28, // 56: bytebase.v1.RolloutService.PreviewRollout:input_type -> bytebase.v1.PreviewRolloutRequest
-	29, // 57: bytebase.v1.RolloutService.ListTaskRuns:input_type -> bytebase.v1.ListTaskRunsRequest
-	15, // 58: bytebase.v1.RolloutService.ListPlanCheckRuns:input_type -> bytebase.v1.ListPlanCheckRunsRequest
-	17, // 59: bytebase.v1.RolloutService.RunPlanChecks:input_type -> bytebase.v1.RunPlanChecksRequest
-	19, // 60: bytebase.v1.RolloutService.BatchRunTasks:input_type -> bytebase.v1.BatchRunTasksRequest
-	21, // 61: bytebase.v1.RolloutService.BatchSkipTasks:input_type -> bytebase.v1.BatchSkipTasksRequest
-	23, // 62: bytebase.v1.RolloutService.BatchCancelTaskRuns:input_type -> bytebase.v1.BatchCancelTaskRunsRequest
-	14, // 63: bytebase.v1.RolloutService.GetPlan:output_type -> bytebase.v1.Plan
-	11, // 64: bytebase.v1.RolloutService.ListPlans:output_type -> bytebase.v1.ListPlansResponse
-	14, // 65: bytebase.v1.RolloutService.CreatePlan:output_type -> bytebase.v1.Plan
-	14, // 66: bytebase.v1.RolloutService.UpdatePlan:output_type -> bytebase.v1.Plan
-	31, // 67: bytebase.v1.RolloutService.GetRollout:output_type -> bytebase.v1.Rollout
-	31, // 68: bytebase.v1.RolloutService.CreateRollout:output_type -> bytebase.v1.Rollout
-	31, // 69: bytebase.v1.RolloutService.PreviewRollout:output_type -> bytebase.v1.Rollout
-	30, // 70: bytebase.v1.RolloutService.ListTaskRuns:output_type -> bytebase.v1.ListTaskRunsResponse
-	16, // 71: bytebase.v1.RolloutService.ListPlanCheckRuns:output_type -> bytebase.v1.ListPlanCheckRunsResponse
-	18, // 72: bytebase.v1.RolloutService.RunPlanChecks:output_type -> bytebase.v1.RunPlanChecksResponse
-	20, // 73: bytebase.v1.RolloutService.BatchRunTasks:output_type -> bytebase.v1.BatchRunTasksResponse
-	22, // 74: bytebase.v1.RolloutService.BatchSkipTasks:output_type -> bytebase.v1.BatchSkipTasksResponse
-	24, // 75: bytebase.v1.RolloutService.BatchCancelTaskRuns:output_type -> bytebase.v1.BatchCancelTaskRunsResponse
-	63, // [63:76] is the sub-list for method output_type
-	50, // [50:63] is the sub-list for method input_type
-	50, // [50:50] is the sub-list for extension type_name
-	50, // [50:50] is the sub-list for extension extendee
-	0,  // [0:50] is the sub-list for field type_name
+	20,  // 0: bytebase.v1.ListPlansResponse.plans:type_name -> bytebase.v1.Plan
+	20,  // 1: bytebase.v1.CreatePlanRequest.plan:type_name -> bytebase.v1.Plan
+	20,  // 2: bytebase.v1.UpdatePlanRequest.plan:type_name -> bytebase.v1.Plan
+	94,  // 3: bytebase.v1.UpdatePlanRequest.update_mask:type_name -> google.protobuf.FieldMask
+	61,  // 4: bytebase.v1.Plan.steps:type_name -> bytebase.v1.Plan.Step
+	44,  // 5: bytebase.v1.ListPlanCheckRunsResponse.plan_check_runs:type_name -> bytebase.v1.PlanCheckRun
+	95,  // 6: bytebase.v1.BatchRetryFailedTasksResponse.failures:type_name -> bytebase.v1.BatchOperationFailure
+	95,  // 7: bytebase.v1.BatchCancelPendingTaskRunsResponse.failures:type_name -> bytebase.v1.BatchOperationFailure
+	59,  // 8: bytebase.v1.ListTaskRunArtifactsResponse.artifacts:type_name -> bytebase.v1.TaskRunArtifact
+	96,  // 9: bytebase.v1.ListTaskRunLogEntriesRequest.wait:type_name -> google.protobuf.Duration
+	60,  // 10: bytebase.v1.ListTaskRunLogEntriesResponse.entries:type_name -> bytebase.v1.TaskRunLogEntry
+	1,   // 11: bytebase.v1.PlanCheckRun.type:type_name -> bytebase.v1.PlanCheckRun.Type
+	2,   // 12: bytebase.v1.PlanCheckRun.status:type_name -> bytebase.v1.PlanCheckRun.Status
+	75,  // 13: bytebase.v1.PlanCheckRun.results:type_name -> bytebase.v1.PlanCheckRun.Result
+	97,  // 14: bytebase.v1.PlanCheckRun.create_time:type_name -> google.protobuf.Timestamp
+	20,  // 15: bytebase.v1.PreviewRolloutRequest.plan:type_name -> bytebase.v1.Plan
+	20,  // 16: bytebase.v1.PreviewPlanRequest.plan:type_name -> bytebase.v1.Plan
+	52,  // 17: bytebase.v1.PlanPreview.rollout:type_name -> bytebase.v1.Rollout
+	79,  // 18: bytebase.v1.PlanPreview.stage_policies:type_name -> bytebase.v1.PlanPreview.StagePolicy
+	98,  // 19: bytebase.v1.PlanPreview.risk_source:type_name -> bytebase.v1.Risk.Source
+	99,  // 20: bytebase.v1.PlanPreview.approval_template:type_name -> bytebase.v1.ApprovalTemplate
+	58,  // 21: bytebase.v1.ListTaskRunsResponse.task_runs:type_name -> bytebase.v1.TaskRun
+	53,  // 22: bytebase.v1.Rollout.stages:type_name -> bytebase.v1.Stage
+	57,  // 23: bytebase.v1.Stage.tasks:type_name -> bytebase.v1.Task
+	56,  // 24: bytebase.v1.Stage.approval_gate:type_name -> bytebase.v1.StageApprovalGate
+	55,  // 25: bytebase.v1.Stage.canary:type_name -> bytebase.v1.StageCanary
+	54,  // 26: bytebase.v1.Stage.change_request:type_name -> bytebase.v1.StageChangeRequest
+	4,   // 27: bytebase.v1.StageChangeRequest.status:type_name -> bytebase.v1.StageChangeRequest.Status
+	96,  // 28: bytebase.v1.StageCanary.bake_duration:type_name -> google.protobuf.Duration
+	5,   // 29: bytebase.v1.StageCanary.status:type_name -> bytebase.v1.StageCanary.Status
+	80,  // 30: bytebase.v1.StageApprovalGate.approvals:type_name -> bytebase.v1.StageApprovalGate.Approval
+	6,   // 31: bytebase.v1.Task.status:type_name -> bytebase.v1.Task.Status
+	7,   // 32: bytebase.v1.Task.type:type_name -> bytebase.v1.Task.Type
+	81,  // 33: bytebase.v1.Task.database_create:type_name -> bytebase.v1.Task.DatabaseCreate
+	82,  // 34: bytebase.v1.Task.database_schema_baseline:type_name -> bytebase.v1.Task.DatabaseSchemaBaseline
+	83,  // 35: bytebase.v1.Task.database_schema_update:type_name -> bytebase.v1.Task.DatabaseSchemaUpdate
+	84,  // 36: bytebase.v1.Task.database_data_update:type_name -> bytebase.v1.Task.DatabaseDataUpdate
+	85,  // 37: bytebase.v1.Task.database_backup:type_name -> bytebase.v1.Task.DatabaseBackup
+	86,  // 38: bytebase.v1.Task.database_restore_restore:type_name -> bytebase.v1.Task.DatabaseRestoreRestore
+	87,  // 39: bytebase.v1.Task.database_data_provision:type_name -> bytebase.v1.Task.DatabaseDataProvision
+	88,  // 40: bytebase.v1.Task.database_data_migrate:type_name -> bytebase.v1.Task.DatabaseDataMigrate
+	97,  // 41: bytebase.v1.TaskRun.create_time:type_name -> google.protobuf.Timestamp
+	97,  // 42: bytebase.v1.TaskRun.update_time:type_name -> google.protobuf.Timestamp
+	9,   // 43: bytebase.v1.TaskRun.status:type_name -> bytebase.v1.TaskRun.Status
+	10,  // 44: bytebase.v1.TaskRun.execution_status:type_name -> bytebase.v1.TaskRun.ExecutionStatus
+	97,  // 45: bytebase.v1.TaskRun.execution_status_update_time:type_name -> google.protobuf.Timestamp
+	90,  // 46: bytebase.v1.TaskRun.execution_detail:type_name -> bytebase.v1.TaskRun.ExecutionDetail
+	97,  // 47: bytebase.v1.TaskRun.start_time:type_name -> google.protobuf.Timestamp
+	91,  // 48: bytebase.v1.TaskRun.check_results:type_name -> bytebase.v1.TaskRun.CheckResult
+	13,  // 49: bytebase.v1.TaskRunArtifact.type:type_name -> bytebase.v1.TaskRunArtifact.Type
+	97,  // 50: bytebase.v1.TaskRunArtifact.create_time:type_name -> google.protobuf.Timestamp
+	14,  // 51: bytebase.v1.TaskRunLogEntry.level:type_name -> bytebase.v1.TaskRunLogEntry.Level
+	97,  // 52: bytebase.v1.TaskRunLogEntry.create_time:type_name -> google.protobuf.Timestamp
+	62,  // 53: bytebase.v1.Plan.Step.specs:type_name -> bytebase.v1.Plan.Spec
+	97,  // 54: bytebase.v1.Plan.Spec.earliest_allowed_time:type_name -> google.protobuf.Timestamp
+	63,  // 55: bytebase.v1.Plan.Spec.create_database_config:type_name -> bytebase.v1.Plan.CreateDatabaseConfig
+	64,  // 56: bytebase.v1.Plan.Spec.change_database_config:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig
+	65,  // 57: bytebase.v1.Plan.Spec.restore_database_config:type_name -> bytebase.v1.Plan.RestoreDatabaseConfig
+	66,  // 58: bytebase.v1.Plan.Spec.provision_database_config:type_name -> bytebase.v1.Plan.ProvisionDatabaseConfig
+	67,  // 59: bytebase.v1.Plan.Spec.migrate_data_config:type_name -> bytebase.v1.Plan.MigrateDataConfig
+	68,  // 60: bytebase.v1.Plan.CreateDatabaseConfig.labels:type_name -> bytebase.v1.Plan.CreateDatabaseConfig.LabelsEntry
+	0,   // 61: bytebase.v1.Plan.ChangeDatabaseConfig.type:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig.Type
+	69,  // 62: bytebase.v1.Plan.ChangeDatabaseConfig.rollback_detail:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig.RollbackDetail
+	70,  // 63: bytebase.v1.Plan.ChangeDatabaseConfig.ghost_flags:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig.GhostFlagsEntry
+	71,  // 64: bytebase.v1.Plan.ChangeDatabaseConfig.pre_update_backup_detail:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig.PreUpdateBackupDetail
+	72,  // 65: bytebase.v1.Plan.ChangeDatabaseConfig.batch_config:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig.BatchConfig
+	73,  // 66: bytebase.v1.Plan.ChangeDatabaseConfig.online_migration_config:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig.OnlineMigrationConfig
+	74,  // 67: bytebase.v1.Plan.ChangeDatabaseConfig.pre_checks:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig.Check
+	74,  // 68: bytebase.v1.Plan.ChangeDatabaseConfig.post_checks:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig.Check
+	63,  // 69: bytebase.v1.Plan.RestoreDatabaseConfig.create_database_config:type_name -> bytebase.v1.Plan.CreateDatabaseConfig
+	97,  // 70: bytebase.v1.Plan.RestoreDatabaseConfig.point_in_time:type_name -> google.protobuf.Timestamp
+	96,  // 71: bytebase.v1.Plan.ChangeDatabaseConfig.BatchConfig.sleep_interval:type_name -> google.protobuf.Duration
+	3,   // 72: bytebase.v1.PlanCheckRun.Result.status:type_name -> bytebase.v1.PlanCheckRun.Result.Status
+	76,  // 73: bytebase.v1.PlanCheckRun.Result.sql_summary_report:type_name -> bytebase.v1.PlanCheckRun.Result.SqlSummaryReport
+	77,  // 74: bytebase.v1.PlanCheckRun.Result.sql_review_report:type_name -> bytebase.v1.PlanCheckRun.Result.SqlReviewReport
+	78,  // 75: bytebase.v1.PlanCheckRun.Result.query_plan_report:type_name -> bytebase.v1.PlanCheckRun.Result.QueryPlanReport
+	100, // 76: bytebase.v1.PlanCheckRun.Result.SqlSummaryReport.changed_resources:type_name -> bytebase.v1.ChangedResources
+	101, // 77: bytebase.v1.PlanPreview.StagePolicy.rollout_policy:type_name -> bytebase.v1.RolloutPolicy
+	97,  // 78: bytebase.v1.StageApprovalGate.Approval.create_time:type_name -> google.protobuf.Timestamp
+	89,  // 79: bytebase.v1.Task.DatabaseCreate.labels:type_name -> bytebase.v1.Task.DatabaseCreate.LabelsEntry
+	8,   // 80: bytebase.v1.Task.DatabaseSchemaUpdate.rollback_sql_status:type_name -> bytebase.v1.Task.DatabaseDataUpdate.RollbackSqlStatus
+	8,   // 81: bytebase.v1.Task.DatabaseDataUpdate.rollback_sql_status:type_name -> bytebase.v1.Task.DatabaseDataUpdate.RollbackSqlStatus
+	97,  // 82: bytebase.v1.Task.DatabaseRestoreRestore.point_in_time:type_name -> google.protobuf.Timestamp
+	92,  // 83: bytebase.v1.TaskRun.ExecutionDetail.command_start_position:type_name -> bytebase.v1.TaskRun.ExecutionDetail.Position
+	92,  // 84: bytebase.v1.TaskRun.ExecutionDetail.command_end_position:type_name -> bytebase.v1.TaskRun.ExecutionDetail.Position
+	96,  // 85: bytebase.v1.TaskRun.ExecutionDetail.replication_lag:type_name -> google.protobuf.Duration
+	96,  // 86: bytebase.v1.TaskRun.ExecutionDetail.estimated_time_remaining:type_name -> google.protobuf.Duration
+	93,  // 87: bytebase.v1.TaskRun.ExecutionDetail.steps:type_name -> bytebase.v1.TaskRun.ExecutionDetail.Step
+	12,  // 88: bytebase.v1.TaskRun.CheckResult.type:type_name -> bytebase.v1.TaskRun.CheckResult.Type
+	11,  // 89: bytebase.v1.TaskRun.ExecutionDetail.Step.status:type_name -> bytebase.v1.TaskRun.ExecutionDetail.Step.Status
+	15,  // 90: bytebase.v1.RolloutService.GetPlan:input_type -> bytebase.v1.GetPlanRequest
+	16,  // 91: bytebase.v1.RolloutService.ListPlans:input_type -> bytebase.v1.ListPlansRequest
+	18,  // 92: bytebase.v1.RolloutService.CreatePlan:input_type -> bytebase.v1.CreatePlanRequest
+	19,  // 93: bytebase.v1.RolloutService.UpdatePlan:input_type -> bytebase.v1.UpdatePlanRequest
+	45,  // 94: bytebase.v1.RolloutService.GetRollout:input_type -> bytebase.v1.GetRolloutRequest
+	46,  // 95: bytebase.v1.RolloutService.CreateRollout:input_type -> bytebase.v1.CreateRolloutRequest
+	47,  // 96: bytebase.v1.RolloutService.PreviewRollout:input_type -> bytebase.v1.PreviewRolloutRequest
+	48,  // 97: bytebase.v1.RolloutService.PreviewPlan:input_type -> bytebase.v1.PreviewPlanRequest
+	50,  // 98: bytebase.v1.RolloutService.ListTaskRuns:input_type -> bytebase.v1.ListTaskRunsRequest
+	21,  // 99: bytebase.v1.RolloutService.ListPlanCheckRuns:input_type -> bytebase.v1.ListPlanCheckRunsRequest
+	23,  // 100: bytebase.v1.RolloutService.RunPlanChecks:input_type -> bytebase.v1.RunPlanChecksRequest
+	25,  // 101: bytebase.v1.RolloutService.BatchRunTasks:input_type -> bytebase.v1.BatchRunTasksRequest
+	27,  // 102: bytebase.v1.RolloutService.BatchSkipTasks:input_type -> bytebase.v1.BatchSkipTasksRequest
+	29,  // 103: bytebase.v1.RolloutService.ApproveStage:input_type -> bytebase.v1.ApproveStageRequest
+	31,  // 104: bytebase.v1.RolloutService.BatchCancelTaskRuns:input_type -> bytebase.v1.BatchCancelTaskRunsRequest
+	33,  // 105: bytebase.v1.RolloutService.BatchRetryFailedTasks:input_type -> bytebase.v1.BatchRetryFailedTasksRequest
+	35,  // 106: bytebase.v1.RolloutService.BatchCancelPendingTaskRuns:input_type -> bytebase.v1.BatchCancelPendingTaskRunsRequest
+	37,  // 107: bytebase.v1.RolloutService.CutoverTask:input_type -> bytebase.v1.CutoverTaskRequest
+	39,  // 108: bytebase.v1.RolloutService.ListTaskRunArtifacts:input_type -> bytebase.v1.ListTaskRunArtifactsRequest
+	41,  // 109: bytebase.v1.RolloutService.GetTaskRunArtifact:input_type -> bytebase.v1.GetTaskRunArtifactRequest
+	42,  // 110: bytebase.v1.RolloutService.ListTaskRunLogEntries:input_type -> bytebase.v1.ListTaskRunLogEntriesRequest
+	20,  // 111: bytebase.v1.RolloutService.GetPlan:output_type -> bytebase.v1.Plan
+	17,  // 112: bytebase.v1.RolloutService.ListPlans:output_type -> bytebase.v1.ListPlansResponse
+	20,  // 113: bytebase.v1.RolloutService.CreatePlan:output_type -> bytebase.v1.Plan
+	20,  // 114: bytebase.v1.RolloutService.UpdatePlan:output_type -> bytebase.v1.Plan
+	52,  // 115: bytebase.v1.RolloutService.GetRollout:output_type -> bytebase.v1.Rollout
+	52,  // 116: bytebase.v1.RolloutService.CreateRollout:output_type -> bytebase.v1.Rollout
+	52,  // 117: bytebase.v1.RolloutService.PreviewRollout:output_type -> bytebase.v1.Rollout
+	49,  // 118: bytebase.v1.RolloutService.PreviewPlan:output_type -> bytebase.v1.PlanPreview
+	51,  // 119: bytebase.v1.RolloutService.ListTaskRuns:output_type -> bytebase.v1.ListTaskRunsResponse
+	22,  // 120: bytebase.v1.RolloutService.ListPlanCheckRuns:output_type -> bytebase.v1.ListPlanCheckRunsResponse
+	24,  // 121: bytebase.v1.RolloutService.RunPlanChecks:output_type -> bytebase.v1.RunPlanChecksResponse
+	26,  // 122: bytebase.v1.RolloutService.BatchRunTasks:output_type -> bytebase.v1.BatchRunTasksResponse
+	28,  // 123: bytebase.v1.RolloutService.BatchSkipTasks:output_type -> bytebase.v1.BatchSkipTasksResponse
+	30,  // 124: bytebase.v1.RolloutService.ApproveStage:output_type -> bytebase.v1.ApproveStageResponse
+	32,  // 125: bytebase.v1.RolloutService.BatchCancelTaskRuns:output_type -> bytebase.v1.BatchCancelTaskRunsResponse
+	34,  // 126: bytebase.v1.RolloutService.BatchRetryFailedTasks:output_type -> bytebase.v1.BatchRetryFailedTasksResponse
+	36,  // 127: bytebase.v1.RolloutService.BatchCancelPendingTaskRuns:output_type -> bytebase.v1.BatchCancelPendingTaskRunsResponse
+	38,  // 128: bytebase.v1.RolloutService.CutoverTask:output_type -> bytebase.v1.CutoverTaskResponse
+	40,  // 129: bytebase.v1.RolloutService.ListTaskRunArtifacts:output_type -> bytebase.v1.ListTaskRunArtifactsResponse
+	59,  // 130: bytebase.v1.RolloutService.GetTaskRunArtifact:output_type -> bytebase.v1.TaskRunArtifact
+	43,  // 131: bytebase.v1.RolloutService.ListTaskRunLogEntries:output_type -> bytebase.v1.ListTaskRunLogEntriesResponse
+	111, // [111:132] is the sub-list for method output_type
+	90,  // [90:111] is the sub-list for method input_type
+	90,  // [90:90] is the sub-list for extension type_name
+	90,  // [90:90] is the sub-list for extension extendee
+	0,   // [0:90] is the sub-list for field type_name
 }
 
 func init() { file_v1_rollout_service_proto_init() }
@@ -4846,6 +8169,9 @@ func file_v1_rollout_service_proto_init() {
 		return
 	}
 	file_v1_database_service_proto_init()
+	file_v1_issue_service_proto_init()
+	file_v1_org_policy_service_proto_init()
+	file_v1_risk_service_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_v1_rollout_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPlanRequest); i {
@@ -4859,8 +8185,212 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPlansRequest); i {
+		file_v1_rollout_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlansRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlansResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Plan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlanCheckRunsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlanCheckRunsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunPlanChecksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunPlanChecksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchRunTasksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchRunTasksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSkipTasksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSkipTasksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveStageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveStageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCancelTaskRunsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCancelTaskRunsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchRetryFailedTasksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4871,8 +8401,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPlansResponse); i {
+		file_v1_rollout_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchRetryFailedTasksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4883,8 +8413,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePlanRequest); i {
+		file_v1_rollout_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCancelPendingTaskRunsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4895,8 +8425,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePlanRequest); i {
+		file_v1_rollout_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCancelPendingTaskRunsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4907,8 +8437,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Plan); i {
+		file_v1_rollout_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CutoverTaskRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4919,8 +8449,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPlanCheckRunsRequest); i {
+		file_v1_rollout_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CutoverTaskResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4931,8 +8461,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPlanCheckRunsResponse); i {
+		file_v1_rollout_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTaskRunArtifactsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4943,8 +8473,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunPlanChecksRequest); i {
+		file_v1_rollout_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTaskRunArtifactsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4955,8 +8485,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunPlanChecksResponse); i {
+		file_v1_rollout_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTaskRunArtifactRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4967,8 +8497,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchRunTasksRequest); i {
+		file_v1_rollout_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTaskRunLogEntriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4979,8 +8509,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchRunTasksResponse); i {
+		file_v1_rollout_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTaskRunLogEntriesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4991,8 +8521,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchSkipTasksRequest); i {
+		file_v1_rollout_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanCheckRun); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5003,8 +8533,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchSkipTasksResponse); i {
+		file_v1_rollout_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRolloutRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5015,8 +8545,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCancelTaskRunsRequest); i {
+		file_v1_rollout_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRolloutRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5027,8 +8557,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCancelTaskRunsResponse); i {
+		file_v1_rollout_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewRolloutRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5039,8 +8569,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlanCheckRun); i {
+		file_v1_rollout_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewPlanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5051,8 +8581,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRolloutRequest); i {
+		file_v1_rollout_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanPreview); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5063,8 +8593,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRolloutRequest); i {
+		file_v1_rollout_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTaskRunsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5075,8 +8605,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PreviewRolloutRequest); i {
+		file_v1_rollout_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTaskRunsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5087,8 +8617,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTaskRunsRequest); i {
+		file_v1_rollout_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rollout); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5099,8 +8629,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTaskRunsResponse); i {
+		file_v1_rollout_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5111,8 +8641,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Rollout); i {
+		file_v1_rollout_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StageChangeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5123,8 +8653,8 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stage); i {
+		file_v1_rollout_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StageCanary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5135,7 +8665,19 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StageApprovalGate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Task); i {
 			case 0:
 				return &v.state
@@ -5147,7 +8689,7 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TaskRun); i {
 			case 0:
 				return &v.state
@@ -5159,7 +8701,31 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskRunArtifact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskRunLogEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Plan_Step); i {
 			case 0:
 				return &v.state
@@ -5171,7 +8737,7 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Plan_Spec); i {
 			case 0:
 				return &v.state
@@ -5183,7 +8749,7 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Plan_CreateDatabaseConfig); i {
 			case 0:
 				return &v.state
@@ -5195,7 +8761,7 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Plan_ChangeDatabaseConfig); i {
 			case 0:
 				return &v.state
@@ -5207,7 +8773,7 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Plan_RestoreDatabaseConfig); i {
 			case 0:
 				return &v.state
@@ -5219,7 +8785,31 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Plan_ProvisionDatabaseConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Plan_MigrateDataConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Plan_ChangeDatabaseConfig_RollbackDetail); i {
 			case 0:
 				return &v.state
@@ -5231,7 +8821,7 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Plan_ChangeDatabaseConfig_PreUpdateBackupDetail); i {
 			case 0:
 				return &v.state
@@ -5243,7 +8833,43 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Plan_ChangeDatabaseConfig_BatchConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Plan_ChangeDatabaseConfig_OnlineMigrationConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Plan_ChangeDatabaseConfig_Check); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PlanCheckRun_Result); i {
 			case 0:
 				return &v.state
@@ -5255,7 +8881,7 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PlanCheckRun_Result_SqlSummaryReport); i {
 			case 0:
 				return &v.state
@@ -5267,7 +8893,7 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PlanCheckRun_Result_SqlReviewReport); i {
 			case 0:
 				return &v.state
@@ -5279,7 +8905,43 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanCheckRun_Result_QueryPlanReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanPreview_StagePolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StageApprovalGate_Approval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Task_DatabaseCreate); i {
 			case 0:
 				return &v.state
@@ -5291,7 +8953,7 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Task_DatabaseSchemaBaseline); i {
 			case 0:
 				return &v.state
@@ -5303,7 +8965,7 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Task_DatabaseSchemaUpdate); i {
 			case 0:
 				return &v.state
@@ -5315,7 +8977,7 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Task_DatabaseDataUpdate); i {
 			case 0:
 				return &v.state
@@ -5327,7 +8989,7 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Task_DatabaseBackup); i {
 			case 0:
 				return &v.state
@@ -5339,7 +9001,7 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Task_DatabaseRestoreRestore); i {
 			case 0:
 				return &v.state
@@ -5351,7 +9013,31 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Task_DatabaseDataProvision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Task_DatabaseDataMigrate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TaskRun_ExecutionDetail); i {
 			case 0:
 				return &v.state
@@ -5363,7 +9049,19 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_rollout_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_rollout_service_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskRun_CheckResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_rollout_service_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TaskRun_ExecutionDetail_Position); i {
 			case 0:
 				return &v.state
@@ -5375,30 +9073,48 @@ func file_v1_rollout_service_proto_init() {
 				return nil
 			}
 		}
+		file_v1_rollout_service_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskRun_ExecutionDetail_Step); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_v1_rollout_service_proto_msgTypes[24].OneofWrappers = []interface{}{
+	file_v1_rollout_service_proto_msgTypes[42].OneofWrappers = []interface{}{
 		(*Task_DatabaseCreate_)(nil),
 		(*Task_DatabaseSchemaBaseline_)(nil),
 		(*Task_DatabaseSchemaUpdate_)(nil),
 		(*Task_DatabaseDataUpdate_)(nil),
 		(*Task_DatabaseBackup_)(nil),
 		(*Task_DatabaseRestoreRestore_)(nil),
+		(*Task_DatabaseDataProvision_)(nil),
+		(*Task_DatabaseDataMigrate_)(nil),
 	}
-	file_v1_rollout_service_proto_msgTypes[27].OneofWrappers = []interface{}{
+	file_v1_rollout_service_proto_msgTypes[47].OneofWrappers = []interface{}{
 		(*Plan_Spec_CreateDatabaseConfig)(nil),
 		(*Plan_Spec_ChangeDatabaseConfig)(nil),
 		(*Plan_Spec_RestoreDatabaseConfig)(nil),
+		(*Plan_Spec_ProvisionDatabaseConfig)(nil),
+		(*Plan_Spec_MigrateDataConfig)(nil),
 	}
-	file_v1_rollout_service_proto_msgTypes[29].OneofWrappers = []interface{}{}
-	file_v1_rollout_service_proto_msgTypes[30].OneofWrappers = []interface{}{
+	file_v1_rollout_service_proto_msgTypes[49].OneofWrappers = []interface{}{}
+	file_v1_rollout_service_proto_msgTypes[50].OneofWrappers = []interface{}{
 		(*Plan_RestoreDatabaseConfig_Backup)(nil),
 		(*Plan_RestoreDatabaseConfig_PointInTime)(nil),
 	}
-	file_v1_rollout_service_proto_msgTypes[35].OneofWrappers = []interface{}{
+	file_v1_rollout_service_proto_msgTypes[59].OneofWrappers = []interface{}{}
+	file_v1_rollout_service_proto_msgTypes[60].OneofWrappers = []interface{}{
 		(*PlanCheckRun_Result_SqlSummaryReport_)(nil),
 		(*PlanCheckRun_Result_SqlReviewReport_)(nil),
+		(*PlanCheckRun_Result_QueryPlanReport_)(nil),
 	}
-	file_v1_rollout_service_proto_msgTypes[43].OneofWrappers = []interface{}{
+	file_v1_rollout_service_proto_msgTypes[71].OneofWrappers = []interface{}{
 		(*Task_DatabaseRestoreRestore_Backup)(nil),
 		(*Task_DatabaseRestoreRestore_PointInTime)(nil),
 	}
@@ -5407,8 +9123,8 @@ func file_v1_rollout_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_rollout_service_proto_rawDesc,
-			NumEnums:      9,
-			NumMessages:   47,
+			NumEnums:      15,
+			NumMessages:   79,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
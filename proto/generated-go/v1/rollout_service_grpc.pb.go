@@ -19,19 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	RolloutService_GetPlan_FullMethodName             = "/bytebase.v1.RolloutService/GetPlan"
-	RolloutService_ListPlans_FullMethodName           = "/bytebase.v1.RolloutService/ListPlans"
-	RolloutService_CreatePlan_FullMethodName          = "/bytebase.v1.RolloutService/CreatePlan"
-	RolloutService_UpdatePlan_FullMethodName          = "/bytebase.v1.RolloutService/UpdatePlan"
-	RolloutService_GetRollout_FullMethodName          = "/bytebase.v1.RolloutService/GetRollout"
-	RolloutService_CreateRollout_FullMethodName       = "/bytebase.v1.RolloutService/CreateRollout"
-	RolloutService_PreviewRollout_FullMethodName      = "/bytebase.v1.RolloutService/PreviewRollout"
-	RolloutService_ListTaskRuns_FullMethodName        = "/bytebase.v1.RolloutService/ListTaskRuns"
-	RolloutService_ListPlanCheckRuns_FullMethodName   = "/bytebase.v1.RolloutService/ListPlanCheckRuns"
-	RolloutService_RunPlanChecks_FullMethodName       = "/bytebase.v1.RolloutService/RunPlanChecks"
-	RolloutService_BatchRunTasks_FullMethodName       = "/bytebase.v1.RolloutService/BatchRunTasks"
-	RolloutService_BatchSkipTasks_FullMethodName      = "/bytebase.v1.RolloutService/BatchSkipTasks"
-	RolloutService_BatchCancelTaskRuns_FullMethodName = "/bytebase.v1.RolloutService/BatchCancelTaskRuns"
+	RolloutService_GetPlan_FullMethodName                    = "/bytebase.v1.RolloutService/GetPlan"
+	RolloutService_ListPlans_FullMethodName                  = "/bytebase.v1.RolloutService/ListPlans"
+	RolloutService_CreatePlan_FullMethodName                 = "/bytebase.v1.RolloutService/CreatePlan"
+	RolloutService_UpdatePlan_FullMethodName                 = "/bytebase.v1.RolloutService/UpdatePlan"
+	RolloutService_GetRollout_FullMethodName                 = "/bytebase.v1.RolloutService/GetRollout"
+	RolloutService_CreateRollout_FullMethodName              = "/bytebase.v1.RolloutService/CreateRollout"
+	RolloutService_PreviewRollout_FullMethodName             = "/bytebase.v1.RolloutService/PreviewRollout"
+	RolloutService_PreviewPlan_FullMethodName                = "/bytebase.v1.RolloutService/PreviewPlan"
+	RolloutService_ListTaskRuns_FullMethodName               = "/bytebase.v1.RolloutService/ListTaskRuns"
+	RolloutService_ListPlanCheckRuns_FullMethodName          = "/bytebase.v1.RolloutService/ListPlanCheckRuns"
+	RolloutService_RunPlanChecks_FullMethodName              = "/bytebase.v1.RolloutService/RunPlanChecks"
+	RolloutService_BatchRunTasks_FullMethodName              = "/bytebase.v1.RolloutService/BatchRunTasks"
+	RolloutService_BatchSkipTasks_FullMethodName             = "/bytebase.v1.RolloutService/BatchSkipTasks"
+	RolloutService_ApproveStage_FullMethodName               = "/bytebase.v1.RolloutService/ApproveStage"
+	RolloutService_BatchCancelTaskRuns_FullMethodName        = "/bytebase.v1.RolloutService/BatchCancelTaskRuns"
+	RolloutService_BatchRetryFailedTasks_FullMethodName      = "/bytebase.v1.RolloutService/BatchRetryFailedTasks"
+	RolloutService_BatchCancelPendingTaskRuns_FullMethodName = "/bytebase.v1.RolloutService/BatchCancelPendingTaskRuns"
+	RolloutService_CutoverTask_FullMethodName                = "/bytebase.v1.RolloutService/CutoverTask"
+	RolloutService_ListTaskRunArtifacts_FullMethodName       = "/bytebase.v1.RolloutService/ListTaskRunArtifacts"
+	RolloutService_GetTaskRunArtifact_FullMethodName         = "/bytebase.v1.RolloutService/GetTaskRunArtifact"
+	RolloutService_ListTaskRunLogEntries_FullMethodName      = "/bytebase.v1.RolloutService/ListTaskRunLogEntries"
 )
 
 // RolloutServiceClient is the client API for RolloutService service.
@@ -45,12 +53,29 @@ type RolloutServiceClient interface {
 	GetRollout(ctx context.Context, in *GetRolloutRequest, opts ...grpc.CallOption) (*Rollout, error)
 	CreateRollout(ctx context.Context, in *CreateRolloutRequest, opts ...grpc.CallOption) (*Rollout, error)
 	PreviewRollout(ctx context.Context, in *PreviewRolloutRequest, opts ...grpc.CallOption) (*Rollout, error)
+	// PreviewPlan returns the rollout shape of the plan without creating anything,
+	// including the stages, the tasks with the resolved target databases, the policies of the stage environments and the required approval.
+	PreviewPlan(ctx context.Context, in *PreviewPlanRequest, opts ...grpc.CallOption) (*PlanPreview, error)
 	ListTaskRuns(ctx context.Context, in *ListTaskRunsRequest, opts ...grpc.CallOption) (*ListTaskRunsResponse, error)
 	ListPlanCheckRuns(ctx context.Context, in *ListPlanCheckRunsRequest, opts ...grpc.CallOption) (*ListPlanCheckRunsResponse, error)
 	RunPlanChecks(ctx context.Context, in *RunPlanChecksRequest, opts ...grpc.CallOption) (*RunPlanChecksResponse, error)
 	BatchRunTasks(ctx context.Context, in *BatchRunTasksRequest, opts ...grpc.CallOption) (*BatchRunTasksResponse, error)
 	BatchSkipTasks(ctx context.Context, in *BatchSkipTasksRequest, opts ...grpc.CallOption) (*BatchSkipTasksResponse, error)
+	// ApproveStage signs off the approval gate of the stage or its tasks with a role of the caller.
+	ApproveStage(ctx context.Context, in *ApproveStageRequest, opts ...grpc.CallOption) (*ApproveStageResponse, error)
 	BatchCancelTaskRuns(ctx context.Context, in *BatchCancelTaskRunsRequest, opts ...grpc.CallOption) (*BatchCancelTaskRunsResponse, error)
+	// BatchRetryFailedTasks runs the failed tasks matching the filter again.
+	BatchRetryFailedTasks(ctx context.Context, in *BatchRetryFailedTasksRequest, opts ...grpc.CallOption) (*BatchRetryFailedTasksResponse, error)
+	// BatchCancelPendingTaskRuns cancels the pending task runs of the rollouts in the environment.
+	BatchCancelPendingTaskRuns(ctx context.Context, in *BatchCancelPendingTaskRunsRequest, opts ...grpc.CallOption) (*BatchCancelPendingTaskRunsResponse, error)
+	// CutoverTask cuts over the gh-ost migration of a postponed cutover task now.
+	CutoverTask(ctx context.Context, in *CutoverTaskRequest, opts ...grpc.CallOption) (*CutoverTaskResponse, error)
+	ListTaskRunArtifacts(ctx context.Context, in *ListTaskRunArtifactsRequest, opts ...grpc.CallOption) (*ListTaskRunArtifactsResponse, error)
+	// GetTaskRunArtifact gets the artifact of a task run with its content.
+	GetTaskRunArtifact(ctx context.Context, in *GetTaskRunArtifactRequest, opts ...grpc.CallOption) (*TaskRunArtifact, error)
+	// ListTaskRunLogEntries lists the log entries of a task run incrementally.
+	// It long-polls for the new entries if there is none yet and the task run is not finished.
+	ListTaskRunLogEntries(ctx context.Context, in *ListTaskRunLogEntriesRequest, opts ...grpc.CallOption) (*ListTaskRunLogEntriesResponse, error)
 }
 
 type rolloutServiceClient struct {
@@ -124,6 +149,15 @@ func (c *rolloutServiceClient) PreviewRollout(ctx context.Context, in *PreviewRo
 	return out, nil
 }
 
+func (c *rolloutServiceClient) PreviewPlan(ctx context.Context, in *PreviewPlanRequest, opts ...grpc.CallOption) (*PlanPreview, error) {
+	out := new(PlanPreview)
+	err := c.cc.Invoke(ctx, RolloutService_PreviewPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rolloutServiceClient) ListTaskRuns(ctx context.Context, in *ListTaskRunsRequest, opts ...grpc.CallOption) (*ListTaskRunsResponse, error) {
 	out := new(ListTaskRunsResponse)
 	err := c.cc.Invoke(ctx, RolloutService_ListTaskRuns_FullMethodName, in, out, opts...)
@@ -169,6 +203,15 @@ func (c *rolloutServiceClient) BatchSkipTasks(ctx context.Context, in *BatchSkip
 	return out, nil
 }
 
+func (c *rolloutServiceClient) ApproveStage(ctx context.Context, in *ApproveStageRequest, opts ...grpc.CallOption) (*ApproveStageResponse, error) {
+	out := new(ApproveStageResponse)
+	err := c.cc.Invoke(ctx, RolloutService_ApproveStage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rolloutServiceClient) BatchCancelTaskRuns(ctx context.Context, in *BatchCancelTaskRunsRequest, opts ...grpc.CallOption) (*BatchCancelTaskRunsResponse, error) {
 	out := new(BatchCancelTaskRunsResponse)
 	err := c.cc.Invoke(ctx, RolloutService_BatchCancelTaskRuns_FullMethodName, in, out, opts...)
@@ -178,6 +221,60 @@ func (c *rolloutServiceClient) BatchCancelTaskRuns(ctx context.Context, in *Batc
 	return out, nil
 }
 
+func (c *rolloutServiceClient) BatchRetryFailedTasks(ctx context.Context, in *BatchRetryFailedTasksRequest, opts ...grpc.CallOption) (*BatchRetryFailedTasksResponse, error) {
+	out := new(BatchRetryFailedTasksResponse)
+	err := c.cc.Invoke(ctx, RolloutService_BatchRetryFailedTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rolloutServiceClient) BatchCancelPendingTaskRuns(ctx context.Context, in *BatchCancelPendingTaskRunsRequest, opts ...grpc.CallOption) (*BatchCancelPendingTaskRunsResponse, error) {
+	out := new(BatchCancelPendingTaskRunsResponse)
+	err := c.cc.Invoke(ctx, RolloutService_BatchCancelPendingTaskRuns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rolloutServiceClient) CutoverTask(ctx context.Context, in *CutoverTaskRequest, opts ...grpc.CallOption) (*CutoverTaskResponse, error) {
+	out := new(CutoverTaskResponse)
+	err := c.cc.Invoke(ctx, RolloutService_CutoverTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rolloutServiceClient) ListTaskRunArtifacts(ctx context.Context, in *ListTaskRunArtifactsRequest, opts ...grpc.CallOption) (*ListTaskRunArtifactsResponse, error) {
+	out := new(ListTaskRunArtifactsResponse)
+	err := c.cc.Invoke(ctx, RolloutService_ListTaskRunArtifacts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rolloutServiceClient) GetTaskRunArtifact(ctx context.Context, in *GetTaskRunArtifactRequest, opts ...grpc.CallOption) (*TaskRunArtifact, error) {
+	out := new(TaskRunArtifact)
+	err := c.cc.Invoke(ctx, RolloutService_GetTaskRunArtifact_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rolloutServiceClient) ListTaskRunLogEntries(ctx context.Context, in *ListTaskRunLogEntriesRequest, opts ...grpc.CallOption) (*ListTaskRunLogEntriesResponse, error) {
+	out := new(ListTaskRunLogEntriesResponse)
+	err := c.cc.Invoke(ctx, RolloutService_ListTaskRunLogEntries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RolloutServiceServer is the server API for RolloutService service.
 // All implementations must embed UnimplementedRolloutServiceServer
 // for forward compatibility
@@ -189,12 +286,29 @@ type RolloutServiceServer interface {
 	GetRollout(context.Context, *GetRolloutRequest) (*Rollout, error)
 	CreateRollout(context.Context, *CreateRolloutRequest) (*Rollout, error)
 	PreviewRollout(context.Context, *PreviewRolloutRequest) (*Rollout, error)
+	// PreviewPlan returns the rollout shape of the plan without creating anything,
+	// including the stages, the tasks with the resolved target databases, the policies of the stage environments and the required approval.
+	PreviewPlan(context.Context, *PreviewPlanRequest) (*PlanPreview, error)
 	ListTaskRuns(context.Context, *ListTaskRunsRequest) (*ListTaskRunsResponse, error)
 	ListPlanCheckRuns(context.Context, *ListPlanCheckRunsRequest) (*ListPlanCheckRunsResponse, error)
 	RunPlanChecks(context.Context, *RunPlanChecksRequest) (*RunPlanChecksResponse, error)
 	BatchRunTasks(context.Context, *BatchRunTasksRequest) (*BatchRunTasksResponse, error)
 	BatchSkipTasks(context.Context, *BatchSkipTasksRequest) (*BatchSkipTasksResponse, error)
+	// ApproveStage signs off the approval gate of the stage or its tasks with a role of the caller.
+	ApproveStage(context.Context, *ApproveStageRequest) (*ApproveStageResponse, error)
 	BatchCancelTaskRuns(context.Context, *BatchCancelTaskRunsRequest) (*BatchCancelTaskRunsResponse, error)
+	// BatchRetryFailedTasks runs the failed tasks matching the filter again.
+	BatchRetryFailedTasks(context.Context, *BatchRetryFailedTasksRequest) (*BatchRetryFailedTasksResponse, error)
+	// BatchCancelPendingTaskRuns cancels the pending task runs of the rollouts in the environment.
+	BatchCancelPendingTaskRuns(context.Context, *BatchCancelPendingTaskRunsRequest) (*BatchCancelPendingTaskRunsResponse, error)
+	// CutoverTask cuts over the gh-ost migration of a postponed cutover task now.
+	CutoverTask(context.Context, *CutoverTaskRequest) (*CutoverTaskResponse, error)
+	ListTaskRunArtifacts(context.Context, *ListTaskRunArtifactsRequest) (*ListTaskRunArtifactsResponse, error)
+	// GetTaskRunArtifact gets the artifact of a task run with its content.
+	GetTaskRunArtifact(context.Context, *GetTaskRunArtifactRequest) (*TaskRunArtifact, error)
+	// ListTaskRunLogEntries lists the log entries of a task run incrementally.
+	// It long-polls for the new entries if there is none yet and the task run is not finished.
+	ListTaskRunLogEntries(context.Context, *ListTaskRunLogEntriesRequest) (*ListTaskRunLogEntriesResponse, error)
 	mustEmbedUnimplementedRolloutServiceServer()
 }
 
@@ -223,6 +337,9 @@ func (UnimplementedRolloutServiceServer) CreateRollout(context.Context, *CreateR
 func (UnimplementedRolloutServiceServer) PreviewRollout(context.Context, *PreviewRolloutRequest) (*Rollout, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PreviewRollout not implemented")
 }
+func (UnimplementedRolloutServiceServer) PreviewPlan(context.Context, *PreviewPlanRequest) (*PlanPreview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewPlan not implemented")
+}
 func (UnimplementedRolloutServiceServer) ListTaskRuns(context.Context, *ListTaskRunsRequest) (*ListTaskRunsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTaskRuns not implemented")
 }
@@ -238,9 +355,30 @@ func (UnimplementedRolloutServiceServer) BatchRunTasks(context.Context, *BatchRu
 func (UnimplementedRolloutServiceServer) BatchSkipTasks(context.Context, *BatchSkipTasksRequest) (*BatchSkipTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchSkipTasks not implemented")
 }
+func (UnimplementedRolloutServiceServer) ApproveStage(context.Context, *ApproveStageRequest) (*ApproveStageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveStage not implemented")
+}
 func (UnimplementedRolloutServiceServer) BatchCancelTaskRuns(context.Context, *BatchCancelTaskRunsRequest) (*BatchCancelTaskRunsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCancelTaskRuns not implemented")
 }
+func (UnimplementedRolloutServiceServer) BatchRetryFailedTasks(context.Context, *BatchRetryFailedTasksRequest) (*BatchRetryFailedTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchRetryFailedTasks not implemented")
+}
+func (UnimplementedRolloutServiceServer) BatchCancelPendingTaskRuns(context.Context, *BatchCancelPendingTaskRunsRequest) (*BatchCancelPendingTaskRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCancelPendingTaskRuns not implemented")
+}
+func (UnimplementedRolloutServiceServer) CutoverTask(context.Context, *CutoverTaskRequest) (*CutoverTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CutoverTask not implemented")
+}
+func (UnimplementedRolloutServiceServer) ListTaskRunArtifacts(context.Context, *ListTaskRunArtifactsRequest) (*ListTaskRunArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskRunArtifacts not implemented")
+}
+func (UnimplementedRolloutServiceServer) GetTaskRunArtifact(context.Context, *GetTaskRunArtifactRequest) (*TaskRunArtifact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskRunArtifact not implemented")
+}
+func (UnimplementedRolloutServiceServer) ListTaskRunLogEntries(context.Context, *ListTaskRunLogEntriesRequest) (*ListTaskRunLogEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskRunLogEntries not implemented")
+}
 func (UnimplementedRolloutServiceServer) mustEmbedUnimplementedRolloutServiceServer() {}
 
 // UnsafeRolloutServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -380,6 +518,24 @@ func _RolloutService_PreviewRollout_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RolloutService_PreviewPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).PreviewPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolloutService_PreviewPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).PreviewPlan(ctx, req.(*PreviewPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RolloutService_ListTaskRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListTaskRunsRequest)
 	if err := dec(in); err != nil {
@@ -470,6 +626,24 @@ func _RolloutService_BatchSkipTasks_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RolloutService_ApproveStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveStageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).ApproveStage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolloutService_ApproveStage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).ApproveStage(ctx, req.(*ApproveStageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RolloutService_BatchCancelTaskRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchCancelTaskRunsRequest)
 	if err := dec(in); err != nil {
@@ -488,6 +662,114 @@ func _RolloutService_BatchCancelTaskRuns_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RolloutService_BatchRetryFailedTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRetryFailedTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).BatchRetryFailedTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolloutService_BatchRetryFailedTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).BatchRetryFailedTasks(ctx, req.(*BatchRetryFailedTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RolloutService_BatchCancelPendingTaskRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCancelPendingTaskRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).BatchCancelPendingTaskRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolloutService_BatchCancelPendingTaskRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).BatchCancelPendingTaskRuns(ctx, req.(*BatchCancelPendingTaskRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RolloutService_CutoverTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CutoverTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).CutoverTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolloutService_CutoverTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).CutoverTask(ctx, req.(*CutoverTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RolloutService_ListTaskRunArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskRunArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).ListTaskRunArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolloutService_ListTaskRunArtifacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).ListTaskRunArtifacts(ctx, req.(*ListTaskRunArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RolloutService_GetTaskRunArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskRunArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).GetTaskRunArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolloutService_GetTaskRunArtifact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).GetTaskRunArtifact(ctx, req.(*GetTaskRunArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RolloutService_ListTaskRunLogEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskRunLogEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).ListTaskRunLogEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolloutService_ListTaskRunLogEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).ListTaskRunLogEntries(ctx, req.(*ListTaskRunLogEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RolloutService_ServiceDesc is the grpc.ServiceDesc for RolloutService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -523,6 +805,10 @@ var RolloutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PreviewRollout",
 			Handler:    _RolloutService_PreviewRollout_Handler,
 		},
+		{
+			MethodName: "PreviewPlan",
+			Handler:    _RolloutService_PreviewPlan_Handler,
+		},
 		{
 			MethodName: "ListTaskRuns",
 			Handler:    _RolloutService_ListTaskRuns_Handler,
@@ -543,10 +829,38 @@ var RolloutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchSkipTasks",
 			Handler:    _RolloutService_BatchSkipTasks_Handler,
 		},
+		{
+			MethodName: "ApproveStage",
+			Handler:    _RolloutService_ApproveStage_Handler,
+		},
 		{
 			MethodName: "BatchCancelTaskRuns",
 			Handler:    _RolloutService_BatchCancelTaskRuns_Handler,
 		},
+		{
+			MethodName: "BatchRetryFailedTasks",
+			Handler:    _RolloutService_BatchRetryFailedTasks_Handler,
+		},
+		{
+			MethodName: "BatchCancelPendingTaskRuns",
+			Handler:    _RolloutService_BatchCancelPendingTaskRuns_Handler,
+		},
+		{
+			MethodName: "CutoverTask",
+			Handler:    _RolloutService_CutoverTask_Handler,
+		},
+		{
+			MethodName: "ListTaskRunArtifacts",
+			Handler:    _RolloutService_ListTaskRunArtifacts_Handler,
+		},
+		{
+			MethodName: "GetTaskRunArtifact",
+			Handler:    _RolloutService_GetTaskRunArtifact_Handler,
+		},
+		{
+			MethodName: "ListTaskRunLogEntries",
+			Handler:    _RolloutService_ListTaskRunLogEntries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/rollout_service.proto",
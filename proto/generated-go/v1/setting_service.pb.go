@@ -24,6 +24,105 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// The format of the exported bundle.
+type ExportWorkspaceConfigRequest_Format int32
+
+const (
+	ExportWorkspaceConfigRequest_FORMAT_UNSPECIFIED ExportWorkspaceConfigRequest_Format = 0
+	ExportWorkspaceConfigRequest_YAML               ExportWorkspaceConfigRequest_Format = 1
+	ExportWorkspaceConfigRequest_JSON               ExportWorkspaceConfigRequest_Format = 2
+)
+
+// Enum value maps for ExportWorkspaceConfigRequest_Format.
+var (
+	ExportWorkspaceConfigRequest_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "YAML",
+		2: "JSON",
+	}
+	ExportWorkspaceConfigRequest_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"YAML":               1,
+		"JSON":               2,
+	}
+)
+
+func (x ExportWorkspaceConfigRequest_Format) Enum() *ExportWorkspaceConfigRequest_Format {
+	p := new(ExportWorkspaceConfigRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ExportWorkspaceConfigRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportWorkspaceConfigRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_setting_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ExportWorkspaceConfigRequest_Format) Type() protoreflect.EnumType {
+	return &file_v1_setting_service_proto_enumTypes[0]
+}
+
+func (x ExportWorkspaceConfigRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportWorkspaceConfigRequest_Format.Descriptor instead.
+func (ExportWorkspaceConfigRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type WorkspaceConfigChange_Action int32
+
+const (
+	WorkspaceConfigChange_ACTION_UNSPECIFIED WorkspaceConfigChange_Action = 0
+	WorkspaceConfigChange_CREATE             WorkspaceConfigChange_Action = 1
+	WorkspaceConfigChange_UPDATE             WorkspaceConfigChange_Action = 2
+)
+
+// Enum value maps for WorkspaceConfigChange_Action.
+var (
+	WorkspaceConfigChange_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "CREATE",
+		2: "UPDATE",
+	}
+	WorkspaceConfigChange_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"CREATE":             1,
+		"UPDATE":             2,
+	}
+)
+
+func (x WorkspaceConfigChange_Action) Enum() *WorkspaceConfigChange_Action {
+	p := new(WorkspaceConfigChange_Action)
+	*p = x
+	return p
+}
+
+func (x WorkspaceConfigChange_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceConfigChange_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_setting_service_proto_enumTypes[1].Descriptor()
+}
+
+func (WorkspaceConfigChange_Action) Type() protoreflect.EnumType {
+	return &file_v1_setting_service_proto_enumTypes[1]
+}
+
+func (x WorkspaceConfigChange_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceConfigChange_Action.Descriptor instead.
+func (WorkspaceConfigChange_Action) EnumDescriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{9, 0}
+}
+
 // We support three types of SMTP encryption: NONE, STARTTLS, and SSL/TLS.
 type SMTPMailDeliverySettingValue_Encryption int32
 
@@ -61,11 +160,11 @@ func (x SMTPMailDeliverySettingValue_Encryption) String() string {
 }
 
 func (SMTPMailDeliverySettingValue_Encryption) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_setting_service_proto_enumTypes[0].Descriptor()
+	return file_v1_setting_service_proto_enumTypes[2].Descriptor()
 }
 
 func (SMTPMailDeliverySettingValue_Encryption) Type() protoreflect.EnumType {
-	return &file_v1_setting_service_proto_enumTypes[0]
+	return &file_v1_setting_service_proto_enumTypes[2]
 }
 
 func (x SMTPMailDeliverySettingValue_Encryption) Number() protoreflect.EnumNumber {
@@ -74,7 +173,7 @@ func (x SMTPMailDeliverySettingValue_Encryption) Number() protoreflect.EnumNumbe
 
 // Deprecated: Use SMTPMailDeliverySettingValue_Encryption.Descriptor instead.
 func (SMTPMailDeliverySettingValue_Encryption) EnumDescriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{7, 0}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{13, 0}
 }
 
 // We support four types of SMTP authentication: NONE, PLAIN, LOGIN, and CRAM-MD5.
@@ -117,11 +216,11 @@ func (x SMTPMailDeliverySettingValue_Authentication) String() string {
 }
 
 func (SMTPMailDeliverySettingValue_Authentication) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_setting_service_proto_enumTypes[1].Descriptor()
+	return file_v1_setting_service_proto_enumTypes[3].Descriptor()
 }
 
 func (SMTPMailDeliverySettingValue_Authentication) Type() protoreflect.EnumType {
-	return &file_v1_setting_service_proto_enumTypes[1]
+	return &file_v1_setting_service_proto_enumTypes[3]
 }
 
 func (x SMTPMailDeliverySettingValue_Authentication) Number() protoreflect.EnumNumber {
@@ -130,7 +229,7 @@ func (x SMTPMailDeliverySettingValue_Authentication) Number() protoreflect.EnumN
 
 // Deprecated: Use SMTPMailDeliverySettingValue_Authentication.Descriptor instead.
 func (SMTPMailDeliverySettingValue_Authentication) EnumDescriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{7, 1}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{13, 1}
 }
 
 type AppIMSetting_IMType int32
@@ -163,11 +262,11 @@ func (x AppIMSetting_IMType) String() string {
 }
 
 func (AppIMSetting_IMType) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_setting_service_proto_enumTypes[2].Descriptor()
+	return file_v1_setting_service_proto_enumTypes[4].Descriptor()
 }
 
 func (AppIMSetting_IMType) Type() protoreflect.EnumType {
-	return &file_v1_setting_service_proto_enumTypes[2]
+	return &file_v1_setting_service_proto_enumTypes[4]
 }
 
 func (x AppIMSetting_IMType) Number() protoreflect.EnumNumber {
@@ -176,7 +275,7 @@ func (x AppIMSetting_IMType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AppIMSetting_IMType.Descriptor instead.
 func (AppIMSetting_IMType) EnumDescriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{8, 0}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{14, 0}
 }
 
 // We support three levels of AlertLevel: INFO, WARNING, and ERROR.
@@ -216,11 +315,11 @@ func (x Announcement_AlertLevel) String() string {
 }
 
 func (Announcement_AlertLevel) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_setting_service_proto_enumTypes[3].Descriptor()
+	return file_v1_setting_service_proto_enumTypes[5].Descriptor()
 }
 
 func (Announcement_AlertLevel) Type() protoreflect.EnumType {
-	return &file_v1_setting_service_proto_enumTypes[3]
+	return &file_v1_setting_service_proto_enumTypes[5]
 }
 
 func (x Announcement_AlertLevel) Number() protoreflect.EnumNumber {
@@ -229,7 +328,217 @@ func (x Announcement_AlertLevel) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Announcement_AlertLevel.Descriptor instead.
 func (Announcement_AlertLevel) EnumDescriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{11, 0}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{17, 0}
+}
+
+// How the decision of the external approval is learned.
+type ExternalApprovalSetting_Node_Mode int32
+
+const (
+	// The unspecified mode is treated as POLL.
+	ExternalApprovalSetting_Node_MODE_UNSPECIFIED ExternalApprovalSetting_Node_Mode = 0
+	// The relay service is polled for the decision.
+	ExternalApprovalSetting_Node_POLL ExternalApprovalSetting_Node_Mode = 1
+	// The external service, e.g. ServiceNow or Jira, posts the decision to the callback URL
+	// sent in the create request. It requires the workspace external URL.
+	ExternalApprovalSetting_Node_CALLBACK ExternalApprovalSetting_Node_Mode = 2
+	// The approval is decided by the status of the linked Jira ticket of the issue.
+	// It requires the workspace Jira setting.
+	ExternalApprovalSetting_Node_JIRA ExternalApprovalSetting_Node_Mode = 3
+)
+
+// Enum value maps for ExternalApprovalSetting_Node_Mode.
+var (
+	ExternalApprovalSetting_Node_Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "POLL",
+		2: "CALLBACK",
+		3: "JIRA",
+	}
+	ExternalApprovalSetting_Node_Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"POLL":             1,
+		"CALLBACK":         2,
+		"JIRA":             3,
+	}
+)
+
+func (x ExternalApprovalSetting_Node_Mode) Enum() *ExternalApprovalSetting_Node_Mode {
+	p := new(ExternalApprovalSetting_Node_Mode)
+	*p = x
+	return p
+}
+
+func (x ExternalApprovalSetting_Node_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExternalApprovalSetting_Node_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_setting_service_proto_enumTypes[6].Descriptor()
+}
+
+func (ExternalApprovalSetting_Node_Mode) Type() protoreflect.EnumType {
+	return &file_v1_setting_service_proto_enumTypes[6]
+}
+
+func (x ExternalApprovalSetting_Node_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExternalApprovalSetting_Node_Mode.Descriptor instead.
+func (ExternalApprovalSetting_Node_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{19, 0, 0}
+}
+
+type EventBusSetting_Type int32
+
+const (
+	// The events are not published.
+	EventBusSetting_TYPE_UNSPECIFIED EventBusSetting_Type = 0
+	EventBusSetting_KAFKA            EventBusSetting_Type = 1
+	EventBusSetting_NATS             EventBusSetting_Type = 2
+)
+
+// Enum value maps for EventBusSetting_Type.
+var (
+	EventBusSetting_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "KAFKA",
+		2: "NATS",
+	}
+	EventBusSetting_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"KAFKA":            1,
+		"NATS":             2,
+	}
+)
+
+func (x EventBusSetting_Type) Enum() *EventBusSetting_Type {
+	p := new(EventBusSetting_Type)
+	*p = x
+	return p
+}
+
+func (x EventBusSetting_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventBusSetting_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_setting_service_proto_enumTypes[7].Descriptor()
+}
+
+func (EventBusSetting_Type) Type() protoreflect.EnumType {
+	return &file_v1_setting_service_proto_enumTypes[7]
+}
+
+func (x EventBusSetting_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventBusSetting_Type.Descriptor instead.
+func (EventBusSetting_Type) EnumDescriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{33, 0}
+}
+
+type AuditLogSetting_ExportType int32
+
+const (
+	// The audit logs are not exported.
+	AuditLogSetting_EXPORT_TYPE_UNSPECIFIED AuditLogSetting_ExportType = 0
+	AuditLogSetting_S3                      AuditLogSetting_ExportType = 1
+	AuditLogSetting_SYSLOG                  AuditLogSetting_ExportType = 2
+)
+
+// Enum value maps for AuditLogSetting_ExportType.
+var (
+	AuditLogSetting_ExportType_name = map[int32]string{
+		0: "EXPORT_TYPE_UNSPECIFIED",
+		1: "S3",
+		2: "SYSLOG",
+	}
+	AuditLogSetting_ExportType_value = map[string]int32{
+		"EXPORT_TYPE_UNSPECIFIED": 0,
+		"S3":                      1,
+		"SYSLOG":                  2,
+	}
+)
+
+func (x AuditLogSetting_ExportType) Enum() *AuditLogSetting_ExportType {
+	p := new(AuditLogSetting_ExportType)
+	*p = x
+	return p
+}
+
+func (x AuditLogSetting_ExportType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditLogSetting_ExportType) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_setting_service_proto_enumTypes[8].Descriptor()
+}
+
+func (AuditLogSetting_ExportType) Type() protoreflect.EnumType {
+	return &file_v1_setting_service_proto_enumTypes[8]
+}
+
+func (x AuditLogSetting_ExportType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditLogSetting_ExportType.Descriptor instead.
+func (AuditLogSetting_ExportType) EnumDescriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{35, 0}
+}
+
+type InstanceDiscoverySetting_Source_Provider int32
+
+const (
+	InstanceDiscoverySetting_Source_PROVIDER_UNSPECIFIED InstanceDiscoverySetting_Source_Provider = 0
+	// AWS lists the RDS instances and the Aurora clusters.
+	InstanceDiscoverySetting_Source_AWS InstanceDiscoverySetting_Source_Provider = 1
+	// GCP lists the Cloud SQL instances.
+	InstanceDiscoverySetting_Source_GCP InstanceDiscoverySetting_Source_Provider = 2
+)
+
+// Enum value maps for InstanceDiscoverySetting_Source_Provider.
+var (
+	InstanceDiscoverySetting_Source_Provider_name = map[int32]string{
+		0: "PROVIDER_UNSPECIFIED",
+		1: "AWS",
+		2: "GCP",
+	}
+	InstanceDiscoverySetting_Source_Provider_value = map[string]int32{
+		"PROVIDER_UNSPECIFIED": 0,
+		"AWS":                  1,
+		"GCP":                  2,
+	}
+)
+
+func (x InstanceDiscoverySetting_Source_Provider) Enum() *InstanceDiscoverySetting_Source_Provider {
+	p := new(InstanceDiscoverySetting_Source_Provider)
+	*p = x
+	return p
+}
+
+func (x InstanceDiscoverySetting_Source_Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstanceDiscoverySetting_Source_Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_setting_service_proto_enumTypes[9].Descriptor()
+}
+
+func (InstanceDiscoverySetting_Source_Provider) Type() protoreflect.EnumType {
+	return &file_v1_setting_service_proto_enumTypes[9]
+}
+
+func (x InstanceDiscoverySetting_Source_Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstanceDiscoverySetting_Source_Provider.Descriptor instead.
+func (InstanceDiscoverySetting_Source_Provider) EnumDescriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{37, 0, 0}
 }
 
 type ListSettingsRequest struct {
@@ -510,23 +819,16 @@ func (x *SetSettingRequest) GetValidateOnly() bool {
 	return false
 }
 
-// The schema of setting.
-type Setting struct {
+type ExportWorkspaceConfigRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The resource name of the setting. Must be one of the following forms:
-	//
-	// - `setting/{setting_name}`
-	// For example, "settings/bb.branding.logo"
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The value of the setting.
-	Value *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Format ExportWorkspaceConfigRequest_Format `protobuf:"varint,1,opt,name=format,proto3,enum=bytebase.v1.ExportWorkspaceConfigRequest_Format" json:"format,omitempty"`
 }
 
-func (x *Setting) Reset() {
-	*x = Setting{}
+func (x *ExportWorkspaceConfigRequest) Reset() {
+	*x = ExportWorkspaceConfigRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_setting_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -534,13 +836,13 @@ func (x *Setting) Reset() {
 	}
 }
 
-func (x *Setting) String() string {
+func (x *ExportWorkspaceConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Setting) ProtoMessage() {}
+func (*ExportWorkspaceConfigRequest) ProtoMessage() {}
 
-func (x *Setting) ProtoReflect() protoreflect.Message {
+func (x *ExportWorkspaceConfigRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_setting_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -552,52 +854,29 @@ func (x *Setting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Setting.ProtoReflect.Descriptor instead.
-func (*Setting) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportWorkspaceConfigRequest.ProtoReflect.Descriptor instead.
+func (*ExportWorkspaceConfigRequest) Descriptor() ([]byte, []int) {
 	return file_v1_setting_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Setting) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Setting) GetValue() *Value {
+func (x *ExportWorkspaceConfigRequest) GetFormat() ExportWorkspaceConfigRequest_Format {
 	if x != nil {
-		return x.Value
+		return x.Format
 	}
-	return nil
+	return ExportWorkspaceConfigRequest_FORMAT_UNSPECIFIED
 }
 
-// The data in setting value.
-type Value struct {
+type ExportWorkspaceConfigResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Value is a oneof field for setting value.
-	//
-	// Types that are assignable to Value:
-	//
-	//	*Value_StringValue
-	//	*Value_SmtpMailDeliverySettingValue
-	//	*Value_AppImSettingValue
-	//	*Value_AgentPluginSettingValue
-	//	*Value_WorkspaceProfileSettingValue
-	//	*Value_WorkspaceApprovalSettingValue
-	//	*Value_WorkspaceTrialSettingValue
-	//	*Value_ExternalApprovalSettingValue
-	//	*Value_SchemaTemplateSettingValue
-	//	*Value_DataClassificationSettingValue
-	//	*Value_SemanticTypeSettingValue
-	//	*Value_MaskingAlgorithmSettingValue
-	Value isValue_Value `protobuf_oneof:"value"`
+	// The YAML or JSON encoded WorkspaceConfig.
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *Value) Reset() {
-	*x = Value{}
+func (x *ExportWorkspaceConfigResponse) Reset() {
+	*x = ExportWorkspaceConfigResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_setting_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -605,13 +884,13 @@ func (x *Value) Reset() {
 	}
 }
 
-func (x *Value) String() string {
+func (x *ExportWorkspaceConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value) ProtoMessage() {}
+func (*ExportWorkspaceConfigResponse) ProtoMessage() {}
 
-func (x *Value) ProtoReflect() protoreflect.Message {
+func (x *ExportWorkspaceConfigResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_setting_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -623,222 +902,2494 @@ func (x *Value) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value.ProtoReflect.Descriptor instead.
-func (*Value) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportWorkspaceConfigResponse.ProtoReflect.Descriptor instead.
+func (*ExportWorkspaceConfigResponse) Descriptor() ([]byte, []int) {
 	return file_v1_setting_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (m *Value) GetValue() isValue_Value {
-	if m != nil {
-		return m.Value
-	}
-	return nil
-}
-
-func (x *Value) GetStringValue() string {
-	if x, ok := x.GetValue().(*Value_StringValue); ok {
-		return x.StringValue
+func (x *ExportWorkspaceConfigResponse) GetContent() string {
+	if x != nil {
+		return x.Content
 	}
 	return ""
 }
 
-func (x *Value) GetSmtpMailDeliverySettingValue() *SMTPMailDeliverySettingValue {
-	if x, ok := x.GetValue().(*Value_SmtpMailDeliverySettingValue); ok {
-		return x.SmtpMailDeliverySettingValue
-	}
-	return nil
+type ApplyWorkspaceConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The YAML or JSON encoded WorkspaceConfig.
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// validate_only validates the bundle and previews the changes without applying them.
+	ValidateOnly bool `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
 }
 
-func (x *Value) GetAppImSettingValue() *AppIMSetting {
-	if x, ok := x.GetValue().(*Value_AppImSettingValue); ok {
-		return x.AppImSettingValue
+func (x *ApplyWorkspaceConfigRequest) Reset() {
+	*x = ApplyWorkspaceConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Value) GetAgentPluginSettingValue() *AgentPluginSetting {
-	if x, ok := x.GetValue().(*Value_AgentPluginSettingValue); ok {
-		return x.AgentPluginSettingValue
-	}
-	return nil
+func (x *ApplyWorkspaceConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Value) GetWorkspaceProfileSettingValue() *WorkspaceProfileSetting {
+func (*ApplyWorkspaceConfigRequest) ProtoMessage() {}
+
+func (x *ApplyWorkspaceConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyWorkspaceConfigRequest.ProtoReflect.Descriptor instead.
+func (*ApplyWorkspaceConfigRequest) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ApplyWorkspaceConfigRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ApplyWorkspaceConfigRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type ApplyWorkspaceConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The changes made to the workspace, or to be made if validate_only is true.
+	// Resources already matching the bundle are not listed.
+	Changes []*WorkspaceConfigChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *ApplyWorkspaceConfigResponse) Reset() {
+	*x = ApplyWorkspaceConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyWorkspaceConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyWorkspaceConfigResponse) ProtoMessage() {}
+
+func (x *ApplyWorkspaceConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyWorkspaceConfigResponse.ProtoReflect.Descriptor instead.
+func (*ApplyWorkspaceConfigResponse) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ApplyWorkspaceConfigResponse) GetChanges() []*WorkspaceConfigChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type WorkspaceConfigChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resource name, e.g. environments/prod or environments/prod/policies/sql_review.
+	Resource string                       `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Action   WorkspaceConfigChange_Action `protobuf:"varint,2,opt,name=action,proto3,enum=bytebase.v1.WorkspaceConfigChange_Action" json:"action,omitempty"`
+	// The updated fields for UPDATE.
+	UpdateMask []string `protobuf:"bytes,3,rep,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *WorkspaceConfigChange) Reset() {
+	*x = WorkspaceConfigChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceConfigChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceConfigChange) ProtoMessage() {}
+
+func (x *WorkspaceConfigChange) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceConfigChange.ProtoReflect.Descriptor instead.
+func (*WorkspaceConfigChange) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WorkspaceConfigChange) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *WorkspaceConfigChange) GetAction() WorkspaceConfigChange_Action {
+	if x != nil {
+		return x.Action
+	}
+	return WorkspaceConfigChange_ACTION_UNSPECIFIED
+}
+
+func (x *WorkspaceConfigChange) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// WorkspaceConfig is the declarative bundle of the workspace configuration.
+type WorkspaceConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Environments []*Environment `protobuf:"bytes,1,rep,name=environments,proto3" json:"environments,omitempty"`
+	// The instances without data source secrets such as passwords, SSL and SSH keys.
+	// Data sources are only used to create missing instances, existing instances keep theirs.
+	Instances []*Instance `protobuf:"bytes,2,rep,name=instances,proto3" json:"instances,omitempty"`
+	// The projects without webhooks.
+	Projects []*Project `protobuf:"bytes,3,rep,name=projects,proto3" json:"projects,omitempty"`
+	// The workspace, environment, project and instance policies, including the SQL review policies.
+	Policies []*Policy `protobuf:"bytes,4,rep,name=policies,proto3" json:"policies,omitempty"`
+}
+
+func (x *WorkspaceConfig) Reset() {
+	*x = WorkspaceConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceConfig) ProtoMessage() {}
+
+func (x *WorkspaceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceConfig.ProtoReflect.Descriptor instead.
+func (*WorkspaceConfig) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WorkspaceConfig) GetEnvironments() []*Environment {
+	if x != nil {
+		return x.Environments
+	}
+	return nil
+}
+
+func (x *WorkspaceConfig) GetInstances() []*Instance {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+func (x *WorkspaceConfig) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *WorkspaceConfig) GetPolicies() []*Policy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+// The schema of setting.
+type Setting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resource name of the setting. Must be one of the following forms:
+	//
+	// - `setting/{setting_name}`
+	// For example, "settings/bb.branding.logo"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The value of the setting.
+	Value *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Setting) Reset() {
+	*x = Setting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Setting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Setting) ProtoMessage() {}
+
+func (x *Setting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Setting.ProtoReflect.Descriptor instead.
+func (*Setting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Setting) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Setting) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// The data in setting value.
+type Value struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Value is a oneof field for setting value.
+	//
+	// Types that are assignable to Value:
+	//
+	//	*Value_StringValue
+	//	*Value_SmtpMailDeliverySettingValue
+	//	*Value_AppImSettingValue
+	//	*Value_AgentPluginSettingValue
+	//	*Value_WorkspaceProfileSettingValue
+	//	*Value_WorkspaceApprovalSettingValue
+	//	*Value_WorkspaceTrialSettingValue
+	//	*Value_ExternalApprovalSettingValue
+	//	*Value_SchemaTemplateSettingValue
+	//	*Value_DataClassificationSettingValue
+	//	*Value_SemanticTypeSettingValue
+	//	*Value_MaskingAlgorithmSettingValue
+	//	*Value_RateLimitSettingValue
+	//	*Value_QueryAuditSettingValue
+	//	*Value_QueryCursorSettingValue
+	//	*Value_QueryHistorySettingValue
+	//	*Value_ArchiveSettingValue
+	//	*Value_JiraSettingValue
+	//	*Value_ServiceNowSettingValue
+	//	*Value_SlackAppSettingValue
+	//	*Value_EventBusSettingValue
+	//	*Value_ScimSettingValue
+	//	*Value_AuditLogSettingValue
+	//	*Value_MaintenanceSettingValue
+	//	*Value_InstanceDiscoverySettingValue
+	Value isValue_Value `protobuf_oneof:"value"`
+}
+
+func (x *Value) Reset() {
+	*x = Value{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value) ProtoMessage() {}
+
+func (x *Value) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value.ProtoReflect.Descriptor instead.
+func (*Value) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (m *Value) GetValue() isValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *Value) GetStringValue() string {
+	if x, ok := x.GetValue().(*Value_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *Value) GetSmtpMailDeliverySettingValue() *SMTPMailDeliverySettingValue {
+	if x, ok := x.GetValue().(*Value_SmtpMailDeliverySettingValue); ok {
+		return x.SmtpMailDeliverySettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetAppImSettingValue() *AppIMSetting {
+	if x, ok := x.GetValue().(*Value_AppImSettingValue); ok {
+		return x.AppImSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetAgentPluginSettingValue() *AgentPluginSetting {
+	if x, ok := x.GetValue().(*Value_AgentPluginSettingValue); ok {
+		return x.AgentPluginSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetWorkspaceProfileSettingValue() *WorkspaceProfileSetting {
 	if x, ok := x.GetValue().(*Value_WorkspaceProfileSettingValue); ok {
 		return x.WorkspaceProfileSettingValue
 	}
-	return nil
+	return nil
+}
+
+func (x *Value) GetWorkspaceApprovalSettingValue() *WorkspaceApprovalSetting {
+	if x, ok := x.GetValue().(*Value_WorkspaceApprovalSettingValue); ok {
+		return x.WorkspaceApprovalSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetWorkspaceTrialSettingValue() *WorkspaceTrialSetting {
+	if x, ok := x.GetValue().(*Value_WorkspaceTrialSettingValue); ok {
+		return x.WorkspaceTrialSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetExternalApprovalSettingValue() *ExternalApprovalSetting {
+	if x, ok := x.GetValue().(*Value_ExternalApprovalSettingValue); ok {
+		return x.ExternalApprovalSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetSchemaTemplateSettingValue() *SchemaTemplateSetting {
+	if x, ok := x.GetValue().(*Value_SchemaTemplateSettingValue); ok {
+		return x.SchemaTemplateSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetDataClassificationSettingValue() *DataClassificationSetting {
+	if x, ok := x.GetValue().(*Value_DataClassificationSettingValue); ok {
+		return x.DataClassificationSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetSemanticTypeSettingValue() *SemanticTypeSetting {
+	if x, ok := x.GetValue().(*Value_SemanticTypeSettingValue); ok {
+		return x.SemanticTypeSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetMaskingAlgorithmSettingValue() *MaskingAlgorithmSetting {
+	if x, ok := x.GetValue().(*Value_MaskingAlgorithmSettingValue); ok {
+		return x.MaskingAlgorithmSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetRateLimitSettingValue() *RateLimitSetting {
+	if x, ok := x.GetValue().(*Value_RateLimitSettingValue); ok {
+		return x.RateLimitSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetQueryAuditSettingValue() *QueryAuditSetting {
+	if x, ok := x.GetValue().(*Value_QueryAuditSettingValue); ok {
+		return x.QueryAuditSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetQueryCursorSettingValue() *QueryCursorSetting {
+	if x, ok := x.GetValue().(*Value_QueryCursorSettingValue); ok {
+		return x.QueryCursorSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetQueryHistorySettingValue() *QueryHistorySetting {
+	if x, ok := x.GetValue().(*Value_QueryHistorySettingValue); ok {
+		return x.QueryHistorySettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetArchiveSettingValue() *ArchiveSetting {
+	if x, ok := x.GetValue().(*Value_ArchiveSettingValue); ok {
+		return x.ArchiveSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetJiraSettingValue() *JiraSetting {
+	if x, ok := x.GetValue().(*Value_JiraSettingValue); ok {
+		return x.JiraSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetServiceNowSettingValue() *ServiceNowSetting {
+	if x, ok := x.GetValue().(*Value_ServiceNowSettingValue); ok {
+		return x.ServiceNowSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetSlackAppSettingValue() *SlackAppSetting {
+	if x, ok := x.GetValue().(*Value_SlackAppSettingValue); ok {
+		return x.SlackAppSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetEventBusSettingValue() *EventBusSetting {
+	if x, ok := x.GetValue().(*Value_EventBusSettingValue); ok {
+		return x.EventBusSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetScimSettingValue() *SCIMSetting {
+	if x, ok := x.GetValue().(*Value_ScimSettingValue); ok {
+		return x.ScimSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetAuditLogSettingValue() *AuditLogSetting {
+	if x, ok := x.GetValue().(*Value_AuditLogSettingValue); ok {
+		return x.AuditLogSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetMaintenanceSettingValue() *MaintenanceSetting {
+	if x, ok := x.GetValue().(*Value_MaintenanceSettingValue); ok {
+		return x.MaintenanceSettingValue
+	}
+	return nil
+}
+
+func (x *Value) GetInstanceDiscoverySettingValue() *InstanceDiscoverySetting {
+	if x, ok := x.GetValue().(*Value_InstanceDiscoverySettingValue); ok {
+		return x.InstanceDiscoverySettingValue
+	}
+	return nil
+}
+
+type isValue_Value interface {
+	isValue_Value()
+}
+
+type Value_StringValue struct {
+	// Defines this value as being a string value.
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type Value_SmtpMailDeliverySettingValue struct {
+	SmtpMailDeliverySettingValue *SMTPMailDeliverySettingValue `protobuf:"bytes,2,opt,name=smtp_mail_delivery_setting_value,json=smtpMailDeliverySettingValue,proto3,oneof"`
+}
+
+type Value_AppImSettingValue struct {
+	AppImSettingValue *AppIMSetting `protobuf:"bytes,3,opt,name=app_im_setting_value,json=appImSettingValue,proto3,oneof"`
+}
+
+type Value_AgentPluginSettingValue struct {
+	AgentPluginSettingValue *AgentPluginSetting `protobuf:"bytes,4,opt,name=agent_plugin_setting_value,json=agentPluginSettingValue,proto3,oneof"`
+}
+
+type Value_WorkspaceProfileSettingValue struct {
+	WorkspaceProfileSettingValue *WorkspaceProfileSetting `protobuf:"bytes,5,opt,name=workspace_profile_setting_value,json=workspaceProfileSettingValue,proto3,oneof"`
+}
+
+type Value_WorkspaceApprovalSettingValue struct {
+	WorkspaceApprovalSettingValue *WorkspaceApprovalSetting `protobuf:"bytes,6,opt,name=workspace_approval_setting_value,json=workspaceApprovalSettingValue,proto3,oneof"`
+}
+
+type Value_WorkspaceTrialSettingValue struct {
+	WorkspaceTrialSettingValue *WorkspaceTrialSetting `protobuf:"bytes,7,opt,name=workspace_trial_setting_value,json=workspaceTrialSettingValue,proto3,oneof"`
+}
+
+type Value_ExternalApprovalSettingValue struct {
+	ExternalApprovalSettingValue *ExternalApprovalSetting `protobuf:"bytes,8,opt,name=external_approval_setting_value,json=externalApprovalSettingValue,proto3,oneof"`
+}
+
+type Value_SchemaTemplateSettingValue struct {
+	SchemaTemplateSettingValue *SchemaTemplateSetting `protobuf:"bytes,9,opt,name=schema_template_setting_value,json=schemaTemplateSettingValue,proto3,oneof"`
+}
+
+type Value_DataClassificationSettingValue struct {
+	DataClassificationSettingValue *DataClassificationSetting `protobuf:"bytes,10,opt,name=data_classification_setting_value,json=dataClassificationSettingValue,proto3,oneof"`
+}
+
+type Value_SemanticTypeSettingValue struct {
+	SemanticTypeSettingValue *SemanticTypeSetting `protobuf:"bytes,11,opt,name=semantic_type_setting_value,json=semanticTypeSettingValue,proto3,oneof"`
+}
+
+type Value_MaskingAlgorithmSettingValue struct {
+	MaskingAlgorithmSettingValue *MaskingAlgorithmSetting `protobuf:"bytes,12,opt,name=masking_algorithm_setting_value,json=maskingAlgorithmSettingValue,proto3,oneof"`
+}
+
+type Value_RateLimitSettingValue struct {
+	RateLimitSettingValue *RateLimitSetting `protobuf:"bytes,13,opt,name=rate_limit_setting_value,json=rateLimitSettingValue,proto3,oneof"`
+}
+
+type Value_QueryAuditSettingValue struct {
+	QueryAuditSettingValue *QueryAuditSetting `protobuf:"bytes,14,opt,name=query_audit_setting_value,json=queryAuditSettingValue,proto3,oneof"`
+}
+
+type Value_QueryCursorSettingValue struct {
+	QueryCursorSettingValue *QueryCursorSetting `protobuf:"bytes,15,opt,name=query_cursor_setting_value,json=queryCursorSettingValue,proto3,oneof"`
+}
+
+type Value_QueryHistorySettingValue struct {
+	QueryHistorySettingValue *QueryHistorySetting `protobuf:"bytes,16,opt,name=query_history_setting_value,json=queryHistorySettingValue,proto3,oneof"`
+}
+
+type Value_ArchiveSettingValue struct {
+	ArchiveSettingValue *ArchiveSetting `protobuf:"bytes,17,opt,name=archive_setting_value,json=archiveSettingValue,proto3,oneof"`
+}
+
+type Value_JiraSettingValue struct {
+	JiraSettingValue *JiraSetting `protobuf:"bytes,18,opt,name=jira_setting_value,json=jiraSettingValue,proto3,oneof"`
+}
+
+type Value_ServiceNowSettingValue struct {
+	ServiceNowSettingValue *ServiceNowSetting `protobuf:"bytes,19,opt,name=service_now_setting_value,json=serviceNowSettingValue,proto3,oneof"`
+}
+
+type Value_SlackAppSettingValue struct {
+	SlackAppSettingValue *SlackAppSetting `protobuf:"bytes,20,opt,name=slack_app_setting_value,json=slackAppSettingValue,proto3,oneof"`
+}
+
+type Value_EventBusSettingValue struct {
+	EventBusSettingValue *EventBusSetting `protobuf:"bytes,21,opt,name=event_bus_setting_value,json=eventBusSettingValue,proto3,oneof"`
+}
+
+type Value_ScimSettingValue struct {
+	ScimSettingValue *SCIMSetting `protobuf:"bytes,22,opt,name=scim_setting_value,json=scimSettingValue,proto3,oneof"`
+}
+
+type Value_AuditLogSettingValue struct {
+	AuditLogSettingValue *AuditLogSetting `protobuf:"bytes,23,opt,name=audit_log_setting_value,json=auditLogSettingValue,proto3,oneof"`
+}
+
+type Value_MaintenanceSettingValue struct {
+	MaintenanceSettingValue *MaintenanceSetting `protobuf:"bytes,24,opt,name=maintenance_setting_value,json=maintenanceSettingValue,proto3,oneof"`
+}
+
+type Value_InstanceDiscoverySettingValue struct {
+	InstanceDiscoverySettingValue *InstanceDiscoverySetting `protobuf:"bytes,25,opt,name=instance_discovery_setting_value,json=instanceDiscoverySettingValue,proto3,oneof"`
+}
+
+func (*Value_StringValue) isValue_Value() {}
+
+func (*Value_SmtpMailDeliverySettingValue) isValue_Value() {}
+
+func (*Value_AppImSettingValue) isValue_Value() {}
+
+func (*Value_AgentPluginSettingValue) isValue_Value() {}
+
+func (*Value_WorkspaceProfileSettingValue) isValue_Value() {}
+
+func (*Value_WorkspaceApprovalSettingValue) isValue_Value() {}
+
+func (*Value_WorkspaceTrialSettingValue) isValue_Value() {}
+
+func (*Value_ExternalApprovalSettingValue) isValue_Value() {}
+
+func (*Value_SchemaTemplateSettingValue) isValue_Value() {}
+
+func (*Value_DataClassificationSettingValue) isValue_Value() {}
+
+func (*Value_SemanticTypeSettingValue) isValue_Value() {}
+
+func (*Value_MaskingAlgorithmSettingValue) isValue_Value() {}
+
+func (*Value_RateLimitSettingValue) isValue_Value() {}
+
+func (*Value_QueryAuditSettingValue) isValue_Value() {}
+
+func (*Value_QueryCursorSettingValue) isValue_Value() {}
+
+func (*Value_QueryHistorySettingValue) isValue_Value() {}
+
+func (*Value_ArchiveSettingValue) isValue_Value() {}
+
+func (*Value_JiraSettingValue) isValue_Value() {}
+
+func (*Value_ServiceNowSettingValue) isValue_Value() {}
+
+func (*Value_SlackAppSettingValue) isValue_Value() {}
+
+func (*Value_EventBusSettingValue) isValue_Value() {}
+
+func (*Value_ScimSettingValue) isValue_Value() {}
+
+func (*Value_AuditLogSettingValue) isValue_Value() {}
+
+func (*Value_MaintenanceSettingValue) isValue_Value() {}
+
+func (*Value_InstanceDiscoverySettingValue) isValue_Value() {}
+
+type SMTPMailDeliverySettingValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The SMTP server address.
+	Server string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	// The SMTP server port.
+	Port int32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// The SMTP server encryption.
+	Encryption SMTPMailDeliverySettingValue_Encryption `protobuf:"varint,3,opt,name=encryption,proto3,enum=bytebase.v1.SMTPMailDeliverySettingValue_Encryption" json:"encryption,omitempty"`
+	// The CA, KEY, and CERT for the SMTP server.
+	// Not used.
+	Ca             *string                                     `protobuf:"bytes,4,opt,name=ca,proto3,oneof" json:"ca,omitempty"`
+	Key            *string                                     `protobuf:"bytes,5,opt,name=key,proto3,oneof" json:"key,omitempty"`
+	Cert           *string                                     `protobuf:"bytes,6,opt,name=cert,proto3,oneof" json:"cert,omitempty"`
+	Authentication SMTPMailDeliverySettingValue_Authentication `protobuf:"varint,7,opt,name=authentication,proto3,enum=bytebase.v1.SMTPMailDeliverySettingValue_Authentication" json:"authentication,omitempty"`
+	Username       string                                      `protobuf:"bytes,8,opt,name=username,proto3" json:"username,omitempty"`
+	// If not specified, server will use the existed password.
+	Password *string `protobuf:"bytes,9,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	// The sender email address.
+	From string `protobuf:"bytes,10,opt,name=from,proto3" json:"from,omitempty"`
+	// The recipient email address, used with validate_only to send test email.
+	To string `protobuf:"bytes,11,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *SMTPMailDeliverySettingValue) Reset() {
+	*x = SMTPMailDeliverySettingValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SMTPMailDeliverySettingValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SMTPMailDeliverySettingValue) ProtoMessage() {}
+
+func (x *SMTPMailDeliverySettingValue) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SMTPMailDeliverySettingValue.ProtoReflect.Descriptor instead.
+func (*SMTPMailDeliverySettingValue) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SMTPMailDeliverySettingValue) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *SMTPMailDeliverySettingValue) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *SMTPMailDeliverySettingValue) GetEncryption() SMTPMailDeliverySettingValue_Encryption {
+	if x != nil {
+		return x.Encryption
+	}
+	return SMTPMailDeliverySettingValue_ENCRYPTION_UNSPECIFIED
+}
+
+func (x *SMTPMailDeliverySettingValue) GetCa() string {
+	if x != nil && x.Ca != nil {
+		return *x.Ca
+	}
+	return ""
+}
+
+func (x *SMTPMailDeliverySettingValue) GetKey() string {
+	if x != nil && x.Key != nil {
+		return *x.Key
+	}
+	return ""
+}
+
+func (x *SMTPMailDeliverySettingValue) GetCert() string {
+	if x != nil && x.Cert != nil {
+		return *x.Cert
+	}
+	return ""
+}
+
+func (x *SMTPMailDeliverySettingValue) GetAuthentication() SMTPMailDeliverySettingValue_Authentication {
+	if x != nil {
+		return x.Authentication
+	}
+	return SMTPMailDeliverySettingValue_AUTHENTICATION_UNSPECIFIED
+}
+
+func (x *SMTPMailDeliverySettingValue) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SMTPMailDeliverySettingValue) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+func (x *SMTPMailDeliverySettingValue) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SMTPMailDeliverySettingValue) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type AppIMSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImType           AppIMSetting_IMType            `protobuf:"varint,1,opt,name=im_type,json=imType,proto3,enum=bytebase.v1.AppIMSetting_IMType" json:"im_type,omitempty"`
+	AppId            string                         `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	AppSecret        string                         `protobuf:"bytes,3,opt,name=app_secret,json=appSecret,proto3" json:"app_secret,omitempty"`
+	ExternalApproval *AppIMSetting_ExternalApproval `protobuf:"bytes,4,opt,name=external_approval,json=externalApproval,proto3" json:"external_approval,omitempty"`
+}
+
+func (x *AppIMSetting) Reset() {
+	*x = AppIMSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppIMSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppIMSetting) ProtoMessage() {}
+
+func (x *AppIMSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppIMSetting.ProtoReflect.Descriptor instead.
+func (*AppIMSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AppIMSetting) GetImType() AppIMSetting_IMType {
+	if x != nil {
+		return x.ImType
+	}
+	return AppIMSetting_IM_TYPE_UNSPECIFIED
+}
+
+func (x *AppIMSetting) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *AppIMSetting) GetAppSecret() string {
+	if x != nil {
+		return x.AppSecret
+	}
+	return ""
+}
+
+func (x *AppIMSetting) GetExternalApproval() *AppIMSetting_ExternalApproval {
+	if x != nil {
+		return x.ExternalApproval
+	}
+	return nil
+}
+
+type AgentPluginSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The URL for the agent API.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The token for the agent.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *AgentPluginSetting) Reset() {
+	*x = AgentPluginSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentPluginSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentPluginSetting) ProtoMessage() {}
+
+func (x *AgentPluginSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentPluginSetting.ProtoReflect.Descriptor instead.
+func (*AgentPluginSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AgentPluginSetting) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AgentPluginSetting) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type WorkspaceProfileSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The URL user visits Bytebase.
+	//
+	// The external URL is used for:
+	// 1. Constructing the correct callback URL when configuring the VCS provider. The callback URL points to the frontend.
+	// 2. Creating the correct webhook endpoint when configuring the project GitOps workflow. The webhook endpoint points to the backend.
+	ExternalUrl string `protobuf:"bytes,1,opt,name=external_url,json=externalUrl,proto3" json:"external_url,omitempty"`
+	// Disallow self-service signup, users can only be invited by the owner.
+	DisallowSignup bool `protobuf:"varint,2,opt,name=disallow_signup,json=disallowSignup,proto3" json:"disallow_signup,omitempty"`
+	// Require 2FA for all users.
+	Require_2Fa bool `protobuf:"varint,3,opt,name=require_2fa,json=require2fa,proto3" json:"require_2fa,omitempty"`
+	// outbound_ip_list is the outbound IP for Bytebase instance in SaaS mode.
+	OutboundIpList []string `protobuf:"bytes,4,rep,name=outbound_ip_list,json=outboundIpList,proto3" json:"outbound_ip_list,omitempty"`
+	// The webhook URL for the GitOps workflow.
+	GitopsWebhookUrl string `protobuf:"bytes,5,opt,name=gitops_webhook_url,json=gitopsWebhookUrl,proto3" json:"gitops_webhook_url,omitempty"`
+	// The duration for token.
+	TokenDuration *durationpb.Duration `protobuf:"bytes,6,opt,name=token_duration,json=tokenDuration,proto3" json:"token_duration,omitempty"`
+	// The setting of custom announcement
+	Announcement *Announcement `protobuf:"bytes,7,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	// The maximum number of the concurrent sessions of an end user.
+	// The oldest sessions are revoked when a new session exceeds the limit. No limit if not set.
+	MaximumSessionCount int32 `protobuf:"varint,8,opt,name=maximum_session_count,json=maximumSessionCount,proto3" json:"maximum_session_count,omitempty"`
+	// The idle timeout of the sessions.
+	// A session expires if there is no request during the idle timeout. No idle timeout if not set.
+	SessionIdleTimeout *durationpb.Duration `protobuf:"bytes,9,opt,name=session_idle_timeout,json=sessionIdleTimeout,proto3" json:"session_idle_timeout,omitempty"`
+}
+
+func (x *WorkspaceProfileSetting) Reset() {
+	*x = WorkspaceProfileSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceProfileSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceProfileSetting) ProtoMessage() {}
+
+func (x *WorkspaceProfileSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceProfileSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceProfileSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WorkspaceProfileSetting) GetExternalUrl() string {
+	if x != nil {
+		return x.ExternalUrl
+	}
+	return ""
+}
+
+func (x *WorkspaceProfileSetting) GetDisallowSignup() bool {
+	if x != nil {
+		return x.DisallowSignup
+	}
+	return false
+}
+
+func (x *WorkspaceProfileSetting) GetRequire_2Fa() bool {
+	if x != nil {
+		return x.Require_2Fa
+	}
+	return false
+}
+
+func (x *WorkspaceProfileSetting) GetOutboundIpList() []string {
+	if x != nil {
+		return x.OutboundIpList
+	}
+	return nil
+}
+
+func (x *WorkspaceProfileSetting) GetGitopsWebhookUrl() string {
+	if x != nil {
+		return x.GitopsWebhookUrl
+	}
+	return ""
+}
+
+func (x *WorkspaceProfileSetting) GetTokenDuration() *durationpb.Duration {
+	if x != nil {
+		return x.TokenDuration
+	}
+	return nil
+}
+
+func (x *WorkspaceProfileSetting) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *WorkspaceProfileSetting) GetMaximumSessionCount() int32 {
+	if x != nil {
+		return x.MaximumSessionCount
+	}
+	return 0
+}
+
+func (x *WorkspaceProfileSetting) GetSessionIdleTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.SessionIdleTimeout
+	}
+	return nil
+}
+
+type Announcement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The alert level of announcemnt
+	Level Announcement_AlertLevel `protobuf:"varint,1,opt,name=level,proto3,enum=bytebase.v1.Announcement_AlertLevel" json:"level,omitempty"`
+	// The text of announcemnt
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// The optional link, user can follow the link to check extra details
+	Link string `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Announcement) GetLevel() Announcement_AlertLevel {
+	if x != nil {
+		return x.Level
+	}
+	return Announcement_ALERT_LEVEL_UNSPECIFIED
+}
+
+func (x *Announcement) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Announcement) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+type WorkspaceApprovalSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*WorkspaceApprovalSetting_Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *WorkspaceApprovalSetting) Reset() {
+	*x = WorkspaceApprovalSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceApprovalSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceApprovalSetting) ProtoMessage() {}
+
+func (x *WorkspaceApprovalSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceApprovalSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceApprovalSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WorkspaceApprovalSetting) GetRules() []*WorkspaceApprovalSetting_Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ExternalApprovalSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes []*ExternalApprovalSetting_Node `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *ExternalApprovalSetting) Reset() {
+	*x = ExternalApprovalSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalApprovalSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalApprovalSetting) ProtoMessage() {}
+
+func (x *ExternalApprovalSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalApprovalSetting.ProtoReflect.Descriptor instead.
+func (*ExternalApprovalSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExternalApprovalSetting) GetNodes() []*ExternalApprovalSetting_Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type SchemaTemplateSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FieldTemplates []*SchemaTemplateSetting_FieldTemplate `protobuf:"bytes,1,rep,name=field_templates,json=fieldTemplates,proto3" json:"field_templates,omitempty"`
+	ColumnTypes    []*SchemaTemplateSetting_ColumnType    `protobuf:"bytes,2,rep,name=column_types,json=columnTypes,proto3" json:"column_types,omitempty"`
+	TableTemplates []*SchemaTemplateSetting_TableTemplate `protobuf:"bytes,3,rep,name=table_templates,json=tableTemplates,proto3" json:"table_templates,omitempty"`
+}
+
+func (x *SchemaTemplateSetting) Reset() {
+	*x = SchemaTemplateSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchemaTemplateSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaTemplateSetting) ProtoMessage() {}
+
+func (x *SchemaTemplateSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaTemplateSetting.ProtoReflect.Descriptor instead.
+func (*SchemaTemplateSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SchemaTemplateSetting) GetFieldTemplates() []*SchemaTemplateSetting_FieldTemplate {
+	if x != nil {
+		return x.FieldTemplates
+	}
+	return nil
+}
+
+func (x *SchemaTemplateSetting) GetColumnTypes() []*SchemaTemplateSetting_ColumnType {
+	if x != nil {
+		return x.ColumnTypes
+	}
+	return nil
+}
+
+func (x *SchemaTemplateSetting) GetTableTemplates() []*SchemaTemplateSetting_TableTemplate {
+	if x != nil {
+		return x.TableTemplates
+	}
+	return nil
+}
+
+type WorkspaceTrialSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceCount int32                  `protobuf:"varint,1,opt,name=instance_count,json=instanceCount,proto3" json:"instance_count,omitempty"`
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	IssuedTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=issued_time,json=issuedTime,proto3" json:"issued_time,omitempty"`
+	Subject       string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	OrgName       string                 `protobuf:"bytes,5,opt,name=org_name,json=orgName,proto3" json:"org_name,omitempty"`
+	Plan          PlanType               `protobuf:"varint,6,opt,name=plan,proto3,enum=bytebase.v1.PlanType" json:"plan,omitempty"`
+}
+
+func (x *WorkspaceTrialSetting) Reset() {
+	*x = WorkspaceTrialSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceTrialSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceTrialSetting) ProtoMessage() {}
+
+func (x *WorkspaceTrialSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceTrialSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceTrialSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WorkspaceTrialSetting) GetInstanceCount() int32 {
+	if x != nil {
+		return x.InstanceCount
+	}
+	return 0
+}
+
+func (x *WorkspaceTrialSetting) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *WorkspaceTrialSetting) GetIssuedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedTime
+	}
+	return nil
+}
+
+func (x *WorkspaceTrialSetting) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *WorkspaceTrialSetting) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *WorkspaceTrialSetting) GetPlan() PlanType {
+	if x != nil {
+		return x.Plan
+	}
+	return PlanType_PLAN_TYPE_UNSPECIFIED
+}
+
+type DataClassificationSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Configs []*DataClassificationSetting_DataClassificationConfig `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+}
+
+func (x *DataClassificationSetting) Reset() {
+	*x = DataClassificationSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataClassificationSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataClassificationSetting) ProtoMessage() {}
+
+func (x *DataClassificationSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataClassificationSetting.ProtoReflect.Descriptor instead.
+func (*DataClassificationSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DataClassificationSetting) GetConfigs() []*DataClassificationSetting_DataClassificationConfig {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type SemanticTypeSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Types []*SemanticTypeSetting_SemanticType `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+}
+
+func (x *SemanticTypeSetting) Reset() {
+	*x = SemanticTypeSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SemanticTypeSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SemanticTypeSetting) ProtoMessage() {}
+
+func (x *SemanticTypeSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SemanticTypeSetting.ProtoReflect.Descriptor instead.
+func (*SemanticTypeSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SemanticTypeSetting) GetTypes() []*SemanticTypeSetting_SemanticType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type MaskingAlgorithmSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// algorithms is the list of masking algorithms.
+	Algorithms []*MaskingAlgorithmSetting_Algorithm `protobuf:"bytes,1,rep,name=algorithms,proto3" json:"algorithms,omitempty"`
+}
+
+func (x *MaskingAlgorithmSetting) Reset() {
+	*x = MaskingAlgorithmSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaskingAlgorithmSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaskingAlgorithmSetting) ProtoMessage() {}
+
+func (x *MaskingAlgorithmSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaskingAlgorithmSetting.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MaskingAlgorithmSetting) GetAlgorithms() []*MaskingAlgorithmSetting_Algorithm {
+	if x != nil {
+		return x.Algorithms
+	}
+	return nil
+}
+
+type RateLimitSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user_quota is the default quota for each end user on the rate-limited methods.
+	UserQuota *RateLimitSetting_Quota `protobuf:"bytes,1,opt,name=user_quota,json=userQuota,proto3" json:"user_quota,omitempty"`
+	// service_account_quota is the default quota for each service account token on the rate-limited methods.
+	ServiceAccountQuota *RateLimitSetting_Quota `protobuf:"bytes,2,opt,name=service_account_quota,json=serviceAccountQuota,proto3" json:"service_account_quota,omitempty"`
+	// overrides are the quotas for specific principals, taking precedence over the default quotas.
+	Overrides []*RateLimitSetting_Override `protobuf:"bytes,3,rep,name=overrides,proto3" json:"overrides,omitempty"`
+}
+
+func (x *RateLimitSetting) Reset() {
+	*x = RateLimitSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimitSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitSetting) ProtoMessage() {}
+
+func (x *RateLimitSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitSetting.ProtoReflect.Descriptor instead.
+func (*RateLimitSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RateLimitSetting) GetUserQuota() *RateLimitSetting_Quota {
+	if x != nil {
+		return x.UserQuota
+	}
+	return nil
+}
+
+func (x *RateLimitSetting) GetServiceAccountQuota() *RateLimitSetting_Quota {
+	if x != nil {
+		return x.ServiceAccountQuota
+	}
+	return nil
+}
+
+func (x *RateLimitSetting) GetOverrides() []*RateLimitSetting_Override {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+type QueryAuditSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// enabled is whether to score the SQL editor and admin executions for suspicious patterns.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// sample_rate is the ratio of the executions recorded with their anomaly score in the audit log, between 0 and 1.
+	// The executions reaching the alert score are always recorded.
+	SampleRate float64 `protobuf:"fixed64,2,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	// alert_score is the anomaly score between 1 and 100 at which an alert is raised.
+	AlertScore int32 `protobuf:"varint,3,opt,name=alert_score,json=alertScore,proto3" json:"alert_score,omitempty"`
+	// classified_rows_threshold is the number of rows with classified columns selected at once considered as a mass select, 0 means disabled.
+	ClassifiedRowsThreshold int64 `protobuf:"varint,4,opt,name=classified_rows_threshold,json=classifiedRowsThreshold,proto3" json:"classified_rows_threshold,omitempty"`
+	// export_rows_threshold is the number of rows exported at once considered as a large export, 0 means disabled.
+	ExportRowsThreshold int64 `protobuf:"varint,5,opt,name=export_rows_threshold,json=exportRowsThreshold,proto3" json:"export_rows_threshold,omitempty"`
+	// working_hour_start and working_hour_end are the working hours in [0, 24), the executions outside are considered unusual.
+	// The unusual hours check is disabled if they are equal.
+	WorkingHourStart int32 `protobuf:"varint,6,opt,name=working_hour_start,json=workingHourStart,proto3" json:"working_hour_start,omitempty"`
+	WorkingHourEnd   int32 `protobuf:"varint,7,opt,name=working_hour_end,json=workingHourEnd,proto3" json:"working_hour_end,omitempty"`
+	// time_zone is the time zone of the working hours, e.g. "Asia/Shanghai", UTC is used if empty.
+	TimeZone string `protobuf:"bytes,8,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// export_watermark is whether to inject an invisible watermark of the requester and the export time into the exported CSV and XLSX files.
+	ExportWatermark bool `protobuf:"varint,9,opt,name=export_watermark,json=exportWatermark,proto3" json:"export_watermark,omitempty"`
+}
+
+func (x *QueryAuditSetting) Reset() {
+	*x = QueryAuditSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAuditSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditSetting) ProtoMessage() {}
+
+func (x *QueryAuditSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditSetting.ProtoReflect.Descriptor instead.
+func (*QueryAuditSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *QueryAuditSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *QueryAuditSetting) GetSampleRate() float64 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *QueryAuditSetting) GetAlertScore() int32 {
+	if x != nil {
+		return x.AlertScore
+	}
+	return 0
+}
+
+func (x *QueryAuditSetting) GetClassifiedRowsThreshold() int64 {
+	if x != nil {
+		return x.ClassifiedRowsThreshold
+	}
+	return 0
+}
+
+func (x *QueryAuditSetting) GetExportRowsThreshold() int64 {
+	if x != nil {
+		return x.ExportRowsThreshold
+	}
+	return 0
+}
+
+func (x *QueryAuditSetting) GetWorkingHourStart() int32 {
+	if x != nil {
+		return x.WorkingHourStart
+	}
+	return 0
+}
+
+func (x *QueryAuditSetting) GetWorkingHourEnd() int32 {
+	if x != nil {
+		return x.WorkingHourEnd
+	}
+	return 0
+}
+
+func (x *QueryAuditSetting) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *QueryAuditSetting) GetExportWatermark() bool {
+	if x != nil {
+		return x.ExportWatermark
+	}
+	return false
+}
+
+type QueryCursorSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max_total_rows is the maximum number of rows fetched through a query cursor, 0 means the default 1,000,000 rows.
+	MaxTotalRows int64 `protobuf:"varint,1,opt,name=max_total_rows,json=maxTotalRows,proto3" json:"max_total_rows,omitempty"`
+	// max_total_bytes is the maximum bytes of the rows fetched through a query cursor, 0 means the default 1 GiB.
+	MaxTotalBytes int64 `protobuf:"varint,2,opt,name=max_total_bytes,json=maxTotalBytes,proto3" json:"max_total_bytes,omitempty"`
+	// idle_timeout_seconds is the seconds after which a query cursor not fetched or kept alive is closed, 0 means the default 5 minutes.
+	IdleTimeoutSeconds int32 `protobuf:"varint,3,opt,name=idle_timeout_seconds,json=idleTimeoutSeconds,proto3" json:"idle_timeout_seconds,omitempty"`
+}
+
+func (x *QueryCursorSetting) Reset() {
+	*x = QueryCursorSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCursorSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCursorSetting) ProtoMessage() {}
+
+func (x *QueryCursorSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryCursorSetting.ProtoReflect.Descriptor instead.
+func (*QueryCursorSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *QueryCursorSetting) GetMaxTotalRows() int64 {
+	if x != nil {
+		return x.MaxTotalRows
+	}
+	return 0
+}
+
+func (x *QueryCursorSetting) GetMaxTotalBytes() int64 {
+	if x != nil {
+		return x.MaxTotalBytes
+	}
+	return 0
+}
+
+func (x *QueryCursorSetting) GetIdleTimeoutSeconds() int32 {
+	if x != nil {
+		return x.IdleTimeoutSeconds
+	}
+	return 0
+}
+
+type QueryHistorySetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// disabled is whether to stop recording the SQL Editor queries in the query history.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// retention_days is the days to keep the query history, 0 means the default 90 days.
+	RetentionDays int32 `protobuf:"varint,2,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"`
+}
+
+func (x *QueryHistorySetting) Reset() {
+	*x = QueryHistorySetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryHistorySetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryHistorySetting) ProtoMessage() {}
+
+func (x *QueryHistorySetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryHistorySetting.ProtoReflect.Descriptor instead.
+func (*QueryHistorySetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *QueryHistorySetting) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *QueryHistorySetting) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+type ArchiveSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// issue_retention_days is the days after which the done and canceled issues and their rollouts are archived, 0 means never.
+	IssueRetentionDays int32 `protobuf:"varint,1,opt,name=issue_retention_days,json=issueRetentionDays,proto3" json:"issue_retention_days,omitempty"`
+	// database_retention_days is the days after which the databases not found in the instances are archived, 0 means never.
+	DatabaseRetentionDays int32 `protobuf:"varint,2,opt,name=database_retention_days,json=databaseRetentionDays,proto3" json:"database_retention_days,omitempty"`
+}
+
+func (x *ArchiveSetting) Reset() {
+	*x = ArchiveSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveSetting) ProtoMessage() {}
+
+func (x *ArchiveSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveSetting.ProtoReflect.Descriptor instead.
+func (*ArchiveSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ArchiveSetting) GetIssueRetentionDays() int32 {
+	if x != nil {
+		return x.IssueRetentionDays
+	}
+	return 0
+}
+
+func (x *ArchiveSetting) GetDatabaseRetentionDays() int32 {
+	if x != nil {
+		return x.DatabaseRetentionDays
+	}
+	return 0
+}
+
+type JiraSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// url is the base URL of the Jira site, e.g. "https://example.atlassian.net".
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// email is the email of the Jira account calling the Jira API.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// api_token is the API token of the Jira account.
+	// It is never returned, and the stored token is kept if it is not set.
+	ApiToken *string `protobuf:"bytes,3,opt,name=api_token,json=apiToken,proto3,oneof" json:"api_token,omitempty"`
+	// project_key is the key of the Jira project where the linked tickets are created.
+	ProjectKey string `protobuf:"bytes,4,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// issue_type is the type of the linked tickets, e.g. "Task".
+	IssueType string `protobuf:"bytes,5,opt,name=issue_type,json=issueType,proto3" json:"issue_type,omitempty"`
+	// webhook_token authenticates the Jira webhooks posted to "/hook/jira/{webhook_token}".
+	WebhookToken string `protobuf:"bytes,6,opt,name=webhook_token,json=webhookToken,proto3" json:"webhook_token,omitempty"`
+	// open_status is the Jira status mirrored with the open issues.
+	OpenStatus string `protobuf:"bytes,7,opt,name=open_status,json=openStatus,proto3" json:"open_status,omitempty"`
+	// done_status is the Jira status mirrored with the done issues.
+	DoneStatus string `protobuf:"bytes,8,opt,name=done_status,json=doneStatus,proto3" json:"done_status,omitempty"`
+	// canceled_status is the Jira status mirrored with the canceled issues.
+	CanceledStatus string `protobuf:"bytes,9,opt,name=canceled_status,json=canceledStatus,proto3" json:"canceled_status,omitempty"`
+	// approved_status is the Jira status approving the pending JIRA mode external approval nodes.
+	ApprovedStatus string `protobuf:"bytes,10,opt,name=approved_status,json=approvedStatus,proto3" json:"approved_status,omitempty"`
+	// rejected_status is the Jira status rejecting the pending JIRA mode external approval nodes.
+	RejectedStatus string `protobuf:"bytes,11,opt,name=rejected_status,json=rejectedStatus,proto3" json:"rejected_status,omitempty"`
+}
+
+func (x *JiraSetting) Reset() {
+	*x = JiraSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JiraSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JiraSetting) ProtoMessage() {}
+
+func (x *JiraSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JiraSetting.ProtoReflect.Descriptor instead.
+func (*JiraSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *JiraSetting) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *JiraSetting) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *JiraSetting) GetApiToken() string {
+	if x != nil && x.ApiToken != nil {
+		return *x.ApiToken
+	}
+	return ""
+}
+
+func (x *JiraSetting) GetProjectKey() string {
+	if x != nil {
+		return x.ProjectKey
+	}
+	return ""
+}
+
+func (x *JiraSetting) GetIssueType() string {
+	if x != nil {
+		return x.IssueType
+	}
+	return ""
+}
+
+func (x *JiraSetting) GetWebhookToken() string {
+	if x != nil {
+		return x.WebhookToken
+	}
+	return ""
+}
+
+func (x *JiraSetting) GetOpenStatus() string {
+	if x != nil {
+		return x.OpenStatus
+	}
+	return ""
+}
+
+func (x *JiraSetting) GetDoneStatus() string {
+	if x != nil {
+		return x.DoneStatus
+	}
+	return ""
+}
+
+func (x *JiraSetting) GetCanceledStatus() string {
+	if x != nil {
+		return x.CanceledStatus
+	}
+	return ""
+}
+
+func (x *JiraSetting) GetApprovedStatus() string {
+	if x != nil {
+		return x.ApprovedStatus
+	}
+	return ""
+}
+
+func (x *JiraSetting) GetRejectedStatus() string {
+	if x != nil {
+		return x.RejectedStatus
+	}
+	return ""
+}
+
+type ServiceNowSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// url is the base URL of the ServiceNow instance, e.g. "https://example.service-now.com".
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// username is the user calling the ServiceNow Table API.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// password is the password of the user.
+	// It is never returned, and the stored password is kept if it is not set.
+	Password *string `protobuf:"bytes,3,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	// assignment_group is the sys_id of the group assigned the change requests, optional.
+	AssignmentGroup string `protobuf:"bytes,4,opt,name=assignment_group,json=assignmentGroup,proto3" json:"assignment_group,omitempty"`
+	// webhook_token authenticates the ServiceNow webhooks posted to "/hook/servicenow/{webhook_token}".
+	WebhookToken string `protobuf:"bytes,5,opt,name=webhook_token,json=webhookToken,proto3" json:"webhook_token,omitempty"`
+}
+
+func (x *ServiceNowSetting) Reset() {
+	*x = ServiceNowSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceNowSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceNowSetting) ProtoMessage() {}
+
+func (x *ServiceNowSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceNowSetting.ProtoReflect.Descriptor instead.
+func (*ServiceNowSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ServiceNowSetting) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ServiceNowSetting) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ServiceNowSetting) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+func (x *ServiceNowSetting) GetAssignmentGroup() string {
+	if x != nil {
+		return x.AssignmentGroup
+	}
+	return ""
+}
+
+func (x *ServiceNowSetting) GetWebhookToken() string {
+	if x != nil {
+		return x.WebhookToken
+	}
+	return ""
+}
+
+type SlackAppSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// bot_token is the bot user OAuth token of the Slack app, used to look up the Slack users.
+	// It is never returned, and the stored token is kept if it is not set.
+	BotToken *string `protobuf:"bytes,1,opt,name=bot_token,json=botToken,proto3,oneof" json:"bot_token,omitempty"`
+	// signing_secret verifies the requests posted by Slack to "/hook/slack/interactions" and "/hook/slack/commands".
+	// It is never returned, and the stored secret is kept if it is not set.
+	SigningSecret *string `protobuf:"bytes,2,opt,name=signing_secret,json=signingSecret,proto3,oneof" json:"signing_secret,omitempty"`
+}
+
+func (x *SlackAppSetting) Reset() {
+	*x = SlackAppSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlackAppSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlackAppSetting) ProtoMessage() {}
+
+func (x *SlackAppSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *Value) GetWorkspaceApprovalSettingValue() *WorkspaceApprovalSetting {
-	if x, ok := x.GetValue().(*Value_WorkspaceApprovalSettingValue); ok {
-		return x.WorkspaceApprovalSettingValue
-	}
-	return nil
+// Deprecated: Use SlackAppSetting.ProtoReflect.Descriptor instead.
+func (*SlackAppSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *Value) GetWorkspaceTrialSettingValue() *WorkspaceTrialSetting {
-	if x, ok := x.GetValue().(*Value_WorkspaceTrialSettingValue); ok {
-		return x.WorkspaceTrialSettingValue
+func (x *SlackAppSetting) GetBotToken() string {
+	if x != nil && x.BotToken != nil {
+		return *x.BotToken
 	}
-	return nil
+	return ""
 }
 
-func (x *Value) GetExternalApprovalSettingValue() *ExternalApprovalSetting {
-	if x, ok := x.GetValue().(*Value_ExternalApprovalSettingValue); ok {
-		return x.ExternalApprovalSettingValue
+func (x *SlackAppSetting) GetSigningSecret() string {
+	if x != nil && x.SigningSecret != nil {
+		return *x.SigningSecret
 	}
-	return nil
+	return ""
 }
 
-func (x *Value) GetSchemaTemplateSettingValue() *SchemaTemplateSetting {
-	if x, ok := x.GetValue().(*Value_SchemaTemplateSettingValue); ok {
-		return x.SchemaTemplateSettingValue
+type EventBusSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type EventBusSetting_Type `protobuf:"varint,1,opt,name=type,proto3,enum=bytebase.v1.EventBusSetting_Type" json:"type,omitempty"`
+	// url is the comma separated broker addresses for Kafka, e.g. "kafka-1:9092,kafka-2:9092",
+	// or the server URL for NATS, e.g. "nats://nats:4222".
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// topic is the Kafka topic of the events keyed by the project, or the NATS subject prefix followed by the event type,
+	// e.g. the subject is "bytebase.events.bb.issue.create" for the prefix "bytebase.events".
+	// The events are encoded as the bytebase.v1.Event protobuf message.
+	Topic string `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	// username authenticates with SASL/PLAIN for Kafka, or the user credentials for NATS, optional.
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	// password is the password of the user.
+	// It is never returned, and the stored password is kept if it is not set.
+	Password *string `protobuf:"bytes,5,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	// tls connects to the Kafka brokers with TLS, NATS uses the "tls://" URL instead.
+	Tls bool `protobuf:"varint,6,opt,name=tls,proto3" json:"tls,omitempty"`
+	// event_types are the types of the events published, e.g. "bb.issue.create", all events are published if empty.
+	EventTypes []string `protobuf:"bytes,7,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+}
+
+func (x *EventBusSetting) Reset() {
+	*x = EventBusSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Value) GetDataClassificationSettingValue() *DataClassificationSetting {
-	if x, ok := x.GetValue().(*Value_DataClassificationSettingValue); ok {
-		return x.DataClassificationSettingValue
-	}
-	return nil
+func (x *EventBusSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Value) GetSemanticTypeSettingValue() *SemanticTypeSetting {
-	if x, ok := x.GetValue().(*Value_SemanticTypeSettingValue); ok {
-		return x.SemanticTypeSettingValue
+func (*EventBusSetting) ProtoMessage() {}
+
+func (x *EventBusSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Value) GetMaskingAlgorithmSettingValue() *MaskingAlgorithmSetting {
-	if x, ok := x.GetValue().(*Value_MaskingAlgorithmSettingValue); ok {
-		return x.MaskingAlgorithmSettingValue
-	}
-	return nil
+// Deprecated: Use EventBusSetting.ProtoReflect.Descriptor instead.
+func (*EventBusSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{33}
 }
 
-type isValue_Value interface {
-	isValue_Value()
+func (x *EventBusSetting) GetType() EventBusSetting_Type {
+	if x != nil {
+		return x.Type
+	}
+	return EventBusSetting_TYPE_UNSPECIFIED
 }
 
-type Value_StringValue struct {
-	// Defines this value as being a string value.
-	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+func (x *EventBusSetting) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
 }
 
-type Value_SmtpMailDeliverySettingValue struct {
-	SmtpMailDeliverySettingValue *SMTPMailDeliverySettingValue `protobuf:"bytes,2,opt,name=smtp_mail_delivery_setting_value,json=smtpMailDeliverySettingValue,proto3,oneof"`
+func (x *EventBusSetting) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
 }
 
-type Value_AppImSettingValue struct {
-	AppImSettingValue *AppIMSetting `protobuf:"bytes,3,opt,name=app_im_setting_value,json=appImSettingValue,proto3,oneof"`
+func (x *EventBusSetting) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
 }
 
-type Value_AgentPluginSettingValue struct {
-	AgentPluginSettingValue *AgentPluginSetting `protobuf:"bytes,4,opt,name=agent_plugin_setting_value,json=agentPluginSettingValue,proto3,oneof"`
+func (x *EventBusSetting) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
 }
 
-type Value_WorkspaceProfileSettingValue struct {
-	WorkspaceProfileSettingValue *WorkspaceProfileSetting `protobuf:"bytes,5,opt,name=workspace_profile_setting_value,json=workspaceProfileSettingValue,proto3,oneof"`
+func (x *EventBusSetting) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
 }
 
-type Value_WorkspaceApprovalSettingValue struct {
-	WorkspaceApprovalSettingValue *WorkspaceApprovalSetting `protobuf:"bytes,6,opt,name=workspace_approval_setting_value,json=workspaceApprovalSettingValue,proto3,oneof"`
+func (x *EventBusSetting) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
 }
 
-type Value_WorkspaceTrialSettingValue struct {
-	WorkspaceTrialSettingValue *WorkspaceTrialSetting `protobuf:"bytes,7,opt,name=workspace_trial_setting_value,json=workspaceTrialSettingValue,proto3,oneof"`
+type SCIMSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// token authenticates the SCIM requests to "/scim/v2" with the "Authorization: Bearer {token}" header.
+	// The SCIM provisioning is disabled if empty.
+	// It is never returned, and the stored token is kept if it is not set.
+	Token *string `protobuf:"bytes,1,opt,name=token,proto3,oneof" json:"token,omitempty"`
+	// group_mappings maps the provisioned groups to the roles granted to their members.
+	// The roles are revoked when the members are removed from the groups.
+	GroupMappings []*SCIMSetting_GroupMapping `protobuf:"bytes,2,rep,name=group_mappings,json=groupMappings,proto3" json:"group_mappings,omitempty"`
 }
 
-type Value_ExternalApprovalSettingValue struct {
-	ExternalApprovalSettingValue *ExternalApprovalSetting `protobuf:"bytes,8,opt,name=external_approval_setting_value,json=externalApprovalSettingValue,proto3,oneof"`
+func (x *SCIMSetting) Reset() {
+	*x = SCIMSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type Value_SchemaTemplateSettingValue struct {
-	SchemaTemplateSettingValue *SchemaTemplateSetting `protobuf:"bytes,9,opt,name=schema_template_setting_value,json=schemaTemplateSettingValue,proto3,oneof"`
+func (x *SCIMSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type Value_DataClassificationSettingValue struct {
-	DataClassificationSettingValue *DataClassificationSetting `protobuf:"bytes,10,opt,name=data_classification_setting_value,json=dataClassificationSettingValue,proto3,oneof"`
+func (*SCIMSetting) ProtoMessage() {}
+
+func (x *SCIMSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type Value_SemanticTypeSettingValue struct {
-	SemanticTypeSettingValue *SemanticTypeSetting `protobuf:"bytes,11,opt,name=semantic_type_setting_value,json=semanticTypeSettingValue,proto3,oneof"`
+// Deprecated: Use SCIMSetting.ProtoReflect.Descriptor instead.
+func (*SCIMSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{34}
 }
 
-type Value_MaskingAlgorithmSettingValue struct {
-	MaskingAlgorithmSettingValue *MaskingAlgorithmSetting `protobuf:"bytes,12,opt,name=masking_algorithm_setting_value,json=maskingAlgorithmSettingValue,proto3,oneof"`
+func (x *SCIMSetting) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
 }
 
-func (*Value_StringValue) isValue_Value() {}
+func (x *SCIMSetting) GetGroupMappings() []*SCIMSetting_GroupMapping {
+	if x != nil {
+		return x.GroupMappings
+	}
+	return nil
+}
 
-func (*Value_SmtpMailDeliverySettingValue) isValue_Value() {}
+type AuditLogSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (*Value_AppImSettingValue) isValue_Value() {}
+	// retention is the duration the audit logs are kept, the audit logs are kept forever if not set.
+	// The audit logs are only deleted after they are exported if the export is enabled.
+	Retention *durationpb.Duration `protobuf:"bytes,1,opt,name=retention,proto3" json:"retention,omitempty"`
+	// export_type is the destination the audit logs are exported to in the JSON schema for SIEM ingestion.
+	ExportType AuditLogSetting_ExportType `protobuf:"varint,2,opt,name=export_type,json=exportType,proto3,enum=bytebase.v1.AuditLogSetting_ExportType" json:"export_type,omitempty"`
+	// s3 exports the audit logs as the JSON Lines objects, one object per batch.
+	S3 *AuditLogSetting_S3Export `protobuf:"bytes,3,opt,name=s3,proto3" json:"s3,omitempty"`
+	// syslog exports the audit logs as the RFC 5424 messages, one message per audit log.
+	Syslog *AuditLogSetting_SyslogExport `protobuf:"bytes,4,opt,name=syslog,proto3" json:"syslog,omitempty"`
+}
 
-func (*Value_AgentPluginSettingValue) isValue_Value() {}
+func (x *AuditLogSetting) Reset() {
+	*x = AuditLogSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func (*Value_WorkspaceProfileSettingValue) isValue_Value() {}
+func (x *AuditLogSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (*Value_WorkspaceApprovalSettingValue) isValue_Value() {}
+func (*AuditLogSetting) ProtoMessage() {}
 
-func (*Value_WorkspaceTrialSettingValue) isValue_Value() {}
+func (x *AuditLogSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*Value_ExternalApprovalSettingValue) isValue_Value() {}
+// Deprecated: Use AuditLogSetting.ProtoReflect.Descriptor instead.
+func (*AuditLogSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{35}
+}
 
-func (*Value_SchemaTemplateSettingValue) isValue_Value() {}
+func (x *AuditLogSetting) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
 
-func (*Value_DataClassificationSettingValue) isValue_Value() {}
+func (x *AuditLogSetting) GetExportType() AuditLogSetting_ExportType {
+	if x != nil {
+		return x.ExportType
+	}
+	return AuditLogSetting_EXPORT_TYPE_UNSPECIFIED
+}
 
-func (*Value_SemanticTypeSettingValue) isValue_Value() {}
+func (x *AuditLogSetting) GetS3() *AuditLogSetting_S3Export {
+	if x != nil {
+		return x.S3
+	}
+	return nil
+}
 
-func (*Value_MaskingAlgorithmSettingValue) isValue_Value() {}
+func (x *AuditLogSetting) GetSyslog() *AuditLogSetting_SyslogExport {
+	if x != nil {
+		return x.Syslog
+	}
+	return nil
+}
 
-type SMTPMailDeliverySettingValue struct {
+type MaintenanceSetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The SMTP server address.
-	Server string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
-	// The SMTP server port.
-	Port int32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	// The SMTP server encryption.
-	Encryption SMTPMailDeliverySettingValue_Encryption `protobuf:"varint,3,opt,name=encryption,proto3,enum=bytebase.v1.SMTPMailDeliverySettingValue_Encryption" json:"encryption,omitempty"`
-	// The CA, KEY, and CERT for the SMTP server.
-	// Not used.
-	Ca             *string                                     `protobuf:"bytes,4,opt,name=ca,proto3,oneof" json:"ca,omitempty"`
-	Key            *string                                     `protobuf:"bytes,5,opt,name=key,proto3,oneof" json:"key,omitempty"`
-	Cert           *string                                     `protobuf:"bytes,6,opt,name=cert,proto3,oneof" json:"cert,omitempty"`
-	Authentication SMTPMailDeliverySettingValue_Authentication `protobuf:"varint,7,opt,name=authentication,proto3,enum=bytebase.v1.SMTPMailDeliverySettingValue_Authentication" json:"authentication,omitempty"`
-	Username       string                                      `protobuf:"bytes,8,opt,name=username,proto3" json:"username,omitempty"`
-	// If not specified, server will use the existed password.
-	Password *string `protobuf:"bytes,9,opt,name=password,proto3,oneof" json:"password,omitempty"`
-	// The sender email address.
-	From string `protobuf:"bytes,10,opt,name=from,proto3" json:"from,omitempty"`
-	// The recipient email address, used with validate_only to send test email.
-	To string `protobuf:"bytes,11,opt,name=to,proto3" json:"to,omitempty"`
+	// enabled rejects the new rollouts and the SQL editor writes while the reads are still served,
+	// e.g. during the metadata database upgrade.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// message is shown to the users whose requests are rejected.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *SMTPMailDeliverySettingValue) Reset() {
-	*x = SMTPMailDeliverySettingValue{}
+func (x *MaintenanceSetting) Reset() {
+	*x = MaintenanceSetting{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[7]
+		mi := &file_v1_setting_service_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SMTPMailDeliverySettingValue) String() string {
+func (x *MaintenanceSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SMTPMailDeliverySettingValue) ProtoMessage() {}
+func (*MaintenanceSetting) ProtoMessage() {}
 
-func (x *SMTPMailDeliverySettingValue) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[7]
+func (x *MaintenanceSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -849,116 +3400,154 @@ func (x *SMTPMailDeliverySettingValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SMTPMailDeliverySettingValue.ProtoReflect.Descriptor instead.
-func (*SMTPMailDeliverySettingValue) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MaintenanceSetting.ProtoReflect.Descriptor instead.
+func (*MaintenanceSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *SMTPMailDeliverySettingValue) GetServer() string {
+func (x *MaintenanceSetting) GetEnabled() bool {
 	if x != nil {
-		return x.Server
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *SMTPMailDeliverySettingValue) GetPort() int32 {
+func (x *MaintenanceSetting) GetMessage() string {
 	if x != nil {
-		return x.Port
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *SMTPMailDeliverySettingValue) GetEncryption() SMTPMailDeliverySettingValue_Encryption {
-	if x != nil {
-		return x.Encryption
+type InstanceDiscoverySetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sources are the cloud accounts the instances are discovered from periodically.
+	Sources []*InstanceDiscoverySetting_Source `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *InstanceDiscoverySetting) Reset() {
+	*x = InstanceDiscoverySetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return SMTPMailDeliverySettingValue_ENCRYPTION_UNSPECIFIED
 }
 
-func (x *SMTPMailDeliverySettingValue) GetCa() string {
-	if x != nil && x.Ca != nil {
-		return *x.Ca
+func (x *InstanceDiscoverySetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceDiscoverySetting) ProtoMessage() {}
+
+func (x *InstanceDiscoverySetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceDiscoverySetting.ProtoReflect.Descriptor instead.
+func (*InstanceDiscoverySetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *InstanceDiscoverySetting) GetSources() []*InstanceDiscoverySetting_Source {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
 }
 
-func (x *SMTPMailDeliverySettingValue) GetKey() string {
-	if x != nil && x.Key != nil {
-		return *x.Key
-	}
-	return ""
+type AppIMSetting_ExternalApproval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled              bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ApprovalDefinitionId string `protobuf:"bytes,2,opt,name=approval_definition_id,json=approvalDefinitionId,proto3" json:"approval_definition_id,omitempty"`
 }
 
-func (x *SMTPMailDeliverySettingValue) GetCert() string {
-	if x != nil && x.Cert != nil {
-		return *x.Cert
+func (x *AppIMSetting_ExternalApproval) Reset() {
+	*x = AppIMSetting_ExternalApproval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *SMTPMailDeliverySettingValue) GetAuthentication() SMTPMailDeliverySettingValue_Authentication {
-	if x != nil {
-		return x.Authentication
-	}
-	return SMTPMailDeliverySettingValue_AUTHENTICATION_UNSPECIFIED
+func (x *AppIMSetting_ExternalApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SMTPMailDeliverySettingValue) GetUsername() string {
-	if x != nil {
-		return x.Username
+func (*AppIMSetting_ExternalApproval) ProtoMessage() {}
+
+func (x *AppIMSetting_ExternalApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SMTPMailDeliverySettingValue) GetPassword() string {
-	if x != nil && x.Password != nil {
-		return *x.Password
-	}
-	return ""
+// Deprecated: Use AppIMSetting_ExternalApproval.ProtoReflect.Descriptor instead.
+func (*AppIMSetting_ExternalApproval) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{14, 0}
 }
 
-func (x *SMTPMailDeliverySettingValue) GetFrom() string {
+func (x *AppIMSetting_ExternalApproval) GetEnabled() bool {
 	if x != nil {
-		return x.From
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *SMTPMailDeliverySettingValue) GetTo() string {
+func (x *AppIMSetting_ExternalApproval) GetApprovalDefinitionId() string {
 	if x != nil {
-		return x.To
+		return x.ApprovalDefinitionId
 	}
 	return ""
 }
 
-type AppIMSetting struct {
+type WorkspaceApprovalSetting_Rule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ImType           AppIMSetting_IMType            `protobuf:"varint,1,opt,name=im_type,json=imType,proto3,enum=bytebase.v1.AppIMSetting_IMType" json:"im_type,omitempty"`
-	AppId            string                         `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	AppSecret        string                         `protobuf:"bytes,3,opt,name=app_secret,json=appSecret,proto3" json:"app_secret,omitempty"`
-	ExternalApproval *AppIMSetting_ExternalApproval `protobuf:"bytes,4,opt,name=external_approval,json=externalApproval,proto3" json:"external_approval,omitempty"`
+	Template  *ApprovalTemplate `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	Condition *expr.Expr        `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
 }
 
-func (x *AppIMSetting) Reset() {
-	*x = AppIMSetting{}
+func (x *WorkspaceApprovalSetting_Rule) Reset() {
+	*x = WorkspaceApprovalSetting_Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[8]
+		mi := &file_v1_setting_service_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AppIMSetting) String() string {
+func (x *WorkspaceApprovalSetting_Rule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppIMSetting) ProtoMessage() {}
+func (*WorkspaceApprovalSetting_Rule) ProtoMessage() {}
 
-func (x *AppIMSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[8]
+func (x *WorkspaceApprovalSetting_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -969,67 +3558,57 @@ func (x *AppIMSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppIMSetting.ProtoReflect.Descriptor instead.
-func (*AppIMSetting) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *AppIMSetting) GetImType() AppIMSetting_IMType {
-	if x != nil {
-		return x.ImType
-	}
-	return AppIMSetting_IM_TYPE_UNSPECIFIED
-}
-
-func (x *AppIMSetting) GetAppId() string {
-	if x != nil {
-		return x.AppId
-	}
-	return ""
+// Deprecated: Use WorkspaceApprovalSetting_Rule.ProtoReflect.Descriptor instead.
+func (*WorkspaceApprovalSetting_Rule) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{18, 0}
 }
 
-func (x *AppIMSetting) GetAppSecret() string {
+func (x *WorkspaceApprovalSetting_Rule) GetTemplate() *ApprovalTemplate {
 	if x != nil {
-		return x.AppSecret
+		return x.Template
 	}
-	return ""
+	return nil
 }
 
-func (x *AppIMSetting) GetExternalApproval() *AppIMSetting_ExternalApproval {
+func (x *WorkspaceApprovalSetting_Rule) GetCondition() *expr.Expr {
 	if x != nil {
-		return x.ExternalApproval
+		return x.Condition
 	}
 	return nil
 }
 
-type AgentPluginSetting struct {
+type ExternalApprovalSetting_Node struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The URL for the agent API.
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	// The token for the agent.
-	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// A unique identifier for a node in UUID format.
+	// We will also include the id in the message sending to the external relay service to identify the node.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The title of the node.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The external endpoint for the relay service, e.g. "http://hello:1234".
+	Endpoint string                            `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Mode     ExternalApprovalSetting_Node_Mode `protobuf:"varint,4,opt,name=mode,proto3,enum=bytebase.v1.ExternalApprovalSetting_Node_Mode" json:"mode,omitempty"`
 }
 
-func (x *AgentPluginSetting) Reset() {
-	*x = AgentPluginSetting{}
+func (x *ExternalApprovalSetting_Node) Reset() {
+	*x = ExternalApprovalSetting_Node{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[9]
+		mi := &file_v1_setting_service_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AgentPluginSetting) String() string {
+func (x *ExternalApprovalSetting_Node) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AgentPluginSetting) ProtoMessage() {}
+func (*ExternalApprovalSetting_Node) ProtoMessage() {}
 
-func (x *AgentPluginSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[9]
+func (x *ExternalApprovalSetting_Node) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1040,67 +3619,68 @@ func (x *AgentPluginSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AgentPluginSetting.ProtoReflect.Descriptor instead.
-func (*AgentPluginSetting) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ExternalApprovalSetting_Node.ProtoReflect.Descriptor instead.
+func (*ExternalApprovalSetting_Node) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{19, 0}
 }
 
-func (x *AgentPluginSetting) GetUrl() string {
+func (x *ExternalApprovalSetting_Node) GetId() string {
 	if x != nil {
-		return x.Url
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AgentPluginSetting) GetToken() string {
+func (x *ExternalApprovalSetting_Node) GetTitle() string {
 	if x != nil {
-		return x.Token
+		return x.Title
 	}
 	return ""
 }
 
-type WorkspaceProfileSetting struct {
+func (x *ExternalApprovalSetting_Node) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *ExternalApprovalSetting_Node) GetMode() ExternalApprovalSetting_Node_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return ExternalApprovalSetting_Node_MODE_UNSPECIFIED
+}
+
+type SchemaTemplateSetting_FieldTemplate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The URL user visits Bytebase.
-	//
-	// The external URL is used for:
-	// 1. Constructing the correct callback URL when configuring the VCS provider. The callback URL points to the frontend.
-	// 2. Creating the correct webhook endpoint when configuring the project GitOps workflow. The webhook endpoint points to the backend.
-	ExternalUrl string `protobuf:"bytes,1,opt,name=external_url,json=externalUrl,proto3" json:"external_url,omitempty"`
-	// Disallow self-service signup, users can only be invited by the owner.
-	DisallowSignup bool `protobuf:"varint,2,opt,name=disallow_signup,json=disallowSignup,proto3" json:"disallow_signup,omitempty"`
-	// Require 2FA for all users.
-	Require_2Fa bool `protobuf:"varint,3,opt,name=require_2fa,json=require2fa,proto3" json:"require_2fa,omitempty"`
-	// outbound_ip_list is the outbound IP for Bytebase instance in SaaS mode.
-	OutboundIpList []string `protobuf:"bytes,4,rep,name=outbound_ip_list,json=outboundIpList,proto3" json:"outbound_ip_list,omitempty"`
-	// The webhook URL for the GitOps workflow.
-	GitopsWebhookUrl string `protobuf:"bytes,5,opt,name=gitops_webhook_url,json=gitopsWebhookUrl,proto3" json:"gitops_webhook_url,omitempty"`
-	// The duration for token.
-	TokenDuration *durationpb.Duration `protobuf:"bytes,6,opt,name=token_duration,json=tokenDuration,proto3" json:"token_duration,omitempty"`
-	// The setting of custom announcement
-	Announcement *Announcement `protobuf:"bytes,7,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	Id       string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Engine   Engine          `protobuf:"varint,2,opt,name=engine,proto3,enum=bytebase.v1.Engine" json:"engine,omitempty"`
+	Category string          `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Column   *ColumnMetadata `protobuf:"bytes,4,opt,name=column,proto3" json:"column,omitempty"`
+	Config   *ColumnConfig   `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *WorkspaceProfileSetting) Reset() {
-	*x = WorkspaceProfileSetting{}
+func (x *SchemaTemplateSetting_FieldTemplate) Reset() {
+	*x = SchemaTemplateSetting_FieldTemplate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[10]
+		mi := &file_v1_setting_service_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WorkspaceProfileSetting) String() string {
+func (x *SchemaTemplateSetting_FieldTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceProfileSetting) ProtoMessage() {}
+func (*SchemaTemplateSetting_FieldTemplate) ProtoMessage() {}
 
-func (x *WorkspaceProfileSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[10]
+func (x *SchemaTemplateSetting_FieldTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,90 +3691,138 @@ func (x *WorkspaceProfileSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceProfileSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceProfileSetting) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use SchemaTemplateSetting_FieldTemplate.ProtoReflect.Descriptor instead.
+func (*SchemaTemplateSetting_FieldTemplate) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{20, 0}
 }
 
-func (x *WorkspaceProfileSetting) GetExternalUrl() string {
+func (x *SchemaTemplateSetting_FieldTemplate) GetId() string {
 	if x != nil {
-		return x.ExternalUrl
+		return x.Id
 	}
 	return ""
 }
 
-func (x *WorkspaceProfileSetting) GetDisallowSignup() bool {
+func (x *SchemaTemplateSetting_FieldTemplate) GetEngine() Engine {
 	if x != nil {
-		return x.DisallowSignup
+		return x.Engine
 	}
-	return false
+	return Engine_ENGINE_UNSPECIFIED
 }
 
-func (x *WorkspaceProfileSetting) GetRequire_2Fa() bool {
+func (x *SchemaTemplateSetting_FieldTemplate) GetCategory() string {
 	if x != nil {
-		return x.Require_2Fa
+		return x.Category
 	}
-	return false
+	return ""
 }
 
-func (x *WorkspaceProfileSetting) GetOutboundIpList() []string {
+func (x *SchemaTemplateSetting_FieldTemplate) GetColumn() *ColumnMetadata {
 	if x != nil {
-		return x.OutboundIpList
+		return x.Column
+	}
+	return nil
+}
+
+func (x *SchemaTemplateSetting_FieldTemplate) GetConfig() *ColumnConfig {
+	if x != nil {
+		return x.Config
 	}
 	return nil
 }
 
-func (x *WorkspaceProfileSetting) GetGitopsWebhookUrl() string {
+type SchemaTemplateSetting_ColumnType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Engine  Engine   `protobuf:"varint,1,opt,name=engine,proto3,enum=bytebase.v1.Engine" json:"engine,omitempty"`
+	Enabled bool     `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Types   []string `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty"`
+}
+
+func (x *SchemaTemplateSetting_ColumnType) Reset() {
+	*x = SchemaTemplateSetting_ColumnType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_setting_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchemaTemplateSetting_ColumnType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaTemplateSetting_ColumnType) ProtoMessage() {}
+
+func (x *SchemaTemplateSetting_ColumnType) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaTemplateSetting_ColumnType.ProtoReflect.Descriptor instead.
+func (*SchemaTemplateSetting_ColumnType) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{20, 1}
+}
+
+func (x *SchemaTemplateSetting_ColumnType) GetEngine() Engine {
 	if x != nil {
-		return x.GitopsWebhookUrl
+		return x.Engine
 	}
-	return ""
+	return Engine_ENGINE_UNSPECIFIED
 }
 
-func (x *WorkspaceProfileSetting) GetTokenDuration() *durationpb.Duration {
+func (x *SchemaTemplateSetting_ColumnType) GetEnabled() bool {
 	if x != nil {
-		return x.TokenDuration
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-func (x *WorkspaceProfileSetting) GetAnnouncement() *Announcement {
+func (x *SchemaTemplateSetting_ColumnType) GetTypes() []string {
 	if x != nil {
-		return x.Announcement
+		return x.Types
 	}
 	return nil
 }
 
-type Announcement struct {
+type SchemaTemplateSetting_TableTemplate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The alert level of announcemnt
-	Level Announcement_AlertLevel `protobuf:"varint,1,opt,name=level,proto3,enum=bytebase.v1.Announcement_AlertLevel" json:"level,omitempty"`
-	// The text of announcemnt
-	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	// The optional link, user can follow the link to check extra details
-	Link string `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
+	Id       string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Engine   Engine         `protobuf:"varint,2,opt,name=engine,proto3,enum=bytebase.v1.Engine" json:"engine,omitempty"`
+	Category string         `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Table    *TableMetadata `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
+	Config   *TableConfig   `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *Announcement) Reset() {
-	*x = Announcement{}
+func (x *SchemaTemplateSetting_TableTemplate) Reset() {
+	*x = SchemaTemplateSetting_TableTemplate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[11]
+		mi := &file_v1_setting_service_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Announcement) String() string {
+func (x *SchemaTemplateSetting_TableTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Announcement) ProtoMessage() {}
+func (*SchemaTemplateSetting_TableTemplate) ProtoMessage() {}
 
-func (x *Announcement) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[11]
+func (x *SchemaTemplateSetting_TableTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1205,57 +3833,79 @@ func (x *Announcement) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
-func (*Announcement) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SchemaTemplateSetting_TableTemplate.ProtoReflect.Descriptor instead.
+func (*SchemaTemplateSetting_TableTemplate) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{20, 2}
 }
 
-func (x *Announcement) GetLevel() Announcement_AlertLevel {
+func (x *SchemaTemplateSetting_TableTemplate) GetId() string {
 	if x != nil {
-		return x.Level
+		return x.Id
 	}
-	return Announcement_ALERT_LEVEL_UNSPECIFIED
+	return ""
 }
 
-func (x *Announcement) GetText() string {
+func (x *SchemaTemplateSetting_TableTemplate) GetEngine() Engine {
 	if x != nil {
-		return x.Text
+		return x.Engine
 	}
-	return ""
+	return Engine_ENGINE_UNSPECIFIED
 }
 
-func (x *Announcement) GetLink() string {
+func (x *SchemaTemplateSetting_TableTemplate) GetCategory() string {
 	if x != nil {
-		return x.Link
+		return x.Category
 	}
 	return ""
 }
 
-type WorkspaceApprovalSetting struct {
+func (x *SchemaTemplateSetting_TableTemplate) GetTable() *TableMetadata {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *SchemaTemplateSetting_TableTemplate) GetConfig() *TableConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type DataClassificationSetting_DataClassificationConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rules []*WorkspaceApprovalSetting_Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	// id is the uuid for classification. Each project can chose one classification config.
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// levels is user defined level list for classification.
+	// The order for the level decides its priority.
+	Levels []*DataClassificationSetting_DataClassificationConfig_Level `protobuf:"bytes,3,rep,name=levels,proto3" json:"levels,omitempty"`
+	// classification is the id - DataClassification map.
+	// The id should in [0-9]+-[0-9]+-[0-9]+ format.
+	Classification map[string]*DataClassificationSetting_DataClassificationConfig_DataClassification `protobuf:"bytes,4,rep,name=classification,proto3" json:"classification,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *WorkspaceApprovalSetting) Reset() {
-	*x = WorkspaceApprovalSetting{}
+func (x *DataClassificationSetting_DataClassificationConfig) Reset() {
+	*x = DataClassificationSetting_DataClassificationConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[12]
+		mi := &file_v1_setting_service_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WorkspaceApprovalSetting) String() string {
+func (x *DataClassificationSetting_DataClassificationConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceApprovalSetting) ProtoMessage() {}
+func (*DataClassificationSetting_DataClassificationConfig) ProtoMessage() {}
 
-func (x *WorkspaceApprovalSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[12]
+func (x *DataClassificationSetting_DataClassificationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1266,43 +3916,66 @@ func (x *WorkspaceApprovalSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceApprovalSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceApprovalSetting) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DataClassificationSetting_DataClassificationConfig.ProtoReflect.Descriptor instead.
+func (*DataClassificationSetting_DataClassificationConfig) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{22, 0}
 }
 
-func (x *WorkspaceApprovalSetting) GetRules() []*WorkspaceApprovalSetting_Rule {
+func (x *DataClassificationSetting_DataClassificationConfig) GetId() string {
 	if x != nil {
-		return x.Rules
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig) GetLevels() []*DataClassificationSetting_DataClassificationConfig_Level {
+	if x != nil {
+		return x.Levels
 	}
 	return nil
 }
 
-type ExternalApprovalSetting struct {
+func (x *DataClassificationSetting_DataClassificationConfig) GetClassification() map[string]*DataClassificationSetting_DataClassificationConfig_DataClassification {
+	if x != nil {
+		return x.Classification
+	}
+	return nil
+}
+
+type DataClassificationSetting_DataClassificationConfig_Level struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nodes []*ExternalApprovalSetting_Node `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (x *ExternalApprovalSetting) Reset() {
-	*x = ExternalApprovalSetting{}
+func (x *DataClassificationSetting_DataClassificationConfig_Level) Reset() {
+	*x = DataClassificationSetting_DataClassificationConfig_Level{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[13]
+		mi := &file_v1_setting_service_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExternalApprovalSetting) String() string {
+func (x *DataClassificationSetting_DataClassificationConfig_Level) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExternalApprovalSetting) ProtoMessage() {}
+func (*DataClassificationSetting_DataClassificationConfig_Level) ProtoMessage() {}
 
-func (x *ExternalApprovalSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[13]
+func (x *DataClassificationSetting_DataClassificationConfig_Level) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1313,45 +3986,61 @@ func (x *ExternalApprovalSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExternalApprovalSetting.ProtoReflect.Descriptor instead.
-func (*ExternalApprovalSetting) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DataClassificationSetting_DataClassificationConfig_Level.ProtoReflect.Descriptor instead.
+func (*DataClassificationSetting_DataClassificationConfig_Level) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{22, 0, 0}
 }
 
-func (x *ExternalApprovalSetting) GetNodes() []*ExternalApprovalSetting_Node {
+func (x *DataClassificationSetting_DataClassificationConfig_Level) GetId() string {
 	if x != nil {
-		return x.Nodes
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type SchemaTemplateSetting struct {
+func (x *DataClassificationSetting_DataClassificationConfig_Level) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Level) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type DataClassificationSetting_DataClassificationConfig_DataClassification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FieldTemplates []*SchemaTemplateSetting_FieldTemplate `protobuf:"bytes,1,rep,name=field_templates,json=fieldTemplates,proto3" json:"field_templates,omitempty"`
-	ColumnTypes    []*SchemaTemplateSetting_ColumnType    `protobuf:"bytes,2,rep,name=column_types,json=columnTypes,proto3" json:"column_types,omitempty"`
-	TableTemplates []*SchemaTemplateSetting_TableTemplate `protobuf:"bytes,3,rep,name=table_templates,json=tableTemplates,proto3" json:"table_templates,omitempty"`
+	// id is the classification id in [0-9]+-[0-9]+-[0-9]+ format.
+	Id          string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	LevelId     *string `protobuf:"bytes,4,opt,name=level_id,json=levelId,proto3,oneof" json:"level_id,omitempty"`
 }
 
-func (x *SchemaTemplateSetting) Reset() {
-	*x = SchemaTemplateSetting{}
+func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) Reset() {
+	*x = DataClassificationSetting_DataClassificationConfig_DataClassification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[14]
+		mi := &file_v1_setting_service_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SchemaTemplateSetting) String() string {
+func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchemaTemplateSetting) ProtoMessage() {}
+func (*DataClassificationSetting_DataClassificationConfig_DataClassification) ProtoMessage() {}
 
-func (x *SchemaTemplateSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[14]
+func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1362,62 +4051,73 @@ func (x *SchemaTemplateSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchemaTemplateSetting.ProtoReflect.Descriptor instead.
-func (*SchemaTemplateSetting) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use DataClassificationSetting_DataClassificationConfig_DataClassification.ProtoReflect.Descriptor instead.
+func (*DataClassificationSetting_DataClassificationConfig_DataClassification) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{22, 0, 1}
 }
 
-func (x *SchemaTemplateSetting) GetFieldTemplates() []*SchemaTemplateSetting_FieldTemplate {
+func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) GetId() string {
 	if x != nil {
-		return x.FieldTemplates
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *SchemaTemplateSetting) GetColumnTypes() []*SchemaTemplateSetting_ColumnType {
+func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) GetTitle() string {
 	if x != nil {
-		return x.ColumnTypes
+		return x.Title
 	}
-	return nil
+	return ""
 }
 
-func (x *SchemaTemplateSetting) GetTableTemplates() []*SchemaTemplateSetting_TableTemplate {
+func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) GetDescription() string {
 	if x != nil {
-		return x.TableTemplates
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-type WorkspaceTrialSetting struct {
+func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) GetLevelId() string {
+	if x != nil && x.LevelId != nil {
+		return *x.LevelId
+	}
+	return ""
+}
+
+type SemanticTypeSetting_SemanticType struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceCount int32                  `protobuf:"varint,1,opt,name=instance_count,json=instanceCount,proto3" json:"instance_count,omitempty"`
-	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
-	IssuedTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=issued_time,json=issuedTime,proto3" json:"issued_time,omitempty"`
-	Subject       string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
-	OrgName       string                 `protobuf:"bytes,5,opt,name=org_name,json=orgName,proto3" json:"org_name,omitempty"`
-	Plan          PlanType               `protobuf:"varint,6,opt,name=plan,proto3,enum=bytebase.v1.PlanType" json:"plan,omitempty"`
+	// id is the uuid for semantic type.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the title of the semantic type, it should not be empty.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the semantic type, it can be empty.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// the partial mask algorithm id for the semantic type, if it is empty, should use the default partial mask algorithm.
+	PartialMaskAlgorithmId string `protobuf:"bytes,4,opt,name=partial_mask_algorithm_id,json=partialMaskAlgorithmId,proto3" json:"partial_mask_algorithm_id,omitempty"`
+	// the full mask algorithm id for the semantic type, if it is empty, should use the default full mask algorithm.
+	FullMaskAlgorithmId string `protobuf:"bytes,5,opt,name=full_mask_algorithm_id,json=fullMaskAlgorithmId,proto3" json:"full_mask_algorithm_id,omitempty"`
 }
 
-func (x *WorkspaceTrialSetting) Reset() {
-	*x = WorkspaceTrialSetting{}
+func (x *SemanticTypeSetting_SemanticType) Reset() {
+	*x = SemanticTypeSetting_SemanticType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[15]
+		mi := &file_v1_setting_service_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WorkspaceTrialSetting) String() string {
+func (x *SemanticTypeSetting_SemanticType) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceTrialSetting) ProtoMessage() {}
+func (*SemanticTypeSetting_SemanticType) ProtoMessage() {}
 
-func (x *WorkspaceTrialSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[15]
+func (x *SemanticTypeSetting_SemanticType) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1428,78 +4128,91 @@ func (x *WorkspaceTrialSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceTrialSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceTrialSetting) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *WorkspaceTrialSetting) GetInstanceCount() int32 {
-	if x != nil {
-		return x.InstanceCount
-	}
-	return 0
+// Deprecated: Use SemanticTypeSetting_SemanticType.ProtoReflect.Descriptor instead.
+func (*SemanticTypeSetting_SemanticType) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{23, 0}
 }
 
-func (x *WorkspaceTrialSetting) GetExpireTime() *timestamppb.Timestamp {
+func (x *SemanticTypeSetting_SemanticType) GetId() string {
 	if x != nil {
-		return x.ExpireTime
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *WorkspaceTrialSetting) GetIssuedTime() *timestamppb.Timestamp {
+func (x *SemanticTypeSetting_SemanticType) GetTitle() string {
 	if x != nil {
-		return x.IssuedTime
+		return x.Title
 	}
-	return nil
+	return ""
 }
 
-func (x *WorkspaceTrialSetting) GetSubject() string {
+func (x *SemanticTypeSetting_SemanticType) GetDescription() string {
 	if x != nil {
-		return x.Subject
+		return x.Description
 	}
 	return ""
 }
 
-func (x *WorkspaceTrialSetting) GetOrgName() string {
+func (x *SemanticTypeSetting_SemanticType) GetPartialMaskAlgorithmId() string {
 	if x != nil {
-		return x.OrgName
+		return x.PartialMaskAlgorithmId
 	}
 	return ""
 }
 
-func (x *WorkspaceTrialSetting) GetPlan() PlanType {
+func (x *SemanticTypeSetting_SemanticType) GetFullMaskAlgorithmId() string {
 	if x != nil {
-		return x.Plan
+		return x.FullMaskAlgorithmId
 	}
-	return PlanType_PLAN_TYPE_UNSPECIFIED
+	return ""
 }
 
-type DataClassificationSetting struct {
+type MaskingAlgorithmSetting_Algorithm struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Configs []*DataClassificationSetting_DataClassificationConfig `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	// id is the uuid for masking algorithm.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// title is the title for masking algorithm.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// description is the description for masking algorithm.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Category is the category for masking algorithm. Currently, it accepts 2 categories only: MASK and HASH.
+	// The range of accepted Payload is decided by the category.
+	// MASK: FullMask, RangeMask, FormatPreservingMask, DateShiftMask, RegexMask
+	// HASH: MD5Mask, DeterministicHashMask
+	Category string `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	// Types that are assignable to Mask:
+	//
+	//	*MaskingAlgorithmSetting_Algorithm_FullMask_
+	//	*MaskingAlgorithmSetting_Algorithm_RangeMask_
+	//	*MaskingAlgorithmSetting_Algorithm_Md5Mask
+	//	*MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_
+	//	*MaskingAlgorithmSetting_Algorithm_DeterministicHashMask_
+	//	*MaskingAlgorithmSetting_Algorithm_DateShiftMask_
+	//	*MaskingAlgorithmSetting_Algorithm_RegexMask_
+	Mask isMaskingAlgorithmSetting_Algorithm_Mask `protobuf_oneof:"mask"`
 }
 
-func (x *DataClassificationSetting) Reset() {
-	*x = DataClassificationSetting{}
+func (x *MaskingAlgorithmSetting_Algorithm) Reset() {
+	*x = MaskingAlgorithmSetting_Algorithm{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[16]
+		mi := &file_v1_setting_service_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DataClassificationSetting) String() string {
+func (x *MaskingAlgorithmSetting_Algorithm) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataClassificationSetting) ProtoMessage() {}
+func (*MaskingAlgorithmSetting_Algorithm) ProtoMessage() {}
 
-func (x *DataClassificationSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[16]
+func (x *MaskingAlgorithmSetting_Algorithm) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1510,139 +4223,170 @@ func (x *DataClassificationSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataClassificationSetting.ProtoReflect.Descriptor instead.
-func (*DataClassificationSetting) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use MaskingAlgorithmSetting_Algorithm.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting_Algorithm) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{24, 0}
 }
 
-func (x *DataClassificationSetting) GetConfigs() []*DataClassificationSetting_DataClassificationConfig {
+func (x *MaskingAlgorithmSetting_Algorithm) GetId() string {
 	if x != nil {
-		return x.Configs
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type SemanticTypeSetting struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *MaskingAlgorithmSetting_Algorithm) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
 
-	Types []*SemanticTypeSetting_SemanticType `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+func (x *MaskingAlgorithmSetting_Algorithm) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *SemanticTypeSetting) Reset() {
-	*x = SemanticTypeSetting{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MaskingAlgorithmSetting_Algorithm) GetCategory() string {
+	if x != nil {
+		return x.Category
 	}
+	return ""
 }
 
-func (x *SemanticTypeSetting) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *MaskingAlgorithmSetting_Algorithm) GetMask() isMaskingAlgorithmSetting_Algorithm_Mask {
+	if m != nil {
+		return m.Mask
+	}
+	return nil
 }
 
-func (*SemanticTypeSetting) ProtoMessage() {}
+func (x *MaskingAlgorithmSetting_Algorithm) GetFullMask() *MaskingAlgorithmSetting_Algorithm_FullMask {
+	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_FullMask_); ok {
+		return x.FullMask
+	}
+	return nil
+}
 
-func (x *SemanticTypeSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *MaskingAlgorithmSetting_Algorithm) GetRangeMask() *MaskingAlgorithmSetting_Algorithm_RangeMask {
+	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_RangeMask_); ok {
+		return x.RangeMask
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use SemanticTypeSetting.ProtoReflect.Descriptor instead.
-func (*SemanticTypeSetting) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{17}
+func (x *MaskingAlgorithmSetting_Algorithm) GetMd5Mask() *MaskingAlgorithmSetting_Algorithm_MD5Mask {
+	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_Md5Mask); ok {
+		return x.Md5Mask
+	}
+	return nil
 }
 
-func (x *SemanticTypeSetting) GetTypes() []*SemanticTypeSetting_SemanticType {
-	if x != nil {
-		return x.Types
+func (x *MaskingAlgorithmSetting_Algorithm) GetFormatPreservingMask() *MaskingAlgorithmSetting_Algorithm_FormatPreservingMask {
+	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_); ok {
+		return x.FormatPreservingMask
 	}
 	return nil
 }
 
-type MaskingAlgorithmSetting struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *MaskingAlgorithmSetting_Algorithm) GetDeterministicHashMask() *MaskingAlgorithmSetting_Algorithm_DeterministicHashMask {
+	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_DeterministicHashMask_); ok {
+		return x.DeterministicHashMask
+	}
+	return nil
+}
 
-	// algorithms is the list of masking algorithms.
-	Algorithms []*MaskingAlgorithmSetting_Algorithm `protobuf:"bytes,1,rep,name=algorithms,proto3" json:"algorithms,omitempty"`
+func (x *MaskingAlgorithmSetting_Algorithm) GetDateShiftMask() *MaskingAlgorithmSetting_Algorithm_DateShiftMask {
+	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_DateShiftMask_); ok {
+		return x.DateShiftMask
+	}
+	return nil
 }
 
-func (x *MaskingAlgorithmSetting) Reset() {
-	*x = MaskingAlgorithmSetting{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MaskingAlgorithmSetting_Algorithm) GetRegexMask() *MaskingAlgorithmSetting_Algorithm_RegexMask {
+	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_RegexMask_); ok {
+		return x.RegexMask
 	}
+	return nil
 }
 
-func (x *MaskingAlgorithmSetting) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type isMaskingAlgorithmSetting_Algorithm_Mask interface {
+	isMaskingAlgorithmSetting_Algorithm_Mask()
 }
 
-func (*MaskingAlgorithmSetting) ProtoMessage() {}
+type MaskingAlgorithmSetting_Algorithm_FullMask_ struct {
+	FullMask *MaskingAlgorithmSetting_Algorithm_FullMask `protobuf:"bytes,5,opt,name=full_mask,json=fullMask,proto3,oneof"`
+}
 
-func (x *MaskingAlgorithmSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type MaskingAlgorithmSetting_Algorithm_RangeMask_ struct {
+	RangeMask *MaskingAlgorithmSetting_Algorithm_RangeMask `protobuf:"bytes,6,opt,name=range_mask,json=rangeMask,proto3,oneof"`
 }
 
-// Deprecated: Use MaskingAlgorithmSetting.ProtoReflect.Descriptor instead.
-func (*MaskingAlgorithmSetting) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{18}
+type MaskingAlgorithmSetting_Algorithm_Md5Mask struct {
+	Md5Mask *MaskingAlgorithmSetting_Algorithm_MD5Mask `protobuf:"bytes,7,opt,name=md5_mask,json=md5Mask,proto3,oneof"`
 }
 
-func (x *MaskingAlgorithmSetting) GetAlgorithms() []*MaskingAlgorithmSetting_Algorithm {
-	if x != nil {
-		return x.Algorithms
-	}
-	return nil
+type MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_ struct {
+	FormatPreservingMask *MaskingAlgorithmSetting_Algorithm_FormatPreservingMask `protobuf:"bytes,8,opt,name=format_preserving_mask,json=formatPreservingMask,proto3,oneof"`
 }
 
-type AppIMSetting_ExternalApproval struct {
+type MaskingAlgorithmSetting_Algorithm_DeterministicHashMask_ struct {
+	DeterministicHashMask *MaskingAlgorithmSetting_Algorithm_DeterministicHashMask `protobuf:"bytes,9,opt,name=deterministic_hash_mask,json=deterministicHashMask,proto3,oneof"`
+}
+
+type MaskingAlgorithmSetting_Algorithm_DateShiftMask_ struct {
+	DateShiftMask *MaskingAlgorithmSetting_Algorithm_DateShiftMask `protobuf:"bytes,10,opt,name=date_shift_mask,json=dateShiftMask,proto3,oneof"`
+}
+
+type MaskingAlgorithmSetting_Algorithm_RegexMask_ struct {
+	RegexMask *MaskingAlgorithmSetting_Algorithm_RegexMask `protobuf:"bytes,11,opt,name=regex_mask,json=regexMask,proto3,oneof"`
+}
+
+func (*MaskingAlgorithmSetting_Algorithm_FullMask_) isMaskingAlgorithmSetting_Algorithm_Mask() {}
+
+func (*MaskingAlgorithmSetting_Algorithm_RangeMask_) isMaskingAlgorithmSetting_Algorithm_Mask() {}
+
+func (*MaskingAlgorithmSetting_Algorithm_Md5Mask) isMaskingAlgorithmSetting_Algorithm_Mask() {}
+
+func (*MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_) isMaskingAlgorithmSetting_Algorithm_Mask() {
+}
+
+func (*MaskingAlgorithmSetting_Algorithm_DeterministicHashMask_) isMaskingAlgorithmSetting_Algorithm_Mask() {
+}
+
+func (*MaskingAlgorithmSetting_Algorithm_DateShiftMask_) isMaskingAlgorithmSetting_Algorithm_Mask() {}
+
+func (*MaskingAlgorithmSetting_Algorithm_RegexMask_) isMaskingAlgorithmSetting_Algorithm_Mask() {}
+
+type MaskingAlgorithmSetting_Algorithm_FullMask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled              bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	ApprovalDefinitionId string `protobuf:"bytes,2,opt,name=approval_definition_id,json=approvalDefinitionId,proto3" json:"approval_definition_id,omitempty"`
+	// substitution is the string used to replace the original value, the
+	// max length of the string is 16 bytes.
+	Substitution string `protobuf:"bytes,1,opt,name=substitution,proto3" json:"substitution,omitempty"`
 }
 
-func (x *AppIMSetting_ExternalApproval) Reset() {
-	*x = AppIMSetting_ExternalApproval{}
+func (x *MaskingAlgorithmSetting_Algorithm_FullMask) Reset() {
+	*x = MaskingAlgorithmSetting_Algorithm_FullMask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[19]
+		mi := &file_v1_setting_service_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AppIMSetting_ExternalApproval) String() string {
+func (x *MaskingAlgorithmSetting_Algorithm_FullMask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppIMSetting_ExternalApproval) ProtoMessage() {}
+func (*MaskingAlgorithmSetting_Algorithm_FullMask) ProtoMessage() {}
 
-func (x *AppIMSetting_ExternalApproval) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[19]
+func (x *MaskingAlgorithmSetting_Algorithm_FullMask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1653,51 +4397,45 @@ func (x *AppIMSetting_ExternalApproval) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppIMSetting_ExternalApproval.ProtoReflect.Descriptor instead.
-func (*AppIMSetting_ExternalApproval) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{8, 0}
-}
-
-func (x *AppIMSetting_ExternalApproval) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
-	}
-	return false
+// Deprecated: Use MaskingAlgorithmSetting_Algorithm_FullMask.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting_Algorithm_FullMask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{24, 0, 0}
 }
 
-func (x *AppIMSetting_ExternalApproval) GetApprovalDefinitionId() string {
+func (x *MaskingAlgorithmSetting_Algorithm_FullMask) GetSubstitution() string {
 	if x != nil {
-		return x.ApprovalDefinitionId
+		return x.Substitution
 	}
 	return ""
 }
 
-type WorkspaceApprovalSetting_Rule struct {
+type MaskingAlgorithmSetting_Algorithm_RangeMask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Template  *ApprovalTemplate `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
-	Condition *expr.Expr        `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	// We store it as a repeated field to face the fact that the original value may have multiple parts should be masked.
+	// But frontend can be started with a single rule easily.
+	Slices []*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice `protobuf:"bytes,1,rep,name=slices,proto3" json:"slices,omitempty"`
 }
 
-func (x *WorkspaceApprovalSetting_Rule) Reset() {
-	*x = WorkspaceApprovalSetting_Rule{}
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask) Reset() {
+	*x = MaskingAlgorithmSetting_Algorithm_RangeMask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[20]
+		mi := &file_v1_setting_service_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WorkspaceApprovalSetting_Rule) String() string {
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceApprovalSetting_Rule) ProtoMessage() {}
+func (*MaskingAlgorithmSetting_Algorithm_RangeMask) ProtoMessage() {}
 
-func (x *WorkspaceApprovalSetting_Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[20]
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1708,56 +4446,44 @@ func (x *WorkspaceApprovalSetting_Rule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceApprovalSetting_Rule.ProtoReflect.Descriptor instead.
-func (*WorkspaceApprovalSetting_Rule) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{12, 0}
-}
-
-func (x *WorkspaceApprovalSetting_Rule) GetTemplate() *ApprovalTemplate {
-	if x != nil {
-		return x.Template
-	}
-	return nil
+// Deprecated: Use MaskingAlgorithmSetting_Algorithm_RangeMask.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting_Algorithm_RangeMask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{24, 0, 1}
 }
 
-func (x *WorkspaceApprovalSetting_Rule) GetCondition() *expr.Expr {
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask) GetSlices() []*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice {
 	if x != nil {
-		return x.Condition
+		return x.Slices
 	}
 	return nil
 }
 
-type ExternalApprovalSetting_Node struct {
+type MaskingAlgorithmSetting_Algorithm_MD5Mask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A unique identifier for a node in UUID format.
-	// We will also include the id in the message sending to the external relay service to identify the node.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The title of the node.
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// The external endpoint for the relay service, e.g. "http://hello:1234".
-	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// salt is the salt value to generate a different hash that with the word alone.
+	Salt string `protobuf:"bytes,1,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
-func (x *ExternalApprovalSetting_Node) Reset() {
-	*x = ExternalApprovalSetting_Node{}
+func (x *MaskingAlgorithmSetting_Algorithm_MD5Mask) Reset() {
+	*x = MaskingAlgorithmSetting_Algorithm_MD5Mask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[21]
+		mi := &file_v1_setting_service_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExternalApprovalSetting_Node) String() string {
+func (x *MaskingAlgorithmSetting_Algorithm_MD5Mask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExternalApprovalSetting_Node) ProtoMessage() {}
+func (*MaskingAlgorithmSetting_Algorithm_MD5Mask) ProtoMessage() {}
 
-func (x *ExternalApprovalSetting_Node) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[21]
+func (x *MaskingAlgorithmSetting_Algorithm_MD5Mask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1768,61 +4494,47 @@ func (x *ExternalApprovalSetting_Node) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExternalApprovalSetting_Node.ProtoReflect.Descriptor instead.
-func (*ExternalApprovalSetting_Node) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{13, 0}
-}
-
-func (x *ExternalApprovalSetting_Node) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *ExternalApprovalSetting_Node) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use MaskingAlgorithmSetting_Algorithm_MD5Mask.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting_Algorithm_MD5Mask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{24, 0, 2}
 }
 
-func (x *ExternalApprovalSetting_Node) GetEndpoint() string {
+func (x *MaskingAlgorithmSetting_Algorithm_MD5Mask) GetSalt() string {
 	if x != nil {
-		return x.Endpoint
+		return x.Salt
 	}
 	return ""
 }
 
-type SchemaTemplateSetting_FieldTemplate struct {
+type MaskingAlgorithmSetting_Algorithm_FormatPreservingMask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Engine   Engine          `protobuf:"varint,2,opt,name=engine,proto3,enum=bytebase.v1.Engine" json:"engine,omitempty"`
-	Category string          `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	Column   *ColumnMetadata `protobuf:"bytes,4,opt,name=column,proto3" json:"column,omitempty"`
-	Config   *ColumnConfig   `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	// key is the key to encrypt the digits of the original value, the length and the other characters
+	// are kept, e.g. the separators of the credit card and phone numbers.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// keep_suffix_digits is the number of the trailing digits kept as they are, e.g. 4 for the credit card numbers.
+	KeepSuffixDigits int32 `protobuf:"varint,2,opt,name=keep_suffix_digits,json=keepSuffixDigits,proto3" json:"keep_suffix_digits,omitempty"`
 }
 
-func (x *SchemaTemplateSetting_FieldTemplate) Reset() {
-	*x = SchemaTemplateSetting_FieldTemplate{}
+func (x *MaskingAlgorithmSetting_Algorithm_FormatPreservingMask) Reset() {
+	*x = MaskingAlgorithmSetting_Algorithm_FormatPreservingMask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[22]
+		mi := &file_v1_setting_service_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SchemaTemplateSetting_FieldTemplate) String() string {
+func (x *MaskingAlgorithmSetting_Algorithm_FormatPreservingMask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchemaTemplateSetting_FieldTemplate) ProtoMessage() {}
+func (*MaskingAlgorithmSetting_Algorithm_FormatPreservingMask) ProtoMessage() {}
 
-func (x *SchemaTemplateSetting_FieldTemplate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[22]
+func (x *MaskingAlgorithmSetting_Algorithm_FormatPreservingMask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1833,73 +4545,48 @@ func (x *SchemaTemplateSetting_FieldTemplate) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchemaTemplateSetting_FieldTemplate.ProtoReflect.Descriptor instead.
-func (*SchemaTemplateSetting_FieldTemplate) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{14, 0}
-}
-
-func (x *SchemaTemplateSetting_FieldTemplate) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *SchemaTemplateSetting_FieldTemplate) GetEngine() Engine {
-	if x != nil {
-		return x.Engine
-	}
-	return Engine_ENGINE_UNSPECIFIED
+// Deprecated: Use MaskingAlgorithmSetting_Algorithm_FormatPreservingMask.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting_Algorithm_FormatPreservingMask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{24, 0, 3}
 }
 
-func (x *SchemaTemplateSetting_FieldTemplate) GetCategory() string {
+func (x *MaskingAlgorithmSetting_Algorithm_FormatPreservingMask) GetKey() string {
 	if x != nil {
-		return x.Category
+		return x.Key
 	}
 	return ""
 }
 
-func (x *SchemaTemplateSetting_FieldTemplate) GetColumn() *ColumnMetadata {
-	if x != nil {
-		return x.Column
-	}
-	return nil
-}
-
-func (x *SchemaTemplateSetting_FieldTemplate) GetConfig() *ColumnConfig {
+func (x *MaskingAlgorithmSetting_Algorithm_FormatPreservingMask) GetKeepSuffixDigits() int32 {
 	if x != nil {
-		return x.Config
+		return x.KeepSuffixDigits
 	}
-	return nil
+	return 0
 }
 
-type SchemaTemplateSetting_ColumnType struct {
+type MaskingAlgorithmSetting_Algorithm_DeterministicHashMask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Engine  Engine   `protobuf:"varint,1,opt,name=engine,proto3,enum=bytebase.v1.Engine" json:"engine,omitempty"`
-	Enabled bool     `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Types   []string `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty"`
 }
 
-func (x *SchemaTemplateSetting_ColumnType) Reset() {
-	*x = SchemaTemplateSetting_ColumnType{}
+func (x *MaskingAlgorithmSetting_Algorithm_DeterministicHashMask) Reset() {
+	*x = MaskingAlgorithmSetting_Algorithm_DeterministicHashMask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[23]
+		mi := &file_v1_setting_service_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SchemaTemplateSetting_ColumnType) String() string {
+func (x *MaskingAlgorithmSetting_Algorithm_DeterministicHashMask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchemaTemplateSetting_ColumnType) ProtoMessage() {}
+func (*MaskingAlgorithmSetting_Algorithm_DeterministicHashMask) ProtoMessage() {}
 
-func (x *SchemaTemplateSetting_ColumnType) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[23]
+func (x *MaskingAlgorithmSetting_Algorithm_DeterministicHashMask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1910,61 +4597,38 @@ func (x *SchemaTemplateSetting_ColumnType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchemaTemplateSetting_ColumnType.ProtoReflect.Descriptor instead.
-func (*SchemaTemplateSetting_ColumnType) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{14, 1}
-}
-
-func (x *SchemaTemplateSetting_ColumnType) GetEngine() Engine {
-	if x != nil {
-		return x.Engine
-	}
-	return Engine_ENGINE_UNSPECIFIED
-}
-
-func (x *SchemaTemplateSetting_ColumnType) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
-	}
-	return false
-}
-
-func (x *SchemaTemplateSetting_ColumnType) GetTypes() []string {
-	if x != nil {
-		return x.Types
-	}
-	return nil
+// Deprecated: Use MaskingAlgorithmSetting_Algorithm_DeterministicHashMask.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting_Algorithm_DeterministicHashMask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{24, 0, 4}
 }
 
-type SchemaTemplateSetting_TableTemplate struct {
+type MaskingAlgorithmSetting_Algorithm_DateShiftMask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Engine   Engine         `protobuf:"varint,2,opt,name=engine,proto3,enum=bytebase.v1.Engine" json:"engine,omitempty"`
-	Category string         `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	Table    *TableMetadata `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
-	Config   *TableConfig   `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	// max_days is the window of the shift, the date is shifted by [-max_days, max_days] days.
+	// The number of days is derived from the original value and the per-workspace salt, so the same date is always shifted to the same date.
+	MaxDays int32 `protobuf:"varint,1,opt,name=max_days,json=maxDays,proto3" json:"max_days,omitempty"`
 }
 
-func (x *SchemaTemplateSetting_TableTemplate) Reset() {
-	*x = SchemaTemplateSetting_TableTemplate{}
+func (x *MaskingAlgorithmSetting_Algorithm_DateShiftMask) Reset() {
+	*x = MaskingAlgorithmSetting_Algorithm_DateShiftMask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[24]
+		mi := &file_v1_setting_service_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SchemaTemplateSetting_TableTemplate) String() string {
+func (x *MaskingAlgorithmSetting_Algorithm_DateShiftMask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchemaTemplateSetting_TableTemplate) ProtoMessage() {}
+func (*MaskingAlgorithmSetting_Algorithm_DateShiftMask) ProtoMessage() {}
 
-func (x *SchemaTemplateSetting_TableTemplate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[24]
+func (x *MaskingAlgorithmSetting_Algorithm_DateShiftMask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1975,79 +4639,46 @@ func (x *SchemaTemplateSetting_TableTemplate) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchemaTemplateSetting_TableTemplate.ProtoReflect.Descriptor instead.
-func (*SchemaTemplateSetting_TableTemplate) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{14, 2}
-}
-
-func (x *SchemaTemplateSetting_TableTemplate) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *SchemaTemplateSetting_TableTemplate) GetEngine() Engine {
-	if x != nil {
-		return x.Engine
-	}
-	return Engine_ENGINE_UNSPECIFIED
-}
-
-func (x *SchemaTemplateSetting_TableTemplate) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
-}
-
-func (x *SchemaTemplateSetting_TableTemplate) GetTable() *TableMetadata {
-	if x != nil {
-		return x.Table
-	}
-	return nil
+// Deprecated: Use MaskingAlgorithmSetting_Algorithm_DateShiftMask.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting_Algorithm_DateShiftMask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{24, 0, 5}
 }
 
-func (x *SchemaTemplateSetting_TableTemplate) GetConfig() *TableConfig {
+func (x *MaskingAlgorithmSetting_Algorithm_DateShiftMask) GetMaxDays() int32 {
 	if x != nil {
-		return x.Config
+		return x.MaxDays
 	}
-	return nil
+	return 0
 }
 
-type DataClassificationSetting_DataClassificationConfig struct {
+type MaskingAlgorithmSetting_Algorithm_RegexMask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the uuid for classification. Each project can chose one classification config.
-	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// levels is user defined level list for classification.
-	// The order for the level decides its priority.
-	Levels []*DataClassificationSetting_DataClassificationConfig_Level `protobuf:"bytes,3,rep,name=levels,proto3" json:"levels,omitempty"`
-	// classification is the id - DataClassification map.
-	// The id should in [0-9]+-[0-9]+-[0-9]+ format.
-	Classification map[string]*DataClassificationSetting_DataClassificationConfig_DataClassification `protobuf:"bytes,4,rep,name=classification,proto3" json:"classification,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// pattern is the RE2 regular expression matching the parts of the original value to be masked.
+	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// substitution is the string used to replace the matched parts, it can refer to the capturing groups, e.g. ${1}****.
+	Substitution string `protobuf:"bytes,2,opt,name=substitution,proto3" json:"substitution,omitempty"`
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig) Reset() {
-	*x = DataClassificationSetting_DataClassificationConfig{}
+func (x *MaskingAlgorithmSetting_Algorithm_RegexMask) Reset() {
+	*x = MaskingAlgorithmSetting_Algorithm_RegexMask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[25]
+		mi := &file_v1_setting_service_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig) String() string {
+func (x *MaskingAlgorithmSetting_Algorithm_RegexMask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataClassificationSetting_DataClassificationConfig) ProtoMessage() {}
+func (*MaskingAlgorithmSetting_Algorithm_RegexMask) ProtoMessage() {}
 
-func (x *DataClassificationSetting_DataClassificationConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[25]
+func (x *MaskingAlgorithmSetting_Algorithm_RegexMask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2058,66 +4689,55 @@ func (x *DataClassificationSetting_DataClassificationConfig) ProtoReflect() prot
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataClassificationSetting_DataClassificationConfig.ProtoReflect.Descriptor instead.
-func (*DataClassificationSetting_DataClassificationConfig) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{16, 0}
+// Deprecated: Use MaskingAlgorithmSetting_Algorithm_RegexMask.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting_Algorithm_RegexMask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{24, 0, 6}
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig) GetId() string {
+func (x *MaskingAlgorithmSetting_Algorithm_RegexMask) GetPattern() string {
 	if x != nil {
-		return x.Id
+		return x.Pattern
 	}
 	return ""
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig) GetTitle() string {
+func (x *MaskingAlgorithmSetting_Algorithm_RegexMask) GetSubstitution() string {
 	if x != nil {
-		return x.Title
+		return x.Substitution
 	}
 	return ""
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig) GetLevels() []*DataClassificationSetting_DataClassificationConfig_Level {
-	if x != nil {
-		return x.Levels
-	}
-	return nil
-}
-
-func (x *DataClassificationSetting_DataClassificationConfig) GetClassification() map[string]*DataClassificationSetting_DataClassificationConfig_DataClassification {
-	if x != nil {
-		return x.Classification
-	}
-	return nil
-}
-
-type DataClassificationSetting_DataClassificationConfig_Level struct {
+type MaskingAlgorithmSetting_Algorithm_RangeMask_Slice struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// start is the start index of the original value, start from 0 and should be less than stop.
+	Start int32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// stop is the stop index of the original value, should be less than the length of the original value.
+	End int32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	// substitution is the string used to replace the OriginalValue[start:end).
+	Substitution string `protobuf:"bytes,3,opt,name=substitution,proto3" json:"substitution,omitempty"`
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig_Level) Reset() {
-	*x = DataClassificationSetting_DataClassificationConfig_Level{}
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) Reset() {
+	*x = MaskingAlgorithmSetting_Algorithm_RangeMask_Slice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[26]
+		mi := &file_v1_setting_service_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig_Level) String() string {
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataClassificationSetting_DataClassificationConfig_Level) ProtoMessage() {}
-
-func (x *DataClassificationSetting_DataClassificationConfig_Level) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[26]
+func (*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) ProtoMessage() {}
+
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2128,61 +4748,60 @@ func (x *DataClassificationSetting_DataClassificationConfig_Level) ProtoReflect(
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataClassificationSetting_DataClassificationConfig_Level.ProtoReflect.Descriptor instead.
-func (*DataClassificationSetting_DataClassificationConfig_Level) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{16, 0, 0}
+// Deprecated: Use MaskingAlgorithmSetting_Algorithm_RangeMask_Slice.ProtoReflect.Descriptor instead.
+func (*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{24, 0, 1, 0}
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig_Level) GetId() string {
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) GetStart() int32 {
 	if x != nil {
-		return x.Id
+		return x.Start
 	}
-	return ""
+	return 0
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig_Level) GetTitle() string {
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) GetEnd() int32 {
 	if x != nil {
-		return x.Title
+		return x.End
 	}
-	return ""
+	return 0
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig_Level) GetDescription() string {
+func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) GetSubstitution() string {
 	if x != nil {
-		return x.Description
+		return x.Substitution
 	}
 	return ""
 }
 
-type DataClassificationSetting_DataClassificationConfig_DataClassification struct {
+type RateLimitSetting_Quota struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the classification id in [0-9]+-[0-9]+-[0-9]+ format.
-	Id          string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	LevelId     *string `protobuf:"bytes,4,opt,name=level_id,json=levelId,proto3,oneof" json:"level_id,omitempty"`
+	// requests_per_minute is the number of requests allowed per minute, 0 means unlimited.
+	RequestsPerMinute int32 `protobuf:"varint,1,opt,name=requests_per_minute,json=requestsPerMinute,proto3" json:"requests_per_minute,omitempty"`
+	// burst is the maximum number of requests allowed at once, it defaults to requests_per_minute if not set.
+	Burst int32 `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) Reset() {
-	*x = DataClassificationSetting_DataClassificationConfig_DataClassification{}
+func (x *RateLimitSetting_Quota) Reset() {
+	*x = RateLimitSetting_Quota{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[27]
+		mi := &file_v1_setting_service_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) String() string {
+func (x *RateLimitSetting_Quota) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataClassificationSetting_DataClassificationConfig_DataClassification) ProtoMessage() {}
+func (*RateLimitSetting_Quota) ProtoMessage() {}
 
-func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[27]
+func (x *RateLimitSetting_Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2193,73 +4812,52 @@ func (x *DataClassificationSetting_DataClassificationConfig_DataClassification)
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataClassificationSetting_DataClassificationConfig_DataClassification.ProtoReflect.Descriptor instead.
-func (*DataClassificationSetting_DataClassificationConfig_DataClassification) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{16, 0, 1}
-}
-
-func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use RateLimitSetting_Quota.ProtoReflect.Descriptor instead.
+func (*RateLimitSetting_Quota) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{25, 0}
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) GetTitle() string {
+func (x *RateLimitSetting_Quota) GetRequestsPerMinute() int32 {
 	if x != nil {
-		return x.Title
+		return x.RequestsPerMinute
 	}
-	return ""
+	return 0
 }
 
-func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) GetDescription() string {
+func (x *RateLimitSetting_Quota) GetBurst() int32 {
 	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) GetLevelId() string {
-	if x != nil && x.LevelId != nil {
-		return *x.LevelId
+		return x.Burst
 	}
-	return ""
+	return 0
 }
 
-type SemanticTypeSetting_SemanticType struct {
+type RateLimitSetting_Override struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the uuid for semantic type.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// the title of the semantic type, it should not be empty.
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// the description of the semantic type, it can be empty.
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// the partial mask algorithm id for the semantic type, if it is empty, should use the default partial mask algorithm.
-	PartialMaskAlgorithmId string `protobuf:"bytes,4,opt,name=partial_mask_algorithm_id,json=partialMaskAlgorithmId,proto3" json:"partial_mask_algorithm_id,omitempty"`
-	// the full mask algorithm id for the semantic type, if it is empty, should use the default full mask algorithm.
-	FullMaskAlgorithmId string `protobuf:"bytes,5,opt,name=full_mask_algorithm_id,json=fullMaskAlgorithmId,proto3" json:"full_mask_algorithm_id,omitempty"`
+	// principal is the email of the user or service account.
+	Principal string                  `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	Quota     *RateLimitSetting_Quota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
 }
 
-func (x *SemanticTypeSetting_SemanticType) Reset() {
-	*x = SemanticTypeSetting_SemanticType{}
+func (x *RateLimitSetting_Override) Reset() {
+	*x = RateLimitSetting_Override{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[29]
+		mi := &file_v1_setting_service_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SemanticTypeSetting_SemanticType) String() string {
+func (x *RateLimitSetting_Override) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SemanticTypeSetting_SemanticType) ProtoMessage() {}
+func (*RateLimitSetting_Override) ProtoMessage() {}
 
-func (x *SemanticTypeSetting_SemanticType) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[29]
+func (x *RateLimitSetting_Override) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2270,87 +4868,54 @@ func (x *SemanticTypeSetting_SemanticType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SemanticTypeSetting_SemanticType.ProtoReflect.Descriptor instead.
-func (*SemanticTypeSetting_SemanticType) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{17, 0}
-}
-
-func (x *SemanticTypeSetting_SemanticType) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *SemanticTypeSetting_SemanticType) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *SemanticTypeSetting_SemanticType) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Deprecated: Use RateLimitSetting_Override.ProtoReflect.Descriptor instead.
+func (*RateLimitSetting_Override) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{25, 1}
 }
 
-func (x *SemanticTypeSetting_SemanticType) GetPartialMaskAlgorithmId() string {
+func (x *RateLimitSetting_Override) GetPrincipal() string {
 	if x != nil {
-		return x.PartialMaskAlgorithmId
+		return x.Principal
 	}
 	return ""
 }
 
-func (x *SemanticTypeSetting_SemanticType) GetFullMaskAlgorithmId() string {
+func (x *RateLimitSetting_Override) GetQuota() *RateLimitSetting_Quota {
 	if x != nil {
-		return x.FullMaskAlgorithmId
+		return x.Quota
 	}
-	return ""
+	return nil
 }
 
-type MaskingAlgorithmSetting_Algorithm struct {
+type SCIMSetting_GroupMapping struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the uuid for masking algorithm.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// title is the title for masking algorithm.
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// description is the description for masking algorithm.
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// Category is the category for masking algorithm. Currently, it accepts 2 categories only: MASK and HASH.
-	// The range of accepted Payload is decided by the category.
-	// MASK: FullMask, RangeMask
-	// HASH: MD5Mask
-	Category string `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
-	// Types that are assignable to Mask:
-	//
-	//	*MaskingAlgorithmSetting_Algorithm_FullMask_
-	//	*MaskingAlgorithmSetting_Algorithm_RangeMask_
-	//	*MaskingAlgorithmSetting_Algorithm_Md5Mask
-	Mask isMaskingAlgorithmSetting_Algorithm_Mask `protobuf_oneof:"mask"`
+	// group is the display name of the identity provider group, e.g. "dba-team".
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// workspace_role is the workspace role granted to the group members, e.g. "roles/workspaceDBA", optional.
+	WorkspaceRole string                                  `protobuf:"bytes,2,opt,name=workspace_role,json=workspaceRole,proto3" json:"workspace_role,omitempty"`
+	ProjectRoles  []*SCIMSetting_GroupMapping_ProjectRole `protobuf:"bytes,3,rep,name=project_roles,json=projectRoles,proto3" json:"project_roles,omitempty"`
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm) Reset() {
-	*x = MaskingAlgorithmSetting_Algorithm{}
+func (x *SCIMSetting_GroupMapping) Reset() {
+	*x = SCIMSetting_GroupMapping{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[30]
+		mi := &file_v1_setting_service_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm) String() string {
+func (x *SCIMSetting_GroupMapping) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaskingAlgorithmSetting_Algorithm) ProtoMessage() {}
+func (*SCIMSetting_GroupMapping) ProtoMessage() {}
 
-func (x *MaskingAlgorithmSetting_Algorithm) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[30]
+func (x *SCIMSetting_GroupMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2361,116 +4926,60 @@ func (x *MaskingAlgorithmSetting_Algorithm) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaskingAlgorithmSetting_Algorithm.ProtoReflect.Descriptor instead.
-func (*MaskingAlgorithmSetting_Algorithm) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{18, 0}
-}
-
-func (x *MaskingAlgorithmSetting_Algorithm) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use SCIMSetting_GroupMapping.ProtoReflect.Descriptor instead.
+func (*SCIMSetting_GroupMapping) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{34, 0}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm) GetTitle() string {
+func (x *SCIMSetting_GroupMapping) GetGroup() string {
 	if x != nil {
-		return x.Title
+		return x.Group
 	}
 	return ""
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm) GetDescription() string {
+func (x *SCIMSetting_GroupMapping) GetWorkspaceRole() string {
 	if x != nil {
-		return x.Description
+		return x.WorkspaceRole
 	}
 	return ""
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm) GetCategory() string {
+func (x *SCIMSetting_GroupMapping) GetProjectRoles() []*SCIMSetting_GroupMapping_ProjectRole {
 	if x != nil {
-		return x.Category
-	}
-	return ""
-}
-
-func (m *MaskingAlgorithmSetting_Algorithm) GetMask() isMaskingAlgorithmSetting_Algorithm_Mask {
-	if m != nil {
-		return m.Mask
-	}
-	return nil
-}
-
-func (x *MaskingAlgorithmSetting_Algorithm) GetFullMask() *MaskingAlgorithmSetting_Algorithm_FullMask {
-	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_FullMask_); ok {
-		return x.FullMask
+		return x.ProjectRoles
 	}
 	return nil
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm) GetRangeMask() *MaskingAlgorithmSetting_Algorithm_RangeMask {
-	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_RangeMask_); ok {
-		return x.RangeMask
-	}
-	return nil
-}
-
-func (x *MaskingAlgorithmSetting_Algorithm) GetMd5Mask() *MaskingAlgorithmSetting_Algorithm_MD5Mask {
-	if x, ok := x.GetMask().(*MaskingAlgorithmSetting_Algorithm_Md5Mask); ok {
-		return x.Md5Mask
-	}
-	return nil
-}
-
-type isMaskingAlgorithmSetting_Algorithm_Mask interface {
-	isMaskingAlgorithmSetting_Algorithm_Mask()
-}
-
-type MaskingAlgorithmSetting_Algorithm_FullMask_ struct {
-	FullMask *MaskingAlgorithmSetting_Algorithm_FullMask `protobuf:"bytes,5,opt,name=full_mask,json=fullMask,proto3,oneof"`
-}
-
-type MaskingAlgorithmSetting_Algorithm_RangeMask_ struct {
-	RangeMask *MaskingAlgorithmSetting_Algorithm_RangeMask `protobuf:"bytes,6,opt,name=range_mask,json=rangeMask,proto3,oneof"`
-}
-
-type MaskingAlgorithmSetting_Algorithm_Md5Mask struct {
-	Md5Mask *MaskingAlgorithmSetting_Algorithm_MD5Mask `protobuf:"bytes,7,opt,name=md5_mask,json=md5Mask,proto3,oneof"`
-}
-
-func (*MaskingAlgorithmSetting_Algorithm_FullMask_) isMaskingAlgorithmSetting_Algorithm_Mask() {}
-
-func (*MaskingAlgorithmSetting_Algorithm_RangeMask_) isMaskingAlgorithmSetting_Algorithm_Mask() {}
-
-func (*MaskingAlgorithmSetting_Algorithm_Md5Mask) isMaskingAlgorithmSetting_Algorithm_Mask() {}
-
-type MaskingAlgorithmSetting_Algorithm_FullMask struct {
+type SCIMSetting_GroupMapping_ProjectRole struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// substitution is the string used to replace the original value, the
-	// max length of the string is 16 bytes.
-	Substitution string `protobuf:"bytes,1,opt,name=substitution,proto3" json:"substitution,omitempty"`
+	// Format: projects/{project}
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// role is the project role granted to the group members, e.g. "roles/projectDeveloper".
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_FullMask) Reset() {
-	*x = MaskingAlgorithmSetting_Algorithm_FullMask{}
+func (x *SCIMSetting_GroupMapping_ProjectRole) Reset() {
+	*x = SCIMSetting_GroupMapping_ProjectRole{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[31]
+		mi := &file_v1_setting_service_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_FullMask) String() string {
+func (x *SCIMSetting_GroupMapping_ProjectRole) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaskingAlgorithmSetting_Algorithm_FullMask) ProtoMessage() {}
+func (*SCIMSetting_GroupMapping_ProjectRole) ProtoMessage() {}
 
-func (x *MaskingAlgorithmSetting_Algorithm_FullMask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[31]
+func (x *SCIMSetting_GroupMapping_ProjectRole) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2481,45 +4990,56 @@ func (x *MaskingAlgorithmSetting_Algorithm_FullMask) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaskingAlgorithmSetting_Algorithm_FullMask.ProtoReflect.Descriptor instead.
-func (*MaskingAlgorithmSetting_Algorithm_FullMask) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{18, 0, 0}
+// Deprecated: Use SCIMSetting_GroupMapping_ProjectRole.ProtoReflect.Descriptor instead.
+func (*SCIMSetting_GroupMapping_ProjectRole) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{34, 0, 0}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_FullMask) GetSubstitution() string {
+func (x *SCIMSetting_GroupMapping_ProjectRole) GetProject() string {
 	if x != nil {
-		return x.Substitution
+		return x.Project
 	}
 	return ""
 }
 
-type MaskingAlgorithmSetting_Algorithm_RangeMask struct {
+func (x *SCIMSetting_GroupMapping_ProjectRole) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AuditLogSetting_S3Export struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// We store it as a repeated field to face the fact that the original value may have multiple parts should be masked.
-	// But frontend can be started with a single rule easily.
-	Slices []*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice `protobuf:"bytes,1,rep,name=slices,proto3" json:"slices,omitempty"`
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// prefix is the prefix of the object keys, e.g. "bytebase/audit-logs".
+	Prefix      string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	AccessKeyId string `protobuf:"bytes,4,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	// It is never returned, and the stored secret access key is kept if it is not set.
+	SecretAccessKey *string `protobuf:"bytes,5,opt,name=secret_access_key,json=secretAccessKey,proto3,oneof" json:"secret_access_key,omitempty"`
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask) Reset() {
-	*x = MaskingAlgorithmSetting_Algorithm_RangeMask{}
+func (x *AuditLogSetting_S3Export) Reset() {
+	*x = AuditLogSetting_S3Export{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[32]
+		mi := &file_v1_setting_service_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask) String() string {
+func (x *AuditLogSetting_S3Export) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaskingAlgorithmSetting_Algorithm_RangeMask) ProtoMessage() {}
+func (*AuditLogSetting_S3Export) ProtoMessage() {}
 
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[32]
+func (x *AuditLogSetting_S3Export) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2530,44 +5050,72 @@ func (x *MaskingAlgorithmSetting_Algorithm_RangeMask) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaskingAlgorithmSetting_Algorithm_RangeMask.ProtoReflect.Descriptor instead.
-func (*MaskingAlgorithmSetting_Algorithm_RangeMask) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{18, 0, 1}
+// Deprecated: Use AuditLogSetting_S3Export.ProtoReflect.Descriptor instead.
+func (*AuditLogSetting_S3Export) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{35, 0}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask) GetSlices() []*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice {
+func (x *AuditLogSetting_S3Export) GetRegion() string {
 	if x != nil {
-		return x.Slices
+		return x.Region
 	}
-	return nil
+	return ""
 }
 
-type MaskingAlgorithmSetting_Algorithm_MD5Mask struct {
+func (x *AuditLogSetting_S3Export) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *AuditLogSetting_S3Export) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *AuditLogSetting_S3Export) GetAccessKeyId() string {
+	if x != nil {
+		return x.AccessKeyId
+	}
+	return ""
+}
+
+func (x *AuditLogSetting_S3Export) GetSecretAccessKey() string {
+	if x != nil && x.SecretAccessKey != nil {
+		return *x.SecretAccessKey
+	}
+	return ""
+}
+
+type AuditLogSetting_SyslogExport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// salt is the salt value to generate a different hash that with the word alone.
-	Salt string `protobuf:"bytes,1,opt,name=salt,proto3" json:"salt,omitempty"`
+
+	// address is the syslog server address, e.g. "udp://syslog:514" or "tcp://syslog:514".
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_MD5Mask) Reset() {
-	*x = MaskingAlgorithmSetting_Algorithm_MD5Mask{}
+func (x *AuditLogSetting_SyslogExport) Reset() {
+	*x = AuditLogSetting_SyslogExport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[33]
+		mi := &file_v1_setting_service_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_MD5Mask) String() string {
+func (x *AuditLogSetting_SyslogExport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaskingAlgorithmSetting_Algorithm_MD5Mask) ProtoMessage() {}
+func (*AuditLogSetting_SyslogExport) ProtoMessage() {}
 
-func (x *MaskingAlgorithmSetting_Algorithm_MD5Mask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[33]
+func (x *AuditLogSetting_SyslogExport) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2578,48 +5126,70 @@ func (x *MaskingAlgorithmSetting_Algorithm_MD5Mask) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaskingAlgorithmSetting_Algorithm_MD5Mask.ProtoReflect.Descriptor instead.
-func (*MaskingAlgorithmSetting_Algorithm_MD5Mask) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{18, 0, 2}
+// Deprecated: Use AuditLogSetting_SyslogExport.ProtoReflect.Descriptor instead.
+func (*AuditLogSetting_SyslogExport) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{35, 1}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_MD5Mask) GetSalt() string {
+func (x *AuditLogSetting_SyslogExport) GetAddress() string {
 	if x != nil {
-		return x.Salt
+		return x.Address
 	}
 	return ""
 }
 
-type MaskingAlgorithmSetting_Algorithm_RangeMask_Slice struct {
+type InstanceDiscoverySetting_Source struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// start is the start index of the original value, start from 0 and should be less than stop.
-	Start int32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
-	// stop is the stop index of the original value, should be less than the length of the original value.
-	End int32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
-	// substitution is the string used to replace the OriginalValue[start:end).
-	Substitution string `protobuf:"bytes,3,opt,name=substitution,proto3" json:"substitution,omitempty"`
-}
-
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) Reset() {
-	*x = MaskingAlgorithmSetting_Algorithm_RangeMask_Slice{}
+	// id is the unique identifier of the source, e.g. "aws-prod".
+	Id       string                                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider InstanceDiscoverySetting_Source_Provider `protobuf:"varint,2,opt,name=provider,proto3,enum=bytebase.v1.InstanceDiscoverySetting_Source_Provider" json:"provider,omitempty"`
+	// regions are the AWS regions to list, e.g. "us-east-1".
+	Regions []string `protobuf:"bytes,3,rep,name=regions,proto3" json:"regions,omitempty"`
+	// project is the GCP project to list.
+	Project string `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`
+	// access_key_id is the AWS access key ID. The default credential chain is used if it is empty.
+	AccessKeyId string `protobuf:"bytes,5,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	// It is never returned, and the stored secret access key is kept if it is not set.
+	SecretAccessKey *string `protobuf:"bytes,6,opt,name=secret_access_key,json=secretAccessKey,proto3,oneof" json:"secret_access_key,omitempty"`
+	// service_account_key is the GCP service account key in JSON. The application default credentials are used if it is empty.
+	// It is never returned, and the stored service account key is kept if it is not set.
+	ServiceAccountKey *string `protobuf:"bytes,7,opt,name=service_account_key,json=serviceAccountKey,proto3,oneof" json:"service_account_key,omitempty"`
+	// tag_filters are the tags (AWS) or the user labels (GCP) the discovered instances must have, e.g. {"bytebase": "true"}.
+	// All the cloud instances are discovered if it is empty.
+	TagFilters map[string]string `protobuf:"bytes,8,rep,name=tag_filters,json=tagFilters,proto3" json:"tag_filters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// environment is the environment of the registered instances.
+	// Format: environments/{environment}
+	Environment string `protobuf:"bytes,9,opt,name=environment,proto3" json:"environment,omitempty"`
+	// auto_register registers the discovered instances automatically,
+	// otherwise the discovered instances are proposed for the adoption.
+	AutoRegister bool `protobuf:"varint,10,opt,name=auto_register,json=autoRegister,proto3" json:"auto_register,omitempty"`
+	// username is the username of the admin data source of the registered instances.
+	Username string `protobuf:"bytes,11,opt,name=username,proto3" json:"username,omitempty"`
+	// password is the password of the admin data source of the registered instances.
+	// It is never returned, and the stored password is kept if it is not set.
+	Password *string `protobuf:"bytes,12,opt,name=password,proto3,oneof" json:"password,omitempty"`
+}
+
+func (x *InstanceDiscoverySetting_Source) Reset() {
+	*x = InstanceDiscoverySetting_Source{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_setting_service_proto_msgTypes[34]
+		mi := &file_v1_setting_service_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) String() string {
+func (x *InstanceDiscoverySetting_Source) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) ProtoMessage() {}
+func (*InstanceDiscoverySetting_Source) ProtoMessage() {}
 
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[34]
+func (x *InstanceDiscoverySetting_Source) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2630,28 +5200,91 @@ func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) ProtoReflect() proto
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaskingAlgorithmSetting_Algorithm_RangeMask_Slice.ProtoReflect.Descriptor instead.
-func (*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{18, 0, 1, 0}
+// Deprecated: Use InstanceDiscoverySetting_Source.ProtoReflect.Descriptor instead.
+func (*InstanceDiscoverySetting_Source) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{37, 0}
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) GetStart() int32 {
+func (x *InstanceDiscoverySetting_Source) GetId() string {
 	if x != nil {
-		return x.Start
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) GetEnd() int32 {
+func (x *InstanceDiscoverySetting_Source) GetProvider() InstanceDiscoverySetting_Source_Provider {
 	if x != nil {
-		return x.End
+		return x.Provider
 	}
-	return 0
+	return InstanceDiscoverySetting_Source_PROVIDER_UNSPECIFIED
 }
 
-func (x *MaskingAlgorithmSetting_Algorithm_RangeMask_Slice) GetSubstitution() string {
+func (x *InstanceDiscoverySetting_Source) GetRegions() []string {
 	if x != nil {
-		return x.Substitution
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *InstanceDiscoverySetting_Source) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *InstanceDiscoverySetting_Source) GetAccessKeyId() string {
+	if x != nil {
+		return x.AccessKeyId
+	}
+	return ""
+}
+
+func (x *InstanceDiscoverySetting_Source) GetSecretAccessKey() string {
+	if x != nil && x.SecretAccessKey != nil {
+		return *x.SecretAccessKey
+	}
+	return ""
+}
+
+func (x *InstanceDiscoverySetting_Source) GetServiceAccountKey() string {
+	if x != nil && x.ServiceAccountKey != nil {
+		return *x.ServiceAccountKey
+	}
+	return ""
+}
+
+func (x *InstanceDiscoverySetting_Source) GetTagFilters() map[string]string {
+	if x != nil {
+		return x.TagFilters
+	}
+	return nil
+}
+
+func (x *InstanceDiscoverySetting_Source) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *InstanceDiscoverySetting_Source) GetAutoRegister() bool {
+	if x != nil {
+		return x.AutoRegister
+	}
+	return false
+}
+
+func (x *InstanceDiscoverySetting_Source) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *InstanceDiscoverySetting_Source) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
 	}
 	return ""
 }
@@ -2675,7 +5308,14 @@ var file_v1_setting_service_proto_rawDesc = []byte{
 	0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d,
 	0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x76, 0x31, 0x2f, 0x64, 0x61,
 	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x76, 0x31, 0x2f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x73,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x19, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x76,
+	0x31, 0x2f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x18, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x76, 0x31,
 	0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x13, 0x4c,
@@ -2705,182 +5345,317 @@ var file_v1_setting_service_proto_rawDesc = []byte{
 	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x23,
 	0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f,
-	0x6e, 0x6c, 0x79, 0x22, 0x47, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xc1, 0x09, 0x0a,
-	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x73, 0x0a, 0x20, 0x73,
-	0x6d, 0x74, 0x70, 0x5f, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x79, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x4d, 0x54, 0x50, 0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x48, 0x00, 0x52, 0x1c, 0x73, 0x6d, 0x74, 0x70, 0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x4c, 0x0a, 0x14, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x6d, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70,
-	0x49, 0x4d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x11, 0x61, 0x70, 0x70,
-	0x49, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x5e,
-	0x0a, 0x1a, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x73,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x17, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x6d,
-	0x0a, 0x1f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52,
-	0x1c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x70, 0x0a,
-	0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00,
-	0x52, 0x1d, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x67, 0x0a, 0x1d, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x72, 0x69,
-	0x61, 0x6c, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x72,
-	0x69, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x1a, 0x77, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x72, 0x69, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x6d, 0x0a, 0x1f, 0x65, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x1c, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x6c, 0x79, 0x22, 0x9e, 0x01, 0x0a, 0x1c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x34,
+	0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x59, 0x41, 0x4d, 0x4c, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53,
+	0x4f, 0x4e, 0x10, 0x02, 0x22, 0x39, 0x0a, 0x1d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
+	0x61, 0x0a, 0x1b, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e,
+	0x6c, 0x79, 0x22, 0x5c, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x22, 0xd1, 0x01, 0x0a, 0x15, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x38, 0x0a, 0x06, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41,
+	0x54, 0x45, 0x10, 0x02, 0x22, 0xe7, 0x01, 0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x0c, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x2f, 0x0a,
+	0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x47,
+	0x0a, 0x07, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xd5, 0x12, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x73, 0x0a, 0x20, 0x73, 0x6d, 0x74, 0x70, 0x5f, 0x6d,
+	0x61, 0x69, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x4d, 0x54, 0x50, 0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x1c, 0x73,
+	0x6d, 0x74, 0x70, 0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x61,
+	0x70, 0x70, 0x5f, 0x69, 0x6d, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x4d, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x11, 0x61, 0x70, 0x70, 0x49, 0x6d, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x5e, 0x0a, 0x1a, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00,
+	0x52, 0x17, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x6d, 0x0a, 0x1f, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x1c, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x70, 0x0a, 0x20, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x1d, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x67, 0x0a, 0x1d, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x72, 0x69, 0x61, 0x6c, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x1a, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x54, 0x72, 0x69, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x6d, 0x0a, 0x1f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72,
 	0x6e, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x67, 0x0a, 0x1d, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x48, 0x00, 0x52, 0x1a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x54, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x73, 0x0a, 0x21, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c,
-	0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x1e, 0x64, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x61, 0x0a, 0x1b, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69,
-	0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x79, 0x74,
-	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69,
-	0x63, 0x54, 0x79, 0x70, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x18,
-	0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x6d, 0x0a, 0x1f, 0x6d, 0x61, 0x73, 0x6b,
-	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x5f, 0x73, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x1c, 0x6d, 0x61, 0x73, 0x6b, 0x69,
+	0x6e, 0x67, 0x48, 0x00, 0x52, 0x1c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x67, 0x0a, 0x1d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52,
+	0x1a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x73, 0x0a, 0x21, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00,
+	0x52, 0x1e, 0x64, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x61, 0x0a, 0x1b, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x18, 0x73, 0x65, 0x6d, 0x61, 0x6e,
+	0x74, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x6d, 0x0a, 0x1f, 0x6d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x61,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69,
 	0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0xda, 0x05, 0x0a, 0x1c, 0x53, 0x4d, 0x54, 0x50, 0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x54, 0x0a,
-	0x0a, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x34, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x4d, 0x54, 0x50, 0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x45, 0x6e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x02, 0x63, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x02, 0x63, 0x61, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12,
-	0x17, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
-	0x04, 0x63, 0x65, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x60, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x38, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6e, 0x67, 0x48, 0x00, 0x52, 0x1c, 0x6d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x58, 0x0a, 0x18, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x15, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x5b, 0x0a, 0x19,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48,
+	0x00, 0x52, 0x16, 0x71, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x5e, 0x0a, 0x1a, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00,
+	0x52, 0x17, 0x71, 0x75, 0x65, 0x72, 0x79, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x61, 0x0a, 0x1b, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x48, 0x00, 0x52, 0x18, 0x71, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x51, 0x0a, 0x15,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x13, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x48, 0x0a, 0x12, 0x6a, 0x69, 0x72, 0x61, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x69, 0x72, 0x61, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x10, 0x6a, 0x69, 0x72, 0x61, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x5b, 0x0a, 0x19, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4e, 0x6f, 0x77, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x16,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x77, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x55, 0x0a, 0x17, 0x73, 0x6c, 0x61, 0x63, 0x6b, 0x5f,
+	0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x61, 0x63, 0x6b, 0x41, 0x70, 0x70, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x14, 0x73, 0x6c, 0x61, 0x63, 0x6b, 0x41, 0x70,
+	0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x55, 0x0a,
+	0x17, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x75, 0x73, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x42, 0x75, 0x73, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x14,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x63, 0x69, 0x6d, 0x5f, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x43, 0x49, 0x4d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x10, 0x73, 0x63,
+	0x69, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x55,
+	0x0a, 0x17, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52,
+	0x14, 0x61, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x5d, 0x0a, 0x19, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x17, 0x6d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x70, 0x0a, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x1d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xda, 0x05, 0x0a, 0x1c, 0x53, 0x4d, 0x54, 0x50, 0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x54, 0x0a, 0x0a,
+	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x34, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
 	0x4d, 0x54, 0x50, 0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74,
-	0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x6e, 0x0a, 0x0a, 0x45,
-	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x4e, 0x43,
-	0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e,
-	0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x54, 0x4c,
-	0x53, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x53, 0x53, 0x4c, 0x5f, 0x54, 0x4c, 0x53, 0x10, 0x03, 0x22, 0x9a, 0x01, 0x0a, 0x0e,
-	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
-	0x0a, 0x1a, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17,
-	0x0a, 0x13, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x54, 0x48, 0x45,
-	0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x10,
-	0x02, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x41,
-	0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52,
-	0x41, 0x4d, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x04, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x63, 0x61, 0x42,
-	0x06, 0x0a, 0x04, 0x5f, 0x6b, 0x65, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x65, 0x72, 0x74,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xeb, 0x02,
-	0x0a, 0x0c, 0x41, 0x70, 0x70, 0x49, 0x4d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x39,
-	0x0a, 0x07, 0x69, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x20, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
-	0x70, 0x49, 0x4d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x49, 0x4d, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x06, 0x69, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
-	0x57, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x62, 0x79, 0x74,
-	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x4d, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x1a, 0x62, 0x0a, 0x10, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x61, 0x6c, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
-	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x06,
-	0x49, 0x4d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4d, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x0a, 0x0a, 0x06, 0x46, 0x45, 0x49, 0x53, 0x48, 0x55, 0x10, 0x01, 0x22, 0x3c, 0x0a, 0x12, 0x41,
-	0x67, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xdf, 0x02, 0x0a, 0x17, 0x57, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x69, 0x67, 0x6e, 0x75,
-	0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x32, 0x66, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x32,
-	0x66, 0x61, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69,
-	0x70, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x75,
-	0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12,
-	0x67, 0x69, 0x74, 0x6f, 0x70, 0x73, 0x5f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75,
-	0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x67, 0x69, 0x74, 0x6f, 0x70, 0x73,
-	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0c,
-	0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61,
-	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xe6, 0x01, 0x0a, 0x0c,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x02, 0x63, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x02, 0x63, 0x61, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x17,
+	0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04,
+	0x63, 0x65, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x60, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x38, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x4d,
+	0x54, 0x50, 0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x6e, 0x0a, 0x0a, 0x45, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x4e, 0x43, 0x52,
+	0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x43,
+	0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x54, 0x4c, 0x53,
+	0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x53, 0x4c, 0x5f, 0x54, 0x4c, 0x53, 0x10, 0x03, 0x22, 0x9a, 0x01, 0x0a, 0x0e, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
+	0x1a, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a,
+	0x13, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e,
+	0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x10, 0x02,
+	0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x55,
+	0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x41,
+	0x4d, 0x5f, 0x4d, 0x44, 0x35, 0x10, 0x04, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x63, 0x61, 0x42, 0x06,
+	0x0a, 0x04, 0x5f, 0x6b, 0x65, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xeb, 0x02, 0x0a,
+	0x0c, 0x41, 0x70, 0x70, 0x49, 0x4d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a,
+	0x07, 0x69, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70,
+	0x49, 0x4d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x49, 0x4d, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x06, 0x69, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x57,
+	0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x4d, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x1a, 0x62, 0x0a, 0x10, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x06, 0x49,
+	0x4d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x46, 0x45, 0x49, 0x53, 0x48, 0x55, 0x10, 0x01, 0x22, 0x3c, 0x0a, 0x12, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe0, 0x03, 0x0a, 0x17, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x32, 0x66, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x32, 0x66,
+	0x61, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x70,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x75, 0x74,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x67,
+	0x69, 0x74, 0x6f, 0x70, 0x73, 0x5f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x67, 0x69, 0x74, 0x6f, 0x70, 0x73, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x61,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x61,
+	0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x69, 0x6d,
+	0x75, 0x6d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4b,
+	0x0a, 0x14, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xe6, 0x01, 0x0a, 0x0c,
 	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x05,
 	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x62, 0x79,
 	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
@@ -2908,223 +5683,544 @@ var file_v1_setting_service_proto_rawDesc = []byte{
 	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
 	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x09, 0x63, 0x6f,
-	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x17, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa9, 0x02, 0x0a, 0x17, 0x45, 0x78, 0x74, 0x65,
 	0x72, 0x6e, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74,
 	0x69, 0x6e, 0x67, 0x12, 0x3f, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
 	0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
 	0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e,
-	0x6f, 0x64, 0x65, 0x73, 0x1a, 0x48, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xac,
-	0x06, 0x0a, 0x15, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x59, 0x0a, 0x0f, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x30, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x52, 0x0e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x62, 0x79, 0x74, 0x65,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x54, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x0f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30,
+	0x6f, 0x64, 0x65, 0x73, 0x1a, 0xcc, 0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x42, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x22, 0x3e, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43,
+	0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x49, 0x52,
+	0x41, 0x10, 0x03, 0x22, 0xac, 0x06, 0x0a, 0x15, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x59, 0x0a,
+	0x0f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
 	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68,
 	0x65, 0x6d, 0x61, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x52, 0x0e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
-	0x1a, 0xd0, 0x01, 0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x33, 0x0a, 0x06, 0x63,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x12, 0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x1a, 0x69, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x13, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0xcc,
-	0x01, 0x0a, 0x0d, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x2b, 0x0a, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x13, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x98, 0x02,
-	0x0a, 0x15, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x72, 0x69, 0x61, 0x6c,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b,
-	0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x69,
-	0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x69, 0x73,
-	0x73, 0x75, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a,
-	0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0x8a, 0x06, 0x0a, 0x19, 0x44, 0x61, 0x74,
-	0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x59, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e,
-	0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x73, 0x1a, 0x91, 0x05, 0x0a, 0x18, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e,
+	0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x0f, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x1a, 0xd0, 0x01, 0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x06, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x12, 0x33, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x06, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x69, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x06, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x1a, 0xcc, 0x01, 0x0a, 0x0d, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x06, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x30,
+	0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x30, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x22, 0x98, 0x02, 0x0a, 0x15, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x54, 0x72, 0x69, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x3b, 0x0a, 0x0b, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x67, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6c, 0x61, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0x8a, 0x06,
+	0x0a, 0x19, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x59, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x1a, 0x91, 0x05, 0x0a, 0x18, 0x44, 0x61, 0x74, 0x61, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x5d, 0x0a, 0x06, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x7b, 0x0a, 0x0e, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x53, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x4f, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x0e,
 	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
 	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x12, 0x5d, 0x0a, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x61, 0x74,
-	0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x73, 0x12, 0x7b, 0x0a, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x53, 0x2e, 0x62, 0x79,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x89, 0x01, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x1a, 0x95, 0x01, 0x0a, 0x13, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x68, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x52, 0x2e, 0x62, 0x79,
 	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c,
 	0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74,
 	0x69, 0x6e, 0x67, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6c, 0x61,
-	0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x1a, 0x4f, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x1a, 0x89, 0x01, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x53,
+	0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x43, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0xc6, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x6d, 0x61,
+	0x6e, 0x74, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20,
 	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1e, 0x0a, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x1a, 0x95, 0x01,
-	0x0a, 0x13, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x68, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x52, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44,
-	0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6c, 0x61, 0x73,
-	0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa3, 0x02, 0x0a, 0x13, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74,
-	0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x43, 0x0a,
-	0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x62,
-	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6d, 0x61, 0x6e,
-	0x74, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53,
-	0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x1a, 0xc6, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x70,
-	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x5f, 0x61, 0x6c, 0x67, 0x6f,
-	0x72, 0x69, 0x74, 0x68, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4d, 0x61, 0x73, 0x6b, 0x41, 0x6c, 0x67, 0x6f, 0x72,
-	0x69, 0x74, 0x68, 0x6d, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6d,
-	0x61, 0x73, 0x6b, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x5f, 0x69, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x66, 0x75, 0x6c, 0x6c, 0x4d, 0x61, 0x73, 0x6b,
-	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x49, 0x64, 0x22, 0xf5, 0x05, 0x0a, 0x17,
+	0x12, 0x39, 0x0a, 0x19, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x16, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4d, 0x61, 0x73, 0x6b,
+	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x66,
+	0x75, 0x6c, 0x6c, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x66, 0x75, 0x6c,
+	0x6c, 0x4d, 0x61, 0x73, 0x6b, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x49, 0x64,
+	0x22, 0x9d, 0x0b, 0x0a, 0x17, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x4e, 0x0a, 0x0a,
+	0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x52, 0x0a, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x1a, 0xb1, 0x0a, 0x0a,
+	0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x56,
+	0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x37, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
 	0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x4e, 0x0a, 0x0a, 0x61, 0x6c, 0x67, 0x6f, 0x72,
-	0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x62, 0x79,
-	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e,
-	0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x0a, 0x61, 0x6c, 0x67,
-	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x1a, 0x89, 0x05, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f,
-	0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
-	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x56, 0x0a, 0x09, 0x66, 0x75, 0x6c,
-	0x6c, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x62,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x08, 0x66, 0x75,
+	0x6c, 0x6c, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x59, 0x0a, 0x0a, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x6d, 0x61, 0x73, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x62, 0x79, 0x74,
+	0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67,
+	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x73,
+	0x6b, 0x12, 0x53, 0x0a, 0x08, 0x6d, 0x64, 0x35, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x2e, 0x4d, 0x44, 0x35, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x07, 0x6d,
+	0x64, 0x35, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x7b, 0x0a, 0x16, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x14, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x4d,
+	0x61, 0x73, 0x6b, 0x12, 0x7e, 0x0a, 0x17, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x48, 0x61, 0x73, 0x68, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x15, 0x64, 0x65,
+	0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x48, 0x61, 0x73, 0x68, 0x4d,
+	0x61, 0x73, 0x6b, 0x12, 0x66, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x68, 0x69, 0x66,
+	0x74, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x62,
 	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69,
 	0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e, 0x46, 0x75, 0x6c,
-	0x6c, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4d, 0x61, 0x73,
-	0x6b, 0x12, 0x59, 0x0a, 0x0a, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72,
-	0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67, 0x6f,
-	0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x48,
-	0x00, 0x52, 0x09, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x53, 0x0a, 0x08,
-	0x6d, 0x64, 0x35, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36,
-	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x73,
-	0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e, 0x4d,
-	0x44, 0x35, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x64, 0x35, 0x4d, 0x61, 0x73,
-	0x6b, 0x1a, 0x2e, 0x0a, 0x08, 0x46, 0x75, 0x6c, 0x6c, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x22, 0x0a,
-	0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x1a, 0xb8, 0x01, 0x0a, 0x09, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12,
-	0x56, 0x0a, 0x06, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x3e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e, 0x44, 0x61, 0x74,
+	0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x59, 0x0a, 0x0a, 0x72,
+	0x65, 0x67, 0x65, 0x78, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x38, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
 	0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x53, 0x65,
 	0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x52,
-	0x06, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x53, 0x0a, 0x05, 0x53, 0x6c, 0x69, 0x63, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73,
-	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1d, 0x0a, 0x07,
-	0x4d, 0x44, 0x35, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x6d,
-	0x61, 0x73, 0x6b, 0x32, 0xdc, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6c, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x20, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0xda, 0x41, 0x00,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x12, 0x68, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x24, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61,
-	0x6d, 0x65, 0x3d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0x72,
-	0x0a, 0x0a, 0x53, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x62,
-	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65,
+	0x52, 0x65, 0x67, 0x65, 0x78, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x67,
+	0x65, 0x78, 0x4d, 0x61, 0x73, 0x6b, 0x1a, 0x2e, 0x0a, 0x08, 0x46, 0x75, 0x6c, 0x6c, 0x4d, 0x61,
+	0x73, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69,
+	0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xb8, 0x01, 0x0a, 0x09, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x12, 0x56, 0x0a, 0x06, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x2e, 0x53,
+	0x6c, 0x69, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x53, 0x0a, 0x05,
+	0x53, 0x6c, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x1d, 0x0a, 0x07, 0x4d, 0x44, 0x35, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x61, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74,
+	0x1a, 0x56, 0x0a, 0x14, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x6b, 0x65,
+	0x65, 0x70, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x5f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6b, 0x65, 0x65, 0x70, 0x53, 0x75, 0x66, 0x66,
+	0x69, 0x78, 0x44, 0x69, 0x67, 0x69, 0x74, 0x73, 0x1a, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x48, 0x61, 0x73, 0x68, 0x4d, 0x61, 0x73,
+	0x6b, 0x1a, 0x2a, 0x0a, 0x0d, 0x44, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x4d, 0x61,
+	0x73, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x44, 0x61, 0x79, 0x73, 0x1a, 0x49, 0x0a,
+	0x09, 0x52, 0x65, 0x67, 0x65, 0x78, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x6b,
+	0x22, 0xa9, 0x03, 0x0a, 0x10, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x42, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x57, 0x0a, 0x15, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x13, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x12, 0x44, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x09, 0x6f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x1a, 0x4d, 0x0a, 0x05, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x1a, 0x63, 0x0a, 0x08, 0x4f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x12, 0x39, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0xff, 0x02, 0x0a,
+	0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3a,
+	0x0a, 0x19, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x77,
+	0x73, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x17, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x6f, 0x77,
+	0x73, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x6f, 0x77, 0x73, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2c,
+	0x0a, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x28, 0x0a, 0x10,
+	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x5f, 0x65, 0x6e, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48,
+	0x6f, 0x75, 0x72, 0x45, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a,
+	0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a,
+	0x6f, 0x6e, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x77, 0x61,
+	0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0x94,
+	0x01, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d,
+	0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d,
+	0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x12, 0x69, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x58, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x22,
+	0x7a, 0x0a, 0x0e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x12, 0x69, 0x73, 0x73, 0x75, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x61, 0x79, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x22, 0x87, 0x03, 0x0a, 0x0b,
+	0x4a, 0x69, 0x72, 0x61, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x20, 0x0a, 0x09, 0x61, 0x70, 0x69, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x61, 0x70, 0x69, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70,
+	0x65, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x6f, 0x6e, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x64, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x70, 0x69, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbf, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4e, 0x6f, 0x77, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x0f, 0x53, 0x6c, 0x61, 0x63,
+	0x6b, 0x41, 0x70, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x09, 0x62,
+	0x6f, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x08, 0x62, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a,
+	0x0e, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x62, 0x6f,
+	0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xa0, 0x02, 0x0a, 0x0f, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x35,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x42, 0x75, 0x73, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x6c,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x31, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4b,
+	0x41, 0x46, 0x4b, 0x41, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x41, 0x54, 0x53, 0x10, 0x02,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xe3, 0x02,
+	0x0a, 0x0b, 0x53, 0x43, 0x49, 0x4d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x43, 0x49, 0x4d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0xe0, 0x01, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x25, 0x0a,
+	0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x79,
+	0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x43, 0x49, 0x4d, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0c,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x1a, 0x3b, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0xb7, 0x04, 0x0a, 0x0f, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x48, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x02, 0x73, 0x33,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x33, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x02, 0x73,
+	0x33, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x73, 0x79,
+	0x73, 0x6c, 0x6f, 0x67, 0x1a, 0xbd, 0x01, 0x0a, 0x08, 0x53, 0x33, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x2f, 0x0a,
+	0x11, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x42, 0x14,
+	0x0a, 0x12, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x6b, 0x65, 0x79, 0x1a, 0x28, 0x0a, 0x0c, 0x53, 0x79, 0x73, 0x6c, 0x6f, 0x67, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3d,
+	0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17,
+	0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x53, 0x33, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x59, 0x53, 0x4c, 0x4f, 0x47, 0x10, 0x02, 0x22, 0x48, 0x0a,
+	0x12, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa3, 0x06, 0x0a, 0x18, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x46, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x1a, 0xbe, 0x05, 0x0a,
+	0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x51, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x22,
+	0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79,
+	0x49, 0x64, 0x12, 0x2f, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x0b, 0x74, 0x61, 0x67, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x74, 0x61, 0x67,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x74,
+	0x6f, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x54,
+	0x61, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x36, 0x0a, 0x08, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44,
+	0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x07, 0x0a, 0x03, 0x41, 0x57, 0x53, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x43, 0x50,
+	0x10, 0x02, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x32, 0xa2, 0x05,
+	0x0a, 0x0e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x6c, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x20, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0xda, 0x41, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e,
+	0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x68,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
 	0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x62,
 	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x07, 0x73, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x32, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2f,
-	0x2a, 0x7d, 0x42, 0x11, 0x5a, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d,
+	0x6e, 0x67, 0x22, 0x24, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0x72, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x2e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x28, 0x3a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x32, 0x1d, 0x2f,
+	0x76, 0x31, 0x2f, 0x7b, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x6e, 0x61, 0x6d, 0x65,
+	0x3d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0x9d, 0x01, 0x0a,
+	0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x29, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0xa3, 0x01, 0x0a,
+	0x14, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x28, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0xda, 0x41, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22,
+	0x21, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x61, 0x70,
+	0x70, 0x6c, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x42, 0x11, 0x5a, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d,
 	0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
@@ -3140,120 +6236,205 @@ func file_v1_setting_service_proto_rawDescGZIP() []byte {
 	return file_v1_setting_service_proto_rawDescData
 }
 
-var file_v1_setting_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_v1_setting_service_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_v1_setting_service_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_v1_setting_service_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
 var file_v1_setting_service_proto_goTypes = []interface{}{
-	(SMTPMailDeliverySettingValue_Encryption)(0),                     // 0: bytebase.v1.SMTPMailDeliverySettingValue.Encryption
-	(SMTPMailDeliverySettingValue_Authentication)(0),                 // 1: bytebase.v1.SMTPMailDeliverySettingValue.Authentication
-	(AppIMSetting_IMType)(0),                                         // 2: bytebase.v1.AppIMSetting.IMType
-	(Announcement_AlertLevel)(0),                                     // 3: bytebase.v1.Announcement.AlertLevel
-	(*ListSettingsRequest)(nil),                                      // 4: bytebase.v1.ListSettingsRequest
-	(*ListSettingsResponse)(nil),                                     // 5: bytebase.v1.ListSettingsResponse
-	(*GetSettingRequest)(nil),                                        // 6: bytebase.v1.GetSettingRequest
-	(*GetSettingResponse)(nil),                                       // 7: bytebase.v1.GetSettingResponse
-	(*SetSettingRequest)(nil),                                        // 8: bytebase.v1.SetSettingRequest
-	(*Setting)(nil),                                                  // 9: bytebase.v1.Setting
-	(*Value)(nil),                                                    // 10: bytebase.v1.Value
-	(*SMTPMailDeliverySettingValue)(nil),                             // 11: bytebase.v1.SMTPMailDeliverySettingValue
-	(*AppIMSetting)(nil),                                             // 12: bytebase.v1.AppIMSetting
-	(*AgentPluginSetting)(nil),                                       // 13: bytebase.v1.AgentPluginSetting
-	(*WorkspaceProfileSetting)(nil),                                  // 14: bytebase.v1.WorkspaceProfileSetting
-	(*Announcement)(nil),                                             // 15: bytebase.v1.Announcement
-	(*WorkspaceApprovalSetting)(nil),                                 // 16: bytebase.v1.WorkspaceApprovalSetting
-	(*ExternalApprovalSetting)(nil),                                  // 17: bytebase.v1.ExternalApprovalSetting
-	(*SchemaTemplateSetting)(nil),                                    // 18: bytebase.v1.SchemaTemplateSetting
-	(*WorkspaceTrialSetting)(nil),                                    // 19: bytebase.v1.WorkspaceTrialSetting
-	(*DataClassificationSetting)(nil),                                // 20: bytebase.v1.DataClassificationSetting
-	(*SemanticTypeSetting)(nil),                                      // 21: bytebase.v1.SemanticTypeSetting
-	(*MaskingAlgorithmSetting)(nil),                                  // 22: bytebase.v1.MaskingAlgorithmSetting
-	(*AppIMSetting_ExternalApproval)(nil),                            // 23: bytebase.v1.AppIMSetting.ExternalApproval
-	(*WorkspaceApprovalSetting_Rule)(nil),                            // 24: bytebase.v1.WorkspaceApprovalSetting.Rule
-	(*ExternalApprovalSetting_Node)(nil),                             // 25: bytebase.v1.ExternalApprovalSetting.Node
-	(*SchemaTemplateSetting_FieldTemplate)(nil),                      // 26: bytebase.v1.SchemaTemplateSetting.FieldTemplate
-	(*SchemaTemplateSetting_ColumnType)(nil),                         // 27: bytebase.v1.SchemaTemplateSetting.ColumnType
-	(*SchemaTemplateSetting_TableTemplate)(nil),                      // 28: bytebase.v1.SchemaTemplateSetting.TableTemplate
-	(*DataClassificationSetting_DataClassificationConfig)(nil),       // 29: bytebase.v1.DataClassificationSetting.DataClassificationConfig
-	(*DataClassificationSetting_DataClassificationConfig_Level)(nil), // 30: bytebase.v1.DataClassificationSetting.DataClassificationConfig.Level
-	(*DataClassificationSetting_DataClassificationConfig_DataClassification)(nil), // 31: bytebase.v1.DataClassificationSetting.DataClassificationConfig.DataClassification
-	nil,                                      // 32: bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
-	(*SemanticTypeSetting_SemanticType)(nil), // 33: bytebase.v1.SemanticTypeSetting.SemanticType
-	(*MaskingAlgorithmSetting_Algorithm)(nil),                 // 34: bytebase.v1.MaskingAlgorithmSetting.Algorithm
-	(*MaskingAlgorithmSetting_Algorithm_FullMask)(nil),        // 35: bytebase.v1.MaskingAlgorithmSetting.Algorithm.FullMask
-	(*MaskingAlgorithmSetting_Algorithm_RangeMask)(nil),       // 36: bytebase.v1.MaskingAlgorithmSetting.Algorithm.RangeMask
-	(*MaskingAlgorithmSetting_Algorithm_MD5Mask)(nil),         // 37: bytebase.v1.MaskingAlgorithmSetting.Algorithm.MD5Mask
-	(*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice)(nil), // 38: bytebase.v1.MaskingAlgorithmSetting.Algorithm.RangeMask.Slice
-	(*durationpb.Duration)(nil),                               // 39: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),                             // 40: google.protobuf.Timestamp
-	(PlanType)(0),                                             // 41: bytebase.v1.PlanType
-	(*ApprovalTemplate)(nil),                                  // 42: bytebase.v1.ApprovalTemplate
-	(*expr.Expr)(nil),                                         // 43: google.type.Expr
-	(Engine)(0),                                               // 44: bytebase.v1.Engine
-	(*ColumnMetadata)(nil),                                    // 45: bytebase.v1.ColumnMetadata
-	(*ColumnConfig)(nil),                                      // 46: bytebase.v1.ColumnConfig
-	(*TableMetadata)(nil),                                     // 47: bytebase.v1.TableMetadata
-	(*TableConfig)(nil),                                       // 48: bytebase.v1.TableConfig
+	(ExportWorkspaceConfigRequest_Format)(0),                         // 0: bytebase.v1.ExportWorkspaceConfigRequest.Format
+	(WorkspaceConfigChange_Action)(0),                                // 1: bytebase.v1.WorkspaceConfigChange.Action
+	(SMTPMailDeliverySettingValue_Encryption)(0),                     // 2: bytebase.v1.SMTPMailDeliverySettingValue.Encryption
+	(SMTPMailDeliverySettingValue_Authentication)(0),                 // 3: bytebase.v1.SMTPMailDeliverySettingValue.Authentication
+	(AppIMSetting_IMType)(0),                                         // 4: bytebase.v1.AppIMSetting.IMType
+	(Announcement_AlertLevel)(0),                                     // 5: bytebase.v1.Announcement.AlertLevel
+	(ExternalApprovalSetting_Node_Mode)(0),                           // 6: bytebase.v1.ExternalApprovalSetting.Node.Mode
+	(EventBusSetting_Type)(0),                                        // 7: bytebase.v1.EventBusSetting.Type
+	(AuditLogSetting_ExportType)(0),                                  // 8: bytebase.v1.AuditLogSetting.ExportType
+	(InstanceDiscoverySetting_Source_Provider)(0),                    // 9: bytebase.v1.InstanceDiscoverySetting.Source.Provider
+	(*ListSettingsRequest)(nil),                                      // 10: bytebase.v1.ListSettingsRequest
+	(*ListSettingsResponse)(nil),                                     // 11: bytebase.v1.ListSettingsResponse
+	(*GetSettingRequest)(nil),                                        // 12: bytebase.v1.GetSettingRequest
+	(*GetSettingResponse)(nil),                                       // 13: bytebase.v1.GetSettingResponse
+	(*SetSettingRequest)(nil),                                        // 14: bytebase.v1.SetSettingRequest
+	(*ExportWorkspaceConfigRequest)(nil),                             // 15: bytebase.v1.ExportWorkspaceConfigRequest
+	(*ExportWorkspaceConfigResponse)(nil),                            // 16: bytebase.v1.ExportWorkspaceConfigResponse
+	(*ApplyWorkspaceConfigRequest)(nil),                              // 17: bytebase.v1.ApplyWorkspaceConfigRequest
+	(*ApplyWorkspaceConfigResponse)(nil),                             // 18: bytebase.v1.ApplyWorkspaceConfigResponse
+	(*WorkspaceConfigChange)(nil),                                    // 19: bytebase.v1.WorkspaceConfigChange
+	(*WorkspaceConfig)(nil),                                          // 20: bytebase.v1.WorkspaceConfig
+	(*Setting)(nil),                                                  // 21: bytebase.v1.Setting
+	(*Value)(nil),                                                    // 22: bytebase.v1.Value
+	(*SMTPMailDeliverySettingValue)(nil),                             // 23: bytebase.v1.SMTPMailDeliverySettingValue
+	(*AppIMSetting)(nil),                                             // 24: bytebase.v1.AppIMSetting
+	(*AgentPluginSetting)(nil),                                       // 25: bytebase.v1.AgentPluginSetting
+	(*WorkspaceProfileSetting)(nil),                                  // 26: bytebase.v1.WorkspaceProfileSetting
+	(*Announcement)(nil),                                             // 27: bytebase.v1.Announcement
+	(*WorkspaceApprovalSetting)(nil),                                 // 28: bytebase.v1.WorkspaceApprovalSetting
+	(*ExternalApprovalSetting)(nil),                                  // 29: bytebase.v1.ExternalApprovalSetting
+	(*SchemaTemplateSetting)(nil),                                    // 30: bytebase.v1.SchemaTemplateSetting
+	(*WorkspaceTrialSetting)(nil),                                    // 31: bytebase.v1.WorkspaceTrialSetting
+	(*DataClassificationSetting)(nil),                                // 32: bytebase.v1.DataClassificationSetting
+	(*SemanticTypeSetting)(nil),                                      // 33: bytebase.v1.SemanticTypeSetting
+	(*MaskingAlgorithmSetting)(nil),                                  // 34: bytebase.v1.MaskingAlgorithmSetting
+	(*RateLimitSetting)(nil),                                         // 35: bytebase.v1.RateLimitSetting
+	(*QueryAuditSetting)(nil),                                        // 36: bytebase.v1.QueryAuditSetting
+	(*QueryCursorSetting)(nil),                                       // 37: bytebase.v1.QueryCursorSetting
+	(*QueryHistorySetting)(nil),                                      // 38: bytebase.v1.QueryHistorySetting
+	(*ArchiveSetting)(nil),                                           // 39: bytebase.v1.ArchiveSetting
+	(*JiraSetting)(nil),                                              // 40: bytebase.v1.JiraSetting
+	(*ServiceNowSetting)(nil),                                        // 41: bytebase.v1.ServiceNowSetting
+	(*SlackAppSetting)(nil),                                          // 42: bytebase.v1.SlackAppSetting
+	(*EventBusSetting)(nil),                                          // 43: bytebase.v1.EventBusSetting
+	(*SCIMSetting)(nil),                                              // 44: bytebase.v1.SCIMSetting
+	(*AuditLogSetting)(nil),                                          // 45: bytebase.v1.AuditLogSetting
+	(*MaintenanceSetting)(nil),                                       // 46: bytebase.v1.MaintenanceSetting
+	(*InstanceDiscoverySetting)(nil),                                 // 47: bytebase.v1.InstanceDiscoverySetting
+	(*AppIMSetting_ExternalApproval)(nil),                            // 48: bytebase.v1.AppIMSetting.ExternalApproval
+	(*WorkspaceApprovalSetting_Rule)(nil),                            // 49: bytebase.v1.WorkspaceApprovalSetting.Rule
+	(*ExternalApprovalSetting_Node)(nil),                             // 50: bytebase.v1.ExternalApprovalSetting.Node
+	(*SchemaTemplateSetting_FieldTemplate)(nil),                      // 51: bytebase.v1.SchemaTemplateSetting.FieldTemplate
+	(*SchemaTemplateSetting_ColumnType)(nil),                         // 52: bytebase.v1.SchemaTemplateSetting.ColumnType
+	(*SchemaTemplateSetting_TableTemplate)(nil),                      // 53: bytebase.v1.SchemaTemplateSetting.TableTemplate
+	(*DataClassificationSetting_DataClassificationConfig)(nil),       // 54: bytebase.v1.DataClassificationSetting.DataClassificationConfig
+	(*DataClassificationSetting_DataClassificationConfig_Level)(nil), // 55: bytebase.v1.DataClassificationSetting.DataClassificationConfig.Level
+	(*DataClassificationSetting_DataClassificationConfig_DataClassification)(nil), // 56: bytebase.v1.DataClassificationSetting.DataClassificationConfig.DataClassification
+	nil,                                      // 57: bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
+	(*SemanticTypeSetting_SemanticType)(nil), // 58: bytebase.v1.SemanticTypeSetting.SemanticType
+	(*MaskingAlgorithmSetting_Algorithm)(nil),                       // 59: bytebase.v1.MaskingAlgorithmSetting.Algorithm
+	(*MaskingAlgorithmSetting_Algorithm_FullMask)(nil),              // 60: bytebase.v1.MaskingAlgorithmSetting.Algorithm.FullMask
+	(*MaskingAlgorithmSetting_Algorithm_RangeMask)(nil),             // 61: bytebase.v1.MaskingAlgorithmSetting.Algorithm.RangeMask
+	(*MaskingAlgorithmSetting_Algorithm_MD5Mask)(nil),               // 62: bytebase.v1.MaskingAlgorithmSetting.Algorithm.MD5Mask
+	(*MaskingAlgorithmSetting_Algorithm_FormatPreservingMask)(nil),  // 63: bytebase.v1.MaskingAlgorithmSetting.Algorithm.FormatPreservingMask
+	(*MaskingAlgorithmSetting_Algorithm_DeterministicHashMask)(nil), // 64: bytebase.v1.MaskingAlgorithmSetting.Algorithm.DeterministicHashMask
+	(*MaskingAlgorithmSetting_Algorithm_DateShiftMask)(nil),         // 65: bytebase.v1.MaskingAlgorithmSetting.Algorithm.DateShiftMask
+	(*MaskingAlgorithmSetting_Algorithm_RegexMask)(nil),             // 66: bytebase.v1.MaskingAlgorithmSetting.Algorithm.RegexMask
+	(*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice)(nil),       // 67: bytebase.v1.MaskingAlgorithmSetting.Algorithm.RangeMask.Slice
+	(*RateLimitSetting_Quota)(nil),                                  // 68: bytebase.v1.RateLimitSetting.Quota
+	(*RateLimitSetting_Override)(nil),                               // 69: bytebase.v1.RateLimitSetting.Override
+	(*SCIMSetting_GroupMapping)(nil),                                // 70: bytebase.v1.SCIMSetting.GroupMapping
+	(*SCIMSetting_GroupMapping_ProjectRole)(nil),                    // 71: bytebase.v1.SCIMSetting.GroupMapping.ProjectRole
+	(*AuditLogSetting_S3Export)(nil),                                // 72: bytebase.v1.AuditLogSetting.S3Export
+	(*AuditLogSetting_SyslogExport)(nil),                            // 73: bytebase.v1.AuditLogSetting.SyslogExport
+	(*InstanceDiscoverySetting_Source)(nil),                         // 74: bytebase.v1.InstanceDiscoverySetting.Source
+	nil,                                                             // 75: bytebase.v1.InstanceDiscoverySetting.Source.TagFiltersEntry
+	(*Environment)(nil),                                             // 76: bytebase.v1.Environment
+	(*Instance)(nil),                                                // 77: bytebase.v1.Instance
+	(*Project)(nil),                                                 // 78: bytebase.v1.Project
+	(*Policy)(nil),                                                  // 79: bytebase.v1.Policy
+	(*durationpb.Duration)(nil),                                     // 80: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                                   // 81: google.protobuf.Timestamp
+	(PlanType)(0),                                                   // 82: bytebase.v1.PlanType
+	(*ApprovalTemplate)(nil),                                        // 83: bytebase.v1.ApprovalTemplate
+	(*expr.Expr)(nil),                                               // 84: google.type.Expr
+	(Engine)(0),                                                     // 85: bytebase.v1.Engine
+	(*ColumnMetadata)(nil),                                          // 86: bytebase.v1.ColumnMetadata
+	(*ColumnConfig)(nil),                                            // 87: bytebase.v1.ColumnConfig
+	(*TableMetadata)(nil),                                           // 88: bytebase.v1.TableMetadata
+	(*TableConfig)(nil),                                             // 89: bytebase.v1.TableConfig
 }
 var file_v1_setting_service_proto_depIdxs = []int32{
-	9,  // 0: bytebase.v1.ListSettingsResponse.settings:type_name -> bytebase.v1.Setting
-	9,  // 1: bytebase.v1.GetSettingResponse.setting:type_name -> bytebase.v1.Setting
-	9,  // 2: bytebase.v1.SetSettingRequest.setting:type_name -> bytebase.v1.Setting
-	10, // 3: bytebase.v1.Setting.value:type_name -> bytebase.v1.Value
-	11, // 4: bytebase.v1.Value.smtp_mail_delivery_setting_value:type_name -> bytebase.v1.SMTPMailDeliverySettingValue
-	12, // 5: bytebase.v1.Value.app_im_setting_value:type_name -> bytebase.v1.AppIMSetting
-	13, // 6: bytebase.v1.Value.agent_plugin_setting_value:type_name -> bytebase.v1.AgentPluginSetting
-	14, // 7: bytebase.v1.Value.workspace_profile_setting_value:type_name -> bytebase.v1.WorkspaceProfileSetting
-	16, // 8: bytebase.v1.Value.workspace_approval_setting_value:type_name -> bytebase.v1.WorkspaceApprovalSetting
-	19, // 9: bytebase.v1.Value.workspace_trial_setting_value:type_name -> bytebase.v1.WorkspaceTrialSetting
-	17, // 10: bytebase.v1.Value.external_approval_setting_value:type_name -> bytebase.v1.ExternalApprovalSetting
-	18, // 11: bytebase.v1.Value.schema_template_setting_value:type_name -> bytebase.v1.SchemaTemplateSetting
-	20, // 12: bytebase.v1.Value.data_classification_setting_value:type_name -> bytebase.v1.DataClassificationSetting
-	21, // 13: bytebase.v1.Value.semantic_type_setting_value:type_name -> bytebase.v1.SemanticTypeSetting
-	22, // 14: bytebase.v1.Value.masking_algorithm_setting_value:type_name -> bytebase.v1.MaskingAlgorithmSetting
-	0,  // 15: bytebase.v1.SMTPMailDeliverySettingValue.encryption:type_name -> bytebase.v1.SMTPMailDeliverySettingValue.Encryption
-	1,  // 16: bytebase.v1.SMTPMailDeliverySettingValue.authentication:type_name -> bytebase.v1.SMTPMailDeliverySettingValue.Authentication
-	2,  // 17: bytebase.v1.AppIMSetting.im_type:type_name -> bytebase.v1.AppIMSetting.IMType
-	23, // 18: bytebase.v1.AppIMSetting.external_approval:type_name -> bytebase.v1.AppIMSetting.ExternalApproval
-	39, // 19: bytebase.v1.WorkspaceProfileSetting.token_duration:type_name -> google.protobuf.Duration
-	15, // 20: bytebase.v1.WorkspaceProfileSetting.announcement:type_name -> bytebase.v1.Announcement
-	3,  // 21: bytebase.v1.Announcement.level:type_name -> bytebase.v1.Announcement.AlertLevel
-	24, // 22: bytebase.v1.WorkspaceApprovalSetting.rules:type_name -> bytebase.v1.WorkspaceApprovalSetting.Rule
-	25, // 23: bytebase.v1.ExternalApprovalSetting.nodes:type_name -> bytebase.v1.ExternalApprovalSetting.Node
-	26, // 24: bytebase.v1.SchemaTemplateSetting.field_templates:type_name -> bytebase.v1.SchemaTemplateSetting.FieldTemplate
-	27, // 25: bytebase.v1.SchemaTemplateSetting.column_types:type_name -> bytebase.v1.SchemaTemplateSetting.ColumnType
-	28, // 26: bytebase.v1.SchemaTemplateSetting.table_templates:type_name -> bytebase.v1.SchemaTemplateSetting.TableTemplate
-	40, // 27: bytebase.v1.WorkspaceTrialSetting.expire_time:type_name -> google.protobuf.Timestamp
-	40, // 28: bytebase.v1.WorkspaceTrialSetting.issued_time:type_name -> google.protobuf.Timestamp
-	41, // 29: bytebase.v1.WorkspaceTrialSetting.plan:type_name -> bytebase.v1.PlanType
-	29, // 30: bytebase.v1.DataClassificationSetting.configs:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig
-	33, // 31: bytebase.v1.SemanticTypeSetting.types:type_name -> bytebase.v1.SemanticTypeSetting.SemanticType
-	34, // 32: bytebase.v1.MaskingAlgorithmSetting.algorithms:type_name -> bytebase.v1.MaskingAlgorithmSetting.Algorithm
-	42, // 33: bytebase.v1.WorkspaceApprovalSetting.Rule.template:type_name -> bytebase.v1.ApprovalTemplate
-	43, // 34: bytebase.v1.WorkspaceApprovalSetting.Rule.condition:type_name -> google.type.Expr
-	44, // 35: bytebase.v1.SchemaTemplateSetting.FieldTemplate.engine:type_name -> bytebase.v1.Engine
-	45, // 36: bytebase.v1.SchemaTemplateSetting.FieldTemplate.column:type_name -> bytebase.v1.ColumnMetadata
-	46, // 37: bytebase.v1.SchemaTemplateSetting.FieldTemplate.config:type_name -> bytebase.v1.ColumnConfig
-	44, // 38: bytebase.v1.SchemaTemplateSetting.ColumnType.engine:type_name -> bytebase.v1.Engine
-	44, // 39: bytebase.v1.SchemaTemplateSetting.TableTemplate.engine:type_name -> bytebase.v1.Engine
-	47, // 40: bytebase.v1.SchemaTemplateSetting.TableTemplate.table:type_name -> bytebase.v1.TableMetadata
-	48, // 41: bytebase.v1.SchemaTemplateSetting.TableTemplate.config:type_name -> bytebase.v1.TableConfig
-	30, // 42: bytebase.v1.DataClassificationSetting.DataClassificationConfig.levels:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.Level
-	32, // 43: bytebase.v1.DataClassificationSetting.DataClassificationConfig.classification:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
-	31, // 44: bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry.value:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.DataClassification
-	35, // 45: bytebase.v1.MaskingAlgorithmSetting.Algorithm.full_mask:type_name -> bytebase.v1.MaskingAlgorithmSetting.Algorithm.FullMask
-	36, // 46: bytebase.v1.MaskingAlgorithmSetting.Algorithm.range_mask:type_name -> bytebase.v1.MaskingAlgorithmSetting.Algorithm.RangeMask
-	37, // 47: bytebase.v1.MaskingAlgorithmSetting.Algorithm.md5_mask:type_name -> bytebase.v1.MaskingAlgorithmSetting.Algorithm.MD5Mask
-	38, // 48: bytebase.v1.MaskingAlgorithmSetting.Algorithm.RangeMask.slices:type_name -> bytebase.v1.MaskingAlgorithmSetting.Algorithm.RangeMask.Slice
-	4,  // 49: bytebase.v1.SettingService.ListSettings:input_type -> bytebase.v1.ListSettingsRequest
-	6,  // 50: bytebase.v1.SettingService.GetSetting:input_type -> bytebase.v1.GetSettingRequest
-	8,  // 51: bytebase.v1.SettingService.SetSetting:input_type -> bytebase.v1.SetSettingRequest
-	5,  // 52: bytebase.v1.SettingService.ListSettings:output_type -> bytebase.v1.ListSettingsResponse
-	9,  // 53: bytebase.v1.SettingService.GetSetting:output_type -> bytebase.v1.Setting
-	9,  // 54: bytebase.v1.SettingService.SetSetting:output_type -> bytebase.v1.Setting
-	52, // [52:55] is the sub-list for method output_type
-	49, // [49:52] is the sub-list for method input_type
-	49, // [49:49] is the sub-list for extension type_name
-	49, // [49:49] is the sub-list for extension extendee
-	0,  // [0:49] is the sub-list for field type_name
+	21, // 0: bytebase.v1.ListSettingsResponse.settings:type_name -> bytebase.v1.Setting
+	21, // 1: bytebase.v1.GetSettingResponse.setting:type_name -> bytebase.v1.Setting
+	21, // 2: bytebase.v1.SetSettingRequest.setting:type_name -> bytebase.v1.Setting
+	0,  // 3: bytebase.v1.ExportWorkspaceConfigRequest.format:type_name -> bytebase.v1.ExportWorkspaceConfigRequest.Format
+	19, // 4: bytebase.v1.ApplyWorkspaceConfigResponse.changes:type_name -> bytebase.v1.WorkspaceConfigChange
+	1,  // 5: bytebase.v1.WorkspaceConfigChange.action:type_name -> bytebase.v1.WorkspaceConfigChange.Action
+	76, // 6: bytebase.v1.WorkspaceConfig.environments:type_name -> bytebase.v1.Environment
+	77, // 7: bytebase.v1.WorkspaceConfig.instances:type_name -> bytebase.v1.Instance
+	78, // 8: bytebase.v1.WorkspaceConfig.projects:type_name -> bytebase.v1.Project
+	79, // 9: bytebase.v1.WorkspaceConfig.policies:type_name -> bytebase.v1.Policy
+	22, // 10: bytebase.v1.Setting.value:type_name -> bytebase.v1.Value
+	23, // 11: bytebase.v1.Value.smtp_mail_delivery_setting_value:type_name -> bytebase.v1.SMTPMailDeliverySettingValue
+	24, // 12: bytebase.v1.Value.app_im_setting_value:type_name -> bytebase.v1.AppIMSetting
+	25, // 13: bytebase.v1.Value.agent_plugin_setting_value:type_name -> bytebase.v1.AgentPluginSetting
+	26, // 14: bytebase.v1.Value.workspace_profile_setting_value:type_name -> bytebase.v1.WorkspaceProfileSetting
+	28, // 15: bytebase.v1.Value.workspace_approval_setting_value:type_name -> bytebase.v1.WorkspaceApprovalSetting
+	31, // 16: bytebase.v1.Value.workspace_trial_setting_value:type_name -> bytebase.v1.WorkspaceTrialSetting
+	29, // 17: bytebase.v1.Value.external_approval_setting_value:type_name -> bytebase.v1.ExternalApprovalSetting
+	30, // 18: bytebase.v1.Value.schema_template_setting_value:type_name -> bytebase.v1.SchemaTemplateSetting
+	32, // 19: bytebase.v1.Value.data_classification_setting_value:type_name -> bytebase.v1.DataClassificationSetting
+	33, // 20: bytebase.v1.Value.semantic_type_setting_value:type_name -> bytebase.v1.SemanticTypeSetting
+	34, // 21: bytebase.v1.Value.masking_algorithm_setting_value:type_name -> bytebase.v1.MaskingAlgorithmSetting
+	35, // 22: bytebase.v1.Value.rate_limit_setting_value:type_name -> bytebase.v1.RateLimitSetting
+	36, // 23: bytebase.v1.Value.query_audit_setting_value:type_name -> bytebase.v1.QueryAuditSetting
+	37, // 24: bytebase.v1.Value.query_cursor_setting_value:type_name -> bytebase.v1.QueryCursorSetting
+	38, // 25: bytebase.v1.Value.query_history_setting_value:type_name -> bytebase.v1.QueryHistorySetting
+	39, // 26: bytebase.v1.Value.archive_setting_value:type_name -> bytebase.v1.ArchiveSetting
+	40, // 27: bytebase.v1.Value.jira_setting_value:type_name -> bytebase.v1.JiraSetting
+	41, // 28: bytebase.v1.Value.service_now_setting_value:type_name -> bytebase.v1.ServiceNowSetting
+	42, // 29: bytebase.v1.Value.slack_app_setting_value:type_name -> bytebase.v1.SlackAppSetting
+	43, // 30: bytebase.v1.Value.event_bus_setting_value:type_name -> bytebase.v1.EventBusSetting
+	44, // 31: bytebase.v1.Value.scim_setting_value:type_name -> bytebase.v1.SCIMSetting
+	45, // 32: bytebase.v1.Value.audit_log_setting_value:type_name -> bytebase.v1.AuditLogSetting
+	46, // 33: bytebase.v1.Value.maintenance_setting_value:type_name -> bytebase.v1.MaintenanceSetting
+	47, // 34: bytebase.v1.Value.instance_discovery_setting_value:type_name -> bytebase.v1.InstanceDiscoverySetting
+	2,  // 35: bytebase.v1.SMTPMailDeliverySettingValue.encryption:type_name -> bytebase.v1.SMTPMailDeliverySettingValue.Encryption
+	3,  // 36: bytebase.v1.SMTPMailDeliverySettingValue.authentication:type_name -> bytebase.v1.SMTPMailDeliverySettingValue.Authentication
+	4,  // 37: bytebase.v1.AppIMSetting.im_type:type_name -> bytebase.v1.AppIMSetting.IMType
+	48, // 38: bytebase.v1.AppIMSetting.external_approval:type_name -> bytebase.v1.AppIMSetting.ExternalApproval
+	80, // 39: bytebase.v1.WorkspaceProfileSetting.token_duration:type_name -> google.protobuf.Duration
+	27, // 40: bytebase.v1.WorkspaceProfileSetting.announcement:type_name -> bytebase.v1.Announcement
+	80, // 41: bytebase.v1.WorkspaceProfileSetting.session_idle_timeout:type_name -> google.protobuf.Duration
+	5,  // 42: bytebase.v1.Announcement.level:type_name -> bytebase.v1.Announcement.AlertLevel
+	49, // 43: bytebase.v1.WorkspaceApprovalSetting.rules:type_name -> bytebase.v1.WorkspaceApprovalSetting.Rule
+	50, // 44: bytebase.v1.ExternalApprovalSetting.nodes:type_name -> bytebase.v1.ExternalApprovalSetting.Node
+	51, // 45: bytebase.v1.SchemaTemplateSetting.field_templates:type_name -> bytebase.v1.SchemaTemplateSetting.FieldTemplate
+	52, // 46: bytebase.v1.SchemaTemplateSetting.column_types:type_name -> bytebase.v1.SchemaTemplateSetting.ColumnType
+	53, // 47: bytebase.v1.SchemaTemplateSetting.table_templates:type_name -> bytebase.v1.SchemaTemplateSetting.TableTemplate
+	81, // 48: bytebase.v1.WorkspaceTrialSetting.expire_time:type_name -> google.protobuf.Timestamp
+	81, // 49: bytebase.v1.WorkspaceTrialSetting.issued_time:type_name -> google.protobuf.Timestamp
+	82, // 50: bytebase.v1.WorkspaceTrialSetting.plan:type_name -> bytebase.v1.PlanType
+	54, // 51: bytebase.v1.DataClassificationSetting.configs:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig
+	58, // 52: bytebase.v1.SemanticTypeSetting.types:type_name -> bytebase.v1.SemanticTypeSetting.SemanticType
+	59, // 53: bytebase.v1.MaskingAlgorithmSetting.algorithms:type_name -> bytebase.v1.MaskingAlgorithmSetting.Algorithm
+	68, // 54: bytebase.v1.RateLimitSetting.user_quota:type_name -> bytebase.v1.RateLimitSetting.Quota
+	68, // 55: bytebase.v1.RateLimitSetting.service_account_quota:type_name -> bytebase.v1.RateLimitSetting.Quota
+	69, // 56: bytebase.v1.RateLimitSetting.overrides:type_name -> bytebase.v1.RateLimitSetting.Override
+	7,  // 57: bytebase.v1.EventBusSetting.type:type_name -> bytebase.v1.EventBusSetting.Type
+	70, // 58: bytebase.v1.SCIMSetting.group_mappings:type_name -> bytebase.v1.SCIMSetting.GroupMapping
+	80, // 59: bytebase.v1.AuditLogSetting.retention:type_name -> google.protobuf.Duration
+	8,  // 60: bytebase.v1.AuditLogSetting.export_type:type_name -> bytebase.v1.AuditLogSetting.ExportType
+	72, // 61: bytebase.v1.AuditLogSetting.s3:type_name -> bytebase.v1.AuditLogSetting.S3Export
+	73, // 62: bytebase.v1.AuditLogSetting.syslog:type_name -> bytebase.v1.AuditLogSetting.SyslogExport
+	74, // 63: bytebase.v1.InstanceDiscoverySetting.sources:type_name -> bytebase.v1.InstanceDiscoverySetting.Source
+	83, // 64: bytebase.v1.WorkspaceApprovalSetting.Rule.template:type_name -> bytebase.v1.ApprovalTemplate
+	84, // 65: bytebase.v1.WorkspaceApprovalSetting.Rule.condition:type_name -> google.type.Expr
+	6,  // 66: bytebase.v1.ExternalApprovalSetting.Node.mode:type_name -> bytebase.v1.ExternalApprovalSetting.Node.Mode
+	85, // 67: bytebase.v1.SchemaTemplateSetting.FieldTemplate.engine:type_name -> bytebase.v1.Engine
+	86, // 68: bytebase.v1.SchemaTemplateSetting.FieldTemplate.column:type_name -> bytebase.v1.ColumnMetadata
+	87, // 69: bytebase.v1.SchemaTemplateSetting.FieldTemplate.config:type_name -> bytebase.v1.ColumnConfig
+	85, // 70: bytebase.v1.SchemaTemplateSetting.ColumnType.engine:type_name -> bytebase.v1.Engine
+	85, // 71: bytebase.v1.SchemaTemplateSetting.TableTemplate.engine:type_name -> bytebase.v1.Engine
+	88, // 72: bytebase.v1.SchemaTemplateSetting.TableTemplate.table:type_name -> bytebase.v1.TableMetadata
+	89, // 73: bytebase.v1.SchemaTemplateSetting.TableTemplate.config:type_name -> bytebase.v1.TableConfig
+	55, // 74: bytebase.v1.DataClassificationSetting.DataClassificationConfig.levels:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.Level
+	57, // 75: bytebase.v1.DataClassificationSetting.DataClassificationConfig.classification:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
+	56, // 76: bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry.value:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.DataClassification
+	60, // 77: bytebase.v1.MaskingAlgorithmSetting.Algorithm.full_mask:type_name -> bytebase.v1.MaskingAlgorithmSetting.Algorithm.FullMask
+	61, // 78: bytebase.v1.MaskingAlgorithmSetting.Algorithm.range_mask:type_name -> bytebase.v1.MaskingAlgorithmSetting.Algorithm.RangeMask
+	62, // 79: bytebase.v1.MaskingAlgorithmSetting.Algorithm.md5_mask:type_name -> bytebase.v1.MaskingAlgorithmSetting.Algorithm.MD5Mask
+	63, // 80: bytebase.v1.MaskingAlgorithmSetting.Algorithm.format_preserving_mask:type_name -> bytebase.v1.MaskingAlgorithmSetting.Algorithm.FormatPreservingMask
+	64, // 81: bytebase.v1.MaskingAlgorithmSetting.Algorithm.deterministic_hash_mask:type_name -> bytebase.v1.MaskingAlgorithmSetting.Algorithm.DeterministicHashMask
+	65, // 82: bytebase.v1.MaskingAlgorithmSetting.Algorithm.date_shift_mask:type_name -> bytebase.v1.MaskingAlgorithmSetting.Algorithm.DateShiftMask
+	66, // 83: bytebase.v1.MaskingAlgorithmSetting.Algorithm.regex_mask:type_name -> bytebase.v1.MaskingAlgorithmSetting.Algorithm.RegexMask
+	67, // 84: bytebase.v1.MaskingAlgorithmSetting.Algorithm.RangeMask.slices:type_name -> bytebase.v1.MaskingAlgorithmSetting.Algorithm.RangeMask.Slice
+	68, // 85: bytebase.v1.RateLimitSetting.Override.quota:type_name -> bytebase.v1.RateLimitSetting.Quota
+	71, // 86: bytebase.v1.SCIMSetting.GroupMapping.project_roles:type_name -> bytebase.v1.SCIMSetting.GroupMapping.ProjectRole
+	9,  // 87: bytebase.v1.InstanceDiscoverySetting.Source.provider:type_name -> bytebase.v1.InstanceDiscoverySetting.Source.Provider
+	75, // 88: bytebase.v1.InstanceDiscoverySetting.Source.tag_filters:type_name -> bytebase.v1.InstanceDiscoverySetting.Source.TagFiltersEntry
+	10, // 89: bytebase.v1.SettingService.ListSettings:input_type -> bytebase.v1.ListSettingsRequest
+	12, // 90: bytebase.v1.SettingService.GetSetting:input_type -> bytebase.v1.GetSettingRequest
+	14, // 91: bytebase.v1.SettingService.SetSetting:input_type -> bytebase.v1.SetSettingRequest
+	15, // 92: bytebase.v1.SettingService.ExportWorkspaceConfig:input_type -> bytebase.v1.ExportWorkspaceConfigRequest
+	17, // 93: bytebase.v1.SettingService.ApplyWorkspaceConfig:input_type -> bytebase.v1.ApplyWorkspaceConfigRequest
+	11, // 94: bytebase.v1.SettingService.ListSettings:output_type -> bytebase.v1.ListSettingsResponse
+	21, // 95: bytebase.v1.SettingService.GetSetting:output_type -> bytebase.v1.Setting
+	21, // 96: bytebase.v1.SettingService.SetSetting:output_type -> bytebase.v1.Setting
+	16, // 97: bytebase.v1.SettingService.ExportWorkspaceConfig:output_type -> bytebase.v1.ExportWorkspaceConfigResponse
+	18, // 98: bytebase.v1.SettingService.ApplyWorkspaceConfig:output_type -> bytebase.v1.ApplyWorkspaceConfigResponse
+	94, // [94:99] is the sub-list for method output_type
+	89, // [89:94] is the sub-list for method input_type
+	89, // [89:89] is the sub-list for extension type_name
+	89, // [89:89] is the sub-list for extension extendee
+	0,  // [0:89] is the sub-list for field type_name
 }
 
 func init() { file_v1_setting_service_proto_init() }
@@ -3263,7 +6444,11 @@ func file_v1_setting_service_proto_init() {
 	}
 	file_v1_common_proto_init()
 	file_v1_database_service_proto_init()
+	file_v1_environment_service_proto_init()
+	file_v1_instance_service_proto_init()
 	file_v1_issue_service_proto_init()
+	file_v1_org_policy_service_proto_init()
+	file_v1_project_service_proto_init()
 	file_v1_subscription_service_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_v1_setting_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
@@ -3278,8 +6463,164 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSettingsResponse); i {
+		file_v1_setting_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSettingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSettingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSettingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportWorkspaceConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportWorkspaceConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyWorkspaceConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyWorkspaceConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceConfigChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Setting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Value); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SMTPMailDeliverySettingValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppIMSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3290,8 +6631,8 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSettingRequest); i {
+		file_v1_setting_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentPluginSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3302,8 +6643,8 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSettingResponse); i {
+		file_v1_setting_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceProfileSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3314,8 +6655,8 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetSettingRequest); i {
+		file_v1_setting_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Announcement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3326,8 +6667,8 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Setting); i {
+		file_v1_setting_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceApprovalSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3338,8 +6679,8 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Value); i {
+		file_v1_setting_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalApprovalSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3350,8 +6691,8 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SMTPMailDeliverySettingValue); i {
+		file_v1_setting_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaTemplateSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3362,8 +6703,8 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppIMSetting); i {
+		file_v1_setting_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceTrialSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3374,8 +6715,8 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AgentPluginSetting); i {
+		file_v1_setting_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataClassificationSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3386,8 +6727,8 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkspaceProfileSetting); i {
+		file_v1_setting_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SemanticTypeSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3398,8 +6739,8 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Announcement); i {
+		file_v1_setting_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaskingAlgorithmSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3410,8 +6751,8 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkspaceApprovalSetting); i {
+		file_v1_setting_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimitSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3422,8 +6763,8 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalApprovalSetting); i {
+		file_v1_setting_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAuditSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3434,8 +6775,8 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SchemaTemplateSetting); i {
+		file_v1_setting_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCursorSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3446,8 +6787,8 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkspaceTrialSetting); i {
+		file_v1_setting_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryHistorySetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3458,8 +6799,8 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataClassificationSetting); i {
+		file_v1_setting_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3470,8 +6811,8 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SemanticTypeSetting); i {
+		file_v1_setting_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JiraSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3482,8 +6823,8 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MaskingAlgorithmSetting); i {
+		file_v1_setting_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceNowSetting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3494,7 +6835,79 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_setting_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlackAppSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventBusSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SCIMSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceDiscoverySetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AppIMSetting_ExternalApproval); i {
 			case 0:
 				return &v.state
@@ -3506,7 +6919,7 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_setting_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WorkspaceApprovalSetting_Rule); i {
 			case 0:
 				return &v.state
@@ -3518,7 +6931,7 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_setting_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExternalApprovalSetting_Node); i {
 			case 0:
 				return &v.state
@@ -3530,7 +6943,7 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_setting_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SchemaTemplateSetting_FieldTemplate); i {
 			case 0:
 				return &v.state
@@ -3542,7 +6955,7 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_setting_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SchemaTemplateSetting_ColumnType); i {
 			case 0:
 				return &v.state
@@ -3554,7 +6967,7 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_setting_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SchemaTemplateSetting_TableTemplate); i {
 			case 0:
 				return &v.state
@@ -3566,7 +6979,7 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_setting_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DataClassificationSetting_DataClassificationConfig); i {
 			case 0:
 				return &v.state
@@ -3578,7 +6991,7 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_setting_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DataClassificationSetting_DataClassificationConfig_Level); i {
 			case 0:
 				return &v.state
@@ -3590,7 +7003,7 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_setting_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DataClassificationSetting_DataClassificationConfig_DataClassification); i {
 			case 0:
 				return &v.state
@@ -3602,7 +7015,7 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_setting_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SemanticTypeSetting_SemanticType); i {
 			case 0:
 				return &v.state
@@ -3614,7 +7027,7 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_setting_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MaskingAlgorithmSetting_Algorithm); i {
 			case 0:
 				return &v.state
@@ -3626,7 +7039,7 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_setting_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MaskingAlgorithmSetting_Algorithm_FullMask); i {
 			case 0:
 				return &v.state
@@ -3638,7 +7051,7 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_setting_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MaskingAlgorithmSetting_Algorithm_RangeMask); i {
 			case 0:
 				return &v.state
@@ -3650,7 +7063,7 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_setting_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MaskingAlgorithmSetting_Algorithm_MD5Mask); i {
 			case 0:
 				return &v.state
@@ -3662,7 +7075,55 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
-		file_v1_setting_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_setting_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaskingAlgorithmSetting_Algorithm_FormatPreservingMask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaskingAlgorithmSetting_Algorithm_DeterministicHashMask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaskingAlgorithmSetting_Algorithm_DateShiftMask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaskingAlgorithmSetting_Algorithm_RegexMask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MaskingAlgorithmSetting_Algorithm_RangeMask_Slice); i {
 			case 0:
 				return &v.state
@@ -3674,8 +7135,92 @@ func file_v1_setting_service_proto_init() {
 				return nil
 			}
 		}
+		file_v1_setting_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimitSetting_Quota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimitSetting_Override); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SCIMSetting_GroupMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SCIMSetting_GroupMapping_ProjectRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogSetting_S3Export); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogSetting_SyslogExport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_setting_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceDiscoverySetting_Source); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_v1_setting_service_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_v1_setting_service_proto_msgTypes[12].OneofWrappers = []interface{}{
 		(*Value_StringValue)(nil),
 		(*Value_SmtpMailDeliverySettingValue)(nil),
 		(*Value_AppImSettingValue)(nil),
@@ -3688,21 +7233,45 @@ func file_v1_setting_service_proto_init() {
 		(*Value_DataClassificationSettingValue)(nil),
 		(*Value_SemanticTypeSettingValue)(nil),
 		(*Value_MaskingAlgorithmSettingValue)(nil),
-	}
-	file_v1_setting_service_proto_msgTypes[7].OneofWrappers = []interface{}{}
-	file_v1_setting_service_proto_msgTypes[27].OneofWrappers = []interface{}{}
-	file_v1_setting_service_proto_msgTypes[30].OneofWrappers = []interface{}{
+		(*Value_RateLimitSettingValue)(nil),
+		(*Value_QueryAuditSettingValue)(nil),
+		(*Value_QueryCursorSettingValue)(nil),
+		(*Value_QueryHistorySettingValue)(nil),
+		(*Value_ArchiveSettingValue)(nil),
+		(*Value_JiraSettingValue)(nil),
+		(*Value_ServiceNowSettingValue)(nil),
+		(*Value_SlackAppSettingValue)(nil),
+		(*Value_EventBusSettingValue)(nil),
+		(*Value_ScimSettingValue)(nil),
+		(*Value_AuditLogSettingValue)(nil),
+		(*Value_MaintenanceSettingValue)(nil),
+		(*Value_InstanceDiscoverySettingValue)(nil),
+	}
+	file_v1_setting_service_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_v1_setting_service_proto_msgTypes[30].OneofWrappers = []interface{}{}
+	file_v1_setting_service_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_v1_setting_service_proto_msgTypes[32].OneofWrappers = []interface{}{}
+	file_v1_setting_service_proto_msgTypes[33].OneofWrappers = []interface{}{}
+	file_v1_setting_service_proto_msgTypes[34].OneofWrappers = []interface{}{}
+	file_v1_setting_service_proto_msgTypes[46].OneofWrappers = []interface{}{}
+	file_v1_setting_service_proto_msgTypes[49].OneofWrappers = []interface{}{
 		(*MaskingAlgorithmSetting_Algorithm_FullMask_)(nil),
 		(*MaskingAlgorithmSetting_Algorithm_RangeMask_)(nil),
 		(*MaskingAlgorithmSetting_Algorithm_Md5Mask)(nil),
+		(*MaskingAlgorithmSetting_Algorithm_FormatPreservingMask_)(nil),
+		(*MaskingAlgorithmSetting_Algorithm_DeterministicHashMask_)(nil),
+		(*MaskingAlgorithmSetting_Algorithm_DateShiftMask_)(nil),
+		(*MaskingAlgorithmSetting_Algorithm_RegexMask_)(nil),
 	}
+	file_v1_setting_service_proto_msgTypes[62].OneofWrappers = []interface{}{}
+	file_v1_setting_service_proto_msgTypes[64].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_setting_service_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   35,
+			NumEnums:      10,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
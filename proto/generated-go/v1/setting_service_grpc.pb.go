@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	SettingService_ListSettings_FullMethodName = "/bytebase.v1.SettingService/ListSettings"
-	SettingService_GetSetting_FullMethodName   = "/bytebase.v1.SettingService/GetSetting"
-	SettingService_SetSetting_FullMethodName   = "/bytebase.v1.SettingService/SetSetting"
+	SettingService_ListSettings_FullMethodName          = "/bytebase.v1.SettingService/ListSettings"
+	SettingService_GetSetting_FullMethodName            = "/bytebase.v1.SettingService/GetSetting"
+	SettingService_SetSetting_FullMethodName            = "/bytebase.v1.SettingService/SetSetting"
+	SettingService_ExportWorkspaceConfig_FullMethodName = "/bytebase.v1.SettingService/ExportWorkspaceConfig"
+	SettingService_ApplyWorkspaceConfig_FullMethodName  = "/bytebase.v1.SettingService/ApplyWorkspaceConfig"
 )
 
 // SettingServiceClient is the client API for SettingService service.
@@ -31,6 +33,11 @@ type SettingServiceClient interface {
 	ListSettings(ctx context.Context, in *ListSettingsRequest, opts ...grpc.CallOption) (*ListSettingsResponse, error)
 	GetSetting(ctx context.Context, in *GetSettingRequest, opts ...grpc.CallOption) (*Setting, error)
 	SetSetting(ctx context.Context, in *SetSettingRequest, opts ...grpc.CallOption) (*Setting, error)
+	// ExportWorkspaceConfig exports the workspace configuration as a declarative bundle.
+	ExportWorkspaceConfig(ctx context.Context, in *ExportWorkspaceConfigRequest, opts ...grpc.CallOption) (*ExportWorkspaceConfigResponse, error)
+	// ApplyWorkspaceConfig applies the bundle exported by ExportWorkspaceConfig.
+	// Applying the same bundle again is a no-op.
+	ApplyWorkspaceConfig(ctx context.Context, in *ApplyWorkspaceConfigRequest, opts ...grpc.CallOption) (*ApplyWorkspaceConfigResponse, error)
 }
 
 type settingServiceClient struct {
@@ -68,6 +75,24 @@ func (c *settingServiceClient) SetSetting(ctx context.Context, in *SetSettingReq
 	return out, nil
 }
 
+func (c *settingServiceClient) ExportWorkspaceConfig(ctx context.Context, in *ExportWorkspaceConfigRequest, opts ...grpc.CallOption) (*ExportWorkspaceConfigResponse, error) {
+	out := new(ExportWorkspaceConfigResponse)
+	err := c.cc.Invoke(ctx, SettingService_ExportWorkspaceConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settingServiceClient) ApplyWorkspaceConfig(ctx context.Context, in *ApplyWorkspaceConfigRequest, opts ...grpc.CallOption) (*ApplyWorkspaceConfigResponse, error) {
+	out := new(ApplyWorkspaceConfigResponse)
+	err := c.cc.Invoke(ctx, SettingService_ApplyWorkspaceConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SettingServiceServer is the server API for SettingService service.
 // All implementations must embed UnimplementedSettingServiceServer
 // for forward compatibility
@@ -75,6 +100,11 @@ type SettingServiceServer interface {
 	ListSettings(context.Context, *ListSettingsRequest) (*ListSettingsResponse, error)
 	GetSetting(context.Context, *GetSettingRequest) (*Setting, error)
 	SetSetting(context.Context, *SetSettingRequest) (*Setting, error)
+	// ExportWorkspaceConfig exports the workspace configuration as a declarative bundle.
+	ExportWorkspaceConfig(context.Context, *ExportWorkspaceConfigRequest) (*ExportWorkspaceConfigResponse, error)
+	// ApplyWorkspaceConfig applies the bundle exported by ExportWorkspaceConfig.
+	// Applying the same bundle again is a no-op.
+	ApplyWorkspaceConfig(context.Context, *ApplyWorkspaceConfigRequest) (*ApplyWorkspaceConfigResponse, error)
 	mustEmbedUnimplementedSettingServiceServer()
 }
 
@@ -91,6 +121,12 @@ func (UnimplementedSettingServiceServer) GetSetting(context.Context, *GetSetting
 func (UnimplementedSettingServiceServer) SetSetting(context.Context, *SetSettingRequest) (*Setting, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSetting not implemented")
 }
+func (UnimplementedSettingServiceServer) ExportWorkspaceConfig(context.Context, *ExportWorkspaceConfigRequest) (*ExportWorkspaceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportWorkspaceConfig not implemented")
+}
+func (UnimplementedSettingServiceServer) ApplyWorkspaceConfig(context.Context, *ApplyWorkspaceConfigRequest) (*ApplyWorkspaceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyWorkspaceConfig not implemented")
+}
 func (UnimplementedSettingServiceServer) mustEmbedUnimplementedSettingServiceServer() {}
 
 // UnsafeSettingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +194,42 @@ func _SettingService_SetSetting_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SettingService_ExportWorkspaceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWorkspaceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingServiceServer).ExportWorkspaceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingService_ExportWorkspaceConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingServiceServer).ExportWorkspaceConfig(ctx, req.(*ExportWorkspaceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettingService_ApplyWorkspaceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyWorkspaceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingServiceServer).ApplyWorkspaceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingService_ApplyWorkspaceConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingServiceServer).ApplyWorkspaceConfig(ctx, req.(*ApplyWorkspaceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SettingService_ServiceDesc is the grpc.ServiceDesc for SettingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +249,14 @@ var SettingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSetting",
 			Handler:    _SettingService_SetSetting_Handler,
 		},
+		{
+			MethodName: "ExportWorkspaceConfig",
+			Handler:    _SettingService_ExportWorkspaceConfig_Handler,
+		},
+		{
+			MethodName: "ApplyWorkspaceConfig",
+			Handler:    _SettingService_ApplyWorkspaceConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/setting_service.proto",
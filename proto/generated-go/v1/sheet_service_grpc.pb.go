@@ -26,6 +26,10 @@ const (
 	SheetService_UpdateSheet_FullMethodName          = "/bytebase.v1.SheetService/UpdateSheet"
 	SheetService_UpdateSheetOrganizer_FullMethodName = "/bytebase.v1.SheetService/UpdateSheetOrganizer"
 	SheetService_DeleteSheet_FullMethodName          = "/bytebase.v1.SheetService/DeleteSheet"
+	SheetService_ShareSheet_FullMethodName           = "/bytebase.v1.SheetService/ShareSheet"
+	SheetService_UnshareSheet_FullMethodName         = "/bytebase.v1.SheetService/UnshareSheet"
+	SheetService_GetSharedSheet_FullMethodName       = "/bytebase.v1.SheetService/GetSharedSheet"
+	SheetService_ImportData_FullMethodName           = "/bytebase.v1.SheetService/ImportData"
 )
 
 // SheetServiceClient is the client API for SheetService service.
@@ -38,6 +42,16 @@ type SheetServiceClient interface {
 	UpdateSheet(ctx context.Context, in *UpdateSheetRequest, opts ...grpc.CallOption) (*Sheet, error)
 	UpdateSheetOrganizer(ctx context.Context, in *UpdateSheetOrganizerRequest, opts ...grpc.CallOption) (*SheetOrganizer, error)
 	DeleteSheet(ctx context.Context, in *DeleteSheetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ShareSheet creates the share link of the sheet, which lets the project members run the sheet with their own parameter values.
+	ShareSheet(ctx context.Context, in *ShareSheetRequest, opts ...grpc.CallOption) (*SheetShareLink, error)
+	// UnshareSheet revokes the share link of the sheet.
+	UnshareSheet(ctx context.Context, in *UnshareSheetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetSharedSheet returns the shared sheet to the project members with the share token.
+	GetSharedSheet(ctx context.Context, in *GetSharedSheetRequest, opts ...grpc.CallOption) (*Sheet, error)
+	// ImportData generates the batched INSERT statements importing the CSV or TSV data into the table,
+	// and creates the sheet of the statements for the data change issue going through the approval.
+	// With validate_only, it previews the import without creating the sheet.
+	ImportData(ctx context.Context, in *ImportDataRequest, opts ...grpc.CallOption) (*ImportDataResponse, error)
 }
 
 type sheetServiceClient struct {
@@ -102,6 +116,42 @@ func (c *sheetServiceClient) DeleteSheet(ctx context.Context, in *DeleteSheetReq
 	return out, nil
 }
 
+func (c *sheetServiceClient) ShareSheet(ctx context.Context, in *ShareSheetRequest, opts ...grpc.CallOption) (*SheetShareLink, error) {
+	out := new(SheetShareLink)
+	err := c.cc.Invoke(ctx, SheetService_ShareSheet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sheetServiceClient) UnshareSheet(ctx context.Context, in *UnshareSheetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SheetService_UnshareSheet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sheetServiceClient) GetSharedSheet(ctx context.Context, in *GetSharedSheetRequest, opts ...grpc.CallOption) (*Sheet, error) {
+	out := new(Sheet)
+	err := c.cc.Invoke(ctx, SheetService_GetSharedSheet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sheetServiceClient) ImportData(ctx context.Context, in *ImportDataRequest, opts ...grpc.CallOption) (*ImportDataResponse, error) {
+	out := new(ImportDataResponse)
+	err := c.cc.Invoke(ctx, SheetService_ImportData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SheetServiceServer is the server API for SheetService service.
 // All implementations must embed UnimplementedSheetServiceServer
 // for forward compatibility
@@ -112,6 +162,16 @@ type SheetServiceServer interface {
 	UpdateSheet(context.Context, *UpdateSheetRequest) (*Sheet, error)
 	UpdateSheetOrganizer(context.Context, *UpdateSheetOrganizerRequest) (*SheetOrganizer, error)
 	DeleteSheet(context.Context, *DeleteSheetRequest) (*emptypb.Empty, error)
+	// ShareSheet creates the share link of the sheet, which lets the project members run the sheet with their own parameter values.
+	ShareSheet(context.Context, *ShareSheetRequest) (*SheetShareLink, error)
+	// UnshareSheet revokes the share link of the sheet.
+	UnshareSheet(context.Context, *UnshareSheetRequest) (*emptypb.Empty, error)
+	// GetSharedSheet returns the shared sheet to the project members with the share token.
+	GetSharedSheet(context.Context, *GetSharedSheetRequest) (*Sheet, error)
+	// ImportData generates the batched INSERT statements importing the CSV or TSV data into the table,
+	// and creates the sheet of the statements for the data change issue going through the approval.
+	// With validate_only, it previews the import without creating the sheet.
+	ImportData(context.Context, *ImportDataRequest) (*ImportDataResponse, error)
 	mustEmbedUnimplementedSheetServiceServer()
 }
 
@@ -137,6 +197,18 @@ func (UnimplementedSheetServiceServer) UpdateSheetOrganizer(context.Context, *Up
 func (UnimplementedSheetServiceServer) DeleteSheet(context.Context, *DeleteSheetRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSheet not implemented")
 }
+func (UnimplementedSheetServiceServer) ShareSheet(context.Context, *ShareSheetRequest) (*SheetShareLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareSheet not implemented")
+}
+func (UnimplementedSheetServiceServer) UnshareSheet(context.Context, *UnshareSheetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareSheet not implemented")
+}
+func (UnimplementedSheetServiceServer) GetSharedSheet(context.Context, *GetSharedSheetRequest) (*Sheet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedSheet not implemented")
+}
+func (UnimplementedSheetServiceServer) ImportData(context.Context, *ImportDataRequest) (*ImportDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportData not implemented")
+}
 func (UnimplementedSheetServiceServer) mustEmbedUnimplementedSheetServiceServer() {}
 
 // UnsafeSheetServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -258,6 +330,78 @@ func _SheetService_DeleteSheet_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SheetService_ShareSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareSheetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SheetServiceServer).ShareSheet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SheetService_ShareSheet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SheetServiceServer).ShareSheet(ctx, req.(*ShareSheetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SheetService_UnshareSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareSheetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SheetServiceServer).UnshareSheet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SheetService_UnshareSheet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SheetServiceServer).UnshareSheet(ctx, req.(*UnshareSheetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SheetService_GetSharedSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedSheetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SheetServiceServer).GetSharedSheet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SheetService_GetSharedSheet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SheetServiceServer).GetSharedSheet(ctx, req.(*GetSharedSheetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SheetService_ImportData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SheetServiceServer).ImportData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SheetService_ImportData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SheetServiceServer).ImportData(ctx, req.(*ImportDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SheetService_ServiceDesc is the grpc.ServiceDesc for SheetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +433,22 @@ var SheetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSheet",
 			Handler:    _SheetService_DeleteSheet_Handler,
 		},
+		{
+			MethodName: "ShareSheet",
+			Handler:    _SheetService_ShareSheet_Handler,
+		},
+		{
+			MethodName: "UnshareSheet",
+			Handler:    _SheetService_UnshareSheet_Handler,
+		},
+		{
+			MethodName: "GetSharedSheet",
+			Handler:    _SheetService_GetSharedSheet_Handler,
+		},
+		{
+			MethodName: "ImportData",
+			Handler:    _SheetService_ImportData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/sheet_service.proto",
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,13 +20,26 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	SQLService_Query_FullMethodName             = "/bytebase.v1.SQLService/Query"
-	SQLService_Export_FullMethodName            = "/bytebase.v1.SQLService/Export"
-	SQLService_AdminExecute_FullMethodName      = "/bytebase.v1.SQLService/AdminExecute"
-	SQLService_DifferPreview_FullMethodName     = "/bytebase.v1.SQLService/DifferPreview"
-	SQLService_Check_FullMethodName             = "/bytebase.v1.SQLService/Check"
-	SQLService_Pretty_FullMethodName            = "/bytebase.v1.SQLService/Pretty"
-	SQLService_StringifyMetadata_FullMethodName = "/bytebase.v1.SQLService/StringifyMetadata"
+	SQLService_Query_FullMethodName                     = "/bytebase.v1.SQLService/Query"
+	SQLService_Explain_FullMethodName                   = "/bytebase.v1.SQLService/Explain"
+	SQLService_OpenQueryCursor_FullMethodName           = "/bytebase.v1.SQLService/OpenQueryCursor"
+	SQLService_FetchQueryCursor_FullMethodName          = "/bytebase.v1.SQLService/FetchQueryCursor"
+	SQLService_CloseQueryCursor_FullMethodName          = "/bytebase.v1.SQLService/CloseQueryCursor"
+	SQLService_Export_FullMethodName                    = "/bytebase.v1.SQLService/Export"
+	SQLService_ListExportAudits_FullMethodName          = "/bytebase.v1.SQLService/ListExportAudits"
+	SQLService_QuerySharedSheet_FullMethodName          = "/bytebase.v1.SQLService/QuerySharedSheet"
+	SQLService_SearchQueryHistories_FullMethodName      = "/bytebase.v1.SQLService/SearchQueryHistories"
+	SQLService_GetQueryHistoryStats_FullMethodName      = "/bytebase.v1.SQLService/GetQueryHistoryStats"
+	SQLService_CreateScheduledQuery_FullMethodName      = "/bytebase.v1.SQLService/CreateScheduledQuery"
+	SQLService_ListScheduledQueries_FullMethodName      = "/bytebase.v1.SQLService/ListScheduledQueries"
+	SQLService_UpdateScheduledQuery_FullMethodName      = "/bytebase.v1.SQLService/UpdateScheduledQuery"
+	SQLService_DeleteScheduledQuery_FullMethodName      = "/bytebase.v1.SQLService/DeleteScheduledQuery"
+	SQLService_ListScheduledQueryResults_FullMethodName = "/bytebase.v1.SQLService/ListScheduledQueryResults"
+	SQLService_AdminExecute_FullMethodName              = "/bytebase.v1.SQLService/AdminExecute"
+	SQLService_DifferPreview_FullMethodName             = "/bytebase.v1.SQLService/DifferPreview"
+	SQLService_Check_FullMethodName                     = "/bytebase.v1.SQLService/Check"
+	SQLService_Pretty_FullMethodName                    = "/bytebase.v1.SQLService/Pretty"
+	SQLService_StringifyMetadata_FullMethodName         = "/bytebase.v1.SQLService/StringifyMetadata"
 )
 
 // SQLServiceClient is the client API for SQLService service.
@@ -33,7 +47,25 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SQLServiceClient interface {
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	Explain(ctx context.Context, in *ExplainRequest, opts ...grpc.CallOption) (*ExplainResponse, error)
+	OpenQueryCursor(ctx context.Context, in *OpenQueryCursorRequest, opts ...grpc.CallOption) (*QueryCursorPage, error)
+	FetchQueryCursor(ctx context.Context, in *FetchQueryCursorRequest, opts ...grpc.CallOption) (*QueryCursorPage, error)
+	CloseQueryCursor(ctx context.Context, in *CloseQueryCursorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	ListExportAudits(ctx context.Context, in *ListExportAuditsRequest, opts ...grpc.CallOption) (*ListExportAuditsResponse, error)
+	// QuerySharedSheet runs the shared sheet with the parameter values of the caller.
+	// The caller must be a member of the sheet project, and the query is checked against the query permissions of the caller.
+	QuerySharedSheet(ctx context.Context, in *QuerySharedSheetRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// SearchQueryHistories searches the SQL Editor query history.
+	// The workspace owners and DBAs can search the query history of all users, and the others can only search their own.
+	SearchQueryHistories(ctx context.Context, in *SearchQueryHistoriesRequest, opts ...grpc.CallOption) (*SearchQueryHistoriesResponse, error)
+	// GetQueryHistoryStats returns the per-user statistics of the SQL Editor query history for the workspace owners and DBAs.
+	GetQueryHistoryStats(ctx context.Context, in *GetQueryHistoryStatsRequest, opts ...grpc.CallOption) (*QueryHistoryStats, error)
+	CreateScheduledQuery(ctx context.Context, in *CreateScheduledQueryRequest, opts ...grpc.CallOption) (*ScheduledQuery, error)
+	ListScheduledQueries(ctx context.Context, in *ListScheduledQueriesRequest, opts ...grpc.CallOption) (*ListScheduledQueriesResponse, error)
+	UpdateScheduledQuery(ctx context.Context, in *UpdateScheduledQueryRequest, opts ...grpc.CallOption) (*ScheduledQuery, error)
+	DeleteScheduledQuery(ctx context.Context, in *DeleteScheduledQueryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListScheduledQueryResults(ctx context.Context, in *ListScheduledQueryResultsRequest, opts ...grpc.CallOption) (*ListScheduledQueryResultsResponse, error)
 	AdminExecute(ctx context.Context, opts ...grpc.CallOption) (SQLService_AdminExecuteClient, error)
 	DifferPreview(ctx context.Context, in *DifferPreviewRequest, opts ...grpc.CallOption) (*DifferPreviewResponse, error)
 	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
@@ -58,6 +90,42 @@ func (c *sQLServiceClient) Query(ctx context.Context, in *QueryRequest, opts ...
 	return out, nil
 }
 
+func (c *sQLServiceClient) Explain(ctx context.Context, in *ExplainRequest, opts ...grpc.CallOption) (*ExplainResponse, error) {
+	out := new(ExplainResponse)
+	err := c.cc.Invoke(ctx, SQLService_Explain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) OpenQueryCursor(ctx context.Context, in *OpenQueryCursorRequest, opts ...grpc.CallOption) (*QueryCursorPage, error) {
+	out := new(QueryCursorPage)
+	err := c.cc.Invoke(ctx, SQLService_OpenQueryCursor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) FetchQueryCursor(ctx context.Context, in *FetchQueryCursorRequest, opts ...grpc.CallOption) (*QueryCursorPage, error) {
+	out := new(QueryCursorPage)
+	err := c.cc.Invoke(ctx, SQLService_FetchQueryCursor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) CloseQueryCursor(ctx context.Context, in *CloseQueryCursorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SQLService_CloseQueryCursor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sQLServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
 	out := new(ExportResponse)
 	err := c.cc.Invoke(ctx, SQLService_Export_FullMethodName, in, out, opts...)
@@ -67,6 +135,87 @@ func (c *sQLServiceClient) Export(ctx context.Context, in *ExportRequest, opts .
 	return out, nil
 }
 
+func (c *sQLServiceClient) ListExportAudits(ctx context.Context, in *ListExportAuditsRequest, opts ...grpc.CallOption) (*ListExportAuditsResponse, error) {
+	out := new(ListExportAuditsResponse)
+	err := c.cc.Invoke(ctx, SQLService_ListExportAudits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) QuerySharedSheet(ctx context.Context, in *QuerySharedSheetRequest, opts ...grpc.CallOption) (*QueryResponse, error) {
+	out := new(QueryResponse)
+	err := c.cc.Invoke(ctx, SQLService_QuerySharedSheet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) SearchQueryHistories(ctx context.Context, in *SearchQueryHistoriesRequest, opts ...grpc.CallOption) (*SearchQueryHistoriesResponse, error) {
+	out := new(SearchQueryHistoriesResponse)
+	err := c.cc.Invoke(ctx, SQLService_SearchQueryHistories_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) GetQueryHistoryStats(ctx context.Context, in *GetQueryHistoryStatsRequest, opts ...grpc.CallOption) (*QueryHistoryStats, error) {
+	out := new(QueryHistoryStats)
+	err := c.cc.Invoke(ctx, SQLService_GetQueryHistoryStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) CreateScheduledQuery(ctx context.Context, in *CreateScheduledQueryRequest, opts ...grpc.CallOption) (*ScheduledQuery, error) {
+	out := new(ScheduledQuery)
+	err := c.cc.Invoke(ctx, SQLService_CreateScheduledQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) ListScheduledQueries(ctx context.Context, in *ListScheduledQueriesRequest, opts ...grpc.CallOption) (*ListScheduledQueriesResponse, error) {
+	out := new(ListScheduledQueriesResponse)
+	err := c.cc.Invoke(ctx, SQLService_ListScheduledQueries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) UpdateScheduledQuery(ctx context.Context, in *UpdateScheduledQueryRequest, opts ...grpc.CallOption) (*ScheduledQuery, error) {
+	out := new(ScheduledQuery)
+	err := c.cc.Invoke(ctx, SQLService_UpdateScheduledQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) DeleteScheduledQuery(ctx context.Context, in *DeleteScheduledQueryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SQLService_DeleteScheduledQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) ListScheduledQueryResults(ctx context.Context, in *ListScheduledQueryResultsRequest, opts ...grpc.CallOption) (*ListScheduledQueryResultsResponse, error) {
+	out := new(ListScheduledQueryResultsResponse)
+	err := c.cc.Invoke(ctx, SQLService_ListScheduledQueryResults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sQLServiceClient) AdminExecute(ctx context.Context, opts ...grpc.CallOption) (SQLService_AdminExecuteClient, error) {
 	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[0], SQLService_AdminExecute_FullMethodName, opts...)
 	if err != nil {
@@ -139,7 +288,25 @@ func (c *sQLServiceClient) StringifyMetadata(ctx context.Context, in *StringifyM
 // for forward compatibility
 type SQLServiceServer interface {
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	Explain(context.Context, *ExplainRequest) (*ExplainResponse, error)
+	OpenQueryCursor(context.Context, *OpenQueryCursorRequest) (*QueryCursorPage, error)
+	FetchQueryCursor(context.Context, *FetchQueryCursorRequest) (*QueryCursorPage, error)
+	CloseQueryCursor(context.Context, *CloseQueryCursorRequest) (*emptypb.Empty, error)
 	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	ListExportAudits(context.Context, *ListExportAuditsRequest) (*ListExportAuditsResponse, error)
+	// QuerySharedSheet runs the shared sheet with the parameter values of the caller.
+	// The caller must be a member of the sheet project, and the query is checked against the query permissions of the caller.
+	QuerySharedSheet(context.Context, *QuerySharedSheetRequest) (*QueryResponse, error)
+	// SearchQueryHistories searches the SQL Editor query history.
+	// The workspace owners and DBAs can search the query history of all users, and the others can only search their own.
+	SearchQueryHistories(context.Context, *SearchQueryHistoriesRequest) (*SearchQueryHistoriesResponse, error)
+	// GetQueryHistoryStats returns the per-user statistics of the SQL Editor query history for the workspace owners and DBAs.
+	GetQueryHistoryStats(context.Context, *GetQueryHistoryStatsRequest) (*QueryHistoryStats, error)
+	CreateScheduledQuery(context.Context, *CreateScheduledQueryRequest) (*ScheduledQuery, error)
+	ListScheduledQueries(context.Context, *ListScheduledQueriesRequest) (*ListScheduledQueriesResponse, error)
+	UpdateScheduledQuery(context.Context, *UpdateScheduledQueryRequest) (*ScheduledQuery, error)
+	DeleteScheduledQuery(context.Context, *DeleteScheduledQueryRequest) (*emptypb.Empty, error)
+	ListScheduledQueryResults(context.Context, *ListScheduledQueryResultsRequest) (*ListScheduledQueryResultsResponse, error)
 	AdminExecute(SQLService_AdminExecuteServer) error
 	DifferPreview(context.Context, *DifferPreviewRequest) (*DifferPreviewResponse, error)
 	Check(context.Context, *CheckRequest) (*CheckResponse, error)
@@ -155,9 +322,48 @@ type UnimplementedSQLServiceServer struct {
 func (UnimplementedSQLServiceServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (UnimplementedSQLServiceServer) Explain(context.Context, *ExplainRequest) (*ExplainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Explain not implemented")
+}
+func (UnimplementedSQLServiceServer) OpenQueryCursor(context.Context, *OpenQueryCursorRequest) (*QueryCursorPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenQueryCursor not implemented")
+}
+func (UnimplementedSQLServiceServer) FetchQueryCursor(context.Context, *FetchQueryCursorRequest) (*QueryCursorPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchQueryCursor not implemented")
+}
+func (UnimplementedSQLServiceServer) CloseQueryCursor(context.Context, *CloseQueryCursorRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseQueryCursor not implemented")
+}
 func (UnimplementedSQLServiceServer) Export(context.Context, *ExportRequest) (*ExportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
 }
+func (UnimplementedSQLServiceServer) ListExportAudits(context.Context, *ListExportAuditsRequest) (*ListExportAuditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExportAudits not implemented")
+}
+func (UnimplementedSQLServiceServer) QuerySharedSheet(context.Context, *QuerySharedSheetRequest) (*QueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySharedSheet not implemented")
+}
+func (UnimplementedSQLServiceServer) SearchQueryHistories(context.Context, *SearchQueryHistoriesRequest) (*SearchQueryHistoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchQueryHistories not implemented")
+}
+func (UnimplementedSQLServiceServer) GetQueryHistoryStats(context.Context, *GetQueryHistoryStatsRequest) (*QueryHistoryStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueryHistoryStats not implemented")
+}
+func (UnimplementedSQLServiceServer) CreateScheduledQuery(context.Context, *CreateScheduledQueryRequest) (*ScheduledQuery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduledQuery not implemented")
+}
+func (UnimplementedSQLServiceServer) ListScheduledQueries(context.Context, *ListScheduledQueriesRequest) (*ListScheduledQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledQueries not implemented")
+}
+func (UnimplementedSQLServiceServer) UpdateScheduledQuery(context.Context, *UpdateScheduledQueryRequest) (*ScheduledQuery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduledQuery not implemented")
+}
+func (UnimplementedSQLServiceServer) DeleteScheduledQuery(context.Context, *DeleteScheduledQueryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScheduledQuery not implemented")
+}
+func (UnimplementedSQLServiceServer) ListScheduledQueryResults(context.Context, *ListScheduledQueryResultsRequest) (*ListScheduledQueryResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledQueryResults not implemented")
+}
 func (UnimplementedSQLServiceServer) AdminExecute(SQLService_AdminExecuteServer) error {
 	return status.Errorf(codes.Unimplemented, "method AdminExecute not implemented")
 }
@@ -204,6 +410,78 @@ func _SQLService_Query_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_Explain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).Explain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_Explain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).Explain(ctx, req.(*ExplainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_OpenQueryCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenQueryCursorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).OpenQueryCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_OpenQueryCursor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).OpenQueryCursor(ctx, req.(*OpenQueryCursorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_FetchQueryCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchQueryCursorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).FetchQueryCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_FetchQueryCursor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).FetchQueryCursor(ctx, req.(*FetchQueryCursorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_CloseQueryCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseQueryCursorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).CloseQueryCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_CloseQueryCursor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).CloseQueryCursor(ctx, req.(*CloseQueryCursorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SQLService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExportRequest)
 	if err := dec(in); err != nil {
@@ -222,6 +500,168 @@ func _SQLService_Export_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_ListExportAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExportAuditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).ListExportAudits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_ListExportAudits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).ListExportAudits(ctx, req.(*ListExportAuditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_QuerySharedSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySharedSheetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).QuerySharedSheet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_QuerySharedSheet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).QuerySharedSheet(ctx, req.(*QuerySharedSheetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_SearchQueryHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchQueryHistoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).SearchQueryHistories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_SearchQueryHistories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).SearchQueryHistories(ctx, req.(*SearchQueryHistoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_GetQueryHistoryStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueryHistoryStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).GetQueryHistoryStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_GetQueryHistoryStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).GetQueryHistoryStats(ctx, req.(*GetQueryHistoryStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_CreateScheduledQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduledQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).CreateScheduledQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_CreateScheduledQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).CreateScheduledQuery(ctx, req.(*CreateScheduledQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_ListScheduledQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).ListScheduledQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_ListScheduledQueries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).ListScheduledQueries(ctx, req.(*ListScheduledQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_UpdateScheduledQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduledQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).UpdateScheduledQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_UpdateScheduledQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).UpdateScheduledQuery(ctx, req.(*UpdateScheduledQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_DeleteScheduledQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduledQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).DeleteScheduledQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_DeleteScheduledQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).DeleteScheduledQuery(ctx, req.(*DeleteScheduledQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_ListScheduledQueryResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledQueryResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).ListScheduledQueryResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_ListScheduledQueryResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).ListScheduledQueryResults(ctx, req.(*ListScheduledQueryResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SQLService_AdminExecute_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(SQLServiceServer).AdminExecute(&sQLServiceAdminExecuteServer{stream})
 }
@@ -331,10 +771,62 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Query",
 			Handler:    _SQLService_Query_Handler,
 		},
+		{
+			MethodName: "Explain",
+			Handler:    _SQLService_Explain_Handler,
+		},
+		{
+			MethodName: "OpenQueryCursor",
+			Handler:    _SQLService_OpenQueryCursor_Handler,
+		},
+		{
+			MethodName: "FetchQueryCursor",
+			Handler:    _SQLService_FetchQueryCursor_Handler,
+		},
+		{
+			MethodName: "CloseQueryCursor",
+			Handler:    _SQLService_CloseQueryCursor_Handler,
+		},
 		{
 			MethodName: "Export",
 			Handler:    _SQLService_Export_Handler,
 		},
+		{
+			MethodName: "ListExportAudits",
+			Handler:    _SQLService_ListExportAudits_Handler,
+		},
+		{
+			MethodName: "QuerySharedSheet",
+			Handler:    _SQLService_QuerySharedSheet_Handler,
+		},
+		{
+			MethodName: "SearchQueryHistories",
+			Handler:    _SQLService_SearchQueryHistories_Handler,
+		},
+		{
+			MethodName: "GetQueryHistoryStats",
+			Handler:    _SQLService_GetQueryHistoryStats_Handler,
+		},
+		{
+			MethodName: "CreateScheduledQuery",
+			Handler:    _SQLService_CreateScheduledQuery_Handler,
+		},
+		{
+			MethodName: "ListScheduledQueries",
+			Handler:    _SQLService_ListScheduledQueries_Handler,
+		},
+		{
+			MethodName: "UpdateScheduledQuery",
+			Handler:    _SQLService_UpdateScheduledQuery_Handler,
+		},
+		{
+			MethodName: "DeleteScheduledQuery",
+			Handler:    _SQLService_DeleteScheduledQuery_Handler,
+		},
+		{
+			MethodName: "ListScheduledQueryResults",
+			Handler:    _SQLService_ListScheduledQueryResults_Handler,
+		},
 		{
 			MethodName: "DifferPreview",
 			Handler:    _SQLService_DifferPreview_Handler,